@@ -33,6 +33,7 @@ import (
 	minttypes "github.com/allora-network/allora-chain/x/mint/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -43,7 +44,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	consensuskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
@@ -69,13 +72,13 @@ import (
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 	ibctestingtypes "github.com/cosmos/ibc-go/v8/testing/types"
+	"github.com/spf13/cast"
 
 	_ "cosmossdk.io/api/cosmos/tx/config/v1" // import for side-effects
 	_ "cosmossdk.io/x/circuit"               // import for side-effects
 	_ "cosmossdk.io/x/upgrade"
 	_ "github.com/allora-network/allora-chain/x/emissions/module"
 	_ "github.com/allora-network/allora-chain/x/mint/module" // import for side-effects
-	_ "github.com/cosmos/cosmos-sdk/x/auth"                  // import for side-effects
 	_ "github.com/cosmos/cosmos-sdk/x/auth/tx/config"        // import for side-effects
 	_ "github.com/cosmos/cosmos-sdk/x/authz/module"          // import for side-effects
 	_ "github.com/cosmos/cosmos-sdk/x/bank"                  // import for side-effects
@@ -192,6 +195,13 @@ func NewAlloraApp(
 	if nurseCfgPath != "" {
 		nurseCfg := health.MustReadConfigTOML(nurseCfgPath)
 		nurseCfg.Logger = logger
+		// The chain-aware checks default to watching this node
+		if nurseCfg.RPCAddr == "" {
+			nurseCfg.RPCAddr = cast.ToString(appOpts.Get("rpc.laddr"))
+		}
+		if homeDir := cast.ToString(appOpts.Get(flags.FlagHome)); nurseCfg.DataDir == "" && homeDir != "" {
+			nurseCfg.DataDir = filepath.Join(homeDir, "data")
+		}
 		app.nurse = health.NewNurse(nurseCfg)
 
 		err := app.nurse.Start()
@@ -232,6 +242,14 @@ func NewAlloraApp(
 
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
 
+	// The mint module burns its fee burn fraction of the fees collected by the emissions module
+	app.EmissionsKeeper.SetFeeBurner(app.MintKeeper)
+
+	// The nurse watches how long the emissions EndBlocker takes and whether blocks advance
+	if app.nurse != nil {
+		app.EmissionsKeeper.SetEndBlockerObserver(app.nurse)
+	}
+
 	// Register legacy modules
 	app.registerIBCModules()
 
@@ -275,7 +293,11 @@ func NewAlloraApp(
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	// NOTE: this is not required apps that don't use the simulator for fuzz testing transactions
-	app.sm = module.NewSimulationManagerFromAppModules(app.ModuleManager.Modules, make(map[string]module.AppModuleSimulation, 0))
+	// auth is overridden as the chain does not register vesting accounts, which it would randomly generate
+	overrideModules := map[string]module.AppModuleSimulation{
+		authtypes.ModuleName: auth.NewAppModule(app.appCodec, app.AccountKeeper, randomGenesisAccounts, app.GetSubspace(authtypes.ModuleName)),
+	}
+	app.sm = module.NewSimulationManagerFromAppModules(app.ModuleManager.Modules, overrideModules)
 	app.sm.RegisterStoreDecoders()
 
 	topicsHandler := NewTopicsHandler(app.EmissionsKeeper)
@@ -358,6 +380,15 @@ func (app *AlloraApp) GetCapabilityScopedKeeper(moduleName string) capabilitykee
 	return app.CapabilityKeeper.ScopeToModule(moduleName)
 }
 
+// randomGenesisAccounts gives every simulated account a plain base account
+func randomGenesisAccounts(simState *module.SimulationState) authtypes.GenesisAccounts {
+	genesisAccs := make(authtypes.GenesisAccounts, len(simState.Accounts))
+	for i, acc := range simState.Accounts {
+		genesisAccs[i] = authtypes.NewBaseAccountWithAddress(acc.Address)
+	}
+	return genesisAccs
+}
+
 // SimulationManager implements the SimulationApp interface
 func (app *AlloraApp) SimulationManager() *module.SimulationManager {
 	return app.sm
@@ -0,0 +1,45 @@
+package app
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"github.com/allora-network/allora-chain/app/params"
+	minttypes "github.com/allora-network/allora-chain/x/mint/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The fees collected by the emissions module are burned out of the ecosystem account, which needs the burner permission
+func TestBurnFeesFromEcosystemAccount(t *testing.T) {
+	app, err := NewAlloraApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+	require.NoError(t, err)
+	ctx := app.BaseApp.NewUncachedContext(false, cmtproto.Header{})
+
+	mintParams := minttypes.DefaultParams()
+	mintParams.MintDenom = params.DefaultBondDenom
+	mintParams.FeeBurnFraction = math.LegacyMustNewDecFromStr("0.25")
+	require.NoError(t, app.MintKeeper.Params.Set(ctx, mintParams))
+	fees := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, math.NewInt(1000)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, fees))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, minttypes.EcosystemModuleName, fees))
+	supplyBefore := app.BankKeeper.GetSupply(ctx, params.DefaultBondDenom)
+
+	burned, err := app.EmissionsKeeper.BurnFees(ctx, math.NewInt(1000))
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(250), burned)
+
+	ecosystemAddr := app.AccountKeeper.GetModuleAddress(minttypes.EcosystemModuleName)
+	ecosystemBalance := app.BankKeeper.GetBalance(ctx, ecosystemAddr, params.DefaultBondDenom)
+	require.Equal(t, math.NewInt(750), ecosystemBalance.Amount)
+	supplyAfter := app.BankKeeper.GetSupply(ctx, params.DefaultBondDenom)
+	require.Equal(t, supplyBefore.Amount.Sub(burned), supplyAfter.Amount)
+	totalBurnedFees, err := app.MintKeeper.GetTotalBurnedFees(ctx)
+	require.NoError(t, err)
+	require.Equal(t, burned, totalBurnedFees)
+}
@@ -4,6 +4,7 @@ import (
 	"cosmossdk.io/core/appmodule"
 	storetypes "cosmossdk.io/store/types"
 	alloraMath "github.com/allora-network/allora-chain/math"
+	emissionsmsgserver "github.com/allora-network/allora-chain/x/emissions/keeper/msgserver"
 	"github.com/allora-network/allora-chain/x/ibc/gmp"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -111,6 +112,8 @@ func (app *AlloraApp) registerIBCModules() {
 		scopedIBCTransferKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	// Network inferences of subscribed topics are pushed to EVM chains through Axelar GMP over ICS20
+	app.EmissionsKeeper.SetGmpSender(gmp.NewOutboundSender(app.TransferKeeper))
 
 	// Create interchain account keepers
 	app.ICAHostKeeper = icahostkeeper.NewKeeper(
@@ -142,7 +145,7 @@ func (app *AlloraApp) registerIBCModules() {
 	var transferIBCModule porttypes.IBCModule
 	transferIBCModule = ibctransfer.NewIBCModule(app.TransferKeeper)
 	transferIBCModule = ibcfee.NewIBCMiddleware(transferIBCModule, app.IBCFeeKeeper)
-	transferIBCModule = gmp.NewIBCMiddleware(transferIBCModule)
+	transferIBCModule = gmp.NewIBCMiddleware(transferIBCModule, &app.EmissionsKeeper, emissionsmsgserver.NewMsgServerImpl(app.EmissionsKeeper))
 
 	// integration point for custom authentication modules
 	var noAuthzModule porttypes.IBCModule
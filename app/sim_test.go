@@ -0,0 +1,229 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"github.com/allora-network/allora-chain/app/params"
+	emissionskeeper "github.com/allora-network/allora-chain/x/emissions/keeper"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	minttypes "github.com/allora-network/allora-chain/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
+)
+
+// The simulations are skipped unless enabled, e.g.
+//
+//	go test ./app -run TestFullAppSimulation -Enabled=true -NumBlocks=100 -BlockSize=50 -Commit=true -Seed=7 -v
+func init() {
+	simcli.GetSimulatorFlags()
+}
+
+const simChainID = "allora-simulation"
+
+// Returns the options of a simulation app, which neither loads a nurse config nor keeps snapshots
+func simAppOptions(t *testing.T) simtestutil.AppOptionsMap {
+	t.Helper()
+	return simtestutil.AppOptionsMap{
+		flags.FlagHome:            t.TempDir(),
+		server.FlagInvCheckPeriod: simcli.FlagPeriodValue,
+	}
+}
+
+// Sets up a fresh simulation app on its own database, or skips the test when simulations are not enabled
+func setupSimApp(t *testing.T, config simtypes.Config, dbName string) (*AlloraApp, dbm.DB) {
+	t.Helper()
+	// The simulated accounts hold and stake the native denom
+	sdk.DefaultBondDenom = params.DefaultBondDenom
+
+	db, dir, logger, skip, err := simtestutil.SetupSimulation(config, "leveldb-app-sim", dbName, simcli.FlagVerboseValue, simcli.FlagEnabledValue)
+	if skip {
+		t.Skip("skipping application simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+		require.NoError(t, os.RemoveAll(dir))
+	})
+
+	app, err := NewAlloraApp(logger, db, nil, true, simAppOptions(t), fauxMerkleModeOpt, persistSimulatedTxsOpt, baseapp.SetChainID(config.ChainID))
+	require.NoError(t, err)
+	return app, db
+}
+
+// Runs a simulation from the configured seed and returns the exported parameters
+func runSimulation(t *testing.T, app *AlloraApp, config simtypes.Config) {
+	t.Helper()
+	_, simParams, simErr := simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		app.BaseApp,
+		simAppStateFn(t, app),
+		simtypes.RandomAccounts,
+		simtestutil.SimulationOperations(app, app.AppCodec(), config),
+		app.BankKeeper.GetBlockedAddresses(),
+		config,
+		app.AppCodec(),
+	)
+	require.NoError(t, simErr)
+	require.NoError(t, simtestutil.CheckExportSimulation(app, config, simParams))
+}
+
+// Generates the random genesis of a simulation.
+// The gov module account of this chain holds no burner permission, so proposal deposits are never burned.
+func simAppStateFn(t *testing.T, app *AlloraApp) simtypes.AppStateFn {
+	t.Helper()
+	appStateFn := simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), app.DefaultGenesis())
+	return func(r *rand.Rand, accs []simtypes.Account, config simtypes.Config) (json.RawMessage, []simtypes.Account, string, time.Time) {
+		appState, simAccs, chainID, genesisTime := appStateFn(r, accs, config)
+
+		var genesisState map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(appState, &genesisState))
+		var govGenesis govv1.GenesisState
+		app.AppCodec().MustUnmarshalJSON(genesisState[govtypes.ModuleName], &govGenesis)
+		govGenesis.Params.BurnVoteQuorum = false
+		govGenesis.Params.BurnVoteVeto = false
+		govGenesis.Params.BurnProposalDepositPrevote = false
+		genesisState[govtypes.ModuleName] = app.AppCodec().MustMarshalJSON(&govGenesis)
+
+		appState, err := json.Marshal(genesisState)
+		require.NoError(t, err)
+		return appState, simAccs, chainID, genesisTime
+	}
+}
+
+// Checks the invariants of the emissions module at the last committed height
+func requireEmissionsInvariants(t *testing.T, app *AlloraApp) {
+	t.Helper()
+	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()}) //nolint:exhaustruct
+	msg, broken := emissionskeeper.AllInvariants(app.EmissionsKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+// fauxMerkleModeOpt makes the IAVL stores faster to commit, which is all simulations need
+func fauxMerkleModeOpt(bapp *baseapp.BaseApp) {
+	bapp.SetFauxMerkleMode()
+}
+
+// persistSimulatedTxsOpt flushes the block state once more right before it is committed.
+// The simulator delivers its operations after FinalizeBlock has already written the block state to the root store,
+// so without this their writes would be dropped and every block would start from the state left by the EndBlocker.
+func persistSimulatedTxsOpt(bapp *baseapp.BaseApp) {
+	bapp.SetPrecommiter(func(ctx sdk.Context) {
+		if ms, ok := ctx.MultiStore().(storetypes.CacheMultiStore); ok {
+			ms.Write()
+		}
+	})
+}
+
+func newSimConfig() simtypes.Config {
+	config := simcli.NewConfigFromFlags()
+	config.ChainID = simChainID
+	return config
+}
+
+func TestFullAppSimulation(t *testing.T) {
+	config := newSimConfig()
+	app, db := setupSimApp(t, config, "Simulation")
+
+	runSimulation(t, app, config)
+	requireEmissionsInvariants(t, app)
+
+	if config.Commit {
+		simtestutil.PrintStats(db)
+	}
+}
+
+func TestAppImportExport(t *testing.T) {
+	config := newSimConfig()
+	app, _ := setupSimApp(t, config, "Simulation")
+
+	runSimulation(t, app, config)
+	requireEmissionsInvariants(t, app)
+
+	exported, err := app.ExportAppStateAndValidators(false, []string{}, []string{})
+	require.NoError(t, err)
+
+	newApp, _ := setupSimApp(t, config, "Simulation-2")
+	var genesisState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(exported.AppState, &genesisState))
+	var emissionsGenesis emissionstypes.GenesisState
+	app.AppCodec().MustUnmarshalJSON(genesisState[emissionstypes.ModuleName], &emissionsGenesis)
+	require.Empty(t, emissionsGenesis.ValidationProblems())
+
+	ctxA := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})    //nolint:exhaustruct
+	ctxB := newApp.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()}) //nolint:exhaustruct
+	_, err = newApp.ModuleManager.InitGenesis(ctxB, app.AppCodec(), genesisState)
+	require.NoError(t, err)
+	require.NoError(t, newApp.StoreConsensusParams(ctxB, exported.ConsensusParams))
+
+	// The modules owned by this chain must round trip through genesis without loss
+	for _, storeName := range []string{emissionstypes.StoreKey, minttypes.StoreKey} {
+		storeA := ctxA.KVStore(app.GetKey(storeName))
+		storeB := ctxB.KVStore(newApp.GetKey(storeName))
+		failedKVAs, failedKVBs := simtestutil.DiffKVStores(storeA, storeB, nil)
+		require.Equal(t, len(failedKVAs), len(failedKVBs), "unequal sets of key-values to compare in %s", storeName)
+		require.Empty(t, failedKVAs, simtestutil.GetSimulationLog(storeName, app.SimulationManager().StoreDecoders, failedKVAs, failedKVBs))
+	}
+}
+
+func TestAppStateDeterminism(t *testing.T) {
+	if !simcli.FlagEnabledValue {
+		t.Skip("skipping application simulation")
+	}
+
+	config := newSimConfig()
+	config.InitialBlockHeight = 1
+	config.ExportParamsPath = ""
+	config.OnOperation = false
+	config.AllInvariants = false
+
+	numSeeds := 3
+	numTimesToRunPerSeed := 3
+	appHashList := make([]json.RawMessage, numTimesToRunPerSeed)
+
+	for i := 0; i < numSeeds; i++ {
+		config.Seed = rand.Int63()
+
+		for j := 0; j < numTimesToRunPerSeed; j++ {
+			sdk.DefaultBondDenom = params.DefaultBondDenom
+			db := dbm.NewMemDB()
+			app, err := NewAlloraApp(log.NewNopLogger(), db, nil, true, simAppOptions(t), fauxMerkleModeOpt, persistSimulatedTxsOpt, baseapp.SetChainID(config.ChainID))
+			require.NoError(t, err)
+
+			fmt.Printf(
+				"running non-determinism simulation; seed %d: %d/%d, attempt: %d/%d\n",
+				config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
+			)
+			runSimulation(t, app, config)
+
+			appHash := app.LastCommitID().Hash
+			appHashList[j] = appHash
+
+			if j != 0 {
+				require.Equal(
+					t, string(appHashList[0]), string(appHashList[j]),
+					"non-determinism in seed %d: %d/%d, attempt: %d/%d\n", config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
+				)
+			}
+		}
+	}
+}
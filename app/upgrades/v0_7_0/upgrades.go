@@ -0,0 +1,28 @@
+package v0_7_0 //nolint:revive // var-naming: don't use an underscore in package name
+
+import (
+	"context"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/allora-network/allora-chain/app/upgrades"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+const (
+	UpgradeName = "v0.7.0"
+)
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+}
+
+// Runs the emissions v5 to v6 and mint v2 to v3 migrations
+func CreateUpgradeHandler(
+	moduleManager *module.Manager,
+	configurator module.Configurator,
+) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return moduleManager.RunMigrations(ctx, configurator, vm)
+	}
+}
@@ -0,0 +1,465 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"cosmossdk.io/log"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/allora-network/allora-chain/app"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/module/rewards"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	minttypes "github.com/allora-network/allora-chain/x/mint/types"
+)
+
+const (
+	flagReplayGenesis     = "genesis"
+	flagReplayHeight      = "height"
+	flagReplayTopicReward = "topic-reward"
+	flagReplayParam       = "param"
+	flagReplayOutput      = "output"
+
+	replayOutputTable = "table"
+	replayOutputJSON  = "json"
+)
+
+// EmissionsCmd groups the offline tools working on the emissions state
+func EmissionsCmd() *cobra.Command {
+	cmd := &cobra.Command{ // nolint: exhaustruct // dependency code don't want to change the way it works
+		Use:                        "emissions",
+		Short:                      "Offline tools working on the emissions state",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(ReplayEmissionsCmd())
+
+	return cmd
+}
+
+// ReplayEmissionsCmd replays the reward computation of a topic for one of its reputer nonces
+// over an exported genesis or the state of a stopped node, loaded into an in-memory app.
+// Nothing it computes is ever written back.
+func ReplayEmissionsCmd() *cobra.Command {
+	cmd := &cobra.Command{ //nolint:exhaustruct // only the fields needed by the command are set
+		Use:   "replay [topic_id] [block_height]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Replays the network regrets and rewards distribution of a topic for the reputer nonce at a block height",
+		Long: `Replays the network regrets and rewards distribution of a topic for the reputer nonce at a block height,
+as computed by the EndBlocker once that nonce is closed, and prints the scores, entropies, chi, gamma and rewards of every actor.
+
+The state is read from the exported genesis passed with --genesis, or else from the data directory of the node at --home,
+which must be stopped. The regrets and score EMAs are updated once per nonce, so the nonce must not be rewarded yet:
+either it is still open, and it is closed as the EndBlocker would at the next block, or it is closed and awaiting its rewards.
+To replay a rewarded nonce, use the state from before the block it was closed at, e.g. with --height.
+
+Params can be overridden with --param, e.g. --param task_reward_alpha=0.2 --param p_reward_inference=2.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			topicId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid topic id %s: %w", args[0], err)
+			}
+			blockHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid block height %s: %w", args[1], err)
+			}
+			topicRewardFlag, err := cmd.Flags().GetString(flagReplayTopicReward)
+			if err != nil {
+				return err
+			}
+			topicReward, err := alloraMath.NewDecFromString(topicRewardFlag)
+			if err != nil || !topicReward.Gt(alloraMath.ZeroDec()) {
+				return fmt.Errorf("the topic reward must be a positive decimal, got %s", topicRewardFlag)
+			}
+			overrides, err := cmd.Flags().GetStringArray(flagReplayParam)
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(flagReplayOutput)
+			if err != nil {
+				return err
+			}
+			if output != replayOutputTable && output != replayOutputJSON {
+				return fmt.Errorf("unknown output %s, expected %s or %s", output, replayOutputTable, replayOutputJSON)
+			}
+			genesis, err := cmd.Flags().GetString(flagReplayGenesis)
+			if err != nil {
+				return err
+			}
+			height, err := cmd.Flags().GetInt64(flagReplayHeight)
+			if err != nil {
+				return err
+			}
+
+			// the replay failing is the outcome of the command, not a misuse of it
+			cmd.SilenceUsage = true
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			var (
+				alloraApp *app.AlloraApp
+				ctx       sdk.Context
+				db        dbm.DB
+			)
+			if genesis != "" {
+				db = dbm.NewMemDB()
+				alloraApp, ctx, err = loadAppFromGenesis(serverCtx, db, genesis)
+			} else {
+				dataDir := filepath.Join(serverCtx.Config.RootDir, "data")
+				db, err = dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), dataDir)
+				if err != nil {
+					return fmt.Errorf("error opening the application database in %s, is the node stopped? %w", dataDir, err)
+				}
+				alloraApp, ctx, err = loadAppFromDataDir(serverCtx, db, height)
+			}
+			defer db.Close()
+			if err != nil {
+				return err
+			}
+
+			report, err := replayTopicRewards(ctx, alloraApp, topicId, blockHeight, topicReward, overrides)
+			if err != nil {
+				return err
+			}
+			if output == replayOutputJSON {
+				reportJSON, err := json.MarshalIndent(report, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), string(reportJSON))
+				return nil
+			}
+			return report.printTable(cmd.OutOrStdout())
+		},
+	}
+
+	cmd.Flags().String(flagReplayGenesis, "", "Exported genesis file to replay over, instead of the data directory of the node")
+	cmd.Flags().Int64(flagReplayHeight, 0, "Height of the node state to replay over, the latest one if 0. Ignored with --genesis")
+	cmd.Flags().String(flagReplayTopicReward, "1", "Reward of the topic to distribute, the rewards are then the shares of the topic reward")
+	cmd.Flags().StringArray(flagReplayParam, []string{}, "Emissions param to override as name=value, name being the snake case name of the param. Can be repeated")
+	cmd.Flags().String(flagReplayOutput, replayOutputTable, "Output format (table|json)")
+
+	return cmd
+}
+
+// Initializes an in-memory app with the state of an exported genesis
+func loadAppFromGenesis(serverCtx *server.Context, db dbm.DB, genesis string) (*app.AlloraApp, sdk.Context, error) {
+	appGenesis, err := genutiltypes.AppGenesisFromFile(genesis)
+	if err != nil {
+		return nil, sdk.Context{}, err
+	}
+	var genesisState map[string]json.RawMessage
+	if err := json.Unmarshal(appGenesis.AppState, &genesisState); err != nil {
+		return nil, sdk.Context{}, fmt.Errorf("error unmarshalling genesis doc %s: %w", genesis, err)
+	}
+
+	alloraApp, err := app.NewAlloraApp(log.NewNopLogger(), db, nil, true, serverCtx.Viper)
+	if err != nil {
+		return nil, sdk.Context{}, err
+	}
+	ctx := alloraApp.NewContextLegacy(true, cmtproto.Header{ //nolint:exhaustruct // only the fields read by the modules are set
+		ChainID: appGenesis.ChainID,
+		Height:  appGenesis.InitialHeight,
+		Time:    appGenesis.GenesisTime,
+	})
+	if _, err := alloraApp.ModuleManager.InitGenesis(ctx, alloraApp.AppCodec(), genesisState); err != nil {
+		return nil, sdk.Context{}, fmt.Errorf("error initializing the state of %s: %w", genesis, err)
+	}
+	return alloraApp, ctx, nil
+}
+
+// Loads the app from the database of a node, the returned context caching all writes
+func loadAppFromDataDir(serverCtx *server.Context, db dbm.DB, height int64) (*app.AlloraApp, sdk.Context, error) {
+	alloraApp, err := app.NewAlloraApp(log.NewNopLogger(), db, nil, height == 0, serverCtx.Viper)
+	if err != nil {
+		return nil, sdk.Context{}, err
+	}
+	if height != 0 {
+		if err := alloraApp.LoadHeight(height); err != nil {
+			return nil, sdk.Context{}, err
+		}
+	}
+	if alloraApp.LastBlockHeight() == 0 {
+		return nil, sdk.Context{}, errors.New("the node has not committed any block")
+	}
+	ctx := alloraApp.NewContextLegacy(true, cmtproto.Header{ //nolint:exhaustruct // only the fields read by the modules are set
+		Height: alloraApp.LastBlockHeight() + 1,
+	})
+	return alloraApp, ctx, nil
+}
+
+// Overrides the params whose snake case names are given in `overrides` as name=value
+func overrideParams(cdc codec.JSONCodec, moduleParams emissionstypes.Params, overrides []string) (emissionstypes.Params, error) {
+	if len(overrides) == 0 {
+		return moduleParams, nil
+	}
+	paramsJSON, err := cdc.MarshalJSON(&moduleParams)
+	if err != nil {
+		return emissionstypes.Params{}, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(paramsJSON, &fields); err != nil {
+		return emissionstypes.Params{}, err
+	}
+	for _, override := range overrides {
+		name, value, ok := strings.Cut(override, "=")
+		if !ok {
+			return emissionstypes.Params{}, fmt.Errorf("invalid param override %s, expected name=value", override)
+		}
+		current, ok := fields[name]
+		if !ok {
+			return emissionstypes.Params{}, fmt.Errorf("unknown param %s", name)
+		}
+		// decimals and 64 bit integers are JSON strings
+		if strings.HasPrefix(string(current), `"`) {
+			fields[name], err = json.Marshal(value)
+			if err != nil {
+				return emissionstypes.Params{}, err
+			}
+		} else {
+			if !json.Valid([]byte(value)) {
+				return emissionstypes.Params{}, fmt.Errorf("invalid value %s of param %s", value, name)
+			}
+			fields[name] = json.RawMessage(value)
+		}
+	}
+	overriddenJSON, err := json.Marshal(fields)
+	if err != nil {
+		return emissionstypes.Params{}, err
+	}
+	var overridden emissionstypes.Params
+	if err := cdc.UnmarshalJSON(overriddenJSON, &overridden); err != nil {
+		return emissionstypes.Params{}, fmt.Errorf("error unmarshalling the overridden params: %w", err)
+	}
+	if err := overridden.Validate(); err != nil {
+		return emissionstypes.Params{}, fmt.Errorf("invalid overridden params: %w", err)
+	}
+	return overridden, nil
+}
+
+// Replays the rewards of a topic nonce over the state of the app, with the params overridden
+func replayTopicRewards(
+	ctx sdk.Context,
+	alloraApp *app.AlloraApp,
+	topicId uint64,
+	blockHeight int64,
+	topicReward alloraMath.Dec,
+	overrides []string,
+) (replayReport, error) {
+	k := alloraApp.EmissionsKeeper
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return replayReport{}, err
+	}
+	moduleParams, err = overrideParams(alloraApp.AppCodec(), moduleParams, overrides)
+	if err != nil {
+		return replayReport{}, err
+	}
+	if err := k.SetParams(ctx, moduleParams); err != nil {
+		return replayReport{}, err
+	}
+
+	// The share of the delegators is moved out of the rewards account while distributing,
+	// which must hold the topic reward whatever it was left with in the replayed state
+	topicRewardInt, err := topicReward.SdkIntTrim()
+	if err != nil {
+		return replayReport{}, err
+	}
+	funds := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, topicRewardInt.AddRaw(1)))
+	if err := alloraApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, funds); err != nil {
+		return replayReport{}, err
+	}
+	err = alloraApp.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, emissionstypes.AlloraRewardsAccountName, funds)
+	if err != nil {
+		return replayReport{}, err
+	}
+
+	replay, err := rewards.ReplayTopicRewards(ctx, k, topicId, blockHeight, topicReward, moduleParams)
+	if err != nil {
+		return replayReport{}, err
+	}
+	return newReplayReport(topicId, blockHeight, topicReward, replay), nil
+}
+
+type replayTask struct {
+	Task    string         `json:"task"`
+	Entropy alloraMath.Dec `json:"entropy"`
+	Reward  alloraMath.Dec `json:"reward"`
+}
+
+// The values of an actor for a task, those not applying to the task being omitted
+type replayActor struct {
+	Task           string          `json:"task"`
+	Address        string          `json:"address"`
+	Loss           *alloraMath.Dec `json:"loss,omitempty"`
+	Score          alloraMath.Dec  `json:"score"`
+	RewardFraction *alloraMath.Dec `json:"reward_fraction,omitempty"`
+	Regret         *alloraMath.Dec `json:"regret,omitempty"`
+	NaiveRegret    *alloraMath.Dec `json:"naive_regret,omitempty"`
+	// net of the share of the delegators for reputers
+	Reward *alloraMath.Dec `json:"reward,omitempty"`
+}
+
+type replayReport struct {
+	TopicId      uint64         `json:"topic_id"`
+	BlockHeight  int64          `json:"block_height"`
+	TopicReward  alloraMath.Dec `json:"topic_reward"`
+	CombinedLoss alloraMath.Dec `json:"combined_loss"`
+	NaiveLoss    alloraMath.Dec `json:"naive_loss"`
+	Chi          alloraMath.Dec `json:"chi"`
+	Gamma        alloraMath.Dec `json:"gamma"`
+	Tasks        []replayTask   `json:"tasks"`
+	Actors       []replayActor  `json:"actors"`
+}
+
+func newReplayReport(topicId uint64, blockHeight int64, topicReward alloraMath.Dec, replay rewards.TopicRewardsReplay) replayReport {
+	breakdown := replay.Breakdown
+	report := replayReport{
+		TopicId:      topicId,
+		BlockHeight:  blockHeight,
+		TopicReward:  topicReward,
+		CombinedLoss: replay.NetworkLosses.CombinedValue,
+		NaiveLoss:    replay.NetworkLosses.NaiveValue,
+		Chi:          breakdown.Chi,
+		Gamma:        breakdown.Gamma,
+		Tasks: []replayTask{
+			{Task: "reputer", Entropy: breakdown.ReputerEntropy, Reward: breakdown.TaskReputerReward},
+			{Task: "inference", Entropy: breakdown.InferenceEntropy, Reward: breakdown.TaskInferenceReward},
+			{Task: "forecast", Entropy: breakdown.ForecastingEntropy, Reward: breakdown.TaskForecastingReward},
+		},
+		Actors: []replayActor{},
+	}
+
+	rewardsByTask := make(map[emissionstypes.TaskRewardType]map[string]alloraMath.Dec)
+	for _, reward := range breakdown.Rewards {
+		if rewardsByTask[reward.Type] == nil {
+			rewardsByTask[reward.Type] = make(map[string]alloraMath.Dec)
+		}
+		rewardsByTask[reward.Type][reward.Address] = reward.Reward
+	}
+	byAddress := func(addresses []string, values []alloraMath.Dec) map[string]alloraMath.Dec {
+		valuesByAddress := make(map[string]alloraMath.Dec, len(addresses))
+		for i, address := range addresses {
+			valuesByAddress[address] = values[i]
+		}
+		return valuesByAddress
+	}
+	lossesByWorker := func(losses []*emissionstypes.WorkerAttributedValue) map[string]alloraMath.Dec {
+		lossesByWorker := make(map[string]alloraMath.Dec, len(losses))
+		for _, loss := range losses {
+			lossesByWorker[loss.Worker] = loss.Value
+		}
+		return lossesByWorker
+	}
+	regretsByWorker := func(regrets []rewards.WorkerRegret) map[string]alloraMath.Dec {
+		regretsByWorker := make(map[string]alloraMath.Dec, len(regrets))
+		for _, regret := range regrets {
+			regretsByWorker[regret.Worker] = regret.Regret
+		}
+		return regretsByWorker
+	}
+	lookup := func(values map[string]alloraMath.Dec, address string) *alloraMath.Dec {
+		if value, ok := values[address]; ok {
+			return &value
+		}
+		return nil
+	}
+
+	emptyValues := map[string]alloraMath.Dec{}
+	addActors := func(
+		task string,
+		scores []emissionstypes.Score,
+		rewardType emissionstypes.TaskRewardType,
+		fractions, losses, regrets, naiveRegrets map[string]alloraMath.Dec,
+	) {
+		for _, score := range scores {
+			report.Actors = append(report.Actors, replayActor{
+				Task:           task,
+				Address:        score.Address,
+				Loss:           lookup(losses, score.Address),
+				Score:          score.Score,
+				RewardFraction: lookup(fractions, score.Address),
+				Regret:         lookup(regrets, score.Address),
+				NaiveRegret:    lookup(naiveRegrets, score.Address),
+				Reward:         lookup(rewardsByTask[rewardType], score.Address),
+			})
+		}
+	}
+	addActors("reputer", breakdown.ReputerScores, emissionstypes.ReputerAndDelegatorRewardType,
+		byAddress(breakdown.Reputers, breakdown.ReputersRewardFractions), emptyValues, emptyValues, emptyValues)
+	addActors("inference", breakdown.InfererScores, emissionstypes.WorkerInferenceRewardType,
+		byAddress(breakdown.Inferers, breakdown.InferersRewardFractions),
+		lossesByWorker(replay.NetworkLosses.InfererValues),
+		regretsByWorker(replay.InfererRegrets),
+		regretsByWorker(replay.NaiveInfererRegrets))
+	addActors("forecast", breakdown.ForecasterScores, emissionstypes.WorkerForecastRewardType,
+		byAddress(breakdown.Forecasters, breakdown.ForecastersRewardFractions),
+		lossesByWorker(replay.NetworkLosses.ForecasterValues),
+		regretsByWorker(replay.ForecasterRegrets),
+		emptyValues)
+	return report
+}
+
+func (r replayReport) printTable(out io.Writer) error {
+	orDash := func(value *alloraMath.Dec) string {
+		if value == nil {
+			return "-"
+		}
+		return value.String()
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "TOPIC\t%d\n", r.TopicId)
+	fmt.Fprintf(w, "NONCE\t%d\n", r.BlockHeight)
+	fmt.Fprintf(w, "TOPIC REWARD\t%s\n", r.TopicReward)
+	fmt.Fprintf(w, "COMBINED LOSS\t%s\n", r.CombinedLoss)
+	fmt.Fprintf(w, "NAIVE LOSS\t%s\n", r.NaiveLoss)
+	fmt.Fprintf(w, "CHI\t%s\n", r.Chi)
+	fmt.Fprintf(w, "GAMMA\t%s\n", r.Gamma)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(out)
+	w = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TASK\tENTROPY\tREWARD")
+	for _, task := range r.Tasks {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", task.Task, task.Entropy, task.Reward)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(out)
+	w = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TASK\tADDRESS\tLOSS\tSCORE\tREWARD FRACTION\tREGRET\tNAIVE REGRET\tREWARD")
+	for _, actor := range r.Actors {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			actor.Task,
+			actor.Address,
+			orDash(actor.Loss),
+			actor.Score,
+			orDash(actor.RewardFraction),
+			orDash(actor.Regret),
+			orDash(actor.NaiveRegret),
+			orDash(actor.Reward),
+		)
+	}
+	return w.Flush()
+}
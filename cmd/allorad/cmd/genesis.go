@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	cosmosMath "cosmossdk.io/math"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/allora-network/allora-chain/app/params"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// ValidateEmissionsGenesisCmd reports every problem found in the emissions section of a genesis file,
+// rather than stopping at the first one as `genesis validate` does.
+// It also checks that the emissions module accounts hold the stake and accrued rewards accounted for in that section.
+func ValidateEmissionsGenesisCmd() *cobra.Command {
+	return &cobra.Command{ //nolint:exhaustruct // only the fields needed by the command are set
+		Use:   "validate-emissions [file]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Lists all problems in the emissions state of the genesis file at the default location or at the location passed as an arg",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			genesis := serverCtx.Config.GenesisFile()
+			if len(args) > 0 {
+				genesis = args[0]
+			}
+			appGenesis, err := genutiltypes.AppGenesisFromFile(genesis)
+			if err != nil {
+				return err
+			}
+			var genState map[string]json.RawMessage
+			if err := json.Unmarshal(appGenesis.AppState, &genState); err != nil {
+				return fmt.Errorf("error unmarshalling genesis doc %s: %w", genesis, err)
+			}
+
+			emissionsGenState, ok := genState[emissionstypes.ModuleName]
+			if !ok {
+				return fmt.Errorf("the emissions section is missing in the app_state of %s", genesis)
+			}
+			var emissionsGenesis emissionstypes.GenesisState
+			if err := clientCtx.Codec.UnmarshalJSON(emissionsGenState, &emissionsGenesis); err != nil {
+				return fmt.Errorf("error unmarshalling the emissions genesis state: %w", err)
+			}
+
+			problems := emissionsGenesis.ValidationProblems()
+			if bankGenState, ok := genState[banktypes.ModuleName]; ok {
+				var bankGenesis banktypes.GenesisState
+				if err := clientCtx.Codec.UnmarshalJSON(bankGenState, &bankGenesis); err != nil {
+					return fmt.Errorf("error unmarshalling the bank genesis state: %w", err)
+				}
+				problems = append(problems, moduleAccountBalanceProblems(emissionsGenesis, bankGenesis)...)
+			}
+
+			// the problems are the outcome of the command, not a misuse of it
+			cmd.SilenceUsage = true
+			out := cmd.OutOrStdout()
+			if len(problems) == 0 {
+				fmt.Fprintf(out, "The emissions state of %s is valid\n", genesis)
+				return nil
+			}
+			for _, problem := range problems {
+				fmt.Fprintln(out, problem.Error())
+			}
+			return fmt.Errorf("found %d problems in the emissions state of %s", len(problems), genesis)
+		},
+	}
+}
+
+// Checks the balances of the emissions module accounts against the emissions genesis state,
+// as the staking and accrued rewards invariants do once the chain runs
+func moduleAccountBalanceProblems(emissionsGenesis emissionstypes.GenesisState, bankGenesis banktypes.GenesisState) []error {
+	balances := make(map[string]cosmosMath.Int)
+	for _, balance := range bankGenesis.Balances {
+		balances[balance.Address] = balance.Coins.AmountOf(params.DefaultBondDenom)
+	}
+	balanceOf := func(moduleAccount string) cosmosMath.Int {
+		if balance, ok := balances[authtypes.NewModuleAddress(moduleAccount).String()]; ok {
+			return balance
+		}
+		return cosmosMath.ZeroInt()
+	}
+
+	problems := []error{}
+	totalStake := emissionsGenesis.TotalStake
+	if totalStake.IsNil() {
+		totalStake = cosmosMath.ZeroInt()
+	}
+	if stakingBalance := balanceOf(emissionstypes.AlloraStakingAccountName); !stakingBalance.Equal(totalStake) {
+		problems = append(problems, fmt.Errorf("total_stake: %s does not equal the %s balance of the %s module account %s",
+			totalStake, params.DefaultBondDenom, emissionstypes.AlloraStakingAccountName, stakingBalance))
+	}
+	totalAccrued := cosmosMath.ZeroInt()
+	for _, accrued := range emissionsGenesis.AccruedRewards {
+		if accrued != nil && !accrued.Int.IsNil() {
+			totalAccrued = totalAccrued.Add(accrued.Int)
+		}
+	}
+	if accruedBalance := balanceOf(emissionstypes.AlloraAccruedRewardsAccountName); !accruedBalance.Equal(totalAccrued) {
+		problems = append(problems, fmt.Errorf("accrued_rewards: %s in total does not equal the %s balance of the %s module account %s",
+			totalAccrued, params.DefaultBondDenom, emissionstypes.AlloraAccruedRewardsAccountName, accruedBalance))
+	}
+	return problems
+}
@@ -0,0 +1,205 @@
+package health
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"cosmossdk.io/errors"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+
+	"github.com/allora-network/allora-chain/utils"
+)
+
+const mempoolRPCTimeout = 5 * time.Second
+
+// chainVitals is what the chain-aware checks know about the progress of the chain.
+// The app feeds it through ObserveEndBlocker, once per block.
+type chainVitals struct {
+	mu sync.Mutex
+
+	lastHeight  int64
+	lastBlockAt time.Time
+
+	// the slowest EndBlocker since the last run of the end blocker check
+	slowestEndBlocker       time.Duration
+	slowestEndBlockerHeight int64
+
+	dataDirSize       int64
+	dataDirMeasuredAt time.Time
+
+	// returns the number and total bytes of the transactions in the mempool
+	mempoolSize func(ctx context.Context) (int, int64, error)
+}
+
+func newChainVitals() *chainVitals {
+	return &chainVitals{} //nolint:exhaustruct
+}
+
+// ObserveEndBlocker records that the emissions EndBlocker of a block ran, and how long it took.
+// This makes the nurse an EndBlockerObserver of the emissions keeper.
+func (n *Nurse) ObserveEndBlocker(blockHeight int64, duration time.Duration) {
+	v := n.chain
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.lastHeight = blockHeight
+	v.lastBlockAt = time.Now()
+	if duration > v.slowestEndBlocker {
+		v.slowestEndBlocker = duration
+		v.slowestEndBlockerHeight = blockHeight
+	}
+}
+
+// Registers the chain-aware checks whose threshold is set
+func (n *Nurse) addChainChecks() error {
+	if n.cfg.BlockLagThreshold > 0 {
+		n.chain.mu.Lock()
+		if n.chain.lastBlockAt.IsZero() {
+			// Blocks are expected to advance from the moment the nurse starts
+			n.chain.lastBlockAt = time.Now()
+		}
+		n.chain.mu.Unlock()
+		n.AddCheck("block-lag", n.checkBlockLag)
+	}
+
+	if n.cfg.EndBlockerDurationThreshold > 0 {
+		n.AddCheck("end-blocker-duration", n.checkEndBlockerDuration)
+	}
+
+	if n.cfg.MempoolSizeThreshold > 0 {
+		if n.chain.mempoolSize == nil {
+			client, err := rpchttp.New(n.cfg.RPCAddr, "/websocket")
+			if err != nil {
+				return errors.Wrap(err, "while creating nurse rpc client")
+			}
+			n.chain.mempoolSize = func(ctx context.Context) (int, int64, error) {
+				res, err := client.NumUnconfirmedTxs(ctx)
+				if err != nil {
+					return 0, 0, err
+				}
+				return res.Total, res.TotalBytes, nil
+			}
+		}
+		n.AddCheck("mempool-size", n.checkMempoolSize)
+	}
+
+	if n.cfg.DataDirGrowthThreshold > 0 && n.cfg.DataDir != "" {
+		n.AddCheck("data-dir-growth", n.checkDataDirGrowth)
+	}
+
+	return nil
+}
+
+func (n *Nurse) checkBlockLag() (bool, Meta) {
+	n.chain.mu.Lock()
+	lastHeight, lastBlockAt := n.chain.lastHeight, n.chain.lastBlockAt
+	n.chain.mu.Unlock()
+
+	lag := time.Since(lastBlockAt)
+	unwell := lag >= time.Duration(n.cfg.BlockLagThreshold)
+	if !unwell {
+		return false, nil
+	}
+	return true, Meta{
+		"last_height":     lastHeight,
+		"last_block_time": lastBlockAt,
+		"lag":             lag,
+		"threshold":       n.cfg.BlockLagThreshold,
+	}
+}
+
+func (n *Nurse) checkEndBlockerDuration() (bool, Meta) {
+	n.chain.mu.Lock()
+	slowest, height := n.chain.slowestEndBlocker, n.chain.slowestEndBlockerHeight
+	n.chain.slowestEndBlocker, n.chain.slowestEndBlockerHeight = 0, 0
+	n.chain.mu.Unlock()
+
+	unwell := slowest >= time.Duration(n.cfg.EndBlockerDurationThreshold)
+	if !unwell {
+		return false, nil
+	}
+	return true, Meta{
+		"height":    height,
+		"duration":  slowest,
+		"threshold": n.cfg.EndBlockerDurationThreshold,
+	}
+}
+
+func (n *Nurse) checkMempoolSize() (bool, Meta) {
+	ctx, cancel := context.WithTimeout(context.Background(), mempoolRPCTimeout)
+	defer cancel()
+
+	numTxs, totalBytes, err := n.chain.mempoolSize(ctx)
+	if err != nil {
+		n.Warn("nurse could not fetch the mempool size", "error", err)
+		return false, nil
+	}
+	unwell := numTxs >= n.cfg.MempoolSizeThreshold
+	if !unwell {
+		return false, nil
+	}
+	return true, Meta{
+		"num_txs":     numTxs,
+		"total_bytes": utils.ByteSize(totalBytes),
+		"threshold":   n.cfg.MempoolSizeThreshold,
+	}
+}
+
+// Compares the size of the data directory with its size at the previous run, scaled to an hour
+func (n *Nurse) checkDataDirGrowth() (bool, Meta) {
+	size, err := dirSize(n.cfg.DataDir)
+	if err != nil {
+		n.Warn("nurse could not measure the data directory", "dir", n.cfg.DataDir, "error", err)
+		return false, nil
+	}
+	now := time.Now()
+
+	n.chain.mu.Lock()
+	prevSize, prevMeasuredAt := n.chain.dataDirSize, n.chain.dataDirMeasuredAt
+	n.chain.dataDirSize, n.chain.dataDirMeasuredAt = size, now
+	n.chain.mu.Unlock()
+
+	elapsed := now.Sub(prevMeasuredAt)
+	if prevMeasuredAt.IsZero() || elapsed <= 0 {
+		return false, nil
+	}
+	growthPerHour := utils.ByteSize(float64(size-prevSize) * float64(time.Hour) / float64(elapsed))
+	unwell := growthPerHour >= n.cfg.DataDirGrowthThreshold
+	if !unwell {
+		return false, nil
+	}
+	return true, Meta{
+		"data_dir":        n.cfg.DataDir,
+		"data_dir_size":   utils.ByteSize(size),
+		"growth_per_hour": growthPerHour,
+		"threshold":       n.cfg.DataDirGrowthThreshold,
+	}
+}
+
+// Returns the total size of the regular files under a directory
+func dirSize(root string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if errors.IsOf(err, fs.ErrNotExist) && path != root {
+			// Databases remove files as they compact, while we walk
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		info, err := entry.Info()
+		if errors.IsOf(err, fs.ErrNotExist) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
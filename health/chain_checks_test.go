@@ -0,0 +1,107 @@
+package health
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/allora-network/allora-chain/utils"
+)
+
+func TestCheckBlockLag(t *testing.T) {
+	t.Parallel()
+
+	n := newTestNurse(t)
+	n.cfg.BlockLagThreshold = utils.Duration(time.Minute)
+
+	n.ObserveEndBlocker(10, time.Millisecond)
+	unwell, _ := n.checkBlockLag()
+	require.False(t, unwell)
+
+	n.chain.lastBlockAt = time.Now().Add(-2 * time.Minute)
+	unwell, meta := n.checkBlockLag()
+	require.True(t, unwell)
+	require.Equal(t, int64(10), meta["last_height"])
+}
+
+func TestCheckEndBlockerDuration(t *testing.T) {
+	t.Parallel()
+
+	n := newTestNurse(t)
+	n.cfg.EndBlockerDurationThreshold = utils.Duration(time.Second)
+
+	n.ObserveEndBlocker(10, 100*time.Millisecond)
+	n.ObserveEndBlocker(11, 3*time.Second)
+	n.ObserveEndBlocker(12, 200*time.Millisecond)
+	unwell, meta := n.checkEndBlockerDuration()
+	require.True(t, unwell)
+	require.Equal(t, int64(11), meta["height"])
+	require.Equal(t, 3*time.Second, meta["duration"])
+
+	// Each run only looks at the blocks since the previous one
+	n.ObserveEndBlocker(13, 200*time.Millisecond)
+	unwell, _ = n.checkEndBlockerDuration()
+	require.False(t, unwell)
+}
+
+func TestCheckMempoolSize(t *testing.T) {
+	t.Parallel()
+
+	n := newTestNurse(t)
+	n.cfg.MempoolSizeThreshold = 100
+	numTxs := 99
+	n.chain.mempoolSize = func(context.Context) (int, int64, error) {
+		return numTxs, int64(numTxs) * 1000, nil
+	}
+	require.NoError(t, n.addChainChecks())
+	require.Contains(t, n.CheckResults(), "mempool-size")
+
+	unwell, _ := n.checkMempoolSize()
+	require.False(t, unwell)
+
+	numTxs = 100
+	unwell, meta := n.checkMempoolSize()
+	require.True(t, unwell)
+	require.Equal(t, 100, meta["num_txs"])
+	require.Equal(t, utils.ByteSize(100000), meta["total_bytes"])
+}
+
+func TestCheckDataDirGrowth(t *testing.T) {
+	t.Parallel()
+
+	n := newTestNurse(t)
+	n.cfg.DataDir = t.TempDir()
+	n.cfg.DataDirGrowthThreshold = utils.MB
+	require.NoError(t, os.WriteFile(filepath.Join(n.cfg.DataDir, "a.db"), make([]byte, 1000), profilePerms))
+
+	// The first run only measures the directory
+	unwell, _ := n.checkDataDirGrowth()
+	require.False(t, unwell)
+	require.Equal(t, int64(1000), n.chain.dataDirSize)
+
+	// 1000 bytes in half an hour is well below 1mb an hour
+	require.NoError(t, os.Mkdir(filepath.Join(n.cfg.DataDir, "sub"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(n.cfg.DataDir, "sub", "b.db"), make([]byte, 1000), profilePerms))
+	n.chain.dataDirMeasuredAt = time.Now().Add(-30 * time.Minute)
+	unwell, _ = n.checkDataDirGrowth()
+	require.False(t, unwell)
+
+	// 1mb in half an hour is 2mb an hour
+	require.NoError(t, os.WriteFile(filepath.Join(n.cfg.DataDir, "c.db"), make([]byte, utils.MB), profilePerms))
+	n.chain.dataDirMeasuredAt = time.Now().Add(-30 * time.Minute)
+	unwell, meta := n.checkDataDirGrowth()
+	require.True(t, unwell)
+	require.InDelta(t, float64(2*utils.MB), float64(meta["growth_per_hour"].(utils.ByteSize)), float64(utils.KB))
+}
+
+func TestAddChainChecksSkipsUnsetThresholds(t *testing.T) {
+	t.Parallel()
+
+	n := newTestNurse(t)
+	require.NoError(t, n.addChainChecks())
+	require.Empty(t, n.CheckResults())
+}
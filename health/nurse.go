@@ -2,7 +2,9 @@ package health
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -21,10 +23,16 @@ import (
 )
 
 type Nurse struct {
-	cfg      NurseConfig
-	checks   map[string]CheckFunc
-	checksMu sync.RWMutex
-	chGather chan gatherRequest
+	cfg       NurseConfig
+	checks    map[string]CheckFunc
+	checksMu  sync.RWMutex
+	results   map[string]CheckResult
+	resultsMu sync.RWMutex
+	chGather  chan gatherRequest
+
+	server  *http.Server
+	metrics *nurseMetrics
+	chain   *chainVitals
 
 	log.Logger
 
@@ -34,8 +42,8 @@ type Nurse struct {
 
 type NurseConfig struct {
 	ProfileRoot          string         `toml:"profile-root"`
-	PollInterval         time.Duration  `toml:"poll-interval"`
-	GatherDuration       time.Duration  `toml:"gather-duration"`
+	PollInterval         utils.Duration `toml:"poll-interval"`
+	GatherDuration       utils.Duration `toml:"gather-duration"`
 	MaxProfileSize       utils.ByteSize `toml:"max-profile-size"`
 	CPUProfileRate       int            `toml:"cpu-profile-rate"`
 	MemProfileRate       int            `toml:"mem-profile-rate"`
@@ -43,6 +51,15 @@ type NurseConfig struct {
 	MutexProfileFraction int            `toml:"mutex-profile-fraction"`
 	MemThreshold         utils.ByteSize `toml:"mem-threshold"`
 	GoroutineThreshold   int            `toml:"goroutine-threshold"`
+	HTTPListenAddr       string         `toml:"http-listen-addr"`
+
+	// Chain-aware checks, each disabled when its threshold is zero
+	BlockLagThreshold           utils.Duration `toml:"block-lag-threshold"`
+	EndBlockerDurationThreshold utils.Duration `toml:"end-blocker-duration-threshold"`
+	MempoolSizeThreshold        int            `toml:"mempool-size-threshold"`
+	RPCAddr                     string         `toml:"rpc-addr"`
+	DataDirGrowthThreshold      utils.ByteSize `toml:"data-dir-growth-threshold"`
+	DataDir                     string         `toml:"data-dir"`
 
 	Logger log.Logger `toml:"-"`
 }
@@ -72,6 +89,13 @@ func ReadConfigTOML(path string) (NurseConfig, error) {
 
 type CheckFunc func() (unwell bool, meta Meta)
 
+// CheckResult is the outcome of the last run of a check
+type CheckResult struct {
+	Unwell    bool      `json:"unwell"`
+	Meta      Meta      `json:"meta,omitempty"`
+	CheckedAt time.Time `json:"checked_at"`
+}
+
 type gatherRequest struct {
 	reason string
 	meta   Meta
@@ -87,7 +111,10 @@ func NewNurse(cfg NurseConfig) *Nurse {
 		Logger:   cfg.Logger,
 		checks:   make(map[string]CheckFunc),
 		checksMu: sync.RWMutex{},
+		results:  make(map[string]CheckResult),
 		chGather: make(chan gatherRequest, 1),
+		metrics:  newNurseMetrics(),
+		chain:    newChainVitals(),
 		chStop:   make(chan struct{}),
 		wgDone:   sync.WaitGroup{},
 	}
@@ -104,6 +131,10 @@ func (n *Nurse) Start() error {
 
 	n.AddCheck("mem", n.checkMem)
 	n.AddCheck("goroutines", n.checkGoroutines)
+	err = n.addChainChecks()
+	if err != nil {
+		return err
+	}
 
 	n.wgDone.Add(1)
 	go func() {
@@ -113,19 +144,10 @@ func (n *Nurse) Start() error {
 			select {
 			case <-n.chStop:
 				return
-			case <-time.After(n.cfg.PollInterval):
+			case <-time.After(time.Duration(n.cfg.PollInterval)):
 			}
 
-			func() {
-				n.checksMu.RLock()
-				defer n.checksMu.RUnlock()
-				for reason, checkFunc := range n.checks {
-					if unwell, meta := checkFunc(); unwell {
-						n.GatherVitals(reason, meta)
-						break
-					}
-				}
-			}()
+			n.runChecks()
 		}
 	}()
 
@@ -143,13 +165,26 @@ func (n *Nurse) Start() error {
 		}
 	}()
 
+	if n.cfg.HTTPListenAddr != "" {
+		err = n.startServer()
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 func (n *Nurse) Close() error {
+	var err error
+	if n.server != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), serverShutdownTimeout)
+		defer cancel()
+		err = n.server.Shutdown(ctx)
+	}
 	close(n.chStop)
 	n.wgDone.Wait()
-	return nil
+	return err
 }
 
 func (n *Nurse) AddCheck(reason string, checkFunc CheckFunc) {
@@ -158,10 +193,58 @@ func (n *Nurse) AddCheck(reason string, checkFunc CheckFunc) {
 	n.checks[reason] = checkFunc
 }
 
+// Runs every check and records its result. Vitals are gathered for the first unwell check, if any.
+func (n *Nurse) runChecks() {
+	n.checksMu.RLock()
+	defer n.checksMu.RUnlock()
+
+	gathering := false
+	for reason, checkFunc := range n.checks {
+		unwell, meta := checkFunc()
+		n.recordResult(reason, CheckResult{Unwell: unwell, Meta: meta, CheckedAt: time.Now()})
+		if unwell && !gathering {
+			n.GatherVitals(reason, meta)
+			gathering = true
+		}
+	}
+}
+
+func (n *Nurse) recordResult(reason string, result CheckResult) {
+	n.resultsMu.Lock()
+	defer n.resultsMu.Unlock()
+	n.results[reason] = result
+	n.metrics.observe(reason, result)
+}
+
+// Returns the registered checks and, for those that have run, their last result
+func (n *Nurse) CheckResults() map[string]*CheckResult {
+	n.checksMu.RLock()
+	defer n.checksMu.RUnlock()
+	n.resultsMu.RLock()
+	defer n.resultsMu.RUnlock()
+
+	results := make(map[string]*CheckResult, len(n.checks))
+	for reason := range n.checks {
+		if result, ok := n.results[reason]; ok {
+			results[reason] = &result
+		} else {
+			results[reason] = nil
+		}
+	}
+	return results
+}
+
 func (n *Nurse) GatherVitals(reason string, meta Meta) {
+	n.requestGather(reason, meta)
+}
+
+// Queues a gathering of vitals, unless one is already queued. Returns whether it was queued.
+func (n *Nurse) requestGather(reason string, meta Meta) bool {
 	select {
 	case n.chGather <- gatherRequest{reason, meta}:
+		return true
 	default:
+		return false
 	}
 }
 
@@ -290,7 +373,7 @@ func (n *Nurse) gatherCPU(now time.Time, wg *sync.WaitGroup) {
 
 	select {
 	case <-n.chStop:
-	case <-time.After(n.cfg.GatherDuration):
+	case <-time.After(time.Duration(n.cfg.GatherDuration)):
 	}
 }
 
@@ -313,7 +396,7 @@ func (n *Nurse) gatherTrace(now time.Time, wg *sync.WaitGroup) {
 
 	select {
 	case <-n.chStop:
-	case <-time.After(n.cfg.GatherDuration):
+	case <-time.After(time.Duration(n.cfg.GatherDuration)):
 	}
 }
 
@@ -332,7 +415,7 @@ func (n *Nurse) gather(typ string, now time.Time, wg *sync.WaitGroup) {
 		return
 	}
 
-	t := time.NewTimer(n.cfg.GatherDuration)
+	t := time.NewTimer(time.Duration(n.cfg.GatherDuration))
 	defer t.Stop()
 
 	select {
@@ -403,7 +486,7 @@ func (n *Nurse) totalProfileBytes() (uint64, error) {
 	}
 	var size uint64
 	for _, entry := range entries {
-		if entry.IsDir() || (filepath.Ext(entry.Name()) != ".pprof" && entry.Name() != "nurse.log") {
+		if entry.IsDir() || !isDumpFile(entry.Name()) {
 			continue
 		}
 		info, err := entry.Info()
@@ -0,0 +1,182 @@
+package health
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"cosmossdk.io/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	serverReadHeaderTimeout = 10 * time.Second
+	serverShutdownTimeout   = 5 * time.Second
+)
+
+// The HTTP server lets operators inspect the nurse and trigger a gathering of vitals on demand:
+//
+//	GET  /checks        the registered checks and their last results
+//	POST /gather        queues a gathering of vitals, for the given `reason` form value
+//	GET  /dumps         the profiles and logs written under the profile root
+//	GET  /dumps/{name}  downloads one of them
+//	GET  /metrics       the state of the checks as Prometheus gauges
+func (n *Nurse) startServer() error {
+	listener, err := net.Listen("tcp", n.cfg.HTTPListenAddr)
+	if err != nil {
+		return errors.Wrap(err, "while starting nurse http listener")
+	}
+
+	n.server = &http.Server{
+		Handler:           n.handler(),
+		ReadHeaderTimeout: serverReadHeaderTimeout,
+	}
+
+	n.wgDone.Add(1)
+	go func() {
+		defer n.wgDone.Done()
+
+		err := n.server.Serve(listener)
+		if err != nil && !errors.IsOf(err, http.ErrServerClosed) {
+			n.Error("nurse http server stopped", "error", err)
+		}
+	}()
+
+	n.Info("nurse http server listening", "addr", listener.Addr().String())
+	return nil
+}
+
+func (n *Nurse) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /checks", n.handleChecks)
+	mux.HandleFunc("POST /gather", n.handleGather)
+	mux.HandleFunc("GET /dumps", n.handleListDumps)
+	mux.HandleFunc("GET /dumps/{name}", n.handleDownloadDump)
+	mux.Handle("GET /metrics", promhttp.HandlerFor(n.metrics.registry, promhttp.HandlerOpts{}))
+	return mux
+}
+
+type checkStatus struct {
+	Name       string       `json:"name"`
+	LastResult *CheckResult `json:"last_result"`
+}
+
+func (n *Nurse) handleChecks(w http.ResponseWriter, _ *http.Request) {
+	results := n.CheckResults()
+	checks := make([]checkStatus, 0, len(results))
+	for name, result := range results {
+		checks = append(checks, checkStatus{Name: name, LastResult: result})
+	}
+	sort.Slice(checks, func(i, j int) bool { return checks[i].Name < checks[j].Name })
+
+	n.writeJSON(w, http.StatusOK, checks)
+}
+
+func (n *Nurse) handleGather(w http.ResponseWriter, r *http.Request) {
+	reason := r.FormValue("reason")
+	if reason == "" {
+		n.writeJSON(w, http.StatusBadRequest, map[string]string{"error": "missing reason"})
+		return
+	}
+
+	queued := n.requestGather(reason, Meta{"requested_by": "http", "remote_addr": r.RemoteAddr})
+	if !queued {
+		n.writeJSON(w, http.StatusTooManyRequests, map[string]string{"error": "a gathering of vitals is already queued"})
+		return
+	}
+	n.writeJSON(w, http.StatusAccepted, map[string]string{"reason": reason})
+}
+
+type dumpInfo struct {
+	Name     string    `json:"name"`
+	Size     int64     `json:"size"`
+	Modified time.Time `json:"modified"`
+}
+
+func (n *Nurse) handleListDumps(w http.ResponseWriter, _ *http.Request) {
+	entries, err := os.ReadDir(n.cfg.ProfileRoot)
+	if err != nil && !os.IsNotExist(err) {
+		n.Error("could not list dumps", "error", err)
+		n.writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "could not list dumps"})
+		return
+	}
+
+	dumps := make([]dumpInfo, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !isDumpFile(entry.Name()) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		dumps = append(dumps, dumpInfo{Name: entry.Name(), Size: info.Size(), Modified: info.ModTime()})
+	}
+	n.writeJSON(w, http.StatusOK, dumps)
+}
+
+func (n *Nurse) handleDownloadDump(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	if name != filepath.Base(name) || !isDumpFile(name) {
+		http.NotFound(w, r)
+		return
+	}
+
+	filename := filepath.Join(n.cfg.ProfileRoot, name)
+	if info, err := os.Stat(filename); err != nil || info.IsDir() {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Disposition", "attachment; filename=\""+name+"\"")
+	http.ServeFile(w, r, filename)
+}
+
+func (n *Nurse) writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	err := json.NewEncoder(w).Encode(body)
+	if err != nil {
+		n.Error("could not write nurse http response", "error", err)
+	}
+}
+
+// Whether the file is one of those written by the nurse when gathering vitals
+func isDumpFile(name string) bool {
+	return filepath.Ext(name) == ".pprof" || name == "nurse.log"
+}
+
+type nurseMetrics struct {
+	registry  *prometheus.Registry
+	unwell    *prometheus.GaugeVec
+	checkedAt *prometheus.GaugeVec
+}
+
+func newNurseMetrics() *nurseMetrics {
+	m := &nurseMetrics{
+		registry: prometheus.NewRegistry(),
+		unwell: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "nurse_check_unwell",
+			Help: "Whether the last run of the check found the node unwell (1) or not (0)",
+		}, []string{"check"}),
+		checkedAt: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "nurse_check_last_run_timestamp_seconds",
+			Help: "Unix time of the last run of the check",
+		}, []string{"check"}),
+	}
+	m.registry.MustRegister(m.unwell, m.checkedAt)
+	return m
+}
+
+func (m *nurseMetrics) observe(check string, result CheckResult) {
+	unwell := 0.0
+	if result.Unwell {
+		unwell = 1
+	}
+	m.unwell.WithLabelValues(check).Set(unwell)
+	m.checkedAt.WithLabelValues(check).Set(float64(result.CheckedAt.Unix()))
+}
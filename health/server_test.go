@@ -0,0 +1,128 @@
+package health
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestNurse(t *testing.T) *Nurse {
+	t.Helper()
+	return NewNurse(NurseConfig{
+		ProfileRoot: t.TempDir(),
+		Logger:      log.NewNopLogger(),
+	})
+}
+
+func TestServerChecks(t *testing.T) {
+	t.Parallel()
+
+	n := newTestNurse(t)
+	n.AddCheck("unwell", func() (bool, Meta) { return true, Meta{"value": 7} })
+	n.runChecks()
+	n.AddCheck("never-run", func() (bool, Meta) { return false, nil })
+
+	srv := httptest.NewServer(n.handler())
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/checks")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var checks []checkStatus
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&checks))
+	require.Len(t, checks, 2)
+	require.Equal(t, "never-run", checks[0].Name)
+	require.Nil(t, checks[0].LastResult)
+	require.Equal(t, "unwell", checks[1].Name)
+	require.NotNil(t, checks[1].LastResult)
+	require.True(t, checks[1].LastResult.Unwell)
+	require.InDelta(t, 7, checks[1].LastResult.Meta["value"], 0)
+
+	// The unwell check queued a gathering of vitals
+	req := <-n.chGather
+	require.Equal(t, "unwell", req.reason)
+
+	resp, err = http.Get(srv.URL + "/metrics")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Contains(t, string(body), `nurse_check_unwell{check="unwell"} 1`)
+	require.NotContains(t, string(body), `check="never-run"`)
+}
+
+func TestServerGather(t *testing.T) {
+	t.Parallel()
+
+	n := newTestNurse(t)
+	srv := httptest.NewServer(n.handler())
+	defer srv.Close()
+
+	resp, err := http.PostForm(srv.URL+"/gather", url.Values{})
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = http.PostForm(srv.URL+"/gather", url.Values{"reason": {"investigating"}})
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+	// Only one gathering can be queued at a time
+	resp, err = http.PostForm(srv.URL+"/gather", url.Values{"reason": {"again"}})
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+	req := <-n.chGather
+	require.Equal(t, "investigating", req.reason)
+	require.Equal(t, "http", req.meta["requested_by"])
+}
+
+func TestServerDumps(t *testing.T) {
+	t.Parallel()
+
+	n := newTestNurse(t)
+	require.NoError(t, os.WriteFile(filepath.Join(n.cfg.ProfileRoot, "1.heap.pprof"), []byte("heap"), profilePerms))
+	require.NoError(t, os.WriteFile(filepath.Join(n.cfg.ProfileRoot, "nurse.log"), []byte("log"), profilePerms))
+	require.NoError(t, os.WriteFile(filepath.Join(n.cfg.ProfileRoot, "other.txt"), []byte("other"), profilePerms))
+
+	srv := httptest.NewServer(n.handler())
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/dumps")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var dumps []dumpInfo
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&dumps))
+	require.Len(t, dumps, 2)
+	require.Equal(t, "1.heap.pprof", dumps[0].Name)
+	require.Equal(t, int64(4), dumps[0].Size)
+	require.Equal(t, "nurse.log", dumps[1].Name)
+
+	resp, err = http.Get(srv.URL + "/dumps/1.heap.pprof")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, "heap", string(body))
+
+	// Only the files written by the nurse can be downloaded
+	for _, name := range []string{"other.txt", "missing.pprof", strings.ReplaceAll("../x.pprof", "/", "%2F")} {
+		resp, err = http.Get(srv.URL + "/dumps/" + name)
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusNotFound, resp.StatusCode, name)
+	}
+}
@@ -30,7 +30,7 @@ VALIDATORS_API_PORT_START=1317
 HEADS_IP_START=20
 CHAIN_ID="${CHAIN_ID:-localnet}"
 LOCALNET_DATADIR="$(pwd)/$CHAIN_ID"
-UPGRADE_VERSION="${UPGRADE_VERSION:-"v0.6.0"}"
+UPGRADE_VERSION="${UPGRADE_VERSION:-"v0.7.0"}"
 DO_UPGRADE="${DO_UPGRADE:-"false"}"
 if [ "$DO_UPGRADE" == "true" ]; then
     DOCKERFILE="${DOCKERFILE:-"./Dockerfile.upgrade"}"
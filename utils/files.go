@@ -6,6 +6,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // EnsureDirAndMaxPerms ensures that the given path exists, that it's a directory,
@@ -93,3 +94,20 @@ func (b ByteSize) String() string {
 		return fmt.Sprintf("%dB", b)
 	}
 }
+
+// Duration is a time.Duration that can be decoded from strings such as "90s" or "1m" in config files
+type Duration time.Duration
+
+func (d *Duration) UnmarshalText(text []byte) error {
+	value, err := time.ParseDuration(strings.TrimSpace(string(text)))
+	if err != nil {
+		return fmt.Errorf("invalid duration: %s", string(text))
+	}
+
+	*d = Duration(value)
+	return nil
+}
+
+func (d Duration) String() string {
+	return time.Duration(d).String()
+}
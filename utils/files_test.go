@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -145,3 +146,35 @@ func TestByteSize_String(t *testing.T) {
 		})
 	}
 }
+
+func TestDuration_UnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		want    utils.Duration
+		wantErr bool
+	}{
+		{"Seconds", "90s", utils.Duration(90 * time.Second), false},
+		{"Minutes", "1m", utils.Duration(time.Minute), false},
+		{"Compound", "1h30m", utils.Duration(90 * time.Minute), false},
+		{"Padded", " 5ms ", utils.Duration(5 * time.Millisecond), false},
+		{"No unit", "10", 0, true},
+		{"Invalid", "abc", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var d utils.Duration
+			err := d.UnmarshalText([]byte(tt.input))
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.want, d)
+		})
+	}
+}
@@ -136,3 +136,32 @@ func runSafelyClearWholeMapCase[K, V any](
 		require.True(t, strings.Contains(err.Error(), "collections: not found"))
 	}
 }
+
+func TestClearPage(t *testing.T) {
+	t.Parallel()
+
+	testDB := testutil.NewTestDB(t)
+	prefix := collections.NewPrefix(7)
+	m := collections.NewMap(testDB.SB, prefix, "clear_page", collections.Uint64Key, collections.Uint64Value)
+	other := collections.NewMap(testDB.SB, collections.NewPrefix(8), "untouched", collections.Uint64Key, collections.Uint64Value)
+
+	for i := range uint64(10) {
+		require.NoError(t, m.Set(testDB.TestCtx.Ctx, i, i))
+		require.NoError(t, other.Set(testDB.TestCtx.Ctx, i, i))
+	}
+
+	for _, expected := range []uint64{4, 4, 2, 0} {
+		deleted, err := migutils.ClearPage(testDB.Store, prefix, 4)
+		require.NoError(t, err)
+		require.Equal(t, expected, deleted)
+	}
+
+	for i := range uint64(10) {
+		has, err := m.Has(testDB.TestCtx.Ctx, i)
+		require.NoError(t, err)
+		require.False(t, has)
+		has, err = other.Has(testDB.TestCtx.Ctx, i)
+		require.NoError(t, err)
+		require.True(t, has)
+	}
+}
@@ -32,6 +32,9 @@ var (
 	fd_Topic_active_inferer_quantile    protoreflect.FieldDescriptor
 	fd_Topic_active_forecaster_quantile protoreflect.FieldDescriptor
 	fd_Topic_active_reputer_quantile    protoreflect.FieldDescriptor
+	fd_Topic_worker_reveal_window       protoreflect.FieldDescriptor
+	fd_Topic_value_dimension            protoreflect.FieldDescriptor
+	fd_Topic_archive_network_inferences protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -54,6 +57,9 @@ func init() {
 	fd_Topic_active_inferer_quantile = md_Topic.Fields().ByName("active_inferer_quantile")
 	fd_Topic_active_forecaster_quantile = md_Topic.Fields().ByName("active_forecaster_quantile")
 	fd_Topic_active_reputer_quantile = md_Topic.Fields().ByName("active_reputer_quantile")
+	fd_Topic_worker_reveal_window = md_Topic.Fields().ByName("worker_reveal_window")
+	fd_Topic_value_dimension = md_Topic.Fields().ByName("value_dimension")
+	fd_Topic_archive_network_inferences = md_Topic.Fields().ByName("archive_network_inferences")
 }
 
 var _ protoreflect.Message = (*fastReflection_Topic)(nil)
@@ -223,6 +229,24 @@ func (x *fastReflection_Topic) Range(f func(protoreflect.FieldDescriptor, protor
 			return
 		}
 	}
+	if x.WorkerRevealWindow != int64(0) {
+		value := protoreflect.ValueOfInt64(x.WorkerRevealWindow)
+		if !f(fd_Topic_worker_reveal_window, value) {
+			return
+		}
+	}
+	if x.ValueDimension != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ValueDimension)
+		if !f(fd_Topic_value_dimension, value) {
+			return
+		}
+	}
+	if x.ArchiveNetworkInferences != false {
+		value := protoreflect.ValueOfBool(x.ArchiveNetworkInferences)
+		if !f(fd_Topic_archive_network_inferences, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -272,6 +296,12 @@ func (x *fastReflection_Topic) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.ActiveForecasterQuantile != ""
 	case "emissions.v3.Topic.active_reputer_quantile":
 		return x.ActiveReputerQuantile != ""
+	case "emissions.v3.Topic.worker_reveal_window":
+		return x.WorkerRevealWindow != int64(0)
+	case "emissions.v3.Topic.value_dimension":
+		return x.ValueDimension != uint64(0)
+	case "emissions.v3.Topic.archive_network_inferences":
+		return x.ArchiveNetworkInferences != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Topic"))
@@ -322,6 +352,12 @@ func (x *fastReflection_Topic) Clear(fd protoreflect.FieldDescriptor) {
 		x.ActiveForecasterQuantile = ""
 	case "emissions.v3.Topic.active_reputer_quantile":
 		x.ActiveReputerQuantile = ""
+	case "emissions.v3.Topic.worker_reveal_window":
+		x.WorkerRevealWindow = int64(0)
+	case "emissions.v3.Topic.value_dimension":
+		x.ValueDimension = uint64(0)
+	case "emissions.v3.Topic.archive_network_inferences":
+		x.ArchiveNetworkInferences = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Topic"))
@@ -389,6 +425,15 @@ func (x *fastReflection_Topic) Get(descriptor protoreflect.FieldDescriptor) prot
 	case "emissions.v3.Topic.active_reputer_quantile":
 		value := x.ActiveReputerQuantile
 		return protoreflect.ValueOfString(value)
+	case "emissions.v3.Topic.worker_reveal_window":
+		value := x.WorkerRevealWindow
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v3.Topic.value_dimension":
+		value := x.ValueDimension
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.Topic.archive_network_inferences":
+		value := x.ArchiveNetworkInferences
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Topic"))
@@ -443,6 +488,12 @@ func (x *fastReflection_Topic) Set(fd protoreflect.FieldDescriptor, value protor
 		x.ActiveForecasterQuantile = value.Interface().(string)
 	case "emissions.v3.Topic.active_reputer_quantile":
 		x.ActiveReputerQuantile = value.Interface().(string)
+	case "emissions.v3.Topic.worker_reveal_window":
+		x.WorkerRevealWindow = value.Int()
+	case "emissions.v3.Topic.value_dimension":
+		x.ValueDimension = value.Uint()
+	case "emissions.v3.Topic.archive_network_inferences":
+		x.ArchiveNetworkInferences = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Topic"))
@@ -497,6 +548,12 @@ func (x *fastReflection_Topic) Mutable(fd protoreflect.FieldDescriptor) protoref
 		panic(fmt.Errorf("field active_forecaster_quantile of message emissions.v3.Topic is not mutable"))
 	case "emissions.v3.Topic.active_reputer_quantile":
 		panic(fmt.Errorf("field active_reputer_quantile of message emissions.v3.Topic is not mutable"))
+	case "emissions.v3.Topic.worker_reveal_window":
+		panic(fmt.Errorf("field worker_reveal_window of message emissions.v3.Topic is not mutable"))
+	case "emissions.v3.Topic.value_dimension":
+		panic(fmt.Errorf("field value_dimension of message emissions.v3.Topic is not mutable"))
+	case "emissions.v3.Topic.archive_network_inferences":
+		panic(fmt.Errorf("field archive_network_inferences of message emissions.v3.Topic is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Topic"))
@@ -544,6 +601,12 @@ func (x *fastReflection_Topic) NewField(fd protoreflect.FieldDescriptor) protore
 		return protoreflect.ValueOfString("")
 	case "emissions.v3.Topic.active_reputer_quantile":
 		return protoreflect.ValueOfString("")
+	case "emissions.v3.Topic.worker_reveal_window":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v3.Topic.value_dimension":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.Topic.archive_network_inferences":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Topic"))
@@ -675,6 +738,15 @@ func (x *fastReflection_Topic) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 2 + l + runtime.Sov(uint64(l))
 		}
+		if x.WorkerRevealWindow != 0 {
+			n += 2 + runtime.Sov(uint64(x.WorkerRevealWindow))
+		}
+		if x.ValueDimension != 0 {
+			n += 2 + runtime.Sov(uint64(x.ValueDimension))
+		}
+		if x.ArchiveNetworkInferences {
+			n += 3
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -704,6 +776,32 @@ func (x *fastReflection_Topic) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ArchiveNetworkInferences {
+			i--
+			if x.ArchiveNetworkInferences {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc0
+		}
+		if x.ValueDimension != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValueDimension))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb8
+		}
+		if x.WorkerRevealWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WorkerRevealWindow))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb0
+		}
 		if len(x.ActiveReputerQuantile) > 0 {
 			i -= len(x.ActiveReputerQuantile)
 			copy(dAtA[i:], x.ActiveReputerQuantile)
@@ -1344,6 +1442,64 @@ func (x *fastReflection_Topic) ProtoMethods() *protoiface.Methods {
 				}
 				x.ActiveReputerQuantile = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 22:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WorkerRevealWindow", wireType)
+				}
+				x.WorkerRevealWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WorkerRevealWindow |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 23:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValueDimension", wireType)
+				}
+				x.ValueDimension = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValueDimension |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 24:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ArchiveNetworkInferences", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ArchiveNetworkInferences = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3360,203 +3516,3968 @@ func (x *fastReflection_TopicIdWeightPair) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: emissions/v3/topic.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-type Topic struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+var _ protoreflect.List = (*_OptionalTopicParams_1_list)(nil)
 
-	Id                     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Creator                string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
-	Metadata               string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	LossMethod             string `protobuf:"bytes,5,opt,name=loss_method,json=lossMethod,proto3" json:"loss_method,omitempty"`
-	EpochLastEnded         int64  `protobuf:"varint,8,opt,name=epoch_last_ended,json=epochLastEnded,proto3" json:"epoch_last_ended,omitempty"`
-	EpochLength            int64  `protobuf:"varint,9,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
-	GroundTruthLag         int64  `protobuf:"varint,10,opt,name=ground_truth_lag,json=groundTruthLag,proto3" json:"ground_truth_lag,omitempty"`
-	PNorm                  string `protobuf:"bytes,12,opt,name=p_norm,json=pNorm,proto3" json:"p_norm,omitempty"`
-	AlphaRegret            string `protobuf:"bytes,13,opt,name=alpha_regret,json=alphaRegret,proto3" json:"alpha_regret,omitempty"`
-	AllowNegative          bool   `protobuf:"varint,14,opt,name=allow_negative,json=allowNegative,proto3" json:"allow_negative,omitempty"`
-	Epsilon                string `protobuf:"bytes,15,opt,name=epsilon,proto3" json:"epsilon,omitempty"`
-	InitialRegret          string `protobuf:"bytes,16,opt,name=initial_regret,json=initialRegret,proto3" json:"initial_regret,omitempty"`
-	WorkerSubmissionWindow int64  `protobuf:"varint,17,opt,name=worker_submission_window,json=workerSubmissionWindow,proto3" json:"worker_submission_window,omitempty"`
-	// alpha parameter to weight previous score EMA importance in
-	// filtering the active set of inferers, forecasters, and reputers
-	MeritSortitionAlpha      string `protobuf:"bytes,18,opt,name=merit_sortition_alpha,json=meritSortitionAlpha,proto3" json:"merit_sortition_alpha,omitempty"`
-	ActiveInfererQuantile    string `protobuf:"bytes,19,opt,name=active_inferer_quantile,json=activeInfererQuantile,proto3" json:"active_inferer_quantile,omitempty"`
-	ActiveForecasterQuantile string `protobuf:"bytes,20,opt,name=active_forecaster_quantile,json=activeForecasterQuantile,proto3" json:"active_forecaster_quantile,omitempty"`
-	ActiveReputerQuantile    string `protobuf:"bytes,21,opt,name=active_reputer_quantile,json=activeReputerQuantile,proto3" json:"active_reputer_quantile,omitempty"`
+type _OptionalTopicParams_1_list struct {
+	list *[]int64
 }
 
-func (x *Topic) Reset() {
-	*x = Topic{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v3_topic_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *_OptionalTopicParams_1_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-func (x *Topic) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_OptionalTopicParams_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt64((*x.list)[i])
 }
 
-func (*Topic) ProtoMessage() {}
-
-// Deprecated: Use Topic.ProtoReflect.Descriptor instead.
-func (*Topic) Descriptor() ([]byte, []int) {
-	return file_emissions_v3_topic_proto_rawDescGZIP(), []int{0}
+func (x *_OptionalTopicParams_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
 }
 
-func (x *Topic) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+func (x *_OptionalTopicParams_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *Topic) GetCreator() string {
-	if x != nil {
-		return x.Creator
-	}
-	return ""
+func (x *_OptionalTopicParams_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message OptionalTopicParams at list field EpochLength as it is not of Message kind"))
 }
 
-func (x *Topic) GetMetadata() string {
-	if x != nil {
-		return x.Metadata
-	}
-	return ""
+func (x *_OptionalTopicParams_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
 }
 
-func (x *Topic) GetLossMethod() string {
-	if x != nil {
-		return x.LossMethod
-	}
-	return ""
+func (x *_OptionalTopicParams_1_list) NewElement() protoreflect.Value {
+	v := int64(0)
+	return protoreflect.ValueOfInt64(v)
 }
 
-func (x *Topic) GetEpochLastEnded() int64 {
-	if x != nil {
-		return x.EpochLastEnded
-	}
-	return 0
+func (x *_OptionalTopicParams_1_list) IsValid() bool {
+	return x.list != nil
 }
 
-func (x *Topic) GetEpochLength() int64 {
-	if x != nil {
-		return x.EpochLength
-	}
-	return 0
-}
+var _ protoreflect.List = (*_OptionalTopicParams_2_list)(nil)
 
-func (x *Topic) GetGroundTruthLag() int64 {
-	if x != nil {
-		return x.GroundTruthLag
-	}
-	return 0
+type _OptionalTopicParams_2_list struct {
+	list *[]int64
 }
 
-func (x *Topic) GetPNorm() string {
-	if x != nil {
-		return x.PNorm
+func (x *_OptionalTopicParams_2_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	return ""
+	return len(*x.list)
 }
 
-func (x *Topic) GetAlphaRegret() string {
-	if x != nil {
-		return x.AlphaRegret
-	}
-	return ""
+func (x *_OptionalTopicParams_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt64((*x.list)[i])
 }
 
-func (x *Topic) GetAllowNegative() bool {
-	if x != nil {
-		return x.AllowNegative
-	}
-	return false
+func (x *_OptionalTopicParams_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
 }
 
-func (x *Topic) GetEpsilon() string {
-	if x != nil {
-		return x.Epsilon
-	}
-	return ""
+func (x *_OptionalTopicParams_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *Topic) GetInitialRegret() string {
-	if x != nil {
-		return x.InitialRegret
-	}
-	return ""
+func (x *_OptionalTopicParams_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message OptionalTopicParams at list field GroundTruthLag as it is not of Message kind"))
 }
 
-func (x *Topic) GetWorkerSubmissionWindow() int64 {
-	if x != nil {
-		return x.WorkerSubmissionWindow
-	}
-	return 0
+func (x *_OptionalTopicParams_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
 }
 
-func (x *Topic) GetMeritSortitionAlpha() string {
-	if x != nil {
-		return x.MeritSortitionAlpha
-	}
-	return ""
+func (x *_OptionalTopicParams_2_list) NewElement() protoreflect.Value {
+	v := int64(0)
+	return protoreflect.ValueOfInt64(v)
 }
 
-func (x *Topic) GetActiveInfererQuantile() string {
-	if x != nil {
-		return x.ActiveInfererQuantile
-	}
-	return ""
+func (x *_OptionalTopicParams_2_list) IsValid() bool {
+	return x.list != nil
 }
 
-func (x *Topic) GetActiveForecasterQuantile() string {
-	if x != nil {
-		return x.ActiveForecasterQuantile
-	}
-	return ""
+var _ protoreflect.List = (*_OptionalTopicParams_3_list)(nil)
+
+type _OptionalTopicParams_3_list struct {
+	list *[]int64
 }
 
-func (x *Topic) GetActiveReputerQuantile() string {
-	if x != nil {
-		return x.ActiveReputerQuantile
+func (x *_OptionalTopicParams_3_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	return ""
+	return len(*x.list)
 }
 
-type TopicList struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *_OptionalTopicParams_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt64((*x.list)[i])
+}
 
-	Topics []*Topic `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+func (x *_OptionalTopicParams_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
 }
 
-func (x *TopicList) Reset() {
-	*x = TopicList{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v3_topic_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *_OptionalTopicParams_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *TopicList) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_OptionalTopicParams_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message OptionalTopicParams at list field WorkerSubmissionWindow as it is not of Message kind"))
+}
+
+func (x *_OptionalTopicParams_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OptionalTopicParams_3_list) NewElement() protoreflect.Value {
+	v := int64(0)
+	return protoreflect.ValueOfInt64(v)
+}
+
+func (x *_OptionalTopicParams_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_OptionalTopicParams_4_list)(nil)
+
+type _OptionalTopicParams_4_list struct {
+	list *[]string
+}
+
+func (x *_OptionalTopicParams_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OptionalTopicParams_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_OptionalTopicParams_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OptionalTopicParams_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OptionalTopicParams_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message OptionalTopicParams at list field PNorm as it is not of Message kind"))
+}
+
+func (x *_OptionalTopicParams_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OptionalTopicParams_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_OptionalTopicParams_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_OptionalTopicParams_5_list)(nil)
+
+type _OptionalTopicParams_5_list struct {
+	list *[]string
+}
+
+func (x *_OptionalTopicParams_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OptionalTopicParams_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_OptionalTopicParams_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OptionalTopicParams_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OptionalTopicParams_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message OptionalTopicParams at list field AlphaRegret as it is not of Message kind"))
+}
+
+func (x *_OptionalTopicParams_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OptionalTopicParams_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_OptionalTopicParams_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_OptionalTopicParams_6_list)(nil)
+
+type _OptionalTopicParams_6_list struct {
+	list *[]string
+}
+
+func (x *_OptionalTopicParams_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OptionalTopicParams_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_OptionalTopicParams_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OptionalTopicParams_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OptionalTopicParams_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message OptionalTopicParams at list field Epsilon as it is not of Message kind"))
+}
+
+func (x *_OptionalTopicParams_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OptionalTopicParams_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_OptionalTopicParams_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_OptionalTopicParams_7_list)(nil)
+
+type _OptionalTopicParams_7_list struct {
+	list *[]string
+}
+
+func (x *_OptionalTopicParams_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OptionalTopicParams_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_OptionalTopicParams_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OptionalTopicParams_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OptionalTopicParams_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message OptionalTopicParams at list field MeritSortitionAlpha as it is not of Message kind"))
+}
+
+func (x *_OptionalTopicParams_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OptionalTopicParams_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_OptionalTopicParams_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_OptionalTopicParams_8_list)(nil)
+
+type _OptionalTopicParams_8_list struct {
+	list *[]string
+}
+
+func (x *_OptionalTopicParams_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OptionalTopicParams_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_OptionalTopicParams_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OptionalTopicParams_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OptionalTopicParams_8_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message OptionalTopicParams at list field ActiveInfererQuantile as it is not of Message kind"))
+}
+
+func (x *_OptionalTopicParams_8_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OptionalTopicParams_8_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_OptionalTopicParams_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_OptionalTopicParams_9_list)(nil)
+
+type _OptionalTopicParams_9_list struct {
+	list *[]string
+}
+
+func (x *_OptionalTopicParams_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OptionalTopicParams_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_OptionalTopicParams_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OptionalTopicParams_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OptionalTopicParams_9_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message OptionalTopicParams at list field ActiveForecasterQuantile as it is not of Message kind"))
+}
+
+func (x *_OptionalTopicParams_9_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OptionalTopicParams_9_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_OptionalTopicParams_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_OptionalTopicParams_10_list)(nil)
+
+type _OptionalTopicParams_10_list struct {
+	list *[]string
+}
+
+func (x *_OptionalTopicParams_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OptionalTopicParams_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_OptionalTopicParams_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OptionalTopicParams_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OptionalTopicParams_10_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message OptionalTopicParams at list field ActiveReputerQuantile as it is not of Message kind"))
+}
+
+func (x *_OptionalTopicParams_10_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OptionalTopicParams_10_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_OptionalTopicParams_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_OptionalTopicParams_11_list)(nil)
+
+type _OptionalTopicParams_11_list struct {
+	list *[]int64
+}
+
+func (x *_OptionalTopicParams_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OptionalTopicParams_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt64((*x.list)[i])
+}
+
+func (x *_OptionalTopicParams_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OptionalTopicParams_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OptionalTopicParams_11_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message OptionalTopicParams at list field WorkerRevealWindow as it is not of Message kind"))
+}
+
+func (x *_OptionalTopicParams_11_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OptionalTopicParams_11_list) NewElement() protoreflect.Value {
+	v := int64(0)
+	return protoreflect.ValueOfInt64(v)
+}
+
+func (x *_OptionalTopicParams_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_OptionalTopicParams_12_list)(nil)
+
+type _OptionalTopicParams_12_list struct {
+	list *[]bool
+}
+
+func (x *_OptionalTopicParams_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OptionalTopicParams_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBool((*x.list)[i])
+}
+
+func (x *_OptionalTopicParams_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bool()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OptionalTopicParams_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bool()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OptionalTopicParams_12_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message OptionalTopicParams at list field ArchiveNetworkInferences as it is not of Message kind"))
+}
+
+func (x *_OptionalTopicParams_12_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OptionalTopicParams_12_list) NewElement() protoreflect.Value {
+	v := false
+	return protoreflect.ValueOfBool(v)
+}
+
+func (x *_OptionalTopicParams_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_OptionalTopicParams                            protoreflect.MessageDescriptor
+	fd_OptionalTopicParams_epoch_length               protoreflect.FieldDescriptor
+	fd_OptionalTopicParams_ground_truth_lag           protoreflect.FieldDescriptor
+	fd_OptionalTopicParams_worker_submission_window   protoreflect.FieldDescriptor
+	fd_OptionalTopicParams_p_norm                     protoreflect.FieldDescriptor
+	fd_OptionalTopicParams_alpha_regret               protoreflect.FieldDescriptor
+	fd_OptionalTopicParams_epsilon                    protoreflect.FieldDescriptor
+	fd_OptionalTopicParams_merit_sortition_alpha      protoreflect.FieldDescriptor
+	fd_OptionalTopicParams_active_inferer_quantile    protoreflect.FieldDescriptor
+	fd_OptionalTopicParams_active_forecaster_quantile protoreflect.FieldDescriptor
+	fd_OptionalTopicParams_active_reputer_quantile    protoreflect.FieldDescriptor
+	fd_OptionalTopicParams_worker_reveal_window       protoreflect.FieldDescriptor
+	fd_OptionalTopicParams_archive_network_inferences protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v3_topic_proto_init()
+	md_OptionalTopicParams = File_emissions_v3_topic_proto.Messages().ByName("OptionalTopicParams")
+	fd_OptionalTopicParams_epoch_length = md_OptionalTopicParams.Fields().ByName("epoch_length")
+	fd_OptionalTopicParams_ground_truth_lag = md_OptionalTopicParams.Fields().ByName("ground_truth_lag")
+	fd_OptionalTopicParams_worker_submission_window = md_OptionalTopicParams.Fields().ByName("worker_submission_window")
+	fd_OptionalTopicParams_p_norm = md_OptionalTopicParams.Fields().ByName("p_norm")
+	fd_OptionalTopicParams_alpha_regret = md_OptionalTopicParams.Fields().ByName("alpha_regret")
+	fd_OptionalTopicParams_epsilon = md_OptionalTopicParams.Fields().ByName("epsilon")
+	fd_OptionalTopicParams_merit_sortition_alpha = md_OptionalTopicParams.Fields().ByName("merit_sortition_alpha")
+	fd_OptionalTopicParams_active_inferer_quantile = md_OptionalTopicParams.Fields().ByName("active_inferer_quantile")
+	fd_OptionalTopicParams_active_forecaster_quantile = md_OptionalTopicParams.Fields().ByName("active_forecaster_quantile")
+	fd_OptionalTopicParams_active_reputer_quantile = md_OptionalTopicParams.Fields().ByName("active_reputer_quantile")
+	fd_OptionalTopicParams_worker_reveal_window = md_OptionalTopicParams.Fields().ByName("worker_reveal_window")
+	fd_OptionalTopicParams_archive_network_inferences = md_OptionalTopicParams.Fields().ByName("archive_network_inferences")
+}
+
+var _ protoreflect.Message = (*fastReflection_OptionalTopicParams)(nil)
+
+type fastReflection_OptionalTopicParams OptionalTopicParams
+
+func (x *OptionalTopicParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OptionalTopicParams)(x)
+}
+
+func (x *OptionalTopicParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_topic_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_OptionalTopicParams_messageType fastReflection_OptionalTopicParams_messageType
+var _ protoreflect.MessageType = fastReflection_OptionalTopicParams_messageType{}
+
+type fastReflection_OptionalTopicParams_messageType struct{}
+
+func (x fastReflection_OptionalTopicParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OptionalTopicParams)(nil)
+}
+func (x fastReflection_OptionalTopicParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_OptionalTopicParams)
+}
+func (x fastReflection_OptionalTopicParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OptionalTopicParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OptionalTopicParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_OptionalTopicParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OptionalTopicParams) Type() protoreflect.MessageType {
+	return _fastReflection_OptionalTopicParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OptionalTopicParams) New() protoreflect.Message {
+	return new(fastReflection_OptionalTopicParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OptionalTopicParams) Interface() protoreflect.ProtoMessage {
+	return (*OptionalTopicParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OptionalTopicParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.EpochLength) != 0 {
+		value := protoreflect.ValueOfList(&_OptionalTopicParams_1_list{list: &x.EpochLength})
+		if !f(fd_OptionalTopicParams_epoch_length, value) {
+			return
+		}
+	}
+	if len(x.GroundTruthLag) != 0 {
+		value := protoreflect.ValueOfList(&_OptionalTopicParams_2_list{list: &x.GroundTruthLag})
+		if !f(fd_OptionalTopicParams_ground_truth_lag, value) {
+			return
+		}
+	}
+	if len(x.WorkerSubmissionWindow) != 0 {
+		value := protoreflect.ValueOfList(&_OptionalTopicParams_3_list{list: &x.WorkerSubmissionWindow})
+		if !f(fd_OptionalTopicParams_worker_submission_window, value) {
+			return
+		}
+	}
+	if len(x.PNorm) != 0 {
+		value := protoreflect.ValueOfList(&_OptionalTopicParams_4_list{list: &x.PNorm})
+		if !f(fd_OptionalTopicParams_p_norm, value) {
+			return
+		}
+	}
+	if len(x.AlphaRegret) != 0 {
+		value := protoreflect.ValueOfList(&_OptionalTopicParams_5_list{list: &x.AlphaRegret})
+		if !f(fd_OptionalTopicParams_alpha_regret, value) {
+			return
+		}
+	}
+	if len(x.Epsilon) != 0 {
+		value := protoreflect.ValueOfList(&_OptionalTopicParams_6_list{list: &x.Epsilon})
+		if !f(fd_OptionalTopicParams_epsilon, value) {
+			return
+		}
+	}
+	if len(x.MeritSortitionAlpha) != 0 {
+		value := protoreflect.ValueOfList(&_OptionalTopicParams_7_list{list: &x.MeritSortitionAlpha})
+		if !f(fd_OptionalTopicParams_merit_sortition_alpha, value) {
+			return
+		}
+	}
+	if len(x.ActiveInfererQuantile) != 0 {
+		value := protoreflect.ValueOfList(&_OptionalTopicParams_8_list{list: &x.ActiveInfererQuantile})
+		if !f(fd_OptionalTopicParams_active_inferer_quantile, value) {
+			return
+		}
+	}
+	if len(x.ActiveForecasterQuantile) != 0 {
+		value := protoreflect.ValueOfList(&_OptionalTopicParams_9_list{list: &x.ActiveForecasterQuantile})
+		if !f(fd_OptionalTopicParams_active_forecaster_quantile, value) {
+			return
+		}
+	}
+	if len(x.ActiveReputerQuantile) != 0 {
+		value := protoreflect.ValueOfList(&_OptionalTopicParams_10_list{list: &x.ActiveReputerQuantile})
+		if !f(fd_OptionalTopicParams_active_reputer_quantile, value) {
+			return
+		}
+	}
+	if len(x.WorkerRevealWindow) != 0 {
+		value := protoreflect.ValueOfList(&_OptionalTopicParams_11_list{list: &x.WorkerRevealWindow})
+		if !f(fd_OptionalTopicParams_worker_reveal_window, value) {
+			return
+		}
+	}
+	if len(x.ArchiveNetworkInferences) != 0 {
+		value := protoreflect.ValueOfList(&_OptionalTopicParams_12_list{list: &x.ArchiveNetworkInferences})
+		if !f(fd_OptionalTopicParams_archive_network_inferences, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OptionalTopicParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v3.OptionalTopicParams.epoch_length":
+		return len(x.EpochLength) != 0
+	case "emissions.v3.OptionalTopicParams.ground_truth_lag":
+		return len(x.GroundTruthLag) != 0
+	case "emissions.v3.OptionalTopicParams.worker_submission_window":
+		return len(x.WorkerSubmissionWindow) != 0
+	case "emissions.v3.OptionalTopicParams.p_norm":
+		return len(x.PNorm) != 0
+	case "emissions.v3.OptionalTopicParams.alpha_regret":
+		return len(x.AlphaRegret) != 0
+	case "emissions.v3.OptionalTopicParams.epsilon":
+		return len(x.Epsilon) != 0
+	case "emissions.v3.OptionalTopicParams.merit_sortition_alpha":
+		return len(x.MeritSortitionAlpha) != 0
+	case "emissions.v3.OptionalTopicParams.active_inferer_quantile":
+		return len(x.ActiveInfererQuantile) != 0
+	case "emissions.v3.OptionalTopicParams.active_forecaster_quantile":
+		return len(x.ActiveForecasterQuantile) != 0
+	case "emissions.v3.OptionalTopicParams.active_reputer_quantile":
+		return len(x.ActiveReputerQuantile) != 0
+	case "emissions.v3.OptionalTopicParams.worker_reveal_window":
+		return len(x.WorkerRevealWindow) != 0
+	case "emissions.v3.OptionalTopicParams.archive_network_inferences":
+		return len(x.ArchiveNetworkInferences) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.OptionalTopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v3.OptionalTopicParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OptionalTopicParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v3.OptionalTopicParams.epoch_length":
+		x.EpochLength = nil
+	case "emissions.v3.OptionalTopicParams.ground_truth_lag":
+		x.GroundTruthLag = nil
+	case "emissions.v3.OptionalTopicParams.worker_submission_window":
+		x.WorkerSubmissionWindow = nil
+	case "emissions.v3.OptionalTopicParams.p_norm":
+		x.PNorm = nil
+	case "emissions.v3.OptionalTopicParams.alpha_regret":
+		x.AlphaRegret = nil
+	case "emissions.v3.OptionalTopicParams.epsilon":
+		x.Epsilon = nil
+	case "emissions.v3.OptionalTopicParams.merit_sortition_alpha":
+		x.MeritSortitionAlpha = nil
+	case "emissions.v3.OptionalTopicParams.active_inferer_quantile":
+		x.ActiveInfererQuantile = nil
+	case "emissions.v3.OptionalTopicParams.active_forecaster_quantile":
+		x.ActiveForecasterQuantile = nil
+	case "emissions.v3.OptionalTopicParams.active_reputer_quantile":
+		x.ActiveReputerQuantile = nil
+	case "emissions.v3.OptionalTopicParams.worker_reveal_window":
+		x.WorkerRevealWindow = nil
+	case "emissions.v3.OptionalTopicParams.archive_network_inferences":
+		x.ArchiveNetworkInferences = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.OptionalTopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v3.OptionalTopicParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OptionalTopicParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v3.OptionalTopicParams.epoch_length":
+		if len(x.EpochLength) == 0 {
+			return protoreflect.ValueOfList(&_OptionalTopicParams_1_list{})
+		}
+		listValue := &_OptionalTopicParams_1_list{list: &x.EpochLength}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.OptionalTopicParams.ground_truth_lag":
+		if len(x.GroundTruthLag) == 0 {
+			return protoreflect.ValueOfList(&_OptionalTopicParams_2_list{})
+		}
+		listValue := &_OptionalTopicParams_2_list{list: &x.GroundTruthLag}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.OptionalTopicParams.worker_submission_window":
+		if len(x.WorkerSubmissionWindow) == 0 {
+			return protoreflect.ValueOfList(&_OptionalTopicParams_3_list{})
+		}
+		listValue := &_OptionalTopicParams_3_list{list: &x.WorkerSubmissionWindow}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.OptionalTopicParams.p_norm":
+		if len(x.PNorm) == 0 {
+			return protoreflect.ValueOfList(&_OptionalTopicParams_4_list{})
+		}
+		listValue := &_OptionalTopicParams_4_list{list: &x.PNorm}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.OptionalTopicParams.alpha_regret":
+		if len(x.AlphaRegret) == 0 {
+			return protoreflect.ValueOfList(&_OptionalTopicParams_5_list{})
+		}
+		listValue := &_OptionalTopicParams_5_list{list: &x.AlphaRegret}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.OptionalTopicParams.epsilon":
+		if len(x.Epsilon) == 0 {
+			return protoreflect.ValueOfList(&_OptionalTopicParams_6_list{})
+		}
+		listValue := &_OptionalTopicParams_6_list{list: &x.Epsilon}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.OptionalTopicParams.merit_sortition_alpha":
+		if len(x.MeritSortitionAlpha) == 0 {
+			return protoreflect.ValueOfList(&_OptionalTopicParams_7_list{})
+		}
+		listValue := &_OptionalTopicParams_7_list{list: &x.MeritSortitionAlpha}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.OptionalTopicParams.active_inferer_quantile":
+		if len(x.ActiveInfererQuantile) == 0 {
+			return protoreflect.ValueOfList(&_OptionalTopicParams_8_list{})
+		}
+		listValue := &_OptionalTopicParams_8_list{list: &x.ActiveInfererQuantile}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.OptionalTopicParams.active_forecaster_quantile":
+		if len(x.ActiveForecasterQuantile) == 0 {
+			return protoreflect.ValueOfList(&_OptionalTopicParams_9_list{})
+		}
+		listValue := &_OptionalTopicParams_9_list{list: &x.ActiveForecasterQuantile}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.OptionalTopicParams.active_reputer_quantile":
+		if len(x.ActiveReputerQuantile) == 0 {
+			return protoreflect.ValueOfList(&_OptionalTopicParams_10_list{})
+		}
+		listValue := &_OptionalTopicParams_10_list{list: &x.ActiveReputerQuantile}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.OptionalTopicParams.worker_reveal_window":
+		if len(x.WorkerRevealWindow) == 0 {
+			return protoreflect.ValueOfList(&_OptionalTopicParams_11_list{})
+		}
+		listValue := &_OptionalTopicParams_11_list{list: &x.WorkerRevealWindow}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.OptionalTopicParams.archive_network_inferences":
+		if len(x.ArchiveNetworkInferences) == 0 {
+			return protoreflect.ValueOfList(&_OptionalTopicParams_12_list{})
+		}
+		listValue := &_OptionalTopicParams_12_list{list: &x.ArchiveNetworkInferences}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.OptionalTopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v3.OptionalTopicParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OptionalTopicParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v3.OptionalTopicParams.epoch_length":
+		lv := value.List()
+		clv := lv.(*_OptionalTopicParams_1_list)
+		x.EpochLength = *clv.list
+	case "emissions.v3.OptionalTopicParams.ground_truth_lag":
+		lv := value.List()
+		clv := lv.(*_OptionalTopicParams_2_list)
+		x.GroundTruthLag = *clv.list
+	case "emissions.v3.OptionalTopicParams.worker_submission_window":
+		lv := value.List()
+		clv := lv.(*_OptionalTopicParams_3_list)
+		x.WorkerSubmissionWindow = *clv.list
+	case "emissions.v3.OptionalTopicParams.p_norm":
+		lv := value.List()
+		clv := lv.(*_OptionalTopicParams_4_list)
+		x.PNorm = *clv.list
+	case "emissions.v3.OptionalTopicParams.alpha_regret":
+		lv := value.List()
+		clv := lv.(*_OptionalTopicParams_5_list)
+		x.AlphaRegret = *clv.list
+	case "emissions.v3.OptionalTopicParams.epsilon":
+		lv := value.List()
+		clv := lv.(*_OptionalTopicParams_6_list)
+		x.Epsilon = *clv.list
+	case "emissions.v3.OptionalTopicParams.merit_sortition_alpha":
+		lv := value.List()
+		clv := lv.(*_OptionalTopicParams_7_list)
+		x.MeritSortitionAlpha = *clv.list
+	case "emissions.v3.OptionalTopicParams.active_inferer_quantile":
+		lv := value.List()
+		clv := lv.(*_OptionalTopicParams_8_list)
+		x.ActiveInfererQuantile = *clv.list
+	case "emissions.v3.OptionalTopicParams.active_forecaster_quantile":
+		lv := value.List()
+		clv := lv.(*_OptionalTopicParams_9_list)
+		x.ActiveForecasterQuantile = *clv.list
+	case "emissions.v3.OptionalTopicParams.active_reputer_quantile":
+		lv := value.List()
+		clv := lv.(*_OptionalTopicParams_10_list)
+		x.ActiveReputerQuantile = *clv.list
+	case "emissions.v3.OptionalTopicParams.worker_reveal_window":
+		lv := value.List()
+		clv := lv.(*_OptionalTopicParams_11_list)
+		x.WorkerRevealWindow = *clv.list
+	case "emissions.v3.OptionalTopicParams.archive_network_inferences":
+		lv := value.List()
+		clv := lv.(*_OptionalTopicParams_12_list)
+		x.ArchiveNetworkInferences = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.OptionalTopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v3.OptionalTopicParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OptionalTopicParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.OptionalTopicParams.epoch_length":
+		if x.EpochLength == nil {
+			x.EpochLength = []int64{}
+		}
+		value := &_OptionalTopicParams_1_list{list: &x.EpochLength}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v3.OptionalTopicParams.ground_truth_lag":
+		if x.GroundTruthLag == nil {
+			x.GroundTruthLag = []int64{}
+		}
+		value := &_OptionalTopicParams_2_list{list: &x.GroundTruthLag}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v3.OptionalTopicParams.worker_submission_window":
+		if x.WorkerSubmissionWindow == nil {
+			x.WorkerSubmissionWindow = []int64{}
+		}
+		value := &_OptionalTopicParams_3_list{list: &x.WorkerSubmissionWindow}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v3.OptionalTopicParams.p_norm":
+		if x.PNorm == nil {
+			x.PNorm = []string{}
+		}
+		value := &_OptionalTopicParams_4_list{list: &x.PNorm}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v3.OptionalTopicParams.alpha_regret":
+		if x.AlphaRegret == nil {
+			x.AlphaRegret = []string{}
+		}
+		value := &_OptionalTopicParams_5_list{list: &x.AlphaRegret}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v3.OptionalTopicParams.epsilon":
+		if x.Epsilon == nil {
+			x.Epsilon = []string{}
+		}
+		value := &_OptionalTopicParams_6_list{list: &x.Epsilon}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v3.OptionalTopicParams.merit_sortition_alpha":
+		if x.MeritSortitionAlpha == nil {
+			x.MeritSortitionAlpha = []string{}
+		}
+		value := &_OptionalTopicParams_7_list{list: &x.MeritSortitionAlpha}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v3.OptionalTopicParams.active_inferer_quantile":
+		if x.ActiveInfererQuantile == nil {
+			x.ActiveInfererQuantile = []string{}
+		}
+		value := &_OptionalTopicParams_8_list{list: &x.ActiveInfererQuantile}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v3.OptionalTopicParams.active_forecaster_quantile":
+		if x.ActiveForecasterQuantile == nil {
+			x.ActiveForecasterQuantile = []string{}
+		}
+		value := &_OptionalTopicParams_9_list{list: &x.ActiveForecasterQuantile}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v3.OptionalTopicParams.active_reputer_quantile":
+		if x.ActiveReputerQuantile == nil {
+			x.ActiveReputerQuantile = []string{}
+		}
+		value := &_OptionalTopicParams_10_list{list: &x.ActiveReputerQuantile}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v3.OptionalTopicParams.worker_reveal_window":
+		if x.WorkerRevealWindow == nil {
+			x.WorkerRevealWindow = []int64{}
+		}
+		value := &_OptionalTopicParams_11_list{list: &x.WorkerRevealWindow}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v3.OptionalTopicParams.archive_network_inferences":
+		if x.ArchiveNetworkInferences == nil {
+			x.ArchiveNetworkInferences = []bool{}
+		}
+		value := &_OptionalTopicParams_12_list{list: &x.ArchiveNetworkInferences}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.OptionalTopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v3.OptionalTopicParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_OptionalTopicParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.OptionalTopicParams.epoch_length":
+		list := []int64{}
+		return protoreflect.ValueOfList(&_OptionalTopicParams_1_list{list: &list})
+	case "emissions.v3.OptionalTopicParams.ground_truth_lag":
+		list := []int64{}
+		return protoreflect.ValueOfList(&_OptionalTopicParams_2_list{list: &list})
+	case "emissions.v3.OptionalTopicParams.worker_submission_window":
+		list := []int64{}
+		return protoreflect.ValueOfList(&_OptionalTopicParams_3_list{list: &list})
+	case "emissions.v3.OptionalTopicParams.p_norm":
+		list := []string{}
+		return protoreflect.ValueOfList(&_OptionalTopicParams_4_list{list: &list})
+	case "emissions.v3.OptionalTopicParams.alpha_regret":
+		list := []string{}
+		return protoreflect.ValueOfList(&_OptionalTopicParams_5_list{list: &list})
+	case "emissions.v3.OptionalTopicParams.epsilon":
+		list := []string{}
+		return protoreflect.ValueOfList(&_OptionalTopicParams_6_list{list: &list})
+	case "emissions.v3.OptionalTopicParams.merit_sortition_alpha":
+		list := []string{}
+		return protoreflect.ValueOfList(&_OptionalTopicParams_7_list{list: &list})
+	case "emissions.v3.OptionalTopicParams.active_inferer_quantile":
+		list := []string{}
+		return protoreflect.ValueOfList(&_OptionalTopicParams_8_list{list: &list})
+	case "emissions.v3.OptionalTopicParams.active_forecaster_quantile":
+		list := []string{}
+		return protoreflect.ValueOfList(&_OptionalTopicParams_9_list{list: &list})
+	case "emissions.v3.OptionalTopicParams.active_reputer_quantile":
+		list := []string{}
+		return protoreflect.ValueOfList(&_OptionalTopicParams_10_list{list: &list})
+	case "emissions.v3.OptionalTopicParams.worker_reveal_window":
+		list := []int64{}
+		return protoreflect.ValueOfList(&_OptionalTopicParams_11_list{list: &list})
+	case "emissions.v3.OptionalTopicParams.archive_network_inferences":
+		list := []bool{}
+		return protoreflect.ValueOfList(&_OptionalTopicParams_12_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.OptionalTopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v3.OptionalTopicParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_OptionalTopicParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.OptionalTopicParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_OptionalTopicParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OptionalTopicParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_OptionalTopicParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_OptionalTopicParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*OptionalTopicParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.EpochLength) > 0 {
+			l = 0
+			for _, e := range x.EpochLength {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.GroundTruthLag) > 0 {
+			l = 0
+			for _, e := range x.GroundTruthLag {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.WorkerSubmissionWindow) > 0 {
+			l = 0
+			for _, e := range x.WorkerSubmissionWindow {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.PNorm) > 0 {
+			for _, s := range x.PNorm {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AlphaRegret) > 0 {
+			for _, s := range x.AlphaRegret {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Epsilon) > 0 {
+			for _, s := range x.Epsilon {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MeritSortitionAlpha) > 0 {
+			for _, s := range x.MeritSortitionAlpha {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ActiveInfererQuantile) > 0 {
+			for _, s := range x.ActiveInfererQuantile {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ActiveForecasterQuantile) > 0 {
+			for _, s := range x.ActiveForecasterQuantile {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ActiveReputerQuantile) > 0 {
+			for _, s := range x.ActiveReputerQuantile {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.WorkerRevealWindow) > 0 {
+			l = 0
+			for _, e := range x.WorkerRevealWindow {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.ArchiveNetworkInferences) > 0 {
+			n += 1 + runtime.Sov(uint64(len(x.ArchiveNetworkInferences))) + len(x.ArchiveNetworkInferences)*1
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*OptionalTopicParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ArchiveNetworkInferences) > 0 {
+			for iNdEx := len(x.ArchiveNetworkInferences) - 1; iNdEx >= 0; iNdEx-- {
+				i--
+				if x.ArchiveNetworkInferences[iNdEx] {
+					dAtA[i] = 1
+				} else {
+					dAtA[i] = 0
+				}
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ArchiveNetworkInferences)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.WorkerRevealWindow) > 0 {
+			var pksize2 int
+			for _, num := range x.WorkerRevealWindow {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num1 := range x.WorkerRevealWindow {
+				num := uint64(num1)
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.ActiveReputerQuantile) > 0 {
+			for iNdEx := len(x.ActiveReputerQuantile) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ActiveReputerQuantile[iNdEx])
+				copy(dAtA[i:], x.ActiveReputerQuantile[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ActiveReputerQuantile[iNdEx])))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.ActiveForecasterQuantile) > 0 {
+			for iNdEx := len(x.ActiveForecasterQuantile) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ActiveForecasterQuantile[iNdEx])
+				copy(dAtA[i:], x.ActiveForecasterQuantile[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ActiveForecasterQuantile[iNdEx])))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.ActiveInfererQuantile) > 0 {
+			for iNdEx := len(x.ActiveInfererQuantile) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ActiveInfererQuantile[iNdEx])
+				copy(dAtA[i:], x.ActiveInfererQuantile[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ActiveInfererQuantile[iNdEx])))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.MeritSortitionAlpha) > 0 {
+			for iNdEx := len(x.MeritSortitionAlpha) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.MeritSortitionAlpha[iNdEx])
+				copy(dAtA[i:], x.MeritSortitionAlpha[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MeritSortitionAlpha[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.Epsilon) > 0 {
+			for iNdEx := len(x.Epsilon) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Epsilon[iNdEx])
+				copy(dAtA[i:], x.Epsilon[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Epsilon[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.AlphaRegret) > 0 {
+			for iNdEx := len(x.AlphaRegret) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AlphaRegret[iNdEx])
+				copy(dAtA[i:], x.AlphaRegret[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AlphaRegret[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.PNorm) > 0 {
+			for iNdEx := len(x.PNorm) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PNorm[iNdEx])
+				copy(dAtA[i:], x.PNorm[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PNorm[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.WorkerSubmissionWindow) > 0 {
+			var pksize4 int
+			for _, num := range x.WorkerSubmissionWindow {
+				pksize4 += runtime.Sov(uint64(num))
+			}
+			i -= pksize4
+			j3 := i
+			for _, num1 := range x.WorkerSubmissionWindow {
+				num := uint64(num1)
+				for num >= 1<<7 {
+					dAtA[j3] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j3++
+				}
+				dAtA[j3] = uint8(num)
+				j3++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize4))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.GroundTruthLag) > 0 {
+			var pksize6 int
+			for _, num := range x.GroundTruthLag {
+				pksize6 += runtime.Sov(uint64(num))
+			}
+			i -= pksize6
+			j5 := i
+			for _, num1 := range x.GroundTruthLag {
+				num := uint64(num1)
+				for num >= 1<<7 {
+					dAtA[j5] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j5++
+				}
+				dAtA[j5] = uint8(num)
+				j5++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize6))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.EpochLength) > 0 {
+			var pksize8 int
+			for _, num := range x.EpochLength {
+				pksize8 += runtime.Sov(uint64(num))
+			}
+			i -= pksize8
+			j7 := i
+			for _, num1 := range x.EpochLength {
+				num := uint64(num1)
+				for num >= 1<<7 {
+					dAtA[j7] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j7++
+				}
+				dAtA[j7] = uint8(num)
+				j7++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize8))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*OptionalTopicParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OptionalTopicParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OptionalTopicParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType == 0 {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.EpochLength = append(x.EpochLength, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.EpochLength) == 0 {
+						x.EpochLength = make([]int64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v int64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= int64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.EpochLength = append(x.EpochLength, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+				}
+			case 2:
+				if wireType == 0 {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.GroundTruthLag = append(x.GroundTruthLag, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.GroundTruthLag) == 0 {
+						x.GroundTruthLag = make([]int64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v int64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= int64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.GroundTruthLag = append(x.GroundTruthLag, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroundTruthLag", wireType)
+				}
+			case 3:
+				if wireType == 0 {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.WorkerSubmissionWindow = append(x.WorkerSubmissionWindow, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.WorkerSubmissionWindow) == 0 {
+						x.WorkerSubmissionWindow = make([]int64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v int64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= int64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.WorkerSubmissionWindow = append(x.WorkerSubmissionWindow, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WorkerSubmissionWindow", wireType)
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PNorm", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PNorm = append(x.PNorm, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AlphaRegret", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AlphaRegret = append(x.AlphaRegret, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epsilon", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Epsilon = append(x.Epsilon, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MeritSortitionAlpha", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MeritSortitionAlpha = append(x.MeritSortitionAlpha, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActiveInfererQuantile", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ActiveInfererQuantile = append(x.ActiveInfererQuantile, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActiveForecasterQuantile", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ActiveForecasterQuantile = append(x.ActiveForecasterQuantile, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActiveReputerQuantile", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ActiveReputerQuantile = append(x.ActiveReputerQuantile, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 11:
+				if wireType == 0 {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.WorkerRevealWindow = append(x.WorkerRevealWindow, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.WorkerRevealWindow) == 0 {
+						x.WorkerRevealWindow = make([]int64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v int64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= int64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.WorkerRevealWindow = append(x.WorkerRevealWindow, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WorkerRevealWindow", wireType)
+				}
+			case 12:
+				if wireType == 0 {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.ArchiveNetworkInferences = append(x.ArchiveNetworkInferences, bool(v != 0))
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					elementCount = packedLen
+					if elementCount != 0 && len(x.ArchiveNetworkInferences) == 0 {
+						x.ArchiveNetworkInferences = make([]bool, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v int
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= int(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.ArchiveNetworkInferences = append(x.ArchiveNetworkInferences, bool(v != 0))
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ArchiveNetworkInferences", wireType)
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ScheduledTopicUpdate                        protoreflect.MessageDescriptor
+	fd_ScheduledTopicUpdate_topic_id               protoreflect.FieldDescriptor
+	fd_ScheduledTopicUpdate_effective_block_height protoreflect.FieldDescriptor
+	fd_ScheduledTopicUpdate_params                 protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v3_topic_proto_init()
+	md_ScheduledTopicUpdate = File_emissions_v3_topic_proto.Messages().ByName("ScheduledTopicUpdate")
+	fd_ScheduledTopicUpdate_topic_id = md_ScheduledTopicUpdate.Fields().ByName("topic_id")
+	fd_ScheduledTopicUpdate_effective_block_height = md_ScheduledTopicUpdate.Fields().ByName("effective_block_height")
+	fd_ScheduledTopicUpdate_params = md_ScheduledTopicUpdate.Fields().ByName("params")
+}
+
+var _ protoreflect.Message = (*fastReflection_ScheduledTopicUpdate)(nil)
+
+type fastReflection_ScheduledTopicUpdate ScheduledTopicUpdate
+
+func (x *ScheduledTopicUpdate) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ScheduledTopicUpdate)(x)
+}
+
+func (x *ScheduledTopicUpdate) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_topic_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ScheduledTopicUpdate_messageType fastReflection_ScheduledTopicUpdate_messageType
+var _ protoreflect.MessageType = fastReflection_ScheduledTopicUpdate_messageType{}
+
+type fastReflection_ScheduledTopicUpdate_messageType struct{}
+
+func (x fastReflection_ScheduledTopicUpdate_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ScheduledTopicUpdate)(nil)
+}
+func (x fastReflection_ScheduledTopicUpdate_messageType) New() protoreflect.Message {
+	return new(fastReflection_ScheduledTopicUpdate)
+}
+func (x fastReflection_ScheduledTopicUpdate_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledTopicUpdate
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ScheduledTopicUpdate) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledTopicUpdate
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ScheduledTopicUpdate) Type() protoreflect.MessageType {
+	return _fastReflection_ScheduledTopicUpdate_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ScheduledTopicUpdate) New() protoreflect.Message {
+	return new(fastReflection_ScheduledTopicUpdate)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ScheduledTopicUpdate) Interface() protoreflect.ProtoMessage {
+	return (*ScheduledTopicUpdate)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ScheduledTopicUpdate) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_ScheduledTopicUpdate_topic_id, value) {
+			return
+		}
+	}
+	if x.EffectiveBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EffectiveBlockHeight)
+		if !f(fd_ScheduledTopicUpdate_effective_block_height, value) {
+			return
+		}
+	}
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_ScheduledTopicUpdate_params, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ScheduledTopicUpdate) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v3.ScheduledTopicUpdate.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v3.ScheduledTopicUpdate.effective_block_height":
+		return x.EffectiveBlockHeight != int64(0)
+	case "emissions.v3.ScheduledTopicUpdate.params":
+		return x.Params != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ScheduledTopicUpdate"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ScheduledTopicUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledTopicUpdate) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v3.ScheduledTopicUpdate.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v3.ScheduledTopicUpdate.effective_block_height":
+		x.EffectiveBlockHeight = int64(0)
+	case "emissions.v3.ScheduledTopicUpdate.params":
+		x.Params = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ScheduledTopicUpdate"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ScheduledTopicUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ScheduledTopicUpdate) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v3.ScheduledTopicUpdate.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.ScheduledTopicUpdate.effective_block_height":
+		value := x.EffectiveBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v3.ScheduledTopicUpdate.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ScheduledTopicUpdate"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ScheduledTopicUpdate does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledTopicUpdate) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v3.ScheduledTopicUpdate.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v3.ScheduledTopicUpdate.effective_block_height":
+		x.EffectiveBlockHeight = value.Int()
+	case "emissions.v3.ScheduledTopicUpdate.params":
+		x.Params = value.Message().Interface().(*OptionalTopicParams)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ScheduledTopicUpdate"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ScheduledTopicUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledTopicUpdate) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.ScheduledTopicUpdate.params":
+		if x.Params == nil {
+			x.Params = new(OptionalTopicParams)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "emissions.v3.ScheduledTopicUpdate.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v3.ScheduledTopicUpdate is not mutable"))
+	case "emissions.v3.ScheduledTopicUpdate.effective_block_height":
+		panic(fmt.Errorf("field effective_block_height of message emissions.v3.ScheduledTopicUpdate is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ScheduledTopicUpdate"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ScheduledTopicUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ScheduledTopicUpdate) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.ScheduledTopicUpdate.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.ScheduledTopicUpdate.effective_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v3.ScheduledTopicUpdate.params":
+		m := new(OptionalTopicParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ScheduledTopicUpdate"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ScheduledTopicUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ScheduledTopicUpdate) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.ScheduledTopicUpdate", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ScheduledTopicUpdate) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledTopicUpdate) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ScheduledTopicUpdate) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ScheduledTopicUpdate) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ScheduledTopicUpdate)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		if x.EffectiveBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.EffectiveBlockHeight))
+		}
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledTopicUpdate)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.EffectiveBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EffectiveBlockHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledTopicUpdate)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledTopicUpdate: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledTopicUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EffectiveBlockHeight", wireType)
+				}
+				x.EffectiveBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EffectiveBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &OptionalTopicParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TopicRetirement                                 protoreflect.MessageDescriptor
+	fd_TopicRetirement_topic                           protoreflect.FieldDescriptor
+	fd_TopicRetirement_retired_by                      protoreflect.FieldDescriptor
+	fd_TopicRetirement_retired_at_block                protoreflect.FieldDescriptor
+	fd_TopicRetirement_stage                           protoreflect.FieldDescriptor
+	fd_TopicRetirement_last_refunded_reputer           protoreflect.FieldDescriptor
+	fd_TopicRetirement_last_refunded_delegator         protoreflect.FieldDescriptor
+	fd_TopicRetirement_last_refunded_delegator_reputer protoreflect.FieldDescriptor
+	fd_TopicRetirement_reputer_refunds_queued          protoreflect.FieldDescriptor
+	fd_TopicRetirement_refunds_complete_block          protoreflect.FieldDescriptor
+	fd_TopicRetirement_store_index                     protoreflect.FieldDescriptor
+	fd_TopicRetirement_keys_deleted                    protoreflect.FieldDescriptor
+	fd_TopicRetirement_completed_at_block              protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v3_topic_proto_init()
+	md_TopicRetirement = File_emissions_v3_topic_proto.Messages().ByName("TopicRetirement")
+	fd_TopicRetirement_topic = md_TopicRetirement.Fields().ByName("topic")
+	fd_TopicRetirement_retired_by = md_TopicRetirement.Fields().ByName("retired_by")
+	fd_TopicRetirement_retired_at_block = md_TopicRetirement.Fields().ByName("retired_at_block")
+	fd_TopicRetirement_stage = md_TopicRetirement.Fields().ByName("stage")
+	fd_TopicRetirement_last_refunded_reputer = md_TopicRetirement.Fields().ByName("last_refunded_reputer")
+	fd_TopicRetirement_last_refunded_delegator = md_TopicRetirement.Fields().ByName("last_refunded_delegator")
+	fd_TopicRetirement_last_refunded_delegator_reputer = md_TopicRetirement.Fields().ByName("last_refunded_delegator_reputer")
+	fd_TopicRetirement_reputer_refunds_queued = md_TopicRetirement.Fields().ByName("reputer_refunds_queued")
+	fd_TopicRetirement_refunds_complete_block = md_TopicRetirement.Fields().ByName("refunds_complete_block")
+	fd_TopicRetirement_store_index = md_TopicRetirement.Fields().ByName("store_index")
+	fd_TopicRetirement_keys_deleted = md_TopicRetirement.Fields().ByName("keys_deleted")
+	fd_TopicRetirement_completed_at_block = md_TopicRetirement.Fields().ByName("completed_at_block")
+}
+
+var _ protoreflect.Message = (*fastReflection_TopicRetirement)(nil)
+
+type fastReflection_TopicRetirement TopicRetirement
+
+func (x *TopicRetirement) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TopicRetirement)(x)
+}
+
+func (x *TopicRetirement) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_topic_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TopicRetirement_messageType fastReflection_TopicRetirement_messageType
+var _ protoreflect.MessageType = fastReflection_TopicRetirement_messageType{}
+
+type fastReflection_TopicRetirement_messageType struct{}
+
+func (x fastReflection_TopicRetirement_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TopicRetirement)(nil)
+}
+func (x fastReflection_TopicRetirement_messageType) New() protoreflect.Message {
+	return new(fastReflection_TopicRetirement)
+}
+func (x fastReflection_TopicRetirement_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicRetirement
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TopicRetirement) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicRetirement
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TopicRetirement) Type() protoreflect.MessageType {
+	return _fastReflection_TopicRetirement_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TopicRetirement) New() protoreflect.Message {
+	return new(fastReflection_TopicRetirement)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TopicRetirement) Interface() protoreflect.ProtoMessage {
+	return (*TopicRetirement)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TopicRetirement) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Topic != nil {
+		value := protoreflect.ValueOfMessage(x.Topic.ProtoReflect())
+		if !f(fd_TopicRetirement_topic, value) {
+			return
+		}
+	}
+	if x.RetiredBy != "" {
+		value := protoreflect.ValueOfString(x.RetiredBy)
+		if !f(fd_TopicRetirement_retired_by, value) {
+			return
+		}
+	}
+	if x.RetiredAtBlock != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RetiredAtBlock)
+		if !f(fd_TopicRetirement_retired_at_block, value) {
+			return
+		}
+	}
+	if x.Stage != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Stage))
+		if !f(fd_TopicRetirement_stage, value) {
+			return
+		}
+	}
+	if x.LastRefundedReputer != "" {
+		value := protoreflect.ValueOfString(x.LastRefundedReputer)
+		if !f(fd_TopicRetirement_last_refunded_reputer, value) {
+			return
+		}
+	}
+	if x.LastRefundedDelegator != "" {
+		value := protoreflect.ValueOfString(x.LastRefundedDelegator)
+		if !f(fd_TopicRetirement_last_refunded_delegator, value) {
+			return
+		}
+	}
+	if x.LastRefundedDelegatorReputer != "" {
+		value := protoreflect.ValueOfString(x.LastRefundedDelegatorReputer)
+		if !f(fd_TopicRetirement_last_refunded_delegator_reputer, value) {
+			return
+		}
+	}
+	if x.ReputerRefundsQueued != false {
+		value := protoreflect.ValueOfBool(x.ReputerRefundsQueued)
+		if !f(fd_TopicRetirement_reputer_refunds_queued, value) {
+			return
+		}
+	}
+	if x.RefundsCompleteBlock != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RefundsCompleteBlock)
+		if !f(fd_TopicRetirement_refunds_complete_block, value) {
+			return
+		}
+	}
+	if x.StoreIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StoreIndex)
+		if !f(fd_TopicRetirement_store_index, value) {
+			return
+		}
+	}
+	if x.KeysDeleted != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.KeysDeleted)
+		if !f(fd_TopicRetirement_keys_deleted, value) {
+			return
+		}
+	}
+	if x.CompletedAtBlock != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CompletedAtBlock)
+		if !f(fd_TopicRetirement_completed_at_block, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TopicRetirement) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v3.TopicRetirement.topic":
+		return x.Topic != nil
+	case "emissions.v3.TopicRetirement.retired_by":
+		return x.RetiredBy != ""
+	case "emissions.v3.TopicRetirement.retired_at_block":
+		return x.RetiredAtBlock != int64(0)
+	case "emissions.v3.TopicRetirement.stage":
+		return x.Stage != 0
+	case "emissions.v3.TopicRetirement.last_refunded_reputer":
+		return x.LastRefundedReputer != ""
+	case "emissions.v3.TopicRetirement.last_refunded_delegator":
+		return x.LastRefundedDelegator != ""
+	case "emissions.v3.TopicRetirement.last_refunded_delegator_reputer":
+		return x.LastRefundedDelegatorReputer != ""
+	case "emissions.v3.TopicRetirement.reputer_refunds_queued":
+		return x.ReputerRefundsQueued != false
+	case "emissions.v3.TopicRetirement.refunds_complete_block":
+		return x.RefundsCompleteBlock != int64(0)
+	case "emissions.v3.TopicRetirement.store_index":
+		return x.StoreIndex != uint64(0)
+	case "emissions.v3.TopicRetirement.keys_deleted":
+		return x.KeysDeleted != uint64(0)
+	case "emissions.v3.TopicRetirement.completed_at_block":
+		return x.CompletedAtBlock != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicRetirement"))
+		}
+		panic(fmt.Errorf("message emissions.v3.TopicRetirement does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicRetirement) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v3.TopicRetirement.topic":
+		x.Topic = nil
+	case "emissions.v3.TopicRetirement.retired_by":
+		x.RetiredBy = ""
+	case "emissions.v3.TopicRetirement.retired_at_block":
+		x.RetiredAtBlock = int64(0)
+	case "emissions.v3.TopicRetirement.stage":
+		x.Stage = 0
+	case "emissions.v3.TopicRetirement.last_refunded_reputer":
+		x.LastRefundedReputer = ""
+	case "emissions.v3.TopicRetirement.last_refunded_delegator":
+		x.LastRefundedDelegator = ""
+	case "emissions.v3.TopicRetirement.last_refunded_delegator_reputer":
+		x.LastRefundedDelegatorReputer = ""
+	case "emissions.v3.TopicRetirement.reputer_refunds_queued":
+		x.ReputerRefundsQueued = false
+	case "emissions.v3.TopicRetirement.refunds_complete_block":
+		x.RefundsCompleteBlock = int64(0)
+	case "emissions.v3.TopicRetirement.store_index":
+		x.StoreIndex = uint64(0)
+	case "emissions.v3.TopicRetirement.keys_deleted":
+		x.KeysDeleted = uint64(0)
+	case "emissions.v3.TopicRetirement.completed_at_block":
+		x.CompletedAtBlock = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicRetirement"))
+		}
+		panic(fmt.Errorf("message emissions.v3.TopicRetirement does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TopicRetirement) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v3.TopicRetirement.topic":
+		value := x.Topic
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "emissions.v3.TopicRetirement.retired_by":
+		value := x.RetiredBy
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.TopicRetirement.retired_at_block":
+		value := x.RetiredAtBlock
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v3.TopicRetirement.stage":
+		value := x.Stage
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "emissions.v3.TopicRetirement.last_refunded_reputer":
+		value := x.LastRefundedReputer
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.TopicRetirement.last_refunded_delegator":
+		value := x.LastRefundedDelegator
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.TopicRetirement.last_refunded_delegator_reputer":
+		value := x.LastRefundedDelegatorReputer
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.TopicRetirement.reputer_refunds_queued":
+		value := x.ReputerRefundsQueued
+		return protoreflect.ValueOfBool(value)
+	case "emissions.v3.TopicRetirement.refunds_complete_block":
+		value := x.RefundsCompleteBlock
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v3.TopicRetirement.store_index":
+		value := x.StoreIndex
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.TopicRetirement.keys_deleted":
+		value := x.KeysDeleted
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.TopicRetirement.completed_at_block":
+		value := x.CompletedAtBlock
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicRetirement"))
+		}
+		panic(fmt.Errorf("message emissions.v3.TopicRetirement does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicRetirement) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v3.TopicRetirement.topic":
+		x.Topic = value.Message().Interface().(*Topic)
+	case "emissions.v3.TopicRetirement.retired_by":
+		x.RetiredBy = value.Interface().(string)
+	case "emissions.v3.TopicRetirement.retired_at_block":
+		x.RetiredAtBlock = value.Int()
+	case "emissions.v3.TopicRetirement.stage":
+		x.Stage = (TopicRetirementStage)(value.Enum())
+	case "emissions.v3.TopicRetirement.last_refunded_reputer":
+		x.LastRefundedReputer = value.Interface().(string)
+	case "emissions.v3.TopicRetirement.last_refunded_delegator":
+		x.LastRefundedDelegator = value.Interface().(string)
+	case "emissions.v3.TopicRetirement.last_refunded_delegator_reputer":
+		x.LastRefundedDelegatorReputer = value.Interface().(string)
+	case "emissions.v3.TopicRetirement.reputer_refunds_queued":
+		x.ReputerRefundsQueued = value.Bool()
+	case "emissions.v3.TopicRetirement.refunds_complete_block":
+		x.RefundsCompleteBlock = value.Int()
+	case "emissions.v3.TopicRetirement.store_index":
+		x.StoreIndex = value.Uint()
+	case "emissions.v3.TopicRetirement.keys_deleted":
+		x.KeysDeleted = value.Uint()
+	case "emissions.v3.TopicRetirement.completed_at_block":
+		x.CompletedAtBlock = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicRetirement"))
+		}
+		panic(fmt.Errorf("message emissions.v3.TopicRetirement does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicRetirement) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.TopicRetirement.topic":
+		if x.Topic == nil {
+			x.Topic = new(Topic)
+		}
+		return protoreflect.ValueOfMessage(x.Topic.ProtoReflect())
+	case "emissions.v3.TopicRetirement.retired_by":
+		panic(fmt.Errorf("field retired_by of message emissions.v3.TopicRetirement is not mutable"))
+	case "emissions.v3.TopicRetirement.retired_at_block":
+		panic(fmt.Errorf("field retired_at_block of message emissions.v3.TopicRetirement is not mutable"))
+	case "emissions.v3.TopicRetirement.stage":
+		panic(fmt.Errorf("field stage of message emissions.v3.TopicRetirement is not mutable"))
+	case "emissions.v3.TopicRetirement.last_refunded_reputer":
+		panic(fmt.Errorf("field last_refunded_reputer of message emissions.v3.TopicRetirement is not mutable"))
+	case "emissions.v3.TopicRetirement.last_refunded_delegator":
+		panic(fmt.Errorf("field last_refunded_delegator of message emissions.v3.TopicRetirement is not mutable"))
+	case "emissions.v3.TopicRetirement.last_refunded_delegator_reputer":
+		panic(fmt.Errorf("field last_refunded_delegator_reputer of message emissions.v3.TopicRetirement is not mutable"))
+	case "emissions.v3.TopicRetirement.reputer_refunds_queued":
+		panic(fmt.Errorf("field reputer_refunds_queued of message emissions.v3.TopicRetirement is not mutable"))
+	case "emissions.v3.TopicRetirement.refunds_complete_block":
+		panic(fmt.Errorf("field refunds_complete_block of message emissions.v3.TopicRetirement is not mutable"))
+	case "emissions.v3.TopicRetirement.store_index":
+		panic(fmt.Errorf("field store_index of message emissions.v3.TopicRetirement is not mutable"))
+	case "emissions.v3.TopicRetirement.keys_deleted":
+		panic(fmt.Errorf("field keys_deleted of message emissions.v3.TopicRetirement is not mutable"))
+	case "emissions.v3.TopicRetirement.completed_at_block":
+		panic(fmt.Errorf("field completed_at_block of message emissions.v3.TopicRetirement is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicRetirement"))
+		}
+		panic(fmt.Errorf("message emissions.v3.TopicRetirement does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TopicRetirement) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.TopicRetirement.topic":
+		m := new(Topic)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "emissions.v3.TopicRetirement.retired_by":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.TopicRetirement.retired_at_block":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v3.TopicRetirement.stage":
+		return protoreflect.ValueOfEnum(0)
+	case "emissions.v3.TopicRetirement.last_refunded_reputer":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.TopicRetirement.last_refunded_delegator":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.TopicRetirement.last_refunded_delegator_reputer":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.TopicRetirement.reputer_refunds_queued":
+		return protoreflect.ValueOfBool(false)
+	case "emissions.v3.TopicRetirement.refunds_complete_block":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v3.TopicRetirement.store_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.TopicRetirement.keys_deleted":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.TopicRetirement.completed_at_block":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicRetirement"))
+		}
+		panic(fmt.Errorf("message emissions.v3.TopicRetirement does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TopicRetirement) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.TopicRetirement", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TopicRetirement) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicRetirement) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TopicRetirement) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TopicRetirement) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TopicRetirement)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Topic != nil {
+			l = options.Size(x.Topic)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RetiredBy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RetiredAtBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.RetiredAtBlock))
+		}
+		if x.Stage != 0 {
+			n += 1 + runtime.Sov(uint64(x.Stage))
+		}
+		l = len(x.LastRefundedReputer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LastRefundedDelegator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LastRefundedDelegatorReputer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ReputerRefundsQueued {
+			n += 2
+		}
+		if x.RefundsCompleteBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.RefundsCompleteBlock))
+		}
+		if x.StoreIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.StoreIndex))
+		}
+		if x.KeysDeleted != 0 {
+			n += 1 + runtime.Sov(uint64(x.KeysDeleted))
+		}
+		if x.CompletedAtBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.CompletedAtBlock))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TopicRetirement)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CompletedAtBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CompletedAtBlock))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.KeysDeleted != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.KeysDeleted))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.StoreIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StoreIndex))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.RefundsCompleteBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RefundsCompleteBlock))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.ReputerRefundsQueued {
+			i--
+			if x.ReputerRefundsQueued {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.LastRefundedDelegatorReputer) > 0 {
+			i -= len(x.LastRefundedDelegatorReputer)
+			copy(dAtA[i:], x.LastRefundedDelegatorReputer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LastRefundedDelegatorReputer)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.LastRefundedDelegator) > 0 {
+			i -= len(x.LastRefundedDelegator)
+			copy(dAtA[i:], x.LastRefundedDelegator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LastRefundedDelegator)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.LastRefundedReputer) > 0 {
+			i -= len(x.LastRefundedReputer)
+			copy(dAtA[i:], x.LastRefundedReputer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LastRefundedReputer)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Stage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Stage))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.RetiredAtBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RetiredAtBlock))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.RetiredBy) > 0 {
+			i -= len(x.RetiredBy)
+			copy(dAtA[i:], x.RetiredBy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RetiredBy)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Topic != nil {
+			encoded, err := options.Marshal(x.Topic)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TopicRetirement)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicRetirement: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicRetirement: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Topic == nil {
+					x.Topic = &Topic{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Topic); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetiredBy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RetiredBy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetiredAtBlock", wireType)
+				}
+				x.RetiredAtBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RetiredAtBlock |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+				}
+				x.Stage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Stage |= TopicRetirementStage(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastRefundedReputer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LastRefundedReputer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastRefundedDelegator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LastRefundedDelegator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastRefundedDelegatorReputer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LastRefundedDelegatorReputer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerRefundsQueued", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ReputerRefundsQueued = bool(v != 0)
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RefundsCompleteBlock", wireType)
+				}
+				x.RefundsCompleteBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RefundsCompleteBlock |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StoreIndex", wireType)
+				}
+				x.StoreIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StoreIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeysDeleted", wireType)
+				}
+				x.KeysDeleted = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.KeysDeleted |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CompletedAtBlock", wireType)
+				}
+				x.CompletedAtBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CompletedAtBlock |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: emissions/v3/topic.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Stages a retired topic moves through while its state is garbage collected
+type TopicRetirementStage int32
+
+const (
+	TopicRetirementStage_TOPIC_RETIREMENT_STAGE_UNSPECIFIED TopicRetirementStage = 0
+	// Stake removals refunding every reputer and delegator are being queued
+	TopicRetirementStage_TOPIC_RETIREMENT_STAGE_REFUNDING_STAKE TopicRetirementStage = 1
+	// Waiting for the queued stake removals to be paid out
+	TopicRetirementStage_TOPIC_RETIREMENT_STAGE_AWAITING_REFUNDS TopicRetirementStage = 2
+	// Topic-scoped state is being deleted a bounded number of keys per block
+	TopicRetirementStage_TOPIC_RETIREMENT_STAGE_CLEARING_STATE TopicRetirementStage = 3
+	TopicRetirementStage_TOPIC_RETIREMENT_STAGE_COMPLETE       TopicRetirementStage = 4
+)
+
+// Enum value maps for TopicRetirementStage.
+var (
+	TopicRetirementStage_name = map[int32]string{
+		0: "TOPIC_RETIREMENT_STAGE_UNSPECIFIED",
+		1: "TOPIC_RETIREMENT_STAGE_REFUNDING_STAKE",
+		2: "TOPIC_RETIREMENT_STAGE_AWAITING_REFUNDS",
+		3: "TOPIC_RETIREMENT_STAGE_CLEARING_STATE",
+		4: "TOPIC_RETIREMENT_STAGE_COMPLETE",
+	}
+	TopicRetirementStage_value = map[string]int32{
+		"TOPIC_RETIREMENT_STAGE_UNSPECIFIED":      0,
+		"TOPIC_RETIREMENT_STAGE_REFUNDING_STAKE":  1,
+		"TOPIC_RETIREMENT_STAGE_AWAITING_REFUNDS": 2,
+		"TOPIC_RETIREMENT_STAGE_CLEARING_STATE":   3,
+		"TOPIC_RETIREMENT_STAGE_COMPLETE":         4,
+	}
+)
+
+func (x TopicRetirementStage) Enum() *TopicRetirementStage {
+	p := new(TopicRetirementStage)
+	*p = x
+	return p
+}
+
+func (x TopicRetirementStage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TopicRetirementStage) Descriptor() protoreflect.EnumDescriptor {
+	return file_emissions_v3_topic_proto_enumTypes[0].Descriptor()
+}
+
+func (TopicRetirementStage) Type() protoreflect.EnumType {
+	return &file_emissions_v3_topic_proto_enumTypes[0]
+}
+
+func (x TopicRetirementStage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TopicRetirementStage.Descriptor instead.
+func (TopicRetirementStage) EnumDescriptor() ([]byte, []int) {
+	return file_emissions_v3_topic_proto_rawDescGZIP(), []int{0}
+}
+
+type Topic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator                string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Metadata               string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	LossMethod             string `protobuf:"bytes,5,opt,name=loss_method,json=lossMethod,proto3" json:"loss_method,omitempty"`
+	EpochLastEnded         int64  `protobuf:"varint,8,opt,name=epoch_last_ended,json=epochLastEnded,proto3" json:"epoch_last_ended,omitempty"`
+	EpochLength            int64  `protobuf:"varint,9,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	GroundTruthLag         int64  `protobuf:"varint,10,opt,name=ground_truth_lag,json=groundTruthLag,proto3" json:"ground_truth_lag,omitempty"`
+	PNorm                  string `protobuf:"bytes,12,opt,name=p_norm,json=pNorm,proto3" json:"p_norm,omitempty"`
+	AlphaRegret            string `protobuf:"bytes,13,opt,name=alpha_regret,json=alphaRegret,proto3" json:"alpha_regret,omitempty"`
+	AllowNegative          bool   `protobuf:"varint,14,opt,name=allow_negative,json=allowNegative,proto3" json:"allow_negative,omitempty"`
+	Epsilon                string `protobuf:"bytes,15,opt,name=epsilon,proto3" json:"epsilon,omitempty"`
+	InitialRegret          string `protobuf:"bytes,16,opt,name=initial_regret,json=initialRegret,proto3" json:"initial_regret,omitempty"`
+	WorkerSubmissionWindow int64  `protobuf:"varint,17,opt,name=worker_submission_window,json=workerSubmissionWindow,proto3" json:"worker_submission_window,omitempty"`
+	// alpha parameter to weight previous score EMA importance in
+	// filtering the active set of inferers, forecasters, and reputers
+	MeritSortitionAlpha      string `protobuf:"bytes,18,opt,name=merit_sortition_alpha,json=meritSortitionAlpha,proto3" json:"merit_sortition_alpha,omitempty"`
+	ActiveInfererQuantile    string `protobuf:"bytes,19,opt,name=active_inferer_quantile,json=activeInfererQuantile,proto3" json:"active_inferer_quantile,omitempty"`
+	ActiveForecasterQuantile string `protobuf:"bytes,20,opt,name=active_forecaster_quantile,json=activeForecasterQuantile,proto3" json:"active_forecaster_quantile,omitempty"`
+	ActiveReputerQuantile    string `protobuf:"bytes,21,opt,name=active_reputer_quantile,json=activeReputerQuantile,proto3" json:"active_reputer_quantile,omitempty"`
+	// Blocks following the worker submission window during which workers reveal
+	// the payloads they committed to in it. Zero disables commit-reveal, workers
+	// then submit their payloads in plaintext.
+	WorkerRevealWindow int64 `protobuf:"varint,22,opt,name=worker_reveal_window,json=workerRevealWindow,proto3" json:"worker_reveal_window,omitempty"`
+	// Number of values making up each inference of the topic. Zero and one
+	// denote scalar inferences.
+	ValueDimension uint64 `protobuf:"varint,23,opt,name=value_dimension,json=valueDimension,proto3" json:"value_dimension,omitempty"`
+	// Whether the network inferences of each nonce of the topic are archived so
+	// they remain queryable after their inputs are pruned
+	ArchiveNetworkInferences bool `protobuf:"varint,24,opt,name=archive_network_inferences,json=archiveNetworkInferences,proto3" json:"archive_network_inferences,omitempty"`
+}
+
+func (x *Topic) Reset() {
+	*x = Topic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_topic_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Topic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Topic) ProtoMessage() {}
+
+// Deprecated: Use Topic.ProtoReflect.Descriptor instead.
+func (*Topic) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_topic_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Topic) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Topic) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *Topic) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *Topic) GetLossMethod() string {
+	if x != nil {
+		return x.LossMethod
+	}
+	return ""
+}
+
+func (x *Topic) GetEpochLastEnded() int64 {
+	if x != nil {
+		return x.EpochLastEnded
+	}
+	return 0
+}
+
+func (x *Topic) GetEpochLength() int64 {
+	if x != nil {
+		return x.EpochLength
+	}
+	return 0
+}
+
+func (x *Topic) GetGroundTruthLag() int64 {
+	if x != nil {
+		return x.GroundTruthLag
+	}
+	return 0
+}
+
+func (x *Topic) GetPNorm() string {
+	if x != nil {
+		return x.PNorm
+	}
+	return ""
+}
+
+func (x *Topic) GetAlphaRegret() string {
+	if x != nil {
+		return x.AlphaRegret
+	}
+	return ""
+}
+
+func (x *Topic) GetAllowNegative() bool {
+	if x != nil {
+		return x.AllowNegative
+	}
+	return false
+}
+
+func (x *Topic) GetEpsilon() string {
+	if x != nil {
+		return x.Epsilon
+	}
+	return ""
+}
+
+func (x *Topic) GetInitialRegret() string {
+	if x != nil {
+		return x.InitialRegret
+	}
+	return ""
+}
+
+func (x *Topic) GetWorkerSubmissionWindow() int64 {
+	if x != nil {
+		return x.WorkerSubmissionWindow
+	}
+	return 0
+}
+
+func (x *Topic) GetMeritSortitionAlpha() string {
+	if x != nil {
+		return x.MeritSortitionAlpha
+	}
+	return ""
+}
+
+func (x *Topic) GetActiveInfererQuantile() string {
+	if x != nil {
+		return x.ActiveInfererQuantile
+	}
+	return ""
+}
+
+func (x *Topic) GetActiveForecasterQuantile() string {
+	if x != nil {
+		return x.ActiveForecasterQuantile
+	}
+	return ""
+}
+
+func (x *Topic) GetActiveReputerQuantile() string {
+	if x != nil {
+		return x.ActiveReputerQuantile
+	}
+	return ""
+}
+
+func (x *Topic) GetWorkerRevealWindow() int64 {
+	if x != nil {
+		return x.WorkerRevealWindow
+	}
+	return 0
+}
+
+func (x *Topic) GetValueDimension() uint64 {
+	if x != nil {
+		return x.ValueDimension
+	}
+	return 0
+}
+
+func (x *Topic) GetArchiveNetworkInferences() bool {
+	if x != nil {
+		return x.ArchiveNetworkInferences
+	}
+	return false
+}
+
+type TopicList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topics []*Topic `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+}
+
+func (x *TopicList) Reset() {
+	*x = TopicList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_topic_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopicList) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
 func (*TopicList) ProtoMessage() {}
@@ -3694,6 +7615,313 @@ func (x *TopicIdWeightPair) GetWeight() string {
 	return ""
 }
 
+// Subset of topic fields that may be changed after creation via UpdateTopic.
+// Uses the same single-element repeated field convention as OptionalParams:
+// an empty field means the value is left unchanged.
+type OptionalTopicParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EpochLength              []int64  `protobuf:"varint,1,rep,packed,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	GroundTruthLag           []int64  `protobuf:"varint,2,rep,packed,name=ground_truth_lag,json=groundTruthLag,proto3" json:"ground_truth_lag,omitempty"`
+	WorkerSubmissionWindow   []int64  `protobuf:"varint,3,rep,packed,name=worker_submission_window,json=workerSubmissionWindow,proto3" json:"worker_submission_window,omitempty"`
+	PNorm                    []string `protobuf:"bytes,4,rep,name=p_norm,json=pNorm,proto3" json:"p_norm,omitempty"`
+	AlphaRegret              []string `protobuf:"bytes,5,rep,name=alpha_regret,json=alphaRegret,proto3" json:"alpha_regret,omitempty"`
+	Epsilon                  []string `protobuf:"bytes,6,rep,name=epsilon,proto3" json:"epsilon,omitempty"`
+	MeritSortitionAlpha      []string `protobuf:"bytes,7,rep,name=merit_sortition_alpha,json=meritSortitionAlpha,proto3" json:"merit_sortition_alpha,omitempty"`
+	ActiveInfererQuantile    []string `protobuf:"bytes,8,rep,name=active_inferer_quantile,json=activeInfererQuantile,proto3" json:"active_inferer_quantile,omitempty"`
+	ActiveForecasterQuantile []string `protobuf:"bytes,9,rep,name=active_forecaster_quantile,json=activeForecasterQuantile,proto3" json:"active_forecaster_quantile,omitempty"`
+	ActiveReputerQuantile    []string `protobuf:"bytes,10,rep,name=active_reputer_quantile,json=activeReputerQuantile,proto3" json:"active_reputer_quantile,omitempty"`
+	WorkerRevealWindow       []int64  `protobuf:"varint,11,rep,packed,name=worker_reveal_window,json=workerRevealWindow,proto3" json:"worker_reveal_window,omitempty"`
+	ArchiveNetworkInferences []bool   `protobuf:"varint,12,rep,packed,name=archive_network_inferences,json=archiveNetworkInferences,proto3" json:"archive_network_inferences,omitempty"`
+}
+
+func (x *OptionalTopicParams) Reset() {
+	*x = OptionalTopicParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_topic_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptionalTopicParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionalTopicParams) ProtoMessage() {}
+
+// Deprecated: Use OptionalTopicParams.ProtoReflect.Descriptor instead.
+func (*OptionalTopicParams) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_topic_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OptionalTopicParams) GetEpochLength() []int64 {
+	if x != nil {
+		return x.EpochLength
+	}
+	return nil
+}
+
+func (x *OptionalTopicParams) GetGroundTruthLag() []int64 {
+	if x != nil {
+		return x.GroundTruthLag
+	}
+	return nil
+}
+
+func (x *OptionalTopicParams) GetWorkerSubmissionWindow() []int64 {
+	if x != nil {
+		return x.WorkerSubmissionWindow
+	}
+	return nil
+}
+
+func (x *OptionalTopicParams) GetPNorm() []string {
+	if x != nil {
+		return x.PNorm
+	}
+	return nil
+}
+
+func (x *OptionalTopicParams) GetAlphaRegret() []string {
+	if x != nil {
+		return x.AlphaRegret
+	}
+	return nil
+}
+
+func (x *OptionalTopicParams) GetEpsilon() []string {
+	if x != nil {
+		return x.Epsilon
+	}
+	return nil
+}
+
+func (x *OptionalTopicParams) GetMeritSortitionAlpha() []string {
+	if x != nil {
+		return x.MeritSortitionAlpha
+	}
+	return nil
+}
+
+func (x *OptionalTopicParams) GetActiveInfererQuantile() []string {
+	if x != nil {
+		return x.ActiveInfererQuantile
+	}
+	return nil
+}
+
+func (x *OptionalTopicParams) GetActiveForecasterQuantile() []string {
+	if x != nil {
+		return x.ActiveForecasterQuantile
+	}
+	return nil
+}
+
+func (x *OptionalTopicParams) GetActiveReputerQuantile() []string {
+	if x != nil {
+		return x.ActiveReputerQuantile
+	}
+	return nil
+}
+
+func (x *OptionalTopicParams) GetWorkerRevealWindow() []int64 {
+	if x != nil {
+		return x.WorkerRevealWindow
+	}
+	return nil
+}
+
+func (x *OptionalTopicParams) GetArchiveNetworkInferences() []bool {
+	if x != nil {
+		return x.ArchiveNetworkInferences
+	}
+	return nil
+}
+
+// A topic update waiting for the topic's next epoch boundary
+type ScheduledTopicUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId              uint64               `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	EffectiveBlockHeight int64                `protobuf:"varint,2,opt,name=effective_block_height,json=effectiveBlockHeight,proto3" json:"effective_block_height,omitempty"`
+	Params               *OptionalTopicParams `protobuf:"bytes,3,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *ScheduledTopicUpdate) Reset() {
+	*x = ScheduledTopicUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_topic_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledTopicUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledTopicUpdate) ProtoMessage() {}
+
+// Deprecated: Use ScheduledTopicUpdate.ProtoReflect.Descriptor instead.
+func (*ScheduledTopicUpdate) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_topic_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ScheduledTopicUpdate) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *ScheduledTopicUpdate) GetEffectiveBlockHeight() int64 {
+	if x != nil {
+		return x.EffectiveBlockHeight
+	}
+	return 0
+}
+
+func (x *ScheduledTopicUpdate) GetParams() *OptionalTopicParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// Archive and garbage collection progress of a retired topic
+type TopicRetirement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The topic as it was at the time it was retired
+	Topic          *Topic               `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	RetiredBy      string               `protobuf:"bytes,2,opt,name=retired_by,json=retiredBy,proto3" json:"retired_by,omitempty"`
+	RetiredAtBlock int64                `protobuf:"varint,3,opt,name=retired_at_block,json=retiredAtBlock,proto3" json:"retired_at_block,omitempty"`
+	Stage          TopicRetirementStage `protobuf:"varint,4,opt,name=stage,proto3,enum=emissions.v3.TopicRetirementStage" json:"stage,omitempty"`
+	// Last reputer whose stake removal was queued
+	LastRefundedReputer string `protobuf:"bytes,5,opt,name=last_refunded_reputer,json=lastRefundedReputer,proto3" json:"last_refunded_reputer,omitempty"`
+	// Last (delegator, reputer) pair whose delegate stake removal was queued
+	LastRefundedDelegator        string `protobuf:"bytes,6,opt,name=last_refunded_delegator,json=lastRefundedDelegator,proto3" json:"last_refunded_delegator,omitempty"`
+	LastRefundedDelegatorReputer string `protobuf:"bytes,7,opt,name=last_refunded_delegator_reputer,json=lastRefundedDelegatorReputer,proto3" json:"last_refunded_delegator_reputer,omitempty"`
+	ReputerRefundsQueued         bool   `protobuf:"varint,8,opt,name=reputer_refunds_queued,json=reputerRefundsQueued,proto3" json:"reputer_refunds_queued,omitempty"`
+	// Block at which the last queued stake removal completes
+	RefundsCompleteBlock int64 `protobuf:"varint,9,opt,name=refunds_complete_block,json=refundsCompleteBlock,proto3" json:"refunds_complete_block,omitempty"`
+	// Index into the list of topic-scoped stores being cleared
+	StoreIndex       uint64 `protobuf:"varint,10,opt,name=store_index,json=storeIndex,proto3" json:"store_index,omitempty"`
+	KeysDeleted      uint64 `protobuf:"varint,11,opt,name=keys_deleted,json=keysDeleted,proto3" json:"keys_deleted,omitempty"`
+	CompletedAtBlock int64  `protobuf:"varint,12,opt,name=completed_at_block,json=completedAtBlock,proto3" json:"completed_at_block,omitempty"`
+}
+
+func (x *TopicRetirement) Reset() {
+	*x = TopicRetirement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_topic_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopicRetirement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicRetirement) ProtoMessage() {}
+
+// Deprecated: Use TopicRetirement.ProtoReflect.Descriptor instead.
+func (*TopicRetirement) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_topic_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TopicRetirement) GetTopic() *Topic {
+	if x != nil {
+		return x.Topic
+	}
+	return nil
+}
+
+func (x *TopicRetirement) GetRetiredBy() string {
+	if x != nil {
+		return x.RetiredBy
+	}
+	return ""
+}
+
+func (x *TopicRetirement) GetRetiredAtBlock() int64 {
+	if x != nil {
+		return x.RetiredAtBlock
+	}
+	return 0
+}
+
+func (x *TopicRetirement) GetStage() TopicRetirementStage {
+	if x != nil {
+		return x.Stage
+	}
+	return TopicRetirementStage_TOPIC_RETIREMENT_STAGE_UNSPECIFIED
+}
+
+func (x *TopicRetirement) GetLastRefundedReputer() string {
+	if x != nil {
+		return x.LastRefundedReputer
+	}
+	return ""
+}
+
+func (x *TopicRetirement) GetLastRefundedDelegator() string {
+	if x != nil {
+		return x.LastRefundedDelegator
+	}
+	return ""
+}
+
+func (x *TopicRetirement) GetLastRefundedDelegatorReputer() string {
+	if x != nil {
+		return x.LastRefundedDelegatorReputer
+	}
+	return ""
+}
+
+func (x *TopicRetirement) GetReputerRefundsQueued() bool {
+	if x != nil {
+		return x.ReputerRefundsQueued
+	}
+	return false
+}
+
+func (x *TopicRetirement) GetRefundsCompleteBlock() int64 {
+	if x != nil {
+		return x.RefundsCompleteBlock
+	}
+	return 0
+}
+
+func (x *TopicRetirement) GetStoreIndex() uint64 {
+	if x != nil {
+		return x.StoreIndex
+	}
+	return 0
+}
+
+func (x *TopicRetirement) GetKeysDeleted() uint64 {
+	if x != nil {
+		return x.KeysDeleted
+	}
+	return 0
+}
+
+func (x *TopicRetirement) GetCompletedAtBlock() int64 {
+	if x != nil {
+		return x.CompletedAtBlock
+	}
+	return 0
+}
+
 var File_emissions_v3_topic_proto protoreflect.FileDescriptor
 
 var file_emissions_v3_topic_proto_rawDesc = []byte{
@@ -3702,7 +7930,7 @@ var file_emissions_v3_topic_proto_rawDesc = []byte{
 	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x1a, 0x18, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
 	0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x33, 0x2f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
-	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbf, 0x09, 0x0a, 0x05, 0x54, 0x6f, 0x70,
+	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd8, 0x0a, 0x0a, 0x05, 0x54, 0x6f, 0x70,
 	0x69, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
 	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08,
@@ -3773,47 +8001,180 @@ var file_emissions_v3_topic_proto_rawDesc = []byte{
 	0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
 	0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52,
 	0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x51, 0x75,
-	0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x06,
-	0x10, 0x07, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x4a, 0x04, 0x08, 0x0b, 0x10, 0x0c, 0x52, 0x0a,
-	0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x52, 0x0f, 0x69, 0x6e, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x52, 0x10, 0x69, 0x6e, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0b, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x72, 0x67, 0x22, 0x38, 0x0a, 0x09, 0x54, 0x6f,
-	0x70, 0x69, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x06, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x73, 0x22, 0x78, 0x0a, 0x15, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x65, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a,
-	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x12, 0x29, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x4e,
-	0x6f, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f,
-	0x01, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x2d,
-	0x0a, 0x08, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x74,
-	0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x7f, 0x0a,
-	0x11, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61,
-	0x69, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x4f, 0x0a,
-	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8,
-	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61,
-	0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0xc0,
-	0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x76, 0x33, 0x42, 0x0a, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c,
-	0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c,
-	0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x33, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x76, 0x33, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x33, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x33, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x5c, 0x56, 0x33, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56,
-	0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x5f, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x76, 0x65,
+	0x61, 0x6c, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x5f, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x3c, 0x0a, 0x1a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18,
+	0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x4a,
+	0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x4a, 0x04, 0x08, 0x07, 0x10,
+	0x08, 0x4a, 0x04, 0x08, 0x0b, 0x10, 0x0c, 0x52, 0x0a, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x6c, 0x6f,
+	0x67, 0x69, 0x63, 0x52, 0x0f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c,
+	0x6f, 0x67, 0x69, 0x63, 0x52, 0x10, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f,
+	0x61, 0x72, 0x67, 0x22, 0x38, 0x0a, 0x09, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x2b, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x22, 0x78, 0x0a,
+	0x15, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x41, 0x63, 0x74, 0x6f,
+	0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03,
+	0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x2d, 0x0a, 0x08, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x49, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x73,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x7f, 0x0a, 0x11, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49,
+	0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x69, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x4f, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
+	0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
+	0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xd1, 0x07, 0x0a, 0x13, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x74, 0x72, 0x75,
+	0x74, 0x68, 0x5f, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0e, 0x67, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x75, 0x74, 0x68, 0x4c, 0x61, 0x67, 0x12, 0x38, 0x0a, 0x18,
+	0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x16,
+	0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x4e, 0x0a, 0x06, 0x70, 0x5f, 0x6e, 0x6f, 0x72, 0x6d,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
+	0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
+	0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x05, 0x70, 0x4e, 0x6f, 0x72, 0x6d, 0x12, 0x5a, 0x0a, 0x0c, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x5f,
+	0x72, 0x65, 0x67, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0b, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x52, 0x65, 0x67, 0x72,
+	0x65, 0x74, 0x12, 0x51, 0x0a, 0x07, 0x65, 0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x07, 0x65, 0x70,
+	0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x12, 0x6b, 0x0a, 0x15, 0x6d, 0x65, 0x72, 0x69, 0x74, 0x5f, 0x73,
+	0x6f, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x13, 0x6d,
+	0x65, 0x72, 0x69, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x70,
+	0x68, 0x61, 0x12, 0x6f, 0x0a, 0x17, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x15, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x51, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x6c, 0x65, 0x12, 0x75, 0x0a, 0x1a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x6f,
+	0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c,
+	0x65, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
+	0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63,
+	0x52, 0x18, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x6f, 0x0a, 0x17, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x75,
+	0x74, 0x65, 0x72, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x77,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x5f, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x03, 0x52, 0x12, 0x77, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x3c, 0x0a,
+	0x1a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x5f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x08, 0x52, 0x18, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x14,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12,
+	0x34, 0x0a, 0x16, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x14, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x76, 0x33, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x22, 0xd0, 0x04, 0x0a, 0x0f, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x76, 0x33, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x64, 0x42, 0x79, 0x12, 0x28,
+	0x0a, 0x10, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65,
+	0x64, 0x41, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x38, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x74, 0x69,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x67, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x52,
+	0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x17, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x65, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x45,
+	0x0a, 0x1f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x5f,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1c, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x65, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x70, 0x75, 0x74, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72,
+	0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x73, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x72,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x6b, 0x65, 0x79, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6b, 0x65, 0x79, 0x73, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x2a, 0xe7, 0x01, 0x0a, 0x14, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x74,
+	0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x22,
+	0x54, 0x4f, 0x50, 0x49, 0x43, 0x5f, 0x52, 0x45, 0x54, 0x49, 0x52, 0x45, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x2a, 0x0a, 0x26, 0x54, 0x4f, 0x50, 0x49, 0x43, 0x5f, 0x52, 0x45,
+	0x54, 0x49, 0x52, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x52,
+	0x45, 0x46, 0x55, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x4b, 0x45, 0x10, 0x01,
+	0x12, 0x2b, 0x0a, 0x27, 0x54, 0x4f, 0x50, 0x49, 0x43, 0x5f, 0x52, 0x45, 0x54, 0x49, 0x52, 0x45,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x41, 0x57, 0x41, 0x49, 0x54,
+	0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x53, 0x10, 0x02, 0x12, 0x29, 0x0a,
+	0x25, 0x54, 0x4f, 0x50, 0x49, 0x43, 0x5f, 0x52, 0x45, 0x54, 0x49, 0x52, 0x45, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x52, 0x49, 0x4e, 0x47,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x03, 0x12, 0x23, 0x0a, 0x1f, 0x54, 0x4f, 0x50, 0x49,
+	0x43, 0x5f, 0x52, 0x45, 0x54, 0x49, 0x52, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x47, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x04, 0x42, 0xc0, 0x01,
+	0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x76, 0x33, 0x42, 0x0a, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x2f, 0x76, 0x33, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x76,
+	0x33, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x33, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x5c, 0x56, 0x33, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x5c, 0x56, 0x33, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x33,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3828,23 +8189,31 @@ func file_emissions_v3_topic_proto_rawDescGZIP() []byte {
 	return file_emissions_v3_topic_proto_rawDescData
 }
 
-var file_emissions_v3_topic_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_emissions_v3_topic_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_emissions_v3_topic_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_emissions_v3_topic_proto_goTypes = []interface{}{
-	(*Topic)(nil),                 // 0: emissions.v3.Topic
-	(*TopicList)(nil),             // 1: emissions.v3.TopicList
-	(*TimestampedActorNonce)(nil), // 2: emissions.v3.TimestampedActorNonce
-	(*TopicIds)(nil),              // 3: emissions.v3.TopicIds
-	(*TopicIdWeightPair)(nil),     // 4: emissions.v3.TopicIdWeightPair
-	(*Nonce)(nil),                 // 5: emissions.v3.Nonce
+	(TopicRetirementStage)(0),     // 0: emissions.v3.TopicRetirementStage
+	(*Topic)(nil),                 // 1: emissions.v3.Topic
+	(*TopicList)(nil),             // 2: emissions.v3.TopicList
+	(*TimestampedActorNonce)(nil), // 3: emissions.v3.TimestampedActorNonce
+	(*TopicIds)(nil),              // 4: emissions.v3.TopicIds
+	(*TopicIdWeightPair)(nil),     // 5: emissions.v3.TopicIdWeightPair
+	(*OptionalTopicParams)(nil),   // 6: emissions.v3.OptionalTopicParams
+	(*ScheduledTopicUpdate)(nil),  // 7: emissions.v3.ScheduledTopicUpdate
+	(*TopicRetirement)(nil),       // 8: emissions.v3.TopicRetirement
+	(*Nonce)(nil),                 // 9: emissions.v3.Nonce
 }
 var file_emissions_v3_topic_proto_depIdxs = []int32{
-	0, // 0: emissions.v3.TopicList.topics:type_name -> emissions.v3.Topic
-	5, // 1: emissions.v3.TimestampedActorNonce.nonce:type_name -> emissions.v3.Nonce
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	1, // 0: emissions.v3.TopicList.topics:type_name -> emissions.v3.Topic
+	9, // 1: emissions.v3.TimestampedActorNonce.nonce:type_name -> emissions.v3.Nonce
+	6, // 2: emissions.v3.ScheduledTopicUpdate.params:type_name -> emissions.v3.OptionalTopicParams
+	1, // 3: emissions.v3.TopicRetirement.topic:type_name -> emissions.v3.Topic
+	0, // 4: emissions.v3.TopicRetirement.stage:type_name -> emissions.v3.TopicRetirementStage
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_emissions_v3_topic_proto_init() }
@@ -3914,19 +8283,56 @@ func file_emissions_v3_topic_proto_init() {
 				return nil
 			}
 		}
+		file_emissions_v3_topic_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptionalTopicParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v3_topic_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledTopicUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v3_topic_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopicRetirement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_emissions_v3_topic_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      1,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_emissions_v3_topic_proto_goTypes,
 		DependencyIndexes: file_emissions_v3_topic_proto_depIdxs,
+		EnumInfos:         file_emissions_v3_topic_proto_enumTypes,
 		MessageInfos:      file_emissions_v3_topic_proto_msgTypes,
 	}.Build()
 	File_emissions_v3_topic_proto = out.File
@@ -60,6 +60,22 @@ var (
 	fd_Params_max_string_length                         protoreflect.FieldDescriptor
 	fd_Params_initial_regret_quantile                   protoreflect.FieldDescriptor
 	fd_Params_p_norm_safe_div                           protoreflect.FieldDescriptor
+	fd_Params_reputer_slash_fraction                    protoreflect.FieldDescriptor
+	fd_Params_slashed_stake_burn_fraction               protoreflect.FieldDescriptor
+	fd_Params_max_missed_reputer_nonces                 protoreflect.FieldDescriptor
+	fd_Params_reputer_outlier_score_ratio               protoreflect.FieldDescriptor
+	fd_Params_max_reputer_outlier_epochs                protoreflect.FieldDescriptor
+	fd_Params_reputer_jail_duration                     protoreflect.FieldDescriptor
+	fd_Params_max_reputer_loss_deviation                protoreflect.FieldDescriptor
+	fd_Params_network_inference_archive_retention       protoreflect.FieldDescriptor
+	fd_Params_max_payloads_per_batch                    protoreflect.FieldDescriptor
+	fd_Params_batched_payload_gas_discount              protoreflect.FieldDescriptor
+	fd_Params_max_redelegations_per_delegator           protoreflect.FieldDescriptor
+	fd_Params_reward_accrual_enabled                    protoreflect.FieldDescriptor
+	fd_Params_data_sending_fee_max_change_rate          protoreflect.FieldDescriptor
+	fd_Params_data_sending_fee_target_ratio             protoreflect.FieldDescriptor
+	fd_Params_gmp_axelar_channel_id                     protoreflect.FieldDescriptor
+	fd_Params_gmp_axelar_counterparty_channel_id        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -108,6 +124,22 @@ func init() {
 	fd_Params_max_string_length = md_Params.Fields().ByName("max_string_length")
 	fd_Params_initial_regret_quantile = md_Params.Fields().ByName("initial_regret_quantile")
 	fd_Params_p_norm_safe_div = md_Params.Fields().ByName("p_norm_safe_div")
+	fd_Params_reputer_slash_fraction = md_Params.Fields().ByName("reputer_slash_fraction")
+	fd_Params_slashed_stake_burn_fraction = md_Params.Fields().ByName("slashed_stake_burn_fraction")
+	fd_Params_max_missed_reputer_nonces = md_Params.Fields().ByName("max_missed_reputer_nonces")
+	fd_Params_reputer_outlier_score_ratio = md_Params.Fields().ByName("reputer_outlier_score_ratio")
+	fd_Params_max_reputer_outlier_epochs = md_Params.Fields().ByName("max_reputer_outlier_epochs")
+	fd_Params_reputer_jail_duration = md_Params.Fields().ByName("reputer_jail_duration")
+	fd_Params_max_reputer_loss_deviation = md_Params.Fields().ByName("max_reputer_loss_deviation")
+	fd_Params_network_inference_archive_retention = md_Params.Fields().ByName("network_inference_archive_retention")
+	fd_Params_max_payloads_per_batch = md_Params.Fields().ByName("max_payloads_per_batch")
+	fd_Params_batched_payload_gas_discount = md_Params.Fields().ByName("batched_payload_gas_discount")
+	fd_Params_max_redelegations_per_delegator = md_Params.Fields().ByName("max_redelegations_per_delegator")
+	fd_Params_reward_accrual_enabled = md_Params.Fields().ByName("reward_accrual_enabled")
+	fd_Params_data_sending_fee_max_change_rate = md_Params.Fields().ByName("data_sending_fee_max_change_rate")
+	fd_Params_data_sending_fee_target_ratio = md_Params.Fields().ByName("data_sending_fee_target_ratio")
+	fd_Params_gmp_axelar_channel_id = md_Params.Fields().ByName("gmp_axelar_channel_id")
+	fd_Params_gmp_axelar_counterparty_channel_id = md_Params.Fields().ByName("gmp_axelar_counterparty_channel_id")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -433,6 +465,102 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.ReputerSlashFraction != "" {
+		value := protoreflect.ValueOfString(x.ReputerSlashFraction)
+		if !f(fd_Params_reputer_slash_fraction, value) {
+			return
+		}
+	}
+	if x.SlashedStakeBurnFraction != "" {
+		value := protoreflect.ValueOfString(x.SlashedStakeBurnFraction)
+		if !f(fd_Params_slashed_stake_burn_fraction, value) {
+			return
+		}
+	}
+	if x.MaxMissedReputerNonces != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxMissedReputerNonces)
+		if !f(fd_Params_max_missed_reputer_nonces, value) {
+			return
+		}
+	}
+	if x.ReputerOutlierScoreRatio != "" {
+		value := protoreflect.ValueOfString(x.ReputerOutlierScoreRatio)
+		if !f(fd_Params_reputer_outlier_score_ratio, value) {
+			return
+		}
+	}
+	if x.MaxReputerOutlierEpochs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxReputerOutlierEpochs)
+		if !f(fd_Params_max_reputer_outlier_epochs, value) {
+			return
+		}
+	}
+	if x.ReputerJailDuration != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ReputerJailDuration)
+		if !f(fd_Params_reputer_jail_duration, value) {
+			return
+		}
+	}
+	if x.MaxReputerLossDeviation != "" {
+		value := protoreflect.ValueOfString(x.MaxReputerLossDeviation)
+		if !f(fd_Params_max_reputer_loss_deviation, value) {
+			return
+		}
+	}
+	if x.NetworkInferenceArchiveRetention != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NetworkInferenceArchiveRetention)
+		if !f(fd_Params_network_inference_archive_retention, value) {
+			return
+		}
+	}
+	if x.MaxPayloadsPerBatch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPayloadsPerBatch)
+		if !f(fd_Params_max_payloads_per_batch, value) {
+			return
+		}
+	}
+	if x.BatchedPayloadGasDiscount != "" {
+		value := protoreflect.ValueOfString(x.BatchedPayloadGasDiscount)
+		if !f(fd_Params_batched_payload_gas_discount, value) {
+			return
+		}
+	}
+	if x.MaxRedelegationsPerDelegator != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxRedelegationsPerDelegator)
+		if !f(fd_Params_max_redelegations_per_delegator, value) {
+			return
+		}
+	}
+	if x.RewardAccrualEnabled != false {
+		value := protoreflect.ValueOfBool(x.RewardAccrualEnabled)
+		if !f(fd_Params_reward_accrual_enabled, value) {
+			return
+		}
+	}
+	if x.DataSendingFeeMaxChangeRate != "" {
+		value := protoreflect.ValueOfString(x.DataSendingFeeMaxChangeRate)
+		if !f(fd_Params_data_sending_fee_max_change_rate, value) {
+			return
+		}
+	}
+	if x.DataSendingFeeTargetRatio != "" {
+		value := protoreflect.ValueOfString(x.DataSendingFeeTargetRatio)
+		if !f(fd_Params_data_sending_fee_target_ratio, value) {
+			return
+		}
+	}
+	if x.GmpAxelarChannelId != "" {
+		value := protoreflect.ValueOfString(x.GmpAxelarChannelId)
+		if !f(fd_Params_gmp_axelar_channel_id, value) {
+			return
+		}
+	}
+	if x.GmpAxelarCounterpartyChannelId != "" {
+		value := protoreflect.ValueOfString(x.GmpAxelarCounterpartyChannelId)
+		if !f(fd_Params_gmp_axelar_counterparty_channel_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -534,6 +662,38 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.InitialRegretQuantile != ""
 	case "emissions.v5.Params.p_norm_safe_div":
 		return x.PNormSafeDiv != ""
+	case "emissions.v5.Params.reputer_slash_fraction":
+		return x.ReputerSlashFraction != ""
+	case "emissions.v5.Params.slashed_stake_burn_fraction":
+		return x.SlashedStakeBurnFraction != ""
+	case "emissions.v5.Params.max_missed_reputer_nonces":
+		return x.MaxMissedReputerNonces != uint64(0)
+	case "emissions.v5.Params.reputer_outlier_score_ratio":
+		return x.ReputerOutlierScoreRatio != ""
+	case "emissions.v5.Params.max_reputer_outlier_epochs":
+		return x.MaxReputerOutlierEpochs != uint64(0)
+	case "emissions.v5.Params.reputer_jail_duration":
+		return x.ReputerJailDuration != int64(0)
+	case "emissions.v5.Params.max_reputer_loss_deviation":
+		return x.MaxReputerLossDeviation != ""
+	case "emissions.v5.Params.network_inference_archive_retention":
+		return x.NetworkInferenceArchiveRetention != int64(0)
+	case "emissions.v5.Params.max_payloads_per_batch":
+		return x.MaxPayloadsPerBatch != uint64(0)
+	case "emissions.v5.Params.batched_payload_gas_discount":
+		return x.BatchedPayloadGasDiscount != ""
+	case "emissions.v5.Params.max_redelegations_per_delegator":
+		return x.MaxRedelegationsPerDelegator != uint64(0)
+	case "emissions.v5.Params.reward_accrual_enabled":
+		return x.RewardAccrualEnabled != false
+	case "emissions.v5.Params.data_sending_fee_max_change_rate":
+		return x.DataSendingFeeMaxChangeRate != ""
+	case "emissions.v5.Params.data_sending_fee_target_ratio":
+		return x.DataSendingFeeTargetRatio != ""
+	case "emissions.v5.Params.gmp_axelar_channel_id":
+		return x.GmpAxelarChannelId != ""
+	case "emissions.v5.Params.gmp_axelar_counterparty_channel_id":
+		return x.GmpAxelarCounterpartyChannelId != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v5.Params"))
@@ -636,6 +796,38 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.InitialRegretQuantile = ""
 	case "emissions.v5.Params.p_norm_safe_div":
 		x.PNormSafeDiv = ""
+	case "emissions.v5.Params.reputer_slash_fraction":
+		x.ReputerSlashFraction = ""
+	case "emissions.v5.Params.slashed_stake_burn_fraction":
+		x.SlashedStakeBurnFraction = ""
+	case "emissions.v5.Params.max_missed_reputer_nonces":
+		x.MaxMissedReputerNonces = uint64(0)
+	case "emissions.v5.Params.reputer_outlier_score_ratio":
+		x.ReputerOutlierScoreRatio = ""
+	case "emissions.v5.Params.max_reputer_outlier_epochs":
+		x.MaxReputerOutlierEpochs = uint64(0)
+	case "emissions.v5.Params.reputer_jail_duration":
+		x.ReputerJailDuration = int64(0)
+	case "emissions.v5.Params.max_reputer_loss_deviation":
+		x.MaxReputerLossDeviation = ""
+	case "emissions.v5.Params.network_inference_archive_retention":
+		x.NetworkInferenceArchiveRetention = int64(0)
+	case "emissions.v5.Params.max_payloads_per_batch":
+		x.MaxPayloadsPerBatch = uint64(0)
+	case "emissions.v5.Params.batched_payload_gas_discount":
+		x.BatchedPayloadGasDiscount = ""
+	case "emissions.v5.Params.max_redelegations_per_delegator":
+		x.MaxRedelegationsPerDelegator = uint64(0)
+	case "emissions.v5.Params.reward_accrual_enabled":
+		x.RewardAccrualEnabled = false
+	case "emissions.v5.Params.data_sending_fee_max_change_rate":
+		x.DataSendingFeeMaxChangeRate = ""
+	case "emissions.v5.Params.data_sending_fee_target_ratio":
+		x.DataSendingFeeTargetRatio = ""
+	case "emissions.v5.Params.gmp_axelar_channel_id":
+		x.GmpAxelarChannelId = ""
+	case "emissions.v5.Params.gmp_axelar_counterparty_channel_id":
+		x.GmpAxelarCounterpartyChannelId = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v5.Params"))
@@ -781,6 +973,54 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "emissions.v5.Params.p_norm_safe_div":
 		value := x.PNormSafeDiv
 		return protoreflect.ValueOfString(value)
+	case "emissions.v5.Params.reputer_slash_fraction":
+		value := x.ReputerSlashFraction
+		return protoreflect.ValueOfString(value)
+	case "emissions.v5.Params.slashed_stake_burn_fraction":
+		value := x.SlashedStakeBurnFraction
+		return protoreflect.ValueOfString(value)
+	case "emissions.v5.Params.max_missed_reputer_nonces":
+		value := x.MaxMissedReputerNonces
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v5.Params.reputer_outlier_score_ratio":
+		value := x.ReputerOutlierScoreRatio
+		return protoreflect.ValueOfString(value)
+	case "emissions.v5.Params.max_reputer_outlier_epochs":
+		value := x.MaxReputerOutlierEpochs
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v5.Params.reputer_jail_duration":
+		value := x.ReputerJailDuration
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v5.Params.max_reputer_loss_deviation":
+		value := x.MaxReputerLossDeviation
+		return protoreflect.ValueOfString(value)
+	case "emissions.v5.Params.network_inference_archive_retention":
+		value := x.NetworkInferenceArchiveRetention
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v5.Params.max_payloads_per_batch":
+		value := x.MaxPayloadsPerBatch
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v5.Params.batched_payload_gas_discount":
+		value := x.BatchedPayloadGasDiscount
+		return protoreflect.ValueOfString(value)
+	case "emissions.v5.Params.max_redelegations_per_delegator":
+		value := x.MaxRedelegationsPerDelegator
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v5.Params.reward_accrual_enabled":
+		value := x.RewardAccrualEnabled
+		return protoreflect.ValueOfBool(value)
+	case "emissions.v5.Params.data_sending_fee_max_change_rate":
+		value := x.DataSendingFeeMaxChangeRate
+		return protoreflect.ValueOfString(value)
+	case "emissions.v5.Params.data_sending_fee_target_ratio":
+		value := x.DataSendingFeeTargetRatio
+		return protoreflect.ValueOfString(value)
+	case "emissions.v5.Params.gmp_axelar_channel_id":
+		value := x.GmpAxelarChannelId
+		return protoreflect.ValueOfString(value)
+	case "emissions.v5.Params.gmp_axelar_counterparty_channel_id":
+		value := x.GmpAxelarCounterpartyChannelId
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v5.Params"))
@@ -887,6 +1127,38 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.InitialRegretQuantile = value.Interface().(string)
 	case "emissions.v5.Params.p_norm_safe_div":
 		x.PNormSafeDiv = value.Interface().(string)
+	case "emissions.v5.Params.reputer_slash_fraction":
+		x.ReputerSlashFraction = value.Interface().(string)
+	case "emissions.v5.Params.slashed_stake_burn_fraction":
+		x.SlashedStakeBurnFraction = value.Interface().(string)
+	case "emissions.v5.Params.max_missed_reputer_nonces":
+		x.MaxMissedReputerNonces = value.Uint()
+	case "emissions.v5.Params.reputer_outlier_score_ratio":
+		x.ReputerOutlierScoreRatio = value.Interface().(string)
+	case "emissions.v5.Params.max_reputer_outlier_epochs":
+		x.MaxReputerOutlierEpochs = value.Uint()
+	case "emissions.v5.Params.reputer_jail_duration":
+		x.ReputerJailDuration = value.Int()
+	case "emissions.v5.Params.max_reputer_loss_deviation":
+		x.MaxReputerLossDeviation = value.Interface().(string)
+	case "emissions.v5.Params.network_inference_archive_retention":
+		x.NetworkInferenceArchiveRetention = value.Int()
+	case "emissions.v5.Params.max_payloads_per_batch":
+		x.MaxPayloadsPerBatch = value.Uint()
+	case "emissions.v5.Params.batched_payload_gas_discount":
+		x.BatchedPayloadGasDiscount = value.Interface().(string)
+	case "emissions.v5.Params.max_redelegations_per_delegator":
+		x.MaxRedelegationsPerDelegator = value.Uint()
+	case "emissions.v5.Params.reward_accrual_enabled":
+		x.RewardAccrualEnabled = value.Bool()
+	case "emissions.v5.Params.data_sending_fee_max_change_rate":
+		x.DataSendingFeeMaxChangeRate = value.Interface().(string)
+	case "emissions.v5.Params.data_sending_fee_target_ratio":
+		x.DataSendingFeeTargetRatio = value.Interface().(string)
+	case "emissions.v5.Params.gmp_axelar_channel_id":
+		x.GmpAxelarChannelId = value.Interface().(string)
+	case "emissions.v5.Params.gmp_axelar_counterparty_channel_id":
+		x.GmpAxelarCounterpartyChannelId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v5.Params"))
@@ -993,6 +1265,38 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field initial_regret_quantile of message emissions.v5.Params is not mutable"))
 	case "emissions.v5.Params.p_norm_safe_div":
 		panic(fmt.Errorf("field p_norm_safe_div of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.reputer_slash_fraction":
+		panic(fmt.Errorf("field reputer_slash_fraction of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.slashed_stake_burn_fraction":
+		panic(fmt.Errorf("field slashed_stake_burn_fraction of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.max_missed_reputer_nonces":
+		panic(fmt.Errorf("field max_missed_reputer_nonces of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.reputer_outlier_score_ratio":
+		panic(fmt.Errorf("field reputer_outlier_score_ratio of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.max_reputer_outlier_epochs":
+		panic(fmt.Errorf("field max_reputer_outlier_epochs of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.reputer_jail_duration":
+		panic(fmt.Errorf("field reputer_jail_duration of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.max_reputer_loss_deviation":
+		panic(fmt.Errorf("field max_reputer_loss_deviation of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.network_inference_archive_retention":
+		panic(fmt.Errorf("field network_inference_archive_retention of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.max_payloads_per_batch":
+		panic(fmt.Errorf("field max_payloads_per_batch of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.batched_payload_gas_discount":
+		panic(fmt.Errorf("field batched_payload_gas_discount of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.max_redelegations_per_delegator":
+		panic(fmt.Errorf("field max_redelegations_per_delegator of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.reward_accrual_enabled":
+		panic(fmt.Errorf("field reward_accrual_enabled of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.data_sending_fee_max_change_rate":
+		panic(fmt.Errorf("field data_sending_fee_max_change_rate of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.data_sending_fee_target_ratio":
+		panic(fmt.Errorf("field data_sending_fee_target_ratio of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.gmp_axelar_channel_id":
+		panic(fmt.Errorf("field gmp_axelar_channel_id of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.gmp_axelar_counterparty_channel_id":
+		panic(fmt.Errorf("field gmp_axelar_counterparty_channel_id of message emissions.v5.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v5.Params"))
@@ -1092,6 +1396,38 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "emissions.v5.Params.p_norm_safe_div":
 		return protoreflect.ValueOfString("")
+	case "emissions.v5.Params.reputer_slash_fraction":
+		return protoreflect.ValueOfString("")
+	case "emissions.v5.Params.slashed_stake_burn_fraction":
+		return protoreflect.ValueOfString("")
+	case "emissions.v5.Params.max_missed_reputer_nonces":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v5.Params.reputer_outlier_score_ratio":
+		return protoreflect.ValueOfString("")
+	case "emissions.v5.Params.max_reputer_outlier_epochs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v5.Params.reputer_jail_duration":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v5.Params.max_reputer_loss_deviation":
+		return protoreflect.ValueOfString("")
+	case "emissions.v5.Params.network_inference_archive_retention":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v5.Params.max_payloads_per_batch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v5.Params.batched_payload_gas_discount":
+		return protoreflect.ValueOfString("")
+	case "emissions.v5.Params.max_redelegations_per_delegator":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v5.Params.reward_accrual_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "emissions.v5.Params.data_sending_fee_max_change_rate":
+		return protoreflect.ValueOfString("")
+	case "emissions.v5.Params.data_sending_fee_target_ratio":
+		return protoreflect.ValueOfString("")
+	case "emissions.v5.Params.gmp_axelar_channel_id":
+		return protoreflect.ValueOfString("")
+	case "emissions.v5.Params.gmp_axelar_counterparty_channel_id":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v5.Params"))
@@ -1315,6 +1651,63 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 2 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.ReputerSlashFraction)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SlashedStakeBurnFraction)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxMissedReputerNonces != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxMissedReputerNonces))
+		}
+		l = len(x.ReputerOutlierScoreRatio)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxReputerOutlierEpochs != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxReputerOutlierEpochs))
+		}
+		if x.ReputerJailDuration != 0 {
+			n += 2 + runtime.Sov(uint64(x.ReputerJailDuration))
+		}
+		l = len(x.MaxReputerLossDeviation)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.NetworkInferenceArchiveRetention != 0 {
+			n += 2 + runtime.Sov(uint64(x.NetworkInferenceArchiveRetention))
+		}
+		if x.MaxPayloadsPerBatch != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxPayloadsPerBatch))
+		}
+		l = len(x.BatchedPayloadGasDiscount)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxRedelegationsPerDelegator != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxRedelegationsPerDelegator))
+		}
+		if x.RewardAccrualEnabled {
+			n += 3
+		}
+		l = len(x.DataSendingFeeMaxChangeRate)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DataSendingFeeTargetRatio)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.GmpAxelarChannelId)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.GmpAxelarCounterpartyChannelId)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1344,6 +1737,141 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.GmpAxelarCounterpartyChannelId) > 0 {
+			i -= len(x.GmpAxelarCounterpartyChannelId)
+			copy(dAtA[i:], x.GmpAxelarCounterpartyChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GmpAxelarCounterpartyChannelId)))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.GmpAxelarChannelId) > 0 {
+			i -= len(x.GmpAxelarChannelId)
+			copy(dAtA[i:], x.GmpAxelarChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GmpAxelarChannelId)))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0x82
+		}
+		if len(x.DataSendingFeeTargetRatio) > 0 {
+			i -= len(x.DataSendingFeeTargetRatio)
+			copy(dAtA[i:], x.DataSendingFeeTargetRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DataSendingFeeTargetRatio)))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xfa
+		}
+		if len(x.DataSendingFeeMaxChangeRate) > 0 {
+			i -= len(x.DataSendingFeeMaxChangeRate)
+			copy(dAtA[i:], x.DataSendingFeeMaxChangeRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DataSendingFeeMaxChangeRate)))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xf2
+		}
+		if x.RewardAccrualEnabled {
+			i--
+			if x.RewardAccrualEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xe8
+		}
+		if x.MaxRedelegationsPerDelegator != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRedelegationsPerDelegator))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xe0
+		}
+		if len(x.BatchedPayloadGasDiscount) > 0 {
+			i -= len(x.BatchedPayloadGasDiscount)
+			copy(dAtA[i:], x.BatchedPayloadGasDiscount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BatchedPayloadGasDiscount)))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xda
+		}
+		if x.MaxPayloadsPerBatch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPayloadsPerBatch))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xd0
+		}
+		if x.NetworkInferenceArchiveRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NetworkInferenceArchiveRetention))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xc8
+		}
+		if len(x.MaxReputerLossDeviation) > 0 {
+			i -= len(x.MaxReputerLossDeviation)
+			copy(dAtA[i:], x.MaxReputerLossDeviation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxReputerLossDeviation)))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xc2
+		}
+		if x.ReputerJailDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReputerJailDuration))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xb8
+		}
+		if x.MaxReputerOutlierEpochs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxReputerOutlierEpochs))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xb0
+		}
+		if len(x.ReputerOutlierScoreRatio) > 0 {
+			i -= len(x.ReputerOutlierScoreRatio)
+			copy(dAtA[i:], x.ReputerOutlierScoreRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReputerOutlierScoreRatio)))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xaa
+		}
+		if x.MaxMissedReputerNonces != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMissedReputerNonces))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xa0
+		}
+		if len(x.SlashedStakeBurnFraction) > 0 {
+			i -= len(x.SlashedStakeBurnFraction)
+			copy(dAtA[i:], x.SlashedStakeBurnFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SlashedStakeBurnFraction)))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x9a
+		}
+		if len(x.ReputerSlashFraction) > 0 {
+			i -= len(x.ReputerSlashFraction)
+			copy(dAtA[i:], x.ReputerSlashFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReputerSlashFraction)))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x92
+		}
 		if len(x.PNormSafeDiv) > 0 {
 			i -= len(x.PNormSafeDiv)
 			copy(dAtA[i:], x.PNormSafeDiv)
@@ -2860,54 +3388,476 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.PNormSafeDiv = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 50:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerSlashFraction", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.ReputerSlashFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 51:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashedStakeBurnFraction", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: emissions/v5/params.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashedStakeBurnFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 52:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxMissedReputerNonces", wireType)
+				}
+				x.MaxMissedReputerNonces = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxMissedReputerNonces |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 53:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerOutlierScoreRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReputerOutlierScoreRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 54:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxReputerOutlierEpochs", wireType)
+				}
+				x.MaxReputerOutlierEpochs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxReputerOutlierEpochs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 55:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerJailDuration", wireType)
+				}
+				x.ReputerJailDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReputerJailDuration |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 56:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxReputerLossDeviation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxReputerLossDeviation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 57:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NetworkInferenceArchiveRetention", wireType)
+				}
+				x.NetworkInferenceArchiveRetention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NetworkInferenceArchiveRetention |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 58:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPayloadsPerBatch", wireType)
+				}
+				x.MaxPayloadsPerBatch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPayloadsPerBatch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 59:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchedPayloadGasDiscount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BatchedPayloadGasDiscount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 60:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRedelegationsPerDelegator", wireType)
+				}
+				x.MaxRedelegationsPerDelegator = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxRedelegationsPerDelegator |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 61:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardAccrualEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RewardAccrualEnabled = bool(v != 0)
+			case 62:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSendingFeeMaxChangeRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DataSendingFeeMaxChangeRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 63:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSendingFeeTargetRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DataSendingFeeTargetRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 64:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GmpAxelarChannelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GmpAxelarChannelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 65:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GmpAxelarCounterpartyChannelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GmpAxelarCounterpartyChannelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: emissions/v5/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
 // Params defines the parameters of the module.
 type Params struct {
 	state         protoimpl.MessageState
@@ -2966,14 +3916,30 @@ type Params struct {
 	// Applied twice once for stakeRemovals and once for
 	// DelegateStakeRemovals, so actual max is this number times two
 	EpsilonSafeDiv string `protobuf:"bytes,43,opt,name=epsilon_safe_div,json=epsilonSafeDiv,proto3" json:"epsilon_safe_div,omitempty"`
-	// / a small tolerance quantity used to cap division by zero
+	/// a small tolerance quantity used to cap division by zero
 	DataSendingFee string `protobuf:"bytes,44,opt,name=data_sending_fee,json=dataSendingFee,proto3" json:"data_sending_fee,omitempty"`
 	// payload sending fee for reputer or worker
-	MaxElementsPerForecast  uint64 `protobuf:"varint,45,opt,name=max_elements_per_forecast,json=maxElementsPerForecast,proto3" json:"max_elements_per_forecast,omitempty"`      // max number of top forecasters by score to reward
-	MaxActiveTopicsPerBlock uint64 `protobuf:"varint,46,opt,name=max_active_topics_per_block,json=maxActiveTopicsPerBlock,proto3" json:"max_active_topics_per_block,omitempty"` // max number of active topics per block
-	MaxStringLength         uint64 `protobuf:"varint,47,opt,name=max_string_length,json=maxStringLength,proto3" json:"max_string_length,omitempty"`                             // max permittible length of strings uploaded to the chain
-	InitialRegretQuantile   string `protobuf:"bytes,48,opt,name=initial_regret_quantile,json=initialRegretQuantile,proto3" json:"initial_regret_quantile,omitempty"`
-	PNormSafeDiv            string `protobuf:"bytes,49,opt,name=p_norm_safe_div,json=pNormSafeDiv,proto3" json:"p_norm_safe_div,omitempty"`
+	MaxElementsPerForecast           uint64 `protobuf:"varint,45,opt,name=max_elements_per_forecast,json=maxElementsPerForecast,proto3" json:"max_elements_per_forecast,omitempty"`      // max number of top forecasters by score to reward
+	MaxActiveTopicsPerBlock          uint64 `protobuf:"varint,46,opt,name=max_active_topics_per_block,json=maxActiveTopicsPerBlock,proto3" json:"max_active_topics_per_block,omitempty"` // max number of active topics per block
+	MaxStringLength                  uint64 `protobuf:"varint,47,opt,name=max_string_length,json=maxStringLength,proto3" json:"max_string_length,omitempty"`                             // max permittible length of strings uploaded to the chain
+	InitialRegretQuantile            string `protobuf:"bytes,48,opt,name=initial_regret_quantile,json=initialRegretQuantile,proto3" json:"initial_regret_quantile,omitempty"`
+	PNormSafeDiv                     string `protobuf:"bytes,49,opt,name=p_norm_safe_div,json=pNormSafeDiv,proto3" json:"p_norm_safe_div,omitempty"`
+	ReputerSlashFraction             string `protobuf:"bytes,50,opt,name=reputer_slash_fraction,json=reputerSlashFraction,proto3" json:"reputer_slash_fraction,omitempty"`
+	SlashedStakeBurnFraction         string `protobuf:"bytes,51,opt,name=slashed_stake_burn_fraction,json=slashedStakeBurnFraction,proto3" json:"slashed_stake_burn_fraction,omitempty"`
+	MaxMissedReputerNonces           uint64 `protobuf:"varint,52,opt,name=max_missed_reputer_nonces,json=maxMissedReputerNonces,proto3" json:"max_missed_reputer_nonces,omitempty"` // consecutive reputer nonces a reputer may miss before being slashed and jailed, 0 disables
+	ReputerOutlierScoreRatio         string `protobuf:"bytes,53,opt,name=reputer_outlier_score_ratio,json=reputerOutlierScoreRatio,proto3" json:"reputer_outlier_score_ratio,omitempty"`
+	MaxReputerOutlierEpochs          uint64 `protobuf:"varint,54,opt,name=max_reputer_outlier_epochs,json=maxReputerOutlierEpochs,proto3" json:"max_reputer_outlier_epochs,omitempty"` // consecutive epochs a reputer may be an outlier before being slashed and jailed, 0 disables
+	ReputerJailDuration              int64  `protobuf:"varint,55,opt,name=reputer_jail_duration,json=reputerJailDuration,proto3" json:"reputer_jail_duration,omitempty"`               // blocks a jailed reputer must wait before unjailing itself
+	MaxReputerLossDeviation          string `protobuf:"bytes,56,opt,name=max_reputer_loss_deviation,json=maxReputerLossDeviation,proto3" json:"max_reputer_loss_deviation,omitempty"`
+	NetworkInferenceArchiveRetention int64  `protobuf:"varint,57,opt,name=network_inference_archive_retention,json=networkInferenceArchiveRetention,proto3" json:"network_inference_archive_retention,omitempty"` // blocks archived network inferences are kept for, 0 keeps them indefinitely
+	MaxPayloadsPerBatch              uint64 `protobuf:"varint,58,opt,name=max_payloads_per_batch,json=maxPayloadsPerBatch,proto3" json:"max_payloads_per_batch,omitempty"`                                        // maximum number of payloads in a batched worker or reputer payload message, 0 disables them
+	BatchedPayloadGasDiscount        string `protobuf:"bytes,59,opt,name=batched_payload_gas_discount,json=batchedPayloadGasDiscount,proto3" json:"batched_payload_gas_discount,omitempty"`
+	MaxRedelegationsPerDelegator     uint64 `protobuf:"varint,60,opt,name=max_redelegations_per_delegator,json=maxRedelegationsPerDelegator,proto3" json:"max_redelegations_per_delegator,omitempty"` // maximum number of redelegations in progress per delegator, 0 disables redelegation
+	RewardAccrualEnabled             bool   `protobuf:"varint,61,opt,name=reward_accrual_enabled,json=rewardAccrualEnabled,proto3" json:"reward_accrual_enabled,omitempty"`                           // credit worker rewards to a ledger claimed with ClaimRewards instead of sending them every reward nonce
+	DataSendingFeeMaxChangeRate      string `protobuf:"bytes,62,opt,name=data_sending_fee_max_change_rate,json=dataSendingFeeMaxChangeRate,proto3" json:"data_sending_fee_max_change_rate,omitempty"`
+	DataSendingFeeTargetRatio        string `protobuf:"bytes,63,opt,name=data_sending_fee_target_ratio,json=dataSendingFeeTargetRatio,proto3" json:"data_sending_fee_target_ratio,omitempty"`
+	GmpAxelarChannelId               string `protobuf:"bytes,64,opt,name=gmp_axelar_channel_id,json=gmpAxelarChannelId,proto3" json:"gmp_axelar_channel_id,omitempty"`                                       // channel of this chain to Axelar, the only one GMP messages are accepted from, empty rejects all GMP messages
+	GmpAxelarCounterpartyChannelId   string `protobuf:"bytes,65,opt,name=gmp_axelar_counterparty_channel_id,json=gmpAxelarCounterpartyChannelId,proto3" json:"gmp_axelar_counterparty_channel_id,omitempty"` // channel of Axelar at the other end of gmp_axelar_channel_id
 }
 
 func (x *Params) Reset() {
@@ -3297,6 +4263,118 @@ func (x *Params) GetPNormSafeDiv() string {
 	return ""
 }
 
+func (x *Params) GetReputerSlashFraction() string {
+	if x != nil {
+		return x.ReputerSlashFraction
+	}
+	return ""
+}
+
+func (x *Params) GetSlashedStakeBurnFraction() string {
+	if x != nil {
+		return x.SlashedStakeBurnFraction
+	}
+	return ""
+}
+
+func (x *Params) GetMaxMissedReputerNonces() uint64 {
+	if x != nil {
+		return x.MaxMissedReputerNonces
+	}
+	return 0
+}
+
+func (x *Params) GetReputerOutlierScoreRatio() string {
+	if x != nil {
+		return x.ReputerOutlierScoreRatio
+	}
+	return ""
+}
+
+func (x *Params) GetMaxReputerOutlierEpochs() uint64 {
+	if x != nil {
+		return x.MaxReputerOutlierEpochs
+	}
+	return 0
+}
+
+func (x *Params) GetReputerJailDuration() int64 {
+	if x != nil {
+		return x.ReputerJailDuration
+	}
+	return 0
+}
+
+func (x *Params) GetMaxReputerLossDeviation() string {
+	if x != nil {
+		return x.MaxReputerLossDeviation
+	}
+	return ""
+}
+
+func (x *Params) GetNetworkInferenceArchiveRetention() int64 {
+	if x != nil {
+		return x.NetworkInferenceArchiveRetention
+	}
+	return 0
+}
+
+func (x *Params) GetMaxPayloadsPerBatch() uint64 {
+	if x != nil {
+		return x.MaxPayloadsPerBatch
+	}
+	return 0
+}
+
+func (x *Params) GetBatchedPayloadGasDiscount() string {
+	if x != nil {
+		return x.BatchedPayloadGasDiscount
+	}
+	return ""
+}
+
+func (x *Params) GetMaxRedelegationsPerDelegator() uint64 {
+	if x != nil {
+		return x.MaxRedelegationsPerDelegator
+	}
+	return 0
+}
+
+func (x *Params) GetRewardAccrualEnabled() bool {
+	if x != nil {
+		return x.RewardAccrualEnabled
+	}
+	return false
+}
+
+func (x *Params) GetDataSendingFeeMaxChangeRate() string {
+	if x != nil {
+		return x.DataSendingFeeMaxChangeRate
+	}
+	return ""
+}
+
+func (x *Params) GetDataSendingFeeTargetRatio() string {
+	if x != nil {
+		return x.DataSendingFeeTargetRatio
+	}
+	return ""
+}
+
+func (x *Params) GetGmpAxelarChannelId() string {
+	if x != nil {
+		return x.GmpAxelarChannelId
+	}
+	return ""
+}
+
+func (x *Params) GetGmpAxelarCounterpartyChannelId() string {
+	if x != nil {
+		return x.GmpAxelarCounterpartyChannelId
+	}
+	return ""
+}
+
 var File_emissions_v5_params_proto protoreflect.FileDescriptor
 
 var file_emissions_v5_params_proto_rawDesc = []byte{
@@ -3306,7 +4384,7 @@ var file_emissions_v5_params_proto_rawDesc = []byte{
 	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa7, 0x1d,
+	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9d, 0x28,
 	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
 	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
 	0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
@@ -3529,32 +4607,119 @@ var file_emissions_v5_params_proto_rawDesc = []byte{
 	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
 	0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
 	0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52,
-	0x0c, 0x70, 0x4e, 0x6f, 0x72, 0x6d, 0x53, 0x61, 0x66, 0x65, 0x44, 0x69, 0x76, 0x4a, 0x04, 0x08,
-	0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x1a, 0x10, 0x1b, 0x4a, 0x04, 0x08, 0x1b, 0x10, 0x1c, 0x4a,
-	0x04, 0x08, 0x27, 0x10, 0x28, 0x4a, 0x04, 0x08, 0x29, 0x10, 0x2a, 0x52, 0x14, 0x6d, 0x61, 0x78,
-	0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x52, 0x1b, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x23,
-	0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x66,
-	0x75, 0x6c, 0x66, 0x69, 0x6c, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x5f, 0x77, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x52, 0x1c, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72,
-	0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x64, 0x65, 0x63, 0x61, 0x79, 0x5f, 0x72, 0x61, 0x74,
-	0x65, 0x52, 0x24, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x74,
-	0x6f, 0x5f, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x5f,
-	0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x42, 0xc1, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e,
-	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x35, 0x42, 0x0b, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74,
+	0x0c, 0x70, 0x4e, 0x6f, 0x72, 0x6d, 0x53, 0x61, 0x66, 0x65, 0x44, 0x69, 0x76, 0x12, 0x6d, 0x0a,
+	0x16, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x14, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x76, 0x0a, 0x1b,
+	0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x62, 0x75,
+	0x72, 0x6e, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x33, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x18, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x42, 0x75, 0x72, 0x6e, 0x46, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x69, 0x73, 0x73,
+	0x65, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x73, 0x18, 0x34, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x73, 0x73,
+	0x65, 0x64, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x12,
+	0x76, 0x0a, 0x1b, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x6c, 0x69,
+	0x65, 0x72, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x35,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x18, 0x72,
+	0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x3b, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x72,
+	0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x36, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6d, 0x61, 0x78,
+	0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f,
+	0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x37, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x13, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x4a, 0x61, 0x69, 0x6c,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x74, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f,
+	0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x64, 0x65, 0x76,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x38, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65,
+	0x72, 0x4c, 0x6f, 0x73, 0x73, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d,
+	0x0a, 0x23, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x39, 0x20, 0x01, 0x28, 0x03, 0x52, 0x20, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a,
+	0x16, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6d,
+	0x61, 0x78, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x50, 0x65, 0x72, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x78, 0x0a, 0x1c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65,
+	0x63, 0x52, 0x19, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x47, 0x61, 0x73, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x45, 0x0a, 0x1f,
+	0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x3c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1c, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x61, 0x63,
+	0x63, 0x72, 0x75, 0x61, 0x6c, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x3d, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x14, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x63, 0x63, 0x72, 0x75,
+	0x61, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x7e, 0x0a, 0x20, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x3e, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74,
 	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e,
 	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x35,
-	0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x76, 0x35, 0xa2, 0x02, 0x03, 0x45,
-	0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56,
-	0x35, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x35,
-	0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x35, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x35, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x1b, 0x64, 0x61,
+	0x74, 0x61, 0x53, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x4d, 0x61, 0x78, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x79, 0x0a, 0x1d, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x19, 0x64, 0x61, 0x74, 0x61, 0x53,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52,
+	0x61, 0x74, 0x69, 0x6f, 0x12, 0x31, 0x0a, 0x15, 0x67, 0x6d, 0x70, 0x5f, 0x61, 0x78, 0x65, 0x6c,
+	0x61, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x40, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x67, 0x6d, 0x70, 0x41, 0x78, 0x65, 0x6c, 0x61, 0x72, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x22, 0x67, 0x6d, 0x70, 0x5f, 0x61,
+	0x78, 0x65, 0x6c, 0x61, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72,
+	0x74, 0x79, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x41, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x1e, 0x67, 0x6d, 0x70, 0x41, 0x78, 0x65, 0x6c, 0x61, 0x72, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x1a, 0x10, 0x1b, 0x4a,
+	0x04, 0x08, 0x1b, 0x10, 0x1c, 0x4a, 0x04, 0x08, 0x27, 0x10, 0x28, 0x4a, 0x04, 0x08, 0x29, 0x10,
+	0x2a, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x1b, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x76,
+	0x65, 0x6e, 0x75, 0x65, 0x52, 0x23, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x5f, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x73, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x1c, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x64, 0x65, 0x63,
+	0x61, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x52, 0x24, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x5f, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x42, 0xc1, 0x01,
+	0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x76, 0x35, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c,
+	0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x35, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x76, 0x35, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x35, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x35, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x5c, 0x56, 0x35, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56,
+	0x35, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -62,6 +62,10 @@ const (
 	QueryService_GetDelegateStakePlacement_FullMethodName                   = "/emissions.v5.QueryService/GetDelegateStakePlacement"
 	QueryService_GetDelegateStakeUponReputer_FullMethodName                 = "/emissions.v5.QueryService/GetDelegateStakeUponReputer"
 	QueryService_GetDelegateRewardPerShare_FullMethodName                   = "/emissions.v5.QueryService/GetDelegateRewardPerShare"
+	QueryService_IsDelegateStakeAutoCompounding_FullMethodName              = "/emissions.v5.QueryService/IsDelegateStakeAutoCompounding"
+	QueryService_GetDelegatorRedelegations_FullMethodName                   = "/emissions.v5.QueryService/GetDelegatorRedelegations"
+	QueryService_GetAccruedRewards_FullMethodName                           = "/emissions.v5.QueryService/GetAccruedRewards"
+	QueryService_GetTotalAccruedRewards_FullMethodName                      = "/emissions.v5.QueryService/GetTotalAccruedRewards"
 	QueryService_GetStakeRemovalForReputerAndTopicId_FullMethodName         = "/emissions.v5.QueryService/GetStakeRemovalForReputerAndTopicId"
 	QueryService_GetDelegateStakeRemoval_FullMethodName                     = "/emissions.v5.QueryService/GetDelegateStakeRemoval"
 	QueryService_GetPreviousTopicWeight_FullMethodName                      = "/emissions.v5.QueryService/GetPreviousTopicWeight"
@@ -101,6 +105,23 @@ const (
 	QueryService_GetActiveReputersForTopic_FullMethodName                   = "/emissions.v5.QueryService/GetActiveReputersForTopic"
 	QueryService_GetActiveForecastersForTopic_FullMethodName                = "/emissions.v5.QueryService/GetActiveForecastersForTopic"
 	QueryService_GetActiveInferersForTopic_FullMethodName                   = "/emissions.v5.QueryService/GetActiveInferersForTopic"
+	QueryService_GetScheduledTopicUpdate_FullMethodName                     = "/emissions.v5.QueryService/GetScheduledTopicUpdate"
+	QueryService_GetTopicRetirement_FullMethodName                          = "/emissions.v5.QueryService/GetTopicRetirement"
+	QueryService_IsGmpSourceAllowed_FullMethodName                          = "/emissions.v5.QueryService/IsGmpSourceAllowed"
+	QueryService_GetGmpSubscription_FullMethodName                          = "/emissions.v5.QueryService/GetGmpSubscription"
+	QueryService_GetTopicGmpSubscriptions_FullMethodName                    = "/emissions.v5.QueryService/GetTopicGmpSubscriptions"
+	QueryService_GetTopicSponsorship_FullMethodName                         = "/emissions.v5.QueryService/GetTopicSponsorship"
+	QueryService_GetTopicSponsorships_FullMethodName                        = "/emissions.v5.QueryService/GetTopicSponsorships"
+	QueryService_GetTopicDataSendingFee_FullMethodName                      = "/emissions.v5.QueryService/GetTopicDataSendingFee"
+	QueryService_IsTopicAllowlistEnabled_FullMethodName                     = "/emissions.v5.QueryService/IsTopicAllowlistEnabled"
+	QueryService_IsInTopicAllowlist_FullMethodName                          = "/emissions.v5.QueryService/IsInTopicAllowlist"
+	QueryService_GetTopicAllowlist_FullMethodName                           = "/emissions.v5.QueryService/GetTopicAllowlist"
+	QueryService_GetReputerSlashingInfo_FullMethodName                      = "/emissions.v5.QueryService/GetReputerSlashingInfo"
+	QueryService_GetWorkerCommitment_FullMethodName                         = "/emissions.v5.QueryService/GetWorkerCommitment"
+	QueryService_GetGroundTruth_FullMethodName                              = "/emissions.v5.QueryService/GetGroundTruth"
+	QueryService_GetLossMethods_FullMethodName                              = "/emissions.v5.QueryService/GetLossMethods"
+	QueryService_GetNetworkInferenceHistory_FullMethodName                  = "/emissions.v5.QueryService/GetNetworkInferenceHistory"
+	QueryService_GetEndBlockerTimings_FullMethodName                        = "/emissions.v5.QueryService/GetEndBlockerTimings"
 )
 
 // QueryServiceClient is the client API for QueryService service.
@@ -153,6 +174,10 @@ type QueryServiceClient interface {
 	GetDelegateStakePlacement(ctx context.Context, in *GetDelegateStakePlacementRequest, opts ...grpc.CallOption) (*GetDelegateStakePlacementResponse, error)
 	GetDelegateStakeUponReputer(ctx context.Context, in *GetDelegateStakeUponReputerRequest, opts ...grpc.CallOption) (*GetDelegateStakeUponReputerResponse, error)
 	GetDelegateRewardPerShare(ctx context.Context, in *GetDelegateRewardPerShareRequest, opts ...grpc.CallOption) (*GetDelegateRewardPerShareResponse, error)
+	IsDelegateStakeAutoCompounding(ctx context.Context, in *IsDelegateStakeAutoCompoundingRequest, opts ...grpc.CallOption) (*IsDelegateStakeAutoCompoundingResponse, error)
+	GetDelegatorRedelegations(ctx context.Context, in *GetDelegatorRedelegationsRequest, opts ...grpc.CallOption) (*GetDelegatorRedelegationsResponse, error)
+	GetAccruedRewards(ctx context.Context, in *GetAccruedRewardsRequest, opts ...grpc.CallOption) (*GetAccruedRewardsResponse, error)
+	GetTotalAccruedRewards(ctx context.Context, in *GetTotalAccruedRewardsRequest, opts ...grpc.CallOption) (*GetTotalAccruedRewardsResponse, error)
 	GetStakeRemovalForReputerAndTopicId(ctx context.Context, in *GetStakeRemovalForReputerAndTopicIdRequest, opts ...grpc.CallOption) (*GetStakeRemovalForReputerAndTopicIdResponse, error)
 	GetDelegateStakeRemoval(ctx context.Context, in *GetDelegateStakeRemovalRequest, opts ...grpc.CallOption) (*GetDelegateStakeRemovalResponse, error)
 	GetPreviousTopicWeight(ctx context.Context, in *GetPreviousTopicWeightRequest, opts ...grpc.CallOption) (*GetPreviousTopicWeightResponse, error)
@@ -192,6 +217,25 @@ type QueryServiceClient interface {
 	GetActiveReputersForTopic(ctx context.Context, in *GetActiveReputersForTopicRequest, opts ...grpc.CallOption) (*GetActiveReputersForTopicResponse, error)
 	GetActiveForecastersForTopic(ctx context.Context, in *GetActiveForecastersForTopicRequest, opts ...grpc.CallOption) (*GetActiveForecastersForTopicResponse, error)
 	GetActiveInferersForTopic(ctx context.Context, in *GetActiveInferersForTopicRequest, opts ...grpc.CallOption) (*GetActiveInferersForTopicResponse, error)
+	GetScheduledTopicUpdate(ctx context.Context, in *GetScheduledTopicUpdateRequest, opts ...grpc.CallOption) (*GetScheduledTopicUpdateResponse, error)
+	GetTopicRetirement(ctx context.Context, in *GetTopicRetirementRequest, opts ...grpc.CallOption) (*GetTopicRetirementResponse, error)
+	IsGmpSourceAllowed(ctx context.Context, in *IsGmpSourceAllowedRequest, opts ...grpc.CallOption) (*IsGmpSourceAllowedResponse, error)
+	GetGmpSubscription(ctx context.Context, in *GetGmpSubscriptionRequest, opts ...grpc.CallOption) (*GetGmpSubscriptionResponse, error)
+	GetTopicGmpSubscriptions(ctx context.Context, in *GetTopicGmpSubscriptionsRequest, opts ...grpc.CallOption) (*GetTopicGmpSubscriptionsResponse, error)
+	GetTopicSponsorship(ctx context.Context, in *GetTopicSponsorshipRequest, opts ...grpc.CallOption) (*GetTopicSponsorshipResponse, error)
+	GetTopicSponsorships(ctx context.Context, in *GetTopicSponsorshipsRequest, opts ...grpc.CallOption) (*GetTopicSponsorshipsResponse, error)
+	GetTopicDataSendingFee(ctx context.Context, in *GetTopicDataSendingFeeRequest, opts ...grpc.CallOption) (*GetTopicDataSendingFeeResponse, error)
+	IsTopicAllowlistEnabled(ctx context.Context, in *IsTopicAllowlistEnabledRequest, opts ...grpc.CallOption) (*IsTopicAllowlistEnabledResponse, error)
+	IsInTopicAllowlist(ctx context.Context, in *IsInTopicAllowlistRequest, opts ...grpc.CallOption) (*IsInTopicAllowlistResponse, error)
+	GetTopicAllowlist(ctx context.Context, in *GetTopicAllowlistRequest, opts ...grpc.CallOption) (*GetTopicAllowlistResponse, error)
+	GetReputerSlashingInfo(ctx context.Context, in *GetReputerSlashingInfoRequest, opts ...grpc.CallOption) (*GetReputerSlashingInfoResponse, error)
+	GetWorkerCommitment(ctx context.Context, in *GetWorkerCommitmentRequest, opts ...grpc.CallOption) (*GetWorkerCommitmentResponse, error)
+	GetGroundTruth(ctx context.Context, in *GetGroundTruthRequest, opts ...grpc.CallOption) (*GetGroundTruthResponse, error)
+	GetLossMethods(ctx context.Context, in *GetLossMethodsRequest, opts ...grpc.CallOption) (*GetLossMethodsResponse, error)
+	GetNetworkInferenceHistory(ctx context.Context, in *GetNetworkInferenceHistoryRequest, opts ...grpc.CallOption) (*GetNetworkInferenceHistoryResponse, error)
+	// Returns how long each phase of the EndBlocker took over the last blocks executed by the queried node.
+	// The timings are kept in memory by each node, hence the query is not module query safe.
+	GetEndBlockerTimings(ctx context.Context, in *GetEndBlockerTimingsRequest, opts ...grpc.CallOption) (*GetEndBlockerTimingsResponse, error)
 }
 
 type queryServiceClient struct {
@@ -632,6 +676,46 @@ func (c *queryServiceClient) GetDelegateRewardPerShare(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *queryServiceClient) IsDelegateStakeAutoCompounding(ctx context.Context, in *IsDelegateStakeAutoCompoundingRequest, opts ...grpc.CallOption) (*IsDelegateStakeAutoCompoundingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsDelegateStakeAutoCompoundingResponse)
+	err := c.cc.Invoke(ctx, QueryService_IsDelegateStakeAutoCompounding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetDelegatorRedelegations(ctx context.Context, in *GetDelegatorRedelegationsRequest, opts ...grpc.CallOption) (*GetDelegatorRedelegationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDelegatorRedelegationsResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetDelegatorRedelegations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetAccruedRewards(ctx context.Context, in *GetAccruedRewardsRequest, opts ...grpc.CallOption) (*GetAccruedRewardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccruedRewardsResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetAccruedRewards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetTotalAccruedRewards(ctx context.Context, in *GetTotalAccruedRewardsRequest, opts ...grpc.CallOption) (*GetTotalAccruedRewardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTotalAccruedRewardsResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetTotalAccruedRewards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryServiceClient) GetStakeRemovalForReputerAndTopicId(ctx context.Context, in *GetStakeRemovalForReputerAndTopicIdRequest, opts ...grpc.CallOption) (*GetStakeRemovalForReputerAndTopicIdResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetStakeRemovalForReputerAndTopicIdResponse)
@@ -1022,6 +1106,176 @@ func (c *queryServiceClient) GetActiveInferersForTopic(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *queryServiceClient) GetScheduledTopicUpdate(ctx context.Context, in *GetScheduledTopicUpdateRequest, opts ...grpc.CallOption) (*GetScheduledTopicUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetScheduledTopicUpdateResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetScheduledTopicUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetTopicRetirement(ctx context.Context, in *GetTopicRetirementRequest, opts ...grpc.CallOption) (*GetTopicRetirementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTopicRetirementResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetTopicRetirement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) IsGmpSourceAllowed(ctx context.Context, in *IsGmpSourceAllowedRequest, opts ...grpc.CallOption) (*IsGmpSourceAllowedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsGmpSourceAllowedResponse)
+	err := c.cc.Invoke(ctx, QueryService_IsGmpSourceAllowed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetGmpSubscription(ctx context.Context, in *GetGmpSubscriptionRequest, opts ...grpc.CallOption) (*GetGmpSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGmpSubscriptionResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetGmpSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetTopicGmpSubscriptions(ctx context.Context, in *GetTopicGmpSubscriptionsRequest, opts ...grpc.CallOption) (*GetTopicGmpSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTopicGmpSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetTopicGmpSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetTopicSponsorship(ctx context.Context, in *GetTopicSponsorshipRequest, opts ...grpc.CallOption) (*GetTopicSponsorshipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTopicSponsorshipResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetTopicSponsorship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetTopicSponsorships(ctx context.Context, in *GetTopicSponsorshipsRequest, opts ...grpc.CallOption) (*GetTopicSponsorshipsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTopicSponsorshipsResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetTopicSponsorships_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetTopicDataSendingFee(ctx context.Context, in *GetTopicDataSendingFeeRequest, opts ...grpc.CallOption) (*GetTopicDataSendingFeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTopicDataSendingFeeResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetTopicDataSendingFee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) IsTopicAllowlistEnabled(ctx context.Context, in *IsTopicAllowlistEnabledRequest, opts ...grpc.CallOption) (*IsTopicAllowlistEnabledResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsTopicAllowlistEnabledResponse)
+	err := c.cc.Invoke(ctx, QueryService_IsTopicAllowlistEnabled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) IsInTopicAllowlist(ctx context.Context, in *IsInTopicAllowlistRequest, opts ...grpc.CallOption) (*IsInTopicAllowlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsInTopicAllowlistResponse)
+	err := c.cc.Invoke(ctx, QueryService_IsInTopicAllowlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetTopicAllowlist(ctx context.Context, in *GetTopicAllowlistRequest, opts ...grpc.CallOption) (*GetTopicAllowlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTopicAllowlistResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetTopicAllowlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetReputerSlashingInfo(ctx context.Context, in *GetReputerSlashingInfoRequest, opts ...grpc.CallOption) (*GetReputerSlashingInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReputerSlashingInfoResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetReputerSlashingInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetWorkerCommitment(ctx context.Context, in *GetWorkerCommitmentRequest, opts ...grpc.CallOption) (*GetWorkerCommitmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkerCommitmentResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetWorkerCommitment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetGroundTruth(ctx context.Context, in *GetGroundTruthRequest, opts ...grpc.CallOption) (*GetGroundTruthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroundTruthResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetGroundTruth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetLossMethods(ctx context.Context, in *GetLossMethodsRequest, opts ...grpc.CallOption) (*GetLossMethodsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLossMethodsResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetLossMethods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetNetworkInferenceHistory(ctx context.Context, in *GetNetworkInferenceHistoryRequest, opts ...grpc.CallOption) (*GetNetworkInferenceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNetworkInferenceHistoryResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetNetworkInferenceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetEndBlockerTimings(ctx context.Context, in *GetEndBlockerTimingsRequest, opts ...grpc.CallOption) (*GetEndBlockerTimingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEndBlockerTimingsResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetEndBlockerTimings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServiceServer is the server API for QueryService service.
 // All implementations must embed UnimplementedQueryServiceServer
 // for forward compatibility.
@@ -1072,6 +1326,10 @@ type QueryServiceServer interface {
 	GetDelegateStakePlacement(context.Context, *GetDelegateStakePlacementRequest) (*GetDelegateStakePlacementResponse, error)
 	GetDelegateStakeUponReputer(context.Context, *GetDelegateStakeUponReputerRequest) (*GetDelegateStakeUponReputerResponse, error)
 	GetDelegateRewardPerShare(context.Context, *GetDelegateRewardPerShareRequest) (*GetDelegateRewardPerShareResponse, error)
+	IsDelegateStakeAutoCompounding(context.Context, *IsDelegateStakeAutoCompoundingRequest) (*IsDelegateStakeAutoCompoundingResponse, error)
+	GetDelegatorRedelegations(context.Context, *GetDelegatorRedelegationsRequest) (*GetDelegatorRedelegationsResponse, error)
+	GetAccruedRewards(context.Context, *GetAccruedRewardsRequest) (*GetAccruedRewardsResponse, error)
+	GetTotalAccruedRewards(context.Context, *GetTotalAccruedRewardsRequest) (*GetTotalAccruedRewardsResponse, error)
 	GetStakeRemovalForReputerAndTopicId(context.Context, *GetStakeRemovalForReputerAndTopicIdRequest) (*GetStakeRemovalForReputerAndTopicIdResponse, error)
 	GetDelegateStakeRemoval(context.Context, *GetDelegateStakeRemovalRequest) (*GetDelegateStakeRemovalResponse, error)
 	GetPreviousTopicWeight(context.Context, *GetPreviousTopicWeightRequest) (*GetPreviousTopicWeightResponse, error)
@@ -1111,6 +1369,25 @@ type QueryServiceServer interface {
 	GetActiveReputersForTopic(context.Context, *GetActiveReputersForTopicRequest) (*GetActiveReputersForTopicResponse, error)
 	GetActiveForecastersForTopic(context.Context, *GetActiveForecastersForTopicRequest) (*GetActiveForecastersForTopicResponse, error)
 	GetActiveInferersForTopic(context.Context, *GetActiveInferersForTopicRequest) (*GetActiveInferersForTopicResponse, error)
+	GetScheduledTopicUpdate(context.Context, *GetScheduledTopicUpdateRequest) (*GetScheduledTopicUpdateResponse, error)
+	GetTopicRetirement(context.Context, *GetTopicRetirementRequest) (*GetTopicRetirementResponse, error)
+	IsGmpSourceAllowed(context.Context, *IsGmpSourceAllowedRequest) (*IsGmpSourceAllowedResponse, error)
+	GetGmpSubscription(context.Context, *GetGmpSubscriptionRequest) (*GetGmpSubscriptionResponse, error)
+	GetTopicGmpSubscriptions(context.Context, *GetTopicGmpSubscriptionsRequest) (*GetTopicGmpSubscriptionsResponse, error)
+	GetTopicSponsorship(context.Context, *GetTopicSponsorshipRequest) (*GetTopicSponsorshipResponse, error)
+	GetTopicSponsorships(context.Context, *GetTopicSponsorshipsRequest) (*GetTopicSponsorshipsResponse, error)
+	GetTopicDataSendingFee(context.Context, *GetTopicDataSendingFeeRequest) (*GetTopicDataSendingFeeResponse, error)
+	IsTopicAllowlistEnabled(context.Context, *IsTopicAllowlistEnabledRequest) (*IsTopicAllowlistEnabledResponse, error)
+	IsInTopicAllowlist(context.Context, *IsInTopicAllowlistRequest) (*IsInTopicAllowlistResponse, error)
+	GetTopicAllowlist(context.Context, *GetTopicAllowlistRequest) (*GetTopicAllowlistResponse, error)
+	GetReputerSlashingInfo(context.Context, *GetReputerSlashingInfoRequest) (*GetReputerSlashingInfoResponse, error)
+	GetWorkerCommitment(context.Context, *GetWorkerCommitmentRequest) (*GetWorkerCommitmentResponse, error)
+	GetGroundTruth(context.Context, *GetGroundTruthRequest) (*GetGroundTruthResponse, error)
+	GetLossMethods(context.Context, *GetLossMethodsRequest) (*GetLossMethodsResponse, error)
+	GetNetworkInferenceHistory(context.Context, *GetNetworkInferenceHistoryRequest) (*GetNetworkInferenceHistoryResponse, error)
+	// Returns how long each phase of the EndBlocker took over the last blocks executed by the queried node.
+	// The timings are kept in memory by each node, hence the query is not module query safe.
+	GetEndBlockerTimings(context.Context, *GetEndBlockerTimingsRequest) (*GetEndBlockerTimingsResponse, error)
 	mustEmbedUnimplementedQueryServiceServer()
 }
 
@@ -1250,6 +1527,18 @@ func (UnimplementedQueryServiceServer) GetDelegateStakeUponReputer(context.Conte
 func (UnimplementedQueryServiceServer) GetDelegateRewardPerShare(context.Context, *GetDelegateRewardPerShareRequest) (*GetDelegateRewardPerShareResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDelegateRewardPerShare not implemented")
 }
+func (UnimplementedQueryServiceServer) IsDelegateStakeAutoCompounding(context.Context, *IsDelegateStakeAutoCompoundingRequest) (*IsDelegateStakeAutoCompoundingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsDelegateStakeAutoCompounding not implemented")
+}
+func (UnimplementedQueryServiceServer) GetDelegatorRedelegations(context.Context, *GetDelegatorRedelegationsRequest) (*GetDelegatorRedelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelegatorRedelegations not implemented")
+}
+func (UnimplementedQueryServiceServer) GetAccruedRewards(context.Context, *GetAccruedRewardsRequest) (*GetAccruedRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccruedRewards not implemented")
+}
+func (UnimplementedQueryServiceServer) GetTotalAccruedRewards(context.Context, *GetTotalAccruedRewardsRequest) (*GetTotalAccruedRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTotalAccruedRewards not implemented")
+}
 func (UnimplementedQueryServiceServer) GetStakeRemovalForReputerAndTopicId(context.Context, *GetStakeRemovalForReputerAndTopicIdRequest) (*GetStakeRemovalForReputerAndTopicIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStakeRemovalForReputerAndTopicId not implemented")
 }
@@ -1367,6 +1656,57 @@ func (UnimplementedQueryServiceServer) GetActiveForecastersForTopic(context.Cont
 func (UnimplementedQueryServiceServer) GetActiveInferersForTopic(context.Context, *GetActiveInferersForTopicRequest) (*GetActiveInferersForTopicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetActiveInferersForTopic not implemented")
 }
+func (UnimplementedQueryServiceServer) GetScheduledTopicUpdate(context.Context, *GetScheduledTopicUpdateRequest) (*GetScheduledTopicUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledTopicUpdate not implemented")
+}
+func (UnimplementedQueryServiceServer) GetTopicRetirement(context.Context, *GetTopicRetirementRequest) (*GetTopicRetirementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicRetirement not implemented")
+}
+func (UnimplementedQueryServiceServer) IsGmpSourceAllowed(context.Context, *IsGmpSourceAllowedRequest) (*IsGmpSourceAllowedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsGmpSourceAllowed not implemented")
+}
+func (UnimplementedQueryServiceServer) GetGmpSubscription(context.Context, *GetGmpSubscriptionRequest) (*GetGmpSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGmpSubscription not implemented")
+}
+func (UnimplementedQueryServiceServer) GetTopicGmpSubscriptions(context.Context, *GetTopicGmpSubscriptionsRequest) (*GetTopicGmpSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicGmpSubscriptions not implemented")
+}
+func (UnimplementedQueryServiceServer) GetTopicSponsorship(context.Context, *GetTopicSponsorshipRequest) (*GetTopicSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicSponsorship not implemented")
+}
+func (UnimplementedQueryServiceServer) GetTopicSponsorships(context.Context, *GetTopicSponsorshipsRequest) (*GetTopicSponsorshipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicSponsorships not implemented")
+}
+func (UnimplementedQueryServiceServer) GetTopicDataSendingFee(context.Context, *GetTopicDataSendingFeeRequest) (*GetTopicDataSendingFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicDataSendingFee not implemented")
+}
+func (UnimplementedQueryServiceServer) IsTopicAllowlistEnabled(context.Context, *IsTopicAllowlistEnabledRequest) (*IsTopicAllowlistEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsTopicAllowlistEnabled not implemented")
+}
+func (UnimplementedQueryServiceServer) IsInTopicAllowlist(context.Context, *IsInTopicAllowlistRequest) (*IsInTopicAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsInTopicAllowlist not implemented")
+}
+func (UnimplementedQueryServiceServer) GetTopicAllowlist(context.Context, *GetTopicAllowlistRequest) (*GetTopicAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicAllowlist not implemented")
+}
+func (UnimplementedQueryServiceServer) GetReputerSlashingInfo(context.Context, *GetReputerSlashingInfoRequest) (*GetReputerSlashingInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReputerSlashingInfo not implemented")
+}
+func (UnimplementedQueryServiceServer) GetWorkerCommitment(context.Context, *GetWorkerCommitmentRequest) (*GetWorkerCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerCommitment not implemented")
+}
+func (UnimplementedQueryServiceServer) GetGroundTruth(context.Context, *GetGroundTruthRequest) (*GetGroundTruthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroundTruth not implemented")
+}
+func (UnimplementedQueryServiceServer) GetLossMethods(context.Context, *GetLossMethodsRequest) (*GetLossMethodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLossMethods not implemented")
+}
+func (UnimplementedQueryServiceServer) GetNetworkInferenceHistory(context.Context, *GetNetworkInferenceHistoryRequest) (*GetNetworkInferenceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkInferenceHistory not implemented")
+}
+func (UnimplementedQueryServiceServer) GetEndBlockerTimings(context.Context, *GetEndBlockerTimingsRequest) (*GetEndBlockerTimingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEndBlockerTimings not implemented")
+}
 func (UnimplementedQueryServiceServer) mustEmbedUnimplementedQueryServiceServer() {}
 func (UnimplementedQueryServiceServer) testEmbeddedByValue()                      {}
 
@@ -2162,6 +2502,78 @@ func _QueryService_GetDelegateRewardPerShare_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_IsDelegateStakeAutoCompounding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsDelegateStakeAutoCompoundingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).IsDelegateStakeAutoCompounding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_IsDelegateStakeAutoCompounding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).IsDelegateStakeAutoCompounding(ctx, req.(*IsDelegateStakeAutoCompoundingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetDelegatorRedelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDelegatorRedelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetDelegatorRedelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetDelegatorRedelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetDelegatorRedelegations(ctx, req.(*GetDelegatorRedelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetAccruedRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccruedRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetAccruedRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetAccruedRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetAccruedRewards(ctx, req.(*GetAccruedRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetTotalAccruedRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTotalAccruedRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetTotalAccruedRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetTotalAccruedRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetTotalAccruedRewards(ctx, req.(*GetTotalAccruedRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryService_GetStakeRemovalForReputerAndTopicId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetStakeRemovalForReputerAndTopicIdRequest)
 	if err := dec(in); err != nil {
@@ -2864,6 +3276,312 @@ func _QueryService_GetActiveInferersForTopic_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_GetScheduledTopicUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduledTopicUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetScheduledTopicUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetScheduledTopicUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetScheduledTopicUpdate(ctx, req.(*GetScheduledTopicUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetTopicRetirement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicRetirementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetTopicRetirement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetTopicRetirement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetTopicRetirement(ctx, req.(*GetTopicRetirementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_IsGmpSourceAllowed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsGmpSourceAllowedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).IsGmpSourceAllowed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_IsGmpSourceAllowed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).IsGmpSourceAllowed(ctx, req.(*IsGmpSourceAllowedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetGmpSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGmpSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetGmpSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetGmpSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetGmpSubscription(ctx, req.(*GetGmpSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetTopicGmpSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicGmpSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetTopicGmpSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetTopicGmpSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetTopicGmpSubscriptions(ctx, req.(*GetTopicGmpSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetTopicSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicSponsorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetTopicSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetTopicSponsorship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetTopicSponsorship(ctx, req.(*GetTopicSponsorshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetTopicSponsorships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicSponsorshipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetTopicSponsorships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetTopicSponsorships_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetTopicSponsorships(ctx, req.(*GetTopicSponsorshipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetTopicDataSendingFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicDataSendingFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetTopicDataSendingFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetTopicDataSendingFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetTopicDataSendingFee(ctx, req.(*GetTopicDataSendingFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_IsTopicAllowlistEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsTopicAllowlistEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).IsTopicAllowlistEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_IsTopicAllowlistEnabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).IsTopicAllowlistEnabled(ctx, req.(*IsTopicAllowlistEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_IsInTopicAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsInTopicAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).IsInTopicAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_IsInTopicAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).IsInTopicAllowlist(ctx, req.(*IsInTopicAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetTopicAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetTopicAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetTopicAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetTopicAllowlist(ctx, req.(*GetTopicAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetReputerSlashingInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReputerSlashingInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetReputerSlashingInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetReputerSlashingInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetReputerSlashingInfo(ctx, req.(*GetReputerSlashingInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetWorkerCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkerCommitmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetWorkerCommitment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetWorkerCommitment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetWorkerCommitment(ctx, req.(*GetWorkerCommitmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetGroundTruth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroundTruthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetGroundTruth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetGroundTruth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetGroundTruth(ctx, req.(*GetGroundTruthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetLossMethods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLossMethodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetLossMethods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetLossMethods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetLossMethods(ctx, req.(*GetLossMethodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetNetworkInferenceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetworkInferenceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetNetworkInferenceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetNetworkInferenceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetNetworkInferenceHistory(ctx, req.(*GetNetworkInferenceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetEndBlockerTimings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEndBlockerTimingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetEndBlockerTimings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetEndBlockerTimings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetEndBlockerTimings(ctx, req.(*GetEndBlockerTimingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // QueryService_ServiceDesc is the grpc.ServiceDesc for QueryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -3043,6 +3761,22 @@ var QueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDelegateRewardPerShare",
 			Handler:    _QueryService_GetDelegateRewardPerShare_Handler,
 		},
+		{
+			MethodName: "IsDelegateStakeAutoCompounding",
+			Handler:    _QueryService_IsDelegateStakeAutoCompounding_Handler,
+		},
+		{
+			MethodName: "GetDelegatorRedelegations",
+			Handler:    _QueryService_GetDelegatorRedelegations_Handler,
+		},
+		{
+			MethodName: "GetAccruedRewards",
+			Handler:    _QueryService_GetAccruedRewards_Handler,
+		},
+		{
+			MethodName: "GetTotalAccruedRewards",
+			Handler:    _QueryService_GetTotalAccruedRewards_Handler,
+		},
 		{
 			MethodName: "GetStakeRemovalForReputerAndTopicId",
 			Handler:    _QueryService_GetStakeRemovalForReputerAndTopicId_Handler,
@@ -3199,6 +3933,74 @@ var QueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetActiveInferersForTopic",
 			Handler:    _QueryService_GetActiveInferersForTopic_Handler,
 		},
+		{
+			MethodName: "GetScheduledTopicUpdate",
+			Handler:    _QueryService_GetScheduledTopicUpdate_Handler,
+		},
+		{
+			MethodName: "GetTopicRetirement",
+			Handler:    _QueryService_GetTopicRetirement_Handler,
+		},
+		{
+			MethodName: "IsGmpSourceAllowed",
+			Handler:    _QueryService_IsGmpSourceAllowed_Handler,
+		},
+		{
+			MethodName: "GetGmpSubscription",
+			Handler:    _QueryService_GetGmpSubscription_Handler,
+		},
+		{
+			MethodName: "GetTopicGmpSubscriptions",
+			Handler:    _QueryService_GetTopicGmpSubscriptions_Handler,
+		},
+		{
+			MethodName: "GetTopicSponsorship",
+			Handler:    _QueryService_GetTopicSponsorship_Handler,
+		},
+		{
+			MethodName: "GetTopicSponsorships",
+			Handler:    _QueryService_GetTopicSponsorships_Handler,
+		},
+		{
+			MethodName: "GetTopicDataSendingFee",
+			Handler:    _QueryService_GetTopicDataSendingFee_Handler,
+		},
+		{
+			MethodName: "IsTopicAllowlistEnabled",
+			Handler:    _QueryService_IsTopicAllowlistEnabled_Handler,
+		},
+		{
+			MethodName: "IsInTopicAllowlist",
+			Handler:    _QueryService_IsInTopicAllowlist_Handler,
+		},
+		{
+			MethodName: "GetTopicAllowlist",
+			Handler:    _QueryService_GetTopicAllowlist_Handler,
+		},
+		{
+			MethodName: "GetReputerSlashingInfo",
+			Handler:    _QueryService_GetReputerSlashingInfo_Handler,
+		},
+		{
+			MethodName: "GetWorkerCommitment",
+			Handler:    _QueryService_GetWorkerCommitment_Handler,
+		},
+		{
+			MethodName: "GetGroundTruth",
+			Handler:    _QueryService_GetGroundTruth_Handler,
+		},
+		{
+			MethodName: "GetLossMethods",
+			Handler:    _QueryService_GetLossMethods_Handler,
+		},
+		{
+			MethodName: "GetNetworkInferenceHistory",
+			Handler:    _QueryService_GetNetworkInferenceHistory_Handler,
+		},
+		{
+			MethodName: "GetEndBlockerTimings",
+			Handler:    _QueryService_GetEndBlockerTimings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "emissions/v5/query.proto",
@@ -19,22 +19,43 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MsgService_UpdateParams_FullMethodName              = "/emissions.v5.MsgService/UpdateParams"
-	MsgService_CreateNewTopic_FullMethodName            = "/emissions.v5.MsgService/CreateNewTopic"
-	MsgService_Register_FullMethodName                  = "/emissions.v5.MsgService/Register"
-	MsgService_RemoveRegistration_FullMethodName        = "/emissions.v5.MsgService/RemoveRegistration"
-	MsgService_AddStake_FullMethodName                  = "/emissions.v5.MsgService/AddStake"
-	MsgService_RemoveStake_FullMethodName               = "/emissions.v5.MsgService/RemoveStake"
-	MsgService_CancelRemoveStake_FullMethodName         = "/emissions.v5.MsgService/CancelRemoveStake"
-	MsgService_DelegateStake_FullMethodName             = "/emissions.v5.MsgService/DelegateStake"
-	MsgService_RewardDelegateStake_FullMethodName       = "/emissions.v5.MsgService/RewardDelegateStake"
-	MsgService_RemoveDelegateStake_FullMethodName       = "/emissions.v5.MsgService/RemoveDelegateStake"
-	MsgService_CancelRemoveDelegateStake_FullMethodName = "/emissions.v5.MsgService/CancelRemoveDelegateStake"
-	MsgService_FundTopic_FullMethodName                 = "/emissions.v5.MsgService/FundTopic"
-	MsgService_AddToWhitelistAdmin_FullMethodName       = "/emissions.v5.MsgService/AddToWhitelistAdmin"
-	MsgService_RemoveFromWhitelistAdmin_FullMethodName  = "/emissions.v5.MsgService/RemoveFromWhitelistAdmin"
-	MsgService_InsertWorkerPayload_FullMethodName       = "/emissions.v5.MsgService/InsertWorkerPayload"
-	MsgService_InsertReputerPayload_FullMethodName      = "/emissions.v5.MsgService/InsertReputerPayload"
+	MsgService_UpdateParams_FullMethodName                 = "/emissions.v5.MsgService/UpdateParams"
+	MsgService_CreateNewTopic_FullMethodName               = "/emissions.v5.MsgService/CreateNewTopic"
+	MsgService_UpdateTopic_FullMethodName                  = "/emissions.v5.MsgService/UpdateTopic"
+	MsgService_RetireTopic_FullMethodName                  = "/emissions.v5.MsgService/RetireTopic"
+	MsgService_Register_FullMethodName                     = "/emissions.v5.MsgService/Register"
+	MsgService_RemoveRegistration_FullMethodName           = "/emissions.v5.MsgService/RemoveRegistration"
+	MsgService_AddStake_FullMethodName                     = "/emissions.v5.MsgService/AddStake"
+	MsgService_RemoveStake_FullMethodName                  = "/emissions.v5.MsgService/RemoveStake"
+	MsgService_CancelRemoveStake_FullMethodName            = "/emissions.v5.MsgService/CancelRemoveStake"
+	MsgService_DelegateStake_FullMethodName                = "/emissions.v5.MsgService/DelegateStake"
+	MsgService_RewardDelegateStake_FullMethodName          = "/emissions.v5.MsgService/RewardDelegateStake"
+	MsgService_SetDelegateStakeAutoCompound_FullMethodName = "/emissions.v5.MsgService/SetDelegateStakeAutoCompound"
+	MsgService_RedelegateStake_FullMethodName              = "/emissions.v5.MsgService/RedelegateStake"
+	MsgService_ClaimRewards_FullMethodName                 = "/emissions.v5.MsgService/ClaimRewards"
+	MsgService_RemoveDelegateStake_FullMethodName          = "/emissions.v5.MsgService/RemoveDelegateStake"
+	MsgService_CancelRemoveDelegateStake_FullMethodName    = "/emissions.v5.MsgService/CancelRemoveDelegateStake"
+	MsgService_FundTopic_FullMethodName                    = "/emissions.v5.MsgService/FundTopic"
+	MsgService_AddToWhitelistAdmin_FullMethodName          = "/emissions.v5.MsgService/AddToWhitelistAdmin"
+	MsgService_RemoveFromWhitelistAdmin_FullMethodName     = "/emissions.v5.MsgService/RemoveFromWhitelistAdmin"
+	MsgService_AddGmpAllowedSource_FullMethodName          = "/emissions.v5.MsgService/AddGmpAllowedSource"
+	MsgService_RemoveGmpAllowedSource_FullMethodName       = "/emissions.v5.MsgService/RemoveGmpAllowedSource"
+	MsgService_CreateGmpSubscription_FullMethodName        = "/emissions.v5.MsgService/CreateGmpSubscription"
+	MsgService_FundGmpSubscription_FullMethodName          = "/emissions.v5.MsgService/FundGmpSubscription"
+	MsgService_CancelGmpSubscription_FullMethodName        = "/emissions.v5.MsgService/CancelGmpSubscription"
+	MsgService_FundTopicSponsorship_FullMethodName         = "/emissions.v5.MsgService/FundTopicSponsorship"
+	MsgService_WithdrawTopicSponsorship_FullMethodName     = "/emissions.v5.MsgService/WithdrawTopicSponsorship"
+	MsgService_SetTopicAllowlistEnabled_FullMethodName     = "/emissions.v5.MsgService/SetTopicAllowlistEnabled"
+	MsgService_AddToTopicAllowlist_FullMethodName          = "/emissions.v5.MsgService/AddToTopicAllowlist"
+	MsgService_RemoveFromTopicAllowlist_FullMethodName     = "/emissions.v5.MsgService/RemoveFromTopicAllowlist"
+	MsgService_UnjailReputer_FullMethodName                = "/emissions.v5.MsgService/UnjailReputer"
+	MsgService_InsertWorkerPayload_FullMethodName          = "/emissions.v5.MsgService/InsertWorkerPayload"
+	MsgService_CommitWorkerPayload_FullMethodName          = "/emissions.v5.MsgService/CommitWorkerPayload"
+	MsgService_RevealWorkerPayload_FullMethodName          = "/emissions.v5.MsgService/RevealWorkerPayload"
+	MsgService_InsertReputerPayload_FullMethodName         = "/emissions.v5.MsgService/InsertReputerPayload"
+	MsgService_InsertWorkerPayloads_FullMethodName         = "/emissions.v5.MsgService/InsertWorkerPayloads"
+	MsgService_InsertReputerPayloads_FullMethodName        = "/emissions.v5.MsgService/InsertReputerPayloads"
+	MsgService_SubmitGroundTruth_FullMethodName            = "/emissions.v5.MsgService/SubmitGroundTruth"
 )
 
 // MsgServiceClient is the client API for MsgService service.
@@ -45,6 +66,8 @@ const (
 type MsgServiceClient interface {
 	UpdateParams(ctx context.Context, in *UpdateParamsRequest, opts ...grpc.CallOption) (*UpdateParamsResponse, error)
 	CreateNewTopic(ctx context.Context, in *CreateNewTopicRequest, opts ...grpc.CallOption) (*CreateNewTopicResponse, error)
+	UpdateTopic(ctx context.Context, in *UpdateTopicRequest, opts ...grpc.CallOption) (*UpdateTopicResponse, error)
+	RetireTopic(ctx context.Context, in *RetireTopicRequest, opts ...grpc.CallOption) (*RetireTopicResponse, error)
 	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
 	RemoveRegistration(ctx context.Context, in *RemoveRegistrationRequest, opts ...grpc.CallOption) (*RemoveRegistrationResponse, error)
 	AddStake(ctx context.Context, in *AddStakeRequest, opts ...grpc.CallOption) (*AddStakeResponse, error)
@@ -52,13 +75,32 @@ type MsgServiceClient interface {
 	CancelRemoveStake(ctx context.Context, in *CancelRemoveStakeRequest, opts ...grpc.CallOption) (*CancelRemoveStakeResponse, error)
 	DelegateStake(ctx context.Context, in *DelegateStakeRequest, opts ...grpc.CallOption) (*DelegateStakeResponse, error)
 	RewardDelegateStake(ctx context.Context, in *RewardDelegateStakeRequest, opts ...grpc.CallOption) (*RewardDelegateStakeResponse, error)
+	SetDelegateStakeAutoCompound(ctx context.Context, in *SetDelegateStakeAutoCompoundRequest, opts ...grpc.CallOption) (*SetDelegateStakeAutoCompoundResponse, error)
+	RedelegateStake(ctx context.Context, in *RedelegateStakeRequest, opts ...grpc.CallOption) (*RedelegateStakeResponse, error)
+	ClaimRewards(ctx context.Context, in *ClaimRewardsRequest, opts ...grpc.CallOption) (*ClaimRewardsResponse, error)
 	RemoveDelegateStake(ctx context.Context, in *RemoveDelegateStakeRequest, opts ...grpc.CallOption) (*RemoveDelegateStakeResponse, error)
 	CancelRemoveDelegateStake(ctx context.Context, in *CancelRemoveDelegateStakeRequest, opts ...grpc.CallOption) (*CancelRemoveDelegateStakeResponse, error)
 	FundTopic(ctx context.Context, in *FundTopicRequest, opts ...grpc.CallOption) (*FundTopicResponse, error)
 	AddToWhitelistAdmin(ctx context.Context, in *AddToWhitelistAdminRequest, opts ...grpc.CallOption) (*AddToWhitelistAdminResponse, error)
 	RemoveFromWhitelistAdmin(ctx context.Context, in *RemoveFromWhitelistAdminRequest, opts ...grpc.CallOption) (*RemoveFromWhitelistAdminResponse, error)
+	AddGmpAllowedSource(ctx context.Context, in *AddGmpAllowedSourceRequest, opts ...grpc.CallOption) (*AddGmpAllowedSourceResponse, error)
+	RemoveGmpAllowedSource(ctx context.Context, in *RemoveGmpAllowedSourceRequest, opts ...grpc.CallOption) (*RemoveGmpAllowedSourceResponse, error)
+	CreateGmpSubscription(ctx context.Context, in *CreateGmpSubscriptionRequest, opts ...grpc.CallOption) (*CreateGmpSubscriptionResponse, error)
+	FundGmpSubscription(ctx context.Context, in *FundGmpSubscriptionRequest, opts ...grpc.CallOption) (*FundGmpSubscriptionResponse, error)
+	CancelGmpSubscription(ctx context.Context, in *CancelGmpSubscriptionRequest, opts ...grpc.CallOption) (*CancelGmpSubscriptionResponse, error)
+	FundTopicSponsorship(ctx context.Context, in *FundTopicSponsorshipRequest, opts ...grpc.CallOption) (*FundTopicSponsorshipResponse, error)
+	WithdrawTopicSponsorship(ctx context.Context, in *WithdrawTopicSponsorshipRequest, opts ...grpc.CallOption) (*WithdrawTopicSponsorshipResponse, error)
+	SetTopicAllowlistEnabled(ctx context.Context, in *SetTopicAllowlistEnabledRequest, opts ...grpc.CallOption) (*SetTopicAllowlistEnabledResponse, error)
+	AddToTopicAllowlist(ctx context.Context, in *AddToTopicAllowlistRequest, opts ...grpc.CallOption) (*AddToTopicAllowlistResponse, error)
+	RemoveFromTopicAllowlist(ctx context.Context, in *RemoveFromTopicAllowlistRequest, opts ...grpc.CallOption) (*RemoveFromTopicAllowlistResponse, error)
+	UnjailReputer(ctx context.Context, in *UnjailReputerRequest, opts ...grpc.CallOption) (*UnjailReputerResponse, error)
 	InsertWorkerPayload(ctx context.Context, in *InsertWorkerPayloadRequest, opts ...grpc.CallOption) (*InsertWorkerPayloadResponse, error)
+	CommitWorkerPayload(ctx context.Context, in *CommitWorkerPayloadRequest, opts ...grpc.CallOption) (*CommitWorkerPayloadResponse, error)
+	RevealWorkerPayload(ctx context.Context, in *RevealWorkerPayloadRequest, opts ...grpc.CallOption) (*RevealWorkerPayloadResponse, error)
 	InsertReputerPayload(ctx context.Context, in *InsertReputerPayloadRequest, opts ...grpc.CallOption) (*InsertReputerPayloadResponse, error)
+	InsertWorkerPayloads(ctx context.Context, in *InsertWorkerPayloadsRequest, opts ...grpc.CallOption) (*InsertWorkerPayloadsResponse, error)
+	InsertReputerPayloads(ctx context.Context, in *InsertReputerPayloadsRequest, opts ...grpc.CallOption) (*InsertReputerPayloadsResponse, error)
+	SubmitGroundTruth(ctx context.Context, in *SubmitGroundTruthRequest, opts ...grpc.CallOption) (*SubmitGroundTruthResponse, error)
 }
 
 type msgServiceClient struct {
@@ -89,6 +131,26 @@ func (c *msgServiceClient) CreateNewTopic(ctx context.Context, in *CreateNewTopi
 	return out, nil
 }
 
+func (c *msgServiceClient) UpdateTopic(ctx context.Context, in *UpdateTopicRequest, opts ...grpc.CallOption) (*UpdateTopicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTopicResponse)
+	err := c.cc.Invoke(ctx, MsgService_UpdateTopic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) RetireTopic(ctx context.Context, in *RetireTopicRequest, opts ...grpc.CallOption) (*RetireTopicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetireTopicResponse)
+	err := c.cc.Invoke(ctx, MsgService_RetireTopic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgServiceClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RegisterResponse)
@@ -159,6 +221,36 @@ func (c *msgServiceClient) RewardDelegateStake(ctx context.Context, in *RewardDe
 	return out, nil
 }
 
+func (c *msgServiceClient) SetDelegateStakeAutoCompound(ctx context.Context, in *SetDelegateStakeAutoCompoundRequest, opts ...grpc.CallOption) (*SetDelegateStakeAutoCompoundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDelegateStakeAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, MsgService_SetDelegateStakeAutoCompound_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) RedelegateStake(ctx context.Context, in *RedelegateStakeRequest, opts ...grpc.CallOption) (*RedelegateStakeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedelegateStakeResponse)
+	err := c.cc.Invoke(ctx, MsgService_RedelegateStake_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) ClaimRewards(ctx context.Context, in *ClaimRewardsRequest, opts ...grpc.CallOption) (*ClaimRewardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, MsgService_ClaimRewards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgServiceClient) RemoveDelegateStake(ctx context.Context, in *RemoveDelegateStakeRequest, opts ...grpc.CallOption) (*RemoveDelegateStakeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RemoveDelegateStakeResponse)
@@ -209,6 +301,116 @@ func (c *msgServiceClient) RemoveFromWhitelistAdmin(ctx context.Context, in *Rem
 	return out, nil
 }
 
+func (c *msgServiceClient) AddGmpAllowedSource(ctx context.Context, in *AddGmpAllowedSourceRequest, opts ...grpc.CallOption) (*AddGmpAllowedSourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddGmpAllowedSourceResponse)
+	err := c.cc.Invoke(ctx, MsgService_AddGmpAllowedSource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) RemoveGmpAllowedSource(ctx context.Context, in *RemoveGmpAllowedSourceRequest, opts ...grpc.CallOption) (*RemoveGmpAllowedSourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveGmpAllowedSourceResponse)
+	err := c.cc.Invoke(ctx, MsgService_RemoveGmpAllowedSource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) CreateGmpSubscription(ctx context.Context, in *CreateGmpSubscriptionRequest, opts ...grpc.CallOption) (*CreateGmpSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGmpSubscriptionResponse)
+	err := c.cc.Invoke(ctx, MsgService_CreateGmpSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) FundGmpSubscription(ctx context.Context, in *FundGmpSubscriptionRequest, opts ...grpc.CallOption) (*FundGmpSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FundGmpSubscriptionResponse)
+	err := c.cc.Invoke(ctx, MsgService_FundGmpSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) CancelGmpSubscription(ctx context.Context, in *CancelGmpSubscriptionRequest, opts ...grpc.CallOption) (*CancelGmpSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelGmpSubscriptionResponse)
+	err := c.cc.Invoke(ctx, MsgService_CancelGmpSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) FundTopicSponsorship(ctx context.Context, in *FundTopicSponsorshipRequest, opts ...grpc.CallOption) (*FundTopicSponsorshipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FundTopicSponsorshipResponse)
+	err := c.cc.Invoke(ctx, MsgService_FundTopicSponsorship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) WithdrawTopicSponsorship(ctx context.Context, in *WithdrawTopicSponsorshipRequest, opts ...grpc.CallOption) (*WithdrawTopicSponsorshipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WithdrawTopicSponsorshipResponse)
+	err := c.cc.Invoke(ctx, MsgService_WithdrawTopicSponsorship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) SetTopicAllowlistEnabled(ctx context.Context, in *SetTopicAllowlistEnabledRequest, opts ...grpc.CallOption) (*SetTopicAllowlistEnabledResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTopicAllowlistEnabledResponse)
+	err := c.cc.Invoke(ctx, MsgService_SetTopicAllowlistEnabled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) AddToTopicAllowlist(ctx context.Context, in *AddToTopicAllowlistRequest, opts ...grpc.CallOption) (*AddToTopicAllowlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddToTopicAllowlistResponse)
+	err := c.cc.Invoke(ctx, MsgService_AddToTopicAllowlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) RemoveFromTopicAllowlist(ctx context.Context, in *RemoveFromTopicAllowlistRequest, opts ...grpc.CallOption) (*RemoveFromTopicAllowlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFromTopicAllowlistResponse)
+	err := c.cc.Invoke(ctx, MsgService_RemoveFromTopicAllowlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) UnjailReputer(ctx context.Context, in *UnjailReputerRequest, opts ...grpc.CallOption) (*UnjailReputerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnjailReputerResponse)
+	err := c.cc.Invoke(ctx, MsgService_UnjailReputer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgServiceClient) InsertWorkerPayload(ctx context.Context, in *InsertWorkerPayloadRequest, opts ...grpc.CallOption) (*InsertWorkerPayloadResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(InsertWorkerPayloadResponse)
@@ -219,6 +421,26 @@ func (c *msgServiceClient) InsertWorkerPayload(ctx context.Context, in *InsertWo
 	return out, nil
 }
 
+func (c *msgServiceClient) CommitWorkerPayload(ctx context.Context, in *CommitWorkerPayloadRequest, opts ...grpc.CallOption) (*CommitWorkerPayloadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommitWorkerPayloadResponse)
+	err := c.cc.Invoke(ctx, MsgService_CommitWorkerPayload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) RevealWorkerPayload(ctx context.Context, in *RevealWorkerPayloadRequest, opts ...grpc.CallOption) (*RevealWorkerPayloadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevealWorkerPayloadResponse)
+	err := c.cc.Invoke(ctx, MsgService_RevealWorkerPayload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgServiceClient) InsertReputerPayload(ctx context.Context, in *InsertReputerPayloadRequest, opts ...grpc.CallOption) (*InsertReputerPayloadResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(InsertReputerPayloadResponse)
@@ -229,6 +451,36 @@ func (c *msgServiceClient) InsertReputerPayload(ctx context.Context, in *InsertR
 	return out, nil
 }
 
+func (c *msgServiceClient) InsertWorkerPayloads(ctx context.Context, in *InsertWorkerPayloadsRequest, opts ...grpc.CallOption) (*InsertWorkerPayloadsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InsertWorkerPayloadsResponse)
+	err := c.cc.Invoke(ctx, MsgService_InsertWorkerPayloads_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) InsertReputerPayloads(ctx context.Context, in *InsertReputerPayloadsRequest, opts ...grpc.CallOption) (*InsertReputerPayloadsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InsertReputerPayloadsResponse)
+	err := c.cc.Invoke(ctx, MsgService_InsertReputerPayloads_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) SubmitGroundTruth(ctx context.Context, in *SubmitGroundTruthRequest, opts ...grpc.CallOption) (*SubmitGroundTruthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitGroundTruthResponse)
+	err := c.cc.Invoke(ctx, MsgService_SubmitGroundTruth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServiceServer is the server API for MsgService service.
 // All implementations must embed UnimplementedMsgServiceServer
 // for forward compatibility.
@@ -237,6 +489,8 @@ func (c *msgServiceClient) InsertReputerPayload(ctx context.Context, in *InsertR
 type MsgServiceServer interface {
 	UpdateParams(context.Context, *UpdateParamsRequest) (*UpdateParamsResponse, error)
 	CreateNewTopic(context.Context, *CreateNewTopicRequest) (*CreateNewTopicResponse, error)
+	UpdateTopic(context.Context, *UpdateTopicRequest) (*UpdateTopicResponse, error)
+	RetireTopic(context.Context, *RetireTopicRequest) (*RetireTopicResponse, error)
 	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
 	RemoveRegistration(context.Context, *RemoveRegistrationRequest) (*RemoveRegistrationResponse, error)
 	AddStake(context.Context, *AddStakeRequest) (*AddStakeResponse, error)
@@ -244,13 +498,32 @@ type MsgServiceServer interface {
 	CancelRemoveStake(context.Context, *CancelRemoveStakeRequest) (*CancelRemoveStakeResponse, error)
 	DelegateStake(context.Context, *DelegateStakeRequest) (*DelegateStakeResponse, error)
 	RewardDelegateStake(context.Context, *RewardDelegateStakeRequest) (*RewardDelegateStakeResponse, error)
+	SetDelegateStakeAutoCompound(context.Context, *SetDelegateStakeAutoCompoundRequest) (*SetDelegateStakeAutoCompoundResponse, error)
+	RedelegateStake(context.Context, *RedelegateStakeRequest) (*RedelegateStakeResponse, error)
+	ClaimRewards(context.Context, *ClaimRewardsRequest) (*ClaimRewardsResponse, error)
 	RemoveDelegateStake(context.Context, *RemoveDelegateStakeRequest) (*RemoveDelegateStakeResponse, error)
 	CancelRemoveDelegateStake(context.Context, *CancelRemoveDelegateStakeRequest) (*CancelRemoveDelegateStakeResponse, error)
 	FundTopic(context.Context, *FundTopicRequest) (*FundTopicResponse, error)
 	AddToWhitelistAdmin(context.Context, *AddToWhitelistAdminRequest) (*AddToWhitelistAdminResponse, error)
 	RemoveFromWhitelistAdmin(context.Context, *RemoveFromWhitelistAdminRequest) (*RemoveFromWhitelistAdminResponse, error)
+	AddGmpAllowedSource(context.Context, *AddGmpAllowedSourceRequest) (*AddGmpAllowedSourceResponse, error)
+	RemoveGmpAllowedSource(context.Context, *RemoveGmpAllowedSourceRequest) (*RemoveGmpAllowedSourceResponse, error)
+	CreateGmpSubscription(context.Context, *CreateGmpSubscriptionRequest) (*CreateGmpSubscriptionResponse, error)
+	FundGmpSubscription(context.Context, *FundGmpSubscriptionRequest) (*FundGmpSubscriptionResponse, error)
+	CancelGmpSubscription(context.Context, *CancelGmpSubscriptionRequest) (*CancelGmpSubscriptionResponse, error)
+	FundTopicSponsorship(context.Context, *FundTopicSponsorshipRequest) (*FundTopicSponsorshipResponse, error)
+	WithdrawTopicSponsorship(context.Context, *WithdrawTopicSponsorshipRequest) (*WithdrawTopicSponsorshipResponse, error)
+	SetTopicAllowlistEnabled(context.Context, *SetTopicAllowlistEnabledRequest) (*SetTopicAllowlistEnabledResponse, error)
+	AddToTopicAllowlist(context.Context, *AddToTopicAllowlistRequest) (*AddToTopicAllowlistResponse, error)
+	RemoveFromTopicAllowlist(context.Context, *RemoveFromTopicAllowlistRequest) (*RemoveFromTopicAllowlistResponse, error)
+	UnjailReputer(context.Context, *UnjailReputerRequest) (*UnjailReputerResponse, error)
 	InsertWorkerPayload(context.Context, *InsertWorkerPayloadRequest) (*InsertWorkerPayloadResponse, error)
+	CommitWorkerPayload(context.Context, *CommitWorkerPayloadRequest) (*CommitWorkerPayloadResponse, error)
+	RevealWorkerPayload(context.Context, *RevealWorkerPayloadRequest) (*RevealWorkerPayloadResponse, error)
 	InsertReputerPayload(context.Context, *InsertReputerPayloadRequest) (*InsertReputerPayloadResponse, error)
+	InsertWorkerPayloads(context.Context, *InsertWorkerPayloadsRequest) (*InsertWorkerPayloadsResponse, error)
+	InsertReputerPayloads(context.Context, *InsertReputerPayloadsRequest) (*InsertReputerPayloadsResponse, error)
+	SubmitGroundTruth(context.Context, *SubmitGroundTruthRequest) (*SubmitGroundTruthResponse, error)
 	mustEmbedUnimplementedMsgServiceServer()
 }
 
@@ -267,6 +540,12 @@ func (UnimplementedMsgServiceServer) UpdateParams(context.Context, *UpdateParams
 func (UnimplementedMsgServiceServer) CreateNewTopic(context.Context, *CreateNewTopicRequest) (*CreateNewTopicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateNewTopic not implemented")
 }
+func (UnimplementedMsgServiceServer) UpdateTopic(context.Context, *UpdateTopicRequest) (*UpdateTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTopic not implemented")
+}
+func (UnimplementedMsgServiceServer) RetireTopic(context.Context, *RetireTopicRequest) (*RetireTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetireTopic not implemented")
+}
 func (UnimplementedMsgServiceServer) Register(context.Context, *RegisterRequest) (*RegisterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
 }
@@ -288,6 +567,15 @@ func (UnimplementedMsgServiceServer) DelegateStake(context.Context, *DelegateSta
 func (UnimplementedMsgServiceServer) RewardDelegateStake(context.Context, *RewardDelegateStakeRequest) (*RewardDelegateStakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RewardDelegateStake not implemented")
 }
+func (UnimplementedMsgServiceServer) SetDelegateStakeAutoCompound(context.Context, *SetDelegateStakeAutoCompoundRequest) (*SetDelegateStakeAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDelegateStakeAutoCompound not implemented")
+}
+func (UnimplementedMsgServiceServer) RedelegateStake(context.Context, *RedelegateStakeRequest) (*RedelegateStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegateStake not implemented")
+}
+func (UnimplementedMsgServiceServer) ClaimRewards(context.Context, *ClaimRewardsRequest) (*ClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+}
 func (UnimplementedMsgServiceServer) RemoveDelegateStake(context.Context, *RemoveDelegateStakeRequest) (*RemoveDelegateStakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveDelegateStake not implemented")
 }
@@ -303,12 +591,60 @@ func (UnimplementedMsgServiceServer) AddToWhitelistAdmin(context.Context, *AddTo
 func (UnimplementedMsgServiceServer) RemoveFromWhitelistAdmin(context.Context, *RemoveFromWhitelistAdminRequest) (*RemoveFromWhitelistAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromWhitelistAdmin not implemented")
 }
+func (UnimplementedMsgServiceServer) AddGmpAllowedSource(context.Context, *AddGmpAllowedSourceRequest) (*AddGmpAllowedSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGmpAllowedSource not implemented")
+}
+func (UnimplementedMsgServiceServer) RemoveGmpAllowedSource(context.Context, *RemoveGmpAllowedSourceRequest) (*RemoveGmpAllowedSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGmpAllowedSource not implemented")
+}
+func (UnimplementedMsgServiceServer) CreateGmpSubscription(context.Context, *CreateGmpSubscriptionRequest) (*CreateGmpSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGmpSubscription not implemented")
+}
+func (UnimplementedMsgServiceServer) FundGmpSubscription(context.Context, *FundGmpSubscriptionRequest) (*FundGmpSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundGmpSubscription not implemented")
+}
+func (UnimplementedMsgServiceServer) CancelGmpSubscription(context.Context, *CancelGmpSubscriptionRequest) (*CancelGmpSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelGmpSubscription not implemented")
+}
+func (UnimplementedMsgServiceServer) FundTopicSponsorship(context.Context, *FundTopicSponsorshipRequest) (*FundTopicSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundTopicSponsorship not implemented")
+}
+func (UnimplementedMsgServiceServer) WithdrawTopicSponsorship(context.Context, *WithdrawTopicSponsorshipRequest) (*WithdrawTopicSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawTopicSponsorship not implemented")
+}
+func (UnimplementedMsgServiceServer) SetTopicAllowlistEnabled(context.Context, *SetTopicAllowlistEnabledRequest) (*SetTopicAllowlistEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTopicAllowlistEnabled not implemented")
+}
+func (UnimplementedMsgServiceServer) AddToTopicAllowlist(context.Context, *AddToTopicAllowlistRequest) (*AddToTopicAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToTopicAllowlist not implemented")
+}
+func (UnimplementedMsgServiceServer) RemoveFromTopicAllowlist(context.Context, *RemoveFromTopicAllowlistRequest) (*RemoveFromTopicAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromTopicAllowlist not implemented")
+}
+func (UnimplementedMsgServiceServer) UnjailReputer(context.Context, *UnjailReputerRequest) (*UnjailReputerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailReputer not implemented")
+}
 func (UnimplementedMsgServiceServer) InsertWorkerPayload(context.Context, *InsertWorkerPayloadRequest) (*InsertWorkerPayloadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsertWorkerPayload not implemented")
 }
+func (UnimplementedMsgServiceServer) CommitWorkerPayload(context.Context, *CommitWorkerPayloadRequest) (*CommitWorkerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitWorkerPayload not implemented")
+}
+func (UnimplementedMsgServiceServer) RevealWorkerPayload(context.Context, *RevealWorkerPayloadRequest) (*RevealWorkerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealWorkerPayload not implemented")
+}
 func (UnimplementedMsgServiceServer) InsertReputerPayload(context.Context, *InsertReputerPayloadRequest) (*InsertReputerPayloadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsertReputerPayload not implemented")
 }
+func (UnimplementedMsgServiceServer) InsertWorkerPayloads(context.Context, *InsertWorkerPayloadsRequest) (*InsertWorkerPayloadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertWorkerPayloads not implemented")
+}
+func (UnimplementedMsgServiceServer) InsertReputerPayloads(context.Context, *InsertReputerPayloadsRequest) (*InsertReputerPayloadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertReputerPayloads not implemented")
+}
+func (UnimplementedMsgServiceServer) SubmitGroundTruth(context.Context, *SubmitGroundTruthRequest) (*SubmitGroundTruthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitGroundTruth not implemented")
+}
 func (UnimplementedMsgServiceServer) mustEmbedUnimplementedMsgServiceServer() {}
 func (UnimplementedMsgServiceServer) testEmbeddedByValue()                    {}
 
@@ -366,6 +702,42 @@ func _MsgService_CreateNewTopic_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_UpdateTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).UpdateTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_UpdateTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).UpdateTopic(ctx, req.(*UpdateTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RetireTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetireTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RetireTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_RetireTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RetireTopic(ctx, req.(*RetireTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MsgService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RegisterRequest)
 	if err := dec(in); err != nil {
@@ -492,6 +864,60 @@ func _MsgService_RewardDelegateStake_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_SetDelegateStakeAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDelegateStakeAutoCompoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).SetDelegateStakeAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_SetDelegateStakeAutoCompound_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).SetDelegateStakeAutoCompound(ctx, req.(*SetDelegateStakeAutoCompoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RedelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedelegateStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RedelegateStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_RedelegateStake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RedelegateStake(ctx, req.(*RedelegateStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).ClaimRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_ClaimRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).ClaimRewards(ctx, req.(*ClaimRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MsgService_RemoveDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RemoveDelegateStakeRequest)
 	if err := dec(in); err != nil {
@@ -582,6 +1008,204 @@ func _MsgService_RemoveFromWhitelistAdmin_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_AddGmpAllowedSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGmpAllowedSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).AddGmpAllowedSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_AddGmpAllowedSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).AddGmpAllowedSource(ctx, req.(*AddGmpAllowedSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RemoveGmpAllowedSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGmpAllowedSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RemoveGmpAllowedSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_RemoveGmpAllowedSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RemoveGmpAllowedSource(ctx, req.(*RemoveGmpAllowedSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_CreateGmpSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGmpSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CreateGmpSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_CreateGmpSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CreateGmpSubscription(ctx, req.(*CreateGmpSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_FundGmpSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundGmpSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).FundGmpSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_FundGmpSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).FundGmpSubscription(ctx, req.(*FundGmpSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_CancelGmpSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelGmpSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CancelGmpSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_CancelGmpSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CancelGmpSubscription(ctx, req.(*CancelGmpSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_FundTopicSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundTopicSponsorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).FundTopicSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_FundTopicSponsorship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).FundTopicSponsorship(ctx, req.(*FundTopicSponsorshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_WithdrawTopicSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawTopicSponsorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).WithdrawTopicSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_WithdrawTopicSponsorship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).WithdrawTopicSponsorship(ctx, req.(*WithdrawTopicSponsorshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_SetTopicAllowlistEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTopicAllowlistEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).SetTopicAllowlistEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_SetTopicAllowlistEnabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).SetTopicAllowlistEnabled(ctx, req.(*SetTopicAllowlistEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_AddToTopicAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToTopicAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).AddToTopicAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_AddToTopicAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).AddToTopicAllowlist(ctx, req.(*AddToTopicAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RemoveFromTopicAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromTopicAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RemoveFromTopicAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_RemoveFromTopicAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RemoveFromTopicAllowlist(ctx, req.(*RemoveFromTopicAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_UnjailReputer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnjailReputerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).UnjailReputer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_UnjailReputer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).UnjailReputer(ctx, req.(*UnjailReputerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MsgService_InsertWorkerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InsertWorkerPayloadRequest)
 	if err := dec(in); err != nil {
@@ -600,6 +1224,42 @@ func _MsgService_InsertWorkerPayload_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_CommitWorkerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitWorkerPayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CommitWorkerPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_CommitWorkerPayload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CommitWorkerPayload(ctx, req.(*CommitWorkerPayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RevealWorkerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevealWorkerPayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RevealWorkerPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_RevealWorkerPayload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RevealWorkerPayload(ctx, req.(*RevealWorkerPayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MsgService_InsertReputerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InsertReputerPayloadRequest)
 	if err := dec(in); err != nil {
@@ -618,6 +1278,60 @@ func _MsgService_InsertReputerPayload_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_InsertWorkerPayloads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertWorkerPayloadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).InsertWorkerPayloads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_InsertWorkerPayloads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).InsertWorkerPayloads(ctx, req.(*InsertWorkerPayloadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_InsertReputerPayloads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertReputerPayloadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).InsertReputerPayloads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_InsertReputerPayloads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).InsertReputerPayloads(ctx, req.(*InsertReputerPayloadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_SubmitGroundTruth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitGroundTruthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).SubmitGroundTruth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_SubmitGroundTruth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).SubmitGroundTruth(ctx, req.(*SubmitGroundTruthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MsgService_ServiceDesc is the grpc.ServiceDesc for MsgService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -633,6 +1347,14 @@ var MsgService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateNewTopic",
 			Handler:    _MsgService_CreateNewTopic_Handler,
 		},
+		{
+			MethodName: "UpdateTopic",
+			Handler:    _MsgService_UpdateTopic_Handler,
+		},
+		{
+			MethodName: "RetireTopic",
+			Handler:    _MsgService_RetireTopic_Handler,
+		},
 		{
 			MethodName: "Register",
 			Handler:    _MsgService_Register_Handler,
@@ -661,6 +1383,18 @@ var MsgService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RewardDelegateStake",
 			Handler:    _MsgService_RewardDelegateStake_Handler,
 		},
+		{
+			MethodName: "SetDelegateStakeAutoCompound",
+			Handler:    _MsgService_SetDelegateStakeAutoCompound_Handler,
+		},
+		{
+			MethodName: "RedelegateStake",
+			Handler:    _MsgService_RedelegateStake_Handler,
+		},
+		{
+			MethodName: "ClaimRewards",
+			Handler:    _MsgService_ClaimRewards_Handler,
+		},
 		{
 			MethodName: "RemoveDelegateStake",
 			Handler:    _MsgService_RemoveDelegateStake_Handler,
@@ -681,14 +1415,78 @@ var MsgService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveFromWhitelistAdmin",
 			Handler:    _MsgService_RemoveFromWhitelistAdmin_Handler,
 		},
+		{
+			MethodName: "AddGmpAllowedSource",
+			Handler:    _MsgService_AddGmpAllowedSource_Handler,
+		},
+		{
+			MethodName: "RemoveGmpAllowedSource",
+			Handler:    _MsgService_RemoveGmpAllowedSource_Handler,
+		},
+		{
+			MethodName: "CreateGmpSubscription",
+			Handler:    _MsgService_CreateGmpSubscription_Handler,
+		},
+		{
+			MethodName: "FundGmpSubscription",
+			Handler:    _MsgService_FundGmpSubscription_Handler,
+		},
+		{
+			MethodName: "CancelGmpSubscription",
+			Handler:    _MsgService_CancelGmpSubscription_Handler,
+		},
+		{
+			MethodName: "FundTopicSponsorship",
+			Handler:    _MsgService_FundTopicSponsorship_Handler,
+		},
+		{
+			MethodName: "WithdrawTopicSponsorship",
+			Handler:    _MsgService_WithdrawTopicSponsorship_Handler,
+		},
+		{
+			MethodName: "SetTopicAllowlistEnabled",
+			Handler:    _MsgService_SetTopicAllowlistEnabled_Handler,
+		},
+		{
+			MethodName: "AddToTopicAllowlist",
+			Handler:    _MsgService_AddToTopicAllowlist_Handler,
+		},
+		{
+			MethodName: "RemoveFromTopicAllowlist",
+			Handler:    _MsgService_RemoveFromTopicAllowlist_Handler,
+		},
+		{
+			MethodName: "UnjailReputer",
+			Handler:    _MsgService_UnjailReputer_Handler,
+		},
 		{
 			MethodName: "InsertWorkerPayload",
 			Handler:    _MsgService_InsertWorkerPayload_Handler,
 		},
+		{
+			MethodName: "CommitWorkerPayload",
+			Handler:    _MsgService_CommitWorkerPayload_Handler,
+		},
+		{
+			MethodName: "RevealWorkerPayload",
+			Handler:    _MsgService_RevealWorkerPayload_Handler,
+		},
 		{
 			MethodName: "InsertReputerPayload",
 			Handler:    _MsgService_InsertReputerPayload_Handler,
 		},
+		{
+			MethodName: "InsertWorkerPayloads",
+			Handler:    _MsgService_InsertWorkerPayloads_Handler,
+		},
+		{
+			MethodName: "InsertReputerPayloads",
+			Handler:    _MsgService_InsertReputerPayloads_Handler,
+		},
+		{
+			MethodName: "SubmitGroundTruth",
+			Handler:    _MsgService_SubmitGroundTruth_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "emissions/v5/tx.proto",
@@ -9,8 +9,10 @@ import (
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
 	keeper "github.com/allora-network/allora-chain/x/emissions/keeper"
 	synth "github.com/allora-network/allora-chain/x/emissions/keeper/inference_synthesis"
+	"github.com/allora-network/allora-chain/x/emissions/metrics"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -69,6 +71,13 @@ func CloseReputerNonce(
 		return err
 	}
 
+	// With a ground truth submitted for the nonce, the chain recomputes the losses of the inferers and of the
+	// network inferences itself, and disregards reputers whose reported losses deviate from them
+	recomputedLosses, err := GetRecomputedLosses(k, ctx, topic, nonce)
+	if err != nil {
+		return err
+	}
+
 	lossBundlesByReputer := make([]*types.ReputerValueBundle, 0)
 	stakesByReputer := make(map[string]cosmosMath.Int)
 	for _, address := range activeReputerAddresses {
@@ -85,6 +94,11 @@ func CloseReputerNonce(
 		if stake.LT(params.RequiredMinimumStake) {
 			continue
 		}
+		// Losses of reputers jailed since they submitted them are disregarded
+		jailed, err := k.IsReputerJailed(ctx, topic.Id, bundle.ValueBundle.Reputer)
+		if err != nil || jailed {
+			continue
+		}
 
 		// Examine forecast elements to verify that they're for registered inferers in the current set.
 		// A check of their registration and other filters have already been applied when their inferences were inserted.
@@ -95,6 +109,31 @@ func CloseReputerNonce(
 			continue
 		}
 
+		if recomputedLosses != nil {
+			deviation, err := FindLossDeviatingFromRecomputed(
+				filteredBundle,
+				recomputedLosses,
+				params.MaxReputerLossDeviation,
+				params.EpsilonSafeDiv,
+			)
+			if err != nil {
+				continue
+			}
+			if deviation != nil {
+				types.EmitNewReputerLossesRejectedEvent(
+					ctx,
+					topic.Id,
+					nonce.BlockHeight,
+					filteredBundle.ValueBundle.Reputer,
+					deviation.LossType,
+					deviation.Worker,
+					deviation.ReportedLoss,
+					deviation.RecomputedLoss,
+				)
+				continue
+			}
+		}
+
 		/// Filtering done now, now write what we must for inclusion
 		lossBundlesByReputer = append(lossBundlesByReputer, filteredBundle)
 		stakesByReputer[bundle.ValueBundle.Reputer] = stake
@@ -112,6 +151,7 @@ func CloseReputerNonce(
 	if err != nil {
 		return err
 	}
+	metrics.IncrProcessedCount(metrics.PROCESSED_REPUTER_BUNDLES, topic.Id, len(lossBundlesByReputer))
 
 	networkLossBundle, err := synth.CalcNetworkLosses(topic.Id, nonce.BlockHeight, stakesByReputer, bundles)
 	if err != nil {
@@ -175,6 +215,209 @@ func CloseReputerNonce(
 	return nil
 }
 
+// Losses of a nonce recomputed by the chain against the ground truth submitted for it
+type RecomputedLosses struct {
+	InfererLosses map[string]alloraMath.Dec
+	// Losses of the network inferences, nil if they could not be recomputed
+	CombinedLoss     *alloraMath.Dec
+	NaiveLoss        *alloraMath.Dec
+	ForecasterLosses map[string]alloraMath.Dec
+}
+
+// Returns the losses of the inferers and of the network inferences of the nonce recomputed against the
+// ground truth submitted for it, or nil if there is none. A ground truth the loss function rejects disables
+// the cross-check for the nonce rather than preventing the nonce from closing, and network inferences that
+// cannot be recomputed only disable the cross-check of the network losses.
+func GetRecomputedLosses(
+	k *keeper.Keeper,
+	ctx sdk.Context,
+	topic types.Topic,
+	nonce types.Nonce,
+) (*RecomputedLosses, error) {
+	groundTruth, found, err := k.GetGroundTruth(ctx, topic.Id, nonce.BlockHeight)
+	if err != nil {
+		return nil, err
+	} else if !found {
+		return nil, nil
+	}
+	infererLosses, err := k.CalcInfererLossesFromGroundTruth(ctx, topic, nonce.BlockHeight, groundTruth)
+	if err != nil {
+		ctx.Logger().Warn(fmt.Sprintf("Skipping reputer loss cross-check for topic: %d, nonce: %d: %s", topic.Id, nonce.BlockHeight, err.Error()))
+		return nil, nil
+	}
+	recomputed := &RecomputedLosses{
+		InfererLosses:    infererLosses,
+		CombinedLoss:     nil,
+		NaiveLoss:        nil,
+		ForecasterLosses: nil,
+	}
+
+	// Network inferences are synthesized as reputers query them, from the inferences and forecasts of the nonce
+	networkInferences, err := synth.GetNetworkInferences(ctx, *k, topic.Id, &nonce.BlockHeight)
+	if err == nil {
+		err = setRecomputedNetworkLosses(k, topic, networkInferences.NetworkInferences, groundTruth, recomputed)
+	}
+	if err != nil {
+		ctx.Logger().Warn(fmt.Sprintf("Skipping network loss cross-check for topic: %d, nonce: %d: %s", topic.Id, nonce.BlockHeight, err.Error()))
+	}
+	return recomputed, nil
+}
+
+// Sets the losses of the combined, naive and forecast-implied network inferences against the ground truth,
+// leaving them unset on error
+func setRecomputedNetworkLosses(
+	k *keeper.Keeper,
+	topic types.Topic,
+	networkInferences *types.ValueBundle,
+	groundTruth alloraMath.Dec,
+	recomputed *RecomputedLosses,
+) error {
+	lossFunction, ok := k.GetLossFunction(topic.LossMethod)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrUnknownLossMethod, "topic %d loss method %s", topic.Id, topic.LossMethod)
+	}
+	combinedLoss, err := lossFunction.Loss(networkInferences.CombinedValue, groundTruth)
+	if err != nil {
+		return errorsmod.Wrap(err, "error computing combined loss")
+	}
+	naiveLoss, err := lossFunction.Loss(networkInferences.NaiveValue, groundTruth)
+	if err != nil {
+		return errorsmod.Wrap(err, "error computing naive loss")
+	}
+	forecasterLosses := make(map[string]alloraMath.Dec, len(networkInferences.ForecasterValues))
+	for _, forecasterValue := range networkInferences.ForecasterValues {
+		loss, err := lossFunction.Loss(forecasterValue.Value, groundTruth)
+		if err != nil {
+			return errorsmod.Wrapf(err, "error computing loss of forecaster %s", forecasterValue.Worker)
+		}
+		forecasterLosses[forecasterValue.Worker] = loss
+	}
+	recomputed.CombinedLoss = &combinedLoss
+	recomputed.NaiveLoss = &naiveLoss
+	recomputed.ForecasterLosses = forecasterLosses
+	return nil
+}
+
+// A reported loss that deviates from the loss recomputed by the chain
+type LossDeviation struct {
+	LossType types.ReputerLossType
+	// Empty for the network losses
+	Worker         string
+	ReportedLoss   alloraMath.Dec
+	RecomputedLoss alloraMath.Dec
+}
+
+// Returns the first loss of the bundle whose deviation from the recomputed loss, relative to the recomputed
+// loss or epsilon if larger, exceeds the maximum deviation. The combined and naive losses are checked first,
+// then the inferer and forecaster losses. Returns nil if there is none.
+func FindLossDeviatingFromRecomputed(
+	reputerValueBundle *types.ReputerValueBundle,
+	recomputed *RecomputedLosses,
+	maxDeviation alloraMath.Dec,
+	epsilon alloraMath.Dec,
+) (*LossDeviation, error) {
+	valueBundle := reputerValueBundle.ValueBundle
+	networkLosses := []struct {
+		lossType   types.ReputerLossType
+		reported   alloraMath.Dec
+		recomputed *alloraMath.Dec
+	}{
+		{types.ReputerLossType_REPUTER_LOSS_TYPE_COMBINED, valueBundle.CombinedValue, recomputed.CombinedLoss},
+		{types.ReputerLossType_REPUTER_LOSS_TYPE_NAIVE, valueBundle.NaiveValue, recomputed.NaiveLoss},
+	}
+	for _, networkLoss := range networkLosses {
+		if networkLoss.recomputed == nil {
+			continue
+		}
+		deviates, err := lossDeviates(networkLoss.reported, *networkLoss.recomputed, maxDeviation, epsilon)
+		if err != nil {
+			return nil, err
+		}
+		if deviates {
+			return &LossDeviation{
+				LossType:       networkLoss.lossType,
+				Worker:         "",
+				ReportedLoss:   networkLoss.reported,
+				RecomputedLoss: *networkLoss.recomputed,
+			}, nil
+		}
+	}
+
+	deviation, err := findWorkerLossDeviatingFromRecomputed(
+		types.ReputerLossType_REPUTER_LOSS_TYPE_INFERER,
+		valueBundle.InfererValues,
+		recomputed.InfererLosses,
+		maxDeviation,
+		epsilon,
+	)
+	if err != nil || deviation != nil {
+		return deviation, err
+	}
+	return findWorkerLossDeviatingFromRecomputed(
+		types.ReputerLossType_REPUTER_LOSS_TYPE_FORECASTER,
+		valueBundle.ForecasterValues,
+		recomputed.ForecasterLosses,
+		maxDeviation,
+		epsilon,
+	)
+}
+
+// Returns the first worker loss deviating from the loss recomputed for the worker, skipping workers
+// without a recomputed loss
+func findWorkerLossDeviatingFromRecomputed(
+	lossType types.ReputerLossType,
+	workerValues []*types.WorkerAttributedValue,
+	recomputedLosses map[string]alloraMath.Dec,
+	maxDeviation alloraMath.Dec,
+	epsilon alloraMath.Dec,
+) (*LossDeviation, error) {
+	for _, workerValue := range workerValues {
+		recomputed, ok := recomputedLosses[workerValue.Worker]
+		if !ok {
+			continue
+		}
+		deviates, err := lossDeviates(workerValue.Value, recomputed, maxDeviation, epsilon)
+		if err != nil {
+			return nil, err
+		}
+		if deviates {
+			return &LossDeviation{
+				LossType:       lossType,
+				Worker:         workerValue.Worker,
+				ReportedLoss:   workerValue.Value,
+				RecomputedLoss: recomputed,
+			}, nil
+		}
+	}
+	return nil, nil
+}
+
+// Whether the reported loss deviates from the recomputed loss by more than the maximum deviation,
+// relative to the recomputed loss or epsilon if larger
+func lossDeviates(reported, recomputed, maxDeviation, epsilon alloraMath.Dec) (bool, error) {
+	diff, err := reported.Sub(recomputed)
+	if err != nil {
+		return false, err
+	}
+	absDiff, err := diff.Abs()
+	if err != nil {
+		return false, err
+	}
+	scale, err := recomputed.Abs()
+	if err != nil {
+		return false, err
+	}
+	scale, err = alloraMath.Max(scale, epsilon)
+	if err != nil {
+		return false, err
+	}
+	tolerance, err := scale.Mul(maxDeviation)
+	if err != nil {
+		return false, err
+	}
+	return absDiff.Gt(tolerance), nil
+}
+
 // Filter out values of unaccepted workers.
 // It is assumed that the work of inferers and forecasters stored at the nonce is already filtered for acceptance.
 // This also removes duplicate values of the same worker.
@@ -104,7 +104,7 @@ func (a *ActorUtilsTestSuite) SetupTest() {
 		authtypes.FeeCollectorName,
 	)
 	a.key = key
-	appModule := module.NewAppModule(encCfg.Codec, a.emissionsKeeper)
+	appModule := module.NewAppModule(encCfg.Codec, a.emissionsKeeper, accountKeeper, bankKeeper)
 	defaultGenesis := appModule.DefaultGenesis(encCfg.Codec)
 	appModule.InitGenesis(ctx, encCfg.Codec, defaultGenesis)
 	a.appModule = appModule
@@ -293,3 +293,94 @@ func (a *ActorUtilsTestSuite) TestFilterUnacceptedWorkersFromReputerValueBundle(
 	a.Require().Len(acceptedBundle.ValueBundle.OneOutInfererForecasterValues[0].OneOutInfererValues, 1)
 	a.Require().Len(acceptedBundle.ValueBundle.OneInForecasterValues, 1)
 }
+
+func (a *ActorUtilsTestSuite) TestFindLossDeviatingFromRecomputed() {
+	require := a.Require()
+	maxDeviation := alloraMath.MustNewDecFromString("0.1")
+	epsilon := alloraMath.MustNewDecFromString("0.0001")
+	combinedLoss := alloraMath.NewDecFromInt64(10)
+	naiveLoss := alloraMath.NewDecFromInt64(20)
+	recomputed := &actorutils.RecomputedLosses{
+		InfererLosses:    map[string]alloraMath.Dec{"inferer": alloraMath.NewDecFromInt64(30)},
+		CombinedLoss:     &combinedLoss,
+		NaiveLoss:        &naiveLoss,
+		ForecasterLosses: map[string]alloraMath.Dec{"forecaster": alloraMath.NewDecFromInt64(40)},
+	}
+	honestBundle := func() *emissionstypes.ReputerValueBundle {
+		return &emissionstypes.ReputerValueBundle{
+			ValueBundle: &emissionstypes.ValueBundle{
+				CombinedValue:    alloraMath.MustNewDecFromString("10.5"),
+				NaiveValue:       alloraMath.NewDecFromInt64(20),
+				InfererValues:    []*emissionstypes.WorkerAttributedValue{{Worker: "inferer", Value: alloraMath.NewDecFromInt64(31)}},
+				ForecasterValues: []*emissionstypes.WorkerAttributedValue{{Worker: "forecaster", Value: alloraMath.NewDecFromInt64(40)}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		update   func(bundle *emissionstypes.ValueBundle)
+		lossType emissionstypes.ReputerLossType
+		worker   string
+	}{
+		{
+			name:     "honest",
+			update:   func(*emissionstypes.ValueBundle) {},
+			lossType: emissionstypes.ReputerLossType_REPUTER_LOSS_TYPE_UNSPECIFIED,
+			worker:   "",
+		},
+		{
+			name:     "combined",
+			update:   func(bundle *emissionstypes.ValueBundle) { bundle.CombinedValue = alloraMath.NewDecFromInt64(12) },
+			lossType: emissionstypes.ReputerLossType_REPUTER_LOSS_TYPE_COMBINED,
+			worker:   "",
+		},
+		{
+			name:     "naive",
+			update:   func(bundle *emissionstypes.ValueBundle) { bundle.NaiveValue = alloraMath.NewDecFromInt64(17) },
+			lossType: emissionstypes.ReputerLossType_REPUTER_LOSS_TYPE_NAIVE,
+			worker:   "",
+		},
+		{
+			name: "inferer",
+			update: func(bundle *emissionstypes.ValueBundle) {
+				bundle.InfererValues[0].Value = alloraMath.NewDecFromInt64(40)
+			},
+			lossType: emissionstypes.ReputerLossType_REPUTER_LOSS_TYPE_INFERER,
+			worker:   "inferer",
+		},
+		{
+			name: "forecaster",
+			update: func(bundle *emissionstypes.ValueBundle) {
+				bundle.ForecasterValues[0].Value = alloraMath.NewDecFromInt64(1)
+			},
+			lossType: emissionstypes.ReputerLossType_REPUTER_LOSS_TYPE_FORECASTER,
+			worker:   "forecaster",
+		},
+	}
+	for _, tc := range tests {
+		bundle := honestBundle()
+		tc.update(bundle.ValueBundle)
+		deviation, err := actorutils.FindLossDeviatingFromRecomputed(bundle, recomputed, maxDeviation, epsilon)
+		require.NoError(err, tc.name)
+		if tc.lossType == emissionstypes.ReputerLossType_REPUTER_LOSS_TYPE_UNSPECIFIED {
+			require.Nil(deviation, tc.name)
+			continue
+		}
+		require.NotNil(deviation, tc.name)
+		require.Equal(tc.lossType, deviation.LossType, tc.name)
+		require.Equal(tc.worker, deviation.Worker, tc.name)
+	}
+
+	// Network losses that could not be recomputed are not checked
+	bundle := honestBundle()
+	bundle.ValueBundle.CombinedValue = alloraMath.NewDecFromInt64(1000)
+	deviation, err := actorutils.FindLossDeviatingFromRecomputed(bundle, &actorutils.RecomputedLosses{
+		InfererLosses:    recomputed.InfererLosses,
+		CombinedLoss:     nil,
+		NaiveLoss:        nil,
+		ForecasterLosses: nil,
+	}, maxDeviation, epsilon)
+	require.NoError(err)
+	require.Nil(deviation)
+}
@@ -0,0 +1,59 @@
+package actorutils
+
+import (
+	"cosmossdk.io/errors"
+	keeper "github.com/allora-network/allora-chain/x/emissions/keeper"
+	synth "github.com/allora-network/allora-chain/x/emissions/keeper/inference_synthesis"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Publishes the network inference of a closed worker nonce: archives it if the topic archives its
+// network inferences, and sends it to the outbound GMP subscribers of the topic.
+// The network inference is only synthesized if it is archived or the topic has subscribers.
+func PublishNetworkInferences(k *keeper.Keeper, ctx sdk.Context, topic types.Topic, nonce types.Nonce) error {
+	hasSubscriptions, err := k.HasTopicGmpSubscriptions(ctx, topic.Id)
+	if err != nil {
+		return err
+	}
+	if !hasSubscriptions && !topic.ArchiveNetworkInferences {
+		return nil
+	}
+	result, err := synth.GetNetworkInferences(ctx, *k, topic.Id, &nonce.BlockHeight)
+	if err != nil {
+		return errors.Wrap(err, "error getting network inferences")
+	}
+
+	if hasSubscriptions {
+		err = k.SendNetworkInferenceToGmpSubscribers(ctx, topic.Id, nonce.BlockHeight, result.NetworkInferences)
+		if err != nil {
+			return errors.Wrap(err, "error sending network inferences to gmp subscribers")
+		}
+	}
+
+	if !topic.ArchiveNetworkInferences {
+		return nil
+	}
+	ciRawPercentiles, ciValues, err := synth.CalcConfidenceIntervals(
+		result.NetworkInferences,
+		result.InfererToWeight,
+		result.ForecasterToWeight,
+	)
+	if err != nil {
+		return errors.Wrap(err, "error calculating confidence intervals")
+	}
+	err = k.ArchiveNetworkInference(ctx, types.ArchivedNetworkInference{
+		TopicId:                          topic.Id,
+		BlockHeight:                      nonce.BlockHeight,
+		CombinedValue:                    result.NetworkInferences.CombinedValue,
+		NaiveValue:                       result.NetworkInferences.NaiveValue,
+		CombinedValues:                   result.NetworkInferences.CombinedValues,
+		NaiveValues:                      result.NetworkInferences.NaiveValues,
+		ConfidenceIntervalRawPercentiles: ciRawPercentiles,
+		ConfidenceIntervalValues:         ciValues,
+	})
+	if err != nil {
+		return errors.Wrap(err, "error archiving network inferences")
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"sort"
 
 	keeper "github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/metrics"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -35,6 +36,27 @@ func CloseWorkerNonce(k *keeper.Keeper, ctx sdk.Context, topic types.Topic, nonc
 	if err != nil {
 		return err
 	}
+	// The data sending fee of the topic follows the demand for its nonces, whether or not they can be closed
+	err = k.UpdateTopicDataSendingFee(ctx, topic.Id)
+	if err != nil {
+		return err
+	}
+	// Get all active forecasters for this topic
+	activeForecastAddresses, err := k.GetActiveForecastersForTopic(ctx, topic.Id)
+	if err != nil {
+		return err
+	}
+
+	// In commit-reveal mode only payloads revealed against a commitment for this nonce are accepted
+	if topic.WorkerRevealWindow > 0 {
+		revealedWorkers, err := k.GetWorkersWithRevealedCommitment(ctx, topic.Id, nonce.BlockHeight)
+		if err != nil {
+			return err
+		}
+		activeInfererAddresses = filterRevealedWorkers(activeInfererAddresses, revealedWorkers)
+		activeForecastAddresses = filterRevealedWorkers(activeForecastAddresses, revealedWorkers)
+	}
+
 	if len(activeInfererAddresses) == 0 {
 		return types.ErrNoQualifiedInferers
 	}
@@ -52,12 +74,6 @@ func CloseWorkerNonce(k *keeper.Keeper, ctx sdk.Context, topic types.Topic, nonc
 		return err
 	}
 
-	// Get all active forecasters for this topic
-	activeForecastAddresses, err := k.GetActiveForecastersForTopic(ctx, topic.Id)
-	if err != nil {
-		return err
-	}
-
 	// Insert set of active forecasts for this topic/block and return a map
 	// of the forecasters with active forecasts to be used in the forecasts processing
 	err = closeActiveForecastsSet(
@@ -103,6 +119,17 @@ func CloseWorkerNonce(k *keeper.Keeper, ctx sdk.Context, topic types.Topic, nonc
 	return nil
 }
 
+// Returns the workers that revealed their committed payload, in their original order
+func filterRevealedWorkers(workers []string, revealedWorkers map[string]bool) []string {
+	filtered := make([]string, 0, len(workers))
+	for _, worker := range workers {
+		if revealedWorkers[worker] {
+			filtered = append(filtered, worker)
+		}
+	}
+	return filtered
+}
+
 func closeActiveInferencesSet(
 	ctx sdk.Context,
 	k *keeper.Keeper,
@@ -132,6 +159,7 @@ func closeActiveInferencesSet(
 	if err != nil {
 		return nil, err
 	}
+	metrics.IncrProcessedCount(metrics.PROCESSED_INFERENCES, topicId, len(activeInferences))
 
 	return activeInfererAddressesMap, nil
 }
@@ -203,7 +231,12 @@ func closeActiveForecastsSet(
 		return activeForecasts[i].Forecaster < activeForecasts[j].Forecaster
 	})
 
-	return k.InsertActiveForecasts(ctx, topicId, nonce.BlockHeight, types.Forecasts{
+	err := k.InsertActiveForecasts(ctx, topicId, nonce.BlockHeight, types.Forecasts{
 		Forecasts: activeForecasts,
 	})
+	if err != nil {
+		return err
+	}
+	metrics.IncrProcessedCount(metrics.PROCESSED_FORECASTS, topicId, len(activeForecasts))
+	return nil
 }
@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Max number of auto-compounding delegations upon a reputer, bounding the delegations compounded when it is paid
+const MAX_AUTO_COMPOUNDING_DELEGATIONS_PER_REPUTER = 64
+
+// Opts a delegation in or out of having its rewards compounded into its stake whenever its reputer is paid.
+// Only delegations with stake placed may opt in.
+func (k *Keeper) SetDelegateStakeAutoCompound(ctx context.Context, topicId TopicId, delegator ActorId, reputer ActorId, enabled bool) error {
+	key := collections.Join3(topicId, reputer, delegator)
+	if !enabled {
+		return k.autoCompoundingDelegations.Remove(ctx, key)
+	}
+
+	alreadyEnabled, err := k.autoCompoundingDelegations.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+	if alreadyEnabled {
+		return nil
+	}
+	delegateInfo, err := k.GetDelegateStakePlacement(ctx, topicId, delegator, reputer)
+	if err != nil {
+		return err
+	}
+	if !delegateInfo.Amount.Gt(alloraMath.ZeroDec()) {
+		return errors.Wrapf(types.ErrNoDelegateStakeToAutoCompound, "topic %d reputer %s", topicId, reputer)
+	}
+	// Only delegations with stake still placed count toward the cap
+	count := 0
+	rng := collections.NewSuperPrefixedTripleRange[TopicId, ActorId, ActorId](topicId, reputer)
+	err = k.autoCompoundingDelegations.Walk(ctx, rng, func(key collections.Triple[TopicId, ActorId, ActorId]) (bool, error) {
+		placement, err := k.GetDelegateStakePlacement(ctx, topicId, key.K3(), reputer)
+		if err != nil {
+			return true, err
+		}
+		if placement.Amount.Gt(alloraMath.ZeroDec()) {
+			count++
+		}
+		return count >= MAX_AUTO_COMPOUNDING_DELEGATIONS_PER_REPUTER, nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "error counting auto-compounding delegations")
+	}
+	if count >= MAX_AUTO_COMPOUNDING_DELEGATIONS_PER_REPUTER {
+		return errors.Wrapf(types.ErrTooManyAutoCompoundingDelegations, "topic %d reputer %s", topicId, reputer)
+	}
+	return k.autoCompoundingDelegations.Set(ctx, key)
+}
+
+func (k *Keeper) IsDelegateStakeAutoCompounding(ctx context.Context, topicId TopicId, delegator ActorId, reputer ActorId) (bool, error) {
+	return k.autoCompoundingDelegations.Has(ctx, collections.Join3(topicId, reputer, delegator))
+}
+
+// Returns the delegators upon a reputer whose delegations auto-compound, in delegator order
+func (k *Keeper) GetAutoCompoundingDelegators(ctx context.Context, topicId TopicId, reputer ActorId) ([]ActorId, error) {
+	rng := collections.NewSuperPrefixedTripleRange[TopicId, ActorId, ActorId](topicId, reputer)
+	iter, err := k.autoCompoundingDelegations.Iterate(ctx, rng)
+	if err != nil {
+		return nil, errors.Wrap(err, "error iterating auto-compounding delegations")
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting auto-compounding delegations")
+	}
+	delegators := make([]ActorId, len(keys))
+	for i, key := range keys {
+		delegators[i] = key.K3()
+	}
+	return delegators, nil
+}
+
+// Turns the pending reward of a delegation, as computed from the delegate reward per share of its reputer,
+// into additional delegate stake. The reward moves from the pending delegator reward account into the
+// staking account, so the staking invariants hold. Returns the amount compounded.
+func (k *Keeper) CompoundDelegateReward(ctx context.Context, topicId TopicId, delegator ActorId, reputer ActorId) (cosmosMath.Int, error) {
+	delegateInfo, err := k.GetDelegateStakePlacement(ctx, topicId, delegator, reputer)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	if !delegateInfo.Amount.Gt(alloraMath.ZeroDec()) {
+		return cosmosMath.ZeroInt(), nil
+	}
+	share, err := k.GetDelegateRewardPerShare(ctx, topicId, reputer)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	rewardDebt, err := delegateInfo.Amount.Mul(share)
+	if err != nil {
+		return cosmosMath.Int{}, errors.Wrap(err, "error calculating reward debt")
+	}
+	pendingReward, err := rewardDebt.Sub(delegateInfo.RewardDebt)
+	if err != nil {
+		return cosmosMath.Int{}, errors.Wrap(err, "error subtracting reward debt from pending reward")
+	}
+	if !pendingReward.Gt(alloraMath.ZeroDec()) {
+		return cosmosMath.ZeroInt(), nil
+	}
+	pendingRewardInt, err := pendingReward.SdkIntTrim()
+	if err != nil {
+		return cosmosMath.Int{}, errors.Wrap(err, "error trimming pending reward")
+	}
+	if pendingRewardInt.IsZero() {
+		return cosmosMath.ZeroInt(), nil
+	}
+	pendingRewardIntDec, err := alloraMath.NewDecFromSdkInt(pendingRewardInt)
+	if err != nil {
+		return cosmosMath.Int{}, errors.Wrap(err, "error converting pending reward")
+	}
+	// The fraction of the pending reward trimmed off stays pending for the next payout
+	pendingRewardRemainder, err := pendingReward.Sub(pendingRewardIntDec)
+	if err != nil {
+		return cosmosMath.Int{}, errors.Wrap(err, "error calculating pending reward remainder")
+	}
+
+	err = k.SendCoinsFromModuleToModule(
+		ctx,
+		types.AlloraPendingRewardForDelegatorAccountName,
+		types.AlloraStakingAccountName,
+		sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, pendingRewardInt)),
+	)
+	if err != nil {
+		return cosmosMath.Int{}, errors.Wrap(err, "error moving pending reward to staking account")
+	}
+	// Settle the pending reward first so that adding the stake doesn't also pay it out to the delegator
+	delegateInfo.RewardDebt = rewardDebt
+	err = k.SetDelegateStakePlacement(ctx, topicId, delegator, reputer, delegateInfo)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	err = k.AddDelegateStake(ctx, topicId, delegator, reputer, pendingRewardInt)
+	if err != nil {
+		return cosmosMath.Int{}, errors.Wrap(err, "error adding compounded reward to delegate stake")
+	}
+	delegateInfo, err = k.GetDelegateStakePlacement(ctx, topicId, delegator, reputer)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	delegateInfo.RewardDebt, err = delegateInfo.RewardDebt.Sub(pendingRewardRemainder)
+	if err != nil {
+		return cosmosMath.Int{}, errors.Wrap(err, "error carrying pending reward remainder forward")
+	}
+	err = k.SetDelegateStakePlacement(ctx, topicId, delegator, reputer, delegateInfo)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	return pendingRewardInt, nil
+}
+
+// Compounds the pending rewards of every auto-compounding delegation upon a reputer.
+// Each delegation is compounded in its own cache context; one that fails is logged and left pending.
+func (k *Keeper) CompoundAutoCompoundingDelegateRewards(ctx context.Context, topicId TopicId, reputer ActorId) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	delegators, err := k.GetAutoCompoundingDelegators(ctx, topicId, reputer)
+	if err != nil {
+		return err
+	}
+	for _, delegator := range delegators {
+		// attempt writes in a cache context, only write finally if there are no errors
+		cacheSdkCtx, write := sdkCtx.CacheContext()
+		if _, err := k.CompoundDelegateReward(cacheSdkCtx, topicId, delegator, reputer); err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf("Error compounding reward of delegator %s upon reputer %s in topic %d: %v", delegator, reputer, topicId, err))
+			continue
+		}
+		write()
+	}
+	return nil
+}
+
+func (k *Keeper) GetAllAutoCompoundingDelegations(ctx context.Context) ([]*types.TopicIdDelegatorReputer, error) {
+	iter, err := k.autoCompoundingDelegations.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error iterating auto-compounding delegations")
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting auto-compounding delegations")
+	}
+	delegations := make([]*types.TopicIdDelegatorReputer, len(keys))
+	for i, key := range keys {
+		delegations[i] = &types.TopicIdDelegatorReputer{TopicId: key.K1(), Delegator: key.K3(), Reputer: key.K2()}
+	}
+	return delegations, nil
+}
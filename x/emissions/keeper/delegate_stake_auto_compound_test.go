@@ -0,0 +1,215 @@
+package keeper_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (s *KeeperTestSuite) TestCompoundAutoCompoundingDelegateRewards() {
+	ctx := s.ctx
+	k := s.emissionsKeeper
+	require := s.Require()
+	topicId := uint64(1)
+	reputer := s.addrsStr[0]
+	compounder := s.addrsStr[1]
+	claimer := s.addrsStr[2]
+
+	require.NoError(k.AddReputerStake(ctx, topicId, reputer, cosmosMath.NewInt(1000)))
+	require.NoError(k.AddDelegateStake(ctx, topicId, compounder, reputer, cosmosMath.NewInt(500)))
+	require.NoError(k.AddDelegateStake(ctx, topicId, claimer, reputer, cosmosMath.NewInt(300)))
+	stakeCoins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, cosmosMath.NewInt(1800)))
+	require.NoError(s.bankKeeper.MintCoins(ctx, types.AlloraStakingAccountName, stakeCoins))
+
+	require.NoError(k.SetDelegateStakeAutoCompound(ctx, topicId, compounder, reputer, true))
+	isAutoCompounding, err := k.IsDelegateStakeAutoCompounding(ctx, topicId, compounder, reputer)
+	require.NoError(err)
+	require.True(isAutoCompounding)
+
+	// Delegators have pending rewards of 0.1 per share
+	require.NoError(k.SetDelegateRewardPerShare(ctx, topicId, reputer, alloraMath.MustNewDecFromString("0.1")))
+	rewardCoins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, cosmosMath.NewInt(80)))
+	require.NoError(s.bankKeeper.MintCoins(ctx, types.AlloraPendingRewardForDelegatorAccountName, rewardCoins))
+
+	compounderBalanceBefore := s.bankKeeper.GetBalance(ctx, s.addrs[1], params.DefaultBondDenom)
+	require.NoError(k.CompoundAutoCompoundingDelegateRewards(ctx, topicId, reputer))
+
+	// Only the opted in delegation compounds, without paying anything out to the delegator
+	compounded, err := k.GetDelegateStakePlacement(ctx, topicId, compounder, reputer)
+	require.NoError(err)
+	require.True(alloraMath.NewDecFromInt64(550).Equal(compounded.Amount), compounded.Amount.String())
+	require.True(alloraMath.NewDecFromInt64(55).Equal(compounded.RewardDebt), compounded.RewardDebt.String())
+	claiming, err := k.GetDelegateStakePlacement(ctx, topicId, claimer, reputer)
+	require.NoError(err)
+	require.True(alloraMath.NewDecFromInt64(300).Equal(claiming.Amount))
+	compounderBalanceAfter := s.bankKeeper.GetBalance(ctx, s.addrs[1], params.DefaultBondDenom)
+	require.Equal(compounderBalanceBefore, compounderBalanceAfter)
+
+	stakeUponReputer, err := k.GetDelegateStakeUponReputer(ctx, topicId, reputer)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(850), stakeUponReputer)
+	totalStake, err := k.GetTotalStake(ctx)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(1850), totalStake)
+
+	// The compounded reward moves from the pending reward account into the staking account,
+	// leaving the pending reward of the other delegation
+	stakingAddr := authtypes.NewModuleAddress(types.AlloraStakingAccountName)
+	stakingBalance := s.bankKeeper.GetBalance(ctx, stakingAddr, params.DefaultBondDenom)
+	require.Equal(totalStake, stakingBalance.Amount)
+	pendingAddr := authtypes.NewModuleAddress(types.AlloraPendingRewardForDelegatorAccountName)
+	pendingBalance := s.bankKeeper.GetBalance(ctx, pendingAddr, params.DefaultBondDenom)
+	require.Equal(cosmosMath.NewInt(30), pendingBalance.Amount)
+
+	// Compounding again without new rewards changes nothing
+	require.NoError(k.CompoundAutoCompoundingDelegateRewards(ctx, topicId, reputer))
+	compounded, err = k.GetDelegateStakePlacement(ctx, topicId, compounder, reputer)
+	require.NoError(err)
+	require.True(alloraMath.NewDecFromInt64(550).Equal(compounded.Amount))
+}
+
+// A delegation that fails to compound is left as it was, without undoing the delegations compounded before it
+func (s *KeeperTestSuite) TestCompoundAutoCompoundingDelegateRewardsSkipsFailures() {
+	ctx := s.ctx
+	k := s.emissionsKeeper
+	require := s.Require()
+	topicId := uint64(1)
+	reputer := s.addrsStr[0]
+	delegators := []string{s.addrsStr[1], s.addrsStr[2]}
+
+	require.NoError(k.AddReputerStake(ctx, topicId, reputer, cosmosMath.NewInt(1000)))
+	for _, delegator := range delegators {
+		require.NoError(k.AddDelegateStake(ctx, topicId, delegator, reputer, cosmosMath.NewInt(500)))
+		require.NoError(k.SetDelegateStakeAutoCompound(ctx, topicId, delegator, reputer, true))
+	}
+	stakeCoins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, cosmosMath.NewInt(2000)))
+	require.NoError(s.bankKeeper.MintCoins(ctx, types.AlloraStakingAccountName, stakeCoins))
+
+	// Both delegations have a pending reward of 50, but the pending reward account only holds one of them
+	require.NoError(k.SetDelegateRewardPerShare(ctx, topicId, reputer, alloraMath.MustNewDecFromString("0.1")))
+	rewardCoins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, cosmosMath.NewInt(50)))
+	require.NoError(s.bankKeeper.MintCoins(ctx, types.AlloraPendingRewardForDelegatorAccountName, rewardCoins))
+
+	require.NoError(k.CompoundAutoCompoundingDelegateRewards(ctx, topicId, reputer))
+
+	compounded := 0
+	for _, delegator := range delegators {
+		placement, err := k.GetDelegateStakePlacement(ctx, topicId, delegator, reputer)
+		require.NoError(err)
+		if placement.Amount.Equal(alloraMath.NewDecFromInt64(550)) {
+			compounded++
+			require.True(alloraMath.NewDecFromInt64(55).Equal(placement.RewardDebt), placement.RewardDebt.String())
+		} else {
+			require.True(alloraMath.NewDecFromInt64(500).Equal(placement.Amount), placement.Amount.String())
+			require.True(placement.RewardDebt.IsZero(), placement.RewardDebt.String())
+		}
+	}
+	require.Equal(1, compounded)
+
+	totalStake, err := k.GetTotalStake(ctx)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(2050), totalStake)
+	stakingAddr := authtypes.NewModuleAddress(types.AlloraStakingAccountName)
+	stakingBalance := s.bankKeeper.GetBalance(ctx, stakingAddr, params.DefaultBondDenom)
+	require.Equal(totalStake, stakingBalance.Amount)
+}
+
+func (s *KeeperTestSuite) TestSetDelegateStakeAutoCompound() {
+	ctx := s.ctx
+	k := s.emissionsKeeper
+	require := s.Require()
+	topicId := uint64(1)
+	reputer := s.addrsStr[0]
+	delegator := s.addrsStr[1]
+
+	// Only delegations with stake placed may opt in
+	err := k.SetDelegateStakeAutoCompound(ctx, topicId, delegator, reputer, true)
+	require.ErrorIs(err, types.ErrNoDelegateStakeToAutoCompound)
+
+	require.NoError(k.AddDelegateStake(ctx, topicId, delegator, reputer, cosmosMath.NewInt(500)))
+	require.NoError(k.SetDelegateStakeAutoCompound(ctx, topicId, delegator, reputer, true))
+	delegators, err := k.GetAutoCompoundingDelegators(ctx, topicId, reputer)
+	require.NoError(err)
+	require.Equal([]string{delegator}, delegators)
+
+	require.NoError(k.SetDelegateStakeAutoCompound(ctx, topicId, delegator, reputer, false))
+	isAutoCompounding, err := k.IsDelegateStakeAutoCompounding(ctx, topicId, delegator, reputer)
+	require.NoError(err)
+	require.False(isAutoCompounding)
+}
+
+func (s *KeeperTestSuite) TestCompoundDelegateRewardCarriesFractionalRemainderForward() {
+	ctx := s.ctx
+	k := s.emissionsKeeper
+	require := s.Require()
+	topicId := uint64(1)
+	reputer := s.addrsStr[0]
+	delegator := s.addrsStr[1]
+
+	require.NoError(k.AddReputerStake(ctx, topicId, reputer, cosmosMath.NewInt(1000)))
+	require.NoError(k.AddDelegateStake(ctx, topicId, delegator, reputer, cosmosMath.NewInt(100)))
+	stakeCoins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, cosmosMath.NewInt(1100)))
+	require.NoError(s.bankKeeper.MintCoins(ctx, types.AlloraStakingAccountName, stakeCoins))
+	rewardCoins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, cosmosMath.NewInt(100)))
+	require.NoError(s.bankKeeper.MintCoins(ctx, types.AlloraPendingRewardForDelegatorAccountName, rewardCoins))
+
+	// A pending reward of 1.5, of which only 1 can be compounded
+	require.NoError(k.SetDelegateRewardPerShare(ctx, topicId, reputer, alloraMath.MustNewDecFromString("0.015")))
+	compounded, err := k.CompoundDelegateReward(ctx, topicId, delegator, reputer)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(1), compounded)
+	delegateInfo, err := k.GetDelegateStakePlacement(ctx, topicId, delegator, reputer)
+	require.NoError(err)
+	require.True(alloraMath.NewDecFromInt64(101).Equal(delegateInfo.Amount), delegateInfo.Amount.String())
+	require.True(alloraMath.MustNewDecFromString("1.015").Equal(delegateInfo.RewardDebt), delegateInfo.RewardDebt.String())
+
+	// The remaining 0.5 adds up with the next reward of 1.01 rather than being lost
+	require.NoError(k.SetDelegateRewardPerShare(ctx, topicId, reputer, alloraMath.MustNewDecFromString("0.025")))
+	compounded, err = k.CompoundDelegateReward(ctx, topicId, delegator, reputer)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(1), compounded)
+	delegateInfo, err = k.GetDelegateStakePlacement(ctx, topicId, delegator, reputer)
+	require.NoError(err)
+	require.True(alloraMath.NewDecFromInt64(102).Equal(delegateInfo.Amount), delegateInfo.Amount.String())
+	require.True(alloraMath.MustNewDecFromString("2.04").Equal(delegateInfo.RewardDebt), delegateInfo.RewardDebt.String())
+}
+
+func (s *KeeperTestSuite) TestDelegateStakeAutoCompoundClearedWithPlacement() {
+	ctx := s.ctx
+	k := s.emissionsKeeper
+	require := s.Require()
+	srcReputer, dstReputer, delegator := s.setUpRedelegation()
+	remover := s.addrsStr[3]
+	require.NoError(k.AddDelegateStake(ctx, 1, remover, srcReputer, cosmosMath.NewInt(300)))
+	require.NoError(k.SetDelegateStakeAutoCompound(ctx, 1, delegator, srcReputer, true))
+	require.NoError(k.SetDelegateStakeAutoCompound(ctx, 1, remover, srcReputer, true))
+
+	// A partial redelegation keeps the flag
+	_, err := k.RedelegateStake(ctx, delegator, 1, srcReputer, 2, dstReputer, cosmosMath.NewInt(200))
+	require.NoError(err)
+	isAutoCompounding, err := k.IsDelegateStakeAutoCompounding(ctx, 1, delegator, srcReputer)
+	require.NoError(err)
+	require.True(isAutoCompounding)
+
+	// Redelegating or removing all of the stake placed clears it
+	_, err = k.RedelegateStake(ctx, delegator, 1, srcReputer, 2, dstReputer, cosmosMath.NewInt(300))
+	require.NoError(err)
+	isAutoCompounding, err = k.IsDelegateStakeAutoCompounding(ctx, 1, delegator, srcReputer)
+	require.NoError(err)
+	require.False(isAutoCompounding)
+	require.NoError(k.SetDelegateStakeRemoval(ctx, types.DelegateStakeRemovalInfo{
+		BlockRemovalStarted:   ctx.BlockHeight(),
+		BlockRemovalCompleted: ctx.BlockHeight(),
+		TopicId:               1,
+		Delegator:             remover,
+		Reputer:               srcReputer,
+		Amount:                cosmosMath.NewInt(300),
+	}))
+	require.NoError(k.RemoveDelegateStake(ctx, ctx.BlockHeight(), 1, remover, srcReputer, cosmosMath.NewInt(300)))
+	delegators, err := k.GetAutoCompoundingDelegators(ctx, 1, srcReputer)
+	require.NoError(err)
+	require.Empty(delegators)
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"time"
+)
+
+// endBlockerObserverHolder lets the observer be set after depinject has copied the keeper into the app
+type endBlockerObserverHolder struct {
+	observer EndBlockerObserver
+}
+
+// SetEndBlockerObserver sets the observer told how long each run of the EndBlocker took.
+// It does not take part in consensus: it only watches the node executing the blocks.
+func (k Keeper) SetEndBlockerObserver(observer EndBlockerObserver) {
+	k.endBlockerObserver.observer = observer
+}
+
+// Records how long the EndBlocker of a block took and tells the observer, if any
+func (k *Keeper) ObserveEndBlocker(blockHeight int64, duration time.Duration) {
+	k.finishEndBlockerTimings(blockHeight, duration)
+	if k.endBlockerObserver.observer == nil {
+		return
+	}
+	k.endBlockerObserver.observer.ObserveEndBlocker(blockHeight, duration)
+}
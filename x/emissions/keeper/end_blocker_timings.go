@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"sync"
+	"time"
+
+	"github.com/allora-network/allora-chain/x/emissions/metrics"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Number of blocks whose EndBlocker timings are kept in memory for the GetEndBlockerTimings query
+const MaxRecordedEndBlockerTimings = 100
+
+// endBlockerTimingsHolder keeps the phase timings of the last EndBlockers run by the node.
+// Like the EndBlocker observer, they do not take part in consensus.
+type endBlockerTimingsHolder struct {
+	mu      sync.Mutex
+	current *types.EndBlockerTimings
+	// oldest block first
+	recent []*types.EndBlockerTimings
+}
+
+// Starts recording the phase timings of the EndBlocker of a block,
+// which are recorded until ObserveEndBlocker is called for that block
+func (k Keeper) StartEndBlockerTimings(blockHeight int64) {
+	k.endBlockerTimings.mu.Lock()
+	defer k.endBlockerTimings.mu.Unlock()
+	k.endBlockerTimings.current = &types.EndBlockerTimings{
+		BlockHeight:    blockHeight,
+		DurationMicros: 0,
+		Phases:         []*types.EndBlockerPhaseTiming{},
+	}
+}
+
+// Measures a phase of the EndBlocker started at `start`, topicId being 0 for the phases run once per block
+func (k Keeper) MeasureEndBlockerPhase(phase string, topicId TopicId, start time.Time) {
+	duration := time.Since(start)
+	metrics.MeasureEndBlockerPhase(phase, topicId, duration)
+
+	k.endBlockerTimings.mu.Lock()
+	defer k.endBlockerTimings.mu.Unlock()
+	if k.endBlockerTimings.current == nil {
+		return
+	}
+	k.endBlockerTimings.current.Phases = append(k.endBlockerTimings.current.Phases, &types.EndBlockerPhaseTiming{
+		Phase:          phase,
+		TopicId:        topicId,
+		DurationMicros: duration.Microseconds(),
+	})
+}
+
+// Stops recording the phase timings of the EndBlocker of a block and keeps them among the recent ones
+func (k Keeper) finishEndBlockerTimings(blockHeight int64, duration time.Duration) {
+	k.endBlockerTimings.mu.Lock()
+	defer k.endBlockerTimings.mu.Unlock()
+	current := k.endBlockerTimings.current
+	if current == nil || current.BlockHeight != blockHeight {
+		return
+	}
+	current.DurationMicros = duration.Microseconds()
+	k.endBlockerTimings.current = nil
+	k.endBlockerTimings.recent = append(k.endBlockerTimings.recent, current)
+	if len(k.endBlockerTimings.recent) > MaxRecordedEndBlockerTimings {
+		k.endBlockerTimings.recent = k.endBlockerTimings.recent[len(k.endBlockerTimings.recent)-MaxRecordedEndBlockerTimings:]
+	}
+}
+
+// Returns the phase timings of the last `numBlocks` EndBlockers run by the node, newest block first
+func (k Keeper) GetEndBlockerTimings(numBlocks uint64) []*types.EndBlockerTimings {
+	k.endBlockerTimings.mu.Lock()
+	defer k.endBlockerTimings.mu.Unlock()
+	recent := k.endBlockerTimings.recent
+	if numBlocks > uint64(len(recent)) {
+		numBlocks = uint64(len(recent))
+	}
+	timings := make([]*types.EndBlockerTimings, 0, numBlocks)
+	for i := len(recent) - 1; i >= len(recent)-int(numBlocks); i-- {
+		timings = append(timings, recent[i])
+	}
+	return timings
+}
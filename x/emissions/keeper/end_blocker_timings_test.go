@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/metrics"
+)
+
+func (s *KeeperTestSuite) TestEndBlockerTimingsAreRecordedNewestFirst() {
+	k := s.emissionsKeeper
+	require := s.Require()
+
+	for blockHeight := int64(1); blockHeight <= 3; blockHeight++ {
+		k.StartEndBlockerTimings(blockHeight)
+		k.MeasureEndBlockerPhase(metrics.END_BLOCKER_PHASE_STAKE_REMOVALS, 0, time.Now())
+		k.MeasureEndBlockerPhase(metrics.END_BLOCKER_PHASE_CLOSE_WORKER_NONCE, 7, time.Now())
+		k.ObserveEndBlocker(blockHeight, time.Millisecond)
+	}
+
+	timings := k.GetEndBlockerTimings(2)
+	require.Len(timings, 2)
+	require.Equal(int64(3), timings[0].BlockHeight)
+	require.Equal(int64(2), timings[1].BlockHeight)
+	require.Equal(int64(1000), timings[0].DurationMicros)
+	require.Len(timings[0].Phases, 2)
+	require.Equal(metrics.END_BLOCKER_PHASE_STAKE_REMOVALS, timings[0].Phases[0].Phase)
+	require.Equal(uint64(0), timings[0].Phases[0].TopicId)
+	require.Equal(metrics.END_BLOCKER_PHASE_CLOSE_WORKER_NONCE, timings[0].Phases[1].Phase)
+	require.Equal(uint64(7), timings[0].Phases[1].TopicId)
+
+	// Asking for more blocks than recorded returns all of them
+	require.Len(k.GetEndBlockerTimings(10), 3)
+}
+
+func (s *KeeperTestSuite) TestEndBlockerTimingsAreBounded() {
+	k := s.emissionsKeeper
+	require := s.Require()
+
+	for blockHeight := int64(1); blockHeight <= keeper.MaxRecordedEndBlockerTimings+5; blockHeight++ {
+		k.StartEndBlockerTimings(blockHeight)
+		k.ObserveEndBlocker(blockHeight, time.Millisecond)
+	}
+
+	timings := k.GetEndBlockerTimings(keeper.MaxRecordedEndBlockerTimings + 5)
+	require.Len(timings, keeper.MaxRecordedEndBlockerTimings)
+	require.Equal(int64(keeper.MaxRecordedEndBlockerTimings+5), timings[0].BlockHeight)
+	require.Equal(int64(6), timings[len(timings)-1].BlockHeight)
+}
+
+func (s *KeeperTestSuite) TestEndBlockerTimingsIgnorePhasesOutsideAnEndBlocker() {
+	k := s.emissionsKeeper
+	require := s.Require()
+
+	// Not started, e.g. when the EndBlocker is run directly by tests or tools
+	k.MeasureEndBlockerPhase(metrics.END_BLOCKER_PHASE_EMIT_REWARDS, 0, time.Now())
+	k.ObserveEndBlocker(1, time.Millisecond)
+	require.Empty(k.GetEndBlockerTimings(10))
+
+	// Observed for another block than the one started
+	k.StartEndBlockerTimings(2)
+	k.ObserveEndBlocker(3, time.Millisecond)
+	require.Empty(k.GetEndBlockerTimings(10))
+}
@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	proto "github.com/cosmos/gogoproto/proto"
+)
+
+// Returns the typed events emitted so far whose type is in `eventTypes`, in emission order
+func (s *KeeperTestSuite) typedEventsOf(eventTypes ...proto.Message) []proto.Message {
+	names := make(map[string]bool)
+	for _, eventType := range eventTypes {
+		names[proto.MessageName(eventType)] = true
+	}
+	events := []proto.Message{}
+	for _, event := range s.ctx.EventManager().ABCIEvents() {
+		if !names[event.Type] {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(event)
+		s.Require().NoError(err)
+		events = append(events, typedEvent)
+	}
+	return events
+}
+
+func (s *KeeperTestSuite) TestStakeEvents() {
+	ctx := s.ctx
+	k := s.emissionsKeeper
+	require := s.Require()
+	reputer := s.addrsStr[0]
+	delegator := s.addrsStr[1]
+	blockHeight := ctx.BlockHeight()
+
+	require.NoError(k.AddReputerStake(ctx, 1, reputer, cosmosMath.NewInt(1000)))
+	require.NoError(k.AddDelegateStake(ctx, 1, delegator, reputer, cosmosMath.NewInt(300)))
+	require.NoError(k.SetStakeRemoval(ctx, types.StakeRemovalInfo{
+		BlockRemovalStarted:   blockHeight,
+		TopicId:               1,
+		Reputer:               reputer,
+		Amount:                cosmosMath.NewInt(400),
+		BlockRemovalCompleted: blockHeight + 10,
+	}))
+	require.NoError(k.RemoveReputerStake(ctx, blockHeight+10, 1, reputer, cosmosMath.NewInt(400)))
+	require.NoError(k.SetDelegateStakeRemoval(ctx, types.DelegateStakeRemovalInfo{
+		BlockRemovalStarted:   blockHeight,
+		TopicId:               1,
+		Reputer:               reputer,
+		Delegator:             delegator,
+		Amount:                cosmosMath.NewInt(100),
+		BlockRemovalCompleted: blockHeight + 10,
+	}))
+	require.NoError(k.DeleteDelegateStakeRemoval(ctx, blockHeight+10, 1, reputer, delegator))
+
+	events := s.typedEventsOf(
+		&types.EventStakeAdded{},
+		&types.EventStakeRemoved{},
+		&types.EventStakeRemovalScheduled{},
+		&types.EventStakeRemovalUnscheduled{},
+	)
+	require.Equal([]proto.Message{
+		&types.EventStakeAdded{TopicId: 1, BlockHeight: blockHeight, Reputer: reputer, Delegator: "", Amount: cosmosMath.NewInt(1000)},
+		&types.EventStakeAdded{TopicId: 1, BlockHeight: blockHeight, Reputer: reputer, Delegator: delegator, Amount: cosmosMath.NewInt(300)},
+		&types.EventStakeRemovalScheduled{
+			TopicId:               1,
+			BlockHeight:           blockHeight,
+			Reputer:               reputer,
+			Delegator:             "",
+			Amount:                cosmosMath.NewInt(400),
+			BlockRemovalStarted:   blockHeight,
+			BlockRemovalCompleted: blockHeight + 10,
+		},
+		&types.EventStakeRemoved{TopicId: 1, BlockHeight: blockHeight, Reputer: reputer, Delegator: "", Amount: cosmosMath.NewInt(400)},
+		&types.EventStakeRemovalUnscheduled{TopicId: 1, BlockHeight: blockHeight, Reputer: reputer, Delegator: "", BlockRemovalCompleted: blockHeight + 10},
+		&types.EventStakeRemovalScheduled{
+			TopicId:               1,
+			BlockHeight:           blockHeight,
+			Reputer:               reputer,
+			Delegator:             delegator,
+			Amount:                cosmosMath.NewInt(100),
+			BlockRemovalStarted:   blockHeight,
+			BlockRemovalCompleted: blockHeight + 10,
+		},
+		&types.EventStakeRemovalUnscheduled{TopicId: 1, BlockHeight: blockHeight, Reputer: reputer, Delegator: delegator, BlockRemovalCompleted: blockHeight + 10},
+	}, events)
+}
+
+func (s *KeeperTestSuite) TestRegistrationEvents() {
+	ctx := s.ctx
+	k := s.emissionsKeeper
+	require := s.Require()
+	worker := s.addrsStr[0]
+	reputer := s.addrsStr[1]
+	owner := s.addrsStr[2]
+
+	require.NoError(k.InsertWorker(ctx, 1, worker, types.OffchainNode{NodeAddress: worker, Owner: owner}))
+	require.NoError(k.InsertReputer(ctx, 1, reputer, types.OffchainNode{NodeAddress: reputer, Owner: owner}))
+	require.NoError(k.RemoveWorker(ctx, 1, worker))
+	require.NoError(k.RemoveReputer(ctx, 1, reputer))
+
+	events := s.typedEventsOf(&types.EventActorRegistered{}, &types.EventActorUnregistered{})
+	require.Equal([]proto.Message{
+		&types.EventActorRegistered{TopicId: 1, BlockHeight: ctx.BlockHeight(), Actor: worker, IsReputer: false, Owner: owner},
+		&types.EventActorRegistered{TopicId: 1, BlockHeight: ctx.BlockHeight(), Actor: reputer, IsReputer: true, Owner: owner},
+		&types.EventActorUnregistered{TopicId: 1, BlockHeight: ctx.BlockHeight(), Actor: worker, IsReputer: false},
+		&types.EventActorUnregistered{TopicId: 1, BlockHeight: ctx.BlockHeight(), Actor: reputer, IsReputer: true},
+	}, events)
+}
+
+func (s *KeeperTestSuite) TestTopicLifecycleEvents() {
+	ctx := s.ctx
+	k := s.emissionsKeeper
+	require := s.Require()
+
+	topicId := s.CreateOneTopic(10800)
+	require.NoError(k.ActivateTopic(ctx, topicId))
+	require.NoError(k.InactivateTopic(ctx, topicId))
+	// Inactivating an inactive topic is a no op
+	require.NoError(k.InactivateTopic(ctx, topicId))
+
+	events := s.typedEventsOf(&types.EventTopicCreated{}, &types.EventTopicActivationChanged{})
+	require.Len(events, 3)
+	created, ok := events[0].(*types.EventTopicCreated)
+	require.True(ok)
+	require.Equal(topicId, created.TopicId)
+	require.Equal(s.addrsStr[0], created.Topic.Creator)
+	require.Equal(&types.EventTopicActivationChanged{TopicId: topicId, BlockHeight: ctx.BlockHeight(), Active: true}, events[1])
+	require.Equal(&types.EventTopicActivationChanged{TopicId: topicId, BlockHeight: ctx.BlockHeight(), Active: false}, events[2])
+}
+
+func (s *KeeperTestSuite) TestNonceEvents() {
+	ctx := s.ctx
+	k := s.emissionsKeeper
+	require := s.Require()
+	blockHeight := ctx.BlockHeight()
+	worker := types.NonceType_NONCE_TYPE_WORKER
+	reputer := types.NonceType_NONCE_TYPE_REPUTER
+
+	require.NoError(k.AddWorkerNonce(ctx, 1, &types.Nonce{BlockHeight: 10}))
+	require.NoError(k.AddWorkerNonce(ctx, 1, &types.Nonce{BlockHeight: 20}))
+	// Adding an unfulfilled nonce again is a no op
+	require.NoError(k.AddWorkerNonce(ctx, 1, &types.Nonce{BlockHeight: 20}))
+	fulfilled, err := k.FulfillWorkerNonce(ctx, 1, &types.Nonce{BlockHeight: 20})
+	require.NoError(err)
+	require.True(fulfilled)
+	require.NoError(k.PruneWorkerNonces(ctx, 1, 15))
+	require.NoError(k.AddReputerNonce(ctx, 1, &types.Nonce{BlockHeight: 20}))
+	fulfilled, err = k.FulfillReputerNonce(ctx, 1, &types.Nonce{BlockHeight: 20})
+	require.NoError(err)
+	require.True(fulfilled)
+
+	events := s.typedEventsOf(&types.EventNonceOpened{}, &types.EventNonceFulfilled{}, &types.EventNoncePruned{})
+	require.Equal([]proto.Message{
+		&types.EventNonceOpened{TopicId: 1, BlockHeight: blockHeight, NonceType: worker, NonceBlockHeight: 10},
+		&types.EventNonceOpened{TopicId: 1, BlockHeight: blockHeight, NonceType: worker, NonceBlockHeight: 20},
+		&types.EventNonceFulfilled{TopicId: 1, BlockHeight: blockHeight, NonceType: worker, NonceBlockHeight: 20},
+		&types.EventNoncePruned{TopicId: 1, BlockHeight: blockHeight, NonceType: worker, NonceBlockHeight: 10},
+		&types.EventNonceOpened{TopicId: 1, BlockHeight: blockHeight, NonceType: reputer, NonceBlockHeight: 20},
+		&types.EventNonceFulfilled{TopicId: 1, BlockHeight: blockHeight, NonceType: reputer, NonceBlockHeight: 20},
+	}, events)
+}
+
+func (s *KeeperTestSuite) TestNonceEventsWhenMaxUnfulfilledNoncesIsExceeded() {
+	ctx := s.ctx
+	k := s.emissionsKeeper
+	require := s.Require()
+	moduleParams, err := k.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.MaxUnfulfilledWorkerRequests = 2
+	require.NoError(k.SetParams(ctx, moduleParams))
+
+	for _, nonceBlockHeight := range []int64{10, 20, 30} {
+		require.NoError(k.AddWorkerNonce(ctx, 1, &types.Nonce{BlockHeight: nonceBlockHeight}))
+	}
+
+	// The oldest nonce makes room for the newest one
+	events := s.typedEventsOf(&types.EventNoncePruned{})
+	require.Equal([]proto.Message{
+		&types.EventNoncePruned{
+			TopicId:          1,
+			BlockHeight:      ctx.BlockHeight(),
+			NonceType:        types.NonceType_NONCE_TYPE_WORKER,
+			NonceBlockHeight: 10,
+		},
+	}, events)
+}
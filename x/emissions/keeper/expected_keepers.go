@@ -2,8 +2,11 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/core/address"
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -19,14 +22,41 @@ type AccountKeeper interface {
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 
 	BlockedAddr(addr sdk.AccAddress) bool
 }
+
+// GmpSender delivers network inferences to the destination of an outbound GMP subscription.
+// The fee is paid by the sender and the packet sequence of the delivery is returned.
+type GmpSender interface {
+	SendNetworkInference(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		channelId string,
+		subscription types.GmpSubscription,
+		blockHeight BlockHeight,
+		networkInference *types.ValueBundle,
+		fee sdk.Coin,
+	) (uint64, error)
+}
+
+// FeeBurner burns its configured share of fees just collected into the mint module's ecosystem account.
+// The amount burned is returned.
+type FeeBurner interface {
+	BurnFees(ctx context.Context, fees cosmosMath.Int) (cosmosMath.Int, error)
+}
+
+// EndBlockerObserver is told how long each run of the emissions EndBlocker took, e.g. to watch the health of the node
+type EndBlockerObserver interface {
+	ObserveEndBlocker(blockHeight int64, duration time.Duration)
+}
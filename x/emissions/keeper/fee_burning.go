@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	cosmosMath "cosmossdk.io/math"
+)
+
+// feeBurnerHolder lets the burner be set after depinject has copied the keeper into the app
+type feeBurnerHolder struct {
+	burner FeeBurner
+}
+
+// SetFeeBurner sets the burner of a share of the create topic, registration and data sending fees.
+// Until it is set, every fee goes to the ecosystem account in full.
+func (k Keeper) SetFeeBurner(burner FeeBurner) {
+	k.feeBurner.burner = burner
+}
+
+// Burns the share of fees just sent to the ecosystem account set by the fee burner, if any.
+// Returns the amount burned.
+func (k *Keeper) BurnFees(ctx context.Context, fees cosmosMath.Int) (cosmosMath.Int, error) {
+	if k.feeBurner.burner == nil {
+		return cosmosMath.ZeroInt(), nil
+	}
+	return k.feeBurner.burner.BurnFees(ctx, fees)
+}
@@ -500,7 +500,7 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) erro
 	//AllLossBundles []*TopicIdBlockHeightReputerValueBundles
 	if len(data.AllLossBundles) != 0 {
 		for _, topicIdBlockHeightReputerValueBundles := range data.AllLossBundles {
-			if err := topicIdBlockHeightReputerValueBundles.ReputerValueBundles.Validate(); err != nil {
+			if err := topicIdBlockHeightReputerValueBundles.ReputerValueBundles.ValidateWithoutSignatures(); err != nil {
 				return errors.Wrap(err, "reputer value bundles validation failed")
 			}
 			if err := k.allLossBundles.Set(ctx,
@@ -903,6 +903,211 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) erro
 		}
 	}
 
+	// ScheduledTopicUpdates
+	if len(data.ScheduledTopicUpdates) != 0 {
+		for _, update := range data.ScheduledTopicUpdates {
+			if update != nil {
+				if err := k.SetScheduledTopicUpdate(ctx, *update); err != nil {
+					return errors.Wrap(err, "error setting scheduled topic update")
+				}
+			}
+		}
+	}
+
+	// TopicRetirements
+	if len(data.TopicRetirements) != 0 {
+		for _, retirement := range data.TopicRetirements {
+			if retirement != nil {
+				if err := k.SetTopicRetirement(ctx, *retirement); err != nil {
+					return errors.Wrap(err, "error setting topic retirement")
+				}
+			}
+		}
+	}
+
+	// GmpAllowedSources
+	if len(data.GmpAllowedSources) != 0 {
+		for _, source := range data.GmpAllowedSources {
+			if source != nil {
+				if err := k.AddGmpAllowedSource(ctx, *source); err != nil {
+					return errors.Wrap(err, "error setting gmp allowed source")
+				}
+			}
+		}
+	}
+
+	// NextGmpSubscriptionId
+	if err := k.SetNextGmpSubscriptionId(ctx, data.NextGmpSubscriptionId); err != nil {
+		return errors.Wrap(err, "error setting next gmp subscription id")
+	}
+	// GmpSubscriptions
+	if len(data.GmpSubscriptions) != 0 {
+		for _, subscription := range data.GmpSubscriptions {
+			if subscription != nil {
+				if err := k.SetGmpSubscription(ctx, *subscription); err != nil {
+					return errors.Wrap(err, "error setting gmp subscription")
+				}
+			}
+		}
+	}
+	// GmpDeliveries
+	if len(data.GmpDeliveries) != 0 {
+		for _, delivery := range data.GmpDeliveries {
+			if delivery != nil {
+				if err := k.SetGmpDelivery(ctx, *delivery); err != nil {
+					return errors.Wrap(err, "error setting gmp delivery")
+				}
+			}
+		}
+	}
+
+	// TopicWorkerAllowlistEnabled []uint64
+	for _, topicId := range data.TopicWorkerAllowlistEnabled {
+		if err := types.ValidateTopicId(topicId); err != nil {
+			return errors.Wrap(err, "error setting topicWorkerAllowlistEnabled")
+		}
+		if err := k.SetTopicAllowlistEnabled(ctx, topicId, false, true); err != nil {
+			return errors.Wrap(err, "error setting topicWorkerAllowlistEnabled")
+		}
+	}
+	// TopicReputerAllowlistEnabled []uint64
+	for _, topicId := range data.TopicReputerAllowlistEnabled {
+		if err := types.ValidateTopicId(topicId); err != nil {
+			return errors.Wrap(err, "error setting topicReputerAllowlistEnabled")
+		}
+		if err := k.SetTopicAllowlistEnabled(ctx, topicId, true, true); err != nil {
+			return errors.Wrap(err, "error setting topicReputerAllowlistEnabled")
+		}
+	}
+	// TopicWorkerAllowlist []*TopicAndActorId
+	for _, topicAndActorId := range data.TopicWorkerAllowlist {
+		if topicAndActorId != nil {
+			if err := types.ValidateTopicId(topicAndActorId.TopicId); err != nil {
+				return errors.Wrap(err, "error setting topicWorkerAllowlist")
+			}
+			if err := k.AddToTopicAllowlist(ctx, topicAndActorId.TopicId, false, topicAndActorId.ActorId); err != nil {
+				return errors.Wrap(err, "error setting topicWorkerAllowlist")
+			}
+		}
+	}
+	// TopicReputerAllowlist []*TopicAndActorId
+	for _, topicAndActorId := range data.TopicReputerAllowlist {
+		if topicAndActorId != nil {
+			if err := types.ValidateTopicId(topicAndActorId.TopicId); err != nil {
+				return errors.Wrap(err, "error setting topicReputerAllowlist")
+			}
+			if err := k.AddToTopicAllowlist(ctx, topicAndActorId.TopicId, true, topicAndActorId.ActorId); err != nil {
+				return errors.Wrap(err, "error setting topicReputerAllowlist")
+			}
+		}
+	}
+	// ReputerSlashingInfo []*TopicIdActorIdReputerSlashingInfo
+	for _, topicIdActorIdInfo := range data.ReputerSlashingInfo {
+		if topicIdActorIdInfo != nil && topicIdActorIdInfo.SlashingInfo != nil {
+			if err := types.ValidateTopicId(topicIdActorIdInfo.TopicId); err != nil {
+				return errors.Wrap(err, "error setting reputerSlashingInfo")
+			}
+			if err := types.ValidateBech32(topicIdActorIdInfo.ActorId); err != nil {
+				return errors.Wrap(err, "error setting reputerSlashingInfo")
+			}
+			if err := k.SetReputerSlashingInfo(ctx,
+				topicIdActorIdInfo.TopicId, topicIdActorIdInfo.ActorId,
+				*topicIdActorIdInfo.SlashingInfo); err != nil {
+				return errors.Wrap(err, "error setting reputerSlashingInfo")
+			}
+		}
+	}
+	// WorkerCommitments []*TopicIdBlockHeightActorIdWorkerCommitment
+	for _, workerCommitment := range data.WorkerCommitments {
+		if workerCommitment != nil && workerCommitment.Commitment != nil {
+			if err := k.SetWorkerCommitment(ctx,
+				workerCommitment.TopicId, workerCommitment.BlockHeight, workerCommitment.ActorId,
+				*workerCommitment.Commitment); err != nil {
+				return errors.Wrap(err, "error setting workerCommitments")
+			}
+		}
+	}
+	// GroundTruths []*TopicIdBlockHeightDec
+	for _, groundTruth := range data.GroundTruths {
+		if groundTruth != nil {
+			if err := k.SetGroundTruth(ctx, groundTruth.TopicId, groundTruth.BlockHeight, groundTruth.Dec); err != nil {
+				return errors.Wrap(err, "error setting groundTruths")
+			}
+		}
+	}
+	// NetworkInferenceArchive []*ArchivedNetworkInference
+	for _, archived := range data.NetworkInferenceArchive {
+		if archived != nil {
+			if err := k.SetArchivedNetworkInference(ctx, *archived); err != nil {
+				return errors.Wrap(err, "error setting networkInferenceArchive")
+			}
+		}
+	}
+	// TopicSponsorships []*TopicSponsorship
+	for _, sponsorship := range data.TopicSponsorships {
+		if sponsorship != nil {
+			if err := k.SetTopicSponsorship(ctx, *sponsorship); err != nil {
+				return errors.Wrap(err, "error setting topicSponsorships")
+			}
+		}
+	}
+	// SponsoredActorFees []*TopicIdSponsorActorSponsoredFees
+	for _, actorFees := range data.SponsoredActorFees {
+		if actorFees != nil && actorFees.Fees != nil {
+			if err := k.SetSponsoredActorFees(ctx, actorFees.TopicId, actorFees.Sponsor, actorFees.Actor, *actorFees.Fees); err != nil {
+				return errors.Wrap(err, "error setting sponsoredActorFees")
+			}
+		}
+	}
+	// AutoCompoundingDelegations []*TopicIdDelegatorReputer
+	for _, delegation := range data.AutoCompoundingDelegations {
+		if delegation != nil {
+			if err := delegation.Validate(); err != nil {
+				return errors.Wrap(err, "error validating autoCompoundingDelegations")
+			}
+			key := collections.Join3(delegation.TopicId, delegation.Reputer, delegation.Delegator)
+			if err := k.autoCompoundingDelegations.Set(ctx, key); err != nil {
+				return errors.Wrap(err, "error setting autoCompoundingDelegations")
+			}
+		}
+	}
+	// Redelegations []*Redelegation
+	for _, redelegation := range data.Redelegations {
+		if redelegation != nil {
+			if err := k.SetRedelegation(ctx, *redelegation); err != nil {
+				return errors.Wrap(err, "error setting redelegations")
+			}
+		}
+	}
+	// NextRedelegationId uint64
+	if err := k.SetNextRedelegationId(ctx, data.NextRedelegationId); err != nil {
+		return errors.Wrap(err, "error setting nextRedelegationId")
+	}
+	// AccruedRewards []*TopicIdActorIdInt
+	for _, accrued := range data.AccruedRewards {
+		if accrued != nil {
+			if err := k.creditAccruedReward(ctx, accrued.TopicId, accrued.ActorId, accrued.Int); err != nil {
+				return errors.Wrap(err, "error setting accruedRewards")
+			}
+		}
+	}
+	// TopicDataSendingFees []*TopicIdAndInt
+	for _, fee := range data.TopicDataSendingFees {
+		if fee != nil {
+			if err := k.SetTopicDataSendingFee(ctx, fee.TopicId, fee.Int); err != nil {
+				return errors.Wrap(err, "error setting topicDataSendingFee")
+			}
+		}
+	}
+	// TopicPayloadsReceived []*TopicIdAndUint64
+	for _, received := range data.TopicPayloadsReceived {
+		if received != nil {
+			if err := k.topicPayloadsReceived.Set(ctx, received.TopicId, received.Uint64); err != nil {
+				return errors.Wrap(err, "error setting topicPayloadsReceived")
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -1281,16 +1486,20 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 	// Fill in the values from keeper.go
 
 	// topicStake
+	// Only the topics that hold stake have an entry, new and retired topics may not
 	topicStake := make([]*types.TopicIdAndInt, 0)
-	var i uint64
-	for i = 1; i < nextTopicId; i++ {
-		stake, err := k.topicStake.Get(ctx, i)
+	topicStakeIter, err := k.topicStake.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate topic stake")
+	}
+	for ; topicStakeIter.Valid(); topicStakeIter.Next() {
+		keyValue, err := topicStakeIter.KeyValue()
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to get topic stake %d", i)
+			return nil, errors.Wrap(err, "failed to get key value: topicStakeIter")
 		}
 		topicStake = append(topicStake, &types.TopicIdAndInt{
-			TopicId: i,
-			Int:     stake,
+			TopicId: keyValue.Key,
+			Int:     keyValue.Value,
 		})
 	}
 
@@ -2170,6 +2379,261 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, errors.Wrap(err, "failed to get total sum previous topic weights")
 	}
 
+	scheduledTopicUpdates := make([]*types.ScheduledTopicUpdate, 0)
+	scheduledTopicUpdatesIter, err := k.scheduledTopicUpdates.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate scheduled topic updates")
+	}
+	for ; scheduledTopicUpdatesIter.Valid(); scheduledTopicUpdatesIter.Next() {
+		value, err := scheduledTopicUpdatesIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: scheduledTopicUpdatesIter")
+		}
+		scheduledTopicUpdates = append(scheduledTopicUpdates, &value)
+	}
+
+	topicRetirements := make([]*types.TopicRetirement, 0)
+	topicRetirementsIter, err := k.topicRetirements.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate topic retirements")
+	}
+	for ; topicRetirementsIter.Valid(); topicRetirementsIter.Next() {
+		value, err := topicRetirementsIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: topicRetirementsIter")
+		}
+		topicRetirements = append(topicRetirements, &value)
+	}
+
+	gmpAllowedSources := make([]*types.GmpAllowedSource, 0)
+	gmpAllowedSourcesIter, err := k.gmpAllowedSources.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate gmp allowed sources")
+	}
+	for ; gmpAllowedSourcesIter.Valid(); gmpAllowedSourcesIter.Next() {
+		key, err := gmpAllowedSourcesIter.Key()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key: gmpAllowedSourcesIter")
+		}
+		gmpAllowedSources = append(gmpAllowedSources, &types.GmpAllowedSource{
+			SourceChain:   key.K1(),
+			SourceAddress: key.K2(),
+		})
+	}
+
+	nextGmpSubscriptionId, err := k.GetNextGmpSubscriptionId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get next gmp subscription id")
+	}
+
+	gmpSubscriptions := make([]*types.GmpSubscription, 0)
+	allGmpSubscriptions, err := k.GetAllGmpSubscriptions(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get gmp subscriptions")
+	}
+	for i := range allGmpSubscriptions {
+		gmpSubscriptions = append(gmpSubscriptions, &allGmpSubscriptions[i])
+	}
+
+	gmpDeliveries := make([]*types.GmpDelivery, 0)
+	allGmpDeliveries, err := k.GetAllGmpDeliveries(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get gmp deliveries")
+	}
+	for i := range allGmpDeliveries {
+		gmpDeliveries = append(gmpDeliveries, &allGmpDeliveries[i])
+	}
+
+	topicWorkerAllowlistEnabled := make([]uint64, 0)
+	topicWorkerAllowlistEnabledIter, err := k.topicWorkerAllowlistEnabled.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate topic worker allowlist enabled")
+	}
+	for ; topicWorkerAllowlistEnabledIter.Valid(); topicWorkerAllowlistEnabledIter.Next() {
+		key, err := topicWorkerAllowlistEnabledIter.Key()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key: topicWorkerAllowlistEnabledIter")
+		}
+		topicWorkerAllowlistEnabled = append(topicWorkerAllowlistEnabled, key)
+	}
+
+	topicReputerAllowlistEnabled := make([]uint64, 0)
+	topicReputerAllowlistEnabledIter, err := k.topicReputerAllowlistEnabled.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate topic reputer allowlist enabled")
+	}
+	for ; topicReputerAllowlistEnabledIter.Valid(); topicReputerAllowlistEnabledIter.Next() {
+		key, err := topicReputerAllowlistEnabledIter.Key()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key: topicReputerAllowlistEnabledIter")
+		}
+		topicReputerAllowlistEnabled = append(topicReputerAllowlistEnabled, key)
+	}
+
+	topicWorkerAllowlist := make([]*types.TopicAndActorId, 0)
+	topicWorkerAllowlistIter, err := k.topicWorkerAllowlist.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate topic worker allowlist")
+	}
+	for ; topicWorkerAllowlistIter.Valid(); topicWorkerAllowlistIter.Next() {
+		key, err := topicWorkerAllowlistIter.Key()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key: topicWorkerAllowlistIter")
+		}
+		topicWorkerAllowlist = append(topicWorkerAllowlist, &types.TopicAndActorId{
+			TopicId: key.K1(),
+			ActorId: key.K2(),
+		})
+	}
+
+	topicReputerAllowlist := make([]*types.TopicAndActorId, 0)
+	topicReputerAllowlistIter, err := k.topicReputerAllowlist.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate topic reputer allowlist")
+	}
+	for ; topicReputerAllowlistIter.Valid(); topicReputerAllowlistIter.Next() {
+		key, err := topicReputerAllowlistIter.Key()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key: topicReputerAllowlistIter")
+		}
+		topicReputerAllowlist = append(topicReputerAllowlist, &types.TopicAndActorId{
+			TopicId: key.K1(),
+			ActorId: key.K2(),
+		})
+	}
+
+	reputerSlashingInfo := make([]*types.TopicIdActorIdReputerSlashingInfo, 0)
+	reputerSlashingInfoIter, err := k.reputerSlashingInfo.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate reputer slashing info")
+	}
+	for ; reputerSlashingInfoIter.Valid(); reputerSlashingInfoIter.Next() {
+		keyValue, err := reputerSlashingInfoIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: reputerSlashingInfoIter")
+		}
+		value := keyValue.Value
+		reputerSlashingInfo = append(reputerSlashingInfo, &types.TopicIdActorIdReputerSlashingInfo{
+			TopicId:      keyValue.Key.K1(),
+			ActorId:      keyValue.Key.K2(),
+			SlashingInfo: &value,
+		})
+	}
+
+	workerCommitments := make([]*types.TopicIdBlockHeightActorIdWorkerCommitment, 0)
+	workerCommitmentsIter, err := k.workerCommitments.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate worker commitments")
+	}
+	for ; workerCommitmentsIter.Valid(); workerCommitmentsIter.Next() {
+		keyValue, err := workerCommitmentsIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: workerCommitmentsIter")
+		}
+		value := keyValue.Value
+		workerCommitments = append(workerCommitments, &types.TopicIdBlockHeightActorIdWorkerCommitment{
+			TopicId:     keyValue.Key.K1(),
+			BlockHeight: keyValue.Key.K2(),
+			ActorId:     keyValue.Key.K3(),
+			Commitment:  &value,
+		})
+	}
+
+	groundTruths := make([]*types.TopicIdBlockHeightDec, 0)
+	groundTruthsIter, err := k.groundTruths.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate ground truths")
+	}
+	for ; groundTruthsIter.Valid(); groundTruthsIter.Next() {
+		keyValue, err := groundTruthsIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: groundTruthsIter")
+		}
+		groundTruths = append(groundTruths, &types.TopicIdBlockHeightDec{
+			TopicId:     keyValue.Key.K1(),
+			BlockHeight: keyValue.Key.K2(),
+			Dec:         keyValue.Value,
+		})
+	}
+
+	networkInferenceArchive := make([]*types.ArchivedNetworkInference, 0)
+	networkInferenceArchiveIter, err := k.networkInferenceArchive.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate network inference archive")
+	}
+	for ; networkInferenceArchiveIter.Valid(); networkInferenceArchiveIter.Next() {
+		value, err := networkInferenceArchiveIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: networkInferenceArchiveIter")
+		}
+		networkInferenceArchive = append(networkInferenceArchive, &value)
+	}
+
+	topicSponsorships := make([]*types.TopicSponsorship, 0)
+	topicSponsorshipsIter, err := k.topicSponsorships.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate topic sponsorships")
+	}
+	for ; topicSponsorshipsIter.Valid(); topicSponsorshipsIter.Next() {
+		value, err := topicSponsorshipsIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: topicSponsorshipsIter")
+		}
+		topicSponsorships = append(topicSponsorships, &value)
+	}
+
+	sponsoredActorFees := make([]*types.TopicIdSponsorActorSponsoredFees, 0)
+	sponsoredActorFeesIter, err := k.sponsoredActorFees.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate sponsored actor fees")
+	}
+	for ; sponsoredActorFeesIter.Valid(); sponsoredActorFeesIter.Next() {
+		keyValue, err := sponsoredActorFeesIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: sponsoredActorFeesIter")
+		}
+		value := keyValue.Value
+		sponsoredActorFees = append(sponsoredActorFees, &types.TopicIdSponsorActorSponsoredFees{
+			TopicId: keyValue.Key.K1(),
+			Sponsor: keyValue.Key.K2(),
+			Actor:   keyValue.Key.K3(),
+			Fees:    &value,
+		})
+	}
+
+	autoCompoundingDelegations, err := k.GetAllAutoCompoundingDelegations(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get auto-compounding delegations")
+	}
+
+	allRedelegations, err := k.GetAllRedelegations(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get redelegations")
+	}
+	redelegations := make([]*types.Redelegation, len(allRedelegations))
+	for i := range allRedelegations {
+		redelegations[i] = &allRedelegations[i]
+	}
+	nextRedelegationId, err := k.GetNextRedelegationId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get next redelegation id")
+	}
+
+	accruedRewards, err := k.GetAllAccruedRewards(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get accrued rewards")
+	}
+
+	topicDataSendingFees, err := k.GetAllTopicDataSendingFees(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get topic data sending fees")
+	}
+
+	topicPayloadsReceived, err := k.GetAllTopicPayloadsReceived(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get topic payloads received")
+	}
+
 	return &types.GenesisState{
 		Params:                                      moduleParams,
 		NextTopicId:                                 nextTopicId,
@@ -2244,6 +2708,28 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		CountForecasterInclusionsInTopicActiveSet:      countForecasterInclusionsInTopicActiveSet,
 		TotalSumPreviousTopicWeights:                   totalSumPreviousTopicWeights,
 		RewardCurrentBlockEmission:                     rewardCurrentBlockEmission,
+		ScheduledTopicUpdates:                          scheduledTopicUpdates,
+		TopicRetirements:                               topicRetirements,
+		GmpAllowedSources:                              gmpAllowedSources,
+		NextGmpSubscriptionId:                          nextGmpSubscriptionId,
+		GmpSubscriptions:                               gmpSubscriptions,
+		GmpDeliveries:                                  gmpDeliveries,
+		TopicWorkerAllowlistEnabled:                    topicWorkerAllowlistEnabled,
+		TopicReputerAllowlistEnabled:                   topicReputerAllowlistEnabled,
+		TopicWorkerAllowlist:                           topicWorkerAllowlist,
+		TopicReputerAllowlist:                          topicReputerAllowlist,
+		ReputerSlashingInfo:                            reputerSlashingInfo,
+		WorkerCommitments:                              workerCommitments,
+		GroundTruths:                                   groundTruths,
+		NetworkInferenceArchive:                        networkInferenceArchive,
+		TopicSponsorships:                              topicSponsorships,
+		SponsoredActorFees:                             sponsoredActorFees,
+		AutoCompoundingDelegations:                     autoCompoundingDelegations,
+		Redelegations:                                  redelegations,
+		NextRedelegationId:                             nextRedelegationId,
+		AccruedRewards:                                 accruedRewards,
+		TopicDataSendingFees:                           topicDataSendingFees,
+		TopicPayloadsReceived:                          topicPayloadsReceived,
 	}, nil
 }
 
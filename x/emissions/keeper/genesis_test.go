@@ -2,6 +2,8 @@ package keeper_test
 
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
 )
 
 // at minimum test that an import can be done from an export without error
@@ -14,3 +16,64 @@ func (s *KeeperTestSuite) TestImportExportGenesisNoError() {
 	err = s.emissionsKeeper.InitGenesis(s.ctx, genesisState)
 	s.Require().NoError(err)
 }
+
+// topics without stake, such as new or retired topics, have no topic stake to export
+func (s *KeeperTestSuite) TestExportGenesisSkipsTopicsWithoutStake() {
+	require := s.Require()
+	retiredTopicId := s.CreateOneTopic(10800)
+	stakedTopicId := s.CreateOneTopic(10800)
+	s.CreateOneTopic(10800)
+	err := s.emissionsKeeper.SetTopicStake(s.ctx, stakedTopicId, cosmossdk_io_math.OneInt())
+	require.NoError(err)
+	_, err = s.emissionsKeeper.RetireTopic(s.ctx, retiredTopicId, s.addrsStr[0])
+	require.NoError(err)
+
+	genesisState, err := s.emissionsKeeper.ExportGenesis(s.ctx)
+	require.NoError(err)
+	require.Len(genesisState.TopicStake, 1)
+	require.Equal(stakedTopicId, genesisState.TopicStake[0].TopicId)
+
+	err = s.emissionsKeeper.InitGenesis(s.ctx, genesisState)
+	require.NoError(err)
+}
+
+// loss bundles are stored filtered, with the signature of the unfiltered bundle, so only their signature may not verify
+func (s *KeeperTestSuite) TestInitGenesisLossBundlesSkipSignatureOnly() {
+	require := s.Require()
+	topicId := s.CreateOneTopic(10800)
+	valueBundle := &types.ValueBundle{
+		TopicId: topicId,
+		ReputerRequestNonce: &types.ReputerRequestNonce{
+			ReputerNonce: &types.Nonce{BlockHeight: 100},
+		},
+		Reputer:       s.addrsStr[0],
+		CombinedValue: alloraMath.MustNewDecFromString("0.1"),
+		InfererValues: []*types.WorkerAttributedValue{
+			{Worker: s.addrsStr[1], Value: alloraMath.MustNewDecFromString("0.2")},
+			{Worker: s.addrsStr[2], Value: alloraMath.MustNewDecFromString("0.3")},
+		},
+		NaiveValue: alloraMath.MustNewDecFromString("0.4"),
+	}
+	signature := s.signValueBundle(valueBundle, s.privKeys[0])
+	valueBundle.InfererValues = valueBundle.InfererValues[:1]
+	genesisState, err := s.emissionsKeeper.ExportGenesis(s.ctx)
+	require.NoError(err)
+	genesisState.AllLossBundles = []*types.TopicIdBlockHeightReputerValueBundles{{
+		TopicId:     topicId,
+		BlockHeight: 100,
+		ReputerValueBundles: &types.ReputerValueBundles{
+			ReputerValueBundles: []*types.ReputerValueBundle{
+				{ValueBundle: valueBundle, Signature: signature, Pubkey: s.pubKeyHexStr[0]},
+			},
+		},
+	}}
+	require.NoError(s.emissionsKeeper.InitGenesis(s.ctx, genesisState))
+	lossBundles, err := s.emissionsKeeper.GetReputerLossBundlesAtBlock(s.ctx, topicId, 100)
+	require.NoError(err)
+	require.Len(lossBundles.ReputerValueBundles, 1)
+
+	// The bundle must still be that of the reputer of its pubkey
+	genesisState.AllLossBundles[0].ReputerValueBundles.ReputerValueBundles[0].Pubkey = s.pubKeyHexStr[1]
+	err = s.emissionsKeeper.InitGenesis(s.ctx, genesisState)
+	require.ErrorContains(err, "Reputer does not match pubkey")
+}
@@ -0,0 +1,343 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Max number of outbound GMP subscriptions per topic, bounding the packets sent when a topic closes a nonce
+const MAX_GMP_SUBSCRIPTIONS_PER_TOPIC = 32
+
+// gmpSenderHolder lets the sender be set after depinject has copied the keeper into the app
+type gmpSenderHolder struct {
+	sender GmpSender
+}
+
+// SetGmpSender sets the sender used to push network inferences to GMP subscribers.
+// Until it is set, subscriptions can be managed but nothing is sent.
+func (k Keeper) SetGmpSender(sender GmpSender) {
+	k.gmpSender.sender = sender
+}
+
+func gmpFeeCoins(amount cosmosMath.Int) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, amount))
+}
+
+// Creates a subscription and moves its prepayment from the subscriber into the GMP fee escrow
+func (k *Keeper) CreateGmpSubscription(ctx context.Context, subscription types.GmpSubscription) (uint64, error) {
+	if err := subscription.Validate(); err != nil {
+		return 0, errors.Wrap(err, "error validating gmp subscription")
+	}
+	count := 0
+	rng := collections.NewPrefixedPairRange[TopicId, uint64](subscription.TopicId)
+	err := k.topicGmpSubscriptions.Walk(ctx, rng, func(collections.Pair[TopicId, uint64]) (bool, error) {
+		count++
+		return count >= MAX_GMP_SUBSCRIPTIONS_PER_TOPIC, nil
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "error counting topic gmp subscriptions")
+	}
+	if count >= MAX_GMP_SUBSCRIPTIONS_PER_TOPIC {
+		return 0, errors.Wrapf(types.ErrTooManyGmpSubscriptionsForTopic, "topic %d", subscription.TopicId)
+	}
+
+	if subscription.Balance.IsPositive() {
+		subscriber, err := sdk.AccAddressFromBech32(subscription.Subscriber)
+		if err != nil {
+			return 0, errors.Wrap(err, "error getting subscriber address")
+		}
+		err = k.bankKeeper.SendCoins(ctx, subscriber, types.GmpFeeEscrowAddress, gmpFeeCoins(subscription.Balance))
+		if err != nil {
+			return 0, errors.Wrap(err, "error escrowing gmp subscription prepayment")
+		}
+	}
+
+	id, err := k.nextGmpSubscriptionId.Next(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "error getting next gmp subscription id")
+	}
+	// ids start at 1 so that the zero value never refers to a subscription
+	subscription.Id = id + 1
+	if err := k.SetGmpSubscription(ctx, subscription); err != nil {
+		return 0, err
+	}
+	return subscription.Id, nil
+}
+
+func (k *Keeper) GetGmpSubscription(ctx context.Context, subscriptionId uint64) (types.GmpSubscription, bool, error) {
+	subscription, err := k.gmpSubscriptions.Get(ctx, subscriptionId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.GmpSubscription{}, false, nil
+		}
+		return types.GmpSubscription{}, false, err
+	}
+	return subscription, true, nil
+}
+
+func (k *Keeper) SetGmpSubscription(ctx context.Context, subscription types.GmpSubscription) error {
+	if err := subscription.Validate(); err != nil {
+		return errors.Wrap(err, "error validating gmp subscription")
+	}
+	if err := k.gmpSubscriptions.Set(ctx, subscription.Id, subscription); err != nil {
+		return errors.Wrap(err, "error setting gmp subscription")
+	}
+	return k.topicGmpSubscriptions.Set(ctx, collections.Join(subscription.TopicId, subscription.Id))
+}
+
+// Adds to the prepaid balance of a subscription, moving the amount from the funder into the GMP fee escrow
+func (k *Keeper) FundGmpSubscription(ctx context.Context, subscriptionId uint64, funder sdk.AccAddress, amount cosmosMath.Int) (types.GmpSubscription, error) {
+	subscription, found, err := k.GetGmpSubscription(ctx, subscriptionId)
+	if err != nil {
+		return types.GmpSubscription{}, err
+	}
+	if !found {
+		return types.GmpSubscription{}, errors.Wrapf(types.ErrGmpSubscriptionNotFound, "subscription %d", subscriptionId)
+	}
+	err = k.bankKeeper.SendCoins(ctx, funder, types.GmpFeeEscrowAddress, gmpFeeCoins(amount))
+	if err != nil {
+		return types.GmpSubscription{}, errors.Wrap(err, "error escrowing gmp subscription funds")
+	}
+	subscription.Balance = subscription.Balance.Add(amount)
+	return subscription, k.SetGmpSubscription(ctx, subscription)
+}
+
+// Removes a subscription and refunds its remaining balance to the subscriber.
+// Fees of messages still in flight are refunded to the subscriber if their transfer fails.
+func (k *Keeper) CancelGmpSubscription(ctx context.Context, subscriptionId uint64) (types.GmpSubscription, error) {
+	subscription, found, err := k.GetGmpSubscription(ctx, subscriptionId)
+	if err != nil {
+		return types.GmpSubscription{}, err
+	}
+	if !found {
+		return types.GmpSubscription{}, errors.Wrapf(types.ErrGmpSubscriptionNotFound, "subscription %d", subscriptionId)
+	}
+	if subscription.Balance.IsPositive() {
+		subscriber, err := sdk.AccAddressFromBech32(subscription.Subscriber)
+		if err != nil {
+			return types.GmpSubscription{}, errors.Wrap(err, "error getting subscriber address")
+		}
+		err = k.bankKeeper.SendCoins(ctx, types.GmpFeeEscrowAddress, subscriber, gmpFeeCoins(subscription.Balance))
+		if err != nil {
+			return types.GmpSubscription{}, errors.Wrap(err, "error refunding gmp subscription balance")
+		}
+	}
+	if err := k.gmpSubscriptions.Remove(ctx, subscriptionId); err != nil {
+		return types.GmpSubscription{}, errors.Wrap(err, "error removing gmp subscription")
+	}
+	err = k.topicGmpSubscriptions.Remove(ctx, collections.Join(subscription.TopicId, subscriptionId))
+	if err != nil {
+		return types.GmpSubscription{}, errors.Wrap(err, "error removing topic gmp subscription")
+	}
+	return subscription, nil
+}
+
+// Cancels every subscription of a topic, refunding their balances
+func (k *Keeper) CancelTopicGmpSubscriptions(ctx context.Context, topicId TopicId) error {
+	subscriptions, err := k.GetTopicGmpSubscriptions(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	for _, subscription := range subscriptions {
+		if _, err := k.CancelGmpSubscription(ctx, subscription.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k *Keeper) GetTopicGmpSubscriptions(ctx context.Context, topicId TopicId) ([]types.GmpSubscription, error) {
+	rng := collections.NewPrefixedPairRange[TopicId, uint64](topicId)
+	iter, err := k.topicGmpSubscriptions.Iterate(ctx, rng)
+	if err != nil {
+		return nil, errors.Wrap(err, "error iterating topic gmp subscriptions")
+	}
+	defer iter.Close()
+
+	subscriptions := make([]types.GmpSubscription, 0)
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		subscription, err := k.gmpSubscriptions.Get(ctx, key.K2())
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting gmp subscription %d", key.K2())
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+	return subscriptions, nil
+}
+
+func (k *Keeper) HasTopicGmpSubscriptions(ctx context.Context, topicId TopicId) (bool, error) {
+	rng := collections.NewPrefixedPairRange[TopicId, uint64](topicId)
+	iter, err := k.topicGmpSubscriptions.Iterate(ctx, rng)
+	if err != nil {
+		return false, errors.Wrap(err, "error iterating topic gmp subscriptions")
+	}
+	defer iter.Close()
+	return iter.Valid(), nil
+}
+
+func (k *Keeper) GetGmpDelivery(ctx context.Context, channelId string, sequence uint64) (types.GmpDelivery, bool, error) {
+	delivery, err := k.gmpDeliveries.Get(ctx, collections.Join(channelId, sequence))
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.GmpDelivery{}, false, nil
+		}
+		return types.GmpDelivery{}, false, err
+	}
+	return delivery, true, nil
+}
+
+func (k *Keeper) SetGmpDelivery(ctx context.Context, delivery types.GmpDelivery) error {
+	if err := delivery.Validate(); err != nil {
+		return errors.Wrap(err, "error validating gmp delivery")
+	}
+	return k.gmpDeliveries.Set(ctx, collections.Join(delivery.ChannelId, delivery.Sequence), delivery)
+}
+
+// Sends the network inference of a topic to every subscription whose balance covers its fee,
+// over the channel to Axelar set in the module params. Nothing is sent while that channel is unset.
+// A failing subscription is logged and skipped so that it cannot hold back the others.
+func (k *Keeper) SendNetworkInferenceToGmpSubscribers(
+	ctx sdk.Context,
+	topicId TopicId,
+	blockHeight BlockHeight,
+	networkInference *types.ValueBundle,
+) error {
+	sender := k.gmpSender.sender
+	if sender == nil {
+		return nil
+	}
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	channelId := moduleParams.GmpAxelarChannelId
+	if channelId == "" {
+		return nil
+	}
+	subscriptions, err := k.GetTopicGmpSubscriptions(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	for _, subscription := range subscriptions {
+		if subscription.Balance.LT(subscription.FeePerMessage) {
+			continue
+		}
+		cacheCtx, write := ctx.CacheContext()
+		delivery, err := k.sendGmpSubscriptionMessage(cacheCtx, sender, channelId, subscription, blockHeight, networkInference)
+		if err != nil {
+			ctx.Logger().Warn(fmt.Sprintf("Error sending network inference of topic %d to gmp subscription %d: %s",
+				topicId, subscription.Id, err.Error()))
+			continue
+		}
+		write()
+		types.EmitNewGmpDeliveryEvent(ctx, delivery, types.GmpDeliveryStatusSent)
+	}
+	return nil
+}
+
+func (k *Keeper) sendGmpSubscriptionMessage(
+	ctx sdk.Context,
+	sender GmpSender,
+	channelId string,
+	subscription types.GmpSubscription,
+	blockHeight BlockHeight,
+	networkInference *types.ValueBundle,
+) (types.GmpDelivery, error) {
+	fee := sdk.NewCoin(params.DefaultBondDenom, subscription.FeePerMessage)
+	sequence, err := sender.SendNetworkInference(ctx, types.GmpFeeEscrowAddress, channelId, subscription, blockHeight, networkInference, fee)
+	if err != nil {
+		return types.GmpDelivery{}, err
+	}
+	subscription.Balance = subscription.Balance.Sub(subscription.FeePerMessage)
+	if err := k.SetGmpSubscription(ctx, subscription); err != nil {
+		return types.GmpDelivery{}, err
+	}
+	delivery := types.GmpDelivery{
+		SubscriptionId: subscription.Id,
+		TopicId:        subscription.TopicId,
+		Subscriber:     subscription.Subscriber,
+		BlockHeight:    blockHeight,
+		ChannelId:      channelId,
+		Sequence:       sequence,
+		Fee:            subscription.FeePerMessage,
+	}
+	return delivery, k.SetGmpDelivery(ctx, delivery)
+}
+
+// Settles a message once its packet is acknowledged or timed out.
+// Unless it was acknowledged, the transfer module has returned the fee to the escrow,
+// so it is credited back to the subscription, or to the subscriber if the subscription has been cancelled.
+// Packets that were not sent for a subscription are ignored.
+func (k *Keeper) ResolveGmpDelivery(ctx context.Context, channelId string, sequence uint64, status string) error {
+	delivery, found, err := k.GetGmpDelivery(ctx, channelId, sequence)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return nil
+	}
+	if err := k.gmpDeliveries.Remove(ctx, collections.Join(channelId, sequence)); err != nil {
+		return errors.Wrap(err, "error removing gmp delivery")
+	}
+
+	if status != types.GmpDeliveryStatusAcknowledged && delivery.Fee.IsPositive() {
+		subscription, found, err := k.GetGmpSubscription(ctx, delivery.SubscriptionId)
+		if err != nil {
+			return err
+		}
+		if found {
+			subscription.Balance = subscription.Balance.Add(delivery.Fee)
+			if err := k.SetGmpSubscription(ctx, subscription); err != nil {
+				return err
+			}
+		} else {
+			subscriber, err := sdk.AccAddressFromBech32(delivery.Subscriber)
+			if err != nil {
+				return errors.Wrap(err, "error getting subscriber address")
+			}
+			err = k.bankKeeper.SendCoins(ctx, types.GmpFeeEscrowAddress, subscriber, gmpFeeCoins(delivery.Fee))
+			if err != nil {
+				return errors.Wrap(err, "error refunding gmp delivery fee")
+			}
+		}
+	}
+	types.EmitNewGmpDeliveryEvent(sdk.UnwrapSDKContext(ctx), delivery, status)
+	return nil
+}
+
+// Genesis helpers
+
+func (k *Keeper) GetNextGmpSubscriptionId(ctx context.Context) (uint64, error) {
+	return k.nextGmpSubscriptionId.Peek(ctx)
+}
+
+func (k *Keeper) SetNextGmpSubscriptionId(ctx context.Context, id uint64) error {
+	return k.nextGmpSubscriptionId.Set(ctx, id)
+}
+
+func (k *Keeper) GetAllGmpSubscriptions(ctx context.Context) ([]types.GmpSubscription, error) {
+	iter, err := k.gmpSubscriptions.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error iterating gmp subscriptions")
+	}
+	return iter.Values()
+}
+
+func (k *Keeper) GetAllGmpDeliveries(ctx context.Context) ([]types.GmpDelivery, error) {
+	iter, err := k.gmpDeliveries.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error iterating gmp deliveries")
+	}
+	return iter.Values()
+}
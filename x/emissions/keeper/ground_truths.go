@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	lossfunctions "github.com/allora-network/allora-chain/x/emissions/keeper/loss_functions"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Returns the loss function the chain evaluates for a topic loss method, if it knows one
+func (k *Keeper) GetLossFunction(lossMethod string) (lossfunctions.LossFunction, bool) {
+	return k.lossFunctions.Get(lossMethod)
+}
+
+// Makes an additional loss method known to the chain. Must be called while wiring the app,
+// identically on every node, as topic creation and loss cross-checks depend on it.
+func (k *Keeper) RegisterLossFunction(lossMethod string, f lossfunctions.LossFunction) error {
+	return k.lossFunctions.Register(lossMethod, f)
+}
+
+// Returns the loss methods known to the chain
+func (k *Keeper) GetLossMethods() []string {
+	return k.lossFunctions.LossMethods()
+}
+
+// Returns the ground truth submitted for a reputer nonce, if any
+func (k *Keeper) GetGroundTruth(ctx context.Context, topicId TopicId, nonceBlockHeight BlockHeight) (alloraMath.Dec, bool, error) {
+	groundTruth, err := k.groundTruths.Get(ctx, collections.Join(topicId, nonceBlockHeight))
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return alloraMath.Dec{}, false, nil
+	} else if err != nil {
+		return alloraMath.Dec{}, false, err
+	}
+	return groundTruth, true, nil
+}
+
+func (k *Keeper) SetGroundTruth(ctx context.Context, topicId TopicId, nonceBlockHeight BlockHeight, groundTruth alloraMath.Dec) error {
+	if err := types.ValidateTopicId(topicId); err != nil {
+		return errors.Wrap(err, "topicId is not valid")
+	}
+	if err := types.ValidateBlockHeight(nonceBlockHeight); err != nil {
+		return errors.Wrap(err, "nonce block height is not valid")
+	}
+	if err := types.ValidateDec(groundTruth); err != nil {
+		return errors.Wrap(err, "ground truth is not valid")
+	}
+	return k.groundTruths.Set(ctx, collections.Join(topicId, nonceBlockHeight), groundTruth)
+}
+
+// Recomputes the loss of every inference accepted at a worker nonce against the ground truth,
+// using the loss function of the topic loss method. Only scalar topics have ground truths.
+func (k *Keeper) CalcInfererLossesFromGroundTruth(
+	ctx context.Context,
+	topic types.Topic,
+	nonceBlockHeight BlockHeight,
+	groundTruth alloraMath.Dec,
+) (map[ActorId]alloraMath.Dec, error) {
+	lossFunction, ok := k.GetLossFunction(topic.LossMethod)
+	if !ok {
+		return nil, errors.Wrapf(types.ErrUnknownLossMethod, "topic %d loss method %s", topic.Id, topic.LossMethod)
+	}
+	if topic.InferenceDimension() > 1 {
+		return nil, errors.Wrapf(types.ErrInvalidInferenceDimension, "cannot score vector-valued inferences of topic %d against a scalar ground truth", topic.Id)
+	}
+	inferences, err := k.GetInferencesAtBlock(ctx, topic.Id, nonceBlockHeight)
+	if err != nil {
+		return nil, err
+	}
+	losses := make(map[ActorId]alloraMath.Dec, len(inferences.Inferences))
+	for _, inference := range inferences.Inferences {
+		loss, err := lossFunction.Loss(inference.Value, groundTruth)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error computing loss of inferer %s", inference.Inferer)
+		}
+		losses[inference.Inferer] = loss
+	}
+	return losses, nil
+}
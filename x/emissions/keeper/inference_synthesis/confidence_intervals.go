@@ -0,0 +1,64 @@
+package inferencesynthesis
+
+import (
+	alloraMath "github.com/allora-network/allora-chain/math"
+	emissions "github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Raw percentiles of the confidence intervals of network inferences,
+// at -2, -1, 0, 1 and 2 standard deviations of a normal distribution
+func ConfidenceIntervalRawPercentiles() []alloraMath.Dec {
+	return []alloraMath.Dec{
+		alloraMath.MustNewDecFromString("2.28"),
+		alloraMath.MustNewDecFromString("15.87"),
+		alloraMath.MustNewDecFromString("50"),
+		alloraMath.MustNewDecFromString("84.13"),
+		alloraMath.MustNewDecFromString("97.72"),
+	}
+}
+
+// Calculates the confidence intervals of network inferences as the weighted percentiles of the inferences
+// and forecast-implied inferences they combine. Vector-valued inferences get a confidence interval per
+// dimension, their values following one another, one per raw percentile.
+func CalcConfidenceIntervals(
+	networkInferences *emissions.ValueBundle,
+	infererWeights map[Inferer]Weight,
+	forecasterWeights map[Forecaster]Weight,
+) (ciRawPercentiles []alloraMath.Dec, ciValues []alloraMath.Dec, err error) {
+	ciRawPercentiles = ConfidenceIntervalRawPercentiles()
+
+	dimension := len(networkInferences.CombinedValueVector())
+	for i := 0; i < dimension; i++ {
+		var inferences []alloraMath.Dec // from inferers + forecast-implied inferences
+		var weights []alloraMath.Dec    // weights of all workers
+
+		for _, inference := range networkInferences.InfererValues {
+			weight, exists := infererWeights[inference.Worker]
+			values := inference.ValueVector()
+			if exists && len(values) == dimension {
+				inferences = append(inferences, values[i])
+				weights = append(weights, weight)
+			}
+		}
+
+		for _, forecast := range networkInferences.ForecasterValues {
+			weight, exists := forecasterWeights[forecast.Worker]
+			values := forecast.ValueVector()
+			if exists && len(values) == dimension {
+				inferences = append(inferences, values[i])
+				weights = append(weights, weight)
+			}
+		}
+
+		if len(inferences) == 0 {
+			return []alloraMath.Dec{}, []alloraMath.Dec{}, nil
+		}
+		dimensionCiValues, err := alloraMath.WeightedPercentile(inferences, weights, ciRawPercentiles)
+		if err != nil {
+			return nil, nil, err
+		}
+		ciValues = append(ciValues, dimensionCiValues...)
+	}
+
+	return ciRawPercentiles, ciValues, nil
+}
@@ -32,6 +32,9 @@ type CalcForecastImpliedInferencesArgs struct {
 // Forecast without inference => weight in calculation of I_ik and I_i set to 0. Use latest available regret R_i-1,l
 // Inference without forecast => only weight in calculation of I_ik set to 0
 // A value of 0 => no inference corresponded to any of the forecasts from a forecaster
+//
+// Forecast elements forecast the loss of an inferer, which is aggregated over all dimensions of
+// vector-valued inferences, so the same weights w_ijk combine every dimension of the inferences.
 func CalcForecastImpliedInferences(args CalcForecastImpliedInferencesArgs) (
 	forecasterToForecastImpliedInference map[Forecaster]*emissionstypes.Inference,
 	infererToRegretOut map[Inferer]*Regret,
@@ -61,8 +64,8 @@ func CalcForecastImpliedInferences(args CalcForecastImpliedInferencesArgs) (
 				}
 			}
 
-			weightSum := alloraMath.ZeroDec()                 // denominator in calculation of forecast-implied inferences
-			weightInferenceDotProduct := alloraMath.ZeroDec() // numerator in calculation of forecast-implied inferences
+			weightSum := alloraMath.ZeroDec()            // denominator in calculation of forecast-implied inferences
+			var weightInferenceDotProduct InferenceValue // numerator in calculation of forecast-implied inferences
 
 			blockHeight := int64(0)
 
@@ -71,30 +74,23 @@ func CalcForecastImpliedInferences(args CalcForecastImpliedInferencesArgs) (
 				// If all inferers are new, calculate the median of the inferences
 				// This means that forecasters won't be able to influence the network inference when all inferers are new
 				// However, this seeds losses for forecasters for future rounds
-				inferenceValues := make([]alloraMath.Dec, 0, len(sortedInferersInForecast))
+				inferenceValues := make([]InferenceValue, 0, len(sortedInferersInForecast))
 				for _, inferer := range sortedInferersInForecast {
 					inference, ok := args.InfererToInference[inferer]
 					if ok {
-						inferenceValues = append(inferenceValues, inference.Value)
+						inferenceValues = append(inferenceValues, inference.ValueVector())
 						if blockHeight == 0 {
 							blockHeight = inference.BlockHeight
 						}
 					}
 				}
 
-				medianValue, err := alloraMath.Median(inferenceValues)
+				median, err := medianValue(inferenceValues)
 				if err != nil {
 					return nil, nil, nil, errorsmod.Wrapf(err, "error calculating median of inference values")
 				}
 
-				forecastImpliedInference := emissionstypes.Inference{
-					TopicId:     args.TopicId,
-					BlockHeight: blockHeight,
-					Inferer:     forecaster,
-					Value:       medianValue,
-					ExtraData:   nil,
-					Proof:       "",
-				}
+				forecastImpliedInference := newInference(args.TopicId, blockHeight, forecaster, median)
 				forecasterToForecastImpliedInference[forecaster] = &forecastImpliedInference
 			} else {
 				// If not all inferers are new, calculate forecast-implied inferences using the previous inferer regrets and previous network loss
@@ -160,12 +156,8 @@ func CalcForecastImpliedInferences(args CalcForecastImpliedInferencesArgs) (
 						blockHeight = inference.BlockHeight
 					}
 					if ok && !(weightIJK.Equal(alloraMath.ZeroDec())) {
-						thisDotProduct, err := weightIJK.Mul(args.InfererToInference[infererInForecast].Value)
-						if err != nil {
-							return nil, nil, nil, errorsmod.Wrapf(err,
-								"error calculating forecast-implied inferences: error calculating dot product")
-						}
-						weightInferenceDotProduct, err = weightInferenceDotProduct.Add(thisDotProduct)
+						weightInferenceDotProduct, err = addWeightedValue(
+							weightInferenceDotProduct, weightIJK, args.InfererToInference[infererInForecast].ValueVector())
 						if err != nil {
 							return nil, nil, nil, errorsmod.Wrapf(err,
 								"error calculating forecast-implied inferences: error adding dot product")
@@ -179,18 +171,11 @@ func CalcForecastImpliedInferences(args CalcForecastImpliedInferencesArgs) (
 				}
 
 				if !weightSum.Equal(alloraMath.ZeroDec()) {
-					forecastValue, err := weightInferenceDotProduct.Quo(weightSum)
+					forecastValue, err := quoValue(weightInferenceDotProduct, weightSum)
 					if err != nil {
 						return nil, nil, nil, errorsmod.Wrapf(err, "error calculating forecast value")
 					}
-					forecastImpliedInference := emissionstypes.Inference{
-						TopicId:     args.TopicId,
-						BlockHeight: blockHeight,
-						Inferer:     forecaster,
-						Value:       forecastValue,
-						ExtraData:   nil,
-						Proof:       "",
-					}
+					forecastImpliedInference := newInference(args.TopicId, blockHeight, forecaster, forecastValue)
 					forecasterToForecastImpliedInference[forecaster] = &forecastImpliedInference
 				}
 			}
@@ -77,10 +77,7 @@ func getInferences(
 ) (infererValues []*emissions.WorkerAttributedValue) {
 	infererValues = make([]*emissions.WorkerAttributedValue, 0)
 	for _, inferer := range inferers {
-		infererValues = append(infererValues, &emissions.WorkerAttributedValue{
-			Worker: inferer,
-			Value:  infererToInference[inferer].Value,
-		})
+		infererValues = append(infererValues, newWorkerAttributedValue(inferer, infererToInference[inferer].ValueVector()))
 	}
 	return infererValues
 }
@@ -97,10 +94,9 @@ func getForecastImpliedInferences(
 			logger.Warn(fmt.Sprintf("getForecastImpliedInferences() no forecast-implied inference for forecaster %s", forecaster))
 			continue
 		}
-		forecastImpliedInferences = append(forecastImpliedInferences, &emissions.WorkerAttributedValue{
-			Worker: forecasterToForecastImpliedInference[forecaster].Inferer,
-			Value:  forecasterToForecastImpliedInference[forecaster].Value,
-		})
+		forecastImpliedInference := forecasterToForecastImpliedInference[forecaster]
+		forecastImpliedInferences = append(forecastImpliedInferences,
+			newWorkerAttributedValue(forecastImpliedInference.Inferer, forecastImpliedInference.ValueVector()))
 	}
 	return forecastImpliedInferences
 }
@@ -124,7 +120,7 @@ type GetNaiveInferenceArgs struct {
 }
 
 // Calculates the network naive inference I^-_i
-func GetNaiveInference(args GetNaiveInferenceArgs) (naiveInference alloraMath.Dec, err error) {
+func GetNaiveInference(args GetNaiveInferenceArgs) (naiveInference InferenceValue, err error) {
 	args.Logger.Debug(fmt.Sprintf("Calculating naive inference for topic %v", args.TopicId))
 
 	// Get inferer naive regrets
@@ -132,7 +128,7 @@ func GetNaiveInference(args GetNaiveInferenceArgs) (naiveInference alloraMath.De
 	for _, inferer := range args.Inferers {
 		regret, _, err := args.K.GetNaiveInfererNetworkRegret(args.Ctx, args.TopicId, inferer)
 		if err != nil {
-			return alloraMath.Dec{}, errorsmod.Wrapf(err, "GetNaiveInference() error getting naive regret for inferer %s", inferer)
+			return nil, errorsmod.Wrapf(err, "GetNaiveInference() error getting naive regret for inferer %s", inferer)
 		}
 		infererToRegret[inferer] = &regret.Value
 	}
@@ -150,7 +146,7 @@ func GetNaiveInference(args GetNaiveInferenceArgs) (naiveInference alloraMath.De
 		},
 	)
 	if err != nil {
-		return alloraMath.Dec{}, errorsmod.Wrap(err, "GetNaiveInference() error calculating weights for naive inference")
+		return nil, errorsmod.Wrap(err, "GetNaiveInference() error calculating weights for naive inference")
 	}
 
 	naiveInference, err = calcWeightedInference(calcWeightedInferenceArgs{
@@ -166,7 +162,7 @@ func GetNaiveInference(args GetNaiveInferenceArgs) (naiveInference alloraMath.De
 		epsilonSafeDiv:                       args.EpsilonSafeDiv,
 	})
 	if err != nil {
-		return alloraMath.Dec{}, errorsmod.Wrap(err, "GetNaiveInference() error calculating naive inference")
+		return nil, errorsmod.Wrap(err, "GetNaiveInference() error calculating naive inference")
 	}
 
 	args.Logger.Debug(fmt.Sprintf("Naive inference calculated for topic %v is %v", args.TopicId, naiveInference))
@@ -196,7 +192,7 @@ type CalcOneOutInfererInferenceArgs struct {
 
 // Calculate the one-out inference given a withheld inferer
 func calcOneOutInfererInference(args CalcOneOutInfererInferenceArgs) (
-	oneOutNetworkInferenceWithoutInferer alloraMath.Dec,
+	oneOutNetworkInferenceWithoutInferer InferenceValue,
 	err error,
 ) {
 	args.Logger.Debug(fmt.Sprintf(
@@ -221,7 +217,7 @@ func calcOneOutInfererInference(args CalcOneOutInfererInferenceArgs) (
 		//over every inferer
 		regret, _, err := args.K.GetOneOutInfererInfererNetworkRegret(args.Ctx, args.TopicId, args.WithheldInferer, inferer)
 		if err != nil {
-			return alloraMath.Dec{}, errorsmod.Wrapf(err, "calcOneOutInfererInference() error getting one-out inferer regret")
+			return nil, errorsmod.Wrapf(err, "calcOneOutInfererInference() error getting one-out inferer regret")
 		}
 		remainingInfererRegrets[inferer] = &regret.Value
 	}
@@ -246,7 +242,7 @@ func calcOneOutInfererInference(args CalcOneOutInfererInferenceArgs) (
 		},
 	)
 	if err != nil {
-		return alloraMath.Dec{}, errorsmod.Wrapf(err, "calcOneOutInfererInference() error recalculating forecast-implied inferences")
+		return nil, errorsmod.Wrapf(err, "calcOneOutInfererInference() error recalculating forecast-implied inferences")
 	}
 
 	// Get regrets for the forecasters
@@ -254,7 +250,7 @@ func calcOneOutInfererInference(args CalcOneOutInfererInferenceArgs) (
 	for _, forecaster := range args.Forecasters {
 		regret, _, err := args.K.GetOneOutInfererForecasterNetworkRegret(args.Ctx, args.TopicId, args.WithheldInferer, forecaster)
 		if err != nil {
-			return alloraMath.Dec{}, errorsmod.Wrapf(err, "calcOneOutInfererInference() error getting one-out forecaster regret")
+			return nil, errorsmod.Wrapf(err, "calcOneOutInfererInference() error getting one-out forecaster regret")
 		}
 		remainingForecasterRegrets[forecaster] = &regret.Value
 	}
@@ -272,7 +268,7 @@ func calcOneOutInfererInference(args CalcOneOutInfererInferenceArgs) (
 		},
 	)
 	if err != nil {
-		return alloraMath.Dec{}, errorsmod.Wrapf(err, "calcOneOutInfererInference() error calculating one-out inference for forecaster")
+		return nil, errorsmod.Wrapf(err, "calcOneOutInfererInference() error calculating one-out inference for forecaster")
 	}
 
 	oneOutNetworkInferenceWithoutInferer, err = calcWeightedInference(calcWeightedInferenceArgs{
@@ -288,7 +284,7 @@ func calcOneOutInfererInference(args CalcOneOutInfererInferenceArgs) (
 		epsilonSafeDiv:                       args.EpsilonSafeDiv,
 	})
 	if err != nil {
-		return alloraMath.Dec{}, errorsmod.Wrapf(err, "calcOneOutInfererInference() error calculating one-out inference for inferer")
+		return nil, errorsmod.Wrapf(err, "calcOneOutInfererInference() error calculating one-out inference for inferer")
 	}
 
 	args.Logger.Debug(fmt.Sprintf("One-out inference calculated for topic %v withheld inferer %s is %v", args.TopicId, args.WithheldInferer, oneOutNetworkInferenceWithoutInferer))
@@ -355,10 +351,7 @@ func GetOneOutInfererInferences(args GetOneOutInfererInferencesArgs) (
 			return []*emissions.WithheldWorkerAttributedValue{}, errorsmod.Wrapf(err, "GetOneOutInfererInferences() error calculating one-out inferer inferences")
 		}
 
-		oneOutInferences = append(oneOutInferences, &emissions.WithheldWorkerAttributedValue{
-			Worker: worker,
-			Value:  oneOutInference,
-		})
+		oneOutInferences = append(oneOutInferences, newWithheldWorkerAttributedValue(worker, oneOutInference))
 	}
 
 	args.Logger.Debug(fmt.Sprintf("One-out inferer inferences calculated for topic %v", args.TopicId))
@@ -387,7 +380,7 @@ type CalcOneOutForecasterInferenceArgs struct {
 
 // Calculate the one-out inference given a withheld forecaster
 func calcOneOutForecasterInference(args CalcOneOutForecasterInferenceArgs) (
-	oneOutNetworkInferenceWithoutInferer alloraMath.Dec,
+	oneOutNetworkInferenceWithoutInferer InferenceValue,
 	err error,
 ) {
 	args.Logger.Debug(fmt.Sprintf("Calculating one-out inference for topic %v withheld forecaster %s", args.TopicId, args.WithheldForecaster))
@@ -402,13 +395,13 @@ func calcOneOutForecasterInference(args CalcOneOutForecasterInferenceArgs) (
 
 			regret, _, err := args.K.GetOneOutForecasterForecasterNetworkRegret(args.Ctx, args.TopicId, args.WithheldForecaster, forecaster)
 			if err != nil {
-				return alloraMath.Dec{}, errorsmod.Wrapf(err, "calcOneOutForecasterInference() error getting one-out forecaster regret")
+				return nil, errorsmod.Wrapf(err, "calcOneOutForecasterInference() error getting one-out forecaster regret")
 			}
 			remainingForecasterRegrets[forecaster] = &regret.Value
 
 			forecast, ok := args.ForecasterToForecast[forecaster]
 			if !ok {
-				return alloraMath.Dec{}, errorsmod.Wrapf(emissions.ErrNotFound, "calcOneOutForecasterInference() cannot find forecaster in ForecasterRegrets %v", forecaster)
+				return nil, errorsmod.Wrapf(emissions.ErrNotFound, "calcOneOutForecasterInference() cannot find forecaster in ForecasterRegrets %v", forecaster)
 			}
 			remainingForecasterToForecast[forecaster] = forecast
 		}
@@ -419,7 +412,7 @@ func calcOneOutForecasterInference(args CalcOneOutForecasterInferenceArgs) (
 	for _, inferer := range args.Inferers {
 		regret, _, err := args.K.GetOneOutForecasterInfererNetworkRegret(args.Ctx, args.TopicId, args.WithheldForecaster, inferer)
 		if err != nil {
-			return alloraMath.Dec{}, errorsmod.Wrapf(err, "calcOneOutForecasterInference() error getting one-out inferer regret")
+			return nil, errorsmod.Wrapf(err, "calcOneOutForecasterInference() error getting one-out inferer regret")
 		}
 		remainingInfererRegrets[inferer] = &regret.Value
 	}
@@ -437,7 +430,7 @@ func calcOneOutForecasterInference(args CalcOneOutForecasterInferenceArgs) (
 		},
 	)
 	if err != nil {
-		return alloraMath.Dec{}, errorsmod.Wrapf(err, "calcOneOutForecasterInference() error calculating one-out inference for forecaster")
+		return nil, errorsmod.Wrapf(err, "calcOneOutForecasterInference() error calculating one-out inference for forecaster")
 	}
 
 	oneOutNetworkInferenceWithoutInferer, err = calcWeightedInference(calcWeightedInferenceArgs{
@@ -453,7 +446,7 @@ func calcOneOutForecasterInference(args CalcOneOutForecasterInferenceArgs) (
 		epsilonSafeDiv:                       args.EpsilonSafeDiv,
 	})
 	if err != nil {
-		return alloraMath.Dec{}, errorsmod.Wrapf(err, "calcOneOutForecasterInference() error calculating one-out inference for inferer")
+		return nil, errorsmod.Wrapf(err, "calcOneOutForecasterInference() error calculating one-out inference for inferer")
 	}
 
 	args.Logger.Debug(fmt.Sprintf("One-out inference calculated for topic %v withheld forecaster %s is %v", args.TopicId, args.WithheldForecaster, oneOutNetworkInferenceWithoutInferer))
@@ -515,10 +508,7 @@ func GetOneOutForecasterInferences(args GetOneOutForecasterInferencesArgs) (
 			if err != nil {
 				return []*emissions.WithheldWorkerAttributedValue{}, errorsmod.Wrapf(err, "GetOneOutForecasterInferences() error calculating one-out forecaster inferences")
 			}
-			oneOutForecasterInferences = append(oneOutForecasterInferences, &emissions.WithheldWorkerAttributedValue{
-				Worker: worker,
-				Value:  oneOutInference,
-			})
+			oneOutForecasterInferences = append(oneOutForecasterInferences, newWithheldWorkerAttributedValue(worker, oneOutInference))
 		}
 		args.Logger.Debug(fmt.Sprintf("One-out forecaster inferences calculated for topic %v", args.TopicId))
 	}
@@ -545,7 +535,7 @@ type calcOneInValueArgs struct {
 
 // Calculate the one-in inference given a withheld forecaster
 func calcOneInValue(args calcOneInValueArgs) (
-	oneInInference alloraMath.Dec,
+	oneInInference InferenceValue,
 	err error,
 ) {
 	args.Logger.Debug(fmt.Sprintf("Calculating one-in inference for forecaster: %s", args.OneInForecaster))
@@ -558,7 +548,7 @@ func calcOneInValue(args calcOneInValueArgs) (
 	singleForecasterRegret := make(map[Worker]*Regret, 1)
 	regret, _, err := args.K.GetOneInForecasterNetworkRegret(args.Ctx, args.TopicId, args.OneInForecaster, args.OneInForecaster)
 	if err != nil {
-		return alloraMath.Dec{}, errorsmod.Wrapf(err, "CalcOneInValue() error getting one-in forecaster regret")
+		return nil, errorsmod.Wrapf(err, "CalcOneInValue() error getting one-in forecaster regret")
 	}
 	singleForecasterRegret[args.OneInForecaster] = &regret.Value
 
@@ -575,7 +565,7 @@ func calcOneInValue(args calcOneInValueArgs) (
 	for _, inferer := range args.Inferers {
 		regret, _, err := args.K.GetOneInForecasterNetworkRegret(args.Ctx, args.TopicId, args.OneInForecaster, inferer)
 		if err != nil {
-			return alloraMath.Dec{}, errorsmod.Wrapf(err, "CalcOneInValue() error getting one-in forecaster regret")
+			return nil, errorsmod.Wrapf(err, "CalcOneInValue() error getting one-in forecaster regret")
 		}
 		infererToRegretForSingleForecaster[inferer] = &regret.Value
 
@@ -600,7 +590,7 @@ func calcOneInValue(args calcOneInValueArgs) (
 		},
 	)
 	if err != nil {
-		return alloraMath.Dec{}, errorsmod.Wrapf(err, "CalcOneInValue() error calculating weights for one-in inferences")
+		return nil, errorsmod.Wrapf(err, "CalcOneInValue() error calculating weights for one-in inferences")
 	}
 	// Calculate the network inference with just this forecaster's forecast-implied inference
 	oneInInference, err = calcWeightedInference(calcWeightedInferenceArgs{
@@ -616,7 +606,7 @@ func calcOneInValue(args calcOneInValueArgs) (
 		epsilonSafeDiv:                       args.EpsilonSafeDiv,
 	})
 	if err != nil {
-		return alloraMath.Dec{}, errorsmod.Wrapf(err, "CalcOneInValue() error calculating one-in inference")
+		return nil, errorsmod.Wrapf(err, "CalcOneInValue() error calculating one-in inference")
 	}
 
 	return oneInInference, nil
@@ -673,10 +663,7 @@ func GetOneInForecasterInferences(args GetOneInForecasterInferencesArgs) (
 			if err != nil {
 				return []*emissions.WorkerAttributedValue{}, errorsmod.Wrapf(err, "GetOneInForecasterInferences() error calculating one-in inferences")
 			}
-			oneInInferences = append(oneInInferences, &emissions.WorkerAttributedValue{
-				Worker: oneInForecaster,
-				Value:  oneInValue,
-			})
+			oneInInferences = append(oneInInferences, newWorkerAttributedValue(oneInForecaster, oneInValue))
 		}
 	}
 	return oneInInferences, err
@@ -855,6 +842,8 @@ func CalcNetworkInferences(
 
 	// Build value bundle to return all the calculated inferences
 	// ATTN: PROTO-2464
+	combinedValue, combinedValues := splitBundleValue(combinedInference)
+	naiveValue, naiveValues := splitBundleValue(naiveInference)
 	return &emissions.ValueBundle{
 		TopicId: args.TopicId,
 		ReputerRequestNonce: &emissions.ReputerRequestNonce{
@@ -862,13 +851,15 @@ func CalcNetworkInferences(
 		},
 		Reputer:                       "allo1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqas6usy",
 		ExtraData:                     nil,
-		CombinedValue:                 combinedInference,
+		CombinedValue:                 combinedValue,
 		InfererValues:                 inferences,
 		ForecasterValues:              forecastImpliedInferences,
-		NaiveValue:                    naiveInference,
+		NaiveValue:                    naiveValue,
 		OneOutInfererValues:           oneOutInfererInferences,
 		OneOutForecasterValues:        oneOutForecasterInferences,
 		OneInForecasterValues:         oneInForecasterInferences,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                combinedValues,
+		NaiveValues:                   naiveValues,
 	}, weights, err
 }
@@ -113,7 +113,7 @@ func (s *InferenceSynthesisTestSuite) SetupTest() {
 		authtypes.FeeCollectorName,
 	)
 	s.key = key
-	appModule := module.NewAppModule(encCfg.Codec, s.emissionsKeeper)
+	appModule := module.NewAppModule(encCfg.Codec, s.emissionsKeeper, accountKeeper, bankKeeper)
 	defaultGenesis := appModule.DefaultGenesis(encCfg.Codec)
 	appModule.InitGenesis(ctx, encCfg.Codec, defaultGenesis)
 	s.appModule = appModule
@@ -643,7 +643,8 @@ func (s *InferenceSynthesisTestSuite) testCorrectCombinedInitialValueForEpoch(ep
 			CNorm:                                cNorm,
 		})
 	s.Require().NoError(err)
-	alloratestutil.InEpsilon5(s.T(), combinedValue, epochGet[epoch]("network_inference").String())
+	s.Require().Len(combinedValue, 1)
+	alloratestutil.InEpsilon5(s.T(), combinedValue[0], epochGet[epoch]("network_inference").String())
 }
 
 func (s *InferenceSynthesisTestSuite) TestCorrectCombinedValueEpoch2() {
@@ -681,7 +682,8 @@ func (s *InferenceSynthesisTestSuite) testCorrectNaiveValueForEpoch(epoch int) {
 			CNorm:                                cNorm,
 		})
 	s.Require().NoError(err)
-	alloratestutil.InEpsilon5(s.T(), naiveValue, epochGet[epoch]("network_naive_inference").String())
+	s.Require().Len(naiveValue, 1)
+	alloratestutil.InEpsilon5(s.T(), naiveValue[0], epochGet[epoch]("network_naive_inference").String())
 }
 
 func (s *InferenceSynthesisTestSuite) TestCorrectNaiveValueEpoch2() {
@@ -88,12 +88,13 @@ func calcNetworkInferencesMultipleByMedian(
 	inferences *emissions.Inferences,
 	inferenceBlockHeight BlockHeight,
 ) (*GetNetworkInferencesResult, error) {
-	inferenceValues := fn.Map(inferences.Inferences, func(inf *emissions.Inference) alloraMath.Dec { return inf.Value })
+	inferenceValues := fn.Map(inferences.Inferences, func(inf *emissions.Inference) InferenceValue { return inf.ValueVector() })
 
-	medianValue, err := alloraMath.Median(inferenceValues)
+	median, err := medianValue(inferenceValues)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "while calculating median")
 	}
+	combinedValue, combinedValues := splitBundleValue(median)
 
 	networkInferences := &emissions.ValueBundle{
 		TopicId:   topicId,
@@ -102,9 +103,9 @@ func calcNetworkInferencesMultipleByMedian(
 			ReputerNonce: &emissions.Nonce{BlockHeight: ctx.BlockHeight()},
 		},
 		Reputer:       "allo1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqas6usy",
-		CombinedValue: medianValue,
+		CombinedValue: combinedValue,
 		InfererValues: fn.Map(inferences.Inferences, func(inf *emissions.Inference) *emissions.WorkerAttributedValue {
-			return &emissions.WorkerAttributedValue{Worker: inf.Inferer, Value: inf.Value}
+			return newWorkerAttributedValue(inf.Inferer, inf.ValueVector())
 		}),
 		ForecasterValues:              nil,
 		NaiveValue:                    alloraMath.ZeroDec(),
@@ -112,6 +113,8 @@ func calcNetworkInferencesMultipleByMedian(
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                combinedValues,
+		NaiveValues:                   nil,
 	}
 	return &GetNetworkInferencesResult{
 		NetworkInferences:    networkInferences,
@@ -199,10 +202,7 @@ func calcNetworkInferencesSingle(
 		ExtraData:     nil,
 		CombinedValue: singleInference.Value,
 		InfererValues: []*emissions.WorkerAttributedValue{
-			{
-				Worker: singleInference.Inferer,
-				Value:  singleInference.Value,
-			},
+			newWorkerAttributedValue(singleInference.Inferer, singleInference.ValueVector()),
 		},
 		ForecasterValues:              nil,
 		NaiveValue:                    singleInference.Value,
@@ -210,6 +210,8 @@ func calcNetworkInferencesSingle(
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                singleInference.Values,
+		NaiveValues:                   singleInference.Values,
 	}
 	return &GetNetworkInferencesResult{
 		NetworkInferences:    networkInferences,
@@ -13,9 +13,11 @@ type TopicId = uint64
 type Regret = alloraMath.Dec
 type Loss = alloraMath.Dec
 type Weight = alloraMath.Dec
-type InferenceValue = alloraMath.Dec
 type Stake = cosmosMath.Int
 
+// Values of an inference, one per dimension of the topic. Scalar topics have a single dimension.
+type InferenceValue = []alloraMath.Dec
+
 // Need to differentiate between the two types of regrets because workers may complete tasks
 // for both roles and may have different regrets for those different roles
 type RegretInformedWeights struct {
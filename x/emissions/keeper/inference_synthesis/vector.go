@@ -0,0 +1,123 @@
+package inferencesynthesis
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	emissions "github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Returns an inference holding the given values, in Value if there is a single one
+// as for scalar topics, and in Values otherwise
+func newInference(topicId TopicId, blockHeight BlockHeight, inferer Worker, value InferenceValue) emissions.Inference {
+	inference := emissions.Inference{
+		TopicId:     topicId,
+		BlockHeight: blockHeight,
+		Inferer:     inferer,
+		Value:       alloraMath.ZeroDec(),
+		ExtraData:   nil,
+		Proof:       "",
+	}
+	if len(value) == 1 {
+		inference.Value = value[0]
+	} else {
+		inference.Values = value
+	}
+	return inference
+}
+
+// Returns the values attributed to a worker, in Value if there is a single one
+// as for scalar topics, and in Values otherwise
+func newWorkerAttributedValue(worker Worker, value InferenceValue) *emissions.WorkerAttributedValue {
+	if len(value) == 1 {
+		return &emissions.WorkerAttributedValue{Worker: worker, Value: value[0]}
+	}
+	return &emissions.WorkerAttributedValue{Worker: worker, Value: alloraMath.ZeroDec(), Values: value}
+}
+
+// Returns the values attributed to a withheld worker, in Value if there is a single one
+// as for scalar topics, and in Values otherwise
+func newWithheldWorkerAttributedValue(worker Worker, value InferenceValue) *emissions.WithheldWorkerAttributedValue {
+	if len(value) == 1 {
+		return &emissions.WithheldWorkerAttributedValue{Worker: worker, Value: value[0]}
+	}
+	return &emissions.WithheldWorkerAttributedValue{Worker: worker, Value: alloraMath.ZeroDec(), Values: value}
+}
+
+// Splits a network value into the scalar and per-dimension fields of a value bundle
+func splitBundleValue(value InferenceValue) (alloraMath.Dec, []alloraMath.Dec) {
+	if len(value) == 1 {
+		return value[0], nil
+	}
+	return alloraMath.ZeroDec(), value
+}
+
+// Returns running + weight * value, dimension by dimension.
+// A nil running value is treated as the zero vector of the dimension of value.
+func addWeightedValue(running InferenceValue, weight alloraMath.Dec, value InferenceValue) (InferenceValue, error) {
+	if running == nil {
+		running = zeroValue(len(value))
+	}
+	if len(running) != len(value) {
+		return nil, errorsmod.Wrapf(emissions.ErrInvalidInferenceDimension,
+			"cannot add value of dimension %d to value of dimension %d", len(value), len(running))
+	}
+	sum := make(InferenceValue, len(running))
+	for i := range running {
+		weighted, err := weight.Mul(value[i])
+		if err != nil {
+			return nil, err
+		}
+		sum[i], err = running[i].Add(weighted)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return sum, nil
+}
+
+// Returns value / divisor, dimension by dimension
+func quoValue(value InferenceValue, divisor alloraMath.Dec) (InferenceValue, error) {
+	quotient := make(InferenceValue, len(value))
+	for i := range value {
+		var err error
+		quotient[i], err = value[i].Quo(divisor)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return quotient, nil
+}
+
+// Returns the median of the values, dimension by dimension
+func medianValue(values []InferenceValue) (InferenceValue, error) {
+	if len(values) == 0 {
+		// Same as the median of no scalar values
+		return zeroValue(1), nil
+	}
+	dimension := len(values[0])
+	median := make(InferenceValue, dimension)
+	column := make([]alloraMath.Dec, len(values))
+	for i := 0; i < dimension; i++ {
+		for j, value := range values {
+			if len(value) != dimension {
+				return nil, errorsmod.Wrapf(emissions.ErrInvalidInferenceDimension,
+					"cannot take median of values of dimensions %d and %d", dimension, len(value))
+			}
+			column[j] = value[i]
+		}
+		var err error
+		median[i], err = alloraMath.Median(column)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return median, nil
+}
+
+func zeroValue(dimension int) InferenceValue {
+	value := make(InferenceValue, dimension)
+	for i := range value {
+		value[i] = alloraMath.ZeroDec()
+	}
+	return value
+}
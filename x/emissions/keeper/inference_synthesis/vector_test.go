@@ -0,0 +1,209 @@
+package inferencesynthesis_test
+
+import (
+	alloraMath "github.com/allora-network/allora-chain/math"
+	inferencesynthesis "github.com/allora-network/allora-chain/x/emissions/keeper/inference_synthesis"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Calculates the network inferences of two inferers and two forecasters for the given inferences
+func (s *InferenceSynthesisTestSuite) calcVectorTestNetworkInferences(
+	inferences *emissionstypes.Inferences,
+	allInferersAreNew bool,
+) *emissionstypes.ValueBundle {
+	k := s.emissionsKeeper
+	ctx := s.ctx
+	topicId := uint64(1)
+	blockHeight := int64(300)
+	worker1 := s.addrsStr[1]
+	worker2 := s.addrsStr[2]
+	worker3 := s.addrsStr[3]
+	worker4 := s.addrsStr[4]
+
+	forecasts := &emissionstypes.Forecasts{
+		Forecasts: []*emissionstypes.Forecast{
+			{
+				TopicId:     topicId,
+				BlockHeight: blockHeight,
+				Forecaster:  worker3,
+				ForecastElements: []*emissionstypes.ForecastElement{
+					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.6")},
+					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.8")},
+				},
+			},
+			{
+				TopicId:     topicId,
+				BlockHeight: blockHeight,
+				Forecaster:  worker4,
+				ForecastElements: []*emissionstypes.ForecastElement{
+					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.4")},
+					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.9")},
+				},
+			},
+		},
+	}
+
+	s.Require().NoError(k.SetInfererNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.2"), BlockHeight: blockHeight}))
+	s.Require().NoError(k.SetInfererNetworkRegret(ctx, topicId, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.3"), BlockHeight: blockHeight}))
+	s.Require().NoError(k.SetForecasterNetworkRegret(ctx, topicId, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.4"), BlockHeight: blockHeight}))
+	s.Require().NoError(k.SetForecasterNetworkRegret(ctx, topicId, worker4, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.5"), BlockHeight: blockHeight}))
+	s.Require().NoError(k.SetOneInForecasterNetworkRegret(ctx, topicId, worker3, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.2"), BlockHeight: blockHeight}))
+	s.Require().NoError(k.SetOneInForecasterNetworkRegret(ctx, topicId, worker4, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.4"), BlockHeight: blockHeight}))
+
+	networkCombinedLoss := alloraMath.MustNewDecFromString("0.2")
+	epsilonTopic := alloraMath.MustNewDecFromString("0.0001")
+	epsilonSafeDiv := alloraMath.MustNewDecFromString("0.0000001")
+	pNorm := alloraMath.MustNewDecFromString("2")
+	cNorm := alloraMath.MustNewDecFromString("0.75")
+
+	inferers, inferenceByWorker, infererRegrets,
+		_, forecasters, forecastByWorker,
+		forecasterRegrets, _ := s.getNetworkCalcArgs(
+		blockHeight, inferences, forecasts,
+		networkCombinedLoss, epsilonTopic, epsilonSafeDiv, pNorm, cNorm)
+
+	forecastImpliedInferenceByWorker, _, _, err := inferencesynthesis.CalcForecastImpliedInferences(
+		inferencesynthesis.CalcForecastImpliedInferencesArgs{
+			Logger:               ctx.Logger(),
+			TopicId:              topicId,
+			AllInferersAreNew:    allInferersAreNew,
+			Inferers:             inferers,
+			InfererToInference:   inferenceByWorker,
+			InfererToRegret:      infererRegrets,
+			Forecasters:          forecasters,
+			ForecasterToForecast: forecastByWorker,
+			ForecasterToRegret:   forecasterRegrets,
+			NetworkCombinedLoss:  networkCombinedLoss,
+			EpsilonTopic:         epsilonTopic,
+			PNorm:                pNorm,
+			CNorm:                cNorm,
+		},
+	)
+	s.Require().NoError(err)
+
+	valueBundle, _, err := inferencesynthesis.CalcNetworkInferences(inferencesynthesis.CalcNetworkInferencesArgs{
+		Ctx:                                  ctx,
+		K:                                    k,
+		Logger:                               ctx.Logger(),
+		TopicId:                              topicId,
+		Inferers:                             inferers,
+		InfererToInference:                   inferenceByWorker,
+		InfererToRegret:                      infererRegrets,
+		AllInferersAreNew:                    allInferersAreNew,
+		Forecasters:                          forecasters,
+		ForecasterToForecast:                 forecastByWorker,
+		ForecasterToRegret:                   forecasterRegrets,
+		ForecasterToForecastImpliedInference: forecastImpliedInferenceByWorker,
+		NetworkCombinedLoss:                  networkCombinedLoss,
+		EpsilonTopic:                         epsilonTopic,
+		EpsilonSafeDiv:                       epsilonSafeDiv,
+		PNorm:                                pNorm,
+		CNorm:                                cNorm,
+	})
+	s.Require().NoError(err)
+	return valueBundle
+}
+
+func (s *InferenceSynthesisTestSuite) requireVectorDimensionEqual(expected alloraMath.Dec, actual []alloraMath.Dec, dimension int) {
+	s.Require().Len(actual, 3)
+	s.Require().True(expected.Equal(actual[dimension]), "dimension %d: expected %s, got %s", dimension, expected, actual[dimension])
+}
+
+// Vector-valued inferences are synthesized dimension by dimension with the weights of their aggregated losses,
+// so each dimension of the network inferences matches the network inferences of that dimension alone
+func (s *InferenceSynthesisTestSuite) testVectorNetworkInferencesMatchPerDimension(allInferersAreNew bool) {
+	topicId := uint64(1)
+	blockHeight := int64(300)
+	worker1Values := []alloraMath.Dec{
+		alloraMath.MustNewDecFromString("0.5"),
+		alloraMath.MustNewDecFromString("-12.25"),
+		alloraMath.MustNewDecFromString("1000"),
+	}
+	worker2Values := []alloraMath.Dec{
+		alloraMath.MustNewDecFromString("0.7"),
+		alloraMath.MustNewDecFromString("3.5"),
+		alloraMath.MustNewDecFromString("1250.5"),
+	}
+
+	vectorBundle := s.calcVectorTestNetworkInferences(&emissionstypes.Inferences{
+		Inferences: []*emissionstypes.Inference{
+			{TopicId: topicId, BlockHeight: blockHeight, Inferer: s.addrsStr[1], Value: alloraMath.ZeroDec(), Values: worker1Values},
+			{TopicId: topicId, BlockHeight: blockHeight, Inferer: s.addrsStr[2], Value: alloraMath.ZeroDec(), Values: worker2Values},
+		},
+	}, allInferersAreNew)
+	s.Require().Len(vectorBundle.CombinedValues, 3)
+	s.Require().True(vectorBundle.CombinedValue.IsZero())
+
+	for dimension := range worker1Values {
+		scalarBundle := s.calcVectorTestNetworkInferences(&emissionstypes.Inferences{
+			Inferences: []*emissionstypes.Inference{
+				{TopicId: topicId, BlockHeight: blockHeight, Inferer: s.addrsStr[1], Value: worker1Values[dimension]},
+				{TopicId: topicId, BlockHeight: blockHeight, Inferer: s.addrsStr[2], Value: worker2Values[dimension]},
+			},
+		}, allInferersAreNew)
+		s.Require().Empty(scalarBundle.CombinedValues)
+
+		s.requireVectorDimensionEqual(scalarBundle.CombinedValue, vectorBundle.CombinedValues, dimension)
+		s.requireVectorDimensionEqual(scalarBundle.NaiveValue, vectorBundle.NaiveValues, dimension)
+		s.Require().Len(vectorBundle.InfererValues, len(scalarBundle.InfererValues))
+		for i, value := range scalarBundle.InfererValues {
+			s.requireVectorDimensionEqual(value.Value, vectorBundle.InfererValues[i].Values, dimension)
+		}
+		s.Require().Len(vectorBundle.ForecasterValues, len(scalarBundle.ForecasterValues))
+		for i, value := range scalarBundle.ForecasterValues {
+			s.requireVectorDimensionEqual(value.Value, vectorBundle.ForecasterValues[i].Values, dimension)
+		}
+		s.Require().Len(vectorBundle.OneOutInfererValues, len(scalarBundle.OneOutInfererValues))
+		for i, value := range scalarBundle.OneOutInfererValues {
+			s.requireVectorDimensionEqual(value.Value, vectorBundle.OneOutInfererValues[i].Values, dimension)
+		}
+		s.Require().Len(vectorBundle.OneOutForecasterValues, len(scalarBundle.OneOutForecasterValues))
+		for i, value := range scalarBundle.OneOutForecasterValues {
+			s.requireVectorDimensionEqual(value.Value, vectorBundle.OneOutForecasterValues[i].Values, dimension)
+		}
+		s.Require().Len(vectorBundle.OneInForecasterValues, len(scalarBundle.OneInForecasterValues))
+		for i, value := range scalarBundle.OneInForecasterValues {
+			s.requireVectorDimensionEqual(value.Value, vectorBundle.OneInForecasterValues[i].Values, dimension)
+		}
+	}
+}
+
+func (s *InferenceSynthesisTestSuite) TestVectorNetworkInferencesMatchPerDimension() {
+	s.testVectorNetworkInferencesMatchPerDimension(false)
+}
+
+func (s *InferenceSynthesisTestSuite) TestVectorNetworkInferencesMatchPerDimensionAllInferersNew() {
+	s.testVectorNetworkInferencesMatchPerDimension(true)
+}
+
+func (s *InferenceSynthesisTestSuite) TestVectorNetworkInferencesRejectMixedDimensions() {
+	topicId := uint64(1)
+	blockHeight := int64(300)
+	inferenceByWorker := map[string]*emissionstypes.Inference{
+		s.addrsStr[1]: {TopicId: topicId, BlockHeight: blockHeight, Inferer: s.addrsStr[1], Value: alloraMath.OneDec()},
+		s.addrsStr[2]: {
+			TopicId:     topicId,
+			BlockHeight: blockHeight,
+			Inferer:     s.addrsStr[2],
+			Value:       alloraMath.ZeroDec(),
+			Values:      []alloraMath.Dec{alloraMath.OneDec(), alloraMath.OneDec()},
+		},
+	}
+	zero := alloraMath.ZeroDec()
+
+	_, _, err := inferencesynthesis.GetCombinedInference(inferencesynthesis.GetCombinedInferenceArgs{
+		Logger:                               s.ctx.Logger(),
+		TopicId:                              topicId,
+		Inferers:                             []string{s.addrsStr[1], s.addrsStr[2]},
+		InfererToInference:                   inferenceByWorker,
+		InfererToRegret:                      map[string]*alloraMath.Dec{s.addrsStr[1]: &zero, s.addrsStr[2]: &zero},
+		AllInferersAreNew:                    true,
+		ForecasterToRegret:                   map[string]*alloraMath.Dec{},
+		ForecasterToForecastImpliedInference: map[string]*emissionstypes.Inference{},
+		EpsilonTopic:                         alloraMath.MustNewDecFromString("0.0001"),
+		EpsilonSafeDiv:                       alloraMath.MustNewDecFromString("0.0000001"),
+		PNorm:                                alloraMath.MustNewDecFromString("2"),
+		CNorm:                                alloraMath.MustNewDecFromString("0.75"),
+	})
+	s.Require().ErrorIs(err, emissionstypes.ErrInvalidInferenceDimension)
+}
@@ -148,16 +148,17 @@ type calcWeightedInferenceArgs struct {
 // I_i = Σ_l w_il I_il / Σ_l w_il
 // w_il = φ'_p(\hatR_i-1,l)
 // \hatR_i-1,l = R_i-1,l / |max_{l'}(R_i-1,l')|
-// given inferences, forecast-implied inferences, and network regrets
+// given inferences, forecast-implied inferences, and network regrets.
+// Vector-valued inferences are combined dimension by dimension with the same weights.
 func calcWeightedInference(args calcWeightedInferenceArgs) (InferenceValue, error) {
-	runningUnnormalizedI_i := alloraMath.ZeroDec() //nolint:revive // var-naming: don't use underscores in Go names
+	var runningUnnormalizedI_i InferenceValue //nolint:revive // var-naming: don't use underscores in Go names
 	sumWeights := alloraMath.ZeroDec()
 	err := error(nil)
 
 	// If all inferers are new, then the weight is 1 for all inferers
 	if args.allInferersAreNew {
 		for _, inferer := range args.inferers {
-			runningUnnormalizedI_i, err = runningUnnormalizedI_i.Add(args.workerToInference[inferer].Value)
+			runningUnnormalizedI_i, err = addWeightedValue(runningUnnormalizedI_i, alloraMath.OneDec(), args.workerToInference[inferer].ValueVector())
 			if err != nil {
 				return InferenceValue{}, errorsmod.Wrapf(err, "Error adding weight by worker value")
 			}
@@ -224,10 +225,13 @@ func calcWeightedInference(args calcWeightedInferenceArgs) (InferenceValue, erro
 	}
 
 	// Normalize the running unnormalized network inference to yield output
+	if runningUnnormalizedI_i == nil {
+		runningUnnormalizedI_i = zeroValue(1)
+	}
 	if sumWeights.Lt(args.epsilonSafeDiv) {
 		sumWeights = args.epsilonSafeDiv
 	}
-	ret, err := runningUnnormalizedI_i.Quo(sumWeights)
+	ret, err := quoValue(runningUnnormalizedI_i, sumWeights)
 	if err != nil {
 		return InferenceValue{}, errorsmod.Wrapf(err, "Error normalizing network inference")
 	}
@@ -282,9 +286,9 @@ func accumulateWeights(
 	inference emissionstypes.Inference,
 	weight alloraMath.Dec,
 	allPeersAreNew bool,
-	runningUnnormalizedI_i alloraMath.Dec, //nolint:revive // var-naming: don't use underscores in Go names
+	runningUnnormalizedI_i InferenceValue, //nolint:revive // var-naming: don't use underscores in Go names
 	sumWeights alloraMath.Dec,
-) (alloraMath.Dec, alloraMath.Dec, error) {
+) (InferenceValue, alloraMath.Dec, error) {
 	err := error(nil)
 
 	// Avoid needless computation if the weight is 0 or if there is no inference
@@ -296,26 +300,23 @@ func accumulateWeights(
 	// Otherwise, calculate the weight based on the regret of the worker
 	if allPeersAreNew {
 		// If all workers are new, then the weight is 1 for all workers; take regular average of inferences
-		runningUnnormalizedI_i, err = runningUnnormalizedI_i.Add(inference.Value)
+		runningUnnormalizedI_i, err = addWeightedValue(runningUnnormalizedI_i, alloraMath.OneDec(), inference.ValueVector())
 		if err != nil {
-			return alloraMath.ZeroDec(), alloraMath.ZeroDec(), errorsmod.Wrapf(err, "Error adding weight by worker value")
+			return nil, alloraMath.ZeroDec(), errorsmod.Wrapf(err, "Error adding weight by worker value")
 		}
 		sumWeights, err = sumWeights.Add(alloraMath.OneDec())
 		if err != nil {
-			return alloraMath.ZeroDec(), alloraMath.ZeroDec(), errorsmod.Wrapf(err, "Error adding weight")
+			return nil, alloraMath.ZeroDec(), errorsmod.Wrapf(err, "Error adding weight")
 		}
 	} else {
-		weightTimesInference, err := weight.Mul(inference.Value) // numerator of network combined inference calculation
-		if err != nil {
-			return alloraMath.ZeroDec(), alloraMath.ZeroDec(), errorsmod.Wrapf(err, "Error calculating weight by worker value")
-		}
-		runningUnnormalizedI_i, err = runningUnnormalizedI_i.Add(weightTimesInference)
+		// numerator of network combined inference calculation
+		runningUnnormalizedI_i, err = addWeightedValue(runningUnnormalizedI_i, weight, inference.ValueVector())
 		if err != nil {
-			return alloraMath.ZeroDec(), alloraMath.ZeroDec(), errorsmod.Wrapf(err, "Error adding weight by worker value")
+			return nil, alloraMath.ZeroDec(), errorsmod.Wrapf(err, "Error adding weight by worker value")
 		}
 		sumWeights, err = sumWeights.Add(weight)
 		if err != nil {
-			return alloraMath.ZeroDec(), alloraMath.ZeroDec(), errorsmod.Wrapf(err, "Error adding weight")
+			return nil, alloraMath.ZeroDec(), errorsmod.Wrapf(err, "Error adding weight")
 		}
 	}
 
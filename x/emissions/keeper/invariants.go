@@ -18,6 +18,7 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
 	ir.RegisterRoute(emissionstypes.ModuleName, "stake-removals-length-same", StakingInvariantLenStakeRemovalsSame(*k))
 	ir.RegisterRoute(emissionstypes.ModuleName, "stake-sum-delegated-stakes", StakingInvariantDelegatedStakes(*k))
 	ir.RegisterRoute(emissionstypes.ModuleName, "pending-reward-for-delegators-equal-reward-per-share-minus-reward-debt", StakingInvariantPendingRewardForDelegatorsGreaterThanRewardPerShareMinusRewardDebt(*k))
+	ir.RegisterRoute(emissionstypes.ModuleName, "accrued-rewards-equal-accrued-rewards-balance", RewardsInvariantAccruedRewardsEqualAccruedRewardsBankBalance(*k))
 }
 
 // AllInvariants is a convience function to run all invariants in the emissions module.
@@ -38,6 +39,9 @@ func AllInvariants(k Keeper) sdk.Invariant {
 		if res, stop := StakingInvariantPendingRewardForDelegatorsGreaterThanRewardPerShareMinusRewardDebt(k)(ctx); stop {
 			return res, stop
 		}
+		if res, stop := RewardsInvariantAccruedRewardsEqualAccruedRewardsBankBalance(k)(ctx); stop {
+			return res, stop
+		}
 		return "", false
 	}
 }
@@ -376,3 +380,35 @@ func StakingInvariantPendingRewardForDelegatorsGreaterThanRewardPerShareMinusRew
 		return "", false
 	}
 }
+
+// the rewards accrued in the ledger of every actor sum up to the total accrued rewards,
+// which equals the balance of the accrued rewards bank account
+func RewardsInvariantAccruedRewardsEqualAccruedRewardsBankBalance(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		accruedRewards, err := k.GetAllAccruedRewards(ctx)
+		if err != nil {
+			panic(fmt.Sprintf("failed to get accrued rewards: %v", err))
+		}
+		sumAccruedRewards := cosmosMath.ZeroInt()
+		for _, accrued := range accruedRewards {
+			sumAccruedRewards = sumAccruedRewards.Add(accrued.Int)
+		}
+		totalAccruedRewards, err := k.GetTotalAccruedRewards(ctx)
+		if err != nil {
+			panic(fmt.Sprintf("failed to get total accrued rewards: %v", err))
+		}
+		alloraAccruedAddr := k.authKeeper.GetModuleAccount(ctx, emissionstypes.AlloraAccruedRewardsAccountName).GetAddress()
+		alloraAccruedBankBal := k.GetBankBalance(ctx, alloraAccruedAddr, params.DefaultBondDenom).Amount
+
+		broken := !sumAccruedRewards.Equal(totalAccruedRewards) || !totalAccruedRewards.Equal(alloraAccruedBankBal)
+		return sdk.FormatInvariant(
+			emissionstypes.ModuleName,
+			"emissions module accrued rewards equal accrued rewards bank balance",
+			fmt.Sprintf("Sum of Accrued Rewards: %s | Total Accrued Rewards: %s | Allora Accrued Rewards Bank Balance: %s",
+				sumAccruedRewards.String(),
+				totalAccruedRewards.String(),
+				alloraAccruedBankBal.String(),
+			),
+		), broken
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"cosmossdk.io/core/address"
 
 	coreStore "cosmossdk.io/core/store"
+	lossfunctions "github.com/allora-network/allora-chain/x/emissions/keeper/loss_functions"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -77,6 +78,12 @@ type Keeper struct {
 	lowestForecasterScoreEma collections.Map[TopicId, types.Score]
 	// lowest reputer score ema for a topic
 	lowestReputerScoreEma collections.Map[TopicId, types.Score]
+	// topic updates waiting to be applied at the topic's next epoch boundary
+	scheduledTopicUpdates collections.Map[TopicId, types.ScheduledTopicUpdate]
+	// archive of retired topics and the progress of their garbage collection
+	topicRetirements collections.Map[TopicId, types.TopicRetirement]
+	// retired topics whose garbage collection has not yet completed
+	retiringTopics collections.KeySet[TopicId]
 
 	/// SCORES
 
@@ -123,10 +130,28 @@ type Keeper struct {
 	stakeSumFromDelegator collections.Map[collections.Pair[TopicId, Delegator], cosmosMath.Int]
 	// map of (topic id, delegator, reputer) -> amount of stake that has been placed by that delegator on that target
 	delegatedStakes collections.Map[collections.Triple[TopicId, Delegator, Reputer], types.DelegatorInfo]
+	// set of (topic id, reputer, delegator) for every delegation in delegatedStakes, indexing them by reputer
+	delegationsByReputer collections.KeySet[collections.Triple[TopicId, Reputer, Delegator]]
 	// map of (topic id, reputer) -> total amount of stake that has been placed on that reputer by delegators
 	stakeFromDelegatorsUponReputer collections.Map[collections.Pair[TopicId, Reputer], cosmosMath.Int]
 	// map of (topicId, reputer) -> share of delegate reward
 	delegateRewardPerShare collections.Map[collections.Pair[TopicId, Reputer], alloraMath.Dec]
+	// set of (topic id, reputer, delegator) for the delegations whose rewards are compounded into their stake
+	autoCompoundingDelegations collections.KeySet[collections.Triple[TopicId, Reputer, Delegator]]
+	// the next redelegation id to be used
+	nextRedelegationId collections.Sequence
+	// every redelegation in progress indexed by its id
+	redelegations collections.Map[uint64, types.Redelegation]
+	// set of (source topic id, source reputer, redelegation id), so slashes of a reputer reach stake redelegated away from it
+	redelegationsBySource collections.KeySet[collections.Triple[TopicId, Reputer, uint64]]
+	// set of (delegator, redelegation id) for the redelegations in progress of a delegator
+	redelegationsByDelegator collections.KeySet[collections.Pair[Delegator, uint64]]
+	// set of (block height, redelegation id) for the redelegations completing at a block height
+	redelegationsByCompletion collections.KeySet[collections.Pair[BlockHeight, uint64]]
+	// map of (actor, topic id) -> rewards accrued by the actor in the topic, not claimed yet
+	accruedRewards collections.Map[collections.Pair[ActorId, TopicId], cosmosMath.Int]
+	// sum of all accrued rewards, held by the accrued rewards module account
+	totalAccruedRewards collections.Item[cosmosMath.Int]
 	// stake removals are double indexed to avoid O(n) lookups when removing stake
 	// map of (blockHeight, topic, reputer) -> removal information for that reputer
 	stakeRemovalsByBlock collections.Map[collections.Triple[BlockHeight, TopicId, Reputer], types.StakeRemovalInfo]
@@ -225,6 +250,80 @@ type Keeper struct {
 
 	whitelistAdmins collections.KeySet[ActorId]
 
+	// set of (source chain, source address) allowed to send GMP messages, stored lower-cased
+	gmpAllowedSources collections.KeySet[collections.Pair[string, string]]
+
+	// topics whose worker allowlist is enforced
+	topicWorkerAllowlistEnabled collections.KeySet[TopicId]
+	// topics whose reputer allowlist is enforced
+	topicReputerAllowlistEnabled collections.KeySet[TopicId]
+	// set of (topic, worker) allowed to register and submit worker payloads when the worker allowlist is enforced
+	topicWorkerAllowlist collections.KeySet[collections.Pair[TopicId, ActorId]]
+	// set of (topic, reputer) allowed to register and submit reputer payloads when the reputer allowlist is enforced
+	topicReputerAllowlist collections.KeySet[collections.Pair[TopicId, ActorId]]
+
+	/// REPUTER SLASHING
+
+	// map of (topic, reputer) -> missed nonces, outlier streak and jail status of the reputer
+	reputerSlashingInfo collections.Map[collections.Pair[TopicId, ActorId], types.ReputerSlashingInfo]
+
+	/// WORKER COMMITMENTS
+
+	// map of (topic, worker nonce block height, worker) -> commitment of the worker to the payload it reveals for the nonce
+	workerCommitments collections.Map[collections.Triple[TopicId, BlockHeight, ActorId], types.WorkerCommitment]
+
+	/// LOSS FUNCTIONS
+
+	// loss functions the chain can evaluate, keyed by topic loss method
+	lossFunctions *lossfunctions.Registry
+	// map of (topic, reputer nonce block height) -> ground truth submitted for the nonce
+	groundTruths collections.Map[collections.Pair[TopicId, BlockHeight], alloraMath.Dec]
+
+	/// NETWORK INFERENCE ARCHIVE
+
+	// map of (topic, worker nonce block height) -> final network inferences of the nonce,
+	// for topics that archive them
+	networkInferenceArchive collections.Map[collections.Pair[TopicId, BlockHeight], types.ArchivedNetworkInference]
+
+	/// GMP SUBSCRIPTIONS
+
+	// the next gmp subscription id to be used
+	nextGmpSubscriptionId collections.Sequence
+	// every outbound gmp subscription indexed by its id
+	gmpSubscriptions collections.Map[uint64, types.GmpSubscription]
+	// set of (topic, subscription id) for the subscriptions of a topic
+	topicGmpSubscriptions collections.KeySet[collections.Pair[TopicId, uint64]]
+	// map of (channel id, packet sequence) -> gmp message awaiting acknowledgement
+	gmpDeliveries collections.Map[collections.Pair[string, uint64], types.GmpDelivery]
+	// sends network inferences to gmp subscribers, set after construction by the app
+	gmpSender *gmpSenderHolder
+
+	/// TOPIC SPONSORSHIPS
+
+	// map of (topic, sponsor) -> sponsorship paying the data sending fees of the topic's participants
+	topicSponsorships collections.Map[collections.Pair[TopicId, ActorId], types.TopicSponsorship]
+	// map of (topic, sponsor, actor) -> fees a sponsorship paid for an actor in the topic's current epoch
+	sponsoredActorFees collections.Map[collections.Triple[TopicId, ActorId, ActorId], types.SponsoredFees]
+
+	/// FEE BURNING
+
+	// burns a share of the fees collected, set after construction by the app
+	feeBurner *feeBurnerHolder
+
+	/// END BLOCKER OBSERVATION
+
+	// told how long each run of the EndBlocker took, set after construction by the app
+	endBlockerObserver *endBlockerObserverHolder
+	// phase timings of the last EndBlockers run by the node
+	endBlockerTimings *endBlockerTimingsHolder
+
+	/// DATA SENDING FEE MARKET
+
+	// map of topic -> data sending fee charged per payload, adjusted after every worker nonce
+	topicDataSendingFee collections.Map[TopicId, cosmosMath.Int]
+	// map of topic -> payloads paid for since the topic's last worker nonce closed
+	topicPayloadsReceived collections.Map[TopicId, uint64]
+
 	/// RECORD COMMITS
 
 	topicLastWorkerCommit  collections.Map[TopicId, types.TimestampedActorNonce]
@@ -267,8 +366,17 @@ func NewKeeper(
 		delegateStakeRemovalsByActor:             collections.NewKeySet(sb, types.DelegateStakeRemovalsByActorKey, "delegate_stake_removals_by_actor", QuadrupleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key, collections.Int64Key)),
 		stakeSumFromDelegator:                    collections.NewMap(sb, types.DelegatorStakeKey, "stake_from_delegator", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), sdk.IntValue),
 		delegatedStakes:                          collections.NewMap(sb, types.DelegateStakePlacementKey, "delegate_stake_placement", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey), codec.CollValue[types.DelegatorInfo](cdc)),
+		delegationsByReputer:                     collections.NewKeySet(sb, types.DelegationsByReputerKey, "delegations_by_reputer", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey)),
 		stakeFromDelegatorsUponReputer:           collections.NewMap(sb, types.TargetStakeKey, "stake_upon_reputer", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), sdk.IntValue),
 		delegateRewardPerShare:                   collections.NewMap(sb, types.DelegateRewardPerShare, "delegate_reward_per_share", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), alloraMath.DecValue),
+		autoCompoundingDelegations:               collections.NewKeySet(sb, types.AutoCompoundingDelegationsKey, "auto_compounding_delegations", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey)),
+		nextRedelegationId:                       collections.NewSequence(sb, types.NextRedelegationIdKey, "next_redelegation_id"),
+		redelegations:                            collections.NewMap(sb, types.RedelegationsKey, "redelegations", collections.Uint64Key, codec.CollValue[types.Redelegation](cdc)),
+		redelegationsBySource:                    collections.NewKeySet(sb, types.RedelegationsBySourceKey, "redelegations_by_source", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.Uint64Key)),
+		redelegationsByDelegator:                 collections.NewKeySet(sb, types.RedelegationsByDelegatorKey, "redelegations_by_delegator", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		redelegationsByCompletion:                collections.NewKeySet(sb, types.RedelegationsByCompletionKey, "redelegations_by_completion", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		accruedRewards:                           collections.NewMap(sb, types.AccruedRewardsKey, "accrued_rewards", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), sdk.IntValue),
+		totalAccruedRewards:                      collections.NewItem(sb, types.TotalAccruedRewardsKey, "total_accrued_rewards", sdk.IntValue),
 		topicFeeRevenue:                          collections.NewMap(sb, types.TopicFeeRevenueKey, "topic_fee_revenue", collections.Uint64Key, sdk.IntValue),
 		previousTopicWeight:                      collections.NewMap(sb, types.PreviousTopicWeightKey, "previous_topic_weight", collections.Uint64Key, alloraMath.DecValue),
 		inferences:                               collections.NewMap(sb, types.InferencesKey, "inferences", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.Inference](cdc)),
@@ -290,6 +398,16 @@ func NewKeeper(
 		latestOneOutForecasterInfererNetworkRegrets:    collections.NewMap(sb, types.LatestOneOutForecasterInfererNetworkRegretsKey, "latest_one_out_forecaster_inferer_network_regrets", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey), codec.CollValue[types.TimestampedValue](cdc)),
 		latestOneOutForecasterForecasterNetworkRegrets: collections.NewMap(sb, types.LatestOneOutForecasterForecasterNetworkRegretsKey, "latest_one_out_forecaster_forecaster_network_regrets", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey), codec.CollValue[types.TimestampedValue](cdc)),
 		whitelistAdmins:                           collections.NewKeySet(sb, types.WhitelistAdminsKey, "whitelist_admins", collections.StringKey),
+		gmpAllowedSources:                         collections.NewKeySet(sb, types.GmpAllowedSourcesKey, "gmp_allowed_sources", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		topicWorkerAllowlistEnabled:               collections.NewKeySet(sb, types.TopicWorkerAllowlistEnabledKey, "topic_worker_allowlist_enabled", collections.Uint64Key),
+		topicReputerAllowlistEnabled:              collections.NewKeySet(sb, types.TopicReputerAllowlistEnabledKey, "topic_reputer_allowlist_enabled", collections.Uint64Key),
+		topicWorkerAllowlist:                      collections.NewKeySet(sb, types.TopicWorkerAllowlistKey, "topic_worker_allowlist", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
+		topicReputerAllowlist:                     collections.NewKeySet(sb, types.TopicReputerAllowlistKey, "topic_reputer_allowlist", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
+		reputerSlashingInfo:                       collections.NewMap(sb, types.ReputerSlashingInfoKey, "reputer_slashing_info", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.ReputerSlashingInfo](cdc)),
+		workerCommitments:                         collections.NewMap(sb, types.WorkerCommitmentsKey, "worker_commitments", collections.TripleKeyCodec(collections.Uint64Key, collections.Int64Key, collections.StringKey), codec.CollValue[types.WorkerCommitment](cdc)),
+		lossFunctions:                             lossfunctions.DefaultRegistry(),
+		groundTruths:                              collections.NewMap(sb, types.GroundTruthsKey, "ground_truths", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), alloraMath.DecValue),
+		networkInferenceArchive:                   collections.NewMap(sb, types.NetworkInferenceArchiveKey, "network_inference_archive", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.ArchivedNetworkInference](cdc)),
 		infererScoresByBlock:                      collections.NewMap(sb, types.InferenceScoresKey, "inferer_scores_by_block", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.Scores](cdc)),
 		forecasterScoresByBlock:                   collections.NewMap(sb, types.ForecastScoresKey, "forecaster_scores_by_block", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.Scores](cdc)),
 		infererScoreEmas:                          collections.NewMap(sb, types.InfererScoreEmasKey, "latest_inferer_scores_by_worker", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.Score](cdc)),
@@ -321,6 +439,21 @@ func NewKeeper(
 		lowestReputerScoreEma:                     collections.NewMap(sb, types.LowestReputerScoreEmaKey, "lowest_reputer_score_ema", collections.Uint64Key, codec.CollValue[types.Score](cdc)),
 		totalSumPreviousTopicWeights:              collections.NewItem(sb, types.TotalSumPreviousTopicWeightsKey, "total_sum_previous_topic_weights", alloraMath.DecValue),
 		rewardCurrentBlockEmission:                collections.NewItem(sb, types.RewardCurrentBlockEmissionKey, "reward_current_block_emission", sdk.IntValue),
+		scheduledTopicUpdates:                     collections.NewMap(sb, types.ScheduledTopicUpdatesKey, "scheduled_topic_updates", collections.Uint64Key, codec.CollValue[types.ScheduledTopicUpdate](cdc)),
+		topicRetirements:                          collections.NewMap(sb, types.TopicRetirementsKey, "topic_retirements", collections.Uint64Key, codec.CollValue[types.TopicRetirement](cdc)),
+		retiringTopics:                            collections.NewKeySet(sb, types.RetiringTopicsKey, "retiring_topics", collections.Uint64Key),
+		nextGmpSubscriptionId:                     collections.NewSequence(sb, types.NextGmpSubscriptionIdKey, "next_gmp_subscription_id"),
+		gmpSubscriptions:                          collections.NewMap(sb, types.GmpSubscriptionsKey, "gmp_subscriptions", collections.Uint64Key, codec.CollValue[types.GmpSubscription](cdc)),
+		topicGmpSubscriptions:                     collections.NewKeySet(sb, types.TopicGmpSubscriptionsKey, "topic_gmp_subscriptions", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+		gmpDeliveries:                             collections.NewMap(sb, types.GmpDeliveriesKey, "gmp_deliveries", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.GmpDelivery](cdc)),
+		gmpSender:                                 &gmpSenderHolder{},
+		topicSponsorships:                         collections.NewMap(sb, types.TopicSponsorshipsKey, "topic_sponsorships", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.TopicSponsorship](cdc)),
+		sponsoredActorFees:                        collections.NewMap(sb, types.SponsoredActorFeesKey, "sponsored_actor_fees", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey), codec.CollValue[types.SponsoredFees](cdc)),
+		feeBurner:                                 &feeBurnerHolder{},
+		endBlockerObserver:                        &endBlockerObserverHolder{},
+		endBlockerTimings:                         &endBlockerTimingsHolder{},
+		topicDataSendingFee:                       collections.NewMap(sb, types.TopicDataSendingFeeKey, "topic_data_sending_fee", collections.Uint64Key, sdk.IntValue),
+		topicPayloadsReceived:                     collections.NewMap(sb, types.TopicPayloadsReceivedKey, "topic_payloads_received", collections.Uint64Key, collections.Uint64Value),
 	}
 
 	schema, err := sb.Build()
@@ -341,6 +474,11 @@ func (k *Keeper) GetBinaryCodec() codec.BinaryCodec {
 	return k.cdc
 }
 
+// GetSchema returns the schema of the collections of the module store, e.g. to decode its entries
+func (k *Keeper) GetSchema() collections.Schema {
+	return k.schema
+}
+
 /// NONCES
 
 // GetTopicIds returns the TopicIds for a given BlockHeight.
@@ -405,6 +543,7 @@ func (k *Keeper) FulfillWorkerNonce(ctx context.Context, topicId TopicId, nonce
 			if err != nil {
 				return false, errorsmod.Wrap(err, "error setting unfulfilled worker nonces")
 			}
+			types.EmitNewNonceFulfilledEvent(sdk.UnwrapSDKContext(ctx), topicId, types.NonceType_NONCE_TYPE_WORKER, nonce.BlockHeight)
 			return true, nil
 		}
 	}
@@ -440,6 +579,7 @@ func (k *Keeper) FulfillReputerNonce(ctx context.Context, topicId TopicId, nonce
 			if err != nil {
 				return false, errorsmod.Wrap(err, "error setting unfulfilled reputer nonces")
 			}
+			types.EmitNewNonceFulfilledEvent(sdk.UnwrapSDKContext(ctx), topicId, types.NonceType_NONCE_TYPE_REPUTER, nonce.BlockHeight)
 			return true, nil
 		}
 	}
@@ -518,10 +658,12 @@ func (k *Keeper) AddWorkerNonce(ctx context.Context, topicId TopicId, nonce *typ
 	}
 	maxUnfulfilledRequests := moduleParams.MaxUnfulfilledWorkerRequests
 
+	var prunedNonces []*types.Nonce
 	lenNonces := uint64(len(nonces.Nonces))
 	if lenNonces > maxUnfulfilledRequests {
 		diff := uint64(len(nonces.Nonces)) - maxUnfulfilledRequests
 		if diff > 0 {
+			prunedNonces = nonces.Nonces[maxUnfulfilledRequests:]
 			nonces.Nonces = nonces.Nonces[:maxUnfulfilledRequests]
 		}
 	}
@@ -529,7 +671,15 @@ func (k *Keeper) AddWorkerNonce(ctx context.Context, topicId TopicId, nonce *typ
 	if err := nonces.Validate(); err != nil {
 		return errorsmod.Wrap(err, "error validating unfulfilled worker nonces")
 	}
-	return k.unfulfilledWorkerNonces.Set(ctx, topicId, nonces)
+	if err := k.unfulfilledWorkerNonces.Set(ctx, topicId, nonces); err != nil {
+		return errorsmod.Wrap(err, "error setting unfulfilled worker nonces")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	types.EmitNewNonceOpenedEvent(sdkCtx, topicId, types.NonceType_NONCE_TYPE_WORKER, nonce.BlockHeight)
+	for _, pruned := range prunedNonces {
+		types.EmitNewNoncePrunedEvent(sdkCtx, topicId, types.NonceType_NONCE_TYPE_WORKER, pruned.BlockHeight)
+	}
+	return nil
 }
 
 // Adds a nonce to the unfulfilled nonces for the topic if it is not yet added (idempotent).
@@ -567,17 +717,27 @@ func (k *Keeper) AddReputerNonce(ctx context.Context, topicId TopicId, nonce *ty
 		return errorsmod.Wrap(err, "error getting module params")
 	}
 	maxUnfulfilledRequests := moduleParams.MaxUnfulfilledReputerRequests
+	var prunedNonces []*types.ReputerRequestNonce
 	lenNonces := uint64(len(nonces.Nonces))
 	if lenNonces > maxUnfulfilledRequests {
 		diff := uint64(len(nonces.Nonces)) - maxUnfulfilledRequests
 		if diff > 0 {
+			prunedNonces = nonces.Nonces[maxUnfulfilledRequests:]
 			nonces.Nonces = nonces.Nonces[:maxUnfulfilledRequests]
 		}
 	}
 	if err := nonces.Validate(); err != nil {
 		return errorsmod.Wrap(err, "error validating unfulfilled reputer nonces")
 	}
-	return k.unfulfilledReputerNonces.Set(ctx, topicId, nonces)
+	if err := k.unfulfilledReputerNonces.Set(ctx, topicId, nonces); err != nil {
+		return errorsmod.Wrap(err, "error setting unfulfilled reputer nonces")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	types.EmitNewNonceOpenedEvent(sdkCtx, topicId, types.NonceType_NONCE_TYPE_REPUTER, nonce.BlockHeight)
+	for _, pruned := range prunedNonces {
+		types.EmitNewNoncePrunedEvent(sdkCtx, topicId, types.NonceType_NONCE_TYPE_REPUTER, pruned.ReputerNonce.BlockHeight)
+	}
+	return nil
 }
 
 func (k *Keeper) GetUnfulfilledWorkerNonces(ctx context.Context, topicId TopicId) (types.Nonces, error) {
@@ -1681,6 +1841,7 @@ func (k *Keeper) AddReputerStake(
 	if err := k.SetTotalStake(ctx, totalStakeNew); err != nil {
 		return errorsmod.Wrapf(err, "Setting total stake failed -- rolling back reputer and topic stake")
 	}
+	types.EmitNewStakeAddedEvent(sdk.UnwrapSDKContext(ctx), topicId, reputer, "", stakeToAdd)
 	return nil
 }
 
@@ -1796,6 +1957,7 @@ func (k *Keeper) AddDelegateStake(
 	if err := k.SetDelegateStakeUponReputer(ctx, topicId, reputer, stakeUponReputerNew); err != nil {
 		return errorsmod.Wrapf(err, "AddDelegateStake Setting stake from delegators upon reputer failed")
 	}
+	types.EmitNewStakeAddedEvent(sdk.UnwrapSDKContext(ctx), topicId, reputer, delegator, stakeToAdd)
 	return nil
 }
 
@@ -1860,6 +2022,7 @@ func (k *Keeper) RemoveReputerStake(
 	if err != nil {
 		return errorsmod.Wrapf(err, "Setting total stake failed")
 	}
+	types.EmitNewStakeRemovedEvent(sdk.UnwrapSDKContext(ctx), topicId, reputer, "", stakeToRemove)
 
 	// remove stake withdrawal information
 	err = k.DeleteStakeRemoval(ctx, blockHeight, topicId, reputer)
@@ -1874,6 +2037,8 @@ func (k *Keeper) RemoveReputerStake(
 // subtracts from: totalStake, topicStake, stakeReputerAuthority
 //
 //	stakeSumFromDelegator, delegatedStakes, stakeFromDelegatorsUponReputer
+//
+// and deletes the stake removal from the queue
 func (k *Keeper) RemoveDelegateStake(
 	ctx context.Context,
 	stakeRemovalBlockHeight BlockHeight,
@@ -1881,6 +2046,27 @@ func (k *Keeper) RemoveDelegateStake(
 	delegator ActorId,
 	reputer ActorId,
 	stakeToRemove cosmosMath.Int,
+) error {
+	if stakeToRemove.IsZero() {
+		return nil
+	}
+	if err := k.removeDelegateStake(ctx, topicId, delegator, reputer, stakeToRemove); err != nil {
+		return err
+	}
+	if err := k.DeleteDelegateStakeRemoval(ctx, stakeRemovalBlockHeight, topicId, reputer, delegator); err != nil {
+		return errorsmod.Wrapf(err, "Deleting delegate stake removal from queue failed")
+	}
+	return nil
+}
+
+// Removes delegate stake from the stake data structures, paying out the pending rewards of the delegation.
+// The coins of the removed stake are left in the staking account.
+func (k *Keeper) removeDelegateStake(
+	ctx context.Context,
+	topicId TopicId,
+	delegator ActorId,
+	reputer ActorId,
+	stakeToRemove cosmosMath.Int,
 ) error {
 	// CHECKS
 	if stakeToRemove.IsZero() {
@@ -2013,9 +2199,7 @@ func (k *Keeper) RemoveDelegateStake(
 	if err := k.SetTotalStake(ctx, totalStakeNew); err != nil {
 		return errorsmod.Wrapf(err, "Setting total stake failed")
 	}
-	if err := k.DeleteDelegateStakeRemoval(ctx, stakeRemovalBlockHeight, topicId, reputer, delegator); err != nil {
-		return errorsmod.Wrapf(err, "Deleting delegate stake removal from queue failed")
-	}
+	types.EmitNewStakeRemovedEvent(sdk.UnwrapSDKContext(ctx), topicId, reputer, delegator, stakeToRemove)
 
 	return nil
 }
@@ -2146,6 +2330,27 @@ func (k *Keeper) GetDelegateStakePlacement(ctx context.Context, topicId TopicId,
 	return stake, nil
 }
 
+// Returns every delegation with stake placed in a topic, in delegator then reputer order
+func (k *Keeper) GetTopicDelegations(ctx context.Context, topicId TopicId) ([]types.TopicIdDelegatorReputer, error) {
+	iter, err := k.delegatedStakes.Iterate(ctx, collections.NewPrefixedTripleRange[TopicId, ActorId, ActorId](topicId))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error iterating delegated stakes")
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error getting delegated stakes")
+	}
+	delegations := make([]types.TopicIdDelegatorReputer, 0, len(keys))
+	for _, key := range keys {
+		delegations = append(delegations, types.TopicIdDelegatorReputer{
+			TopicId:   topicId,
+			Delegator: key.K2(),
+			Reputer:   key.K3(),
+		})
+	}
+	return delegations, nil
+}
+
 // Sets the amount of stake placed by a specific delegator on a specific target.
 func (k *Keeper) SetDelegateStakePlacement(ctx context.Context, topicId TopicId, delegator ActorId, target ActorId, stake types.DelegatorInfo) error {
 	if err := types.ValidateTopicId(topicId); err != nil {
@@ -2161,12 +2366,58 @@ func (k *Keeper) SetDelegateStakePlacement(ctx context.Context, topicId TopicId,
 		return errorsmod.Wrap(err, "stake information is not valid")
 	}
 	key := collections.Join3(topicId, delegator, target)
+	indexKey := collections.Join3(topicId, target, delegator)
 	if stake.Amount.IsZero() {
+		if err := k.delegationsByReputer.Remove(ctx, indexKey); err != nil {
+			return errorsmod.Wrap(err, "error removing delegation from reputer index")
+		}
+		// A delegation with no stake left has nothing to compound
+		if err := k.autoCompoundingDelegations.Remove(ctx, indexKey); err != nil {
+			return errorsmod.Wrap(err, "error removing auto-compounding delegation")
+		}
 		return k.delegatedStakes.Remove(ctx, key)
 	}
+	if err := k.delegationsByReputer.Set(ctx, indexKey); err != nil {
+		return errorsmod.Wrap(err, "error adding delegation to reputer index")
+	}
 	return k.delegatedStakes.Set(ctx, key, stake)
 }
 
+// Returns the delegators with stake placed upon a reputer in a topic
+func (k *Keeper) GetDelegatorsOfReputer(ctx context.Context, topicId TopicId, reputer Reputer) ([]Delegator, error) {
+	iter, err := k.delegationsByReputer.Iterate(ctx, collections.NewSuperPrefixedTripleRange[TopicId, Reputer, Delegator](topicId, reputer))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error iterating delegations by reputer")
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error getting delegations by reputer")
+	}
+	delegators := make([]Delegator, 0, len(keys))
+	for _, key := range keys {
+		delegators = append(delegators, key.K3())
+	}
+	return delegators, nil
+}
+
+// Indexes every delegation by its reputer, for stores written before the index existed
+func (k *Keeper) IndexDelegationsByReputer(ctx context.Context) error {
+	iter, err := k.delegatedStakes.Iterate(ctx, nil)
+	if err != nil {
+		return errorsmod.Wrap(err, "error iterating delegated stakes")
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting delegated stakes")
+	}
+	for _, key := range keys {
+		if err := k.delegationsByReputer.Set(ctx, collections.Join3(key.K1(), key.K3(), key.K2())); err != nil {
+			return errorsmod.Wrap(err, "error adding delegation to reputer index")
+		}
+	}
+	return nil
+}
+
 // Returns the share of reward by a specific topic and reputer
 func (k *Keeper) GetDelegateRewardPerShare(ctx context.Context, topicId TopicId, reputer ActorId) (alloraMath.Dec, error) {
 	key := collections.Join(topicId, reputer)
@@ -2242,7 +2493,12 @@ func (k *Keeper) SetStakeRemoval(ctx context.Context, removalInfo types.StakeRem
 		return errorsmod.Wrap(err, "error setting stake removal by block")
 	}
 	byActorKey := collections.Join3(removalInfo.Reputer, removalInfo.TopicId, removalInfo.BlockRemovalCompleted)
-	return k.stakeRemovalsByActor.Set(ctx, byActorKey)
+	err = k.stakeRemovalsByActor.Set(ctx, byActorKey)
+	if err != nil {
+		return errorsmod.Wrap(err, "error setting stake removal by actor")
+	}
+	types.EmitNewStakeRemovalScheduledEvent(sdk.UnwrapSDKContext(ctx), removalInfo)
+	return nil
 }
 
 // remove a stake removal from the queue
@@ -2265,7 +2521,12 @@ func (k *Keeper) DeleteStakeRemoval(
 		return errorsmod.Wrap(err, "error removing stake removal by block")
 	}
 	byActorKey := collections.Join3(address, topicId, blockHeight)
-	return k.stakeRemovalsByActor.Remove(ctx, byActorKey)
+	err = k.stakeRemovalsByActor.Remove(ctx, byActorKey)
+	if err != nil {
+		return errorsmod.Wrap(err, "error removing stake removal by actor")
+	}
+	types.EmitNewStakeRemovalUnscheduledEvent(sdk.UnwrapSDKContext(ctx), topicId, address, "", blockHeight)
+	return nil
 }
 
 // get info about a removal
@@ -2369,7 +2630,12 @@ func (k *Keeper) SetDelegateStakeRemoval(ctx context.Context, removalInfo types.
 		return errorsmod.Wrap(err, "error setting delegate stake removal by block")
 	}
 	byActorKey := Join4(removalInfo.Delegator, removalInfo.Reputer, removalInfo.TopicId, removalInfo.BlockRemovalCompleted)
-	return k.delegateStakeRemovalsByActor.Set(ctx, byActorKey)
+	err = k.delegateStakeRemovalsByActor.Set(ctx, byActorKey)
+	if err != nil {
+		return errorsmod.Wrap(err, "error setting delegate stake removal by actor")
+	}
+	types.EmitNewDelegateStakeRemovalScheduledEvent(sdk.UnwrapSDKContext(ctx), removalInfo)
+	return nil
 }
 
 // remove a stake removal from the queue
@@ -2393,7 +2659,12 @@ func (k *Keeper) DeleteDelegateStakeRemoval(
 		return errorsmod.Wrap(err, "error removing delegate stake removal by block")
 	}
 	byActorKey := Join4(delegator, reputer, topicId, blockHeight)
-	return k.delegateStakeRemovalsByActor.Remove(ctx, byActorKey)
+	err = k.delegateStakeRemovalsByActor.Remove(ctx, byActorKey)
+	if err != nil {
+		return errorsmod.Wrap(err, "error removing delegate stake removal by actor")
+	}
+	types.EmitNewStakeRemovalUnscheduledEvent(sdk.UnwrapSDKContext(ctx), topicId, reputer, delegator, blockHeight)
+	return nil
 }
 
 // get info about a removal
@@ -2508,6 +2779,7 @@ func (k *Keeper) InsertReputer(ctx context.Context, topicId TopicId, reputer Act
 	if err != nil {
 		return errorsmod.Wrap(err, "error setting reputer")
 	}
+	types.EmitNewActorRegisteredEvent(sdk.UnwrapSDKContext(ctx), topicId, reputer, true, reputerInfo.Owner)
 	return nil
 }
 
@@ -2518,6 +2790,7 @@ func (k *Keeper) RemoveReputer(ctx context.Context, topicId TopicId, reputer Act
 	if err != nil {
 		return errorsmod.Wrap(err, "error removing topic reputer")
 	}
+	types.EmitNewActorUnregisteredEvent(sdk.UnwrapSDKContext(ctx), topicId, reputer, true)
 	return nil
 }
 
@@ -2547,6 +2820,7 @@ func (k *Keeper) InsertWorker(ctx context.Context, topicId TopicId, worker Actor
 	if err != nil {
 		return errorsmod.Wrap(err, "error setting worker")
 	}
+	types.EmitNewActorRegisteredEvent(sdk.UnwrapSDKContext(ctx), topicId, worker, false, workerInfo.Owner)
 	return nil
 }
 
@@ -2557,6 +2831,7 @@ func (k *Keeper) RemoveWorker(ctx context.Context, topicId TopicId, worker Actor
 	if err != nil {
 		return errorsmod.Wrap(err, "error removing topic worker")
 	}
+	types.EmitNewActorUnregisteredEvent(sdk.UnwrapSDKContext(ctx), topicId, worker, false)
 	return nil
 }
 
@@ -2723,6 +2998,32 @@ func (k *Keeper) IsReputerRegisteredInTopic(ctx context.Context, topicId TopicId
 	return k.topicReputers.Has(ctx, topicKey)
 }
 
+// Returns the workers registered in a topic
+func (k *Keeper) GetTopicWorkers(ctx context.Context, topicId TopicId) ([]ActorId, error) {
+	return getTopicActors(ctx, k.topicWorkers, topicId)
+}
+
+// Returns the reputers registered in a topic
+func (k *Keeper) GetTopicReputers(ctx context.Context, topicId TopicId) ([]ActorId, error) {
+	return getTopicActors(ctx, k.topicReputers, topicId)
+}
+
+func getTopicActors(ctx context.Context, topicActors collections.KeySet[collections.Pair[TopicId, ActorId]], topicId TopicId) ([]ActorId, error) {
+	iter, err := topicActors.Iterate(ctx, collections.NewPrefixedPairRange[TopicId, ActorId](topicId))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error iterating topic actors")
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error getting topic actors")
+	}
+	actors := make([]ActorId, 0, len(keys))
+	for _, key := range keys {
+		actors = append(actors, key.K2())
+	}
+	return actors, nil
+}
+
 // wrapper for set operation around activeTopics
 func (k *Keeper) SetActiveTopics(ctx context.Context, topicId TopicId) error {
 	if err := types.ValidateTopicId(topicId); err != nil {
@@ -2773,6 +3074,71 @@ func (k *Keeper) SetBlockToLowestActiveTopicWeight(
 	return k.blockToLowestActiveTopicWeight.Set(ctx, block, weightPair)
 }
 
+// Boolean is true if an update is scheduled for the topic, else false
+func (k *Keeper) GetScheduledTopicUpdate(ctx context.Context, topicId TopicId) (types.ScheduledTopicUpdate, bool, error) {
+	update, err := k.scheduledTopicUpdates.Get(ctx, topicId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ScheduledTopicUpdate{}, false, nil
+		}
+		return types.ScheduledTopicUpdate{}, false, errorsmod.Wrap(err, "error getting scheduled topic update")
+	}
+	return update, true, nil
+}
+
+// wrapper for set operation around scheduledTopicUpdates
+func (k *Keeper) SetScheduledTopicUpdate(ctx context.Context, update types.ScheduledTopicUpdate) error {
+	if err := update.Validate(); err != nil {
+		return errorsmod.Wrap(err, "scheduled topic update validation failed")
+	}
+	return k.scheduledTopicUpdates.Set(ctx, update.TopicId, update)
+}
+
+// wrapper for remove operation around scheduledTopicUpdates
+func (k *Keeper) RemoveScheduledTopicUpdate(ctx context.Context, topicId TopicId) error {
+	return k.scheduledTopicUpdates.Remove(ctx, topicId)
+}
+
+// Boolean is true if the topic has been retired, else false
+func (k *Keeper) GetTopicRetirement(ctx context.Context, topicId TopicId) (types.TopicRetirement, bool, error) {
+	retirement, err := k.topicRetirements.Get(ctx, topicId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.TopicRetirement{}, false, nil
+		}
+		return types.TopicRetirement{}, false, errorsmod.Wrap(err, "error getting topic retirement")
+	}
+	return retirement, true, nil
+}
+
+// Sets the retirement record of a topic, tracking it as retiring until its garbage collection is complete
+func (k *Keeper) SetTopicRetirement(ctx context.Context, retirement types.TopicRetirement) error {
+	if err := retirement.Validate(); err != nil {
+		return errorsmod.Wrap(err, "topic retirement validation failed")
+	}
+	topicId := retirement.Topic.Id
+	if err := k.topicRetirements.Set(ctx, topicId, retirement); err != nil {
+		return errorsmod.Wrap(err, "error setting topic retirement")
+	}
+	if retirement.Stage == types.TopicRetirementStage_TOPIC_RETIREMENT_STAGE_COMPLETE {
+		return k.retiringTopics.Remove(ctx, topicId)
+	}
+	return k.retiringTopics.Set(ctx, topicId)
+}
+
+// Get the ids of all retired topics whose garbage collection has not yet completed
+func (k *Keeper) GetRetiringTopicIds(ctx context.Context) ([]TopicId, error) {
+	iter, err := k.retiringTopics.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error iterating retiring topics")
+	}
+	topicIds, err := iter.Keys()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error getting retiring topic ids")
+	}
+	return topicIds, nil
+}
+
 /// TOPIC FEE REVENUE
 
 // Get the amount of fee revenue collected by a topic
@@ -3473,6 +3839,99 @@ func (k *Keeper) RemoveWhitelistAdmin(ctx context.Context, admin ActorId) error
 	return k.whitelistAdmins.Remove(ctx, admin)
 }
 
+/// GMP ALLOWED SOURCES
+
+// Chain names and EVM addresses are case-insensitive, so sources are keyed lower-cased
+func gmpAllowedSourceKey(sourceChain, sourceAddress string) collections.Pair[string, string] {
+	return collections.Join(strings.ToLower(sourceChain), strings.ToLower(sourceAddress))
+}
+
+func (k Keeper) IsGmpSourceAllowed(ctx context.Context, sourceChain, sourceAddress string) (bool, error) {
+	return k.gmpAllowedSources.Has(ctx, gmpAllowedSourceKey(sourceChain, sourceAddress))
+}
+
+func (k *Keeper) AddGmpAllowedSource(ctx context.Context, source types.GmpAllowedSource) error {
+	if err := source.Validate(); err != nil {
+		return errorsmod.Wrap(err, "error validating gmp allowed source")
+	}
+	return k.gmpAllowedSources.Set(ctx, gmpAllowedSourceKey(source.SourceChain, source.SourceAddress))
+}
+
+func (k *Keeper) RemoveGmpAllowedSource(ctx context.Context, source types.GmpAllowedSource) error {
+	return k.gmpAllowedSources.Remove(ctx, gmpAllowedSourceKey(source.SourceChain, source.SourceAddress))
+}
+
+/// TOPIC ALLOWLISTS
+
+func (k *Keeper) topicAllowlistSets(isReputer bool) (collections.KeySet[TopicId], collections.KeySet[collections.Pair[TopicId, ActorId]]) {
+	if isReputer {
+		return k.topicReputerAllowlistEnabled, k.topicReputerAllowlist
+	}
+	return k.topicWorkerAllowlistEnabled, k.topicWorkerAllowlist
+}
+
+func (k *Keeper) IsTopicAllowlistEnabled(ctx context.Context, topicId TopicId, isReputer bool) (bool, error) {
+	enabled, _ := k.topicAllowlistSets(isReputer)
+	return enabled.Has(ctx, topicId)
+}
+
+func (k *Keeper) SetTopicAllowlistEnabled(ctx context.Context, topicId TopicId, isReputer bool, isEnabled bool) error {
+	enabled, _ := k.topicAllowlistSets(isReputer)
+	if isEnabled {
+		return enabled.Set(ctx, topicId)
+	}
+	return enabled.Remove(ctx, topicId)
+}
+
+func (k *Keeper) IsInTopicAllowlist(ctx context.Context, topicId TopicId, isReputer bool, address ActorId) (bool, error) {
+	_, allowlist := k.topicAllowlistSets(isReputer)
+	return allowlist.Has(ctx, collections.Join(topicId, address))
+}
+
+func (k *Keeper) AddToTopicAllowlist(ctx context.Context, topicId TopicId, isReputer bool, address ActorId) error {
+	if err := types.ValidateBech32(address); err != nil {
+		return errorsmod.Wrap(err, "error validating topic allowlist address")
+	}
+	_, allowlist := k.topicAllowlistSets(isReputer)
+	return allowlist.Set(ctx, collections.Join(topicId, address))
+}
+
+func (k *Keeper) RemoveFromTopicAllowlist(ctx context.Context, topicId TopicId, isReputer bool, address ActorId) error {
+	_, allowlist := k.topicAllowlistSets(isReputer)
+	return allowlist.Remove(ctx, collections.Join(topicId, address))
+}
+
+// Returns the addresses in the worker (or reputer) allowlist of a topic
+func (k *Keeper) GetTopicAllowlist(ctx context.Context, topicId TopicId, isReputer bool) ([]ActorId, error) {
+	_, allowlist := k.topicAllowlistSets(isReputer)
+	iter, err := allowlist.Iterate(ctx, collections.NewPrefixedPairRange[TopicId, ActorId](topicId))
+	if err != nil {
+		return nil, err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+	addresses := make([]ActorId, len(keys))
+	for i, key := range keys {
+		addresses[i] = key.K2()
+	}
+	return addresses, nil
+}
+
+// True if the address may act as a worker (or reputer) in the topic:
+// either the topic does not enforce that allowlist, or the address is in it
+func (k *Keeper) IsAllowedInTopic(ctx context.Context, topicId TopicId, isReputer bool, address ActorId) (bool, error) {
+	isEnabled, err := k.IsTopicAllowlistEnabled(ctx, topicId, isReputer)
+	if err != nil {
+		return false, err
+	}
+	if !isEnabled {
+		return true, nil
+	}
+	return k.IsInTopicAllowlist(ctx, topicId, isReputer, address)
+}
+
 /// BANK KEEPER WRAPPERS
 
 // wrapper around bank keeper SendCoinsFromModuleToAccount
@@ -3571,6 +4030,10 @@ func (k *Keeper) PruneRecordsAfterRewards(ctx context.Context, topicId TopicId,
 	if err != nil {
 		return errorsmod.Wrap(err, "error pruning network losses")
 	}
+	err = k.pruneGroundTruths(ctx, blockRange)
+	if err != nil {
+		return errorsmod.Wrap(err, "error pruning ground truths")
+	}
 
 	return nil
 }
@@ -3591,6 +4054,10 @@ func (k *Keeper) pruneNetworkLosses(ctx context.Context, blockRange *collections
 	return k.networkLossBundles.Clear(ctx, blockRange)
 }
 
+func (k *Keeper) pruneGroundTruths(ctx context.Context, blockRange *collections.PairRange[uint64, int64]) error {
+	return k.groundTruths.Clear(ctx, blockRange)
+}
+
 func (k *Keeper) PruneWorkerNonces(ctx context.Context, topicId uint64, blockHeightThreshold int64) error {
 	if err := types.ValidateTopicId(topicId); err != nil {
 		return errorsmod.Wrap(err, "error validating topic id")
@@ -3605,9 +4072,12 @@ func (k *Keeper) PruneWorkerNonces(ctx context.Context, topicId uint64, blockHei
 
 	// Filter Nonces based on block_height
 	filteredNonces := make([]*types.Nonce, 0)
+	prunedNonces := make([]*types.Nonce, 0)
 	for _, nonce := range nonces.Nonces {
 		if nonce.BlockHeight >= blockHeightThreshold {
 			filteredNonces = append(filteredNonces, nonce)
+		} else {
+			prunedNonces = append(prunedNonces, nonce)
 		}
 	}
 
@@ -3619,6 +4089,10 @@ func (k *Keeper) PruneWorkerNonces(ctx context.Context, topicId uint64, blockHei
 	if err := k.unfulfilledWorkerNonces.Set(ctx, topicId, nonces); err != nil {
 		return errorsmod.Wrap(err, "error setting unfulfilled worker nonces")
 	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, pruned := range prunedNonces {
+		types.EmitNewNoncePrunedEvent(sdkCtx, topicId, types.NonceType_NONCE_TYPE_WORKER, pruned.BlockHeight)
+	}
 
 	return nil
 }
@@ -3634,23 +4108,32 @@ func (k *Keeper) PruneReputerNonces(ctx context.Context, topicId uint64, blockHe
 
 	// Filter Nonces based on block_height
 	filteredNonces := make([]*types.ReputerRequestNonce, 0)
+	prunedNonces := make([]*types.ReputerRequestNonce, 0)
 	for _, nonce := range nonces.Nonces {
 		if nonce.ReputerNonce.BlockHeight >= blockHeightThreshold {
 			filteredNonces = append(filteredNonces, nonce)
+		} else {
+			prunedNonces = append(prunedNonces, nonce)
 		}
 	}
 
 	if len(filteredNonces) == 0 {
-		return k.unfulfilledReputerNonces.Remove(ctx, topicId)
-	}
-
-	// Update nonces in the map
-	nonces.Nonces = filteredNonces
-	if err := nonces.Validate(); err != nil {
-		return errorsmod.Wrap(err, "error validating unfulfilled reputer nonces")
+		if err := k.unfulfilledReputerNonces.Remove(ctx, topicId); err != nil {
+			return errorsmod.Wrap(err, "error removing unfulfilled reputer nonces")
+		}
+	} else {
+		// Update nonces in the map
+		nonces.Nonces = filteredNonces
+		if err := nonces.Validate(); err != nil {
+			return errorsmod.Wrap(err, "error validating unfulfilled reputer nonces")
+		}
+		if err := k.unfulfilledReputerNonces.Set(ctx, topicId, nonces); err != nil {
+			return errorsmod.Wrap(err, "error setting unfulfilled reputer nonces")
+		}
 	}
-	if err := k.unfulfilledReputerNonces.Set(ctx, topicId, nonces); err != nil {
-		return errorsmod.Wrap(err, "error setting unfulfilled reputer nonces")
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, pruned := range prunedNonces {
+		types.EmitNewNoncePrunedEvent(sdkCtx, topicId, types.NonceType_NONCE_TYPE_REPUTER, pruned.ReputerNonce.BlockHeight)
 	}
 
 	return nil
@@ -34,6 +34,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/suite"
 )
@@ -66,8 +67,15 @@ func (s *KeeperTestSuite) SetupTest() {
 	key := storetypes.NewKVStoreKey("emissions")
 	storeService := runtime.NewKVStoreService(key)
 	s.storeService = storeService
-	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
-	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()}) // nolint: exhaustruct
+	// Auth and bank get stores of their own, as in the app, so that their keys don't alias emissions prefixes
+	authKey := storetypes.NewKVStoreKey(authtypes.StoreKey)
+	bankKey := storetypes.NewKVStoreKey(banktypes.StoreKey)
+	testCtx := testutil.DefaultContextWithKeys(
+		map[string]*storetypes.KVStoreKey{"emissions": key, authtypes.StoreKey: authKey, banktypes.StoreKey: bankKey},
+		map[string]*storetypes.TransientStoreKey{"transient_test": storetypes.NewTransientStoreKey("transient_test")},
+		nil,
+	)
+	ctx := testCtx.WithHeaderInfo(header.Info{Time: time.Now()}) // nolint: exhaustruct
 	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{}, module.AppModule{})
 	s.codec = encCfg.Codec
 	addressCodec := address.NewBech32Codec(params.Bech32PrefixAccAddr)
@@ -79,6 +87,7 @@ func (s *KeeperTestSuite) SetupTest() {
 		types.AlloraStakingAccountName: {"burner", "minter", "staking"},
 		types.AlloraRewardsAccountName: {"minter"},
 		types.AlloraPendingRewardForDelegatorAccountName: {"minter"},
+		types.AlloraAccruedRewardsAccountName:            {"minter"},
 		minttypes.EcosystemModuleName:                    nil,
 		"bonded_tokens_pool":                             {"burner", "staking"},
 		"not_bonded_tokens_pool":                         {"burner", "staking"},
@@ -88,7 +97,7 @@ func (s *KeeperTestSuite) SetupTest() {
 
 	accountKeeper := authkeeper.NewAccountKeeper(
 		encCfg.Codec,
-		storeService,
+		runtime.NewKVStoreService(authKey),
 		authtypes.ProtoBaseAccount,
 		maccPerms,
 		authcodec.NewBech32Codec(params.Bech32PrefixAccAddr),
@@ -100,7 +109,7 @@ func (s *KeeperTestSuite) SetupTest() {
 
 	bankKeeper := bankkeeper.NewBaseKeeper(
 		encCfg.Codec,
-		storeService,
+		runtime.NewKVStoreService(bankKey),
 		accountKeeper,
 		map[string]bool{},
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
@@ -118,7 +127,7 @@ func (s *KeeperTestSuite) SetupTest() {
 		bankKeeper,
 		authtypes.FeeCollectorName)
 	s.key = key
-	appModule := module.NewAppModule(encCfg.Codec, s.emissionsKeeper)
+	appModule := module.NewAppModule(encCfg.Codec, s.emissionsKeeper, accountKeeper, bankKeeper)
 	defaultGenesis := appModule.DefaultGenesis(encCfg.Codec)
 	appModule.InitGenesis(ctx, encCfg.Codec, defaultGenesis)
 	s.msgServer = msgserver.NewMsgServerImpl(s.emissionsKeeper)
@@ -190,7 +199,7 @@ func (s *KeeperTestSuite) CreateOneTopic(epochLen int64) uint64 {
 	newTopicMsg := &types.CreateNewTopicRequest{
 		Creator:                  s.addrsStr[0],
 		Metadata:                 metadata,
-		LossMethod:               "method",
+		LossMethod:               "mse",
 		EpochLength:              epochLen,
 		GroundTruthLag:           epochLen,
 		WorkerSubmissionWindow:   epochLen,
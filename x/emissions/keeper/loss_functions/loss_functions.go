@@ -0,0 +1,267 @@
+package lossfunctions
+
+import (
+	"sort"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Names of the loss methods known to the chain
+const (
+	MSE     = "mse"
+	MAE     = "mae"
+	LogLoss = "logloss"
+	Huber   = "huber"
+	CRPS    = "crps"
+)
+
+// Clipping applied to probabilities before taking their logarithm in the log-loss
+var logLossEpsilon = alloraMath.MustNewDecFromString("0.000000000000001")
+
+// Threshold between the quadratic and linear regimes of the Huber loss
+var huberDelta = alloraMath.OneDec()
+
+// Scale of the logistic predictive distribution the CRPS places around a point prediction
+var crpsScale = alloraMath.OneDec()
+
+// Beyond this magnitude ln(1 + e^-|x|) is below 1e-21 and is dropped from the softplus
+var softplusCutoff = alloraMath.NewDecFromInt64(50)
+
+// A loss function scores a point prediction against the ground truth it predicted
+type LossFunction interface {
+	Loss(prediction, groundTruth alloraMath.Dec) (alloraMath.Dec, error)
+}
+
+// Adapter allowing plain functions to be used as loss functions
+type LossFunctionFunc func(prediction, groundTruth alloraMath.Dec) (alloraMath.Dec, error)
+
+func (f LossFunctionFunc) Loss(prediction, groundTruth alloraMath.Dec) (alloraMath.Dec, error) {
+	return f(prediction, groundTruth)
+}
+
+// Registry of loss functions keyed by loss method. Loss methods are matched case-insensitively.
+type Registry struct {
+	functions map[string]LossFunction
+}
+
+func NewRegistry() *Registry {
+	return &Registry{functions: make(map[string]LossFunction)}
+}
+
+// Returns a registry with all loss functions implemented by the chain
+func DefaultRegistry() *Registry {
+	registry := NewRegistry()
+	for name, f := range map[string]LossFunctionFunc{
+		MSE:     MeanSquaredError,
+		MAE:     MeanAbsoluteError,
+		LogLoss: BinaryLogLoss,
+		Huber:   HuberLoss,
+		CRPS:    ContinuousRankedProbabilityScore,
+	} {
+		if err := registry.Register(name, f); err != nil {
+			panic(err)
+		}
+	}
+	return registry
+}
+
+func normalizeLossMethod(lossMethod string) string {
+	return strings.ToLower(strings.TrimSpace(lossMethod))
+}
+
+// Registers a loss function under a loss method. Loss methods may only be registered once.
+func (r *Registry) Register(lossMethod string, f LossFunction) error {
+	name := normalizeLossMethod(lossMethod)
+	if name == "" {
+		return errorsmod.Wrap(types.ErrUnknownLossMethod, "loss method cannot be empty")
+	}
+	if f == nil {
+		return errorsmod.Wrapf(types.ErrUnknownLossMethod, "loss function for %s cannot be nil", name)
+	}
+	if _, ok := r.functions[name]; ok {
+		return errorsmod.Wrapf(types.ErrUnknownLossMethod, "loss method %s already registered", name)
+	}
+	r.functions[name] = f
+	return nil
+}
+
+// Returns the loss function registered under a loss method, if any
+func (r *Registry) Get(lossMethod string) (LossFunction, bool) {
+	f, ok := r.functions[normalizeLossMethod(lossMethod)]
+	return f, ok
+}
+
+// Returns the registered loss methods in lexicographic order
+func (r *Registry) LossMethods() []string {
+	names := make([]string, 0, len(r.functions))
+	for name := range r.functions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// (prediction - groundTruth)^2
+func MeanSquaredError(prediction, groundTruth alloraMath.Dec) (alloraMath.Dec, error) {
+	diff, err := prediction.Sub(groundTruth)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	return diff.Mul(diff)
+}
+
+// |prediction - groundTruth|
+func MeanAbsoluteError(prediction, groundTruth alloraMath.Dec) (alloraMath.Dec, error) {
+	diff, err := prediction.Sub(groundTruth)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	return diff.Abs()
+}
+
+// Binary cross-entropy of a predicted probability against an observed outcome in [0, 1]:
+// -(y * ln(p) + (1 - y) * ln(1 - p)), with p clipped away from 0 and 1.
+func BinaryLogLoss(prediction, groundTruth alloraMath.Dec) (alloraMath.Dec, error) {
+	one := alloraMath.OneDec()
+	if groundTruth.IsNegative() || groundTruth.Gt(one) {
+		return alloraMath.Dec{}, errorsmod.Wrapf(types.ErrInvalidValue, "log-loss ground truth must be in [0, 1], got %s", groundTruth)
+	}
+	upper, err := one.Sub(logLossEpsilon)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	p, err := alloraMath.Max(prediction, logLossEpsilon)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	p, err = alloraMath.Min(p, upper)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	oneMinusP, err := one.Sub(p)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	oneMinusY, err := one.Sub(groundTruth)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	lnP, err := alloraMath.Ln(p)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	lnOneMinusP, err := alloraMath.Ln(oneMinusP)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	positiveTerm, err := groundTruth.Mul(lnP)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	negativeTerm, err := oneMinusY.Mul(lnOneMinusP)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	sum, err := positiveTerm.Add(negativeTerm)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	return sum.Neg()
+}
+
+// Huber loss with delta = 1: quadratic for small errors and linear for large ones
+func HuberLoss(prediction, groundTruth alloraMath.Dec) (alloraMath.Dec, error) {
+	absError, err := MeanAbsoluteError(prediction, groundTruth)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	half := alloraMath.MustNewDecFromString("0.5")
+	if absError.Lte(huberDelta) {
+		squared, err := absError.Mul(absError)
+		if err != nil {
+			return alloraMath.Dec{}, err
+		}
+		return squared.Mul(half)
+	}
+	halfDelta, err := huberDelta.Mul(half)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	linear, err := absError.Sub(halfDelta)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	return linear.Mul(huberDelta)
+}
+
+// Continuous ranked probability score of a logistic predictive distribution centred on the prediction with
+// scale s = 1. The CRPS of a point prediction is its absolute error, so the prediction is given a spread:
+// with z = (groundTruth - prediction) / s, the score is s * (z - 2 * ln(F(z)) - 1) where F is the
+// logistic CDF. It is s * (2 * ln(2) - 1) for an exact prediction and approaches |error| - s for large errors.
+func ContinuousRankedProbabilityScore(prediction, groundTruth alloraMath.Dec) (alloraMath.Dec, error) {
+	diff, err := groundTruth.Sub(prediction)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	z, err := diff.Quo(crpsScale)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	negZ, err := z.Neg()
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	// -ln(F(z)) = ln(1 + e^-z)
+	negLnCdf, err := softplus(negZ)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	twiceNegLnCdf, err := negLnCdf.Mul(alloraMath.NewDecFromInt64(2))
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	sum, err := z.Add(twiceNegLnCdf)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	sum, err = sum.Sub(alloraMath.OneDec())
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	return sum.Mul(crpsScale)
+}
+
+// ln(1 + e^x), computed as max(x, 0) + ln(1 + e^-|x|) so that e^x never overflows
+func softplus(x alloraMath.Dec) (alloraMath.Dec, error) {
+	positivePart, err := alloraMath.Max(x, alloraMath.ZeroDec())
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	absX, err := x.Abs()
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	if absX.Gt(softplusCutoff) {
+		return positivePart, nil
+	}
+	negAbsX, err := absX.Neg()
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	expNegAbsX, err := alloraMath.Exp(negAbsX)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	onePlusExp, err := alloraMath.OneDec().Add(expNegAbsX)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	lnOnePlusExp, err := alloraMath.Ln(onePlusExp)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	return positivePart.Add(lnOnePlusExp)
+}
@@ -0,0 +1,84 @@
+package lossfunctions_test
+
+import (
+	"testing"
+
+	alloraMath "github.com/allora-network/allora-chain/math"
+	alloratestutil "github.com/allora-network/allora-chain/test/testutil"
+	lossfunctions "github.com/allora-network/allora-chain/x/emissions/keeper/loss_functions"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLossFunctions(t *testing.T) {
+	tests := []struct {
+		name        string
+		lossMethod  string
+		prediction  string
+		groundTruth string
+		expected    string
+	}{
+		{"mse", lossfunctions.MSE, "3", "1", "4"},
+		{"mse negative error", lossfunctions.MSE, "-1.5", "0.5", "4"},
+		{"mae", lossfunctions.MAE, "-1.5", "0.5", "2"},
+		{"huber quadratic", lossfunctions.Huber, "1.5", "1", "0.125"},
+		{"huber linear", lossfunctions.Huber, "4", "1", "2.5"},
+		{"huber at delta", lossfunctions.Huber, "2", "1", "0.5"},
+		{"crps exact prediction", lossfunctions.CRPS, "1", "1", "0.3862943611"},
+		{"crps under prediction", lossfunctions.CRPS, "2", "3.5", "0.9028265560"},
+		{"crps over prediction", lossfunctions.CRPS, "3.5", "2", "0.9028265560"},
+		{"crps large error", lossfunctions.CRPS, "0", "100", "99"},
+		{"logloss positive outcome", lossfunctions.LogLoss, "0.8", "1", "0.2231435513"},
+		{"logloss negative outcome", lossfunctions.LogLoss, "0.8", "0", "1.6094379124"},
+		{"logloss soft outcome", lossfunctions.LogLoss, "0.5", "0.3", "0.6931471806"},
+	}
+
+	registry := lossfunctions.DefaultRegistry()
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f, ok := registry.Get(tc.lossMethod)
+			require.True(t, ok)
+			loss, err := f.Loss(alloraMath.MustNewDecFromString(tc.prediction), alloraMath.MustNewDecFromString(tc.groundTruth))
+			require.NoError(t, err)
+			alloratestutil.InEpsilon5(t, loss, tc.expected)
+		})
+	}
+}
+
+func TestLogLossClipsCertainPredictions(t *testing.T) {
+	loss, err := lossfunctions.BinaryLogLoss(alloraMath.ZeroDec(), alloraMath.OneDec())
+	require.NoError(t, err)
+	require.True(t, loss.IsPositive())
+	require.True(t, loss.IsFinite())
+
+	loss, err = lossfunctions.BinaryLogLoss(alloraMath.OneDec(), alloraMath.OneDec())
+	require.NoError(t, err)
+	require.True(t, loss.Lt(alloraMath.MustNewDecFromString("0.000001")))
+
+	_, err = lossfunctions.BinaryLogLoss(alloraMath.MustNewDecFromString("0.5"), alloraMath.NewDecFromInt64(2))
+	require.ErrorIs(t, err, types.ErrInvalidValue)
+}
+
+func TestRegistry(t *testing.T) {
+	registry := lossfunctions.DefaultRegistry()
+	require.Equal(t, []string{"crps", "huber", "logloss", "mae", "mse"}, registry.LossMethods())
+
+	// Lookups are case-insensitive
+	_, ok := registry.Get(" MSE ")
+	require.True(t, ok)
+	_, ok = registry.Get("method")
+	require.False(t, ok)
+
+	err := registry.Register("Mse", lossfunctions.LossFunctionFunc(lossfunctions.MeanAbsoluteError))
+	require.ErrorIs(t, err, types.ErrUnknownLossMethod)
+	err = registry.Register("", lossfunctions.LossFunctionFunc(lossfunctions.MeanAbsoluteError))
+	require.ErrorIs(t, err, types.ErrUnknownLossMethod)
+
+	err = registry.Register("abs", lossfunctions.LossFunctionFunc(lossfunctions.MeanAbsoluteError))
+	require.NoError(t, err)
+	f, ok := registry.Get("ABS")
+	require.True(t, ok)
+	loss, err := f.Loss(alloraMath.NewDecFromInt64(1), alloraMath.NewDecFromInt64(4))
+	require.NoError(t, err)
+	require.True(t, alloraMath.NewDecFromInt64(3).Equal(loss))
+}
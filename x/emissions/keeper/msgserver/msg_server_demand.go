@@ -28,3 +28,33 @@ func (ms msgServer) FundTopic(ctx context.Context, msg *types.FundTopicRequest)
 	err = sendEffectiveRevenueActivateTopicIfWeightSufficient(ctx, ms, msg.Sender, msg.TopicId, msg.Amount)
 	return &types.FundTopicResponse{}, err
 }
+
+func (ms msgServer) FundTopicSponsorship(ctx context.Context, msg *types.FundTopicSponsorshipRequest) (_ *types.FundTopicSponsorshipResponse, err error) {
+	defer metrics.RecordMetrics("FundTopicSponsorship", time.Now(), &err)
+
+	err = msg.Validate()
+	if err != nil {
+		return nil, err
+	}
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if !topicExists {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", msg.TopicId)
+	}
+	// The amount is moved into the sponsorship escrow and drawn down by the data sending fees it pays
+	_, err = ms.k.FundTopicSponsorship(ctx, msg.TopicId, msg.Sender, msg.Amount, msg.MaxFeesPerActorPerEpoch, msg.MaxFeesPerEpoch)
+	return &types.FundTopicSponsorshipResponse{}, err
+}
+
+func (ms msgServer) WithdrawTopicSponsorship(ctx context.Context, msg *types.WithdrawTopicSponsorshipRequest) (_ *types.WithdrawTopicSponsorshipResponse, err error) {
+	defer metrics.RecordMetrics("WithdrawTopicSponsorship", time.Now(), &err)
+
+	err = msg.Validate()
+	if err != nil {
+		return nil, err
+	}
+	// Only the sponsor may withdraw, as sponsorships are keyed by their sponsor
+	_, err = ms.k.WithdrawTopicSponsorship(ctx, msg.TopicId, msg.Sender, msg.Amount)
+	return &types.WithdrawTopicSponsorshipResponse{}, err
+}
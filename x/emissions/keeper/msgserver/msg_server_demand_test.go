@@ -4,6 +4,7 @@ import (
 	cosmosMath "cosmossdk.io/math"
 	"github.com/allora-network/allora-chain/app/params"
 	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -130,3 +131,101 @@ func (s *MsgServerTestSuite) TestHighWeightForHighFundedTopic() {
 
 	s.Require().Equal(topic2Weight.Gt(topicWeight), true, "Topic1 weight should be greater than Topic2 weight")
 }
+
+func (s *MsgServerTestSuite) TestSponsoredWorkerPayloadFee() {
+	require := s.Require()
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertWorkerPayload(workerPrivateKey)
+	workerMsg = s.signMsgInsertWorkerPayload(workerMsg, workerPrivateKey)
+	ctx := s.ctx.WithBlockHeight(workerMsg.WorkerDataBundle.Nonce.BlockHeight)
+	fee := types.DefaultParams().DataSendingFee
+
+	sponsorAddr, sponsor := getNewAddress()
+	s.MintTokensToAddress(sponsorAddr, fee.MulRaw(2))
+	_, err := s.msgServer.FundTopicSponsorship(ctx, &types.FundTopicSponsorshipRequest{
+		Sender:                  sponsor,
+		TopicId:                 topicId,
+		Amount:                  fee.MulRaw(2),
+		MaxFeesPerActorPerEpoch: fee,
+		MaxFeesPerEpoch:         cosmosMath.ZeroInt(),
+	})
+	require.NoError(err)
+
+	workerAddr := sdk.AccAddress(workerPrivateKey.PubKey().Address())
+	workerBalanceBefore := s.bankKeeper.GetBalance(ctx, workerAddr, params.DefaultBondDenom)
+	revenueBefore, err := s.emissionsKeeper.GetTopicFeeRevenue(ctx, topicId)
+	require.NoError(err)
+
+	_, err = s.msgServer.InsertWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+
+	// The sponsorship pays the fee, which still counts towards the topic's fee revenue
+	workerBalanceAfter := s.bankKeeper.GetBalance(ctx, workerAddr, params.DefaultBondDenom)
+	require.Equal(workerBalanceBefore, workerBalanceAfter)
+	revenueAfter, err := s.emissionsKeeper.GetTopicFeeRevenue(ctx, topicId)
+	require.NoError(err)
+	require.Equal(revenueBefore.Add(fee), revenueAfter)
+
+	sponsorship, found, err := s.emissionsKeeper.GetTopicSponsorship(ctx, topicId, sponsor)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(fee, sponsorship.Balance)
+
+	// The sponsor withdraws the rest, removing the sponsorship
+	_, err = s.msgServer.WithdrawTopicSponsorship(ctx, &types.WithdrawTopicSponsorshipRequest{
+		Sender:  sponsor,
+		TopicId: topicId,
+		Amount:  fee,
+	})
+	require.NoError(err)
+	_, found, err = s.emissionsKeeper.GetTopicSponsorship(ctx, topicId, sponsor)
+	require.NoError(err)
+	require.False(found)
+	require.Equal(fee, s.bankKeeper.GetBalance(ctx, sponsorAddr, params.DefaultBondDenom).Amount)
+}
+
+func (s *MsgServerTestSuite) TestWorkerPaysFeeBeyondSponsorshipCap() {
+	require := s.Require()
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertWorkerPayload(workerPrivateKey)
+	workerMsg = s.signMsgInsertWorkerPayload(workerMsg, workerPrivateKey)
+	ctx := s.ctx.WithBlockHeight(workerMsg.WorkerDataBundle.Nonce.BlockHeight)
+	fee := types.DefaultParams().DataSendingFee
+
+	sponsorAddr, sponsor := getNewAddress()
+	s.MintTokensToAddress(sponsorAddr, fee)
+	_, err := s.msgServer.FundTopicSponsorship(ctx, &types.FundTopicSponsorshipRequest{
+		Sender:                  sponsor,
+		TopicId:                 topicId,
+		Amount:                  fee,
+		MaxFeesPerActorPerEpoch: fee.SubRaw(1),
+		MaxFeesPerEpoch:         cosmosMath.ZeroInt(),
+	})
+	require.NoError(err)
+
+	workerAddr := sdk.AccAddress(workerPrivateKey.PubKey().Address())
+	workerBalanceBefore := s.bankKeeper.GetBalance(ctx, workerAddr, params.DefaultBondDenom)
+
+	_, err = s.msgServer.InsertWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+
+	workerBalanceAfter := s.bankKeeper.GetBalance(ctx, workerAddr, params.DefaultBondDenom)
+	require.Equal(workerBalanceBefore.Amount.Sub(fee), workerBalanceAfter.Amount)
+	sponsorship, found, err := s.emissionsKeeper.GetTopicSponsorship(ctx, topicId, sponsor)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(fee, sponsorship.Balance)
+}
+
+func (s *MsgServerTestSuite) TestFundTopicSponsorshipUnknownTopic() {
+	senderAddr, sender := getNewAddress()
+	s.MintTokensToAddress(senderAddr, cosmosMath.NewInt(10))
+	_, err := s.msgServer.FundTopicSponsorship(s.ctx, &types.FundTopicSponsorshipRequest{
+		Sender:                  sender,
+		TopicId:                 123,
+		Amount:                  cosmosMath.NewInt(10),
+		MaxFeesPerActorPerEpoch: cosmosMath.ZeroInt(),
+		MaxFeesPerEpoch:         cosmosMath.ZeroInt(),
+	})
+	s.Require().Error(err)
+}
@@ -0,0 +1,137 @@
+package msgserver
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/metrics"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (ms msgServer) AddGmpAllowedSource(ctx context.Context, msg *types.AddGmpAllowedSourceRequest) (_ *types.AddGmpAllowedSourceResponse, err error) {
+	defer metrics.RecordMetrics("AddGmpAllowedSource", time.Now(), &err)
+
+	err = msg.Validate()
+	if err != nil {
+		return nil, err
+	}
+	// Check that sender is a whitelist admin
+	isAdmin, err := ms.k.IsWhitelistAdmin(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	} else if !isAdmin {
+		return nil, types.ErrNotWhitelistAdmin
+	}
+	// Allow the source to route GMP messages into emissions actions
+	err = ms.k.AddGmpAllowedSource(ctx, types.GmpAllowedSource{
+		SourceChain:   msg.SourceChain,
+		SourceAddress: msg.SourceAddress,
+	})
+	return &types.AddGmpAllowedSourceResponse{}, err
+}
+
+func (ms msgServer) RemoveGmpAllowedSource(ctx context.Context, msg *types.RemoveGmpAllowedSourceRequest) (_ *types.RemoveGmpAllowedSourceResponse, err error) {
+	defer metrics.RecordMetrics("RemoveGmpAllowedSource", time.Now(), &err)
+
+	err = msg.Validate()
+	if err != nil {
+		return nil, err
+	}
+	// Check that sender is a whitelist admin
+	isAdmin, err := ms.k.IsWhitelistAdmin(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	} else if !isAdmin {
+		return nil, types.ErrNotWhitelistAdmin
+	}
+	// Remove the source from the allowlist
+	err = ms.k.RemoveGmpAllowedSource(ctx, types.GmpAllowedSource{
+		SourceChain:   msg.SourceChain,
+		SourceAddress: msg.SourceAddress,
+	})
+	return &types.RemoveGmpAllowedSourceResponse{}, err
+}
+
+func (ms msgServer) CreateGmpSubscription(ctx context.Context, msg *types.CreateGmpSubscriptionRequest) (_ *types.CreateGmpSubscriptionResponse, err error) {
+	defer metrics.RecordMetrics("CreateGmpSubscription", time.Now(), &err)
+
+	err = msg.Validate()
+	if err != nil {
+		return nil, err
+	}
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if !topicExists {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", msg.TopicId)
+	}
+	// The prepayment is moved into the GMP fee escrow and drawn down by every message sent
+	subscriptionId, err := ms.k.CreateGmpSubscription(ctx, types.GmpSubscription{
+		TopicId:            msg.TopicId,
+		Subscriber:         msg.Sender,
+		DestinationChain:   msg.DestinationChain,
+		DestinationAddress: msg.DestinationAddress,
+		FeePerMessage:      msg.FeePerMessage,
+		Balance:            msg.Prepayment,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.CreateGmpSubscriptionResponse{SubscriptionId: subscriptionId}, nil
+}
+
+func (ms msgServer) FundGmpSubscription(ctx context.Context, msg *types.FundGmpSubscriptionRequest) (_ *types.FundGmpSubscriptionResponse, err error) {
+	defer metrics.RecordMetrics("FundGmpSubscription", time.Now(), &err)
+
+	err = msg.Validate()
+	if err != nil {
+		return nil, err
+	}
+	subscription, found, err := ms.k.GetGmpSubscription(ctx, msg.SubscriptionId)
+	if err != nil {
+		return nil, err
+	} else if !found {
+		return nil, errors.Wrapf(types.ErrGmpSubscriptionNotFound, "subscription %d", msg.SubscriptionId)
+	}
+	// Only the subscriber funds a subscription, as it is also the one refunded on cancellation
+	if subscription.Subscriber != msg.Sender {
+		return nil, types.ErrNotPermittedToManageGmpSubscription
+	}
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	_, err = ms.k.FundGmpSubscription(ctx, msg.SubscriptionId, sender, msg.Amount)
+	return &types.FundGmpSubscriptionResponse{}, err
+}
+
+func (ms msgServer) CancelGmpSubscription(ctx context.Context, msg *types.CancelGmpSubscriptionRequest) (_ *types.CancelGmpSubscriptionResponse, err error) {
+	defer metrics.RecordMetrics("CancelGmpSubscription", time.Now(), &err)
+
+	err = msg.Validate()
+	if err != nil {
+		return nil, err
+	}
+	subscription, found, err := ms.k.GetGmpSubscription(ctx, msg.SubscriptionId)
+	if err != nil {
+		return nil, err
+	} else if !found {
+		return nil, errors.Wrapf(types.ErrGmpSubscriptionNotFound, "subscription %d", msg.SubscriptionId)
+	}
+	// Check that sender is the subscriber or a whitelist admin
+	if subscription.Subscriber != msg.Sender {
+		isAdmin, err := ms.k.IsWhitelistAdmin(ctx, msg.Sender)
+		if err != nil {
+			return nil, err
+		} else if !isAdmin {
+			return nil, types.ErrNotPermittedToManageGmpSubscription
+		}
+	}
+	// The remaining balance is refunded to the subscriber
+	_, err = ms.k.CancelGmpSubscription(ctx, msg.SubscriptionId)
+	return &types.CancelGmpSubscriptionResponse{}, err
+}
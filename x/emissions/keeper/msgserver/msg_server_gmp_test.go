@@ -0,0 +1,361 @@
+package msgserver_test
+
+import (
+	"context"
+	"errors"
+
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *MsgServerTestSuite) TestAddAndRemoveGmpAllowedSource() {
+	ctx := s.ctx
+	require := s.Require()
+	msgServer := s.msgServer
+
+	adminAddr := s.addrsStr[0]
+	sourceChain := "Ethereum"
+	sourceAddress := "0xAbCdEf0123456789aBcDeF0123456789AbCdEf01"
+
+	isAllowed, err := s.emissionsKeeper.IsGmpSourceAllowed(ctx, sourceChain, sourceAddress)
+	require.NoError(err)
+	require.False(isAllowed, "source should not be allowed before it is added")
+
+	_, err = msgServer.AddGmpAllowedSource(ctx, &types.AddGmpAllowedSourceRequest{
+		Sender:        adminAddr,
+		SourceChain:   sourceChain,
+		SourceAddress: sourceAddress,
+	})
+	require.NoError(err, "Adding a gmp allowed source should succeed")
+
+	isAllowed, err = s.emissionsKeeper.IsGmpSourceAllowed(ctx, "ethereum", "0xabcdef0123456789abcdef0123456789abcdef01")
+	require.NoError(err)
+	require.True(isAllowed, "source should be allowed regardless of case")
+
+	_, err = msgServer.RemoveGmpAllowedSource(ctx, &types.RemoveGmpAllowedSourceRequest{
+		Sender:        adminAddr,
+		SourceChain:   "ETHEREUM",
+		SourceAddress: sourceAddress,
+	})
+	require.NoError(err, "Removing a gmp allowed source should succeed")
+
+	isAllowed, err = s.emissionsKeeper.IsGmpSourceAllowed(ctx, sourceChain, sourceAddress)
+	require.NoError(err)
+	require.False(isAllowed, "source should no longer be allowed")
+}
+
+func (s *MsgServerTestSuite) TestAddGmpAllowedSourceInvalidUnauthorized() {
+	ctx := s.ctx
+	require := s.Require()
+
+	_, err := s.msgServer.AddGmpAllowedSource(ctx, &types.AddGmpAllowedSourceRequest{
+		Sender:        nonAdminAccounts[0].String(),
+		SourceChain:   "ethereum",
+		SourceAddress: "0xabcdef0123456789abcdef0123456789abcdef01",
+	})
+	require.ErrorIs(err, types.ErrNotWhitelistAdmin, "Should fail due to unauthorized access")
+}
+
+func (s *MsgServerTestSuite) TestAddGmpAllowedSourceInvalidEmptyAddress() {
+	ctx := s.ctx
+	require := s.Require()
+
+	_, err := s.msgServer.AddGmpAllowedSource(ctx, &types.AddGmpAllowedSourceRequest{
+		Sender:      s.addrsStr[0],
+		SourceChain: "ethereum",
+	})
+	require.Error(err, "Should fail due to an empty source address")
+}
+
+// fakeGmpSender records the network inferences it is asked to send instead of sending them over IBC
+type fakeGmpSender struct {
+	sent     []types.GmpSubscription
+	sequence uint64
+	err      error
+}
+
+func (f *fakeGmpSender) SendNetworkInference(
+	_ context.Context,
+	_ sdk.AccAddress,
+	_ string,
+	subscription types.GmpSubscription,
+	_ int64,
+	_ *types.ValueBundle,
+	_ sdk.Coin,
+) (uint64, error) {
+	if f.err != nil {
+		return 0, f.err
+	}
+	f.sent = append(f.sent, subscription)
+	f.sequence++
+	return f.sequence, nil
+}
+
+func (s *MsgServerTestSuite) createGmpSubscription(topicId uint64, subscriber sdk.AccAddress, fee, prepayment int64) uint64 {
+	s.MintTokensToAddress(subscriber, cosmosMath.NewInt(prepayment))
+	res, err := s.msgServer.CreateGmpSubscription(s.ctx, &types.CreateGmpSubscriptionRequest{
+		Sender:             subscriber.String(),
+		TopicId:            topicId,
+		DestinationChain:   "ethereum",
+		DestinationAddress: "0xabcdef0123456789abcdef0123456789abcdef01",
+		FeePerMessage:      cosmosMath.NewInt(fee),
+		Prepayment:         cosmosMath.NewInt(prepayment),
+	})
+	s.Require().NoError(err)
+	return res.SubscriptionId
+}
+
+func (s *MsgServerTestSuite) setGmpAxelarChannel(channelId string) {
+	moduleParams, err := s.emissionsKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	moduleParams.GmpAxelarChannelId = channelId
+	moduleParams.GmpAxelarCounterpartyChannelId = "channel-1"
+	s.Require().NoError(s.emissionsKeeper.SetParams(s.ctx, moduleParams))
+}
+
+func (s *MsgServerTestSuite) gmpEscrowBalance() cosmosMath.Int {
+	return s.bankKeeper.GetBalance(s.ctx, types.GmpFeeEscrowAddress, params.DefaultBondDenom).Amount
+}
+
+func (s *MsgServerTestSuite) TestCreateFundAndCancelGmpSubscription() {
+	ctx := s.ctx
+	require := s.Require()
+	topic := s.CreateOneTopic()
+	subscriber := nonAdminAccounts[0]
+
+	subscriptionId := s.createGmpSubscription(topic.Id, subscriber, 10, 100)
+	require.Equal(uint64(1), subscriptionId)
+	require.True(s.gmpEscrowBalance().Equal(cosmosMath.NewInt(100)), "prepayment should be escrowed")
+
+	s.MintTokensToAddress(subscriber, cosmosMath.NewInt(50))
+	_, err := s.msgServer.FundGmpSubscription(ctx, &types.FundGmpSubscriptionRequest{
+		Sender:         subscriber.String(),
+		SubscriptionId: subscriptionId,
+		Amount:         cosmosMath.NewInt(50),
+	})
+	require.NoError(err)
+
+	subscriptions, err := s.emissionsKeeper.GetTopicGmpSubscriptions(ctx, topic.Id)
+	require.NoError(err)
+	require.Len(subscriptions, 1)
+	require.True(subscriptions[0].Balance.Equal(cosmosMath.NewInt(150)))
+
+	_, err = s.msgServer.CancelGmpSubscription(ctx, &types.CancelGmpSubscriptionRequest{
+		Sender:         subscriber.String(),
+		SubscriptionId: subscriptionId,
+	})
+	require.NoError(err)
+
+	_, found, err := s.emissionsKeeper.GetGmpSubscription(ctx, subscriptionId)
+	require.NoError(err)
+	require.False(found, "subscription should be removed")
+	require.True(s.gmpEscrowBalance().IsZero(), "escrow should be emptied")
+	balance := s.bankKeeper.GetBalance(ctx, subscriber, params.DefaultBondDenom).Amount
+	require.True(balance.Equal(cosmosMath.NewInt(150)), "balance should be refunded to the subscriber")
+}
+
+func (s *MsgServerTestSuite) TestGmpSubscriptionOnlyManagedBySubscriber() {
+	ctx := s.ctx
+	require := s.Require()
+	topic := s.CreateOneTopic()
+	subscriptionId := s.createGmpSubscription(topic.Id, nonAdminAccounts[0], 10, 100)
+
+	s.MintTokensToAddress(nonAdminAccounts[1], cosmosMath.NewInt(50))
+	_, err := s.msgServer.FundGmpSubscription(ctx, &types.FundGmpSubscriptionRequest{
+		Sender:         nonAdminAccounts[1].String(),
+		SubscriptionId: subscriptionId,
+		Amount:         cosmosMath.NewInt(50),
+	})
+	require.ErrorIs(err, types.ErrNotPermittedToManageGmpSubscription)
+
+	_, err = s.msgServer.CancelGmpSubscription(ctx, &types.CancelGmpSubscriptionRequest{
+		Sender:         nonAdminAccounts[1].String(),
+		SubscriptionId: subscriptionId,
+	})
+	require.ErrorIs(err, types.ErrNotPermittedToManageGmpSubscription)
+
+	// a whitelist admin may cancel, the balance still goes to the subscriber
+	_, err = s.msgServer.CancelGmpSubscription(ctx, &types.CancelGmpSubscriptionRequest{
+		Sender:         s.addrsStr[0],
+		SubscriptionId: subscriptionId,
+	})
+	require.NoError(err)
+	balance := s.bankKeeper.GetBalance(ctx, nonAdminAccounts[0], params.DefaultBondDenom).Amount
+	require.True(balance.Equal(cosmosMath.NewInt(100)))
+}
+
+func (s *MsgServerTestSuite) TestCreateGmpSubscriptionInvalid() {
+	ctx := s.ctx
+	require := s.Require()
+
+	_, err := s.msgServer.CreateGmpSubscription(ctx, &types.CreateGmpSubscriptionRequest{
+		Sender:             nonAdminAccounts[0].String(),
+		TopicId:            1000,
+		DestinationChain:   "ethereum",
+		DestinationAddress: "0xabcdef0123456789abcdef0123456789abcdef01",
+		FeePerMessage:      cosmosMath.NewInt(10),
+		Prepayment:         cosmosMath.ZeroInt(),
+	})
+	require.Error(err, "Should fail for a topic that does not exist")
+
+	topic := s.CreateOneTopic()
+	_, err = s.msgServer.CreateGmpSubscription(ctx, &types.CreateGmpSubscriptionRequest{
+		Sender:             nonAdminAccounts[0].String(),
+		TopicId:            topic.Id,
+		DestinationChain:   "ethereum",
+		DestinationAddress: "0xabcdef0123456789abcdef0123456789abcdef01",
+		FeePerMessage:      cosmosMath.ZeroInt(),
+		Prepayment:         cosmosMath.ZeroInt(),
+	})
+	require.Error(err, "Should fail for a zero fee per message")
+}
+
+func (s *MsgServerTestSuite) TestRetireTopicCancelsGmpSubscriptions() {
+	ctx := s.ctx
+	require := s.Require()
+	topic := s.CreateOneTopic()
+	subscriber := nonAdminAccounts[0]
+	subscriptionId := s.createGmpSubscription(topic.Id, subscriber, 10, 100)
+
+	_, err := s.msgServer.RetireTopic(ctx, &types.RetireTopicRequest{Sender: topic.Creator, TopicId: topic.Id})
+	require.NoError(err)
+
+	_, found, err := s.emissionsKeeper.GetGmpSubscription(ctx, subscriptionId)
+	require.NoError(err)
+	require.False(found, "subscription should be removed")
+	hasSubscriptions, err := s.emissionsKeeper.HasTopicGmpSubscriptions(ctx, topic.Id)
+	require.NoError(err)
+	require.False(hasSubscriptions, "topic index should be emptied")
+	require.True(s.gmpEscrowBalance().IsZero(), "escrow should be emptied")
+	balance := s.bankKeeper.GetBalance(ctx, subscriber, params.DefaultBondDenom).Amount
+	require.True(balance.Equal(cosmosMath.NewInt(100)), "balance should be refunded to the subscriber")
+
+	// A subscription left on the retired topic, e.g. imported from genesis, is cancelled once its state is cleared
+	s.MintTokensToAddress(types.GmpFeeEscrowAddress, cosmosMath.NewInt(40))
+	err = s.emissionsKeeper.SetGmpSubscription(ctx, types.GmpSubscription{
+		Id:                 subscriptionId + 1,
+		TopicId:            topic.Id,
+		Subscriber:         subscriber.String(),
+		DestinationChain:   "ethereum",
+		DestinationAddress: "0xabcdef0123456789abcdef0123456789abcdef01",
+		FeePerMessage:      cosmosMath.NewInt(10),
+		Balance:            cosmosMath.NewInt(40),
+	})
+	require.NoError(err)
+	for i := 0; i < 3; i++ {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		err = s.appModule.EndBlock(ctx)
+		require.NoError(err)
+	}
+	retirement, _, err := s.emissionsKeeper.GetTopicRetirement(ctx, topic.Id)
+	require.NoError(err)
+	require.Equal(types.TopicRetirementStage_TOPIC_RETIREMENT_STAGE_COMPLETE, retirement.Stage)
+
+	_, found, err = s.emissionsKeeper.GetGmpSubscription(ctx, subscriptionId+1)
+	require.NoError(err)
+	require.False(found, "leftover subscription should be removed")
+	hasSubscriptions, err = s.emissionsKeeper.HasTopicGmpSubscriptions(ctx, topic.Id)
+	require.NoError(err)
+	require.False(hasSubscriptions, "topic index should be emptied")
+	require.True(s.gmpEscrowBalance().IsZero(), "escrow should be emptied")
+	balance = s.bankKeeper.GetBalance(ctx, subscriber, params.DefaultBondDenom).Amount
+	require.True(balance.Equal(cosmosMath.NewInt(140)), "leftover balance should be refunded to the subscriber")
+}
+
+func (s *MsgServerTestSuite) TestSendNetworkInferenceToGmpSubscribers() {
+	ctx := s.ctx
+	require := s.Require()
+	k := s.emissionsKeeper
+	topic := s.CreateOneTopic()
+	funded := s.createGmpSubscription(topic.Id, nonAdminAccounts[0], 10, 15)
+	unfunded := s.createGmpSubscription(topic.Id, nonAdminAccounts[1], 10, 5)
+
+	sender := &fakeGmpSender{}
+	k.SetGmpSender(sender)
+	defer k.SetGmpSender(nil)
+
+	bundle := &types.ValueBundle{
+		TopicId:       topic.Id,
+		CombinedValue: alloraMath.MustNewDecFromString("1.5"),
+		NaiveValue:    alloraMath.MustNewDecFromString("1.25"),
+	}
+	// nothing is sent while no channel to Axelar is set
+	err := k.SendNetworkInferenceToGmpSubscribers(ctx, topic.Id, 10, bundle)
+	require.NoError(err)
+	require.Empty(sender.sent)
+
+	s.setGmpAxelarChannel("channel-0")
+	err = k.SendNetworkInferenceToGmpSubscribers(ctx, topic.Id, 10, bundle)
+	require.NoError(err)
+
+	require.Len(sender.sent, 1, "only the subscription covering its fee should be sent to")
+	require.Equal(funded, sender.sent[0].Id)
+	subscription, _, err := k.GetGmpSubscription(ctx, funded)
+	require.NoError(err)
+	require.True(subscription.Balance.Equal(cosmosMath.NewInt(5)), "fee should be drawn from the balance")
+	subscription, _, err = k.GetGmpSubscription(ctx, unfunded)
+	require.NoError(err)
+	require.True(subscription.Balance.Equal(cosmosMath.NewInt(5)))
+
+	delivery, found, err := k.GetGmpDelivery(ctx, "channel-0", 1)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(funded, delivery.SubscriptionId)
+	require.Equal(int64(10), delivery.BlockHeight)
+
+	// a sender failure leaves the subscription untouched
+	sender.err = errors.New("channel closed")
+	err = k.SendNetworkInferenceToGmpSubscribers(ctx, topic.Id, 11, bundle)
+	require.NoError(err)
+	subscription, _, err = k.GetGmpSubscription(ctx, funded)
+	require.NoError(err)
+	require.True(subscription.Balance.Equal(cosmosMath.NewInt(5)))
+}
+
+func (s *MsgServerTestSuite) TestResolveGmpDeliveryRefundsFailedMessages() {
+	ctx := s.ctx
+	require := s.Require()
+	k := s.emissionsKeeper
+	topic := s.CreateOneTopic()
+	subscriptionId := s.createGmpSubscription(topic.Id, nonAdminAccounts[0], 10, 30)
+	s.setGmpAxelarChannel("channel-0")
+
+	sender := &fakeGmpSender{}
+	k.SetGmpSender(sender)
+	defer k.SetGmpSender(nil)
+
+	bundle := &types.ValueBundle{TopicId: topic.Id}
+	for i := 0; i < 3; i++ {
+		err := k.SendNetworkInferenceToGmpSubscribers(ctx, topic.Id, int64(10+i), bundle)
+		require.NoError(err)
+	}
+
+	// acknowledged: the fee has been spent
+	err := k.ResolveGmpDelivery(ctx, "channel-0", 1, types.GmpDeliveryStatusAcknowledged)
+	require.NoError(err)
+	// timed out: the fee is credited back
+	err = k.ResolveGmpDelivery(ctx, "channel-0", 2, types.GmpDeliveryStatusTimedOut)
+	require.NoError(err)
+	subscription, _, err := k.GetGmpSubscription(ctx, subscriptionId)
+	require.NoError(err)
+	require.True(subscription.Balance.Equal(cosmosMath.NewInt(10)))
+
+	// failed after cancellation: the fee goes straight back to the subscriber
+	_, err = k.CancelGmpSubscription(ctx, subscriptionId)
+	require.NoError(err)
+	err = k.ResolveGmpDelivery(ctx, "channel-0", 3, types.GmpDeliveryStatusFailed)
+	require.NoError(err)
+	balance := s.bankKeeper.GetBalance(ctx, nonAdminAccounts[0], params.DefaultBondDenom).Amount
+	require.True(balance.Equal(cosmosMath.NewInt(20)))
+
+	for sequence := uint64(1); sequence <= 3; sequence++ {
+		_, found, err := k.GetGmpDelivery(ctx, "channel-0", sequence)
+		require.NoError(err)
+		require.False(found, "resolved deliveries should be removed")
+	}
+	// unknown packets are ignored
+	require.NoError(k.ResolveGmpDelivery(ctx, "channel-0", 4, types.GmpDeliveryStatusFailed))
+}
@@ -0,0 +1,189 @@
+package msgserver_test
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	alloraMath "github.com/allora-network/allora-chain/math"
+	actorutils "github.com/allora-network/allora-chain/x/emissions/keeper/actor_utils"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func (s *MsgServerTestSuite) TestMsgCreateNewTopicUnknownLossMethodFails() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	newTopicMsg := &types.CreateNewTopicRequest{
+		Creator:                  s.addrsStr[0],
+		Metadata:                 "Some metadata for the new topic",
+		LossMethod:               "method",
+		EpochLength:              10800,
+		GroundTruthLag:           10800,
+		WorkerSubmissionWindow:   10,
+		AllowNegative:            false,
+		AlphaRegret:              alloraMath.NewDecFromInt64(1),
+		PNorm:                    alloraMath.NewDecFromInt64(3),
+		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+	}
+	s.MintTokensToAddress(s.addrs[0], types.DefaultParams().CreateTopicFee)
+
+	_, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrUnknownLossMethod)
+
+	// Loss methods are matched case-insensitively
+	newTopicMsg.LossMethod = "Huber"
+	_, err = msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.NoError(err)
+}
+
+// Sets up a reputer nonce whose single inference has value 1 and whose reputer reports losses of 100,
+// as changed by updateBundle if not nil
+func (s *MsgServerTestSuite) setUpGroundTruthReputerNonce(updateBundle func(*types.ValueBundle)) (types.Topic, string) {
+	require := s.Require()
+	reputerAddr := s.addrs[0]
+	reputer := s.addrsStr[0]
+	workerAddr := s.addrs[1]
+	worker := s.addrsStr[1]
+
+	reputerValueBundle, expectedInferences, expectedForecasts, topicId := s.setUpMsgReputerPayload(reputer, reputerAddr, worker, workerAddr)
+	if updateBundle != nil {
+		updateBundle(&reputerValueBundle)
+	}
+	require.NoError(s.emissionsKeeper.InsertActiveForecasts(s.ctx, topicId, block, expectedForecasts))
+	require.NoError(s.emissionsKeeper.InsertActiveInferences(s.ctx, topicId, block, expectedInferences))
+
+	topic, err := s.emissionsKeeper.GetTopic(s.ctx, topicId)
+	require.NoError(err)
+	s.ctx = s.ctx.WithBlockHeight(block + topic.GroundTruthLag)
+	err = s.constructAndInsertReputerPayload(reputerAddr, s.privKeys[0], s.pubKeyHexStr[0], &reputerValueBundle)
+	require.NoError(err)
+	return topic, reputer
+}
+
+func (s *MsgServerTestSuite) TestSubmitGroundTruthChecks() {
+	require := s.Require()
+	topic, creator := s.setUpGroundTruthReputerNonce(nil)
+
+	msg := &types.SubmitGroundTruthRequest{
+		TopicId:                 topic.Id,
+		ReputerNonceBlockHeight: block,
+		GroundTruth:             alloraMath.NewDecFromInt64(11),
+	}
+	_, msg.Sender = getNewAddress()
+	_, err := s.msgServer.SubmitGroundTruth(s.ctx, msg)
+	require.ErrorIs(err, types.ErrNotPermittedToSubmitGroundTruth)
+
+	msg.Sender = creator
+	_, err = s.msgServer.SubmitGroundTruth(s.ctx.WithBlockHeight(block+topic.GroundTruthLag-1), msg)
+	require.ErrorIs(err, types.ErrReputerNonceWindowNotAvailable)
+
+	msg.ReputerNonceBlockHeight = block + 1
+	_, err = s.msgServer.SubmitGroundTruth(s.ctx, msg)
+	require.ErrorIs(err, types.ErrUnfulfilledNonceNotFound)
+
+	msg.ReputerNonceBlockHeight = block
+	_, err = s.msgServer.SubmitGroundTruth(s.ctx, msg)
+	require.NoError(err)
+	_, err = s.msgServer.SubmitGroundTruth(s.ctx, msg)
+	require.ErrorIs(err, types.ErrGroundTruthAlreadySubmitted)
+
+	groundTruth, found, err := s.emissionsKeeper.GetGroundTruth(s.ctx, topic.Id, block)
+	require.NoError(err)
+	require.True(found)
+	require.True(alloraMath.NewDecFromInt64(11).Equal(groundTruth))
+
+	// The chain cannot cross-check losses of topics with loss methods it doesn't know
+	topic.LossMethod = "custom"
+	require.NoError(s.emissionsKeeper.SetTopic(s.ctx, topic.Id, topic))
+	_, err = s.msgServer.SubmitGroundTruth(s.ctx, msg)
+	require.ErrorIs(err, types.ErrUnknownLossMethod)
+}
+
+func (s *MsgServerTestSuite) TestCloseReputerNonceCrossChecksLossesAgainstGroundTruth() {
+	require := s.Require()
+	topic, creator := s.setUpGroundTruthReputerNonce(nil)
+
+	// (1 - 11)^2 = 100 matches the reported inferer and network losses
+	_, err := s.msgServer.SubmitGroundTruth(s.ctx, &types.SubmitGroundTruthRequest{
+		Sender:                  creator,
+		TopicId:                 topic.Id,
+		ReputerNonceBlockHeight: block,
+		GroundTruth:             alloraMath.NewDecFromInt64(11),
+	})
+	require.NoError(err)
+
+	err = actorutils.CloseReputerNonce(&s.emissionsKeeper, s.ctx, topic, types.Nonce{BlockHeight: block})
+	require.NoError(err)
+	bundles, err := s.emissionsKeeper.GetReputerLossBundlesAtBlock(s.ctx, topic.Id, block)
+	require.NoError(err)
+	require.Len(bundles.ReputerValueBundles, 1)
+}
+
+func (s *MsgServerTestSuite) TestCloseReputerNonceRejectsLossesDeviatingFromGroundTruth() {
+	require := s.Require()
+	topic, creator := s.setUpGroundTruthReputerNonce(nil)
+
+	// (1 - 2)^2 = 1 is far from the reported inferer loss of 100
+	_, err := s.msgServer.SubmitGroundTruth(s.ctx, &types.SubmitGroundTruthRequest{
+		Sender:                  creator,
+		TopicId:                 topic.Id,
+		ReputerNonceBlockHeight: block,
+		GroundTruth:             alloraMath.NewDecFromInt64(2),
+	})
+	require.NoError(err)
+
+	// With its only reputer rejected, the nonce has no network losses and the EndBlocker fulfills it anyway
+	err = actorutils.CloseReputerNonce(&s.emissionsKeeper, s.ctx, topic, types.Nonce{BlockHeight: block})
+	require.Error(err)
+	bundles, err := s.emissionsKeeper.GetReputerLossBundlesAtBlock(s.ctx, topic.Id, block)
+	require.NoError(err)
+	require.Empty(bundles.ReputerValueBundles)
+
+	rejected := false
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == "emissions.v4.EventReputerLossesRejected" {
+			rejected = true
+		}
+	}
+	require.True(rejected)
+}
+
+func (s *MsgServerTestSuite) TestCloseReputerNonceRejectsNetworkLossesDeviatingFromGroundTruth() {
+	require := s.Require()
+	// The inferer loss is honest, but the combined loss is not
+	topic, creator := s.setUpGroundTruthReputerNonce(func(bundle *types.ValueBundle) {
+		bundle.CombinedValue = alloraMath.NewDecFromInt64(50)
+	})
+
+	_, err := s.msgServer.SubmitGroundTruth(s.ctx, &types.SubmitGroundTruthRequest{
+		Sender:                  creator,
+		TopicId:                 topic.Id,
+		ReputerNonceBlockHeight: block,
+		GroundTruth:             alloraMath.NewDecFromInt64(11),
+	})
+	require.NoError(err)
+
+	err = actorutils.CloseReputerNonce(&s.emissionsKeeper, s.ctx, topic, types.Nonce{BlockHeight: block})
+	require.Error(err)
+	bundles, err := s.emissionsKeeper.GetReputerLossBundlesAtBlock(s.ctx, topic.Id, block)
+	require.NoError(err)
+	require.Empty(bundles.ReputerValueBundles)
+
+	var rejected *types.EventReputerLossesRejected
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == "emissions.v4.EventReputerLossesRejected" {
+			typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(err)
+			rejected = typedEvent.(*types.EventReputerLossesRejected)
+		}
+	}
+	require.NotNil(rejected)
+	require.Equal(types.ReputerLossType_REPUTER_LOSS_TYPE_COMBINED, rejected.LossType)
+	require.Empty(rejected.Worker)
+	require.True(alloraMath.NewDecFromInt64(50).Equal(rejected.ReportedLoss))
+	require.True(alloraMath.NewDecFromInt64(100).Equal(rejected.RecomputedLoss))
+}
@@ -156,6 +156,54 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.UpdateParamsReq
 	if len(newParams.PNormSafeDiv) == 1 {
 		existingParams.PNormSafeDiv = newParams.PNormSafeDiv[0]
 	}
+	if len(newParams.ReputerSlashFraction) == 1 {
+		existingParams.ReputerSlashFraction = newParams.ReputerSlashFraction[0]
+	}
+	if len(newParams.SlashedStakeBurnFraction) == 1 {
+		existingParams.SlashedStakeBurnFraction = newParams.SlashedStakeBurnFraction[0]
+	}
+	if len(newParams.MaxMissedReputerNonces) == 1 {
+		existingParams.MaxMissedReputerNonces = newParams.MaxMissedReputerNonces[0]
+	}
+	if len(newParams.ReputerOutlierScoreRatio) == 1 {
+		existingParams.ReputerOutlierScoreRatio = newParams.ReputerOutlierScoreRatio[0]
+	}
+	if len(newParams.MaxReputerOutlierEpochs) == 1 {
+		existingParams.MaxReputerOutlierEpochs = newParams.MaxReputerOutlierEpochs[0]
+	}
+	if len(newParams.ReputerJailDuration) == 1 {
+		existingParams.ReputerJailDuration = newParams.ReputerJailDuration[0]
+	}
+	if len(newParams.MaxReputerLossDeviation) == 1 {
+		existingParams.MaxReputerLossDeviation = newParams.MaxReputerLossDeviation[0]
+	}
+	if len(newParams.NetworkInferenceArchiveRetention) == 1 {
+		existingParams.NetworkInferenceArchiveRetention = newParams.NetworkInferenceArchiveRetention[0]
+	}
+	if len(newParams.MaxPayloadsPerBatch) == 1 {
+		existingParams.MaxPayloadsPerBatch = newParams.MaxPayloadsPerBatch[0]
+	}
+	if len(newParams.BatchedPayloadGasDiscount) == 1 {
+		existingParams.BatchedPayloadGasDiscount = newParams.BatchedPayloadGasDiscount[0]
+	}
+	if len(newParams.MaxRedelegationsPerDelegator) == 1 {
+		existingParams.MaxRedelegationsPerDelegator = newParams.MaxRedelegationsPerDelegator[0]
+	}
+	if len(newParams.RewardAccrualEnabled) == 1 {
+		existingParams.RewardAccrualEnabled = newParams.RewardAccrualEnabled[0]
+	}
+	if len(newParams.DataSendingFeeMaxChangeRate) == 1 {
+		existingParams.DataSendingFeeMaxChangeRate = newParams.DataSendingFeeMaxChangeRate[0]
+	}
+	if len(newParams.DataSendingFeeTargetRatio) == 1 {
+		existingParams.DataSendingFeeTargetRatio = newParams.DataSendingFeeTargetRatio[0]
+	}
+	if len(newParams.GmpAxelarChannelId) == 1 {
+		existingParams.GmpAxelarChannelId = newParams.GmpAxelarChannelId[0]
+	}
+	if len(newParams.GmpAxelarCounterpartyChannelId) == 1 {
+		existingParams.GmpAxelarCounterpartyChannelId = newParams.GmpAxelarCounterpartyChannelId[0]
+	}
 	err = existingParams.Validate()
 	if err != nil {
 		return nil, err
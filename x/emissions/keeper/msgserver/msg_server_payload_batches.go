@@ -0,0 +1,151 @@
+package msgserver
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/metrics"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Inserts many worker payloads in one message, each as InsertWorkerPayload would.
+// Payloads are processed in isolated cache contexts: a rejected payload is reported in its result
+// and leaves the state untouched, without reverting the payloads accepted before or after it.
+func (ms msgServer) InsertWorkerPayloads(ctx context.Context, msg *types.InsertWorkerPayloadsRequest) (_ *types.InsertWorkerPayloadsResponse, err error) {
+	defer metrics.RecordMetrics("InsertWorkerPayloads", time.Now(), &err)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	moduleParams, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "Error getting params for sender: %v", &msg.Sender)
+	}
+	if uint64(len(msg.WorkerDataBundles)) > moduleParams.MaxPayloadsPerBatch {
+		return nil, errorsmod.Wrapf(types.ErrTooManyBatchedPayloads,
+			"%d worker payloads, at most %d allowed", len(msg.WorkerDataBundles), moduleParams.MaxPayloadsPerBatch)
+	}
+
+	results := make([]*types.BatchedPayloadResult, 0, len(msg.WorkerDataBundles))
+	for _, bundle := range msg.WorkerDataBundles {
+		var topicId TopicId
+		if bundle != nil {
+			topicId = bundle.TopicId
+		}
+		err := processBatchedPayload(sdkCtx, moduleParams, func(cacheCtx sdk.Context) error {
+			if bundle == nil {
+				return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "worker payload cannot be nil")
+			}
+			if err := bundle.Validate(); err != nil {
+				return errorsmod.Wrapf(err, "Worker invalid data for block: %d", cacheCtx.BlockHeight())
+			}
+			if err := checkInputLength(moduleParams.MaxSerializedMsgLength, bundle); err != nil {
+				return err
+			}
+			return insertWorkerPayload(cacheCtx, ms, moduleParams, msg.Sender, bundle)
+		})
+		results = append(results, newBatchedPayloadResult(topicId, err))
+	}
+	return &types.InsertWorkerPayloadsResponse{Results: results}, nil
+}
+
+// Inserts many reputer payloads in one message, each as InsertReputerPayload would.
+// Payloads are processed in isolated cache contexts: a rejected payload is reported in its result
+// and leaves the state untouched, without reverting the payloads accepted before or after it.
+func (ms msgServer) InsertReputerPayloads(ctx context.Context, msg *types.InsertReputerPayloadsRequest) (_ *types.InsertReputerPayloadsResponse, err error) {
+	defer metrics.RecordMetrics("InsertReputerPayloads", time.Now(), &err)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	moduleParams, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "Error getting params for sender: %v", &msg.Sender)
+	}
+	if uint64(len(msg.ReputerValueBundles)) > moduleParams.MaxPayloadsPerBatch {
+		return nil, errorsmod.Wrapf(types.ErrTooManyBatchedPayloads,
+			"%d reputer payloads, at most %d allowed", len(msg.ReputerValueBundles), moduleParams.MaxPayloadsPerBatch)
+	}
+
+	results := make([]*types.BatchedPayloadResult, 0, len(msg.ReputerValueBundles))
+	for _, bundle := range msg.ReputerValueBundles {
+		var topicId TopicId
+		if bundle != nil && bundle.ValueBundle != nil {
+			topicId = bundle.ValueBundle.TopicId
+		}
+		err := processBatchedPayload(sdkCtx, moduleParams, func(cacheCtx sdk.Context) error {
+			if bundle == nil {
+				return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "reputer payload cannot be nil")
+			}
+			if err := bundle.Validate(); err != nil {
+				return errorsmod.Wrapf(err, "Reputer invalid data for block: %d", cacheCtx.BlockHeight())
+			}
+			if err := checkInputLength(moduleParams.MaxSerializedMsgLength, bundle); err != nil {
+				return err
+			}
+			return insertReputerPayload(cacheCtx, ms, moduleParams, msg.Sender, bundle)
+		})
+		results = append(results, newBatchedPayloadResult(topicId, err))
+	}
+	return &types.InsertReputerPayloadsResponse{Results: results}, nil
+}
+
+// Processes a payload of a batch in a cache context, whose writes are only committed if it succeeds.
+// The payload is metered on its own gas meter, bounded by the gas remaining in the transaction,
+// and only the undiscounted fraction of the gas it consumed is charged to the transaction.
+func processBatchedPayload(
+	sdkCtx sdk.Context,
+	moduleParams types.Params,
+	process func(cacheCtx sdk.Context) error,
+) error {
+	cacheCtx, write := sdkCtx.CacheContext()
+	payloadGasMeter := storetypes.NewGasMeter(sdkCtx.GasMeter().GasRemaining())
+	err := process(cacheCtx.WithGasMeter(payloadGasMeter))
+
+	chargedGas, gasErr := discountedGas(payloadGasMeter.GasConsumed(), moduleParams.BatchedPayloadGasDiscount)
+	if gasErr != nil {
+		return gasErr
+	}
+	sdkCtx.GasMeter().ConsumeGas(chargedGas, "batched payload")
+
+	if err != nil {
+		return err
+	}
+	write()
+	return nil
+}
+
+// Returns gas * (1 - discount), rounded down
+func discountedGas(gas storetypes.Gas, discount alloraMath.Dec) (storetypes.Gas, error) {
+	gasDec, err := alloraMath.NewDecFromUint64(gas)
+	if err != nil {
+		return 0, err
+	}
+	chargedFraction, err := alloraMath.OneDec().Sub(discount)
+	if err != nil {
+		return 0, err
+	}
+	charged, err := gasDec.Mul(chargedFraction)
+	if err != nil {
+		return 0, err
+	}
+	charged, err = charged.Floor()
+	if err != nil {
+		return 0, err
+	}
+	return charged.UInt64()
+}
+
+func newBatchedPayloadResult(topicId TopicId, err error) *types.BatchedPayloadResult {
+	if err != nil {
+		return &types.BatchedPayloadResult{TopicId: topicId, Success: false, Error: err.Error()}
+	}
+	return &types.BatchedPayloadResult{TopicId: topicId, Success: true, Error: ""}
+}
@@ -0,0 +1,154 @@
+package msgserver_test
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+)
+
+// Sets up signed worker payloads of two workers, each for a topic of its own
+func (s *MsgServerTestSuite) setUpBatchedWorkerPayloads() []*types.WorkerDataBundle {
+	bundles := make([]*types.WorkerDataBundle, 0)
+	for i := 0; i < 2; i++ {
+		workerPrivateKey := secp256k1.GenPrivKey()
+		workerMsg, _ := s.setUpMsgInsertWorkerPayload(workerPrivateKey)
+		workerMsg = s.signMsgInsertWorkerPayload(workerMsg, workerPrivateKey)
+		bundles = append(bundles, workerMsg.WorkerDataBundle)
+	}
+	return bundles
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertWorkerPayloads() {
+	require := s.Require()
+	bundles := s.setUpBatchedWorkerPayloads()
+	ctx := s.ctx.WithBlockHeight(bundles[0].Nonce.BlockHeight)
+
+	senderAddr, sender := getNewAddress()
+	s.MintTokensToAddress(senderAddr, types.DefaultParams().DataSendingFee.MulRaw(3))
+
+	// A payload for an unknown topic is rejected without reverting the others
+	invalidBundle := *bundles[1]
+	invalidBundle.TopicId = 123
+	response, err := s.msgServer.InsertWorkerPayloads(ctx, &types.InsertWorkerPayloadsRequest{
+		Sender:            sender,
+		WorkerDataBundles: []*types.WorkerDataBundle{bundles[0], &invalidBundle, bundles[1]},
+	})
+	require.NoError(err)
+	require.Len(response.Results, 3)
+	require.True(response.Results[0].Success)
+	require.Equal(bundles[0].TopicId, response.Results[0].TopicId)
+	require.False(response.Results[1].Success)
+	require.Equal(uint64(123), response.Results[1].TopicId)
+	require.NotEmpty(response.Results[1].Error)
+	require.True(response.Results[2].Success)
+	require.Empty(response.Results[2].Error)
+
+	for _, bundle := range bundles {
+		inference, err := s.emissionsKeeper.GetWorkerLatestInferenceByTopicId(ctx, bundle.TopicId, bundle.Worker)
+		require.NoError(err)
+		require.True(alloraMath.NewDecFromInt64(100).Equal(inference.Value))
+	}
+
+	// Only accepted payloads are paid for
+	balance := s.bankKeeper.GetBalance(ctx, senderAddr, params.DefaultBondDenom)
+	require.True(types.DefaultParams().DataSendingFee.Equal(balance.Amount), balance.String())
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertWorkerPayloadsRespectsMaxPayloadsPerBatch() {
+	require := s.Require()
+	bundles := s.setUpBatchedWorkerPayloads()
+	ctx := s.ctx.WithBlockHeight(bundles[0].Nonce.BlockHeight)
+
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.MaxPayloadsPerBatch = 1
+	require.NoError(s.emissionsKeeper.SetParams(ctx, moduleParams))
+
+	_, err = s.msgServer.InsertWorkerPayloads(ctx, &types.InsertWorkerPayloadsRequest{
+		Sender:            bundles[0].Worker,
+		WorkerDataBundles: bundles,
+	})
+	require.ErrorIs(err, types.ErrTooManyBatchedPayloads)
+
+	_, err = s.msgServer.InsertWorkerPayloads(ctx, &types.InsertWorkerPayloadsRequest{
+		Sender:            bundles[0].Worker,
+		WorkerDataBundles: []*types.WorkerDataBundle{},
+	})
+	require.Error(err)
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertWorkerPayloadsDiscountsGas() {
+	require := s.Require()
+	bundles := s.setUpBatchedWorkerPayloads()
+	ctx := s.ctx.WithBlockHeight(bundles[0].Nonce.BlockHeight)
+
+	// Returns the gas the batch consumes with the given discount, leaving the state untouched
+	batchGas := func(discount alloraMath.Dec) storetypes.Gas {
+		cacheCtx, _ := ctx.CacheContext()
+		moduleParams, err := s.emissionsKeeper.GetParams(cacheCtx)
+		require.NoError(err)
+		moduleParams.BatchedPayloadGasDiscount = discount
+		require.NoError(s.emissionsKeeper.SetParams(cacheCtx, moduleParams))
+
+		cacheCtx = cacheCtx.WithGasMeter(storetypes.NewGasMeter(100_000_000))
+		response, err := s.msgServer.InsertWorkerPayloads(cacheCtx, &types.InsertWorkerPayloadsRequest{
+			Sender:            bundles[0].Worker,
+			WorkerDataBundles: bundles,
+		})
+		require.NoError(err)
+		for _, result := range response.Results {
+			require.True(result.Success, result.Error)
+		}
+		return cacheCtx.GasMeter().GasConsumed()
+	}
+
+	undiscountedGas := batchGas(alloraMath.ZeroDec())
+	discountedGas := batchGas(alloraMath.MustNewDecFromString("0.5"))
+	require.Less(discountedGas, undiscountedGas)
+	require.Greater(discountedGas, undiscountedGas/3)
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertReputerPayloads() {
+	require := s.Require()
+	reputerAddr := s.addrs[0]
+	reputer := s.addrsStr[0]
+	workerAddr := s.addrs[1]
+	worker := s.addrsStr[1]
+
+	valueBundle, _, _, topicId := s.setUpMsgReputerPayload(reputer, reputerAddr, worker, workerAddr)
+	topic, err := s.emissionsKeeper.GetTopic(s.ctx, topicId)
+	require.NoError(err)
+	ctx := s.ctx.WithBlockHeight(block + topic.GroundTruthLag)
+
+	// A payload for a nonce without open reputer requests is rejected without reverting the others
+	unknownNonceBundle := valueBundle
+	unknownNonceBundle.ReputerRequestNonce = &types.ReputerRequestNonce{ReputerNonce: &types.Nonce{BlockHeight: block + 1}}
+
+	response, err := s.msgServer.InsertReputerPayloads(ctx, &types.InsertReputerPayloadsRequest{
+		Sender: reputer,
+		ReputerValueBundles: []*types.ReputerValueBundle{
+			{
+				ValueBundle: &unknownNonceBundle,
+				Signature:   s.signValueBundle(&unknownNonceBundle, s.privKeys[0]),
+				Pubkey:      s.pubKeyHexStr[0],
+			},
+			{
+				ValueBundle: &valueBundle,
+				Signature:   s.signValueBundle(&valueBundle, s.privKeys[0]),
+				Pubkey:      s.pubKeyHexStr[0],
+			},
+		},
+	})
+	require.NoError(err)
+	require.Len(response.Results, 2)
+	require.False(response.Results[0].Success)
+	require.Contains(response.Results[0].Error, types.ErrUnfulfilledNonceNotFound.Error())
+	require.True(response.Results[1].Success)
+	require.Equal(topicId, response.Results[1].TopicId)
+
+	loss, err := s.emissionsKeeper.GetReputerLatestLossByTopicId(ctx, topicId, reputer)
+	require.NoError(err)
+	require.Equal(block, loss.ValueBundle.ReputerRequestNonce.ReputerNonce.BlockHeight)
+}
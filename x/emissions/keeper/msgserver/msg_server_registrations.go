@@ -29,6 +29,14 @@ func (ms msgServer) Register(ctx context.Context, msg *types.RegisterRequest) (_
 		return nil, types.ErrTopicDoesNotExist
 	}
 
+	isAllowed, err := ms.k.IsAllowedInTopic(ctx, msg.TopicId, msg.IsReputer, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if !isAllowed {
+		return nil, errorsmod.Wrapf(types.ErrAddressNotInTopicAllowlist, "topic %d", msg.TopicId)
+	}
+
 	if msg.IsReputer {
 		isRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, msg.Sender)
 		if err != nil {
@@ -55,6 +63,10 @@ func (ms msgServer) Register(ctx context.Context, msg *types.RegisterRequest) (_
 	if err != nil {
 		return nil, err
 	}
+	err = burnCollectedFee(ctx, ms, params.RegistrationFee)
+	if err != nil {
+		return nil, err
+	}
 
 	nodeInfo := types.OffchainNode{
 		NodeAddress: msg.Sender,
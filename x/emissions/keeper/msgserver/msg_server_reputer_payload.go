@@ -37,62 +37,163 @@ func (ms msgServer) InsertReputerPayload(ctx context.Context, msg *types.InsertR
 		return nil, err
 	}
 
-	nonce := msg.ReputerValueBundle.ValueBundle.ReputerRequestNonce
-	topicId := msg.ReputerValueBundle.ValueBundle.TopicId
+	err = insertReputerPayload(sdkCtx, ms, moduleParams, msg.Sender, msg.ReputerValueBundle)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.InsertReputerPayloadResponse{}, nil
+}
+
+// Inserts the losses of a reputer, paid for by the sender, after checking that the reputer
+// may submit them for its nonce. The bundle must have been validated.
+func insertReputerPayload(
+	ctx sdk.Context,
+	ms msgServer,
+	moduleParams types.Params,
+	sender string,
+	bundle *types.ReputerValueBundle,
+) error {
+	blockHeight := ctx.BlockHeight()
+	nonce := bundle.ValueBundle.ReputerRequestNonce
+	topicId := bundle.ValueBundle.TopicId
 
 	topic, err := ms.k.GetTopic(ctx, topicId)
 	if err != nil {
-		return nil, types.ErrInvalidTopicId
+		return types.ErrInvalidTopicId
 	}
 
 	workerNonceUnfulfilled, err := ms.k.IsWorkerNonceUnfulfilled(ctx, topicId, nonce.ReputerNonce)
 	if err != nil {
-		return nil, err
+		return err
 	} else if workerNonceUnfulfilled {
-		return nil, errorsmod.Wrapf(types.ErrNonceStillUnfulfilled, "worker nonce")
+		return errorsmod.Wrapf(types.ErrNonceStillUnfulfilled, "worker nonce")
 	}
 
 	reputerNonceUnfulfilled, err := ms.k.IsReputerNonceUnfulfilled(ctx, topicId, nonce.ReputerNonce)
 	if err != nil {
-		return nil, err
+		return err
 	} else if !reputerNonceUnfulfilled {
-		return nil, errorsmod.Wrapf(types.ErrUnfulfilledNonceNotFound, "reputer nonce")
+		return errorsmod.Wrapf(types.ErrUnfulfilledNonceNotFound, "reputer nonce")
 	}
 
 	if !ms.k.BlockWithinReputerSubmissionWindowOfNonce(topic, *nonce, blockHeight) {
-		return nil, errorsmod.Wrapf(
+		return errorsmod.Wrapf(
 			types.ErrReputerNonceWindowNotAvailable,
 			"Reputer window not open for topic: %d, current block %d, start window: %d, end window: %d",
 			topicId, blockHeight, nonce.ReputerNonce.BlockHeight+topic.GroundTruthLag, nonce.ReputerNonce.BlockHeight+topic.GroundTruthLag*2,
 		)
 	}
 
-	isRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, topicId, msg.ReputerValueBundle.ValueBundle.Reputer)
+	isRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, topicId, bundle.ValueBundle.Reputer)
 	if err != nil {
-		return nil, err
+		return err
 	} else if !isRegistered {
-		return nil, errorsmod.Wrapf(types.ErrAddressNotRegistered, "reputer is not registered in this topic")
+		return errorsmod.Wrapf(types.ErrAddressNotRegistered, "reputer is not registered in this topic")
+	}
+	isAllowed, err := ms.k.IsAllowedInTopic(ctx, topicId, true, bundle.ValueBundle.Reputer)
+	if err != nil {
+		return err
+	} else if !isAllowed {
+		return errorsmod.Wrapf(types.ErrAddressNotInTopicAllowlist, "reputer is not in the allowlist of this topic")
+	}
+	isJailed, err := ms.k.IsReputerJailed(ctx, topicId, bundle.ValueBundle.Reputer)
+	if err != nil {
+		return err
+	} else if isJailed {
+		return errorsmod.Wrapf(types.ErrReputerJailed, "reputer must unjail before submitting to this topic")
 	}
 
 	// Check that the reputer enough stake in the topic
-	stake, err := ms.k.GetStakeReputerAuthority(ctx, topicId, msg.ReputerValueBundle.ValueBundle.Reputer)
+	stake, err := ms.k.GetStakeReputerAuthority(ctx, topicId, bundle.ValueBundle.Reputer)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if stake.LT(moduleParams.RequiredMinimumStake) {
-		return nil, errorsmod.Wrapf(types.ErrInsufficientStake, "reputer does not have sufficient stake in the topic")
+		return errorsmod.Wrapf(types.ErrInsufficientStake, "reputer does not have sufficient stake in the topic")
 	}
 
-	// Before accepting data, transfer fee amount from sender to ecosystem bucket
-	err = sendEffectiveRevenueActivateTopicIfWeightSufficient(ctx, ms, msg.Sender, topicId, moduleParams.DataSendingFee)
+	// Before accepting data, pay the fee to the ecosystem bucket out of a topic sponsorship or the sender
+	err = payDataSendingFee(ctx, ms, sender, bundle.ValueBundle.Reputer, topic)
 	if err != nil {
+		return err
+	}
+
+	err = ms.k.AppendReputerLoss(ctx, topic, moduleParams, nonce.ReputerNonce.BlockHeight, bundle)
+	if err != nil {
+		return err
+	}
+
+	// The reputer is present for this nonce even if its losses don't make it into the active set
+	return ms.k.RecordReputerSubmission(ctx, topicId, bundle.ValueBundle.Reputer, nonce.ReputerNonce.BlockHeight)
+}
+
+// Stores the ground truth of a reputer nonce so the chain can recompute inferer losses itself
+// and cross-check the losses reported by reputers when the nonce closes
+func (ms msgServer) SubmitGroundTruth(ctx context.Context, msg *types.SubmitGroundTruthRequest) (_ *types.SubmitGroundTruthResponse, err error) {
+	defer metrics.RecordMetrics("SubmitGroundTruth", time.Now(), &err)
+
+	if err := msg.Validate(); err != nil {
 		return nil, err
 	}
 
-	err = ms.k.AppendReputerLoss(sdkCtx, topic, moduleParams, nonce.ReputerNonce.BlockHeight, msg.ReputerValueBundle)
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
 	if err != nil {
 		return nil, err
 	}
+	if !topicExists {
+		return nil, types.ErrTopicDoesNotExist
+	}
+	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the topic creator or a whitelist admin may submit the ground truth
+	if topic.Creator != msg.Sender {
+		isAdmin, err := ms.k.IsWhitelistAdmin(ctx, msg.Sender)
+		if err != nil {
+			return nil, err
+		} else if !isAdmin {
+			return nil, types.ErrNotPermittedToSubmitGroundTruth
+		}
+	}
+
+	if _, ok := ms.k.GetLossFunction(topic.LossMethod); !ok {
+		return nil, errorsmod.Wrapf(types.ErrUnknownLossMethod, "chain cannot recompute losses of topic with loss method %s", topic.LossMethod)
+	}
+	if topic.InferenceDimension() > 1 {
+		return nil, errorsmod.Wrapf(types.ErrInvalidInferenceDimension, "ground truths are scalar, topic %d is vector-valued", topic.Id)
+	}
+
+	nonce := types.Nonce{BlockHeight: msg.ReputerNonceBlockHeight}
+	reputerNonceUnfulfilled, err := ms.k.IsReputerNonceUnfulfilled(ctx, msg.TopicId, &nonce)
+	if err != nil {
+		return nil, err
+	} else if !reputerNonceUnfulfilled {
+		return nil, errorsmod.Wrapf(types.ErrUnfulfilledNonceNotFound, "reputer nonce")
+	}
 
-	return &types.InsertReputerPayloadResponse{}, err
+	// The ground truth only exists once the ground truth lag has elapsed
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if blockHeight < nonce.BlockHeight+topic.GroundTruthLag {
+		return nil, errorsmod.Wrapf(
+			types.ErrReputerNonceWindowNotAvailable,
+			"ground truth not yet available for topic: %d, current block %d, available from: %d",
+			msg.TopicId, blockHeight, nonce.BlockHeight+topic.GroundTruthLag,
+		)
+	}
+
+	_, found, err := ms.k.GetGroundTruth(ctx, msg.TopicId, nonce.BlockHeight)
+	if err != nil {
+		return nil, err
+	} else if found {
+		return nil, types.ErrGroundTruthAlreadySubmitted
+	}
+
+	err = ms.k.SetGroundTruth(ctx, msg.TopicId, nonce.BlockHeight, msg.GroundTruth)
+	if err != nil {
+		return nil, err
+	}
+	return &types.SubmitGroundTruthResponse{}, nil
 }
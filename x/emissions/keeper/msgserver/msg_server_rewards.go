@@ -0,0 +1,24 @@
+package msgserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/allora-network/allora-chain/x/emissions/metrics"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Pays the sender out the rewards accrued to it in every topic while reward accrual was enabled
+func (ms msgServer) ClaimRewards(ctx context.Context, msg *types.ClaimRewardsRequest) (_ *types.ClaimRewardsResponse, err error) {
+	defer metrics.RecordMetrics("ClaimRewards", time.Now(), &err)
+
+	if err = msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	amount, err := ms.k.ClaimRewards(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	return &types.ClaimRewardsResponse{Amount: amount}, nil
+}
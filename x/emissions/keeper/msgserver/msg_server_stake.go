@@ -383,3 +383,92 @@ func (ms msgServer) RewardDelegateStake(ctx context.Context, msg *types.RewardDe
 	}
 	return &types.RewardDelegateStakeResponse{}, err
 }
+
+// Opts the sender's delegation upon a reputer in or out of auto-compounding. While opted in,
+// its rewards are added to its delegate stake whenever the reputer is paid rather than awaiting RewardDelegateStake.
+func (ms msgServer) SetDelegateStakeAutoCompound(ctx context.Context, msg *types.SetDelegateStakeAutoCompoundRequest) (_ *types.SetDelegateStakeAutoCompoundResponse, err error) {
+	defer metrics.RecordMetrics("SetDelegateStakeAutoCompound", time.Now(), &err)
+
+	if err = msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if !topicExists {
+		return nil, types.ErrTopicDoesNotExist
+	}
+
+	err = ms.k.SetDelegateStakeAutoCompound(ctx, msg.TopicId, msg.Sender, msg.Reputer, msg.Enabled)
+	return &types.SetDelegateStakeAutoCompoundResponse{}, err
+}
+
+// Moves delegate stake from one topic and reputer to another without waiting out the stake removal delay.
+// Until the delay has passed the redelegated stake remains slashable for its source reputer.
+func (ms msgServer) RedelegateStake(ctx context.Context, msg *types.RedelegateStakeRequest) (_ *types.RedelegateStakeResponse, err error) {
+	defer metrics.RecordMetrics("RedelegateStake", time.Now(), &err)
+
+	if err = msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	for _, topicId := range []TopicId{msg.SrcTopicId, msg.DstTopicId} {
+		topicExists, err := ms.k.TopicExists(ctx, topicId)
+		if err != nil {
+			return nil, err
+		} else if !topicExists {
+			return nil, errorsmod.Wrapf(types.ErrTopicDoesNotExist, "topic %d", topicId)
+		}
+	}
+
+	isRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.DstTopicId, msg.DstReputer)
+	if err != nil {
+		return nil, err
+	} else if !isRegistered {
+		return nil, errorsmod.Wrap(types.ErrAddressIsNotRegisteredInThisTopic, "destination reputer address")
+	}
+
+	_, err = ms.k.RedelegateStake(ctx, msg.Sender, msg.SrcTopicId, msg.SrcReputer, msg.DstTopicId, msg.DstReputer, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = activateTopicIfWeightAtLeastGlobalMin(ctx, ms, msg.DstTopicId)
+	return &types.RedelegateStakeResponse{}, err
+}
+
+// Lets a reputer jailed for missing nonces or scoring as an outlier submit payloads to the topic again
+// once its jail period has elapsed, provided it still holds the minimum stake.
+func (ms msgServer) UnjailReputer(ctx context.Context, msg *types.UnjailReputerRequest) (_ *types.UnjailReputerResponse, err error) {
+	defer metrics.RecordMetrics("UnjailReputer", time.Now(), &err)
+
+	err = msg.Validate()
+	if err != nil {
+		return nil, err
+	}
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if !topicExists {
+		return nil, types.ErrTopicDoesNotExist
+	}
+
+	moduleParams, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stake, err := ms.k.GetStakeReputerAuthority(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if stake.LT(moduleParams.RequiredMinimumStake) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientStake, "reputer does not have sufficient stake in the topic")
+	}
+
+	err = ms.k.UnjailReputer(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	return &types.UnjailReputerResponse{}, nil
+}
@@ -624,6 +624,60 @@ func (s *MsgServerTestSuite) TestDelegateStakeUnregisteredReputer() {
 	require.True(errors.Is(err, types.ErrAddressIsNotRegisteredInThisTopic), "Error should indicate that the reputer is not registered in the topic")
 }
 
+func (s *MsgServerTestSuite) TestRedelegateStake() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	delegatorAddr := s.addrs[0]
+	delegator := s.addrsStr[0]
+	srcReputer := s.addrsStr[1]
+	dstReputer := s.addrsStr[2]
+	topicId := s.CreateOneTopic().Id
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(1000))
+	for _, reputer := range []string{srcReputer, dstReputer} {
+		err := keeper.InsertReputer(ctx, topicId, reputer, types.OffchainNode{Owner: s.addrsStr[7], NodeAddress: reputer})
+		require.NoError(err)
+	}
+
+	_, err := s.msgServer.DelegateStake(ctx, &types.DelegateStakeRequest{
+		Sender:  delegator,
+		TopicId: topicId,
+		Reputer: srcReputer,
+		Amount:  cosmosMath.NewInt(50),
+	})
+	require.NoError(err)
+
+	msg := &types.RedelegateStakeRequest{
+		Sender:     delegator,
+		SrcTopicId: topicId,
+		SrcReputer: srcReputer,
+		DstTopicId: topicId,
+		DstReputer: dstReputer,
+		Amount:     cosmosMath.NewInt(30),
+	}
+	_, err = s.msgServer.RedelegateStake(ctx, msg)
+	require.NoError(err)
+
+	srcStake, err := keeper.GetDelegateStakeUponReputer(ctx, topicId, srcReputer)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(20), srcStake)
+	dstStake, err := keeper.GetDelegateStakeUponReputer(ctx, topicId, dstReputer)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(30), dstStake)
+	topicStake, err := keeper.GetTopicStake(ctx, topicId)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(50), topicStake)
+
+	// Redelegating to the source itself or to an unregistered reputer is rejected
+	msg.DstReputer = srcReputer
+	_, err = s.msgServer.RedelegateStake(ctx, msg)
+	require.Error(err)
+	msg.DstReputer = s.addrsStr[3]
+	_, err = s.msgServer.RedelegateStake(ctx, msg)
+	require.ErrorIs(err, types.ErrAddressIsNotRegisteredInThisTopic)
+}
+
 func (s *MsgServerTestSuite) TestStartRemoveDelegateStake() {
 	ctx := s.ctx
 	require := s.Require()
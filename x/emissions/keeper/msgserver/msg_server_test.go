@@ -89,6 +89,7 @@ func (s *MsgServerTestSuite) SetupTest() {
 		types.AlloraStakingAccountName: {"burner", "minter", "staking"},
 		types.AlloraRewardsAccountName: {"minter"},
 		types.AlloraPendingRewardForDelegatorAccountName: {"minter"},
+		types.AlloraAccruedRewardsAccountName:            {"minter"},
 		minttypes.EcosystemModuleName:                    nil,
 		"bonded_tokens_pool":                             {"burner", "staking"},
 		"not_bonded_tokens_pool":                         {"burner", "staking"},
@@ -128,7 +129,7 @@ func (s *MsgServerTestSuite) SetupTest() {
 		bankKeeper,
 		authtypes.FeeCollectorName)
 	s.key = key
-	appModule := module.NewAppModule(encCfg.Codec, s.emissionsKeeper)
+	appModule := module.NewAppModule(encCfg.Codec, s.emissionsKeeper, accountKeeper, bankKeeper)
 	defaultGenesis := appModule.DefaultGenesis(encCfg.Codec)
 	appModule.InitGenesis(ctx, encCfg.Codec, defaultGenesis)
 	s.msgServer = msgserver.NewMsgServerImpl(s.emissionsKeeper)
@@ -8,6 +8,7 @@ import (
 	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/metrics"
 	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (ms msgServer) CreateNewTopic(ctx context.Context, msg *types.CreateNewTopicRequest) (_ *types.CreateNewTopicResponse, err error) {
@@ -20,6 +21,9 @@ func (ms msgServer) CreateNewTopic(ctx context.Context, msg *types.CreateNewTopi
 	if err := msg.Validate(params.MaxStringLength); err != nil {
 		return nil, err
 	}
+	if _, ok := ms.k.GetLossFunction(msg.LossMethod); !ok {
+		return nil, errorsmod.Wrapf(types.ErrUnknownLossMethod, "%s, known loss methods: %v", msg.LossMethod, ms.k.GetLossMethods())
+	}
 
 	topicId, err := ms.k.GetNextTopicId(ctx)
 	if err != nil {
@@ -38,6 +42,10 @@ func (ms msgServer) CreateNewTopic(ctx context.Context, msg *types.CreateNewTopi
 	if err != nil {
 		return nil, err
 	}
+	err = burnCollectedFee(ctx, ms, params.CreateTopicFee)
+	if err != nil {
+		return nil, err
+	}
 
 	topic := types.Topic{
 		Id:                       topicId,
@@ -57,6 +65,9 @@ func (ms msgServer) CreateNewTopic(ctx context.Context, msg *types.CreateNewTopi
 		ActiveInfererQuantile:    msg.ActiveInfererQuantile,
 		ActiveForecasterQuantile: msg.ActiveForecasterQuantile,
 		ActiveReputerQuantile:    msg.ActiveReputerQuantile,
+		WorkerRevealWindow:       msg.WorkerRevealWindow,
+		ValueDimension:           msg.ValueDimension,
+		ArchiveNetworkInferences: msg.ArchiveNetworkInferences,
 	}
 	_, err = ms.k.IncrementTopicId(ctx)
 	if err != nil {
@@ -65,7 +76,80 @@ func (ms msgServer) CreateNewTopic(ctx context.Context, msg *types.CreateNewTopi
 	if err := ms.k.SetTopic(ctx, topicId, topic); err != nil {
 		return nil, err
 	}
+	types.EmitNewTopicCreatedEvent(sdk.UnwrapSDKContext(ctx), topic)
 
 	err = ms.k.AddTopicFeeRevenue(ctx, topicId, params.CreateTopicFee)
 	return &types.CreateNewTopicResponse{TopicId: topicId}, err
 }
+
+func (ms msgServer) UpdateTopic(ctx context.Context, msg *types.UpdateTopicRequest) (_ *types.UpdateTopicResponse, err error) {
+	defer metrics.RecordMetrics("UpdateTopic", time.Now(), &err)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	if !topicExists {
+		return nil, types.ErrTopicDoesNotExist
+	}
+	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the topic creator or a whitelist admin may update the topic
+	if topic.Creator != msg.Sender {
+		isAdmin, err := ms.k.IsWhitelistAdmin(ctx, msg.Sender)
+		if err != nil {
+			return nil, err
+		} else if !isAdmin {
+			return nil, types.ErrNotPermittedToUpdateTopic
+		}
+	}
+
+	effectiveBlock, err := ms.k.ScheduleTopicUpdate(ctx, msg.TopicId, *msg.Params)
+	if err != nil {
+		return nil, err
+	}
+	return &types.UpdateTopicResponse{EffectiveBlockHeight: effectiveBlock}, nil
+}
+
+func (ms msgServer) RetireTopic(ctx context.Context, msg *types.RetireTopicRequest) (_ *types.RetireTopicResponse, err error) {
+	defer metrics.RecordMetrics("RetireTopic", time.Now(), &err)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	if !topicExists {
+		return nil, types.ErrTopicDoesNotExist
+	}
+	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the topic creator or a whitelist admin may retire the topic
+	if topic.Creator != msg.Sender {
+		isAdmin, err := ms.k.IsWhitelistAdmin(ctx, msg.Sender)
+		if err != nil {
+			return nil, err
+		} else if !isAdmin {
+			return nil, types.ErrNotPermittedToRetireTopic
+		}
+	}
+
+	_, err = ms.k.RetireTopic(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	return &types.RetireTopicResponse{}, nil
+}
@@ -1,10 +1,14 @@
 package msgserver_test
 
 import (
+	"context"
 	"strings"
 
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 /// Topics tests
@@ -166,3 +170,393 @@ func (s *MsgServerTestSuite) TestMsgCreateNewTopicTooLongLossMethodFails() {
 	require.Nil(result)
 	require.ErrorContains(err, "loss method invalid")
 }
+
+func (s *MsgServerTestSuite) TestMsgUpdateTopicInactiveTopicAppliesImmediately() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	topic := s.CreateOneTopic()
+
+	result, err := msgServer.UpdateTopic(ctx, &types.UpdateTopicRequest{
+		Sender:  topic.Creator,
+		TopicId: topic.Id,
+		Params: &types.OptionalTopicParams{
+			EpochLength:            []int64{7200},
+			GroundTruthLag:         []int64{14400},
+			WorkerSubmissionWindow: []int64{20},
+			AlphaRegret:            []alloraMath.Dec{alloraMath.MustNewDecFromString("0.5")},
+		},
+	})
+	require.NoError(err)
+	require.Equal(ctx.BlockHeight(), result.EffectiveBlockHeight)
+
+	updatedTopic, err := s.emissionsKeeper.GetTopic(ctx, topic.Id)
+	require.NoError(err)
+	require.Equal(int64(7200), updatedTopic.EpochLength)
+	require.Equal(int64(14400), updatedTopic.GroundTruthLag)
+	require.Equal(int64(20), updatedTopic.WorkerSubmissionWindow)
+	require.Equal(alloraMath.MustNewDecFromString("0.5"), updatedTopic.AlphaRegret)
+	// fields not in the update are left untouched
+	require.Equal(topic.PNorm, updatedTopic.PNorm)
+	require.Equal(topic.Epsilon, updatedTopic.Epsilon)
+
+	_, found, err := s.emissionsKeeper.GetScheduledTopicUpdate(ctx, topic.Id)
+	require.NoError(err)
+	require.False(found)
+}
+
+func (s *MsgServerTestSuite) TestMsgUpdateTopicActiveTopicScheduledAtNextEpoch() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	topic := s.CreateOneTopic()
+
+	err := s.emissionsKeeper.ActivateTopic(ctx, topic.Id)
+	require.NoError(err)
+	churningBlock, isActive, err := s.emissionsKeeper.GetNextPossibleChurningBlockByTopicId(ctx, topic.Id)
+	require.NoError(err)
+	require.True(isActive)
+
+	result, err := msgServer.UpdateTopic(ctx, &types.UpdateTopicRequest{
+		Sender:  topic.Creator,
+		TopicId: topic.Id,
+		Params:  &types.OptionalTopicParams{WorkerSubmissionWindow: []int64{30}},
+	})
+	require.NoError(err)
+	require.Equal(churningBlock, result.EffectiveBlockHeight)
+
+	// A second update before the epoch boundary is merged with the first
+	_, err = msgServer.UpdateTopic(ctx, &types.UpdateTopicRequest{
+		Sender:  topic.Creator,
+		TopicId: topic.Id,
+		Params:  &types.OptionalTopicParams{EpochLength: []int64{3600}, GroundTruthLag: []int64{3600}},
+	})
+	require.NoError(err)
+
+	// Topic is unchanged until the epoch boundary
+	currentTopic, err := s.emissionsKeeper.GetTopic(ctx, topic.Id)
+	require.NoError(err)
+	require.Equal(topic.EpochLength, currentTopic.EpochLength)
+	require.Equal(topic.WorkerSubmissionWindow, currentTopic.WorkerSubmissionWindow)
+
+	update, found, err := s.emissionsKeeper.GetScheduledTopicUpdate(ctx, topic.Id)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(churningBlock, update.EffectiveBlockHeight)
+	require.Equal([]int64{30}, update.Params.WorkerSubmissionWindow)
+	require.Equal([]int64{3600}, update.Params.EpochLength)
+
+	ctx = ctx.WithBlockHeight(churningBlock)
+	err = s.emissionsKeeper.ApplyScheduledTopicUpdatesAtBlock(ctx, churningBlock)
+	require.NoError(err)
+
+	currentTopic, err = s.emissionsKeeper.GetTopic(ctx, topic.Id)
+	require.NoError(err)
+	require.Equal(int64(3600), currentTopic.EpochLength)
+	require.Equal(int64(3600), currentTopic.GroundTruthLag)
+	require.Equal(int64(30), currentTopic.WorkerSubmissionWindow)
+	_, found, err = s.emissionsKeeper.GetScheduledTopicUpdate(ctx, topic.Id)
+	require.NoError(err)
+	require.False(found)
+
+	// The next churning block follows the new epoch length
+	err = s.emissionsKeeper.AttemptTopicReactivation(ctx, topic.Id)
+	require.NoError(err)
+	nextChurningBlock, isActive, err := s.emissionsKeeper.GetNextPossibleChurningBlockByTopicId(ctx, topic.Id)
+	require.NoError(err)
+	require.True(isActive)
+	require.Equal(churningBlock+3600, nextChurningBlock)
+}
+
+func (s *MsgServerTestSuite) TestScheduledTopicUpdateDroppedWhenNoLongerValid() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	topic := s.CreateOneTopic()
+
+	err := s.emissionsKeeper.ActivateTopic(ctx, topic.Id)
+	require.NoError(err)
+	churningBlock, _, err := s.emissionsKeeper.GetNextPossibleChurningBlockByTopicId(ctx, topic.Id)
+	require.NoError(err)
+
+	_, err = msgServer.UpdateTopic(ctx, &types.UpdateTopicRequest{
+		Sender:  topic.Creator,
+		TopicId: topic.Id,
+		Params:  &types.OptionalTopicParams{EpochLength: []int64{3600}, GroundTruthLag: []int64{3600}},
+	})
+	require.NoError(err)
+
+	// The minimum epoch length is raised above the scheduled one before the update takes effect
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.MinEpochLength = 7200
+	err = s.emissionsKeeper.SetParams(ctx, moduleParams)
+	require.NoError(err)
+
+	ctx = ctx.WithBlockHeight(churningBlock).WithEventManager(sdk.NewEventManager())
+	err = s.emissionsKeeper.ApplyScheduledTopicUpdatesAtBlock(ctx, churningBlock)
+	require.NoError(err)
+
+	currentTopic, err := s.emissionsKeeper.GetTopic(ctx, topic.Id)
+	require.NoError(err)
+	require.Equal(topic.EpochLength, currentTopic.EpochLength)
+	_, found, err := s.emissionsKeeper.GetScheduledTopicUpdate(ctx, topic.Id)
+	require.NoError(err)
+	require.False(found)
+
+	events := ctx.EventManager().Events()
+	require.Len(events, 1)
+	require.Equal("emissions.v4.EventScheduledTopicUpdateDropped", events[0].Type)
+	reason, exists := events[0].GetAttribute("reason")
+	require.True(exists)
+	require.Contains(reason.GetValue(), "minimum epoch length")
+}
+
+func (s *MsgServerTestSuite) TestMsgUpdateTopicByNonCreatorNonAdminFails() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	topic := s.CreateOneTopic()
+
+	sender := s.addrsStr[0]
+	err := s.emissionsKeeper.RemoveWhitelistAdmin(ctx, sender)
+	require.NoError(err)
+
+	_, err = msgServer.UpdateTopic(ctx, &types.UpdateTopicRequest{
+		Sender:  sender,
+		TopicId: topic.Id,
+		Params:  &types.OptionalTopicParams{WorkerSubmissionWindow: []int64{30}},
+	})
+	require.ErrorIs(err, types.ErrNotPermittedToUpdateTopic)
+
+	// A whitelist admin that is not the creator may update the topic
+	_, err = msgServer.UpdateTopic(ctx, &types.UpdateTopicRequest{
+		Sender:  s.addrsStr[1],
+		TopicId: topic.Id,
+		Params:  &types.OptionalTopicParams{WorkerSubmissionWindow: []int64{30}},
+	})
+	require.NoError(err)
+}
+
+func (s *MsgServerTestSuite) TestMsgUpdateTopicInvalidParamsFails() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	topic := s.CreateOneTopic()
+
+	// worker submission window cannot be higher than epoch length
+	_, err := msgServer.UpdateTopic(ctx, &types.UpdateTopicRequest{
+		Sender:  topic.Creator,
+		TopicId: topic.Id,
+		Params:  &types.OptionalTopicParams{WorkerSubmissionWindow: []int64{topic.EpochLength + 1}},
+	})
+	require.ErrorContains(err, "worker submission window cannot be higher than epoch length")
+
+	_, err = msgServer.UpdateTopic(ctx, &types.UpdateTopicRequest{
+		Sender:  topic.Creator,
+		TopicId: topic.Id,
+		Params:  &types.OptionalTopicParams{PNorm: []alloraMath.Dec{alloraMath.NewDecFromInt64(5)}},
+	})
+	require.ErrorContains(err, "p-norm must be between 2.5 and 4.5")
+
+	_, err = msgServer.UpdateTopic(ctx, &types.UpdateTopicRequest{
+		Sender:  topic.Creator,
+		TopicId: topic.Id + 1,
+		Params:  &types.OptionalTopicParams{WorkerSubmissionWindow: []int64{30}},
+	})
+	require.ErrorIs(err, types.ErrTopicDoesNotExist)
+
+	updatedTopic, err := s.emissionsKeeper.GetTopic(ctx, topic.Id)
+	require.NoError(err)
+	require.Equal(topic.WorkerSubmissionWindow, updatedTopic.WorkerSubmissionWindow)
+	require.Equal(topic.PNorm, updatedTopic.PNorm)
+}
+
+func (s *MsgServerTestSuite) TestMsgRetireTopicRefundsStakeAndClearsState() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	keeper := s.emissionsKeeper
+	topic := s.CreateOneTopic()
+
+	reputerAddr, reputer := s.addrs[1], s.addrsStr[1]
+	delegatorAddr, delegator := s.addrs[2], s.addrsStr[2]
+	s.MintTokensToAddress(reputerAddr, cosmosMath.NewInt(1000))
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(1000))
+	err := keeper.InsertReputer(ctx, topic.Id, reputer, types.OffchainNode{Owner: reputer, NodeAddress: reputer})
+	require.NoError(err)
+
+	_, err = msgServer.AddStake(ctx, &types.AddStakeRequest{Sender: reputer, TopicId: topic.Id, Amount: cosmosMath.NewInt(100)})
+	require.NoError(err)
+	_, err = msgServer.DelegateStake(ctx, &types.DelegateStakeRequest{
+		Sender:  delegator,
+		TopicId: topic.Id,
+		Reputer: reputer,
+		Amount:  cosmosMath.NewInt(50),
+	})
+	require.NoError(err)
+	// A pending partial stake removal is replaced by the full refund
+	_, err = msgServer.RemoveStake(ctx, &types.RemoveStakeRequest{Sender: reputer, TopicId: topic.Id, Amount: cosmosMath.NewInt(10)})
+	require.NoError(err)
+	err = keeper.SetPreviousTopicWeight(ctx, topic.Id, alloraMath.NewDecFromInt64(10))
+	require.NoError(err)
+
+	reputerBalance := s.bankKeeper.GetBalance(ctx, reputerAddr, params.DefaultBondDenom).Amount
+	delegatorBalance := s.bankKeeper.GetBalance(ctx, delegatorAddr, params.DefaultBondDenom).Amount
+
+	_, err = msgServer.RetireTopic(ctx, &types.RetireTopicRequest{Sender: topic.Creator, TopicId: topic.Id})
+	require.NoError(err)
+
+	// The topic no longer accepts messages but its archive is kept
+	topicExists, err := keeper.TopicExists(ctx, topic.Id)
+	require.NoError(err)
+	require.False(topicExists)
+	_, err = msgServer.AddStake(ctx, &types.AddStakeRequest{Sender: reputer, TopicId: topic.Id, Amount: cosmosMath.NewInt(1)})
+	require.ErrorIs(err, types.ErrTopicDoesNotExist)
+	retirement, found, err := keeper.GetTopicRetirement(ctx, topic.Id)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(topic.Id, retirement.Topic.Id)
+	require.Equal(topic.Creator, retirement.RetiredBy)
+	require.Equal(types.TopicRetirementStage_TOPIC_RETIREMENT_STAGE_REFUNDING_STAKE, retirement.Stage)
+
+	// Refunds are queued through the stake removal queue
+	err = s.appModule.EndBlock(ctx)
+	require.NoError(err)
+	retirement, _, err = keeper.GetTopicRetirement(ctx, topic.Id)
+	require.NoError(err)
+	require.Equal(types.TopicRetirementStage_TOPIC_RETIREMENT_STAGE_AWAITING_REFUNDS, retirement.Stage)
+	removal, found, err := keeper.GetStakeRemovalForReputerAndTopicId(ctx, reputer, topic.Id)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(cosmosMath.NewInt(100), removal.Amount)
+	delegateRemoval, found, err := keeper.GetDelegateStakeRemovalForDelegatorReputerAndTopicId(ctx, delegator, reputer, topic.Id)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(cosmosMath.NewInt(50), delegateRemoval.Amount)
+
+	// Refunds are paid out once the removal delay has passed
+	ctx = ctx.WithBlockHeight(retirement.RefundsCompleteBlock)
+	err = s.appModule.EndBlock(ctx)
+	require.NoError(err)
+	require.Equal(reputerBalance.Add(cosmosMath.NewInt(100)), s.bankKeeper.GetBalance(ctx, reputerAddr, params.DefaultBondDenom).Amount)
+	require.Equal(delegatorBalance.Add(cosmosMath.NewInt(50)), s.bankKeeper.GetBalance(ctx, delegatorAddr, params.DefaultBondDenom).Amount)
+	topicStake, err := keeper.GetTopicStake(ctx, topic.Id)
+	require.NoError(err)
+	require.True(topicStake.IsZero())
+	retirement, _, err = keeper.GetTopicRetirement(ctx, topic.Id)
+	require.NoError(err)
+	require.Equal(types.TopicRetirementStage_TOPIC_RETIREMENT_STAGE_CLEARING_STATE, retirement.Stage)
+
+	// Topic-scoped state is then deleted
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	err = s.appModule.EndBlock(ctx)
+	require.NoError(err)
+	retirement, _, err = keeper.GetTopicRetirement(ctx, topic.Id)
+	require.NoError(err)
+	require.Equal(types.TopicRetirementStage_TOPIC_RETIREMENT_STAGE_COMPLETE, retirement.Stage)
+	require.Equal(ctx.BlockHeight(), retirement.CompletedAtBlock)
+	require.Positive(retirement.KeysDeleted)
+	isRegistered, err := keeper.IsReputerRegisteredInTopic(ctx, topic.Id, reputer)
+	require.NoError(err)
+	require.False(isRegistered)
+	_, noPrior, err := keeper.GetPreviousTopicWeight(ctx, topic.Id)
+	require.NoError(err)
+	require.True(noPrior)
+	retiringTopicIds, err := keeper.GetRetiringTopicIds(ctx)
+	require.NoError(err)
+	require.Empty(retiringTopicIds)
+}
+
+func (s *MsgServerTestSuite) TestMsgRetireTopicFailingRetirementKeepsNoPartialState() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	keeper := s.emissionsKeeper
+	failingTopic := s.CreateOneTopic()
+	topic := s.CreateOneTopic()
+
+	reputer, delegator := s.addrsStr[1], s.addrsStr[2]
+	for _, topicId := range []uint64{failingTopic.Id, topic.Id} {
+		err := keeper.AddReputerStake(ctx, topicId, reputer, cosmosMath.NewInt(100))
+		require.NoError(err)
+	}
+	// The refund of this delegated stake cannot be queued, after the refund of the reputer was
+	err := keeper.SetDelegateStakePlacement(ctx, failingTopic.Id, delegator, reputer, types.DelegatorInfo{
+		Amount:     alloraMath.MustNewDecFromString("1e100"),
+		RewardDebt: alloraMath.ZeroDec(),
+	})
+	require.NoError(err)
+
+	for _, topicId := range []uint64{failingTopic.Id, topic.Id} {
+		_, err = msgServer.RetireTopic(ctx, &types.RetireTopicRequest{Sender: s.addrsStr[0], TopicId: topicId})
+		require.NoError(err)
+	}
+	err = s.appModule.EndBlock(ctx)
+	require.NoError(err)
+
+	// Nothing of the failing retirement is written
+	retirement, _, err := keeper.GetTopicRetirement(ctx, failingTopic.Id)
+	require.NoError(err)
+	require.Equal(types.TopicRetirementStage_TOPIC_RETIREMENT_STAGE_REFUNDING_STAKE, retirement.Stage)
+	require.Empty(retirement.LastRefundedReputer)
+	_, found, err := keeper.GetStakeRemovalForReputerAndTopicId(ctx, reputer, failingTopic.Id)
+	require.NoError(err)
+	require.False(found)
+
+	// while the other retiring topics carry on
+	retirement, _, err = keeper.GetTopicRetirement(ctx, topic.Id)
+	require.NoError(err)
+	require.Equal(types.TopicRetirementStage_TOPIC_RETIREMENT_STAGE_AWAITING_REFUNDS, retirement.Stage)
+	_, found, err = keeper.GetStakeRemovalForReputerAndTopicId(ctx, reputer, topic.Id)
+	require.NoError(err)
+	require.True(found)
+}
+
+func (s *MsgServerTestSuite) TestMsgRetireTopicByNonCreatorNonAdminFails() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	topic := s.CreateOneTopic()
+
+	sender := s.addrsStr[0]
+	err := s.emissionsKeeper.RemoveWhitelistAdmin(ctx, sender)
+	require.NoError(err)
+
+	_, err = msgServer.RetireTopic(ctx, &types.RetireTopicRequest{Sender: sender, TopicId: topic.Id})
+	require.ErrorIs(err, types.ErrNotPermittedToRetireTopic)
+	topicExists, err := s.emissionsKeeper.TopicExists(ctx, topic.Id)
+	require.NoError(err)
+	require.True(topicExists)
+
+	// A retired topic cannot be retired again
+	_, err = msgServer.RetireTopic(ctx, &types.RetireTopicRequest{Sender: s.addrsStr[1], TopicId: topic.Id})
+	require.NoError(err)
+	_, err = msgServer.RetireTopic(ctx, &types.RetireTopicRequest{Sender: s.addrsStr[1], TopicId: topic.Id})
+	require.ErrorIs(err, types.ErrTopicDoesNotExist)
+}
+
+// Records the fees it is asked to burn, without burning anything
+type recordingFeeBurner struct {
+	fees []cosmosMath.Int
+}
+
+func (b *recordingFeeBurner) BurnFees(_ context.Context, fees cosmosMath.Int) (cosmosMath.Int, error) {
+	b.fees = append(b.fees, fees)
+	return cosmosMath.ZeroInt(), nil
+}
+
+func (s *MsgServerTestSuite) TestCreateTopicAndRegisterBurnCollectedFees() {
+	ctx := s.ctx
+	require := s.Require()
+	burner := &recordingFeeBurner{fees: nil}
+	s.emissionsKeeper.SetFeeBurner(burner)
+
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	topic := s.CreateOneTopic()
+	require.Equal([]cosmosMath.Int{moduleParams.CreateTopicFee}, burner.fees)
+	require.NoError(s.emissionsKeeper.ActivateTopic(ctx, topic.Id))
+
+	s.MintTokensToAddress(s.addrs[1], moduleParams.RegistrationFee)
+	_, err = s.msgServer.Register(ctx, &types.RegisterRequest{
+		Sender:    s.addrsStr[1],
+		TopicId:   topic.Id,
+		IsReputer: false,
+		Owner:     s.addrsStr[1],
+	})
+	require.NoError(err)
+	require.Equal([]cosmosMath.Int{moduleParams.CreateTopicFee, moduleParams.RegistrationFee}, burner.fees)
+}
@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/errors"
 	cosmosMath "cosmossdk.io/math"
 	appParams "github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/types"
 	minttypes "github.com/allora-network/allora-chain/x/mint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -82,6 +83,15 @@ func checkBalanceAndSendFee(
 	return nil
 }
 
+// Burns the share of a fee just sent to the EcoSystem bucket set by the mint fee burn fraction
+func burnCollectedFee(ctx context.Context, ms msgServer, amount Allo) error {
+	_, err := ms.k.BurnFees(ctx, amount)
+	if err != nil {
+		return errors.Wrap(err, "error burning fee")
+	}
+	return nil
+}
+
 // Does 4 things:
 // 1. Checks if sender has enough balance to send the fee
 // 2. Sends coins from sender to mint module Ecosystem bucket
@@ -96,13 +106,59 @@ func sendEffectiveRevenueActivateTopicIfWeightSufficient(
 	topicId TopicId,
 	amount Allo,
 ) error {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	err := checkBalanceAndSendFee(ctx, ms, sender, amount)
 	if err != nil {
 		return err
 	}
+	return addEffectiveRevenueActivateTopicIfWeightSufficient(ctx, ms, topicId, amount)
+}
+
+// Pays the data sending fee of a worker or reputer of a topic, at the topic's current fee.
+// The fee is paid by a sponsorship of the topic if one can cover it for the actor, else by the sender.
+// Either way a share of it may be burned, and all of it counts towards the topic's effective revenue and may activate the topic.
+// The payload counts towards the demand the topic's fee is adjusted to.
+func payDataSendingFee(
+	ctx context.Context,
+	ms msgServer,
+	sender string,
+	actor string,
+	topic types.Topic,
+) error {
+	amount, err := ms.k.GetTopicDataSendingFee(ctx, topic.Id)
+	if err != nil {
+		return err
+	}
+	sponsored, err := ms.k.CoverDataSendingFee(ctx, topic, actor, amount, minttypes.EcosystemModuleName)
+	if err != nil {
+		return err
+	}
+	if !sponsored {
+		err = checkBalanceAndSendFee(ctx, ms, sender, amount)
+		if err != nil {
+			return err
+		}
+	}
+	err = ms.k.AddTopicPayloadReceived(ctx, topic.Id)
+	if err != nil {
+		return err
+	}
+	err = burnCollectedFee(ctx, ms, amount)
+	if err != nil {
+		return err
+	}
+	return addEffectiveRevenueActivateTopicIfWeightSufficient(ctx, ms, topic.Id, amount)
+}
 
-	err = ms.k.AddTopicFeeRevenue(ctx, topicId, amount)
+// Adds the amount to the topic's effective revenue, then activates the topic
+// if the weight is at least the global minimum for active topics
+func addEffectiveRevenueActivateTopicIfWeightSufficient(
+	ctx context.Context,
+	ms msgServer,
+	topicId TopicId,
+	amount Allo,
+) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	err := ms.k.AddTopicFeeRevenue(ctx, topicId, amount)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,112 @@
+package msgserver_test
+
+import (
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+)
+
+func (s *MsgServerTestSuite) TestMsgCreateNewTopicWithValueDimension() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	newTopicMsg := &types.CreateNewTopicRequest{
+		Creator:                  s.addrsStr[0],
+		Metadata:                 "Quantiles of the price",
+		LossMethod:               "mse",
+		EpochLength:              10800,
+		GroundTruthLag:           10800,
+		WorkerSubmissionWindow:   10,
+		AllowNegative:            false,
+		AlphaRegret:              alloraMath.NewDecFromInt64(1),
+		PNorm:                    alloraMath.NewDecFromInt64(3),
+		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		ValueDimension:           types.MaxTopicValueDimension + 1,
+	}
+	s.MintTokensToAddress(s.addrs[0], types.DefaultParams().CreateTopicFee)
+
+	_, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.Error(err)
+
+	newTopicMsg.ValueDimension = 3
+	result, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.NoError(err)
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, result.TopicId)
+	require.NoError(err)
+	require.Equal(uint64(3), topic.ValueDimension)
+	require.Equal(3, topic.InferenceDimension())
+}
+
+// Sets up a worker payload for a topic whose inferences have the given value dimension
+func (s *MsgServerTestSuite) setUpVectorInferenceWorkerPayload(
+	workerPrivateKey secp256k1.PrivKey,
+	valueDimension uint64,
+) types.InsertWorkerPayloadRequest {
+	workerMsg, topicId := s.setUpMsgInsertWorkerPayload(workerPrivateKey)
+	topic, err := s.emissionsKeeper.GetTopic(s.ctx, topicId)
+	s.Require().NoError(err)
+	topic.ValueDimension = valueDimension
+	s.Require().NoError(s.emissionsKeeper.SetTopic(s.ctx, topicId, topic))
+	s.ctx = s.ctx.WithBlockHeight(workerMsg.WorkerDataBundle.Nonce.BlockHeight)
+	return workerMsg
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertWorkerPayloadVectorInference() {
+	require := s.Require()
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg := s.setUpVectorInferenceWorkerPayload(workerPrivateKey, 3)
+	inference := workerMsg.WorkerDataBundle.InferenceForecastsBundle.Inference
+
+	// Scalar inferences are rejected by vector-valued topics
+	signedMsg := s.signMsgInsertWorkerPayload(workerMsg, workerPrivateKey)
+	_, err := s.msgServer.InsertWorkerPayload(s.ctx, &signedMsg)
+	require.ErrorIs(err, types.ErrInvalidInferenceDimension)
+
+	inference.Value = alloraMath.ZeroDec()
+	inference.Values = []alloraMath.Dec{alloraMath.NewDecFromInt64(90), alloraMath.NewDecFromInt64(100)}
+	signedMsg = s.signMsgInsertWorkerPayload(workerMsg, workerPrivateKey)
+	_, err = s.msgServer.InsertWorkerPayload(s.ctx, &signedMsg)
+	require.ErrorIs(err, types.ErrInvalidInferenceDimension)
+
+	inference.Values = append(inference.Values, alloraMath.NewDecFromInt64(110))
+	signedMsg = s.signMsgInsertWorkerPayload(workerMsg, workerPrivateKey)
+	_, err = s.msgServer.InsertWorkerPayload(s.ctx, &signedMsg)
+	require.NoError(err)
+
+	stored, err := s.emissionsKeeper.GetWorkerLatestInferenceByTopicId(s.ctx, inference.TopicId, inference.Inferer)
+	require.NoError(err)
+	require.Len(stored.ValueVector(), 3)
+	require.True(alloraMath.NewDecFromInt64(110).Equal(stored.ValueVector()[2]))
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertWorkerPayloadScalarTopicRejectsValues() {
+	require := s.Require()
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg := s.setUpVectorInferenceWorkerPayload(workerPrivateKey, 0)
+
+	inference := workerMsg.WorkerDataBundle.InferenceForecastsBundle.Inference
+	inference.Values = []alloraMath.Dec{alloraMath.NewDecFromInt64(100)}
+	signedMsg := s.signMsgInsertWorkerPayload(workerMsg, workerPrivateKey)
+	_, err := s.msgServer.InsertWorkerPayload(s.ctx, &signedMsg)
+	require.ErrorIs(err, types.ErrInvalidInferenceDimension)
+}
+
+func (s *MsgServerTestSuite) TestSubmitGroundTruthRejectsVectorTopics() {
+	require := s.Require()
+	topic, creator := s.setUpGroundTruthReputerNonce(nil)
+	topic.ValueDimension = 2
+	require.NoError(s.emissionsKeeper.SetTopic(s.ctx, topic.Id, topic))
+
+	_, err := s.msgServer.SubmitGroundTruth(s.ctx, &types.SubmitGroundTruthRequest{
+		Sender:                  creator,
+		TopicId:                 topic.Id,
+		ReputerNonceBlockHeight: block,
+		GroundTruth:             alloraMath.NewDecFromInt64(11),
+	})
+	require.ErrorIs(err, types.ErrInvalidInferenceDimension)
+}
@@ -55,3 +55,73 @@ func (ms msgServer) RemoveFromWhitelistAdmin(ctx context.Context, msg *types.Rem
 	err = ms.k.RemoveWhitelistAdmin(ctx, msg.Address)
 	return &types.RemoveFromWhitelistAdminResponse{}, err
 }
+
+// Only the topic creator or a whitelist admin may manage the allowlists of a topic
+func (ms msgServer) checkCanManageTopicAllowlist(ctx context.Context, sender string, topicId uint64) error {
+	topicExists, err := ms.k.TopicExists(ctx, topicId)
+	if err != nil {
+		return err
+	} else if !topicExists {
+		return types.ErrTopicDoesNotExist
+	}
+	topic, err := ms.k.GetTopic(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	if topic.Creator == sender {
+		return nil
+	}
+	isAdmin, err := ms.k.IsWhitelistAdmin(ctx, sender)
+	if err != nil {
+		return err
+	} else if !isAdmin {
+		return types.ErrNotPermittedToManageTopicAllowlist
+	}
+	return nil
+}
+
+func (ms msgServer) SetTopicAllowlistEnabled(ctx context.Context, msg *types.SetTopicAllowlistEnabledRequest) (_ *types.SetTopicAllowlistEnabledResponse, err error) {
+	defer metrics.RecordMetrics("SetTopicAllowlistEnabled", time.Now(), &err)
+
+	err = msg.Validate()
+	if err != nil {
+		return nil, err
+	}
+	err = ms.checkCanManageTopicAllowlist(ctx, msg.Sender, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	// Actors already registered stay registered, but may only submit payloads while in the allowlist
+	err = ms.k.SetTopicAllowlistEnabled(ctx, msg.TopicId, msg.IsReputer, msg.Enabled)
+	return &types.SetTopicAllowlistEnabledResponse{}, err
+}
+
+func (ms msgServer) AddToTopicAllowlist(ctx context.Context, msg *types.AddToTopicAllowlistRequest) (_ *types.AddToTopicAllowlistResponse, err error) {
+	defer metrics.RecordMetrics("AddToTopicAllowlist", time.Now(), &err)
+
+	err = msg.Validate()
+	if err != nil {
+		return nil, err
+	}
+	err = ms.checkCanManageTopicAllowlist(ctx, msg.Sender, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	err = ms.k.AddToTopicAllowlist(ctx, msg.TopicId, msg.IsReputer, msg.Address)
+	return &types.AddToTopicAllowlistResponse{}, err
+}
+
+func (ms msgServer) RemoveFromTopicAllowlist(ctx context.Context, msg *types.RemoveFromTopicAllowlistRequest) (_ *types.RemoveFromTopicAllowlistResponse, err error) {
+	defer metrics.RecordMetrics("RemoveFromTopicAllowlist", time.Now(), &err)
+
+	err = msg.Validate()
+	if err != nil {
+		return nil, err
+	}
+	err = ms.checkCanManageTopicAllowlist(ctx, msg.Sender, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	err = ms.k.RemoveFromTopicAllowlist(ctx, msg.TopicId, msg.IsReputer, msg.Address)
+	return &types.RemoveFromTopicAllowlistResponse{}, err
+}
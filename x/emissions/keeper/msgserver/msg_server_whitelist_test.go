@@ -1,7 +1,9 @@
 package msgserver_test
 
 import (
+	cosmosMath "cosmossdk.io/math"
 	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
 )
 
 func (s *MsgServerTestSuite) TestAddWhitelistAdmin() {
@@ -86,3 +88,114 @@ func (s *MsgServerTestSuite) TestRemoveWhitelistAdminInvalidUnauthorized() {
 	_, err := s.msgServer.RemoveFromWhitelistAdmin(ctx, msg)
 	require.ErrorIs(err, types.ErrNotWhitelistAdmin, "Should fail due to unauthorized access")
 }
+
+func (s *MsgServerTestSuite) TestTopicAllowlistRestrictsRegistration() {
+	ctx := s.ctx
+	require := s.Require()
+	msgServer := s.msgServer
+
+	topic := s.CreateOneTopic()
+	creator := topic.Creator
+	worker := nonAdminAccounts[0]
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	s.MintTokensToAddress(worker, moduleParams.RegistrationFee.Mul(cosmosMath.NewInt(2)))
+
+	_, err = msgServer.SetTopicAllowlistEnabled(ctx, &types.SetTopicAllowlistEnabledRequest{
+		Sender:    creator,
+		TopicId:   topic.Id,
+		IsReputer: false,
+		Enabled:   true,
+	})
+	require.NoError(err, "Topic creator should be able to enable the worker allowlist")
+
+	registerMsg := &types.RegisterRequest{
+		Sender:    worker.String(),
+		TopicId:   topic.Id,
+		IsReputer: false,
+		Owner:     worker.String(),
+	}
+	_, err = msgServer.Register(ctx, registerMsg)
+	require.ErrorIs(err, types.ErrAddressNotInTopicAllowlist)
+
+	// the reputer allowlist is toggled separately
+	isEnabled, err := s.emissionsKeeper.IsTopicAllowlistEnabled(ctx, topic.Id, true)
+	require.NoError(err)
+	require.False(isEnabled)
+
+	_, err = msgServer.AddToTopicAllowlist(ctx, &types.AddToTopicAllowlistRequest{
+		Sender:    creator,
+		TopicId:   topic.Id,
+		IsReputer: false,
+		Address:   worker.String(),
+	})
+	require.NoError(err)
+	allowlist, err := s.emissionsKeeper.GetTopicAllowlist(ctx, topic.Id, false)
+	require.NoError(err)
+	require.Equal([]string{worker.String()}, allowlist)
+
+	_, err = msgServer.Register(ctx, registerMsg)
+	require.NoError(err, "Allowlisted worker should be able to register")
+
+	_, err = msgServer.RemoveFromTopicAllowlist(ctx, &types.RemoveFromTopicAllowlistRequest{
+		Sender:    s.addrsStr[0],
+		TopicId:   topic.Id,
+		IsReputer: false,
+		Address:   worker.String(),
+	})
+	require.NoError(err, "Whitelist admin should be able to manage the allowlist")
+	isAllowed, err := s.emissionsKeeper.IsInTopicAllowlist(ctx, topic.Id, false, worker.String())
+	require.NoError(err)
+	require.False(isAllowed)
+}
+
+func (s *MsgServerTestSuite) TestTopicAllowlistManagedByCreatorOrAdminOnly() {
+	ctx := s.ctx
+	require := s.Require()
+	topic := s.CreateOneTopic()
+
+	_, err := s.msgServer.SetTopicAllowlistEnabled(ctx, &types.SetTopicAllowlistEnabledRequest{
+		Sender:    nonAdminAccounts[0].String(),
+		TopicId:   topic.Id,
+		IsReputer: true,
+		Enabled:   true,
+	})
+	require.ErrorIs(err, types.ErrNotPermittedToManageTopicAllowlist)
+
+	_, err = s.msgServer.AddToTopicAllowlist(ctx, &types.AddToTopicAllowlistRequest{
+		Sender:    nonAdminAccounts[0].String(),
+		TopicId:   topic.Id,
+		IsReputer: true,
+		Address:   nonAdminAccounts[0].String(),
+	})
+	require.ErrorIs(err, types.ErrNotPermittedToManageTopicAllowlist)
+
+	_, err = s.msgServer.AddToTopicAllowlist(ctx, &types.AddToTopicAllowlistRequest{
+		Sender:    s.addrsStr[0],
+		TopicId:   topic.Id + 1000,
+		IsReputer: true,
+		Address:   nonAdminAccounts[0].String(),
+	})
+	require.ErrorIs(err, types.ErrTopicDoesNotExist)
+}
+
+func (s *MsgServerTestSuite) TestTopicAllowlistRestrictsWorkerPayload() {
+	ctx := s.ctx
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertWorkerPayload(workerPrivateKey)
+	workerMsg = s.signMsgInsertWorkerPayload(workerMsg, workerPrivateKey)
+	ctx = ctx.WithBlockHeight(workerMsg.WorkerDataBundle.InferenceForecastsBundle.Forecast.BlockHeight)
+
+	// the worker registered before the allowlist was enforced
+	err := s.emissionsKeeper.SetTopicAllowlistEnabled(ctx, topicId, false, true)
+	require.NoError(err)
+	_, err = s.msgServer.InsertWorkerPayload(ctx, &workerMsg)
+	require.ErrorIs(err, types.ErrAddressNotInTopicAllowlist)
+
+	err = s.emissionsKeeper.AddToTopicAllowlist(ctx, topicId, false, workerMsg.WorkerDataBundle.Worker)
+	require.NoError(err)
+	_, err = s.msgServer.InsertWorkerPayload(ctx, &workerMsg)
+	require.NoError(err, "Allowlisted worker should be able to insert its payload")
+}
@@ -0,0 +1,141 @@
+package msgserver_test
+
+import (
+	actorutils "github.com/allora-network/allora-chain/x/emissions/keeper/actor_utils"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+)
+
+func (s *MsgServerTestSuite) enableCommitReveal(topicId uint64, revealWindow int64) types.Topic {
+	topic, err := s.emissionsKeeper.GetTopic(s.ctx, topicId)
+	s.Require().NoError(err)
+	topic.WorkerRevealWindow = revealWindow
+	err = s.emissionsKeeper.SetTopic(s.ctx, topicId, topic)
+	s.Require().NoError(err)
+	return topic
+}
+
+func (s *MsgServerTestSuite) TestCommitRevealWorkerPayload() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertWorkerPayload(workerPrivateKey)
+	workerMsg = s.signMsgInsertWorkerPayload(workerMsg, workerPrivateKey)
+	topic := s.enableCommitReveal(topicId, 5)
+	bundle := workerMsg.WorkerDataBundle
+	nonceBlockHeight := bundle.Nonce.BlockHeight
+	worker := bundle.Worker
+
+	ctx = ctx.WithBlockHeight(nonceBlockHeight)
+	_, err := msgServer.InsertWorkerPayload(ctx, &workerMsg)
+	require.ErrorIs(err, types.ErrTopicRequiresWorkerCommitment)
+
+	salt := []byte("0123456789abcdef")
+	commitment, err := types.ComputeWorkerPayloadCommitment(bundle.InferenceForecastsBundle, salt)
+	require.NoError(err)
+	_, err = msgServer.CommitWorkerPayload(ctx, &types.CommitWorkerPayloadRequest{
+		Sender:           worker,
+		TopicId:          topicId,
+		NonceBlockHeight: nonceBlockHeight,
+		Commitment:       commitment,
+	})
+	require.NoError(err)
+
+	// Nothing is inserted until the payload is revealed
+	_, err = s.emissionsKeeper.GetWorkerLatestInferenceByTopicId(ctx, topicId, worker)
+	require.Error(err)
+
+	revealMsg := &types.RevealWorkerPayloadRequest{
+		Sender:           worker,
+		WorkerDataBundle: bundle,
+		Salt:             salt,
+	}
+	_, err = msgServer.RevealWorkerPayload(ctx, revealMsg)
+	require.ErrorIs(err, types.ErrWorkerRevealWindowNotAvailable)
+
+	revealCtx := ctx.WithBlockHeight(nonceBlockHeight + topic.WorkerSubmissionWindow)
+	_, err = msgServer.RevealWorkerPayload(revealCtx, &types.RevealWorkerPayloadRequest{
+		Sender:           worker,
+		WorkerDataBundle: bundle,
+		Salt:             []byte("another salt"),
+	})
+	require.ErrorIs(err, types.ErrWorkerCommitmentMismatch)
+
+	_, err = msgServer.RevealWorkerPayload(revealCtx, revealMsg)
+	require.NoError(err)
+
+	inference, err := s.emissionsKeeper.GetWorkerLatestInferenceByTopicId(revealCtx, topicId, worker)
+	require.NoError(err)
+	require.Equal(bundle.InferenceForecastsBundle.Inference.Value, inference.Value)
+	revealed, err := s.emissionsKeeper.GetWorkersWithRevealedCommitment(revealCtx, topicId, nonceBlockHeight)
+	require.NoError(err)
+	require.True(revealed[worker])
+
+	_, err = msgServer.RevealWorkerPayload(revealCtx, revealMsg)
+	require.ErrorIs(err, types.ErrWorkerPayloadAlreadyRevealed)
+
+	lateCtx := ctx.WithBlockHeight(nonceBlockHeight + topic.WorkerSubmissionWindow + topic.WorkerRevealWindow)
+	_, err = msgServer.RevealWorkerPayload(lateCtx, revealMsg)
+	require.ErrorIs(err, types.ErrWorkerRevealWindowNotAvailable)
+
+	// An inference that bypassed commit-reveal is dropped when the nonce closes
+	_, otherWorker := getNewAddress()
+	err = s.emissionsKeeper.InsertWorker(ctx, topicId, otherWorker, types.OffchainNode{Owner: otherWorker, NodeAddress: otherWorker})
+	require.NoError(err)
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	err = s.emissionsKeeper.AppendInference(revealCtx, topic, nonceBlockHeight, &types.Inference{
+		TopicId:     topicId,
+		BlockHeight: nonceBlockHeight,
+		Inferer:     otherWorker,
+		Value:       bundle.InferenceForecastsBundle.Inference.Value,
+	}, moduleParams.MaxTopInferersToReward)
+	require.NoError(err)
+
+	err = actorutils.CloseWorkerNonce(&s.emissionsKeeper, lateCtx, topic, *bundle.Nonce)
+	require.NoError(err)
+	activeInferences, err := s.emissionsKeeper.GetInferencesAtBlock(lateCtx, topicId, nonceBlockHeight)
+	require.NoError(err)
+	require.Len(activeInferences.Inferences, 1)
+	require.Equal(worker, activeInferences.Inferences[0].Inferer)
+}
+
+func (s *MsgServerTestSuite) TestCommitWorkerPayloadChecks() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertWorkerPayload(workerPrivateKey)
+	bundle := workerMsg.WorkerDataBundle
+	ctx = ctx.WithBlockHeight(bundle.Nonce.BlockHeight)
+
+	commitMsg := &types.CommitWorkerPayloadRequest{
+		Sender:           bundle.Worker,
+		TopicId:          topicId,
+		NonceBlockHeight: bundle.Nonce.BlockHeight,
+		Commitment:       make([]byte, 32),
+	}
+	_, err := msgServer.CommitWorkerPayload(ctx, commitMsg)
+	require.ErrorIs(err, types.ErrTopicCommitRevealDisabled)
+
+	topic := s.enableCommitReveal(topicId, 5)
+
+	// Only registered workers may commit, on their own behalf
+	_, unregistered := getNewAddress()
+	_, err = msgServer.CommitWorkerPayload(ctx, &types.CommitWorkerPayloadRequest{
+		Sender:           unregistered,
+		TopicId:          topicId,
+		NonceBlockHeight: bundle.Nonce.BlockHeight,
+		Commitment:       make([]byte, 32),
+	})
+	require.ErrorIs(err, types.ErrAddressNotRegistered)
+
+	// Commitments are only accepted during the submission window
+	_, err = msgServer.CommitWorkerPayload(ctx.WithBlockHeight(bundle.Nonce.BlockHeight+topic.WorkerSubmissionWindow), commitMsg)
+	require.ErrorIs(err, types.ErrWorkerNonceWindowNotAvailable)
+
+	commitMsg.Commitment = []byte("too short")
+	_, err = msgServer.CommitWorkerPayload(ctx, commitMsg)
+	require.Error(err)
+}
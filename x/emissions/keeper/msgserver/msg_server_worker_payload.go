@@ -1,6 +1,7 @@
 package msgserver
 
 import (
+	"bytes"
 	"context"
 	"time"
 
@@ -40,71 +41,257 @@ func (ms msgServer) InsertWorkerPayload(ctx context.Context, msg *types.InsertWo
 		return nil, err
 	}
 
-	nonce := msg.WorkerDataBundle.Nonce
-	topicId := msg.WorkerDataBundle.TopicId
+	err = insertWorkerPayload(sdkCtx, ms, moduleParams, msg.Sender, msg.WorkerDataBundle)
+	if err != nil {
+		return nil, err
+	}
+	return &types.InsertWorkerPayloadResponse{}, nil
+}
+
+// Inserts the plaintext payload of a worker, paid for by the sender, after checking that
+// the worker may submit it for its nonce. The bundle must have been validated.
+func insertWorkerPayload(
+	ctx sdk.Context,
+	ms msgServer,
+	moduleParams types.Params,
+	sender string,
+	bundle *types.WorkerDataBundle,
+) error {
+	blockHeight := ctx.BlockHeight()
+	nonce := bundle.Nonce
+	topicId := bundle.TopicId
 
 	topic, err := ms.k.GetTopic(ctx, topicId)
 	if err != nil {
-		return nil, types.ErrInvalidTopicId
+		return types.ErrInvalidTopicId
+	}
+	if topic.WorkerRevealWindow > 0 {
+		return errorsmod.Wrapf(types.ErrTopicRequiresWorkerCommitment,
+			"use CommitWorkerPayload and RevealWorkerPayload for topic: %d", topicId)
 	}
 	nonceUnfulfilled, err := ms.k.IsWorkerNonceUnfulfilled(ctx, topicId, nonce)
+	if err != nil {
+		return err
+	} else if !nonceUnfulfilled {
+		return types.ErrUnfulfilledNonceNotFound
+	}
+
+	if !ms.k.BlockWithinWorkerSubmissionWindowOfNonce(topic, *nonce, blockHeight) {
+		return errorsmod.Wrapf(
+			types.ErrWorkerNonceWindowNotAvailable,
+			"Worker window not open for topic: %d, current block %d, start window: %d, end window: %d",
+			topicId, blockHeight, nonce.BlockHeight, nonce.BlockHeight+topic.WorkerSubmissionWindow,
+		)
+	}
+
+	err = checkWorkerMaySubmit(ctx, ms, topicId, bundle.Worker)
+	if err != nil {
+		return err
+	}
+
+	err = payDataSendingFee(ctx, ms, sender, bundle.Worker, topic)
+	if err != nil {
+		return err
+	}
+
+	return insertWorkerDataBundle(ctx, ms, moduleParams, topic, bundle)
+}
+
+// Commits a worker to the payload it will reveal for a worker nonce of a topic in commit-reveal mode.
+// The data sending fee is paid upon commitment, revealing is free.
+func (ms msgServer) CommitWorkerPayload(ctx context.Context, msg *types.CommitWorkerPayloadRequest) (_ *types.CommitWorkerPayloadResponse, err error) {
+	defer metrics.RecordMetrics("CommitWorkerPayload", time.Now(), &err)
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
+	if err != nil {
+		return nil, types.ErrInvalidTopicId
+	}
+	if topic.WorkerRevealWindow == 0 {
+		return nil, errorsmod.Wrapf(types.ErrTopicCommitRevealDisabled, "use InsertWorkerPayload for topic: %d", msg.TopicId)
+	}
+	nonce := types.Nonce{BlockHeight: msg.NonceBlockHeight}
+	nonceUnfulfilled, err := ms.k.IsWorkerNonceUnfulfilled(ctx, msg.TopicId, &nonce)
 	if err != nil {
 		return nil, err
 	} else if !nonceUnfulfilled {
 		return nil, types.ErrUnfulfilledNonceNotFound
 	}
 
-	if !ms.k.BlockWithinWorkerSubmissionWindowOfNonce(topic, *nonce, blockHeight) {
+	if !ms.k.BlockWithinWorkerSubmissionWindowOfNonce(topic, nonce, blockHeight) {
 		return nil, errorsmod.Wrapf(
 			types.ErrWorkerNonceWindowNotAvailable,
 			"Worker window not open for topic: %d, current block %d, start window: %d, end window: %d",
-			topicId, blockHeight, nonce.BlockHeight, nonce.BlockHeight+topic.WorkerSubmissionWindow,
+			msg.TopicId, blockHeight, nonce.BlockHeight, nonce.BlockHeight+topic.WorkerSubmissionWindow,
 		)
 	}
 
-	isWorkerRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, topicId, msg.WorkerDataBundle.Worker)
+	// Commitments are not signed, so only the worker itself may commit lest others overwrite its commitment
+	err = checkWorkerMaySubmit(ctx, ms, msg.TopicId, msg.Sender)
 	if err != nil {
 		return nil, err
-	} else if !isWorkerRegistered {
-		return nil, errorsmod.Wrapf(types.ErrAddressNotRegistered, "worker is not registered in this topic")
 	}
 
-	err = sendEffectiveRevenueActivateTopicIfWeightSufficient(ctx, ms, msg.Sender, topicId, moduleParams.DataSendingFee)
+	err = payDataSendingFee(ctx, ms, msg.Sender, msg.Sender, topic)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ms.k.SetWorkerCommitment(ctx, msg.TopicId, nonce.BlockHeight, msg.Sender, types.WorkerCommitment{
+		Commitment: msg.Commitment,
+		Revealed:   false,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.CommitWorkerPayloadResponse{}, nil
+}
+
+// Reveals a worker payload during the reveal window of its nonce. The payload must match the commitment
+// the worker made during the submission window; it is then processed as if inserted by InsertWorkerPayload.
+func (ms msgServer) RevealWorkerPayload(ctx context.Context, msg *types.RevealWorkerPayloadRequest) (_ *types.RevealWorkerPayloadResponse, err error) {
+	defer metrics.RecordMetrics("RevealWorkerPayload", time.Now(), &err)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockHeight := sdkCtx.BlockHeight()
+	if err := msg.Validate(); err != nil {
+		return nil, errorsmod.Wrapf(err, "Worker invalid data for block: %d", blockHeight)
+	}
+
+	moduleParams, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "Error getting params for sender: %v", &msg.Sender)
+	}
+	err = checkInputLength(moduleParams.MaxSerializedMsgLength, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := msg.WorkerDataBundle
+	nonce := bundle.Nonce
+	topicId := bundle.TopicId
+
+	topic, err := ms.k.GetTopic(ctx, topicId)
+	if err != nil {
+		return nil, types.ErrInvalidTopicId
+	}
+	if topic.WorkerRevealWindow == 0 {
+		return nil, errorsmod.Wrapf(types.ErrTopicCommitRevealDisabled, "use InsertWorkerPayload for topic: %d", topicId)
+	}
+	nonceUnfulfilled, err := ms.k.IsWorkerNonceUnfulfilled(ctx, topicId, nonce)
 	if err != nil {
 		return nil, err
+	} else if !nonceUnfulfilled {
+		return nil, types.ErrUnfulfilledNonceNotFound
 	}
 
+	if !ms.k.BlockWithinWorkerRevealWindowOfNonce(topic, *nonce, blockHeight) {
+		revealWindowStart := nonce.BlockHeight + topic.WorkerSubmissionWindow
+		return nil, errorsmod.Wrapf(
+			types.ErrWorkerRevealWindowNotAvailable,
+			"Worker reveal window not open for topic: %d, current block %d, start window: %d, end window: %d",
+			topicId, blockHeight, revealWindowStart, revealWindowStart+topic.WorkerRevealWindow,
+		)
+	}
+
+	commitment, found, err := ms.k.GetWorkerCommitment(ctx, topicId, nonce.BlockHeight, bundle.Worker)
+	if err != nil {
+		return nil, err
+	} else if !found {
+		return nil, errorsmod.Wrapf(types.ErrWorkerCommitmentNotFound, "worker did not commit to a payload for nonce: %d", nonce.BlockHeight)
+	} else if commitment.Revealed {
+		return nil, types.ErrWorkerPayloadAlreadyRevealed
+	}
+	revealedCommitment, err := types.ComputeWorkerPayloadCommitment(bundle.InferenceForecastsBundle, msg.Salt)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "Error computing worker payload commitment")
+	}
+	if !bytes.Equal(revealedCommitment, commitment.Commitment) {
+		return nil, types.ErrWorkerCommitmentMismatch
+	}
+
+	err = checkWorkerMaySubmit(ctx, ms, topicId, bundle.Worker)
+	if err != nil {
+		return nil, err
+	}
+
+	err = insertWorkerDataBundle(sdkCtx, ms, moduleParams, topic, bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	commitment.Revealed = true
+	err = ms.k.SetWorkerCommitment(ctx, topicId, nonce.BlockHeight, bundle.Worker, commitment)
+	if err != nil {
+		return nil, err
+	}
+	return &types.RevealWorkerPayloadResponse{}, nil
+}
+
+// Checks that the worker is registered in the topic and passes its worker allowlist
+func checkWorkerMaySubmit(ctx context.Context, ms msgServer, topicId uint64, worker string) error {
+	isWorkerRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, topicId, worker)
+	if err != nil {
+		return err
+	} else if !isWorkerRegistered {
+		return errorsmod.Wrapf(types.ErrAddressNotRegistered, "worker is not registered in this topic")
+	}
+	isAllowed, err := ms.k.IsAllowedInTopic(ctx, topicId, false, worker)
+	if err != nil {
+		return err
+	} else if !isAllowed {
+		return errorsmod.Wrapf(types.ErrAddressNotInTopicAllowlist, "worker is not in the allowlist of this topic")
+	}
+	return nil
+}
+
+// Appends the inference and forecast of a worker data bundle to those of its nonce
+func insertWorkerDataBundle(
+	sdkCtx sdk.Context,
+	ms msgServer,
+	moduleParams types.Params,
+	topic types.Topic,
+	bundle *types.WorkerDataBundle,
+) (err error) {
+	nonce := bundle.Nonce
+	topicId := topic.Id
+
 	// Process Inferences
-	if msg.WorkerDataBundle.InferenceForecastsBundle.Inference != nil {
-		inference := msg.WorkerDataBundle.InferenceForecastsBundle.Inference
+	if bundle.InferenceForecastsBundle.Inference != nil {
+		inference := bundle.InferenceForecastsBundle.Inference
 		if inference == nil {
-			return nil, errorsmod.Wrapf(types.ErrNoValidInferences, "Inference not found")
+			return errorsmod.Wrapf(types.ErrNoValidInferences, "Inference not found")
 		}
-		if inference.TopicId != msg.WorkerDataBundle.TopicId {
-			return nil, errorsmod.Wrapf(types.ErrInvalidTopicId,
+		if inference.TopicId != bundle.TopicId {
+			return errorsmod.Wrapf(types.ErrInvalidTopicId,
 				"inferer not using the same topic as bundle")
 		}
+		if err := types.ValidateInferenceDimension(topic, inference); err != nil {
+			return err
+		}
 
 		err = ms.k.AppendInference(sdkCtx, topic, nonce.BlockHeight, inference, moduleParams.MaxTopInferersToReward)
 		if err != nil {
-			return nil, errorsmod.Wrapf(err, "Error appending inference")
+			return errorsmod.Wrapf(err, "Error appending inference")
 		}
 	}
 
 	// Process Forecasts
-	if msg.WorkerDataBundle.InferenceForecastsBundle.Forecast != nil {
-		forecast := msg.WorkerDataBundle.InferenceForecastsBundle.Forecast
+	if bundle.InferenceForecastsBundle.Forecast != nil {
+		forecast := bundle.InferenceForecastsBundle.Forecast
 		if len(forecast.ForecastElements) == 0 {
-			return nil, errorsmod.Wrapf(types.ErrNoValidForecastElements, "No valid forecast elements found in Forecast")
+			return errorsmod.Wrapf(types.ErrNoValidForecastElements, "No valid forecast elements found in Forecast")
 		}
-		if forecast.TopicId != msg.WorkerDataBundle.TopicId {
-			return nil, errorsmod.Wrapf(types.ErrInvalidTopicId, "forecaster not using the same topic as bundle")
+		if forecast.TopicId != bundle.TopicId {
+			return errorsmod.Wrapf(types.ErrInvalidTopicId, "forecaster not using the same topic as bundle")
 		}
 
 		// Limit forecast elements to top inferers
 		latestScoresForForecastedInferers := make([]types.Score, 0)
 		for _, el := range forecast.ForecastElements {
-			score, err := ms.k.GetInfererScoreEma(ctx, forecast.TopicId, el.Inferer)
+			score, err := ms.k.GetInfererScoreEma(sdkCtx, forecast.TopicId, el.Inferer)
 			if err != nil {
 				continue
 			}
@@ -123,12 +310,12 @@ func (ms msgServer) InsertWorkerPayload(ctx context.Context, msg *types.InsertWo
 		seenInferers := make(map[string]bool)
 		for _, el := range forecast.ForecastElements {
 			// Check if the forecasted inferer is registered in the topic
-			isInfererRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, topicId, el.Inferer)
+			isInfererRegistered, err := ms.k.IsWorkerRegisteredInTopic(sdkCtx, topicId, el.Inferer)
 			if err != nil {
-				return nil, err
+				return err
 			}
 			if !isInfererRegistered {
-				return nil, errorsmod.Wrapf(err,
+				return errorsmod.Wrapf(err,
 					"Error forecasted inferer address is not registered in this topic")
 			}
 
@@ -144,10 +331,10 @@ func (ms msgServer) InsertWorkerPayload(ctx context.Context, msg *types.InsertWo
 			forecast.ForecastElements = acceptedForecastElements
 			err = ms.k.AppendForecast(sdkCtx, topic, nonce.BlockHeight, forecast, moduleParams.MaxTopForecastersToReward)
 			if err != nil {
-				return nil, errorsmod.Wrapf(err,
+				return errorsmod.Wrapf(err,
 					"Error appending forecast")
 			}
 		}
 	}
-	return &types.InsertWorkerPayloadResponse{}, nil
+	return nil
 }
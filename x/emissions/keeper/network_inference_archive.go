@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Archives the final network inferences of a topic nonce, then prunes the archived
+// network inferences of the topic that have outlived the archive retention
+func (k *Keeper) ArchiveNetworkInference(ctx context.Context, archived types.ArchivedNetworkInference) error {
+	if err := k.SetArchivedNetworkInference(ctx, archived); err != nil {
+		return err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error getting params")
+	}
+	if params.NetworkInferenceArchiveRetention == 0 {
+		return nil
+	}
+	return k.PruneNetworkInferenceArchive(ctx, archived.TopicId, archived.BlockHeight-params.NetworkInferenceArchiveRetention)
+}
+
+func (k *Keeper) SetArchivedNetworkInference(ctx context.Context, archived types.ArchivedNetworkInference) error {
+	if err := archived.Validate(); err != nil {
+		return errors.Wrap(err, "archived network inference is invalid")
+	}
+	return k.networkInferenceArchive.Set(ctx, collections.Join(archived.TopicId, archived.BlockHeight), archived)
+}
+
+// Deletes the archived network inferences of a topic at block heights strictly below the given one
+func (k *Keeper) PruneNetworkInferenceArchive(ctx context.Context, topicId TopicId, beforeBlockHeight BlockHeight) error {
+	rng := collections.NewPrefixedPairRange[TopicId, BlockHeight](topicId).EndExclusive(beforeBlockHeight)
+	iter, err := k.networkInferenceArchive.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := k.networkInferenceArchive.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the archived network inferences of a topic at a block height, if any
+func (k *Keeper) GetArchivedNetworkInference(ctx context.Context, topicId TopicId, blockHeight BlockHeight) (types.ArchivedNetworkInference, bool, error) {
+	archived, err := k.networkInferenceArchive.Get(ctx, collections.Join(topicId, blockHeight))
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return types.ArchivedNetworkInference{}, false, nil
+	} else if err != nil {
+		return types.ArchivedNetworkInference{}, false, err
+	}
+	return archived, true, nil
+}
+
+// Returns the archived network inferences of a topic with block heights in [fromBlockHeight, toBlockHeight],
+// in ascending block height order. A toBlockHeight of 0 leaves the range unbounded above.
+// The pagination key is the block height to resume from.
+func (k *Keeper) GetNetworkInferenceHistory(
+	ctx context.Context,
+	topicId TopicId,
+	fromBlockHeight BlockHeight,
+	toBlockHeight BlockHeight,
+	pagination *types.SimpleCursorPaginationRequest,
+) ([]*types.ArchivedNetworkInference, *types.SimpleCursorPaginationResponse, error) {
+	if fromBlockHeight < 0 || toBlockHeight < 0 {
+		return nil, nil, errors.Wrap(types.ErrInvalidValue, "block heights cannot be negative")
+	}
+	if toBlockHeight != 0 && toBlockHeight < fromBlockHeight {
+		return nil, nil, errors.Wrap(types.ErrInvalidValue, "to block height cannot be below from block height")
+	}
+	limit, cursor, err := k.CalcAppropriatePaginationForUint64Cursor(ctx, pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	start := fromBlockHeight
+	if BlockHeight(cursor) > start {
+		start = BlockHeight(cursor)
+	}
+	rng := collections.NewPrefixedPairRange[TopicId, BlockHeight](topicId).StartInclusive(start)
+	if toBlockHeight != 0 {
+		rng = rng.EndInclusive(toBlockHeight)
+	}
+	iter, err := k.networkInferenceArchive.Iterate(ctx, rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	history := make([]*types.ArchivedNetworkInference, 0)
+	nextKey := make([]byte, 0)
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, nil, err
+		}
+		if uint64(len(history)) == limit {
+			nextKey = binary.BigEndian.AppendUint64(nextKey, uint64(kv.Key.K2()))
+			break
+		}
+		archived := kv.Value
+		history = append(history, &archived)
+	}
+	return history, &types.SimpleCursorPaginationResponse{NextKey: nextKey}, nil
+}
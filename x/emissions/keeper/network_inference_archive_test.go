@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"encoding/binary"
+
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func (s *KeeperTestSuite) archivedNetworkInference(topicId uint64, blockHeight int64) types.ArchivedNetworkInference {
+	return types.ArchivedNetworkInference{
+		TopicId:                          topicId,
+		BlockHeight:                      blockHeight,
+		CombinedValue:                    alloraMath.NewDecFromInt64(blockHeight),
+		NaiveValue:                       alloraMath.NewDecFromInt64(blockHeight + 1),
+		ConfidenceIntervalRawPercentiles: []alloraMath.Dec{alloraMath.MustNewDecFromString("50")},
+		ConfidenceIntervalValues:         []alloraMath.Dec{alloraMath.NewDecFromInt64(blockHeight)},
+	}
+}
+
+func (s *KeeperTestSuite) setNetworkInferenceArchiveRetention(retention int64) {
+	params, err := s.emissionsKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	params.NetworkInferenceArchiveRetention = retention
+	s.Require().NoError(s.emissionsKeeper.SetParams(s.ctx, params))
+}
+
+func (s *KeeperTestSuite) TestGetNetworkInferenceHistory() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	require := s.Require()
+	topicId := uint64(1)
+	s.setNetworkInferenceArchiveRetention(0)
+
+	for _, blockHeight := range []int64{10, 20, 30, 40, 50} {
+		require.NoError(keeper.ArchiveNetworkInference(ctx, s.archivedNetworkInference(topicId, blockHeight)))
+	}
+	// Archives of other topics are not returned
+	require.NoError(keeper.ArchiveNetworkInference(ctx, s.archivedNetworkInference(topicId+1, 30)))
+
+	history, pagination, err := keeper.GetNetworkInferenceHistory(ctx, topicId, 20, 40, nil)
+	require.NoError(err)
+	require.Len(history, 3)
+	require.Empty(pagination.NextKey)
+	for i, blockHeight := range []int64{20, 30, 40} {
+		require.Equal(topicId, history[i].TopicId)
+		require.Equal(blockHeight, history[i].BlockHeight)
+		require.True(alloraMath.NewDecFromInt64(blockHeight).Equal(history[i].CombinedValue))
+	}
+
+	// A to block height of 0 is unbounded
+	history, _, err = keeper.GetNetworkInferenceHistory(ctx, topicId, 35, 0, nil)
+	require.NoError(err)
+	require.Len(history, 2)
+	require.Equal(int64(40), history[0].BlockHeight)
+	require.Equal(int64(50), history[1].BlockHeight)
+
+	_, _, err = keeper.GetNetworkInferenceHistory(ctx, topicId, 40, 20, nil)
+	require.ErrorIs(err, types.ErrInvalidValue)
+}
+
+func (s *KeeperTestSuite) TestGetNetworkInferenceHistoryPagination() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	require := s.Require()
+	topicId := uint64(1)
+	s.setNetworkInferenceArchiveRetention(0)
+
+	for _, blockHeight := range []int64{10, 20, 30, 40, 50} {
+		require.NoError(keeper.ArchiveNetworkInference(ctx, s.archivedNetworkInference(topicId, blockHeight)))
+	}
+
+	history, pagination, err := keeper.GetNetworkInferenceHistory(ctx, topicId, 0, 0, &types.SimpleCursorPaginationRequest{Limit: 2})
+	require.NoError(err)
+	require.Len(history, 2)
+	require.Equal(int64(10), history[0].BlockHeight)
+	require.Equal(int64(20), history[1].BlockHeight)
+	require.Equal(uint64(30), binary.BigEndian.Uint64(pagination.NextKey))
+
+	history, pagination, err = keeper.GetNetworkInferenceHistory(ctx, topicId, 0, 0,
+		&types.SimpleCursorPaginationRequest{Key: pagination.NextKey, Limit: 2})
+	require.NoError(err)
+	require.Len(history, 2)
+	require.Equal(int64(30), history[0].BlockHeight)
+	require.Equal(int64(40), history[1].BlockHeight)
+	require.NotEmpty(pagination.NextKey)
+
+	history, pagination, err = keeper.GetNetworkInferenceHistory(ctx, topicId, 0, 0,
+		&types.SimpleCursorPaginationRequest{Key: pagination.NextKey, Limit: 2})
+	require.NoError(err)
+	require.Len(history, 1)
+	require.Equal(int64(50), history[0].BlockHeight)
+	require.Empty(pagination.NextKey)
+}
+
+func (s *KeeperTestSuite) TestArchiveNetworkInferencePrunesBeyondRetention() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	require := s.Require()
+	topicId := uint64(1)
+	otherTopicId := uint64(2)
+	s.setNetworkInferenceArchiveRetention(25)
+
+	require.NoError(keeper.ArchiveNetworkInference(ctx, s.archivedNetworkInference(otherTopicId, 10)))
+	for _, blockHeight := range []int64{10, 20, 30, 40, 50} {
+		require.NoError(keeper.ArchiveNetworkInference(ctx, s.archivedNetworkInference(topicId, blockHeight)))
+	}
+
+	// Archives older than 50 - 25 are pruned
+	history, _, err := keeper.GetNetworkInferenceHistory(ctx, topicId, 0, 0, nil)
+	require.NoError(err)
+	require.Len(history, 3)
+	require.Equal(int64(30), history[0].BlockHeight)
+
+	_, found, err := keeper.GetArchivedNetworkInference(ctx, topicId, 20)
+	require.NoError(err)
+	require.False(found)
+
+	// Pruning is scoped to the archiving topic
+	_, found, err = keeper.GetArchivedNetworkInference(ctx, otherTopicId, 10)
+	require.NoError(err)
+	require.True(found)
+}
+
+func (s *KeeperTestSuite) TestArchiveNetworkInferenceRejectsInvalid() {
+	archived := s.archivedNetworkInference(0, 10)
+	err := s.emissionsKeeper.ArchiveNetworkInference(s.ctx, archived)
+	s.Require().Error(err)
+}
@@ -0,0 +1,22 @@
+package queryserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/allora-network/allora-chain/x/emissions/metrics"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetEndBlockerTimings returns the phase timings of the last EndBlockers run by the queried node, newest block first.
+// Only the last keeper.MaxRecordedEndBlockerTimings blocks are kept.
+func (qs queryServer) GetEndBlockerTimings(ctx context.Context, req *types.GetEndBlockerTimingsRequest) (_ *types.GetEndBlockerTimingsResponse, err error) {
+	defer metrics.RecordMetrics("GetEndBlockerTimings", time.Now(), &err)
+
+	if req.NumBlocks == 0 {
+		return nil, status.Error(codes.InvalidArgument, "num_blocks must be greater than 0")
+	}
+	return &types.GetEndBlockerTimingsResponse{Blocks: qs.k.GetEndBlockerTimings(req.NumBlocks)}, nil
+}
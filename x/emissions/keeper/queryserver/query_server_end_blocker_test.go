@@ -0,0 +1,26 @@
+package queryserver_test
+
+import (
+	"time"
+
+	"github.com/allora-network/allora-chain/x/emissions/metrics"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func (s *QueryServerTestSuite) TestGetEndBlockerTimings() {
+	require := s.Require()
+	s.emissionsKeeper.StartEndBlockerTimings(10)
+	s.emissionsKeeper.MeasureEndBlockerPhase(metrics.END_BLOCKER_PHASE_TOPIC_WEIGHTS, 0, time.Now())
+	s.emissionsKeeper.ObserveEndBlocker(10, 2*time.Millisecond)
+
+	response, err := s.queryServer.GetEndBlockerTimings(s.ctx, &types.GetEndBlockerTimingsRequest{NumBlocks: 5})
+	require.NoError(err)
+	require.Len(response.Blocks, 1)
+	require.Equal(int64(10), response.Blocks[0].BlockHeight)
+	require.Equal(int64(2000), response.Blocks[0].DurationMicros)
+	require.Len(response.Blocks[0].Phases, 1)
+	require.Equal(metrics.END_BLOCKER_PHASE_TOPIC_WEIGHTS, response.Blocks[0].Phases[0].Phase)
+
+	_, err = s.queryServer.GetEndBlockerTimings(s.ctx, &types.GetEndBlockerTimingsRequest{NumBlocks: 0})
+	require.Error(err)
+}
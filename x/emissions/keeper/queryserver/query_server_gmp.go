@@ -0,0 +1,40 @@
+package queryserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/allora-network/allora-chain/x/emissions/metrics"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func (qs queryServer) GetGmpSubscription(ctx context.Context, req *types.GetGmpSubscriptionRequest) (_ *types.GetGmpSubscriptionResponse, err error) {
+	defer metrics.RecordMetrics("GetGmpSubscription", time.Now(), &err)
+
+	subscription, found, err := qs.k.GetGmpSubscription(ctx, req.SubscriptionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	} else if !found {
+		return nil, status.Errorf(codes.NotFound, "gmp subscription %d not found", req.SubscriptionId)
+	}
+
+	return &types.GetGmpSubscriptionResponse{Subscription: &subscription}, nil
+}
+
+func (qs queryServer) GetTopicGmpSubscriptions(ctx context.Context, req *types.GetTopicGmpSubscriptionsRequest) (_ *types.GetTopicGmpSubscriptionsResponse, err error) {
+	defer metrics.RecordMetrics("GetTopicGmpSubscriptions", time.Now(), &err)
+
+	subscriptions, err := qs.k.GetTopicGmpSubscriptions(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]*types.GmpSubscription, len(subscriptions))
+	for i := range subscriptions {
+		result[i] = &subscriptions[i]
+	}
+	return &types.GetTopicGmpSubscriptionsResponse{Subscriptions: result}, nil
+}
@@ -212,45 +212,7 @@ func (qs queryServer) GetConfidenceIntervalsForInferenceData(
 	forecasterWeights map[string]alloraMath.Dec,
 ) (_ []alloraMath.Dec, _ []alloraMath.Dec, err error) {
 	defer metrics.RecordMetrics("GetConfidenceIntervalsForInferenceData", time.Now(), &err)
-	var inferences []alloraMath.Dec // from inferers + forecast-implied inferences
-	var weights []alloraMath.Dec    // weights of all workers
-
-	for _, inference := range networkInferences.InfererValues {
-		weight, exists := infererWeights[inference.Worker]
-		if exists {
-			inferences = append(inferences, inference.Value)
-			weights = append(weights, weight)
-		}
-	}
-
-	for _, forecast := range networkInferences.ForecasterValues {
-		weight, exists := forecasterWeights[forecast.Worker]
-		if exists {
-			inferences = append(inferences, forecast.Value)
-			weights = append(weights, weight)
-		}
-	}
-
-	ciRawPercentiles := []alloraMath.Dec{
-		alloraMath.MustNewDecFromString("2.28"),
-		alloraMath.MustNewDecFromString("15.87"),
-		alloraMath.MustNewDecFromString("50"),
-		alloraMath.MustNewDecFromString("84.13"),
-		alloraMath.MustNewDecFromString("97.72"),
-	}
-
-	var ciValues []alloraMath.Dec
-	if len(inferences) == 0 {
-		ciRawPercentiles = []alloraMath.Dec{}
-		ciValues = []alloraMath.Dec{}
-	} else {
-		ciValues, err = alloraMath.WeightedPercentile(inferences, weights, ciRawPercentiles)
-		if err != nil {
-			return nil, nil, err
-		}
-	}
-
-	return ciRawPercentiles, ciValues, nil
+	return synth.CalcConfidenceIntervals(networkInferences, infererWeights, forecasterWeights)
 }
 
 func (qs queryServer) GetLatestTopicInferences(ctx context.Context, req *emissionstypes.GetLatestTopicInferencesRequest) (_ *emissionstypes.GetLatestTopicInferencesResponse, err error) {
@@ -367,3 +329,45 @@ func (qs queryServer) GetOneOutForecasterForecasterNetworkRegret(ctx context.Con
 
 	return &emissionstypes.GetOneOutForecasterForecasterNetworkRegretResponse{Regret: &regret}, nil
 }
+
+// GetWorkerCommitment returns the commitment of a worker to its payload for a worker nonce of a topic in commit-reveal mode.
+func (qs queryServer) GetWorkerCommitment(ctx context.Context, req *emissionstypes.GetWorkerCommitmentRequest) (_ *emissionstypes.GetWorkerCommitmentResponse, err error) {
+	defer metrics.RecordMetrics("GetWorkerCommitment", time.Now(), &err)
+	if err = qs.k.ValidateStringIsBech32(req.Worker); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	commitment, found, err := qs.k.GetWorkerCommitment(ctx, req.TopicId, req.BlockHeight, req.Worker)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emissionstypes.GetWorkerCommitmentResponse{Commitment: &commitment, Found: found}, nil
+}
+
+// GetNetworkInferenceHistory returns the archived network inferences of a topic within a block height range.
+func (qs queryServer) GetNetworkInferenceHistory(ctx context.Context, req *emissionstypes.GetNetworkInferenceHistoryRequest) (_ *emissionstypes.GetNetworkInferenceHistoryResponse, err error) {
+	defer metrics.RecordMetrics("GetNetworkInferenceHistory", time.Now(), &err)
+
+	topicExists, err := qs.k.TopicExists(ctx, req.TopicId)
+	if !topicExists {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	} else if err != nil {
+		return nil, err
+	}
+	if req.FromBlockHeight < 0 || req.ToBlockHeight < 0 || (req.ToBlockHeight != 0 && req.ToBlockHeight < req.FromBlockHeight) {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"invalid block height range [%d, %d]", req.FromBlockHeight, req.ToBlockHeight)
+	}
+
+	networkInferences, pagination, err := qs.k.GetNetworkInferenceHistory(
+		ctx, req.TopicId, req.FromBlockHeight, req.ToBlockHeight, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emissionstypes.GetNetworkInferenceHistoryResponse{
+		NetworkInferences: networkInferences,
+		Pagination:        pagination,
+	}, nil
+}
@@ -79,3 +79,22 @@ func (qs queryServer) GetActiveReputersForTopic(ctx context.Context, req *types.
 
 	return &types.GetActiveReputersForTopicResponse{Reputers: reputers}, nil
 }
+
+// GetGroundTruth returns the ground truth submitted for a reputer nonce of a topic, if any.
+func (qs queryServer) GetGroundTruth(ctx context.Context, req *types.GetGroundTruthRequest) (_ *types.GetGroundTruthResponse, err error) {
+	defer metrics.RecordMetrics("GetGroundTruth", time.Now(), &err)
+
+	groundTruth, found, err := qs.k.GetGroundTruth(ctx, req.TopicId, req.BlockHeight)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.GetGroundTruthResponse{GroundTruth: groundTruth, Found: found}, nil
+}
+
+// GetLossMethods returns the loss methods topics may declare.
+func (qs queryServer) GetLossMethods(ctx context.Context, req *types.GetLossMethodsRequest) (_ *types.GetLossMethodsResponse, err error) {
+	defer metrics.RecordMetrics("GetLossMethods", time.Now(), &err)
+
+	return &types.GetLossMethodsResponse{LossMethods: qs.k.GetLossMethods()}, nil
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/allora-network/allora-chain/x/emissions/metrics"
 	"github.com/allora-network/allora-chain/x/emissions/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (qs queryServer) GetPreviousReputerRewardFraction(ctx context.Context, req *types.GetPreviousReputerRewardFractionRequest) (_ *types.GetPreviousReputerRewardFractionResponse, err error) {
@@ -62,3 +64,29 @@ func (qs queryServer) GetTotalRewardToDistribute(ctx context.Context, req *types
 
 	return &types.GetTotalRewardToDistributeResponse{TotalReward: totalReward}, nil
 }
+
+func (qs queryServer) GetAccruedRewards(ctx context.Context, req *types.GetAccruedRewardsRequest) (_ *types.GetAccruedRewardsResponse, err error) {
+	defer metrics.RecordMetrics("GetAccruedRewards", time.Now(), &err)
+
+	accruedRewards, total, err := qs.k.GetAccruedRewards(ctx, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]*types.AccruedReward, len(accruedRewards))
+	for i := range accruedRewards {
+		result[i] = &accruedRewards[i]
+	}
+	return &types.GetAccruedRewardsResponse{AccruedRewards: result, Total: total}, nil
+}
+
+func (qs queryServer) GetTotalAccruedRewards(ctx context.Context, req *types.GetTotalAccruedRewardsRequest) (_ *types.GetTotalAccruedRewardsResponse, err error) {
+	defer metrics.RecordMetrics("GetTotalAccruedRewards", time.Now(), &err)
+
+	total, err := qs.k.GetTotalAccruedRewards(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.GetTotalAccruedRewardsResponse{Total: total}, nil
+}
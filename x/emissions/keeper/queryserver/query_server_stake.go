@@ -346,6 +346,30 @@ func (qs queryServer) GetDelegateRewardPerShare(ctx context.Context, req *types.
 	return &types.GetDelegateRewardPerShareResponse{RewardPerShare: delegateRewardPerShare}, nil
 }
 
+func (qs queryServer) IsDelegateStakeAutoCompounding(ctx context.Context, req *types.IsDelegateStakeAutoCompoundingRequest) (_ *types.IsDelegateStakeAutoCompoundingResponse, err error) {
+	defer metrics.RecordMetrics("IsDelegateStakeAutoCompounding", time.Now(), &err)
+	isAutoCompounding, err := qs.k.IsDelegateStakeAutoCompounding(ctx, req.TopicId, req.Delegator, req.Reputer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.IsDelegateStakeAutoCompoundingResponse{IsAutoCompounding: isAutoCompounding}, nil
+}
+
+func (qs queryServer) GetDelegatorRedelegations(ctx context.Context, req *types.GetDelegatorRedelegationsRequest) (_ *types.GetDelegatorRedelegationsResponse, err error) {
+	defer metrics.RecordMetrics("GetDelegatorRedelegations", time.Now(), &err)
+	redelegations, err := qs.k.GetDelegatorRedelegations(ctx, req.Delegator)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]*types.Redelegation, len(redelegations))
+	for i := range redelegations {
+		result[i] = &redelegations[i]
+	}
+	return &types.GetDelegatorRedelegationsResponse{Redelegations: result}, nil
+}
+
 func (qs queryServer) GetStakeRemovalForReputerAndTopicId(ctx context.Context, req *types.GetStakeRemovalForReputerAndTopicIdRequest) (_ *types.GetStakeRemovalForReputerAndTopicIdResponse, err error) {
 	defer metrics.RecordMetrics("GetStakeRemovalForReputerAndTopicId", time.Now(), &err)
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -369,3 +393,17 @@ func (qs queryServer) GetDelegateStakeRemoval(ctx context.Context, req *types.Ge
 
 	return &types.GetDelegateStakeRemovalResponse{StakeRemovalInfo: &delegateStakeRemoval}, nil
 }
+
+func (qs queryServer) GetReputerSlashingInfo(ctx context.Context, req *types.GetReputerSlashingInfoRequest) (_ *types.GetReputerSlashingInfoResponse, err error) {
+	defer metrics.RecordMetrics("GetReputerSlashingInfo", time.Now(), &err)
+	if err := qs.k.ValidateStringIsBech32(req.Address); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	info, err := qs.k.GetReputerSlashingInfo(ctx, req.TopicId, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.GetReputerSlashingInfoResponse{SlashingInfo: &info}, nil
+}
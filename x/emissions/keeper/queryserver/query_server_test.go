@@ -81,6 +81,7 @@ func (s *QueryServerTestSuite) SetupTest() {
 		mintTypes.EcosystemModuleName:  {"burner", "minter", "staking"},
 		types.AlloraRewardsAccountName: {"minter"},
 		types.AlloraPendingRewardForDelegatorAccountName: {"minter"},
+		types.AlloraAccruedRewardsAccountName:            {"minter"},
 		"bonded_tokens_pool":                             {"burner", "staking"},
 		"not_bonded_tokens_pool":                         {"burner", "staking"},
 		multiPerm:                                        {"burner", "minter", "staking"},
@@ -133,7 +134,7 @@ func (s *QueryServerTestSuite) SetupTest() {
 		bankKeeper,
 		authtypes.FeeCollectorName)
 	s.key = key
-	appModule := module.NewAppModule(encCfg.Codec, s.emissionsKeeper)
+	appModule := module.NewAppModule(encCfg.Codec, s.emissionsKeeper, accountKeeper, bankKeeper)
 	defaultGenesis := appModule.DefaultGenesis(encCfg.Codec)
 	appModule.InitGenesis(ctx, encCfg.Codec, defaultGenesis)
 	s.msgServer = msgserver.NewMsgServerImpl(s.emissionsKeeper)
@@ -183,7 +184,7 @@ func (s *QueryServerTestSuite) CreateOneTopic() uint64 {
 	newTopicMsg := &types.CreateNewTopicRequest{
 		Creator:                  creator.String(),
 		Metadata:                 metadata,
-		LossMethod:               "method",
+		LossMethod:               "mse",
 		EpochLength:              10800,
 		GroundTruthLag:           10800,
 		WorkerSubmissionWindow:   10,
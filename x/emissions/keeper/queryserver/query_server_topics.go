@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
 	"github.com/allora-network/allora-chain/x/emissions/metrics"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -162,3 +163,76 @@ func (qs queryServer) GetNextChurningBlockByTopicId(ctx context.Context, req *ty
 	}
 	return &types.GetNextChurningBlockByTopicIdResponse{BlockHeight: blockHeight}, nil
 }
+
+func (qs queryServer) GetScheduledTopicUpdate(ctx context.Context, req *types.GetScheduledTopicUpdateRequest) (_ *types.GetScheduledTopicUpdateResponse, err error) {
+	defer metrics.RecordMetrics("GetScheduledTopicUpdate", time.Now(), &err)
+	update, found, err := qs.k.GetScheduledTopicUpdate(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return &types.GetScheduledTopicUpdateResponse{Update: nil, Found: false}, nil
+	}
+	return &types.GetScheduledTopicUpdateResponse{Update: &update, Found: true}, nil
+}
+
+// Returns the archived topic and the garbage collection progress of a retired topic
+func (qs queryServer) GetTopicRetirement(ctx context.Context, req *types.GetTopicRetirementRequest) (_ *types.GetTopicRetirementResponse, err error) {
+	defer metrics.RecordMetrics("GetTopicRetirement", time.Now(), &err)
+	retirement, found, err := qs.k.GetTopicRetirement(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "topic %d has not been retired", req.TopicId)
+	}
+	return &types.GetTopicRetirementResponse{Retirement: &retirement}, nil
+}
+
+func (qs queryServer) GetTopicSponsorship(ctx context.Context, req *types.GetTopicSponsorshipRequest) (_ *types.GetTopicSponsorshipResponse, err error) {
+	defer metrics.RecordMetrics("GetTopicSponsorship", time.Now(), &err)
+
+	sponsorship, found, err := qs.k.GetTopicSponsorship(ctx, req.TopicId, req.Sponsor)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	} else if !found {
+		return &types.GetTopicSponsorshipResponse{Sponsorship: nil, Found: false}, nil
+	}
+
+	return &types.GetTopicSponsorshipResponse{Sponsorship: &sponsorship, Found: true}, nil
+}
+
+func (qs queryServer) GetTopicSponsorships(ctx context.Context, req *types.GetTopicSponsorshipsRequest) (_ *types.GetTopicSponsorshipsResponse, err error) {
+	defer metrics.RecordMetrics("GetTopicSponsorships", time.Now(), &err)
+
+	sponsorships, err := qs.k.GetTopicSponsorships(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]*types.TopicSponsorship, len(sponsorships))
+	totalBalance := cosmosMath.ZeroInt()
+	for i := range sponsorships {
+		result[i] = &sponsorships[i]
+		totalBalance = totalBalance.Add(sponsorships[i].Balance)
+	}
+	return &types.GetTopicSponsorshipsResponse{Sponsorships: result, TotalBalance: totalBalance}, nil
+}
+
+// Returns the data sending fee currently charged for each payload sent to a topic
+func (qs queryServer) GetTopicDataSendingFee(ctx context.Context, req *types.GetTopicDataSendingFeeRequest) (_ *types.GetTopicDataSendingFeeResponse, err error) {
+	defer metrics.RecordMetrics("GetTopicDataSendingFee", time.Now(), &err)
+
+	topicExists, err := qs.k.TopicExists(ctx, req.TopicId)
+	if !topicExists {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	} else if err != nil {
+		return nil, err
+	}
+
+	fee, err := qs.k.GetTopicDataSendingFee(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.GetTopicDataSendingFeeResponse{Fee: fee}, nil
+}
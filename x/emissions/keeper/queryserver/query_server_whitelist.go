@@ -26,3 +26,54 @@ func (qs queryServer) IsWhitelistAdmin(ctx context.Context, req *types.IsWhiteli
 
 	return &types.IsWhitelistAdminResponse{IsAdmin: isAdmin}, nil
 }
+
+func (qs queryServer) IsGmpSourceAllowed(ctx context.Context, req *types.IsGmpSourceAllowedRequest) (_ *types.IsGmpSourceAllowedResponse, err error) {
+	defer metrics.RecordMetrics("IsGmpSourceAllowed", time.Now(), &err)
+	source := types.GmpAllowedSource{SourceChain: req.SourceChain, SourceAddress: req.SourceAddress}
+	if err := source.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	isAllowed, err := qs.k.IsGmpSourceAllowed(ctx, req.SourceChain, req.SourceAddress)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.IsGmpSourceAllowedResponse{IsAllowed: isAllowed}, nil
+}
+
+func (qs queryServer) IsTopicAllowlistEnabled(ctx context.Context, req *types.IsTopicAllowlistEnabledRequest) (_ *types.IsTopicAllowlistEnabledResponse, err error) {
+	defer metrics.RecordMetrics("IsTopicAllowlistEnabled", time.Now(), &err)
+
+	isEnabled, err := qs.k.IsTopicAllowlistEnabled(ctx, req.TopicId, req.IsReputer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.IsTopicAllowlistEnabledResponse{IsEnabled: isEnabled}, nil
+}
+
+func (qs queryServer) IsInTopicAllowlist(ctx context.Context, req *types.IsInTopicAllowlistRequest) (_ *types.IsInTopicAllowlistResponse, err error) {
+	defer metrics.RecordMetrics("IsInTopicAllowlist", time.Now(), &err)
+	if err := qs.k.ValidateStringIsBech32(req.Address); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	isAllowed, err := qs.k.IsInTopicAllowlist(ctx, req.TopicId, req.IsReputer, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.IsInTopicAllowlistResponse{IsAllowed: isAllowed}, nil
+}
+
+func (qs queryServer) GetTopicAllowlist(ctx context.Context, req *types.GetTopicAllowlistRequest) (_ *types.GetTopicAllowlistResponse, err error) {
+	defer metrics.RecordMetrics("GetTopicAllowlist", time.Now(), &err)
+
+	addresses, err := qs.k.GetTopicAllowlist(ctx, req.TopicId, req.IsReputer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.GetTopicAllowlistResponse{Addresses: addresses}, nil
+}
@@ -34,3 +34,64 @@ func (s *QueryServerTestSuite) TestIsWhitelistAdmin() {
 	s.Require().NotNil(response, "The response should not be nil")
 	s.Require().False(response.IsAdmin, "The IsAdmin field should be false for the anti test address")
 }
+
+func (s *QueryServerTestSuite) TestIsGmpSourceAllowed() {
+	ctx := s.ctx
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+
+	err := keeper.AddGmpAllowedSource(ctx, types.GmpAllowedSource{
+		SourceChain:   "Ethereum",
+		SourceAddress: "0xAbCdEf0123456789aBcDeF0123456789AbCdEf01",
+	})
+	s.Require().NoError(err, "AddGmpAllowedSource should not produce an error")
+
+	// Sources are matched case-insensitively
+	response, err := queryServer.IsGmpSourceAllowed(ctx, &types.IsGmpSourceAllowedRequest{
+		SourceChain:   "ethereum",
+		SourceAddress: "0xabcdef0123456789abcdef0123456789abcdef01",
+	})
+	s.Require().NoError(err, "IsGmpSourceAllowed should not produce an error")
+	s.Require().True(response.IsAllowed)
+
+	response, err = queryServer.IsGmpSourceAllowed(ctx, &types.IsGmpSourceAllowedRequest{
+		SourceChain:   "avalanche",
+		SourceAddress: "0xabcdef0123456789abcdef0123456789abcdef01",
+	})
+	s.Require().NoError(err, "IsGmpSourceAllowed should not produce an error")
+	s.Require().False(response.IsAllowed)
+
+	_, err = queryServer.IsGmpSourceAllowed(ctx, &types.IsGmpSourceAllowedRequest{SourceChain: "ethereum"})
+	s.Require().Error(err, "empty source address should be rejected")
+}
+
+func (s *QueryServerTestSuite) TestTopicAllowlistQueries() {
+	ctx := s.ctx
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	reputer := s.addrsStr[1]
+
+	err := keeper.SetTopicAllowlistEnabled(ctx, topicId, true, true)
+	s.Require().NoError(err, "SetTopicAllowlistEnabled should not produce an error")
+	err = keeper.AddToTopicAllowlist(ctx, topicId, true, reputer)
+	s.Require().NoError(err, "AddToTopicAllowlist should not produce an error")
+
+	enabledResponse, err := queryServer.IsTopicAllowlistEnabled(ctx, &types.IsTopicAllowlistEnabledRequest{TopicId: topicId, IsReputer: true})
+	s.Require().NoError(err)
+	s.Require().True(enabledResponse.IsEnabled)
+	enabledResponse, err = queryServer.IsTopicAllowlistEnabled(ctx, &types.IsTopicAllowlistEnabledRequest{TopicId: topicId, IsReputer: false})
+	s.Require().NoError(err)
+	s.Require().False(enabledResponse.IsEnabled, "the worker allowlist is toggled separately")
+
+	allowedResponse, err := queryServer.IsInTopicAllowlist(ctx, &types.IsInTopicAllowlistRequest{TopicId: topicId, IsReputer: true, Address: reputer})
+	s.Require().NoError(err)
+	s.Require().True(allowedResponse.IsAllowed)
+	allowedResponse, err = queryServer.IsInTopicAllowlist(ctx, &types.IsInTopicAllowlistRequest{TopicId: topicId, IsReputer: false, Address: reputer})
+	s.Require().NoError(err)
+	s.Require().False(allowedResponse.IsAllowed)
+
+	allowlistResponse, err := queryServer.GetTopicAllowlist(ctx, &types.GetTopicAllowlistRequest{TopicId: topicId, IsReputer: true})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{reputer}, allowlistResponse.Addresses)
+}
@@ -0,0 +1,295 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Moves delegate stake from a source topic and reputer to a destination topic and reputer at once.
+// The pending rewards of the source delegation are paid out, and the coins never leave the staking account.
+// The redelegation is recorded until `RemoveStakeDelayWindow` blocks have passed, so that slashes of the
+// source reputer for misbehaviour while the stake was placed upon it still reach the redelegated stake.
+func (k *Keeper) RedelegateStake(
+	ctx context.Context,
+	delegator ActorId,
+	srcTopicId TopicId,
+	srcReputer ActorId,
+	dstTopicId TopicId,
+	dstReputer ActorId,
+	amount cosmosMath.Int,
+) (types.Redelegation, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return types.Redelegation{}, errors.Wrap(err, "error getting params")
+	}
+
+	inProgress, err := k.GetDelegatorRedelegations(ctx, delegator)
+	if err != nil {
+		return types.Redelegation{}, err
+	}
+	if uint64(len(inProgress)) >= moduleParams.MaxRedelegationsPerDelegator {
+		return types.Redelegation{}, errors.Wrapf(types.ErrTooManyRedelegations,
+			"%d redelegations in progress, at most %d allowed", len(inProgress), moduleParams.MaxRedelegationsPerDelegator)
+	}
+	// Stake redelegated into the source could not be slashed for its first reputer once moved again
+	for _, redelegation := range inProgress {
+		if redelegation.DstTopicId == srcTopicId && redelegation.DstReputer == srcReputer {
+			return types.Redelegation{}, errors.Wrapf(types.ErrTransitiveRedelegation,
+				"redelegation %d completes at block %d", redelegation.Id, redelegation.BlockRedelegationCompleted)
+		}
+	}
+
+	// Stake queued for removal cannot be redelegated
+	placement, err := k.GetDelegateStakePlacement(ctx, srcTopicId, delegator, srcReputer)
+	if err != nil {
+		return types.Redelegation{}, errors.Wrap(err, "error getting delegate stake placement")
+	}
+	available, err := placement.Amount.SdkIntTrim()
+	if err != nil {
+		return types.Redelegation{}, errors.Wrap(err, "error trimming delegate stake placement")
+	}
+	removal, found, err := k.GetDelegateStakeRemovalForDelegatorReputerAndTopicId(sdkCtx, delegator, srcReputer, srcTopicId)
+	if err != nil {
+		return types.Redelegation{}, errors.Wrap(err, "error getting delegate stake removal")
+	}
+	if found {
+		available = available.Sub(cosmosMath.MinInt(removal.Amount, available))
+	}
+	if amount.GT(available) {
+		return types.Redelegation{}, errors.Wrapf(types.ErrInsufficientDelegateStakeToRedelegate,
+			"%s available, %s requested", available.String(), amount.String())
+	}
+
+	if err := k.removeDelegateStake(ctx, srcTopicId, delegator, srcReputer, amount); err != nil {
+		return types.Redelegation{}, errors.Wrap(err, "error removing stake from source")
+	}
+	if err := k.AddDelegateStake(ctx, dstTopicId, delegator, dstReputer, amount); err != nil {
+		return types.Redelegation{}, errors.Wrap(err, "error adding stake to destination")
+	}
+
+	id, err := k.nextRedelegationId.Next(ctx)
+	if err != nil {
+		return types.Redelegation{}, errors.Wrap(err, "error getting next redelegation id")
+	}
+	redelegation := types.Redelegation{
+		Id:                         id,
+		Delegator:                  delegator,
+		SrcTopicId:                 srcTopicId,
+		SrcReputer:                 srcReputer,
+		DstTopicId:                 dstTopicId,
+		DstReputer:                 dstReputer,
+		Amount:                     amount,
+		BlockRedelegated:           sdkCtx.BlockHeight(),
+		BlockRedelegationCompleted: sdkCtx.BlockHeight() + moduleParams.RemoveStakeDelayWindow,
+	}
+	if err := k.SetRedelegation(ctx, redelegation); err != nil {
+		return types.Redelegation{}, err
+	}
+	return redelegation, nil
+}
+
+// Sets a redelegation in progress along with its indexes
+func (k *Keeper) SetRedelegation(ctx context.Context, redelegation types.Redelegation) error {
+	if err := redelegation.Validate(); err != nil {
+		return errors.Wrap(err, "redelegation is invalid")
+	}
+	if err := k.redelegations.Set(ctx, redelegation.Id, redelegation); err != nil {
+		return err
+	}
+	if err := k.redelegationsBySource.Set(ctx, collections.Join3(redelegation.SrcTopicId, redelegation.SrcReputer, redelegation.Id)); err != nil {
+		return err
+	}
+	if err := k.redelegationsByDelegator.Set(ctx, collections.Join(redelegation.Delegator, redelegation.Id)); err != nil {
+		return err
+	}
+	return k.redelegationsByCompletion.Set(ctx, collections.Join(redelegation.BlockRedelegationCompleted, redelegation.Id))
+}
+
+// Returns a redelegation in progress by id, if any
+func (k *Keeper) GetRedelegation(ctx context.Context, id uint64) (types.Redelegation, bool, error) {
+	redelegation, err := k.redelegations.Get(ctx, id)
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return types.Redelegation{}, false, nil
+	} else if err != nil {
+		return types.Redelegation{}, false, err
+	}
+	return redelegation, true, nil
+}
+
+func (k *Keeper) removeRedelegation(ctx context.Context, redelegation types.Redelegation) error {
+	if err := k.redelegations.Remove(ctx, redelegation.Id); err != nil {
+		return err
+	}
+	if err := k.redelegationsBySource.Remove(ctx, collections.Join3(redelegation.SrcTopicId, redelegation.SrcReputer, redelegation.Id)); err != nil {
+		return err
+	}
+	if err := k.redelegationsByDelegator.Remove(ctx, collections.Join(redelegation.Delegator, redelegation.Id)); err != nil {
+		return err
+	}
+	return k.redelegationsByCompletion.Remove(ctx, collections.Join(redelegation.BlockRedelegationCompleted, redelegation.Id))
+}
+
+// Returns the redelegations in progress of a delegator, in id order
+func (k *Keeper) GetDelegatorRedelegations(ctx context.Context, delegator ActorId) ([]types.Redelegation, error) {
+	iter, err := k.redelegationsByDelegator.Iterate(ctx, collections.NewPrefixedPairRange[ActorId, uint64](delegator))
+	if err != nil {
+		return nil, errors.Wrap(err, "error iterating redelegations of delegator")
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting redelegations of delegator")
+	}
+	ids := make([]uint64, len(keys))
+	for i, key := range keys {
+		ids[i] = key.K2()
+	}
+	return k.getRedelegations(ctx, ids)
+}
+
+// Returns the redelegations in progress away from a reputer in a topic, in id order
+func (k *Keeper) GetRedelegationsFromReputer(ctx context.Context, topicId TopicId, reputer ActorId) ([]types.Redelegation, error) {
+	rng := collections.NewSuperPrefixedTripleRange[TopicId, ActorId, uint64](topicId, reputer)
+	iter, err := k.redelegationsBySource.Iterate(ctx, rng)
+	if err != nil {
+		return nil, errors.Wrap(err, "error iterating redelegations from reputer")
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting redelegations from reputer")
+	}
+	ids := make([]uint64, len(keys))
+	for i, key := range keys {
+		ids[i] = key.K3()
+	}
+	return k.getRedelegations(ctx, ids)
+}
+
+func (k *Keeper) getRedelegations(ctx context.Context, ids []uint64) ([]types.Redelegation, error) {
+	redelegations := make([]types.Redelegation, 0, len(ids))
+	for _, id := range ids {
+		redelegation, err := k.redelegations.Get(ctx, id)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting redelegation %d", id)
+		}
+		redelegations = append(redelegations, redelegation)
+	}
+	return redelegations, nil
+}
+
+// Returns every redelegation in progress, in id order
+func (k *Keeper) GetAllRedelegations(ctx context.Context) ([]types.Redelegation, error) {
+	iter, err := k.redelegations.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error iterating redelegations")
+	}
+	return iter.Values()
+}
+
+func (k *Keeper) GetNextRedelegationId(ctx context.Context) (uint64, error) {
+	return k.nextRedelegationId.Peek(ctx)
+}
+
+func (k *Keeper) SetNextRedelegationId(ctx context.Context, id uint64) error {
+	return k.nextRedelegationId.Set(ctx, id)
+}
+
+// Forgets the redelegations completed at or before a block height, at most `limit` of them.
+// The redelegated stake is no longer slashable for its source reputer.
+func (k *Keeper) RemoveCompletedRedelegations(ctx context.Context, blockHeight BlockHeight, limit uint64) error {
+	rng := collections.NewPrefixUntilPairRange[BlockHeight, uint64](blockHeight)
+	iter, err := k.redelegationsByCompletion.Iterate(ctx, rng)
+	if err != nil {
+		return errors.Wrap(err, "error iterating completed redelegations")
+	}
+	ids := make([]uint64, 0)
+	for ; iter.Valid() && uint64(len(ids)) < limit; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			iter.Close()
+			return errors.Wrap(err, "error getting completed redelegation")
+		}
+		ids = append(ids, key.K2())
+	}
+	iter.Close()
+
+	for _, id := range ids {
+		redelegation, err := k.redelegations.Get(ctx, id)
+		if err != nil {
+			return errors.Wrapf(err, "error getting redelegation %d", id)
+		}
+		if err := k.removeRedelegation(ctx, redelegation); err != nil {
+			return errors.Wrapf(err, "error removing redelegation %d", id)
+		}
+	}
+	return nil
+}
+
+// Slashes `slashFraction` of the stake redelegated away from a reputer in a topic whose redelegation is
+// still in progress. The stake is taken from the destination delegation, as far as it is still placed,
+// and is left in the staking account for the caller to burn or redistribute. Returns the total slashed.
+func (k *Keeper) slashRedelegationsFromReputer(
+	ctx sdk.Context,
+	topicId TopicId,
+	reputer ActorId,
+	slashFraction alloraMath.Dec,
+) (cosmosMath.Int, error) {
+	redelegations, err := k.GetRedelegationsFromReputer(ctx, topicId, reputer)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	totalSlashed := cosmosMath.ZeroInt()
+	for _, redelegation := range redelegations {
+		slashed, err := fractionOfInt(redelegation.Amount, slashFraction)
+		if err != nil {
+			return cosmosMath.Int{}, errors.Wrap(err, "error computing redelegated stake slashed")
+		}
+		placement, err := k.GetDelegateStakePlacement(ctx, redelegation.DstTopicId, redelegation.Delegator, redelegation.DstReputer)
+		if err != nil {
+			return cosmosMath.Int{}, errors.Wrap(err, "error getting destination delegate stake placement")
+		}
+		placed, err := placement.Amount.SdkIntTrim()
+		if err != nil {
+			return cosmosMath.Int{}, errors.Wrap(err, "error trimming destination delegate stake placement")
+		}
+		slashed = cosmosMath.MinInt(slashed, placed)
+		if slashed.IsZero() {
+			continue
+		}
+
+		err = k.removeDelegateStake(ctx, redelegation.DstTopicId, redelegation.Delegator, redelegation.DstReputer, slashed)
+		if err != nil {
+			return cosmosMath.Int{}, errors.Wrap(err, "error slashing destination delegate stake")
+		}
+		removal, found, err := k.GetDelegateStakeRemovalForDelegatorReputerAndTopicId(
+			ctx, redelegation.Delegator, redelegation.DstReputer, redelegation.DstTopicId)
+		if err != nil {
+			return cosmosMath.Int{}, errors.Wrap(err, "error getting delegate stake removal")
+		}
+		remaining := placed.Sub(slashed)
+		if found && removal.Amount.GT(remaining) {
+			if remaining.IsZero() {
+				err = k.DeleteDelegateStakeRemoval(ctx, removal.BlockRemovalCompleted, redelegation.DstTopicId, redelegation.DstReputer, redelegation.Delegator)
+			} else {
+				removal.Amount = remaining
+				err = k.SetDelegateStakeRemoval(ctx, removal)
+			}
+			if err != nil {
+				return cosmosMath.Int{}, errors.Wrap(err, "error capping delegate stake removal")
+			}
+		}
+
+		redelegation.Amount = redelegation.Amount.Sub(slashed)
+		if err := k.redelegations.Set(ctx, redelegation.Id, redelegation); err != nil {
+			return cosmosMath.Int{}, errors.Wrap(err, "error setting slashed redelegation")
+		}
+		totalSlashed = totalSlashed.Add(slashed)
+	}
+	return totalSlashed, nil
+}
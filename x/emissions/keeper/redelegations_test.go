@@ -0,0 +1,197 @@
+package keeper_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Places stake upon a reputer in each of topics 1 and 2, and 500 of delegate stake upon the first one
+func (s *KeeperTestSuite) setUpRedelegation() (srcReputer, dstReputer, delegator string) {
+	ctx := s.ctx
+	k := s.emissionsKeeper
+	require := s.Require()
+	srcReputer = s.addrsStr[0]
+	dstReputer = s.addrsStr[1]
+	delegator = s.addrsStr[2]
+
+	require.NoError(k.AddReputerStake(ctx, 1, srcReputer, cosmosMath.NewInt(1000)))
+	require.NoError(k.AddReputerStake(ctx, 2, dstReputer, cosmosMath.NewInt(1000)))
+	require.NoError(k.AddDelegateStake(ctx, 1, delegator, srcReputer, cosmosMath.NewInt(500)))
+	stakeCoins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, cosmosMath.NewInt(2500)))
+	require.NoError(s.bankKeeper.MintCoins(ctx, types.AlloraStakingAccountName, stakeCoins))
+	return srcReputer, dstReputer, delegator
+}
+
+func (s *KeeperTestSuite) TestRedelegateStake() {
+	ctx := s.ctx
+	k := s.emissionsKeeper
+	require := s.Require()
+	srcReputer, dstReputer, delegator := s.setUpRedelegation()
+
+	redelegation, err := k.RedelegateStake(ctx, delegator, 1, srcReputer, 2, dstReputer, cosmosMath.NewInt(200))
+	require.NoError(err)
+	moduleParams, err := k.GetParams(ctx)
+	require.NoError(err)
+	require.Equal(ctx.BlockHeight()+moduleParams.RemoveStakeDelayWindow, redelegation.BlockRedelegationCompleted)
+	require.Equal(cosmosMath.NewInt(200), redelegation.Amount)
+
+	srcPlacement, err := k.GetDelegateStakePlacement(ctx, 1, delegator, srcReputer)
+	require.NoError(err)
+	require.True(alloraMath.NewDecFromInt64(300).Equal(srcPlacement.Amount), srcPlacement.Amount.String())
+	dstPlacement, err := k.GetDelegateStakePlacement(ctx, 2, delegator, dstReputer)
+	require.NoError(err)
+	require.True(alloraMath.NewDecFromInt64(200).Equal(dstPlacement.Amount), dstPlacement.Amount.String())
+	srcTopicStake, err := k.GetTopicStake(ctx, 1)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(1300), srcTopicStake)
+	dstTopicStake, err := k.GetTopicStake(ctx, 2)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(1200), dstTopicStake)
+
+	// The coins stay in the staking account
+	totalStake, err := k.GetTotalStake(ctx)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(2500), totalStake)
+	stakingAddr := authtypes.NewModuleAddress(types.AlloraStakingAccountName)
+	stakingBalance := s.bankKeeper.GetBalance(ctx, stakingAddr, params.DefaultBondDenom)
+	require.Equal(totalStake, stakingBalance.Amount)
+
+	redelegations, err := k.GetDelegatorRedelegations(ctx, delegator)
+	require.NoError(err)
+	require.Equal([]types.Redelegation{redelegation}, redelegations)
+
+	// Stake queued for removal cannot be redelegated
+	require.NoError(k.SetDelegateStakeRemoval(ctx, types.DelegateStakeRemovalInfo{
+		BlockRemovalStarted:   ctx.BlockHeight(),
+		TopicId:               1,
+		Reputer:               srcReputer,
+		Delegator:             delegator,
+		Amount:                cosmosMath.NewInt(250),
+		BlockRemovalCompleted: ctx.BlockHeight() + moduleParams.RemoveStakeDelayWindow,
+	}))
+	_, err = k.RedelegateStake(ctx, delegator, 1, srcReputer, 2, dstReputer, cosmosMath.NewInt(51))
+	require.ErrorIs(err, types.ErrInsufficientDelegateStakeToRedelegate)
+
+	// Stake redelegated into a reputer cannot be moved on until its redelegation completes
+	_, err = k.RedelegateStake(ctx, delegator, 2, dstReputer, 1, srcReputer, cosmosMath.NewInt(100))
+	require.ErrorIs(err, types.ErrTransitiveRedelegation)
+}
+
+func (s *KeeperTestSuite) TestRedelegateStakeRespectsMaxRedelegationsPerDelegator() {
+	ctx := s.ctx
+	k := s.emissionsKeeper
+	require := s.Require()
+	srcReputer, dstReputer, delegator := s.setUpRedelegation()
+
+	moduleParams, err := k.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.MaxRedelegationsPerDelegator = 1
+	require.NoError(k.SetParams(ctx, moduleParams))
+
+	_, err = k.RedelegateStake(ctx, delegator, 1, srcReputer, 2, dstReputer, cosmosMath.NewInt(100))
+	require.NoError(err)
+	_, err = k.RedelegateStake(ctx, delegator, 1, srcReputer, 2, dstReputer, cosmosMath.NewInt(100))
+	require.ErrorIs(err, types.ErrTooManyRedelegations)
+
+	// Completed redelegations no longer count
+	require.NoError(k.RemoveCompletedRedelegations(ctx, ctx.BlockHeight()+moduleParams.RemoveStakeDelayWindow, 10))
+	_, err = k.RedelegateStake(ctx, delegator, 1, srcReputer, 2, dstReputer, cosmosMath.NewInt(100))
+	require.NoError(err)
+
+	// A max of 0 disables redelegation
+	moduleParams.MaxRedelegationsPerDelegator = 0
+	require.NoError(k.SetParams(ctx, moduleParams))
+	_, err = k.RedelegateStake(ctx, s.addrsStr[3], 1, srcReputer, 2, dstReputer, cosmosMath.NewInt(100))
+	require.ErrorIs(err, types.ErrTooManyRedelegations)
+}
+
+func (s *KeeperTestSuite) TestSlashReputerReachesRedelegatedStake() {
+	ctx := s.ctx
+	k := s.emissionsKeeper
+	require := s.Require()
+	srcReputer, dstReputer, delegator := s.setUpRedelegation()
+
+	redelegation, err := k.RedelegateStake(ctx, delegator, 1, srcReputer, 2, dstReputer, cosmosMath.NewInt(200))
+	require.NoError(err)
+
+	err = k.SlashReputer(
+		ctx,
+		1,
+		srcReputer,
+		alloraMath.MustNewDecFromString("0.1"),
+		alloraMath.ZeroDec(),
+		types.ReputerSlashReason_REPUTER_SLASH_REASON_OUTLIER,
+	)
+	require.NoError(err)
+
+	// 100 of reputer stake, 30 of delegate stake still upon the source and 20 of redelegated stake
+	dstPlacement, err := k.GetDelegateStakePlacement(ctx, 2, delegator, dstReputer)
+	require.NoError(err)
+	require.True(alloraMath.NewDecFromInt64(180).Equal(dstPlacement.Amount), dstPlacement.Amount.String())
+	dstTopicStake, err := k.GetTopicStake(ctx, 2)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(1180), dstTopicStake)
+	dstAuthority, err := k.GetStakeReputerAuthority(ctx, 2, dstReputer)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(1180), dstAuthority)
+	srcTopicStake, err := k.GetTopicStake(ctx, 1)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(1170), srcTopicStake)
+	totalStake, err := k.GetTotalStake(ctx)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(2350), totalStake)
+	stakingAddr := authtypes.NewModuleAddress(types.AlloraStakingAccountName)
+	stakingBalance := s.bankKeeper.GetBalance(ctx, stakingAddr, params.DefaultBondDenom)
+	require.Equal(totalStake, stakingBalance.Amount)
+
+	slashed, found, err := k.GetRedelegation(ctx, redelegation.Id)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(cosmosMath.NewInt(180), slashed.Amount)
+
+	// Once completed, the redelegation is forgotten and further slashes of the source no longer reach it
+	require.NoError(k.RemoveCompletedRedelegations(ctx, redelegation.BlockRedelegationCompleted, 10))
+	_, found, err = k.GetRedelegation(ctx, redelegation.Id)
+	require.NoError(err)
+	require.False(found)
+	err = k.SlashReputer(
+		ctx,
+		1,
+		srcReputer,
+		alloraMath.MustNewDecFromString("0.1"),
+		alloraMath.ZeroDec(),
+		types.ReputerSlashReason_REPUTER_SLASH_REASON_OUTLIER,
+	)
+	require.NoError(err)
+	dstPlacement, err = k.GetDelegateStakePlacement(ctx, 2, delegator, dstReputer)
+	require.NoError(err)
+	require.True(alloraMath.NewDecFromInt64(180).Equal(dstPlacement.Amount), dstPlacement.Amount.String())
+}
+
+func (s *KeeperTestSuite) TestRemoveCompletedRedelegationsRespectsLimit() {
+	ctx := s.ctx
+	k := s.emissionsKeeper
+	require := s.Require()
+	srcReputer, dstReputer, delegator := s.setUpRedelegation()
+
+	first, err := k.RedelegateStake(ctx, delegator, 1, srcReputer, 2, dstReputer, cosmosMath.NewInt(100))
+	require.NoError(err)
+	_, err = k.RedelegateStake(ctx.WithBlockHeight(ctx.BlockHeight()+1), delegator, 1, srcReputer, 2, dstReputer, cosmosMath.NewInt(100))
+	require.NoError(err)
+
+	// Redelegations not completed yet are kept
+	require.NoError(k.RemoveCompletedRedelegations(ctx, first.BlockRedelegationCompleted-1, 10))
+	redelegations, err := k.GetDelegatorRedelegations(ctx, delegator)
+	require.NoError(err)
+	require.Len(redelegations, 2)
+
+	require.NoError(k.RemoveCompletedRedelegations(ctx, first.BlockRedelegationCompleted+1, 1))
+	redelegations, err = k.GetDelegatorRedelegations(ctx, delegator)
+	require.NoError(err)
+	require.Len(redelegations, 1)
+	require.NotEqual(first.Id, redelegations[0].Id)
+}
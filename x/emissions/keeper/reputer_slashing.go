@@ -0,0 +1,462 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Returns the slashing info of a reputer in a topic, or the zero value if nothing was recorded for it yet.
+func (k *Keeper) GetReputerSlashingInfo(ctx context.Context, topicId TopicId, reputer ActorId) (types.ReputerSlashingInfo, error) {
+	info, err := k.reputerSlashingInfo.Get(ctx, collections.Join(topicId, reputer))
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return types.ReputerSlashingInfo{}, nil
+	}
+	return info, err
+}
+
+func (k *Keeper) SetReputerSlashingInfo(ctx context.Context, topicId TopicId, reputer ActorId, info types.ReputerSlashingInfo) error {
+	return k.reputerSlashingInfo.Set(ctx, collections.Join(topicId, reputer), info)
+}
+
+func (k *Keeper) IsReputerJailed(ctx context.Context, topicId TopicId, reputer ActorId) (bool, error) {
+	info, err := k.GetReputerSlashingInfo(ctx, topicId, reputer)
+	if err != nil {
+		return false, err
+	}
+	return info.Jailed, nil
+}
+
+// Gives the staking module account the burner permission needed to burn slashed stake.
+// Module accounts keep the permissions they were created with, so the staking accounts of chains
+// started before reputer slashing existed must be granted it by a migration.
+func (k *Keeper) GrantStakingBurnerPermission(ctx context.Context) error {
+	acc := k.authKeeper.GetModuleAccount(ctx, types.AlloraStakingAccountName)
+	if acc == nil || acc.HasPermission(authtypes.Burner) {
+		return nil
+	}
+	moduleAcc, ok := acc.(*authtypes.ModuleAccount)
+	if !ok {
+		return errors.Wrapf(types.ErrInvalidValue, "unexpected type %T for the %s module account", acc, types.AlloraStakingAccountName)
+	}
+	moduleAcc.Permissions = append(moduleAcc.Permissions, authtypes.Burner)
+	k.authKeeper.SetModuleAccount(ctx, moduleAcc)
+	return nil
+}
+
+// Records that a reputer submitted losses for the reputer nonce at the given block height,
+// so that it is not counted as absent when the nonce is closed.
+func (k *Keeper) RecordReputerSubmission(ctx context.Context, topicId TopicId, reputer ActorId, nonceBlockHeight BlockHeight) error {
+	info, err := k.GetReputerSlashingInfo(ctx, topicId, reputer)
+	if err != nil {
+		return err
+	}
+	if info.LastSubmittedNonce >= nonceBlockHeight {
+		return nil
+	}
+	info.LastSubmittedNonce = nonceBlockHeight
+	return k.SetReputerSlashingInfo(ctx, topicId, reputer, info)
+}
+
+// Called once a reputer nonce is closed. Every unjailed reputer of the topic with enough stake to have
+// submitted losses for the nonce but that did not has its missed nonce streak extended, and is slashed
+// and jailed once the streak reaches the max missed reputer nonces param. Other reputers' streaks are reset.
+func (k *Keeper) UpdateReputerMissedNonces(ctx context.Context, topicId TopicId, nonceBlockHeight BlockHeight) error {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error getting params")
+	}
+	if moduleParams.MaxMissedReputerNonces == 0 {
+		return nil
+	}
+
+	// Collect the reputers before writing, the iterator must be closed first
+	iter, err := k.topicReputers.Iterate(ctx, collections.NewPrefixedPairRange[TopicId, ActorId](topicId))
+	if err != nil {
+		return errors.Wrap(err, "error iterating topic reputers")
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return errors.Wrap(err, "error getting topic reputers")
+	}
+
+	for _, key := range keys {
+		reputer := key.K2()
+		info, err := k.GetReputerSlashingInfo(ctx, topicId, reputer)
+		if err != nil {
+			return errors.Wrapf(err, "error getting slashing info of reputer %s", reputer)
+		}
+		if info.Jailed {
+			continue
+		}
+		if info.LastSubmittedNonce >= nonceBlockHeight {
+			if info.ConsecutiveMissedNonces > 0 {
+				info.ConsecutiveMissedNonces = 0
+				if err := k.SetReputerSlashingInfo(ctx, topicId, reputer, info); err != nil {
+					return errors.Wrapf(err, "error resetting missed nonces of reputer %s", reputer)
+				}
+			}
+			continue
+		}
+		// Reputers without the minimum stake could not have submitted losses
+		stake, err := k.GetStakeReputerAuthority(ctx, topicId, reputer)
+		if err != nil {
+			return errors.Wrapf(err, "error getting stake of reputer %s", reputer)
+		}
+		if stake.LT(moduleParams.RequiredMinimumStake) {
+			continue
+		}
+
+		info.ConsecutiveMissedNonces++
+		if info.ConsecutiveMissedNonces >= moduleParams.MaxMissedReputerNonces {
+			err = k.slashAndJailReputer(ctx, moduleParams, topicId, reputer, info, types.ReputerSlashReason_REPUTER_SLASH_REASON_MISSED_NONCES)
+		} else {
+			err = k.SetReputerSlashingInfo(ctx, topicId, reputer, info)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "error updating missed nonces of reputer %s", reputer)
+		}
+	}
+	return nil
+}
+
+// Extends or resets the outlier streak of an unjailed reputer after a rewarded epoch. The reputer is slashed
+// and jailed once the streak reaches the max reputer outlier epochs param.
+func (k *Keeper) UpdateReputerOutlierEpochs(ctx context.Context, topicId TopicId, reputer ActorId, isOutlier bool) error {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error getting params")
+	}
+	if moduleParams.MaxReputerOutlierEpochs == 0 {
+		return nil
+	}
+	info, err := k.GetReputerSlashingInfo(ctx, topicId, reputer)
+	if err != nil {
+		return errors.Wrapf(err, "error getting slashing info of reputer %s", reputer)
+	}
+	if info.Jailed {
+		return nil
+	}
+	if !isOutlier {
+		if info.ConsecutiveOutlierEpochs == 0 {
+			return nil
+		}
+		info.ConsecutiveOutlierEpochs = 0
+		return k.SetReputerSlashingInfo(ctx, topicId, reputer, info)
+	}
+	info.ConsecutiveOutlierEpochs++
+	if info.ConsecutiveOutlierEpochs >= moduleParams.MaxReputerOutlierEpochs {
+		return k.slashAndJailReputer(ctx, moduleParams, topicId, reputer, info, types.ReputerSlashReason_REPUTER_SLASH_REASON_OUTLIER)
+	}
+	return k.SetReputerSlashingInfo(ctx, topicId, reputer, info)
+}
+
+// Slashes and jails a reputer in a cache context, written only if every step succeeds. A reputer that could
+// not be slashed in full is left untouched but for its streak, so that the slash is attempted again.
+func (k *Keeper) slashAndJailReputer(
+	ctx context.Context,
+	moduleParams types.Params,
+	topicId TopicId,
+	reputer ActorId,
+	info types.ReputerSlashingInfo,
+	reason types.ReputerSlashReason,
+) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheSdkCtx, write := sdkCtx.CacheContext()
+	err := k.slashAndJailReputerUncached(cacheSdkCtx, moduleParams, topicId, reputer, info, reason)
+	if err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf("Error slashing and jailing reputer %s in topic %d: %v", reputer, topicId, err))
+		return k.SetReputerSlashingInfo(ctx, topicId, reputer, info)
+	}
+	write()
+	return nil
+}
+
+func (k *Keeper) slashAndJailReputerUncached(
+	sdkCtx sdk.Context,
+	moduleParams types.Params,
+	topicId TopicId,
+	reputer ActorId,
+	info types.ReputerSlashingInfo,
+	reason types.ReputerSlashReason,
+) error {
+	err := k.SlashReputer(sdkCtx, topicId, reputer, moduleParams.ReputerSlashFraction, moduleParams.SlashedStakeBurnFraction, reason)
+	if err != nil {
+		return errors.Wrap(err, "error slashing reputer")
+	}
+	info.ConsecutiveMissedNonces = 0
+	info.ConsecutiveOutlierEpochs = 0
+	info.Jailed = true
+	info.JailedUntilBlock = sdkCtx.BlockHeight() + moduleParams.ReputerJailDuration
+	err = k.SetReputerSlashingInfo(sdkCtx, topicId, reputer, info)
+	if err != nil {
+		return errors.Wrap(err, "error jailing reputer")
+	}
+	types.EmitNewReputerJailStatusChangedEvent(sdkCtx, topicId, reputer, info)
+	return nil
+}
+
+// Unjails a reputer whose jail period has elapsed. Its missed nonce and outlier streaks start over.
+func (k *Keeper) UnjailReputer(ctx context.Context, topicId TopicId, reputer ActorId) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	info, err := k.GetReputerSlashingInfo(ctx, topicId, reputer)
+	if err != nil {
+		return err
+	}
+	if !info.Jailed {
+		return types.ErrReputerNotJailed
+	}
+	if sdkCtx.BlockHeight() < info.JailedUntilBlock {
+		return errors.Wrapf(types.ErrReputerJailPeriodNotElapsed, "reputer may unjail at block %d", info.JailedUntilBlock)
+	}
+	info.ConsecutiveMissedNonces = 0
+	info.ConsecutiveOutlierEpochs = 0
+	info.Jailed = false
+	info.JailedUntilBlock = 0
+	err = k.SetReputerSlashingInfo(ctx, topicId, reputer, info)
+	if err != nil {
+		return err
+	}
+	types.EmitNewReputerJailStatusChangedEvent(sdkCtx, topicId, reputer, info)
+	return nil
+}
+
+// Slashes `slashFraction` of the stake placed upon a reputer in a topic: its own stake and every delegation to it alike.
+// Pending delegator rewards are paid out first so that reward debts can be rebased on the slashed amounts,
+// keeping the reward per share accounting of the reputer exact. Pending stake removals are capped at what remains.
+// Stake redelegated away from the reputer whose redelegation is still in progress is slashed alike.
+// `burnFraction` of the slashed coins is burned and the rest goes to the rewards account to be redistributed.
+func (k *Keeper) SlashReputer(
+	ctx context.Context,
+	topicId TopicId,
+	reputer ActorId,
+	slashFraction alloraMath.Dec,
+	burnFraction alloraMath.Dec,
+	reason types.ReputerSlashReason,
+) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if slashFraction.IsZero() {
+		return nil
+	}
+
+	stakeReputerAuthority, err := k.GetStakeReputerAuthority(ctx, topicId, reputer)
+	if err != nil {
+		return errors.Wrap(err, "error getting reputer authority")
+	}
+	stakeUponReputer, err := k.GetDelegateStakeUponReputer(ctx, topicId, reputer)
+	if err != nil {
+		return errors.Wrap(err, "error getting delegate stake upon reputer")
+	}
+	reputerStakeSlashed, err := fractionOfInt(stakeReputerAuthority.Sub(stakeUponReputer), slashFraction)
+	if err != nil {
+		return errors.Wrap(err, "error computing reputer stake slashed")
+	}
+
+	delegateStakeSlashed, err := k.slashDelegatorsOfReputer(sdkCtx, topicId, reputer, slashFraction)
+	if err != nil {
+		return errors.Wrap(err, "error slashing delegators")
+	}
+
+	// Slashing redelegated stake updates the destination aggregates, so it goes before the topic and total stakes are read
+	redelegatedStakeSlashed, err := k.slashRedelegationsFromReputer(sdkCtx, topicId, reputer, slashFraction)
+	if err != nil {
+		return errors.Wrap(err, "error slashing redelegations")
+	}
+
+	sourceSlashed := reputerStakeSlashed.Add(delegateStakeSlashed)
+	totalSlashed := sourceSlashed.Add(redelegatedStakeSlashed)
+	if totalSlashed.IsZero() {
+		return nil
+	}
+	stakeUponReputerNew := stakeUponReputer.Sub(delegateStakeSlashed)
+	stakeReputerAuthorityNew := stakeReputerAuthority.Sub(sourceSlashed)
+	topicStake, err := k.GetTopicStake(ctx, topicId)
+	if err != nil {
+		return errors.Wrap(err, "error getting topic stake")
+	}
+	totalStake, err := k.GetTotalStake(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error getting total stake")
+	}
+	if err := k.SetDelegateStakeUponReputer(ctx, topicId, reputer, stakeUponReputerNew); err != nil {
+		return errors.Wrap(err, "error setting delegate stake upon reputer")
+	}
+	if err := k.SetStakeReputerAuthority(ctx, topicId, reputer, stakeReputerAuthorityNew); err != nil {
+		return errors.Wrap(err, "error setting reputer authority")
+	}
+	if err := k.SetTopicStake(ctx, topicId, topicStake.Sub(sourceSlashed)); err != nil {
+		return errors.Wrap(err, "error setting topic stake")
+	}
+	if err := k.SetTotalStake(ctx, totalStake.Sub(sourceSlashed)); err != nil {
+		return errors.Wrap(err, "error setting total stake")
+	}
+
+	removal, found, err := k.GetStakeRemovalForReputerAndTopicId(sdkCtx, reputer, topicId)
+	if err != nil {
+		return errors.Wrap(err, "error getting reputer stake removal")
+	}
+	reputerStakeNew := stakeReputerAuthorityNew.Sub(stakeUponReputerNew)
+	if found && removal.Amount.GT(reputerStakeNew) {
+		if reputerStakeNew.IsZero() {
+			err = k.DeleteStakeRemoval(ctx, removal.BlockRemovalCompleted, topicId, reputer)
+		} else {
+			removal.Amount = reputerStakeNew
+			err = k.SetStakeRemoval(ctx, removal)
+		}
+		if err != nil {
+			return errors.Wrap(err, "error capping reputer stake removal")
+		}
+	}
+
+	burned, err := fractionOfInt(totalSlashed, burnFraction)
+	if err != nil {
+		return errors.Wrap(err, "error computing burned stake")
+	}
+	if burned.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, burned))
+		if err := k.bankKeeper.BurnCoins(ctx, types.AlloraStakingAccountName, coins); err != nil {
+			return errors.Wrap(err, "error burning slashed stake")
+		}
+	}
+	redistributed := totalSlashed.Sub(burned)
+	if redistributed.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, redistributed))
+		if err := k.SendCoinsFromModuleToModule(ctx, types.AlloraStakingAccountName, types.AlloraRewardsAccountName, coins); err != nil {
+			return errors.Wrap(err, "error redistributing slashed stake")
+		}
+	}
+
+	types.EmitNewReputerSlashedEvent(sdkCtx, topicId, reputer, reason, reputerStakeSlashed, delegateStakeSlashed.Add(redelegatedStakeSlashed), burned)
+	return nil
+}
+
+// Slashes every delegation upon a reputer in a topic and returns the total slashed.
+func (k *Keeper) slashDelegatorsOfReputer(
+	ctx sdk.Context,
+	topicId TopicId,
+	reputer ActorId,
+	slashFraction alloraMath.Dec,
+) (cosmosMath.Int, error) {
+	delegators, err := k.GetDelegatorsOfReputer(ctx, topicId, reputer)
+	if err != nil {
+		return cosmosMath.Int{}, errors.Wrap(err, "error getting delegators of reputer")
+	}
+
+	share, err := k.GetDelegateRewardPerShare(ctx, topicId, reputer)
+	if err != nil {
+		return cosmosMath.Int{}, errors.Wrap(err, "error getting delegate reward per share")
+	}
+	totalSlashed := cosmosMath.ZeroInt()
+	for _, delegator := range delegators {
+		placement, err := k.GetDelegateStakePlacement(ctx, topicId, delegator, reputer)
+		if err != nil {
+			return cosmosMath.Int{}, errors.Wrap(err, "error getting delegate stake placement")
+		}
+		amount, err := placement.Amount.SdkIntTrim()
+		if err != nil {
+			return cosmosMath.Int{}, errors.Wrap(err, "error trimming delegated stake")
+		}
+		slashed, err := fractionOfInt(amount, slashFraction)
+		if err != nil {
+			return cosmosMath.Int{}, errors.Wrap(err, "error computing delegated stake slashed")
+		}
+		if slashed.IsZero() {
+			continue
+		}
+
+		// Pay out the rewards accrued on the unslashed amount
+		pendingReward, err := placement.Amount.Mul(share)
+		if err != nil {
+			return cosmosMath.Int{}, errors.Wrap(err, "error calculating pending reward")
+		}
+		pendingReward, err = pendingReward.Sub(placement.RewardDebt)
+		if err != nil {
+			return cosmosMath.Int{}, errors.Wrap(err, "error subtracting reward debt from pending reward")
+		}
+		if pendingReward.Gt(alloraMath.ZeroDec()) {
+			pendingRewardInt, err := pendingReward.SdkIntTrim()
+			if err != nil {
+				return cosmosMath.Int{}, errors.Wrap(err, "error trimming pending reward")
+			}
+			if pendingRewardInt.IsPositive() {
+				err = k.SendCoinsFromModuleToAccount(
+					ctx,
+					types.AlloraPendingRewardForDelegatorAccountName,
+					delegator,
+					sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, pendingRewardInt)),
+				)
+				if err != nil {
+					return cosmosMath.Int{}, errors.Wrap(err, "error sending pending reward to delegator")
+				}
+			}
+		}
+
+		slashedDec, err := alloraMath.NewDecFromSdkInt(slashed)
+		if err != nil {
+			return cosmosMath.Int{}, err
+		}
+		newAmount, err := placement.Amount.Sub(slashedDec)
+		if err != nil {
+			return cosmosMath.Int{}, errors.Wrap(err, "error subtracting slashed stake from delegated stake")
+		}
+		newDebt, err := newAmount.Mul(share)
+		if err != nil {
+			return cosmosMath.Int{}, errors.Wrap(err, "error multiplying new amount by share")
+		}
+		err = k.SetDelegateStakePlacement(ctx, topicId, delegator, reputer, types.DelegatorInfo{
+			Amount:     newAmount,
+			RewardDebt: newDebt,
+		})
+		if err != nil {
+			return cosmosMath.Int{}, errors.Wrap(err, "error setting delegate stake placement")
+		}
+		stakeSumFromDelegator, err := k.GetStakeFromDelegatorInTopic(ctx, topicId, delegator)
+		if err != nil {
+			return cosmosMath.Int{}, errors.Wrap(err, "error getting stake from delegator in topic")
+		}
+		err = k.SetStakeFromDelegator(ctx, topicId, delegator, stakeSumFromDelegator.Sub(slashed))
+		if err != nil {
+			return cosmosMath.Int{}, errors.Wrap(err, "error setting stake from delegator in topic")
+		}
+
+		removal, found, err := k.GetDelegateStakeRemovalForDelegatorReputerAndTopicId(ctx, delegator, reputer, topicId)
+		if err != nil {
+			return cosmosMath.Int{}, errors.Wrap(err, "error getting delegate stake removal")
+		}
+		remaining := amount.Sub(slashed)
+		if found && removal.Amount.GT(remaining) {
+			if remaining.IsZero() {
+				err = k.DeleteDelegateStakeRemoval(ctx, removal.BlockRemovalCompleted, topicId, reputer, delegator)
+			} else {
+				removal.Amount = remaining
+				err = k.SetDelegateStakeRemoval(ctx, removal)
+			}
+			if err != nil {
+				return cosmosMath.Int{}, errors.Wrap(err, "error capping delegate stake removal")
+			}
+		}
+		totalSlashed = totalSlashed.Add(slashed)
+	}
+	return totalSlashed, nil
+}
+
+// Returns floor(amount * fraction)
+func fractionOfInt(amount cosmosMath.Int, fraction alloraMath.Dec) (cosmosMath.Int, error) {
+	amountDec, err := alloraMath.NewDecFromSdkInt(amount)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	resultDec, err := amountDec.Mul(fraction)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	return resultDec.SdkIntTrim()
+}
@@ -0,0 +1,248 @@
+package keeper_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (s *KeeperTestSuite) TestSlashReputer() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	require := s.Require()
+	topicId := uint64(1)
+	reputer := s.addrsStr[0]
+	delegator1 := s.addrsStr[1]
+	delegator2 := s.addrsStr[2]
+
+	require.NoError(keeper.AddReputerStake(ctx, topicId, reputer, cosmosMath.NewInt(1000)))
+	require.NoError(keeper.AddDelegateStake(ctx, topicId, delegator1, reputer, cosmosMath.NewInt(500)))
+	require.NoError(keeper.AddDelegateStake(ctx, topicId, delegator2, reputer, cosmosMath.NewInt(300)))
+	stakeCoins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, cosmosMath.NewInt(1800)))
+	require.NoError(s.bankKeeper.MintCoins(ctx, types.AlloraStakingAccountName, stakeCoins))
+
+	// Delegators have pending rewards of 0.1 per share
+	require.NoError(keeper.SetDelegateRewardPerShare(ctx, topicId, reputer, alloraMath.MustNewDecFromString("0.1")))
+	rewardCoins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, cosmosMath.NewInt(80)))
+	require.NoError(s.bankKeeper.MintCoins(ctx, types.AlloraPendingRewardForDelegatorAccountName, rewardCoins))
+
+	require.NoError(keeper.SetStakeRemoval(ctx, types.StakeRemovalInfo{
+		TopicId:               topicId,
+		Reputer:               reputer,
+		Amount:                cosmosMath.NewInt(1000),
+		BlockRemovalStarted:   ctx.BlockHeight(),
+		BlockRemovalCompleted: ctx.BlockHeight() + 10,
+	}))
+
+	rewardsAddr := authtypes.NewModuleAddress(types.AlloraRewardsAccountName)
+	rewardsBefore := s.bankKeeper.GetBalance(ctx, rewardsAddr, params.DefaultBondDenom)
+	delegator1Before := s.bankKeeper.GetBalance(ctx, s.addrs[1], params.DefaultBondDenom)
+
+	err := keeper.SlashReputer(
+		ctx,
+		topicId,
+		reputer,
+		alloraMath.MustNewDecFromString("0.1"),
+		alloraMath.MustNewDecFromString("0.5"),
+		types.ReputerSlashReason_REPUTER_SLASH_REASON_OUTLIER,
+	)
+	require.NoError(err)
+
+	authority, err := keeper.GetStakeReputerAuthority(ctx, topicId, reputer)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(1620), authority)
+	stakeUponReputer, err := keeper.GetDelegateStakeUponReputer(ctx, topicId, reputer)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(720), stakeUponReputer)
+	topicStake, err := keeper.GetTopicStake(ctx, topicId)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(1620), topicStake)
+	totalStake, err := keeper.GetTotalStake(ctx)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(1620), totalStake)
+
+	placement, err := keeper.GetDelegateStakePlacement(ctx, topicId, delegator1, reputer)
+	require.NoError(err)
+	require.True(alloraMath.NewDecFromInt64(450).Equal(placement.Amount))
+	require.True(alloraMath.NewDecFromInt64(45).Equal(placement.RewardDebt))
+	delegatorStake, err := keeper.GetStakeFromDelegatorInTopic(ctx, topicId, delegator2)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(270), delegatorStake)
+
+	// Pending rewards were paid out before slashing
+	delegator1After := s.bankKeeper.GetBalance(ctx, s.addrs[1], params.DefaultBondDenom)
+	require.Equal(cosmosMath.NewInt(50), delegator1After.Amount.Sub(delegator1Before.Amount))
+
+	// The reputer stake removal is capped at what remains of its own stake
+	removal, found, err := keeper.GetStakeRemovalForReputerAndTopicId(ctx, reputer, topicId)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(cosmosMath.NewInt(900), removal.Amount)
+
+	// Half of the 180 slashed is burned and the rest redistributed
+	rewardsAfter := s.bankKeeper.GetBalance(ctx, rewardsAddr, params.DefaultBondDenom)
+	require.Equal(cosmosMath.NewInt(90), rewardsAfter.Amount.Sub(rewardsBefore.Amount))
+	stakingBalance := s.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.AlloraStakingAccountName), params.DefaultBondDenom)
+	require.Equal(cosmosMath.NewInt(1620), stakingBalance.Amount)
+}
+
+func (s *KeeperTestSuite) TestUpdateReputerMissedNoncesJailsAbsentReputer() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	require := s.Require()
+	topicId := uint64(1)
+	presentReputer := s.addrsStr[0]
+	absentReputer := s.addrsStr[1]
+
+	moduleParams, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.MaxMissedReputerNonces = 2
+	moduleParams.ReputerJailDuration = 10
+	require.NoError(keeper.SetParams(ctx, moduleParams))
+
+	for _, reputer := range []string{presentReputer, absentReputer} {
+		require.NoError(keeper.InsertReputer(ctx, topicId, reputer, types.OffchainNode{Owner: reputer, NodeAddress: reputer}))
+		require.NoError(keeper.AddReputerStake(ctx, topicId, reputer, moduleParams.RequiredMinimumStake.MulRaw(10)))
+	}
+	stakeCoins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, moduleParams.RequiredMinimumStake.MulRaw(20)))
+	require.NoError(s.bankKeeper.MintCoins(ctx, types.AlloraStakingAccountName, stakeCoins))
+
+	for nonce := int64(1); nonce <= 2; nonce++ {
+		require.NoError(keeper.RecordReputerSubmission(ctx, topicId, presentReputer, nonce))
+		require.NoError(keeper.UpdateReputerMissedNonces(ctx, topicId, nonce))
+	}
+
+	presentInfo, err := keeper.GetReputerSlashingInfo(ctx, topicId, presentReputer)
+	require.NoError(err)
+	require.False(presentInfo.Jailed)
+	require.Equal(uint64(0), presentInfo.ConsecutiveMissedNonces)
+	require.Equal(int64(2), presentInfo.LastSubmittedNonce)
+
+	absentInfo, err := keeper.GetReputerSlashingInfo(ctx, topicId, absentReputer)
+	require.NoError(err)
+	require.True(absentInfo.Jailed)
+	require.Equal(ctx.BlockHeight()+10, absentInfo.JailedUntilBlock)
+	require.Equal(uint64(0), absentInfo.ConsecutiveMissedNonces)
+
+	expectedStake := moduleParams.RequiredMinimumStake.MulRaw(10)
+	slashed, err := alloraMath.NewDecFromSdkInt(expectedStake)
+	require.NoError(err)
+	slashed, err = slashed.Mul(moduleParams.ReputerSlashFraction)
+	require.NoError(err)
+	slashedInt, err := slashed.SdkIntTrim()
+	require.NoError(err)
+	stake, err := keeper.GetStakeReputerAuthority(ctx, topicId, absentReputer)
+	require.NoError(err)
+	require.Equal(expectedStake.Sub(slashedInt), stake)
+
+	// Jailed reputers are not counted as absent again
+	require.NoError(keeper.UpdateReputerMissedNonces(ctx, topicId, 3))
+	stakeAfter, err := keeper.GetStakeReputerAuthority(ctx, topicId, absentReputer)
+	require.NoError(err)
+	require.Equal(stake, stakeAfter)
+
+	err = keeper.UnjailReputer(ctx, topicId, absentReputer)
+	require.ErrorIs(err, types.ErrReputerJailPeriodNotElapsed)
+
+	err = keeper.UnjailReputer(ctx.WithBlockHeight(absentInfo.JailedUntilBlock), topicId, absentReputer)
+	require.NoError(err)
+	isJailed, err := keeper.IsReputerJailed(ctx, topicId, absentReputer)
+	require.NoError(err)
+	require.False(isJailed)
+
+	err = keeper.UnjailReputer(ctx, topicId, absentReputer)
+	require.ErrorIs(err, types.ErrReputerNotJailed)
+}
+
+// A reputer whose slash fails partway is left unslashed and unjailed, without undoing the slashes of others
+func (s *KeeperTestSuite) TestUpdateReputerMissedNoncesSkipsFailedSlash() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	require := s.Require()
+	topicId := uint64(1)
+	reputers := []string{s.addrsStr[0], s.addrsStr[1]}
+
+	moduleParams, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.MaxMissedReputerNonces = 1
+	require.NoError(keeper.SetParams(ctx, moduleParams))
+
+	stake := moduleParams.RequiredMinimumStake.MulRaw(10)
+	for _, reputer := range reputers {
+		require.NoError(keeper.InsertReputer(ctx, topicId, reputer, types.OffchainNode{Owner: reputer, NodeAddress: reputer}))
+		require.NoError(keeper.AddReputerStake(ctx, topicId, reputer, stake))
+	}
+	// The staking account only holds the coins of a single slash, so the second slash fails to move them
+	slashed, err := alloraMath.NewDecFromSdkInt(stake)
+	require.NoError(err)
+	slashed, err = slashed.Mul(moduleParams.ReputerSlashFraction)
+	require.NoError(err)
+	slashedInt, err := slashed.SdkIntTrim()
+	require.NoError(err)
+	require.True(slashedInt.IsPositive())
+	stakeCoins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, slashedInt))
+	require.NoError(s.bankKeeper.MintCoins(ctx, types.AlloraStakingAccountName, stakeCoins))
+
+	require.NoError(keeper.UpdateReputerMissedNonces(ctx, topicId, 1))
+
+	jailed := 0
+	for _, reputer := range reputers {
+		info, err := keeper.GetReputerSlashingInfo(ctx, topicId, reputer)
+		require.NoError(err)
+		reputerStake, err := keeper.GetStakeReputerAuthority(ctx, topicId, reputer)
+		require.NoError(err)
+		if info.Jailed {
+			jailed++
+			require.Equal(stake.Sub(slashedInt), reputerStake)
+		} else {
+			require.Equal(stake, reputerStake)
+			require.Equal(uint64(1), info.ConsecutiveMissedNonces)
+		}
+	}
+	require.Equal(1, jailed)
+	topicStake, err := keeper.GetTopicStake(ctx, topicId)
+	require.NoError(err)
+	require.Equal(stake.MulRaw(2).Sub(slashedInt), topicStake)
+	totalStake, err := keeper.GetTotalStake(ctx)
+	require.NoError(err)
+	require.Equal(stake.MulRaw(2).Sub(slashedInt), totalStake)
+}
+
+func (s *KeeperTestSuite) TestUpdateReputerOutlierEpochs() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	require := s.Require()
+	topicId := uint64(1)
+	reputer := s.addrsStr[0]
+
+	moduleParams, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.MaxReputerOutlierEpochs = 3
+	require.NoError(keeper.SetParams(ctx, moduleParams))
+	require.NoError(keeper.AddReputerStake(ctx, topicId, reputer, cosmosMath.NewInt(1000)))
+	stakeCoins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, cosmosMath.NewInt(1000)))
+	require.NoError(s.bankKeeper.MintCoins(ctx, types.AlloraStakingAccountName, stakeCoins))
+
+	// A non-outlier epoch resets the streak
+	require.NoError(keeper.UpdateReputerOutlierEpochs(ctx, topicId, reputer, true))
+	require.NoError(keeper.UpdateReputerOutlierEpochs(ctx, topicId, reputer, true))
+	require.NoError(keeper.UpdateReputerOutlierEpochs(ctx, topicId, reputer, false))
+	info, err := keeper.GetReputerSlashingInfo(ctx, topicId, reputer)
+	require.NoError(err)
+	require.Equal(uint64(0), info.ConsecutiveOutlierEpochs)
+	require.False(info.Jailed)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(keeper.UpdateReputerOutlierEpochs(ctx, topicId, reputer, true))
+	}
+	info, err = keeper.GetReputerSlashingInfo(ctx, topicId, reputer)
+	require.NoError(err)
+	require.True(info.Jailed)
+
+	stake, err := keeper.GetStakeReputerAuthority(ctx, topicId, reputer)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(990), stake)
+}
@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Credits rewards to the ledger of the actors, to be claimed later with ClaimRewards.
+// The coins of all the rewards move from the sender module to the accrued rewards account in a single transfer.
+func (k *Keeper) AccrueRewards(ctx context.Context, senderModule string, rewards []types.TopicIdActorIdInt) error {
+	total := cosmosMath.ZeroInt()
+	for _, reward := range rewards {
+		if reward.Int.IsNegative() {
+			return errors.Wrapf(types.ErrInvalidReward, "reward cannot be negative: %s", reward.Int.String())
+		}
+		total = total.Add(reward.Int)
+	}
+	if total.IsZero() {
+		return nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, total))
+	if err := k.SendCoinsFromModuleToModule(ctx, senderModule, types.AlloraAccruedRewardsAccountName, coins); err != nil {
+		return errors.Wrap(err, "error sending rewards to accrued rewards account")
+	}
+	for _, reward := range rewards {
+		if err := k.creditAccruedReward(ctx, reward.TopicId, reward.ActorId, reward.Int); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Adds to the rewards accrued by an actor in a topic and to the total accrued rewards, without moving coins
+func (k *Keeper) creditAccruedReward(ctx context.Context, topicId TopicId, actor ActorId, amount cosmosMath.Int) error {
+	if amount.IsNegative() {
+		return errors.Wrapf(types.ErrInvalidReward, "reward cannot be negative: %s", amount.String())
+	}
+	if amount.IsZero() {
+		return nil
+	}
+	accrued, err := k.GetAccruedReward(ctx, topicId, actor)
+	if err != nil {
+		return err
+	}
+	if err := k.accruedRewards.Set(ctx, collections.Join(actor, topicId), accrued.Add(amount)); err != nil {
+		return errors.Wrap(err, "error setting accrued reward")
+	}
+	total, err := k.GetTotalAccruedRewards(ctx)
+	if err != nil {
+		return err
+	}
+	return k.totalAccruedRewards.Set(ctx, total.Add(amount))
+}
+
+// Pays an actor out all of its accrued rewards, across all topics, and clears its ledger. Returns the amount claimed.
+func (k *Keeper) ClaimRewards(ctx context.Context, actor ActorId) (cosmosMath.Int, error) {
+	accruedRewards, claimed, err := k.GetAccruedRewards(ctx, actor)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	if claimed.IsZero() {
+		return cosmosMath.Int{}, errors.Wrapf(types.ErrNoRewardsToClaim, "address %s", actor)
+	}
+
+	for _, accrued := range accruedRewards {
+		if err := k.accruedRewards.Remove(ctx, collections.Join(actor, accrued.TopicId)); err != nil {
+			return cosmosMath.Int{}, errors.Wrap(err, "error removing accrued reward")
+		}
+	}
+	total, err := k.GetTotalAccruedRewards(ctx)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	if claimed.GT(total) {
+		return cosmosMath.Int{}, errors.Wrapf(types.ErrInvalidReward, "claimed %s exceeds total accrued rewards %s", claimed, total)
+	}
+	if err := k.totalAccruedRewards.Set(ctx, total.Sub(claimed)); err != nil {
+		return cosmosMath.Int{}, errors.Wrap(err, "error setting total accrued rewards")
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, claimed))
+	if err := k.SendCoinsFromModuleToAccount(ctx, types.AlloraAccruedRewardsAccountName, actor, coins); err != nil {
+		return cosmosMath.Int{}, errors.Wrap(err, "error sending claimed rewards")
+	}
+	return claimed, nil
+}
+
+// Returns the rewards accrued by an actor in a topic, not claimed yet
+func (k *Keeper) GetAccruedReward(ctx context.Context, topicId TopicId, actor ActorId) (cosmosMath.Int, error) {
+	accrued, err := k.accruedRewards.Get(ctx, collections.Join(actor, topicId))
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return cosmosMath.ZeroInt(), nil
+	}
+	return accrued, err
+}
+
+// Returns the rewards accrued by an actor in each topic, in topic order, and their sum
+func (k *Keeper) GetAccruedRewards(ctx context.Context, actor ActorId) ([]types.AccruedReward, cosmosMath.Int, error) {
+	iter, err := k.accruedRewards.Iterate(ctx, collections.NewPrefixedPairRange[ActorId, TopicId](actor))
+	if err != nil {
+		return nil, cosmosMath.Int{}, errors.Wrap(err, "error iterating accrued rewards")
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return nil, cosmosMath.Int{}, errors.Wrap(err, "error getting accrued rewards")
+	}
+	accruedRewards := make([]types.AccruedReward, len(kvs))
+	total := cosmosMath.ZeroInt()
+	for i, kv := range kvs {
+		accruedRewards[i] = types.AccruedReward{TopicId: kv.Key.K2(), Amount: kv.Value}
+		total = total.Add(kv.Value)
+	}
+	return accruedRewards, total, nil
+}
+
+// Returns the sum of the rewards accrued by all actors, not claimed yet
+func (k *Keeper) GetTotalAccruedRewards(ctx context.Context) (cosmosMath.Int, error) {
+	total, err := k.totalAccruedRewards.Get(ctx)
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return cosmosMath.ZeroInt(), nil
+	}
+	return total, err
+}
+
+// Returns the rewards accrued by every actor in every topic
+func (k *Keeper) GetAllAccruedRewards(ctx context.Context) ([]*types.TopicIdActorIdInt, error) {
+	iter, err := k.accruedRewards.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error iterating accrued rewards")
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting accrued rewards")
+	}
+	accruedRewards := make([]*types.TopicIdActorIdInt, len(kvs))
+	for i, kv := range kvs {
+		accruedRewards[i] = &types.TopicIdActorIdInt{TopicId: kv.Key.K2(), ActorId: kv.Key.K1(), Int: kv.Value}
+	}
+	return accruedRewards, nil
+}
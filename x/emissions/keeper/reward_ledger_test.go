@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestAccrueAndClaimRewards() {
+	ctx := s.ctx
+	k := s.emissionsKeeper
+	require := s.Require()
+	actor := s.addrsStr[0]
+	otherActor := s.addrsStr[1]
+
+	rewardCoins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, cosmosMath.NewInt(100)))
+	require.NoError(s.bankKeeper.MintCoins(ctx, types.AlloraRewardsAccountName, rewardCoins))
+	require.NoError(k.AccrueRewards(ctx, types.AlloraRewardsAccountName, []types.TopicIdActorIdInt{
+		{TopicId: 1, ActorId: actor, Int: cosmosMath.NewInt(30)},
+		{TopicId: 2, ActorId: actor, Int: cosmosMath.NewInt(20)},
+		{TopicId: 1, ActorId: otherActor, Int: cosmosMath.NewInt(15)},
+	}))
+	require.NoError(k.AccrueRewards(ctx, types.AlloraRewardsAccountName, []types.TopicIdActorIdInt{
+		{TopicId: 1, ActorId: actor, Int: cosmosMath.NewInt(5)},
+	}))
+
+	accruedRewards, accrued, err := k.GetAccruedRewards(ctx, actor)
+	require.NoError(err)
+	require.Equal([]types.AccruedReward{
+		{TopicId: 1, Amount: cosmosMath.NewInt(35)},
+		{TopicId: 2, Amount: cosmosMath.NewInt(20)},
+	}, accruedRewards)
+	require.Equal(cosmosMath.NewInt(55), accrued)
+	total, err := k.GetTotalAccruedRewards(ctx)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(70), total)
+	_, broken := keeper.RewardsInvariantAccruedRewardsEqualAccruedRewardsBankBalance(k)(ctx)
+	require.False(broken)
+
+	// Claiming pays out every topic at once
+	balanceBefore := s.bankKeeper.GetBalance(ctx, s.addrs[0], params.DefaultBondDenom)
+	claimed, err := k.ClaimRewards(ctx, actor)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(55), claimed)
+	balanceAfter := s.bankKeeper.GetBalance(ctx, s.addrs[0], params.DefaultBondDenom)
+	require.Equal(balanceBefore.Amount.Add(claimed), balanceAfter.Amount)
+
+	accruedRewards, _, err = k.GetAccruedRewards(ctx, actor)
+	require.NoError(err)
+	require.Empty(accruedRewards)
+	total, err = k.GetTotalAccruedRewards(ctx)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(15), total)
+	_, broken = keeper.RewardsInvariantAccruedRewardsEqualAccruedRewardsBankBalance(k)(ctx)
+	require.False(broken)
+
+	_, err = k.ClaimRewards(ctx, actor)
+	require.ErrorIs(err, types.ErrNoRewardsToClaim)
+}
+
+func (s *KeeperTestSuite) TestAccrueRewardsRejectsNegativeRewards() {
+	err := s.emissionsKeeper.AccrueRewards(s.ctx, types.AlloraRewardsAccountName, []types.TopicIdActorIdInt{
+		{TopicId: 1, ActorId: s.addrsStr[0], Int: cosmosMath.NewInt(-1)},
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidReward)
+}
@@ -156,6 +156,7 @@ func (k *Keeper) inactivateTopicWithoutMinWeightReset(ctx context.Context, topic
 	if err != nil {
 		return errors.Wrap(err, "failed to remove active topics")
 	}
+	types.EmitNewTopicActivationChangedEvent(sdk.UnwrapSDKContext(ctx), topicId, false)
 
 	// Remove previous weight from total sum of previous topic weights, but keep on list
 	totalSumPreviousTopicWeights, err := k.totalSumPreviousTopicWeights.Get(ctx)
@@ -270,6 +271,13 @@ func (k *Keeper) ActivateTopic(ctx context.Context, topicId TopicId) error {
 		return nil
 	}
 
+	// An update may have been scheduled while the topic was active and then left behind
+	// when the topic was inactivated. Apply it now so the new epoch uses the new parameters.
+	err = k.ApplyScheduledTopicUpdate(ctx, topicId)
+	if err != nil {
+		return errors.Wrap(err, "failed to apply scheduled topic update")
+	}
+
 	topic, err := k.GetTopic(ctx, topicId)
 	if err != nil {
 		return errors.Wrap(err, "failed to get topic")
@@ -288,6 +296,7 @@ func (k *Keeper) ActivateTopic(ctx context.Context, topicId TopicId) error {
 	if err != nil {
 		return errors.Wrap(err, "failed to set active topics")
 	}
+	types.EmitNewTopicActivationChangedEvent(sdkCtx, topicId, true)
 
 	sdkCtx.Logger().Info(fmt.Sprintf("Topic %d activated at block %d", topicId, currentBlock))
 	// This topic was activated, so we need to add its previous weight if any to the total sum of previous topic weights
@@ -399,3 +408,122 @@ func (k *Keeper) activateTopicAndResetLowestWeightAtBlock(ctx context.Context, t
 
 	return nil
 }
+
+// Schedules an update of the mutable parameters of a topic and returns the block at which it takes effect.
+// An inactive topic has no running epoch, so the update is applied immediately.
+// An active topic receives the update at its next possible churning block, i.e. the end of its current epoch,
+// merged onto any update already scheduled for it. The merged result is validated against the current topic.
+func (k *Keeper) ScheduleTopicUpdate(ctx context.Context, topicId TopicId, newParams types.OptionalTopicParams) (BlockHeight, error) {
+	currentBlock := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return RESERVED_BLOCK, errors.Wrap(err, "failed to get params")
+	}
+	topic, err := k.GetTopic(ctx, topicId)
+	if err != nil {
+		return RESERVED_BLOCK, errors.Wrap(err, "failed to get topic")
+	}
+
+	update, found, err := k.GetScheduledTopicUpdate(ctx, topicId)
+	if err != nil {
+		return RESERVED_BLOCK, errors.Wrap(err, "failed to get scheduled topic update")
+	}
+	mergedParams := newParams
+	if found {
+		mergedParams = update.Params.Merge(newParams)
+	}
+	if err := mergedParams.ApplyTo(topic).Validate(moduleParams); err != nil {
+		return RESERVED_BLOCK, errors.Wrap(err, "updated topic is invalid")
+	}
+
+	churningBlock, topicIsActive, err := k.GetNextPossibleChurningBlockByTopicId(ctx, topicId)
+	if err != nil {
+		return RESERVED_BLOCK, errors.Wrap(err, "failed to get next possible churning block by topic id")
+	}
+	effectiveBlock := currentBlock
+	if topicIsActive {
+		effectiveBlock = churningBlock
+	}
+	err = k.SetScheduledTopicUpdate(ctx, types.ScheduledTopicUpdate{
+		TopicId:              topicId,
+		EffectiveBlockHeight: effectiveBlock,
+		Params:               &mergedParams,
+	})
+	if err != nil {
+		return RESERVED_BLOCK, errors.Wrap(err, "failed to set scheduled topic update")
+	}
+
+	if !topicIsActive {
+		err = k.ApplyScheduledTopicUpdate(ctx, topicId)
+		if err != nil {
+			return RESERVED_BLOCK, errors.Wrap(err, "failed to apply topic update")
+		}
+	}
+	return effectiveBlock, nil
+}
+
+// Applies the update scheduled for a topic, if any, and removes it from the schedule.
+// The update is dropped, with an event, if the updated topic no longer validates, e.g. because module params changed
+// in the meantime. Any other error is returned.
+func (k *Keeper) ApplyScheduledTopicUpdate(ctx context.Context, topicId TopicId) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	update, found, err := k.GetScheduledTopicUpdate(ctx, topicId)
+	if err != nil {
+		return errors.Wrap(err, "failed to get scheduled topic update")
+	}
+	if !found {
+		return nil
+	}
+	err = k.RemoveScheduledTopicUpdate(ctx, topicId)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove scheduled topic update")
+	}
+
+	topic, err := k.GetTopic(ctx, topicId)
+	if err != nil {
+		return errors.Wrap(err, "failed to get topic")
+	}
+	updatedTopic := update.Params.ApplyTo(topic)
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get params")
+	}
+	if err := updatedTopic.Validate(params); err != nil {
+		sdkCtx.Logger().Warn(fmt.Sprintf("Dropping invalid scheduled update for topic %d: %s", topicId, err.Error()))
+		types.EmitNewScheduledTopicUpdateDroppedEvent(sdkCtx, sdkCtx.BlockHeight(), update, err.Error())
+		return nil
+	}
+	err = k.SetTopic(ctx, topicId, updatedTopic)
+	if err != nil {
+		return errors.Wrap(err, "failed to set updated topic")
+	}
+
+	sdkCtx.Logger().Info(fmt.Sprintf("Topic %d updated at block %d", topicId, sdkCtx.BlockHeight()))
+	types.EmitNewTopicUpdatedEvent(sdkCtx, sdkCtx.BlockHeight(), updatedTopic)
+	return nil
+}
+
+// Applies the updates scheduled for the topics whose epoch ends at this block.
+// Must run before the topics are reactivated for their next epoch, so that the new epoch length
+// determines the next churning block and the new worker submission window determines when
+// the next worker window closes.
+func (k *Keeper) ApplyScheduledTopicUpdatesAtBlock(ctx context.Context, block BlockHeight) error {
+	activeTopicIds, err := k.GetActiveTopicIdsAtBlock(ctx, block)
+	if err != nil {
+		return errors.Wrap(err, "failed to get active topic ids at block")
+	}
+	for _, topicId := range activeTopicIds.TopicIds {
+		update, found, err := k.GetScheduledTopicUpdate(ctx, topicId)
+		if err != nil {
+			return errors.Wrap(err, "failed to get scheduled topic update")
+		}
+		if !found || update.EffectiveBlockHeight > block {
+			continue
+		}
+		err = k.ApplyScheduledTopicUpdate(ctx, topicId)
+		if err != nil {
+			return errors.Wrap(err, "failed to apply scheduled topic update")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Returns the data sending fee charged for each payload sent to a topic.
+// The fee of a topic never goes below the data sending fee param, which is charged until the topic's fee first rises.
+func (k *Keeper) GetTopicDataSendingFee(ctx context.Context, topicId TopicId) (cosmosMath.Int, error) {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	fee, err := k.topicDataSendingFee.Get(ctx, topicId)
+	if errors.IsOf(err, collections.ErrNotFound) || (err == nil && fee.LT(moduleParams.DataSendingFee)) {
+		return moduleParams.DataSendingFee, nil
+	} else if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	return fee, nil
+}
+
+func (k *Keeper) SetTopicDataSendingFee(ctx context.Context, topicId TopicId, fee cosmosMath.Int) error {
+	if fee.IsNil() || fee.IsNegative() {
+		return errors.Wrapf(types.ErrInvalidValue, "data sending fee cannot be negative")
+	}
+	return k.topicDataSendingFee.Set(ctx, topicId, fee)
+}
+
+// Counts a payload whose data sending fee was paid towards the demand of the topic's current worker nonce
+func (k *Keeper) AddTopicPayloadReceived(ctx context.Context, topicId TopicId) error {
+	received, err := k.GetTopicPayloadsReceived(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	return k.topicPayloadsReceived.Set(ctx, topicId, received+1)
+}
+
+// Returns the payloads paid for in a topic since its last worker nonce closed
+func (k *Keeper) GetTopicPayloadsReceived(ctx context.Context, topicId TopicId) (uint64, error) {
+	received, err := k.topicPayloadsReceived.Get(ctx, topicId)
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	return received, nil
+}
+
+// Adjusts the data sending fee of a topic to the payloads it received during a worker nonce, then starts counting anew.
+// Like EIP-1559, the fee rises when the payloads exceed the target, DataSendingFeeTargetRatio of the inferers,
+// forecasters and reputers rewarded per nonce, and decays when they fall short of it, by at most DataSendingFeeMaxChangeRate.
+// The fee never decays below the data sending fee param.
+func (k *Keeper) UpdateTopicDataSendingFee(ctx context.Context, topicId TopicId) error {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	submissions, err := k.GetTopicPayloadsReceived(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	err = k.topicPayloadsReceived.Remove(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	fee, err := k.GetTopicDataSendingFee(ctx, topicId)
+	if err != nil {
+		return err
+	}
+
+	delta, err := dataSendingFeeDelta(moduleParams, submissions)
+	if err != nil {
+		return err
+	}
+	change, err := moduleParams.DataSendingFeeMaxChangeRate.Mul(delta)
+	if err != nil {
+		return err
+	}
+	factor, err := alloraMath.OneDec().Add(change)
+	if err != nil {
+		return err
+	}
+	feeDec, err := alloraMath.NewDecFromSdkInt(fee)
+	if err != nil {
+		return err
+	}
+	newFeeDec, err := feeDec.Mul(factor)
+	if err != nil {
+		return err
+	}
+	newFee, err := newFeeDec.SdkIntTrim()
+	if err != nil {
+		return err
+	}
+	// A rising fee moves by at least one unit so that small fees are not stuck by truncation
+	if change.IsPositive() && newFee.LTE(fee) {
+		newFee = fee.AddRaw(1)
+	}
+	if newFee.LT(moduleParams.DataSendingFee) {
+		newFee = moduleParams.DataSendingFee
+	}
+	return k.SetTopicDataSendingFee(ctx, topicId, newFee)
+}
+
+// Returns how far the payloads of a nonce are from the target, relative to the target and clamped to [-1, 1]
+func dataSendingFeeDelta(moduleParams types.Params, submissions uint64) (alloraMath.Dec, error) {
+	maxRewarded, err := alloraMath.NewDecFromUint64(
+		moduleParams.MaxTopInferersToReward + moduleParams.MaxTopForecastersToReward + moduleParams.MaxTopReputersToReward,
+	)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	target, err := moduleParams.DataSendingFeeTargetRatio.Mul(maxRewarded)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	submitted, err := alloraMath.NewDecFromUint64(submissions)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	if target.IsZero() {
+		if submitted.IsZero() {
+			return alloraMath.ZeroDec(), nil
+		}
+		return alloraMath.OneDec(), nil
+	}
+	excess, err := submitted.Sub(target)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	delta, err := excess.Quo(target)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	if delta.Gt(alloraMath.OneDec()) {
+		return alloraMath.OneDec(), nil
+	}
+	return delta, nil
+}
+
+// Returns the data sending fee stored for every topic
+func (k *Keeper) GetAllTopicDataSendingFees(ctx context.Context) ([]*types.TopicIdAndInt, error) {
+	iter, err := k.topicDataSendingFee.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error iterating topic data sending fees")
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting topic data sending fees")
+	}
+	fees := make([]*types.TopicIdAndInt, len(kvs))
+	for i, kv := range kvs {
+		fees[i] = &types.TopicIdAndInt{TopicId: kv.Key, Int: kv.Value}
+	}
+	return fees, nil
+}
+
+// Returns the payloads received by every topic since its last worker nonce closed
+func (k *Keeper) GetAllTopicPayloadsReceived(ctx context.Context) ([]*types.TopicIdAndUint64, error) {
+	iter, err := k.topicPayloadsReceived.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error iterating topic payloads received")
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting topic payloads received")
+	}
+	received := make([]*types.TopicIdAndUint64, len(kvs))
+	for i, kv := range kvs {
+		received[i] = &types.TopicIdAndUint64{TopicId: kv.Key, Uint64: kv.Value}
+	}
+	return received, nil
+}
@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Counts the given number of paid payloads in the topic, then closes its worker nonce
+func (s *KeeperTestSuite) closeNonceWithPayloads(topicId uint64, payloads uint64) {
+	for i := uint64(0); i < payloads; i++ {
+		s.Require().NoError(s.emissionsKeeper.AddTopicPayloadReceived(s.ctx, topicId))
+	}
+	s.Require().NoError(s.emissionsKeeper.UpdateTopicDataSendingFee(s.ctx, topicId))
+}
+
+func (s *KeeperTestSuite) TestUpdateTopicDataSendingFee() {
+	ctx := s.ctx
+	k := s.emissionsKeeper
+	require := s.Require()
+	topicId := uint64(1)
+
+	moduleParams, err := k.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.DataSendingFee = cosmosMath.NewInt(100)
+	moduleParams.DataSendingFeeMaxChangeRate = alloraMath.MustNewDecFromString("0.125")
+	moduleParams.DataSendingFeeTargetRatio = alloraMath.MustNewDecFromString("0.5")
+	moduleParams.MaxTopInferersToReward = 4
+	moduleParams.MaxTopForecastersToReward = 2
+	moduleParams.MaxTopReputersToReward = 2
+	require.NoError(k.SetParams(ctx, moduleParams))
+
+	// Topics start at the data sending fee param
+	fee, err := k.GetTopicDataSendingFee(ctx, topicId)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(100), fee)
+
+	// 6 payloads against a target of 4 raise the fee by half the max change rate
+	s.closeNonceWithPayloads(topicId, 6)
+	fee, err = k.GetTopicDataSendingFee(ctx, topicId)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(106), fee)
+
+	// The rise is capped at the max change rate however many payloads exceed the target
+	s.closeNonceWithPayloads(topicId, 100)
+	fee, err = k.GetTopicDataSendingFee(ctx, topicId)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(119), fee)
+
+	// Payloads on target leave the fee unchanged
+	s.closeNonceWithPayloads(topicId, 4)
+	fee, err = k.GetTopicDataSendingFee(ctx, topicId)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(119), fee)
+
+	// A nonce without payloads decays the fee by the max change rate
+	s.closeNonceWithPayloads(topicId, 0)
+	fee, err = k.GetTopicDataSendingFee(ctx, topicId)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(104), fee)
+
+	// The fee never decays below the data sending fee param
+	s.closeNonceWithPayloads(topicId, 0)
+	fee, err = k.GetTopicDataSendingFee(ctx, topicId)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(100), fee)
+
+	// Other topics are unaffected
+	fee, err = k.GetTopicDataSendingFee(ctx, topicId+1)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(100), fee)
+}
+
+func (s *KeeperTestSuite) TestUpdateTopicDataSendingFeeDisabledByZeroChangeRate() {
+	ctx := s.ctx
+	k := s.emissionsKeeper
+	require := s.Require()
+	topicId := uint64(1)
+
+	moduleParams, err := k.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.DataSendingFeeMaxChangeRate = alloraMath.ZeroDec()
+	require.NoError(k.SetParams(ctx, moduleParams))
+
+	s.closeNonceWithPayloads(topicId, moduleParams.MaxTopInferersToReward*10)
+	fee, err := k.GetTopicDataSendingFee(ctx, topicId)
+	require.NoError(err)
+	require.Equal(moduleParams.DataSendingFee, fee)
+}
+
+func (s *KeeperTestSuite) TestUpdateTopicDataSendingFeeRisesSmallFees() {
+	ctx := s.ctx
+	k := s.emissionsKeeper
+	require := s.Require()
+	topicId := uint64(1)
+
+	moduleParams, err := k.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.DataSendingFee = cosmosMath.NewInt(1)
+	moduleParams.DataSendingFeeMaxChangeRate = alloraMath.MustNewDecFromString("0.125")
+	require.NoError(k.SetParams(ctx, moduleParams))
+
+	// 12.5% of 1 truncates to nothing, yet the fee still rises
+	s.closeNonceWithPayloads(topicId, moduleParams.MaxTopInferersToReward*2)
+	fee, err := k.GetTopicDataSendingFee(ctx, topicId)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(2), fee)
+}
+
+func (s *KeeperTestSuite) TestUpdateTopicDataSendingFeeWithDefaultParams() {
+	ctx := s.ctx
+	k := s.emissionsKeeper
+	require := s.Require()
+	topicId := uint64(1)
+
+	moduleParams, err := k.GetParams(ctx)
+	require.NoError(err)
+	require.Equal(types.DefaultParams(), moduleParams)
+	target := moduleParams.MaxTopInferersToReward + moduleParams.MaxTopForecastersToReward + moduleParams.MaxTopReputersToReward
+
+	// Payloads beyond the inferers, forecasters and reputers rewarded per nonce raise the fee
+	s.closeNonceWithPayloads(topicId, target*2)
+	fee, err := k.GetTopicDataSendingFee(ctx, topicId)
+	require.NoError(err)
+	require.True(fee.GT(moduleParams.DataSendingFee), "fee %s should rise above %s", fee, moduleParams.DataSendingFee)
+	raisedFee := fee
+
+	// The count starts anew with every nonce
+	received, err := k.GetTopicPayloadsReceived(ctx, topicId)
+	require.NoError(err)
+	require.Zero(received)
+
+	// A quiet nonce decays the fee again
+	s.closeNonceWithPayloads(topicId, target/2)
+	fee, err = k.GetTopicDataSendingFee(ctx, topicId)
+	require.NoError(err)
+	require.True(fee.LT(raisedFee), "fee %s should decay below %s", fee, raisedFee)
+}
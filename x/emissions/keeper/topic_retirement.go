@@ -0,0 +1,418 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/utils/migutils"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Max number of topic-scoped keys deleted per block across all retiring topics
+const MAX_TOPIC_RETIREMENT_KEYS_DELETED_PER_BLOCK = uint64(1000)
+
+// Stores whose keys start with the topic id and that are deleted once a retired topic has been refunded.
+// Stake accounting and stake removal queues are not listed: they empty themselves as the refunds are paid out.
+var topicScopedStorePrefixes = []collections.Prefix{
+	types.ActiveTopicsKey,
+	types.TopicToNextPossibleChurningBlockKey,
+	types.RewardableTopicsKey,
+	types.TopicWorkersKey,
+	types.TopicReputersKey,
+	types.TopicRewardNonceKey,
+	types.ActiveInferersKey,
+	types.ActiveForecastersKey,
+	types.ActiveReputersKey,
+	types.LowestInfererScoreEmaKey,
+	types.LowestForecasterScoreEmaKey,
+	types.LowestReputerScoreEmaKey,
+	types.InferenceScoresKey,
+	types.ForecastScoresKey,
+	types.ReputerScoresKey,
+	types.InfererScoreEmasKey,
+	types.ForecasterScoreEmasKey,
+	types.ReputerScoreEmasKey,
+	types.ReputerListeningCoefficientKey,
+	types.PreviousReputerRewardFractionKey,
+	types.PreviousInferenceRewardFractionKey,
+	types.PreviousForecastRewardFractionKey,
+	types.PreviousForecasterScoreRatioKey,
+	types.PreviousTopicQuantileInfererScoreEmaKey,
+	types.PreviousTopicQuantileForecasterScoreEmaKey,
+	types.PreviousTopicQuantileReputerScoreEmaKey,
+	types.DelegateRewardPerShare,
+	types.InferencesKey,
+	types.ForecastsKey,
+	types.LossBundlesKey,
+	types.TopicFeeRevenueKey,
+	types.PreviousTopicWeightKey,
+	types.AllInferencesKey,
+	types.AllForecastsKey,
+	types.AllLossBundlesKey,
+	types.NetworkLossBundlesKey,
+	types.UnfulfilledWorkerNoncesKey,
+	types.UnfulfilledReputerNoncesKey,
+	types.LastDripBlockKey,
+	types.InfererNetworkRegretsKey,
+	types.ForecasterNetworkRegretsKey,
+	types.OneInForecasterNetworkRegretsKey,
+	types.LatestNaiveInfererNetworkRegretsKey,
+	types.LatestOneOutInfererInfererNetworkRegretsKey,
+	types.LatestOneOutInfererForecasterNetworkRegretsKey,
+	types.LatestOneOutForecasterInfererNetworkRegretsKey,
+	types.LatestOneOutForecasterForecasterNetworkRegretsKey,
+	types.CountInfererInclusionsInTopicKey,
+	types.CountForecasterInclusionsInTopicKey,
+	types.TopicLastWorkerCommitKey,
+	types.TopicLastReputerCommitKey,
+	types.TopicWorkerAllowlistEnabledKey,
+	types.TopicReputerAllowlistEnabledKey,
+	types.TopicWorkerAllowlistKey,
+	types.TopicReputerAllowlistKey,
+	types.ReputerSlashingInfoKey,
+	types.WorkerCommitmentsKey,
+	types.GroundTruthsKey,
+	types.NetworkInferenceArchiveKey,
+	types.TopicSponsorshipsKey,
+	types.SponsoredActorFeesKey,
+	types.AutoCompoundingDelegationsKey,
+	types.TopicDataSendingFeeKey,
+	types.TopicPayloadsReceivedKey,
+}
+
+// Retires a topic. The topic is inactivated and moved from the topics map into the retirement archive,
+// so every message addressed to it is rejected from now on.
+// Its stake is refunded and its state deleted over the following blocks by ProcessTopicRetirements.
+func (k *Keeper) RetireTopic(ctx context.Context, topicId TopicId, retiredBy string) (types.TopicRetirement, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	topic, err := k.GetTopic(ctx, topicId)
+	if err != nil {
+		return types.TopicRetirement{}, errors.Wrap(err, "failed to get topic")
+	}
+
+	err = k.InactivateTopic(ctx, topicId)
+	if err != nil {
+		return types.TopicRetirement{}, errors.Wrap(err, "failed to inactivate topic")
+	}
+	err = k.RemoveScheduledTopicUpdate(ctx, topicId)
+	if err != nil {
+		return types.TopicRetirement{}, errors.Wrap(err, "failed to remove scheduled topic update")
+	}
+	err = k.CancelTopicGmpSubscriptions(ctx, topicId)
+	if err != nil {
+		return types.TopicRetirement{}, errors.Wrap(err, "failed to cancel topic gmp subscriptions")
+	}
+	err = k.RefundTopicSponsorships(ctx, topicId)
+	if err != nil {
+		return types.TopicRetirement{}, errors.Wrap(err, "failed to refund topic sponsorships")
+	}
+	err = k.topics.Remove(ctx, topicId)
+	if err != nil {
+		return types.TopicRetirement{}, errors.Wrap(err, "failed to remove topic")
+	}
+
+	retirement := types.TopicRetirement{
+		Topic:          &topic,
+		RetiredBy:      retiredBy,
+		RetiredAtBlock: sdkCtx.BlockHeight(),
+		Stage:          types.TopicRetirementStage_TOPIC_RETIREMENT_STAGE_REFUNDING_STAKE,
+	}
+	err = k.SetTopicRetirement(ctx, retirement)
+	if err != nil {
+		return types.TopicRetirement{}, errors.Wrap(err, "failed to set topic retirement")
+	}
+	types.EmitNewTopicRetirementProgressEvent(sdkCtx, sdkCtx.BlockHeight(), retirement)
+	sdkCtx.Logger().Info(fmt.Sprintf("Topic %d retired at block %d", topicId, sdkCtx.BlockHeight()))
+	return retirement, nil
+}
+
+// Advances the garbage collection of every retiring topic by a bounded amount of work.
+// Per block at most half of `HalfMaxProcessStakeRemovalsEndBlock` reputer and delegate stake removals are queued,
+// so refunds never crowd user stake removals out of the queue,
+// and at most MAX_TOPIC_RETIREMENT_KEYS_DELETED_PER_BLOCK topic-scoped keys are deleted.
+// The work on each topic is written only if it succeeds, a failing topic is retried next block.
+func (k *Keeper) ProcessTopicRetirements(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get params")
+	}
+	topicIds, err := k.GetRetiringTopicIds(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get retiring topic ids")
+	}
+
+	budgets := topicRetirementBudgets{
+		reputerRefunds:   max(moduleParams.HalfMaxProcessStakeRemovalsEndBlock/2, 1),
+		delegatorRefunds: max(moduleParams.HalfMaxProcessStakeRemovalsEndBlock/2, 1),
+		keys:             MAX_TOPIC_RETIREMENT_KEYS_DELETED_PER_BLOCK,
+	}
+	for _, topicId := range topicIds {
+		// attempt writes in a cache context, only write finally if there are no errors
+		cacheSdkCtx, write := sdkCtx.CacheContext()
+		remainingBudgets := budgets
+		err = k.processTopicRetirement(cacheSdkCtx, topicId, moduleParams.RemoveStakeDelayWindow, &remainingBudgets)
+		if err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf("Error processing retirement of topic %d: %v", topicId, err))
+			continue
+		}
+		write()
+		budgets = remainingBudgets
+	}
+	return nil
+}
+
+// The work left to the retiring topics in the current block
+type topicRetirementBudgets struct {
+	reputerRefunds   uint64
+	delegatorRefunds uint64
+	keys             uint64
+}
+
+// Advances the retirement of a topic by one stage at most, drawing from the budgets
+func (k *Keeper) processTopicRetirement(
+	sdkCtx sdk.Context,
+	topicId TopicId,
+	removeStakeDelayWindow BlockHeight,
+	budgets *topicRetirementBudgets,
+) error {
+	retirement, found, err := k.GetTopicRetirement(sdkCtx, topicId)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get retirement of topic %d", topicId)
+	}
+	if !found {
+		return nil
+	}
+	stage := retirement.Stage
+
+	switch retirement.Stage {
+	case types.TopicRetirementStage_TOPIC_RETIREMENT_STAGE_REFUNDING_STAKE:
+		err = k.queueTopicRetirementRefunds(sdkCtx, &retirement, removeStakeDelayWindow, &budgets.reputerRefunds, &budgets.delegatorRefunds)
+	case types.TopicRetirementStage_TOPIC_RETIREMENT_STAGE_AWAITING_REFUNDS:
+		err = k.checkTopicRetirementRefunds(sdkCtx, &retirement)
+	case types.TopicRetirementStage_TOPIC_RETIREMENT_STAGE_CLEARING_STATE:
+		err = k.clearTopicRetirementState(sdkCtx, &retirement, &budgets.keys)
+	default:
+		err = errors.Wrapf(types.ErrInvariantFailure, "unexpected retirement stage %s", retirement.Stage)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to process retirement of topic %d", topicId)
+	}
+
+	err = k.SetTopicRetirement(sdkCtx, retirement)
+	if err != nil {
+		return errors.Wrapf(err, "failed to set retirement of topic %d", topicId)
+	}
+	if retirement.Stage != stage {
+		types.EmitNewTopicRetirementProgressEvent(sdkCtx, sdkCtx.BlockHeight(), retirement)
+	}
+	return nil
+}
+
+// Queues stake removals returning the own stake of every reputer, then the stake of every delegator, of a retired topic.
+// Each budget bounds the number of stakes visited; when one is exhausted the work resumes from the stored cursors next block.
+// Any stake removal already queued by the same staker for the topic is replaced by the full refund.
+func (k *Keeper) queueTopicRetirementRefunds(
+	ctx context.Context,
+	retirement *types.TopicRetirement,
+	removeStakeDelayWindow BlockHeight,
+	reputerBudget *uint64,
+	delegatorBudget *uint64,
+) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	topicId := retirement.Topic.Id
+	currentBlock := sdkCtx.BlockHeight()
+	completionBlock := currentBlock + removeStakeDelayWindow
+
+	if !retirement.ReputerRefundsQueued {
+		rng := &collections.Range[collections.Pair[TopicId, ActorId]]{}
+		rng = rng.Prefix(collections.PairPrefix[TopicId, ActorId](topicId))
+		if retirement.LastRefundedReputer != "" {
+			rng = rng.StartExclusive(collections.Join(topicId, retirement.LastRefundedReputer))
+		}
+		stakes, more, err := collectPage(ctx, k.stakeReputerAuthority, rng, *reputerBudget)
+		if err != nil {
+			return errors.Wrap(err, "failed to get reputer stakes")
+		}
+		for _, stake := range stakes {
+			reputer := stake.Key.K2()
+			delegateStakeUponReputer, err := k.GetDelegateStakeUponReputer(ctx, topicId, reputer)
+			if err != nil {
+				return errors.Wrap(err, "failed to get delegate stake upon reputer")
+			}
+			amount := stake.Value.Sub(delegateStakeUponReputer)
+			if amount.IsPositive() {
+				existing, found, err := k.GetStakeRemovalForReputerAndTopicId(sdkCtx, reputer, topicId)
+				if err != nil {
+					return errors.Wrap(err, "failed to get existing stake removal")
+				}
+				if found {
+					err = k.DeleteStakeRemoval(ctx, existing.BlockRemovalCompleted, topicId, reputer)
+					if err != nil {
+						return errors.Wrap(err, "failed to delete existing stake removal")
+					}
+				}
+				err = k.SetStakeRemoval(ctx, types.StakeRemovalInfo{
+					BlockRemovalStarted:   currentBlock,
+					BlockRemovalCompleted: completionBlock,
+					TopicId:               topicId,
+					Reputer:               reputer,
+					Amount:                amount,
+				})
+				if err != nil {
+					return errors.Wrap(err, "failed to queue reputer refund")
+				}
+				retirement.RefundsCompleteBlock = completionBlock
+			}
+			retirement.LastRefundedReputer = reputer
+		}
+		*reputerBudget -= uint64(len(stakes))
+		if more {
+			return nil
+		}
+		retirement.ReputerRefundsQueued = true
+	}
+
+	rng := &collections.Range[collections.Triple[TopicId, ActorId, ActorId]]{}
+	rng = rng.Prefix(collections.TriplePrefix[TopicId, ActorId, ActorId](topicId))
+	if retirement.LastRefundedDelegator != "" {
+		rng = rng.StartExclusive(collections.Join3(topicId, retirement.LastRefundedDelegator, retirement.LastRefundedDelegatorReputer))
+	}
+	stakes, more, err := collectPage(ctx, k.delegatedStakes, rng, *delegatorBudget)
+	if err != nil {
+		return errors.Wrap(err, "failed to get delegated stakes")
+	}
+	for _, stake := range stakes {
+		delegator := stake.Key.K2()
+		reputer := stake.Key.K3()
+		amount, err := stake.Value.Amount.SdkIntTrim()
+		if err != nil {
+			return errors.Wrap(err, "failed to trim delegated stake")
+		}
+		if amount.IsPositive() {
+			existing, found, err := k.GetDelegateStakeRemovalForDelegatorReputerAndTopicId(sdkCtx, delegator, reputer, topicId)
+			if err != nil {
+				return errors.Wrap(err, "failed to get existing delegate stake removal")
+			}
+			if found {
+				err = k.DeleteDelegateStakeRemoval(ctx, existing.BlockRemovalCompleted, topicId, reputer, delegator)
+				if err != nil {
+					return errors.Wrap(err, "failed to delete existing delegate stake removal")
+				}
+			}
+			err = k.SetDelegateStakeRemoval(ctx, types.DelegateStakeRemovalInfo{
+				BlockRemovalStarted:   currentBlock,
+				BlockRemovalCompleted: completionBlock,
+				TopicId:               topicId,
+				Delegator:             delegator,
+				Reputer:               reputer,
+				Amount:                amount,
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to queue delegator refund")
+			}
+			retirement.RefundsCompleteBlock = completionBlock
+		}
+		retirement.LastRefundedDelegator = delegator
+		retirement.LastRefundedDelegatorReputer = reputer
+	}
+	*delegatorBudget -= uint64(len(stakes))
+	if more {
+		return nil
+	}
+
+	retirement.Stage = types.TopicRetirementStage_TOPIC_RETIREMENT_STAGE_AWAITING_REFUNDS
+	return nil
+}
+
+// Returns at most `limit` entries of the range, and whether entries remain beyond them.
+// The iterator is closed before returning so that the caller may write to the store.
+func collectPage[K, V any](
+	ctx context.Context,
+	m collections.Map[K, V],
+	rng collections.Ranger[K],
+	limit uint64,
+) ([]collections.KeyValue[K, V], bool, error) {
+	iter, err := m.Iterate(ctx, rng)
+	if err != nil {
+		return nil, false, err
+	}
+	defer iter.Close()
+	page := make([]collections.KeyValue[K, V], 0)
+	for ; iter.Valid(); iter.Next() {
+		if uint64(len(page)) >= limit {
+			return page, true, nil
+		}
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, false, err
+		}
+		page = append(page, kv)
+	}
+	return page, false, nil
+}
+
+// Moves a retired topic on to clearing its state once all of its stake has been paid out.
+// If stake is left after the last queued removal was due, e.g. because a removal failed,
+// the refunds are queued again from the start.
+func (k *Keeper) checkTopicRetirementRefunds(ctx context.Context, retirement *types.TopicRetirement) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if sdkCtx.BlockHeight() < retirement.RefundsCompleteBlock {
+		return nil
+	}
+	topicStake, err := k.GetTopicStake(ctx, retirement.Topic.Id)
+	if err != nil {
+		return errors.Wrap(err, "failed to get topic stake")
+	}
+	if topicStake.IsZero() {
+		retirement.Stage = types.TopicRetirementStage_TOPIC_RETIREMENT_STAGE_CLEARING_STATE
+		return nil
+	}
+	sdkCtx.Logger().Warn(fmt.Sprintf("Retired topic %d still has stake %s, queueing refunds again", retirement.Topic.Id, topicStake))
+	retirement.Stage = types.TopicRetirementStage_TOPIC_RETIREMENT_STAGE_REFUNDING_STAKE
+	retirement.ReputerRefundsQueued = false
+	retirement.LastRefundedReputer = ""
+	retirement.LastRefundedDelegator = ""
+	retirement.LastRefundedDelegatorReputer = ""
+	return nil
+}
+
+// Deletes the keys of a retired topic store by store, at most `keyBudget` keys in total.
+// GMP subscriptions are keyed by their id rather than by topic, so any left on the topic,
+// e.g. imported from genesis after it was retired, are cancelled through the by-topic index first.
+func (k *Keeper) clearTopicRetirementState(ctx context.Context, retirement *types.TopicRetirement, keyBudget *uint64) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := k.CancelTopicGmpSubscriptions(ctx, retirement.Topic.Id); err != nil {
+		return errors.Wrap(err, "failed to cancel gmp subscriptions")
+	}
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	topicIdBytes := binary.BigEndian.AppendUint64(nil, retirement.Topic.Id)
+
+	for retirement.StoreIndex < uint64(len(topicScopedStorePrefixes)) {
+		if *keyBudget == 0 {
+			return nil
+		}
+		storePrefix := topicScopedStorePrefixes[retirement.StoreIndex].Bytes()
+		keyPrefix := append(append(make([]byte, 0, len(storePrefix)+len(topicIdBytes)), storePrefix...), topicIdBytes...)
+		deleted, err := migutils.ClearPage(store, keyPrefix, *keyBudget)
+		if err != nil {
+			return errors.Wrapf(err, "failed to clear store with prefix %v", storePrefix)
+		}
+		retirement.KeysDeleted += deleted
+		*keyBudget -= deleted
+		if *keyBudget == 0 {
+			// The store may still hold keys, check it again next block
+			return nil
+		}
+		retirement.StoreIndex++
+	}
+
+	retirement.Stage = types.TopicRetirementStage_TOPIC_RETIREMENT_STAGE_COMPLETE
+	retirement.CompletedAtBlock = sdkCtx.BlockHeight()
+	return nil
+}
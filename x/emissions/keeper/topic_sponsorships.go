@@ -0,0 +1,277 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Max number of sponsorships per topic, bounding the sponsorships visited when a data sending fee is paid
+const MAX_SPONSORSHIPS_PER_TOPIC = 32
+
+func sponsorshipCoins(amount cosmosMath.Int) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, amount))
+}
+
+// Adds to the sponsorship of a topic by a sponsor, creating it if needed, and moves the amount from the sponsor
+// into the sponsorship escrow. The caps of the sponsorship are replaced by the given ones.
+// The amount must cover at least one data sending fee of the topic. When the topic has no free sponsorship slot,
+// sponsorships whose balance can no longer cover the fee are refunded to make room.
+func (k *Keeper) FundTopicSponsorship(
+	ctx context.Context,
+	topicId TopicId,
+	sponsor ActorId,
+	amount cosmosMath.Int,
+	maxFeesPerActorPerEpoch cosmosMath.Int,
+	maxFeesPerEpoch cosmosMath.Int,
+) (types.TopicSponsorship, error) {
+	fee, err := k.GetTopicDataSendingFee(ctx, topicId)
+	if err != nil {
+		return types.TopicSponsorship{}, err
+	}
+	if amount.LT(fee) {
+		return types.TopicSponsorship{}, errors.Wrapf(types.ErrTopicSponsorshipBelowDataSendingFee,
+			"funding %s against a fee of %s", amount.String(), fee.String())
+	}
+	sponsorship, found, err := k.GetTopicSponsorship(ctx, topicId, sponsor)
+	if err != nil {
+		return types.TopicSponsorship{}, err
+	}
+	if !found {
+		count, err := k.evictExhaustedTopicSponsorships(ctx, topicId, fee)
+		if err != nil {
+			return types.TopicSponsorship{}, err
+		}
+		if count >= MAX_SPONSORSHIPS_PER_TOPIC {
+			return types.TopicSponsorship{}, errors.Wrapf(types.ErrTooManyTopicSponsorships, "topic %d", topicId)
+		}
+		sponsorship = types.TopicSponsorship{
+			TopicId:   topicId,
+			Sponsor:   sponsor,
+			Balance:   cosmosMath.ZeroInt(),
+			EpochFees: &types.SponsoredFees{EpochLastEnded: 0, Fees: cosmosMath.ZeroInt()},
+		}
+	}
+
+	sponsorAddr, err := sdk.AccAddressFromBech32(sponsor)
+	if err != nil {
+		return types.TopicSponsorship{}, errors.Wrap(err, "error getting sponsor address")
+	}
+	err = k.bankKeeper.SendCoins(ctx, sponsorAddr, types.TopicSponsorshipEscrowAddress, sponsorshipCoins(amount))
+	if err != nil {
+		return types.TopicSponsorship{}, errors.Wrap(err, "error escrowing topic sponsorship funds")
+	}
+	sponsorship.Balance = sponsorship.Balance.Add(amount)
+	sponsorship.MaxFeesPerActorPerEpoch = maxFeesPerActorPerEpoch
+	sponsorship.MaxFeesPerEpoch = maxFeesPerEpoch
+	return sponsorship, k.SetTopicSponsorship(ctx, sponsorship)
+}
+
+// Returns part of the remaining balance of a sponsorship to its sponsor.
+// A sponsorship whose balance is fully withdrawn is removed.
+func (k *Keeper) WithdrawTopicSponsorship(ctx context.Context, topicId TopicId, sponsor ActorId, amount cosmosMath.Int) (types.TopicSponsorship, error) {
+	sponsorship, found, err := k.GetTopicSponsorship(ctx, topicId, sponsor)
+	if err != nil {
+		return types.TopicSponsorship{}, err
+	}
+	if !found {
+		return types.TopicSponsorship{}, errors.Wrapf(types.ErrTopicSponsorshipNotFound, "topic %d sponsor %s", topicId, sponsor)
+	}
+	if amount.GT(sponsorship.Balance) {
+		return types.TopicSponsorship{}, errors.Wrapf(types.ErrInsufficientSponsorshipBalance,
+			"withdrawing %s from a balance of %s", amount.String(), sponsorship.Balance.String())
+	}
+
+	if amount.IsPositive() {
+		sponsorAddr, err := sdk.AccAddressFromBech32(sponsor)
+		if err != nil {
+			return types.TopicSponsorship{}, errors.Wrap(err, "error getting sponsor address")
+		}
+		err = k.bankKeeper.SendCoins(ctx, types.TopicSponsorshipEscrowAddress, sponsorAddr, sponsorshipCoins(amount))
+		if err != nil {
+			return types.TopicSponsorship{}, errors.Wrap(err, "error returning topic sponsorship funds")
+		}
+	}
+	sponsorship.Balance = sponsorship.Balance.Sub(amount)
+	if sponsorship.Balance.IsZero() {
+		return sponsorship, k.removeTopicSponsorship(ctx, topicId, sponsor)
+	}
+	return sponsorship, k.SetTopicSponsorship(ctx, sponsorship)
+}
+
+// Once a topic has no free sponsorship slot, refunds the sponsorships of the topic whose balance is below
+// the given data sending fee, as they can pay no fee. Returns the number of sponsorships left.
+func (k *Keeper) evictExhaustedTopicSponsorships(ctx context.Context, topicId TopicId, fee cosmosMath.Int) (int, error) {
+	sponsorships, err := k.GetTopicSponsorships(ctx, topicId)
+	if err != nil {
+		return 0, err
+	}
+	if len(sponsorships) < MAX_SPONSORSHIPS_PER_TOPIC {
+		return len(sponsorships), nil
+	}
+	count := len(sponsorships)
+	for _, sponsorship := range sponsorships {
+		if sponsorship.Balance.GTE(fee) {
+			continue
+		}
+		if _, err := k.WithdrawTopicSponsorship(ctx, topicId, sponsorship.Sponsor, sponsorship.Balance); err != nil {
+			return 0, err
+		}
+		count--
+	}
+	return count, nil
+}
+
+// Withdraws the whole balance of every sponsorship of a topic to their sponsors
+func (k *Keeper) RefundTopicSponsorships(ctx context.Context, topicId TopicId) error {
+	sponsorships, err := k.GetTopicSponsorships(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	for _, sponsorship := range sponsorships {
+		if _, err := k.WithdrawTopicSponsorship(ctx, topicId, sponsorship.Sponsor, sponsorship.Balance); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pays a data sending fee of an actor of a topic out of the first sponsorship of the topic, in sponsor order,
+// whose balance and caps for the current epoch of the topic allow it, sending it from the sponsorship escrow
+// to the recipient module. Returns whether a sponsorship paid the fee.
+func (k *Keeper) CoverDataSendingFee(
+	ctx context.Context,
+	topic types.Topic,
+	actor ActorId,
+	fee cosmosMath.Int,
+	recipientModule string,
+) (bool, error) {
+	if !fee.IsPositive() {
+		return false, nil
+	}
+	sponsorships, err := k.GetTopicSponsorships(ctx, topic.Id)
+	if err != nil {
+		return false, err
+	}
+	for _, sponsorship := range sponsorships {
+		if sponsorship.Balance.LT(fee) {
+			continue
+		}
+		epochFees := currentEpochSponsoredFees(sponsorship.EpochFees, topic.EpochLastEnded)
+		if sponsorship.MaxFeesPerEpoch.IsPositive() && epochFees.Fees.Add(fee).GT(sponsorship.MaxFeesPerEpoch) {
+			continue
+		}
+		actorFees, err := k.GetSponsoredActorFees(ctx, topic.Id, sponsorship.Sponsor, actor)
+		if err != nil {
+			return false, err
+		}
+		actorFees = currentEpochSponsoredFees(&actorFees, topic.EpochLastEnded)
+		if sponsorship.MaxFeesPerActorPerEpoch.IsPositive() && actorFees.Fees.Add(fee).GT(sponsorship.MaxFeesPerActorPerEpoch) {
+			continue
+		}
+
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, types.TopicSponsorshipEscrowAddress, recipientModule, sponsorshipCoins(fee))
+		if err != nil {
+			return false, errors.Wrap(err, "error paying data sending fee from topic sponsorship")
+		}
+		sponsorship.Balance = sponsorship.Balance.Sub(fee)
+		epochFees.Fees = epochFees.Fees.Add(fee)
+		sponsorship.EpochFees = &epochFees
+		if err := k.SetTopicSponsorship(ctx, sponsorship); err != nil {
+			return false, err
+		}
+		actorFees.Fees = actorFees.Fees.Add(fee)
+		if err := k.SetSponsoredActorFees(ctx, topic.Id, sponsorship.Sponsor, actor, actorFees); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// Returns the fees recorded for the epoch of a topic that last ended at epochLastEnded,
+// which are zero if the record is from an earlier epoch
+func currentEpochSponsoredFees(fees *types.SponsoredFees, epochLastEnded BlockHeight) types.SponsoredFees {
+	if fees == nil || fees.EpochLastEnded != epochLastEnded || fees.Fees.IsNil() {
+		return types.SponsoredFees{EpochLastEnded: epochLastEnded, Fees: cosmosMath.ZeroInt()}
+	}
+	return *fees
+}
+
+func (k *Keeper) GetTopicSponsorship(ctx context.Context, topicId TopicId, sponsor ActorId) (types.TopicSponsorship, bool, error) {
+	sponsorship, err := k.topicSponsorships.Get(ctx, collections.Join(topicId, sponsor))
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.TopicSponsorship{}, false, nil
+		}
+		return types.TopicSponsorship{}, false, err
+	}
+	return sponsorship, true, nil
+}
+
+func (k *Keeper) SetTopicSponsorship(ctx context.Context, sponsorship types.TopicSponsorship) error {
+	if err := sponsorship.Validate(); err != nil {
+		return errors.Wrap(err, "error validating topic sponsorship")
+	}
+	return k.topicSponsorships.Set(ctx, collections.Join(sponsorship.TopicId, sponsorship.Sponsor), sponsorship)
+}
+
+// Removes a sponsorship along with the fees it paid per actor
+func (k *Keeper) removeTopicSponsorship(ctx context.Context, topicId TopicId, sponsor ActorId) error {
+	if err := k.topicSponsorships.Remove(ctx, collections.Join(topicId, sponsor)); err != nil {
+		return errors.Wrap(err, "error removing topic sponsorship")
+	}
+	rng := collections.NewSuperPrefixedTripleRange[TopicId, ActorId, ActorId](topicId, sponsor)
+	iter, err := k.sponsoredActorFees.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := k.sponsoredActorFees.Remove(ctx, key); err != nil {
+			return errors.Wrap(err, "error removing sponsored actor fees")
+		}
+	}
+	return nil
+}
+
+// Returns the sponsorships of a topic in sponsor order
+func (k *Keeper) GetTopicSponsorships(ctx context.Context, topicId TopicId) ([]types.TopicSponsorship, error) {
+	rng := collections.NewPrefixedPairRange[TopicId, ActorId](topicId)
+	iter, err := k.topicSponsorships.Iterate(ctx, rng)
+	if err != nil {
+		return nil, errors.Wrap(err, "error iterating topic sponsorships")
+	}
+	sponsorships, err := iter.Values()
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting topic sponsorships")
+	}
+	return sponsorships, nil
+}
+
+// Returns the fees a sponsorship paid for an actor, as last recorded
+func (k *Keeper) GetSponsoredActorFees(ctx context.Context, topicId TopicId, sponsor ActorId, actor ActorId) (types.SponsoredFees, error) {
+	fees, err := k.sponsoredActorFees.Get(ctx, collections.Join3(topicId, sponsor, actor))
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.SponsoredFees{EpochLastEnded: 0, Fees: cosmosMath.ZeroInt()}, nil
+		}
+		return types.SponsoredFees{}, err
+	}
+	return fees, nil
+}
+
+func (k *Keeper) SetSponsoredActorFees(ctx context.Context, topicId TopicId, sponsor ActorId, actor ActorId, fees types.SponsoredFees) error {
+	if err := fees.Validate(); err != nil {
+		return errors.Wrap(err, "error validating sponsored fees")
+	}
+	return k.sponsoredActorFees.Set(ctx, collections.Join3(topicId, sponsor, actor), fees)
+}
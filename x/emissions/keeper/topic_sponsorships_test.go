@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	minttypes "github.com/allora-network/allora-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestCoverDataSendingFeeRespectsCaps() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	require := s.Require()
+	topic := types.Topic{Id: 1, EpochLastEnded: 10} // nolint: exhaustruct
+	sponsorAddr := s.addrs[0]
+	sponsor := s.addrsStr[0]
+	actor := s.addrsStr[1]
+	otherActor := s.addrsStr[2]
+	fee := cosmosMath.NewInt(10)
+
+	s.MintTokensToAddress(sponsorAddr, cosmosMath.NewInt(100))
+	_, err := keeper.FundTopicSponsorship(ctx, topic.Id, sponsor, cosmosMath.NewInt(100), cosmosMath.NewInt(10), cosmosMath.NewInt(20))
+	require.NoError(err)
+
+	// The per actor cap allows a single fee per actor per epoch
+	covered, err := keeper.CoverDataSendingFee(ctx, topic, actor, fee, minttypes.EcosystemModuleName)
+	require.NoError(err)
+	require.True(covered)
+	covered, err = keeper.CoverDataSendingFee(ctx, topic, actor, fee, minttypes.EcosystemModuleName)
+	require.NoError(err)
+	require.False(covered)
+
+	// The per epoch cap allows two fees per epoch across actors
+	covered, err = keeper.CoverDataSendingFee(ctx, topic, otherActor, fee, minttypes.EcosystemModuleName)
+	require.NoError(err)
+	require.True(covered)
+	covered, err = keeper.CoverDataSendingFee(ctx, topic, s.addrsStr[3], fee, minttypes.EcosystemModuleName)
+	require.NoError(err)
+	require.False(covered)
+
+	// Both caps reset once the topic's epoch ends
+	topic.EpochLastEnded = 20
+	covered, err = keeper.CoverDataSendingFee(ctx, topic, actor, fee, minttypes.EcosystemModuleName)
+	require.NoError(err)
+	require.True(covered)
+
+	sponsorship, found, err := keeper.GetTopicSponsorship(ctx, topic.Id, sponsor)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(cosmosMath.NewInt(70), sponsorship.Balance)
+	require.Equal(int64(20), sponsorship.EpochFees.EpochLastEnded)
+	require.Equal(fee, sponsorship.EpochFees.Fees)
+	escrowBalance := s.bankKeeper.GetBalance(ctx, types.TopicSponsorshipEscrowAddress, params.DefaultBondDenom)
+	require.Equal(cosmosMath.NewInt(70), escrowBalance.Amount)
+}
+
+func (s *KeeperTestSuite) TestCoverDataSendingFeeRequiresSufficientBalance() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	require := s.Require()
+	topic := types.Topic{Id: 1, EpochLastEnded: 10} // nolint: exhaustruct
+
+	s.MintTokensToAddress(s.addrs[0], cosmosMath.NewInt(10))
+	_, err := keeper.FundTopicSponsorship(ctx, topic.Id, s.addrsStr[0], cosmosMath.NewInt(10), cosmosMath.ZeroInt(), cosmosMath.ZeroInt())
+	require.NoError(err)
+
+	covered, err := keeper.CoverDataSendingFee(ctx, topic, s.addrsStr[1], cosmosMath.NewInt(11), minttypes.EcosystemModuleName)
+	require.NoError(err)
+	require.False(covered)
+
+	// Sponsorships of other topics never pay
+	otherTopic := types.Topic{Id: 2, EpochLastEnded: 10} // nolint: exhaustruct
+	covered, err = keeper.CoverDataSendingFee(ctx, otherTopic, s.addrsStr[1], cosmosMath.NewInt(1), minttypes.EcosystemModuleName)
+	require.NoError(err)
+	require.False(covered)
+}
+
+func (s *KeeperTestSuite) TestWithdrawTopicSponsorship() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	require := s.Require()
+	topicId := uint64(1)
+	sponsorAddr := s.addrs[0]
+	sponsor := s.addrsStr[0]
+
+	s.MintTokensToAddress(sponsorAddr, cosmosMath.NewInt(100))
+	initialBalance := s.bankKeeper.GetBalance(ctx, sponsorAddr, params.DefaultBondDenom)
+	_, err := keeper.FundTopicSponsorship(ctx, topicId, sponsor, cosmosMath.NewInt(100), cosmosMath.ZeroInt(), cosmosMath.ZeroInt())
+	require.NoError(err)
+
+	_, err = keeper.WithdrawTopicSponsorship(ctx, topicId, sponsor, cosmosMath.NewInt(101))
+	require.ErrorIs(err, types.ErrInsufficientSponsorshipBalance)
+	_, err = keeper.WithdrawTopicSponsorship(ctx, topicId, s.addrsStr[1], cosmosMath.NewInt(1))
+	require.ErrorIs(err, types.ErrTopicSponsorshipNotFound)
+
+	sponsorship, err := keeper.WithdrawTopicSponsorship(ctx, topicId, sponsor, cosmosMath.NewInt(40))
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(60), sponsorship.Balance)
+
+	// Withdrawing the whole balance removes the sponsorship
+	err = keeper.RefundTopicSponsorships(ctx, topicId)
+	require.NoError(err)
+	_, found, err := keeper.GetTopicSponsorship(ctx, topicId, sponsor)
+	require.NoError(err)
+	require.False(found)
+	balance := s.bankKeeper.GetBalance(ctx, sponsorAddr, params.DefaultBondDenom)
+	require.Equal(initialBalance, balance)
+}
+
+func (s *KeeperTestSuite) TestFundTopicSponsorshipRequiresDataSendingFee() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	require := s.Require()
+	topicId := uint64(1)
+
+	fee, err := keeper.GetTopicDataSendingFee(ctx, topicId)
+	require.NoError(err)
+	s.MintTokensToAddress(s.addrs[0], fee)
+	_, err = keeper.FundTopicSponsorship(ctx, topicId, s.addrsStr[0], fee.SubRaw(1), cosmosMath.ZeroInt(), cosmosMath.ZeroInt())
+	require.ErrorIs(err, types.ErrTopicSponsorshipBelowDataSendingFee)
+	_, err = keeper.FundTopicSponsorship(ctx, topicId, s.addrsStr[0], fee, cosmosMath.ZeroInt(), cosmosMath.ZeroInt())
+	require.NoError(err)
+}
+
+func (s *KeeperTestSuite) TestFundTopicSponsorshipEvictsExhaustedSponsorships() {
+	ctx := s.ctx
+	k := s.emissionsKeeper
+	require := s.Require()
+	topic := types.Topic{Id: 1, EpochLastEnded: 10} // nolint: exhaustruct
+
+	fee, err := k.GetTopicDataSendingFee(ctx, topic.Id)
+	require.NoError(err)
+	sponsors := make([]string, keeper.MAX_SPONSORSHIPS_PER_TOPIC+1)
+	for i := range sponsors {
+		sponsorAddr := sdk.AccAddress(fmt.Sprintf("sponsor%013d", i))
+		sponsors[i] = sponsorAddr.String()
+		s.MintTokensToAddress(sponsorAddr, fee)
+	}
+	for _, sponsor := range sponsors[:keeper.MAX_SPONSORSHIPS_PER_TOPIC] {
+		_, err := k.FundTopicSponsorship(ctx, topic.Id, sponsor, fee, cosmosMath.ZeroInt(), cosmosMath.ZeroInt())
+		require.NoError(err)
+	}
+
+	// While every sponsorship can still pay a fee, new sponsors are turned away
+	newSponsor := sponsors[keeper.MAX_SPONSORSHIPS_PER_TOPIC]
+	_, err = k.FundTopicSponsorship(ctx, topic.Id, newSponsor, fee, cosmosMath.ZeroInt(), cosmosMath.ZeroInt())
+	require.ErrorIs(err, types.ErrTooManyTopicSponsorships)
+
+	// A sponsorship drained below the fee gives up its slot and its dust is refunded
+	covered, err := k.CoverDataSendingFee(ctx, topic, s.addrsStr[1], fee.SubRaw(1), minttypes.EcosystemModuleName)
+	require.NoError(err)
+	require.True(covered)
+	_, err = k.FundTopicSponsorship(ctx, topic.Id, newSponsor, fee, cosmosMath.ZeroInt(), cosmosMath.ZeroInt())
+	require.NoError(err)
+
+	sponsorships, err := k.GetTopicSponsorships(ctx, topic.Id)
+	require.NoError(err)
+	require.Len(sponsorships, keeper.MAX_SPONSORSHIPS_PER_TOPIC)
+	for _, sponsorship := range sponsorships {
+		require.True(sponsorship.Balance.GTE(fee))
+	}
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Return true if the block is within the worker reveal window of the nonce,
+// which follows the worker submission window of topics in commit-reveal mode
+func (k *Keeper) BlockWithinWorkerRevealWindowOfNonce(topic types.Topic, nonce types.Nonce, blockHeight int64) bool {
+	revealWindowStart := nonce.BlockHeight + topic.WorkerSubmissionWindow
+	return revealWindowStart <= blockHeight && blockHeight < revealWindowStart+topic.WorkerRevealWindow
+}
+
+// Returns the commitment of a worker to its payload for a worker nonce, if any
+func (k *Keeper) GetWorkerCommitment(
+	ctx context.Context,
+	topicId TopicId,
+	nonceBlockHeight BlockHeight,
+	worker ActorId,
+) (types.WorkerCommitment, bool, error) {
+	commitment, err := k.workerCommitments.Get(ctx, collections.Join3(topicId, nonceBlockHeight, worker))
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return types.WorkerCommitment{}, false, nil
+	} else if err != nil {
+		return types.WorkerCommitment{}, false, err
+	}
+	return commitment, true, nil
+}
+
+func (k *Keeper) SetWorkerCommitment(
+	ctx context.Context,
+	topicId TopicId,
+	nonceBlockHeight BlockHeight,
+	worker ActorId,
+	commitment types.WorkerCommitment,
+) error {
+	if err := types.ValidateTopicId(topicId); err != nil {
+		return errors.Wrap(err, "topicId is not valid")
+	}
+	if err := types.ValidateBlockHeight(nonceBlockHeight); err != nil {
+		return errors.Wrap(err, "nonce block height is not valid")
+	}
+	if err := types.ValidateBech32(worker); err != nil {
+		return errors.Wrap(err, "worker is not valid")
+	}
+	if err := commitment.Validate(); err != nil {
+		return errors.Wrap(err, "commitment is not valid")
+	}
+	return k.workerCommitments.Set(ctx, collections.Join3(topicId, nonceBlockHeight, worker), commitment)
+}
+
+// Returns the workers that revealed the payload they committed to for a worker nonce
+func (k *Keeper) GetWorkersWithRevealedCommitment(ctx context.Context, topicId TopicId, nonceBlockHeight BlockHeight) (map[ActorId]bool, error) {
+	rng := collections.NewSuperPrefixedTripleRange[TopicId, BlockHeight, ActorId](topicId, nonceBlockHeight)
+	iter, err := k.workerCommitments.Iterate(ctx, rng)
+	if err != nil {
+		return nil, errors.Wrap(err, "error iterating worker commitments")
+	}
+	defer iter.Close()
+	revealed := make(map[ActorId]bool)
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "error getting worker commitment")
+		}
+		if kv.Value.Revealed {
+			revealed[kv.Key.K3()] = true
+		}
+	}
+	return revealed, nil
+}
+
+// Deletes the commitments of all workers for a worker nonce, revealed or not
+func (k *Keeper) DeleteWorkerCommitmentsOfNonce(ctx context.Context, topicId TopicId, nonceBlockHeight BlockHeight) error {
+	rng := collections.NewSuperPrefixedTripleRange[TopicId, BlockHeight, ActorId](topicId, nonceBlockHeight)
+	iter, err := k.workerCommitments.Iterate(ctx, rng)
+	if err != nil {
+		return errors.Wrap(err, "error iterating worker commitments")
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return errors.Wrap(err, "error getting worker commitments")
+	}
+	for _, key := range keys {
+		if err := k.workerCommitments.Remove(ctx, key); err != nil {
+			return errors.Wrap(err, "error removing worker commitment")
+		}
+	}
+	return nil
+}
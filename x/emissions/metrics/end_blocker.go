@@ -0,0 +1,97 @@
+package metrics
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	metrics "github.com/hashicorp/go-metrics"
+)
+
+// Phases of the emissions EndBlocker, the last ones being run once per topic
+const (
+	END_BLOCKER_PHASE_STAKE_REMOVALS          = "stake_removals"
+	END_BLOCKER_PHASE_DELEGATE_STAKE_REMOVALS = "delegate_stake_removals"
+	END_BLOCKER_PHASE_REDELEGATIONS           = "redelegations"
+	END_BLOCKER_PHASE_TOPIC_RETIREMENTS       = "topic_retirements"
+	END_BLOCKER_PHASE_TOPIC_UPDATES           = "topic_updates"
+	END_BLOCKER_PHASE_TOPIC_WEIGHTS           = "topic_weights"
+	END_BLOCKER_PHASE_UPDATE_NONCES           = "update_nonces"
+	END_BLOCKER_PHASE_EMIT_REWARDS            = "emit_rewards"
+	END_BLOCKER_PHASE_REPUTER_OUTLIERS        = "reputer_outliers"
+	END_BLOCKER_PHASE_CLOSE_WORKER_WINDOWS    = "close_worker_windows"
+	END_BLOCKER_PHASE_TOPIC_REWARDS           = "topic_rewards"
+	END_BLOCKER_PHASE_CLOSE_WORKER_NONCE      = "close_worker_nonce"
+	END_BLOCKER_PHASE_CLOSE_REPUTER_NONCE     = "close_reputer_nonce"
+)
+
+// Payloads counted once processed by the EndBlocker
+const (
+	PROCESSED_INFERENCES      = "inferences"
+	PROCESSED_FORECASTS       = "forecasts"
+	PROCESSED_REPUTER_BUNDLES = "reputer_bundles"
+)
+
+// MaxLabelledTopics bounds the cardinality of the per-topic metrics:
+// the topics seen after the first MaxLabelledTopics ones share the OtherTopicsLabel
+const MaxLabelledTopics = 100
+
+const OtherTopicsLabel = "other"
+
+var labelledTopics = struct {
+	sync.Mutex
+	ids map[uint64]bool
+}{ids: make(map[uint64]bool)}
+
+// TopicLabel returns the value of the topic label of the per-topic metrics
+func TopicLabel(topicId uint64) string {
+	labelledTopics.Lock()
+	defer labelledTopics.Unlock()
+	if !labelledTopics.ids[topicId] {
+		if len(labelledTopics.ids) >= MaxLabelledTopics {
+			return OtherTopicsLabel
+		}
+		labelledTopics.ids[topicId] = true
+	}
+	return strconv.FormatUint(topicId, 10)
+}
+
+// Measures the duration in milliseconds of a phase of the EndBlocker,
+// labelled with the topic for the phases run once per topic, i.e. with a non zero topic id
+// Metric Name:
+//
+//	allora_emissions_end_blocker_phase_ms
+func MeasureEndBlockerPhase(phase string, topicId uint64, duration time.Duration) {
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+	labels := []metrics.Label{telemetry.NewLabel("phase", phase)}
+	if topicId != 0 {
+		labels = append(labels, telemetry.NewLabel("topic", TopicLabel(topicId)))
+	}
+	metrics.AddSampleWithLabels(
+		[]string{"allora", "emissions", "end_blocker", "phase_ms"},
+		float32(duration)/float32(time.Millisecond),
+		labels,
+	)
+}
+
+// Counts the payloads of a topic processed by the EndBlocker
+// Metric Name:
+//
+//	allora_emissions_processed_count
+func IncrProcessedCount(payload string, topicId uint64, count int) {
+	// Topics are only labelled, using up the labels, while telemetry is enabled
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+	telemetry.IncrCounterWithLabels(
+		[]string{"allora", "emissions", "processed", "count"},
+		float32(count),
+		[]metrics.Label{
+			telemetry.NewLabel("payload", payload),
+			telemetry.NewLabel("topic", TopicLabel(topicId)),
+		},
+	)
+}
@@ -0,0 +1,34 @@
+package metrics
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopicLabelIsCapped(t *testing.T) {
+	labelledTopics.Lock()
+	labelledTopics.ids = make(map[uint64]bool)
+	labelledTopics.Unlock()
+
+	for topicId := uint64(1); topicId <= MaxLabelledTopics; topicId++ {
+		require.Equal(t, strconv.FormatUint(topicId, 10), TopicLabel(topicId))
+	}
+	require.Equal(t, OtherTopicsLabel, TopicLabel(MaxLabelledTopics+1))
+	// Topics labelled before the cap was reached keep their label
+	require.Equal(t, "1", TopicLabel(1))
+}
+
+func TestEndBlockerMetricsAreSkippedWithoutTelemetry(t *testing.T) {
+	labelledTopics.Lock()
+	labelledTopics.ids = make(map[uint64]bool)
+	labelledTopics.Unlock()
+
+	require.False(t, telemetry.IsTelemetryEnabled())
+	MeasureEndBlockerPhase(END_BLOCKER_PHASE_TOPIC_REWARDS, 1, time.Millisecond)
+	IncrProcessedCount(PROCESSED_INFERENCES, 2, 3)
+	require.Empty(t, labelledTopics.ids)
+}
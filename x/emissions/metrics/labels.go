@@ -1,16 +1,34 @@
 package metrics
 
 const (
-	INFERER_SCORE_EVENT       = "inferer_score_event"
-	FORECASTER_SCORE_EVENT    = "forecaster_score_event"
-	REPUTER_SOCRE_EVENT       = "reputer_score_event"
-	NETWORK_LOSS_EVENT        = "network_loss_event"
-	INFERER_REWARD_EVENT      = "inferer_reward_event"
-	FORECASTER_REWARD_EVENT   = "forecaster_reward_event"
-	REPUTER_REWARD_EVENT      = "reputer_reward_event"
-	WORKER_LAST_COMMIT_EVENT  = "worker_last_commit_event"
-	REPUTER_LAST_COMMIT_EVENT = "reputer_last_commit_event"
-	FORECAST_TASK_SCORE_EVENT = "forecast_task_score_event"
-	TOPIC_REWARD_EVENT        = "topic_reward_event"
-	WORKER_EMA_SCORE_EVENT    = "worker_ema_score_event"
+	INFERER_SCORE_EVENT             = "inferer_score_event"
+	FORECASTER_SCORE_EVENT          = "forecaster_score_event"
+	REPUTER_SOCRE_EVENT             = "reputer_score_event"
+	NETWORK_LOSS_EVENT              = "network_loss_event"
+	INFERER_REWARD_EVENT            = "inferer_reward_event"
+	FORECASTER_REWARD_EVENT         = "forecaster_reward_event"
+	REPUTER_REWARD_EVENT            = "reputer_reward_event"
+	WORKER_LAST_COMMIT_EVENT        = "worker_last_commit_event"
+	REPUTER_LAST_COMMIT_EVENT       = "reputer_last_commit_event"
+	FORECAST_TASK_SCORE_EVENT       = "forecast_task_score_event"
+	TOPIC_REWARD_EVENT              = "topic_reward_event"
+	WORKER_EMA_SCORE_EVENT          = "worker_ema_score_event"
+	TOPIC_UPDATED_EVENT             = "topic_updated_event"
+	TOPIC_UPDATE_DROPPED_EVENT      = "topic_update_dropped_event"
+	TOPIC_RETIREMENT_PROGRESS_EVENT = "topic_retirement_progress_event"
+	GMP_DELIVERY_EVENT              = "gmp_delivery_event"
+	REPUTER_SLASHED_EVENT           = "reputer_slashed_event"
+	REPUTER_JAIL_STATUS_EVENT       = "reputer_jail_status_event"
+	REPUTER_LOSSES_REJECTED_EVENT   = "reputer_losses_rejected_event"
+	STAKE_ADDED_EVENT               = "stake_added_event"
+	STAKE_REMOVED_EVENT             = "stake_removed_event"
+	STAKE_REMOVAL_SCHEDULED_EVENT   = "stake_removal_scheduled_event"
+	STAKE_REMOVAL_UNSCHEDULED_EVENT = "stake_removal_unscheduled_event"
+	ACTOR_REGISTERED_EVENT          = "actor_registered_event"
+	ACTOR_UNREGISTERED_EVENT        = "actor_unregistered_event"
+	TOPIC_CREATED_EVENT             = "topic_created_event"
+	TOPIC_ACTIVATION_EVENT          = "topic_activation_event"
+	NONCE_OPENED_EVENT              = "nonce_opened_event"
+	NONCE_FULFILLED_EVENT           = "nonce_fulfilled_event"
+	NONCE_PRUNED_EVENT              = "nonce_pruned_event"
 )
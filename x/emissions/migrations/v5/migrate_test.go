@@ -130,7 +130,24 @@ func (s *EmissionsV5MigrationTestSuite) TestMigrateParams() {
 	// InitialRegretQuantile - defaultParams.InitialRegretQuantile
 	// PNormSafeDiv - defaultParams.PNormSafeDiv
 
+	// LEFT UNSET, ADDED IN VERSION 6
 	paramsExpected := defaultParams
+	paramsExpected.ReputerSlashFraction = alloraMath.ZeroDec()
+	paramsExpected.SlashedStakeBurnFraction = alloraMath.ZeroDec()
+	paramsExpected.MaxMissedReputerNonces = 0
+	paramsExpected.ReputerOutlierScoreRatio = alloraMath.ZeroDec()
+	paramsExpected.MaxReputerOutlierEpochs = 0
+	paramsExpected.ReputerJailDuration = 0
+	paramsExpected.GmpAxelarChannelId = ""
+	paramsExpected.GmpAxelarCounterpartyChannelId = ""
+	paramsExpected.MaxReputerLossDeviation = alloraMath.ZeroDec()
+	paramsExpected.NetworkInferenceArchiveRetention = 0
+	paramsExpected.MaxPayloadsPerBatch = 0
+	paramsExpected.BatchedPayloadGasDiscount = alloraMath.ZeroDec()
+	paramsExpected.MaxRedelegationsPerDelegator = 0
+	paramsExpected.RewardAccrualEnabled = false
+	paramsExpected.DataSendingFeeMaxChangeRate = alloraMath.ZeroDec()
+	paramsExpected.DataSendingFeeTargetRatio = alloraMath.ZeroDec()
 
 	params, err := s.emissionsKeeper.GetParams(s.ctx)
 	s.Require().NoError(err)
@@ -0,0 +1,93 @@
+package v6
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+// MigrateStore migrates the store from version 5 to version 6
+// it does the following:
+// - sets the params added in this version
+// - Indexes delegations by reputer
+// - allows the staking account to burn slashed stake
+func MigrateStore(ctx sdk.Context, emissionsKeeper keeper.Keeper) error {
+	ctx.Logger().Info("STARTING EMISSIONS MODULE MIGRATION FROM VERSION 5 TO VERSION 6")
+	ctx.Logger().Info("MIGRATING STORE FROM VERSION 5 TO VERSION 6")
+	storageService := emissionsKeeper.GetStorageService()
+	store := runtime.KVStoreAdapter(storageService.OpenKVStore(ctx))
+	cdc := emissionsKeeper.GetBinaryCodec()
+
+	ctx.Logger().Info("MIGRATING PARAMS FROM VERSION 5 TO VERSION 6")
+	if err := MigrateParams(store, cdc); err != nil {
+		ctx.Logger().Error("ERROR INVOKING MIGRATION HANDLER MigrateParams() FROM VERSION 5 TO VERSION 6")
+		return err
+	}
+
+	ctx.Logger().Info("INDEXING DELEGATIONS BY REPUTER IN MIGRATION FROM VERSION 5 TO VERSION 6")
+	if err := emissionsKeeper.IndexDelegationsByReputer(ctx); err != nil {
+		ctx.Logger().Error("ERROR INVOKING MIGRATION HANDLER IndexDelegationsByReputer() FROM VERSION 5 TO VERSION 6")
+		return err
+	}
+
+	ctx.Logger().Info("GRANTING THE STAKING ACCOUNT THE BURNER PERMISSION IN MIGRATION FROM VERSION 5 TO VERSION 6")
+	if err := emissionsKeeper.GrantStakingBurnerPermission(ctx); err != nil {
+		ctx.Logger().Error("ERROR INVOKING MIGRATION HANDLER GrantStakingBurnerPermission() FROM VERSION 5 TO VERSION 6")
+		return err
+	}
+
+	ctx.Logger().Info("MIGRATING EMISSIONS MODULE FROM VERSION 5 TO VERSION 6 COMPLETE")
+	return nil
+}
+
+// migrate params for this new version
+// the params added in this version have new field numbers, so the version 5 params
+// decode into the current params type with the added params unset
+func MigrateParams(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	params := emissionstypes.Params{} //nolint: exhaustruct // empty struct used by cosmos-sdk Unmarshal below
+	oldParamsBytes := store.Get(emissionstypes.ParamsKey)
+	if oldParamsBytes == nil {
+		return errorsmod.Wrapf(emissionstypes.ErrNotFound, "old parameters not found")
+	}
+	err := proto.Unmarshal(oldParamsBytes, &params)
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to unmarshal old parameters")
+	}
+
+	defaultParams := emissionstypes.DefaultParams()
+
+	// DIFFERENCE BETWEEN OLD PARAMS AND NEW PARAMS:
+	// ADDED:
+	//      ReputerSlashFraction, SlashedStakeBurnFraction, MaxMissedReputerNonces,
+	//      ReputerOutlierScoreRatio, MaxReputerOutlierEpochs, ReputerJailDuration,
+	//      GmpAxelarChannelId, GmpAxelarCounterpartyChannelId, MaxReputerLossDeviation,
+	//      NetworkInferenceArchiveRetention, MaxPayloadsPerBatch, BatchedPayloadGasDiscount,
+	//      MaxRedelegationsPerDelegator, RewardAccrualEnabled, DataSendingFeeMaxChangeRate,
+	//      DataSendingFeeTargetRatio
+	params.ReputerSlashFraction = alloraMath.ZeroDec() // reputer slashing starts disabled, for governance to enable
+	params.SlashedStakeBurnFraction = alloraMath.ZeroDec()
+	params.MaxMissedReputerNonces = 0
+	params.ReputerOutlierScoreRatio = defaultParams.ReputerOutlierScoreRatio
+	params.MaxReputerOutlierEpochs = 0
+	params.ReputerJailDuration = defaultParams.ReputerJailDuration
+	params.GmpAxelarChannelId = defaultParams.GmpAxelarChannelId
+	params.GmpAxelarCounterpartyChannelId = defaultParams.GmpAxelarCounterpartyChannelId
+	params.MaxReputerLossDeviation = defaultParams.MaxReputerLossDeviation
+	params.NetworkInferenceArchiveRetention = defaultParams.NetworkInferenceArchiveRetention
+	params.MaxPayloadsPerBatch = defaultParams.MaxPayloadsPerBatch
+	params.BatchedPayloadGasDiscount = defaultParams.BatchedPayloadGasDiscount
+	params.MaxRedelegationsPerDelegator = defaultParams.MaxRedelegationsPerDelegator
+	params.RewardAccrualEnabled = defaultParams.RewardAccrualEnabled
+	params.DataSendingFeeMaxChangeRate = defaultParams.DataSendingFeeMaxChangeRate
+	params.DataSendingFeeTargetRatio = defaultParams.DataSendingFeeTargetRatio
+
+	store.Delete(emissionstypes.ParamsKey)
+	store.Set(emissionstypes.ParamsKey, cdc.MustMarshal(&params))
+	return nil
+}
@@ -0,0 +1,195 @@
+package v6_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/utils/migutils"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	v6 "github.com/allora-network/allora-chain/x/emissions/migrations/v6"
+	emissions "github.com/allora-network/allora-chain/x/emissions/module"
+	emissionstestutil "github.com/allora-network/allora-chain/x/emissions/testutil"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	codecAddress "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	cosmostestutil "github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type EmissionsV6MigrationTestSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+
+	ctx             sdk.Context
+	storeService    store.KVStoreService
+	accountKeeper   *emissionstestutil.MockAccountKeeper
+	emissionsKeeper *keeper.Keeper
+}
+
+func TestEmissionsV6MigrationTestSuite(t *testing.T) {
+	suite.Run(t, new(EmissionsV6MigrationTestSuite))
+}
+
+func (s *EmissionsV6MigrationTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(emissions.AppModule{})
+	key := storetypes.NewKVStoreKey(emissionstypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	s.storeService = storeService
+	testCtx := cosmostestutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx
+
+	// gomock initializations
+	s.ctrl = gomock.NewController(s.T())
+	accountKeeper := emissionstestutil.NewMockAccountKeeper(s.ctrl)
+	bankKeeper := emissionstestutil.NewMockBankKeeper(s.ctrl)
+	emissionsKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		codecAddress.NewBech32Codec(params.Bech32PrefixAccAddr),
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		authtypes.FeeCollectorName)
+
+	s.accountKeeper = accountKeeper
+	s.emissionsKeeper = &emissionsKeeper
+}
+
+// version 5 params, without the params added in version 6
+func v5Params() emissionstypes.Params {
+	paramsOld := emissionstypes.DefaultParams()
+	paramsOld.ReputerSlashFraction = alloraMath.Dec{}
+	paramsOld.SlashedStakeBurnFraction = alloraMath.Dec{}
+	paramsOld.MaxMissedReputerNonces = 0
+	paramsOld.ReputerOutlierScoreRatio = alloraMath.Dec{}
+	paramsOld.MaxReputerOutlierEpochs = 0
+	paramsOld.ReputerJailDuration = 0
+	paramsOld.GmpAxelarChannelId = ""
+	paramsOld.GmpAxelarCounterpartyChannelId = ""
+	paramsOld.MaxReputerLossDeviation = alloraMath.Dec{}
+	paramsOld.NetworkInferenceArchiveRetention = 0
+	paramsOld.MaxPayloadsPerBatch = 0
+	paramsOld.BatchedPayloadGasDiscount = alloraMath.Dec{}
+	paramsOld.MaxRedelegationsPerDelegator = 0
+	paramsOld.RewardAccrualEnabled = false
+	paramsOld.DataSendingFeeMaxChangeRate = alloraMath.Dec{}
+	paramsOld.DataSendingFeeTargetRatio = alloraMath.Dec{}
+	return paramsOld
+}
+
+// in this test we check that the emissions module params have been migrated
+// and the params added in this version are set.
+func (s *EmissionsV6MigrationTestSuite) TestMigrateParams() {
+	store := runtime.KVStoreAdapter(s.storeService.OpenKVStore(s.ctx))
+	cdc := s.emissionsKeeper.GetBinaryCodec()
+
+	paramsOld := v5Params()
+	paramsOld.MaxTopInferersToReward = 7
+	paramsOld.DataSendingFee = paramsOld.DataSendingFee.AddRaw(1)
+	store.Set(emissionstypes.ParamsKey, cdc.MustMarshal(&paramsOld))
+
+	err := v6.MigrateParams(store, cdc)
+	s.Require().NoError(err)
+
+	// TO BE ADDED VIA DEFAULT PARAMS
+	// ReputerOutlierScoreRatio, ReputerJailDuration - defaults
+	// ReputerSlashFraction, SlashedStakeBurnFraction, MaxMissedReputerNonces,
+	// MaxReputerOutlierEpochs - zero, slashing disabled
+	// GmpAxelarChannelId, GmpAxelarCounterpartyChannelId - defaults
+	// MaxReputerLossDeviation - defaults
+	// NetworkInferenceArchiveRetention - defaults
+	// MaxPayloadsPerBatch, BatchedPayloadGasDiscount - defaults
+	// MaxRedelegationsPerDelegator - defaults
+	// RewardAccrualEnabled - defaults
+	// DataSendingFeeMaxChangeRate, DataSendingFeeTargetRatio - defaults
+	defaultParams := emissionstypes.DefaultParams()
+	paramsExpected := paramsOld
+	paramsExpected.ReputerSlashFraction = alloraMath.ZeroDec()
+	paramsExpected.SlashedStakeBurnFraction = alloraMath.ZeroDec()
+	paramsExpected.MaxMissedReputerNonces = 0
+	paramsExpected.ReputerOutlierScoreRatio = defaultParams.ReputerOutlierScoreRatio
+	paramsExpected.MaxReputerOutlierEpochs = 0
+	paramsExpected.ReputerJailDuration = defaultParams.ReputerJailDuration
+	paramsExpected.GmpAxelarChannelId = defaultParams.GmpAxelarChannelId
+	paramsExpected.GmpAxelarCounterpartyChannelId = defaultParams.GmpAxelarCounterpartyChannelId
+	paramsExpected.MaxReputerLossDeviation = defaultParams.MaxReputerLossDeviation
+	paramsExpected.NetworkInferenceArchiveRetention = defaultParams.NetworkInferenceArchiveRetention
+	paramsExpected.MaxPayloadsPerBatch = defaultParams.MaxPayloadsPerBatch
+	paramsExpected.BatchedPayloadGasDiscount = defaultParams.BatchedPayloadGasDiscount
+	paramsExpected.MaxRedelegationsPerDelegator = defaultParams.MaxRedelegationsPerDelegator
+	paramsExpected.RewardAccrualEnabled = defaultParams.RewardAccrualEnabled
+	paramsExpected.DataSendingFeeMaxChangeRate = defaultParams.DataSendingFeeMaxChangeRate
+	paramsExpected.DataSendingFeeTargetRatio = defaultParams.DataSendingFeeTargetRatio
+
+	params, err := s.emissionsKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(7), params.MaxTopInferersToReward, "existing params should be kept")
+	s.Require().True(paramsExpected.DataSendingFee.Equal(params.DataSendingFee))
+	s.Require().True(paramsExpected.ReputerOutlierScoreRatio.Equal(params.ReputerOutlierScoreRatio))
+	s.Require().Equal(paramsExpected.ReputerJailDuration, params.ReputerJailDuration)
+	s.Require().Equal(paramsExpected, params)
+	s.Require().NoError(params.Validate())
+}
+
+func (s *EmissionsV6MigrationTestSuite) TestIndexDelegationsByReputer() {
+	store := runtime.KVStoreAdapter(s.storeService.OpenKVStore(s.ctx))
+	cdc := s.emissionsKeeper.GetBinaryCodec()
+	paramsOld := v5Params()
+	store.Set(emissionstypes.ParamsKey, cdc.MustMarshal(&paramsOld))
+
+	reputer := sdk.AccAddress([]byte("reputer_____________")).String()
+	otherReputer := sdk.AccAddress([]byte("other_reputer_______")).String()
+	delegator1 := sdk.AccAddress([]byte("delegator1__________")).String()
+	delegator2 := sdk.AccAddress([]byte("delegator2__________")).String()
+	stake := emissionstypes.DelegatorInfo{
+		Amount:     alloraMath.MustNewDecFromString("100"),
+		RewardDebt: alloraMath.ZeroDec(),
+	}
+	s.Require().NoError(s.emissionsKeeper.SetDelegateStakePlacement(s.ctx, 1, delegator1, reputer, stake))
+	s.Require().NoError(s.emissionsKeeper.SetDelegateStakePlacement(s.ctx, 1, delegator2, reputer, stake))
+	s.Require().NoError(s.emissionsKeeper.SetDelegateStakePlacement(s.ctx, 1, delegator1, otherReputer, stake))
+	s.Require().NoError(s.emissionsKeeper.SetDelegateStakePlacement(s.ctx, 2, delegator1, reputer, stake))
+
+	// Delegations placed before the index existed are not indexed
+	err := migutils.SafelyClearWholeMap(s.ctx, store, emissionstypes.DelegationsByReputerKey, 10)
+	s.Require().NoError(err)
+	delegators, err := s.emissionsKeeper.GetDelegatorsOfReputer(s.ctx, 1, reputer)
+	s.Require().NoError(err)
+	s.Require().Empty(delegators)
+
+	staking := authtypes.NewEmptyModuleAccount(emissionstypes.AlloraStakingAccountName, authtypes.Burner)
+	s.accountKeeper.EXPECT().GetModuleAccount(s.ctx, emissionstypes.AlloraStakingAccountName).Return(staking)
+	err = v6.MigrateStore(s.ctx, *s.emissionsKeeper)
+	s.Require().NoError(err)
+
+	delegators, err = s.emissionsKeeper.GetDelegatorsOfReputer(s.ctx, 1, reputer)
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]string{delegator1, delegator2}, delegators)
+	delegators, err = s.emissionsKeeper.GetDelegatorsOfReputer(s.ctx, 1, otherReputer)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{delegator1}, delegators)
+	delegators, err = s.emissionsKeeper.GetDelegatorsOfReputer(s.ctx, 2, reputer)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{delegator1}, delegators)
+}
+
+// staking accounts created before reputer slashing existed lack the permission to burn slashed stake
+func (s *EmissionsV6MigrationTestSuite) TestGrantStakingBurnerPermission() {
+	store := runtime.KVStoreAdapter(s.storeService.OpenKVStore(s.ctx))
+	cdc := s.emissionsKeeper.GetBinaryCodec()
+	paramsOld := v5Params()
+	store.Set(emissionstypes.ParamsKey, cdc.MustMarshal(&paramsOld))
+
+	staking := authtypes.NewEmptyModuleAccount(emissionstypes.AlloraStakingAccountName)
+	s.accountKeeper.EXPECT().GetModuleAccount(s.ctx, emissionstypes.AlloraStakingAccountName).Return(staking)
+	s.accountKeeper.EXPECT().SetModuleAccount(s.ctx, authtypes.NewEmptyModuleAccount(emissionstypes.AlloraStakingAccountName, authtypes.Burner))
+
+	err := v6.MigrateStore(s.ctx, *s.emissionsKeeper)
+	s.Require().NoError(err)
+}
@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
 	allorautils "github.com/allora-network/allora-chain/x/emissions/keeper/actor_utils"
+	"github.com/allora-network/allora-chain/x/emissions/metrics"
 	"github.com/allora-network/allora-chain/x/emissions/module/rewards"
 	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -27,23 +29,53 @@ func EndBlocker(ctx context.Context, am AppModule) error {
 		return err
 	}
 	// Remove Stakers that have been wanting to unstake this block. They no longer get paid rewards
+	phaseStart := time.Now()
 	err = RemoveStakes(sdkCtx, blockHeight, am.keeper, moduleParams.HalfMaxProcessStakeRemovalsEndBlock)
 	if err != nil {
 		sdkCtx.Logger().Error("Error removing stakes: ", err)
 	}
+	am.keeper.MeasureEndBlockerPhase(metrics.END_BLOCKER_PHASE_STAKE_REMOVALS, 0, phaseStart)
+	phaseStart = time.Now()
 	err = RemoveDelegateStakes(sdkCtx, blockHeight, am.keeper, moduleParams.HalfMaxProcessStakeRemovalsEndBlock)
 	if err != nil {
 		sdkCtx.Logger().Error("Error removing delegate stakes: ", err)
 	}
+	am.keeper.MeasureEndBlockerPhase(metrics.END_BLOCKER_PHASE_DELEGATE_STAKE_REMOVALS, 0, phaseStart)
+	// Completed redelegations are no longer slashable for their source reputer
+	phaseStart = time.Now()
+	err = am.keeper.RemoveCompletedRedelegations(sdkCtx, blockHeight, moduleParams.HalfMaxProcessStakeRemovalsEndBlock)
+	if err != nil {
+		sdkCtx.Logger().Error("Error removing completed redelegations: ", err)
+	}
+	am.keeper.MeasureEndBlockerPhase(metrics.END_BLOCKER_PHASE_REDELEGATIONS, 0, phaseStart)
+	// Refund and garbage collect retired topics, a bounded amount of work per block
+	phaseStart = time.Now()
+	err = am.keeper.ProcessTopicRetirements(sdkCtx)
+	if err != nil {
+		sdkCtx.Logger().Error("Error processing topic retirements: ", err)
+	}
+	am.keeper.MeasureEndBlockerPhase(metrics.END_BLOCKER_PHASE_TOPIC_RETIREMENTS, 0, phaseStart)
+
+	// Apply topic updates scheduled for topics whose epoch ends at this block,
+	// before they are reactivated for their next epoch
+	phaseStart = time.Now()
+	err = am.keeper.ApplyScheduledTopicUpdatesAtBlock(sdkCtx, blockHeight)
+	if err != nil {
+		sdkCtx.Logger().Error("Error applying scheduled topic updates: ", err)
+	}
+	am.keeper.MeasureEndBlockerPhase(metrics.END_BLOCKER_PHASE_TOPIC_UPDATES, 0, phaseStart)
 
 	// Get unnormalized weights of active topics and the sum weight and revenue they have generated
+	phaseStart = time.Now()
 	weights, sumWeight, totalRevenue, err := rewards.GetAndUpdateActiveTopicWeights(sdkCtx, am.keeper, blockHeight)
 	if err != nil {
 		return errors.Wrapf(err, "Weights error")
 	}
+	am.keeper.MeasureEndBlockerPhase(metrics.END_BLOCKER_PHASE_TOPIC_WEIGHTS, 0, phaseStart)
 
 	sdkCtx.Logger().Debug(fmt.Sprintf("ABCI EndBlocker %d: Total Revenue: %v, Sum Weight: %v", blockHeight, totalRevenue, sumWeight))
 
+	phaseStart = time.Now()
 	err = rewards.UpdateNoncesOfActiveTopics(
 		sdkCtx,
 		am.keeper,
@@ -54,8 +86,16 @@ func EndBlocker(ctx context.Context, am AppModule) error {
 		sdkCtx.Logger().Error("Error applying function on all rewardable topics: ", err)
 		return err
 	}
+	am.keeper.MeasureEndBlockerPhase(metrics.END_BLOCKER_PHASE_UPDATE_NONCES, 0, phaseStart)
+
+	// Reward nonces are deleted once rewarded, so they are read ahead for the reputer outliers step
+	rewardNonces, err := rewards.GetRewardNoncesOfTopics(sdkCtx, am.keeper, alloraMath.GetSortedKeys(weights))
+	if err != nil {
+		return errors.Wrapf(err, "Reward nonces error")
+	}
 
 	// REWARDS (will internally filter any non-RewardReady topics)
+	phaseStart = time.Now()
 	err = rewards.EmitRewards(rewards.EmitRewardsArgs{
 		Ctx:          sdkCtx,
 		K:            am.keeper,
@@ -69,7 +109,16 @@ func EndBlocker(ctx context.Context, am AppModule) error {
 		sdkCtx.Logger().Error("Error calculating global emission per topic: ", err)
 		return errors.Wrapf(err, "Rewards error")
 	}
+	am.keeper.MeasureEndBlockerPhase(metrics.END_BLOCKER_PHASE_EMIT_REWARDS, 0, phaseStart)
+	// Track reputers far from consensus in the topics rewarded, slashing and jailing persistent outliers
+	phaseStart = time.Now()
+	err = rewards.UpdateReputerOutliersOfRewardedTopics(sdkCtx, am.keeper, moduleParams, rewardNonces)
+	if err != nil {
+		sdkCtx.Logger().Error("Error updating reputer outliers: ", err)
+	}
+	am.keeper.MeasureEndBlockerPhase(metrics.END_BLOCKER_PHASE_REPUTER_OUTLIERS, 0, phaseStart)
 	// Close any open windows due this blockHeight
+	phaseStart = time.Now()
 	workerWindowsToClose := am.keeper.GetWorkerWindowTopicIds(sdkCtx, blockHeight)
 	if len(workerWindowsToClose.TopicIds) > 0 {
 		for _, topicId := range workerWindowsToClose.TopicIds {
@@ -89,13 +138,16 @@ func EndBlocker(ctx context.Context, am AppModule) error {
 					continue
 				}
 				for _, nonce := range nonces.Nonces {
-					// Skip rest of logic if the worker submission window is still open (i.e. don't close the window yet)
-					if am.keeper.BlockWithinWorkerSubmissionWindowOfNonce(topic, *nonce, blockHeight) {
+					// Skip rest of logic if the worker submission or reveal window is still open (i.e. don't close the window yet)
+					if am.keeper.BlockWithinWorkerSubmissionWindowOfNonce(topic, *nonce, blockHeight) ||
+						am.keeper.BlockWithinWorkerRevealWindowOfNonce(topic, *nonce, blockHeight) {
 						sdkCtx.Logger().Debug(fmt.Sprintf("ABCI EndBlocker %d: Worker window still open for topic: %d, nonce: %v", blockHeight, topicId, nonce))
 						continue
 					}
 					sdkCtx.Logger().Debug(fmt.Sprintf("ABCI EndBlocker %d: Closing Worker window for topic: %d, nonce: %v", blockHeight, topicId, nonce))
+					closeStart := time.Now()
 					err := allorautils.CloseWorkerNonce(&am.keeper, sdkCtx, topic, *nonce)
+					am.keeper.MeasureEndBlockerPhase(metrics.END_BLOCKER_PHASE_CLOSE_WORKER_NONCE, topicId, closeStart)
 					if err != nil {
 						sdkCtx.Logger().Info(fmt.Sprintf("Error closing worker nonce, proactively fulfilling: %s", err.Error()))
 						// Proactively close the nonce
@@ -105,6 +157,16 @@ func EndBlocker(ctx context.Context, am AppModule) error {
 						} else {
 							sdkCtx.Logger().Debug(fmt.Sprintf("Fulfilled: %t, worker nonce: %v", fulfilledNonce, nonce))
 						}
+					} else {
+						err = allorautils.PublishNetworkInferences(&am.keeper, sdkCtx, topic, *nonce)
+						if err != nil {
+							sdkCtx.Logger().Warn(fmt.Sprintf("Error publishing network inferences: %s", err.Error()))
+						}
+					}
+					// Commitments, revealed or not, are of no use once the nonce is closed
+					err = am.keeper.DeleteWorkerCommitmentsOfNonce(sdkCtx, topicId, nonce.BlockHeight)
+					if err != nil {
+						sdkCtx.Logger().Warn(fmt.Sprintf("Error deleting worker commitments: %s", err.Error()))
 					}
 				}
 			}
@@ -114,5 +176,6 @@ func EndBlocker(ctx context.Context, am AppModule) error {
 			sdkCtx.Logger().Warn(fmt.Sprintf("Error deleting worker window blockheight: %s", err.Error()))
 		}
 	}
+	am.keeper.MeasureEndBlockerPhase(metrics.END_BLOCKER_PHASE_CLOSE_WORKER_WINDOWS, 0, phaseStart)
 	return nil
 }
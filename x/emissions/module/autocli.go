@@ -72,6 +72,37 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "reputer"},
 					},
 				},
+				{
+					RpcMethod: "IsDelegateStakeAutoCompounding",
+					Use:       "is-delegate-stake-auto-compounding [topic_id] [delegator] [reputer]",
+					Short:     "True if the rewards of a delegator's stake upon a reputer in a topic are compounded into the stake as they are paid, else false",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "delegator"},
+						{ProtoField: "reputer"},
+					},
+				},
+				{
+					RpcMethod: "GetDelegatorRedelegations",
+					Use:       "delegator-redelegations [delegator]",
+					Short:     "Get the redelegations of a delegator that are still slashable for their source reputer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator"},
+					},
+				},
+				{
+					RpcMethod: "GetAccruedRewards",
+					Use:       "accrued-rewards [address]",
+					Short:     "Get the rewards accrued to an address in each topic that it has not claimed yet",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "GetTotalAccruedRewards",
+					Use:       "total-accrued-rewards",
+					Short:     "Get the sum of the rewards accrued to all addresses that they have not claimed yet",
+				},
 				{
 					RpcMethod: "GetDelegateStakePlacement",
 					Use:       "delegate-stake-placement [topic_id] [delegator] [target]",
@@ -707,6 +738,151 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "forecaster"},
 					},
 				},
+				{
+					RpcMethod: "GetScheduledTopicUpdate",
+					Use:       "scheduled-topic-update [topic_id]",
+					Short:     "Get the topic update waiting for the next epoch boundary of a topic, if any",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "GetTopicRetirement",
+					Use:       "topic-retirement [topic_id]",
+					Short:     "Get the archived topic and the refund and garbage collection progress of a retired topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "IsGmpSourceAllowed",
+					Use:       "is-gmp-source-allowed [source_chain] [source_address]",
+					Short:     "Check if a source chain and address may send GMP messages into emissions actions. True if so, else false",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "source_chain"},
+						{ProtoField: "source_address"},
+					},
+				},
+				{
+					RpcMethod: "GetGmpSubscription",
+					Use:       "gmp-subscription [subscription_id]",
+					Short:     "Get an outbound GMP subscription, including its destination and remaining prepaid balance",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "subscription_id"},
+					},
+				},
+				{
+					RpcMethod: "GetTopicGmpSubscriptions",
+					Use:       "topic-gmp-subscriptions [topic_id]",
+					Short:     "Get every outbound GMP subscription receiving the network inferences of a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "GetTopicSponsorship",
+					Use:       "topic-sponsorship [topic_id] [sponsor]",
+					Short:     "Get the sponsorship of a topic by a sponsor, paying the data sending fees of the topic's workers and reputers",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "sponsor"},
+					},
+				},
+				{
+					RpcMethod: "GetTopicSponsorships",
+					Use:       "topic-sponsorships [topic_id]",
+					Short:     "Get every sponsorship of a topic and their total remaining balance",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "GetTopicDataSendingFee",
+					Use:       "topic-data-sending-fee [topic_id]",
+					Short:     "Get the data sending fee currently charged for each worker or reputer payload sent to a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "IsTopicAllowlistEnabled",
+					Use:       "is-topic-allowlist-enabled [topic_id] [is_reputer]",
+					Short:     "Check if a topic restricts its workers (or reputers if is_reputer) to its allowlist. True if so, else false",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "is_reputer"},
+					},
+				},
+				{
+					RpcMethod: "IsInTopicAllowlist",
+					Use:       "is-in-topic-allowlist [topic_id] [is_reputer] [address]",
+					Short:     "Check if an address is in the worker (or reputer if is_reputer) allowlist of a topic. True if so, else false",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "is_reputer"},
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "GetTopicAllowlist",
+					Use:       "topic-allowlist [topic_id] [is_reputer]",
+					Short:     "Get the addresses in the worker (or reputer if is_reputer) allowlist of a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "is_reputer"},
+					},
+				},
+				{
+					RpcMethod: "GetReputerSlashingInfo",
+					Use:       "reputer-slashing-info [topic_id] [address]",
+					Short:     "Get the missed nonces, outlier epochs and jail status of a reputer in a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "GetWorkerCommitment",
+					Use:       "worker-commitment [topic_id] [block_height] [worker]",
+					Short:     "Get the commitment of a worker to its payload for a worker nonce of a commit-reveal topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "block_height"},
+						{ProtoField: "worker"},
+					},
+				},
+				{
+					RpcMethod: "GetGroundTruth",
+					Use:       "ground-truth [topic_id] [block_height]",
+					Short:     "Get the ground truth submitted for a reputer nonce of a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "block_height"},
+					},
+				},
+				{
+					RpcMethod: "GetLossMethods",
+					Use:       "loss-methods",
+					Short:     "Get the loss methods topics may declare",
+				},
+				{
+					RpcMethod: "GetNetworkInferenceHistory",
+					Use:       "network-inference-history [topic_id] [from_block_height] [to_block_height]",
+					Short:     "Get the archived network inferences of a topic between two block heights, inclusive. A to block height of 0 is unbounded",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "from_block_height"},
+						{ProtoField: "to_block_height"},
+					},
+				},
+				{
+					RpcMethod: "GetEndBlockerTimings",
+					Use:       "end-blocker-timings [num_blocks]",
+					Short:     "Get how long each phase of the EndBlocker took over the last blocks executed by the queried node, newest block first",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "num_blocks"},
+					},
+				},
 			},
 			SubCommands:          nil,
 			EnhanceCustomCommand: false,
@@ -745,6 +921,25 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "active_reputer_quantile"},
 					},
 				},
+				{
+					RpcMethod: "UpdateTopic",
+					Use:       "update-topic [sender] [topic_id] [params]",
+					Short:     "Update the parameters of a topic, effective at its next epoch boundary if it is active",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "params"},
+					},
+				},
+				{
+					RpcMethod: "RetireTopic",
+					Use:       "retire-topic [sender] [topic_id]",
+					Short:     "Permanently retire a topic, refunding its stake and deleting its state over the following blocks",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+					},
+				},
 				{
 					RpcMethod: "Register",
 					Use:       "register [sender] [topic_ids] [owner] [is_reputer]",
@@ -837,6 +1032,38 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "reputer"},
 					},
 				},
+				{
+					RpcMethod: "SetDelegateStakeAutoCompound",
+					Use:       "set-delegate-stake-auto-compound [sender] [topic_id] [reputer] [enabled]",
+					Short:     "Opt the delegation of [sender] upon a reputer in or out of compounding its rewards into its stake as they are paid",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "reputer"},
+						{ProtoField: "enabled"},
+					},
+				},
+				{
+					RpcMethod: "RedelegateStake",
+					Use:       "redelegate-stake [sender] [src_topic_id] [src_reputer] [dst_topic_id] [dst_reputer] [amount]",
+					Short:     "Move stake delegated by [sender] upon a reputer in a topic to another reputer or topic without waiting for the stake removal delay",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "src_topic_id"},
+						{ProtoField: "src_reputer"},
+						{ProtoField: "dst_topic_id"},
+						{ProtoField: "dst_reputer"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "ClaimRewards",
+					Use:       "claim-rewards [sender]",
+					Short:     "Claim the rewards accrued to [sender] across all topics",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+					},
+				},
 				{
 					RpcMethod: "FundTopic",
 					Use:       "fund-topic [sender] [topic_id] [amount] [extra_data]",
@@ -865,6 +1092,122 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "address"},
 					},
 				},
+				{
+					RpcMethod: "AddGmpAllowedSource",
+					Use:       "add-gmp-allowed-source [sender] [source_chain] [source_address]",
+					Short:     "allow a source chain and address to send Axelar GMP messages that execute emissions actions",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "source_chain"},
+						{ProtoField: "source_address"},
+					},
+				},
+				{
+					RpcMethod: "RemoveGmpAllowedSource",
+					Use:       "remove-gmp-allowed-source [sender] [source_chain] [source_address]",
+					Short:     "remove a source chain and address from the GMP allowlist",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "source_chain"},
+						{ProtoField: "source_address"},
+					},
+				},
+				{
+					RpcMethod: "CreateGmpSubscription",
+					Use:       "create-gmp-subscription [sender] [topic_id] [destination_chain] [destination_address] [fee_per_message] [prepayment]",
+					Short:     "subscribe an EVM contract to the network inferences of a topic, sent over Axelar GMP and paid from a prepaid balance",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "destination_chain"},
+						{ProtoField: "destination_address"},
+						{ProtoField: "fee_per_message"},
+						{ProtoField: "prepayment"},
+					},
+				},
+				{
+					RpcMethod: "FundGmpSubscription",
+					Use:       "fund-gmp-subscription [sender] [subscription_id] [amount]",
+					Short:     "add to the prepaid balance of a GMP subscription",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "subscription_id"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "CancelGmpSubscription",
+					Use:       "cancel-gmp-subscription [sender] [subscription_id]",
+					Short:     "cancel a GMP subscription and refund its remaining balance to the subscriber",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "subscription_id"},
+					},
+				},
+				{
+					RpcMethod: "FundTopicSponsorship",
+					Use:       "fund-topic-sponsorship [sender] [topic_id] [amount] [max_fees_per_actor_per_epoch] [max_fees_per_epoch]",
+					Short:     "add to the sponsorship of a topic paying the data sending fees of its workers and reputers, with caps of 0 meaning no cap",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "amount"},
+						{ProtoField: "max_fees_per_actor_per_epoch"},
+						{ProtoField: "max_fees_per_epoch"},
+					},
+				},
+				{
+					RpcMethod: "WithdrawTopicSponsorship",
+					Use:       "withdraw-topic-sponsorship [sender] [topic_id] [amount]",
+					Short:     "withdraw part of the remaining balance of the sender's sponsorship of a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "SetTopicAllowlistEnabled",
+					Use:       "set-topic-allowlist-enabled [sender] [topic_id] [is_reputer] [enabled]",
+					Short:     "restrict (or stop restricting) the workers, or reputers if is_reputer, of a topic to its allowlist",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "is_reputer"},
+						{ProtoField: "enabled"},
+					},
+				},
+				{
+					RpcMethod: "AddToTopicAllowlist",
+					Use:       "add-to-topic-allowlist [sender] [topic_id] [is_reputer] [address]",
+					Short:     "add an address to the worker, or reputer if is_reputer, allowlist of a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "is_reputer"},
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "RemoveFromTopicAllowlist",
+					Use:       "remove-from-topic-allowlist [sender] [topic_id] [is_reputer] [address]",
+					Short:     "remove an address from the worker, or reputer if is_reputer, allowlist of a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "is_reputer"},
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "UnjailReputer",
+					Use:       "unjail-reputer [sender] [topic_id]",
+					Short:     "unjail a reputer in a topic once its jail period has elapsed",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+					},
+				},
 				{
 					RpcMethod: "InsertWorkerPayload",
 					Use:       "insert-worker-payload [sender] [worker_data]",
@@ -874,6 +1217,27 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "worker_data_bundle"},
 					},
 				},
+				{
+					RpcMethod: "CommitWorkerPayload",
+					Use:       "commit-worker-payload [sender] [topic_id] [nonce_block_height] [commitment]",
+					Short:     "Commit to a worker payload of a commit-reveal topic, to be revealed after the worker submission window",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "nonce_block_height"},
+						{ProtoField: "commitment"},
+					},
+				},
+				{
+					RpcMethod: "RevealWorkerPayload",
+					Use:       "reveal-worker-payload [sender] [worker_data] [salt]",
+					Short:     "Reveal a worker payload committed to with commit-worker-payload",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "worker_data_bundle"},
+						{ProtoField: "salt"},
+					},
+				},
 				{
 					RpcMethod: "InsertReputerPayload",
 					Use:       "insert-reputer-payload [sender] [reputer_data]",
@@ -883,6 +1247,35 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "reputer_value_bundle"},
 					},
 				},
+				{
+					RpcMethod: "InsertWorkerPayloads",
+					Use:       "insert-worker-payloads [sender] [worker_data...]",
+					Short:     "Insert many worker payloads, possibly for different topics, in one transaction. Rejected payloads do not revert the others",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "worker_data_bundles", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "InsertReputerPayloads",
+					Use:       "insert-reputer-payloads [sender] [reputer_data...]",
+					Short:     "Insert many reputer payloads, possibly for different topics, in one transaction. Rejected payloads do not revert the others",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "reputer_value_bundles", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "SubmitGroundTruth",
+					Use:       "submit-ground-truth [sender] [topic_id] [reputer_nonce_block_height] [ground_truth]",
+					Short:     "Submit the ground truth of a reputer nonce so the chain cross-checks reputer losses. Sender must be the topic creator or a whitelist admin",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "reputer_nonce_block_height"},
+						{ProtoField: "ground_truth"},
+					},
+				},
 			},
 			SubCommands:          nil,
 			EnhanceCustomCommand: false,
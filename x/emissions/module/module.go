@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/core/appmodule"
 	keeper "github.com/allora-network/allora-chain/x/emissions/keeper"
@@ -13,12 +14,15 @@ import (
 	migrationV3 "github.com/allora-network/allora-chain/x/emissions/migrations/v3"
 	migrationV4 "github.com/allora-network/allora-chain/x/emissions/migrations/v4"
 	migrationV5 "github.com/allora-network/allora-chain/x/emissions/migrations/v5"
+	migrationV6 "github.com/allora-network/allora-chain/x/emissions/migrations/v6"
+	"github.com/allora-network/allora-chain/x/emissions/simulation"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 )
 
@@ -27,21 +31,27 @@ var (
 	_ module.HasGenesis       = AppModule{} // nolint: exhaustruct
 	_ appmodule.AppModule     = AppModule{} // nolint: exhaustruct
 	_ appmodule.HasEndBlocker = AppModule{} // nolint: exhaustruct
+
+	_ module.AppModuleSimulation = AppModule{} // nolint: exhaustruct
 )
 
 // ConsensusVersion defines the current module consensus version.
-const ConsensusVersion = 5
+const ConsensusVersion = 6
 
 type AppModule struct {
-	cdc    codec.Codec
-	keeper keeper.Keeper
+	cdc           codec.Codec
+	keeper        keeper.Keeper
+	accountKeeper keeper.AccountKeeper
+	bankKeeper    keeper.BankKeeper
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(cdc codec.Codec, keeper keeper.Keeper) AppModule {
+func NewAppModule(cdc codec.Codec, keeper keeper.Keeper, ak keeper.AccountKeeper, bk keeper.BankKeeper) AppModule {
 	return AppModule{
-		cdc:    cdc,
-		keeper: keeper,
+		cdc:           cdc,
+		keeper:        keeper,
+		accountKeeper: ak,
+		bankKeeper:    bk,
 	}
 }
 
@@ -94,6 +104,11 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	}); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5: %v", types.ModuleName, err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 5, func(ctx sdk.Context) error {
+		return migrationV6.MigrateStore(ctx, am.keeper)
+	}); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 5 to 6: %v", types.ModuleName, err))
+	}
 }
 
 // DefaultGenesis returns default genesis state as raw bytes for the module.
@@ -142,6 +157,11 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 			sdkCtx.Logger().Error("Recover panic in EndBlocker", err)
 		}
 	}()
+	start := time.Now()
+	am.keeper.StartEndBlockerTimings(sdkCtx.BlockHeight())
+	defer func() {
+		am.keeper.ObserveEndBlocker(sdkCtx.BlockHeight(), time.Since(start))
+	}()
 
 	err := EndBlocker(ctx, am)
 	if err != nil {
@@ -150,3 +170,18 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 	}
 	return err
 }
+
+// GenerateGenesisState creates a randomized GenState of the emissions module.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// RegisterStoreDecoder registers a decoder for the emissions module's types.
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simtypes.NewStoreDecoderFuncFromCollectionsSchema(am.keeper.GetSchema())
+}
+
+// WeightedOperations returns all the emissions module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(simState.AppParams, simState.TxConfig, am.accountKeeper, am.bankKeeper, am.keeper)
+}
@@ -1,10 +1,13 @@
 package rewards
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/allora-network/allora-chain/x/emissions/keeper"
 	allorautils "github.com/allora-network/allora-chain/x/emissions/keeper/actor_utils"
+	"github.com/allora-network/allora-chain/x/emissions/metrics"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -23,7 +26,11 @@ func UpdateReputerNonce(ctx sdk.Context, k keeper.Keeper, topic types.Topic, blo
 		if block >= closingReputerNonceMinBlockHeight {
 			ctx.Logger().Debug(fmt.Sprintf("ABCI EndBlocker: Closing reputer nonce for topic: %v nonce: %v, min: %d. \n",
 				topic.Id, nonce, closingReputerNonceMinBlockHeight))
+			closeStart := time.Now()
 			err = allorautils.CloseReputerNonce(&k, ctx, topic, *nonce.ReputerNonce)
+			k.MeasureEndBlockerPhase(metrics.END_BLOCKER_PHASE_CLOSE_REPUTER_NONCE, topic.Id, closeStart)
+			// Reputers can only have submitted losses if the worker nonce was fulfilled
+			reputersCouldSubmit := !errors.Is(err, types.ErrNonceStillUnfulfilled)
 			if err != nil {
 				ctx.Logger().Warn(fmt.Sprintf("Error closing reputer nonce: %s", err.Error()))
 				// Proactively close the nonce to avoid
@@ -32,6 +39,11 @@ func UpdateReputerNonce(ctx sdk.Context, k keeper.Keeper, topic types.Topic, blo
 					ctx.Logger().Warn(fmt.Sprintf("Error fulfilling reputer nonce: %s", err.Error()))
 				}
 			}
+			if reputersCouldSubmit {
+				if err := k.UpdateReputerMissedNonces(ctx, topic.Id, nonce.ReputerNonce.BlockHeight); err != nil {
+					ctx.Logger().Warn(fmt.Sprintf("Error updating missed reputer nonces: %s", err.Error()))
+				}
+			}
 		}
 	}
 	return err
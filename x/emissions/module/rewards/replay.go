@@ -0,0 +1,108 @@
+package rewards
+
+import (
+	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	allorautils "github.com/allora-network/allora-chain/x/emissions/keeper/actor_utils"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type WorkerRegret struct {
+	Worker string
+	Regret alloraMath.Dec
+}
+
+// The network regrets and the rewards distribution computed by replaying a reputer nonce of a topic
+type TopicRewardsReplay struct {
+	NetworkLosses       types.ValueBundle
+	InfererRegrets      []WorkerRegret
+	ForecasterRegrets   []WorkerRegret
+	NaiveInfererRegrets []WorkerRegret
+	Breakdown           RewardsBreakdown
+}
+
+// ReplayTopicRewards computes the network regrets and the rewards distribution of the reputer nonce at blockHeight
+// of a topic as the EndBlocker does, without paying anything out. The regrets, scores and score EMAs it derives them
+// from are updated once per nonce, so the nonce must not be rewarded yet: it is either still open, and then closed
+// as the EndBlocker would close it at the block of ctx, or closed and awaiting its rewards.
+// Like the EndBlocker, it updates the state, hence it is meant to be run over a cached or throwaway state.
+func ReplayTopicRewards(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	topicId uint64,
+	blockHeight int64,
+	topicReward alloraMath.Dec,
+	moduleParams types.Params,
+) (TopicRewardsReplay, error) {
+	topic, err := k.GetTopic(ctx, topicId)
+	if err != nil {
+		return TopicRewardsReplay{}, errors.Wrapf(err, "failed to get topic %d", topicId)
+	}
+	nonce := types.Nonce{BlockHeight: blockHeight}
+	open, err := k.IsReputerNonceUnfulfilled(ctx, topicId, &nonce)
+	if err != nil {
+		return TopicRewardsReplay{}, err
+	}
+	if open {
+		// Closing the nonce updates the network regrets
+		if err := allorautils.CloseReputerNonce(&k, ctx, topic, nonce); err != nil {
+			return TopicRewardsReplay{}, errors.Wrapf(err, "failed to close the reputer nonce of topic %d at block %d", topicId, blockHeight)
+		}
+	} else {
+		rewardNonce, err := k.GetTopicRewardNonce(ctx, topicId)
+		if err != nil {
+			return TopicRewardsReplay{}, err
+		}
+		if rewardNonce != blockHeight {
+			return TopicRewardsReplay{}, errors.Wrapf(types.ErrNotFound,
+				"the reputer nonce of topic %d at block %d is neither open nor awaiting its rewards, "+
+					"replay it over the state from before the block it was closed at", topicId, blockHeight)
+		}
+	}
+	networkLosses, err := k.GetNetworkLossBundleAtBlock(ctx, topicId, blockHeight)
+	if err != nil {
+		return TopicRewardsReplay{}, err
+	}
+
+	replay := TopicRewardsReplay{
+		NetworkLosses:       *networkLosses,
+		InfererRegrets:      make([]WorkerRegret, 0, len(networkLosses.InfererValues)),
+		ForecasterRegrets:   make([]WorkerRegret, 0, len(networkLosses.ForecasterValues)),
+		NaiveInfererRegrets: make([]WorkerRegret, 0, len(networkLosses.InfererValues)),
+		Breakdown:           RewardsBreakdown{}, //nolint:exhaustruct // set once the regrets are known
+	}
+	for _, infererLoss := range networkLosses.InfererValues {
+		regret, _, err := k.GetInfererNetworkRegret(ctx, topicId, infererLoss.Worker)
+		if err != nil {
+			return TopicRewardsReplay{}, err
+		}
+		replay.InfererRegrets = append(replay.InfererRegrets, WorkerRegret{Worker: infererLoss.Worker, Regret: regret.Value})
+		naiveRegret, _, err := k.GetNaiveInfererNetworkRegret(ctx, topicId, infererLoss.Worker)
+		if err != nil {
+			return TopicRewardsReplay{}, err
+		}
+		replay.NaiveInfererRegrets = append(replay.NaiveInfererRegrets, WorkerRegret{Worker: infererLoss.Worker, Regret: naiveRegret.Value})
+	}
+	for _, forecasterLoss := range networkLosses.ForecasterValues {
+		regret, _, err := k.GetForecasterNetworkRegret(ctx, topicId, forecasterLoss.Worker)
+		if err != nil {
+			return TopicRewardsReplay{}, err
+		}
+		replay.ForecasterRegrets = append(replay.ForecasterRegrets, WorkerRegret{Worker: forecasterLoss.Worker, Regret: regret.Value})
+	}
+
+	replay.Breakdown, err = GenerateRewardsBreakdownByTopicParticipant(GenerateRewardsDistributionByTopicParticipantArgs{
+		Ctx:          ctx,
+		K:            k,
+		TopicId:      topicId,
+		TopicReward:  &topicReward,
+		BlockHeight:  blockHeight,
+		ModuleParams: moduleParams,
+	})
+	if err != nil {
+		return TopicRewardsReplay{}, err
+	}
+	return replay, nil
+}
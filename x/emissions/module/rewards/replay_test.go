@@ -0,0 +1,145 @@
+package rewards_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	actorutils "github.com/allora-network/allora-chain/x/emissions/keeper/actor_utils"
+	inferencesynthesis "github.com/allora-network/allora-chain/x/emissions/keeper/inference_synthesis"
+	"github.com/allora-network/allora-chain/x/emissions/module/rewards"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func (s *RewardsTestSuite) TestReplayTopicRewardsMatchesTheRewardsDistribution() {
+	require := s.Require()
+	k := s.emissionsKeeper
+
+	blockHeight0 := int64(100)
+	s.ctx = s.ctx.WithBlockHeight(blockHeight0)
+	workerIndexes := s.returnIndexes(0, 3)
+	reputerIndexes := s.returnIndexes(3, 3)
+	stake := cosmosMath.NewInt(1000000000000000000).Mul(inferencesynthesis.CosmosIntOneE18())
+	topicId := s.setUpTopic(blockHeight0, workerIndexes, reputerIndexes, stake, alloraMath.MustNewDecFromString("0.1"))
+	workerValues := []TestWorkerValue{
+		{Index: 0, Value: "0.1"},
+		{Index: 1, Value: "0.2"},
+		{Index: 2, Value: "0.3"},
+	}
+	reputerValues := []TestWorkerValue{
+		{Index: 3, Value: "0.1"},
+		{Index: 4, Value: "0.2"},
+		{Index: 5, Value: "0.3"},
+	}
+	blockHeight := s.closeRewardNonce(topicId, workerValues, reputerValues, s.addrs[workerIndexes[0]], "0.1", "0.1")
+
+	moduleParams, err := k.GetParams(s.ctx)
+	require.NoError(err)
+	topicReward := alloraMath.NewDecFromInt64(1000000)
+
+	distributionCtx, _ := s.ctx.CacheContext()
+	expectedRewards, expectedReputerReward, err := rewards.GenerateRewardsDistributionByTopicParticipant(
+		rewards.GenerateRewardsDistributionByTopicParticipantArgs{
+			Ctx:          distributionCtx,
+			K:            k,
+			TopicId:      topicId,
+			TopicReward:  &topicReward,
+			BlockHeight:  blockHeight,
+			ModuleParams: moduleParams,
+		},
+	)
+	require.NoError(err)
+
+	replayCtx, _ := s.ctx.CacheContext()
+	replay, err := rewards.ReplayTopicRewards(replayCtx, k, topicId, blockHeight, topicReward, moduleParams)
+	require.NoError(err)
+
+	require.True(areTaskRewardsEqualIgnoringTopicId(s, expectedRewards, replay.Breakdown.Rewards))
+	require.Equal(expectedReputerReward, replay.Breakdown.TaskReputerReward)
+	require.Len(replay.Breakdown.ReputerScores, len(reputerIndexes))
+	require.Len(replay.Breakdown.InfererScores, len(workerIndexes))
+	require.Len(replay.InfererRegrets, len(workerIndexes))
+	require.Len(replay.NaiveInfererRegrets, len(workerIndexes))
+	require.Equal(blockHeight, replay.NetworkLosses.ReputerRequestNonce.ReputerNonce.BlockHeight)
+
+	// The rewards of the three tasks make up the topic reward
+	totalTaskRewards, err := replay.Breakdown.TaskReputerReward.Add(replay.Breakdown.TaskInferenceReward)
+	require.NoError(err)
+	totalTaskRewards, err = totalTaskRewards.Add(replay.Breakdown.TaskForecastingReward)
+	require.NoError(err)
+	inDelta, err := alloraMath.InDelta(topicReward, totalTaskRewards, alloraMath.MustNewDecFromString("0.0001"))
+	require.NoError(err)
+	require.True(inDelta)
+}
+
+func (s *RewardsTestSuite) TestReplayTopicRewardsOfAnOpenNonceReproducesItsPayout() {
+	require := s.Require()
+	k := s.emissionsKeeper
+
+	blockHeight0 := int64(100)
+	s.ctx = s.ctx.WithBlockHeight(blockHeight0)
+	workerIndexes := s.returnIndexes(0, 3)
+	reputerIndexes := s.returnIndexes(3, 3)
+	stake := cosmosMath.NewInt(1000000000000000000).Mul(inferencesynthesis.CosmosIntOneE18())
+	topicId := s.setUpTopic(blockHeight0, workerIndexes, reputerIndexes, stake, alloraMath.MustNewDecFromString("0.1"))
+	workerValues := []TestWorkerValue{
+		{Index: 0, Value: "0.1"},
+		{Index: 1, Value: "0.2"},
+		{Index: 2, Value: "0.3"},
+	}
+	reputerValues := []TestWorkerValue{
+		{Index: 3, Value: "0.1"},
+		{Index: 4, Value: "0.2"},
+		{Index: 5, Value: "0.3"},
+	}
+	blockHeight, topic, reputerNonce := s.openRewardNonce(topicId, workerValues, reputerValues, s.addrs[workerIndexes[0]], "0.1", "0.1")
+
+	moduleParams, err := k.GetParams(s.ctx)
+	require.NoError(err)
+	topicReward := alloraMath.NewDecFromInt64(1000000)
+
+	// The EndBlocker closes the nonce then distributes its rewards
+	endBlockerCtx, _ := s.ctx.CacheContext()
+	require.NoError(actorutils.CloseReputerNonce(&k, endBlockerCtx, topic, reputerNonce))
+	paidRewards, paidReputerReward, err := rewards.GenerateRewardsDistributionByTopicParticipant(
+		rewards.GenerateRewardsDistributionByTopicParticipantArgs{
+			Ctx:          endBlockerCtx,
+			K:            k,
+			TopicId:      topicId,
+			TopicReward:  &topicReward,
+			BlockHeight:  blockHeight,
+			ModuleParams: moduleParams,
+		},
+	)
+	require.NoError(err)
+
+	replayCtx, _ := s.ctx.CacheContext()
+	replay, err := rewards.ReplayTopicRewards(replayCtx, k, topicId, blockHeight, topicReward, moduleParams)
+	require.NoError(err)
+	require.True(areTaskRewardsEqualIgnoringTopicId(s, paidRewards, replay.Breakdown.Rewards))
+	require.Equal(paidReputerReward, replay.Breakdown.TaskReputerReward)
+
+	// The regrets replayed are those the nonce left
+	for _, infererRegret := range replay.InfererRegrets {
+		regret, _, err := k.GetInfererNetworkRegret(endBlockerCtx, topicId, infererRegret.Worker)
+		require.NoError(err)
+		require.Equal(regret.Value, infererRegret.Regret)
+	}
+
+	// Once rewarded, the regrets and score EMAs already include the nonce
+	require.NoError(k.DeleteTopicRewardNonce(endBlockerCtx, topicId))
+	_, err = rewards.ReplayTopicRewards(endBlockerCtx, k, topicId, blockHeight, topicReward, moduleParams)
+	require.ErrorIs(err, types.ErrNotFound)
+}
+
+func (s *RewardsTestSuite) TestReplayTopicRewardsOfAnUnknownNonceFails() {
+	require := s.Require()
+	k := s.emissionsKeeper
+
+	workerIndexes := s.returnIndexes(0, 3)
+	reputerIndexes := s.returnIndexes(3, 3)
+	topicId := s.setUpTopic(100, workerIndexes, reputerIndexes, cosmosMath.NewInt(1000), alloraMath.MustNewDecFromString("0.1"))
+	moduleParams, err := k.GetParams(s.ctx)
+	require.NoError(err)
+
+	_, err = rewards.ReplayTopicRewards(s.ctx, k, topicId, 100, alloraMath.OneDec(), moduleParams)
+	require.ErrorIs(err, types.ErrNotFound)
+}
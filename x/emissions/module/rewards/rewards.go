@@ -2,6 +2,7 @@ package rewards
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/errors"
 	"cosmossdk.io/log"
@@ -9,6 +10,7 @@ import (
 	"github.com/allora-network/allora-chain/app/params"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/metrics"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -32,6 +34,28 @@ type GenerateRewardsDistributionByTopicParticipantArgs struct {
 	ModuleParams types.Params
 }
 
+// The intermediate values of the distribution of the rewards of a topic to its participants
+type RewardsBreakdown struct {
+	ReputerScores              []types.Score
+	InfererScores              []types.Score
+	ForecasterScores           []types.Score
+	Reputers                   []string
+	ReputersRewardFractions    []alloraMath.Dec
+	Inferers                   []string
+	InferersRewardFractions    []alloraMath.Dec
+	Forecasters                []string
+	ForecastersRewardFractions []alloraMath.Dec
+	ReputerEntropy             alloraMath.Dec
+	InferenceEntropy           alloraMath.Dec
+	ForecastingEntropy         alloraMath.Dec
+	Chi                        alloraMath.Dec // forecasting utility
+	Gamma                      alloraMath.Dec // normalization factor
+	TaskReputerReward          alloraMath.Dec
+	TaskInferenceReward        alloraMath.Dec
+	TaskForecastingReward      alloraMath.Dec
+	Rewards                    []types.TaskReward
+}
+
 type GetDistributionAndPayoutRewardsToTopicActorsArgs struct {
 	Ctx              sdk.Context
 	K                keeper.Keeper
@@ -141,6 +165,7 @@ func EmitRewards(args EmitRewardsArgs) error {
 		}(topicId, topicRewardNonce)
 
 		topicReward := topicRewards[topicId]
+		payoutStart := time.Now()
 		rewardInTopicToReputers, err := getDistributionAndPayoutRewardsToTopicActors(GetDistributionAndPayoutRewardsToTopicActorsArgs{
 			Ctx:              args.Ctx,
 			K:                args.K,
@@ -149,6 +174,7 @@ func EmitRewards(args EmitRewardsArgs) error {
 			TopicReward:      topicReward,
 			ModuleParams:     args.ModuleParams,
 		})
+		args.K.MeasureEndBlockerPhase(metrics.END_BLOCKER_PHASE_TOPIC_REWARDS, topicId, payoutStart)
 		if err != nil {
 			Logger(args.Ctx).Error(fmt.Sprintf("Failed to process rewards for topic %d: %s", topicId, err.Error()))
 			continue
@@ -207,7 +233,7 @@ func getDistributionAndPayoutRewardsToTopicActors(args GetDistributionAndPayoutR
 	}
 
 	// Pay out rewards to topic participants
-	payoutErrors := payoutRewards(args.Ctx, args.K, totalRewardsDistribution)
+	payoutErrors := payoutRewards(args.Ctx, args.K, args.ModuleParams, totalRewardsDistribution)
 	if len(payoutErrors) > 0 {
 		for _, payoutErr := range payoutErrors {
 			Logger(args.Ctx).Warn(fmt.Sprintf("Failed to pay out rewards to participant in Topic %d: %s", args.TopicId, payoutErr.Error()))
@@ -341,48 +367,58 @@ func GenerateRewardsDistributionByTopicParticipant(
 	taskReputerReward alloraMath.Dec,
 	err error,
 ) {
+	breakdown, err := GenerateRewardsBreakdownByTopicParticipant(args)
+	if err != nil {
+		return nil, alloraMath.Dec{}, err
+	}
+	return breakdown.Rewards, breakdown.TaskReputerReward, nil
+}
+
+// Calculates the distribution of rewards to topic participants as GenerateRewardsDistributionByTopicParticipant does,
+// also returning the intermediate values it is derived from
+func GenerateRewardsBreakdownByTopicParticipant(args GenerateRewardsDistributionByTopicParticipantArgs) (RewardsBreakdown, error) {
 	if args.TopicReward == nil {
-		return nil, alloraMath.Dec{}, types.ErrInvalidReward
+		return RewardsBreakdown{}, types.ErrInvalidReward
 	}
 	args.Ctx.Logger().Debug(fmt.Sprintf("Generating rewards distribution for topic: %d, block: %d, topicReward: %s", args.TopicId, args.BlockHeight, args.TopicReward.String()))
 	bundles, err := args.K.GetReputerLossBundlesAtBlock(args.Ctx, args.TopicId, args.BlockHeight)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reputer loss bundle at block %d", args.BlockHeight)
+		return RewardsBreakdown{}, errors.Wrapf(err, "failed to get reputer loss bundle at block %d", args.BlockHeight)
 	}
 	if bundles != nil && len(bundles.ReputerValueBundles) == 0 {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(types.ErrInvalidReward, "empty reputer loss bundles")
+		return RewardsBreakdown{}, errors.Wrapf(types.ErrInvalidReward, "empty reputer loss bundles")
 	}
 
 	lossBundles, err := args.K.GetNetworkLossBundleAtBlock(args.Ctx, args.TopicId, args.BlockHeight)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get network loss bundle at block %d", args.BlockHeight)
+		return RewardsBreakdown{}, errors.Wrapf(err, "failed to get network loss bundle at block %d", args.BlockHeight)
 	}
 
 	// Calculate and Set the reputer scores
 	reputerScores, err := GenerateReputerScores(args.Ctx, args.K, args.TopicId, args.BlockHeight, *bundles)
 	if err != nil {
-		return nil, alloraMath.Dec{}, err
+		return RewardsBreakdown{}, err
 	}
 	if len(reputerScores) == 0 {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(types.ErrInvalidReward, "empty reputer scores")
+		return RewardsBreakdown{}, errors.Wrapf(types.ErrInvalidReward, "empty reputer scores")
 	}
 
 	// Calculate and Set the worker scores for their inference work
 	infererScores, err := GenerateInferenceScores(args.Ctx, args.K, args.TopicId, args.BlockHeight, *lossBundles)
 	if err != nil {
-		return nil, alloraMath.Dec{}, err
+		return RewardsBreakdown{}, err
 	}
 
 	// Calculate and Set the worker scores for their forecast work
 	forecasterScores, err := GenerateForecastScores(args.Ctx, args.K, args.TopicId, args.BlockHeight, *lossBundles)
 	if err != nil {
-		return nil, alloraMath.Dec{}, err
+		return RewardsBreakdown{}, err
 	}
 
 	// Get reputer participants' addresses and reward fractions to be used in the reward round for topic
 	reputers, reputersRewardFractions, err := GetReputersRewardFractions(args.Ctx, args.K, args.TopicId, args.ModuleParams.PRewardReputer, reputerScores)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reputer reward round data")
+		return RewardsBreakdown{}, errors.Wrapf(err, "failed to get reputer reward round data")
 	}
 
 	// Get reputer task entropy
@@ -396,7 +432,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 		reputersRewardFractions,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reputer task entropy")
+		return RewardsBreakdown{}, errors.Wrapf(err, "failed to get reputer task entropy")
 	}
 
 	// Get inferer reward fractions
@@ -410,7 +446,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 		infererScores,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get inferer reward fractions")
+		return RewardsBreakdown{}, errors.Wrapf(err, "failed to get inferer reward fractions")
 	}
 
 	// Get inference entropy
@@ -424,7 +460,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 		inferersRewardFractions,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get inference task entropy")
+		return RewardsBreakdown{}, errors.Wrapf(err, "failed to get inference task entropy")
 	}
 
 	// Get forecaster reward fractions
@@ -438,7 +474,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 		forecasterScores,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get forecaster reward fractions")
+		return RewardsBreakdown{}, errors.Wrapf(err, "failed to get forecaster reward fractions")
 	}
 
 	var forecastingEntropy alloraMath.Dec
@@ -454,7 +490,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 			forecastersRewardFractions,
 		)
 		if err != nil {
-			return []types.TaskReward{}, alloraMath.Dec{}, err
+			return RewardsBreakdown{}, err
 		}
 	} else {
 		// If there are no forecasters, set forecasting entropy to zero
@@ -462,20 +498,20 @@ func GenerateRewardsDistributionByTopicParticipant(
 	}
 
 	// Get Total Rewards for Reputation task
-	taskReputerReward, err = GetRewardForReputerTaskInTopic(
+	taskReputerReward, err := GetRewardForReputerTaskInTopic(
 		inferenceEntropy,
 		forecastingEntropy,
 		reputerEntropy,
 		args.TopicReward,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reward for reputer task in topic")
+		return RewardsBreakdown{}, errors.Wrapf(err, "failed to get reward for reputer task in topic")
 	}
 
 	// Get previous forecaster score ratio for topic
 	previousForecasterScoreRatio, err := args.K.GetPreviousForecasterScoreRatio(args.Ctx, args.TopicId)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get previous forecast score ratio")
+		return RewardsBreakdown{}, errors.Wrapf(err, "failed to get previous forecast score ratio")
 	}
 
 	// Get chi (Forecasting Utility) and gamma (Normalization Factor)
@@ -489,14 +525,14 @@ func GenerateRewardsDistributionByTopicParticipant(
 		args.ModuleParams.TaskRewardAlpha,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get chi and gamma")
+		return RewardsBreakdown{}, errors.Wrapf(err, "failed to get chi and gamma")
 	}
 	types.EmitNewForecastTaskUtilityScoreSetEvent(args.Ctx, args.TopicId, forecastingTaskUtilityScore)
 
 	// Set updated forecaster score ratio
 	err = args.K.SetPreviousForecasterScoreRatio(args.Ctx, args.TopicId, updatedForecasterScoreRatio)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to set previous forecast score ratio")
+		return RewardsBreakdown{}, errors.Wrapf(err, "failed to set previous forecast score ratio")
 	}
 
 	// Get Total Rewards for Inference task
@@ -509,7 +545,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 		gamma,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reward for inference task in topic")
+		return RewardsBreakdown{}, errors.Wrapf(err, "failed to get reward for inference task in topic")
 	}
 
 	// Get Total Rewards for Forecasting task
@@ -522,10 +558,10 @@ func GenerateRewardsDistributionByTopicParticipant(
 		gamma,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reward for forecasting task in topic")
+		return RewardsBreakdown{}, errors.Wrapf(err, "failed to get reward for forecasting task in topic")
 	}
 
-	totalRewardsDistribution = make([]types.TaskReward, 0)
+	totalRewardsDistribution := make([]types.TaskReward, 0)
 
 	// Get Distribution of Rewards per Reputer
 	reputerRewards, err := GetRewardPerReputer(
@@ -537,7 +573,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 		reputersRewardFractions,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reputer rewards")
+		return RewardsBreakdown{}, errors.Wrapf(err, "failed to get reputer rewards")
 	}
 	totalRewardsDistribution = append(totalRewardsDistribution, reputerRewards...)
 
@@ -550,7 +586,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 		inferersRewardFractions,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get inference rewards")
+		return RewardsBreakdown{}, errors.Wrapf(err, "failed to get inference rewards")
 	}
 	totalRewardsDistribution = append(totalRewardsDistribution, inferenceRewards...)
 
@@ -563,26 +599,69 @@ func GenerateRewardsDistributionByTopicParticipant(
 		forecastersRewardFractions,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get forecast rewards")
+		return RewardsBreakdown{}, errors.Wrapf(err, "failed to get forecast rewards")
 	}
 	totalRewardsDistribution = append(totalRewardsDistribution, forecastRewards...)
 
-	return totalRewardsDistribution, taskReputerReward, nil
+	return RewardsBreakdown{
+		ReputerScores:              reputerScores,
+		InfererScores:              infererScores,
+		ForecasterScores:           forecasterScores,
+		Reputers:                   reputers,
+		ReputersRewardFractions:    reputersRewardFractions,
+		Inferers:                   inferers,
+		InferersRewardFractions:    inferersRewardFractions,
+		Forecasters:                forecasters,
+		ForecastersRewardFractions: forecastersRewardFractions,
+		ReputerEntropy:             reputerEntropy,
+		InferenceEntropy:           inferenceEntropy,
+		ForecastingEntropy:         forecastingEntropy,
+		Chi:                        chi,
+		Gamma:                      gamma,
+		TaskReputerReward:          taskReputerReward,
+		TaskInferenceReward:        taskInferenceReward,
+		TaskForecastingReward:      taskForecastingReward,
+		Rewards:                    totalRewardsDistribution,
+	}, nil
 }
 
 // pay out the rewards to the participants
 // this function moves tokens from the rewards module to the participants
 // if it fails to pay a particular participant, it will continue to the next participant
+// when reward accrual is enabled, worker rewards are credited to the reward ledger in one transfer instead
 func payoutRewards(
 	ctx sdk.Context,
 	k keeper.Keeper,
+	moduleParams types.Params,
 	rewards []types.TaskReward,
 ) []error {
 	ret := make([]error, 0) // errors to return from paying individual actors
 	reputerAndDelegatorRewards := make([]types.TaskReward, 0)
 	infererRewards := make([]types.TaskReward, 0)
 	forecasterRewards := make([]types.TaskReward, 0)
+	workerRewardsToAccrue := make([]types.TaskReward, 0)
+	accruals := make([]types.TopicIdActorIdInt, 0)
 	blockHeight := ctx.BlockHeight()
+
+	// records a worker reward once it is paid out or accrued
+	recordWorkerReward := func(reward types.TaskReward) error {
+		if reward.Type == types.WorkerInferenceRewardType {
+			infererRewards = append(infererRewards, reward)
+
+			err := k.IncrementCountInfererInclusionsInTopic(ctx, reward.TopicId, reward.Address)
+			if err != nil {
+				return errors.Wrapf(err, "failed to increment count inferer inclusions in topic")
+			}
+		} else if reward.Type == types.WorkerForecastRewardType {
+			forecasterRewards = append(forecasterRewards, reward)
+
+			err := k.IncrementCountForecasterInclusionsInTopic(ctx, reward.TopicId, reward.Address)
+			if err != nil {
+				return errors.Wrapf(err, "failed to increment count forecaster inclusions in topic")
+			}
+		}
+		return nil
+	}
 	for _, reward := range rewards {
 		if reward.Reward.IsZero() {
 			continue
@@ -632,6 +711,12 @@ func payoutRewards(
 				ret = append(ret, errors.Wrapf(err, "failed to decode payout address: %s", reward.Address))
 				continue
 			}
+			if moduleParams.RewardAccrualEnabled {
+				// Worker rewards are accrued together once all of them are known
+				workerRewardsToAccrue = append(workerRewardsToAccrue, reward)
+				accruals = append(accruals, types.TopicIdActorIdInt{TopicId: reward.TopicId, ActorId: reward.Address, Int: rewardInt})
+				continue
+			}
 			err = k.SendCoinsFromModuleToAccount(
 				ctx,
 				types.AlloraRewardsAccountName,
@@ -648,26 +733,36 @@ func payoutRewards(
 				continue
 			}
 
-			if reward.Type == types.WorkerInferenceRewardType {
-				infererRewards = append(infererRewards, reward)
-
-				err := k.IncrementCountInfererInclusionsInTopic(ctx, reward.TopicId, reward.Address)
-				if err != nil {
-					ret = append(ret, errors.Wrapf(err, "failed to increment count inferer inclusions in topic"))
-					continue
-				}
-			} else if reward.Type == types.WorkerForecastRewardType {
-				forecasterRewards = append(forecasterRewards, reward)
+			if err := recordWorkerReward(reward); err != nil {
+				ret = append(ret, err)
+			}
+		}
+	}
 
-				err := k.IncrementCountForecasterInclusionsInTopic(ctx, reward.TopicId, reward.Address)
-				if err != nil {
-					ret = append(ret, errors.Wrapf(err, "failed to increment count forecaster inclusions in topic"))
-					continue
+	if len(accruals) > 0 {
+		err := k.AccrueRewards(ctx, types.AlloraRewardsAccountName, accruals)
+		if err != nil {
+			ret = append(ret, errors.Wrapf(err, "failed to accrue worker rewards"))
+		} else {
+			for _, reward := range workerRewardsToAccrue {
+				if err := recordWorkerReward(reward); err != nil {
+					ret = append(ret, err)
 				}
 			}
 		}
 	}
 
+	// Delegator rewards were credited to the reward per share of their reputers, so they may now be compounded
+	for _, reward := range rewards {
+		if reward.Type != types.ReputerAndDelegatorRewardType {
+			continue
+		}
+		err := k.CompoundAutoCompoundingDelegateRewards(ctx, reward.TopicId, reward.Address)
+		if err != nil {
+			ret = append(ret, errors.Wrapf(err, "failed to compound delegator rewards upon reputer %s", reward.Address))
+		}
+	}
+
 	types.EmitNewInfererRewardsSettledEvent(ctx, blockHeight, infererRewards)
 	types.EmitNewForecasterRewardsSettledEvent(ctx, blockHeight, forecasterRewards)
 	types.EmitNewReputerAndDelegatorRewardsSettledEvent(ctx, blockHeight, reputerAndDelegatorRewards)
@@ -78,11 +78,12 @@ func (s *RewardsTestSuite) SetupTest() {
 		types.AlloraStakingAccountName: {"burner", "minter", "staking"},
 		types.AlloraRewardsAccountName: {"minter"},
 		types.AlloraPendingRewardForDelegatorAccountName: {"minter"},
-		"ecosystem":              {"minter"},
-		"bonded_tokens_pool":     {"burner", "staking"},
-		"not_bonded_tokens_pool": {"burner", "staking"},
-		multiPerm:                {"burner", "minter", "staking"},
-		randomPerm:               {"random"},
+		types.AlloraAccruedRewardsAccountName:            {"minter"},
+		"ecosystem":                                      {"minter"},
+		"bonded_tokens_pool":                             {"burner", "staking"},
+		"not_bonded_tokens_pool":                         {"burner", "staking"},
+		multiPerm:                                        {"burner", "minter", "staking"},
+		randomPerm:                                       {"random"},
 	}
 
 	accountKeeper := authkeeper.NewAccountKeeper(
@@ -133,12 +134,12 @@ func (s *RewardsTestSuite) SetupTest() {
 	s.bankKeeper = bankKeeper
 	s.emissionsKeeper = emissionsKeeper
 	s.key = key
-	emissionsAppModule := module.NewAppModule(encCfg.Codec, s.emissionsKeeper)
+	emissionsAppModule := module.NewAppModule(encCfg.Codec, s.emissionsKeeper, accountKeeper, bankKeeper)
 	defaultEmissionsGenesis := emissionsAppModule.DefaultGenesis(encCfg.Codec)
 	emissionsAppModule.InitGenesis(ctx, encCfg.Codec, defaultEmissionsGenesis)
 	s.msgServer = msgserver.NewMsgServerImpl(s.emissionsKeeper)
 	s.emissionsAppModule = emissionsAppModule
-	mintAppModule := mint.NewAppModule(encCfg.Codec, mintKeeper, accountKeeper)
+	mintAppModule := mint.NewAppModule(encCfg.Codec, mintKeeper, accountKeeper, bankKeeper)
 	defaultMintGenesis := mintAppModule.DefaultGenesis(encCfg.Codec)
 	mintAppModule.InitGenesis(ctx, encCfg.Codec, defaultMintGenesis)
 	s.mintAppModule = mintAppModule
@@ -722,6 +723,60 @@ func (s *RewardsTestSuite) getRewardsDistribution(
 	params, err := s.emissionsKeeper.GetParams(s.ctx)
 	require.NoError(err)
 
+	blockHeight := s.closeRewardNonce(
+		topicId,
+		workerValues,
+		reputerValues,
+		workerZeroAddress,
+		workerZeroOneOutInfererValue,
+		workerZeroInfererValue,
+	)
+
+	topicTotalRewards := alloraMath.NewDecFromInt64(1000000)
+
+	rewardsDistributionByTopicParticipant, _, err := rewards.GenerateRewardsDistributionByTopicParticipant(
+		rewards.GenerateRewardsDistributionByTopicParticipantArgs{
+			Ctx:          s.ctx,
+			K:            s.emissionsKeeper,
+			TopicId:      topicId,
+			TopicReward:  &topicTotalRewards,
+			BlockHeight:  blockHeight,
+			ModuleParams: params,
+		},
+	)
+	require.NoError(err)
+
+	return rewardsDistributionByTopicParticipant
+}
+
+// Runs an epoch of the topic up to the closing of its reputer nonce, returning the block height of that nonce
+func (s *RewardsTestSuite) closeRewardNonce(
+	topicId uint64,
+	workerValues []TestWorkerValue,
+	reputerValues []TestWorkerValue,
+	workerZeroAddress sdk.AccAddress,
+	workerZeroOneOutInfererValue string,
+	workerZeroInfererValue string,
+) int64 {
+	blockHeight, topic, reputerNonce := s.openRewardNonce(
+		topicId, workerValues, reputerValues, workerZeroAddress, workerZeroOneOutInfererValue, workerZeroInfererValue)
+	err := actorutils.CloseReputerNonce(&s.emissionsKeeper, s.ctx, topic, reputerNonce)
+	s.Require().NoError(err)
+
+	return blockHeight
+}
+
+// Sends the worker and reputer payloads of the next epoch of a topic, leaving its reputer nonce open to be closed
+func (s *RewardsTestSuite) openRewardNonce(
+	topicId uint64,
+	workerValues []TestWorkerValue,
+	reputerValues []TestWorkerValue,
+	workerZeroAddress sdk.AccAddress,
+	workerZeroOneOutInfererValue string,
+	workerZeroInfererValue string,
+) (int64, types.Topic, types.Nonce) {
+	require := s.Require()
+
 	// Move to end of this epoch block
 	nextBlock, _, err := s.emissionsKeeper.GetNextPossibleChurningBlockByTopicId(s.ctx, topicId)
 	s.T().Logf("Next block: %v", nextBlock)
@@ -790,27 +845,8 @@ func (s *RewardsTestSuite) getRewardsDistribution(
 		})
 		require.NoError(err)
 	}
-	err = actorutils.CloseReputerNonce(
-		&s.emissionsKeeper, s.ctx, topic,
-		*lossBundles.ReputerValueBundles[0].ValueBundle.ReputerRequestNonce.ReputerNonce,
-	)
-	s.Require().NoError(err)
-
-	topicTotalRewards := alloraMath.NewDecFromInt64(1000000)
-
-	rewardsDistributionByTopicParticipant, _, err := rewards.GenerateRewardsDistributionByTopicParticipant(
-		rewards.GenerateRewardsDistributionByTopicParticipantArgs{
-			Ctx:          s.ctx,
-			K:            s.emissionsKeeper,
-			TopicId:      topicId,
-			TopicReward:  &topicTotalRewards,
-			BlockHeight:  blockHeight,
-			ModuleParams: params,
-		},
-	)
-	require.NoError(err)
 
-	return rewardsDistributionByTopicParticipant
+	return blockHeight, topic, *lossBundles.ReputerValueBundles[0].ValueBundle.ReputerRequestNonce.ReputerNonce
 }
 
 func areTaskRewardsEqualIgnoringTopicId(s *RewardsTestSuite, A []types.TaskReward, B []types.TaskReward) bool {
@@ -1566,16 +1602,29 @@ func (s *RewardsTestSuite) TestGenerateTasksRewardsShouldIncreaseRewardShareIfMo
 	s.Require().True(secondTotalReputerReward.Gt(firstTotalReputerReward))
 }
 
-func (s *RewardsTestSuite) TestRewardsIncreasesBalance() {
+// Runs a worker and a reputer nonce of a topic with 5 workers and 5 reputers through reward distribution.
+// Returns the topic id, the reputer and worker indexes, and the reputer stakes and worker balances before the rewards.
+func (s *RewardsTestSuite) distributeRewardsToWorkersAndReputers(rewardAccrualEnabled bool) (
+	topicId uint64,
+	reputerIndexes []int,
+	workerIndexes []int,
+	reputerStake []cosmosMath.Int,
+	workerBalances map[int]sdk.Coin,
+) {
+	moduleParams, err := s.emissionsKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	moduleParams.RewardAccrualEnabled = rewardAccrualEnabled
+	s.Require().NoError(s.emissionsKeeper.SetParams(s.ctx, moduleParams))
+
 	block := int64(600)
 	s.ctx = s.ctx.WithBlockHeight(block)
 	epochLength := int64(10800)
 	s.MintTokensToModule(types.AlloraStakingAccountName, cosmosMath.NewInt(10000000000))
 
 	// Reputer Addresses
-	reputerIndexes := s.returnIndexes(0, 5)
+	reputerIndexes = s.returnIndexes(0, 5)
 	// Worker Addresses
-	workerIndexes := s.returnIndexes(5, 5)
+	workerIndexes = s.returnIndexes(5, 5)
 
 	// Create topic
 	newTopicMsg := &types.CreateNewTopicRequest{
@@ -1598,7 +1647,7 @@ func (s *RewardsTestSuite) TestRewardsIncreasesBalance() {
 	s.Require().NoError(err)
 
 	// Get Topic Id
-	topicId := res.TopicId
+	topicId = res.TopicId
 
 	// Register 5 workers
 	for _, index := range workerIndexes {
@@ -1665,14 +1714,14 @@ func (s *RewardsTestSuite) TestRewardsIncreasesBalance() {
 	s.Require().NoError(err)
 
 	reputerBalances := make([]sdk.Coin, 5)
-	reputerStake := make([]cosmosMath.Int, 5)
+	reputerStake = make([]cosmosMath.Int, 5)
 	for _, index := range reputerIndexes {
 		reputerBalances[index] = s.bankKeeper.GetBalance(s.ctx, s.addrs[index], params.DefaultBondDenom)
 		reputerStake[index], err = s.emissionsKeeper.GetStakeReputerAuthority(s.ctx, topicId, s.addrsStr[index])
 		s.Require().NoError(err)
 	}
 
-	workerBalances := make(map[int]sdk.Coin)
+	workerBalances = make(map[int]sdk.Coin)
 	for _, index := range workerIndexes {
 		workerBalances[index] = s.bankKeeper.GetBalance(s.ctx, s.addrs[index], params.DefaultBondDenom)
 	}
@@ -1726,6 +1775,12 @@ func (s *RewardsTestSuite) TestRewardsIncreasesBalance() {
 	err = s.emissionsAppModule.EndBlock(s.ctx)
 	s.Require().NoError(err)
 
+	return topicId, reputerIndexes, workerIndexes, reputerStake, workerBalances
+}
+
+func (s *RewardsTestSuite) TestRewardsIncreasesBalance() {
+	topicId, reputerIndexes, workerIndexes, reputerStake, workerBalances := s.distributeRewardsToWorkersAndReputers(false)
+
 	for i, index := range reputerIndexes {
 		reputerStakeCurrent, err := s.emissionsKeeper.GetStakeReputerAuthority(s.ctx, topicId, s.addrsStr[index])
 		s.Require().NoError(err)
@@ -1743,6 +1798,52 @@ func (s *RewardsTestSuite) TestRewardsIncreasesBalance() {
 	}
 }
 
+func (s *RewardsTestSuite) TestRewardsAccrueWhenRewardAccrualEnabled() {
+	topicId, reputerIndexes, workerIndexes, reputerStake, workerBalances := s.distributeRewardsToWorkersAndReputers(true)
+
+	// Reputer rewards are still added to their stake
+	for i, index := range reputerIndexes {
+		reputerStakeCurrent, err := s.emissionsKeeper.GetStakeReputerAuthority(s.ctx, topicId, s.addrsStr[index])
+		s.Require().NoError(err)
+		s.Require().True(reputerStakeCurrent.GT(reputerStake[i]))
+	}
+
+	// Worker rewards are credited to the ledger rather than sent
+	totalAccrued := cosmosMath.ZeroInt()
+	for _, index := range workerIndexes {
+		balance := s.bankKeeper.GetBalance(s.ctx, s.addrs[index], params.DefaultBondDenom)
+		s.Require().True(balance.Amount.LTE(workerBalances[index].Amount))
+		accruedRewards, accrued, err := s.emissionsKeeper.GetAccruedRewards(s.ctx, s.addrsStr[index])
+		s.Require().NoError(err)
+		s.Require().True(accrued.IsPositive())
+		s.Require().Len(accruedRewards, 1)
+		s.Require().Equal(topicId, accruedRewards[0].TopicId)
+		totalAccrued = totalAccrued.Add(accrued)
+	}
+	total, err := s.emissionsKeeper.GetTotalAccruedRewards(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(totalAccrued, total)
+	_, broken := keeper.RewardsInvariantAccruedRewardsEqualAccruedRewardsBankBalance(s.emissionsKeeper)(s.ctx)
+	s.Require().False(broken)
+
+	// Claiming pays out the accrued rewards and clears the ledger
+	worker := workerIndexes[0]
+	balanceBefore := s.bankKeeper.GetBalance(s.ctx, s.addrs[worker], params.DefaultBondDenom)
+	response, err := s.msgServer.ClaimRewards(s.ctx, &types.ClaimRewardsRequest{Sender: s.addrsStr[worker]})
+	s.Require().NoError(err)
+	s.Require().True(response.Amount.IsPositive())
+	balance := s.bankKeeper.GetBalance(s.ctx, s.addrs[worker], params.DefaultBondDenom)
+	s.Require().Equal(balanceBefore.Amount.Add(response.Amount), balance.Amount)
+	_, accrued, err := s.emissionsKeeper.GetAccruedRewards(s.ctx, s.addrsStr[worker])
+	s.Require().NoError(err)
+	s.Require().True(accrued.IsZero())
+	_, broken = keeper.RewardsInvariantAccruedRewardsEqualAccruedRewardsBankBalance(s.emissionsKeeper)(s.ctx)
+	s.Require().False(broken)
+
+	_, err = s.msgServer.ClaimRewards(s.ctx, &types.ClaimRewardsRequest{Sender: s.addrsStr[worker]})
+	s.Require().ErrorIs(err, types.ErrNoRewardsToClaim)
+}
+
 func (s *RewardsTestSuite) TestRewardsHandleStandardDeviationOfZero() {
 	block := int64(600)
 	s.ctx = s.ctx.WithBlockHeight(block)
@@ -1,6 +1,8 @@
 package rewards
 
 import (
+	"fmt"
+
 	"cosmossdk.io/errors"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/keeper"
@@ -158,6 +160,98 @@ func GenerateReputerScores(
 	return instantScores, nil
 }
 
+// Fewest reputers scored in an epoch for their median score to meaningfully single out outliers
+const MIN_REPUTERS_FOR_OUTLIER_DETECTION = 3
+
+// UpdateReputerOutliers flags the reputers whose consensus score for an epoch is below a fraction of the
+// median reputer score as outliers. Reputers flagged for too many consecutive epochs are slashed and jailed.
+func UpdateReputerOutliers(
+	ctx sdk.Context,
+	keeper keeper.Keeper,
+	topicId uint64,
+	moduleParams types.Params,
+	reputerScores []types.Score,
+) error {
+	if moduleParams.MaxReputerOutlierEpochs == 0 || moduleParams.ReputerOutlierScoreRatio.IsZero() {
+		return nil
+	}
+	if len(reputerScores) < MIN_REPUTERS_FOR_OUTLIER_DETECTION {
+		return nil
+	}
+	medianScore, err := getQuantileOfScores(reputerScores, alloraMath.MustNewDecFromString("0.5"))
+	if err != nil {
+		return errors.Wrapf(err, "Error getting median reputer score")
+	}
+	outlierThreshold, err := medianScore.Mul(moduleParams.ReputerOutlierScoreRatio)
+	if err != nil {
+		return errors.Wrapf(err, "Error getting reputer outlier threshold")
+	}
+	for _, score := range reputerScores {
+		err = keeper.UpdateReputerOutlierEpochs(ctx, topicId, score.Address, score.Score.Lt(outlierThreshold))
+		if err != nil {
+			return errors.Wrapf(err, "Error updating outlier epochs of reputer %s", score.Address)
+		}
+	}
+	return nil
+}
+
+// GetRewardNoncesOfTopics returns the reward nonce of each topic that has one.
+// It is read before EmitRewards, which deletes the reward nonces of the topics it rewards.
+func GetRewardNoncesOfTopics(ctx sdk.Context, keeper keeper.Keeper, topicIds []uint64) (map[uint64]int64, error) {
+	rewardNonces := make(map[uint64]int64)
+	for _, topicId := range topicIds {
+		rewardNonce, err := keeper.GetTopicRewardNonce(ctx, topicId)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Error getting reward nonce of topic %d", topicId)
+		}
+		if rewardNonce != 0 {
+			rewardNonces[topicId] = rewardNonce
+		}
+	}
+	return rewardNonces, nil
+}
+
+// UpdateReputerOutliersOfRewardedTopics runs UpdateReputerOutliers on the reputer scores of the reward nonce
+// of each topic rewarded by EmitRewards, i.e. whose reward nonce has since been deleted.
+// Each topic is processed in a cache context, its errors logged rather than failing the others.
+func UpdateReputerOutliersOfRewardedTopics(
+	ctx sdk.Context,
+	keeper keeper.Keeper,
+	moduleParams types.Params,
+	rewardNonces map[uint64]int64,
+) error {
+	if moduleParams.MaxReputerOutlierEpochs == 0 || moduleParams.ReputerOutlierScoreRatio.IsZero() {
+		return nil
+	}
+	for _, topicId := range alloraMath.GetSortedKeys(rewardNonces) {
+		currentRewardNonce, err := keeper.GetTopicRewardNonce(ctx, topicId)
+		if err != nil {
+			return errors.Wrapf(err, "Error getting reward nonce of topic %d", topicId)
+		}
+		if currentRewardNonce == rewardNonces[topicId] {
+			// Not rewarded this block
+			continue
+		}
+		reputerScores, err := keeper.GetReputersScoresAtBlock(ctx, topicId, rewardNonces[topicId])
+		if err != nil {
+			return errors.Wrapf(err, "Error getting reputer scores of topic %d", topicId)
+		}
+		scores := make([]types.Score, 0, len(reputerScores.Scores))
+		for _, score := range reputerScores.Scores {
+			scores = append(scores, *score)
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		err = UpdateReputerOutliers(cacheCtx, keeper, topicId, moduleParams, scores)
+		if err != nil {
+			Logger(ctx).Error(fmt.Sprintf("Failed to update reputer outliers of topic %d: %s", topicId, err.Error()))
+			continue
+		}
+		write()
+	}
+	return nil
+}
+
 // GenerateInferenceScores calculates and persists scores for workers based on their inference task performance.
 func GenerateInferenceScores(
 	ctx sdk.Context,
@@ -1679,3 +1679,48 @@ func generateSimpleLossBundles(
 
 	return reputerValueBundles
 }
+
+func (s *RewardsTestSuite) TestUpdateReputerOutliersOfRewardedTopics() {
+	require := s.Require()
+	rewardNonce := int64(100)
+	rewardedTopicId := uint64(1)
+	pendingTopicId := uint64(2)
+
+	moduleParams, err := s.emissionsKeeper.GetParams(s.ctx)
+	require.NoError(err)
+	moduleParams.MaxReputerOutlierEpochs = 1
+	require.NoError(s.emissionsKeeper.SetParams(s.ctx, moduleParams))
+
+	reputerScores := []alloraMath.Dec{
+		alloraMath.MustNewDecFromString("1"),
+		alloraMath.MustNewDecFromString("1.2"),
+		alloraMath.MustNewDecFromString("0.01"),
+	}
+	for _, topicId := range []uint64{rewardedTopicId, pendingTopicId} {
+		for i, score := range reputerScores {
+			err = s.emissionsKeeper.InsertReputerScore(s.ctx, topicId, rewardNonce, types.Score{
+				TopicId:     topicId,
+				BlockHeight: rewardNonce,
+				Address:     s.addrsStr[i],
+				Score:       score,
+			})
+			require.NoError(err)
+		}
+	}
+	// Only the pending topic still has its reward nonce, the rewarded one had it deleted by EmitRewards
+	require.NoError(s.emissionsKeeper.SetTopicRewardNonce(s.ctx, pendingTopicId, rewardNonce))
+	rewardNonces := map[uint64]int64{rewardedTopicId: rewardNonce, pendingTopicId: rewardNonce}
+
+	err = rewards.UpdateReputerOutliersOfRewardedTopics(s.ctx, s.emissionsKeeper, moduleParams, rewardNonces)
+	require.NoError(err)
+
+	for i := range reputerScores {
+		info, err := s.emissionsKeeper.GetReputerSlashingInfo(s.ctx, rewardedTopicId, s.addrsStr[i])
+		require.NoError(err)
+		require.Equal(i == 2, info.Jailed, "reputer %d", i)
+
+		info, err = s.emissionsKeeper.GetReputerSlashingInfo(s.ctx, pendingTopicId, s.addrsStr[i])
+		require.NoError(err)
+		require.False(info.Jailed, "reputer %d", i)
+	}
+}
@@ -98,7 +98,7 @@ func UpdateNoncesOfActiveTopics(
 		}
 		ctx.Logger().Debug(fmt.Sprintf("Added worker nonce for topic %d: %v \n", topic.Id, nextNonce.BlockHeight))
 
-		err = k.AddWorkerWindowTopicId(ctx, block+topic.WorkerSubmissionWindow, topic.Id)
+		err = k.AddWorkerWindowTopicId(ctx, block+topic.WorkerSubmissionWindow+topic.WorkerRevealWindow, topic.Id)
 		if err != nil {
 			ctx.Logger().Warn(fmt.Sprintf("Error adding worker window topic id: %s", err.Error()))
 			continue
@@ -0,0 +1,358 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Keys of the randomized genesis parameters, which may be overridden by a simulation params file
+const (
+	minEpochLength          = "min_epoch_length"
+	removeStakeDelayWindow  = "remove_stake_delay_window"
+	requiredMinimumStake    = "required_minimum_stake"
+	minTopicWeight          = "min_topic_weight"
+	createTopicFee          = "create_topic_fee"
+	registrationFee         = "registration_fee"
+	dataSendingFee          = "data_sending_fee"
+	maxTopInferersToReward  = "max_top_inferers_to_reward"
+	maxTopForecasters       = "max_top_forecasters_to_reward"
+	maxTopReputersToReward  = "max_top_reputers_to_reward"
+	maxActiveTopicsPerBlock = "max_active_topics_per_block"
+	maxMissedReputerNonces  = "max_missed_reputer_nonces"
+	reputerJailDuration     = "reputer_jail_duration"
+	rewardAccrualEnabled    = "reward_accrual_enabled"
+	numGenesisTopics        = "num_genesis_topics"
+)
+
+// Loss methods the chain knows how to recompute, which simulated topics pick from
+var simLossMethods = []string{"mse", "mae", "huber", "logloss", "crps"}
+
+// Blocks are short in simulations, so epochs and delays are kept to a few blocks for nonces, rewards
+// and stake removals to come around within a run
+func genMinEpochLength(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 1, 4))
+}
+
+func genRemoveStakeDelayWindow(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 0, 20))
+}
+
+func genRequiredMinimumStake(r *rand.Rand) cosmosMath.Int {
+	return cosmosMath.NewInt(int64(simtypes.RandIntBetween(r, 0, 1000)))
+}
+
+func genMinTopicWeight(r *rand.Rand) alloraMath.Dec {
+	return alloraMath.NewDecFromInt64(int64(simtypes.RandIntBetween(r, 0, 100)))
+}
+
+func genFee(r *rand.Rand, max int) cosmosMath.Int {
+	return cosmosMath.NewInt(int64(simtypes.RandIntBetween(r, 0, max)))
+}
+
+func genMaxTopActors(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 2, 8))
+}
+
+func genMaxActiveTopicsPerBlock(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 4))
+}
+
+// Reputers missing a few nonces in a row are slashed and jailed within a run
+func genMaxMissedReputerNonces(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 10))
+}
+
+func genReputerJailDuration(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 0, 20))
+}
+
+func genNumGenesisTopics(r *rand.Rand) int {
+	return simtypes.RandIntBetween(r, 1, 6)
+}
+
+// Returns a random decimal coeff * 10^exp with coeff in [min, max]
+func randDecFinite(r *rand.Rand, min, max int64, exp int32) alloraMath.Dec {
+	return alloraMath.NewDecFinite(min+r.Int63n(max-min+1), exp)
+}
+
+// RandomizedParams returns module params with the fields shaping the simulation randomized
+func RandomizedParams(simState *module.SimulationState) types.Params {
+	r := simState.Rand
+	params := types.DefaultParams()
+	simState.AppParams.GetOrGenerate(minEpochLength, &params.MinEpochLength, r, func(r *rand.Rand) { params.MinEpochLength = genMinEpochLength(r) })
+	simState.AppParams.GetOrGenerate(removeStakeDelayWindow, &params.RemoveStakeDelayWindow, r, func(r *rand.Rand) { params.RemoveStakeDelayWindow = genRemoveStakeDelayWindow(r) })
+	simState.AppParams.GetOrGenerate(requiredMinimumStake, &params.RequiredMinimumStake, r, func(r *rand.Rand) { params.RequiredMinimumStake = genRequiredMinimumStake(r) })
+	simState.AppParams.GetOrGenerate(minTopicWeight, &params.MinTopicWeight, r, func(r *rand.Rand) { params.MinTopicWeight = genMinTopicWeight(r) })
+	simState.AppParams.GetOrGenerate(createTopicFee, &params.CreateTopicFee, r, func(r *rand.Rand) { params.CreateTopicFee = genFee(r, 100000) })
+	simState.AppParams.GetOrGenerate(registrationFee, &params.RegistrationFee, r, func(r *rand.Rand) { params.RegistrationFee = genFee(r, 1000) })
+	simState.AppParams.GetOrGenerate(dataSendingFee, &params.DataSendingFee, r, func(r *rand.Rand) { params.DataSendingFee = genFee(r, 100) })
+	simState.AppParams.GetOrGenerate(maxTopInferersToReward, &params.MaxTopInferersToReward, r, func(r *rand.Rand) { params.MaxTopInferersToReward = genMaxTopActors(r) })
+	simState.AppParams.GetOrGenerate(maxTopForecasters, &params.MaxTopForecastersToReward, r, func(r *rand.Rand) { params.MaxTopForecastersToReward = genMaxTopActors(r) })
+	simState.AppParams.GetOrGenerate(maxTopReputersToReward, &params.MaxTopReputersToReward, r, func(r *rand.Rand) { params.MaxTopReputersToReward = genMaxTopActors(r) })
+	simState.AppParams.GetOrGenerate(maxActiveTopicsPerBlock, &params.MaxActiveTopicsPerBlock, r, func(r *rand.Rand) { params.MaxActiveTopicsPerBlock = genMaxActiveTopicsPerBlock(r) })
+	simState.AppParams.GetOrGenerate(maxMissedReputerNonces, &params.MaxMissedReputerNonces, r, func(r *rand.Rand) { params.MaxMissedReputerNonces = genMaxMissedReputerNonces(r) })
+	simState.AppParams.GetOrGenerate(reputerJailDuration, &params.ReputerJailDuration, r, func(r *rand.Rand) { params.ReputerJailDuration = genReputerJailDuration(r) })
+	simState.AppParams.GetOrGenerate(rewardAccrualEnabled, &params.RewardAccrualEnabled, r, func(r *rand.Rand) { params.RewardAccrualEnabled = r.Intn(2) == 0 })
+	return params
+}
+
+// RandomTopic returns a valid topic under the given params, with epochs of a few blocks.
+// Worker nonces open in the EndBlocker, so submission windows span at least two blocks for payloads to make it in.
+func RandomTopic(r *rand.Rand, id uint64, creator string, params types.Params) types.Topic {
+	epochLength := max(params.MinEpochLength, 2) + r.Int63n(10)
+	groundTruthLag := epochLength * (1 + r.Int63n(2))
+	workerRevealWindow := int64(0)
+	if epochLength > 2 && r.Intn(3) == 0 {
+		workerRevealWindow = 1 + r.Int63n(epochLength-2)
+	}
+	workerSubmissionWindow := 2 + r.Int63n(epochLength-workerRevealWindow-1)
+
+	return types.Topic{
+		Id:                       id,
+		Creator:                  creator,
+		Metadata:                 simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 0, 32)),
+		LossMethod:               simLossMethods[r.Intn(len(simLossMethods))],
+		EpochLastEnded:           0,
+		EpochLength:              epochLength,
+		GroundTruthLag:           groundTruthLag,
+		PNorm:                    randDecFinite(r, 25, 45, -1),
+		AlphaRegret:              randDecFinite(r, 1, 10, -1),
+		AllowNegative:            r.Intn(2) == 0,
+		Epsilon:                  randDecFinite(r, 1, 100, -4),
+		InitialRegret:            alloraMath.ZeroDec(),
+		WorkerSubmissionWindow:   workerSubmissionWindow,
+		MeritSortitionAlpha:      randDecFinite(r, 0, 9, -1),
+		ActiveInfererQuantile:    randDecFinite(r, 0, 9, -1),
+		ActiveForecasterQuantile: randDecFinite(r, 0, 9, -1),
+		ActiveReputerQuantile:    randDecFinite(r, 0, 9, -1),
+		WorkerRevealWindow:       workerRevealWindow,
+		ValueDimension:           0,
+		ArchiveNetworkInferences: r.Intn(2) == 0,
+	}
+}
+
+// Accumulates the stakes of the genesis so that their aggregates are consistent
+type genesisStakes struct {
+	total                   cosmosMath.Int
+	topicStake              map[uint64]cosmosMath.Int
+	reputerAuthority        map[uint64]map[string]cosmosMath.Int
+	sumFromDelegator        map[uint64]map[string]cosmosMath.Int
+	fromDelegatorsUpon      map[uint64]map[string]cosmosMath.Int
+	delegatedStakes         []*types.TopicIdDelegatorReputerDelegatorInfo
+	stakedByAccount         map[string]cosmosMath.Int
+	reputersInInsertOrder   map[uint64][]string
+	delegatorsInInsertOrder map[uint64][]string
+}
+
+func newGenesisStakes() *genesisStakes {
+	return &genesisStakes{
+		total:                   cosmosMath.ZeroInt(),
+		topicStake:              make(map[uint64]cosmosMath.Int),
+		reputerAuthority:        make(map[uint64]map[string]cosmosMath.Int),
+		sumFromDelegator:        make(map[uint64]map[string]cosmosMath.Int),
+		fromDelegatorsUpon:      make(map[uint64]map[string]cosmosMath.Int),
+		delegatedStakes:         []*types.TopicIdDelegatorReputerDelegatorInfo{},
+		stakedByAccount:         make(map[string]cosmosMath.Int),
+		reputersInInsertOrder:   make(map[uint64][]string),
+		delegatorsInInsertOrder: make(map[uint64][]string),
+	}
+}
+
+// Adds amount to m[topicId][actor], keeping track of the order of the actors for a deterministic export
+func addToTopicActor(m map[uint64]map[string]cosmosMath.Int, order map[uint64][]string, topicId uint64, actor string, amount cosmosMath.Int) {
+	if _, ok := m[topicId]; !ok {
+		m[topicId] = make(map[string]cosmosMath.Int)
+	}
+	if _, ok := m[topicId][actor]; !ok {
+		m[topicId][actor] = cosmosMath.ZeroInt()
+		if order != nil {
+			order[topicId] = append(order[topicId], actor)
+		}
+	}
+	m[topicId][actor] = m[topicId][actor].Add(amount)
+}
+
+func (s *genesisStakes) addToTotals(topicId uint64, staker string, amount cosmosMath.Int) {
+	s.total = s.total.Add(amount)
+	if _, ok := s.topicStake[topicId]; !ok {
+		s.topicStake[topicId] = cosmosMath.ZeroInt()
+	}
+	s.topicStake[topicId] = s.topicStake[topicId].Add(amount)
+	if _, ok := s.stakedByAccount[staker]; !ok {
+		s.stakedByAccount[staker] = cosmosMath.ZeroInt()
+	}
+	s.stakedByAccount[staker] = s.stakedByAccount[staker].Add(amount)
+}
+
+func (s *genesisStakes) addStake(topicId uint64, reputer string, amount cosmosMath.Int) {
+	addToTopicActor(s.reputerAuthority, s.reputersInInsertOrder, topicId, reputer, amount)
+	s.addToTotals(topicId, reputer, amount)
+}
+
+func (s *genesisStakes) delegateStake(topicId uint64, delegator, reputer string, amount cosmosMath.Int) {
+	addToTopicActor(s.reputerAuthority, s.reputersInInsertOrder, topicId, reputer, amount)
+	addToTopicActor(s.fromDelegatorsUpon, nil, topicId, reputer, amount)
+	addToTopicActor(s.sumFromDelegator, s.delegatorsInInsertOrder, topicId, delegator, amount)
+	s.addToTotals(topicId, delegator, amount)
+	s.delegatedStakes = append(s.delegatedStakes, &types.TopicIdDelegatorReputerDelegatorInfo{
+		TopicId:   topicId,
+		Delegator: delegator,
+		Reputer:   reputer,
+		DelegatorInfo: &types.DelegatorInfo{
+			Amount:     alloraMath.NewDecFromInt64(amount.Int64()),
+			RewardDebt: alloraMath.ZeroDec(),
+		},
+	})
+}
+
+// Writes the stakes to the genesis state
+func (s *genesisStakes) setGenesisState(genesis *types.GenesisState, topicIds []uint64) {
+	genesis.TotalStake = s.total
+	for _, topicId := range topicIds {
+		if stake, ok := s.topicStake[topicId]; ok {
+			genesis.TopicStake = append(genesis.TopicStake, &types.TopicIdAndInt{TopicId: topicId, Int: stake})
+		}
+		for _, reputer := range s.reputersInInsertOrder[topicId] {
+			genesis.StakeReputerAuthority = append(genesis.StakeReputerAuthority, &types.TopicIdActorIdInt{
+				TopicId: topicId,
+				ActorId: reputer,
+				Int:     s.reputerAuthority[topicId][reputer],
+			})
+			if delegated, ok := s.fromDelegatorsUpon[topicId][reputer]; ok {
+				genesis.StakeFromDelegatorsUponReputer = append(genesis.StakeFromDelegatorsUponReputer, &types.TopicIdActorIdInt{
+					TopicId: topicId,
+					ActorId: reputer,
+					Int:     delegated,
+				})
+			}
+		}
+		for _, delegator := range s.delegatorsInInsertOrder[topicId] {
+			genesis.StakeSumFromDelegator = append(genesis.StakeSumFromDelegator, &types.TopicIdActorIdInt{
+				TopicId: topicId,
+				ActorId: delegator,
+				Int:     s.sumFromDelegator[topicId][delegator],
+			})
+		}
+	}
+	genesis.DelegatedStakes = s.delegatedStakes
+}
+
+// Moves the staked coins out of the balances of the stakers into the staking module account of the bank genesis,
+// which leaves the supply untouched
+func (s *genesisStakes) moveStakedCoins(simState *module.SimulationState) {
+	if s.total.IsZero() {
+		return
+	}
+	bankGenesisBz, ok := simState.GenState[banktypes.ModuleName]
+	if !ok {
+		panic("the bank genesis state must be generated before the emissions one")
+	}
+	var bankGenesis banktypes.GenesisState
+	simState.Cdc.MustUnmarshalJSON(bankGenesisBz, &bankGenesis)
+
+	for i, balance := range bankGenesis.Balances {
+		staked, ok := s.stakedByAccount[balance.Address]
+		if !ok {
+			continue
+		}
+		bankGenesis.Balances[i].Coins = balance.Coins.Sub(sdk.NewCoin(simState.BondDenom, staked))
+	}
+	bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{
+		Address: authtypes.NewModuleAddress(types.AlloraStakingAccountName).String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(simState.BondDenom, s.total)),
+	})
+	simState.GenState[banktypes.ModuleName] = simState.Cdc.MustMarshalJSON(&bankGenesis)
+}
+
+// RandomizedGenState generates a random GenesisState for the emissions module: params, a few topics with workers
+// and reputers registered among the simulation accounts, reputer stakes and delegations.
+// Some simulation accounts are made whitelist admins so that the admin messages can be simulated.
+func RandomizedGenState(simState *module.SimulationState) {
+	r := simState.Rand
+	genesis := types.NewGenesisState()
+	genesis.Params = RandomizedParams(simState)
+	params := genesis.Params
+
+	accs := simState.Accounts
+	genesis.CoreTeamAddresses = []string{}
+	numAdmins := simtypes.RandIntBetween(r, 1, 4)
+	for _, i := range r.Perm(len(accs))[:min(numAdmins, len(accs))] {
+		genesis.CoreTeamAddresses = append(genesis.CoreTeamAddresses, accs[i].Address.String())
+	}
+
+	var numTopics int
+	simState.AppParams.GetOrGenerate(numGenesisTopics, &numTopics, r, func(r *rand.Rand) { numTopics = genNumGenesisTopics(r) })
+
+	// Every account may stake up to 5% of its balance per position, leaving most of it to pay for fees
+	stakeUnit := simState.InitialStake.QuoRaw(100)
+	stakes := newGenesisStakes()
+	topicIds := make([]uint64, 0, numTopics)
+	workers := make(map[string]bool)
+	reputers := make(map[string]bool)
+	for i := 0; i < numTopics && len(accs) > 0; i++ {
+		topicId := uint64(i + 1)
+		topicIds = append(topicIds, topicId)
+		creator, _ := simtypes.RandomAcc(r, accs)
+		topic := RandomTopic(r, topicId, creator.Address.String(), params)
+		genesis.Topics = append(genesis.Topics, &types.TopicIdAndTopic{TopicId: topicId, Topic: &topic})
+
+		numActors := min(len(accs), 10)
+		for _, j := range r.Perm(len(accs))[:simtypes.RandIntBetween(r, 0, numActors+1)] {
+			worker := accs[j].Address.String()
+			genesis.TopicWorkers = append(genesis.TopicWorkers, &types.TopicAndActorId{TopicId: topicId, ActorId: worker})
+			workers[worker] = true
+		}
+		topicReputers := []string{}
+		for _, j := range r.Perm(len(accs))[:simtypes.RandIntBetween(r, 0, numActors+1)] {
+			reputer := accs[j].Address.String()
+			genesis.TopicReputers = append(genesis.TopicReputers, &types.TopicAndActorId{TopicId: topicId, ActorId: reputer})
+			reputers[reputer] = true
+			topicReputers = append(topicReputers, reputer)
+			if stakeUnit.IsPositive() && r.Intn(4) != 0 {
+				stakes.addStake(topicId, reputer, stakeUnit.MulRaw(int64(simtypes.RandIntBetween(r, 1, 6))))
+			}
+		}
+		if len(topicReputers) == 0 || !stakeUnit.IsPositive() {
+			continue
+		}
+		for _, j := range r.Perm(len(accs))[:simtypes.RandIntBetween(r, 0, numActors+1)] {
+			delegator := accs[j].Address.String()
+			reputer := topicReputers[r.Intn(len(topicReputers))]
+			if delegator == reputer {
+				continue
+			}
+			stakes.delegateStake(topicId, delegator, reputer, stakeUnit.MulRaw(int64(simtypes.RandIntBetween(r, 1, 6))))
+		}
+	}
+	genesis.NextTopicId = uint64(len(topicIds) + 1)
+	stakes.setGenesisState(genesis, topicIds)
+	stakes.moveStakedCoins(simState)
+
+	for _, acc := range accs {
+		address := acc.Address.String()
+		node := &types.OffchainNode{Owner: address, NodeAddress: address}
+		if workers[address] {
+			genesis.Workers = append(genesis.Workers, &types.LibP2PKeyAndOffchainNode{LibP2PKey: address, OffchainNode: node})
+		}
+		if reputers[address] {
+			genesis.Reputers = append(genesis.Reputers, &types.LibP2PKeyAndOffchainNode{LibP2PKey: address, OffchainNode: node})
+		}
+	}
+
+	paramsBytes, err := json.MarshalIndent(&genesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated emissions parameters:\n%s\n", paramsBytes)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
@@ -0,0 +1,88 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/emissions/simulation"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banksimulation "github.com/cosmos/cosmos-sdk/x/bank/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newSimulationState(seed int64) *module.SimulationState {
+	r := rand.New(rand.NewSource(seed)) //nolint:gosec // simulations need deterministic randomness
+	return &module.SimulationState{     //nolint:exhaustruct // the remaining fields are not used by genesis generation
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+		Rand:         r,
+		NumBonded:    3,
+		BondDenom:    sdk.DefaultBondDenom,
+		Accounts:     simtypes.RandomAccounts(r, 20),
+		InitialStake: cosmosMath.NewInt(1000000000),
+		GenState:     make(map[string]json.RawMessage),
+	}
+}
+
+func TestRandomizedGenState(t *testing.T) {
+	for seed := int64(0); seed < 20; seed++ {
+		simState := newSimulationState(seed)
+		banksimulation.RandomizedGenState(simState)
+		simulation.RandomizedGenState(simState)
+
+		var genesis types.GenesisState
+		simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &genesis)
+		require.NoError(t, genesis.Validate())
+		require.NotEmpty(t, genesis.CoreTeamAddresses)
+		require.NotEmpty(t, genesis.Topics)
+		require.Equal(t, uint64(len(genesis.Topics)+1), genesis.NextTopicId)
+		for _, topic := range genesis.Topics {
+			require.NoError(t, topic.Topic.Validate(genesis.Params))
+		}
+
+		// Delegated stakes sum up to the stakes from delegators and reputers never delegate to themselves
+		delegated := cosmosMath.ZeroInt()
+		for _, delegation := range genesis.DelegatedStakes {
+			require.NotEqual(t, delegation.Delegator, delegation.Reputer)
+			amount, err := delegation.DelegatorInfo.Amount.SdkIntTrim()
+			require.NoError(t, err)
+			delegated = delegated.Add(amount)
+		}
+		fromDelegators := cosmosMath.ZeroInt()
+		for _, stake := range genesis.StakeSumFromDelegator {
+			fromDelegators = fromDelegators.Add(stake.Int)
+		}
+		require.Equal(t, delegated, fromDelegators)
+
+		// The staked coins are held by the staking module account
+		var bankGenesis banktypes.GenesisState
+		simState.Cdc.MustUnmarshalJSON(simState.GenState[banktypes.ModuleName], &bankGenesis)
+		staking := authtypes.NewModuleAddress(types.AlloraStakingAccountName).String()
+		held := cosmosMath.ZeroInt()
+		for _, balance := range bankGenesis.Balances {
+			if balance.Address == staking {
+				held = balance.Coins.AmountOf(sdk.DefaultBondDenom)
+			}
+		}
+		require.Equal(t, genesis.TotalStake, held)
+	}
+}
+
+func TestRandomizedGenStateIsDeterministic(t *testing.T) {
+	generate := func() json.RawMessage {
+		simState := newSimulationState(7)
+		banksimulation.RandomizedGenState(simState)
+		simulation.RandomizedGenState(simState)
+		return simState.GenState[types.ModuleName]
+	}
+	require.Equal(t, generate(), generate())
+}
@@ -0,0 +1,341 @@
+package simulation
+
+import (
+	"context"
+	"math/rand"
+
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgUpdateParams                 = "op_weight_msg_update_params"
+	OpWeightMsgCreateNewTopic               = "op_weight_msg_create_new_topic"
+	OpWeightMsgUpdateTopic                  = "op_weight_msg_update_topic"
+	OpWeightMsgRetireTopic                  = "op_weight_msg_retire_topic"
+	OpWeightMsgRegister                     = "op_weight_msg_register"
+	OpWeightMsgRemoveRegistration           = "op_weight_msg_remove_registration"
+	OpWeightMsgAddStake                     = "op_weight_msg_add_stake"
+	OpWeightMsgRemoveStake                  = "op_weight_msg_remove_stake"
+	OpWeightMsgCancelRemoveStake            = "op_weight_msg_cancel_remove_stake"
+	OpWeightMsgDelegateStake                = "op_weight_msg_delegate_stake"
+	OpWeightMsgRewardDelegateStake          = "op_weight_msg_reward_delegate_stake"
+	OpWeightMsgSetDelegateStakeAutoCompound = "op_weight_msg_set_delegate_stake_auto_compound"
+	OpWeightMsgRedelegateStake              = "op_weight_msg_redelegate_stake"
+	OpWeightMsgClaimRewards                 = "op_weight_msg_claim_rewards"
+	OpWeightMsgRemoveDelegateStake          = "op_weight_msg_remove_delegate_stake"
+	OpWeightMsgCancelRemoveDelegateStake    = "op_weight_msg_cancel_remove_delegate_stake"
+	OpWeightMsgFundTopic                    = "op_weight_msg_fund_topic"
+	OpWeightMsgAddToWhitelistAdmin          = "op_weight_msg_add_to_whitelist_admin"
+	OpWeightMsgRemoveFromWhitelistAdmin     = "op_weight_msg_remove_from_whitelist_admin"
+	OpWeightMsgAddGmpAllowedSource          = "op_weight_msg_add_gmp_allowed_source"
+	OpWeightMsgRemoveGmpAllowedSource       = "op_weight_msg_remove_gmp_allowed_source"
+	OpWeightMsgCreateGmpSubscription        = "op_weight_msg_create_gmp_subscription"
+	OpWeightMsgFundGmpSubscription          = "op_weight_msg_fund_gmp_subscription"
+	OpWeightMsgCancelGmpSubscription        = "op_weight_msg_cancel_gmp_subscription"
+	OpWeightMsgFundTopicSponsorship         = "op_weight_msg_fund_topic_sponsorship"
+	OpWeightMsgWithdrawTopicSponsorship     = "op_weight_msg_withdraw_topic_sponsorship"
+	OpWeightMsgSetTopicAllowlistEnabled     = "op_weight_msg_set_topic_allowlist_enabled"
+	OpWeightMsgAddToTopicAllowlist          = "op_weight_msg_add_to_topic_allowlist"
+	OpWeightMsgRemoveFromTopicAllowlist     = "op_weight_msg_remove_from_topic_allowlist"
+	OpWeightMsgUnjailReputer                = "op_weight_msg_unjail_reputer"
+	OpWeightMsgInsertWorkerPayload          = "op_weight_msg_insert_worker_payload"
+	OpWeightMsgCommitWorkerPayload          = "op_weight_msg_commit_worker_payload"
+	OpWeightMsgRevealWorkerPayload          = "op_weight_msg_reveal_worker_payload"
+	OpWeightMsgInsertReputerPayload         = "op_weight_msg_insert_reputer_payload"
+	OpWeightMsgInsertWorkerPayloads         = "op_weight_msg_insert_worker_payloads"
+	OpWeightMsgInsertReputerPayloads        = "op_weight_msg_insert_reputer_payloads"
+	OpWeightMsgSubmitGroundTruth            = "op_weight_msg_submit_ground_truth"
+
+	// Payloads drive the topics through their nonces, so they are sent the most
+	DefaultWeightMsgUpdateParams                 = 5
+	DefaultWeightMsgCreateNewTopic               = 10
+	DefaultWeightMsgUpdateTopic                  = 10
+	DefaultWeightMsgRetireTopic                  = 2
+	DefaultWeightMsgRegister                     = 50
+	DefaultWeightMsgRemoveRegistration           = 5
+	DefaultWeightMsgAddStake                     = 50
+	DefaultWeightMsgRemoveStake                  = 20
+	DefaultWeightMsgCancelRemoveStake            = 10
+	DefaultWeightMsgDelegateStake                = 40
+	DefaultWeightMsgRewardDelegateStake          = 20
+	DefaultWeightMsgSetDelegateStakeAutoCompound = 10
+	DefaultWeightMsgRedelegateStake              = 10
+	DefaultWeightMsgClaimRewards                 = 20
+	DefaultWeightMsgRemoveDelegateStake          = 20
+	DefaultWeightMsgCancelRemoveDelegateStake    = 10
+	DefaultWeightMsgFundTopic                    = 30
+	DefaultWeightMsgAddToWhitelistAdmin          = 5
+	DefaultWeightMsgRemoveFromWhitelistAdmin     = 2
+	DefaultWeightMsgAddGmpAllowedSource          = 5
+	DefaultWeightMsgRemoveGmpAllowedSource       = 3
+	DefaultWeightMsgCreateGmpSubscription        = 10
+	DefaultWeightMsgFundGmpSubscription          = 10
+	DefaultWeightMsgCancelGmpSubscription        = 5
+	DefaultWeightMsgFundTopicSponsorship         = 15
+	DefaultWeightMsgWithdrawTopicSponsorship     = 10
+	DefaultWeightMsgSetTopicAllowlistEnabled     = 5
+	DefaultWeightMsgAddToTopicAllowlist          = 10
+	DefaultWeightMsgRemoveFromTopicAllowlist     = 5
+	DefaultWeightMsgUnjailReputer                = 20
+	DefaultWeightMsgInsertWorkerPayload          = 100
+	DefaultWeightMsgCommitWorkerPayload          = 50
+	DefaultWeightMsgRevealWorkerPayload          = 50
+	DefaultWeightMsgInsertReputerPayload         = 100
+	DefaultWeightMsgInsertWorkerPayloads         = 20
+	DefaultWeightMsgInsertReputerPayloads        = 20
+	DefaultWeightMsgSubmitGroundTruth            = 30
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	txGen client.TxConfig,
+	ak keeper.AccountKeeper,
+	bk keeper.BankKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	operations := []struct {
+		key           string
+		defaultWeight int
+		operation     simtypes.Operation
+	}{
+		{OpWeightMsgUpdateParams, DefaultWeightMsgUpdateParams, SimulateMsgUpdateParams(txGen, ak, bk, k)},
+		{OpWeightMsgCreateNewTopic, DefaultWeightMsgCreateNewTopic, SimulateMsgCreateNewTopic(txGen, ak, bk, k)},
+		{OpWeightMsgUpdateTopic, DefaultWeightMsgUpdateTopic, SimulateMsgUpdateTopic(txGen, ak, bk, k)},
+		{OpWeightMsgRetireTopic, DefaultWeightMsgRetireTopic, SimulateMsgRetireTopic(txGen, ak, bk, k)},
+		{OpWeightMsgRegister, DefaultWeightMsgRegister, SimulateMsgRegister(txGen, ak, bk, k)},
+		{OpWeightMsgRemoveRegistration, DefaultWeightMsgRemoveRegistration, SimulateMsgRemoveRegistration(txGen, ak, bk, k)},
+		{OpWeightMsgAddStake, DefaultWeightMsgAddStake, SimulateMsgAddStake(txGen, ak, bk, k)},
+		{OpWeightMsgRemoveStake, DefaultWeightMsgRemoveStake, SimulateMsgRemoveStake(txGen, ak, bk, k)},
+		{OpWeightMsgCancelRemoveStake, DefaultWeightMsgCancelRemoveStake, SimulateMsgCancelRemoveStake(txGen, ak, bk, k)},
+		{OpWeightMsgDelegateStake, DefaultWeightMsgDelegateStake, SimulateMsgDelegateStake(txGen, ak, bk, k)},
+		{OpWeightMsgRewardDelegateStake, DefaultWeightMsgRewardDelegateStake, SimulateMsgRewardDelegateStake(txGen, ak, bk, k)},
+		{OpWeightMsgSetDelegateStakeAutoCompound, DefaultWeightMsgSetDelegateStakeAutoCompound, SimulateMsgSetDelegateStakeAutoCompound(txGen, ak, bk, k)},
+		{OpWeightMsgRedelegateStake, DefaultWeightMsgRedelegateStake, SimulateMsgRedelegateStake(txGen, ak, bk, k)},
+		{OpWeightMsgClaimRewards, DefaultWeightMsgClaimRewards, SimulateMsgClaimRewards(txGen, ak, bk, k)},
+		{OpWeightMsgRemoveDelegateStake, DefaultWeightMsgRemoveDelegateStake, SimulateMsgRemoveDelegateStake(txGen, ak, bk, k)},
+		{OpWeightMsgCancelRemoveDelegateStake, DefaultWeightMsgCancelRemoveDelegateStake, SimulateMsgCancelRemoveDelegateStake(txGen, ak, bk, k)},
+		{OpWeightMsgFundTopic, DefaultWeightMsgFundTopic, SimulateMsgFundTopic(txGen, ak, bk, k)},
+		{OpWeightMsgAddToWhitelistAdmin, DefaultWeightMsgAddToWhitelistAdmin, SimulateMsgAddToWhitelistAdmin(txGen, ak, bk, k)},
+		{OpWeightMsgRemoveFromWhitelistAdmin, DefaultWeightMsgRemoveFromWhitelistAdmin, SimulateMsgRemoveFromWhitelistAdmin(txGen, ak, bk, k)},
+		{OpWeightMsgAddGmpAllowedSource, DefaultWeightMsgAddGmpAllowedSource, SimulateMsgAddGmpAllowedSource(txGen, ak, bk, k)},
+		{OpWeightMsgRemoveGmpAllowedSource, DefaultWeightMsgRemoveGmpAllowedSource, SimulateMsgRemoveGmpAllowedSource(txGen, ak, bk, k)},
+		{OpWeightMsgCreateGmpSubscription, DefaultWeightMsgCreateGmpSubscription, SimulateMsgCreateGmpSubscription(txGen, ak, bk, k)},
+		{OpWeightMsgFundGmpSubscription, DefaultWeightMsgFundGmpSubscription, SimulateMsgFundGmpSubscription(txGen, ak, bk, k)},
+		{OpWeightMsgCancelGmpSubscription, DefaultWeightMsgCancelGmpSubscription, SimulateMsgCancelGmpSubscription(txGen, ak, bk, k)},
+		{OpWeightMsgFundTopicSponsorship, DefaultWeightMsgFundTopicSponsorship, SimulateMsgFundTopicSponsorship(txGen, ak, bk, k)},
+		{OpWeightMsgWithdrawTopicSponsorship, DefaultWeightMsgWithdrawTopicSponsorship, SimulateMsgWithdrawTopicSponsorship(txGen, ak, bk, k)},
+		{OpWeightMsgSetTopicAllowlistEnabled, DefaultWeightMsgSetTopicAllowlistEnabled, SimulateMsgSetTopicAllowlistEnabled(txGen, ak, bk, k)},
+		{OpWeightMsgAddToTopicAllowlist, DefaultWeightMsgAddToTopicAllowlist, SimulateMsgAddToTopicAllowlist(txGen, ak, bk, k)},
+		{OpWeightMsgRemoveFromTopicAllowlist, DefaultWeightMsgRemoveFromTopicAllowlist, SimulateMsgRemoveFromTopicAllowlist(txGen, ak, bk, k)},
+		{OpWeightMsgUnjailReputer, DefaultWeightMsgUnjailReputer, SimulateMsgUnjailReputer(txGen, ak, bk, k)},
+		{OpWeightMsgInsertWorkerPayload, DefaultWeightMsgInsertWorkerPayload, SimulateMsgInsertWorkerPayload(txGen, ak, bk, k)},
+		{OpWeightMsgCommitWorkerPayload, DefaultWeightMsgCommitWorkerPayload, SimulateMsgCommitWorkerPayload(txGen, ak, bk, k)},
+		{OpWeightMsgRevealWorkerPayload, DefaultWeightMsgRevealWorkerPayload, SimulateMsgRevealWorkerPayload(txGen, ak, bk, k)},
+		{OpWeightMsgInsertReputerPayload, DefaultWeightMsgInsertReputerPayload, SimulateMsgInsertReputerPayload(txGen, ak, bk, k)},
+		{OpWeightMsgInsertWorkerPayloads, DefaultWeightMsgInsertWorkerPayloads, SimulateMsgInsertWorkerPayloads(txGen, ak, bk, k)},
+		{OpWeightMsgInsertReputerPayloads, DefaultWeightMsgInsertReputerPayloads, SimulateMsgInsertReputerPayloads(txGen, ak, bk, k)},
+		{OpWeightMsgSubmitGroundTruth, DefaultWeightMsgSubmitGroundTruth, SimulateMsgSubmitGroundTruth(txGen, ak, bk, k)},
+	}
+
+	weightedOperations := make(simulation.WeightedOperations, 0, len(operations))
+	for _, operation := range operations {
+		var weight int
+		appParams.GetOrGenerate(operation.key, &weight, nil, func(_ *rand.Rand) {
+			weight = operation.defaultWeight
+		})
+		weightedOperations = append(weightedOperations, simulation.NewWeightedOperation(weight, operation.operation))
+	}
+	return weightedOperations
+}
+
+// Signs and delivers a message from a simulation account, paying random fees out of what the message leaves spendable
+func deliver(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	txGen client.TxConfig,
+	ak keeper.AccountKeeper,
+	bk keeper.BankKeeper,
+	msg sdk.Msg,
+	account simtypes.Account,
+	coinsSpent sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	return simulation.GenAndDeliverTxWithRandFees(simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           txGen,
+		Cdc:             nil,
+		Msg:             msg,
+		CoinsSpentInMsg: coinsSpent,
+		Context:         ctx,
+		SimAccount:      account,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+	})
+}
+
+func noOp(msg sdk.Msg, comment string) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), comment), nil, nil
+}
+
+func coins(amount cosmosMath.Int) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, amount))
+}
+
+func spendable(ctx context.Context, bk keeper.BankKeeper, account simtypes.Account) cosmosMath.Int {
+	return bk.SpendableCoins(ctx, account.Address).AmountOf(params.DefaultBondDenom)
+}
+
+// Returns a random amount in [1, max(1, balance/divisor)], leaving most of the balance to pay for fees
+func randomAmount(r *rand.Rand, balance cosmosMath.Int, divisor int64) cosmosMath.Int {
+	upper := balance.QuoRaw(divisor)
+	if !upper.IsPositive() {
+		return cosmosMath.OneInt()
+	}
+	amount, err := simtypes.RandPositiveInt(r, upper)
+	if err != nil {
+		return cosmosMath.OneInt()
+	}
+	return amount
+}
+
+// Returns the simulation account of an address, if any
+func findAccount(accs []simtypes.Account, address string) (simtypes.Account, bool) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return simtypes.Account{}, false
+	}
+	return simtypes.FindAccount(accs, addr)
+}
+
+// Returns the simulation accounts among the given addresses, in their order
+func findAccounts(accs []simtypes.Account, addresses []string) []simtypes.Account {
+	found := make([]simtypes.Account, 0, len(addresses))
+	for _, address := range addresses {
+		if acc, ok := findAccount(accs, address); ok {
+			found = append(found, acc)
+		}
+	}
+	return found
+}
+
+func randomAccountOf(r *rand.Rand, accs []simtypes.Account) (simtypes.Account, bool) {
+	if len(accs) == 0 {
+		return simtypes.Account{}, false
+	}
+	return accs[r.Intn(len(accs))], true
+}
+
+// Returns the topics that have not been retired, in id order
+func getTopics(ctx sdk.Context, k keeper.Keeper) ([]types.Topic, error) {
+	nextTopicId, err := k.GetNextTopicId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	topics := []types.Topic{}
+	for topicId := uint64(1); topicId < nextTopicId; topicId++ {
+		exists, err := k.TopicExists(ctx, topicId)
+		if err != nil {
+			return nil, err
+		} else if !exists {
+			continue
+		}
+		topic, err := k.GetTopic(ctx, topicId)
+		if err != nil {
+			return nil, err
+		}
+		topics = append(topics, topic)
+	}
+	return topics, nil
+}
+
+func randomTopic(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (types.Topic, bool, error) {
+	topics, err := getTopics(ctx, k)
+	if err != nil || len(topics) == 0 {
+		return types.Topic{}, false, err
+	}
+	return topics[r.Intn(len(topics))], true, nil
+}
+
+// Returns the simulation accounts that are whitelist admins
+func getWhitelistAdmins(ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) ([]simtypes.Account, error) {
+	admins := []simtypes.Account{}
+	for _, acc := range accs {
+		isAdmin, err := k.IsWhitelistAdmin(ctx, acc.Address.String())
+		if err != nil {
+			return nil, err
+		} else if isAdmin {
+			admins = append(admins, acc)
+		}
+	}
+	return admins, nil
+}
+
+func randomWhitelistAdmin(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (simtypes.Account, bool, error) {
+	admins, err := getWhitelistAdmins(ctx, k, accs)
+	if err != nil {
+		return simtypes.Account{}, false, err
+	}
+	admin, found := randomAccountOf(r, admins)
+	return admin, found, nil
+}
+
+// Returns the topic creator or, half of the time or when the creator is not a simulation account, a whitelist admin
+func randomTopicManager(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account, topic types.Topic) (simtypes.Account, bool, error) {
+	if creator, found := findAccount(accs, topic.Creator); found && r.Intn(2) == 0 {
+		return creator, true, nil
+	}
+	return randomWhitelistAdmin(r, ctx, k, accs)
+}
+
+// Returns the reputers or the workers registered in a topic
+func getTopicActors(ctx sdk.Context, k keeper.Keeper, topicId uint64, isReputer bool) ([]string, error) {
+	if isReputer {
+		return k.GetTopicReputers(ctx, topicId)
+	}
+	return k.GetTopicWorkers(ctx, topicId)
+}
+
+// Returns a random topic and one of its registered reputers or workers that is a simulation account
+func randomTopicActor(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account, isReputer bool) (types.Topic, simtypes.Account, bool) {
+	topic, found, err := randomTopic(r, ctx, k)
+	if err != nil || !found {
+		return types.Topic{}, simtypes.Account{}, false
+	}
+	actors, err := getTopicActors(ctx, k, topic.Id, isReputer)
+	if err != nil {
+		return types.Topic{}, simtypes.Account{}, false
+	}
+	actor, found := randomAccountOf(r, findAccounts(accs, actors))
+	return topic, actor, found
+}
+
+// Returns a random delegation to a reputer still registered in a random topic, whose delegator is a simulation account
+func randomDelegation(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (types.TopicIdDelegatorReputer, simtypes.Account, bool) {
+	topic, found, err := randomTopic(r, ctx, k)
+	if err != nil || !found {
+		return types.TopicIdDelegatorReputer{}, simtypes.Account{}, false
+	}
+	delegations, err := k.GetTopicDelegations(ctx, topic.Id)
+	if err != nil || len(delegations) == 0 {
+		return types.TopicIdDelegatorReputer{}, simtypes.Account{}, false
+	}
+	delegation := delegations[r.Intn(len(delegations))]
+	isRegistered, err := k.IsReputerRegisteredInTopic(ctx, delegation.TopicId, delegation.Reputer)
+	if err != nil || !isRegistered {
+		return types.TopicIdDelegatorReputer{}, simtypes.Account{}, false
+	}
+	delegator, found := findAccount(accs, delegation.Delegator)
+	return delegation, delegator, found
+}
@@ -0,0 +1,136 @@
+package simulation
+
+import (
+	"math/rand"
+
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// Sources of GMP messages the admins allow and disallow, drawn from a fixed pool so that removals find added sources
+var simGmpAllowedSources = []types.GmpAllowedSource{
+	{SourceChain: "ethereum", SourceAddress: "0x1111111111111111111111111111111111111111"},
+	{SourceChain: "ethereum", SourceAddress: "0x2222222222222222222222222222222222222222"},
+	{SourceChain: "arbitrum", SourceAddress: "0x3333333333333333333333333333333333333333"},
+	{SourceChain: "base", SourceAddress: "0x4444444444444444444444444444444444444444"},
+}
+
+// SimulateMsgUpdateParams updates a random subset of the params that shape the simulation without stalling it
+func SimulateMsgUpdateParams(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.UpdateParamsRequest{Sender: "", Params: nil}
+		admin, found, err := randomWhitelistAdmin(r, ctx, k, accs)
+		if err != nil || !found {
+			return noOp(msg, "no whitelist admin among the accounts")
+		}
+
+		params := &types.OptionalParams{} //nolint:exhaustruct // every option left empty is left unchanged
+		if r.Intn(2) == 0 {
+			params.MinTopicWeight = []alloraMath.Dec{genMinTopicWeight(r)}
+		}
+		if r.Intn(2) == 0 {
+			params.RequiredMinimumStake = []cosmosMath.Int{genRequiredMinimumStake(r)}
+		}
+		if r.Intn(2) == 0 {
+			params.RemoveStakeDelayWindow = []int64{genRemoveStakeDelayWindow(r)}
+		}
+		if r.Intn(2) == 0 {
+			params.CreateTopicFee = []cosmosMath.Int{genFee(r, 100000)}
+		}
+		if r.Intn(2) == 0 {
+			params.RegistrationFee = []cosmosMath.Int{genFee(r, 1000)}
+		}
+		if r.Intn(2) == 0 {
+			params.DataSendingFee = []cosmosMath.Int{genFee(r, 100)}
+		}
+		if r.Intn(2) == 0 {
+			params.ReputerJailDuration = []int64{genReputerJailDuration(r)}
+		}
+		if r.Intn(4) == 0 {
+			params.RewardAccrualEnabled = []bool{r.Intn(2) == 0}
+		}
+		msg.Sender = admin.Address.String()
+		msg.Params = params
+		return deliver(r, app, ctx, txGen, ak, bk, msg, admin, nil)
+	}
+}
+
+func SimulateMsgAddToWhitelistAdmin(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.AddToWhitelistAdminRequest{Sender: "", Address: ""}
+		admin, found, err := randomWhitelistAdmin(r, ctx, k, accs)
+		if err != nil || !found {
+			return noOp(msg, "no whitelist admin among the accounts")
+		}
+		newAdmin, _ := simtypes.RandomAcc(r, accs)
+		msg.Sender = admin.Address.String()
+		msg.Address = newAdmin.Address.String()
+		return deliver(r, app, ctx, txGen, ak, bk, msg, admin, nil)
+	}
+}
+
+// SimulateMsgRemoveFromWhitelistAdmin removes a whitelist admin, always leaving one among the accounts
+func SimulateMsgRemoveFromWhitelistAdmin(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.RemoveFromWhitelistAdminRequest{Sender: "", Address: ""}
+		admins, err := getWhitelistAdmins(ctx, k, accs)
+		if err != nil || len(admins) < 2 {
+			return noOp(msg, "too few whitelist admins among the accounts")
+		}
+		admin := admins[r.Intn(len(admins))]
+		removed := admins[r.Intn(len(admins))]
+		msg.Sender = admin.Address.String()
+		msg.Address = removed.Address.String()
+		return deliver(r, app, ctx, txGen, ak, bk, msg, admin, nil)
+	}
+}
+
+func SimulateMsgAddGmpAllowedSource(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.AddGmpAllowedSourceRequest{Sender: "", SourceChain: "", SourceAddress: ""}
+		admin, found, err := randomWhitelistAdmin(r, ctx, k, accs)
+		if err != nil || !found {
+			return noOp(msg, "no whitelist admin among the accounts")
+		}
+		source := simGmpAllowedSources[r.Intn(len(simGmpAllowedSources))]
+		msg.Sender = admin.Address.String()
+		msg.SourceChain = source.SourceChain
+		msg.SourceAddress = source.SourceAddress
+		return deliver(r, app, ctx, txGen, ak, bk, msg, admin, nil)
+	}
+}
+
+func SimulateMsgRemoveGmpAllowedSource(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.RemoveGmpAllowedSourceRequest{Sender: "", SourceChain: "", SourceAddress: ""}
+		source := simGmpAllowedSources[r.Intn(len(simGmpAllowedSources))]
+		allowed, err := k.IsGmpSourceAllowed(ctx, source.SourceChain, source.SourceAddress)
+		if err != nil || !allowed {
+			return noOp(msg, "gmp source not allowed")
+		}
+		admin, found, err := randomWhitelistAdmin(r, ctx, k, accs)
+		if err != nil || !found {
+			return noOp(msg, "no whitelist admin among the accounts")
+		}
+		msg.Sender = admin.Address.String()
+		msg.SourceChain = source.SourceChain
+		msg.SourceAddress = source.SourceAddress
+		return deliver(r, app, ctx, txGen, ak, bk, msg, admin, nil)
+	}
+}
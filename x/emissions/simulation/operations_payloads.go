@@ -0,0 +1,650 @@
+package simulation
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"math/rand"
+
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	actorutils "github.com/allora-network/allora-chain/x/emissions/keeper/actor_utils"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// Returns the unfulfilled worker nonces of a topic whose submission window, or reveal window if `reveal`, is open
+func openWorkerNonces(ctx sdk.Context, k keeper.Keeper, topic types.Topic, reveal bool) ([]*types.Nonce, error) {
+	nonces, err := k.GetUnfulfilledWorkerNonces(ctx, topic.Id)
+	if err != nil {
+		return nil, err
+	}
+	open := []*types.Nonce{}
+	for _, nonce := range nonces.Nonces {
+		if reveal && k.BlockWithinWorkerRevealWindowOfNonce(topic, *nonce, ctx.BlockHeight()) ||
+			!reveal && k.BlockWithinWorkerSubmissionWindowOfNonce(topic, *nonce, ctx.BlockHeight()) {
+			open = append(open, nonce)
+		}
+	}
+	return open, nil
+}
+
+// Returns the unfulfilled reputer nonces of a topic whose worker nonce is fulfilled and whose reputer window is open
+func openReputerNonces(ctx sdk.Context, k keeper.Keeper, topic types.Topic) ([]*types.ReputerRequestNonce, error) {
+	nonces, err := k.GetUnfulfilledReputerNonces(ctx, topic.Id)
+	if err != nil {
+		return nil, err
+	}
+	open := []*types.ReputerRequestNonce{}
+	for _, nonce := range nonces.Nonces {
+		if !k.BlockWithinReputerSubmissionWindowOfNonce(topic, *nonce, ctx.BlockHeight()) {
+			continue
+		}
+		workerNonceUnfulfilled, err := k.IsWorkerNonceUnfulfilled(ctx, topic.Id, nonce.ReputerNonce)
+		if err != nil {
+			return nil, err
+		} else if !workerNonceUnfulfilled {
+			open = append(open, nonce)
+		}
+	}
+	return open, nil
+}
+
+// Returns whether a payload of the actor would be taken into the active set of the topic, or update its score otherwise:
+// actors may submit once per nonce, and once the set is full only if their score was not updated for the nonce yet
+func mayAppendPayload(
+	ctx sdk.Context,
+	nonceBlockHeight int64,
+	actor string,
+	maxActive uint64,
+	isActive func() (bool, error),
+	getActive func() ([]string, error),
+	getScoreEma func() (types.Score, error),
+) bool {
+	active, err := isActive()
+	if err != nil || active {
+		return false
+	}
+	actives, err := getActive()
+	if err != nil {
+		return false
+	}
+	if uint64(len(actives)) < maxActive {
+		return true
+	}
+	score, err := getScoreEma()
+	return err == nil && score.BlockHeight < nonceBlockHeight
+}
+
+func mayAppendInference(ctx sdk.Context, k keeper.Keeper, params types.Params, topicId uint64, nonceBlockHeight int64, worker string) bool {
+	return mayAppendPayload(ctx, nonceBlockHeight, worker, params.MaxTopInferersToReward,
+		func() (bool, error) { return k.IsActiveInferer(ctx, topicId, worker) },
+		func() ([]string, error) { return k.GetActiveInferersForTopic(ctx, topicId) },
+		func() (types.Score, error) { return k.GetInfererScoreEma(ctx, topicId, worker) },
+	)
+}
+
+func mayAppendForecast(ctx sdk.Context, k keeper.Keeper, params types.Params, topicId uint64, nonceBlockHeight int64, worker string) bool {
+	return mayAppendPayload(ctx, nonceBlockHeight, worker, params.MaxTopForecastersToReward,
+		func() (bool, error) { return k.IsActiveForecaster(ctx, topicId, worker) },
+		func() ([]string, error) { return k.GetActiveForecastersForTopic(ctx, topicId) },
+		func() (types.Score, error) { return k.GetForecasterScoreEma(ctx, topicId, worker) },
+	)
+}
+
+func mayAppendReputerLoss(ctx sdk.Context, k keeper.Keeper, params types.Params, topicId uint64, nonceBlockHeight int64, reputer string) bool {
+	return mayAppendPayload(ctx, nonceBlockHeight, reputer, params.MaxTopReputersToReward,
+		func() (bool, error) { return k.IsActiveReputer(ctx, topicId, reputer) },
+		func() ([]string, error) { return k.GetActiveReputersForTopic(ctx, topicId) },
+		func() (types.Score, error) { return k.GetReputerScoreEma(ctx, topicId, reputer) },
+	)
+}
+
+// Inferences and forecasts are probabilities, valid predictions under every simulated loss method
+func randomPrediction(r *rand.Rand) alloraMath.Dec {
+	return randDecFinite(r, 0, 10000, -4)
+}
+
+func randomLoss(r *rand.Rand) alloraMath.Dec {
+	return randDecFinite(r, 1, 100000, -4)
+}
+
+// Returns whether a worker may submit a payload to a topic: it must be registered and allowed
+func workerMaySubmit(ctx sdk.Context, k keeper.Keeper, topicId uint64, worker string) bool {
+	isRegistered, err := k.IsWorkerRegisteredInTopic(ctx, topicId, worker)
+	if err != nil || !isRegistered {
+		return false
+	}
+	isAllowed, err := k.IsAllowedInTopic(ctx, topicId, false, worker)
+	return err == nil && isAllowed
+}
+
+// Returns whether a reputer may submit losses to a topic: it must be registered, allowed, free and staked enough
+func reputerMaySubmit(ctx sdk.Context, k keeper.Keeper, params types.Params, topicId uint64, reputer string) bool {
+	isRegistered, err := k.IsReputerRegisteredInTopic(ctx, topicId, reputer)
+	if err != nil || !isRegistered {
+		return false
+	}
+	isAllowed, err := k.IsAllowedInTopic(ctx, topicId, true, reputer)
+	if err != nil || !isAllowed {
+		return false
+	}
+	isJailed, err := k.IsReputerJailed(ctx, topicId, reputer)
+	if err != nil || isJailed {
+		return false
+	}
+	stake, err := k.GetStakeReputerAuthority(ctx, topicId, reputer)
+	return err == nil && stake.GTE(params.RequiredMinimumStake)
+}
+
+// Signs the inference and forecast of a worker into a worker data bundle
+func signWorkerDataBundle(worker simtypes.Account, topicId uint64, nonce *types.Nonce, bundle *types.InferenceForecastBundle) (*types.WorkerDataBundle, error) {
+	marshaled, err := bundle.XXX_Marshal(nil, true)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := worker.PrivKey.Sign(marshaled)
+	if err != nil {
+		return nil, err
+	}
+	return &types.WorkerDataBundle{
+		Worker:                             worker.Address.String(),
+		Nonce:                              nonce,
+		TopicId:                            topicId,
+		InferenceForecastsBundle:           bundle,
+		InferencesForecastsBundleSignature: signature,
+		Pubkey:                             hex.EncodeToString(worker.PubKey.Bytes()),
+	}, nil
+}
+
+// Returns a signed payload of a worker for a nonce, with an inference and a forecast of the registered workers
+// as long as they would be accepted. Returns false if neither would be.
+func randomWorkerDataBundle(
+	r *rand.Rand,
+	ctx sdk.Context,
+	k keeper.Keeper,
+	params types.Params,
+	topic types.Topic,
+	nonce *types.Nonce,
+	worker simtypes.Account,
+) (*types.WorkerDataBundle, bool) {
+	address := worker.Address.String()
+	bundle := &types.InferenceForecastBundle{Inference: nil, Forecast: nil}
+	if mayAppendInference(ctx, k, params, topic.Id, nonce.BlockHeight, address) {
+		bundle.Inference = &types.Inference{ //nolint:exhaustruct // extra data and proof are not used by the chain
+			TopicId:     topic.Id,
+			BlockHeight: nonce.BlockHeight,
+			Inferer:     address,
+			Value:       randomPrediction(r),
+		}
+	}
+	if mayAppendForecast(ctx, k, params, topic.Id, nonce.BlockHeight, address) {
+		workers, err := k.GetTopicWorkers(ctx, topic.Id)
+		if err == nil && len(workers) > 0 {
+			elements := []*types.ForecastElement{}
+			for _, i := range r.Perm(len(workers))[:simtypes.RandIntBetween(r, 1, min(len(workers), int(params.MaxElementsPerForecast))+1)] {
+				elements = append(elements, &types.ForecastElement{Inferer: workers[i], Value: randomPrediction(r)})
+			}
+			bundle.Forecast = &types.Forecast{ //nolint:exhaustruct // extra data is not used by the chain
+				TopicId:          topic.Id,
+				BlockHeight:      nonce.BlockHeight,
+				Forecaster:       address,
+				ForecastElements: elements,
+			}
+		}
+	}
+	if bundle.Inference == nil && bundle.Forecast == nil {
+		return nil, false
+	}
+	workerDataBundle, err := signWorkerDataBundle(worker, topic.Id, nonce, bundle)
+	return workerDataBundle, err == nil
+}
+
+// Returns the payload a worker commits to for a nonce and the salt of its commitment. Both are derived from the
+// worker, topic and nonce alone so that the payload can be revealed without keeping it across operations.
+func committedWorkerPayload(worker simtypes.Account, topicId uint64, nonceBlockHeight int64) (*types.InferenceForecastBundle, []byte) {
+	seed := sha256.New()
+	seed.Write(worker.Address)
+	seed.Write(binary.BigEndian.AppendUint64(nil, topicId))
+	seed.Write(binary.BigEndian.AppendUint64(nil, uint64(nonceBlockHeight)))
+	salt := seed.Sum(nil)
+	r := rand.New(rand.NewSource(int64(binary.BigEndian.Uint64(salt)))) //nolint:gosec // simulations need deterministic randomness
+	return &types.InferenceForecastBundle{
+		Inference: &types.Inference{ //nolint:exhaustruct // extra data and proof are not used by the chain
+			TopicId:     topicId,
+			BlockHeight: nonceBlockHeight,
+			Inferer:     worker.Address.String(),
+			Value:       randomPrediction(r),
+		},
+		Forecast: nil,
+	}, salt
+}
+
+// Returns a random worker among the simulation accounts that may submit to a topic
+func randomSubmittingWorker(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account, topic types.Topic) (simtypes.Account, bool) {
+	workers, err := k.GetTopicWorkers(ctx, topic.Id)
+	if err != nil {
+		return simtypes.Account{}, false
+	}
+	worker, found := randomAccountOf(r, findAccounts(accs, workers))
+	if !found || !workerMaySubmit(ctx, k, topic.Id, worker.Address.String()) {
+		return simtypes.Account{}, false
+	}
+	return worker, true
+}
+
+// Returns a random topic in the given worker payload mode and one of its open worker nonces
+func randomOpenWorkerNonce(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, commitReveal, reveal bool) (types.Topic, *types.Nonce, bool) {
+	topic, found, err := randomTopic(r, ctx, k)
+	if err != nil || !found || (topic.WorkerRevealWindow > 0) != commitReveal {
+		return types.Topic{}, nil, false
+	}
+	nonces, err := openWorkerNonces(ctx, k, topic, reveal)
+	if err != nil || len(nonces) == 0 {
+		return types.Topic{}, nil, false
+	}
+	return topic, nonces[r.Intn(len(nonces))], true
+}
+
+func SimulateMsgInsertWorkerPayload(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.InsertWorkerPayloadRequest{Sender: "", WorkerDataBundle: nil}
+		topic, nonce, found := randomOpenWorkerNonce(r, ctx, k, false, false)
+		if !found {
+			return noOp(msg, "no open worker nonce")
+		}
+		worker, found := randomSubmittingWorker(r, ctx, k, accs, topic)
+		if !found {
+			return noOp(msg, "no worker may submit to the topic")
+		}
+		moduleParams, err := k.GetParams(ctx)
+		if err != nil {
+			return noOp(msg, "failed to get params")
+		}
+		fee, err := k.GetTopicDataSendingFee(ctx, topic.Id)
+		if err != nil || spendable(ctx, bk, worker).LT(fee) {
+			return noOp(msg, "worker cannot pay the data sending fee")
+		}
+		bundle, found := randomWorkerDataBundle(r, ctx, k, moduleParams, topic, nonce, worker)
+		if !found {
+			return noOp(msg, "worker payload would not be accepted")
+		}
+		msg.Sender = worker.Address.String()
+		msg.WorkerDataBundle = bundle
+		return deliver(r, app, ctx, txGen, ak, bk, msg, worker, coins(fee))
+	}
+}
+
+func SimulateMsgCommitWorkerPayload(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.CommitWorkerPayloadRequest{Sender: "", TopicId: 0, NonceBlockHeight: 0, Commitment: nil}
+		topic, nonce, found := randomOpenWorkerNonce(r, ctx, k, true, false)
+		if !found {
+			return noOp(msg, "no open worker nonce in commit-reveal mode")
+		}
+		worker, found := randomSubmittingWorker(r, ctx, k, accs, topic)
+		if !found {
+			return noOp(msg, "no worker may submit to the topic")
+		}
+		fee, err := k.GetTopicDataSendingFee(ctx, topic.Id)
+		if err != nil || spendable(ctx, bk, worker).LT(fee) {
+			return noOp(msg, "worker cannot pay the data sending fee")
+		}
+		bundle, salt := committedWorkerPayload(worker, topic.Id, nonce.BlockHeight)
+		commitment, err := types.ComputeWorkerPayloadCommitment(bundle, salt)
+		if err != nil {
+			return noOp(msg, "failed to compute commitment")
+		}
+		msg.Sender = worker.Address.String()
+		msg.TopicId = topic.Id
+		msg.NonceBlockHeight = nonce.BlockHeight
+		msg.Commitment = commitment
+		return deliver(r, app, ctx, txGen, ak, bk, msg, worker, coins(fee))
+	}
+}
+
+func SimulateMsgRevealWorkerPayload(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.RevealWorkerPayloadRequest{Sender: "", WorkerDataBundle: nil, Salt: nil}
+		topic, nonce, found := randomOpenWorkerNonce(r, ctx, k, true, true)
+		if !found {
+			return noOp(msg, "no worker nonce in its reveal window")
+		}
+		worker, found := randomSubmittingWorker(r, ctx, k, accs, topic)
+		if !found {
+			return noOp(msg, "no worker may submit to the topic")
+		}
+		address := worker.Address.String()
+		commitment, found, err := k.GetWorkerCommitment(ctx, topic.Id, nonce.BlockHeight, address)
+		if err != nil || !found || commitment.Revealed {
+			return noOp(msg, "no unrevealed worker commitment")
+		}
+		moduleParams, err := k.GetParams(ctx)
+		if err != nil {
+			return noOp(msg, "failed to get params")
+		}
+		if !mayAppendInference(ctx, k, moduleParams, topic.Id, nonce.BlockHeight, address) {
+			return noOp(msg, "revealed inference would not be accepted")
+		}
+		bundle, salt := committedWorkerPayload(worker, topic.Id, nonce.BlockHeight)
+		workerDataBundle, err := signWorkerDataBundle(worker, topic.Id, nonce, bundle)
+		if err != nil {
+			return noOp(msg, "failed to sign worker payload")
+		}
+		msg.Sender = address
+		msg.WorkerDataBundle = workerDataBundle
+		msg.Salt = salt
+		return deliver(r, app, ctx, txGen, ak, bk, msg, worker, nil)
+	}
+}
+
+func SimulateMsgInsertWorkerPayloads(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.InsertWorkerPayloadsRequest{Sender: "", WorkerDataBundles: nil}
+		topic, nonce, found := randomOpenWorkerNonce(r, ctx, k, false, false)
+		if !found {
+			return noOp(msg, "no open worker nonce")
+		}
+		workers, err := k.GetTopicWorkers(ctx, topic.Id)
+		if err != nil {
+			return noOp(msg, "failed to get topic workers")
+		}
+		moduleParams, err := k.GetParams(ctx)
+		if err != nil {
+			return noOp(msg, "failed to get params")
+		}
+		fee, err := k.GetTopicDataSendingFee(ctx, topic.Id)
+		if err != nil {
+			return noOp(msg, "failed to get data sending fee")
+		}
+
+		// A relayer submits the payloads of several workers at once and pays for all of them
+		bundles := []*types.WorkerDataBundle{}
+		for _, worker := range findAccounts(accs, workers) {
+			if uint64(len(bundles)) >= moduleParams.MaxPayloadsPerBatch {
+				break
+			}
+			if !workerMaySubmit(ctx, k, topic.Id, worker.Address.String()) {
+				continue
+			}
+			if bundle, found := randomWorkerDataBundle(r, ctx, k, moduleParams, topic, nonce, worker); found {
+				bundles = append(bundles, bundle)
+			}
+		}
+		if len(bundles) == 0 {
+			return noOp(msg, "no worker payload would be accepted")
+		}
+		totalFee := fee.MulRaw(int64(len(bundles)))
+		relayer, _ := simtypes.RandomAcc(r, accs)
+		if spendable(ctx, bk, relayer).LT(totalFee) {
+			return noOp(msg, "relayer cannot pay the data sending fees")
+		}
+		msg.Sender = relayer.Address.String()
+		msg.WorkerDataBundles = bundles
+		return deliver(r, app, ctx, txGen, ak, bk, msg, relayer, coins(totalFee))
+	}
+}
+
+// Returns the losses of a nonce the chain recomputes against the ground truth if one was submitted,
+// so that reputers are mostly found honest. Reputers report random losses for those left unset.
+func recomputedLosses(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, topic types.Topic, nonceBlockHeight int64) actorutils.RecomputedLosses {
+	if r.Intn(5) != 0 {
+		recomputed, err := actorutils.GetRecomputedLosses(&k, ctx, topic, types.Nonce{BlockHeight: nonceBlockHeight})
+		if err == nil && recomputed != nil {
+			return *recomputed
+		}
+	}
+	return actorutils.RecomputedLosses{InfererLosses: nil, CombinedLoss: nil, NaiveLoss: nil, ForecasterLosses: nil}
+}
+
+// Returns the recomputed loss of a worker if there is one, a random loss otherwise
+func workerLoss(r *rand.Rand, recomputedLosses map[string]alloraMath.Dec, worker string) alloraMath.Dec {
+	if loss, ok := recomputedLosses[worker]; ok {
+		return loss
+	}
+	return randomLoss(r)
+}
+
+// Returns the recomputed network loss if there is one, a random loss otherwise
+func networkLoss(r *rand.Rand, recomputedLoss *alloraMath.Dec) alloraMath.Dec {
+	if recomputedLoss != nil {
+		return *recomputedLoss
+	}
+	return randomLoss(r)
+}
+
+// Returns the signed losses of a reputer for the inferences and forecasts accepted at a reputer nonce
+func randomReputerValueBundle(
+	r *rand.Rand,
+	ctx sdk.Context,
+	k keeper.Keeper,
+	topic types.Topic,
+	nonce *types.ReputerRequestNonce,
+	reputer simtypes.Account,
+) (*types.ReputerValueBundle, bool) {
+	nonceBlockHeight := nonce.ReputerNonce.BlockHeight
+	inferences, err := k.GetInferencesAtBlock(ctx, topic.Id, nonceBlockHeight)
+	if err != nil {
+		return nil, false
+	}
+	forecasts, err := k.GetForecastsAtBlock(ctx, topic.Id, nonceBlockHeight)
+	if err != nil {
+		return nil, false
+	}
+
+	recomputed := recomputedLosses(r, ctx, k, topic, nonceBlockHeight)
+	infererValues := make([]*types.WorkerAttributedValue, 0, len(inferences.Inferences))
+	oneOutInfererValues := make([]*types.WithheldWorkerAttributedValue, 0, len(inferences.Inferences))
+	for _, inference := range inferences.Inferences {
+		infererValues = append(infererValues, &types.WorkerAttributedValue{Worker: inference.Inferer, Value: workerLoss(r, recomputed.InfererLosses, inference.Inferer), Values: nil})
+		oneOutInfererValues = append(oneOutInfererValues, &types.WithheldWorkerAttributedValue{Worker: inference.Inferer, Value: randomLoss(r), Values: nil})
+	}
+	forecasterValues := make([]*types.WorkerAttributedValue, 0, len(forecasts.Forecasts))
+	oneOutForecasterValues := make([]*types.WithheldWorkerAttributedValue, 0, len(forecasts.Forecasts))
+	oneInForecasterValues := make([]*types.WorkerAttributedValue, 0, len(forecasts.Forecasts))
+	oneOutInfererForecasterValues := make([]*types.OneOutInfererForecasterValues, 0, len(forecasts.Forecasts))
+	for _, forecast := range forecasts.Forecasts {
+		forecasterValues = append(forecasterValues, &types.WorkerAttributedValue{Worker: forecast.Forecaster, Value: workerLoss(r, recomputed.ForecasterLosses, forecast.Forecaster), Values: nil})
+		oneOutForecasterValues = append(oneOutForecasterValues, &types.WithheldWorkerAttributedValue{Worker: forecast.Forecaster, Value: randomLoss(r), Values: nil})
+		oneInForecasterValues = append(oneInForecasterValues, &types.WorkerAttributedValue{Worker: forecast.Forecaster, Value: randomLoss(r), Values: nil})
+		withheld := make([]*types.WithheldWorkerAttributedValue, 0, len(inferences.Inferences))
+		for _, inference := range inferences.Inferences {
+			withheld = append(withheld, &types.WithheldWorkerAttributedValue{Worker: inference.Inferer, Value: randomLoss(r), Values: nil})
+		}
+		oneOutInfererForecasterValues = append(oneOutInfererForecasterValues, &types.OneOutInfererForecasterValues{
+			Forecaster:          forecast.Forecaster,
+			OneOutInfererValues: withheld,
+		})
+	}
+
+	valueBundle := &types.ValueBundle{
+		TopicId:                       topic.Id,
+		ReputerRequestNonce:           nonce,
+		Reputer:                       reputer.Address.String(),
+		ExtraData:                     nil,
+		CombinedValue:                 networkLoss(r, recomputed.CombinedLoss),
+		InfererValues:                 infererValues,
+		ForecasterValues:              forecasterValues,
+		NaiveValue:                    networkLoss(r, recomputed.NaiveLoss),
+		OneOutInfererValues:           oneOutInfererValues,
+		OneOutForecasterValues:        oneOutForecasterValues,
+		OneInForecasterValues:         oneInForecasterValues,
+		OneOutInfererForecasterValues: oneOutInfererForecasterValues,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
+	}
+	marshaled, err := valueBundle.XXX_Marshal(nil, true)
+	if err != nil {
+		return nil, false
+	}
+	signature, err := reputer.PrivKey.Sign(marshaled)
+	if err != nil {
+		return nil, false
+	}
+	return &types.ReputerValueBundle{
+		ValueBundle: valueBundle,
+		Signature:   signature,
+		Pubkey:      hex.EncodeToString(reputer.PubKey.Bytes()),
+	}, true
+}
+
+// Returns a random topic and one of its reputer nonces open to losses
+func randomOpenReputerNonce(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (types.Topic, *types.ReputerRequestNonce, bool) {
+	topic, found, err := randomTopic(r, ctx, k)
+	if err != nil || !found {
+		return types.Topic{}, nil, false
+	}
+	nonces, err := openReputerNonces(ctx, k, topic)
+	if err != nil || len(nonces) == 0 {
+		return types.Topic{}, nil, false
+	}
+	return topic, nonces[r.Intn(len(nonces))], true
+}
+
+func SimulateMsgInsertReputerPayload(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.InsertReputerPayloadRequest{Sender: "", ReputerValueBundle: nil}
+		topic, nonce, found := randomOpenReputerNonce(r, ctx, k)
+		if !found {
+			return noOp(msg, "no open reputer nonce")
+		}
+		reputers, err := k.GetTopicReputers(ctx, topic.Id)
+		if err != nil {
+			return noOp(msg, "failed to get topic reputers")
+		}
+		reputer, found := randomAccountOf(r, findAccounts(accs, reputers))
+		if !found {
+			return noOp(msg, "no registered reputer")
+		}
+		address := reputer.Address.String()
+		moduleParams, err := k.GetParams(ctx)
+		if err != nil {
+			return noOp(msg, "failed to get params")
+		}
+		if !reputerMaySubmit(ctx, k, moduleParams, topic.Id, address) ||
+			!mayAppendReputerLoss(ctx, k, moduleParams, topic.Id, nonce.ReputerNonce.BlockHeight, address) {
+			return noOp(msg, "reputer losses would not be accepted")
+		}
+		fee, err := k.GetTopicDataSendingFee(ctx, topic.Id)
+		if err != nil || spendable(ctx, bk, reputer).LT(fee) {
+			return noOp(msg, "reputer cannot pay the data sending fee")
+		}
+		bundle, found := randomReputerValueBundle(r, ctx, k, topic, nonce, reputer)
+		if !found {
+			return noOp(msg, "failed to build reputer losses")
+		}
+		msg.Sender = address
+		msg.ReputerValueBundle = bundle
+		return deliver(r, app, ctx, txGen, ak, bk, msg, reputer, coins(fee))
+	}
+}
+
+func SimulateMsgInsertReputerPayloads(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.InsertReputerPayloadsRequest{Sender: "", ReputerValueBundles: nil}
+		topic, nonce, found := randomOpenReputerNonce(r, ctx, k)
+		if !found {
+			return noOp(msg, "no open reputer nonce")
+		}
+		reputers, err := k.GetTopicReputers(ctx, topic.Id)
+		if err != nil {
+			return noOp(msg, "failed to get topic reputers")
+		}
+		moduleParams, err := k.GetParams(ctx)
+		if err != nil {
+			return noOp(msg, "failed to get params")
+		}
+		fee, err := k.GetTopicDataSendingFee(ctx, topic.Id)
+		if err != nil {
+			return noOp(msg, "failed to get data sending fee")
+		}
+
+		// A relayer submits the losses of several reputers at once and pays for all of them
+		bundles := []*types.ReputerValueBundle{}
+		for _, reputer := range findAccounts(accs, reputers) {
+			if uint64(len(bundles)) >= moduleParams.MaxPayloadsPerBatch {
+				break
+			}
+			address := reputer.Address.String()
+			if !reputerMaySubmit(ctx, k, moduleParams, topic.Id, address) ||
+				!mayAppendReputerLoss(ctx, k, moduleParams, topic.Id, nonce.ReputerNonce.BlockHeight, address) {
+				continue
+			}
+			if bundle, found := randomReputerValueBundle(r, ctx, k, topic, nonce, reputer); found {
+				bundles = append(bundles, bundle)
+			}
+		}
+		if len(bundles) == 0 {
+			return noOp(msg, "no reputer losses would be accepted")
+		}
+		totalFee := fee.MulRaw(int64(len(bundles)))
+		relayer, _ := simtypes.RandomAcc(r, accs)
+		if spendable(ctx, bk, relayer).LT(totalFee) {
+			return noOp(msg, "relayer cannot pay the data sending fees")
+		}
+		msg.Sender = relayer.Address.String()
+		msg.ReputerValueBundles = bundles
+		return deliver(r, app, ctx, txGen, ak, bk, msg, relayer, coins(totalFee))
+	}
+}
+
+func SimulateMsgSubmitGroundTruth(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.SubmitGroundTruthRequest{Sender: "", TopicId: 0, ReputerNonceBlockHeight: 0, GroundTruth: alloraMath.ZeroDec()}
+		topic, found, err := randomTopic(r, ctx, k)
+		if err != nil || !found {
+			return noOp(msg, "no topic")
+		}
+		if _, ok := k.GetLossFunction(topic.LossMethod); !ok || topic.InferenceDimension() > 1 {
+			return noOp(msg, "chain cannot recompute the losses of the topic")
+		}
+		nonces, err := k.GetUnfulfilledReputerNonces(ctx, topic.Id)
+		if err != nil {
+			return noOp(msg, "failed to get unfulfilled reputer nonces")
+		}
+
+		// The ground truth of a nonce is known once the ground truth lag has elapsed, and is submitted once
+		available := []int64{}
+		for _, nonce := range nonces.Nonces {
+			nonceBlockHeight := nonce.ReputerNonce.BlockHeight
+			if ctx.BlockHeight() < nonceBlockHeight+topic.GroundTruthLag {
+				continue
+			}
+			_, found, err := k.GetGroundTruth(ctx, topic.Id, nonceBlockHeight)
+			if err != nil {
+				return noOp(msg, "failed to get ground truth")
+			} else if !found {
+				available = append(available, nonceBlockHeight)
+			}
+		}
+		if len(available) == 0 {
+			return noOp(msg, "no reputer nonce awaiting its ground truth")
+		}
+		sender, found, err := randomTopicManager(r, ctx, k, accs, topic)
+		if err != nil || !found {
+			return noOp(msg, "no account may submit the ground truth")
+		}
+		msg.Sender = sender.Address.String()
+		msg.TopicId = topic.Id
+		msg.ReputerNonceBlockHeight = available[r.Intn(len(available))]
+		msg.GroundTruth = randomPrediction(r)
+		return deliver(r, app, ctx, txGen, ak, bk, msg, sender, nil)
+	}
+}
@@ -0,0 +1,118 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func SimulateMsgRegister(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.RegisterRequest{Sender: "", TopicId: 0, Owner: "", IsReputer: false}
+		topic, found, err := randomTopic(r, ctx, k)
+		if err != nil || !found {
+			return noOp(msg, "no topic")
+		}
+		actor, _ := simtypes.RandomAcc(r, accs)
+		isReputer := r.Intn(2) == 0
+		address := actor.Address.String()
+
+		isAllowed, err := k.IsAllowedInTopic(ctx, topic.Id, isReputer, address)
+		if err != nil || !isAllowed {
+			return noOp(msg, "actor not allowed in topic")
+		}
+		var isRegistered bool
+		if isReputer {
+			isRegistered, err = k.IsReputerRegisteredInTopic(ctx, topic.Id, address)
+		} else {
+			isRegistered, err = k.IsWorkerRegisteredInTopic(ctx, topic.Id, address)
+		}
+		if err != nil || isRegistered {
+			return noOp(msg, "actor already registered in topic")
+		}
+		moduleParams, err := k.GetParams(ctx)
+		if err != nil {
+			return noOp(msg, "failed to get params")
+		}
+		if spendable(ctx, bk, actor).LT(moduleParams.RegistrationFee) {
+			return noOp(msg, "actor cannot pay the registration fee")
+		}
+
+		msg.Sender = address
+		msg.TopicId = topic.Id
+		msg.Owner = address
+		msg.IsReputer = isReputer
+		return deliver(r, app, ctx, txGen, ak, bk, msg, actor, coins(moduleParams.RegistrationFee))
+	}
+}
+
+func SimulateMsgRemoveRegistration(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.RemoveRegistrationRequest{Sender: "", TopicId: 0, IsReputer: false}
+		isReputer := r.Intn(2) == 0
+		topic, actor, found := randomTopicActor(r, ctx, k, accs, isReputer)
+		if !found {
+			return noOp(msg, "no registered actor")
+		}
+		msg.Sender = actor.Address.String()
+		msg.TopicId = topic.Id
+		msg.IsReputer = isReputer
+		return deliver(r, app, ctx, txGen, ak, bk, msg, actor, nil)
+	}
+}
+
+func SimulateMsgUnjailReputer(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.UnjailReputerRequest{Sender: "", TopicId: 0}
+		topic, found, err := randomTopic(r, ctx, k)
+		if err != nil || !found {
+			return noOp(msg, "no topic")
+		}
+		reputers, err := k.GetTopicReputers(ctx, topic.Id)
+		if err != nil {
+			return noOp(msg, "failed to get topic reputers")
+		}
+		moduleParams, err := k.GetParams(ctx)
+		if err != nil {
+			return noOp(msg, "failed to get params")
+		}
+
+		// Only the reputers whose jail period has elapsed and that kept the minimum stake may unjail
+		unjailable := []simtypes.Account{}
+		for _, reputer := range findAccounts(accs, reputers) {
+			address := reputer.Address.String()
+			info, err := k.GetReputerSlashingInfo(ctx, topic.Id, address)
+			if err != nil {
+				return noOp(msg, "failed to get reputer slashing info")
+			}
+			if !info.Jailed || ctx.BlockHeight() < info.JailedUntilBlock {
+				continue
+			}
+			stake, err := k.GetStakeReputerAuthority(ctx, topic.Id, address)
+			if err != nil {
+				return noOp(msg, "failed to get reputer stake")
+			}
+			if stake.GTE(moduleParams.RequiredMinimumStake) {
+				unjailable = append(unjailable, reputer)
+			}
+		}
+		reputer, found := randomAccountOf(r, unjailable)
+		if !found {
+			return noOp(msg, "no reputer may unjail")
+		}
+		msg.Sender = reputer.Address.String()
+		msg.TopicId = topic.Id
+		return deliver(r, app, ctx, txGen, ak, bk, msg, reputer, nil)
+	}
+}
@@ -0,0 +1,312 @@
+package simulation
+
+import (
+	"math/rand"
+
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func SimulateMsgAddStake(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.AddStakeRequest{Sender: "", TopicId: 0, Amount: cosmosMath.ZeroInt()}
+		topic, reputer, found := randomTopicActor(r, ctx, k, accs, true)
+		if !found {
+			return noOp(msg, "no registered reputer")
+		}
+		balance := spendable(ctx, bk, reputer)
+		if !balance.IsPositive() {
+			return noOp(msg, "reputer has no balance")
+		}
+		msg.Sender = reputer.Address.String()
+		msg.TopicId = topic.Id
+		msg.Amount = randomAmount(r, balance, 10)
+		return deliver(r, app, ctx, txGen, ak, bk, msg, reputer, coins(msg.Amount))
+	}
+}
+
+func SimulateMsgRemoveStake(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.RemoveStakeRequest{Sender: "", TopicId: 0, Amount: cosmosMath.ZeroInt()}
+		topic, reputer, found := randomTopicActor(r, ctx, k, accs, true)
+		if !found {
+			return noOp(msg, "no registered reputer")
+		}
+		address := reputer.Address.String()
+		stake, err := k.GetStakeReputerAuthority(ctx, topic.Id, address)
+		if err != nil {
+			return noOp(msg, "failed to get reputer stake")
+		}
+		delegated, err := k.GetDelegateStakeUponReputer(ctx, topic.Id, address)
+		if err != nil {
+			return noOp(msg, "failed to get delegate stake upon reputer")
+		}
+		// Only the reputer's own stake may be removed
+		ownStake := stake.Sub(delegated)
+		if !ownStake.IsPositive() {
+			return noOp(msg, "reputer has no stake of its own")
+		}
+		amount, err := simtypes.RandPositiveInt(r, ownStake)
+		if err != nil {
+			return noOp(msg, "failed to draw amount")
+		}
+		msg.Sender = address
+		msg.TopicId = topic.Id
+		msg.Amount = amount
+		return deliver(r, app, ctx, txGen, ak, bk, msg, reputer, nil)
+	}
+}
+
+func SimulateMsgCancelRemoveStake(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.CancelRemoveStakeRequest{Sender: "", TopicId: 0}
+		topic, reputer, found := randomTopicActor(r, ctx, k, accs, true)
+		if !found {
+			return noOp(msg, "no registered reputer")
+		}
+		_, found, err := k.GetStakeRemovalForReputerAndTopicId(ctx, reputer.Address.String(), topic.Id)
+		if err != nil || !found {
+			return noOp(msg, "no stake removal to cancel")
+		}
+		msg.Sender = reputer.Address.String()
+		msg.TopicId = topic.Id
+		return deliver(r, app, ctx, txGen, ak, bk, msg, reputer, nil)
+	}
+}
+
+func SimulateMsgDelegateStake(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.DelegateStakeRequest{Sender: "", TopicId: 0, Reputer: "", Amount: cosmosMath.ZeroInt()}
+		topic, found, err := randomTopic(r, ctx, k)
+		if err != nil || !found {
+			return noOp(msg, "no topic")
+		}
+		// Delegations may go to any registered reputer, simulation account or not
+		reputers, err := k.GetTopicReputers(ctx, topic.Id)
+		if err != nil || len(reputers) == 0 {
+			return noOp(msg, "no registered reputer")
+		}
+		delegator, _ := simtypes.RandomAcc(r, accs)
+		balance := spendable(ctx, bk, delegator)
+		if !balance.IsPositive() {
+			return noOp(msg, "delegator has no balance")
+		}
+		reputer := reputers[r.Intn(len(reputers))]
+		if reputer == delegator.Address.String() {
+			return noOp(msg, "reputers cannot delegate to themselves")
+		}
+		msg.Sender = delegator.Address.String()
+		msg.TopicId = topic.Id
+		msg.Reputer = reputer
+		msg.Amount = randomAmount(r, balance, 10)
+		return deliver(r, app, ctx, txGen, ak, bk, msg, delegator, coins(msg.Amount))
+	}
+}
+
+// Returns the whole part of the stake a delegator placed upon a reputer
+func delegatedAmount(ctx sdk.Context, k keeper.Keeper, delegation types.TopicIdDelegatorReputer) (cosmosMath.Int, error) {
+	placement, err := k.GetDelegateStakePlacement(ctx, delegation.TopicId, delegation.Delegator, delegation.Reputer)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	return placement.Amount.SdkIntTrim()
+}
+
+func SimulateMsgRemoveDelegateStake(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.RemoveDelegateStakeRequest{Sender: "", Reputer: "", TopicId: 0, Amount: cosmosMath.ZeroInt()}
+		delegation, delegator, found := randomDelegation(r, ctx, k, accs)
+		if !found {
+			return noOp(msg, "no delegation")
+		}
+		delegated, err := delegatedAmount(ctx, k, delegation)
+		if err != nil {
+			return noOp(msg, "failed to get delegated amount")
+		}
+		stake, err := k.GetStakeReputerAuthority(ctx, delegation.TopicId, delegation.Reputer)
+		if err != nil {
+			return noOp(msg, "failed to get reputer stake")
+		}
+		removable := cosmosMath.MinInt(delegated, stake)
+		if !removable.IsPositive() {
+			return noOp(msg, "no delegate stake to remove")
+		}
+		amount, err := simtypes.RandPositiveInt(r, removable)
+		if err != nil {
+			return noOp(msg, "failed to draw amount")
+		}
+		msg.Sender = delegator.Address.String()
+		msg.Reputer = delegation.Reputer
+		msg.TopicId = delegation.TopicId
+		msg.Amount = amount
+		return deliver(r, app, ctx, txGen, ak, bk, msg, delegator, nil)
+	}
+}
+
+func SimulateMsgCancelRemoveDelegateStake(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.CancelRemoveDelegateStakeRequest{Sender: "", TopicId: 0, Delegator: "", Reputer: ""}
+		delegation, delegator, found := randomDelegation(r, ctx, k, accs)
+		if !found {
+			return noOp(msg, "no delegation")
+		}
+		_, found, err := k.GetDelegateStakeRemovalForDelegatorReputerAndTopicId(
+			ctx, delegation.Delegator, delegation.Reputer, delegation.TopicId)
+		if err != nil || !found {
+			return noOp(msg, "no delegate stake removal to cancel")
+		}
+		msg.Sender = delegator.Address.String()
+		msg.TopicId = delegation.TopicId
+		msg.Delegator = delegation.Delegator
+		msg.Reputer = delegation.Reputer
+		return deliver(r, app, ctx, txGen, ak, bk, msg, delegator, nil)
+	}
+}
+
+func SimulateMsgRewardDelegateStake(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.RewardDelegateStakeRequest{Sender: "", TopicId: 0, Reputer: ""}
+		delegation, delegator, found := randomDelegation(r, ctx, k, accs)
+		if !found {
+			return noOp(msg, "no delegation")
+		}
+		msg.Sender = delegator.Address.String()
+		msg.TopicId = delegation.TopicId
+		msg.Reputer = delegation.Reputer
+		return deliver(r, app, ctx, txGen, ak, bk, msg, delegator, nil)
+	}
+}
+
+func SimulateMsgSetDelegateStakeAutoCompound(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.SetDelegateStakeAutoCompoundRequest{Sender: "", TopicId: 0, Reputer: "", Enabled: false}
+		delegation, delegator, found := randomDelegation(r, ctx, k, accs)
+		if !found {
+			return noOp(msg, "no delegation")
+		}
+		enabled := r.Intn(3) != 0
+		if enabled {
+			delegated, err := delegatedAmount(ctx, k, delegation)
+			if err != nil || !delegated.IsPositive() {
+				return noOp(msg, "no delegate stake to auto-compound")
+			}
+			delegators, err := k.GetAutoCompoundingDelegators(ctx, delegation.TopicId, delegation.Reputer)
+			if err != nil || len(delegators) >= keeper.MAX_AUTO_COMPOUNDING_DELEGATIONS_PER_REPUTER {
+				return noOp(msg, "too many auto-compounding delegations to the reputer")
+			}
+		}
+		msg.Sender = delegator.Address.String()
+		msg.TopicId = delegation.TopicId
+		msg.Reputer = delegation.Reputer
+		msg.Enabled = enabled
+		return deliver(r, app, ctx, txGen, ak, bk, msg, delegator, nil)
+	}
+}
+
+func SimulateMsgRedelegateStake(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.RedelegateStakeRequest{
+			Sender:     "",
+			SrcTopicId: 0,
+			SrcReputer: "",
+			DstTopicId: 0,
+			DstReputer: "",
+			Amount:     cosmosMath.ZeroInt(),
+		}
+		src, delegator, found := randomDelegation(r, ctx, k, accs)
+		if !found {
+			return noOp(msg, "no delegation")
+		}
+		dstTopic, found, err := randomTopic(r, ctx, k)
+		if err != nil || !found {
+			return noOp(msg, "no topic")
+		}
+		dstReputers, err := k.GetTopicReputers(ctx, dstTopic.Id)
+		if err != nil || len(dstReputers) == 0 {
+			return noOp(msg, "no registered reputer to redelegate to")
+		}
+		dstReputer := dstReputers[r.Intn(len(dstReputers))]
+		if dstReputer == src.Delegator || (dstTopic.Id == src.TopicId && dstReputer == src.Reputer) {
+			return noOp(msg, "cannot redelegate to the delegator or to the source")
+		}
+
+		moduleParams, err := k.GetParams(ctx)
+		if err != nil {
+			return noOp(msg, "failed to get params")
+		}
+		inProgress, err := k.GetDelegatorRedelegations(ctx, src.Delegator)
+		if err != nil || uint64(len(inProgress)) >= moduleParams.MaxRedelegationsPerDelegator {
+			return noOp(msg, "too many redelegations in progress")
+		}
+		for _, redelegation := range inProgress {
+			if redelegation.DstTopicId == src.TopicId && redelegation.DstReputer == src.Reputer {
+				return noOp(msg, "source stake is being redelegated into")
+			}
+		}
+
+		// Stake queued for removal cannot be redelegated
+		available, err := delegatedAmount(ctx, k, src)
+		if err != nil {
+			return noOp(msg, "failed to get delegated amount")
+		}
+		removal, found, err := k.GetDelegateStakeRemovalForDelegatorReputerAndTopicId(ctx, src.Delegator, src.Reputer, src.TopicId)
+		if err != nil {
+			return noOp(msg, "failed to get delegate stake removal")
+		} else if found {
+			available = available.Sub(cosmosMath.MinInt(removal.Amount, available))
+		}
+		if !available.IsPositive() {
+			return noOp(msg, "no delegate stake to redelegate")
+		}
+		amount, err := simtypes.RandPositiveInt(r, available)
+		if err != nil {
+			return noOp(msg, "failed to draw amount")
+		}
+
+		msg.Sender = delegator.Address.String()
+		msg.SrcTopicId = src.TopicId
+		msg.SrcReputer = src.Reputer
+		msg.DstTopicId = dstTopic.Id
+		msg.DstReputer = dstReputer
+		msg.Amount = amount
+		return deliver(r, app, ctx, txGen, ak, bk, msg, delegator, nil)
+	}
+}
+
+func SimulateMsgClaimRewards(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.ClaimRewardsRequest{Sender: ""}
+		actor, _ := simtypes.RandomAcc(r, accs)
+		_, claimable, err := k.GetAccruedRewards(ctx, actor.Address.String())
+		if err != nil || !claimable.IsPositive() {
+			return noOp(msg, "no accrued rewards to claim")
+		}
+		msg.Sender = actor.Address.String()
+		return deliver(r, app, ctx, txGen, ak, bk, msg, actor, nil)
+	}
+}
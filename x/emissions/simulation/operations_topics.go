@@ -0,0 +1,399 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func SimulateMsgCreateNewTopic(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.CreateNewTopicRequest{} //nolint:exhaustruct // filled from a random topic below
+		moduleParams, err := k.GetParams(ctx)
+		if err != nil {
+			return noOp(msg, "failed to get params")
+		}
+		creator, _ := simtypes.RandomAcc(r, accs)
+		if spendable(ctx, bk, creator).LT(moduleParams.CreateTopicFee) {
+			return noOp(msg, "creator cannot pay the topic creation fee")
+		}
+
+		topic := RandomTopic(r, 0, creator.Address.String(), moduleParams)
+		msg = &types.CreateNewTopicRequest{
+			Creator:                  topic.Creator,
+			Metadata:                 topic.Metadata,
+			LossMethod:               topic.LossMethod,
+			EpochLength:              topic.EpochLength,
+			GroundTruthLag:           topic.GroundTruthLag,
+			PNorm:                    topic.PNorm,
+			AlphaRegret:              topic.AlphaRegret,
+			AllowNegative:            topic.AllowNegative,
+			Epsilon:                  topic.Epsilon,
+			WorkerSubmissionWindow:   topic.WorkerSubmissionWindow,
+			MeritSortitionAlpha:      topic.MeritSortitionAlpha,
+			ActiveInfererQuantile:    topic.ActiveInfererQuantile,
+			ActiveForecasterQuantile: topic.ActiveForecasterQuantile,
+			ActiveReputerQuantile:    topic.ActiveReputerQuantile,
+			WorkerRevealWindow:       topic.WorkerRevealWindow,
+			ValueDimension:           topic.ValueDimension,
+			ArchiveNetworkInferences: topic.ArchiveNetworkInferences,
+		}
+		return deliver(r, app, ctx, txGen, ak, bk, msg, creator, coins(moduleParams.CreateTopicFee))
+	}
+}
+
+// SimulateMsgUpdateTopic schedules a random update of a topic, sometimes of the timing of its epochs
+func SimulateMsgUpdateTopic(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.UpdateTopicRequest{Sender: "", TopicId: 0, Params: nil}
+		topic, found, err := randomTopic(r, ctx, k)
+		if err != nil || !found {
+			return noOp(msg, "no topic")
+		}
+		sender, found, err := randomTopicManager(r, ctx, k, accs, topic)
+		if err != nil || !found {
+			return noOp(msg, "no account may update the topic")
+		}
+		moduleParams, err := k.GetParams(ctx)
+		if err != nil {
+			return noOp(msg, "failed to get params")
+		}
+
+		updated := RandomTopic(r, topic.Id, topic.Creator, moduleParams)
+		params := types.OptionalTopicParams{} //nolint:exhaustruct // every option left empty is left unchanged
+		if r.Intn(4) == 0 {
+			params.EpochLength = []int64{updated.EpochLength}
+			params.GroundTruthLag = []int64{updated.GroundTruthLag}
+			params.WorkerSubmissionWindow = []int64{updated.WorkerSubmissionWindow}
+			params.WorkerRevealWindow = []int64{updated.WorkerRevealWindow}
+		}
+		if r.Intn(2) == 0 {
+			params.PNorm = []alloraMath.Dec{updated.PNorm}
+		}
+		if r.Intn(2) == 0 {
+			params.AlphaRegret = []alloraMath.Dec{updated.AlphaRegret}
+		}
+		if r.Intn(2) == 0 {
+			params.Epsilon = []alloraMath.Dec{updated.Epsilon}
+		}
+		if r.Intn(2) == 0 {
+			params.MeritSortitionAlpha = []alloraMath.Dec{updated.MeritSortitionAlpha}
+		}
+		if r.Intn(2) == 0 {
+			params.ActiveInfererQuantile = []alloraMath.Dec{updated.ActiveInfererQuantile}
+			params.ActiveForecasterQuantile = []alloraMath.Dec{updated.ActiveForecasterQuantile}
+			params.ActiveReputerQuantile = []alloraMath.Dec{updated.ActiveReputerQuantile}
+		}
+		if r.Intn(2) == 0 {
+			params.ArchiveNetworkInferences = []bool{updated.ArchiveNetworkInferences}
+		}
+
+		// The update is merged onto any update already scheduled, and the result must make for a valid topic
+		merged := params
+		scheduled, found, err := k.GetScheduledTopicUpdate(ctx, topic.Id)
+		if err != nil {
+			return noOp(msg, "failed to get scheduled topic update")
+		} else if found {
+			merged = scheduled.Params.Merge(params)
+		}
+		if err := merged.ApplyTo(topic).Validate(moduleParams); err != nil {
+			return noOp(msg, fmt.Sprintf("updated topic would be invalid: %s", err))
+		}
+
+		msg.Sender = sender.Address.String()
+		msg.TopicId = topic.Id
+		msg.Params = &params
+		return deliver(r, app, ctx, txGen, ak, bk, msg, sender, nil)
+	}
+}
+
+func SimulateMsgRetireTopic(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.RetireTopicRequest{Sender: "", TopicId: 0}
+		topics, err := getTopics(ctx, k)
+		// Retiring the last topics would leave nothing to simulate
+		if err != nil || len(topics) < 3 {
+			return noOp(msg, "too few topics to retire one")
+		}
+		topic := topics[r.Intn(len(topics))]
+		sender, found, err := randomTopicManager(r, ctx, k, accs, topic)
+		if err != nil || !found {
+			return noOp(msg, "no account may retire the topic")
+		}
+		msg.Sender = sender.Address.String()
+		msg.TopicId = topic.Id
+		return deliver(r, app, ctx, txGen, ak, bk, msg, sender, nil)
+	}
+}
+
+func SimulateMsgFundTopic(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.FundTopicRequest{Sender: "", TopicId: 0, Amount: cosmosMath.ZeroInt()}
+		topic, found, err := randomTopic(r, ctx, k)
+		if err != nil || !found {
+			return noOp(msg, "no topic")
+		}
+		funder, _ := simtypes.RandomAcc(r, accs)
+		balance := spendable(ctx, bk, funder)
+		if !balance.IsPositive() {
+			return noOp(msg, "funder has no balance")
+		}
+		msg.Sender = funder.Address.String()
+		msg.TopicId = topic.Id
+		msg.Amount = randomAmount(r, balance, 100)
+		return deliver(r, app, ctx, txGen, ak, bk, msg, funder, coins(msg.Amount))
+	}
+}
+
+func SimulateMsgSetTopicAllowlistEnabled(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.SetTopicAllowlistEnabledRequest{Sender: "", TopicId: 0, IsReputer: false, Enabled: false}
+		topic, found, err := randomTopic(r, ctx, k)
+		if err != nil || !found {
+			return noOp(msg, "no topic")
+		}
+		sender, found, err := randomTopicManager(r, ctx, k, accs, topic)
+		if err != nil || !found {
+			return noOp(msg, "no account may manage the topic allowlist")
+		}
+		msg.Sender = sender.Address.String()
+		msg.TopicId = topic.Id
+		msg.IsReputer = r.Intn(2) == 0
+		// Allowlists are mostly left disabled, for most actors to keep taking part in the topics
+		msg.Enabled = r.Intn(3) == 0
+		return deliver(r, app, ctx, txGen, ak, bk, msg, sender, nil)
+	}
+}
+
+func SimulateMsgAddToTopicAllowlist(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.AddToTopicAllowlistRequest{Sender: "", TopicId: 0, IsReputer: false, Address: ""}
+		topic, found, err := randomTopic(r, ctx, k)
+		if err != nil || !found {
+			return noOp(msg, "no topic")
+		}
+		sender, found, err := randomTopicManager(r, ctx, k, accs, topic)
+		if err != nil || !found {
+			return noOp(msg, "no account may manage the topic allowlist")
+		}
+		msg.IsReputer = r.Intn(2) == 0
+		// Registered actors are allowed first, so they may keep submitting once the allowlist is enabled
+		actors, err := getTopicActors(ctx, k, topic.Id, msg.IsReputer)
+		if err != nil {
+			return noOp(msg, "failed to get topic actors")
+		}
+		allowed, found := randomAccountOf(r, findAccounts(accs, actors))
+		if !found || r.Intn(4) == 0 {
+			allowed, _ = simtypes.RandomAcc(r, accs)
+		}
+		msg.Sender = sender.Address.String()
+		msg.TopicId = topic.Id
+		msg.Address = allowed.Address.String()
+		return deliver(r, app, ctx, txGen, ak, bk, msg, sender, nil)
+	}
+}
+
+func SimulateMsgRemoveFromTopicAllowlist(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.RemoveFromTopicAllowlistRequest{Sender: "", TopicId: 0, IsReputer: false, Address: ""}
+		topic, found, err := randomTopic(r, ctx, k)
+		if err != nil || !found {
+			return noOp(msg, "no topic")
+		}
+		isReputer := r.Intn(2) == 0
+		allowlist, err := k.GetTopicAllowlist(ctx, topic.Id, isReputer)
+		if err != nil || len(allowlist) == 0 {
+			return noOp(msg, "empty topic allowlist")
+		}
+		sender, found, err := randomTopicManager(r, ctx, k, accs, topic)
+		if err != nil || !found {
+			return noOp(msg, "no account may manage the topic allowlist")
+		}
+		msg.Sender = sender.Address.String()
+		msg.TopicId = topic.Id
+		msg.IsReputer = isReputer
+		msg.Address = allowlist[r.Intn(len(allowlist))]
+		return deliver(r, app, ctx, txGen, ak, bk, msg, sender, nil)
+	}
+}
+
+func SimulateMsgFundTopicSponsorship(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.FundTopicSponsorshipRequest{
+			Sender:                  "",
+			TopicId:                 0,
+			Amount:                  cosmosMath.ZeroInt(),
+			MaxFeesPerActorPerEpoch: cosmosMath.ZeroInt(),
+			MaxFeesPerEpoch:         cosmosMath.ZeroInt(),
+		}
+		topic, found, err := randomTopic(r, ctx, k)
+		if err != nil || !found {
+			return noOp(msg, "no topic")
+		}
+		sponsor, _ := simtypes.RandomAcc(r, accs)
+		_, alreadySponsoring, err := k.GetTopicSponsorship(ctx, topic.Id, sponsor.Address.String())
+		if err != nil {
+			return noOp(msg, "failed to get topic sponsorship")
+		}
+		sponsorships, err := k.GetTopicSponsorships(ctx, topic.Id)
+		if err != nil || (!alreadySponsoring && len(sponsorships) >= keeper.MAX_SPONSORSHIPS_PER_TOPIC) {
+			return noOp(msg, "too many sponsorships of the topic")
+		}
+		balance := spendable(ctx, bk, sponsor)
+		if !balance.IsPositive() {
+			return noOp(msg, "sponsor has no balance")
+		}
+		msg.Sender = sponsor.Address.String()
+		msg.TopicId = topic.Id
+		msg.Amount = randomAmount(r, balance, 100)
+		// Caps of zero mean no cap
+		if r.Intn(2) == 0 {
+			msg.MaxFeesPerActorPerEpoch = genFee(r, 1000)
+		}
+		if r.Intn(2) == 0 {
+			msg.MaxFeesPerEpoch = genFee(r, 10000)
+		}
+		return deliver(r, app, ctx, txGen, ak, bk, msg, sponsor, coins(msg.Amount))
+	}
+}
+
+func SimulateMsgWithdrawTopicSponsorship(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.WithdrawTopicSponsorshipRequest{Sender: "", TopicId: 0, Amount: cosmosMath.ZeroInt()}
+		topic, found, err := randomTopic(r, ctx, k)
+		if err != nil || !found {
+			return noOp(msg, "no topic")
+		}
+		sponsorships, err := k.GetTopicSponsorships(ctx, topic.Id)
+		if err != nil || len(sponsorships) == 0 {
+			return noOp(msg, "no sponsorship of the topic")
+		}
+		sponsorship := sponsorships[r.Intn(len(sponsorships))]
+		sponsor, found := findAccount(accs, sponsorship.Sponsor)
+		if !found || !sponsorship.Balance.IsPositive() {
+			return noOp(msg, "sponsorship cannot be withdrawn")
+		}
+		amount, err := simtypes.RandPositiveInt(r, sponsorship.Balance)
+		if err != nil {
+			return noOp(msg, "failed to draw amount")
+		}
+		msg.Sender = sponsor.Address.String()
+		msg.TopicId = topic.Id
+		msg.Amount = amount
+		return deliver(r, app, ctx, txGen, ak, bk, msg, sponsor, nil)
+	}
+}
+
+func SimulateMsgCreateGmpSubscription(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.CreateGmpSubscriptionRequest{
+			Sender:             "",
+			TopicId:            0,
+			DestinationChain:   "",
+			DestinationAddress: "",
+			FeePerMessage:      cosmosMath.ZeroInt(),
+			Prepayment:         cosmosMath.ZeroInt(),
+		}
+		topic, found, err := randomTopic(r, ctx, k)
+		if err != nil || !found {
+			return noOp(msg, "no topic")
+		}
+		subscriptions, err := k.GetTopicGmpSubscriptions(ctx, topic.Id)
+		if err != nil || len(subscriptions) >= keeper.MAX_GMP_SUBSCRIPTIONS_PER_TOPIC {
+			return noOp(msg, "too many gmp subscriptions to the topic")
+		}
+		subscriber, _ := simtypes.RandomAcc(r, accs)
+		balance := spendable(ctx, bk, subscriber)
+		if !balance.IsPositive() {
+			return noOp(msg, "subscriber has no balance")
+		}
+		destination := simGmpAllowedSources[r.Intn(len(simGmpAllowedSources))]
+		msg.Sender = subscriber.Address.String()
+		msg.TopicId = topic.Id
+		msg.DestinationChain = destination.SourceChain
+		msg.DestinationAddress = destination.SourceAddress
+		msg.FeePerMessage = cosmosMath.NewInt(int64(simtypes.RandIntBetween(r, 1, 1000)))
+		if r.Intn(4) != 0 {
+			msg.Prepayment = randomAmount(r, balance, 100)
+		}
+		return deliver(r, app, ctx, txGen, ak, bk, msg, subscriber, coins(msg.Prepayment))
+	}
+}
+
+// Returns a random gmp subscription to a topic whose subscriber is a simulation account
+func randomGmpSubscription(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (types.GmpSubscription, simtypes.Account, bool) {
+	topic, found, err := randomTopic(r, ctx, k)
+	if err != nil || !found {
+		return types.GmpSubscription{}, simtypes.Account{}, false
+	}
+	subscriptions, err := k.GetTopicGmpSubscriptions(ctx, topic.Id)
+	if err != nil || len(subscriptions) == 0 {
+		return types.GmpSubscription{}, simtypes.Account{}, false
+	}
+	subscription := subscriptions[r.Intn(len(subscriptions))]
+	subscriber, found := findAccount(accs, subscription.Subscriber)
+	return subscription, subscriber, found
+}
+
+func SimulateMsgFundGmpSubscription(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.FundGmpSubscriptionRequest{Sender: "", SubscriptionId: 0, Amount: cosmosMath.ZeroInt()}
+		subscription, subscriber, found := randomGmpSubscription(r, ctx, k, accs)
+		if !found {
+			return noOp(msg, "no gmp subscription")
+		}
+		balance := spendable(ctx, bk, subscriber)
+		if !balance.IsPositive() {
+			return noOp(msg, "subscriber has no balance")
+		}
+		msg.Sender = subscriber.Address.String()
+		msg.SubscriptionId = subscription.Id
+		msg.Amount = randomAmount(r, balance, 100)
+		return deliver(r, app, ctx, txGen, ak, bk, msg, subscriber, coins(msg.Amount))
+	}
+}
+
+func SimulateMsgCancelGmpSubscription(txGen client.TxConfig, ak keeper.AccountKeeper, bk keeper.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.CancelGmpSubscriptionRequest{Sender: "", SubscriptionId: 0}
+		subscription, subscriber, found := randomGmpSubscription(r, ctx, k, accs)
+		if !found {
+			return noOp(msg, "no gmp subscription")
+		}
+		msg.Sender = subscriber.Address.String()
+		msg.SubscriptionId = subscription.Id
+		return deliver(r, app, ctx, txGen, ak, bk, msg, subscriber, nil)
+	}
+}
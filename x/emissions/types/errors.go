@@ -83,4 +83,36 @@ var (
 	ErrCantUpdateEmaMoreThanOncePerWindow    = errors.Register(ModuleName, 78, "cannot update EMA more than once per window")
 	ErrNoInferencesToInsert                  = errors.Register(ModuleName, 79, "no inferences to insert")
 	ErrNoQualifiedInferers                   = errors.Register(ModuleName, 80, "no qualified inferers found for this topic")
+	ErrNotPermittedToUpdateTopic             = errors.Register(ModuleName, 81, "only the topic creator or a whitelist admin can update a topic")
+	ErrNotPermittedToRetireTopic             = errors.Register(ModuleName, 82, "only the topic creator or a whitelist admin can retire a topic")
+	ErrGmpSubscriptionNotFound               = errors.Register(ModuleName, 83, "gmp subscription not found")
+	ErrNotPermittedToManageGmpSubscription   = errors.Register(ModuleName, 84, "only the subscriber or a whitelist admin can manage a gmp subscription")
+	ErrTooManyGmpSubscriptionsForTopic       = errors.Register(ModuleName, 85, "topic has reached the maximum number of gmp subscriptions")
+	ErrNotPermittedToManageTopicAllowlist    = errors.Register(ModuleName, 86, "only the topic creator or a whitelist admin can manage a topic allowlist")
+	ErrAddressNotInTopicAllowlist            = errors.Register(ModuleName, 87, "address is not in the topic allowlist")
+	ErrReputerJailed                         = errors.Register(ModuleName, 88, "reputer is jailed in this topic")
+	ErrReputerNotJailed                      = errors.Register(ModuleName, 89, "reputer is not jailed in this topic")
+	ErrReputerJailPeriodNotElapsed           = errors.Register(ModuleName, 90, "reputer jail period has not elapsed")
+	ErrTopicRequiresWorkerCommitment         = errors.Register(ModuleName, 91, "topic requires workers to commit to their payload before revealing it")
+	ErrTopicCommitRevealDisabled             = errors.Register(ModuleName, 92, "topic does not use commit-reveal for worker payloads")
+	ErrWorkerCommitmentNotFound              = errors.Register(ModuleName, 93, "worker commitment not found")
+	ErrWorkerCommitmentMismatch              = errors.Register(ModuleName, 94, "revealed worker payload does not match its commitment")
+	ErrWorkerPayloadAlreadyRevealed          = errors.Register(ModuleName, 95, "worker payload already revealed")
+	ErrWorkerRevealWindowNotAvailable        = errors.Register(ModuleName, 96, "worker reveal window not available")
+	ErrUnknownLossMethod                     = errors.Register(ModuleName, 97, "unknown loss method")
+	ErrNotPermittedToSubmitGroundTruth       = errors.Register(ModuleName, 98, "not permitted to submit ground truth")
+	ErrGroundTruthAlreadySubmitted           = errors.Register(ModuleName, 99, "ground truth already submitted")
+	ErrInvalidInferenceDimension             = errors.Register(ModuleName, 100, "inference dimension does not match topic value dimension")
+	ErrTooManyBatchedPayloads                = errors.Register(ModuleName, 101, "too many payloads in batch")
+	ErrTopicSponsorshipNotFound              = errors.Register(ModuleName, 102, "topic sponsorship not found")
+	ErrInsufficientSponsorshipBalance        = errors.Register(ModuleName, 103, "insufficient topic sponsorship balance")
+	ErrTooManyTopicSponsorships              = errors.Register(ModuleName, 104, "topic has reached the maximum number of sponsorships")
+	ErrTooManyAutoCompoundingDelegations     = errors.Register(ModuleName, 105, "reputer has reached the maximum number of auto-compounding delegations")
+	ErrNoDelegateStakeToAutoCompound         = errors.Register(ModuleName, 106, "no delegate stake placed upon reputer to auto-compound")
+	ErrTooManyRedelegations                  = errors.Register(ModuleName, 107, "delegator has reached the maximum number of redelegations in progress")
+	ErrInsufficientDelegateStakeToRedelegate = errors.Register(ModuleName, 108, "insufficient delegate stake to redelegate")
+	ErrTransitiveRedelegation                = errors.Register(ModuleName, 109, "cannot redelegate stake whose redelegation into the source is still in progress")
+	ErrNoRewardsToClaim                      = errors.Register(ModuleName, 110, "no accrued rewards to claim")
+	ErrInvalidGenesisState                   = errors.Register(ModuleName, 111, "invalid genesis state")
+	ErrTopicSponsorshipBelowDataSendingFee   = errors.Register(ModuleName, 112, "topic sponsorship funding is below the data sending fee of the topic")
 )
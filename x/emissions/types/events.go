@@ -1,6 +1,7 @@
 package types
 
 import (
+	cosmosMath "cosmossdk.io/math"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/metrics"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -126,6 +127,177 @@ func EmitNewActorEMAScoresSetEvent(ctx sdk.Context, actorType ActorType, scores
 	}
 }
 
+func EmitNewTopicUpdatedEvent(ctx sdk.Context, blockHeight BlockHeight, topic Topic) {
+	metrics.IncrProducerEventCount(metrics.TOPIC_UPDATED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewTopicUpdatedEventBase(blockHeight, topic))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewTopicUpdatedEvent: ", err.Error())
+	}
+}
+
+func EmitNewScheduledTopicUpdateDroppedEvent(ctx sdk.Context, blockHeight BlockHeight, update ScheduledTopicUpdate, reason string) {
+	metrics.IncrProducerEventCount(metrics.TOPIC_UPDATE_DROPPED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewScheduledTopicUpdateDroppedEventBase(blockHeight, update, reason))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewScheduledTopicUpdateDroppedEvent: ", err.Error())
+	}
+}
+
+func EmitNewTopicRetirementProgressEvent(ctx sdk.Context, blockHeight BlockHeight, retirement TopicRetirement) {
+	metrics.IncrProducerEventCount(metrics.TOPIC_RETIREMENT_PROGRESS_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewTopicRetirementProgressEventBase(blockHeight, retirement))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewTopicRetirementProgressEvent: ", err.Error())
+	}
+}
+
+func EmitNewGmpDeliveryEvent(ctx sdk.Context, delivery GmpDelivery, status string) {
+	metrics.IncrProducerEventCount(metrics.GMP_DELIVERY_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewGmpDeliveryEventBase(delivery, status))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewGmpDeliveryEvent: ", err.Error())
+	}
+}
+
+func EmitNewReputerSlashedEvent(
+	ctx sdk.Context,
+	topicId TopicId,
+	reputer string,
+	reason ReputerSlashReason,
+	reputerStakeSlashed, delegateStakeSlashed, burned cosmosMath.Int,
+) {
+	metrics.IncrProducerEventCount(metrics.REPUTER_SLASHED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewReputerSlashedEventBase(ctx.BlockHeight(), topicId, reputer, reason, reputerStakeSlashed, delegateStakeSlashed, burned))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewReputerSlashedEvent: ", err.Error())
+	}
+}
+
+func EmitNewReputerJailStatusChangedEvent(ctx sdk.Context, topicId TopicId, reputer string, info ReputerSlashingInfo) {
+	metrics.IncrProducerEventCount(metrics.REPUTER_JAIL_STATUS_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewReputerJailStatusChangedEventBase(ctx.BlockHeight(), topicId, reputer, info))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewReputerJailStatusChangedEvent: ", err.Error())
+	}
+}
+
+func EmitNewReputerLossesRejectedEvent(
+	ctx sdk.Context,
+	topicId TopicId,
+	nonceBlockHeight BlockHeight,
+	reputer string,
+	lossType ReputerLossType,
+	worker string,
+	reportedLoss, recomputedLoss alloraMath.Dec,
+) {
+	metrics.IncrProducerEventCount(metrics.REPUTER_LOSSES_REJECTED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewReputerLossesRejectedEventBase(ctx.BlockHeight(), topicId, nonceBlockHeight, reputer, lossType, worker, reportedLoss, recomputedLoss))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewReputerLossesRejectedEvent: ", err.Error())
+	}
+}
+
+// The delegator is empty for the stake a reputer placed on itself
+func EmitNewStakeAddedEvent(ctx sdk.Context, topicId TopicId, reputer, delegator string, amount cosmosMath.Int) {
+	metrics.IncrProducerEventCount(metrics.STAKE_ADDED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewStakeAddedEventBase(ctx.BlockHeight(), topicId, reputer, delegator, amount))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewStakeAddedEvent: ", err.Error())
+	}
+}
+
+// The delegator is empty for the stake a reputer placed on itself
+func EmitNewStakeRemovedEvent(ctx sdk.Context, topicId TopicId, reputer, delegator string, amount cosmosMath.Int) {
+	metrics.IncrProducerEventCount(metrics.STAKE_REMOVED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewStakeRemovedEventBase(ctx.BlockHeight(), topicId, reputer, delegator, amount))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewStakeRemovedEvent: ", err.Error())
+	}
+}
+
+func EmitNewStakeRemovalScheduledEvent(ctx sdk.Context, removal StakeRemovalInfo) {
+	metrics.IncrProducerEventCount(metrics.STAKE_REMOVAL_SCHEDULED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewStakeRemovalScheduledEventBase(
+		ctx.BlockHeight(), removal.TopicId, removal.Reputer, "", removal.Amount, removal.BlockRemovalStarted, removal.BlockRemovalCompleted))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewStakeRemovalScheduledEvent: ", err.Error())
+	}
+}
+
+func EmitNewDelegateStakeRemovalScheduledEvent(ctx sdk.Context, removal DelegateStakeRemovalInfo) {
+	metrics.IncrProducerEventCount(metrics.STAKE_REMOVAL_SCHEDULED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewStakeRemovalScheduledEventBase(
+		ctx.BlockHeight(), removal.TopicId, removal.Reputer, removal.Delegator, removal.Amount, removal.BlockRemovalStarted, removal.BlockRemovalCompleted))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewDelegateStakeRemovalScheduledEvent: ", err.Error())
+	}
+}
+
+// The delegator is empty for the stake a reputer placed on itself
+func EmitNewStakeRemovalUnscheduledEvent(ctx sdk.Context, topicId TopicId, reputer, delegator string, blockRemovalCompleted BlockHeight) {
+	metrics.IncrProducerEventCount(metrics.STAKE_REMOVAL_UNSCHEDULED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewStakeRemovalUnscheduledEventBase(ctx.BlockHeight(), topicId, reputer, delegator, blockRemovalCompleted))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewStakeRemovalUnscheduledEvent: ", err.Error())
+	}
+}
+
+func EmitNewActorRegisteredEvent(ctx sdk.Context, topicId TopicId, actor string, isReputer bool, owner string) {
+	metrics.IncrProducerEventCount(metrics.ACTOR_REGISTERED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewActorRegisteredEventBase(ctx.BlockHeight(), topicId, actor, isReputer, owner))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewActorRegisteredEvent: ", err.Error())
+	}
+}
+
+func EmitNewActorUnregisteredEvent(ctx sdk.Context, topicId TopicId, actor string, isReputer bool) {
+	metrics.IncrProducerEventCount(metrics.ACTOR_UNREGISTERED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewActorUnregisteredEventBase(ctx.BlockHeight(), topicId, actor, isReputer))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewActorUnregisteredEvent: ", err.Error())
+	}
+}
+
+func EmitNewTopicCreatedEvent(ctx sdk.Context, topic Topic) {
+	metrics.IncrProducerEventCount(metrics.TOPIC_CREATED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewTopicCreatedEventBase(ctx.BlockHeight(), topic))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewTopicCreatedEvent: ", err.Error())
+	}
+}
+
+func EmitNewTopicActivationChangedEvent(ctx sdk.Context, topicId TopicId, active bool) {
+	metrics.IncrProducerEventCount(metrics.TOPIC_ACTIVATION_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewTopicActivationChangedEventBase(ctx.BlockHeight(), topicId, active))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewTopicActivationChangedEvent: ", err.Error())
+	}
+}
+
+func EmitNewNonceOpenedEvent(ctx sdk.Context, topicId TopicId, nonceType NonceType, nonceBlockHeight BlockHeight) {
+	metrics.IncrProducerEventCount(metrics.NONCE_OPENED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewNonceOpenedEventBase(ctx.BlockHeight(), topicId, nonceType, nonceBlockHeight))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewNonceOpenedEvent: ", err.Error())
+	}
+}
+
+func EmitNewNonceFulfilledEvent(ctx sdk.Context, topicId TopicId, nonceType NonceType, nonceBlockHeight BlockHeight) {
+	metrics.IncrProducerEventCount(metrics.NONCE_FULFILLED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewNonceFulfilledEventBase(ctx.BlockHeight(), topicId, nonceType, nonceBlockHeight))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewNonceFulfilledEvent: ", err.Error())
+	}
+}
+
+func EmitNewNoncePrunedEvent(ctx sdk.Context, topicId TopicId, nonceType NonceType, nonceBlockHeight BlockHeight) {
+	metrics.IncrProducerEventCount(metrics.NONCE_PRUNED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewNoncePrunedEventBase(ctx.BlockHeight(), topicId, nonceType, nonceBlockHeight))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewNoncePrunedEvent: ", err.Error())
+	}
+}
+
 /// Utils
 
 // Assumes length of `scores` is at least 1
@@ -229,3 +401,204 @@ func NewEMAScoresSetEventBase(actorType ActorType, scores []Score, activations m
 		IsActive:  activeArr,
 	}
 }
+
+func NewTopicUpdatedEventBase(blockHeight BlockHeight, topic Topic) proto.Message {
+	return &EventTopicUpdated{
+		TopicId:     topic.Id,
+		BlockHeight: blockHeight,
+		Topic:       &topic,
+	}
+}
+
+func NewScheduledTopicUpdateDroppedEventBase(blockHeight BlockHeight, update ScheduledTopicUpdate, reason string) proto.Message {
+	return &EventScheduledTopicUpdateDropped{
+		TopicId:     update.TopicId,
+		BlockHeight: blockHeight,
+		Update:      &update,
+		Reason:      reason,
+	}
+}
+
+func NewTopicRetirementProgressEventBase(blockHeight BlockHeight, retirement TopicRetirement) proto.Message {
+	return &EventTopicRetirementProgress{
+		TopicId:     retirement.Topic.Id,
+		BlockHeight: blockHeight,
+		Stage:       retirement.Stage,
+		KeysDeleted: retirement.KeysDeleted,
+	}
+}
+
+func NewGmpDeliveryEventBase(delivery GmpDelivery, status string) proto.Message {
+	return &EventGmpDelivery{
+		SubscriptionId: delivery.SubscriptionId,
+		TopicId:        delivery.TopicId,
+		BlockHeight:    delivery.BlockHeight,
+		ChannelId:      delivery.ChannelId,
+		Sequence:       delivery.Sequence,
+		Status:         status,
+	}
+}
+
+func NewReputerSlashedEventBase(
+	blockHeight BlockHeight,
+	topicId TopicId,
+	reputer string,
+	reason ReputerSlashReason,
+	reputerStakeSlashed, delegateStakeSlashed, burned cosmosMath.Int,
+) proto.Message {
+	return &EventReputerSlashed{
+		TopicId:              topicId,
+		BlockHeight:          blockHeight,
+		Reputer:              reputer,
+		Reason:               reason,
+		ReputerStakeSlashed:  reputerStakeSlashed,
+		DelegateStakeSlashed: delegateStakeSlashed,
+		Burned:               burned,
+	}
+}
+
+func NewReputerJailStatusChangedEventBase(blockHeight BlockHeight, topicId TopicId, reputer string, info ReputerSlashingInfo) proto.Message {
+	return &EventReputerJailStatusChanged{
+		TopicId:          topicId,
+		BlockHeight:      blockHeight,
+		Reputer:          reputer,
+		Jailed:           info.Jailed,
+		JailedUntilBlock: info.JailedUntilBlock,
+	}
+}
+
+func NewReputerLossesRejectedEventBase(
+	blockHeight BlockHeight,
+	topicId TopicId,
+	nonceBlockHeight BlockHeight,
+	reputer string,
+	lossType ReputerLossType,
+	worker string,
+	reportedLoss, recomputedLoss alloraMath.Dec,
+) proto.Message {
+	return &EventReputerLossesRejected{
+		TopicId:          topicId,
+		BlockHeight:      blockHeight,
+		NonceBlockHeight: nonceBlockHeight,
+		Reputer:          reputer,
+		Worker:           worker,
+		ReportedLoss:     reportedLoss,
+		RecomputedLoss:   recomputedLoss,
+		LossType:         lossType,
+	}
+}
+
+func NewStakeAddedEventBase(blockHeight BlockHeight, topicId TopicId, reputer, delegator string, amount cosmosMath.Int) proto.Message {
+	return &EventStakeAdded{
+		TopicId:     topicId,
+		BlockHeight: blockHeight,
+		Reputer:     reputer,
+		Delegator:   delegator,
+		Amount:      amount,
+	}
+}
+
+func NewStakeRemovedEventBase(blockHeight BlockHeight, topicId TopicId, reputer, delegator string, amount cosmosMath.Int) proto.Message {
+	return &EventStakeRemoved{
+		TopicId:     topicId,
+		BlockHeight: blockHeight,
+		Reputer:     reputer,
+		Delegator:   delegator,
+		Amount:      amount,
+	}
+}
+
+func NewStakeRemovalScheduledEventBase(
+	blockHeight BlockHeight,
+	topicId TopicId,
+	reputer, delegator string,
+	amount cosmosMath.Int,
+	blockRemovalStarted, blockRemovalCompleted BlockHeight,
+) proto.Message {
+	return &EventStakeRemovalScheduled{
+		TopicId:               topicId,
+		BlockHeight:           blockHeight,
+		Reputer:               reputer,
+		Delegator:             delegator,
+		Amount:                amount,
+		BlockRemovalStarted:   blockRemovalStarted,
+		BlockRemovalCompleted: blockRemovalCompleted,
+	}
+}
+
+func NewStakeRemovalUnscheduledEventBase(
+	blockHeight BlockHeight,
+	topicId TopicId,
+	reputer, delegator string,
+	blockRemovalCompleted BlockHeight,
+) proto.Message {
+	return &EventStakeRemovalUnscheduled{
+		TopicId:               topicId,
+		BlockHeight:           blockHeight,
+		Reputer:               reputer,
+		Delegator:             delegator,
+		BlockRemovalCompleted: blockRemovalCompleted,
+	}
+}
+
+func NewActorRegisteredEventBase(blockHeight BlockHeight, topicId TopicId, actor string, isReputer bool, owner string) proto.Message {
+	return &EventActorRegistered{
+		TopicId:     topicId,
+		BlockHeight: blockHeight,
+		Actor:       actor,
+		IsReputer:   isReputer,
+		Owner:       owner,
+	}
+}
+
+func NewActorUnregisteredEventBase(blockHeight BlockHeight, topicId TopicId, actor string, isReputer bool) proto.Message {
+	return &EventActorUnregistered{
+		TopicId:     topicId,
+		BlockHeight: blockHeight,
+		Actor:       actor,
+		IsReputer:   isReputer,
+	}
+}
+
+func NewTopicCreatedEventBase(blockHeight BlockHeight, topic Topic) proto.Message {
+	return &EventTopicCreated{
+		TopicId:     topic.Id,
+		BlockHeight: blockHeight,
+		Topic:       &topic,
+	}
+}
+
+func NewTopicActivationChangedEventBase(blockHeight BlockHeight, topicId TopicId, active bool) proto.Message {
+	return &EventTopicActivationChanged{
+		TopicId:     topicId,
+		BlockHeight: blockHeight,
+		Active:      active,
+	}
+}
+
+func NewNonceOpenedEventBase(blockHeight BlockHeight, topicId TopicId, nonceType NonceType, nonceBlockHeight BlockHeight) proto.Message {
+	return &EventNonceOpened{
+		TopicId:          topicId,
+		BlockHeight:      blockHeight,
+		NonceType:        nonceType,
+		NonceBlockHeight: nonceBlockHeight,
+	}
+}
+
+func NewNonceFulfilledEventBase(blockHeight BlockHeight, topicId TopicId, nonceType NonceType, nonceBlockHeight BlockHeight) proto.Message {
+	return &EventNonceFulfilled{
+		TopicId:          topicId,
+		BlockHeight:      blockHeight,
+		NonceType:        nonceType,
+		NonceBlockHeight: nonceBlockHeight,
+	}
+}
+
+func NewNoncePrunedEventBase(blockHeight BlockHeight, topicId TopicId, nonceType NonceType, nonceBlockHeight BlockHeight) proto.Message {
+	return &EventNoncePruned{
+		TopicId:          topicId,
+		BlockHeight:      blockHeight,
+		NonceType:        nonceType,
+		NonceBlockHeight: nonceBlockHeight,
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"testing"
 
+	cosmosMath "cosmossdk.io/math"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 
@@ -394,6 +395,17 @@ func TestEmitNewNetworkLossSetEvent(t *testing.T) {
 		OneInForecasterValues:         []*types.WorkerAttributedValue{{Worker: "TestForecaster5", Value: alloraMath.MustNewDecFromString("0.0112")}, {Worker: "TestForecaster6", Value: alloraMath.MustNewDecFromString("0.0112")}},
 		OneOutInfererForecasterValues: nil,
 	}
+	// Proto JSON renders the empty per-dimension values of scalar losses as empty arrays
+	for _, values := range [][]*types.WorkerAttributedValue{loss.InfererValues, loss.ForecasterValues, loss.OneInForecasterValues} {
+		for _, value := range values {
+			value.Values = []alloraMath.Dec{}
+		}
+	}
+	for _, values := range [][]*types.WithheldWorkerAttributedValue{loss.OneOutInfererValues, loss.OneOutForecasterValues} {
+		for _, value := range values {
+			value.Values = []alloraMath.Dec{}
+		}
+	}
 
 	types.EmitNewNetworkLossSetEvent(ctx, topicId, blockHeight, loss)
 
@@ -560,3 +572,58 @@ func TestEmitNewEMAScoresSetEventWithScores(t *testing.T) {
 	require.Contains(t, events[2].Attributes[2].Key, "is_active")
 	require.Contains(t, events[2].Attributes[2].Value, "[true,true]")
 }
+
+func TestEmitNewStakeAddedEvent(t *testing.T) {
+	ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager()).WithBlockHeight(10)
+
+	types.EmitNewStakeAddedEvent(ctx, 1, "reputer", "", cosmosMath.NewInt(1000))
+	types.EmitNewStakeAddedEvent(ctx, 1, "reputer", "delegator", cosmosMath.NewInt(300))
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	require.Equal(t, "emissions.v4.EventStakeAdded", events[0].Type)
+
+	val, exists := events[0].GetAttribute(AttributeKeyBlockHeight)
+	require.True(t, exists)
+	require.Equal(t, `"10"`, val.GetValue())
+	val, exists = events[0].GetAttribute("delegator")
+	require.True(t, exists)
+	require.Equal(t, `""`, val.GetValue())
+	val, exists = events[0].GetAttribute("amount")
+	require.True(t, exists)
+	require.Equal(t, `"1000"`, val.GetValue())
+
+	val, exists = events[1].GetAttribute("delegator")
+	require.True(t, exists)
+	require.Equal(t, `"delegator"`, val.GetValue())
+	val, exists = events[1].GetAttribute("amount")
+	require.True(t, exists)
+	require.Equal(t, `"300"`, val.GetValue())
+}
+
+func TestEmitNewNonceEvents(t *testing.T) {
+	ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager()).WithBlockHeight(30)
+
+	types.EmitNewNonceOpenedEvent(ctx, 1, types.NonceType_NONCE_TYPE_WORKER, 20)
+	types.EmitNewNonceFulfilledEvent(ctx, 1, types.NonceType_NONCE_TYPE_REPUTER, 10)
+	types.EmitNewNoncePrunedEvent(ctx, 2, types.NonceType_NONCE_TYPE_REPUTER, 5)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 3)
+	require.Equal(t, "emissions.v4.EventNonceOpened", events[0].Type)
+	require.Equal(t, "emissions.v4.EventNonceFulfilled", events[1].Type)
+	require.Equal(t, "emissions.v4.EventNoncePruned", events[2].Type)
+
+	val, exists := events[0].GetAttribute("nonce_type")
+	require.True(t, exists)
+	require.Equal(t, `"NONCE_TYPE_WORKER"`, val.GetValue())
+	val, exists = events[1].GetAttribute("nonce_type")
+	require.True(t, exists)
+	require.Equal(t, `"NONCE_TYPE_REPUTER"`, val.GetValue())
+	val, exists = events[2].GetAttribute("nonce_block_height")
+	require.True(t, exists)
+	require.Equal(t, `"5"`, val.GetValue())
+	val, exists = events[2].GetAttribute(AttributeKeyTopicId)
+	require.True(t, exists)
+	require.Equal(t, `"2"`, val.GetValue())
+}
@@ -192,6 +192,43 @@ type GenesisState struct {
 	TotalSumPreviousTopicWeights github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,75,opt,name=total_sum_previous_topic_weights,json=totalSumPreviousTopicWeights,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"total_sum_previous_topic_weights"`
 	// reward emission on current block
 	RewardCurrentBlockEmission cosmossdk_io_math.Int `protobuf:"bytes,76,opt,name=reward_current_block_emission,json=rewardCurrentBlockEmission,proto3,customtype=cosmossdk.io/math.Int" json:"reward_current_block_emission"`
+	// topic updates waiting for the topic's next epoch boundary
+	ScheduledTopicUpdates []*ScheduledTopicUpdate `protobuf:"bytes,77,rep,name=scheduled_topic_updates,json=scheduledTopicUpdates,proto3" json:"scheduled_topic_updates,omitempty"`
+	// retired topics and their garbage collection progress
+	TopicRetirements []*TopicRetirement `protobuf:"bytes,78,rep,name=topic_retirements,json=topicRetirements,proto3" json:"topic_retirements,omitempty"`
+	// source chains and addresses allowed to send GMP messages
+	GmpAllowedSources []*GmpAllowedSource `protobuf:"bytes,79,rep,name=gmp_allowed_sources,json=gmpAllowedSources,proto3" json:"gmp_allowed_sources,omitempty"`
+	// outbound GMP subscriptions and the messages awaiting acknowledgement
+	NextGmpSubscriptionId uint64             `protobuf:"varint,80,opt,name=next_gmp_subscription_id,json=nextGmpSubscriptionId,proto3" json:"next_gmp_subscription_id,omitempty"`
+	GmpSubscriptions      []*GmpSubscription `protobuf:"bytes,81,rep,name=gmp_subscriptions,json=gmpSubscriptions,proto3" json:"gmp_subscriptions,omitempty"`
+	GmpDeliveries         []*GmpDelivery     `protobuf:"bytes,82,rep,name=gmp_deliveries,json=gmpDeliveries,proto3" json:"gmp_deliveries,omitempty"`
+	// per-topic allowlists restricting which addresses may register and submit payloads
+	TopicWorkerAllowlistEnabled  []uint64           `protobuf:"varint,83,rep,packed,name=topic_worker_allowlist_enabled,json=topicWorkerAllowlistEnabled,proto3" json:"topic_worker_allowlist_enabled,omitempty"`
+	TopicReputerAllowlistEnabled []uint64           `protobuf:"varint,84,rep,packed,name=topic_reputer_allowlist_enabled,json=topicReputerAllowlistEnabled,proto3" json:"topic_reputer_allowlist_enabled,omitempty"`
+	TopicWorkerAllowlist         []*TopicAndActorId `protobuf:"bytes,85,rep,name=topic_worker_allowlist,json=topicWorkerAllowlist,proto3" json:"topic_worker_allowlist,omitempty"`
+	TopicReputerAllowlist        []*TopicAndActorId `protobuf:"bytes,86,rep,name=topic_reputer_allowlist,json=topicReputerAllowlist,proto3" json:"topic_reputer_allowlist,omitempty"`
+	// missed nonces, outlier streaks and jail status of reputers
+	ReputerSlashingInfo []*TopicIdActorIdReputerSlashingInfo `protobuf:"bytes,87,rep,name=reputer_slashing_info,json=reputerSlashingInfo,proto3" json:"reputer_slashing_info,omitempty"`
+	// commitments of workers to payloads of open worker nonces
+	WorkerCommitments []*TopicIdBlockHeightActorIdWorkerCommitment `protobuf:"bytes,88,rep,name=worker_commitments,json=workerCommitments,proto3" json:"worker_commitments,omitempty"`
+	// ground truths submitted for open reputer nonces
+	GroundTruths []*TopicIdBlockHeightDec `protobuf:"bytes,89,rep,name=ground_truths,json=groundTruths,proto3" json:"ground_truths,omitempty"`
+	// archived network inferences of topics that archive them
+	NetworkInferenceArchive []*ArchivedNetworkInference `protobuf:"bytes,90,rep,name=network_inference_archive,json=networkInferenceArchive,proto3" json:"network_inference_archive,omitempty"`
+	// sponsorships of topic data sending fees and the fees they covered per actor
+	TopicSponsorships  []*TopicSponsorship                 `protobuf:"bytes,91,rep,name=topic_sponsorships,json=topicSponsorships,proto3" json:"topic_sponsorships,omitempty"`
+	SponsoredActorFees []*TopicIdSponsorActorSponsoredFees `protobuf:"bytes,92,rep,name=sponsored_actor_fees,json=sponsoredActorFees,proto3" json:"sponsored_actor_fees,omitempty"`
+	// delegations whose rewards are compounded into their stake as they are paid out
+	AutoCompoundingDelegations []*TopicIdDelegatorReputer `protobuf:"bytes,93,rep,name=auto_compounding_delegations,json=autoCompoundingDelegations,proto3" json:"auto_compounding_delegations,omitempty"`
+	// redelegations in progress and the next id to assign to a redelegation
+	Redelegations      []*Redelegation `protobuf:"bytes,94,rep,name=redelegations,proto3" json:"redelegations,omitempty"`
+	NextRedelegationId uint64          `protobuf:"varint,95,opt,name=next_redelegation_id,json=nextRedelegationId,proto3" json:"next_redelegation_id,omitempty"`
+	// rewards accrued by each actor in each topic, not claimed yet
+	AccruedRewards []*TopicIdActorIdInt `protobuf:"bytes,96,rep,name=accrued_rewards,json=accruedRewards,proto3" json:"accrued_rewards,omitempty"`
+	// data sending fee of each topic, as adjusted to its demand
+	TopicDataSendingFees []*TopicIdAndInt `protobuf:"bytes,97,rep,name=topic_data_sending_fees,json=topicDataSendingFees,proto3" json:"topic_data_sending_fees,omitempty"`
+	// payloads each topic received since its last worker nonce closed
+	TopicPayloadsReceived []*TopicIdAndUint64 `protobuf:"bytes,98,rep,name=topic_payloads_received,json=topicPayloadsReceived,proto3" json:"topic_payloads_received,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -710,6 +747,160 @@ func (m *GenesisState) GetLossBundles() []*TopicIdReputerReputerValueBundle {
 	return nil
 }
 
+func (m *GenesisState) GetScheduledTopicUpdates() []*ScheduledTopicUpdate {
+	if m != nil {
+		return m.ScheduledTopicUpdates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTopicRetirements() []*TopicRetirement {
+	if m != nil {
+		return m.TopicRetirements
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGmpAllowedSources() []*GmpAllowedSource {
+	if m != nil {
+		return m.GmpAllowedSources
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextGmpSubscriptionId() uint64 {
+	if m != nil {
+		return m.NextGmpSubscriptionId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetGmpSubscriptions() []*GmpSubscription {
+	if m != nil {
+		return m.GmpSubscriptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGmpDeliveries() []*GmpDelivery {
+	if m != nil {
+		return m.GmpDeliveries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTopicWorkerAllowlistEnabled() []uint64 {
+	if m != nil {
+		return m.TopicWorkerAllowlistEnabled
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTopicReputerAllowlistEnabled() []uint64 {
+	if m != nil {
+		return m.TopicReputerAllowlistEnabled
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTopicWorkerAllowlist() []*TopicAndActorId {
+	if m != nil {
+		return m.TopicWorkerAllowlist
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTopicReputerAllowlist() []*TopicAndActorId {
+	if m != nil {
+		return m.TopicReputerAllowlist
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReputerSlashingInfo() []*TopicIdActorIdReputerSlashingInfo {
+	if m != nil {
+		return m.ReputerSlashingInfo
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWorkerCommitments() []*TopicIdBlockHeightActorIdWorkerCommitment {
+	if m != nil {
+		return m.WorkerCommitments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGroundTruths() []*TopicIdBlockHeightDec {
+	if m != nil {
+		return m.GroundTruths
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNetworkInferenceArchive() []*ArchivedNetworkInference {
+	if m != nil {
+		return m.NetworkInferenceArchive
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTopicSponsorships() []*TopicSponsorship {
+	if m != nil {
+		return m.TopicSponsorships
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSponsoredActorFees() []*TopicIdSponsorActorSponsoredFees {
+	if m != nil {
+		return m.SponsoredActorFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoCompoundingDelegations() []*TopicIdDelegatorReputer {
+	if m != nil {
+		return m.AutoCompoundingDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRedelegations() []*Redelegation {
+	if m != nil {
+		return m.Redelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextRedelegationId() uint64 {
+	if m != nil {
+		return m.NextRedelegationId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAccruedRewards() []*TopicIdActorIdInt {
+	if m != nil {
+		return m.AccruedRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTopicDataSendingFees() []*TopicIdAndInt {
+	if m != nil {
+		return m.TopicDataSendingFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTopicPayloadsReceived() []*TopicIdAndUint64 {
+	if m != nil {
+		return m.TopicPayloadsReceived
+	}
+	return nil
+}
+
 type TopicIdAndTopic struct {
 	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 	Topic   *Topic `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
@@ -1158,24 +1349,24 @@ func (m *TopicIdActorIdListeningCoefficient) GetListeningCoefficient() *Listenin
 	return nil
 }
 
-type TopicIdActorIdDec struct {
-	TopicId uint64                                          `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	ActorId string                                          `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
-	Dec     github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,opt,name=dec,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"dec"`
+type TopicIdActorIdReputerSlashingInfo struct {
+	TopicId      uint64               `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	ActorId      string               `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	SlashingInfo *ReputerSlashingInfo `protobuf:"bytes,3,opt,name=slashing_info,json=slashingInfo,proto3" json:"slashing_info,omitempty"`
 }
 
-func (m *TopicIdActorIdDec) Reset()         { *m = TopicIdActorIdDec{} }
-func (m *TopicIdActorIdDec) String() string { return proto.CompactTextString(m) }
-func (*TopicIdActorIdDec) ProtoMessage()    {}
-func (*TopicIdActorIdDec) Descriptor() ([]byte, []int) {
+func (m *TopicIdActorIdReputerSlashingInfo) Reset()         { *m = TopicIdActorIdReputerSlashingInfo{} }
+func (m *TopicIdActorIdReputerSlashingInfo) String() string { return proto.CompactTextString(m) }
+func (*TopicIdActorIdReputerSlashingInfo) ProtoMessage()    {}
+func (*TopicIdActorIdReputerSlashingInfo) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a6c24e1b56b831d9, []int{9}
 }
-func (m *TopicIdActorIdDec) XXX_Unmarshal(b []byte) error {
+func (m *TopicIdActorIdReputerSlashingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TopicIdActorIdDec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicIdActorIdReputerSlashingInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TopicIdActorIdDec.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicIdActorIdReputerSlashingInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1185,49 +1376,62 @@ func (m *TopicIdActorIdDec) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *TopicIdActorIdDec) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicIdActorIdDec.Merge(m, src)
+func (m *TopicIdActorIdReputerSlashingInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdActorIdReputerSlashingInfo.Merge(m, src)
 }
-func (m *TopicIdActorIdDec) XXX_Size() int {
+func (m *TopicIdActorIdReputerSlashingInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *TopicIdActorIdDec) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicIdActorIdDec.DiscardUnknown(m)
+func (m *TopicIdActorIdReputerSlashingInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdActorIdReputerSlashingInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TopicIdActorIdDec proto.InternalMessageInfo
+var xxx_messageInfo_TopicIdActorIdReputerSlashingInfo proto.InternalMessageInfo
 
-func (m *TopicIdActorIdDec) GetTopicId() uint64 {
+func (m *TopicIdActorIdReputerSlashingInfo) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-func (m *TopicIdActorIdDec) GetActorId() string {
+func (m *TopicIdActorIdReputerSlashingInfo) GetActorId() string {
 	if m != nil {
 		return m.ActorId
 	}
 	return ""
 }
 
-type TopicIdAndInt struct {
-	TopicId uint64                `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Int     cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=int,proto3,customtype=cosmossdk.io/math.Int" json:"int"`
+func (m *TopicIdActorIdReputerSlashingInfo) GetSlashingInfo() *ReputerSlashingInfo {
+	if m != nil {
+		return m.SlashingInfo
+	}
+	return nil
 }
 
-func (m *TopicIdAndInt) Reset()         { *m = TopicIdAndInt{} }
-func (m *TopicIdAndInt) String() string { return proto.CompactTextString(m) }
-func (*TopicIdAndInt) ProtoMessage()    {}
-func (*TopicIdAndInt) Descriptor() ([]byte, []int) {
+type TopicIdBlockHeightActorIdWorkerCommitment struct {
+	TopicId     uint64            `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64             `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	ActorId     string            `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Commitment  *WorkerCommitment `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (m *TopicIdBlockHeightActorIdWorkerCommitment) Reset() {
+	*m = TopicIdBlockHeightActorIdWorkerCommitment{}
+}
+func (m *TopicIdBlockHeightActorIdWorkerCommitment) String() string {
+	return proto.CompactTextString(m)
+}
+func (*TopicIdBlockHeightActorIdWorkerCommitment) ProtoMessage() {}
+func (*TopicIdBlockHeightActorIdWorkerCommitment) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a6c24e1b56b831d9, []int{10}
 }
-func (m *TopicIdAndInt) XXX_Unmarshal(b []byte) error {
+func (m *TopicIdBlockHeightActorIdWorkerCommitment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TopicIdAndInt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicIdBlockHeightActorIdWorkerCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TopicIdAndInt.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicIdBlockHeightActorIdWorkerCommitment.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1237,97 +1441,65 @@ func (m *TopicIdAndInt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *TopicIdAndInt) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicIdAndInt.Merge(m, src)
+func (m *TopicIdBlockHeightActorIdWorkerCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdBlockHeightActorIdWorkerCommitment.Merge(m, src)
 }
-func (m *TopicIdAndInt) XXX_Size() int {
+func (m *TopicIdBlockHeightActorIdWorkerCommitment) XXX_Size() int {
 	return m.Size()
 }
-func (m *TopicIdAndInt) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicIdAndInt.DiscardUnknown(m)
+func (m *TopicIdBlockHeightActorIdWorkerCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdBlockHeightActorIdWorkerCommitment.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TopicIdAndInt proto.InternalMessageInfo
+var xxx_messageInfo_TopicIdBlockHeightActorIdWorkerCommitment proto.InternalMessageInfo
 
-func (m *TopicIdAndInt) GetTopicId() uint64 {
+func (m *TopicIdBlockHeightActorIdWorkerCommitment) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-type TopicIdActorIdInt struct {
-	TopicId uint64                `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	ActorId string                `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
-	Int     cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=int,proto3,customtype=cosmossdk.io/math.Int" json:"int"`
-}
-
-func (m *TopicIdActorIdInt) Reset()         { *m = TopicIdActorIdInt{} }
-func (m *TopicIdActorIdInt) String() string { return proto.CompactTextString(m) }
-func (*TopicIdActorIdInt) ProtoMessage()    {}
-func (*TopicIdActorIdInt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{11}
-}
-func (m *TopicIdActorIdInt) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *TopicIdActorIdInt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_TopicIdActorIdInt.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *TopicIdActorIdInt) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicIdActorIdInt.Merge(m, src)
-}
-func (m *TopicIdActorIdInt) XXX_Size() int {
-	return m.Size()
-}
-func (m *TopicIdActorIdInt) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicIdActorIdInt.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_TopicIdActorIdInt proto.InternalMessageInfo
-
-func (m *TopicIdActorIdInt) GetTopicId() uint64 {
+func (m *TopicIdBlockHeightActorIdWorkerCommitment) GetBlockHeight() int64 {
 	if m != nil {
-		return m.TopicId
+		return m.BlockHeight
 	}
 	return 0
 }
 
-func (m *TopicIdActorIdInt) GetActorId() string {
+func (m *TopicIdBlockHeightActorIdWorkerCommitment) GetActorId() string {
 	if m != nil {
 		return m.ActorId
 	}
 	return ""
 }
 
-type TopicIdDelegatorReputerDelegatorInfo struct {
-	TopicId       uint64         `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Delegator     string         `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
-	Reputer       string         `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
-	DelegatorInfo *DelegatorInfo `protobuf:"bytes,4,opt,name=delegator_info,json=delegatorInfo,proto3" json:"delegator_info,omitempty"`
+func (m *TopicIdBlockHeightActorIdWorkerCommitment) GetCommitment() *WorkerCommitment {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
 }
 
-func (m *TopicIdDelegatorReputerDelegatorInfo) Reset()         { *m = TopicIdDelegatorReputerDelegatorInfo{} }
-func (m *TopicIdDelegatorReputerDelegatorInfo) String() string { return proto.CompactTextString(m) }
-func (*TopicIdDelegatorReputerDelegatorInfo) ProtoMessage()    {}
-func (*TopicIdDelegatorReputerDelegatorInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{12}
+type TopicIdSponsorActorSponsoredFees struct {
+	TopicId uint64         `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Sponsor string         `protobuf:"bytes,2,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	Actor   string         `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Fees    *SponsoredFees `protobuf:"bytes,4,opt,name=fees,proto3" json:"fees,omitempty"`
 }
-func (m *TopicIdDelegatorReputerDelegatorInfo) XXX_Unmarshal(b []byte) error {
+
+func (m *TopicIdSponsorActorSponsoredFees) Reset()         { *m = TopicIdSponsorActorSponsoredFees{} }
+func (m *TopicIdSponsorActorSponsoredFees) String() string { return proto.CompactTextString(m) }
+func (*TopicIdSponsorActorSponsoredFees) ProtoMessage()    {}
+func (*TopicIdSponsorActorSponsoredFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{11}
+}
+func (m *TopicIdSponsorActorSponsoredFees) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TopicIdDelegatorReputerDelegatorInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicIdSponsorActorSponsoredFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TopicIdDelegatorReputerDelegatorInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicIdSponsorActorSponsoredFees.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1337,69 +1509,64 @@ func (m *TopicIdDelegatorReputerDelegatorInfo) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *TopicIdDelegatorReputerDelegatorInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicIdDelegatorReputerDelegatorInfo.Merge(m, src)
+func (m *TopicIdSponsorActorSponsoredFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdSponsorActorSponsoredFees.Merge(m, src)
 }
-func (m *TopicIdDelegatorReputerDelegatorInfo) XXX_Size() int {
+func (m *TopicIdSponsorActorSponsoredFees) XXX_Size() int {
 	return m.Size()
 }
-func (m *TopicIdDelegatorReputerDelegatorInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicIdDelegatorReputerDelegatorInfo.DiscardUnknown(m)
+func (m *TopicIdSponsorActorSponsoredFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdSponsorActorSponsoredFees.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TopicIdDelegatorReputerDelegatorInfo proto.InternalMessageInfo
+var xxx_messageInfo_TopicIdSponsorActorSponsoredFees proto.InternalMessageInfo
 
-func (m *TopicIdDelegatorReputerDelegatorInfo) GetTopicId() uint64 {
+func (m *TopicIdSponsorActorSponsoredFees) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-func (m *TopicIdDelegatorReputerDelegatorInfo) GetDelegator() string {
+func (m *TopicIdSponsorActorSponsoredFees) GetSponsor() string {
 	if m != nil {
-		return m.Delegator
+		return m.Sponsor
 	}
 	return ""
 }
 
-func (m *TopicIdDelegatorReputerDelegatorInfo) GetReputer() string {
+func (m *TopicIdSponsorActorSponsoredFees) GetActor() string {
 	if m != nil {
-		return m.Reputer
+		return m.Actor
 	}
 	return ""
 }
 
-func (m *TopicIdDelegatorReputerDelegatorInfo) GetDelegatorInfo() *DelegatorInfo {
+func (m *TopicIdSponsorActorSponsoredFees) GetFees() *SponsoredFees {
 	if m != nil {
-		return m.DelegatorInfo
+		return m.Fees
 	}
 	return nil
 }
 
-type BlockHeightTopicIdReputerStakeRemovalInfo struct {
-	BlockHeight      int64             `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	TopicId          uint64            `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Reputer          string            `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
-	StakeRemovalInfo *StakeRemovalInfo `protobuf:"bytes,4,opt,name=stake_removal_info,json=stakeRemovalInfo,proto3" json:"stake_removal_info,omitempty"`
+type TopicIdBlockHeightDec struct {
+	TopicId     uint64                                          `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64                                           `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Dec         github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,opt,name=dec,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"dec"`
 }
 
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Reset() {
-	*m = BlockHeightTopicIdReputerStakeRemovalInfo{}
-}
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) String() string {
-	return proto.CompactTextString(m)
-}
-func (*BlockHeightTopicIdReputerStakeRemovalInfo) ProtoMessage() {}
-func (*BlockHeightTopicIdReputerStakeRemovalInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{13}
+func (m *TopicIdBlockHeightDec) Reset()         { *m = TopicIdBlockHeightDec{} }
+func (m *TopicIdBlockHeightDec) String() string { return proto.CompactTextString(m) }
+func (*TopicIdBlockHeightDec) ProtoMessage()    {}
+func (*TopicIdBlockHeightDec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{12}
 }
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) XXX_Unmarshal(b []byte) error {
+func (m *TopicIdBlockHeightDec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicIdBlockHeightDec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BlockHeightTopicIdReputerStakeRemovalInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicIdBlockHeightDec.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1409,64 +1576,50 @@ func (m *BlockHeightTopicIdReputerStakeRemovalInfo) XXX_Marshal(b []byte, determ
 		return b[:n], nil
 	}
 }
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockHeightTopicIdReputerStakeRemovalInfo.Merge(m, src)
+func (m *TopicIdBlockHeightDec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdBlockHeightDec.Merge(m, src)
 }
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) XXX_Size() int {
+func (m *TopicIdBlockHeightDec) XXX_Size() int {
 	return m.Size()
 }
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockHeightTopicIdReputerStakeRemovalInfo.DiscardUnknown(m)
+func (m *TopicIdBlockHeightDec) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdBlockHeightDec.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlockHeightTopicIdReputerStakeRemovalInfo proto.InternalMessageInfo
+var xxx_messageInfo_TopicIdBlockHeightDec proto.InternalMessageInfo
 
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) GetBlockHeight() int64 {
+func (m *TopicIdBlockHeightDec) GetTopicId() uint64 {
 	if m != nil {
-		return m.BlockHeight
+		return m.TopicId
 	}
 	return 0
 }
 
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) GetTopicId() uint64 {
+func (m *TopicIdBlockHeightDec) GetBlockHeight() int64 {
 	if m != nil {
-		return m.TopicId
+		return m.BlockHeight
 	}
 	return 0
 }
 
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) GetReputer() string {
-	if m != nil {
-		return m.Reputer
-	}
-	return ""
-}
-
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) GetStakeRemovalInfo() *StakeRemovalInfo {
-	if m != nil {
-		return m.StakeRemovalInfo
-	}
-	return nil
-}
-
-type ActorIdTopicIdBlockHeight struct {
-	ActorId     string `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
-	TopicId     uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	BlockHeight int64  `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+type TopicIdActorIdDec struct {
+	TopicId uint64                                          `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	ActorId string                                          `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Dec     github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,opt,name=dec,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"dec"`
 }
 
-func (m *ActorIdTopicIdBlockHeight) Reset()         { *m = ActorIdTopicIdBlockHeight{} }
-func (m *ActorIdTopicIdBlockHeight) String() string { return proto.CompactTextString(m) }
-func (*ActorIdTopicIdBlockHeight) ProtoMessage()    {}
-func (*ActorIdTopicIdBlockHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{14}
+func (m *TopicIdActorIdDec) Reset()         { *m = TopicIdActorIdDec{} }
+func (m *TopicIdActorIdDec) String() string { return proto.CompactTextString(m) }
+func (*TopicIdActorIdDec) ProtoMessage()    {}
+func (*TopicIdActorIdDec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{13}
 }
-func (m *ActorIdTopicIdBlockHeight) XXX_Unmarshal(b []byte) error {
+func (m *TopicIdActorIdDec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ActorIdTopicIdBlockHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicIdActorIdDec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ActorIdTopicIdBlockHeight.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicIdActorIdDec.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1476,63 +1629,49 @@ func (m *ActorIdTopicIdBlockHeight) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *ActorIdTopicIdBlockHeight) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ActorIdTopicIdBlockHeight.Merge(m, src)
+func (m *TopicIdActorIdDec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdActorIdDec.Merge(m, src)
 }
-func (m *ActorIdTopicIdBlockHeight) XXX_Size() int {
+func (m *TopicIdActorIdDec) XXX_Size() int {
 	return m.Size()
 }
-func (m *ActorIdTopicIdBlockHeight) XXX_DiscardUnknown() {
-	xxx_messageInfo_ActorIdTopicIdBlockHeight.DiscardUnknown(m)
+func (m *TopicIdActorIdDec) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdActorIdDec.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ActorIdTopicIdBlockHeight proto.InternalMessageInfo
-
-func (m *ActorIdTopicIdBlockHeight) GetActorId() string {
-	if m != nil {
-		return m.ActorId
-	}
-	return ""
-}
+var xxx_messageInfo_TopicIdActorIdDec proto.InternalMessageInfo
 
-func (m *ActorIdTopicIdBlockHeight) GetTopicId() uint64 {
+func (m *TopicIdActorIdDec) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-func (m *ActorIdTopicIdBlockHeight) GetBlockHeight() int64 {
+func (m *TopicIdActorIdDec) GetActorId() string {
 	if m != nil {
-		return m.BlockHeight
+		return m.ActorId
 	}
-	return 0
+	return ""
 }
 
-type BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo struct {
-	BlockHeight              int64                     `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	TopicId                  uint64                    `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Delegator                string                    `protobuf:"bytes,3,opt,name=delegator,proto3" json:"delegator,omitempty"`
-	Reputer                  string                    `protobuf:"bytes,4,opt,name=reputer,proto3" json:"reputer,omitempty"`
-	DelegateStakeRemovalInfo *DelegateStakeRemovalInfo `protobuf:"bytes,5,opt,name=delegate_stake_removal_info,json=delegateStakeRemovalInfo,proto3" json:"delegate_stake_removal_info,omitempty"`
+type TopicIdAndUint64 struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Uint64  uint64 `protobuf:"varint,2,opt,name=uint64,proto3" json:"uint64,omitempty"`
 }
 
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Reset() {
-	*m = BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo{}
-}
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) String() string {
-	return proto.CompactTextString(m)
-}
-func (*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) ProtoMessage() {}
-func (*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{15}
+func (m *TopicIdAndUint64) Reset()         { *m = TopicIdAndUint64{} }
+func (m *TopicIdAndUint64) String() string { return proto.CompactTextString(m) }
+func (*TopicIdAndUint64) ProtoMessage()    {}
+func (*TopicIdAndUint64) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{14}
 }
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) XXX_Unmarshal(b []byte) error {
+func (m *TopicIdAndUint64) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicIdAndUint64) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicIdAndUint64.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1542,72 +1681,49 @@ func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) XXX_Marshal
 		return b[:n], nil
 	}
 }
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo.Merge(m, src)
+func (m *TopicIdAndUint64) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdAndUint64.Merge(m, src)
 }
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) XXX_Size() int {
+func (m *TopicIdAndUint64) XXX_Size() int {
 	return m.Size()
 }
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo.DiscardUnknown(m)
+func (m *TopicIdAndUint64) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdAndUint64.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo proto.InternalMessageInfo
-
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) GetBlockHeight() int64 {
-	if m != nil {
-		return m.BlockHeight
-	}
-	return 0
-}
+var xxx_messageInfo_TopicIdAndUint64 proto.InternalMessageInfo
 
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) GetTopicId() uint64 {
+func (m *TopicIdAndUint64) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) GetDelegator() string {
-	if m != nil {
-		return m.Delegator
-	}
-	return ""
-}
-
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) GetReputer() string {
-	if m != nil {
-		return m.Reputer
-	}
-	return ""
-}
-
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) GetDelegateStakeRemovalInfo() *DelegateStakeRemovalInfo {
+func (m *TopicIdAndUint64) GetUint64() uint64 {
 	if m != nil {
-		return m.DelegateStakeRemovalInfo
+		return m.Uint64
 	}
-	return nil
+	return 0
 }
 
-type DelegatorReputerTopicIdBlockHeight struct {
-	Delegator   string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
-	Reputer     string `protobuf:"bytes,2,opt,name=reputer,proto3" json:"reputer,omitempty"`
-	TopicId     uint64 `protobuf:"varint,3,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	BlockHeight int64  `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+type TopicIdAndInt struct {
+	TopicId uint64                `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Int     cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=int,proto3,customtype=cosmossdk.io/math.Int" json:"int"`
 }
 
-func (m *DelegatorReputerTopicIdBlockHeight) Reset()         { *m = DelegatorReputerTopicIdBlockHeight{} }
-func (m *DelegatorReputerTopicIdBlockHeight) String() string { return proto.CompactTextString(m) }
-func (*DelegatorReputerTopicIdBlockHeight) ProtoMessage()    {}
-func (*DelegatorReputerTopicIdBlockHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{16}
+func (m *TopicIdAndInt) Reset()         { *m = TopicIdAndInt{} }
+func (m *TopicIdAndInt) String() string { return proto.CompactTextString(m) }
+func (*TopicIdAndInt) ProtoMessage()    {}
+func (*TopicIdAndInt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{15}
 }
-func (m *DelegatorReputerTopicIdBlockHeight) XXX_Unmarshal(b []byte) error {
+func (m *TopicIdAndInt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegatorReputerTopicIdBlockHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicIdAndInt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegatorReputerTopicIdBlockHeight.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicIdAndInt.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1617,64 +1733,43 @@ func (m *DelegatorReputerTopicIdBlockHeight) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *DelegatorReputerTopicIdBlockHeight) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegatorReputerTopicIdBlockHeight.Merge(m, src)
+func (m *TopicIdAndInt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdAndInt.Merge(m, src)
 }
-func (m *DelegatorReputerTopicIdBlockHeight) XXX_Size() int {
+func (m *TopicIdAndInt) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegatorReputerTopicIdBlockHeight) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegatorReputerTopicIdBlockHeight.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_DelegatorReputerTopicIdBlockHeight proto.InternalMessageInfo
-
-func (m *DelegatorReputerTopicIdBlockHeight) GetDelegator() string {
-	if m != nil {
-		return m.Delegator
-	}
-	return ""
+func (m *TopicIdAndInt) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdAndInt.DiscardUnknown(m)
 }
 
-func (m *DelegatorReputerTopicIdBlockHeight) GetReputer() string {
-	if m != nil {
-		return m.Reputer
-	}
-	return ""
-}
+var xxx_messageInfo_TopicIdAndInt proto.InternalMessageInfo
 
-func (m *DelegatorReputerTopicIdBlockHeight) GetTopicId() uint64 {
+func (m *TopicIdAndInt) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-func (m *DelegatorReputerTopicIdBlockHeight) GetBlockHeight() int64 {
-	if m != nil {
-		return m.BlockHeight
-	}
-	return 0
-}
-
-type TopicIdActorIdInference struct {
-	TopicId   uint64     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	ActorId   string     `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
-	Inference *Inference `protobuf:"bytes,3,opt,name=inference,proto3" json:"inference,omitempty"`
+type TopicIdActorIdInt struct {
+	TopicId uint64                `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	ActorId string                `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Int     cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=int,proto3,customtype=cosmossdk.io/math.Int" json:"int"`
 }
 
-func (m *TopicIdActorIdInference) Reset()         { *m = TopicIdActorIdInference{} }
-func (m *TopicIdActorIdInference) String() string { return proto.CompactTextString(m) }
-func (*TopicIdActorIdInference) ProtoMessage()    {}
-func (*TopicIdActorIdInference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{17}
+func (m *TopicIdActorIdInt) Reset()         { *m = TopicIdActorIdInt{} }
+func (m *TopicIdActorIdInt) String() string { return proto.CompactTextString(m) }
+func (*TopicIdActorIdInt) ProtoMessage()    {}
+func (*TopicIdActorIdInt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{16}
 }
-func (m *TopicIdActorIdInference) XXX_Unmarshal(b []byte) error {
+func (m *TopicIdActorIdInt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TopicIdActorIdInference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicIdActorIdInt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TopicIdActorIdInference.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicIdActorIdInt.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1684,57 +1779,50 @@ func (m *TopicIdActorIdInference) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *TopicIdActorIdInference) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicIdActorIdInference.Merge(m, src)
+func (m *TopicIdActorIdInt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdActorIdInt.Merge(m, src)
 }
-func (m *TopicIdActorIdInference) XXX_Size() int {
+func (m *TopicIdActorIdInt) XXX_Size() int {
 	return m.Size()
 }
-func (m *TopicIdActorIdInference) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicIdActorIdInference.DiscardUnknown(m)
+func (m *TopicIdActorIdInt) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdActorIdInt.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TopicIdActorIdInference proto.InternalMessageInfo
+var xxx_messageInfo_TopicIdActorIdInt proto.InternalMessageInfo
 
-func (m *TopicIdActorIdInference) GetTopicId() uint64 {
+func (m *TopicIdActorIdInt) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-func (m *TopicIdActorIdInference) GetActorId() string {
+func (m *TopicIdActorIdInt) GetActorId() string {
 	if m != nil {
 		return m.ActorId
 	}
 	return ""
 }
 
-func (m *TopicIdActorIdInference) GetInference() *Inference {
-	if m != nil {
-		return m.Inference
-	}
-	return nil
-}
-
-type TopicIdActorIdForecast struct {
-	TopicId  uint64    `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	ActorId  string    `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
-	Forecast *Forecast `protobuf:"bytes,3,opt,name=forecast,proto3" json:"forecast,omitempty"`
+type TopicIdDelegatorReputer struct {
+	TopicId   uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Delegator string `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Reputer   string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
 }
 
-func (m *TopicIdActorIdForecast) Reset()         { *m = TopicIdActorIdForecast{} }
-func (m *TopicIdActorIdForecast) String() string { return proto.CompactTextString(m) }
-func (*TopicIdActorIdForecast) ProtoMessage()    {}
-func (*TopicIdActorIdForecast) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{18}
+func (m *TopicIdDelegatorReputer) Reset()         { *m = TopicIdDelegatorReputer{} }
+func (m *TopicIdDelegatorReputer) String() string { return proto.CompactTextString(m) }
+func (*TopicIdDelegatorReputer) ProtoMessage()    {}
+func (*TopicIdDelegatorReputer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{17}
 }
-func (m *TopicIdActorIdForecast) XXX_Unmarshal(b []byte) error {
+func (m *TopicIdDelegatorReputer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TopicIdActorIdForecast) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicIdDelegatorReputer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TopicIdActorIdForecast.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicIdDelegatorReputer.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1744,56 +1832,58 @@ func (m *TopicIdActorIdForecast) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *TopicIdActorIdForecast) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicIdActorIdForecast.Merge(m, src)
+func (m *TopicIdDelegatorReputer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdDelegatorReputer.Merge(m, src)
 }
-func (m *TopicIdActorIdForecast) XXX_Size() int {
+func (m *TopicIdDelegatorReputer) XXX_Size() int {
 	return m.Size()
 }
-func (m *TopicIdActorIdForecast) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicIdActorIdForecast.DiscardUnknown(m)
+func (m *TopicIdDelegatorReputer) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdDelegatorReputer.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TopicIdActorIdForecast proto.InternalMessageInfo
+var xxx_messageInfo_TopicIdDelegatorReputer proto.InternalMessageInfo
 
-func (m *TopicIdActorIdForecast) GetTopicId() uint64 {
+func (m *TopicIdDelegatorReputer) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-func (m *TopicIdActorIdForecast) GetActorId() string {
+func (m *TopicIdDelegatorReputer) GetDelegator() string {
 	if m != nil {
-		return m.ActorId
+		return m.Delegator
 	}
 	return ""
 }
 
-func (m *TopicIdActorIdForecast) GetForecast() *Forecast {
+func (m *TopicIdDelegatorReputer) GetReputer() string {
 	if m != nil {
-		return m.Forecast
+		return m.Reputer
 	}
-	return nil
+	return ""
 }
 
-type LibP2PKeyAndOffchainNode struct {
-	LibP2PKey    string        `protobuf:"bytes,1,opt,name=lib_p2p_key,json=libP2pKey,proto3" json:"lib_p2p_key,omitempty"`
-	OffchainNode *OffchainNode `protobuf:"bytes,2,opt,name=offchain_node,json=offchainNode,proto3" json:"offchain_node,omitempty"`
+type TopicIdDelegatorReputerDelegatorInfo struct {
+	TopicId       uint64         `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Delegator     string         `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Reputer       string         `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	DelegatorInfo *DelegatorInfo `protobuf:"bytes,4,opt,name=delegator_info,json=delegatorInfo,proto3" json:"delegator_info,omitempty"`
 }
 
-func (m *LibP2PKeyAndOffchainNode) Reset()         { *m = LibP2PKeyAndOffchainNode{} }
-func (m *LibP2PKeyAndOffchainNode) String() string { return proto.CompactTextString(m) }
-func (*LibP2PKeyAndOffchainNode) ProtoMessage()    {}
-func (*LibP2PKeyAndOffchainNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{19}
+func (m *TopicIdDelegatorReputerDelegatorInfo) Reset()         { *m = TopicIdDelegatorReputerDelegatorInfo{} }
+func (m *TopicIdDelegatorReputerDelegatorInfo) String() string { return proto.CompactTextString(m) }
+func (*TopicIdDelegatorReputerDelegatorInfo) ProtoMessage()    {}
+func (*TopicIdDelegatorReputerDelegatorInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{18}
 }
-func (m *LibP2PKeyAndOffchainNode) XXX_Unmarshal(b []byte) error {
+func (m *TopicIdDelegatorReputerDelegatorInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LibP2PKeyAndOffchainNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicIdDelegatorReputerDelegatorInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LibP2PKeyAndOffchainNode.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicIdDelegatorReputerDelegatorInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1803,95 +1893,69 @@ func (m *LibP2PKeyAndOffchainNode) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *LibP2PKeyAndOffchainNode) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LibP2PKeyAndOffchainNode.Merge(m, src)
+func (m *TopicIdDelegatorReputerDelegatorInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdDelegatorReputerDelegatorInfo.Merge(m, src)
 }
-func (m *LibP2PKeyAndOffchainNode) XXX_Size() int {
+func (m *TopicIdDelegatorReputerDelegatorInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *LibP2PKeyAndOffchainNode) XXX_DiscardUnknown() {
-	xxx_messageInfo_LibP2PKeyAndOffchainNode.DiscardUnknown(m)
+func (m *TopicIdDelegatorReputerDelegatorInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdDelegatorReputerDelegatorInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LibP2PKeyAndOffchainNode proto.InternalMessageInfo
+var xxx_messageInfo_TopicIdDelegatorReputerDelegatorInfo proto.InternalMessageInfo
 
-func (m *LibP2PKeyAndOffchainNode) GetLibP2PKey() string {
+func (m *TopicIdDelegatorReputerDelegatorInfo) GetTopicId() uint64 {
 	if m != nil {
-		return m.LibP2PKey
+		return m.TopicId
 	}
-	return ""
+	return 0
 }
 
-func (m *LibP2PKeyAndOffchainNode) GetOffchainNode() *OffchainNode {
+func (m *TopicIdDelegatorReputerDelegatorInfo) GetDelegator() string {
 	if m != nil {
-		return m.OffchainNode
+		return m.Delegator
 	}
-	return nil
-}
-
-type TopicIdAndDec struct {
-	TopicId uint64                                          `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Dec     github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=dec,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"dec"`
+	return ""
 }
 
-func (m *TopicIdAndDec) Reset()         { *m = TopicIdAndDec{} }
-func (m *TopicIdAndDec) String() string { return proto.CompactTextString(m) }
-func (*TopicIdAndDec) ProtoMessage()    {}
-func (*TopicIdAndDec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{20}
-}
-func (m *TopicIdAndDec) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *TopicIdAndDec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_TopicIdAndDec.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *TopicIdDelegatorReputerDelegatorInfo) GetReputer() string {
+	if m != nil {
+		return m.Reputer
 	}
-}
-func (m *TopicIdAndDec) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicIdAndDec.Merge(m, src)
-}
-func (m *TopicIdAndDec) XXX_Size() int {
-	return m.Size()
-}
-func (m *TopicIdAndDec) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicIdAndDec.DiscardUnknown(m)
+	return ""
 }
 
-var xxx_messageInfo_TopicIdAndDec proto.InternalMessageInfo
-
-func (m *TopicIdAndDec) GetTopicId() uint64 {
+func (m *TopicIdDelegatorReputerDelegatorInfo) GetDelegatorInfo() *DelegatorInfo {
 	if m != nil {
-		return m.TopicId
+		return m.DelegatorInfo
 	}
-	return 0
+	return nil
 }
 
-type TopicIdBlockHeightInferences struct {
-	TopicId     uint64      `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	BlockHeight int64       `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	Inferences  *Inferences `protobuf:"bytes,3,opt,name=inferences,proto3" json:"inferences,omitempty"`
+type BlockHeightTopicIdReputerStakeRemovalInfo struct {
+	BlockHeight      int64             `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	TopicId          uint64            `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reputer          string            `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	StakeRemovalInfo *StakeRemovalInfo `protobuf:"bytes,4,opt,name=stake_removal_info,json=stakeRemovalInfo,proto3" json:"stake_removal_info,omitempty"`
 }
 
-func (m *TopicIdBlockHeightInferences) Reset()         { *m = TopicIdBlockHeightInferences{} }
-func (m *TopicIdBlockHeightInferences) String() string { return proto.CompactTextString(m) }
-func (*TopicIdBlockHeightInferences) ProtoMessage()    {}
-func (*TopicIdBlockHeightInferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{21}
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Reset() {
+	*m = BlockHeightTopicIdReputerStakeRemovalInfo{}
 }
-func (m *TopicIdBlockHeightInferences) XXX_Unmarshal(b []byte) error {
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) String() string {
+	return proto.CompactTextString(m)
+}
+func (*BlockHeightTopicIdReputerStakeRemovalInfo) ProtoMessage() {}
+func (*BlockHeightTopicIdReputerStakeRemovalInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{19}
+}
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TopicIdBlockHeightInferences) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TopicIdBlockHeightInferences.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BlockHeightTopicIdReputerStakeRemovalInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1901,57 +1965,64 @@ func (m *TopicIdBlockHeightInferences) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *TopicIdBlockHeightInferences) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicIdBlockHeightInferences.Merge(m, src)
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockHeightTopicIdReputerStakeRemovalInfo.Merge(m, src)
 }
-func (m *TopicIdBlockHeightInferences) XXX_Size() int {
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *TopicIdBlockHeightInferences) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicIdBlockHeightInferences.DiscardUnknown(m)
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockHeightTopicIdReputerStakeRemovalInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TopicIdBlockHeightInferences proto.InternalMessageInfo
+var xxx_messageInfo_BlockHeightTopicIdReputerStakeRemovalInfo proto.InternalMessageInfo
 
-func (m *TopicIdBlockHeightInferences) GetTopicId() uint64 {
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) GetBlockHeight() int64 {
 	if m != nil {
-		return m.TopicId
+		return m.BlockHeight
 	}
 	return 0
 }
 
-func (m *TopicIdBlockHeightInferences) GetBlockHeight() int64 {
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) GetTopicId() uint64 {
 	if m != nil {
-		return m.BlockHeight
+		return m.TopicId
 	}
 	return 0
 }
 
-func (m *TopicIdBlockHeightInferences) GetInferences() *Inferences {
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) GetReputer() string {
 	if m != nil {
-		return m.Inferences
+		return m.Reputer
+	}
+	return ""
+}
+
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) GetStakeRemovalInfo() *StakeRemovalInfo {
+	if m != nil {
+		return m.StakeRemovalInfo
 	}
 	return nil
 }
 
-type TopicIdBlockHeightForecasts struct {
-	TopicId     uint64     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	BlockHeight int64      `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	Forecasts   *Forecasts `protobuf:"bytes,3,opt,name=forecasts,proto3" json:"forecasts,omitempty"`
+type ActorIdTopicIdBlockHeight struct {
+	ActorId     string `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	TopicId     uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64  `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (m *TopicIdBlockHeightForecasts) Reset()         { *m = TopicIdBlockHeightForecasts{} }
-func (m *TopicIdBlockHeightForecasts) String() string { return proto.CompactTextString(m) }
-func (*TopicIdBlockHeightForecasts) ProtoMessage()    {}
-func (*TopicIdBlockHeightForecasts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{22}
+func (m *ActorIdTopicIdBlockHeight) Reset()         { *m = ActorIdTopicIdBlockHeight{} }
+func (m *ActorIdTopicIdBlockHeight) String() string { return proto.CompactTextString(m) }
+func (*ActorIdTopicIdBlockHeight) ProtoMessage()    {}
+func (*ActorIdTopicIdBlockHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{20}
 }
-func (m *TopicIdBlockHeightForecasts) XXX_Unmarshal(b []byte) error {
+func (m *ActorIdTopicIdBlockHeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TopicIdBlockHeightForecasts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ActorIdTopicIdBlockHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TopicIdBlockHeightForecasts.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ActorIdTopicIdBlockHeight.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1961,57 +2032,63 @@ func (m *TopicIdBlockHeightForecasts) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *TopicIdBlockHeightForecasts) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicIdBlockHeightForecasts.Merge(m, src)
+func (m *ActorIdTopicIdBlockHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActorIdTopicIdBlockHeight.Merge(m, src)
 }
-func (m *TopicIdBlockHeightForecasts) XXX_Size() int {
+func (m *ActorIdTopicIdBlockHeight) XXX_Size() int {
 	return m.Size()
 }
-func (m *TopicIdBlockHeightForecasts) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicIdBlockHeightForecasts.DiscardUnknown(m)
+func (m *ActorIdTopicIdBlockHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActorIdTopicIdBlockHeight.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TopicIdBlockHeightForecasts proto.InternalMessageInfo
+var xxx_messageInfo_ActorIdTopicIdBlockHeight proto.InternalMessageInfo
 
-func (m *TopicIdBlockHeightForecasts) GetTopicId() uint64 {
+func (m *ActorIdTopicIdBlockHeight) GetActorId() string {
 	if m != nil {
-		return m.TopicId
+		return m.ActorId
 	}
-	return 0
+	return ""
 }
 
-func (m *TopicIdBlockHeightForecasts) GetBlockHeight() int64 {
+func (m *ActorIdTopicIdBlockHeight) GetTopicId() uint64 {
 	if m != nil {
-		return m.BlockHeight
+		return m.TopicId
 	}
 	return 0
 }
 
-func (m *TopicIdBlockHeightForecasts) GetForecasts() *Forecasts {
+func (m *ActorIdTopicIdBlockHeight) GetBlockHeight() int64 {
 	if m != nil {
-		return m.Forecasts
+		return m.BlockHeight
 	}
-	return nil
+	return 0
 }
 
-type TopicIdBlockHeightReputerValueBundles struct {
-	TopicId             uint64               `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	BlockHeight         int64                `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	ReputerValueBundles *ReputerValueBundles `protobuf:"bytes,3,opt,name=reputer_value_bundles,json=reputerValueBundles,proto3" json:"reputer_value_bundles,omitempty"`
+type BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo struct {
+	BlockHeight              int64                     `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	TopicId                  uint64                    `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Delegator                string                    `protobuf:"bytes,3,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Reputer                  string                    `protobuf:"bytes,4,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	DelegateStakeRemovalInfo *DelegateStakeRemovalInfo `protobuf:"bytes,5,opt,name=delegate_stake_removal_info,json=delegateStakeRemovalInfo,proto3" json:"delegate_stake_removal_info,omitempty"`
 }
 
-func (m *TopicIdBlockHeightReputerValueBundles) Reset()         { *m = TopicIdBlockHeightReputerValueBundles{} }
-func (m *TopicIdBlockHeightReputerValueBundles) String() string { return proto.CompactTextString(m) }
-func (*TopicIdBlockHeightReputerValueBundles) ProtoMessage()    {}
-func (*TopicIdBlockHeightReputerValueBundles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{23}
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Reset() {
+	*m = BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo{}
 }
-func (m *TopicIdBlockHeightReputerValueBundles) XXX_Unmarshal(b []byte) error {
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) String() string {
+	return proto.CompactTextString(m)
+}
+func (*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) ProtoMessage() {}
+func (*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{21}
+}
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TopicIdBlockHeightReputerValueBundles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TopicIdBlockHeightReputerValueBundles.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2021,57 +2098,72 @@ func (m *TopicIdBlockHeightReputerValueBundles) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *TopicIdBlockHeightReputerValueBundles) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicIdBlockHeightReputerValueBundles.Merge(m, src)
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo.Merge(m, src)
 }
-func (m *TopicIdBlockHeightReputerValueBundles) XXX_Size() int {
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *TopicIdBlockHeightReputerValueBundles) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicIdBlockHeightReputerValueBundles.DiscardUnknown(m)
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TopicIdBlockHeightReputerValueBundles proto.InternalMessageInfo
+var xxx_messageInfo_BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo proto.InternalMessageInfo
 
-func (m *TopicIdBlockHeightReputerValueBundles) GetTopicId() uint64 {
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) GetBlockHeight() int64 {
 	if m != nil {
-		return m.TopicId
+		return m.BlockHeight
 	}
 	return 0
 }
 
-func (m *TopicIdBlockHeightReputerValueBundles) GetBlockHeight() int64 {
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) GetTopicId() uint64 {
 	if m != nil {
-		return m.BlockHeight
+		return m.TopicId
 	}
 	return 0
 }
 
-func (m *TopicIdBlockHeightReputerValueBundles) GetReputerValueBundles() *ReputerValueBundles {
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) GetDelegator() string {
 	if m != nil {
-		return m.ReputerValueBundles
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) GetReputer() string {
+	if m != nil {
+		return m.Reputer
+	}
+	return ""
+}
+
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) GetDelegateStakeRemovalInfo() *DelegateStakeRemovalInfo {
+	if m != nil {
+		return m.DelegateStakeRemovalInfo
 	}
 	return nil
 }
 
-type TopicIdBlockHeightValueBundles struct {
-	TopicId     uint64       `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	BlockHeight int64        `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	ValueBundle *ValueBundle `protobuf:"bytes,3,opt,name=value_bundle,json=valueBundle,proto3" json:"value_bundle,omitempty"`
+type DelegatorReputerTopicIdBlockHeight struct {
+	Delegator   string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Reputer     string `protobuf:"bytes,2,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	TopicId     uint64 `protobuf:"varint,3,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64  `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (m *TopicIdBlockHeightValueBundles) Reset()         { *m = TopicIdBlockHeightValueBundles{} }
-func (m *TopicIdBlockHeightValueBundles) String() string { return proto.CompactTextString(m) }
-func (*TopicIdBlockHeightValueBundles) ProtoMessage()    {}
-func (*TopicIdBlockHeightValueBundles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{24}
+func (m *DelegatorReputerTopicIdBlockHeight) Reset()         { *m = DelegatorReputerTopicIdBlockHeight{} }
+func (m *DelegatorReputerTopicIdBlockHeight) String() string { return proto.CompactTextString(m) }
+func (*DelegatorReputerTopicIdBlockHeight) ProtoMessage()    {}
+func (*DelegatorReputerTopicIdBlockHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{22}
 }
-func (m *TopicIdBlockHeightValueBundles) XXX_Unmarshal(b []byte) error {
+func (m *DelegatorReputerTopicIdBlockHeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TopicIdBlockHeightValueBundles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DelegatorReputerTopicIdBlockHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TopicIdBlockHeightValueBundles.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DelegatorReputerTopicIdBlockHeight.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2081,56 +2173,64 @@ func (m *TopicIdBlockHeightValueBundles) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *TopicIdBlockHeightValueBundles) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicIdBlockHeightValueBundles.Merge(m, src)
+func (m *DelegatorReputerTopicIdBlockHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorReputerTopicIdBlockHeight.Merge(m, src)
 }
-func (m *TopicIdBlockHeightValueBundles) XXX_Size() int {
+func (m *DelegatorReputerTopicIdBlockHeight) XXX_Size() int {
 	return m.Size()
 }
-func (m *TopicIdBlockHeightValueBundles) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicIdBlockHeightValueBundles.DiscardUnknown(m)
+func (m *DelegatorReputerTopicIdBlockHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorReputerTopicIdBlockHeight.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TopicIdBlockHeightValueBundles proto.InternalMessageInfo
+var xxx_messageInfo_DelegatorReputerTopicIdBlockHeight proto.InternalMessageInfo
 
-func (m *TopicIdBlockHeightValueBundles) GetTopicId() uint64 {
+func (m *DelegatorReputerTopicIdBlockHeight) GetDelegator() string {
 	if m != nil {
-		return m.TopicId
+		return m.Delegator
 	}
-	return 0
+	return ""
 }
 
-func (m *TopicIdBlockHeightValueBundles) GetBlockHeight() int64 {
+func (m *DelegatorReputerTopicIdBlockHeight) GetReputer() string {
 	if m != nil {
-		return m.BlockHeight
+		return m.Reputer
+	}
+	return ""
+}
+
+func (m *DelegatorReputerTopicIdBlockHeight) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
 	return 0
 }
 
-func (m *TopicIdBlockHeightValueBundles) GetValueBundle() *ValueBundle {
+func (m *DelegatorReputerTopicIdBlockHeight) GetBlockHeight() int64 {
 	if m != nil {
-		return m.ValueBundle
+		return m.BlockHeight
 	}
-	return nil
+	return 0
 }
 
-type TopicIdAndNonces struct {
-	TopicId uint64  `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Nonces  *Nonces `protobuf:"bytes,2,opt,name=nonces,proto3" json:"nonces,omitempty"`
+type TopicIdActorIdInference struct {
+	TopicId   uint64     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	ActorId   string     `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Inference *Inference `protobuf:"bytes,3,opt,name=inference,proto3" json:"inference,omitempty"`
 }
 
-func (m *TopicIdAndNonces) Reset()         { *m = TopicIdAndNonces{} }
-func (m *TopicIdAndNonces) String() string { return proto.CompactTextString(m) }
-func (*TopicIdAndNonces) ProtoMessage()    {}
-func (*TopicIdAndNonces) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{25}
+func (m *TopicIdActorIdInference) Reset()         { *m = TopicIdActorIdInference{} }
+func (m *TopicIdActorIdInference) String() string { return proto.CompactTextString(m) }
+func (*TopicIdActorIdInference) ProtoMessage()    {}
+func (*TopicIdActorIdInference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{23}
 }
-func (m *TopicIdAndNonces) XXX_Unmarshal(b []byte) error {
+func (m *TopicIdActorIdInference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TopicIdAndNonces) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicIdActorIdInference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TopicIdAndNonces.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicIdActorIdInference.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2140,49 +2240,57 @@ func (m *TopicIdAndNonces) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *TopicIdAndNonces) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicIdAndNonces.Merge(m, src)
+func (m *TopicIdActorIdInference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdActorIdInference.Merge(m, src)
 }
-func (m *TopicIdAndNonces) XXX_Size() int {
+func (m *TopicIdActorIdInference) XXX_Size() int {
 	return m.Size()
 }
-func (m *TopicIdAndNonces) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicIdAndNonces.DiscardUnknown(m)
+func (m *TopicIdActorIdInference) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdActorIdInference.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TopicIdAndNonces proto.InternalMessageInfo
+var xxx_messageInfo_TopicIdActorIdInference proto.InternalMessageInfo
 
-func (m *TopicIdAndNonces) GetTopicId() uint64 {
+func (m *TopicIdActorIdInference) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-func (m *TopicIdAndNonces) GetNonces() *Nonces {
+func (m *TopicIdActorIdInference) GetActorId() string {
 	if m != nil {
-		return m.Nonces
+		return m.ActorId
+	}
+	return ""
+}
+
+func (m *TopicIdActorIdInference) GetInference() *Inference {
+	if m != nil {
+		return m.Inference
 	}
 	return nil
 }
 
-type TopicIdAndReputerRequestNonces struct {
-	TopicId              uint64                `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	ReputerRequestNonces *ReputerRequestNonces `protobuf:"bytes,2,opt,name=reputer_request_nonces,json=reputerRequestNonces,proto3" json:"reputer_request_nonces,omitempty"`
+type TopicIdActorIdForecast struct {
+	TopicId  uint64    `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	ActorId  string    `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Forecast *Forecast `protobuf:"bytes,3,opt,name=forecast,proto3" json:"forecast,omitempty"`
 }
 
-func (m *TopicIdAndReputerRequestNonces) Reset()         { *m = TopicIdAndReputerRequestNonces{} }
-func (m *TopicIdAndReputerRequestNonces) String() string { return proto.CompactTextString(m) }
-func (*TopicIdAndReputerRequestNonces) ProtoMessage()    {}
-func (*TopicIdAndReputerRequestNonces) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{26}
+func (m *TopicIdActorIdForecast) Reset()         { *m = TopicIdActorIdForecast{} }
+func (m *TopicIdActorIdForecast) String() string { return proto.CompactTextString(m) }
+func (*TopicIdActorIdForecast) ProtoMessage()    {}
+func (*TopicIdActorIdForecast) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{24}
 }
-func (m *TopicIdAndReputerRequestNonces) XXX_Unmarshal(b []byte) error {
+func (m *TopicIdActorIdForecast) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TopicIdAndReputerRequestNonces) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicIdActorIdForecast) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TopicIdAndReputerRequestNonces.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicIdActorIdForecast.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2192,50 +2300,56 @@ func (m *TopicIdAndReputerRequestNonces) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *TopicIdAndReputerRequestNonces) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicIdAndReputerRequestNonces.Merge(m, src)
+func (m *TopicIdActorIdForecast) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdActorIdForecast.Merge(m, src)
 }
-func (m *TopicIdAndReputerRequestNonces) XXX_Size() int {
+func (m *TopicIdActorIdForecast) XXX_Size() int {
 	return m.Size()
 }
-func (m *TopicIdAndReputerRequestNonces) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicIdAndReputerRequestNonces.DiscardUnknown(m)
+func (m *TopicIdActorIdForecast) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdActorIdForecast.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TopicIdAndReputerRequestNonces proto.InternalMessageInfo
+var xxx_messageInfo_TopicIdActorIdForecast proto.InternalMessageInfo
 
-func (m *TopicIdAndReputerRequestNonces) GetTopicId() uint64 {
+func (m *TopicIdActorIdForecast) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-func (m *TopicIdAndReputerRequestNonces) GetReputerRequestNonces() *ReputerRequestNonces {
+func (m *TopicIdActorIdForecast) GetActorId() string {
 	if m != nil {
-		return m.ReputerRequestNonces
+		return m.ActorId
+	}
+	return ""
+}
+
+func (m *TopicIdActorIdForecast) GetForecast() *Forecast {
+	if m != nil {
+		return m.Forecast
 	}
 	return nil
 }
 
-type TopicIdActorIdTimeStampedValue struct {
-	TopicId          uint64            `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	ActorId          string            `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
-	TimestampedValue *TimestampedValue `protobuf:"bytes,3,opt,name=timestamped_value,json=timestampedValue,proto3" json:"timestamped_value,omitempty"`
+type LibP2PKeyAndOffchainNode struct {
+	LibP2PKey    string        `protobuf:"bytes,1,opt,name=lib_p2p_key,json=libP2pKey,proto3" json:"lib_p2p_key,omitempty"`
+	OffchainNode *OffchainNode `protobuf:"bytes,2,opt,name=offchain_node,json=offchainNode,proto3" json:"offchain_node,omitempty"`
 }
 
-func (m *TopicIdActorIdTimeStampedValue) Reset()         { *m = TopicIdActorIdTimeStampedValue{} }
-func (m *TopicIdActorIdTimeStampedValue) String() string { return proto.CompactTextString(m) }
-func (*TopicIdActorIdTimeStampedValue) ProtoMessage()    {}
-func (*TopicIdActorIdTimeStampedValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{27}
+func (m *LibP2PKeyAndOffchainNode) Reset()         { *m = LibP2PKeyAndOffchainNode{} }
+func (m *LibP2PKeyAndOffchainNode) String() string { return proto.CompactTextString(m) }
+func (*LibP2PKeyAndOffchainNode) ProtoMessage()    {}
+func (*LibP2PKeyAndOffchainNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{25}
 }
-func (m *TopicIdActorIdTimeStampedValue) XXX_Unmarshal(b []byte) error {
+func (m *LibP2PKeyAndOffchainNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TopicIdActorIdTimeStampedValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *LibP2PKeyAndOffchainNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TopicIdActorIdTimeStampedValue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_LibP2PKeyAndOffchainNode.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2245,58 +2359,49 @@ func (m *TopicIdActorIdTimeStampedValue) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *TopicIdActorIdTimeStampedValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicIdActorIdTimeStampedValue.Merge(m, src)
+func (m *LibP2PKeyAndOffchainNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LibP2PKeyAndOffchainNode.Merge(m, src)
 }
-func (m *TopicIdActorIdTimeStampedValue) XXX_Size() int {
+func (m *LibP2PKeyAndOffchainNode) XXX_Size() int {
 	return m.Size()
 }
-func (m *TopicIdActorIdTimeStampedValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicIdActorIdTimeStampedValue.DiscardUnknown(m)
+func (m *LibP2PKeyAndOffchainNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_LibP2PKeyAndOffchainNode.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TopicIdActorIdTimeStampedValue proto.InternalMessageInfo
-
-func (m *TopicIdActorIdTimeStampedValue) GetTopicId() uint64 {
-	if m != nil {
-		return m.TopicId
-	}
-	return 0
-}
+var xxx_messageInfo_LibP2PKeyAndOffchainNode proto.InternalMessageInfo
 
-func (m *TopicIdActorIdTimeStampedValue) GetActorId() string {
+func (m *LibP2PKeyAndOffchainNode) GetLibP2PKey() string {
 	if m != nil {
-		return m.ActorId
+		return m.LibP2PKey
 	}
 	return ""
 }
 
-func (m *TopicIdActorIdTimeStampedValue) GetTimestampedValue() *TimestampedValue {
+func (m *LibP2PKeyAndOffchainNode) GetOffchainNode() *OffchainNode {
 	if m != nil {
-		return m.TimestampedValue
+		return m.OffchainNode
 	}
 	return nil
 }
 
-type TopicIdActorIdActorIdTimeStampedValue struct {
-	TopicId          uint64            `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	ActorId1         string            `protobuf:"bytes,2,opt,name=actor_id1,json=actorId1,proto3" json:"actor_id1,omitempty"`
-	ActorId2         string            `protobuf:"bytes,3,opt,name=actor_id2,json=actorId2,proto3" json:"actor_id2,omitempty"`
-	TimestampedValue *TimestampedValue `protobuf:"bytes,4,opt,name=timestamped_value,json=timestampedValue,proto3" json:"timestamped_value,omitempty"`
+type TopicIdAndDec struct {
+	TopicId uint64                                          `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Dec     github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=dec,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"dec"`
 }
 
-func (m *TopicIdActorIdActorIdTimeStampedValue) Reset()         { *m = TopicIdActorIdActorIdTimeStampedValue{} }
-func (m *TopicIdActorIdActorIdTimeStampedValue) String() string { return proto.CompactTextString(m) }
-func (*TopicIdActorIdActorIdTimeStampedValue) ProtoMessage()    {}
-func (*TopicIdActorIdActorIdTimeStampedValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{28}
+func (m *TopicIdAndDec) Reset()         { *m = TopicIdAndDec{} }
+func (m *TopicIdAndDec) String() string { return proto.CompactTextString(m) }
+func (*TopicIdAndDec) ProtoMessage()    {}
+func (*TopicIdAndDec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{26}
 }
-func (m *TopicIdActorIdActorIdTimeStampedValue) XXX_Unmarshal(b []byte) error {
+func (m *TopicIdAndDec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TopicIdActorIdActorIdTimeStampedValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicIdAndDec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TopicIdActorIdActorIdTimeStampedValue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicIdAndDec.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2306,63 +2411,103 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *TopicIdActorIdActorIdTimeStampedValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicIdActorIdActorIdTimeStampedValue.Merge(m, src)
+func (m *TopicIdAndDec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdAndDec.Merge(m, src)
 }
-func (m *TopicIdActorIdActorIdTimeStampedValue) XXX_Size() int {
+func (m *TopicIdAndDec) XXX_Size() int {
 	return m.Size()
 }
-func (m *TopicIdActorIdActorIdTimeStampedValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicIdActorIdActorIdTimeStampedValue.DiscardUnknown(m)
+func (m *TopicIdAndDec) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdAndDec.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TopicIdActorIdActorIdTimeStampedValue proto.InternalMessageInfo
+var xxx_messageInfo_TopicIdAndDec proto.InternalMessageInfo
 
-func (m *TopicIdActorIdActorIdTimeStampedValue) GetTopicId() uint64 {
+func (m *TopicIdAndDec) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-func (m *TopicIdActorIdActorIdTimeStampedValue) GetActorId1() string {
+type TopicIdBlockHeightInferences struct {
+	TopicId     uint64      `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64       `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Inferences  *Inferences `protobuf:"bytes,3,opt,name=inferences,proto3" json:"inferences,omitempty"`
+}
+
+func (m *TopicIdBlockHeightInferences) Reset()         { *m = TopicIdBlockHeightInferences{} }
+func (m *TopicIdBlockHeightInferences) String() string { return proto.CompactTextString(m) }
+func (*TopicIdBlockHeightInferences) ProtoMessage()    {}
+func (*TopicIdBlockHeightInferences) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{27}
+}
+func (m *TopicIdBlockHeightInferences) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicIdBlockHeightInferences) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicIdBlockHeightInferences.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicIdBlockHeightInferences) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdBlockHeightInferences.Merge(m, src)
+}
+func (m *TopicIdBlockHeightInferences) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicIdBlockHeightInferences) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdBlockHeightInferences.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicIdBlockHeightInferences proto.InternalMessageInfo
+
+func (m *TopicIdBlockHeightInferences) GetTopicId() uint64 {
 	if m != nil {
-		return m.ActorId1
+		return m.TopicId
 	}
-	return ""
+	return 0
 }
 
-func (m *TopicIdActorIdActorIdTimeStampedValue) GetActorId2() string {
+func (m *TopicIdBlockHeightInferences) GetBlockHeight() int64 {
 	if m != nil {
-		return m.ActorId2
+		return m.BlockHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *TopicIdActorIdActorIdTimeStampedValue) GetTimestampedValue() *TimestampedValue {
+func (m *TopicIdBlockHeightInferences) GetInferences() *Inferences {
 	if m != nil {
-		return m.TimestampedValue
+		return m.Inferences
 	}
 	return nil
 }
 
-type TopicIdTimestampedActorNonce struct {
-	TopicId               uint64                 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	TimestampedActorNonce *TimestampedActorNonce `protobuf:"bytes,2,opt,name=timestamped_actor_nonce,json=timestampedActorNonce,proto3" json:"timestamped_actor_nonce,omitempty"`
+type TopicIdBlockHeightForecasts struct {
+	TopicId     uint64     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64      `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Forecasts   *Forecasts `protobuf:"bytes,3,opt,name=forecasts,proto3" json:"forecasts,omitempty"`
 }
 
-func (m *TopicIdTimestampedActorNonce) Reset()         { *m = TopicIdTimestampedActorNonce{} }
-func (m *TopicIdTimestampedActorNonce) String() string { return proto.CompactTextString(m) }
-func (*TopicIdTimestampedActorNonce) ProtoMessage()    {}
-func (*TopicIdTimestampedActorNonce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{29}
+func (m *TopicIdBlockHeightForecasts) Reset()         { *m = TopicIdBlockHeightForecasts{} }
+func (m *TopicIdBlockHeightForecasts) String() string { return proto.CompactTextString(m) }
+func (*TopicIdBlockHeightForecasts) ProtoMessage()    {}
+func (*TopicIdBlockHeightForecasts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{28}
 }
-func (m *TopicIdTimestampedActorNonce) XXX_Unmarshal(b []byte) error {
+func (m *TopicIdBlockHeightForecasts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TopicIdTimestampedActorNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicIdBlockHeightForecasts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TopicIdTimestampedActorNonce.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicIdBlockHeightForecasts.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2372,49 +2517,57 @@ func (m *TopicIdTimestampedActorNonce) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *TopicIdTimestampedActorNonce) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicIdTimestampedActorNonce.Merge(m, src)
+func (m *TopicIdBlockHeightForecasts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdBlockHeightForecasts.Merge(m, src)
 }
-func (m *TopicIdTimestampedActorNonce) XXX_Size() int {
+func (m *TopicIdBlockHeightForecasts) XXX_Size() int {
 	return m.Size()
 }
-func (m *TopicIdTimestampedActorNonce) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicIdTimestampedActorNonce.DiscardUnknown(m)
+func (m *TopicIdBlockHeightForecasts) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdBlockHeightForecasts.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TopicIdTimestampedActorNonce proto.InternalMessageInfo
+var xxx_messageInfo_TopicIdBlockHeightForecasts proto.InternalMessageInfo
 
-func (m *TopicIdTimestampedActorNonce) GetTopicId() uint64 {
+func (m *TopicIdBlockHeightForecasts) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-func (m *TopicIdTimestampedActorNonce) GetTimestampedActorNonce() *TimestampedActorNonce {
+func (m *TopicIdBlockHeightForecasts) GetBlockHeight() int64 {
 	if m != nil {
-		return m.TimestampedActorNonce
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *TopicIdBlockHeightForecasts) GetForecasts() *Forecasts {
+	if m != nil {
+		return m.Forecasts
 	}
 	return nil
 }
 
-type BlockHeightTopicIds struct {
-	BlockHeight int64     `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	TopicIds    *TopicIds `protobuf:"bytes,2,opt,name=topic_ids,json=topicIds,proto3" json:"topic_ids,omitempty"`
+type TopicIdBlockHeightReputerValueBundles struct {
+	TopicId             uint64               `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight         int64                `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	ReputerValueBundles *ReputerValueBundles `protobuf:"bytes,3,opt,name=reputer_value_bundles,json=reputerValueBundles,proto3" json:"reputer_value_bundles,omitempty"`
 }
 
-func (m *BlockHeightTopicIds) Reset()         { *m = BlockHeightTopicIds{} }
-func (m *BlockHeightTopicIds) String() string { return proto.CompactTextString(m) }
-func (*BlockHeightTopicIds) ProtoMessage()    {}
-func (*BlockHeightTopicIds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{30}
+func (m *TopicIdBlockHeightReputerValueBundles) Reset()         { *m = TopicIdBlockHeightReputerValueBundles{} }
+func (m *TopicIdBlockHeightReputerValueBundles) String() string { return proto.CompactTextString(m) }
+func (*TopicIdBlockHeightReputerValueBundles) ProtoMessage()    {}
+func (*TopicIdBlockHeightReputerValueBundles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{29}
 }
-func (m *BlockHeightTopicIds) XXX_Unmarshal(b []byte) error {
+func (m *TopicIdBlockHeightReputerValueBundles) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BlockHeightTopicIds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicIdBlockHeightReputerValueBundles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BlockHeightTopicIds.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicIdBlockHeightReputerValueBundles.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2424,49 +2577,57 @@ func (m *BlockHeightTopicIds) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *BlockHeightTopicIds) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockHeightTopicIds.Merge(m, src)
+func (m *TopicIdBlockHeightReputerValueBundles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdBlockHeightReputerValueBundles.Merge(m, src)
 }
-func (m *BlockHeightTopicIds) XXX_Size() int {
+func (m *TopicIdBlockHeightReputerValueBundles) XXX_Size() int {
 	return m.Size()
 }
-func (m *BlockHeightTopicIds) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockHeightTopicIds.DiscardUnknown(m)
+func (m *TopicIdBlockHeightReputerValueBundles) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdBlockHeightReputerValueBundles.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlockHeightTopicIds proto.InternalMessageInfo
+var xxx_messageInfo_TopicIdBlockHeightReputerValueBundles proto.InternalMessageInfo
 
-func (m *BlockHeightTopicIds) GetBlockHeight() int64 {
+func (m *TopicIdBlockHeightReputerValueBundles) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *TopicIdBlockHeightReputerValueBundles) GetBlockHeight() int64 {
 	if m != nil {
 		return m.BlockHeight
 	}
 	return 0
 }
 
-func (m *BlockHeightTopicIds) GetTopicIds() *TopicIds {
+func (m *TopicIdBlockHeightReputerValueBundles) GetReputerValueBundles() *ReputerValueBundles {
 	if m != nil {
-		return m.TopicIds
+		return m.ReputerValueBundles
 	}
 	return nil
 }
 
-type BlockHeightTopicIdWeightPair struct {
-	BlockHeight int64              `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	TopicWeight *TopicIdWeightPair `protobuf:"bytes,2,opt,name=topic_weight,json=topicWeight,proto3" json:"topic_weight,omitempty"`
+type TopicIdBlockHeightValueBundles struct {
+	TopicId     uint64       `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64        `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	ValueBundle *ValueBundle `protobuf:"bytes,3,opt,name=value_bundle,json=valueBundle,proto3" json:"value_bundle,omitempty"`
 }
 
-func (m *BlockHeightTopicIdWeightPair) Reset()         { *m = BlockHeightTopicIdWeightPair{} }
-func (m *BlockHeightTopicIdWeightPair) String() string { return proto.CompactTextString(m) }
-func (*BlockHeightTopicIdWeightPair) ProtoMessage()    {}
-func (*BlockHeightTopicIdWeightPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{31}
+func (m *TopicIdBlockHeightValueBundles) Reset()         { *m = TopicIdBlockHeightValueBundles{} }
+func (m *TopicIdBlockHeightValueBundles) String() string { return proto.CompactTextString(m) }
+func (*TopicIdBlockHeightValueBundles) ProtoMessage()    {}
+func (*TopicIdBlockHeightValueBundles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{30}
 }
-func (m *BlockHeightTopicIdWeightPair) XXX_Unmarshal(b []byte) error {
+func (m *TopicIdBlockHeightValueBundles) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BlockHeightTopicIdWeightPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicIdBlockHeightValueBundles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BlockHeightTopicIdWeightPair.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicIdBlockHeightValueBundles.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2476,50 +2637,56 @@ func (m *BlockHeightTopicIdWeightPair) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *BlockHeightTopicIdWeightPair) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockHeightTopicIdWeightPair.Merge(m, src)
+func (m *TopicIdBlockHeightValueBundles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdBlockHeightValueBundles.Merge(m, src)
 }
-func (m *BlockHeightTopicIdWeightPair) XXX_Size() int {
+func (m *TopicIdBlockHeightValueBundles) XXX_Size() int {
 	return m.Size()
 }
-func (m *BlockHeightTopicIdWeightPair) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockHeightTopicIdWeightPair.DiscardUnknown(m)
+func (m *TopicIdBlockHeightValueBundles) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdBlockHeightValueBundles.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlockHeightTopicIdWeightPair proto.InternalMessageInfo
+var xxx_messageInfo_TopicIdBlockHeightValueBundles proto.InternalMessageInfo
 
-func (m *BlockHeightTopicIdWeightPair) GetBlockHeight() int64 {
+func (m *TopicIdBlockHeightValueBundles) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *TopicIdBlockHeightValueBundles) GetBlockHeight() int64 {
 	if m != nil {
 		return m.BlockHeight
 	}
 	return 0
 }
 
-func (m *BlockHeightTopicIdWeightPair) GetTopicWeight() *TopicIdWeightPair {
+func (m *TopicIdBlockHeightValueBundles) GetValueBundle() *ValueBundle {
 	if m != nil {
-		return m.TopicWeight
+		return m.ValueBundle
 	}
 	return nil
 }
 
-type TopicIdReputerReputerValueBundle struct {
-	TopicId            uint64              `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Reputer            string              `protobuf:"bytes,2,opt,name=reputer,proto3" json:"reputer,omitempty"`
-	ReputerValueBundle *ReputerValueBundle `protobuf:"bytes,3,opt,name=reputer_value_bundle,json=reputerValueBundle,proto3" json:"reputer_value_bundle,omitempty"`
+type TopicIdAndNonces struct {
+	TopicId uint64  `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Nonces  *Nonces `protobuf:"bytes,2,opt,name=nonces,proto3" json:"nonces,omitempty"`
 }
 
-func (m *TopicIdReputerReputerValueBundle) Reset()         { *m = TopicIdReputerReputerValueBundle{} }
-func (m *TopicIdReputerReputerValueBundle) String() string { return proto.CompactTextString(m) }
-func (*TopicIdReputerReputerValueBundle) ProtoMessage()    {}
-func (*TopicIdReputerReputerValueBundle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{32}
+func (m *TopicIdAndNonces) Reset()         { *m = TopicIdAndNonces{} }
+func (m *TopicIdAndNonces) String() string { return proto.CompactTextString(m) }
+func (*TopicIdAndNonces) ProtoMessage()    {}
+func (*TopicIdAndNonces) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{31}
 }
-func (m *TopicIdReputerReputerValueBundle) XXX_Unmarshal(b []byte) error {
+func (m *TopicIdAndNonces) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TopicIdReputerReputerValueBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicIdAndNonces) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TopicIdReputerReputerValueBundle.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicIdAndNonces.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2529,547 +2696,738 @@ func (m *TopicIdReputerReputerValueBundle) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *TopicIdReputerReputerValueBundle) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicIdReputerReputerValueBundle.Merge(m, src)
+func (m *TopicIdAndNonces) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdAndNonces.Merge(m, src)
 }
-func (m *TopicIdReputerReputerValueBundle) XXX_Size() int {
+func (m *TopicIdAndNonces) XXX_Size() int {
 	return m.Size()
 }
-func (m *TopicIdReputerReputerValueBundle) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicIdReputerReputerValueBundle.DiscardUnknown(m)
+func (m *TopicIdAndNonces) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdAndNonces.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TopicIdReputerReputerValueBundle proto.InternalMessageInfo
+var xxx_messageInfo_TopicIdAndNonces proto.InternalMessageInfo
 
-func (m *TopicIdReputerReputerValueBundle) GetTopicId() uint64 {
+func (m *TopicIdAndNonces) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-func (m *TopicIdReputerReputerValueBundle) GetReputer() string {
+func (m *TopicIdAndNonces) GetNonces() *Nonces {
 	if m != nil {
-		return m.Reputer
+		return m.Nonces
 	}
-	return ""
+	return nil
 }
 
-func (m *TopicIdReputerReputerValueBundle) GetReputerValueBundle() *ReputerValueBundle {
-	if m != nil {
-		return m.ReputerValueBundle
-	}
-	return nil
+type TopicIdAndReputerRequestNonces struct {
+	TopicId              uint64                `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	ReputerRequestNonces *ReputerRequestNonces `protobuf:"bytes,2,opt,name=reputer_request_nonces,json=reputerRequestNonces,proto3" json:"reputer_request_nonces,omitempty"`
 }
 
-func init() {
-	proto.RegisterType((*GenesisState)(nil), "emissions.v5.GenesisState")
-	proto.RegisterType((*TopicIdAndTopic)(nil), "emissions.v5.TopicIdAndTopic")
-	proto.RegisterType((*TopicAndActorId)(nil), "emissions.v5.TopicAndActorId")
-	proto.RegisterType((*TopicIdAndBlockHeight)(nil), "emissions.v5.TopicIdAndBlockHeight")
-	proto.RegisterType((*BlockHeightAndTopicIds)(nil), "emissions.v5.BlockHeightAndTopicIds")
-	proto.RegisterType((*TopicIdBlockHeightScores)(nil), "emissions.v5.TopicIdBlockHeightScores")
-	proto.RegisterType((*TopicIdActorIdScore)(nil), "emissions.v5.TopicIdActorIdScore")
-	proto.RegisterType((*TopicIdActorIdUint64)(nil), "emissions.v5.TopicIdActorIdUint64")
-	proto.RegisterType((*TopicIdActorIdListeningCoefficient)(nil), "emissions.v5.TopicIdActorIdListeningCoefficient")
-	proto.RegisterType((*TopicIdActorIdDec)(nil), "emissions.v5.TopicIdActorIdDec")
-	proto.RegisterType((*TopicIdAndInt)(nil), "emissions.v5.TopicIdAndInt")
-	proto.RegisterType((*TopicIdActorIdInt)(nil), "emissions.v5.TopicIdActorIdInt")
-	proto.RegisterType((*TopicIdDelegatorReputerDelegatorInfo)(nil), "emissions.v5.TopicIdDelegatorReputerDelegatorInfo")
-	proto.RegisterType((*BlockHeightTopicIdReputerStakeRemovalInfo)(nil), "emissions.v5.BlockHeightTopicIdReputerStakeRemovalInfo")
-	proto.RegisterType((*ActorIdTopicIdBlockHeight)(nil), "emissions.v5.ActorIdTopicIdBlockHeight")
-	proto.RegisterType((*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo)(nil), "emissions.v5.BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo")
-	proto.RegisterType((*DelegatorReputerTopicIdBlockHeight)(nil), "emissions.v5.DelegatorReputerTopicIdBlockHeight")
-	proto.RegisterType((*TopicIdActorIdInference)(nil), "emissions.v5.TopicIdActorIdInference")
-	proto.RegisterType((*TopicIdActorIdForecast)(nil), "emissions.v5.TopicIdActorIdForecast")
-	proto.RegisterType((*LibP2PKeyAndOffchainNode)(nil), "emissions.v5.LibP2pKeyAndOffchainNode")
-	proto.RegisterType((*TopicIdAndDec)(nil), "emissions.v5.TopicIdAndDec")
-	proto.RegisterType((*TopicIdBlockHeightInferences)(nil), "emissions.v5.TopicIdBlockHeightInferences")
-	proto.RegisterType((*TopicIdBlockHeightForecasts)(nil), "emissions.v5.TopicIdBlockHeightForecasts")
-	proto.RegisterType((*TopicIdBlockHeightReputerValueBundles)(nil), "emissions.v5.TopicIdBlockHeightReputerValueBundles")
-	proto.RegisterType((*TopicIdBlockHeightValueBundles)(nil), "emissions.v5.TopicIdBlockHeightValueBundles")
-	proto.RegisterType((*TopicIdAndNonces)(nil), "emissions.v5.TopicIdAndNonces")
-	proto.RegisterType((*TopicIdAndReputerRequestNonces)(nil), "emissions.v5.TopicIdAndReputerRequestNonces")
-	proto.RegisterType((*TopicIdActorIdTimeStampedValue)(nil), "emissions.v5.TopicIdActorIdTimeStampedValue")
-	proto.RegisterType((*TopicIdActorIdActorIdTimeStampedValue)(nil), "emissions.v5.TopicIdActorIdActorIdTimeStampedValue")
-	proto.RegisterType((*TopicIdTimestampedActorNonce)(nil), "emissions.v5.TopicIdTimestampedActorNonce")
-	proto.RegisterType((*BlockHeightTopicIds)(nil), "emissions.v5.BlockHeightTopicIds")
-	proto.RegisterType((*BlockHeightTopicIdWeightPair)(nil), "emissions.v5.BlockHeightTopicIdWeightPair")
-	proto.RegisterType((*TopicIdReputerReputerValueBundle)(nil), "emissions.v5.TopicIdReputerReputerValueBundle")
+func (m *TopicIdAndReputerRequestNonces) Reset()         { *m = TopicIdAndReputerRequestNonces{} }
+func (m *TopicIdAndReputerRequestNonces) String() string { return proto.CompactTextString(m) }
+func (*TopicIdAndReputerRequestNonces) ProtoMessage()    {}
+func (*TopicIdAndReputerRequestNonces) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{32}
+}
+func (m *TopicIdAndReputerRequestNonces) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicIdAndReputerRequestNonces) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicIdAndReputerRequestNonces.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicIdAndReputerRequestNonces) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdAndReputerRequestNonces.Merge(m, src)
+}
+func (m *TopicIdAndReputerRequestNonces) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicIdAndReputerRequestNonces) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdAndReputerRequestNonces.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("emissions/v5/genesis.proto", fileDescriptor_a6c24e1b56b831d9) }
+var xxx_messageInfo_TopicIdAndReputerRequestNonces proto.InternalMessageInfo
 
-var fileDescriptor_a6c24e1b56b831d9 = []byte{
-	// 3089 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xcb, 0x6f, 0xdc, 0xc6,
-	0x19, 0x37, 0x25, 0x45, 0x96, 0x3e, 0x49, 0x96, 0x34, 0x7a, 0x51, 0x0f, 0xaf, 0xd6, 0xf4, 0x23,
-	0x72, 0x62, 0xaf, 0x62, 0x29, 0x4e, 0xdc, 0x34, 0x6d, 0xa2, 0xb5, 0x2d, 0x67, 0x6d, 0xc1, 0x96,
-	0x29, 0x39, 0x0e, 0x12, 0x24, 0x2c, 0xb5, 0x9c, 0x95, 0x18, 0x73, 0x39, 0x1b, 0x0e, 0x57, 0xb2,
-	0xdb, 0x02, 0x2d, 0x0a, 0x34, 0x87, 0x02, 0x05, 0x82, 0xa2, 0x29, 0xfa, 0xba, 0x14, 0xbd, 0x14,
-	0xe8, 0xa5, 0x40, 0x8b, 0x9e, 0xda, 0x7b, 0x7a, 0x4b, 0x7b, 0x2a, 0x7a, 0x08, 0x8a, 0xe4, 0xd0,
-	0x7f, 0xa3, 0xe0, 0xcc, 0xf0, 0xb5, 0x3b, 0xe4, 0xae, 0x56, 0xb9, 0x08, 0x22, 0x67, 0xbe, 0xdf,
-	0xef, 0x37, 0xdf, 0x7c, 0xfc, 0xe6, 0xf1, 0x2d, 0x2c, 0xe0, 0xba, 0x4d, 0xa9, 0x4d, 0x5c, 0xba,
-	0x7a, 0x78, 0x7d, 0x75, 0x1f, 0xbb, 0x98, 0xda, 0xb4, 0xd4, 0xf0, 0x88, 0x4f, 0xd0, 0x68, 0xd4,
-	0x56, 0x3a, 0xbc, 0xbe, 0x30, 0x69, 0xd6, 0x6d, 0x97, 0xac, 0xb2, 0xbf, 0xbc, 0xc3, 0xc2, 0x7c,
-	0x95, 0xd0, 0x3a, 0xa1, 0x06, 0x7b, 0x5a, 0xe5, 0x0f, 0xa2, 0x69, 0x2e, 0x81, 0xbb, 0xbe, 0xea,
-	0x12, 0x0b, 0x8b, 0x06, 0xb5, 0xa5, 0xc1, 0xad, 0x86, 0x2d, 0x0b, 0xa9, 0x16, 0x0f, 0x37, 0x9a,
-	0x3e, 0xf6, 0xa4, 0x56, 0xb4, 0x4a, 0x3c, 0x39, 0x1e, 0xf5, 0xcd, 0x27, 0xf2, 0x16, 0x9f, 0x34,
-	0xec, 0xaa, 0xbc, 0xe5, 0x59, 0x03, 0x87, 0xb2, 0xe7, 0x53, 0x2d, 0x47, 0xc4, 0x7b, 0x12, 0x49,
-	0x98, 0x4f, 0x79, 0xaa, 0x61, 0x7a, 0x66, 0x3d, 0xb4, 0x9a, 0xde, 0x27, 0xfb, 0x84, 0x3b, 0x21,
-	0xf8, 0x8f, 0xbf, 0xd5, 0xfe, 0x7a, 0x03, 0x46, 0xef, 0x70, 0x87, 0xee, 0xf8, 0xa6, 0x8f, 0xd1,
-	0x1a, 0x0c, 0x72, 0x33, 0x55, 0x29, 0x2a, 0x2b, 0x23, 0x6b, 0xd3, 0xa5, 0xa4, 0x83, 0x4b, 0xdb,
-	0xac, 0xad, 0x3c, 0xf0, 0xd9, 0x17, 0xcb, 0xa7, 0x74, 0xd1, 0x13, 0x69, 0x30, 0xe6, 0xe2, 0xa7,
-	0xbe, 0xc1, 0xe4, 0x1b, 0xb6, 0xa5, 0xf6, 0x17, 0x95, 0x95, 0x01, 0x7d, 0x24, 0x78, 0xb9, 0x1b,
-	0xbc, 0xab, 0x58, 0xe8, 0x3a, 0x0c, 0xb2, 0x66, 0xaa, 0x0e, 0x14, 0xfb, 0x57, 0x46, 0xd6, 0xce,
-	0xa6, 0x71, 0x45, 0xb7, 0x0d, 0xd7, 0x62, 0xff, 0xe9, 0xa2, 0x33, 0x3a, 0x0f, 0x63, 0x66, 0xd5,
-	0xb7, 0x0f, 0xb1, 0x21, 0xac, 0x9f, 0x2b, 0xf6, 0xaf, 0x0c, 0xe8, 0xa3, 0xfc, 0xe5, 0x2e, 0xef,
-	0xf4, 0x22, 0x4c, 0x7a, 0xf8, 0xc8, 0xf4, 0x2c, 0x73, 0xcf, 0x89, 0x3a, 0x0e, 0xb2, 0x8e, 0x13,
-	0x71, 0x83, 0xe8, 0x5c, 0x86, 0x31, 0xae, 0x93, 0x3b, 0x8e, 0xaa, 0xa7, 0x33, 0xf5, 0x6c, 0xb8,
-	0xd6, 0x46, 0xd5, 0x27, 0x5e, 0xc5, 0xd2, 0x47, 0x99, 0xcd, 0x63, 0x6e, 0x82, 0x6e, 0xc1, 0x19,
-	0x8e, 0x21, 0x02, 0x80, 0xaa, 0x43, 0xdd, 0x80, 0x70, 0x62, 0x5d, 0xd8, 0xa0, 0x87, 0x80, 0x42,
-	0x94, 0x40, 0xa3, 0xc1, 0xe2, 0x4c, 0x1d, 0x66, 0x48, 0xe7, 0xb3, 0xdc, 0x53, 0x76, 0x48, 0xf5,
-	0xc9, 0x5b, 0xd8, 0xde, 0x3f, 0xf0, 0xf5, 0x09, 0x81, 0x17, 0x58, 0xdf, 0x0f, 0x8c, 0xd1, 0xfb,
-	0x30, 0x67, 0xbb, 0x35, 0xec, 0x61, 0xcf, 0x60, 0xf1, 0x47, 0x8d, 0xbd, 0x67, 0xc6, 0x5e, 0x60,
-	0xa2, 0x02, 0xc3, 0xbd, 0x24, 0xc5, 0x4d, 0x80, 0xee, 0x30, 0x33, 0x7d, 0x5a, 0xc0, 0xf0, 0xc7,
-	0xf2, 0x33, 0xd6, 0x03, 0x55, 0x61, 0xa1, 0x46, 0x3c, 0x5c, 0x35, 0xa9, 0x2f, 0x61, 0x18, 0x39,
-	0x16, 0xc3, 0x5c, 0x8c, 0x94, 0x26, 0x79, 0x1f, 0xe6, 0x84, 0x5b, 0xdb, 0x18, 0x46, 0x8f, 0x37,
-	0x06, 0x01, 0x93, 0x86, 0x7f, 0x00, 0x28, 0xe5, 0x22, 0x03, 0xd7, 0x4d, 0xaa, 0xbe, 0xce, 0x90,
-	0xcf, 0xc9, 0xbd, 0xce, 0xa7, 0x8f, 0xc1, 0xe8, 0x13, 0x49, 0xc7, 0xdc, 0xae, 0x9b, 0x14, 0x3d,
-	0x82, 0x99, 0x56, 0xa7, 0x70, 0xcc, 0x6f, 0x75, 0x8b, 0x39, 0xd5, 0xe2, 0x0a, 0x06, 0xfb, 0x00,
-	0x50, 0xca, 0x0d, 0x1c, 0xf3, 0xdb, 0x5d, 0xeb, 0x4c, 0x0e, 0x9e, 0x01, 0xfa, 0x70, 0x36, 0x04,
-	0x74, 0x6c, 0xea, 0x63, 0xd7, 0x76, 0xf7, 0x8d, 0x2a, 0xc1, 0xb5, 0x9a, 0x5d, 0xb5, 0xb1, 0xeb,
-	0xab, 0x13, 0x0c, 0xfb, 0xa5, 0x3c, 0xec, 0xad, 0xd0, 0xf0, 0x66, 0x6c, 0xa7, 0x2f, 0x0a, 0x58,
-	0x59, 0x23, 0x3a, 0x80, 0x62, 0xc3, 0xc3, 0x87, 0x36, 0x69, 0xd2, 0xf0, 0x6b, 0x09, 0xe3, 0xbd,
-	0xe6, 0x05, 0x5f, 0x31, 0x71, 0xd5, 0x49, 0x46, 0xbc, 0x9c, 0x47, 0x7c, 0x0b, 0x57, 0xf5, 0xb3,
-	0x21, 0x90, 0xf8, 0x82, 0x78, 0xe0, 0x6f, 0x0a, 0x14, 0xe4, 0x80, 0x16, 0x31, 0xf1, 0x49, 0x72,
-	0xab, 0xb8, 0x8d, 0x0b, 0x75, 0xc7, 0xb5, 0x1c, 0x42, 0x55, 0x42, 0xa4, 0x16, 0xb6, 0x0f, 0xe1,
-	0x5c, 0xc4, 0x16, 0x4e, 0x5f, 0x1b, 0xd9, 0x54, 0x77, 0x64, 0x85, 0x10, 0x69, 0x53, 0x00, 0xb5,
-	0x70, 0xed, 0xc1, 0x72, 0x1b, 0x57, 0x14, 0x16, 0x9e, 0xe9, 0xdb, 0x44, 0x9d, 0x66, 0x4c, 0x8b,
-	0x59, 0x59, 0x23, 0x60, 0x59, 0x6a, 0x65, 0x11, 0xc1, 0xa1, 0x07, 0x00, 0xe8, 0x21, 0x8c, 0xf8,
-	0xc4, 0x37, 0x1d, 0x83, 0xad, 0x4e, 0xea, 0x4c, 0x51, 0x59, 0x19, 0x2e, 0xbf, 0x14, 0xa4, 0xf9,
-	0xff, 0x7c, 0xb1, 0x3c, 0xc3, 0x97, 0x4d, 0x6a, 0x3d, 0x29, 0xd9, 0x64, 0xb5, 0x6e, 0xfa, 0x07,
-	0xa5, 0x8a, 0xeb, 0xff, 0xeb, 0x2f, 0x57, 0x41, 0xac, 0xa7, 0x15, 0xd7, 0xff, 0xc3, 0xff, 0xfe,
-	0xf4, 0x82, 0xa2, 0x03, 0x03, 0xd9, 0x09, 0x30, 0xd0, 0xeb, 0x01, 0x64, 0x90, 0xdf, 0x38, 0xe4,
-	0x6c, 0xbe, 0xc4, 0x8a, 0xeb, 0x07, 0xd6, 0x0d, 0xbb, 0xca, 0xad, 0x1f, 0xc3, 0x1c, 0xb3, 0x8b,
-	0xa2, 0xc6, 0x6c, 0xfa, 0x07, 0xc4, 0xb3, 0xfd, 0x67, 0xea, 0x5c, 0x67, 0xb7, 0x06, 0x68, 0x33,
-	0xcc, 0x5e, 0x04, 0xcb, 0x46, 0x68, 0x8d, 0xde, 0x01, 0x95, 0x03, 0xd3, 0x66, 0xdd, 0xa8, 0x79,
-	0xa4, 0x6e, 0x58, 0xd8, 0xc1, 0xfb, 0xa6, 0x4f, 0x3c, 0x55, 0x3d, 0x0e, 0xf2, 0x4e, 0xb3, 0xbe,
-	0xe9, 0x91, 0xfa, 0xad, 0xd0, 0x1a, 0xbd, 0x0f, 0x13, 0x02, 0x0a, 0x5b, 0x7c, 0xd0, 0x54, 0x9d,
-	0x67, 0x88, 0x6b, 0x52, 0xc4, 0xc8, 0x52, 0x68, 0x8c, 0x9e, 0x2b, 0x6e, 0x8d, 0xe8, 0xe3, 0x11,
-	0x16, 0x73, 0x08, 0x45, 0x4f, 0x40, 0xe3, 0xc2, 0xd3, 0xa2, 0xa9, 0xd1, 0x6c, 0x10, 0x37, 0x74,
-	0x93, 0xba, 0xd0, 0xdd, 0x10, 0x0a, 0x0c, 0x2a, 0xa5, 0x9f, 0x3e, 0x6a, 0x10, 0x57, 0x48, 0x41,
-	0xef, 0xc2, 0x7c, 0xc8, 0x1f, 0x86, 0x75, 0x23, 0x88, 0xb9, 0x03, 0xd3, 0xc3, 0xea, 0x62, 0x77,
-	0x71, 0x3d, 0x1b, 0x22, 0xf0, 0x78, 0xde, 0xc6, 0xde, 0x4e, 0x60, 0x8e, 0xdc, 0x78, 0x6a, 0xeb,
-	0xe4, 0xd0, 0x74, 0x12, 0x19, 0x7e, 0x89, 0x21, 0xbf, 0x9a, 0x46, 0x4e, 0xa4, 0x76, 0x41, 0x22,
-	0x54, 0xee, 0xf0, 0x09, 0x66, 0x20, 0xcc, 0x67, 0xd3, 0x34, 0xf1, 0x26, 0x4a, 0xf9, 0x1f, 0xc8,
-	0xf8, 0xcc, 0x40, 0xa7, 0x7a, 0x96, 0xf1, 0x3d, 0x9f, 0xe6, 0x13, 0x43, 0x68, 0x5f, 0x58, 0xda,
-	0xf0, 0x59, 0x4f, 0xf4, 0x89, 0x02, 0xc5, 0xc8, 0x59, 0x59, 0x23, 0x2b, 0x30, 0xa6, 0xb7, 0x3a,
-	0x8d, 0x2c, 0x23, 0x26, 0x70, 0xdb, 0x50, 0x97, 0x2c, 0x49, 0x4b, 0x34, 0xe4, 0xa7, 0xb9, 0x8a,
-	0xf8, 0xd8, 0x97, 0x65, 0xf9, 0xbe, 0x95, 0x5f, 0xe2, 0x84, 0x2c, 0x66, 0xee, 0x8c, 0xdb, 0x00,
-	0x51, 0xf6, 0xa5, 0x6a, 0x91, 0x71, 0x5c, 0xcc, 0x8f, 0xc6, 0x30, 0xc3, 0x26, 0x0c, 0x51, 0x19,
-	0x86, 0xc3, 0x54, 0x47, 0xd5, 0x73, 0x0c, 0xe5, 0x42, 0x1e, 0x4a, 0x94, 0x3a, 0x63, 0x33, 0xf4,
-	0x26, 0x9c, 0x0e, 0x37, 0x79, 0x9a, 0x6c, 0xe7, 0xb0, 0x65, 0xef, 0x6d, 0xaf, 0x35, 0xee, 0xe1,
-	0x67, 0x1b, 0xae, 0xf5, 0xa0, 0x56, 0xab, 0x1e, 0x98, 0xb6, 0x7b, 0x9f, 0x58, 0x58, 0x0f, 0xcd,
-	0x50, 0x19, 0x86, 0xa2, 0x2d, 0xde, 0xf9, 0x63, 0x41, 0x44, 0x76, 0xe8, 0x0e, 0x4c, 0xf2, 0x34,
-	0x58, 0xc3, 0xc1, 0x2c, 0x1c, 0x62, 0xb7, 0x89, 0xd5, 0x0b, 0x9d, 0x93, 0xe1, 0x38, 0xb3, 0xda,
-	0xc4, 0x58, 0xe7, 0x36, 0xe8, 0x01, 0xcc, 0x44, 0xcb, 0x80, 0xd8, 0xc2, 0xb2, 0x09, 0x51, 0x2f,
-	0x76, 0x4e, 0xfe, 0x53, 0xa1, 0x25, 0x7b, 0xfd, 0x98, 0xd9, 0xa1, 0x87, 0x70, 0xc6, 0x74, 0x1c,
-	0x23, 0x31, 0x5d, 0x97, 0x18, 0xd2, 0x0b, 0x9d, 0x36, 0x58, 0xd1, 0x94, 0x51, 0x7d, 0xcc, 0x74,
-	0x9c, 0xf8, 0x11, 0xdd, 0x87, 0xe0, 0x85, 0x11, 0x4f, 0xdd, 0xf3, 0x0c, 0xf1, 0x72, 0x27, 0xc4,
-	0x70, 0xfa, 0xa8, 0x3e, 0x6a, 0x3a, 0x4e, 0xf4, 0x14, 0xa4, 0xd4, 0x00, 0xcf, 0x21, 0x94, 0x1a,
-	0x7b, 0x4d, 0xd7, 0x72, 0x30, 0x55, 0x57, 0x18, 0xe4, 0x7a, 0x27, 0x48, 0x11, 0xc0, 0x6f, 0x9b,
-	0x4e, 0x13, 0x97, 0xb9, 0xa9, 0x1e, 0x8c, 0x77, 0x8b, 0x50, 0x2a, 0x9e, 0xd1, 0x07, 0x30, 0xed,
-	0x62, 0x3f, 0x98, 0xed, 0x34, 0xc5, 0x65, 0x46, 0x71, 0xa5, 0x13, 0x45, 0x0a, 0x1b, 0x09, 0xa4,
-	0x24, 0xfe, 0x2f, 0x15, 0xb8, 0x1a, 0xcd, 0x59, 0x03, 0x7b, 0x55, 0xec, 0xfa, 0xe6, 0x7e, 0x94,
-	0x51, 0x7d, 0xc2, 0x3f, 0x4e, 0x2b, 0x3e, 0x48, 0xbc, 0xc0, 0x16, 0xde, 0x57, 0xc5, 0xc2, 0xbb,
-	0xba, 0x6f, 0xfb, 0x07, 0xcd, 0xbd, 0x52, 0x95, 0xd4, 0x57, 0x4d, 0xc7, 0x21, 0x9e, 0x79, 0x55,
-	0xb0, 0x84, 0x8f, 0x2c, 0xe2, 0xf8, 0x92, 0x1c, 0xcc, 0xf3, 0x4a, 0xc8, 0xb6, 0x1d, 0x91, 0xf1,
-	0xe4, 0xbb, 0x4b, 0xd8, 0xd7, 0x6a, 0x45, 0xa7, 0x8f, 0x5d, 0x98, 0x22, 0x0d, 0xec, 0x8a, 0x63,
-	0x90, 0x71, 0x64, 0xbb, 0x16, 0x39, 0xa2, 0xea, 0xab, 0xb2, 0x4f, 0x2d, 0x31, 0xe4, 0xf0, 0x84,
-	0x56, 0xb1, 0xa8, 0x3e, 0x19, 0x00, 0xf0, 0x33, 0xd1, 0x63, 0x6e, 0x1e, 0x2c, 0x1b, 0x4d, 0xb7,
-	0xd6, 0x74, 0x6a, 0xb6, 0xe3, 0x60, 0x2b, 0x04, 0x67, 0x27, 0x1b, 0xaa, 0xbe, 0xc8, 0xb0, 0x0b,
-	0x59, 0x71, 0xca, 0x8e, 0x30, 0x54, 0x9f, 0x4b, 0x00, 0x70, 0x70, 0xde, 0x80, 0x3e, 0x84, 0x85,
-	0x24, 0x76, 0xb8, 0x2f, 0x10, 0xe0, 0x57, 0x72, 0xa6, 0x6c, 0xc3, 0xb5, 0xa2, 0x3d, 0xe3, 0x47,
-	0x4d, 0x4c, 0x7d, 0x41, 0xa5, 0x26, 0xf0, 0x44, 0x07, 0xc1, 0x75, 0x0f, 0xc6, 0x9d, 0x60, 0x47,
-	0x67, 0x79, 0x76, 0x43, 0x24, 0xf0, 0x1b, 0xdd, 0x1f, 0xcc, 0xc6, 0x02, 0xdb, 0x5b, 0x9e, 0xdd,
-	0xe0, 0xc9, 0xf8, 0x23, 0x28, 0x38, 0xa6, 0x8f, 0xa9, 0x6f, 0x84, 0x27, 0x8f, 0x30, 0xe8, 0x3c,
-	0xbc, 0xef, 0x61, 0x9f, 0xaa, 0x57, 0xf3, 0xc4, 0x8b, 0xd5, 0xc8, 0xae, 0x07, 0x09, 0xb7, 0xde,
-	0xc0, 0x16, 0x0b, 0x3b, 0x7d, 0x91, 0x63, 0xf2, 0x8f, 0xcf, 0xbb, 0xcf, 0x11, 0x75, 0x0e, 0x88,
-	0x8e, 0xe0, 0x9c, 0xa0, 0x4c, 0x6c, 0x18, 0x5b, 0x59, 0x4b, 0x3d, 0xb0, 0x8a, 0x91, 0xc4, 0x7b,
-	0xc8, 0x16, 0xe2, 0x9f, 0x28, 0xb0, 0x22, 0x98, 0x89, 0x8b, 0x0d, 0xdb, 0xcd, 0x13, 0xb0, 0x9a,
-	0xf3, 0x25, 0x0b, 0x01, 0x59, 0x3a, 0xce, 0x73, 0x92, 0x07, 0x2e, 0xae, 0xb8, 0x99, 0x62, 0xbe,
-	0x0f, 0x17, 0x84, 0x16, 0xd7, 0xb4, 0x0f, 0x71, 0xa6, 0xfb, 0x5f, 0xea, 0xc1, 0x11, 0x45, 0x8e,
-	0x7c, 0x3f, 0x00, 0x96, 0xcf, 0xc1, 0xa7, 0x0a, 0x94, 0x12, 0xae, 0x20, 0xcd, 0x78, 0xfe, 0xb3,
-	0x84, 0x5c, 0xeb, 0xdd, 0x21, 0x2b, 0x91, 0x43, 0x1e, 0x34, 0xc3, 0xa0, 0x90, 0xeb, 0xfa, 0x8d,
-	0x02, 0xd7, 0x32, 0x74, 0xe5, 0xcc, 0xd5, 0x5a, 0xef, 0xd2, 0x5e, 0x94, 0x48, 0xcb, 0x9c, 0x33,
-	0x89, 0xba, 0x84, 0xaa, 0x2c, 0xc7, 0xad, 0x7f, 0x4d, 0xea, 0x62, 0x59, 0x72, 0xdf, 0xfd, 0x4e,
-	0x81, 0x97, 0xb3, 0xd5, 0xe5, 0xb8, 0xef, 0xe5, 0xde, 0x05, 0x96, 0xe4, 0x02, 0x33, 0x3d, 0x58,
-	0x82, 0x29, 0x76, 0x32, 0xf4, 0xb1, 0x59, 0x37, 0x4c, 0xcb, 0xf2, 0x30, 0xa5, 0x98, 0xaa, 0x7d,
-	0xc5, 0xfe, 0x95, 0x61, 0x7d, 0x32, 0x68, 0xda, 0xc5, 0x66, 0x7d, 0x23, 0x6c, 0x40, 0x55, 0x50,
-	0xf9, 0x76, 0x82, 0x65, 0x3c, 0x91, 0xb2, 0xab, 0xa4, 0x5e, 0xb7, 0x7d, 0xf5, 0x7a, 0xce, 0x86,
-	0x20, 0x10, 0x4a, 0xb9, 0x50, 0x26, 0x9d, 0xa5, 0x4e, 0x7d, 0x86, 0x61, 0x6d, 0x99, 0xd4, 0xe7,
-	0xc9, 0xfb, 0x26, 0x03, 0x42, 0x18, 0xe6, 0x13, 0x24, 0x61, 0xee, 0x16, 0x2c, 0xaf, 0x1c, 0x9b,
-	0x65, 0x36, 0x62, 0x11, 0x79, 0x5b, 0xd0, 0xf8, 0x70, 0x91, 0xd3, 0xf8, 0xc4, 0x60, 0x77, 0x92,
-	0x0d, 0x42, 0xa9, 0xbd, 0xe7, 0x60, 0xa3, 0x7a, 0xd0, 0xf4, 0xd8, 0x95, 0x07, 0xcf, 0xe6, 0xdf,
-	0xe8, 0x3e, 0x9b, 0x17, 0x19, 0xe2, 0x2e, 0xb9, 0x8f, 0x9f, 0xfa, 0xdb, 0x02, 0xee, 0xa6, 0x40,
-	0xe3, 0x09, 0xfe, 0x6d, 0x98, 0x65, 0xa8, 0x01, 0x6b, 0xfa, 0xba, 0xf2, 0x35, 0xd9, 0x7d, 0x4d,
-	0xfb, 0xae, 0x9f, 0xea, 0x53, 0x0c, 0x60, 0x97, 0x6c, 0x24, 0x2f, 0x36, 0x8f, 0xe0, 0x7c, 0x84,
-	0xeb, 0x90, 0xa3, 0x20, 0xea, 0x92, 0xf0, 0xe1, 0xfe, 0xef, 0x9b, 0x32, 0xf7, 0xb5, 0x93, 0xf0,
-	0x5d, 0xdf, 0xb6, 0x69, 0x7b, 0x7a, 0x41, 0xb0, 0x6d, 0x31, 0xd0, 0x04, 0xa7, 0xd8, 0x19, 0xfa,
-	0x70, 0xb9, 0x65, 0xab, 0xf9, 0x51, 0xd3, 0x74, 0x7d, 0xdb, 0x89, 0x73, 0x68, 0x74, 0x29, 0xa5,
-	0xbe, 0xd1, 0x79, 0xfb, 0x79, 0x21, 0xb5, 0xfd, 0x7c, 0x28, 0xb0, 0x2a, 0xe9, 0xab, 0x34, 0xf4,
-	0x5d, 0xb8, 0x92, 0xc5, 0x2a, 0xbb, 0x61, 0x53, 0xdf, 0xec, 0x4c, 0xfc, 0xbc, 0x94, 0x78, 0xb3,
-	0xed, 0xbe, 0x2d, 0x6f, 0xc4, 0x6d, 0xd7, 0x70, 0xea, 0x46, 0xaf, 0x23, 0xd6, 0xd3, 0x97, 0x72,
-	0xe8, 0x87, 0x0a, 0x5c, 0xa9, 0x92, 0xa6, 0x9b, 0x5c, 0x19, 0xaa, 0x4e, 0x93, 0x61, 0x06, 0x6b,
-	0x27, 0x57, 0x22, 0xe6, 0x9c, 0x62, 0x5f, 0x2d, 0x33, 0x66, 0x2d, 0x2f, 0x8d, 0x3c, 0xb2, 0x5d,
-	0xff, 0x95, 0x97, 0xf5, 0x4b, 0x0c, 0x37, 0x5a, 0x08, 0x42, 0xd4, 0x8a, 0xcb, 0x6f, 0xa5, 0x19,
-	0xe4, 0x0e, 0xf6, 0xd1, 0xc7, 0x0a, 0xac, 0x72, 0x09, 0xa9, 0x34, 0x9b, 0xab, 0xe2, 0x66, 0xd7,
-	0x2a, 0x2e, 0x33, 0xe8, 0x64, 0x56, 0xcd, 0x14, 0xb2, 0x09, 0xe3, 0x82, 0x42, 0xf8, 0x82, 0xaa,
-	0xb7, 0xba, 0xb9, 0x55, 0x3f, 0xc3, 0xad, 0xc4, 0x48, 0x29, 0xda, 0x02, 0x24, 0x70, 0xe2, 0x01,
-	0x51, 0xf5, 0x76, 0x37, 0x50, 0x93, 0xdc, 0x30, 0x96, 0x1b, 0x6c, 0x68, 0x55, 0xf1, 0xe5, 0xb5,
-	0x07, 0xfe, 0x66, 0xb7, 0x97, 0xb1, 0x33, 0x1c, 0xa2, 0x35, 0xde, 0xbf, 0x03, 0x8b, 0x02, 0x5b,
-	0x1a, 0xde, 0x77, 0xba, 0x85, 0x17, 0x0a, 0x25, 0x51, 0x1d, 0xfb, 0x34, 0x3a, 0x60, 0xbc, 0x75,
-	0x0c, 0x9f, 0x46, 0x87, 0x85, 0xd8, 0x0b, 0xed, 0x1f, 0x43, 0xe5, 0x98, 0x5e, 0x68, 0xfd, 0x06,
-	0x1e, 0xc2, 0x68, 0xea, 0xec, 0x75, 0x97, 0xe1, 0x95, 0xa4, 0x78, 0xd1, 0x2e, 0xbe, 0xf5, 0x68,
-	0xa7, 0x8f, 0x38, 0x89, 0x63, 0xd7, 0x0f, 0xa0, 0x28, 0x2e, 0x33, 0x9b, 0x75, 0x43, 0x7a, 0x66,
-	0xa6, 0xea, 0xbd, 0x93, 0x1d, 0xb4, 0x96, 0xf8, 0x45, 0x67, 0xb3, 0xbe, 0xdd, 0x7e, 0xb0, 0xa6,
-	0x88, 0xc2, 0x59, 0x71, 0xc4, 0xab, 0x36, 0x3d, 0x0f, 0xbb, 0x3e, 0x5f, 0x75, 0x8c, 0x70, 0x4c,
-	0xea, 0x56, 0x8f, 0xf7, 0xab, 0x0b, 0x1c, 0xf6, 0x26, 0x47, 0x65, 0x39, 0xfe, 0xb6, 0xc0, 0xbc,
-	0x3b, 0x30, 0x34, 0x36, 0x71, 0xe6, 0xee, 0xc0, 0xd0, 0x99, 0x89, 0xf1, 0xbb, 0x03, 0x43, 0xe3,
-	0x13, 0x13, 0x2d, 0x47, 0x84, 0xb0, 0x0e, 0xc2, 0xd7, 0xe5, 0xf6, 0x9d, 0x7c, 0x4b, 0xfb, 0x12,
-	0x6f, 0xd7, 0xd3, 0x45, 0x14, 0xf1, 0xa8, 0x3d, 0x86, 0xf1, 0x96, 0x9a, 0x1d, 0x9a, 0x87, 0xa1,
-	0xa8, 0x02, 0xa8, 0xb0, 0x0a, 0xe0, 0x69, 0x5f, 0x54, 0xff, 0x2e, 0xc3, 0x73, 0xec, 0x5f, 0xb5,
-	0x8f, 0x15, 0x15, 0xa7, 0x92, 0x93, 0xbb, 0xce, 0x27, 0x57, 0xe7, 0x3d, 0xb4, 0x3b, 0x02, 0x38,
-	0x8e, 0xc6, 0x3c, 0xe0, 0x79, 0x18, 0x62, 0x97, 0x59, 0x41, 0x53, 0x80, 0x3d, 0xac, 0x9f, 0x36,
-	0xb9, 0x95, 0xf6, 0x08, 0x66, 0xa4, 0xeb, 0x79, 0x1e, 0xdc, 0x39, 0x18, 0xe5, 0x13, 0x75, 0xc0,
-	0x57, 0xd6, 0x00, 0xb2, 0x5f, 0x1f, 0xd9, 0x8b, 0xad, 0xb5, 0x77, 0x60, 0x56, 0x7e, 0x1c, 0x6e,
-	0x33, 0x56, 0xda, 0x8c, 0xd1, 0x22, 0x0c, 0x87, 0xd4, 0x7c, 0x43, 0x36, 0xa0, 0x0f, 0x09, 0x6e,
-	0xaa, 0x7d, 0xac, 0x80, 0x9a, 0x55, 0xcc, 0x3a, 0x99, 0x68, 0x74, 0x05, 0x06, 0x79, 0x2d, 0x8d,
-	0x95, 0x66, 0x5b, 0xaa, 0xba, 0xeb, 0x25, 0x51, 0x30, 0x13, 0x7d, 0xb4, 0x43, 0x98, 0x92, 0x7c,
-	0xbf, 0xbd, 0x4d, 0x43, 0x30, 0xf5, 0x0c, 0x56, 0x30, 0x4f, 0x49, 0x98, 0x75, 0xde, 0x43, 0xb3,
-	0x60, 0x5a, 0xb6, 0x88, 0xf4, 0x48, 0x3c, 0x0b, 0x83, 0x4d, 0x66, 0x2f, 0xca, 0xd1, 0xe2, 0x49,
-	0xfb, 0xb3, 0x02, 0x5a, 0xe7, 0xaa, 0x56, 0x8f, 0xa4, 0x8f, 0x61, 0x46, 0x5e, 0x5c, 0xe3, 0xa3,
-	0xd7, 0xd2, 0xa3, 0x97, 0x96, 0xd3, 0xa6, 0x1d, 0xc9, 0x5b, 0xed, 0xe7, 0x0a, 0x4c, 0xb6, 0xdd,
-	0xb0, 0xf7, 0x28, 0xb2, 0x02, 0xfd, 0x16, 0xae, 0x32, 0x49, 0x27, 0xc8, 0x80, 0x01, 0x86, 0xe6,
-	0xc2, 0x58, 0xea, 0xd6, 0x32, 0x4f, 0x51, 0x19, 0xfa, 0x6d, 0x97, 0x87, 0x67, 0x2f, 0xa9, 0x2f,
-	0x30, 0xd6, 0x7e, 0xda, 0xe6, 0x86, 0x4a, 0xcf, 0x73, 0x25, 0xf4, 0xf4, 0x9f, 0x44, 0xcf, 0xdf,
-	0x15, 0xb8, 0xd0, 0x4d, 0x35, 0x27, 0x4f, 0xe2, 0x12, 0x0c, 0xc7, 0x15, 0x28, 0xae, 0x31, 0x7e,
-	0x81, 0x54, 0x38, 0x1d, 0x96, 0x76, 0xfa, 0xb9, 0x7e, 0xf1, 0x88, 0xca, 0x70, 0x26, 0xea, 0x16,
-	0xec, 0x4e, 0x88, 0x3a, 0xc0, 0x82, 0x6c, 0x31, 0x1d, 0x64, 0xe9, 0xaa, 0xd2, 0x98, 0x95, 0x7c,
-	0xd4, 0xfe, 0xa9, 0xc0, 0xe5, 0xae, 0xcb, 0x2b, 0xdd, 0x64, 0xb8, 0xe4, 0x38, 0xfb, 0xd2, 0xe3,
-	0xcc, 0x1e, 0xc9, 0x16, 0xa0, 0x54, 0x91, 0x22, 0x39, 0x9a, 0x42, 0x4b, 0xc2, 0x68, 0xad, 0x83,
-	0x4c, 0xd0, 0x96, 0x37, 0x9a, 0x0f, 0xf3, 0x99, 0x15, 0x9c, 0x54, 0x3c, 0x28, 0xe9, 0x78, 0xc8,
-	0x91, 0xde, 0x3a, 0xf0, 0xfe, 0xf6, 0x75, 0xe1, 0x57, 0x7d, 0xf0, 0x5a, 0xef, 0xe5, 0x9c, 0x13,
-	0xba, 0x36, 0x15, 0x42, 0xfd, 0x39, 0x21, 0x34, 0x90, 0x76, 0x3c, 0x86, 0x45, 0x79, 0x99, 0x88,
-	0xcf, 0xc0, 0x73, 0x6c, 0x06, 0x2e, 0x49, 0xe3, 0xa9, 0xbd, 0x22, 0xa5, 0x5a, 0x19, 0x2d, 0xda,
-	0xaf, 0x15, 0xd0, 0x3a, 0x17, 0x96, 0xd2, 0xa3, 0x50, 0x72, 0x46, 0xd1, 0x97, 0x1e, 0x45, 0xd2,
-	0x31, 0xfd, 0xf9, 0x13, 0x37, 0xd0, 0x3e, 0x71, 0x1f, 0x2b, 0x30, 0x97, 0x51, 0x91, 0xea, 0x31,
-	0xb1, 0x5c, 0x87, 0xe1, 0xa8, 0xa6, 0x22, 0x12, 0xff, 0x5c, 0xda, 0x87, 0x71, 0xcd, 0x2b, 0xee,
-	0xa9, 0xfd, 0x48, 0x81, 0x59, 0x79, 0x51, 0xab, 0x47, 0x1d, 0x6b, 0x30, 0x14, 0x1e, 0x2c, 0x84,
-	0x8c, 0xd9, 0xb4, 0x8c, 0xa8, 0x68, 0x16, 0xf5, 0xd3, 0xbe, 0x07, 0x6a, 0x56, 0x4d, 0x0b, 0x15,
-	0x60, 0xc4, 0xb1, 0xf7, 0x8c, 0xc6, 0x5a, 0xc3, 0x78, 0x82, 0x9f, 0x85, 0x33, 0xe4, 0x84, 0xdd,
-	0xd1, 0x1b, 0x30, 0x46, 0x44, 0x7f, 0xc3, 0x25, 0x16, 0x16, 0xbb, 0xbd, 0x85, 0x34, 0x69, 0xaa,
-	0x4c, 0x36, 0x4a, 0x12, 0x4f, 0x5a, 0x33, 0xb9, 0x9a, 0x74, 0x58, 0xdf, 0xc4, 0x22, 0xd6, 0xf7,
-	0x35, 0x2c, 0x62, 0xbf, 0x50, 0x60, 0x29, 0xaf, 0xc8, 0x75, 0xc2, 0xcd, 0xd7, 0x8d, 0x54, 0x45,
-	0x94, 0x4f, 0x84, 0x9a, 0x11, 0x0f, 0x34, 0x59, 0x04, 0xd5, 0x7e, 0xa6, 0xc0, 0x62, 0x4e, 0xad,
-	0xec, 0x84, 0xba, 0xae, 0x27, 0x4b, 0xac, 0xd2, 0x30, 0x8d, 0xab, 0x72, 0x71, 0x4f, 0xed, 0x6f,
-	0x0a, 0x5c, 0xec, 0xaa, 0xda, 0x76, 0x42, 0x79, 0x8f, 0x60, 0x26, 0x3c, 0x6c, 0x1e, 0x06, 0xa8,
-	0xd1, 0x01, 0x91, 0x4b, 0x3d, 0x97, 0x96, 0x2a, 0xab, 0xf6, 0x4d, 0x79, 0xed, 0x2f, 0xb5, 0xdf,
-	0x2a, 0x50, 0xc8, 0xaf, 0xe4, 0x9d, 0x50, 0xf7, 0xeb, 0x30, 0x9a, 0xd4, 0x2b, 0xe4, 0xce, 0xa7,
-	0xe5, 0xa6, 0x8e, 0xae, 0x87, 0xf1, 0x83, 0xf6, 0x1e, 0x4c, 0xb4, 0x56, 0xc4, 0xf2, 0xf4, 0x5c,
-	0x81, 0x41, 0x51, 0xff, 0xea, 0x93, 0x6d, 0xec, 0x45, 0x9d, 0x4b, 0xf4, 0xd1, 0x3e, 0x8d, 0xc7,
-	0x9e, 0x51, 0x12, 0xcb, 0xe3, 0x7a, 0x07, 0x66, 0xe3, 0x5f, 0x70, 0x31, 0x1b, 0x23, 0xc5, 0xad,
-	0x49, 0x67, 0x24, 0x5d, 0x71, 0x0b, 0x7f, 0x93, 0x97, 0x7a, 0xab, 0xfd, 0x3e, 0xa1, 0x4b, 0x7e,
-	0x09, 0xde, 0x63, 0x06, 0xbc, 0x07, 0x93, 0x7e, 0x7c, 0x7f, 0xcc, 0xe3, 0x48, 0x4c, 0x48, 0xcb,
-	0xbe, 0x22, 0x71, 0xcd, 0xcc, 0x6f, 0xdd, 0x27, 0xfc, 0x96, 0x37, 0xda, 0x3f, 0xe2, 0xc0, 0xcf,
-	0xbf, 0xb1, 0xcf, 0x13, 0xbb, 0x08, 0xc3, 0xa1, 0xd8, 0x6b, 0x42, 0xed, 0x90, 0x50, 0x7b, 0x2d,
-	0xd9, 0xb8, 0x26, 0x96, 0xf1, 0xb0, 0x71, 0x4d, 0x3e, 0x96, 0x81, 0x1e, 0xc7, 0x92, 0x48, 0x79,
-	0xd2, 0x0b, 0xf6, 0xbc, 0x21, 0xbc, 0x07, 0x73, 0x49, 0x21, 0x5c, 0x31, 0xff, 0xf1, 0x2a, 0x0f,
-	0x84, 0xf3, 0x99, 0x72, 0x52, 0x75, 0x02, 0xd9, 0x6b, 0xad, 0x0e, 0x53, 0x92, 0xeb, 0xf1, 0x6e,
-	0xb6, 0x47, 0xeb, 0xe9, 0xb3, 0xb5, 0x64, 0xb9, 0x8b, 0x2e, 0xdb, 0xe3, 0x33, 0xf7, 0x8f, 0x15,
-	0x58, 0xca, 0xbb, 0x29, 0xef, 0x86, 0xb8, 0x0c, 0xa3, 0xa9, 0xeb, 0x78, 0xce, 0xbd, 0x2c, 0xe5,
-	0x4e, 0xdc, 0xc1, 0xf3, 0x1f, 0xc7, 0xf1, 0x17, 0xda, 0x1f, 0x15, 0x28, 0x76, 0xba, 0xe3, 0xca,
-	0x9b, 0x93, 0xec, 0xcd, 0x91, 0x0e, 0xd3, 0xb2, 0x34, 0x2a, 0xbe, 0x82, 0x62, 0xa7, 0x2c, 0xaa,
-	0xa3, 0xf6, 0x24, 0x5a, 0xd6, 0x3f, 0xfb, 0xb2, 0xa0, 0x7c, 0xfe, 0x65, 0x41, 0xf9, 0xef, 0x97,
-	0x05, 0xe5, 0x93, 0xaf, 0x0a, 0xa7, 0x3e, 0xff, 0xaa, 0x70, 0xea, 0xdf, 0x5f, 0x15, 0x4e, 0xbd,
-	0x7b, 0xa3, 0xcb, 0x05, 0xf8, 0xe9, 0x6a, 0xfc, 0x4b, 0x75, 0xf6, 0xdb, 0xf6, 0xbd, 0x41, 0xf6,
-	0x83, 0xf4, 0xf5, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x19, 0x81, 0xa1, 0xa8, 0xed, 0x2f, 0x00,
-	0x00,
+func (m *TopicIdAndReputerRequestNonces) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TopicIdAndReputerRequestNonces) GetReputerRequestNonces() *ReputerRequestNonces {
+	if m != nil {
+		return m.ReputerRequestNonces
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type TopicIdActorIdTimeStampedValue struct {
+	TopicId          uint64            `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	ActorId          string            `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	TimestampedValue *TimestampedValue `protobuf:"bytes,3,opt,name=timestamped_value,json=timestampedValue,proto3" json:"timestamped_value,omitempty"`
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.RewardCurrentBlockEmission.Size()
-		i -= size
-		if _, err := m.RewardCurrentBlockEmission.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4
-	i--
-	dAtA[i] = 0xe2
-	{
-		size := m.TotalSumPreviousTopicWeights.Size()
-		i -= size
-		if _, err := m.TotalSumPreviousTopicWeights.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *TopicIdActorIdTimeStampedValue) Reset()         { *m = TopicIdActorIdTimeStampedValue{} }
+func (m *TopicIdActorIdTimeStampedValue) String() string { return proto.CompactTextString(m) }
+func (*TopicIdActorIdTimeStampedValue) ProtoMessage()    {}
+func (*TopicIdActorIdTimeStampedValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{33}
+}
+func (m *TopicIdActorIdTimeStampedValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicIdActorIdTimeStampedValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicIdActorIdTimeStampedValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x4
-	i--
-	dAtA[i] = 0xda
-	if len(m.LossBundles) > 0 {
-		for iNdEx := len(m.LossBundles) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0xd2
-		}
+}
+func (m *TopicIdActorIdTimeStampedValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdActorIdTimeStampedValue.Merge(m, src)
+}
+func (m *TopicIdActorIdTimeStampedValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicIdActorIdTimeStampedValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdActorIdTimeStampedValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicIdActorIdTimeStampedValue proto.InternalMessageInfo
+
+func (m *TopicIdActorIdTimeStampedValue) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if len(m.LowestReputerScoreEma) > 0 {
-		for iNdEx := len(m.LowestReputerScoreEma) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LowestReputerScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0xca
-		}
+	return 0
+}
+
+func (m *TopicIdActorIdTimeStampedValue) GetActorId() string {
+	if m != nil {
+		return m.ActorId
 	}
-	if len(m.ActiveReputers) > 0 {
-		for iNdEx := len(m.ActiveReputers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ActiveReputers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0xc2
-		}
+	return ""
+}
+
+func (m *TopicIdActorIdTimeStampedValue) GetTimestampedValue() *TimestampedValue {
+	if m != nil {
+		return m.TimestampedValue
 	}
-	if len(m.LowestForecasterScoreEma) > 0 {
-		for iNdEx := len(m.LowestForecasterScoreEma) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LowestForecasterScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0xba
+	return nil
+}
+
+type TopicIdActorIdActorIdTimeStampedValue struct {
+	TopicId          uint64            `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	ActorId1         string            `protobuf:"bytes,2,opt,name=actor_id1,json=actorId1,proto3" json:"actor_id1,omitempty"`
+	ActorId2         string            `protobuf:"bytes,3,opt,name=actor_id2,json=actorId2,proto3" json:"actor_id2,omitempty"`
+	TimestampedValue *TimestampedValue `protobuf:"bytes,4,opt,name=timestamped_value,json=timestampedValue,proto3" json:"timestamped_value,omitempty"`
+}
+
+func (m *TopicIdActorIdActorIdTimeStampedValue) Reset()         { *m = TopicIdActorIdActorIdTimeStampedValue{} }
+func (m *TopicIdActorIdActorIdTimeStampedValue) String() string { return proto.CompactTextString(m) }
+func (*TopicIdActorIdActorIdTimeStampedValue) ProtoMessage()    {}
+func (*TopicIdActorIdActorIdTimeStampedValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{34}
+}
+func (m *TopicIdActorIdActorIdTimeStampedValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicIdActorIdActorIdTimeStampedValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicIdActorIdActorIdTimeStampedValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.LowestInfererScoreEma) > 0 {
-		for iNdEx := len(m.LowestInfererScoreEma) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LowestInfererScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0xb2
-		}
+}
+func (m *TopicIdActorIdActorIdTimeStampedValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdActorIdActorIdTimeStampedValue.Merge(m, src)
+}
+func (m *TopicIdActorIdActorIdTimeStampedValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicIdActorIdActorIdTimeStampedValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdActorIdActorIdTimeStampedValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicIdActorIdActorIdTimeStampedValue proto.InternalMessageInfo
+
+func (m *TopicIdActorIdActorIdTimeStampedValue) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if len(m.ActiveForecasters) > 0 {
-		for iNdEx := len(m.ActiveForecasters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ActiveForecasters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0xaa
-		}
+	return 0
+}
+
+func (m *TopicIdActorIdActorIdTimeStampedValue) GetActorId1() string {
+	if m != nil {
+		return m.ActorId1
 	}
-	if len(m.ActiveInferers) > 0 {
-		for iNdEx := len(m.ActiveInferers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ActiveInferers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0xa2
-		}
+	return ""
+}
+
+func (m *TopicIdActorIdActorIdTimeStampedValue) GetActorId2() string {
+	if m != nil {
+		return m.ActorId2
 	}
-	if len(m.CountForecasterInclusionsInTopicActiveSet) > 0 {
-		for iNdEx := len(m.CountForecasterInclusionsInTopicActiveSet) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CountForecasterInclusionsInTopicActiveSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0x9a
-		}
+	return ""
+}
+
+func (m *TopicIdActorIdActorIdTimeStampedValue) GetTimestampedValue() *TimestampedValue {
+	if m != nil {
+		return m.TimestampedValue
 	}
-	if len(m.CountInfererInclusionsInTopicActiveSet) > 0 {
-		for iNdEx := len(m.CountInfererInclusionsInTopicActiveSet) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CountInfererInclusionsInTopicActiveSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0x92
+	return nil
+}
+
+type TopicIdTimestampedActorNonce struct {
+	TopicId               uint64                 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	TimestampedActorNonce *TimestampedActorNonce `protobuf:"bytes,2,opt,name=timestamped_actor_nonce,json=timestampedActorNonce,proto3" json:"timestamped_actor_nonce,omitempty"`
+}
+
+func (m *TopicIdTimestampedActorNonce) Reset()         { *m = TopicIdTimestampedActorNonce{} }
+func (m *TopicIdTimestampedActorNonce) String() string { return proto.CompactTextString(m) }
+func (*TopicIdTimestampedActorNonce) ProtoMessage()    {}
+func (*TopicIdTimestampedActorNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{35}
+}
+func (m *TopicIdTimestampedActorNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicIdTimestampedActorNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicIdTimestampedActorNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.PreviousTopicQuantileReputerScoreEma) > 0 {
-		for iNdEx := len(m.PreviousTopicQuantileReputerScoreEma) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PreviousTopicQuantileReputerScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0x8a
-		}
+}
+func (m *TopicIdTimestampedActorNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdTimestampedActorNonce.Merge(m, src)
+}
+func (m *TopicIdTimestampedActorNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicIdTimestampedActorNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdTimestampedActorNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicIdTimestampedActorNonce proto.InternalMessageInfo
+
+func (m *TopicIdTimestampedActorNonce) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if len(m.PreviousTopicQuantileForecasterScoreEma) > 0 {
-		for iNdEx := len(m.PreviousTopicQuantileForecasterScoreEma) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PreviousTopicQuantileForecasterScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0x82
-		}
+	return 0
+}
+
+func (m *TopicIdTimestampedActorNonce) GetTimestampedActorNonce() *TimestampedActorNonce {
+	if m != nil {
+		return m.TimestampedActorNonce
 	}
-	if len(m.PreviousTopicQuantileInfererScoreEma) > 0 {
-		for iNdEx := len(m.PreviousTopicQuantileInfererScoreEma) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PreviousTopicQuantileInfererScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xfa
+	return nil
+}
+
+type BlockHeightTopicIds struct {
+	BlockHeight int64     `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	TopicIds    *TopicIds `protobuf:"bytes,2,opt,name=topic_ids,json=topicIds,proto3" json:"topic_ids,omitempty"`
+}
+
+func (m *BlockHeightTopicIds) Reset()         { *m = BlockHeightTopicIds{} }
+func (m *BlockHeightTopicIds) String() string { return proto.CompactTextString(m) }
+func (*BlockHeightTopicIds) ProtoMessage()    {}
+func (*BlockHeightTopicIds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{36}
+}
+func (m *BlockHeightTopicIds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockHeightTopicIds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockHeightTopicIds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ReputerScoreEmas) > 0 {
-		for iNdEx := len(m.ReputerScoreEmas) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReputerScoreEmas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xf2
-		}
+}
+func (m *BlockHeightTopicIds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockHeightTopicIds.Merge(m, src)
+}
+func (m *BlockHeightTopicIds) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockHeightTopicIds) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockHeightTopicIds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockHeightTopicIds proto.InternalMessageInfo
+
+func (m *BlockHeightTopicIds) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	if len(m.ForecasterScoreEmas) > 0 {
-		for iNdEx := len(m.ForecasterScoreEmas) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ForecasterScoreEmas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xea
+	return 0
+}
+
+func (m *BlockHeightTopicIds) GetTopicIds() *TopicIds {
+	if m != nil {
+		return m.TopicIds
+	}
+	return nil
+}
+
+type BlockHeightTopicIdWeightPair struct {
+	BlockHeight int64              `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	TopicWeight *TopicIdWeightPair `protobuf:"bytes,2,opt,name=topic_weight,json=topicWeight,proto3" json:"topic_weight,omitempty"`
+}
+
+func (m *BlockHeightTopicIdWeightPair) Reset()         { *m = BlockHeightTopicIdWeightPair{} }
+func (m *BlockHeightTopicIdWeightPair) String() string { return proto.CompactTextString(m) }
+func (*BlockHeightTopicIdWeightPair) ProtoMessage()    {}
+func (*BlockHeightTopicIdWeightPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{37}
+}
+func (m *BlockHeightTopicIdWeightPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockHeightTopicIdWeightPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockHeightTopicIdWeightPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.InfererScoreEmas) > 0 {
-		for iNdEx := len(m.InfererScoreEmas) - 1; iNdEx >= 0; iNdEx-- {
+}
+func (m *BlockHeightTopicIdWeightPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockHeightTopicIdWeightPair.Merge(m, src)
+}
+func (m *BlockHeightTopicIdWeightPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockHeightTopicIdWeightPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockHeightTopicIdWeightPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockHeightTopicIdWeightPair proto.InternalMessageInfo
+
+func (m *BlockHeightTopicIdWeightPair) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *BlockHeightTopicIdWeightPair) GetTopicWeight() *TopicIdWeightPair {
+	if m != nil {
+		return m.TopicWeight
+	}
+	return nil
+}
+
+type TopicIdReputerReputerValueBundle struct {
+	TopicId            uint64              `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reputer            string              `protobuf:"bytes,2,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	ReputerValueBundle *ReputerValueBundle `protobuf:"bytes,3,opt,name=reputer_value_bundle,json=reputerValueBundle,proto3" json:"reputer_value_bundle,omitempty"`
+}
+
+func (m *TopicIdReputerReputerValueBundle) Reset()         { *m = TopicIdReputerReputerValueBundle{} }
+func (m *TopicIdReputerReputerValueBundle) String() string { return proto.CompactTextString(m) }
+func (*TopicIdReputerReputerValueBundle) ProtoMessage()    {}
+func (*TopicIdReputerReputerValueBundle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{38}
+}
+func (m *TopicIdReputerReputerValueBundle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicIdReputerReputerValueBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicIdReputerReputerValueBundle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicIdReputerReputerValueBundle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdReputerReputerValueBundle.Merge(m, src)
+}
+func (m *TopicIdReputerReputerValueBundle) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicIdReputerReputerValueBundle) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdReputerReputerValueBundle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicIdReputerReputerValueBundle proto.InternalMessageInfo
+
+func (m *TopicIdReputerReputerValueBundle) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *TopicIdReputerReputerValueBundle) GetReputer() string {
+	if m != nil {
+		return m.Reputer
+	}
+	return ""
+}
+
+func (m *TopicIdReputerReputerValueBundle) GetReputerValueBundle() *ReputerValueBundle {
+	if m != nil {
+		return m.ReputerValueBundle
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "emissions.v5.GenesisState")
+	proto.RegisterType((*TopicIdAndTopic)(nil), "emissions.v5.TopicIdAndTopic")
+	proto.RegisterType((*TopicAndActorId)(nil), "emissions.v5.TopicAndActorId")
+	proto.RegisterType((*TopicIdAndBlockHeight)(nil), "emissions.v5.TopicIdAndBlockHeight")
+	proto.RegisterType((*BlockHeightAndTopicIds)(nil), "emissions.v5.BlockHeightAndTopicIds")
+	proto.RegisterType((*TopicIdBlockHeightScores)(nil), "emissions.v5.TopicIdBlockHeightScores")
+	proto.RegisterType((*TopicIdActorIdScore)(nil), "emissions.v5.TopicIdActorIdScore")
+	proto.RegisterType((*TopicIdActorIdUint64)(nil), "emissions.v5.TopicIdActorIdUint64")
+	proto.RegisterType((*TopicIdActorIdListeningCoefficient)(nil), "emissions.v5.TopicIdActorIdListeningCoefficient")
+	proto.RegisterType((*TopicIdActorIdReputerSlashingInfo)(nil), "emissions.v5.TopicIdActorIdReputerSlashingInfo")
+	proto.RegisterType((*TopicIdBlockHeightActorIdWorkerCommitment)(nil), "emissions.v5.TopicIdBlockHeightActorIdWorkerCommitment")
+	proto.RegisterType((*TopicIdSponsorActorSponsoredFees)(nil), "emissions.v5.TopicIdSponsorActorSponsoredFees")
+	proto.RegisterType((*TopicIdBlockHeightDec)(nil), "emissions.v5.TopicIdBlockHeightDec")
+	proto.RegisterType((*TopicIdActorIdDec)(nil), "emissions.v5.TopicIdActorIdDec")
+	proto.RegisterType((*TopicIdAndUint64)(nil), "emissions.v5.TopicIdAndUint64")
+	proto.RegisterType((*TopicIdAndInt)(nil), "emissions.v5.TopicIdAndInt")
+	proto.RegisterType((*TopicIdActorIdInt)(nil), "emissions.v5.TopicIdActorIdInt")
+	proto.RegisterType((*TopicIdDelegatorReputer)(nil), "emissions.v5.TopicIdDelegatorReputer")
+	proto.RegisterType((*TopicIdDelegatorReputerDelegatorInfo)(nil), "emissions.v5.TopicIdDelegatorReputerDelegatorInfo")
+	proto.RegisterType((*BlockHeightTopicIdReputerStakeRemovalInfo)(nil), "emissions.v5.BlockHeightTopicIdReputerStakeRemovalInfo")
+	proto.RegisterType((*ActorIdTopicIdBlockHeight)(nil), "emissions.v5.ActorIdTopicIdBlockHeight")
+	proto.RegisterType((*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo)(nil), "emissions.v5.BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo")
+	proto.RegisterType((*DelegatorReputerTopicIdBlockHeight)(nil), "emissions.v5.DelegatorReputerTopicIdBlockHeight")
+	proto.RegisterType((*TopicIdActorIdInference)(nil), "emissions.v5.TopicIdActorIdInference")
+	proto.RegisterType((*TopicIdActorIdForecast)(nil), "emissions.v5.TopicIdActorIdForecast")
+	proto.RegisterType((*LibP2PKeyAndOffchainNode)(nil), "emissions.v5.LibP2pKeyAndOffchainNode")
+	proto.RegisterType((*TopicIdAndDec)(nil), "emissions.v5.TopicIdAndDec")
+	proto.RegisterType((*TopicIdBlockHeightInferences)(nil), "emissions.v5.TopicIdBlockHeightInferences")
+	proto.RegisterType((*TopicIdBlockHeightForecasts)(nil), "emissions.v5.TopicIdBlockHeightForecasts")
+	proto.RegisterType((*TopicIdBlockHeightReputerValueBundles)(nil), "emissions.v5.TopicIdBlockHeightReputerValueBundles")
+	proto.RegisterType((*TopicIdBlockHeightValueBundles)(nil), "emissions.v5.TopicIdBlockHeightValueBundles")
+	proto.RegisterType((*TopicIdAndNonces)(nil), "emissions.v5.TopicIdAndNonces")
+	proto.RegisterType((*TopicIdAndReputerRequestNonces)(nil), "emissions.v5.TopicIdAndReputerRequestNonces")
+	proto.RegisterType((*TopicIdActorIdTimeStampedValue)(nil), "emissions.v5.TopicIdActorIdTimeStampedValue")
+	proto.RegisterType((*TopicIdActorIdActorIdTimeStampedValue)(nil), "emissions.v5.TopicIdActorIdActorIdTimeStampedValue")
+	proto.RegisterType((*TopicIdTimestampedActorNonce)(nil), "emissions.v5.TopicIdTimestampedActorNonce")
+	proto.RegisterType((*BlockHeightTopicIds)(nil), "emissions.v5.BlockHeightTopicIds")
+	proto.RegisterType((*BlockHeightTopicIdWeightPair)(nil), "emissions.v5.BlockHeightTopicIdWeightPair")
+	proto.RegisterType((*TopicIdReputerReputerValueBundle)(nil), "emissions.v5.TopicIdReputerReputerValueBundle")
+}
+
+func init() { proto.RegisterFile("emissions/v5/genesis.proto", fileDescriptor_a6c24e1b56b831d9) }
+
+var fileDescriptor_a6c24e1b56b831d9 = []byte{
+	// 3805 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5b, 0xcd, 0x6f, 0x1c, 0x47,
+	0x76, 0x77, 0x73, 0x68, 0x89, 0x7c, 0xfc, 0x2e, 0x7e, 0x15, 0x3f, 0x4c, 0x8e, 0x5a, 0xb2, 0x4d,
+	0xd9, 0xf2, 0x50, 0x22, 0xad, 0x95, 0xb3, 0x71, 0x76, 0x4d, 0x8a, 0xa2, 0x44, 0x89, 0x4b, 0x51,
+	0x4d, 0x52, 0x52, 0xe4, 0xc8, 0xed, 0xe6, 0x74, 0x91, 0xec, 0x55, 0x4f, 0xf7, 0xb8, 0xab, 0x9b,
+	0x14, 0x93, 0x00, 0x09, 0x02, 0xc4, 0x87, 0x00, 0x01, 0x16, 0x41, 0x36, 0xc8, 0x17, 0x82, 0x2c,
+	0x72, 0x09, 0x90, 0x1c, 0x02, 0x24, 0xc7, 0xe4, 0x96, 0xc3, 0xe6, 0xb6, 0xc9, 0x29, 0xc8, 0x61,
+	0x11, 0xd8, 0x87, 0xfc, 0x1b, 0x41, 0x7d, 0xf4, 0x77, 0x4f, 0xcf, 0x68, 0xe8, 0xbd, 0x10, 0xac,
+	0x8f, 0xf7, 0x7b, 0xaf, 0x5e, 0xbd, 0x7a, 0xf5, 0xea, 0xf5, 0x1b, 0x98, 0x25, 0x0d, 0x8b, 0x52,
+	0xcb, 0x75, 0xe8, 0xf2, 0xe9, 0xed, 0xe5, 0x63, 0xe2, 0x10, 0x6a, 0xd1, 0x5a, 0xd3, 0x73, 0x7d,
+	0x17, 0x0d, 0x46, 0x63, 0xb5, 0xd3, 0xdb, 0xb3, 0x63, 0x46, 0xc3, 0x72, 0xdc, 0x65, 0xfe, 0x57,
+	0x4c, 0x98, 0x9d, 0xa9, 0xbb, 0xb4, 0xe1, 0x52, 0x9d, 0xb7, 0x96, 0x45, 0x43, 0x0e, 0x4d, 0x27,
+	0x70, 0x57, 0x97, 0x1d, 0xd7, 0x24, 0x72, 0x00, 0x67, 0x06, 0x9c, 0x7a, 0x38, 0x32, 0x9b, 0x1a,
+	0xf1, 0x48, 0x33, 0xf0, 0x89, 0x57, 0x48, 0x45, 0xeb, 0xae, 0x57, 0x8c, 0x47, 0x7d, 0xe3, 0x55,
+	0xf1, 0x88, 0xef, 0x36, 0xad, 0x7a, 0xf1, 0xc8, 0x79, 0x93, 0x84, 0x62, 0xcf, 0xa4, 0x46, 0xce,
+	0x5c, 0xef, 0x15, 0xf1, 0x0a, 0x86, 0x6e, 0x2f, 0x37, 0x0d, 0xcf, 0x68, 0x84, 0x54, 0x13, 0xc7,
+	0xee, 0xb1, 0x2b, 0x94, 0xc0, 0xfe, 0x13, 0xbd, 0xea, 0x3f, 0x6e, 0xc2, 0xe0, 0x7d, 0xa1, 0xd0,
+	0x3d, 0xdf, 0xf0, 0x09, 0x5a, 0x81, 0x4b, 0x82, 0x0c, 0x2b, 0x55, 0x65, 0x69, 0x60, 0x65, 0xa2,
+	0x96, 0x54, 0x70, 0x6d, 0x97, 0x8f, 0xad, 0xf7, 0xfe, 0xfc, 0x97, 0x8b, 0x6f, 0x69, 0x72, 0x26,
+	0x52, 0x61, 0xc8, 0x21, 0xaf, 0x7d, 0x9d, 0x8b, 0xaf, 0x5b, 0x26, 0xae, 0x54, 0x95, 0xa5, 0x5e,
+	0x6d, 0x80, 0x75, 0xee, 0xb3, 0xbe, 0x2d, 0x13, 0xdd, 0x86, 0x4b, 0x7c, 0x98, 0xe2, 0xde, 0x6a,
+	0x65, 0x69, 0x60, 0xe5, 0x9d, 0x34, 0xae, 0x9c, 0xb6, 0xe6, 0x98, 0xfc, 0x3f, 0x4d, 0x4e, 0x46,
+	0x57, 0x61, 0xc8, 0xa8, 0xfb, 0xd6, 0x29, 0xd1, 0x25, 0xf5, 0xdb, 0xd5, 0xca, 0x52, 0xaf, 0x36,
+	0x28, 0x3a, 0xf7, 0xc5, 0xa4, 0x0f, 0x61, 0xcc, 0x23, 0x67, 0x86, 0x67, 0x1a, 0x87, 0x76, 0x34,
+	0xf1, 0x12, 0x9f, 0x38, 0x1a, 0x0f, 0xc8, 0xc9, 0xeb, 0x30, 0x24, 0xe4, 0x14, 0x8a, 0xa3, 0xf8,
+	0x72, 0x4b, 0x79, 0xd6, 0x1c, 0x73, 0xad, 0xee, 0xbb, 0xde, 0x96, 0xa9, 0x0d, 0x72, 0x9a, 0x67,
+	0x82, 0x04, 0x6d, 0xc0, 0xb0, 0xc0, 0x90, 0x06, 0x40, 0x71, 0x5f, 0x27, 0x20, 0x82, 0xb1, 0x26,
+	0x69, 0xd0, 0x13, 0x40, 0x21, 0x0a, 0x93, 0x51, 0xe7, 0x76, 0x86, 0xfb, 0x39, 0xd2, 0xd5, 0x56,
+	0xea, 0x59, 0xb7, 0xdd, 0xfa, 0xab, 0x07, 0xc4, 0x3a, 0x3e, 0xf1, 0xb5, 0x51, 0x89, 0xc7, 0xa8,
+	0x77, 0x18, 0x31, 0x7a, 0x09, 0xd3, 0x96, 0x73, 0x44, 0x3c, 0xe2, 0xe9, 0xdc, 0xfe, 0xa8, 0x7e,
+	0x78, 0xae, 0x1f, 0x32, 0x12, 0x0c, 0x1c, 0xf7, 0xbd, 0x42, 0xdc, 0x04, 0xe8, 0x1e, 0x27, 0xd3,
+	0x26, 0x24, 0x8c, 0x68, 0xae, 0x9f, 0xf3, 0x19, 0xa8, 0x0e, 0xb3, 0x47, 0xae, 0x47, 0xea, 0x06,
+	0xf5, 0x0b, 0x38, 0x0c, 0xbc, 0x11, 0x87, 0xe9, 0x18, 0x29, 0xcd, 0xe4, 0x25, 0x4c, 0x4b, 0xb5,
+	0xe6, 0x38, 0x0c, 0xbe, 0xd9, 0x1a, 0x24, 0x4c, 0x1a, 0xfe, 0x31, 0xa0, 0x94, 0x8a, 0x74, 0xd2,
+	0x30, 0x28, 0xfe, 0x94, 0x23, 0x5f, 0x29, 0xd6, 0xba, 0xd8, 0x3e, 0x0e, 0xa3, 0x8d, 0x26, 0x15,
+	0x73, 0xaf, 0x61, 0x50, 0x74, 0x00, 0x93, 0x59, 0xa5, 0x08, 0xcc, 0xdf, 0xe8, 0x14, 0x73, 0x3c,
+	0xa3, 0x0a, 0x0e, 0xfb, 0x18, 0x50, 0x4a, 0x0d, 0x02, 0xf3, 0x07, 0x1d, 0xcb, 0x99, 0x5c, 0x3c,
+	0x07, 0xf4, 0xe1, 0x9d, 0x10, 0xd0, 0xb6, 0xa8, 0x4f, 0x1c, 0xcb, 0x39, 0xd6, 0xeb, 0x2e, 0x39,
+	0x3a, 0xb2, 0xea, 0x16, 0x71, 0x7c, 0x3c, 0xca, 0xb1, 0x6f, 0x96, 0x61, 0x6f, 0x87, 0x84, 0x77,
+	0x63, 0x3a, 0x6d, 0x4e, 0xc2, 0x16, 0x0d, 0xa2, 0x13, 0xa8, 0x36, 0x3d, 0x72, 0x6a, 0xb9, 0x01,
+	0x0d, 0x4f, 0x4b, 0x68, 0xef, 0x47, 0x1e, 0x3b, 0xc5, 0xae, 0x83, 0xc7, 0x38, 0xe3, 0xc5, 0x32,
+	0xc6, 0x1b, 0xa4, 0xae, 0xbd, 0x13, 0x02, 0xc9, 0x13, 0x24, 0x0c, 0x7f, 0x53, 0xa2, 0x20, 0x1b,
+	0xd4, 0x88, 0x93, 0xd8, 0x24, 0xa7, 0x4e, 0x72, 0xbc, 0x50, 0x67, 0xbc, 0x16, 0x43, 0xa8, 0xad,
+	0x10, 0x29, 0xc3, 0xed, 0xc7, 0x70, 0x25, 0xe2, 0x16, 0x6e, 0x5f, 0x8e, 0xd9, 0x78, 0x67, 0xcc,
+	0x16, 0x42, 0xa4, 0x4d, 0x09, 0x94, 0xe1, 0x75, 0x08, 0x8b, 0x39, 0x5e, 0x91, 0x59, 0x78, 0x86,
+	0x6f, 0xb9, 0x78, 0x82, 0x73, 0x9a, 0x6b, 0xe5, 0x35, 0x18, 0x97, 0xf9, 0x2c, 0x17, 0x69, 0x1c,
+	0x1a, 0x03, 0x40, 0x4f, 0x60, 0xc0, 0x77, 0x7d, 0xc3, 0xd6, 0xf9, 0xed, 0x84, 0x27, 0xab, 0xca,
+	0x52, 0xff, 0xfa, 0x4d, 0xe6, 0xe6, 0xff, 0xe7, 0x97, 0x8b, 0x93, 0xe2, 0xda, 0xa4, 0xe6, 0xab,
+	0x9a, 0xe5, 0x2e, 0x37, 0x0c, 0xff, 0xa4, 0xb6, 0xe5, 0xf8, 0xff, 0xf5, 0x2f, 0x1f, 0x81, 0x18,
+	0x60, 0xad, 0xbf, 0xff, 0xbf, 0x7f, 0xfa, 0x40, 0xd1, 0x80, 0x83, 0xec, 0x31, 0x0c, 0xf4, 0x29,
+	0x0c, 0x08, 0xff, 0x26, 0x20, 0xa7, 0xca, 0x45, 0xdc, 0x72, 0x7c, 0x46, 0xdd, 0xb4, 0xea, 0x82,
+	0xfa, 0x19, 0x4c, 0x73, 0xba, 0xc8, 0x6a, 0x8c, 0xc0, 0x3f, 0x71, 0x3d, 0xcb, 0x3f, 0xc7, 0xd3,
+	0xed, 0xd5, 0xca, 0xd0, 0x26, 0x39, 0xbd, 0x34, 0x96, 0xb5, 0x90, 0x1a, 0x3d, 0x07, 0x2c, 0x80,
+	0x69, 0xd0, 0xd0, 0x8f, 0x3c, 0xb7, 0xa1, 0x9b, 0xc4, 0x26, 0xc7, 0x86, 0xef, 0x7a, 0x18, 0xbf,
+	0x09, 0xf2, 0x5e, 0xd0, 0xd8, 0xf4, 0xdc, 0xc6, 0x46, 0x48, 0x8d, 0x5e, 0xc2, 0xa8, 0x84, 0x22,
+	0xa6, 0x58, 0x34, 0xc5, 0x33, 0x1c, 0x71, 0xa5, 0x10, 0x31, 0xa2, 0x94, 0x32, 0x46, 0xed, 0x2d,
+	0xe7, 0xc8, 0xd5, 0x46, 0x22, 0x2c, 0xae, 0x10, 0x8a, 0x5e, 0x81, 0x2a, 0x04, 0x4f, 0x0b, 0x4d,
+	0xf5, 0xa0, 0xe9, 0x3a, 0xa1, 0x9a, 0xf0, 0x6c, 0x67, 0x4b, 0x58, 0xe0, 0x50, 0x29, 0xf9, 0xe9,
+	0x41, 0xd3, 0x75, 0xa4, 0x28, 0xe8, 0x05, 0xcc, 0x84, 0xfc, 0x43, 0xb3, 0x6e, 0x32, 0x9b, 0x3b,
+	0x31, 0x3c, 0x82, 0xe7, 0x3a, 0xb3, 0xeb, 0xa9, 0x10, 0x41, 0xd8, 0xf3, 0x2e, 0xf1, 0xf6, 0x18,
+	0x39, 0x72, 0xe2, 0xad, 0x6d, 0xb8, 0xa7, 0x86, 0x9d, 0xf0, 0xf0, 0xf3, 0x1c, 0xf9, 0x4e, 0x1a,
+	0x39, 0xe1, 0xda, 0x25, 0x13, 0x29, 0xe5, 0x9e, 0xd8, 0x60, 0x0e, 0xc2, 0x75, 0x36, 0x41, 0x13,
+	0x3d, 0x91, 0xcb, 0xff, 0xa2, 0x88, 0x9f, 0xc1, 0xe4, 0xc4, 0xef, 0x70, 0x7e, 0xef, 0xa7, 0xf9,
+	0xc9, 0x25, 0xe4, 0x2f, 0x96, 0x1c, 0x3e, 0x9f, 0x89, 0x7e, 0xa2, 0x40, 0x35, 0x52, 0x56, 0xab,
+	0x95, 0x2d, 0x70, 0x4e, 0x0f, 0xda, 0xad, 0xac, 0x85, 0x4d, 0x90, 0xdc, 0x52, 0xe7, 0xcd, 0x82,
+	0x91, 0x68, 0xc9, 0xaf, 0x4b, 0x25, 0x12, 0x6b, 0x5f, 0x2c, 0xf2, 0xf7, 0x59, 0xfe, 0x05, 0x4a,
+	0x68, 0xc5, 0x59, 0x28, 0xe3, 0x1e, 0x40, 0xe4, 0x7d, 0x29, 0xae, 0x72, 0x1e, 0xef, 0x96, 0x5b,
+	0x63, 0xe8, 0x61, 0x13, 0x84, 0x68, 0x1d, 0xfa, 0x43, 0x57, 0x47, 0xf1, 0x15, 0x8e, 0x72, 0xad,
+	0x0c, 0x25, 0x72, 0x9d, 0x31, 0x19, 0xfa, 0x0c, 0x2e, 0x87, 0x41, 0x9e, 0x5a, 0x14, 0x39, 0x6c,
+	0x5b, 0x87, 0xbb, 0x2b, 0xcd, 0x47, 0xe4, 0x7c, 0xcd, 0x31, 0x1f, 0x1f, 0x1d, 0xd5, 0x4f, 0x0c,
+	0xcb, 0xd9, 0x71, 0x4d, 0xa2, 0x85, 0x64, 0x68, 0x1d, 0xfa, 0xa2, 0x10, 0xef, 0xea, 0x1b, 0x41,
+	0x44, 0x74, 0xe8, 0x3e, 0x8c, 0x09, 0x37, 0x78, 0x44, 0xd8, 0x2e, 0x9c, 0x12, 0x27, 0x20, 0xf8,
+	0x5a, 0x7b, 0x67, 0x38, 0xc2, 0xa9, 0x36, 0x09, 0xd1, 0x04, 0x0d, 0x7a, 0x0c, 0x93, 0xd1, 0x35,
+	0x20, 0x43, 0x58, 0xbe, 0x21, 0xf8, 0xdd, 0xf6, 0xce, 0x7f, 0x3c, 0xa4, 0xe4, 0xdd, 0xcf, 0x38,
+	0x1d, 0x7a, 0x02, 0xc3, 0x86, 0x6d, 0xeb, 0x89, 0xed, 0x7a, 0x8f, 0x23, 0x7d, 0xd0, 0x2e, 0xc0,
+	0x8a, 0xb6, 0x8c, 0x6a, 0x43, 0x86, 0x6d, 0xc7, 0x4d, 0xb4, 0x03, 0xac, 0x43, 0x8f, 0xb7, 0xee,
+	0x7d, 0x8e, 0x78, 0xbd, 0x1d, 0x62, 0xb8, 0x7d, 0x54, 0x1b, 0x34, 0x6c, 0x3b, 0x6a, 0x31, 0x97,
+	0xca, 0xf0, 0x6c, 0x97, 0x52, 0xfd, 0x30, 0x70, 0x4c, 0x9b, 0x50, 0xbc, 0xc4, 0x21, 0x57, 0xdb,
+	0x41, 0x4a, 0x03, 0x7e, 0x6a, 0xd8, 0x01, 0x59, 0x17, 0xa4, 0x1a, 0x5b, 0xef, 0xb6, 0x4b, 0xa9,
+	0x6c, 0xa3, 0x2f, 0x60, 0xc2, 0x21, 0x3e, 0xdb, 0xed, 0x34, 0x8b, 0xeb, 0x9c, 0xc5, 0x8d, 0x76,
+	0x2c, 0x52, 0xd8, 0x48, 0x22, 0x25, 0xf1, 0xff, 0x5c, 0x81, 0x8f, 0xa2, 0x3d, 0x6b, 0x12, 0xaf,
+	0x4e, 0x1c, 0xdf, 0x38, 0x8e, 0x3c, 0xaa, 0xef, 0x8a, 0xc3, 0x69, 0xc6, 0x0f, 0x89, 0x0f, 0xf8,
+	0xc5, 0x7b, 0x47, 0x5e, 0xbc, 0xcb, 0xc7, 0x96, 0x7f, 0x12, 0x1c, 0xd6, 0xea, 0x6e, 0x63, 0xd9,
+	0xb0, 0x6d, 0xd7, 0x33, 0x3e, 0x92, 0x5c, 0xc2, 0x26, 0xb7, 0x38, 0x71, 0x25, 0xb3, 0x7d, 0x5e,
+	0x0a, 0xb9, 0xed, 0x46, 0xcc, 0x84, 0xf3, 0xdd, 0x77, 0xf9, 0x69, 0x35, 0xa3, 0xd7, 0xc7, 0x3e,
+	0x8c, 0xbb, 0x4d, 0xe2, 0xc8, 0x67, 0x90, 0x7e, 0x66, 0x39, 0xa6, 0x7b, 0x46, 0xf1, 0x9d, 0xa2,
+	0xa3, 0x96, 0x58, 0x72, 0xf8, 0x42, 0xdb, 0x32, 0xa9, 0x36, 0xc6, 0x00, 0xc4, 0x9b, 0xe8, 0x99,
+	0x20, 0x67, 0xd7, 0x46, 0xe0, 0x1c, 0x05, 0xf6, 0x91, 0x65, 0xdb, 0xc4, 0x0c, 0xc1, 0xf9, 0xcb,
+	0x86, 0xe2, 0x0f, 0x39, 0xf6, 0x42, 0x2b, 0x3b, 0xe5, 0x4f, 0x18, 0xaa, 0x4d, 0x27, 0x00, 0x04,
+	0xb8, 0x18, 0x40, 0x3f, 0x86, 0xd9, 0x24, 0x76, 0x18, 0x17, 0x48, 0xf0, 0x1b, 0x25, 0x5b, 0xb6,
+	0xe6, 0x98, 0x51, 0xcc, 0xf8, 0x55, 0x40, 0xa8, 0x2f, 0x59, 0xe1, 0x04, 0x9e, 0x9c, 0x20, 0x79,
+	0x3d, 0x82, 0x11, 0x9b, 0x45, 0x74, 0xa6, 0x67, 0x35, 0xa5, 0x03, 0xff, 0xa4, 0xf3, 0x87, 0xd9,
+	0x10, 0xa3, 0xdd, 0xf0, 0xac, 0x26, 0xef, 0x44, 0x5f, 0xc1, 0x82, 0x6d, 0xf8, 0x84, 0xfa, 0x7a,
+	0xf8, 0xf2, 0x08, 0x8d, 0xce, 0x23, 0xc7, 0x1e, 0xf1, 0x29, 0xfe, 0xa8, 0x4c, 0x78, 0x79, 0x1b,
+	0x59, 0x0d, 0xe6, 0x70, 0x1b, 0x4d, 0x62, 0x72, 0xb3, 0xd3, 0xe6, 0x04, 0xa6, 0x38, 0x7c, 0xde,
+	0x8e, 0x40, 0xd4, 0x04, 0x20, 0x3a, 0x83, 0x2b, 0x92, 0x65, 0x22, 0x60, 0xcc, 0x72, 0xad, 0x75,
+	0xc1, 0x55, 0xae, 0x24, 0x8e, 0x21, 0x33, 0x8c, 0xff, 0x48, 0x81, 0x25, 0xc9, 0xd9, 0x75, 0x88,
+	0x6e, 0x39, 0x65, 0x02, 0x2c, 0x97, 0x9c, 0x64, 0x29, 0x40, 0x2b, 0x39, 0xae, 0x0a, 0x26, 0x8f,
+	0x1d, 0xb2, 0xe5, 0xb4, 0x14, 0xe6, 0x77, 0xe1, 0x9a, 0x94, 0xc5, 0x31, 0x58, 0x0e, 0xa1, 0x95,
+	0xfa, 0x6f, 0x76, 0xa1, 0x88, 0xaa, 0x40, 0xde, 0x61, 0xc0, 0xc5, 0x7b, 0xf0, 0x53, 0x05, 0x6a,
+	0x09, 0x55, 0xb8, 0x41, 0xbc, 0xff, 0xad, 0x04, 0xb9, 0xd5, 0xbd, 0x42, 0x96, 0x22, 0x85, 0x3c,
+	0x0e, 0x42, 0xa3, 0x28, 0x96, 0xeb, 0xaf, 0x14, 0xb8, 0xd5, 0x42, 0xae, 0x92, 0xbd, 0x5a, 0xe9,
+	0x5e, 0xb4, 0x0f, 0x0b, 0x44, 0x6b, 0xb9, 0x67, 0x05, 0xd2, 0x25, 0xa4, 0x6a, 0xa5, 0xb8, 0xd5,
+	0xef, 0x48, 0xba, 0x58, 0xac, 0x62, 0xdd, 0xfd, 0x4c, 0x81, 0x8f, 0x5b, 0x4b, 0x57, 0xa2, 0xbe,
+	0x8f, 0xbb, 0x17, 0xb0, 0x56, 0x2c, 0x60, 0x4b, 0x0d, 0xd6, 0x60, 0x9c, 0xbf, 0x0c, 0x7d, 0x62,
+	0x34, 0x74, 0xc3, 0x34, 0x3d, 0x42, 0x29, 0xa1, 0xb8, 0xa7, 0x5a, 0x59, 0xea, 0xd7, 0xc6, 0xd8,
+	0xd0, 0x3e, 0x31, 0x1a, 0x6b, 0xe1, 0x00, 0xaa, 0x03, 0x16, 0xe1, 0x04, 0xf7, 0x78, 0xd2, 0x65,
+	0xd7, 0xdd, 0x46, 0xc3, 0xf2, 0xf1, 0xed, 0x92, 0x80, 0x80, 0x09, 0x4a, 0x85, 0xa0, 0x5c, 0x74,
+	0xee, 0x3a, 0xb5, 0x49, 0x8e, 0xb5, 0x6d, 0x50, 0x5f, 0x38, 0xef, 0xbb, 0x1c, 0x08, 0x11, 0x98,
+	0x49, 0x30, 0x09, 0x7d, 0xb7, 0xe4, 0xf2, 0xbd, 0x37, 0xe6, 0x32, 0x15, 0x71, 0x91, 0x7e, 0x5b,
+	0xb2, 0xf1, 0xe1, 0x5d, 0xc1, 0xc6, 0x77, 0x75, 0x9e, 0x93, 0x6c, 0xba, 0x94, 0x5a, 0x2c, 0x2d,
+	0x58, 0x3f, 0x09, 0x3c, 0x9e, 0xf2, 0x10, 0xde, 0xfc, 0xd7, 0x3a, 0xf7, 0xe6, 0x55, 0x8e, 0xb8,
+	0xef, 0xee, 0x90, 0xd7, 0xfe, 0xae, 0x84, 0xbb, 0x2b, 0xd1, 0x84, 0x83, 0x7f, 0x0a, 0x53, 0x1c,
+	0x95, 0x71, 0x4d, 0xa7, 0x2b, 0xbf, 0x5f, 0x94, 0xaf, 0xc9, 0x47, 0xfd, 0x54, 0x1b, 0xe7, 0x00,
+	0xfb, 0xee, 0x5a, 0x32, 0xb1, 0x79, 0x06, 0x57, 0x23, 0x5c, 0xdb, 0x3d, 0x63, 0x56, 0x97, 0x84,
+	0x0f, 0xe3, 0xbf, 0x5f, 0x2f, 0x52, 0x5f, 0x9e, 0x89, 0x88, 0xfa, 0x76, 0x0d, 0xcb, 0xd3, 0x16,
+	0x24, 0xb7, 0x6d, 0x0e, 0x9a, 0xe0, 0x29, 0xe6, 0x20, 0x1f, 0xae, 0x67, 0x42, 0xcd, 0xaf, 0x02,
+	0xc3, 0xf1, 0x2d, 0x3b, 0xf6, 0xa1, 0x51, 0x52, 0x0a, 0xff, 0xb0, 0x7d, 0xf8, 0x79, 0x2d, 0x15,
+	0x7e, 0x3e, 0x91, 0x58, 0x5b, 0xe9, 0x54, 0x1a, 0xfa, 0x6d, 0xb8, 0xd1, 0x8a, 0x6b, 0x51, 0x86,
+	0x0d, 0x7f, 0xd6, 0x9e, 0xf1, 0xfb, 0x85, 0x8c, 0x37, 0x73, 0xf9, 0xb6, 0xb2, 0x15, 0xe7, 0xd2,
+	0x70, 0x78, 0xad, 0xdb, 0x15, 0x6b, 0xe9, 0xa4, 0x1c, 0xfa, 0x7d, 0x05, 0x6e, 0xd4, 0xdd, 0xc0,
+	0x49, 0xde, 0x0c, 0x75, 0x3b, 0xe0, 0x98, 0xec, 0xee, 0x14, 0x92, 0xc8, 0x3d, 0xa7, 0xc4, 0xc7,
+	0xeb, 0x9c, 0xb3, 0x5a, 0xe6, 0x46, 0x0e, 0x2c, 0xc7, 0xff, 0xde, 0xc7, 0xda, 0x7b, 0x1c, 0x37,
+	0xba, 0x08, 0x42, 0xd4, 0x2d, 0x87, 0xcf, 0x16, 0x5b, 0xbe, 0x47, 0x7c, 0xf4, 0xb5, 0x02, 0xcb,
+	0x42, 0x84, 0x94, 0x9b, 0x2d, 0x95, 0xe2, 0x6e, 0xc7, 0x52, 0x5c, 0xe7, 0xd0, 0x49, 0xaf, 0xda,
+	0x52, 0x90, 0x4d, 0x18, 0x91, 0x2c, 0xa4, 0x2e, 0x28, 0xde, 0xe8, 0x24, 0xab, 0x3e, 0x2c, 0xa8,
+	0xe4, 0x4a, 0x29, 0xda, 0x06, 0x24, 0x71, 0xe2, 0x05, 0x51, 0x7c, 0xaf, 0x13, 0xa8, 0x31, 0x41,
+	0x18, 0x8b, 0xcb, 0x02, 0x5a, 0x2c, 0x4f, 0x5e, 0xde, 0xf0, 0x37, 0x3b, 0x4d, 0xc6, 0x4e, 0x0a,
+	0x88, 0xac, 0xbd, 0x7f, 0x09, 0x73, 0x12, 0xbb, 0xd0, 0xbc, 0xef, 0x77, 0x0a, 0x2f, 0x25, 0x2c,
+	0xb0, 0xea, 0x58, 0xa7, 0xd1, 0x03, 0xe3, 0xc1, 0x1b, 0xe8, 0x34, 0x7a, 0x2c, 0xc4, 0x5a, 0xc8,
+	0x1f, 0x86, 0xad, 0x37, 0xd4, 0x42, 0xf6, 0x0c, 0x3c, 0x81, 0xc1, 0xd4, 0xdb, 0xeb, 0x21, 0xc7,
+	0xab, 0x15, 0xe2, 0x45, 0x51, 0x7c, 0xf6, 0x69, 0xa7, 0x0d, 0xd8, 0x89, 0x67, 0xd7, 0xef, 0x41,
+	0x55, 0x26, 0x33, 0x83, 0x86, 0x5e, 0xf8, 0x66, 0xa6, 0xf8, 0xd1, 0xc5, 0x1e, 0x5a, 0xf3, 0x22,
+	0xd1, 0x19, 0x34, 0x76, 0xf3, 0x0f, 0x6b, 0x8a, 0x28, 0xcb, 0xb5, 0xf3, 0x27, 0x5e, 0x3d, 0xf0,
+	0x3c, 0xe2, 0xf8, 0xe2, 0xd6, 0xd1, 0xc3, 0x35, 0xe1, 0xed, 0x2e, 0xf3, 0xab, 0xb3, 0x02, 0xf6,
+	0xae, 0x40, 0xe5, 0x3e, 0xfe, 0x9e, 0xc4, 0x44, 0x2f, 0x60, 0x9a, 0xd6, 0x4f, 0x88, 0x19, 0xb0,
+	0xd7, 0x91, 0x58, 0x6c, 0xd0, 0x34, 0x0d, 0x9f, 0x50, 0xfc, 0xa3, 0xfc, 0x81, 0x5d, 0xad, 0xed,
+	0x85, 0x93, 0xb9, 0xe8, 0x07, 0x7c, 0xaa, 0x36, 0x49, 0x0b, 0x7a, 0x29, 0x7a, 0x18, 0x26, 0x31,
+	0x3c, 0xe2, 0x5b, 0x1e, 0x69, 0x10, 0xc7, 0xa7, 0x78, 0x27, 0x6f, 0x4a, 0xab, 0x62, 0xa7, 0xb4,
+	0x68, 0x56, 0xf4, 0x91, 0x2a, 0x22, 0x43, 0x3b, 0x30, 0x7e, 0xdc, 0x68, 0xea, 0x4c, 0xaf, 0x67,
+	0x2c, 0x51, 0xea, 0x06, 0x1e, 0x7b, 0xc0, 0x3d, 0xce, 0xbf, 0x0e, 0x57, 0x6b, 0xf7, 0x1b, 0xcd,
+	0x35, 0x31, 0x6f, 0x8f, 0x4f, 0xd3, 0xc6, 0x8e, 0x33, 0x3d, 0x14, 0xdd, 0x01, 0xcc, 0xaf, 0x7a,
+	0x06, 0x4a, 0x83, 0x43, 0x5a, 0xf7, 0xac, 0xa6, 0x6f, 0xb9, 0x0e, 0xfb, 0x12, 0xb9, 0xcb, 0xbf,
+	0x44, 0x4e, 0xb2, 0xf1, 0xfb, 0x8d, 0xe6, 0x5e, 0x62, 0x74, 0xcb, 0x64, 0x8b, 0xca, 0xd2, 0x50,
+	0xfc, 0xa4, 0x68, 0x51, 0x19, 0x5a, 0x6d, 0xf4, 0x38, 0xdd, 0xc1, 0x72, 0x4d, 0xc3, 0x0c, 0xcb,
+	0x24, 0xb6, 0x75, 0x4a, 0x3c, 0x8b, 0x50, 0xac, 0x71, 0xa0, 0x99, 0x1c, 0xd0, 0x86, 0x98, 0x72,
+	0xae, 0x0d, 0x1d, 0x47, 0x0d, 0x8b, 0x50, 0x74, 0x17, 0x16, 0x92, 0x1f, 0x26, 0x85, 0x7e, 0x6c,
+	0x8b, 0xfa, 0x3a, 0x71, 0xd8, 0xf7, 0x4b, 0x13, 0xef, 0xf1, 0x4f, 0x9a, 0x73, 0x89, 0x4f, 0x91,
+	0x6b, 0xe1, 0x9c, 0x7b, 0x62, 0x0a, 0xba, 0x07, 0x8b, 0xa9, 0x2f, 0x93, 0x05, 0x28, 0xfb, 0x1c,
+	0x65, 0x3e, 0xf9, 0x2d, 0x32, 0x07, 0xb3, 0x07, 0x53, 0xc5, 0xb2, 0xe0, 0x83, 0x4e, 0xdc, 0xc7,
+	0x44, 0x91, 0x88, 0xe8, 0x00, 0xa6, 0x5b, 0xc8, 0x86, 0x9f, 0x76, 0x82, 0x3a, 0x59, 0x28, 0x32,
+	0xaa, 0xc3, 0x64, 0x08, 0x48, 0x6d, 0x83, 0x9e, 0xb0, 0x18, 0xcf, 0x72, 0x8e, 0x5c, 0xfc, 0x8c,
+	0x83, 0x2e, 0x97, 0x39, 0xa6, 0xd0, 0x17, 0x49, 0x3a, 0x9e, 0x58, 0x1d, 0xf7, 0xf2, 0x9d, 0xe8,
+	0x08, 0x50, 0x2a, 0x30, 0x16, 0x07, 0xe0, 0x79, 0x51, 0xb6, 0x3a, 0x9f, 0x26, 0x92, 0xcc, 0x92,
+	0xf1, 0x30, 0x3f, 0x1a, 0x63, 0x67, 0x99, 0x1e, 0x8a, 0x1e, 0xc0, 0xd0, 0xb1, 0xe7, 0x06, 0x8e,
+	0xa9, 0xfb, 0x5e, 0xe0, 0x9f, 0x50, 0xfc, 0x9b, 0x25, 0x71, 0x6a, 0x82, 0x05, 0x73, 0x49, 0x83,
+	0x82, 0x72, 0x9f, 0x13, 0xa2, 0x43, 0x98, 0x09, 0xdf, 0x20, 0xf1, 0xd7, 0x30, 0xc3, 0xab, 0x9f,
+	0x58, 0xa7, 0x04, 0xbf, 0xc8, 0xe7, 0x32, 0x57, 0x6b, 0x6b, 0x62, 0xd0, 0x94, 0xcf, 0x89, 0x38,
+	0x2f, 0x3b, 0xed, 0x64, 0x7a, 0xe4, 0x4c, 0xf4, 0xa3, 0xf0, 0x0b, 0x36, 0x6d, 0xba, 0x0e, 0x75,
+	0x3d, 0x7a, 0x62, 0x35, 0x29, 0xfe, 0xbc, 0xe8, 0x20, 0x73, 0x91, 0xf7, 0xe2, 0x69, 0xda, 0x98,
+	0x9f, 0xe9, 0xa1, 0xe8, 0x4b, 0x98, 0x90, 0x40, 0xc4, 0x14, 0x39, 0x6a, 0x96, 0x33, 0xa5, 0xf8,
+	0xb7, 0x4a, 0x6e, 0x04, 0x09, 0xc0, 0x55, 0xbc, 0x17, 0x12, 0x6f, 0x12, 0x96, 0x8f, 0x8b, 0xb0,
+	0xf8, 0x20, 0xeb, 0x43, 0xc7, 0x30, 0x6f, 0x04, 0xbe, 0xcb, 0x36, 0xb1, 0xc9, 0x74, 0xc5, 0x6c,
+	0x45, 0x66, 0xb3, 0xf9, 0xe1, 0x7f, 0x59, 0x92, 0xae, 0xce, 0x66, 0xc6, 0xb5, 0x59, 0x06, 0x75,
+	0x37, 0x46, 0xda, 0x88, 0x81, 0xd0, 0x67, 0x30, 0xe4, 0x91, 0x24, 0xf2, 0x17, 0x1c, 0x79, 0x36,
+	0xad, 0x14, 0x2d, 0x31, 0x45, 0x4b, 0x13, 0xa0, 0x9b, 0x2c, 0x35, 0xf9, 0xda, 0xd7, 0x93, 0xbd,
+	0xcc, 0xa3, 0xe9, 0xdc, 0xa3, 0x21, 0x36, 0x96, 0x24, 0xdf, 0x32, 0xd1, 0x03, 0x76, 0xd9, 0xd7,
+	0xbd, 0x80, 0xe7, 0xc6, 0xd8, 0x2d, 0x41, 0xf1, 0x97, 0x9d, 0x7d, 0x0c, 0x1a, 0x96, 0x74, 0x22,
+	0x55, 0x48, 0x91, 0x16, 0x9e, 0x54, 0xd3, 0xf0, 0x0d, 0x9d, 0x12, 0xa1, 0x28, 0xbe, 0x17, 0x46,
+	0xfb, 0xc4, 0xb5, 0x38, 0xfd, 0x1b, 0x86, 0x6f, 0xec, 0x09, 0x4a, 0xae, 0xfa, 0xa7, 0x21, 0x66,
+	0xd3, 0x38, 0xb7, 0x5d, 0xc3, 0x64, 0x9f, 0x83, 0xeb, 0x84, 0xd9, 0x1b, 0x3e, 0x2c, 0xcf, 0x0b,
+	0xca, 0x50, 0x52, 0x1c, 0xff, 0x5d, 0x49, 0xad, 0x49, 0xe2, 0x87, 0xbd, 0x7d, 0x43, 0xa3, 0xc3,
+	0x0f, 0x7b, 0xfb, 0x86, 0x47, 0x47, 0x1e, 0xf6, 0xf6, 0x8d, 0x8c, 0x8e, 0x66, 0x12, 0x63, 0xe1,
+	0xd7, 0x7f, 0x71, 0xfa, 0xf2, 0xf9, 0xab, 0xcc, 0xf8, 0xbc, 0x18, 0xd7, 0xd2, 0xa5, 0x03, 0xb2,
+	0xa9, 0x3e, 0x83, 0x91, 0x4c, 0xa5, 0x0a, 0x9a, 0x81, 0xbe, 0xa8, 0xee, 0x45, 0xe1, 0x7b, 0x73,
+	0xd9, 0x17, 0x53, 0xd0, 0x75, 0x78, 0x9b, 0xff, 0x8b, 0x7b, 0x78, 0x29, 0xcd, 0x78, 0xd1, 0x45,
+	0x29, 0x66, 0xa8, 0xf7, 0x61, 0x24, 0xe3, 0xee, 0xca, 0x80, 0x67, 0xa0, 0x4f, 0x1c, 0x0f, 0xcb,
+	0xe4, 0xd8, 0xfd, 0xda, 0x65, 0x43, 0x50, 0xa9, 0x07, 0x30, 0x59, 0xf8, 0x8a, 0x2d, 0x83, 0xbb,
+	0x02, 0x83, 0x22, 0x3c, 0x39, 0xe1, 0x53, 0x39, 0x64, 0x45, 0x1b, 0x38, 0x8c, 0xa9, 0xd5, 0xe7,
+	0x30, 0x55, 0x9c, 0x04, 0xce, 0x11, 0x2b, 0x39, 0x62, 0x34, 0x07, 0xfd, 0x21, 0x6b, 0x91, 0x86,
+	0xe8, 0xd5, 0xfa, 0x24, 0x6f, 0xaa, 0x7e, 0xad, 0x00, 0x6e, 0x55, 0xc2, 0x71, 0x31, 0xa1, 0xd1,
+	0x0d, 0xb8, 0x24, 0x2a, 0x48, 0x70, 0x25, 0x5f, 0xcb, 0xc4, 0xe2, 0x1f, 0x36, 0xa6, 0xc9, 0x39,
+	0xea, 0x29, 0x8c, 0x17, 0x44, 0xad, 0xdd, 0x6d, 0x03, 0xdb, 0x7a, 0x0e, 0x8b, 0x2b, 0x45, 0x5b,
+	0x2f, 0xe2, 0x61, 0x31, 0x43, 0x35, 0x61, 0xa2, 0xe8, 0xe9, 0xd4, 0x25, 0xe3, 0x29, 0xb8, 0x14,
+	0x70, 0x7a, 0x59, 0x84, 0x25, 0x5b, 0xea, 0x3f, 0x2b, 0xa0, 0xb6, 0xaf, 0xe5, 0xe8, 0x92, 0xe9,
+	0x33, 0x98, 0x2c, 0x2e, 0x29, 0x11, 0xab, 0xcf, 0xc4, 0x9d, 0x45, 0x8c, 0xb5, 0x09, 0xbb, 0xa0,
+	0x57, 0xfd, 0x99, 0x02, 0x57, 0xda, 0xde, 0xd8, 0x5d, 0x0a, 0xbd, 0x09, 0x43, 0xe9, 0x78, 0x41,
+	0x08, 0x7b, 0x25, 0xeb, 0xa2, 0xf3, 0x11, 0xc2, 0x20, 0x4d, 0xb4, 0xd4, 0x7f, 0x57, 0xe0, 0x7a,
+	0xc7, 0x77, 0xfe, 0x05, 0x2d, 0x3a, 0xb9, 0x9c, 0x4a, 0x7a, 0x39, 0x3f, 0x00, 0x88, 0x43, 0x13,
+	0xdc, 0x5b, 0x55, 0xb2, 0x2e, 0x75, 0xb5, 0x96, 0x0b, 0x40, 0x12, 0x14, 0xea, 0xdf, 0x28, 0x50,
+	0x6d, 0x77, 0xa7, 0x96, 0x49, 0x8f, 0xe1, 0xb2, 0xbc, 0x70, 0x43, 0x45, 0xcb, 0x26, 0x9a, 0x80,
+	0xb7, 0xc5, 0x07, 0x67, 0x21, 0xb1, 0x68, 0xa0, 0x65, 0xe8, 0xe5, 0x17, 0x8a, 0x90, 0x74, 0x2e,
+	0x73, 0x40, 0x52, 0x37, 0x39, 0x9f, 0xa8, 0xfe, 0xad, 0x02, 0x93, 0x79, 0x3d, 0x6f, 0x90, 0xfa,
+	0x05, 0x75, 0xba, 0x05, 0x15, 0x93, 0xd4, 0x71, 0xe5, 0x62, 0xef, 0x41, 0x86, 0xa1, 0xfe, 0xa9,
+	0x02, 0x63, 0xb9, 0x32, 0x88, 0x2e, 0xcd, 0xf3, 0x3b, 0x14, 0xeb, 0x1e, 0x8c, 0x66, 0x6f, 0xd3,
+	0x32, 0xa1, 0x62, 0x17, 0xd2, 0x93, 0x72, 0x21, 0x0e, 0x0c, 0xa5, 0x2e, 0xfa, 0x32, 0x8c, 0x75,
+	0xa8, 0x58, 0x8e, 0x50, 0x77, 0x37, 0xcf, 0x5c, 0x46, 0xac, 0xfe, 0x71, 0x4e, 0x9b, 0x5b, 0x5d,
+	0x7b, 0x28, 0x29, 0x4f, 0xe5, 0x22, 0xf2, 0xd8, 0x30, 0xdd, 0x22, 0x14, 0x2c, 0x13, 0x6a, 0x1e,
+	0xfa, 0xe3, 0xfa, 0x22, 0x21, 0x55, 0xdc, 0xc1, 0x4e, 0x4d, 0x58, 0xb8, 0x23, 0xcf, 0xb3, 0x6c,
+	0xaa, 0xff, 0xa6, 0xc0, 0xb5, 0x4e, 0xea, 0x84, 0x7e, 0x05, 0xbc, 0xd1, 0x3a, 0x0c, 0x47, 0xd3,
+	0x84, 0x6f, 0x2c, 0x3c, 0xa5, 0x29, 0x39, 0xb4, 0x21, 0x33, 0xd9, 0x54, 0xff, 0x53, 0x81, 0xeb,
+	0x1d, 0x17, 0xee, 0x74, 0x12, 0x45, 0x24, 0xd7, 0xd9, 0x93, 0xf3, 0x3d, 0x2d, 0x56, 0xb2, 0x0d,
+	0x28, 0x55, 0xfe, 0x92, 0x5c, 0x4d, 0xc6, 0x3b, 0x66, 0x65, 0xd2, 0x46, 0x69, 0xa6, 0x47, 0xf5,
+	0x61, 0xa6, 0x65, 0x6d, 0x50, 0xca, 0xfa, 0x94, 0xb4, 0xf5, 0x95, 0x88, 0x9e, 0x5d, 0x78, 0x25,
+	0x1f, 0x7b, 0xfd, 0x45, 0x0f, 0x7c, 0xbf, 0xfb, 0x42, 0xa1, 0x0b, 0xaa, 0x36, 0x65, 0x42, 0x95,
+	0x12, 0x13, 0xea, 0x4d, 0x2b, 0x9e, 0xc0, 0x5c, 0x71, 0x01, 0x92, 0xd8, 0x81, 0xb7, 0xab, 0x4a,
+	0xfe, 0x01, 0xda, 0x6a, 0x09, 0x1a, 0x36, 0x5b, 0x8c, 0xa8, 0x7f, 0xa9, 0x80, 0xda, 0xbe, 0x64,
+	0x29, 0xbd, 0x0a, 0xa5, 0x64, 0x15, 0x3d, 0xe9, 0x55, 0x24, 0x15, 0x53, 0x29, 0xdf, 0xb8, 0xde,
+	0xfc, 0xc6, 0x7d, 0xad, 0x44, 0x1e, 0x23, 0x5b, 0xeb, 0xd4, 0xa5, 0x1b, 0xbb, 0x0d, 0xfd, 0xd1,
+	0x63, 0x5e, 0xc6, 0x2b, 0xd3, 0x69, 0x1d, 0xc6, 0xaf, 0xf6, 0x78, 0xa6, 0xfa, 0x07, 0x0a, 0x4c,
+	0x15, 0x97, 0x4b, 0x75, 0x29, 0xc7, 0x0a, 0xf4, 0x85, 0x29, 0x6b, 0x29, 0xc6, 0x54, 0x5a, 0x8c,
+	0x10, 0x5f, 0x8b, 0xe6, 0xa9, 0xbf, 0x03, 0xb8, 0x55, 0xb5, 0x14, 0x5a, 0x80, 0x01, 0xdb, 0x3a,
+	0xd4, 0x9b, 0x2b, 0x4d, 0xfd, 0x15, 0x39, 0x0f, 0x77, 0xc8, 0x0e, 0xa7, 0xa3, 0x1f, 0xc2, 0x90,
+	0x2b, 0xe7, 0xeb, 0x8e, 0x6b, 0x12, 0xf9, 0xa2, 0xca, 0x3c, 0xa7, 0x93, 0x90, 0xda, 0xa0, 0x9b,
+	0x68, 0xa9, 0x41, 0xf2, 0xee, 0x6a, 0x73, 0x29, 0xcb, 0x9b, 0xb7, 0xe7, 0x3b, 0xb8, 0x79, 0xff,
+	0x4c, 0x81, 0xf9, 0xb2, 0xf2, 0xa9, 0x0b, 0x86, 0x2e, 0x9f, 0xa4, 0x6a, 0xed, 0xc4, 0x46, 0xe0,
+	0x16, 0xf6, 0x40, 0x93, 0xe5, 0x75, 0xea, 0x9f, 0x28, 0x30, 0x57, 0x52, 0x85, 0x75, 0x41, 0xb9,
+	0x6e, 0x27, 0x8b, 0xf7, 0x0a, 0xcd, 0x34, 0xe2, 0x94, 0xa8, 0xd7, 0x53, 0xff, 0x55, 0x81, 0x77,
+	0x3b, 0xaa, 0xe3, 0xba, 0xa0, 0x78, 0x07, 0x71, 0xc6, 0xf0, 0x94, 0xa1, 0x46, 0x9f, 0x1e, 0xca,
+	0x5e, 0x00, 0x49, 0xfe, 0x51, 0x8e, 0x30, 0xd9, 0xa9, 0xfe, 0xb5, 0x02, 0x0b, 0xe5, 0x35, 0x62,
+	0x17, 0x94, 0xfb, 0x53, 0x18, 0x4c, 0xca, 0x2b, 0xc5, 0xcd, 0x64, 0x98, 0x53, 0x1f, 0x45, 0x4e,
+	0xe3, 0x86, 0xfa, 0x79, 0x32, 0x0a, 0x94, 0x65, 0x4e, 0x25, 0xf2, 0xdc, 0x80, 0x4b, 0xb2, 0xb2,
+	0xaa, 0xa7, 0xe8, 0xf1, 0x2c, 0x00, 0x34, 0x39, 0x47, 0xfd, 0x69, 0xbc, 0xf6, 0x16, 0xc5, 0x56,
+	0x65, 0xbc, 0x9e, 0xc3, 0x54, 0xfc, 0xdb, 0x00, 0x4e, 0xa3, 0xa7, 0x78, 0xab, 0x85, 0x3b, 0x92,
+	0x82, 0x8f, 0x7e, 0xed, 0x91, 0xea, 0x55, 0xff, 0x2e, 0x21, 0x57, 0x71, 0x79, 0x45, 0x97, 0x1e,
+	0xf0, 0x11, 0x8c, 0xf9, 0x71, 0x65, 0x82, 0xb0, 0x23, 0x5c, 0x29, 0x8a, 0x2b, 0x12, 0x05, 0x0c,
+	0x9c, 0xa1, 0x36, 0xea, 0x67, 0x7a, 0xd4, 0xff, 0x88, 0x0d, 0xbf, 0xbc, 0x16, 0xa4, 0x4c, 0xd8,
+	0x39, 0xe8, 0x0f, 0x85, 0xbd, 0x25, 0xa5, 0xed, 0x93, 0xd2, 0xde, 0x4a, 0x0e, 0xae, 0xe0, 0x4a,
+	0x6a, 0x70, 0xa5, 0x78, 0x2d, 0xbd, 0x5d, 0xae, 0x25, 0xe1, 0xf2, 0x0a, 0x4b, 0x37, 0xca, 0x96,
+	0xf0, 0x39, 0x4c, 0x27, 0x05, 0x11, 0x12, 0x8b, 0x9f, 0x45, 0x09, 0x43, 0xb8, 0xda, 0x52, 0x9c,
+	0x54, 0x05, 0x4a, 0x51, 0xb7, 0xda, 0x80, 0xf1, 0x82, 0xc2, 0x8b, 0x4e, 0xc2, 0xa3, 0xd5, 0x74,
+	0xfe, 0xaa, 0xe0, 0xba, 0x0b, 0xd1, 0x12, 0x79, 0xad, 0x3f, 0x54, 0x60, 0xbe, 0xac, 0x06, 0xa3,
+	0x13, 0xc6, 0xeb, 0x30, 0x98, 0x2a, 0xf4, 0x10, 0xbc, 0x17, 0x0b, 0x79, 0xc7, 0xc8, 0x9a, 0xf8,
+	0xd9, 0x85, 0xe8, 0x50, 0xff, 0x21, 0x7e, 0xd7, 0xb7, 0xfc, 0x7a, 0xda, 0xe6, 0x5d, 0xdf, 0x22,
+	0x38, 0xd2, 0x60, 0xa2, 0xc8, 0x8d, 0xca, 0x53, 0x50, 0x6d, 0xe7, 0x45, 0x35, 0x94, 0x77, 0xa2,
+	0xeb, 0xda, 0xcf, 0xbf, 0x59, 0x50, 0x7e, 0xf1, 0xcd, 0x82, 0xf2, 0xbf, 0xdf, 0x2c, 0x28, 0x3f,
+	0xf9, 0x76, 0xe1, 0xad, 0x5f, 0x7c, 0xbb, 0xf0, 0xd6, 0x7f, 0x7f, 0xbb, 0xf0, 0xd6, 0x8b, 0x4f,
+	0x3a, 0xbc, 0x80, 0x5f, 0x2f, 0xc7, 0xbf, 0x81, 0xe4, 0xbf, 0x9a, 0x3c, 0xbc, 0xc4, 0x7f, 0xea,
+	0xb8, 0xfa, 0xff, 0x03, 0x00, 0xc7, 0x26, 0x1a, 0xb9, 0x47, 0x3a, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TopicPayloadsReceived) > 0 {
+		for iNdEx := len(m.TopicPayloadsReceived) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.InfererScoreEmas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicPayloadsReceived[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3077,15 +3435,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x6
 			i--
-			dAtA[i] = 0xe2
+			dAtA[i] = 0x92
 		}
 	}
-	if len(m.BlockToLowestActiveTopicWeight) > 0 {
-		for iNdEx := len(m.BlockToLowestActiveTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicDataSendingFees) > 0 {
+		for iNdEx := len(m.TopicDataSendingFees) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.BlockToLowestActiveTopicWeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicDataSendingFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3093,15 +3451,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x6
 			i--
-			dAtA[i] = 0xda
+			dAtA[i] = 0x8a
 		}
 	}
-	if len(m.BlockToActiveTopics) > 0 {
-		for iNdEx := len(m.BlockToActiveTopics) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.AccruedRewards) > 0 {
+		for iNdEx := len(m.AccruedRewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.BlockToActiveTopics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.AccruedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3109,15 +3467,22 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x6
 			i--
-			dAtA[i] = 0xd2
+			dAtA[i] = 0x82
 		}
 	}
-	if len(m.TopicToNextPossibleChurningBlock) > 0 {
-		for iNdEx := len(m.TopicToNextPossibleChurningBlock) - 1; iNdEx >= 0; iNdEx-- {
+	if m.NextRedelegationId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextRedelegationId))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xf8
+	}
+	if len(m.Redelegations) > 0 {
+		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicToNextPossibleChurningBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3125,15 +3490,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
-			dAtA[i] = 0xca
+			dAtA[i] = 0xf2
 		}
 	}
-	if len(m.LastDripBlock) > 0 {
-		for iNdEx := len(m.LastDripBlock) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.AutoCompoundingDelegations) > 0 {
+		for iNdEx := len(m.AutoCompoundingDelegations) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LastDripBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.AutoCompoundingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3141,15 +3506,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
-			dAtA[i] = 0xc2
+			dAtA[i] = 0xea
 		}
 	}
-	if len(m.OpenWorkerWindows) > 0 {
-		for iNdEx := len(m.OpenWorkerWindows) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.SponsoredActorFees) > 0 {
+		for iNdEx := len(m.SponsoredActorFees) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.OpenWorkerWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.SponsoredActorFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3157,15 +3522,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
-			dAtA[i] = 0xba
+			dAtA[i] = 0xe2
 		}
 	}
-	if len(m.TopicLastReputerCommit) > 0 {
-		for iNdEx := len(m.TopicLastReputerCommit) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicSponsorships) > 0 {
+		for iNdEx := len(m.TopicSponsorships) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicLastReputerCommit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicSponsorships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3173,15 +3538,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
-			dAtA[i] = 0xb2
+			dAtA[i] = 0xda
 		}
 	}
-	if len(m.TopicLastWorkerCommit) > 0 {
-		for iNdEx := len(m.TopicLastWorkerCommit) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.NetworkInferenceArchive) > 0 {
+		for iNdEx := len(m.NetworkInferenceArchive) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicLastWorkerCommit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.NetworkInferenceArchive[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3189,15 +3554,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
-			dAtA[i] = 0xaa
+			dAtA[i] = 0xd2
 		}
 	}
-	if len(m.LatestOneOutForecasterForecasterNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestOneOutForecasterForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.GroundTruths) > 0 {
+		for iNdEx := len(m.GroundTruths) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestOneOutForecasterForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.GroundTruths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3205,15 +3570,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
-			dAtA[i] = 0xa2
+			dAtA[i] = 0xca
 		}
 	}
-	if len(m.LatestOneOutForecasterInfererNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestOneOutForecasterInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.WorkerCommitments) > 0 {
+		for iNdEx := len(m.WorkerCommitments) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestOneOutForecasterInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.WorkerCommitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3221,15 +3586,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
-			dAtA[i] = 0x9a
+			dAtA[i] = 0xc2
 		}
 	}
-	if len(m.LatestOneOutInfererForecasterNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestOneOutInfererForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ReputerSlashingInfo) > 0 {
+		for iNdEx := len(m.ReputerSlashingInfo) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestOneOutInfererForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ReputerSlashingInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3237,15 +3602,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
-			dAtA[i] = 0x92
+			dAtA[i] = 0xba
 		}
 	}
-	if len(m.LatestOneOutInfererInfererNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestOneOutInfererInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicReputerAllowlist) > 0 {
+		for iNdEx := len(m.TopicReputerAllowlist) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestOneOutInfererInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicReputerAllowlist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3253,15 +3618,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
-			dAtA[i] = 0x8a
+			dAtA[i] = 0xb2
 		}
 	}
-	if len(m.LatestNaiveInfererNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestNaiveInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicWorkerAllowlist) > 0 {
+		for iNdEx := len(m.TopicWorkerAllowlist) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestNaiveInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicWorkerAllowlist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3269,15 +3634,55 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
-			dAtA[i] = 0x82
+			dAtA[i] = 0xaa
 		}
 	}
-	if len(m.LatestOneInForecasterNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestOneInForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicReputerAllowlistEnabled) > 0 {
+		dAtA2 := make([]byte, len(m.TopicReputerAllowlistEnabled)*10)
+		var j1 int
+		for _, num := range m.TopicReputerAllowlistEnabled {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.TopicWorkerAllowlistEnabled) > 0 {
+		dAtA4 := make([]byte, len(m.TopicWorkerAllowlistEnabled)*10)
+		var j3 int
+		for _, num := range m.TopicWorkerAllowlistEnabled {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintGenesis(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.GmpDeliveries) > 0 {
+		for iNdEx := len(m.GmpDeliveries) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestOneInForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.GmpDeliveries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3285,15 +3690,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x5
 			i--
-			dAtA[i] = 0xfa
+			dAtA[i] = 0x92
 		}
 	}
-	if len(m.LatestForecasterNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.GmpSubscriptions) > 0 {
+		for iNdEx := len(m.GmpSubscriptions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.GmpSubscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3301,15 +3706,22 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x5
 			i--
-			dAtA[i] = 0xf2
+			dAtA[i] = 0x8a
 		}
 	}
-	if len(m.LatestInfererNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+	if m.NextGmpSubscriptionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextGmpSubscriptionId))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.GmpAllowedSources) > 0 {
+		for iNdEx := len(m.GmpAllowedSources) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.GmpAllowedSources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3317,15 +3729,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0xea
+			dAtA[i] = 0xfa
 		}
 	}
-	if len(m.UnfulfilledReputerNonces) > 0 {
-		for iNdEx := len(m.UnfulfilledReputerNonces) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicRetirements) > 0 {
+		for iNdEx := len(m.TopicRetirements) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.UnfulfilledReputerNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicRetirements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3333,15 +3745,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0xe2
+			dAtA[i] = 0xf2
 		}
 	}
-	if len(m.UnfulfilledWorkerNonces) > 0 {
-		for iNdEx := len(m.UnfulfilledWorkerNonces) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ScheduledTopicUpdates) > 0 {
+		for iNdEx := len(m.ScheduledTopicUpdates) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.UnfulfilledWorkerNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ScheduledTopicUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3349,27 +3761,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0xda
+			dAtA[i] = 0xea
 		}
 	}
 	{
-		size := m.PreviousPercentageRewardToStakedReputers.Size()
+		size := m.RewardCurrentBlockEmission.Size()
 		i -= size
-		if _, err := m.PreviousPercentageRewardToStakedReputers.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.RewardCurrentBlockEmission.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2
+	dAtA[i] = 0x4
 	i--
-	dAtA[i] = 0xd2
-	if len(m.NetworkLossBundles) > 0 {
-		for iNdEx := len(m.NetworkLossBundles) - 1; iNdEx >= 0; iNdEx-- {
+	dAtA[i] = 0xe2
+	{
+		size := m.TotalSumPreviousTopicWeights.Size()
+		i -= size
+		if _, err := m.TotalSumPreviousTopicWeights.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4
+	i--
+	dAtA[i] = 0xda
+	if len(m.LossBundles) > 0 {
+		for iNdEx := len(m.LossBundles) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.NetworkLossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3377,15 +3801,31 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.LowestReputerScoreEma) > 0 {
+		for iNdEx := len(m.LowestReputerScoreEma) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LowestReputerScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0xca
 		}
 	}
-	if len(m.AllLossBundles) > 0 {
-		for iNdEx := len(m.AllLossBundles) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ActiveReputers) > 0 {
+		for iNdEx := len(m.ActiveReputers) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AllLossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ActiveReputers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3393,15 +3833,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0xc2
 		}
 	}
-	if len(m.AllForecasts) > 0 {
-		for iNdEx := len(m.AllForecasts) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LowestForecasterScoreEma) > 0 {
+		for iNdEx := len(m.LowestForecasterScoreEma) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AllForecasts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LowestForecasterScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3409,15 +3849,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0xba
 		}
 	}
-	if len(m.AllInferences) > 0 {
-		for iNdEx := len(m.AllInferences) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LowestInfererScoreEma) > 0 {
+		for iNdEx := len(m.LowestInfererScoreEma) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AllInferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LowestInfererScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3425,15 +3865,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0xb2
 		}
 	}
-	if len(m.PreviousTopicWeight) > 0 {
-		for iNdEx := len(m.PreviousTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ActiveForecasters) > 0 {
+		for iNdEx := len(m.ActiveForecasters) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PreviousTopicWeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ActiveForecasters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3441,15 +3881,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0xaa
 		}
 	}
-	if len(m.TopicFeeRevenue) > 0 {
-		for iNdEx := len(m.TopicFeeRevenue) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ActiveInferers) > 0 {
+		for iNdEx := len(m.ActiveInferers) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicFeeRevenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ActiveInferers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3457,15 +3897,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0xa2
 		}
 	}
-	if len(m.Reputers) > 0 {
-		for iNdEx := len(m.Reputers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.CountForecasterInclusionsInTopicActiveSet) > 0 {
+		for iNdEx := len(m.CountForecasterInclusionsInTopicActiveSet) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Reputers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.CountForecasterInclusionsInTopicActiveSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3473,15 +3913,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0x9a
 		}
 	}
-	if len(m.Workers) > 0 {
-		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.CountInfererInclusionsInTopicActiveSet) > 0 {
+		for iNdEx := len(m.CountInfererInclusionsInTopicActiveSet) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Workers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.CountInfererInclusionsInTopicActiveSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3489,15 +3929,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0x92
 		}
 	}
-	if len(m.Forecasts) > 0 {
-		for iNdEx := len(m.Forecasts) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PreviousTopicQuantileReputerScoreEma) > 0 {
+		for iNdEx := len(m.PreviousTopicQuantileReputerScoreEma) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Forecasts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PreviousTopicQuantileReputerScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3505,15 +3945,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0x8a
 		}
 	}
-	if len(m.Inferences) > 0 {
-		for iNdEx := len(m.Inferences) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PreviousTopicQuantileForecasterScoreEma) > 0 {
+		for iNdEx := len(m.PreviousTopicQuantileForecasterScoreEma) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Inferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PreviousTopicQuantileForecasterScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3521,15 +3961,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0x82
 		}
 	}
-	if len(m.DelegateStakeRemovalsByActor) > 0 {
-		for iNdEx := len(m.DelegateStakeRemovalsByActor) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PreviousTopicQuantileInfererScoreEma) > 0 {
+		for iNdEx := len(m.PreviousTopicQuantileInfererScoreEma) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegateStakeRemovalsByActor[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PreviousTopicQuantileInfererScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3537,15 +3977,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xfa
 		}
 	}
-	if len(m.DelegateStakeRemovalsByBlock) > 0 {
-		for iNdEx := len(m.DelegateStakeRemovalsByBlock) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ReputerScoreEmas) > 0 {
+		for iNdEx := len(m.ReputerScoreEmas) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegateStakeRemovalsByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ReputerScoreEmas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3553,15 +3993,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xf2
 		}
 	}
-	if len(m.StakeRemovalsByActor) > 0 {
-		for iNdEx := len(m.StakeRemovalsByActor) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ForecasterScoreEmas) > 0 {
+		for iNdEx := len(m.ForecasterScoreEmas) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.StakeRemovalsByActor[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ForecasterScoreEmas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3569,15 +4009,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xea
 		}
 	}
-	if len(m.StakeRemovalsByBlock) > 0 {
-		for iNdEx := len(m.StakeRemovalsByBlock) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.InfererScoreEmas) > 0 {
+		for iNdEx := len(m.InfererScoreEmas) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.StakeRemovalsByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.InfererScoreEmas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3585,15 +4025,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xe2
 		}
 	}
-	if len(m.DelegateRewardPerShare) > 0 {
-		for iNdEx := len(m.DelegateRewardPerShare) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.BlockToLowestActiveTopicWeight) > 0 {
+		for iNdEx := len(m.BlockToLowestActiveTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegateRewardPerShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.BlockToLowestActiveTopicWeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3601,15 +4041,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xda
 		}
 	}
-	if len(m.StakeFromDelegatorsUponReputer) > 0 {
-		for iNdEx := len(m.StakeFromDelegatorsUponReputer) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.BlockToActiveTopics) > 0 {
+		for iNdEx := len(m.BlockToActiveTopics) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.StakeFromDelegatorsUponReputer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.BlockToActiveTopics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3617,15 +4057,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xd2
 		}
 	}
-	if len(m.DelegatedStakes) > 0 {
-		for iNdEx := len(m.DelegatedStakes) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicToNextPossibleChurningBlock) > 0 {
+		for iNdEx := len(m.TopicToNextPossibleChurningBlock) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegatedStakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicToNextPossibleChurningBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3633,15 +4073,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xca
 		}
 	}
-	if len(m.StakeSumFromDelegator) > 0 {
-		for iNdEx := len(m.StakeSumFromDelegator) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LastDripBlock) > 0 {
+		for iNdEx := len(m.LastDripBlock) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.StakeSumFromDelegator[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LastDripBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3649,15 +4089,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xc2
 		}
 	}
-	if len(m.StakeReputerAuthority) > 0 {
-		for iNdEx := len(m.StakeReputerAuthority) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.OpenWorkerWindows) > 0 {
+		for iNdEx := len(m.OpenWorkerWindows) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.StakeReputerAuthority[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.OpenWorkerWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3665,15 +4105,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xba
 		}
 	}
-	if len(m.TopicStake) > 0 {
-		for iNdEx := len(m.TopicStake) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicLastReputerCommit) > 0 {
+		for iNdEx := len(m.TopicLastReputerCommit) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicStake[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicLastReputerCommit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3681,27 +4121,31 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xb2
 		}
 	}
-	{
-		size := m.TotalStake.Size()
-		i -= size
-		if _, err := m.TotalStake.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.TopicLastWorkerCommit) > 0 {
+		for iNdEx := len(m.TopicLastWorkerCommit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopicLastWorkerCommit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xaa
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xaa
-	if len(m.PreviousForecasterScoreRatio) > 0 {
-		for iNdEx := len(m.PreviousForecasterScoreRatio) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestOneOutForecasterForecasterNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestOneOutForecasterForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PreviousForecasterScoreRatio[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestOneOutForecasterForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3709,15 +4153,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xa2
 		}
 	}
-	if len(m.PreviousForecastRewardFraction) > 0 {
-		for iNdEx := len(m.PreviousForecastRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestOneOutForecasterInfererNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestOneOutForecasterInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PreviousForecastRewardFraction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestOneOutForecasterInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3725,15 +4169,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0x9a
 		}
 	}
-	if len(m.PreviousInferenceRewardFraction) > 0 {
-		for iNdEx := len(m.PreviousInferenceRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestOneOutInfererForecasterNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestOneOutInfererForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PreviousInferenceRewardFraction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestOneOutInfererForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3741,15 +4185,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0x92
 		}
 	}
-	if len(m.PreviousReputerRewardFraction) > 0 {
-		for iNdEx := len(m.PreviousReputerRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestOneOutInfererInfererNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestOneOutInfererInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PreviousReputerRewardFraction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestOneOutInfererInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3757,15 +4201,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0x8a
 		}
 	}
-	if len(m.ReputerListeningCoefficient) > 0 {
-		for iNdEx := len(m.ReputerListeningCoefficient) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestNaiveInfererNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestNaiveInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ReputerListeningCoefficient[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestNaiveInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3773,15 +4217,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0x82
 		}
 	}
-	if len(m.ReputerScoresByBlock) > 0 {
-		for iNdEx := len(m.ReputerScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestOneInForecasterNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestOneInForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ReputerScoresByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestOneInForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3789,13 +4233,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x62
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xfa
 		}
 	}
-	if len(m.ForecasterScoresByBlock) > 0 {
-		for iNdEx := len(m.ForecasterScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestForecasterNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ForecasterScoresByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3803,13 +4249,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x5a
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xf2
 		}
 	}
-	if len(m.InfererScoresByBlock) > 0 {
-		for iNdEx := len(m.InfererScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestInfererNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.InfererScoresByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3817,13 +4265,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xea
 		}
 	}
-	if len(m.TopicRewardNonce) > 0 {
-		for iNdEx := len(m.TopicRewardNonce) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.UnfulfilledReputerNonces) > 0 {
+		for iNdEx := len(m.UnfulfilledReputerNonces) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicRewardNonce[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.UnfulfilledReputerNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3831,13 +4281,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe2
 		}
 	}
-	if len(m.TopicReputers) > 0 {
-		for iNdEx := len(m.TopicReputers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.UnfulfilledWorkerNonces) > 0 {
+		for iNdEx := len(m.UnfulfilledWorkerNonces) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicReputers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.UnfulfilledWorkerNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3845,13 +4297,27 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
 		}
 	}
-	if len(m.TopicWorkers) > 0 {
-		for iNdEx := len(m.TopicWorkers) - 1; iNdEx >= 0; iNdEx-- {
+	{
+		size := m.PreviousPercentageRewardToStakedReputers.Size()
+		i -= size
+		if _, err := m.PreviousPercentageRewardToStakedReputers.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xd2
+	if len(m.NetworkLossBundles) > 0 {
+		for iNdEx := len(m.NetworkLossBundles) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicWorkers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.NetworkLossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3859,49 +4325,47 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xca
 		}
 	}
-	if len(m.RewardableTopics) > 0 {
-		dAtA2 := make([]byte, len(m.RewardableTopics)*10)
-		var j1 int
-		for _, num := range m.RewardableTopics {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
+	if len(m.AllLossBundles) > 0 {
+		for iNdEx := len(m.AllLossBundles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllLossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc2
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintGenesis(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x32
 	}
-	if len(m.ActiveTopics) > 0 {
-		dAtA4 := make([]byte, len(m.ActiveTopics)*10)
-		var j3 int
-		for _, num := range m.ActiveTopics {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
+	if len(m.AllForecasts) > 0 {
+		for iNdEx := len(m.AllForecasts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllForecasts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xba
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintGenesis(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0x2a
 	}
-	if len(m.Topics) > 0 {
-		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.AllInferences) > 0 {
+		for iNdEx := len(m.AllInferences) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.AllInferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3909,323 +4373,521 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb2
 		}
 	}
-	if m.NextTopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.NextTopicId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.CoreTeamAddresses) > 0 {
-		for iNdEx := len(m.CoreTeamAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CoreTeamAddresses[iNdEx])
-			copy(dAtA[i:], m.CoreTeamAddresses[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.CoreTeamAddresses[iNdEx])))
+	if len(m.PreviousTopicWeight) > 0 {
+		for iNdEx := len(m.PreviousTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousTopicWeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
 		}
 	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.TopicFeeRevenue) > 0 {
+		for iNdEx := len(m.TopicFeeRevenue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopicFeeRevenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicIdAndTopic) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicIdAndTopic) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicIdAndTopic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Topic != nil {
-		{
-			size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Reputers) > 0 {
+		for iNdEx := len(m.Reputers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reputers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x9a
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicAndActorId) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicAndActorId) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicAndActorId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.Workers) > 0 {
+		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Workers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	if len(m.Forecasts) > 0 {
+		for iNdEx := len(m.Forecasts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Forecasts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicIdAndBlockHeight) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.Inferences) > 0 {
+		for iNdEx := len(m.Inferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicIdAndBlockHeight) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicIdAndBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
+	if len(m.DelegateStakeRemovalsByActor) > 0 {
+		for iNdEx := len(m.DelegateStakeRemovalsByActor) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateStakeRemovalsByActor[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	if len(m.DelegateStakeRemovalsByBlock) > 0 {
+		for iNdEx := len(m.DelegateStakeRemovalsByBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateStakeRemovalsByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *BlockHeightAndTopicIds) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.StakeRemovalsByActor) > 0 {
+		for iNdEx := len(m.StakeRemovalsByActor) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeRemovalsByActor[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *BlockHeightAndTopicIds) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *BlockHeightAndTopicIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TopicIds) > 0 {
-		dAtA8 := make([]byte, len(m.TopicIds)*10)
-		var j7 int
-		for _, num := range m.TopicIds {
-			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j7++
+	if len(m.StakeRemovalsByBlock) > 0 {
+		for iNdEx := len(m.StakeRemovalsByBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeRemovalsByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			dAtA8[j7] = uint8(num)
-			j7++
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
 		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintGenesis(dAtA, i, uint64(j7))
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicIdBlockHeightScores) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.DelegateRewardPerShare) > 0 {
+		for iNdEx := len(m.DelegateRewardPerShare) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateRewardPerShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicIdBlockHeightScores) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicIdBlockHeightScores) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Scores != nil {
-		{
-			size, err := m.Scores.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.StakeFromDelegatorsUponReputer) > 0 {
+		for iNdEx := len(m.StakeFromDelegatorsUponReputer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeFromDelegatorsUponReputer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
+	if len(m.DelegatedStakes) > 0 {
+		for iNdEx := len(m.DelegatedStakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatedStakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	if len(m.StakeSumFromDelegator) > 0 {
+		for iNdEx := len(m.StakeSumFromDelegator) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeSumFromDelegator[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicIdActorIdScore) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.StakeReputerAuthority) > 0 {
+		for iNdEx := len(m.StakeReputerAuthority) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeReputerAuthority[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicIdActorIdScore) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicIdActorIdScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Score != nil {
-		{
-			size, err := m.Score.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.TopicStake) > 0 {
+		for iNdEx := len(m.TopicStake) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopicStake[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size := m.TotalStake.Size()
+		i -= size
+		if _, err := m.TotalStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if len(m.PreviousForecasterScoreRatio) > 0 {
+		for iNdEx := len(m.PreviousForecasterScoreRatio) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousForecasterScoreRatio[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicIdActorIdUint64) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.PreviousForecastRewardFraction) > 0 {
+		for iNdEx := len(m.PreviousForecastRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousForecastRewardFraction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicIdActorIdUint64) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicIdActorIdUint64) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Uint64 != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Uint64))
-		i--
-		dAtA[i] = 0x18
+	if len(m.PreviousInferenceRewardFraction) > 0 {
+		for iNdEx := len(m.PreviousInferenceRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousInferenceRewardFraction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
 	}
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.PreviousReputerRewardFraction) > 0 {
+		for iNdEx := len(m.PreviousReputerRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousReputerRewardFraction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+	if len(m.ReputerListeningCoefficient) > 0 {
+		for iNdEx := len(m.ReputerListeningCoefficient) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReputerListeningCoefficient[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.ReputerScoresByBlock) > 0 {
+		for iNdEx := len(m.ReputerScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReputerScoresByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ForecasterScoresByBlock) > 0 {
+		for iNdEx := len(m.ForecasterScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForecasterScoresByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.InfererScoresByBlock) > 0 {
+		for iNdEx := len(m.InfererScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InfererScoresByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.TopicRewardNonce) > 0 {
+		for iNdEx := len(m.TopicRewardNonce) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopicRewardNonce[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.TopicReputers) > 0 {
+		for iNdEx := len(m.TopicReputers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopicReputers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.TopicWorkers) > 0 {
+		for iNdEx := len(m.TopicWorkers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopicWorkers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RewardableTopics) > 0 {
+		dAtA6 := make([]byte, len(m.RewardableTopics)*10)
+		var j5 int
+		for _, num := range m.RewardableTopics {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintGenesis(dAtA, i, uint64(j5))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x32
+	}
+	if len(m.ActiveTopics) > 0 {
+		dAtA8 := make([]byte, len(m.ActiveTopics)*10)
+		var j7 int
+		for _, num := range m.ActiveTopics {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintGenesis(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.NextTopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextTopicId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CoreTeamAddresses) > 0 {
+		for iNdEx := len(m.CoreTeamAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CoreTeamAddresses[iNdEx])
+			copy(dAtA[i:], m.CoreTeamAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.CoreTeamAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdActorIdListeningCoefficient) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdAndTopic) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4235,19 +4897,19 @@ func (m *TopicIdActorIdListeningCoefficient) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdActorIdListeningCoefficient) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdAndTopic) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdActorIdListeningCoefficient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdAndTopic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ListeningCoefficient != nil {
+	if m.Topic != nil {
 		{
-			size, err := m.ListeningCoefficient.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4255,13 +4917,6 @@ func (m *TopicIdActorIdListeningCoefficient) MarshalToSizedBuffer(dAtA []byte) (
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
-		i--
 		dAtA[i] = 0x12
 	}
 	if m.TopicId != 0 {
@@ -4272,7 +4927,7 @@ func (m *TopicIdActorIdListeningCoefficient) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdActorIdDec) Marshal() (dAtA []byte, err error) {
+func (m *TopicAndActorId) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4282,26 +4937,16 @@ func (m *TopicIdActorIdDec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdActorIdDec) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicAndActorId) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdActorIdDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicAndActorId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Dec.Size()
-		i -= size
-		if _, err := m.Dec.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
 	if len(m.ActorId) > 0 {
 		i -= len(m.ActorId)
 		copy(dAtA[i:], m.ActorId)
@@ -4317,7 +4962,7 @@ func (m *TopicIdActorIdDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdAndInt) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdAndBlockHeight) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4327,26 +4972,21 @@ func (m *TopicIdAndInt) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdAndInt) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdAndBlockHeight) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdAndInt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdAndBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Int.Size()
-		i -= size
-		if _, err := m.Int.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	i--
-	dAtA[i] = 0x12
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
@@ -4355,7 +4995,7 @@ func (m *TopicIdAndInt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdActorIdInt) Marshal() (dAtA []byte, err error) {
+func (m *BlockHeightAndTopicIds) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4365,42 +5005,43 @@ func (m *TopicIdActorIdInt) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdActorIdInt) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockHeightAndTopicIds) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdActorIdInt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockHeightAndTopicIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Int.Size()
-		i -= size
-		if _, err := m.Int.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.TopicIds) > 0 {
+		dAtA12 := make([]byte, len(m.TopicIds)*10)
+		var j11 int
+		for _, num := range m.TopicIds {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintGenesis(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdDelegatorReputerDelegatorInfo) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdBlockHeightScores) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4410,19 +5051,19 @@ func (m *TopicIdDelegatorReputerDelegatorInfo) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdDelegatorReputerDelegatorInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdBlockHeightScores) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdDelegatorReputerDelegatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdBlockHeightScores) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DelegatorInfo != nil {
+	if m.Scores != nil {
 		{
-			size, err := m.DelegatorInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Scores.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4430,21 +5071,12 @@ func (m *TopicIdDelegatorReputerDelegatorInfo) MarshalToSizedBuffer(dAtA []byte)
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
-		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
@@ -4454,7 +5086,7 @@ func (m *TopicIdDelegatorReputerDelegatorInfo) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdScore) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4464,19 +5096,19 @@ func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdScore) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.StakeRemovalInfo != nil {
+	if m.Score != nil {
 		{
-			size, err := m.StakeRemovalInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Score.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4484,29 +5116,24 @@ func (m *BlockHeightTopicIdReputerStakeRemovalInfo) MarshalToSizedBuffer(dAtA []
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x1a
 	}
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0x10
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
-		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ActorIdTopicIdBlockHeight) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdUint64) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4516,37 +5143,37 @@ func (m *ActorIdTopicIdBlockHeight) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ActorIdTopicIdBlockHeight) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdUint64) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ActorIdTopicIdBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdUint64) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+	if m.Uint64 != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Uint64))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
-	}
 	if len(m.ActorId) > 0 {
 		i -= len(m.ActorId)
 		copy(dAtA[i:], m.ActorId)
 		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdListeningCoefficient) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4556,19 +5183,19 @@ func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Marshal() (
 	return dAtA[:n], nil
 }
 
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdListeningCoefficient) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdListeningCoefficient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DelegateStakeRemovalInfo != nil {
+	if m.ListeningCoefficient != nil {
 		{
-			size, err := m.DelegateStakeRemovalInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ListeningCoefficient.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4576,36 +5203,24 @@ func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) MarshalToSi
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
-		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x1a
 	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0x10
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
-		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegatorReputerTopicIdBlockHeight) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdReputerSlashingInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4615,44 +5230,44 @@ func (m *DelegatorReputerTopicIdBlockHeight) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *DelegatorReputerTopicIdBlockHeight) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdReputerSlashingInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegatorReputerTopicIdBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdReputerSlashingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+	if m.SlashingInfo != nil {
+		{
+			size, err := m.SlashingInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdActorIdInference) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdBlockHeightActorIdWorkerCommitment) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4662,19 +5277,19 @@ func (m *TopicIdActorIdInference) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdActorIdInference) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdBlockHeightActorIdWorkerCommitment) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdActorIdInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdBlockHeightActorIdWorkerCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Inference != nil {
+	if m.Commitment != nil {
 		{
-			size, err := m.Inference.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Commitment.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4682,14 +5297,19 @@ func (m *TopicIdActorIdInference) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
 	if len(m.ActorId) > 0 {
 		i -= len(m.ActorId)
 		copy(dAtA[i:], m.ActorId)
 		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
@@ -4699,7 +5319,7 @@ func (m *TopicIdActorIdInference) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdActorIdForecast) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdSponsorActorSponsoredFees) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4709,19 +5329,19 @@ func (m *TopicIdActorIdForecast) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdActorIdForecast) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdSponsorActorSponsoredFees) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdActorIdForecast) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdSponsorActorSponsoredFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Forecast != nil {
+	if m.Fees != nil {
 		{
-			size, err := m.Forecast.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Fees.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4729,12 +5349,19 @@ func (m *TopicIdActorIdForecast) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Actor)))
+		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Sponsor)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -4746,7 +5373,7 @@ func (m *TopicIdActorIdForecast) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *LibP2PKeyAndOffchainNode) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdBlockHeightDec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4756,39 +5383,40 @@ func (m *LibP2PKeyAndOffchainNode) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LibP2PKeyAndOffchainNode) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdBlockHeightDec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LibP2PKeyAndOffchainNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdBlockHeightDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.OffchainNode != nil {
-		{
-			size, err := m.OffchainNode.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+	{
+		size := m.Dec.Size()
+		i -= size
+		if _, err := m.Dec.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.LibP2PKey) > 0 {
-		i -= len(m.LibP2PKey)
-		copy(dAtA[i:], m.LibP2PKey)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.LibP2PKey)))
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdAndDec) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdDec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4798,12 +5426,12 @@ func (m *TopicIdAndDec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdAndDec) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdDec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdAndDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4817,7 +5445,14 @@ func (m *TopicIdAndDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x1a
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
@@ -4826,7 +5461,7 @@ func (m *TopicIdAndDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdBlockHeightInferences) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdAndUint64) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4836,30 +5471,18 @@ func (m *TopicIdBlockHeightInferences) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdBlockHeightInferences) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdAndUint64) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdBlockHeightInferences) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdAndUint64) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Inferences != nil {
-		{
-			size, err := m.Inferences.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+	if m.Uint64 != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Uint64))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -4871,7 +5494,7 @@ func (m *TopicIdBlockHeightInferences) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdBlockHeightForecasts) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdAndInt) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4881,33 +5504,26 @@ func (m *TopicIdBlockHeightForecasts) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdBlockHeightForecasts) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdAndInt) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdBlockHeightForecasts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdAndInt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Forecasts != nil {
-		{
-			size, err := m.Forecasts.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+	{
+		size := m.Int.Size()
+		i -= size
+		if _, err := m.Int.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
@@ -4916,7 +5532,7 @@ func (m *TopicIdBlockHeightForecasts) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdBlockHeightReputerValueBundles) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdInt) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4926,32 +5542,32 @@ func (m *TopicIdBlockHeightReputerValueBundles) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdBlockHeightReputerValueBundles) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdInt) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdBlockHeightReputerValueBundles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdInt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ReputerValueBundles != nil {
-		{
-			size, err := m.ReputerValueBundles.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+	{
+		size := m.Int.Size()
+		i -= size
+		if _, err := m.Int.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
@@ -4961,7 +5577,7 @@ func (m *TopicIdBlockHeightReputerValueBundles) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdBlockHeightValueBundles) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdDelegatorReputer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4971,32 +5587,29 @@ func (m *TopicIdBlockHeightValueBundles) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdBlockHeightValueBundles) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdDelegatorReputer) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdBlockHeightValueBundles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdDelegatorReputer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ValueBundle != nil {
-		{
-			size, err := m.ValueBundle.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
@@ -5006,7 +5619,7 @@ func (m *TopicIdBlockHeightValueBundles) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdAndNonces) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdDelegatorReputerDelegatorInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5016,19 +5629,19 @@ func (m *TopicIdAndNonces) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdAndNonces) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdDelegatorReputerDelegatorInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdAndNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdDelegatorReputerDelegatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Nonces != nil {
+	if m.DelegatorInfo != nil {
 		{
-			size, err := m.Nonces.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DelegatorInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5036,6 +5649,20 @@ func (m *TopicIdAndNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
+		i--
 		dAtA[i] = 0x12
 	}
 	if m.TopicId != 0 {
@@ -5046,7 +5673,7 @@ func (m *TopicIdAndNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdAndReputerRequestNonces) Marshal() (dAtA []byte, err error) {
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5056,19 +5683,19 @@ func (m *TopicIdAndReputerRequestNonces) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdAndReputerRequestNonces) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdAndReputerRequestNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ReputerRequestNonces != nil {
+	if m.StakeRemovalInfo != nil {
 		{
-			size, err := m.ReputerRequestNonces.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.StakeRemovalInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5076,17 +5703,29 @@ func (m *TopicIdAndReputerRequestNonces) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
+	}
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdActorIdTimeStampedValue) Marshal() (dAtA []byte, err error) {
+func (m *ActorIdTopicIdBlockHeight) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5096,44 +5735,37 @@ func (m *TopicIdActorIdTimeStampedValue) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdActorIdTimeStampedValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *ActorIdTopicIdBlockHeight) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdActorIdTimeStampedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ActorIdTopicIdBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TimestampedValue != nil {
-		{
-			size, err := m.TimestampedValue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
 	}
 	if len(m.ActorId) > 0 {
 		i -= len(m.ActorId)
 		copy(dAtA[i:], m.ActorId)
 		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdActorIdActorIdTimeStampedValue) Marshal() (dAtA []byte, err error) {
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5143,19 +5775,19 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdActorIdActorIdTimeStampedValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdActorIdActorIdTimeStampedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TimestampedValue != nil {
+	if m.DelegateStakeRemovalInfo != nil {
 		{
-			size, err := m.TimestampedValue.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DelegateStakeRemovalInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5163,31 +5795,36 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) MarshalToSizedBuffer(dAtA []byte
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x2a
 	}
-	if len(m.ActorId2) > 0 {
-		i -= len(m.ActorId2)
-		copy(dAtA[i:], m.ActorId2)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId2)))
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
-	if len(m.ActorId1) > 0 {
-		i -= len(m.ActorId1)
-		copy(dAtA[i:], m.ActorId1)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId1)))
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdTimestampedActorNonce) Marshal() (dAtA []byte, err error) {
+func (m *DelegatorReputerTopicIdBlockHeight) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5197,37 +5834,44 @@ func (m *TopicIdTimestampedActorNonce) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdTimestampedActorNonce) MarshalTo(dAtA []byte) (int, error) {
+func (m *DelegatorReputerTopicIdBlockHeight) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdTimestampedActorNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DelegatorReputerTopicIdBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TimestampedActorNonce != nil {
-		{
-			size, err := m.TimestampedActorNonce.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x20
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x18
+	}
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockHeightTopicIds) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdInference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5237,19 +5881,19 @@ func (m *BlockHeightTopicIds) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockHeightTopicIds) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdInference) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockHeightTopicIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TopicIds != nil {
+	if m.Inference != nil {
 		{
-			size, err := m.TopicIds.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Inference.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5257,17 +5901,24 @@ func (m *BlockHeightTopicIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
+		i--
 		dAtA[i] = 0x12
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockHeightTopicIdWeightPair) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdForecast) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5277,19 +5928,19 @@ func (m *BlockHeightTopicIdWeightPair) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockHeightTopicIdWeightPair) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdForecast) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockHeightTopicIdWeightPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdForecast) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TopicWeight != nil {
+	if m.Forecast != nil {
 		{
-			size, err := m.TopicWeight.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Forecast.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5297,17 +5948,24 @@ func (m *BlockHeightTopicIdWeightPair) MarshalToSizedBuffer(dAtA []byte) (int, e
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdReputerReputerValueBundle) Marshal() (dAtA []byte, err error) {
+func (m *LibP2PKeyAndOffchainNode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5317,19 +5975,19 @@ func (m *TopicIdReputerReputerValueBundle) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdReputerReputerValueBundle) MarshalTo(dAtA []byte) (int, error) {
+func (m *LibP2PKeyAndOffchainNode) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdReputerReputerValueBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LibP2PKeyAndOffchainNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ReputerValueBundle != nil {
+	if m.OffchainNode != nil {
 		{
-			size, err := m.ReputerValueBundle.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.OffchainNode.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5337,15 +5995,48 @@ func (m *TopicIdReputerReputerValueBundle) MarshalToSizedBuffer(dAtA []byte) (in
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
+	if len(m.LibP2PKey) > 0 {
+		i -= len(m.LibP2PKey)
+		copy(dAtA[i:], m.LibP2PKey)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.LibP2PKey)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdAndDec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdAndDec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdAndDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Dec.Size()
+		i -= size
+		if _, err := m.Dec.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
@@ -5354,638 +6045,1100 @@ func (m *TopicIdReputerReputerValueBundle) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TopicIdBlockHeightInferences) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TopicIdBlockHeightInferences) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdBlockHeightInferences) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.CoreTeamAddresses) > 0 {
-		for _, s := range m.CoreTeamAddresses {
-			l = len(s)
-			n += 1 + l + sovGenesis(uint64(l))
+	if m.Inferences != nil {
+		{
+			size, err := m.Inferences.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.NextTopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.NextTopicId))
-	}
-	if len(m.Topics) > 0 {
-		for _, e := range m.Topics {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.ActiveTopics) > 0 {
-		l = 0
-		for _, e := range m.ActiveTopics {
-			l += sovGenesis(uint64(e))
-		}
-		n += 1 + sovGenesis(uint64(l)) + l
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.RewardableTopics) > 0 {
-		l = 0
-		for _, e := range m.RewardableTopics {
-			l += sovGenesis(uint64(e))
-		}
-		n += 1 + sovGenesis(uint64(l)) + l
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdBlockHeightForecasts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.TopicWorkers) > 0 {
-		for _, e := range m.TopicWorkers {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdBlockHeightForecasts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdBlockHeightForecasts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Forecasts != nil {
+		{
+			size, err := m.Forecasts.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.TopicReputers) > 0 {
-		for _, e := range m.TopicReputers {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.TopicRewardNonce) > 0 {
-		for _, e := range m.TopicRewardNonce {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.InfererScoresByBlock) > 0 {
-		for _, e := range m.InfererScoresByBlock {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdBlockHeightReputerValueBundles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ForecasterScoresByBlock) > 0 {
-		for _, e := range m.ForecasterScoresByBlock {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdBlockHeightReputerValueBundles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdBlockHeightReputerValueBundles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReputerValueBundles != nil {
+		{
+			size, err := m.ReputerValueBundles.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.ReputerScoresByBlock) > 0 {
-		for _, e := range m.ReputerScoresByBlock {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.ReputerListeningCoefficient) > 0 {
-		for _, e := range m.ReputerListeningCoefficient {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.PreviousReputerRewardFraction) > 0 {
-		for _, e := range m.PreviousReputerRewardFraction {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdBlockHeightValueBundles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PreviousInferenceRewardFraction) > 0 {
-		for _, e := range m.PreviousInferenceRewardFraction {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdBlockHeightValueBundles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdBlockHeightValueBundles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValueBundle != nil {
+		{
+			size, err := m.ValueBundle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.PreviousForecastRewardFraction) > 0 {
-		for _, e := range m.PreviousForecastRewardFraction {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.PreviousForecasterScoreRatio) > 0 {
-		for _, e := range m.PreviousForecasterScoreRatio {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.TotalStake.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	if len(m.TopicStake) > 0 {
-		for _, e := range m.TopicStake {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdAndNonces) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.StakeReputerAuthority) > 0 {
-		for _, e := range m.StakeReputerAuthority {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdAndNonces) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdAndNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonces != nil {
+		{
+			size, err := m.Nonces.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.StakeSumFromDelegator) > 0 {
-		for _, e := range m.StakeSumFromDelegator {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.DelegatedStakes) > 0 {
-		for _, e := range m.DelegatedStakes {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdAndReputerRequestNonces) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.StakeFromDelegatorsUponReputer) > 0 {
-		for _, e := range m.StakeFromDelegatorsUponReputer {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdAndReputerRequestNonces) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdAndReputerRequestNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReputerRequestNonces != nil {
+		{
+			size, err := m.ReputerRequestNonces.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.DelegateRewardPerShare) > 0 {
-		for _, e := range m.DelegateRewardPerShare {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.StakeRemovalsByBlock) > 0 {
-		for _, e := range m.StakeRemovalsByBlock {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdActorIdTimeStampedValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.StakeRemovalsByActor) > 0 {
-		for _, e := range m.StakeRemovalsByActor {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdActorIdTimeStampedValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdActorIdTimeStampedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimestampedValue != nil {
+		{
+			size, err := m.TimestampedValue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.DelegateStakeRemovalsByBlock) > 0 {
-		for _, e := range m.DelegateStakeRemovalsByBlock {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.DelegateStakeRemovalsByActor) > 0 {
-		for _, e := range m.DelegateStakeRemovalsByActor {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.Inferences) > 0 {
-		for _, e := range m.Inferences {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdActorIdActorIdTimeStampedValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdActorIdActorIdTimeStampedValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdActorIdActorIdTimeStampedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimestampedValue != nil {
+		{
+			size, err := m.TimestampedValue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Forecasts) > 0 {
-		for _, e := range m.Forecasts {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.Workers) > 0 {
-		for _, e := range m.Workers {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.Reputers) > 0 {
-		for _, e := range m.Reputers {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if len(m.ActorId2) > 0 {
+		i -= len(m.ActorId2)
+		copy(dAtA[i:], m.ActorId2)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId2)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.TopicFeeRevenue) > 0 {
-		for _, e := range m.TopicFeeRevenue {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if len(m.ActorId1) > 0 {
+		i -= len(m.ActorId1)
+		copy(dAtA[i:], m.ActorId1)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId1)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.PreviousTopicWeight) > 0 {
-		for _, e := range m.PreviousTopicWeight {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.AllInferences) > 0 {
-		for _, e := range m.AllInferences {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdTimestampedActorNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.AllForecasts) > 0 {
-		for _, e := range m.AllForecasts {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdTimestampedActorNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdTimestampedActorNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimestampedActorNonce != nil {
+		{
+			size, err := m.TimestampedActorNonce.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.AllLossBundles) > 0 {
-		for _, e := range m.AllLossBundles {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.NetworkLossBundles) > 0 {
-		for _, e := range m.NetworkLossBundles {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockHeightTopicIds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.PreviousPercentageRewardToStakedReputers.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	if len(m.UnfulfilledWorkerNonces) > 0 {
-		for _, e := range m.UnfulfilledWorkerNonces {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *BlockHeightTopicIds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockHeightTopicIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicIds != nil {
+		{
+			size, err := m.TopicIds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.UnfulfilledReputerNonces) > 0 {
-		for _, e := range m.UnfulfilledReputerNonces {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.LatestInfererNetworkRegrets) > 0 {
-		for _, e := range m.LatestInfererNetworkRegrets {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockHeightTopicIdWeightPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.LatestForecasterNetworkRegrets) > 0 {
-		for _, e := range m.LatestForecasterNetworkRegrets {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *BlockHeightTopicIdWeightPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockHeightTopicIdWeightPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicWeight != nil {
+		{
+			size, err := m.TopicWeight.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.LatestOneInForecasterNetworkRegrets) > 0 {
-		for _, e := range m.LatestOneInForecasterNetworkRegrets {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.LatestNaiveInfererNetworkRegrets) > 0 {
-		for _, e := range m.LatestNaiveInfererNetworkRegrets {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdReputerReputerValueBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.LatestOneOutInfererInfererNetworkRegrets) > 0 {
-		for _, e := range m.LatestOneOutInfererInfererNetworkRegrets {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdReputerReputerValueBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdReputerReputerValueBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReputerValueBundle != nil {
+		{
+			size, err := m.ReputerValueBundle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.LatestOneOutInfererForecasterNetworkRegrets) > 0 {
-		for _, e := range m.LatestOneOutInfererForecasterNetworkRegrets {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.LatestOneOutForecasterInfererNetworkRegrets) > 0 {
-		for _, e := range m.LatestOneOutForecasterInfererNetworkRegrets {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.LatestOneOutForecasterForecasterNetworkRegrets) > 0 {
-		for _, e := range m.LatestOneOutForecasterForecasterNetworkRegrets {
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.CoreTeamAddresses) > 0 {
+		for _, s := range m.CoreTeamAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextTopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextTopicId))
+	}
+	if len(m.Topics) > 0 {
+		for _, e := range m.Topics {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ActiveTopics) > 0 {
+		l = 0
+		for _, e := range m.ActiveTopics {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.RewardableTopics) > 0 {
+		l = 0
+		for _, e := range m.RewardableTopics {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.TopicWorkers) > 0 {
+		for _, e := range m.TopicWorkers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicReputers) > 0 {
+		for _, e := range m.TopicReputers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicRewardNonce) > 0 {
+		for _, e := range m.TopicRewardNonce {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InfererScoresByBlock) > 0 {
+		for _, e := range m.InfererScoresByBlock {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ForecasterScoresByBlock) > 0 {
+		for _, e := range m.ForecasterScoresByBlock {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReputerScoresByBlock) > 0 {
+		for _, e := range m.ReputerScoresByBlock {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReputerListeningCoefficient) > 0 {
+		for _, e := range m.ReputerListeningCoefficient {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.TopicLastWorkerCommit) > 0 {
-		for _, e := range m.TopicLastWorkerCommit {
+	if len(m.PreviousReputerRewardFraction) > 0 {
+		for _, e := range m.PreviousReputerRewardFraction {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.TopicLastReputerCommit) > 0 {
-		for _, e := range m.TopicLastReputerCommit {
+	if len(m.PreviousInferenceRewardFraction) > 0 {
+		for _, e := range m.PreviousInferenceRewardFraction {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.OpenWorkerWindows) > 0 {
-		for _, e := range m.OpenWorkerWindows {
+	if len(m.PreviousForecastRewardFraction) > 0 {
+		for _, e := range m.PreviousForecastRewardFraction {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.LastDripBlock) > 0 {
-		for _, e := range m.LastDripBlock {
+	if len(m.PreviousForecasterScoreRatio) > 0 {
+		for _, e := range m.PreviousForecasterScoreRatio {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.TopicToNextPossibleChurningBlock) > 0 {
-		for _, e := range m.TopicToNextPossibleChurningBlock {
+	l = m.TotalStake.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.TopicStake) > 0 {
+		for _, e := range m.TopicStake {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.BlockToActiveTopics) > 0 {
-		for _, e := range m.BlockToActiveTopics {
+	if len(m.StakeReputerAuthority) > 0 {
+		for _, e := range m.StakeReputerAuthority {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.BlockToLowestActiveTopicWeight) > 0 {
-		for _, e := range m.BlockToLowestActiveTopicWeight {
+	if len(m.StakeSumFromDelegator) > 0 {
+		for _, e := range m.StakeSumFromDelegator {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.InfererScoreEmas) > 0 {
-		for _, e := range m.InfererScoreEmas {
+	if len(m.DelegatedStakes) > 0 {
+		for _, e := range m.DelegatedStakes {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.ForecasterScoreEmas) > 0 {
-		for _, e := range m.ForecasterScoreEmas {
+	if len(m.StakeFromDelegatorsUponReputer) > 0 {
+		for _, e := range m.StakeFromDelegatorsUponReputer {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.ReputerScoreEmas) > 0 {
-		for _, e := range m.ReputerScoreEmas {
+	if len(m.DelegateRewardPerShare) > 0 {
+		for _, e := range m.DelegateRewardPerShare {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.PreviousTopicQuantileInfererScoreEma) > 0 {
-		for _, e := range m.PreviousTopicQuantileInfererScoreEma {
+	if len(m.StakeRemovalsByBlock) > 0 {
+		for _, e := range m.StakeRemovalsByBlock {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.PreviousTopicQuantileForecasterScoreEma) > 0 {
-		for _, e := range m.PreviousTopicQuantileForecasterScoreEma {
+	if len(m.StakeRemovalsByActor) > 0 {
+		for _, e := range m.StakeRemovalsByActor {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.PreviousTopicQuantileReputerScoreEma) > 0 {
-		for _, e := range m.PreviousTopicQuantileReputerScoreEma {
+	if len(m.DelegateStakeRemovalsByBlock) > 0 {
+		for _, e := range m.DelegateStakeRemovalsByBlock {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.CountInfererInclusionsInTopicActiveSet) > 0 {
-		for _, e := range m.CountInfererInclusionsInTopicActiveSet {
+	if len(m.DelegateStakeRemovalsByActor) > 0 {
+		for _, e := range m.DelegateStakeRemovalsByActor {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.CountForecasterInclusionsInTopicActiveSet) > 0 {
-		for _, e := range m.CountForecasterInclusionsInTopicActiveSet {
+	if len(m.Inferences) > 0 {
+		for _, e := range m.Inferences {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.ActiveInferers) > 0 {
-		for _, e := range m.ActiveInferers {
+	if len(m.Forecasts) > 0 {
+		for _, e := range m.Forecasts {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.ActiveForecasters) > 0 {
-		for _, e := range m.ActiveForecasters {
+	if len(m.Workers) > 0 {
+		for _, e := range m.Workers {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.LowestInfererScoreEma) > 0 {
-		for _, e := range m.LowestInfererScoreEma {
+	if len(m.Reputers) > 0 {
+		for _, e := range m.Reputers {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.LowestForecasterScoreEma) > 0 {
-		for _, e := range m.LowestForecasterScoreEma {
+	if len(m.TopicFeeRevenue) > 0 {
+		for _, e := range m.TopicFeeRevenue {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.ActiveReputers) > 0 {
-		for _, e := range m.ActiveReputers {
+	if len(m.PreviousTopicWeight) > 0 {
+		for _, e := range m.PreviousTopicWeight {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.LowestReputerScoreEma) > 0 {
-		for _, e := range m.LowestReputerScoreEma {
+	if len(m.AllInferences) > 0 {
+		for _, e := range m.AllInferences {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.LossBundles) > 0 {
-		for _, e := range m.LossBundles {
+	if len(m.AllForecasts) > 0 {
+		for _, e := range m.AllForecasts {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	l = m.TotalSumPreviousTopicWeights.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	l = m.RewardCurrentBlockEmission.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func (m *TopicIdAndTopic) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.AllLossBundles) > 0 {
+		for _, e := range m.AllLossBundles {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if len(m.NetworkLossBundles) > 0 {
+		for _, e := range m.NetworkLossBundles {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.Topic != nil {
-		l = m.Topic.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	l = m.PreviousPercentageRewardToStakedReputers.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.UnfulfilledWorkerNonces) > 0 {
+		for _, e := range m.UnfulfilledWorkerNonces {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *TopicAndActorId) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.UnfulfilledReputerNonces) > 0 {
+		for _, e := range m.UnfulfilledReputerNonces {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if len(m.LatestInfererNetworkRegrets) > 0 {
+		for _, e := range m.LatestInfererNetworkRegrets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	l = len(m.ActorId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.LatestForecasterNetworkRegrets) > 0 {
+		for _, e := range m.LatestForecasterNetworkRegrets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *TopicIdAndBlockHeight) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.LatestOneInForecasterNetworkRegrets) > 0 {
+		for _, e := range m.LatestOneInForecasterNetworkRegrets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	if len(m.LatestNaiveInfererNetworkRegrets) > 0 {
+		for _, e := range m.LatestNaiveInfererNetworkRegrets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *BlockHeightAndTopicIds) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.LatestOneOutInfererInfererNetworkRegrets) > 0 {
+		for _, e := range m.LatestOneOutInfererInfererNetworkRegrets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	if len(m.LatestOneOutInfererForecasterNetworkRegrets) > 0 {
+		for _, e := range m.LatestOneOutInfererForecasterNetworkRegrets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	if len(m.TopicIds) > 0 {
-		l = 0
-		for _, e := range m.TopicIds {
-			l += sovGenesis(uint64(e))
+	if len(m.LatestOneOutForecasterInfererNetworkRegrets) > 0 {
+		for _, e := range m.LatestOneOutForecasterInfererNetworkRegrets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
 		}
-		n += 1 + sovGenesis(uint64(l)) + l
 	}
-	return n
-}
-
-func (m *TopicIdBlockHeightScores) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.LatestOneOutForecasterForecasterNetworkRegrets) > 0 {
+		for _, e := range m.LatestOneOutForecasterForecasterNetworkRegrets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if len(m.TopicLastWorkerCommit) > 0 {
+		for _, e := range m.TopicLastWorkerCommit {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	if len(m.TopicLastReputerCommit) > 0 {
+		for _, e := range m.TopicLastReputerCommit {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.Scores != nil {
-		l = m.Scores.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.OpenWorkerWindows) > 0 {
+		for _, e := range m.OpenWorkerWindows {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *TopicIdActorIdScore) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.LastDripBlock) > 0 {
+		for _, e := range m.LastDripBlock {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if len(m.TopicToNextPossibleChurningBlock) > 0 {
+		for _, e := range m.TopicToNextPossibleChurningBlock {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	l = len(m.ActorId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BlockToActiveTopics) > 0 {
+		for _, e := range m.BlockToActiveTopics {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.Score != nil {
-		l = m.Score.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BlockToLowestActiveTopicWeight) > 0 {
+		for _, e := range m.BlockToLowestActiveTopicWeight {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *TopicIdActorIdUint64) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.InfererScoreEmas) > 0 {
+		for _, e := range m.InfererScoreEmas {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if len(m.ForecasterScoreEmas) > 0 {
+		for _, e := range m.ForecasterScoreEmas {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	l = len(m.ActorId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ReputerScoreEmas) > 0 {
+		for _, e := range m.ReputerScoreEmas {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.Uint64 != 0 {
-		n += 1 + sovGenesis(uint64(m.Uint64))
+	if len(m.PreviousTopicQuantileInfererScoreEma) > 0 {
+		for _, e := range m.PreviousTopicQuantileInfererScoreEma {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *TopicIdActorIdListeningCoefficient) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.PreviousTopicQuantileForecasterScoreEma) > 0 {
+		for _, e := range m.PreviousTopicQuantileForecasterScoreEma {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if len(m.PreviousTopicQuantileReputerScoreEma) > 0 {
+		for _, e := range m.PreviousTopicQuantileReputerScoreEma {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	l = len(m.ActorId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.CountInfererInclusionsInTopicActiveSet) > 0 {
+		for _, e := range m.CountInfererInclusionsInTopicActiveSet {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.ListeningCoefficient != nil {
-		l = m.ListeningCoefficient.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.CountForecasterInclusionsInTopicActiveSet) > 0 {
+		for _, e := range m.CountForecasterInclusionsInTopicActiveSet {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *TopicIdActorIdDec) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ActiveInferers) > 0 {
+		for _, e := range m.ActiveInferers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if len(m.ActiveForecasters) > 0 {
+		for _, e := range m.ActiveForecasters {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	l = len(m.ActorId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.LowestInfererScoreEma) > 0 {
+		for _, e := range m.LowestInfererScoreEma {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	l = m.Dec.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func (m *TopicIdAndInt) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.LowestForecasterScoreEma) > 0 {
+		for _, e := range m.LowestForecasterScoreEma {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if len(m.ActiveReputers) > 0 {
+		for _, e := range m.ActiveReputers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	l = m.Int.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func (m *TopicIdActorIdInt) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.LowestReputerScoreEma) > 0 {
+		for _, e := range m.LowestReputerScoreEma {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if len(m.LossBundles) > 0 {
+		for _, e := range m.LossBundles {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	l = len(m.ActorId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	l = m.TotalSumPreviousTopicWeights.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.RewardCurrentBlockEmission.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.ScheduledTopicUpdates) > 0 {
+		for _, e := range m.ScheduledTopicUpdates {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicRetirements) > 0 {
+		for _, e := range m.TopicRetirements {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GmpAllowedSources) > 0 {
+		for _, e := range m.GmpAllowedSources {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextGmpSubscriptionId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextGmpSubscriptionId))
+	}
+	if len(m.GmpSubscriptions) > 0 {
+		for _, e := range m.GmpSubscriptions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GmpDeliveries) > 0 {
+		for _, e := range m.GmpDeliveries {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicWorkerAllowlistEnabled) > 0 {
+		l = 0
+		for _, e := range m.TopicWorkerAllowlistEnabled {
+			l += sovGenesis(uint64(e))
+		}
+		n += 2 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.TopicReputerAllowlistEnabled) > 0 {
+		l = 0
+		for _, e := range m.TopicReputerAllowlistEnabled {
+			l += sovGenesis(uint64(e))
+		}
+		n += 2 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.TopicWorkerAllowlist) > 0 {
+		for _, e := range m.TopicWorkerAllowlist {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicReputerAllowlist) > 0 {
+		for _, e := range m.TopicReputerAllowlist {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReputerSlashingInfo) > 0 {
+		for _, e := range m.ReputerSlashingInfo {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WorkerCommitments) > 0 {
+		for _, e := range m.WorkerCommitments {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GroundTruths) > 0 {
+		for _, e := range m.GroundTruths {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NetworkInferenceArchive) > 0 {
+		for _, e := range m.NetworkInferenceArchive {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicSponsorships) > 0 {
+		for _, e := range m.TopicSponsorships {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SponsoredActorFees) > 0 {
+		for _, e := range m.SponsoredActorFees {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoCompoundingDelegations) > 0 {
+		for _, e := range m.AutoCompoundingDelegations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Redelegations) > 0 {
+		for _, e := range m.Redelegations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextRedelegationId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextRedelegationId))
+	}
+	if len(m.AccruedRewards) > 0 {
+		for _, e := range m.AccruedRewards {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicDataSendingFees) > 0 {
+		for _, e := range m.TopicDataSendingFees {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicPayloadsReceived) > 0 {
+		for _, e := range m.TopicPayloadsReceived {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	l = m.Int.Size()
-	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
-func (m *TopicIdDelegatorReputerDelegatorInfo) Size() (n int) {
+func (m *TopicIdAndTopic) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5994,54 +7147,35 @@ func (m *TopicIdDelegatorReputerDelegatorInfo) Size() (n int) {
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.DelegatorInfo != nil {
-		l = m.DelegatorInfo.Size()
+	if m.Topic != nil {
+		l = m.Topic.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
 	return n
 }
 
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Size() (n int) {
+func (m *TopicAndActorId) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
-	}
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	l = len(m.Reputer)
+	l = len(m.ActorId)
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	if m.StakeRemovalInfo != nil {
-		l = m.StakeRemovalInfo.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
 	return n
 }
 
-func (m *ActorIdTopicIdBlockHeight) Size() (n int) {
+func (m *TopicIdAndBlockHeight) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ActorId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
@@ -6051,7 +7185,7 @@ func (m *ActorIdTopicIdBlockHeight) Size() (n int) {
 	return n
 }
 
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Size() (n int) {
+func (m *BlockHeightAndTopicIds) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6060,48 +7194,36 @@ func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Size() (n i
 	if m.BlockHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockHeight))
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
-	}
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.DelegateStakeRemovalInfo != nil {
-		l = m.DelegateStakeRemovalInfo.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TopicIds) > 0 {
+		l = 0
+		for _, e := range m.TopicIds {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
 	}
 	return n
 }
 
-func (m *DelegatorReputerTopicIdBlockHeight) Size() (n int) {
+func (m *TopicIdBlockHeightScores) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
 	if m.BlockHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockHeight))
 	}
+	if m.Scores != nil {
+		l = m.Scores.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
-func (m *TopicIdActorIdInference) Size() (n int) {
+func (m *TopicIdActorIdScore) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6114,14 +7236,14 @@ func (m *TopicIdActorIdInference) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	if m.Inference != nil {
-		l = m.Inference.Size()
+	if m.Score != nil {
+		l = m.Score.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
 	return n
 }
 
-func (m *TopicIdActorIdForecast) Size() (n int) {
+func (m *TopicIdActorIdUint64) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6134,31 +7256,33 @@ func (m *TopicIdActorIdForecast) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	if m.Forecast != nil {
-		l = m.Forecast.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.Uint64 != 0 {
+		n += 1 + sovGenesis(uint64(m.Uint64))
 	}
 	return n
 }
 
-func (m *LibP2PKeyAndOffchainNode) Size() (n int) {
+func (m *TopicIdActorIdListeningCoefficient) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.LibP2PKey)
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = len(m.ActorId)
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	if m.OffchainNode != nil {
-		l = m.OffchainNode.Size()
+	if m.ListeningCoefficient != nil {
+		l = m.ListeningCoefficient.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
 	return n
 }
 
-func (m *TopicIdAndDec) Size() (n int) {
+func (m *TopicIdActorIdReputerSlashingInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6167,12 +7291,18 @@ func (m *TopicIdAndDec) Size() (n int) {
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	l = m.Dec.Size()
-	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.SlashingInfo != nil {
+		l = m.SlashingInfo.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
-func (m *TopicIdBlockHeightInferences) Size() (n int) {
+func (m *TopicIdBlockHeightActorIdWorkerCommitment) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6184,14 +7314,18 @@ func (m *TopicIdBlockHeightInferences) Size() (n int) {
 	if m.BlockHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockHeight))
 	}
-	if m.Inferences != nil {
-		l = m.Inferences.Size()
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Commitment != nil {
+		l = m.Commitment.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
 	return n
 }
 
-func (m *TopicIdBlockHeightForecasts) Size() (n int) {
+func (m *TopicIdSponsorActorSponsoredFees) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6200,17 +7334,22 @@ func (m *TopicIdBlockHeightForecasts) Size() (n int) {
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
 	}
-	if m.Forecasts != nil {
-		l = m.Forecasts.Size()
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Fees != nil {
+		l = m.Fees.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
 	return n
 }
 
-func (m *TopicIdBlockHeightReputerValueBundles) Size() (n int) {
+func (m *TopicIdBlockHeightDec) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6222,14 +7361,12 @@ func (m *TopicIdBlockHeightReputerValueBundles) Size() (n int) {
 	if m.BlockHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockHeight))
 	}
-	if m.ReputerValueBundles != nil {
-		l = m.ReputerValueBundles.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
+	l = m.Dec.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
-func (m *TopicIdBlockHeightValueBundles) Size() (n int) {
+func (m *TopicIdActorIdDec) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6238,17 +7375,16 @@ func (m *TopicIdBlockHeightValueBundles) Size() (n int) {
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
-	}
-	if m.ValueBundle != nil {
-		l = m.ValueBundle.Size()
+	l = len(m.ActorId)
+	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	l = m.Dec.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
-func (m *TopicIdAndNonces) Size() (n int) {
+func (m *TopicIdAndUint64) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6257,14 +7393,13 @@ func (m *TopicIdAndNonces) Size() (n int) {
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	if m.Nonces != nil {
-		l = m.Nonces.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.Uint64 != 0 {
+		n += 1 + sovGenesis(uint64(m.Uint64))
 	}
 	return n
 }
 
-func (m *TopicIdAndReputerRequestNonces) Size() (n int) {
+func (m *TopicIdAndInt) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6273,14 +7408,12 @@ func (m *TopicIdAndReputerRequestNonces) Size() (n int) {
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	if m.ReputerRequestNonces != nil {
-		l = m.ReputerRequestNonces.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
+	l = m.Int.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
-func (m *TopicIdActorIdTimeStampedValue) Size() (n int) {
+func (m *TopicIdActorIdInt) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6293,14 +7426,12 @@ func (m *TopicIdActorIdTimeStampedValue) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	if m.TimestampedValue != nil {
-		l = m.TimestampedValue.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
+	l = m.Int.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
-func (m *TopicIdActorIdActorIdTimeStampedValue) Size() (n int) {
+func (m *TopicIdDelegatorReputer) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6309,22 +7440,18 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) Size() (n int) {
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	l = len(m.ActorId1)
+	l = len(m.Delegator)
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	l = len(m.ActorId2)
+	l = len(m.Reputer)
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	if m.TimestampedValue != nil {
-		l = m.TimestampedValue.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
 	return n
 }
 
-func (m *TopicIdTimestampedActorNonce) Size() (n int) {
+func (m *TopicIdDelegatorReputerDelegatorInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6333,14 +7460,22 @@ func (m *TopicIdTimestampedActorNonce) Size() (n int) {
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	if m.TimestampedActorNonce != nil {
-		l = m.TimestampedActorNonce.Size()
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.DelegatorInfo != nil {
+		l = m.DelegatorInfo.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
 	return n
 }
 
-func (m *BlockHeightTopicIds) Size() (n int) {
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6349,14 +7484,40 @@ func (m *BlockHeightTopicIds) Size() (n int) {
 	if m.BlockHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockHeight))
 	}
-	if m.TopicIds != nil {
-		l = m.TopicIds.Size()
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.StakeRemovalInfo != nil {
+		l = m.StakeRemovalInfo.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
 	return n
 }
 
-func (m *BlockHeightTopicIdWeightPair) Size() (n int) {
+func (m *ActorIdTopicIdBlockHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6365,14 +7526,48 @@ func (m *BlockHeightTopicIdWeightPair) Size() (n int) {
 	if m.BlockHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockHeight))
 	}
-	if m.TopicWeight != nil {
-		l = m.TopicWeight.Size()
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.DelegateStakeRemovalInfo != nil {
+		l = m.DelegateStakeRemovalInfo.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
 	return n
 }
 
-func (m *TopicIdReputerReputerValueBundle) Size() (n int) {
+func (m *DelegatorReputerTopicIdBlockHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *TopicIdActorIdInference) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6381,55 +7576,1480 @@ func (m *TopicIdReputerReputerValueBundle) Size() (n int) {
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	l = len(m.Reputer)
+	l = len(m.ActorId)
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	if m.ReputerValueBundle != nil {
-		l = m.ReputerValueBundle.Size()
+	if m.Inference != nil {
+		l = m.Inference.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
 	return n
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *TopicIdActorIdForecast) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Forecast != nil {
+		l = m.Forecast.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
 }
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *LibP2PKeyAndOffchainNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LibP2PKey)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.OffchainNode != nil {
+		l = m.OffchainNode.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+
+func (m *TopicIdAndDec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = m.Dec.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *TopicIdBlockHeightInferences) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.Inferences != nil {
+		l = m.Inferences.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdBlockHeightForecasts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.Forecasts != nil {
+		l = m.Forecasts.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdBlockHeightReputerValueBundles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.ReputerValueBundles != nil {
+		l = m.ReputerValueBundles.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdBlockHeightValueBundles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.ValueBundle != nil {
+		l = m.ValueBundle.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdAndNonces) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.Nonces != nil {
+		l = m.Nonces.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdAndReputerRequestNonces) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.ReputerRequestNonces != nil {
+		l = m.ReputerRequestNonces.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdActorIdTimeStampedValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TimestampedValue != nil {
+		l = m.TimestampedValue.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdActorIdActorIdTimeStampedValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = len(m.ActorId1)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ActorId2)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TimestampedValue != nil {
+		l = m.TimestampedValue.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdTimestampedActorNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.TimestampedActorNonce != nil {
+		l = m.TimestampedActorNonce.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockHeightTopicIds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.TopicIds != nil {
+		l = m.TopicIds.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockHeightTopicIdWeightPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.TopicWeight != nil {
+		l = m.TopicWeight.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdReputerReputerValueBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ReputerValueBundle != nil {
+		l = m.ReputerValueBundle.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoreTeamAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoreTeamAddresses = append(m.CoreTeamAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTopicId", wireType)
+			}
+			m.NextTopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextTopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, &TopicIdAndTopic{})
+			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ActiveTopics = append(m.ActiveTopics, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ActiveTopics) == 0 {
+					m.ActiveTopics = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ActiveTopics = append(m.ActiveTopics, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveTopics", wireType)
+			}
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RewardableTopics = append(m.RewardableTopics, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RewardableTopics) == 0 {
+					m.RewardableTopics = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RewardableTopics = append(m.RewardableTopics, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardableTopics", wireType)
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicWorkers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicWorkers = append(m.TopicWorkers, &TopicAndActorId{})
+			if err := m.TopicWorkers[len(m.TopicWorkers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicReputers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicReputers = append(m.TopicReputers, &TopicAndActorId{})
+			if err := m.TopicReputers[len(m.TopicReputers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardNonce", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicRewardNonce = append(m.TopicRewardNonce, &TopicIdAndBlockHeight{})
+			if err := m.TopicRewardNonce[len(m.TopicRewardNonce)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfererScoresByBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InfererScoresByBlock = append(m.InfererScoresByBlock, &TopicIdBlockHeightScores{})
+			if err := m.InfererScoresByBlock[len(m.InfererScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForecasterScoresByBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForecasterScoresByBlock = append(m.ForecasterScoresByBlock, &TopicIdBlockHeightScores{})
+			if err := m.ForecasterScoresByBlock[len(m.ForecasterScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerScoresByBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReputerScoresByBlock = append(m.ReputerScoresByBlock, &TopicIdBlockHeightScores{})
+			if err := m.ReputerScoresByBlock[len(m.ReputerScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerListeningCoefficient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReputerListeningCoefficient = append(m.ReputerListeningCoefficient, &TopicIdActorIdListeningCoefficient{})
+			if err := m.ReputerListeningCoefficient[len(m.ReputerListeningCoefficient)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousReputerRewardFraction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousReputerRewardFraction = append(m.PreviousReputerRewardFraction, &TopicIdActorIdDec{})
+			if err := m.PreviousReputerRewardFraction[len(m.PreviousReputerRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousInferenceRewardFraction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousInferenceRewardFraction = append(m.PreviousInferenceRewardFraction, &TopicIdActorIdDec{})
+			if err := m.PreviousInferenceRewardFraction[len(m.PreviousInferenceRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousForecastRewardFraction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousForecastRewardFraction = append(m.PreviousForecastRewardFraction, &TopicIdActorIdDec{})
+			if err := m.PreviousForecastRewardFraction[len(m.PreviousForecastRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousForecasterScoreRatio", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousForecasterScoreRatio = append(m.PreviousForecasterScoreRatio, &TopicIdAndDec{})
+			if err := m.PreviousForecasterScoreRatio[len(m.PreviousForecasterScoreRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicStake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicStake = append(m.TopicStake, &TopicIdAndInt{})
+			if err := m.TopicStake[len(m.TopicStake)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeReputerAuthority", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeReputerAuthority = append(m.StakeReputerAuthority, &TopicIdActorIdInt{})
+			if err := m.StakeReputerAuthority[len(m.StakeReputerAuthority)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeSumFromDelegator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeSumFromDelegator = append(m.StakeSumFromDelegator, &TopicIdActorIdInt{})
+			if err := m.StakeSumFromDelegator[len(m.StakeSumFromDelegator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedStakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedStakes = append(m.DelegatedStakes, &TopicIdDelegatorReputerDelegatorInfo{})
+			if err := m.DelegatedStakes[len(m.DelegatedStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeFromDelegatorsUponReputer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeFromDelegatorsUponReputer = append(m.StakeFromDelegatorsUponReputer, &TopicIdActorIdInt{})
+			if err := m.StakeFromDelegatorsUponReputer[len(m.StakeFromDelegatorsUponReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateRewardPerShare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateRewardPerShare = append(m.DelegateRewardPerShare, &TopicIdActorIdDec{})
+			if err := m.DelegateRewardPerShare[len(m.DelegateRewardPerShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalsByBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeRemovalsByBlock = append(m.StakeRemovalsByBlock, &BlockHeightTopicIdReputerStakeRemovalInfo{})
+			if err := m.StakeRemovalsByBlock[len(m.StakeRemovalsByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalsByActor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeRemovalsByActor = append(m.StakeRemovalsByActor, &ActorIdTopicIdBlockHeight{})
+			if err := m.StakeRemovalsByActor[len(m.StakeRemovalsByActor)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalsByBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateStakeRemovalsByBlock = append(m.DelegateStakeRemovalsByBlock, &BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo{})
+			if err := m.DelegateStakeRemovalsByBlock[len(m.DelegateStakeRemovalsByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalsByActor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateStakeRemovalsByActor = append(m.DelegateStakeRemovalsByActor, &DelegatorReputerTopicIdBlockHeight{})
+			if err := m.DelegateStakeRemovalsByActor[len(m.DelegateStakeRemovalsByActor)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inferences = append(m.Inferences, &TopicIdActorIdInference{})
+			if err := m.Inferences[len(m.Inferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forecasts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Forecasts = append(m.Forecasts, &TopicIdActorIdForecast{})
+			if err := m.Forecasts[len(m.Forecasts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Workers = append(m.Workers, &LibP2PKeyAndOffchainNode{})
+			if err := m.Workers[len(m.Workers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Reputers = append(m.Reputers, &LibP2PKeyAndOffchainNode{})
+			if err := m.Reputers[len(m.Reputers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicFeeRevenue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6456,15 +9076,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicFeeRevenue = append(m.TopicFeeRevenue, &TopicIdAndInt{})
+			if err := m.TopicFeeRevenue[len(m.TopicFeeRevenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 37:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoreTeamAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicWeight", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6474,29 +9095,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CoreTeamAddresses = append(m.CoreTeamAddresses, string(dAtA[iNdEx:postIndex]))
+			m.PreviousTopicWeight = append(m.PreviousTopicWeight, &TopicIdAndDec{})
+			if err := m.PreviousTopicWeight[len(m.PreviousTopicWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextTopicId", wireType)
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllInferences", wireType)
 			}
-			m.NextTopicId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6506,14 +9129,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextTopicId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllInferences = append(m.AllInferences, &TopicIdBlockHeightInferences{})
+			if err := m.AllInferences[len(m.AllInferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 39:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllForecasts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6540,166 +9178,150 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topics = append(m.Topics, &TopicIdAndTopic{})
-			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AllForecasts = append(m.AllForecasts, &TopicIdBlockHeightForecasts{})
+			if err := m.AllForecasts[len(m.AllForecasts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ActiveTopics = append(m.ActiveTopics, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthGenesis
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthGenesis
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllLossBundles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ActiveTopics) == 0 {
-					m.ActiveTopics = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ActiveTopics = append(m.ActiveTopics, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveTopics", wireType)
 			}
-		case 6:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllLossBundles = append(m.AllLossBundles, &TopicIdBlockHeightReputerValueBundles{})
+			if err := m.AllLossBundles[len(m.AllLossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkLossBundles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
 				}
-				m.RewardableTopics = append(m.RewardableTopics, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthGenesis
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthGenesis
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkLossBundles = append(m.NetworkLossBundles, &TopicIdBlockHeightValueBundles{})
+			if err := m.NetworkLossBundles[len(m.NetworkLossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPercentageRewardToStakedReputers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.RewardableTopics) == 0 {
-					m.RewardableTopics = make([]uint64, 0, elementCount)
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousPercentageRewardToStakedReputers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnfulfilledWorkerNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RewardableTopics = append(m.RewardableTopics, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardableTopics", wireType)
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnfulfilledWorkerNonces = append(m.UnfulfilledWorkerNonces, &TopicIdAndNonces{})
+			if err := m.UnfulfilledWorkerNonces[len(m.UnfulfilledWorkerNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 44:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicWorkers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnfulfilledReputerNonces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6726,14 +9348,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicWorkers = append(m.TopicWorkers, &TopicAndActorId{})
-			if err := m.TopicWorkers[len(m.TopicWorkers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UnfulfilledReputerNonces = append(m.UnfulfilledReputerNonces, &TopicIdAndReputerRequestNonces{})
+			if err := m.UnfulfilledReputerNonces[len(m.UnfulfilledReputerNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 45:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicReputers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestInfererNetworkRegrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6760,14 +9382,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicReputers = append(m.TopicReputers, &TopicAndActorId{})
-			if err := m.TopicReputers[len(m.TopicReputers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestInfererNetworkRegrets = append(m.LatestInfererNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
+			if err := m.LatestInfererNetworkRegrets[len(m.LatestInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 46:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestForecasterNetworkRegrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6794,14 +9416,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicRewardNonce = append(m.TopicRewardNonce, &TopicIdAndBlockHeight{})
-			if err := m.TopicRewardNonce[len(m.TopicRewardNonce)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestForecasterNetworkRegrets = append(m.LatestForecasterNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
+			if err := m.LatestForecasterNetworkRegrets[len(m.LatestForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 47:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InfererScoresByBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneInForecasterNetworkRegrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6828,14 +9450,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InfererScoresByBlock = append(m.InfererScoresByBlock, &TopicIdBlockHeightScores{})
-			if err := m.InfererScoresByBlock[len(m.InfererScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestOneInForecasterNetworkRegrets = append(m.LatestOneInForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
+			if err := m.LatestOneInForecasterNetworkRegrets[len(m.LatestOneInForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 48:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForecasterScoresByBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestNaiveInfererNetworkRegrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6862,14 +9484,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForecasterScoresByBlock = append(m.ForecasterScoresByBlock, &TopicIdBlockHeightScores{})
-			if err := m.ForecasterScoresByBlock[len(m.ForecasterScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestNaiveInfererNetworkRegrets = append(m.LatestNaiveInfererNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
+			if err := m.LatestNaiveInfererNetworkRegrets[len(m.LatestNaiveInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 49:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerScoresByBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutInfererInfererNetworkRegrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6896,14 +9518,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReputerScoresByBlock = append(m.ReputerScoresByBlock, &TopicIdBlockHeightScores{})
-			if err := m.ReputerScoresByBlock[len(m.ReputerScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestOneOutInfererInfererNetworkRegrets = append(m.LatestOneOutInfererInfererNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
+			if err := m.LatestOneOutInfererInfererNetworkRegrets[len(m.LatestOneOutInfererInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 50:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerListeningCoefficient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutInfererForecasterNetworkRegrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6930,14 +9552,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReputerListeningCoefficient = append(m.ReputerListeningCoefficient, &TopicIdActorIdListeningCoefficient{})
-			if err := m.ReputerListeningCoefficient[len(m.ReputerListeningCoefficient)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestOneOutInfererForecasterNetworkRegrets = append(m.LatestOneOutInfererForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
+			if err := m.LatestOneOutInfererForecasterNetworkRegrets[len(m.LatestOneOutInfererForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 51:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousReputerRewardFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutForecasterInfererNetworkRegrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6964,14 +9586,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousReputerRewardFraction = append(m.PreviousReputerRewardFraction, &TopicIdActorIdDec{})
-			if err := m.PreviousReputerRewardFraction[len(m.PreviousReputerRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestOneOutForecasterInfererNetworkRegrets = append(m.LatestOneOutForecasterInfererNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
+			if err := m.LatestOneOutForecasterInfererNetworkRegrets[len(m.LatestOneOutForecasterInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
+		case 52:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousInferenceRewardFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutForecasterForecasterNetworkRegrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6998,14 +9620,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousInferenceRewardFraction = append(m.PreviousInferenceRewardFraction, &TopicIdActorIdDec{})
-			if err := m.PreviousInferenceRewardFraction[len(m.PreviousInferenceRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestOneOutForecasterForecasterNetworkRegrets = append(m.LatestOneOutForecasterForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
+			if err := m.LatestOneOutForecasterForecasterNetworkRegrets[len(m.LatestOneOutForecasterForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 19:
+		case 53:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousForecastRewardFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastWorkerCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7032,14 +9654,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousForecastRewardFraction = append(m.PreviousForecastRewardFraction, &TopicIdActorIdDec{})
-			if err := m.PreviousForecastRewardFraction[len(m.PreviousForecastRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicLastWorkerCommit = append(m.TopicLastWorkerCommit, &TopicIdTimestampedActorNonce{})
+			if err := m.TopicLastWorkerCommit[len(m.TopicLastWorkerCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		case 54:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousForecasterScoreRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastReputerCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7066,16 +9688,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousForecasterScoreRatio = append(m.PreviousForecasterScoreRatio, &TopicIdAndDec{})
-			if err := m.PreviousForecasterScoreRatio[len(m.PreviousForecasterScoreRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicLastReputerCommit = append(m.TopicLastReputerCommit, &TopicIdTimestampedActorNonce{})
+			if err := m.TopicLastReputerCommit[len(m.TopicLastReputerCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		case 55:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalStake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenWorkerWindows", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -7085,29 +9707,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OpenWorkerWindows = append(m.OpenWorkerWindows, &BlockHeightAndTopicIds{})
+			if err := m.OpenWorkerWindows[len(m.OpenWorkerWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 22:
+		case 56:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicStake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDripBlock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7134,14 +9756,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicStake = append(m.TopicStake, &TopicIdAndInt{})
-			if err := m.TopicStake[len(m.TopicStake)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LastDripBlock = append(m.LastDripBlock, &TopicIdAndBlockHeight{})
+			if err := m.LastDripBlock[len(m.LastDripBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 23:
+		case 57:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeReputerAuthority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicToNextPossibleChurningBlock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7168,14 +9790,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeReputerAuthority = append(m.StakeReputerAuthority, &TopicIdActorIdInt{})
-			if err := m.StakeReputerAuthority[len(m.StakeReputerAuthority)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicToNextPossibleChurningBlock = append(m.TopicToNextPossibleChurningBlock, &TopicIdAndBlockHeight{})
+			if err := m.TopicToNextPossibleChurningBlock[len(m.TopicToNextPossibleChurningBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 24:
+		case 58:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeSumFromDelegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockToActiveTopics", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7202,14 +9824,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeSumFromDelegator = append(m.StakeSumFromDelegator, &TopicIdActorIdInt{})
-			if err := m.StakeSumFromDelegator[len(m.StakeSumFromDelegator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BlockToActiveTopics = append(m.BlockToActiveTopics, &BlockHeightTopicIds{})
+			if err := m.BlockToActiveTopics[len(m.BlockToActiveTopics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 25:
+		case 59:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedStakes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockToLowestActiveTopicWeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7236,14 +9858,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatedStakes = append(m.DelegatedStakes, &TopicIdDelegatorReputerDelegatorInfo{})
-			if err := m.DelegatedStakes[len(m.DelegatedStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BlockToLowestActiveTopicWeight = append(m.BlockToLowestActiveTopicWeight, &BlockHeightTopicIdWeightPair{})
+			if err := m.BlockToLowestActiveTopicWeight[len(m.BlockToLowestActiveTopicWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 26:
+		case 60:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeFromDelegatorsUponReputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InfererScoreEmas", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7270,14 +9892,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeFromDelegatorsUponReputer = append(m.StakeFromDelegatorsUponReputer, &TopicIdActorIdInt{})
-			if err := m.StakeFromDelegatorsUponReputer[len(m.StakeFromDelegatorsUponReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InfererScoreEmas = append(m.InfererScoreEmas, &TopicIdActorIdScore{})
+			if err := m.InfererScoreEmas[len(m.InfererScoreEmas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 27:
+		case 61:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateRewardPerShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForecasterScoreEmas", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7304,14 +9926,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateRewardPerShare = append(m.DelegateRewardPerShare, &TopicIdActorIdDec{})
-			if err := m.DelegateRewardPerShare[len(m.DelegateRewardPerShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ForecasterScoreEmas = append(m.ForecasterScoreEmas, &TopicIdActorIdScore{})
+			if err := m.ForecasterScoreEmas[len(m.ForecasterScoreEmas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 28:
+		case 62:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalsByBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerScoreEmas", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7338,14 +9960,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeRemovalsByBlock = append(m.StakeRemovalsByBlock, &BlockHeightTopicIdReputerStakeRemovalInfo{})
-			if err := m.StakeRemovalsByBlock[len(m.StakeRemovalsByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReputerScoreEmas = append(m.ReputerScoreEmas, &TopicIdActorIdScore{})
+			if err := m.ReputerScoreEmas[len(m.ReputerScoreEmas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 29:
+		case 63:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalsByActor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicQuantileInfererScoreEma", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7372,14 +9994,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeRemovalsByActor = append(m.StakeRemovalsByActor, &ActorIdTopicIdBlockHeight{})
-			if err := m.StakeRemovalsByActor[len(m.StakeRemovalsByActor)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PreviousTopicQuantileInfererScoreEma = append(m.PreviousTopicQuantileInfererScoreEma, &TopicIdAndDec{})
+			if err := m.PreviousTopicQuantileInfererScoreEma[len(m.PreviousTopicQuantileInfererScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 30:
+		case 64:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalsByBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicQuantileForecasterScoreEma", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7406,14 +10028,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateStakeRemovalsByBlock = append(m.DelegateStakeRemovalsByBlock, &BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo{})
-			if err := m.DelegateStakeRemovalsByBlock[len(m.DelegateStakeRemovalsByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PreviousTopicQuantileForecasterScoreEma = append(m.PreviousTopicQuantileForecasterScoreEma, &TopicIdAndDec{})
+			if err := m.PreviousTopicQuantileForecasterScoreEma[len(m.PreviousTopicQuantileForecasterScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 31:
+		case 65:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalsByActor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicQuantileReputerScoreEma", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7440,14 +10062,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateStakeRemovalsByActor = append(m.DelegateStakeRemovalsByActor, &DelegatorReputerTopicIdBlockHeight{})
-			if err := m.DelegateStakeRemovalsByActor[len(m.DelegateStakeRemovalsByActor)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PreviousTopicQuantileReputerScoreEma = append(m.PreviousTopicQuantileReputerScoreEma, &TopicIdAndDec{})
+			if err := m.PreviousTopicQuantileReputerScoreEma[len(m.PreviousTopicQuantileReputerScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 32:
+		case 66:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CountInfererInclusionsInTopicActiveSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7474,14 +10096,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Inferences = append(m.Inferences, &TopicIdActorIdInference{})
-			if err := m.Inferences[len(m.Inferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CountInfererInclusionsInTopicActiveSet = append(m.CountInfererInclusionsInTopicActiveSet, &TopicIdActorIdUint64{})
+			if err := m.CountInfererInclusionsInTopicActiveSet[len(m.CountInfererInclusionsInTopicActiveSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 33:
+		case 67:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Forecasts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CountForecasterInclusionsInTopicActiveSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7508,14 +10130,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Forecasts = append(m.Forecasts, &TopicIdActorIdForecast{})
-			if err := m.Forecasts[len(m.Forecasts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CountForecasterInclusionsInTopicActiveSet = append(m.CountForecasterInclusionsInTopicActiveSet, &TopicIdActorIdUint64{})
+			if err := m.CountForecasterInclusionsInTopicActiveSet[len(m.CountForecasterInclusionsInTopicActiveSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 34:
+		case 68:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveInferers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7542,14 +10164,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Workers = append(m.Workers, &LibP2PKeyAndOffchainNode{})
-			if err := m.Workers[len(m.Workers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ActiveInferers = append(m.ActiveInferers, &TopicAndActorId{})
+			if err := m.ActiveInferers[len(m.ActiveInferers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 35:
+		case 69:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveForecasters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7576,14 +10198,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reputers = append(m.Reputers, &LibP2PKeyAndOffchainNode{})
-			if err := m.Reputers[len(m.Reputers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ActiveForecasters = append(m.ActiveForecasters, &TopicAndActorId{})
+			if err := m.ActiveForecasters[len(m.ActiveForecasters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 36:
+		case 70:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicFeeRevenue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LowestInfererScoreEma", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7610,14 +10232,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicFeeRevenue = append(m.TopicFeeRevenue, &TopicIdAndInt{})
-			if err := m.TopicFeeRevenue[len(m.TopicFeeRevenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LowestInfererScoreEma = append(m.LowestInfererScoreEma, &TopicIdActorIdScore{})
+			if err := m.LowestInfererScoreEma[len(m.LowestInfererScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 37:
+		case 71:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LowestForecasterScoreEma", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7644,14 +10266,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousTopicWeight = append(m.PreviousTopicWeight, &TopicIdAndDec{})
-			if err := m.PreviousTopicWeight[len(m.PreviousTopicWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LowestForecasterScoreEma = append(m.LowestForecasterScoreEma, &TopicIdActorIdScore{})
+			if err := m.LowestForecasterScoreEma[len(m.LowestForecasterScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 38:
+		case 72:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllInferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveReputers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7678,14 +10300,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllInferences = append(m.AllInferences, &TopicIdBlockHeightInferences{})
-			if err := m.AllInferences[len(m.AllInferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ActiveReputers = append(m.ActiveReputers, &TopicAndActorId{})
+			if err := m.ActiveReputers[len(m.ActiveReputers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 39:
+		case 73:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllForecasts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LowestReputerScoreEma", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7712,14 +10334,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllForecasts = append(m.AllForecasts, &TopicIdBlockHeightForecasts{})
-			if err := m.AllForecasts[len(m.AllForecasts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LowestReputerScoreEma = append(m.LowestReputerScoreEma, &TopicIdActorIdScore{})
+			if err := m.LowestReputerScoreEma[len(m.LowestReputerScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 40:
+		case 74:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllLossBundles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LossBundles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7746,16 +10368,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllLossBundles = append(m.AllLossBundles, &TopicIdBlockHeightReputerValueBundles{})
-			if err := m.AllLossBundles[len(m.AllLossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LossBundles = append(m.LossBundles, &TopicIdReputerReputerValueBundle{})
+			if err := m.LossBundles[len(m.LossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 41:
+		case 75:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkLossBundles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSumPreviousTopicWeights", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -7765,29 +10387,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetworkLossBundles = append(m.NetworkLossBundles, &TopicIdBlockHeightValueBundles{})
-			if err := m.NetworkLossBundles[len(m.NetworkLossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalSumPreviousTopicWeights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 42:
+		case 76:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPercentageRewardToStakedReputers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardCurrentBlockEmission", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7815,13 +10437,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PreviousPercentageRewardToStakedReputers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardCurrentBlockEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 43:
+		case 77:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnfulfilledWorkerNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTopicUpdates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7848,14 +10470,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnfulfilledWorkerNonces = append(m.UnfulfilledWorkerNonces, &TopicIdAndNonces{})
-			if err := m.UnfulfilledWorkerNonces[len(m.UnfulfilledWorkerNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ScheduledTopicUpdates = append(m.ScheduledTopicUpdates, &ScheduledTopicUpdate{})
+			if err := m.ScheduledTopicUpdates[len(m.ScheduledTopicUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 44:
+		case 78:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnfulfilledReputerNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicRetirements", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7882,14 +10504,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnfulfilledReputerNonces = append(m.UnfulfilledReputerNonces, &TopicIdAndReputerRequestNonces{})
-			if err := m.UnfulfilledReputerNonces[len(m.UnfulfilledReputerNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicRetirements = append(m.TopicRetirements, &TopicRetirement{})
+			if err := m.TopicRetirements[len(m.TopicRetirements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 45:
+		case 79:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestInfererNetworkRegrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GmpAllowedSources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7916,16 +10538,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestInfererNetworkRegrets = append(m.LatestInfererNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
-			if err := m.LatestInfererNetworkRegrets[len(m.LatestInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GmpAllowedSources = append(m.GmpAllowedSources, &GmpAllowedSource{})
+			if err := m.GmpAllowedSources[len(m.GmpAllowedSources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 46:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestForecasterNetworkRegrets", wireType)
+		case 80:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextGmpSubscriptionId", wireType)
 			}
-			var msglen int
+			m.NextGmpSubscriptionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -7935,29 +10557,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NextGmpSubscriptionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LatestForecasterNetworkRegrets = append(m.LatestForecasterNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
-			if err := m.LatestForecasterNetworkRegrets[len(m.LatestForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 47:
+		case 81:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneInForecasterNetworkRegrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GmpSubscriptions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7984,14 +10591,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestOneInForecasterNetworkRegrets = append(m.LatestOneInForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
-			if err := m.LatestOneInForecasterNetworkRegrets[len(m.LatestOneInForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GmpSubscriptions = append(m.GmpSubscriptions, &GmpSubscription{})
+			if err := m.GmpSubscriptions[len(m.GmpSubscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 48:
+		case 82:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestNaiveInfererNetworkRegrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GmpDeliveries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8018,116 +10625,166 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestNaiveInfererNetworkRegrets = append(m.LatestNaiveInfererNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
-			if err := m.LatestNaiveInfererNetworkRegrets[len(m.LatestNaiveInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GmpDeliveries = append(m.GmpDeliveries, &GmpDelivery{})
+			if err := m.GmpDeliveries[len(m.GmpDeliveries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 49:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutInfererInfererNetworkRegrets", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
+		case 83:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.TopicWorkerAllowlistEnabled = append(m.TopicWorkerAllowlistEnabled, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TopicWorkerAllowlistEnabled) == 0 {
+					m.TopicWorkerAllowlistEnabled = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TopicWorkerAllowlistEnabled = append(m.TopicWorkerAllowlistEnabled, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicWorkerAllowlistEnabled", wireType)
+			}
+		case 84:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TopicReputerAllowlistEnabled = append(m.TopicReputerAllowlistEnabled, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
 				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LatestOneOutInfererInfererNetworkRegrets = append(m.LatestOneOutInfererInfererNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
-			if err := m.LatestOneOutInfererInfererNetworkRegrets[len(m.LatestOneOutInfererInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 50:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutInfererForecasterNetworkRegrets", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LatestOneOutInfererForecasterNetworkRegrets = append(m.LatestOneOutInfererForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
-			if err := m.LatestOneOutInfererForecasterNetworkRegrets[len(m.LatestOneOutInfererForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 51:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutForecasterInfererNetworkRegrets", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				elementCount = count
+				if elementCount != 0 && len(m.TopicReputerAllowlistEnabled) == 0 {
+					m.TopicReputerAllowlistEnabled = make([]uint64, 0, elementCount)
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TopicReputerAllowlistEnabled = append(m.TopicReputerAllowlistEnabled, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicReputerAllowlistEnabled", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LatestOneOutForecasterInfererNetworkRegrets = append(m.LatestOneOutForecasterInfererNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
-			if err := m.LatestOneOutForecasterInfererNetworkRegrets[len(m.LatestOneOutForecasterInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 52:
+		case 85:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutForecasterForecasterNetworkRegrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicWorkerAllowlist", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8154,14 +10811,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestOneOutForecasterForecasterNetworkRegrets = append(m.LatestOneOutForecasterForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
-			if err := m.LatestOneOutForecasterForecasterNetworkRegrets[len(m.LatestOneOutForecasterForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicWorkerAllowlist = append(m.TopicWorkerAllowlist, &TopicAndActorId{})
+			if err := m.TopicWorkerAllowlist[len(m.TopicWorkerAllowlist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 53:
+		case 86:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastWorkerCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicReputerAllowlist", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8188,14 +10845,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicLastWorkerCommit = append(m.TopicLastWorkerCommit, &TopicIdTimestampedActorNonce{})
-			if err := m.TopicLastWorkerCommit[len(m.TopicLastWorkerCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicReputerAllowlist = append(m.TopicReputerAllowlist, &TopicAndActorId{})
+			if err := m.TopicReputerAllowlist[len(m.TopicReputerAllowlist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 54:
+		case 87:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastReputerCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerSlashingInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8222,14 +10879,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicLastReputerCommit = append(m.TopicLastReputerCommit, &TopicIdTimestampedActorNonce{})
-			if err := m.TopicLastReputerCommit[len(m.TopicLastReputerCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReputerSlashingInfo = append(m.ReputerSlashingInfo, &TopicIdActorIdReputerSlashingInfo{})
+			if err := m.ReputerSlashingInfo[len(m.ReputerSlashingInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 55:
+		case 88:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenWorkerWindows", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerCommitments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8256,14 +10913,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OpenWorkerWindows = append(m.OpenWorkerWindows, &BlockHeightAndTopicIds{})
-			if err := m.OpenWorkerWindows[len(m.OpenWorkerWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WorkerCommitments = append(m.WorkerCommitments, &TopicIdBlockHeightActorIdWorkerCommitment{})
+			if err := m.WorkerCommitments[len(m.WorkerCommitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 56:
+		case 89:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastDripBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroundTruths", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8290,14 +10947,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastDripBlock = append(m.LastDripBlock, &TopicIdAndBlockHeight{})
-			if err := m.LastDripBlock[len(m.LastDripBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GroundTruths = append(m.GroundTruths, &TopicIdBlockHeightDec{})
+			if err := m.GroundTruths[len(m.GroundTruths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 57:
+		case 90:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicToNextPossibleChurningBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkInferenceArchive", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8324,14 +10981,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicToNextPossibleChurningBlock = append(m.TopicToNextPossibleChurningBlock, &TopicIdAndBlockHeight{})
-			if err := m.TopicToNextPossibleChurningBlock[len(m.TopicToNextPossibleChurningBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NetworkInferenceArchive = append(m.NetworkInferenceArchive, &ArchivedNetworkInference{})
+			if err := m.NetworkInferenceArchive[len(m.NetworkInferenceArchive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 58:
+		case 91:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockToActiveTopics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicSponsorships", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8358,14 +11015,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockToActiveTopics = append(m.BlockToActiveTopics, &BlockHeightTopicIds{})
-			if err := m.BlockToActiveTopics[len(m.BlockToActiveTopics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicSponsorships = append(m.TopicSponsorships, &TopicSponsorship{})
+			if err := m.TopicSponsorships[len(m.TopicSponsorships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 59:
+		case 92:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockToLowestActiveTopicWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SponsoredActorFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8392,14 +11049,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockToLowestActiveTopicWeight = append(m.BlockToLowestActiveTopicWeight, &BlockHeightTopicIdWeightPair{})
-			if err := m.BlockToLowestActiveTopicWeight[len(m.BlockToLowestActiveTopicWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SponsoredActorFees = append(m.SponsoredActorFees, &TopicIdSponsorActorSponsoredFees{})
+			if err := m.SponsoredActorFees[len(m.SponsoredActorFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 60:
+		case 93:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InfererScoreEmas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundingDelegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8426,14 +11083,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InfererScoreEmas = append(m.InfererScoreEmas, &TopicIdActorIdScore{})
-			if err := m.InfererScoreEmas[len(m.InfererScoreEmas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AutoCompoundingDelegations = append(m.AutoCompoundingDelegations, &TopicIdDelegatorReputer{})
+			if err := m.AutoCompoundingDelegations[len(m.AutoCompoundingDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 61:
+		case 94:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForecasterScoreEmas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8460,16 +11117,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForecasterScoreEmas = append(m.ForecasterScoreEmas, &TopicIdActorIdScore{})
-			if err := m.ForecasterScoreEmas[len(m.ForecasterScoreEmas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Redelegations = append(m.Redelegations, &Redelegation{})
+			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 62:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerScoreEmas", wireType)
+		case 95:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRedelegationId", wireType)
 			}
-			var msglen int
+			m.NextRedelegationId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8479,29 +11136,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NextRedelegationId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReputerScoreEmas = append(m.ReputerScoreEmas, &TopicIdActorIdScore{})
-			if err := m.ReputerScoreEmas[len(m.ReputerScoreEmas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 63:
+		case 96:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicQuantileInfererScoreEma", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8528,14 +11170,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousTopicQuantileInfererScoreEma = append(m.PreviousTopicQuantileInfererScoreEma, &TopicIdAndDec{})
-			if err := m.PreviousTopicQuantileInfererScoreEma[len(m.PreviousTopicQuantileInfererScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AccruedRewards = append(m.AccruedRewards, &TopicIdActorIdInt{})
+			if err := m.AccruedRewards[len(m.AccruedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 64:
+		case 97:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicQuantileForecasterScoreEma", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicDataSendingFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8562,14 +11204,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousTopicQuantileForecasterScoreEma = append(m.PreviousTopicQuantileForecasterScoreEma, &TopicIdAndDec{})
-			if err := m.PreviousTopicQuantileForecasterScoreEma[len(m.PreviousTopicQuantileForecasterScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicDataSendingFees = append(m.TopicDataSendingFees, &TopicIdAndInt{})
+			if err := m.TopicDataSendingFees[len(m.TopicDataSendingFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 65:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicQuantileReputerScoreEma", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicPayloadsReceived", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8596,50 +11238,66 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousTopicQuantileReputerScoreEma = append(m.PreviousTopicQuantileReputerScoreEma, &TopicIdAndDec{})
-			if err := m.PreviousTopicQuantileReputerScoreEma[len(m.PreviousTopicQuantileReputerScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicPayloadsReceived = append(m.TopicPayloadsReceived, &TopicIdAndUint64{})
+			if err := m.TopicPayloadsReceived[len(m.TopicPayloadsReceived)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 66:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CountInfererInclusionsInTopicActiveSet", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CountInfererInclusionsInTopicActiveSet = append(m.CountInfererInclusionsInTopicActiveSet, &TopicIdActorIdUint64{})
-			if err := m.CountInfererInclusionsInTopicActiveSet[len(m.CountInfererInclusionsInTopicActiveSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 67:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CountForecasterInclusionsInTopicActiveSet", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicIdAndTopic: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicIdAndTopic: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var msglen int
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8649,29 +11307,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CountForecasterInclusionsInTopicActiveSet = append(m.CountForecasterInclusionsInTopicActiveSet, &TopicIdActorIdUint64{})
-			if err := m.CountForecasterInclusionsInTopicActiveSet[len(m.CountForecasterInclusionsInTopicActiveSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 68:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveInferers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8698,16 +11341,68 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActiveInferers = append(m.ActiveInferers, &TopicAndActorId{})
-			if err := m.ActiveInferers[len(m.ActiveInferers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Topic == nil {
+				m.Topic = &Topic{}
+			}
+			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 69:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveForecasters", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicAndActorId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicAndActorId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicAndActorId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8717,31 +11412,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ActiveForecasters = append(m.ActiveForecasters, &TopicAndActorId{})
-			if err := m.ActiveForecasters[len(m.ActiveForecasters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 70:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowestInfererScoreEma", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8751,31 +11431,79 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LowestInfererScoreEma = append(m.LowestInfererScoreEma, &TopicIdActorIdScore{})
-			if err := m.LowestInfererScoreEma[len(m.LowestInfererScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ActorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 71:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowestForecasterScoreEma", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			var msglen int
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicIdAndBlockHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicIdAndBlockHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicIdAndBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8785,31 +11513,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LowestForecasterScoreEma = append(m.LowestForecasterScoreEma, &TopicIdActorIdScore{})
-			if err := m.LowestForecasterScoreEma[len(m.LowestForecasterScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 72:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveReputers", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var msglen int
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8819,31 +11532,66 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActiveReputers = append(m.ActiveReputers, &TopicAndActorId{})
-			if err := m.ActiveReputers[len(m.ActiveReputers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockHeightAndTopicIds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			iNdEx = postIndex
-		case 73:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowestReputerScoreEma", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockHeightAndTopicIds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockHeightAndTopicIds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var msglen int
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8853,31 +11601,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TopicIds = append(m.TopicIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TopicIds) == 0 {
+					m.TopicIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TopicIds = append(m.TopicIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicIds", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LowestReputerScoreEma = append(m.LowestReputerScoreEma, &TopicIdActorIdScore{})
-			if err := m.LowestReputerScoreEma[len(m.LowestReputerScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicIdBlockHeightScores) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			iNdEx = postIndex
-		case 74:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LossBundles", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicIdBlockHeightScores: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicIdBlockHeightScores: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8887,31 +11746,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LossBundles = append(m.LossBundles, &TopicIdReputerReputerValueBundle{})
-			if err := m.LossBundles[len(m.LossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 75:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSumPreviousTopicWeights", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8921,31 +11765,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TotalSumPreviousTopicWeights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 76:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardCurrentBlockEmission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8955,23 +11784,25 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardCurrentBlockEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Scores == nil {
+				m.Scores = &Scores{}
+			}
+			if err := m.Scores.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8996,7 +11827,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
+func (m *TopicIdActorIdScore) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9019,10 +11850,10 @@ func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdAndTopic: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdActorIdScore: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdAndTopic: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdActorIdScore: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9046,7 +11877,39 @@ func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9073,10 +11936,10 @@ func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Topic == nil {
-				m.Topic = &Topic{}
+			if m.Score == nil {
+				m.Score = &Score{}
 			}
-			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9101,7 +11964,7 @@ func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicAndActorId) Unmarshal(dAtA []byte) error {
+func (m *TopicIdActorIdUint64) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9124,10 +11987,10 @@ func (m *TopicAndActorId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicAndActorId: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdActorIdUint64: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicAndActorId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdActorIdUint64: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9181,6 +12044,25 @@ func (m *TopicAndActorId) Unmarshal(dAtA []byte) error {
 			}
 			m.ActorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint64", wireType)
+			}
+			m.Uint64 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Uint64 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -9202,7 +12084,7 @@ func (m *TopicAndActorId) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdAndBlockHeight) Unmarshal(dAtA []byte) error {
+func (m *TopicIdActorIdListeningCoefficient) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9225,10 +12107,10 @@ func (m *TopicIdAndBlockHeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdAndBlockHeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdActorIdListeningCoefficient: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdAndBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdActorIdListeningCoefficient: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9251,10 +12133,10 @@ func (m *TopicIdAndBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -9264,11 +12146,60 @@ func (m *TopicIdAndBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListeningCoefficient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ListeningCoefficient == nil {
+				m.ListeningCoefficient = &ListeningCoefficient{}
+			}
+			if err := m.ListeningCoefficient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -9290,7 +12221,7 @@ func (m *TopicIdAndBlockHeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockHeightAndTopicIds) Unmarshal(dAtA []byte) error {
+func (m *TopicIdActorIdReputerSlashingInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9313,17 +12244,17 @@ func (m *BlockHeightAndTopicIds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockHeightAndTopicIds: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdActorIdReputerSlashingInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockHeightAndTopicIds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdActorIdReputerSlashingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			m.BlockHeight = 0
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -9333,87 +12264,79 @@ func (m *BlockHeightAndTopicIds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
 				}
-				m.TopicIds = append(m.TopicIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthGenesis
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthGenesis
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.TopicIds) == 0 {
-					m.TopicIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.TopicIds = append(m.TopicIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicIds", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SlashingInfo == nil {
+				m.SlashingInfo = &ReputerSlashingInfo{}
+			}
+			if err := m.SlashingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -9435,7 +12358,7 @@ func (m *BlockHeightAndTopicIds) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdBlockHeightScores) Unmarshal(dAtA []byte) error {
+func (m *TopicIdBlockHeightActorIdWorkerCommitment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9458,10 +12381,10 @@ func (m *TopicIdBlockHeightScores) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdBlockHeightScores: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdBlockHeightActorIdWorkerCommitment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdBlockHeightScores: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdBlockHeightActorIdWorkerCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9502,9 +12425,41 @@ func (m *TopicIdBlockHeightScores) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9531,10 +12486,10 @@ func (m *TopicIdBlockHeightScores) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Scores == nil {
-				m.Scores = &Scores{}
+			if m.Commitment == nil {
+				m.Commitment = &WorkerCommitment{}
 			}
-			if err := m.Scores.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commitment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9559,7 +12514,7 @@ func (m *TopicIdBlockHeightScores) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdActorIdScore) Unmarshal(dAtA []byte) error {
+func (m *TopicIdSponsorActorSponsoredFees) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9582,10 +12537,10 @@ func (m *TopicIdActorIdScore) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdActorIdScore: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdSponsorActorSponsoredFees: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdActorIdScore: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdSponsorActorSponsoredFees: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9609,7 +12564,7 @@ func (m *TopicIdActorIdScore) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9637,11 +12592,43 @@ func (m *TopicIdActorIdScore) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActorId = string(dAtA[iNdEx:postIndex])
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9668,10 +12655,10 @@ func (m *TopicIdActorIdScore) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Score == nil {
-				m.Score = &Score{}
+			if m.Fees == nil {
+				m.Fees = &SponsoredFees{}
 			}
-			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9696,7 +12683,7 @@ func (m *TopicIdActorIdScore) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdActorIdUint64) Unmarshal(dAtA []byte) error {
+func (m *TopicIdBlockHeightDec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9719,10 +12706,10 @@ func (m *TopicIdActorIdUint64) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdActorIdUint64: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdBlockHeightDec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdActorIdUint64: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdBlockHeightDec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9745,10 +12732,10 @@ func (m *TopicIdActorIdUint64) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -9758,29 +12745,16 @@ func (m *TopicIdActorIdUint64) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ActorId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uint64", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dec", wireType)
 			}
-			m.Uint64 = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -9790,11 +12764,26 @@ func (m *TopicIdActorIdUint64) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Uint64 |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Dec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -9816,7 +12805,7 @@ func (m *TopicIdActorIdUint64) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdActorIdListeningCoefficient) Unmarshal(dAtA []byte) error {
+func (m *TopicIdActorIdDec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9839,10 +12828,10 @@ func (m *TopicIdActorIdListeningCoefficient) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdActorIdListeningCoefficient: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdActorIdDec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdActorIdListeningCoefficient: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdActorIdDec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9898,9 +12887,9 @@ func (m *TopicIdActorIdListeningCoefficient) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListeningCoefficient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dec", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -9910,25 +12899,23 @@ func (m *TopicIdActorIdListeningCoefficient) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ListeningCoefficient == nil {
-				m.ListeningCoefficient = &ListeningCoefficient{}
-			}
-			if err := m.ListeningCoefficient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Dec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9953,7 +12940,7 @@ func (m *TopicIdActorIdListeningCoefficient) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdActorIdDec) Unmarshal(dAtA []byte) error {
+func (m *TopicIdAndUint64) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9976,10 +12963,10 @@ func (m *TopicIdActorIdDec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdActorIdDec: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdAndUint64: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdActorIdDec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdAndUint64: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10002,10 +12989,10 @@ func (m *TopicIdActorIdDec) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint64", wireType)
 			}
-			var stringLen uint64
+			m.Uint64 = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -10015,27 +13002,83 @@ func (m *TopicIdActorIdDec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Uint64 |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActorId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicIdAndInt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicIdAndInt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicIdAndInt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Int", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10063,7 +13106,7 @@ func (m *TopicIdActorIdDec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Dec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Int.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10088,7 +13131,7 @@ func (m *TopicIdActorIdDec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdAndInt) Unmarshal(dAtA []byte) error {
+func (m *TopicIdActorIdInt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10111,10 +13154,10 @@ func (m *TopicIdAndInt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdAndInt: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdActorIdInt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdAndInt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdActorIdInt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10137,6 +13180,38 @@ func (m *TopicIdAndInt) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Int", wireType)
 			}
@@ -10191,7 +13266,7 @@ func (m *TopicIdAndInt) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdActorIdInt) Unmarshal(dAtA []byte) error {
+func (m *TopicIdDelegatorReputer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10214,10 +13289,10 @@ func (m *TopicIdActorIdInt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdActorIdInt: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdDelegatorReputer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdActorIdInt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdDelegatorReputer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10241,7 +13316,7 @@ func (m *TopicIdActorIdInt) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10269,11 +13344,11 @@ func (m *TopicIdActorIdInt) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActorId = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Int", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10301,9 +13376,7 @@ func (m *TopicIdActorIdInt) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Int.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,744 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+)
+
+// Collects the problems found in a genesis state, each prefixed by the field and index of the offending entry
+type genesisValidator struct {
+	problems []error
+
+	// Topics that exist, and retired topics whose state is still being garbage collected
+	liveTopics    map[TopicId]bool
+	retiredTopics map[TopicId]bool
+	// Actors that registered in a topic at some point, their node info outlives their topic registrations
+	workers  map[string]bool
+	reputers map[string]bool
+}
+
+type topicActor struct {
+	topicId TopicId
+	actor   string
+}
+
+func (key topicActor) String() string {
+	return fmt.Sprintf("topic %d and actor %s", key.topicId, key.actor)
+}
+
+type topicActorBlock struct {
+	topicId TopicId
+	actor   string
+	block   BlockHeight
+}
+
+type topicDelegatorReputer struct {
+	topicId   TopicId
+	delegator string
+	reputer   string
+}
+
+type topicDelegatorReputerBlock struct {
+	topicDelegatorReputer
+	block BlockHeight
+}
+
+func (v *genesisValidator) addf(field string, i int, format string, args ...any) {
+	v.problems = append(v.problems, errors.Wrapf(ErrInvalidGenesisState, "%s[%d]: "+format, append([]any{field, i}, args...)...))
+}
+
+// Records err against the entry and reports whether there was none
+func (v *genesisValidator) check(field string, i int, err error) bool {
+	if err != nil {
+		v.problems = append(v.problems, errors.Wrapf(err, "%s[%d]", field, i))
+		return false
+	}
+	return true
+}
+
+// Checks that the entry refers to a topic that exists or is being retired
+func (v *genesisValidator) topic(field string, i int, topicId TopicId) {
+	if !v.check(field, i, ValidateTopicId(topicId)) {
+		return
+	}
+	if !v.liveTopics[topicId] && !v.retiredTopics[topicId] {
+		v.addf(field, i, "topic %d does not exist", topicId)
+	}
+}
+
+// Checks that the entry refers to a topic that exists and is not being retired
+func (v *genesisValidator) liveTopic(field string, i int, topicId TopicId) {
+	if !v.check(field, i, ValidateTopicId(topicId)) {
+		return
+	}
+	if !v.liveTopics[topicId] {
+		v.addf(field, i, "topic %d does not exist or is retired", topicId)
+	}
+}
+
+func (v *genesisValidator) address(field string, i int, address string) bool {
+	return v.check(field, i, ValidateBech32(address))
+}
+
+// Checks that the entry refers to a reputer that registered at some point, which it must have to hold stake
+func (v *genesisValidator) registeredReputer(field string, i int, reputer string) {
+	if v.address(field, i, reputer) && !v.reputers[reputer] {
+		v.addf(field, i, "reputer %s never registered", reputer)
+	}
+}
+
+func (v *genesisValidator) dec(field string, i int, value alloraMath.Dec) {
+	v.check(field, i, ValidateDec(value))
+}
+
+func (v *genesisValidator) monetaryValue(field string, i int, value cosmosMath.Int) bool {
+	return v.check(field, i, ValidateSdkIntRepresentingMonetaryValue(value))
+}
+
+// Validates every entry of a genesis field, reporting the nil ones that InitGenesis would skip or dereference
+func validateEntries[T any](v *genesisValidator, field string, entries []*T, validate func(i int, entry *T)) {
+	for i, entry := range entries {
+		if entry == nil {
+			v.addf(field, i, "entry cannot be nil")
+			continue
+		}
+		validate(i, entry)
+	}
+}
+
+// Validates a nested value of an entry, which must be present
+func validateNested[T any, P interface {
+	*T
+	Validate() error
+}](v *genesisValidator, field string, i int, name string, value P) bool {
+	if value == nil {
+		v.addf(field, i, "%s cannot be nil", name)
+		return false
+	}
+	return v.check(field, i, errors.Wrapf(value.Validate(), "%s is invalid", name))
+}
+
+func addToSum[K comparable](sums map[K]cosmosMath.Int, key K, amount cosmosMath.Int) {
+	sum, ok := sums[key]
+	if !ok {
+		sum = cosmosMath.ZeroInt()
+	}
+	sums[key] = sum.Add(amount)
+}
+
+func sumOrZero[K comparable](sums map[K]cosmosMath.Int, key K) cosmosMath.Int {
+	if sum, ok := sums[key]; ok {
+		return sum
+	}
+	return cosmosMath.ZeroInt()
+}
+
+// ValidationProblems returns every problem found in the genesis state, so that they can all be reported at once.
+// It checks each entry on its own as InitGenesis would, that entries refer to topics and actors that exist,
+// and that the stake accounting adds up as checked by the staking invariants.
+func (gs *GenesisState) ValidationProblems() []error {
+	v := &genesisValidator{
+		problems:      []error{},
+		liveTopics:    make(map[TopicId]bool),
+		retiredTopics: make(map[TopicId]bool),
+		workers:       make(map[string]bool),
+		reputers:      make(map[string]bool),
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		v.problems = append(v.problems, errors.Wrap(err, "params"))
+	}
+	for i, address := range gs.CoreTeamAddresses {
+		v.address("core_team_addresses", i, address)
+	}
+
+	v.validateTopics(gs)
+	v.validateRegistrations(gs)
+	v.validateTopicState(gs)
+	v.validateStakes(gs)
+	v.validatePayloads(gs)
+	v.validateScoresAndRegrets(gs)
+	v.validateTopicFeatures(gs)
+	return v.problems
+}
+
+// Topics and retired topics, which every other topic-scoped entry refers to
+func (v *genesisValidator) validateTopics(gs *GenesisState) {
+	validateEntries(v, "topic_retirements", gs.TopicRetirements, func(i int, retirement *TopicRetirement) {
+		if !v.check("topic_retirements", i, retirement.Validate()) {
+			return
+		}
+		if v.retiredTopics[retirement.Topic.Id] {
+			v.addf("topic_retirements", i, "topic %d is retired more than once", retirement.Topic.Id)
+		}
+		v.retiredTopics[retirement.Topic.Id] = true
+	})
+	validateEntries(v, "topics", gs.Topics, func(i int, entry *TopicIdAndTopic) {
+		if entry.Topic == nil {
+			v.addf("topics", i, "topic cannot be nil")
+			return
+		}
+		if entry.TopicId != entry.Topic.Id {
+			v.addf("topics", i, "topic id %d does not match the id %d of the topic", entry.TopicId, entry.Topic.Id)
+		}
+		if !v.check("topics", i, entry.Topic.Validate(gs.Params)) {
+			return
+		}
+		if v.liveTopics[entry.TopicId] {
+			v.addf("topics", i, "topic %d is listed more than once", entry.TopicId)
+		}
+		if v.retiredTopics[entry.TopicId] {
+			v.addf("topics", i, "topic %d is also retired", entry.TopicId)
+		}
+		v.liveTopics[entry.TopicId] = true
+	})
+	for _, topicIds := range []map[TopicId]bool{v.liveTopics, v.retiredTopics} {
+		for topicId := range topicIds {
+			if topicId >= gs.NextTopicId {
+				v.problems = append(v.problems, errors.Wrapf(ErrInvalidGenesisState,
+					"next_topic_id: %d is not above the id of topic %d", gs.NextTopicId, topicId))
+			}
+		}
+	}
+}
+
+// Registrations of actors in topics, and the node info of every actor that registered
+func (v *genesisValidator) validateRegistrations(gs *GenesisState) {
+	nodes := func(field string, entries []*LibP2PKeyAndOffchainNode, registered map[string]bool) {
+		validateEntries(v, field, entries, func(i int, entry *LibP2PKeyAndOffchainNode) {
+			validateNested(v, field, i, "offchain node", entry.OffchainNode)
+			registered[entry.LibP2PKey] = true
+		})
+	}
+	nodes("workers", gs.Workers, v.workers)
+	nodes("reputers", gs.Reputers, v.reputers)
+
+	topicActors := func(field string, entries []*TopicAndActorId, registered map[string]bool) {
+		validateEntries(v, field, entries, func(i int, entry *TopicAndActorId) {
+			v.topic(field, i, entry.TopicId)
+			if v.address(field, i, entry.ActorId) && registered != nil && !registered[entry.ActorId] {
+				v.addf(field, i, "actor %s has no node info", entry.ActorId)
+			}
+		})
+	}
+	topicActors("topic_workers", gs.TopicWorkers, v.workers)
+	topicActors("topic_reputers", gs.TopicReputers, v.reputers)
+	topicActors("active_inferers", gs.ActiveInferers, nil)
+	topicActors("active_forecasters", gs.ActiveForecasters, nil)
+	topicActors("active_reputers", gs.ActiveReputers, nil)
+	topicActors("topic_worker_allowlist", gs.TopicWorkerAllowlist, nil)
+	topicActors("topic_reputer_allowlist", gs.TopicReputerAllowlist, nil)
+
+	for _, field := range []struct {
+		name     string
+		topicIds []uint64
+	}{
+		{"topic_worker_allowlist_enabled", gs.TopicWorkerAllowlistEnabled},
+		{"topic_reputer_allowlist_enabled", gs.TopicReputerAllowlistEnabled},
+		{"rewardable_topics", gs.RewardableTopics},
+	} {
+		for i, topicId := range field.topicIds {
+			v.topic(field.name, i, topicId)
+		}
+	}
+	validateEntries(v, "reputer_slashing_info", gs.ReputerSlashingInfo, func(i int, entry *TopicIdActorIdReputerSlashingInfo) {
+		v.topic("reputer_slashing_info", i, entry.TopicId)
+		v.address("reputer_slashing_info", i, entry.ActorId)
+		if entry.SlashingInfo == nil {
+			v.addf("reputer_slashing_info", i, "slashing info cannot be nil")
+		}
+	})
+}
+
+// Topic activation, nonces and the per-topic values carried from one epoch to the next
+func (v *genesisValidator) validateTopicState(gs *GenesisState) {
+	// Retired topics are inactivated, so only existing topics may be active
+	for i, topicId := range gs.ActiveTopics {
+		v.liveTopic("active_topics", i, topicId)
+	}
+	validateEntries(v, "block_to_active_topics", gs.BlockToActiveTopics, func(i int, entry *BlockHeightTopicIds) {
+		v.check("block_to_active_topics", i, ValidateBlockHeight(entry.BlockHeight))
+		if entry.TopicIds == nil {
+			v.addf("block_to_active_topics", i, "topic ids cannot be nil")
+			return
+		}
+		for _, topicId := range entry.TopicIds.TopicIds {
+			v.topic("block_to_active_topics", i, topicId)
+		}
+	})
+	validateEntries(v, "block_to_lowest_active_topic_weight", gs.BlockToLowestActiveTopicWeight, func(i int, entry *BlockHeightTopicIdWeightPair) {
+		v.check("block_to_lowest_active_topic_weight", i, ValidateBlockHeight(entry.BlockHeight))
+		if entry.TopicWeight == nil {
+			v.addf("block_to_lowest_active_topic_weight", i, "topic weight cannot be nil")
+			return
+		}
+		v.dec("block_to_lowest_active_topic_weight", i, entry.TopicWeight.Weight)
+	})
+	validateEntries(v, "open_worker_windows", gs.OpenWorkerWindows, func(i int, entry *BlockHeightAndTopicIds) {
+		v.check("open_worker_windows", i, ValidateBlockHeight(entry.BlockHeight))
+		for _, topicId := range entry.TopicIds {
+			v.topic("open_worker_windows", i, topicId)
+		}
+	})
+
+	topicBlocks := func(field string, entries []*TopicIdAndBlockHeight) {
+		validateEntries(v, field, entries, func(i int, entry *TopicIdAndBlockHeight) {
+			v.topic(field, i, entry.TopicId)
+			v.check(field, i, ValidateBlockHeight(entry.BlockHeight))
+		})
+	}
+	topicBlocks("topic_reward_nonce", gs.TopicRewardNonce)
+	topicBlocks("last_drip_block", gs.LastDripBlock)
+	topicBlocks("topic_to_next_possible_churning_block", gs.TopicToNextPossibleChurningBlock)
+
+	validateEntries(v, "unfulfilled_worker_nonces", gs.UnfulfilledWorkerNonces, func(i int, entry *TopicIdAndNonces) {
+		v.topic("unfulfilled_worker_nonces", i, entry.TopicId)
+		validateNested(v, "unfulfilled_worker_nonces", i, "nonces", entry.Nonces)
+	})
+	validateEntries(v, "unfulfilled_reputer_nonces", gs.UnfulfilledReputerNonces, func(i int, entry *TopicIdAndReputerRequestNonces) {
+		v.topic("unfulfilled_reputer_nonces", i, entry.TopicId)
+		validateNested(v, "unfulfilled_reputer_nonces", i, "reputer request nonces", entry.ReputerRequestNonces)
+	})
+	lastCommits := func(field string, entries []*TopicIdTimestampedActorNonce) {
+		validateEntries(v, field, entries, func(i int, entry *TopicIdTimestampedActorNonce) {
+			v.topic(field, i, entry.TopicId)
+			if entry.TimestampedActorNonce == nil {
+				v.addf(field, i, "timestamped actor nonce cannot be nil")
+				return
+			}
+			v.check(field, i, ValidateBlockHeight(entry.TimestampedActorNonce.BlockHeight))
+			validateNested(v, field, i, "nonce", entry.TimestampedActorNonce.Nonce)
+		})
+	}
+	lastCommits("topic_last_worker_commit", gs.TopicLastWorkerCommit)
+	lastCommits("topic_last_reputer_commit", gs.TopicLastReputerCommit)
+
+	topicInts := func(field string, entries []*TopicIdAndInt) {
+		validateEntries(v, field, entries, func(i int, entry *TopicIdAndInt) {
+			v.topic(field, i, entry.TopicId)
+			v.monetaryValue(field, i, entry.Int)
+		})
+	}
+	topicInts("topic_fee_revenue", gs.TopicFeeRevenue)
+	topicInts("topic_data_sending_fees", gs.TopicDataSendingFees)
+	validateEntries(v, "topic_payloads_received", gs.TopicPayloadsReceived, func(i int, entry *TopicIdAndUint64) {
+		v.topic("topic_payloads_received", i, entry.TopicId)
+	})
+
+	topicDecs := func(field string, entries []*TopicIdAndDec) {
+		validateEntries(v, field, entries, func(i int, entry *TopicIdAndDec) {
+			v.topic(field, i, entry.TopicId)
+			v.dec(field, i, entry.Dec)
+		})
+	}
+	topicDecs("previous_topic_weight", gs.PreviousTopicWeight)
+	topicDecs("previous_forecaster_score_ratio", gs.PreviousForecasterScoreRatio)
+	topicDecs("previous_topic_quantile_inferer_score_ema", gs.PreviousTopicQuantileInfererScoreEma)
+	topicDecs("previous_topic_quantile_forecaster_score_ema", gs.PreviousTopicQuantileForecasterScoreEma)
+	topicDecs("previous_topic_quantile_reputer_score_ema", gs.PreviousTopicQuantileReputerScoreEma)
+
+	v.dec("previous_percentage_reward_to_staked_reputers", 0, gs.PreviousPercentageRewardToStakedReputers)
+	v.dec("total_sum_previous_topic_weights", 0, gs.TotalSumPreviousTopicWeights)
+	if !gs.RewardCurrentBlockEmission.IsNil() {
+		v.monetaryValue("reward_current_block_emission", 0, gs.RewardCurrentBlockEmission)
+	}
+}
+
+// Stakes, delegations and pending stake removals, whose sums must agree with each other
+func (v *genesisValidator) validateStakes(gs *GenesisState) {
+	totalStake := cosmosMath.ZeroInt()
+	if !gs.TotalStake.IsNil() && v.monetaryValue("total_stake", 0, gs.TotalStake) {
+		totalStake = gs.TotalStake
+	}
+
+	topicStakes := make(map[TopicId]cosmosMath.Int)
+	validateEntries(v, "topic_stake", gs.TopicStake, func(i int, entry *TopicIdAndInt) {
+		v.topic("topic_stake", i, entry.TopicId)
+		if !v.monetaryValue("topic_stake", i, entry.Int) {
+			return
+		}
+		if _, ok := topicStakes[entry.TopicId]; ok {
+			v.addf("topic_stake", i, "topic %d is listed more than once", entry.TopicId)
+		}
+		addToSum(topicStakes, entry.TopicId, entry.Int)
+	})
+
+	// The stake of reputers, and the delegate stake sums, keyed by topic and reputer or delegator
+	stakesByTopicActor := func(field string, entries []*TopicIdActorIdInt, reputers bool) map[topicActor]cosmosMath.Int {
+		stakes := make(map[topicActor]cosmosMath.Int)
+		validateEntries(v, field, entries, func(i int, entry *TopicIdActorIdInt) {
+			v.topic(field, i, entry.TopicId)
+			if reputers {
+				v.registeredReputer(field, i, entry.ActorId)
+			} else {
+				v.address(field, i, entry.ActorId)
+			}
+			if !v.monetaryValue(field, i, entry.Int) {
+				return
+			}
+			key := topicActor{topicId: entry.TopicId, actor: entry.ActorId}
+			if _, ok := stakes[key]; ok {
+				v.addf(field, i, "topic %d and actor %s are listed more than once", entry.TopicId, entry.ActorId)
+			}
+			addToSum(stakes, key, entry.Int)
+		})
+		return stakes
+	}
+	reputerAuthorities := stakesByTopicActor("stake_reputer_authority", gs.StakeReputerAuthority, true)
+	sumsFromDelegator := stakesByTopicActor("stake_sum_from_delegator", gs.StakeSumFromDelegator, false)
+	sumsUponReputer := stakesByTopicActor("stake_from_delegators_upon_reputer", gs.StakeFromDelegatorsUponReputer, true)
+
+	// total stake = sum of topic stakes, topic stake = sum of the reputer authorities in the topic
+	sumTopicStakes := cosmosMath.ZeroInt()
+	for _, stake := range topicStakes {
+		sumTopicStakes = sumTopicStakes.Add(stake)
+	}
+	if !totalStake.Equal(sumTopicStakes) {
+		v.problems = append(v.problems, errors.Wrapf(ErrInvalidGenesisState,
+			"total_stake: %s does not equal the sum of topic stakes %s", totalStake, sumTopicStakes))
+	}
+	authoritiesByTopic := make(map[TopicId]cosmosMath.Int)
+	for key, stake := range reputerAuthorities {
+		addToSum(authoritiesByTopic, key.topicId, stake)
+	}
+	compareSums(v, "topic_stake", "the sum of stake_reputer_authority", topicStakes, authoritiesByTopic, func(topicId TopicId) string {
+		return fmt.Sprintf("topic %d", topicId)
+	})
+
+	// stake sum from delegator = sum of its delegations in the topic,
+	// stake from delegators upon reputer = sum of the delegations upon it in the topic
+	delegatedByDelegator := make(map[topicActor]cosmosMath.Int)
+	delegatedUponReputer := make(map[topicActor]cosmosMath.Int)
+	delegations := make(map[topicDelegatorReputer]bool)
+	validateEntries(v, "delegated_stakes", gs.DelegatedStakes, func(i int, entry *TopicIdDelegatorReputerDelegatorInfo) {
+		v.topic("delegated_stakes", i, entry.TopicId)
+		v.address("delegated_stakes", i, entry.Delegator)
+		v.registeredReputer("delegated_stakes", i, entry.Reputer)
+		if !validateNested(v, "delegated_stakes", i, "delegator info", entry.DelegatorInfo) {
+			return
+		}
+		amount, err := entry.DelegatorInfo.Amount.SdkIntTrim()
+		if !v.check("delegated_stakes", i, err) {
+			return
+		}
+		key := topicDelegatorReputer{topicId: entry.TopicId, delegator: entry.Delegator, reputer: entry.Reputer}
+		if delegations[key] {
+			v.addf("delegated_stakes", i, "delegation of %s upon %s in topic %d is listed more than once", entry.Delegator, entry.Reputer, entry.TopicId)
+		}
+		delegations[key] = true
+		addToSum(delegatedByDelegator, topicActor{topicId: entry.TopicId, actor: entry.Delegator}, amount)
+		addToSum(delegatedUponReputer, topicActor{topicId: entry.TopicId, actor: entry.Reputer}, amount)
+	})
+	compareSums(v, "stake_sum_from_delegator", "the sum of delegated_stakes", sumsFromDelegator, delegatedByDelegator, topicActor.String)
+	compareSums(v, "stake_from_delegators_upon_reputer", "the sum of delegated_stakes", sumsUponReputer, delegatedUponReputer, topicActor.String)
+	validateEntries(v, "delegate_reward_per_share", gs.DelegateRewardPerShare, func(i int, entry *TopicIdActorIdDec) {
+		v.topic("delegate_reward_per_share", i, entry.TopicId)
+		v.address("delegate_reward_per_share", i, entry.ActorId)
+		v.dec("delegate_reward_per_share", i, entry.Dec)
+	})
+	validateEntries(v, "auto_compounding_delegations", gs.AutoCompoundingDelegations, func(i int, entry *TopicIdDelegatorReputer) {
+		v.topic("auto_compounding_delegations", i, entry.TopicId)
+		v.check("auto_compounding_delegations", i, entry.Validate())
+	})
+
+	// Stake removals are listed by block with their info and by actor with their keys only, both must match.
+	// No topic may have more stake being removed than it holds.
+	removalsByTopic := make(map[TopicId]cosmosMath.Int)
+	removalsByBlock := make(map[topicActorBlock]bool)
+	validateEntries(v, "stake_removals_by_block", gs.StakeRemovalsByBlock, func(i int, entry *BlockHeightTopicIdReputerStakeRemovalInfo) {
+		if !validateNested(v, "stake_removals_by_block", i, "stake removal info", entry.StakeRemovalInfo) {
+			return
+		}
+		info := entry.StakeRemovalInfo
+		if entry.BlockHeight != info.BlockRemovalCompleted || entry.TopicId != info.TopicId || entry.Reputer != info.Reputer {
+			v.addf("stake_removals_by_block", i, "key (%d, %d, %s) does not match the stake removal info", entry.BlockHeight, entry.TopicId, entry.Reputer)
+		}
+		v.topic("stake_removals_by_block", i, info.TopicId)
+		removalsByBlock[topicActorBlock{topicId: info.TopicId, actor: info.Reputer, block: info.BlockRemovalCompleted}] = true
+		addToSum(removalsByTopic, info.TopicId, info.Amount)
+	})
+	removalsByActor := make(map[topicActorBlock]bool)
+	validateEntries(v, "stake_removals_by_actor", gs.StakeRemovalsByActor, func(i int, entry *ActorIdTopicIdBlockHeight) {
+		key := topicActorBlock{topicId: entry.TopicId, actor: entry.ActorId, block: entry.BlockHeight}
+		if !removalsByBlock[key] {
+			v.addf("stake_removals_by_actor", i, "no stake removal of %s in topic %d completes at block %d", entry.ActorId, entry.TopicId, entry.BlockHeight)
+		}
+		removalsByActor[key] = true
+	})
+	if len(removalsByActor) != len(removalsByBlock) {
+		v.problems = append(v.problems, errors.Wrapf(ErrInvalidGenesisState,
+			"stake_removals_by_actor: %d removals listed by actor but %d by block", len(removalsByActor), len(removalsByBlock)))
+	}
+
+	delegateRemovalsByBlock := make(map[topicDelegatorReputerBlock]bool)
+	validateEntries(v, "delegate_stake_removals_by_block", gs.DelegateStakeRemovalsByBlock, func(i int, entry *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) {
+		if !validateNested(v, "delegate_stake_removals_by_block", i, "delegate stake removal info", entry.DelegateStakeRemovalInfo) {
+			return
+		}
+		info := entry.DelegateStakeRemovalInfo
+		if entry.BlockHeight != info.BlockRemovalCompleted || entry.TopicId != info.TopicId ||
+			entry.Delegator != info.Delegator || entry.Reputer != info.Reputer {
+			v.addf("delegate_stake_removals_by_block", i, "key (%d, %d, %s, %s) does not match the delegate stake removal info",
+				entry.BlockHeight, entry.TopicId, entry.Delegator, entry.Reputer)
+		}
+		v.topic("delegate_stake_removals_by_block", i, info.TopicId)
+		delegation := topicDelegatorReputer{topicId: info.TopicId, delegator: info.Delegator, reputer: info.Reputer}
+		delegateRemovalsByBlock[topicDelegatorReputerBlock{topicDelegatorReputer: delegation, block: info.BlockRemovalCompleted}] = true
+		addToSum(removalsByTopic, info.TopicId, info.Amount)
+	})
+	delegateRemovalsByActor := make(map[topicDelegatorReputerBlock]bool)
+	validateEntries(v, "delegate_stake_removals_by_actor", gs.DelegateStakeRemovalsByActor, func(i int, entry *DelegatorReputerTopicIdBlockHeight) {
+		delegation := topicDelegatorReputer{topicId: entry.TopicId, delegator: entry.Delegator, reputer: entry.Reputer}
+		key := topicDelegatorReputerBlock{topicDelegatorReputer: delegation, block: entry.BlockHeight}
+		if !delegateRemovalsByBlock[key] {
+			v.addf("delegate_stake_removals_by_actor", i, "no delegate stake removal of %s upon %s in topic %d completes at block %d",
+				entry.Delegator, entry.Reputer, entry.TopicId, entry.BlockHeight)
+		}
+		delegateRemovalsByActor[key] = true
+	})
+	if len(delegateRemovalsByActor) != len(delegateRemovalsByBlock) {
+		v.problems = append(v.problems, errors.Wrapf(ErrInvalidGenesisState,
+			"delegate_stake_removals_by_actor: %d removals listed by actor but %d by block", len(delegateRemovalsByActor), len(delegateRemovalsByBlock)))
+	}
+	for topicId, removed := range removalsByTopic {
+		if topicStake := sumOrZero(topicStakes, topicId); removed.GT(topicStake) {
+			v.problems = append(v.problems, errors.Wrapf(ErrInvalidGenesisState,
+				"stake_removals_by_block: %s of stake is being removed from topic %d which holds %s", removed, topicId, topicStake))
+		}
+	}
+
+	validateEntries(v, "redelegations", gs.Redelegations, func(i int, redelegation *Redelegation) {
+		if !v.check("redelegations", i, redelegation.Validate()) {
+			return
+		}
+		v.topic("redelegations", i, redelegation.SrcTopicId)
+		v.topic("redelegations", i, redelegation.DstTopicId)
+		if redelegation.Id >= gs.NextRedelegationId {
+			v.addf("redelegations", i, "redelegation id %d is not below next_redelegation_id %d", redelegation.Id, gs.NextRedelegationId)
+		}
+	})
+	validateEntries(v, "accrued_rewards", gs.AccruedRewards, func(i int, entry *TopicIdActorIdInt) {
+		v.topic("accrued_rewards", i, entry.TopicId)
+		v.address("accrued_rewards", i, entry.ActorId)
+		v.monetaryValue("accrued_rewards", i, entry.Int)
+	})
+}
+
+// Reports the keys whose expected sum differs from the sum computed from the entries they summarize.
+// Missing keys count as zero, as the keeper removes the zero entries.
+func compareSums[K comparable](
+	v *genesisValidator,
+	field, computedFrom string,
+	expected, computed map[K]cosmosMath.Int,
+	describe func(K) string,
+) {
+	keys := make(map[K]bool)
+	for key := range expected {
+		keys[key] = true
+	}
+	for key := range computed {
+		keys[key] = true
+	}
+	for key := range keys {
+		if expectedSum, computedSum := sumOrZero(expected, key), sumOrZero(computed, key); !expectedSum.Equal(computedSum) {
+			v.problems = append(v.problems, errors.Wrapf(ErrInvalidGenesisState,
+				"%s: %s of %s does not equal %s %s", field, expectedSum, describe(key), computedFrom, computedSum))
+		}
+	}
+}
+
+// Inferences, forecasts and losses submitted or computed for the open and recent nonces
+func (v *genesisValidator) validatePayloads(gs *GenesisState) {
+	validateEntries(v, "inferences", gs.Inferences, func(i int, entry *TopicIdActorIdInference) {
+		v.topic("inferences", i, entry.TopicId)
+		validateNested(v, "inferences", i, "inference", entry.Inference)
+	})
+	validateEntries(v, "forecasts", gs.Forecasts, func(i int, entry *TopicIdActorIdForecast) {
+		v.topic("forecasts", i, entry.TopicId)
+		validateNested(v, "forecasts", i, "forecast", entry.Forecast)
+	})
+	validateEntries(v, "all_inferences", gs.AllInferences, func(i int, entry *TopicIdBlockHeightInferences) {
+		v.topic("all_inferences", i, entry.TopicId)
+		v.check("all_inferences", i, ValidateBlockHeight(entry.BlockHeight))
+		if entry.Inferences == nil {
+			v.addf("all_inferences", i, "inferences cannot be nil")
+			return
+		}
+		for _, inference := range entry.Inferences.Inferences {
+			validateNested(v, "all_inferences", i, "inference", inference)
+		}
+	})
+	validateEntries(v, "all_forecasts", gs.AllForecasts, func(i int, entry *TopicIdBlockHeightForecasts) {
+		v.topic("all_forecasts", i, entry.TopicId)
+		v.check("all_forecasts", i, ValidateBlockHeight(entry.BlockHeight))
+		if entry.Forecasts == nil {
+			v.addf("all_forecasts", i, "forecasts cannot be nil")
+			return
+		}
+		for _, forecast := range entry.Forecasts.Forecasts {
+			validateNested(v, "all_forecasts", i, "forecast", forecast)
+		}
+	})
+	validateEntries(v, "all_loss_bundles", gs.AllLossBundles, func(i int, entry *TopicIdBlockHeightReputerValueBundles) {
+		v.topic("all_loss_bundles", i, entry.TopicId)
+		v.check("all_loss_bundles", i, ValidateBlockHeight(entry.BlockHeight))
+		if entry.ReputerValueBundles == nil {
+			v.addf("all_loss_bundles", i, "reputer value bundles cannot be nil")
+			return
+		}
+		for j, bundle := range entry.ReputerValueBundles.ReputerValueBundles {
+			if bundle == nil {
+				v.addf("all_loss_bundles", i, "reputer value bundle %d cannot be nil", j)
+				continue
+			}
+			// bundles filtered in CloseReputerNonce are stored with the signature of the unfiltered bundle
+			v.check("all_loss_bundles", i, errors.Wrapf(bundle.ValidateWithoutSignature(), "reputer value bundle %d is invalid", j))
+		}
+	})
+	validateEntries(v, "network_loss_bundles", gs.NetworkLossBundles, func(i int, entry *TopicIdBlockHeightValueBundles) {
+		v.topic("network_loss_bundles", i, entry.TopicId)
+		v.check("network_loss_bundles", i, ValidateBlockHeight(entry.BlockHeight))
+		validateNested(v, "network_loss_bundles", i, "value bundle", entry.ValueBundle)
+	})
+	validateEntries(v, "loss_bundles", gs.LossBundles, func(i int, entry *TopicIdReputerReputerValueBundle) {
+		v.topic("loss_bundles", i, entry.TopicId)
+		v.address("loss_bundles", i, entry.Reputer)
+		validateNested(v, "loss_bundles", i, "reputer value bundle", entry.ReputerValueBundle)
+	})
+	validateEntries(v, "worker_commitments", gs.WorkerCommitments, func(i int, entry *TopicIdBlockHeightActorIdWorkerCommitment) {
+		v.topic("worker_commitments", i, entry.TopicId)
+		v.check("worker_commitments", i, ValidateBlockHeight(entry.BlockHeight))
+		v.address("worker_commitments", i, entry.ActorId)
+		validateNested(v, "worker_commitments", i, "commitment", entry.Commitment)
+	})
+	validateEntries(v, "ground_truths", gs.GroundTruths, func(i int, entry *TopicIdBlockHeightDec) {
+		v.topic("ground_truths", i, entry.TopicId)
+		v.check("ground_truths", i, ValidateBlockHeight(entry.BlockHeight))
+		v.dec("ground_truths", i, entry.Dec)
+	})
+	validateEntries(v, "network_inference_archive", gs.NetworkInferenceArchive, func(i int, archived *ArchivedNetworkInference) {
+		if v.check("network_inference_archive", i, archived.Validate()) {
+			v.topic("network_inference_archive", i, archived.TopicId)
+		}
+	})
+}
+
+// Scores, score EMAs, reward fractions and regrets of the actors of each topic
+func (v *genesisValidator) validateScoresAndRegrets(gs *GenesisState) {
+	scoresByBlock := func(field string, entries []*TopicIdBlockHeightScores) {
+		validateEntries(v, field, entries, func(i int, entry *TopicIdBlockHeightScores) {
+			v.topic(field, i, entry.TopicId)
+			v.check(field, i, ValidateBlockHeight(entry.BlockHeight))
+			validateNested(v, field, i, "scores", entry.Scores)
+		})
+	}
+	scoresByBlock("inferer_scores_by_block", gs.InfererScoresByBlock)
+	scoresByBlock("forecaster_scores_by_block", gs.ForecasterScoresByBlock)
+	scoresByBlock("reputer_scores_by_block", gs.ReputerScoresByBlock)
+
+	scores := func(field string, entries []*TopicIdActorIdScore, checkActor bool) {
+		validateEntries(v, field, entries, func(i int, entry *TopicIdActorIdScore) {
+			v.topic(field, i, entry.TopicId)
+			if checkActor {
+				v.address(field, i, entry.ActorId)
+			}
+			validateNested(v, field, i, "score", entry.Score)
+		})
+	}
+	scores("inferer_score_emas", gs.InfererScoreEmas, true)
+	scores("forecaster_score_emas", gs.ForecasterScoreEmas, true)
+	scores("reputer_score_emas", gs.ReputerScoreEmas, true)
+	// The lowest score EMAs are kept per topic only
+	scores("lowest_inferer_score_ema", gs.LowestInfererScoreEma, false)
+	scores("lowest_forecaster_score_ema", gs.LowestForecasterScoreEma, false)
+	scores("lowest_reputer_score_ema", gs.LowestReputerScoreEma, false)
+
+	validateEntries(v, "reputer_listening_coefficient", gs.ReputerListeningCoefficient, func(i int, entry *TopicIdActorIdListeningCoefficient) {
+		v.topic("reputer_listening_coefficient", i, entry.TopicId)
+		v.address("reputer_listening_coefficient", i, entry.ActorId)
+		validateNested(v, "reputer_listening_coefficient", i, "listening coefficient", entry.ListeningCoefficient)
+	})
+	rewardFractions := func(field string, entries []*TopicIdActorIdDec) {
+		validateEntries(v, field, entries, func(i int, entry *TopicIdActorIdDec) {
+			v.topic(field, i, entry.TopicId)
+			v.address(field, i, entry.ActorId)
+			v.dec(field, i, entry.Dec)
+		})
+	}
+	rewardFractions("previous_reputer_reward_fraction", gs.PreviousReputerRewardFraction)
+	rewardFractions("previous_inference_reward_fraction", gs.PreviousInferenceRewardFraction)
+	rewardFractions("previous_forecast_reward_fraction", gs.PreviousForecastRewardFraction)
+
+	inclusions := func(field string, entries []*TopicIdActorIdUint64) {
+		validateEntries(v, field, entries, func(i int, entry *TopicIdActorIdUint64) {
+			v.topic(field, i, entry.TopicId)
+			v.address(field, i, entry.ActorId)
+		})
+	}
+	inclusions("count_inferer_inclusions_in_topic_active_set", gs.CountInfererInclusionsInTopicActiveSet)
+	inclusions("count_forecaster_inclusions_in_topic_active_set", gs.CountForecasterInclusionsInTopicActiveSet)
+
+	regrets := func(field string, entries []*TopicIdActorIdTimeStampedValue) {
+		validateEntries(v, field, entries, func(i int, entry *TopicIdActorIdTimeStampedValue) {
+			v.topic(field, i, entry.TopicId)
+			v.address(field, i, entry.ActorId)
+			validateNested(v, field, i, "timestamped value", entry.TimestampedValue)
+		})
+	}
+	regrets("latest_inferer_network_regrets", gs.LatestInfererNetworkRegrets)
+	regrets("latest_forecaster_network_regrets", gs.LatestForecasterNetworkRegrets)
+	regrets("latest_naive_inferer_network_regrets", gs.LatestNaiveInfererNetworkRegrets)
+
+	pairRegrets := func(field string, entries []*TopicIdActorIdActorIdTimeStampedValue) {
+		validateEntries(v, field, entries, func(i int, entry *TopicIdActorIdActorIdTimeStampedValue) {
+			v.topic(field, i, entry.TopicId)
+			v.address(field, i, entry.ActorId1)
+			v.address(field, i, entry.ActorId2)
+			validateNested(v, field, i, "timestamped value", entry.TimestampedValue)
+		})
+	}
+	pairRegrets("latest_one_in_forecaster_network_regrets", gs.LatestOneInForecasterNetworkRegrets)
+	pairRegrets("latest_one_out_inferer_inferer_network_regrets", gs.LatestOneOutInfererInfererNetworkRegrets)
+	pairRegrets("latest_one_out_inferer_forecaster_network_regrets", gs.LatestOneOutInfererForecasterNetworkRegrets)
+	pairRegrets("latest_one_out_forecaster_inferer_network_regrets", gs.LatestOneOutForecasterInfererNetworkRegrets)
+	pairRegrets("latest_one_out_forecaster_forecaster_network_regrets", gs.LatestOneOutForecasterForecasterNetworkRegrets)
+}
+
+// Scheduled updates, GMP routing and subscriptions, and topic sponsorships
+func (v *genesisValidator) validateTopicFeatures(gs *GenesisState) {
+	validateEntries(v, "scheduled_topic_updates", gs.ScheduledTopicUpdates, func(i int, update *ScheduledTopicUpdate) {
+		if v.check("scheduled_topic_updates", i, update.Validate()) {
+			v.liveTopic("scheduled_topic_updates", i, update.TopicId)
+		}
+	})
+	validateEntries(v, "gmp_allowed_sources", gs.GmpAllowedSources, func(i int, source *GmpAllowedSource) {
+		v.check("gmp_allowed_sources", i, source.Validate())
+	})
+	validateEntries(v, "gmp_subscriptions", gs.GmpSubscriptions, func(i int, subscription *GmpSubscription) {
+		if !v.check("gmp_subscriptions", i, subscription.Validate()) {
+			return
+		}
+		v.topic("gmp_subscriptions", i, subscription.TopicId)
+		// subscription ids are one above the sequence value they were issued from
+		if subscription.Id > gs.NextGmpSubscriptionId {
+			v.addf("gmp_subscriptions", i, "subscription id %d is above next_gmp_subscription_id %d", subscription.Id, gs.NextGmpSubscriptionId)
+		}
+	})
+	validateEntries(v, "gmp_deliveries", gs.GmpDeliveries, func(i int, delivery *GmpDelivery) {
+		v.check("gmp_deliveries", i, delivery.Validate())
+	})
+	validateEntries(v, "topic_sponsorships", gs.TopicSponsorships, func(i int, sponsorship *TopicSponsorship) {
+		if v.check("topic_sponsorships", i, sponsorship.Validate()) {
+			v.topic("topic_sponsorships", i, sponsorship.TopicId)
+		}
+	})
+	validateEntries(v, "sponsored_actor_fees", gs.SponsoredActorFees, func(i int, entry *TopicIdSponsorActorSponsoredFees) {
+		v.topic("sponsored_actor_fees", i, entry.TopicId)
+		v.address("sponsored_actor_fees", i, entry.Sponsor)
+		v.address("sponsored_actor_fees", i, entry.Actor)
+		validateNested(v, "sponsored_actor_fees", i, "fees", entry.Fees)
+	})
+}
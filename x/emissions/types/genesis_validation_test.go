@@ -0,0 +1,219 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	// the default core team addresses use the allo prefix
+	params.SetAddressPrefixes()
+}
+
+func genesisTestAddress(seed byte) string {
+	return sdk.AccAddress([]byte{seed, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19}).String()
+}
+
+// Returns a valid genesis state with one topic, a reputer staking on it and a delegator staking upon that reputer
+func stakedGenesisState() *types.GenesisState {
+	creator := genesisTestAddress(1)
+	reputer := genesisTestAddress(2)
+	delegator := genesisTestAddress(3)
+
+	genesisState := types.NewGenesisState()
+	genesisState.NextTopicId = 2
+	genesisState.Topics = []*types.TopicIdAndTopic{{
+		TopicId: 1,
+		Topic: &types.Topic{ //nolint:exhaustruct // only the validated fields are set
+			Id:                       1,
+			Creator:                  creator,
+			LossMethod:               "mse",
+			EpochLength:              12,
+			GroundTruthLag:           12,
+			WorkerSubmissionWindow:   10,
+			AlphaRegret:              alloraMath.MustNewDecFromString("0.1"),
+			PNorm:                    alloraMath.MustNewDecFromString("3"),
+			Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
+			MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
+			ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.25"),
+			ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.25"),
+			ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.25"),
+		},
+	}}
+	genesisState.Reputers = []*types.LibP2PKeyAndOffchainNode{{
+		LibP2PKey:    reputer,
+		OffchainNode: &types.OffchainNode{NodeAddress: reputer, Owner: reputer},
+	}}
+	genesisState.TopicReputers = []*types.TopicAndActorId{{TopicId: 1, ActorId: reputer}}
+	genesisState.TotalStake = cosmosMath.NewInt(150)
+	genesisState.TopicStake = []*types.TopicIdAndInt{{TopicId: 1, Int: cosmosMath.NewInt(150)}}
+	genesisState.StakeReputerAuthority = []*types.TopicIdActorIdInt{{TopicId: 1, ActorId: reputer, Int: cosmosMath.NewInt(150)}}
+	genesisState.StakeSumFromDelegator = []*types.TopicIdActorIdInt{{TopicId: 1, ActorId: delegator, Int: cosmosMath.NewInt(50)}}
+	genesisState.StakeFromDelegatorsUponReputer = []*types.TopicIdActorIdInt{{TopicId: 1, ActorId: reputer, Int: cosmosMath.NewInt(50)}}
+	genesisState.DelegatedStakes = []*types.TopicIdDelegatorReputerDelegatorInfo{{
+		TopicId:   1,
+		Delegator: delegator,
+		Reputer:   reputer,
+		DelegatorInfo: &types.DelegatorInfo{
+			Amount:     alloraMath.NewDecFromInt64(50),
+			RewardDebt: alloraMath.ZeroDec(),
+		},
+	}}
+	genesisState.StakeRemovalsByBlock = []*types.BlockHeightTopicIdReputerStakeRemovalInfo{{
+		BlockHeight: 100,
+		TopicId:     1,
+		Reputer:     reputer,
+		StakeRemovalInfo: &types.StakeRemovalInfo{
+			BlockRemovalStarted:   10,
+			TopicId:               1,
+			Reputer:               reputer,
+			Amount:                cosmosMath.NewInt(20),
+			BlockRemovalCompleted: 100,
+		},
+	}}
+	genesisState.StakeRemovalsByActor = []*types.ActorIdTopicIdBlockHeight{{ActorId: reputer, TopicId: 1, BlockHeight: 100}}
+	return genesisState
+}
+
+func TestDefaultGenesisStateIsValid(t *testing.T) {
+	genesisState := types.NewGenesisState()
+	require.Empty(t, genesisState.ValidationProblems())
+	require.NoError(t, genesisState.Validate())
+}
+
+func TestStakedGenesisStateIsValid(t *testing.T) {
+	genesisState := stakedGenesisState()
+	require.Empty(t, genesisState.ValidationProblems())
+	require.NoError(t, genesisState.Validate())
+}
+
+func TestGenesisStateReportsUnknownTopic(t *testing.T) {
+	genesisState := stakedGenesisState()
+	genesisState.ActiveTopics = []uint64{7}
+
+	problems := genesisState.ValidationProblems()
+	require.Len(t, problems, 1)
+	require.ErrorIs(t, problems[0], types.ErrInvalidGenesisState)
+	require.ErrorContains(t, problems[0], "active_topics[0]")
+}
+
+func TestGenesisStateReportsInvalidAddress(t *testing.T) {
+	genesisState := stakedGenesisState()
+	genesisState.TopicWorkers = []*types.TopicAndActorId{{TopicId: 1, ActorId: "not an address"}}
+
+	problems := genesisState.ValidationProblems()
+	require.NotEmpty(t, problems)
+	require.ErrorContains(t, problems[0], "topic_workers[0]")
+}
+
+func TestGenesisStateReportsUnregisteredStakeHolder(t *testing.T) {
+	genesisState := stakedGenesisState()
+	genesisState.Reputers = []*types.LibP2PKeyAndOffchainNode{}
+	genesisState.TopicReputers = []*types.TopicAndActorId{}
+
+	require.NotEmpty(t, genesisState.ValidationProblems())
+}
+
+func TestGenesisStateReportsTotalStakeMismatch(t *testing.T) {
+	genesisState := stakedGenesisState()
+	genesisState.TotalStake = cosmosMath.NewInt(151)
+
+	problems := genesisState.ValidationProblems()
+	require.Len(t, problems, 1)
+	require.ErrorContains(t, problems[0], "total_stake")
+}
+
+func TestGenesisStateReportsTopicStakeMismatch(t *testing.T) {
+	genesisState := stakedGenesisState()
+	genesisState.StakeReputerAuthority[0].Int = cosmosMath.NewInt(149)
+
+	problems := genesisState.ValidationProblems()
+	require.Len(t, problems, 1)
+	require.ErrorContains(t, problems[0], "topic_stake: 150 of topic 1 does not equal the sum of stake_reputer_authority 149")
+}
+
+func TestGenesisStateReportsDelegatedStakeMismatch(t *testing.T) {
+	genesisState := stakedGenesisState()
+	genesisState.DelegatedStakes[0].DelegatorInfo.Amount = alloraMath.NewDecFromInt64(40)
+
+	problems := genesisState.ValidationProblems()
+	require.Len(t, problems, 2)
+	require.ErrorContains(t, problems[0], "stake_sum_from_delegator")
+	require.ErrorContains(t, problems[1], "stake_from_delegators_upon_reputer")
+}
+
+func TestGenesisStateReportsStakeRemovalIndexMismatch(t *testing.T) {
+	genesisState := stakedGenesisState()
+	genesisState.StakeRemovalsByActor[0].BlockHeight = 101
+
+	problems := genesisState.ValidationProblems()
+	require.NotEmpty(t, problems)
+	require.ErrorContains(t, problems[0], "stake_removals_by_actor")
+}
+
+// Returns a loss bundle of a reputer, signed before its second inferer value was filtered out
+func filteredLossBundle(t *testing.T) *types.ReputerValueBundle {
+	t.Helper()
+	privKey := secp256k1.GenPrivKey()
+	valueBundle := &types.ValueBundle{ //nolint:exhaustruct // only the validated fields are set
+		TopicId:             1,
+		ReputerRequestNonce: &types.ReputerRequestNonce{ReputerNonce: &types.Nonce{BlockHeight: 100}},
+		Reputer:             sdk.AccAddress(privKey.PubKey().Address()).String(),
+		CombinedValue:       alloraMath.MustNewDecFromString("0.1"),
+		InfererValues: []*types.WorkerAttributedValue{
+			{Worker: genesisTestAddress(4), Value: alloraMath.MustNewDecFromString("0.2")},
+			{Worker: genesisTestAddress(5), Value: alloraMath.MustNewDecFromString("0.3")},
+		},
+		NaiveValue: alloraMath.MustNewDecFromString("0.4"),
+	}
+	marshaled, err := valueBundle.XXX_Marshal(nil, true)
+	require.NoError(t, err)
+	signature, err := privKey.Sign(marshaled)
+	require.NoError(t, err)
+	valueBundle.InfererValues = valueBundle.InfererValues[:1]
+	return &types.ReputerValueBundle{
+		ValueBundle: valueBundle,
+		Signature:   signature,
+		Pubkey:      hex.EncodeToString(privKey.PubKey().Bytes()),
+	}
+}
+
+func TestGenesisStateReportsEveryInvalidLossBundle(t *testing.T) {
+	genesisState := stakedGenesisState()
+	mismatchedPubkey := filteredLossBundle(t)
+	mismatchedPubkey.Pubkey = filteredLossBundle(t).Pubkey
+	invalidValue := filteredLossBundle(t)
+	invalidValue.ValueBundle.NaiveValue = alloraMath.NewNaN()
+	genesisState.AllLossBundles = []*types.TopicIdBlockHeightReputerValueBundles{{
+		TopicId:     1,
+		BlockHeight: 100,
+		ReputerValueBundles: &types.ReputerValueBundles{
+			ReputerValueBundles: []*types.ReputerValueBundle{filteredLossBundle(t), mismatchedPubkey, invalidValue},
+		},
+	}}
+
+	// Only the signatures, made over the unfiltered bundles, go unchecked
+	problems := genesisState.ValidationProblems()
+	require.Len(t, problems, 2)
+	require.ErrorContains(t, problems[0], "all_loss_bundles[0]: reputer value bundle 1 is invalid: Reputer does not match pubkey")
+	require.ErrorContains(t, problems[1], "all_loss_bundles[0]: reputer value bundle 2 is invalid")
+}
+
+func TestGenesisStateValidateWrapsAllProblems(t *testing.T) {
+	genesisState := stakedGenesisState()
+	genesisState.ActiveTopics = []uint64{7}
+	genesisState.TotalStake = cosmosMath.NewInt(151)
+
+	require.Len(t, genesisState.ValidationProblems(), 2)
+	err := genesisState.Validate()
+	require.ErrorIs(t, err, types.ErrInvalidGenesisState)
+	require.ErrorContains(t, err, "first of 2 genesis problems")
+}
@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// Statuses reported in EventGmpDelivery for outbound GMP messages
+const (
+	GmpDeliveryStatusSent         = "sent"
+	GmpDeliveryStatusAcknowledged = "acknowledged"
+	GmpDeliveryStatusFailed       = "failed"
+	GmpDeliveryStatusTimedOut     = "timed_out"
+)
+
+// GmpFeeEscrowAddress holds the prepaid fees of outbound GMP subscriptions and is the sender of the
+// ICS-20 transfers that carry their messages, so refunds of failed transfers return to it.
+// It is derived from the module name rather than being a module account so that it may send over IBC.
+var GmpFeeEscrowAddress = sdk.AccAddress(address.Module(ModuleName, []byte("gmp_fee_escrow")))
@@ -8,6 +8,7 @@ const (
 	AlloraStakingAccountName                   = "allorastaking"
 	AlloraRewardsAccountName                   = "allorarewards"
 	AlloraPendingRewardForDelegatorAccountName = "allorapendingrewards"
+	AlloraAccruedRewardsAccountName            = "alloraaccruedrewards"
 )
 
 var (
@@ -97,4 +98,33 @@ var (
 	LossBundlesKey                                    = collections.NewPrefix(82)
 	TotalSumPreviousTopicWeightsKey                   = collections.NewPrefix(83)
 	RewardCurrentBlockEmissionKey                     = collections.NewPrefix(84)
+	ScheduledTopicUpdatesKey                          = collections.NewPrefix(85)
+	TopicRetirementsKey                               = collections.NewPrefix(86)
+	RetiringTopicsKey                                 = collections.NewPrefix(87)
+	GmpAllowedSourcesKey                              = collections.NewPrefix(88)
+	NextGmpSubscriptionIdKey                          = collections.NewPrefix(89)
+	GmpSubscriptionsKey                               = collections.NewPrefix(90)
+	TopicGmpSubscriptionsKey                          = collections.NewPrefix(91)
+	GmpDeliveriesKey                                  = collections.NewPrefix(92)
+	TopicWorkerAllowlistEnabledKey                    = collections.NewPrefix(93)
+	TopicReputerAllowlistEnabledKey                   = collections.NewPrefix(94)
+	TopicWorkerAllowlistKey                           = collections.NewPrefix(95)
+	TopicReputerAllowlistKey                          = collections.NewPrefix(96)
+	ReputerSlashingInfoKey                            = collections.NewPrefix(97)
+	WorkerCommitmentsKey                              = collections.NewPrefix(98)
+	GroundTruthsKey                                   = collections.NewPrefix(99)
+	NetworkInferenceArchiveKey                        = collections.NewPrefix(100)
+	TopicSponsorshipsKey                              = collections.NewPrefix(101)
+	SponsoredActorFeesKey                             = collections.NewPrefix(102)
+	AutoCompoundingDelegationsKey                     = collections.NewPrefix(103)
+	NextRedelegationIdKey                             = collections.NewPrefix(104)
+	RedelegationsKey                                  = collections.NewPrefix(105)
+	RedelegationsBySourceKey                          = collections.NewPrefix(106)
+	RedelegationsByDelegatorKey                       = collections.NewPrefix(107)
+	RedelegationsByCompletionKey                      = collections.NewPrefix(108)
+	AccruedRewardsKey                                 = collections.NewPrefix(109)
+	TotalAccruedRewardsKey                            = collections.NewPrefix(110)
+	TopicDataSendingFeeKey                            = collections.NewPrefix(111)
+	DelegationsByReputerKey                           = collections.NewPrefix(112)
+	TopicPayloadsReceivedKey                          = collections.NewPrefix(113)
 )
@@ -7,6 +7,7 @@ import (
 
 	cosmosMath "cosmossdk.io/math"
 	alloraMath "github.com/allora-network/allora-chain/math"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
 // DefaultParams returns default module parameters.
@@ -55,6 +56,22 @@ func DefaultParams() Params {
 		MaxStringLength:                     uint64(255),                                  // maximum length of strings uploaded to the chain
 		InitialRegretQuantile:               alloraMath.MustNewDecFromString("0.25"),      // quantile value for getting initial regret during network regret calculation
 		PNormSafeDiv:                        alloraMath.MustNewDecFromString("8.25"),      // pnorm divide value to calculate offset with cnorm
+		ReputerSlashFraction:                alloraMath.MustNewDecFromString("0.01"),      // fraction of a reputer's stake, delegations included, slashed per offense
+		SlashedStakeBurnFraction:            alloraMath.MustNewDecFromString("0.5"),       // fraction of slashed stake burned, the rest is redistributed as rewards
+		MaxMissedReputerNonces:              uint64(20),                                   // consecutive reputer nonces a reputer may miss before being slashed and jailed
+		ReputerOutlierScoreRatio:            alloraMath.MustNewDecFromString("0.1"),       // reputers scoring below this fraction of the median reputer score are outliers
+		MaxReputerOutlierEpochs:             uint64(10),                                   // consecutive epochs a reputer may be an outlier before being slashed and jailed
+		ReputerJailDuration:                 int64((60 * 60 * 24) / 3),                    // ~approx 1 day assuming 3 second block time, number of blocks a jailed reputer waits before unjailing
+		MaxReputerLossDeviation:             alloraMath.MustNewDecFromString("0.001"),     // relative deviation from losses recomputed against a submitted ground truth beyond which reputer losses are rejected
+		NetworkInferenceArchiveRetention:    int64((60 * 60 * 24 * 30) / 3),               // ~approx 30 days assuming 3 second block time, number of blocks archived network inferences are kept for
+		MaxPayloadsPerBatch:                 uint64(64),                                   // maximum number of payloads in a batched worker or reputer payload message
+		BatchedPayloadGasDiscount:           alloraMath.MustNewDecFromString("0.25"),      // fraction of the gas consumed processing each payload of a batched payload message that is not charged
+		MaxRedelegationsPerDelegator:        uint64(7),                                    // maximum number of redelegations in progress per delegator
+		RewardAccrualEnabled:                false,                                        // worker rewards are sent every reward nonce rather than credited to a ledger
+		DataSendingFeeMaxChangeRate:         alloraMath.MustNewDecFromString("0.125"),     // maximum fraction by which the data sending fee of a topic changes per worker nonce
+		DataSendingFeeTargetRatio:           alloraMath.MustNewDecFromString("1"),         // target payloads per worker nonce, as a fraction of the inferers, forecasters and reputers rewarded per nonce
+		GmpAxelarChannelId:                  "",                                           // no channel to Axelar, GMP messages are rejected until one is set
+		GmpAxelarCounterpartyChannelId:      "",                                           // channel of Axelar at the other end of the channel to Axelar
 	}
 }
 
@@ -189,6 +206,48 @@ func (p Params) Validate() error {
 	if err := validatePNormSafeDiv(p.PNormSafeDiv); err != nil {
 		return errorsmod.Wrap(err, "params validation failure: pnorm safe div")
 	}
+	if err := validateReputerSlashFraction(p.ReputerSlashFraction); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: reputer slash fraction")
+	}
+	if err := validateSlashedStakeBurnFraction(p.SlashedStakeBurnFraction); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: slashed stake burn fraction")
+	}
+	if err := validateMaxMissedReputerNonces(p.MaxMissedReputerNonces); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: max missed reputer nonces")
+	}
+	if err := validateReputerOutlierScoreRatio(p.ReputerOutlierScoreRatio); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: reputer outlier score ratio")
+	}
+	if err := validateMaxReputerOutlierEpochs(p.MaxReputerOutlierEpochs); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: max reputer outlier epochs")
+	}
+	if err := validateReputerJailDuration(p.ReputerJailDuration); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: reputer jail duration")
+	}
+	if err := validateMaxReputerLossDeviation(p.MaxReputerLossDeviation); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: max reputer loss deviation")
+	}
+	if err := validateNetworkInferenceArchiveRetention(p.NetworkInferenceArchiveRetention); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: network inference archive retention")
+	}
+	if err := validateMaxPayloadsPerBatch(p.MaxPayloadsPerBatch); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: max payloads per batch")
+	}
+	if err := validateBatchedPayloadGasDiscount(p.BatchedPayloadGasDiscount); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: batched payload gas discount")
+	}
+	if err := validateMaxRedelegationsPerDelegator(p.MaxRedelegationsPerDelegator); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: max redelegations per delegator")
+	}
+	if err := validateDataSendingFeeMaxChangeRate(p.DataSendingFeeMaxChangeRate); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: data sending fee max change rate")
+	}
+	if err := validateDataSendingFeeTargetRatio(p.DataSendingFeeTargetRatio); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: data sending fee target ratio")
+	}
+	if err := validateGmpAxelarChannels(p.GmpAxelarChannelId, p.GmpAxelarCounterpartyChannelId); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: gmp axelar channels")
+	}
 	return nil
 }
 
@@ -576,6 +635,139 @@ func validatePNormSafeDiv(i alloraMath.Dec) error {
 	return nil
 }
 
+// fraction of a reputer's stake slashed per offense
+// should be between 0 and 1, exclusive of 1 so that slashing never empties a stake
+func validateReputerSlashFraction(i alloraMath.Dec) error {
+	if err := ValidateDec(i); err != nil {
+		return err
+	} else if !isAlloraDecZeroOrLessThanOne(i) {
+		return ErrValidationMustBeBetweenZeroAndOne
+	}
+	return nil
+}
+
+// fraction of slashed stake that is burned rather than redistributed
+// should be between 0 and 1 inclusive
+func validateSlashedStakeBurnFraction(i alloraMath.Dec) error {
+	if err := ValidateDec(i); err != nil {
+		return err
+	} else if !isAlloraDecBetweenZeroAndOneInclusive(i) {
+		return ErrValidationMustBeBetweenZeroAndOne
+	}
+	return nil
+}
+
+// consecutive reputer nonces that may be missed before slashing
+// should be non-negative, enforced by uint type, 0 disables
+func validateMaxMissedReputerNonces(_ uint64) error {
+	return nil
+}
+
+// fraction of the median reputer score below which a reputer is an outlier
+// should be between 0 and 1, exclusive of 1, 0 disables
+func validateReputerOutlierScoreRatio(i alloraMath.Dec) error {
+	if err := ValidateDec(i); err != nil {
+		return err
+	} else if !isAlloraDecZeroOrLessThanOne(i) {
+		return ErrValidationMustBeBetweenZeroAndOne
+	}
+	return nil
+}
+
+// consecutive outlier epochs allowed before slashing
+// should be non-negative, enforced by uint type, 0 disables
+func validateMaxReputerOutlierEpochs(_ uint64) error {
+	return nil
+}
+
+// number of blocks a jailed reputer waits before it can unjail
+// should be non-negative
+func validateReputerJailDuration(i BlockHeight) error {
+	if i < 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
+// relative deviation from recomputed losses tolerated in reputer losses
+// should be non-negative, 0 requires reputer losses to match exactly
+func validateMaxReputerLossDeviation(i alloraMath.Dec) error {
+	if err := ValidateDec(i); err != nil {
+		return err
+	} else if i.IsNegative() {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
+// blocks archived network inferences are kept for
+// should be non-negative, 0 keeps them indefinitely
+func validateNetworkInferenceArchiveRetention(i BlockHeight) error {
+	if i < 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
+// maximum number of payloads in a batched payload message
+// should be non-negative, enforced by uint type, 0 disables batched payload messages
+func validateMaxPayloadsPerBatch(_ uint64) error {
+	return nil
+}
+
+// fraction of the gas of batched payloads that is not charged
+// Should be 0 <= x < 1, lest processing batched payloads be free
+func validateBatchedPayloadGasDiscount(i alloraMath.Dec) error {
+	if err := ValidateDec(i); err != nil {
+		return err
+	}
+	if !isAlloraDecBetweenZeroAndOneInclusive(i) || i.Equal(alloraMath.OneDec()) {
+		return ErrValidationMustBeBetweenZeroAndOne
+	}
+	return nil
+}
+
+// maximum number of redelegations in progress per delegator
+// should be non-negative, enforced by uint type, 0 disables redelegation
+func validateMaxRedelegationsPerDelegator(_ uint64) error {
+	return nil
+}
+
+// maximum fraction by which the data sending fee of a topic changes per worker nonce
+// Should be 0 <= x <= 1, 0 keeps every topic at the data sending fee
+func validateDataSendingFeeMaxChangeRate(i alloraMath.Dec) error {
+	if err := ValidateDec(i); err != nil {
+		return err
+	}
+	if !isAlloraDecBetweenZeroAndOneInclusive(i) {
+		return ErrValidationMustBeBetweenZeroAndOne
+	}
+	return nil
+}
+
+// target payloads per worker nonce, as a fraction of the inferers, forecasters and reputers rewarded per nonce
+// should be non-negative, 0 raises the fee whenever a nonce receives any submission
+func validateDataSendingFeeTargetRatio(i alloraMath.Dec) error {
+	if err := ValidateDec(i); err != nil {
+		return err
+	} else if i.IsNegative() {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
+// channel to Axelar and the channel of Axelar at its other end
+// Should both be valid channel identifiers, or both be empty to reject all GMP messages
+func validateGmpAxelarChannels(channelId, counterpartyChannelId string) error {
+	if channelId == "" && counterpartyChannelId == "" {
+		return nil
+	}
+	if err := host.ChannelIdentifierValidator(channelId); err != nil {
+		return err
+	}
+	return host.ChannelIdentifierValidator(counterpartyChannelId)
+}
+
 // Whether an alloraDec is between the value of [0, 1] inclusive
 func isAlloraDecBetweenZeroAndOneInclusive(a alloraMath.Dec) bool {
 	return a.Gte(alloraMath.ZeroDec()) && a.Lte(alloraMath.OneDec())
@@ -84,11 +84,27 @@ type Params struct {
 	/// a small tolerance quantity used to cap division by zero
 	DataSendingFee cosmossdk_io_math.Int `protobuf:"bytes,44,opt,name=data_sending_fee,json=dataSendingFee,proto3,customtype=cosmossdk.io/math.Int" json:"data_sending_fee"`
 	// payload sending fee for reputer or worker
-	MaxElementsPerForecast  uint64                                          `protobuf:"varint,45,opt,name=max_elements_per_forecast,json=maxElementsPerForecast,proto3" json:"max_elements_per_forecast,omitempty"`
-	MaxActiveTopicsPerBlock uint64                                          `protobuf:"varint,46,opt,name=max_active_topics_per_block,json=maxActiveTopicsPerBlock,proto3" json:"max_active_topics_per_block,omitempty"`
-	MaxStringLength         uint64                                          `protobuf:"varint,47,opt,name=max_string_length,json=maxStringLength,proto3" json:"max_string_length,omitempty"`
-	InitialRegretQuantile   github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,48,opt,name=initial_regret_quantile,json=initialRegretQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"initial_regret_quantile"`
-	PNormSafeDiv            github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,49,opt,name=p_norm_safe_div,json=pNormSafeDiv,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_norm_safe_div"`
+	MaxElementsPerForecast           uint64                                          `protobuf:"varint,45,opt,name=max_elements_per_forecast,json=maxElementsPerForecast,proto3" json:"max_elements_per_forecast,omitempty"`
+	MaxActiveTopicsPerBlock          uint64                                          `protobuf:"varint,46,opt,name=max_active_topics_per_block,json=maxActiveTopicsPerBlock,proto3" json:"max_active_topics_per_block,omitempty"`
+	MaxStringLength                  uint64                                          `protobuf:"varint,47,opt,name=max_string_length,json=maxStringLength,proto3" json:"max_string_length,omitempty"`
+	InitialRegretQuantile            github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,48,opt,name=initial_regret_quantile,json=initialRegretQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"initial_regret_quantile"`
+	PNormSafeDiv                     github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,49,opt,name=p_norm_safe_div,json=pNormSafeDiv,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_norm_safe_div"`
+	ReputerSlashFraction             github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,50,opt,name=reputer_slash_fraction,json=reputerSlashFraction,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reputer_slash_fraction"`
+	SlashedStakeBurnFraction         github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,51,opt,name=slashed_stake_burn_fraction,json=slashedStakeBurnFraction,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"slashed_stake_burn_fraction"`
+	MaxMissedReputerNonces           uint64                                          `protobuf:"varint,52,opt,name=max_missed_reputer_nonces,json=maxMissedReputerNonces,proto3" json:"max_missed_reputer_nonces,omitempty"`
+	ReputerOutlierScoreRatio         github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,53,opt,name=reputer_outlier_score_ratio,json=reputerOutlierScoreRatio,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reputer_outlier_score_ratio"`
+	MaxReputerOutlierEpochs          uint64                                          `protobuf:"varint,54,opt,name=max_reputer_outlier_epochs,json=maxReputerOutlierEpochs,proto3" json:"max_reputer_outlier_epochs,omitempty"`
+	ReputerJailDuration              int64                                           `protobuf:"varint,55,opt,name=reputer_jail_duration,json=reputerJailDuration,proto3" json:"reputer_jail_duration,omitempty"`
+	MaxReputerLossDeviation          github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,56,opt,name=max_reputer_loss_deviation,json=maxReputerLossDeviation,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"max_reputer_loss_deviation"`
+	NetworkInferenceArchiveRetention int64                                           `protobuf:"varint,57,opt,name=network_inference_archive_retention,json=networkInferenceArchiveRetention,proto3" json:"network_inference_archive_retention,omitempty"`
+	MaxPayloadsPerBatch              uint64                                          `protobuf:"varint,58,opt,name=max_payloads_per_batch,json=maxPayloadsPerBatch,proto3" json:"max_payloads_per_batch,omitempty"`
+	BatchedPayloadGasDiscount        github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,59,opt,name=batched_payload_gas_discount,json=batchedPayloadGasDiscount,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"batched_payload_gas_discount"`
+	MaxRedelegationsPerDelegator     uint64                                          `protobuf:"varint,60,opt,name=max_redelegations_per_delegator,json=maxRedelegationsPerDelegator,proto3" json:"max_redelegations_per_delegator,omitempty"`
+	RewardAccrualEnabled             bool                                            `protobuf:"varint,61,opt,name=reward_accrual_enabled,json=rewardAccrualEnabled,proto3" json:"reward_accrual_enabled,omitempty"`
+	DataSendingFeeMaxChangeRate      github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,62,opt,name=data_sending_fee_max_change_rate,json=dataSendingFeeMaxChangeRate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"data_sending_fee_max_change_rate"`
+	DataSendingFeeTargetRatio        github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,63,opt,name=data_sending_fee_target_ratio,json=dataSendingFeeTargetRatio,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"data_sending_fee_target_ratio"`
+	GmpAxelarChannelId               string                                          `protobuf:"bytes,64,opt,name=gmp_axelar_channel_id,json=gmpAxelarChannelId,proto3" json:"gmp_axelar_channel_id,omitempty"`
+	GmpAxelarCounterpartyChannelId   string                                          `protobuf:"bytes,65,opt,name=gmp_axelar_counterparty_channel_id,json=gmpAxelarCounterpartyChannelId,proto3" json:"gmp_axelar_counterparty_channel_id,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -257,6 +273,69 @@ func (m *Params) GetMaxStringLength() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxMissedReputerNonces() uint64 {
+	if m != nil {
+		return m.MaxMissedReputerNonces
+	}
+	return 0
+}
+
+func (m *Params) GetMaxReputerOutlierEpochs() uint64 {
+	if m != nil {
+		return m.MaxReputerOutlierEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetReputerJailDuration() int64 {
+	if m != nil {
+		return m.ReputerJailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetNetworkInferenceArchiveRetention() int64 {
+	if m != nil {
+		return m.NetworkInferenceArchiveRetention
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPayloadsPerBatch() uint64 {
+	if m != nil {
+		return m.MaxPayloadsPerBatch
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRedelegationsPerDelegator() uint64 {
+	if m != nil {
+		return m.MaxRedelegationsPerDelegator
+	}
+	return 0
+}
+
+func (m *Params) GetRewardAccrualEnabled() bool {
+	if m != nil {
+		return m.RewardAccrualEnabled
+	}
+	return false
+}
+
+func (m *Params) GetGmpAxelarChannelId() string {
+	if m != nil {
+		return m.GmpAxelarChannelId
+	}
+	return ""
+}
+
+func (m *Params) GetGmpAxelarCounterpartyChannelId() string {
+	if m != nil {
+		return m.GmpAxelarCounterpartyChannelId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "emissions.v5.Params")
 }
@@ -264,94 +343,120 @@ func init() {
 func init() { proto.RegisterFile("emissions/v5/params.proto", fileDescriptor_93845aebd2ad0d44) }
 
 var fileDescriptor_93845aebd2ad0d44 = []byte{
-	// 1380 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4d, 0x6f, 0x14, 0x47,
-	0x13, 0xf6, 0xbe, 0x18, 0x63, 0x1a, 0x63, 0xaf, 0xe7, 0x35, 0x30, 0xfe, 0xf6, 0x8b, 0x79, 0x13,
-	0xe3, 0x80, 0x97, 0x28, 0x42, 0xf9, 0x3c, 0x04, 0x64, 0x1b, 0xd9, 0xc2, 0xc8, 0x19, 0x3b, 0x20,
-	0x91, 0x28, 0x9d, 0xf6, 0x4c, 0xcd, 0x6e, 0xcb, 0x33, 0xdd, 0x43, 0x77, 0xef, 0x7a, 0xcd, 0x3d,
-	0xf7, 0xfc, 0x8b, 0xe4, 0x98, 0x43, 0x7e, 0x04, 0x47, 0x94, 0x53, 0x94, 0x03, 0x8a, 0xe0, 0x90,
-	0xbf, 0x11, 0x55, 0x77, 0xcf, 0x78, 0x0d, 0x51, 0x14, 0x31, 0xb9, 0xac, 0x76, 0xba, 0xab, 0x9e,
-	0xa7, 0xe6, 0xe9, 0xea, 0xaa, 0x1a, 0x32, 0x0d, 0x39, 0xd7, 0x9a, 0x4b, 0xa1, 0x5b, 0xbd, 0xdb,
-	0xad, 0x82, 0x29, 0x96, 0xeb, 0xb5, 0x42, 0x49, 0x23, 0x83, 0xb1, 0x6a, 0x6b, 0xad, 0x77, 0x7b,
-	0x66, 0x92, 0xe5, 0x5c, 0xc8, 0x96, 0xfd, 0x75, 0x06, 0x33, 0xd3, 0xb1, 0xd4, 0xb9, 0xd4, 0xd4,
-	0x3e, 0xb5, 0xdc, 0x83, 0xdf, 0x9a, 0x6a, 0xcb, 0xb6, 0x74, 0xeb, 0xf8, 0xcf, 0xad, 0x5e, 0xfd,
-	0x61, 0x9e, 0x8c, 0xec, 0x5a, 0x8a, 0x20, 0x24, 0xe7, 0x7a, 0xa0, 0x10, 0x3d, 0x6c, 0x2c, 0x35,
-	0x56, 0xce, 0x47, 0xe5, 0x63, 0xf0, 0x31, 0x99, 0xce, 0x59, 0x9f, 0x6a, 0x50, 0x9c, 0x65, 0xfc,
-	0x29, 0x24, 0x34, 0xd7, 0x6d, 0x9a, 0x81, 0x68, 0x9b, 0x4e, 0xf8, 0x9f, 0xa5, 0xc6, 0xca, 0x99,
-	0xe8, 0x72, 0xce, 0xfa, 0x7b, 0xd5, 0xfe, 0x8e, 0x6e, 0xdf, 0xb7, 0xbb, 0x01, 0x23, 0xcd, 0x9c,
-	0x0b, 0x6a, 0x64, 0xc1, 0x63, 0x7a, 0x04, 0xbc, 0xdd, 0x31, 0xe1, 0x19, 0x44, 0xbf, 0xfb, 0xe1,
-	0xb3, 0x17, 0x8b, 0x43, 0xbf, 0xbd, 0x58, 0x6c, 0xb5, 0xb9, 0xe9, 0x74, 0x0f, 0xd6, 0x62, 0x99,
-	0xb7, 0x58, 0x96, 0x49, 0xc5, 0x6e, 0x0a, 0x30, 0x47, 0x52, 0x1d, 0x96, 0x8f, 0x71, 0x87, 0x71,
-	0xd1, 0xca, 0x99, 0xe9, 0xac, 0xad, 0x43, 0x1c, 0x8d, 0xe7, 0x5c, 0xec, 0x23, 0xde, 0x23, 0x0b,
-	0x17, 0xa4, 0xe4, 0xb2, 0x82, 0x27, 0x5d, 0xae, 0x30, 0x2e, 0x2e, 0x78, 0xde, 0xcd, 0xa9, 0x36,
-	0xec, 0x10, 0xc2, 0xb3, 0x96, 0xe8, 0x96, 0x27, 0xba, 0xe4, 0xe4, 0xd0, 0xc9, 0xe1, 0x1a, 0x97,
-	0x0e, 0x6e, 0x4b, 0x98, 0x5f, 0x7e, 0xbe, 0x49, 0xbc, 0x4e, 0x5b, 0xc2, 0xfc, 0xf8, 0xc7, 0x4f,
-	0xab, 0x8d, 0x68, 0xaa, 0xc4, 0xdb, 0x71, 0x70, 0x7b, 0x88, 0x86, 0x2a, 0x28, 0xc8, 0x65, 0x0f,
-	0x1c, 0x3a, 0x4d, 0x20, 0x63, 0xc7, 0xf4, 0x88, 0x8b, 0x44, 0x1e, 0x85, 0x23, 0x4e, 0x05, 0x67,
-	0x60, 0xed, 0xd7, 0x71, 0xfb, 0x91, 0xdd, 0x0d, 0x56, 0x9c, 0x0a, 0x50, 0xc8, 0xb8, 0x53, 0xea,
-	0x76, 0xce, 0x7a, 0xe0, 0xcb, 0x6c, 0xe0, 0xb2, 0xd7, 0xeb, 0x31, 0x19, 0x3b, 0x00, 0xc3, 0x28,
-	0x08, 0xa3, 0x64, 0x71, 0x1c, 0x8e, 0xd6, 0xd3, 0xea, 0x02, 0x82, 0x6d, 0x38, 0xac, 0xe0, 0x6b,
-	0x72, 0x31, 0x03, 0xa6, 0x04, 0x17, 0x6d, 0xaa, 0x98, 0x81, 0xf0, 0x7c, 0x3d, 0xf0, 0xb1, 0x12,
-	0x2d, 0x62, 0x06, 0x82, 0x9c, 0x60, 0x0e, 0xd0, 0xb6, 0x62, 0x09, 0x07, 0x61, 0xa8, 0xe9, 0x28,
-	0xd0, 0x1d, 0x99, 0x25, 0x21, 0xa9, 0x47, 0x33, 0x95, 0xb3, 0xfe, 0x3d, 0x8f, 0xba, 0x5f, 0x82,
-	0x06, 0x40, 0x02, 0x94, 0xd4, 0x1d, 0x45, 0xaa, 0x58, 0x6c, 0x30, 0x71, 0x2f, 0xd4, 0xa3, 0xc2,
-	0x53, 0xb2, 0x87, 0xb7, 0xe9, 0x01, 0x83, 0x0d, 0xb2, 0x88, 0x6f, 0xd5, 0x15, 0x69, 0x37, 0x4b,
-	0x79, 0x96, 0x41, 0x42, 0xd1, 0x1f, 0x14, 0xc5, 0x1c, 0x01, 0x6d, 0x74, 0x78, 0x71, 0xa9, 0xb1,
-	0x32, 0x1c, 0xcd, 0xe5, 0xac, 0xff, 0xe5, 0x89, 0xd5, 0x23, 0x6b, 0x14, 0x79, 0x9b, 0xe0, 0x1e,
-	0x59, 0x7a, 0x1d, 0x46, 0x41, 0xd1, 0x35, 0x83, 0x38, 0xe3, 0x16, 0x67, 0xfe, 0x34, 0x4e, 0xe4,
-	0xac, 0x2a, 0xa0, 0xa7, 0x64, 0xde, 0xdd, 0x25, 0x05, 0x47, 0x4c, 0x25, 0xfe, 0xfd, 0x79, 0x5e,
-	0x48, 0x65, 0x98, 0x88, 0x21, 0x9c, 0xa8, 0xa7, 0xc0, 0x8c, 0x45, 0x8f, 0x2c, 0xb8, 0x55, 0x62,
-	0xab, 0x82, 0x0e, 0xbe, 0x6b, 0x90, 0xe5, 0x53, 0xe4, 0x29, 0x00, 0x55, 0xd0, 0x03, 0xd1, 0x3d,
-	0x15, 0x42, 0xb3, 0x5e, 0x08, 0x8b, 0x03, 0x21, 0x6c, 0x02, 0x44, 0x8e, 0x60, 0x20, 0x0e, 0x20,
-	0xc1, 0xa9, 0x30, 0x58, 0x56, 0x74, 0x58, 0x38, 0x59, 0xf3, 0xe8, 0x07, 0x58, 0xef, 0x20, 0x60,
-	0x10, 0x93, 0x49, 0xc3, 0xf4, 0xe1, 0x69, 0x96, 0xa0, 0x1e, 0xcb, 0x04, 0x22, 0x0e, 0x92, 0xa0,
-	0xa6, 0x3d, 0x96, 0xf1, 0x84, 0x19, 0xa9, 0x34, 0xed, 0x69, 0xea, 0x1c, 0x69, 0x01, 0x2a, 0xc6,
-	0x6b, 0xe4, 0xd8, 0xc3, 0xff, 0xd6, 0xd4, 0xf4, 0x84, 0xe3, 0xa1, 0xbe, 0x63, 0x4d, 0x76, 0x1d,
-	0x81, 0x0b, 0x26, 0xf8, 0x8c, 0xcc, 0xda, 0x12, 0xcf, 0xf2, 0x22, 0x03, 0x4d, 0x8d, 0xa4, 0x3a,
-	0x66, 0x19, 0x50, 0x1d, 0x4b, 0x05, 0x3a, 0x9c, 0xb2, 0xb9, 0x79, 0x05, 0x8b, 0xbc, 0xb3, 0xd8,
-	0x97, 0x7b, 0xb8, 0xbf, 0x67, 0xb7, 0x83, 0x4f, 0xc8, 0x0c, 0x7a, 0x1b, 0x59, 0x50, 0x2e, 0x52,
-	0x50, 0xa0, 0x2c, 0x84, 0x8f, 0xfd, 0x92, 0x75, 0xc6, 0xea, 0xb0, 0x2f, 0x8b, 0x2d, 0xbf, 0xbf,
-	0x2f, 0x3d, 0xf3, 0xe7, 0x64, 0xbe, 0xf4, 0x4d, 0xa5, 0x82, 0x98, 0x69, 0x73, 0xda, 0xfd, 0xb2,
-	0x75, 0x9f, 0x76, 0xee, 0x9b, 0x27, 0x26, 0x15, 0xc2, 0x00, 0xbb, 0xbf, 0x54, 0x83, 0xee, 0x57,
-	0x06, 0xd9, 0xfd, 0x75, 0x3a, 0xf1, 0x7d, 0x4c, 0x9a, 0xb1, 0x02, 0x66, 0xc0, 0xb7, 0xa8, 0x14,
-	0x20, 0x0c, 0xdf, 0xb2, 0x6d, 0x8c, 0x3b, 0x24, 0xdb, 0x9b, 0x36, 0x01, 0x82, 0x4f, 0xc9, 0x4c,
-	0x55, 0x0d, 0x13, 0xd0, 0xf6, 0x38, 0x31, 0x50, 0x8e, 0x11, 0x84, 0xd3, 0x4e, 0xd2, 0xd2, 0x62,
-	0xdd, 0x19, 0xec, 0xb0, 0xfe, 0x16, 0x6e, 0x07, 0x5f, 0x91, 0xa6, 0x82, 0x36, 0xd7, 0x46, 0x31,
-	0x2c, 0x44, 0x36, 0xb0, 0xb9, 0xb7, 0x0c, 0x6c, 0x62, 0x10, 0x09, 0x23, 0xbb, 0x41, 0x82, 0x04,
-	0x52, 0xd6, 0xcd, 0x0c, 0x2d, 0x58, 0x1b, 0x68, 0xc6, 0x73, 0x6e, 0xc2, 0x79, 0x1b, 0x51, 0xd3,
-	0xef, 0xec, 0xb2, 0x36, 0xdc, 0xc7, 0xf5, 0xe0, 0x1a, 0x19, 0xc7, 0xb0, 0x07, 0x2c, 0x17, 0xac,
-	0xe5, 0x58, 0xce, 0xfa, 0x27, 0x56, 0x78, 0x8e, 0xaf, 0xf5, 0x38, 0xaa, 0x20, 0x96, 0x2a, 0xf1,
-	0x4e, 0x8b, 0xb6, 0xe1, 0x4d, 0x9f, 0x6e, 0x78, 0x91, 0xb5, 0x70, 0x08, 0x2b, 0xa4, 0x79, 0x90,
-	0xc9, 0xf8, 0x50, 0x63, 0xf2, 0xd3, 0x5c, 0x0a, 0xd3, 0x09, 0x97, 0x2c, 0xd3, 0xb8, 0x5b, 0xdf,
-	0x05, 0xb5, 0x83, 0xab, 0x58, 0x01, 0x8a, 0xf2, 0x5e, 0xba, 0x84, 0xc3, 0xba, 0xf3, 0xbf, 0x9a,
-	0x15, 0xa0, 0x70, 0x39, 0xb1, 0x55, 0x02, 0x62, 0x05, 0xa8, 0x68, 0xca, 0xdc, 0x0c, 0xaf, 0xd6,
-	0xac, 0x00, 0x9e, 0xa5, 0x4c, 0x64, 0x9c, 0x90, 0x2a, 0x12, 0x9f, 0xbe, 0xe1, 0x72, 0xcd, 0x09,
-	0xc9, 0x73, 0xf8, 0x6c, 0x47, 0xb9, 0xe2, 0x37, 0xe5, 0xba, 0x56, 0x53, 0xae, 0xf8, 0x2f, 0xe4,
-	0x8a, 0xdf, 0x90, 0xeb, 0xff, 0x35, 0xe5, 0x8a, 0x5f, 0x93, 0xeb, 0x01, 0x19, 0x89, 0xa9, 0x90,
-	0x2a, 0x0f, 0xdf, 0xa9, 0x87, 0x7c, 0x36, 0x7e, 0x20, 0x55, 0x1e, 0x7c, 0x4b, 0x26, 0xa0, 0xd0,
-	0x3c, 0x93, 0xa2, 0x52, 0x7f, 0xa5, 0xa6, 0xfa, 0x1e, 0xaf, 0x54, 0xff, 0x21, 0xb9, 0xde, 0x61,
-	0x59, 0x6a, 0xaf, 0x7e, 0xa1, 0x64, 0x0c, 0x5a, 0xfb, 0xb6, 0x6d, 0xa7, 0x45, 0x96, 0x69, 0x0a,
-	0x22, 0xa1, 0x36, 0xc5, 0xc3, 0x55, 0x9b, 0xef, 0xcb, 0xe8, 0xb0, 0xc3, 0xfa, 0xbb, 0xce, 0xdc,
-	0x36, 0xe2, 0xc8, 0x1b, 0x6f, 0x88, 0xe4, 0x2e, 0x9a, 0x62, 0xe2, 0x94, 0x91, 0x6b, 0x96, 0x02,
-	0x4d, 0x78, 0x2f, 0x7c, 0xef, 0xdf, 0x09, 0x7d, 0x8f, 0xa5, 0xb0, 0xce, 0x7b, 0x58, 0x1d, 0x13,
-	0x66, 0x18, 0xd5, 0x20, 0x12, 0x9c, 0x1a, 0xb1, 0x08, 0xdd, 0x78, 0xdb, 0xea, 0x88, 0x48, 0x7b,
-	0x0e, 0x08, 0x6b, 0x90, 0xff, 0xa8, 0x80, 0x0c, 0x72, 0x10, 0xc6, 0xdd, 0xf9, 0x2a, 0x6b, 0x6e,
-	0x56, 0x45, 0x7b, 0xc3, 0xef, 0xef, 0x82, 0xaa, 0x72, 0xc0, 0x37, 0x2b, 0x1c, 0xd1, 0x7a, 0xbe,
-	0x70, 0x3b, 0x7f, 0xa7, 0xe1, 0x5a, 0xd5, 0xac, 0xee, 0x58, 0x0b, 0x5b, 0x90, 0x11, 0xc0, 0xe9,
-	0xb6, 0x4a, 0x26, 0x6d, 0xab, 0x33, 0x0a, 0x5f, 0xc9, 0x4f, 0xe3, 0x2d, 0xeb, 0x33, 0x81, 0x0d,
-	0xce, 0xae, 0xfb, 0x71, 0x5c, 0x92, 0x2b, 0x5c, 0x70, 0xc3, 0x59, 0x46, 0x15, 0xb4, 0x15, 0x18,
-	0xfa, 0xa4, 0xcb, 0x84, 0xe1, 0x19, 0x84, 0xb7, 0xea, 0x49, 0x7d, 0xc9, 0xe3, 0x46, 0x16, 0xf6,
-	0x0b, 0x8f, 0x1a, 0x7c, 0x43, 0x26, 0x0a, 0x9b, 0xde, 0x27, 0x67, 0xfa, 0x7e, 0xcd, 0x29, 0xbd,
-	0xc0, 0x3c, 0xf7, 0x27, 0xba, 0x3d, 0x3c, 0x3a, 0xdc, 0x3c, 0xbb, 0x3d, 0x3c, 0x3a, 0xd3, 0x9c,
-	0xdd, 0x1e, 0x1e, 0x9d, 0x6d, 0xce, 0x6d, 0x0f, 0x8f, 0xbe, 0xdb, 0x5c, 0xd9, 0x1e, 0x1e, 0xbd,
-	0xde, 0x5c, 0xb5, 0xa3, 0xf6, 0x1b, 0x7a, 0x46, 0xb3, 0xb6, 0xae, 0xa7, 0x29, 0x0c, 0xe8, 0x5d,
-	0xce, 0x7d, 0xd1, 0x32, 0xba, 0x28, 0x30, 0x8a, 0xbb, 0xb1, 0xc1, 0x4d, 0xae, 0x54, 0x48, 0x11,
-	0x83, 0xf6, 0xb3, 0x72, 0x34, 0x57, 0x35, 0xd7, 0x6a, 0x5e, 0x4c, 0x20, 0x66, 0xc7, 0xf6, 0x33,
-	0x24, 0xba, 0xf6, 0xb7, 0x10, 0xfe, 0x56, 0xde, 0x8d, 0x9e, 0xbd, 0x5c, 0x68, 0x3c, 0x7f, 0xb9,
-	0xd0, 0xf8, 0xfd, 0xe5, 0x42, 0xe3, 0xfb, 0x57, 0x0b, 0x43, 0xcf, 0x5f, 0x2d, 0x0c, 0xfd, 0xfa,
-	0x6a, 0x61, 0xe8, 0xf1, 0x47, 0xff, 0x50, 0x92, 0x7e, 0xeb, 0xe4, 0xcb, 0xda, 0x1c, 0x17, 0xa0,
-	0x0f, 0x46, 0xec, 0x47, 0xf0, 0x07, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0xb8, 0x43, 0x8d, 0x72,
-	0x73, 0x0f, 0x00, 0x00,
+	// 1803 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x73, 0x1c, 0xb7,
+	0xd1, 0xd6, 0xbe, 0xa6, 0x64, 0x1a, 0x96, 0xc9, 0xd5, 0x88, 0x94, 0xc0, 0x6f, 0xbe, 0xa2, 0x92,
+	0xd0, 0x8a, 0xc5, 0xb5, 0x2d, 0x2b, 0xfe, 0x4c, 0x62, 0xca, 0xa4, 0x54, 0x64, 0x89, 0x0a, 0x33,
+	0x64, 0xac, 0x2a, 0x25, 0x15, 0x04, 0x9c, 0xe9, 0x9d, 0x45, 0x38, 0x03, 0x8c, 0x01, 0xec, 0x72,
+	0xe9, 0x43, 0x6e, 0xb9, 0xe7, 0x0f, 0xe4, 0x9e, 0x63, 0x0e, 0xf9, 0x11, 0x3e, 0xba, 0x72, 0x4a,
+	0xe5, 0xe0, 0x4a, 0x49, 0x87, 0xfc, 0x8d, 0x54, 0x03, 0x98, 0xd9, 0x5d, 0x2a, 0x95, 0x4a, 0x69,
+	0x72, 0x61, 0x71, 0xa7, 0xbb, 0x9f, 0xa7, 0xd1, 0x68, 0x74, 0x37, 0x40, 0x16, 0xa0, 0x10, 0xc6,
+	0x08, 0x25, 0x4d, 0x67, 0x70, 0xbf, 0x53, 0x72, 0xcd, 0x0b, 0xb3, 0x55, 0x6a, 0x65, 0x55, 0x74,
+	0xb5, 0x16, 0x6d, 0x0d, 0xee, 0x2f, 0x5e, 0xe3, 0x85, 0x90, 0xaa, 0xe3, 0xfe, 0x7a, 0x85, 0xc5,
+	0x85, 0x44, 0x99, 0x42, 0x19, 0xe6, 0x7e, 0x75, 0xfc, 0x8f, 0x20, 0x9a, 0xcb, 0x54, 0xa6, 0xfc,
+	0x77, 0xfc, 0xcf, 0x7f, 0xbd, 0xf5, 0xc7, 0x4d, 0x72, 0xe5, 0xd0, 0x51, 0x44, 0x94, 0xbc, 0x3e,
+	0x00, 0x8d, 0xe8, 0xb4, 0xb5, 0xde, 0xda, 0x7c, 0x23, 0xae, 0x7e, 0x46, 0x1f, 0x93, 0x85, 0x82,
+	0x0f, 0x99, 0x01, 0x2d, 0x78, 0x2e, 0xbe, 0x86, 0x94, 0x15, 0x26, 0x63, 0x39, 0xc8, 0xcc, 0xf6,
+	0xe8, 0xff, 0xad, 0xb7, 0x36, 0x5f, 0x8b, 0x6f, 0x14, 0x7c, 0x78, 0x54, 0xcb, 0x0f, 0x4c, 0xf6,
+	0xd8, 0x49, 0x23, 0x4e, 0xda, 0x85, 0x90, 0xcc, 0xaa, 0x52, 0x24, 0xec, 0x0c, 0x44, 0xd6, 0xb3,
+	0xf4, 0x35, 0x44, 0x7f, 0xf0, 0xe1, 0x37, 0xdf, 0xad, 0x5d, 0xfa, 0xfb, 0x77, 0x6b, 0x9d, 0x4c,
+	0xd8, 0x5e, 0xff, 0x64, 0x2b, 0x51, 0x45, 0x87, 0xe7, 0xb9, 0xd2, 0xfc, 0xae, 0x04, 0x7b, 0xa6,
+	0xf4, 0x69, 0xf5, 0x33, 0xe9, 0x71, 0x21, 0x3b, 0x05, 0xb7, 0xbd, 0xad, 0x1d, 0x48, 0xe2, 0x99,
+	0x42, 0xc8, 0x63, 0xc4, 0x7b, 0xea, 0xe0, 0xa2, 0x2e, 0xb9, 0xa1, 0xe1, 0xab, 0xbe, 0xd0, 0xe8,
+	0x97, 0x90, 0xa2, 0xe8, 0x17, 0xcc, 0x58, 0x7e, 0x0a, 0xf4, 0xb2, 0x23, 0x7a, 0x37, 0x10, 0xcd,
+	0xfb, 0x70, 0x98, 0xf4, 0x74, 0x4b, 0x28, 0x0f, 0xb7, 0x27, 0xed, 0x5f, 0xff, 0x72, 0x97, 0x84,
+	0x38, 0xed, 0x49, 0xfb, 0xa7, 0x7f, 0xfe, 0xf9, 0x4e, 0x2b, 0x9e, 0xab, 0xf0, 0x0e, 0x3c, 0xdc,
+	0x11, 0xa2, 0x61, 0x14, 0x34, 0x14, 0x6a, 0x00, 0x1e, 0x9d, 0xa5, 0x90, 0xf3, 0x73, 0x76, 0x26,
+	0x64, 0xaa, 0xce, 0xe8, 0x15, 0x1f, 0x05, 0xaf, 0xe0, 0xf4, 0x77, 0x50, 0xfc, 0xd4, 0x49, 0xa3,
+	0x4d, 0x1f, 0x05, 0x28, 0x55, 0xd2, 0xab, 0xe2, 0xf6, 0xba, 0xb3, 0xc0, 0xc5, 0xec, 0xe2, 0xe7,
+	0x10, 0xaf, 0x67, 0xe4, 0xea, 0x09, 0x58, 0xce, 0x40, 0x5a, 0xad, 0xca, 0x73, 0x3a, 0xdd, 0x2c,
+	0x56, 0x6f, 0x22, 0xd8, 0xae, 0xc7, 0x8a, 0x7e, 0x45, 0xde, 0xca, 0x81, 0x6b, 0x29, 0x64, 0xc6,
+	0x34, 0xb7, 0x40, 0xdf, 0x68, 0x06, 0x7e, 0xb5, 0x42, 0x8b, 0xb9, 0x85, 0xa8, 0x20, 0x98, 0x03,
+	0x2c, 0xd3, 0x3c, 0x15, 0x20, 0x2d, 0xb3, 0x3d, 0x0d, 0xa6, 0xa7, 0xf2, 0x94, 0x92, 0x66, 0x34,
+	0x73, 0x05, 0x1f, 0x3e, 0x0a, 0xa8, 0xc7, 0x15, 0x68, 0x04, 0x24, 0xc2, 0x90, 0xfa, 0xad, 0xe8,
+	0x6a, 0x9e, 0x58, 0x4c, 0xdc, 0x37, 0x9b, 0x51, 0xe1, 0x2e, 0xb9, 0xcd, 0x7b, 0x18, 0x00, 0xa3,
+	0x5d, 0xb2, 0x86, 0xab, 0xea, 0xcb, 0x6e, 0x3f, 0xef, 0x8a, 0x3c, 0x87, 0x94, 0xa1, 0x3d, 0x68,
+	0x86, 0x39, 0x02, 0xc6, 0x1a, 0xfa, 0xd6, 0x7a, 0x6b, 0x73, 0x2a, 0x5e, 0x2e, 0xf8, 0xf0, 0x17,
+	0x23, 0xad, 0xa7, 0x4e, 0x29, 0x0e, 0x3a, 0xd1, 0x23, 0xb2, 0x7e, 0x11, 0x46, 0x43, 0xd9, 0xb7,
+	0xe3, 0x38, 0x33, 0x0e, 0x67, 0x65, 0x12, 0x27, 0xf6, 0x5a, 0x35, 0xd0, 0xd7, 0x64, 0xc5, 0x9f,
+	0x25, 0x0d, 0x67, 0x5c, 0xa7, 0x61, 0xfd, 0xa2, 0x28, 0x95, 0xb6, 0x5c, 0x26, 0x40, 0x67, 0x9b,
+	0x45, 0x60, 0xd1, 0xa1, 0xc7, 0x0e, 0xdc, 0x45, 0x62, 0xaf, 0x86, 0x8e, 0x7e, 0xdf, 0x22, 0x1b,
+	0x13, 0xe4, 0x5d, 0x00, 0xa6, 0x61, 0x00, 0xb2, 0x3f, 0xe1, 0x42, 0xbb, 0x99, 0x0b, 0x6b, 0x63,
+	0x2e, 0x3c, 0x04, 0x88, 0x3d, 0xc1, 0x98, 0x1f, 0x40, 0xa2, 0x09, 0x37, 0x78, 0x5e, 0xf6, 0x38,
+	0xbd, 0xd6, 0x70, 0xeb, 0xc7, 0x58, 0xb7, 0x11, 0x30, 0x4a, 0xc8, 0x35, 0xcb, 0xcd, 0xe9, 0x24,
+	0x4b, 0xd4, 0x8c, 0x65, 0x16, 0x11, 0xc7, 0x49, 0x30, 0xa6, 0x03, 0x9e, 0x8b, 0x94, 0x5b, 0xa5,
+	0x0d, 0x1b, 0x18, 0xe6, 0x0d, 0x59, 0x09, 0x3a, 0xc1, 0x63, 0xe4, 0xd9, 0xe9, 0xf5, 0x86, 0x31,
+	0x1d, 0x71, 0x7c, 0x69, 0xb6, 0x9d, 0xca, 0xa1, 0x27, 0xf0, 0xce, 0x44, 0x9f, 0x91, 0x25, 0x57,
+	0xe2, 0x79, 0x51, 0xe6, 0x60, 0x98, 0x55, 0xcc, 0x24, 0x3c, 0x07, 0x66, 0x12, 0xa5, 0xc1, 0xd0,
+	0x39, 0x97, 0x9b, 0x37, 0xb1, 0xc8, 0x7b, 0x8d, 0x63, 0x75, 0x84, 0xf2, 0x23, 0x27, 0x8e, 0x3e,
+	0x21, 0x8b, 0x68, 0x6d, 0x55, 0xc9, 0x84, 0xec, 0x82, 0x06, 0xed, 0x20, 0x82, 0xef, 0xf3, 0xce,
+	0x18, 0xab, 0xc3, 0xb1, 0x2a, 0xf7, 0x82, 0xfc, 0x58, 0x05, 0xe6, 0xcf, 0xc9, 0x4a, 0x65, 0xdb,
+	0x55, 0x1a, 0x12, 0x6e, 0xec, 0xa4, 0xf9, 0x0d, 0x67, 0xbe, 0xe0, 0xcd, 0x1f, 0x8e, 0x54, 0x6a,
+	0x84, 0x31, 0xf6, 0x70, 0xa8, 0xc6, 0xcd, 0x6f, 0x8e, 0xb3, 0x87, 0xe3, 0x34, 0xb2, 0x7d, 0x46,
+	0xda, 0x89, 0x06, 0x6e, 0x21, 0xb4, 0xa8, 0x2e, 0x00, 0xa5, 0xaf, 0xd8, 0x36, 0x66, 0x3c, 0x92,
+	0xeb, 0x4d, 0x0f, 0x01, 0xa2, 0x4f, 0xc9, 0x62, 0x5d, 0x0d, 0x53, 0x30, 0x6e, 0x3b, 0xd1, 0x51,
+	0x81, 0x1e, 0xd0, 0x05, 0x1f, 0xd2, 0x4a, 0x63, 0xc7, 0x2b, 0x1c, 0xf0, 0xe1, 0x1e, 0x8a, 0xa3,
+	0x5f, 0x92, 0xb6, 0x86, 0x4c, 0x18, 0xab, 0x39, 0x16, 0x22, 0xe7, 0xd8, 0xf2, 0x2b, 0x3a, 0x36,
+	0x3b, 0x8e, 0x84, 0x9e, 0xbd, 0x43, 0xa2, 0x14, 0xba, 0xbc, 0x9f, 0x5b, 0x56, 0xf2, 0x0c, 0x58,
+	0x2e, 0x0a, 0x61, 0xe9, 0x8a, 0xf3, 0xa8, 0x1d, 0x24, 0x87, 0x3c, 0x83, 0xc7, 0xf8, 0x3d, 0xba,
+	0x4d, 0x66, 0xd0, 0xed, 0x31, 0xcd, 0x55, 0xa7, 0x79, 0xb5, 0xe0, 0xc3, 0x91, 0x16, 0xee, 0xe3,
+	0x85, 0x1e, 0xc7, 0x34, 0x24, 0x4a, 0xa7, 0xc1, 0x68, 0xcd, 0x35, 0xbc, 0x85, 0xc9, 0x86, 0x17,
+	0x3b, 0x0d, 0x8f, 0xb0, 0x49, 0xda, 0x27, 0xb9, 0x4a, 0x4e, 0x0d, 0x26, 0x3f, 0x2b, 0x94, 0xb4,
+	0x3d, 0xba, 0xee, 0x98, 0x66, 0xfc, 0xf7, 0x43, 0xd0, 0x07, 0xf8, 0x15, 0x2b, 0x40, 0x59, 0x9d,
+	0x4b, 0x9f, 0x70, 0x58, 0x77, 0xfe, 0xbf, 0x61, 0x05, 0x28, 0x7d, 0x4e, 0xec, 0x55, 0x80, 0x58,
+	0x01, 0x6a, 0x9a, 0x2a, 0x37, 0xe9, 0xad, 0x86, 0x15, 0x20, 0xb0, 0x54, 0x89, 0x8c, 0x13, 0x52,
+	0x4d, 0x12, 0xd2, 0x97, 0x6e, 0x34, 0x9c, 0x90, 0x02, 0x47, 0xc8, 0x76, 0x0c, 0x57, 0xf2, 0x72,
+	0xb8, 0x6e, 0x37, 0x0c, 0x57, 0xf2, 0x6f, 0xc2, 0x95, 0xbc, 0x14, 0xae, 0xef, 0x35, 0x0c, 0x57,
+	0x72, 0x21, 0x5c, 0x4f, 0xc8, 0x95, 0x84, 0x49, 0xa5, 0x0b, 0xfa, 0xfd, 0x66, 0xc8, 0x97, 0x93,
+	0x27, 0x4a, 0x17, 0xd1, 0x6f, 0xc8, 0x2c, 0x94, 0x46, 0xe4, 0x4a, 0xd6, 0xd1, 0xdf, 0x6c, 0x18,
+	0xfd, 0x80, 0x57, 0x45, 0xff, 0x4b, 0xf2, 0x76, 0x8f, 0xe7, 0x5d, 0x77, 0xf4, 0x4b, 0xad, 0x12,
+	0x30, 0x26, 0xb4, 0x6d, 0x37, 0x2d, 0xf2, 0xdc, 0x30, 0x90, 0x29, 0x73, 0x29, 0x4e, 0xef, 0xb8,
+	0x7c, 0xdf, 0x40, 0x83, 0x03, 0x3e, 0x3c, 0xf4, 0xea, 0xae, 0x11, 0xc7, 0x41, 0x79, 0x57, 0xa6,
+	0x0f, 0x50, 0x15, 0x13, 0xa7, 0xf2, 0xdc, 0xf0, 0x2e, 0xb0, 0x54, 0x0c, 0xe8, 0x0f, 0xff, 0x37,
+	0xae, 0x1f, 0xf1, 0x2e, 0xec, 0x88, 0x01, 0x56, 0xc7, 0x94, 0x5b, 0xce, 0x0c, 0xc8, 0x14, 0xa7,
+	0x46, 0x2c, 0x42, 0xef, 0xbc, 0x6a, 0x75, 0x44, 0xa4, 0x23, 0x0f, 0x84, 0x35, 0x28, 0x5c, 0x2a,
+	0x20, 0x87, 0x02, 0xa4, 0xf5, 0x67, 0xbe, 0xce, 0x9a, 0xbb, 0x75, 0xd1, 0xde, 0x0d, 0xf2, 0x43,
+	0xd0, 0x75, 0x0e, 0x84, 0x66, 0x85, 0x23, 0xda, 0x20, 0x14, 0x6e, 0x6f, 0xef, 0x63, 0xb8, 0x55,
+	0x37, 0xab, 0x6d, 0xa7, 0xe1, 0x0a, 0x32, 0x02, 0xf8, 0xb8, 0xdd, 0x21, 0xd7, 0xd0, 0xda, 0x58,
+	0x8d, 0x4b, 0x0a, 0xd3, 0x78, 0xc7, 0xd9, 0xcc, 0x62, 0x83, 0x73, 0xdf, 0xc3, 0x38, 0xae, 0xc8,
+	0x4d, 0x21, 0x85, 0x15, 0x3c, 0x67, 0x1a, 0x32, 0x0d, 0x96, 0x7d, 0xd5, 0xe7, 0xd2, 0x8a, 0x1c,
+	0xe8, 0xbb, 0xcd, 0x42, 0x3d, 0x1f, 0x70, 0x63, 0x07, 0xfb, 0xf3, 0x80, 0x1a, 0xfd, 0x9a, 0xcc,
+	0x96, 0x2e, 0xbd, 0x47, 0x7b, 0xfa, 0x5e, 0xc3, 0x29, 0xbd, 0xc4, 0x3c, 0xaf, 0x76, 0xb4, 0xc0,
+	0xcb, 0x92, 0x1f, 0x3c, 0x4d, 0xce, 0x4d, 0x6f, 0x34, 0x3a, 0xbf, 0xdf, 0x70, 0x4a, 0x0f, 0xb0,
+	0x47, 0x88, 0x5a, 0x8f, 0xcf, 0x03, 0xb2, 0xe4, 0x68, 0xa0, 0x9a, 0x54, 0x4f, 0xfa, 0x5a, 0x8e,
+	0x38, 0xef, 0x35, 0xe3, 0xa4, 0x01, 0xdb, 0x9d, 0x8f, 0x07, 0x7d, 0x2d, 0x6b, 0xde, 0x90, 0x5c,
+	0x78, 0x5b, 0x1e, 0x1b, 0xb5, 0xa5, 0x92, 0x09, 0x18, 0xfa, 0x41, 0x9d, 0x5c, 0x07, 0x4e, 0x1e,
+	0x0e, 0xea, 0x13, 0x27, 0x45, 0x97, 0x2b, 0x7d, 0xd5, 0xb7, 0xb9, 0xc0, 0x48, 0x25, 0x4a, 0x03,
+	0x73, 0xed, 0x93, 0xde, 0x6f, 0xe8, 0x72, 0xc0, 0xfe, 0x99, 0x87, 0x76, 0xf3, 0x53, 0x8c, 0xc0,
+	0x38, 0x2d, 0xa0, 0xcb, 0x17, 0xb9, 0x5d, 0x3f, 0x35, 0xf4, 0x47, 0x75, 0x4e, 0xc7, 0x13, 0x00,
+	0xae, 0x95, 0x9a, 0xe8, 0x7d, 0x32, 0x5f, 0x19, 0xfe, 0x96, 0x8b, 0x9c, 0xa5, 0x7d, 0xdf, 0xec,
+	0xe9, 0x87, 0xae, 0xe9, 0x5e, 0x0f, 0xc2, 0x7d, 0x2e, 0xf2, 0x9d, 0x20, 0x8a, 0xec, 0x24, 0x61,
+	0xae, 0x8c, 0x61, 0x29, 0x0c, 0x84, 0x37, 0xfc, 0xa8, 0xd9, 0x3a, 0xc7, 0x3c, 0x7d, 0xac, 0x8c,
+	0xd9, 0xa9, 0x70, 0xa3, 0x03, 0xb2, 0x11, 0x2c, 0x47, 0xad, 0x88, 0x71, 0x9d, 0xf4, 0xf0, 0x28,
+	0x6b, 0xb0, 0x20, 0x1d, 0xfd, 0xc7, 0xce, 0xef, 0xf5, 0xa0, 0x5a, 0xf7, 0x98, 0x6d, 0xaf, 0x18,
+	0x57, 0x7a, 0xd1, 0x3d, 0x7f, 0xeb, 0x2c, 0xf9, 0x79, 0xae, 0x78, 0x1a, 0xaa, 0x00, 0xb7, 0x49,
+	0x8f, 0x7e, 0xe2, 0x22, 0x76, 0xdd, 0xcd, 0x28, 0x5e, 0x88, 0x15, 0x00, 0x45, 0xd1, 0x90, 0x2c,
+	0x3b, 0x1d, 0x48, 0x2b, 0x43, 0x96, 0x71, 0xc3, 0x52, 0x61, 0x12, 0xd5, 0x97, 0x96, 0x7e, 0xda,
+	0x6c, 0xed, 0x0b, 0x01, 0x3c, 0xf0, 0x3e, 0xe2, 0x66, 0x27, 0x20, 0x57, 0xd7, 0x49, 0x0d, 0x29,
+	0xe4, 0x90, 0xb9, 0x88, 0x78, 0x9f, 0xc3, 0x6f, 0xa5, 0xe9, 0x67, 0xf5, 0x75, 0x32, 0x1e, 0xd7,
+	0x3a, 0x04, 0xbd, 0x53, 0xe9, 0x44, 0x1f, 0xe0, 0x29, 0xf6, 0xb7, 0x92, 0x24, 0xd1, 0x7d, 0x9e,
+	0x33, 0x90, 0xfc, 0x24, 0x87, 0x94, 0xfe, 0x78, 0xbd, 0xb5, 0x39, 0x1d, 0xcf, 0x79, 0xe9, 0xb6,
+	0x17, 0xee, 0x7a, 0x59, 0xf4, 0x3b, 0xb2, 0x7e, 0xb1, 0x9a, 0xbb, 0xa6, 0x94, 0xf4, 0xb8, 0xcc,
+	0xc0, 0x3f, 0x09, 0xfc, 0xa4, 0xd9, 0xd2, 0x97, 0x26, 0x8b, 0xfc, 0x01, 0x1f, 0x7e, 0xe1, 0xc0,
+	0xdd, 0x0b, 0xc1, 0x39, 0x59, 0x79, 0x89, 0xdf, 0x72, 0x9d, 0x81, 0x0d, 0x67, 0xeb, 0xa7, 0x0d,
+	0xe3, 0x3e, 0x49, 0x7e, 0xec, 0xa0, 0xfd, 0xe1, 0x7a, 0x8f, 0xcc, 0x67, 0x45, 0xc9, 0xf8, 0x10,
+	0x72, 0xae, 0xdd, 0x82, 0x25, 0xe4, 0x4c, 0xa4, 0xf4, 0x73, 0xa4, 0x8c, 0xa3, 0xac, 0x28, 0xb7,
+	0x9d, 0xec, 0x0b, 0x2f, 0xda, 0x4b, 0xa3, 0x7d, 0x72, 0x6b, 0xdc, 0x04, 0xb7, 0x0f, 0x74, 0xc9,
+	0xb5, 0x3d, 0x1f, 0xb7, 0xdf, 0x76, 0xf6, 0xab, 0x23, 0xfb, 0x31, 0xbd, 0x1a, 0x6b, 0x7f, 0x6a,
+	0x7a, 0xaa, 0x7d, 0x79, 0x7f, 0x6a, 0x7a, 0xb1, 0xbd, 0xb4, 0x3f, 0x35, 0xbd, 0xd4, 0x5e, 0xde,
+	0x9f, 0x9a, 0xfe, 0x41, 0x7b, 0x73, 0x7f, 0x6a, 0xfa, 0xed, 0xf6, 0x1d, 0xf7, 0xc0, 0xf1, 0x52,
+	0x17, 0x8b, 0x97, 0xdc, 0x34, 0xdd, 0xed, 0xc2, 0x58, 0x97, 0xab, 0x6e, 0xdb, 0xf1, 0x86, 0xcf,
+	0x22, 0xab, 0x85, 0xbf, 0xac, 0xf9, 0xf7, 0x82, 0x50, 0xe0, 0xc2, 0x0b, 0x45, 0xbc, 0x5c, 0x5f,
+	0x69, 0x2a, 0x3b, 0x96, 0x42, 0xc2, 0xcf, 0xdd, 0x4e, 0xc7, 0xb7, 0xff, 0x23, 0x44, 0xa8, 0x0a,
+	0x0f, 0xe2, 0x6f, 0x9e, 0xaf, 0xb6, 0xbe, 0x7d, 0xbe, 0xda, 0xfa, 0xc7, 0xf3, 0xd5, 0xd6, 0x1f,
+	0x5e, 0xac, 0x5e, 0xfa, 0xf6, 0xc5, 0xea, 0xa5, 0xbf, 0xbd, 0x58, 0xbd, 0xf4, 0xec, 0xa3, 0xff,
+	0x72, 0x7b, 0x86, 0x9d, 0xd1, 0x7b, 0xa6, 0x3d, 0x2f, 0xc1, 0x9c, 0x5c, 0x71, 0x4f, 0x8f, 0xf7,
+	0xfe, 0x35, 0x00, 0x10, 0xc5, 0xc0, 0xd3, 0xe9, 0x14, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -374,6 +479,162 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GmpAxelarCounterpartyChannelId) > 0 {
+		i -= len(m.GmpAxelarCounterpartyChannelId)
+		copy(dAtA[i:], m.GmpAxelarCounterpartyChannelId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.GmpAxelarCounterpartyChannelId)))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.GmpAxelarChannelId) > 0 {
+		i -= len(m.GmpAxelarChannelId)
+		copy(dAtA[i:], m.GmpAxelarChannelId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.GmpAxelarChannelId)))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x82
+	}
+	{
+		size := m.DataSendingFeeTargetRatio.Size()
+		i -= size
+		if _, err := m.DataSendingFeeTargetRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xfa
+	{
+		size := m.DataSendingFeeMaxChangeRate.Size()
+		i -= size
+		if _, err := m.DataSendingFeeMaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xf2
+	if m.RewardAccrualEnabled {
+		i--
+		if m.RewardAccrualEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.MaxRedelegationsPerDelegator != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRedelegationsPerDelegator))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xe0
+	}
+	{
+		size := m.BatchedPayloadGasDiscount.Size()
+		i -= size
+		if _, err := m.BatchedPayloadGasDiscount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xda
+	if m.MaxPayloadsPerBatch != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPayloadsPerBatch))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.NetworkInferenceArchiveRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.NetworkInferenceArchiveRetention))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xc8
+	}
+	{
+		size := m.MaxReputerLossDeviation.Size()
+		i -= size
+		if _, err := m.MaxReputerLossDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xc2
+	if m.ReputerJailDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReputerJailDuration))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.MaxReputerOutlierEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxReputerOutlierEpochs))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb0
+	}
+	{
+		size := m.ReputerOutlierScoreRatio.Size()
+		i -= size
+		if _, err := m.ReputerOutlierScoreRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xaa
+	if m.MaxMissedReputerNonces != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMissedReputerNonces))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa0
+	}
+	{
+		size := m.SlashedStakeBurnFraction.Size()
+		i -= size
+		if _, err := m.SlashedStakeBurnFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0x9a
+	{
+		size := m.ReputerSlashFraction.Size()
+		i -= size
+		if _, err := m.ReputerSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0x92
 	{
 		size := m.PNormSafeDiv.Size()
 		i -= size
@@ -897,6 +1158,49 @@ func (m *Params) Size() (n int) {
 	n += 2 + l + sovParams(uint64(l))
 	l = m.PNormSafeDiv.Size()
 	n += 2 + l + sovParams(uint64(l))
+	l = m.ReputerSlashFraction.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.SlashedStakeBurnFraction.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.MaxMissedReputerNonces != 0 {
+		n += 2 + sovParams(uint64(m.MaxMissedReputerNonces))
+	}
+	l = m.ReputerOutlierScoreRatio.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.MaxReputerOutlierEpochs != 0 {
+		n += 2 + sovParams(uint64(m.MaxReputerOutlierEpochs))
+	}
+	if m.ReputerJailDuration != 0 {
+		n += 2 + sovParams(uint64(m.ReputerJailDuration))
+	}
+	l = m.MaxReputerLossDeviation.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.NetworkInferenceArchiveRetention != 0 {
+		n += 2 + sovParams(uint64(m.NetworkInferenceArchiveRetention))
+	}
+	if m.MaxPayloadsPerBatch != 0 {
+		n += 2 + sovParams(uint64(m.MaxPayloadsPerBatch))
+	}
+	l = m.BatchedPayloadGasDiscount.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.MaxRedelegationsPerDelegator != 0 {
+		n += 2 + sovParams(uint64(m.MaxRedelegationsPerDelegator))
+	}
+	if m.RewardAccrualEnabled {
+		n += 3
+	}
+	l = m.DataSendingFeeMaxChangeRate.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.DataSendingFeeTargetRatio.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = len(m.GmpAxelarChannelId)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	l = len(m.GmpAxelarCounterpartyChannelId)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -2125,6 +2429,442 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 50:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReputerSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedStakeBurnFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashedStakeBurnFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 52:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedReputerNonces", wireType)
+			}
+			m.MaxMissedReputerNonces = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMissedReputerNonces |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 53:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerOutlierScoreRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReputerOutlierScoreRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 54:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReputerOutlierEpochs", wireType)
+			}
+			m.MaxReputerOutlierEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReputerOutlierEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 55:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerJailDuration", wireType)
+			}
+			m.ReputerJailDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReputerJailDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 56:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReputerLossDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxReputerLossDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 57:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkInferenceArchiveRetention", wireType)
+			}
+			m.NetworkInferenceArchiveRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetworkInferenceArchiveRetention |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 58:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPayloadsPerBatch", wireType)
+			}
+			m.MaxPayloadsPerBatch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPayloadsPerBatch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 59:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchedPayloadGasDiscount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BatchedPayloadGasDiscount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 60:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedelegationsPerDelegator", wireType)
+			}
+			m.MaxRedelegationsPerDelegator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRedelegationsPerDelegator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 61:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAccrualEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RewardAccrualEnabled = bool(v != 0)
+		case 62:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSendingFeeMaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DataSendingFeeMaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 63:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSendingFeeTargetRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DataSendingFeeTargetRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 64:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GmpAxelarChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GmpAxelarChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 65:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GmpAxelarCounterpartyChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GmpAxelarCounterpartyChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
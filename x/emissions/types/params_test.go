@@ -53,6 +53,22 @@ func TestDefaultParams(t *testing.T) {
 		MaxStringLength:                     uint64(255),
 		InitialRegretQuantile:               alloraMath.MustNewDecFromString("0.25"),
 		PNormSafeDiv:                        alloraMath.MustNewDecFromString("8.25"),
+		ReputerSlashFraction:                alloraMath.MustNewDecFromString("0.01"),
+		SlashedStakeBurnFraction:            alloraMath.MustNewDecFromString("0.5"),
+		MaxMissedReputerNonces:              uint64(20),
+		ReputerOutlierScoreRatio:            alloraMath.MustNewDecFromString("0.1"),
+		MaxReputerOutlierEpochs:             uint64(10),
+		ReputerJailDuration:                 int64((60 * 60 * 24) / 3),
+		MaxReputerLossDeviation:             alloraMath.MustNewDecFromString("0.001"),
+		NetworkInferenceArchiveRetention:    int64((60 * 60 * 24 * 30) / 3),
+		MaxPayloadsPerBatch:                 uint64(64),
+		BatchedPayloadGasDiscount:           alloraMath.MustNewDecFromString("0.25"),
+		MaxRedelegationsPerDelegator:        uint64(7),
+		RewardAccrualEnabled:                false,
+		DataSendingFeeMaxChangeRate:         alloraMath.MustNewDecFromString("0.125"),
+		DataSendingFeeTargetRatio:           alloraMath.MustNewDecFromString("1"),
+		GmpAxelarChannelId:                  "",
+		GmpAxelarCounterpartyChannelId:      "",
 	}
 
 	params := DefaultParams()
@@ -25,9 +25,41 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type ReputerSlashReason int32
+
+const (
+	ReputerSlashReason_REPUTER_SLASH_REASON_UNSPECIFIED ReputerSlashReason = 0
+	// The reputer did not report losses for too many consecutive reputer nonces
+	ReputerSlashReason_REPUTER_SLASH_REASON_MISSED_NONCES ReputerSlashReason = 1
+	// The reputer scored far from consensus for too many consecutive epochs
+	ReputerSlashReason_REPUTER_SLASH_REASON_OUTLIER ReputerSlashReason = 2
+)
+
+var ReputerSlashReason_name = map[int32]string{
+	0: "REPUTER_SLASH_REASON_UNSPECIFIED",
+	1: "REPUTER_SLASH_REASON_MISSED_NONCES",
+	2: "REPUTER_SLASH_REASON_OUTLIER",
+}
+
+var ReputerSlashReason_value = map[string]int32{
+	"REPUTER_SLASH_REASON_UNSPECIFIED":   0,
+	"REPUTER_SLASH_REASON_MISSED_NONCES": 1,
+	"REPUTER_SLASH_REASON_OUTLIER":       2,
+}
+
+func (x ReputerSlashReason) String() string {
+	return proto.EnumName(ReputerSlashReason_name, int32(x))
+}
+
+func (ReputerSlashReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_314531a75ebe144f, []int{0}
+}
+
 type WorkerAttributedValue struct {
 	Worker string                                          `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"`
 	Value  github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"value"`
+	// Per-dimension values, set instead of value for vector-valued topics
+	Values []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,rep,name=values,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"values"`
 }
 
 func (m *WorkerAttributedValue) Reset()         { *m = WorkerAttributedValue{} }
@@ -73,6 +105,8 @@ func (m *WorkerAttributedValue) GetWorker() string {
 type WithheldWorkerAttributedValue struct {
 	Worker string                                          `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"`
 	Value  github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"value"`
+	// Per-dimension values, set instead of value for vector-valued topics
+	Values []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,rep,name=values,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"values"`
 }
 
 func (m *WithheldWorkerAttributedValue) Reset()         { *m = WithheldWorkerAttributedValue{} }
@@ -195,6 +229,10 @@ type ValueBundle struct {
 	// R^-_ilm || log10 L^-_ilm || I^-_il where l = any j
 	// log10 L^-_j'ikm || I^-_j'ik
 	OneOutInfererForecasterValues []*OneOutInfererForecasterValues `protobuf:"bytes,12,rep,name=one_out_inferer_forecaster_values,json=oneOutInfererForecasterValues,proto3" json:"one_out_inferer_forecaster_values,omitempty"`
+	// Per-dimension combined and naive values, set instead of combined_value and
+	// naive_value for vector-valued topics
+	CombinedValues []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,13,rep,name=combined_values,json=combinedValues,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"combined_values"`
+	NaiveValues    []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,14,rep,name=naive_values,json=naiveValues,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"naive_values"`
 }
 
 func (m *ValueBundle) Reset()         { *m = ValueBundle{} }
@@ -406,61 +444,231 @@ func (m *ReputerValueBundles) GetReputerValueBundles() []*ReputerValueBundle {
 	return nil
 }
 
+// The final network inferences of a topic nonce, kept after the inferences
+// they were synthesized from are pruned
+type ArchivedNetworkInference struct {
+	TopicId       uint64                                          `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight   int64                                           `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	CombinedValue github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,opt,name=combined_value,json=combinedValue,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"combined_value"`
+	NaiveValue    github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,4,opt,name=naive_value,json=naiveValue,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"naive_value"`
+	// Per-dimension combined and naive values of vector-valued topics
+	CombinedValues                   []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,5,rep,name=combined_values,json=combinedValues,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"combined_values"`
+	NaiveValues                      []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,6,rep,name=naive_values,json=naiveValues,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"naive_values"`
+	ConfidenceIntervalRawPercentiles []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,7,rep,name=confidence_interval_raw_percentiles,json=confidenceIntervalRawPercentiles,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"confidence_interval_raw_percentiles"`
+	ConfidenceIntervalValues         []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,8,rep,name=confidence_interval_values,json=confidenceIntervalValues,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"confidence_interval_values"`
+}
+
+func (m *ArchivedNetworkInference) Reset()         { *m = ArchivedNetworkInference{} }
+func (m *ArchivedNetworkInference) String() string { return proto.CompactTextString(m) }
+func (*ArchivedNetworkInference) ProtoMessage()    {}
+func (*ArchivedNetworkInference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_314531a75ebe144f, []int{6}
+}
+func (m *ArchivedNetworkInference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedNetworkInference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedNetworkInference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivedNetworkInference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedNetworkInference.Merge(m, src)
+}
+func (m *ArchivedNetworkInference) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedNetworkInference) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedNetworkInference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedNetworkInference proto.InternalMessageInfo
+
+func (m *ArchivedNetworkInference) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *ArchivedNetworkInference) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// Tracks the behaviour of a reputer in a topic that may lead to it being slashed and jailed
+type ReputerSlashingInfo struct {
+	// number of reputer nonces closed in a row without a payload from the reputer
+	ConsecutiveMissedNonces uint64 `protobuf:"varint,1,opt,name=consecutive_missed_nonces,json=consecutiveMissedNonces,proto3" json:"consecutive_missed_nonces,omitempty"`
+	// number of rewarded epochs in a row where the reputer scored as an outlier
+	ConsecutiveOutlierEpochs uint64 `protobuf:"varint,2,opt,name=consecutive_outlier_epochs,json=consecutiveOutlierEpochs,proto3" json:"consecutive_outlier_epochs,omitempty"`
+	// block height of the latest reputer nonce the reputer submitted a payload for
+	LastSubmittedNonce int64 `protobuf:"varint,3,opt,name=last_submitted_nonce,json=lastSubmittedNonce,proto3" json:"last_submitted_nonce,omitempty"`
+	// jailed reputers may not submit payloads until they unjail themselves
+	Jailed bool `protobuf:"varint,4,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// first block at which a jailed reputer may unjail itself
+	JailedUntilBlock int64 `protobuf:"varint,5,opt,name=jailed_until_block,json=jailedUntilBlock,proto3" json:"jailed_until_block,omitempty"`
+}
+
+func (m *ReputerSlashingInfo) Reset()         { *m = ReputerSlashingInfo{} }
+func (m *ReputerSlashingInfo) String() string { return proto.CompactTextString(m) }
+func (*ReputerSlashingInfo) ProtoMessage()    {}
+func (*ReputerSlashingInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_314531a75ebe144f, []int{7}
+}
+func (m *ReputerSlashingInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReputerSlashingInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReputerSlashingInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReputerSlashingInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReputerSlashingInfo.Merge(m, src)
+}
+func (m *ReputerSlashingInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReputerSlashingInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReputerSlashingInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReputerSlashingInfo proto.InternalMessageInfo
+
+func (m *ReputerSlashingInfo) GetConsecutiveMissedNonces() uint64 {
+	if m != nil {
+		return m.ConsecutiveMissedNonces
+	}
+	return 0
+}
+
+func (m *ReputerSlashingInfo) GetConsecutiveOutlierEpochs() uint64 {
+	if m != nil {
+		return m.ConsecutiveOutlierEpochs
+	}
+	return 0
+}
+
+func (m *ReputerSlashingInfo) GetLastSubmittedNonce() int64 {
+	if m != nil {
+		return m.LastSubmittedNonce
+	}
+	return 0
+}
+
+func (m *ReputerSlashingInfo) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *ReputerSlashingInfo) GetJailedUntilBlock() int64 {
+	if m != nil {
+		return m.JailedUntilBlock
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("emissions.v3.ReputerSlashReason", ReputerSlashReason_name, ReputerSlashReason_value)
 	proto.RegisterType((*WorkerAttributedValue)(nil), "emissions.v3.WorkerAttributedValue")
 	proto.RegisterType((*WithheldWorkerAttributedValue)(nil), "emissions.v3.WithheldWorkerAttributedValue")
 	proto.RegisterType((*OneOutInfererForecasterValues)(nil), "emissions.v3.OneOutInfererForecasterValues")
 	proto.RegisterType((*ValueBundle)(nil), "emissions.v3.ValueBundle")
 	proto.RegisterType((*ReputerValueBundle)(nil), "emissions.v3.ReputerValueBundle")
 	proto.RegisterType((*ReputerValueBundles)(nil), "emissions.v3.ReputerValueBundles")
+	proto.RegisterType((*ArchivedNetworkInference)(nil), "emissions.v3.ArchivedNetworkInference")
+	proto.RegisterType((*ReputerSlashingInfo)(nil), "emissions.v3.ReputerSlashingInfo")
 }
 
 func init() { proto.RegisterFile("emissions/v3/reputer.proto", fileDescriptor_314531a75ebe144f) }
 
 var fileDescriptor_314531a75ebe144f = []byte{
-	// 665 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x41, 0x4f, 0x13, 0x41,
-	0x14, 0xee, 0x4a, 0xa1, 0xf4, 0x6d, 0x21, 0x3a, 0x08, 0x59, 0x88, 0x5d, 0x0a, 0x5e, 0x48, 0x8c,
-	0xdd, 0x04, 0x0e, 0x1a, 0xe3, 0x45, 0x42, 0x4c, 0x30, 0x51, 0xcc, 0x46, 0xc5, 0x18, 0xe3, 0x3a,
-	0xdd, 0x7d, 0x6d, 0x27, 0xb4, 0x33, 0x75, 0x76, 0xb6, 0xc2, 0xdd, 0xa3, 0x31, 0xfe, 0x04, 0x6f,
-	0xfe, 0x02, 0xff, 0x03, 0x47, 0x8e, 0xc6, 0x03, 0x31, 0x70, 0xf1, 0x67, 0x98, 0x9d, 0xdd, 0xd2,
-	0xa5, 0xad, 0xa4, 0x06, 0x0f, 0xde, 0xf6, 0xbd, 0x79, 0xf3, 0xbe, 0xef, 0x7d, 0xf3, 0xde, 0x5b,
-	0x58, 0xc2, 0x36, 0x0b, 0x43, 0x26, 0x78, 0xe8, 0x74, 0x37, 0x1c, 0x89, 0x9d, 0x48, 0xa1, 0xac,
-	0x76, 0xa4, 0x50, 0x82, 0x94, 0xce, 0xce, 0xaa, 0xdd, 0x8d, 0x25, 0xeb, 0x5c, 0x24, 0x17, 0xdc,
-	0xc7, 0x24, 0x6e, 0xe9, 0x7a, 0x43, 0x34, 0x84, 0xfe, 0x74, 0xe2, 0xaf, 0xc4, 0xbb, 0xfa, 0xc1,
-	0x80, 0xf9, 0x5d, 0x21, 0xf7, 0x50, 0x3e, 0x50, 0x4a, 0xb2, 0x5a, 0xa4, 0x30, 0x78, 0x41, 0x5b,
-	0x11, 0x92, 0x05, 0x98, 0x7a, 0xaf, 0x0f, 0x2c, 0xa3, 0x62, 0xac, 0x15, 0xdd, 0xd4, 0x22, 0x8f,
-	0x61, 0xb2, 0x1b, 0x07, 0x58, 0x57, 0x62, 0xf7, 0xe6, 0x9d, 0xc3, 0xe3, 0xe5, 0xdc, 0x8f, 0xe3,
-	0x65, 0xa7, 0xc1, 0x54, 0x33, 0xaa, 0x55, 0x7d, 0xd1, 0x76, 0x68, 0xab, 0x25, 0x24, 0xbd, 0xcd,
-	0x51, 0xc5, 0x77, 0x7a, 0xa6, 0xdf, 0xa4, 0x8c, 0x3b, 0x6d, 0xaa, 0x9a, 0xd5, 0x2d, 0xf4, 0xdd,
-	0x24, 0xcb, 0xbd, 0xfc, 0xaf, 0x2f, 0xcb, 0xc6, 0xea, 0x47, 0x03, 0xca, 0xbb, 0x4c, 0x35, 0x9b,
-	0xd8, 0x0a, 0xfe, 0x03, 0x3a, 0x5f, 0x0d, 0x28, 0xef, 0x70, 0xdc, 0x89, 0xd4, 0x36, 0xaf, 0xa3,
-	0x44, 0xf9, 0x50, 0x48, 0xf4, 0x69, 0xa8, 0x50, 0x6a, 0x36, 0x21, 0xb1, 0x01, 0xea, 0x67, 0x3e,
-	0x6b, 0x42, 0x53, 0xca, 0x78, 0xc8, 0x5b, 0x58, 0x10, 0x1c, 0x3d, 0x11, 0x29, 0x8f, 0x25, 0x29,
-	0x3c, 0x0d, 0x10, 0x5a, 0xf9, 0xca, 0xc4, 0x9a, 0xb9, 0x7e, 0xab, 0x9a, 0x7d, 0xb6, 0xea, 0x85,
-	0xb5, 0xbb, 0x73, 0x22, 0xcb, 0x25, 0x61, 0x90, 0x32, 0xfd, 0x56, 0x00, 0x53, 0x3b, 0x36, 0x23,
-	0x1e, 0xb4, 0x90, 0x2c, 0xc2, 0xb4, 0x12, 0x1d, 0xe6, 0x7b, 0x2c, 0xd0, 0x42, 0xe5, 0xdd, 0x82,
-	0xb6, 0xb7, 0x03, 0xf2, 0x1c, 0xe6, 0xd3, 0xce, 0xf1, 0x24, 0xbe, 0x8b, 0x30, 0x54, 0x9e, 0xee,
-	0x0f, 0xad, 0x9c, 0xb9, 0xbe, 0x72, 0x9e, 0x91, 0x9b, 0x84, 0xba, 0x49, 0xe4, 0x93, 0x38, 0xd0,
-	0x9d, 0x93, 0xc3, 0x4e, 0x62, 0x41, 0x21, 0x75, 0xa7, 0x32, 0xf4, 0x4c, 0x52, 0x06, 0xc0, 0x7d,
-	0x25, 0xa9, 0x17, 0x50, 0x45, 0xad, 0x7c, 0xc5, 0x58, 0x2b, 0xb9, 0x45, 0xed, 0xd9, 0xa2, 0x8a,
-	0x92, 0x37, 0x30, 0xeb, 0x8b, 0x76, 0x8d, 0x71, 0x0c, 0x12, 0x6d, 0xac, 0xc9, 0xcb, 0x3d, 0xe1,
-	0x4c, 0x2f, 0x5d, 0xd2, 0x31, 0x8f, 0x60, 0x76, 0x40, 0xfa, 0x29, 0x2d, 0xfd, 0xcd, 0x01, 0xe9,
-	0x47, 0x4a, 0x3e, 0xc3, 0xb2, 0x62, 0x93, 0xa7, 0x70, 0xad, 0xff, 0xb8, 0xbd, 0x74, 0x85, 0xf1,
-	0xd3, 0x5d, 0xad, 0x0f, 0x36, 0xd0, 0x4b, 0x30, 0x39, 0x65, 0x5d, 0x4c, 0x4b, 0x9f, 0xbe, 0x5c,
-	0xe9, 0xa0, 0x73, 0x25, 0x75, 0xff, 0xb9, 0xf5, 0x8a, 0xff, 0xa6, 0xf5, 0x48, 0x1d, 0x16, 0x7b,
-	0x08, 0xc3, 0xaa, 0xc0, 0xdf, 0x83, 0x2c, 0x24, 0x20, 0x43, 0x43, 0xf6, 0x1a, 0xac, 0x18, 0x87,
-	0xf1, 0x11, 0x30, 0xe6, 0xf8, 0xe2, 0xcf, 0x0b, 0x8e, 0xdb, 0x7c, 0x28, 0x7b, 0x04, 0x2b, 0x83,
-	0x3a, 0x0d, 0xc3, 0x94, 0x46, 0x55, 0x73, 0xe1, 0x6a, 0x70, 0xcb, 0xe2, 0xa2, 0xe3, 0x74, 0x6e,
-	0x3f, 0x19, 0x40, 0xd2, 0x11, 0xcb, 0x8e, 0xef, 0x7d, 0x28, 0x69, 0x60, 0xaf, 0xa6, 0x6d, 0x3d,
-	0xc2, 0xe6, 0xfa, 0xe2, 0x79, 0xf8, 0xcc, 0x05, 0xd7, 0xec, 0x66, 0x6e, 0xdf, 0x80, 0x62, 0xc8,
-	0x1a, 0x9c, 0xaa, 0x48, 0x26, 0x53, 0x5d, 0x72, 0xfb, 0x8e, 0x78, 0x83, 0x76, 0xa2, 0xda, 0x1e,
-	0x1e, 0xa4, 0x73, 0x9a, 0x5a, 0x29, 0xa1, 0x3d, 0x98, 0x1b, 0xe6, 0x13, 0x92, 0x67, 0xfd, 0xa5,
-	0x91, 0x25, 0x16, 0x5a, 0x86, 0x16, 0xa6, 0x32, 0x72, 0x69, 0x64, 0x09, 0xf6, 0x76, 0x46, 0x36,
-	0xeb, 0xa6, 0x7b, 0x78, 0x62, 0x1b, 0x47, 0x27, 0xb6, 0xf1, 0xf3, 0xc4, 0x36, 0x3e, 0x9f, 0xda,
-	0xb9, 0xa3, 0x53, 0x3b, 0xf7, 0xfd, 0xd4, 0xce, 0xbd, 0xba, 0x3b, 0x66, 0xe7, 0xef, 0x3b, 0xfd,
-	0x1f, 0x9d, 0x3a, 0xe8, 0x60, 0x58, 0x9b, 0xd2, 0x3f, 0xb4, 0x8d, 0xdf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xe0, 0x07, 0x4c, 0xeb, 0x2c, 0x07, 0x00, 0x00,
+	// 1046 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4f, 0x4f, 0x1b, 0x47,
+	0x14, 0x67, 0x63, 0x63, 0xe0, 0xd9, 0x50, 0x3a, 0x04, 0xba, 0xa0, 0x60, 0x0c, 0xa9, 0x2a, 0xd4,
+	0x3f, 0x76, 0x05, 0x87, 0x56, 0x51, 0x2e, 0x10, 0x1c, 0xc5, 0x55, 0xb0, 0xd1, 0x18, 0x92, 0x2a,
+	0xaa, 0xba, 0x19, 0xef, 0x8e, 0xed, 0x29, 0xcb, 0x8c, 0x3b, 0x33, 0x6b, 0x92, 0x7b, 0xd5, 0xde,
+	0xaa, 0x7e, 0x84, 0xde, 0xfa, 0x55, 0x72, 0xcc, 0xa5, 0x55, 0x55, 0xa9, 0x51, 0x05, 0x97, 0xde,
+	0xfb, 0x05, 0xaa, 0x9d, 0x5d, 0xe3, 0x35, 0x76, 0x11, 0x95, 0xe9, 0xa5, 0xb7, 0x99, 0xf7, 0xde,
+	0xbe, 0x3f, 0xbf, 0xf9, 0xcd, 0xcf, 0x63, 0x58, 0xa1, 0x27, 0x4c, 0x29, 0x26, 0xb8, 0x2a, 0x75,
+	0xb7, 0x4b, 0x92, 0x76, 0x02, 0x4d, 0x65, 0xb1, 0x23, 0x85, 0x16, 0x28, 0x77, 0xe1, 0x2b, 0x76,
+	0xb7, 0x57, 0xec, 0x81, 0x48, 0x2e, 0xb8, 0x4b, 0xa3, 0xb8, 0x95, 0xdb, 0x2d, 0xd1, 0x12, 0x66,
+	0x59, 0x0a, 0x57, 0x91, 0x75, 0xe3, 0x67, 0x0b, 0x16, 0x9f, 0x0a, 0x79, 0x4c, 0xe5, 0x8e, 0xd6,
+	0x92, 0x35, 0x02, 0x4d, 0xbd, 0x27, 0xc4, 0x0f, 0x28, 0x5a, 0x82, 0xcc, 0xa9, 0x71, 0xd8, 0x56,
+	0xc1, 0xda, 0x9c, 0xc1, 0xf1, 0x0e, 0xed, 0xc3, 0x64, 0x37, 0x0c, 0xb0, 0x6f, 0x85, 0xe6, 0xdd,
+	0x4f, 0x5e, 0xbd, 0x59, 0x9b, 0xf8, 0xed, 0xcd, 0x5a, 0xa9, 0xc5, 0x74, 0x3b, 0x68, 0x14, 0x5d,
+	0x71, 0x52, 0x22, 0xbe, 0x2f, 0x24, 0xf9, 0x88, 0x53, 0x1d, 0x7e, 0xd3, 0xdb, 0xba, 0x6d, 0xc2,
+	0x78, 0xe9, 0x84, 0xe8, 0x76, 0x71, 0x8f, 0xba, 0x38, 0xca, 0x82, 0x6a, 0x90, 0x31, 0x0b, 0x65,
+	0xa7, 0x0a, 0xa9, 0x71, 0xf2, 0xc5, 0x69, 0xee, 0xa5, 0xff, 0xfc, 0x71, 0xcd, 0xda, 0xf8, 0xdd,
+	0x82, 0xd5, 0xa7, 0x4c, 0xb7, 0xdb, 0xd4, 0xf7, 0xfe, 0x8f, 0xf3, 0xfd, 0x64, 0xc1, 0x6a, 0x8d,
+	0xd3, 0x5a, 0xa0, 0x2b, 0xbc, 0x49, 0x25, 0x95, 0x0f, 0x85, 0xa4, 0x2e, 0x51, 0x9a, 0x4a, 0x33,
+	0x9e, 0x42, 0x79, 0x80, 0xe6, 0x85, 0xcd, 0x4e, 0x99, 0x19, 0x13, 0x16, 0xf4, 0x1c, 0x96, 0x04,
+	0xa7, 0x8e, 0x08, 0xb4, 0xc3, 0xa2, 0x14, 0x4e, 0xdc, 0x68, 0xba, 0x90, 0xda, 0xcc, 0x6e, 0x7d,
+	0x50, 0x4c, 0x12, 0xab, 0x78, 0x25, 0x98, 0x78, 0x41, 0x24, 0x7b, 0x79, 0x92, 0xec, 0xf4, 0xaf,
+	0x69, 0xc8, 0x1a, 0xc3, 0x6e, 0xc0, 0x3d, 0x9f, 0xa2, 0x65, 0x98, 0xd6, 0xa2, 0xc3, 0x5c, 0x87,
+	0x79, 0x06, 0xf9, 0x34, 0x9e, 0x32, 0xfb, 0x8a, 0x87, 0x8e, 0x60, 0x31, 0xe6, 0xb6, 0x23, 0xe9,
+	0xd7, 0x01, 0x55, 0xda, 0x31, 0x0c, 0x36, 0x47, 0x91, 0xdd, 0x5a, 0x1f, 0xec, 0x08, 0x47, 0xa1,
+	0x38, 0x8a, 0xac, 0x86, 0x81, 0x78, 0x41, 0x0e, 0x1b, 0x91, 0x0d, 0x53, 0xb1, 0x39, 0x86, 0xa1,
+	0xb7, 0x45, 0xab, 0x00, 0xf4, 0x85, 0x96, 0xc4, 0xf1, 0x88, 0x26, 0x76, 0xba, 0x60, 0x6d, 0xe6,
+	0xf0, 0x8c, 0xb1, 0xec, 0x11, 0x4d, 0xd0, 0x97, 0x30, 0xe7, 0x8a, 0x93, 0x06, 0xe3, 0xd4, 0x8b,
+	0xb0, 0xb1, 0x27, 0xc7, 0xe3, 0xc4, 0x6c, 0x2f, 0x5d, 0x44, 0xc1, 0xcf, 0x60, 0xee, 0x12, 0xf4,
+	0x19, 0x03, 0xfd, 0xdd, 0x4b, 0xd0, 0x8f, 0x84, 0x7c, 0x96, 0x25, 0xc1, 0x46, 0x07, 0xf0, 0x76,
+	0xff, 0x70, 0x7b, 0xe9, 0xa6, 0xae, 0x9f, 0x6e, 0xbe, 0x79, 0x99, 0x40, 0x9f, 0x43, 0x96, 0x13,
+	0xd6, 0xa5, 0xf1, 0xe8, 0xd3, 0xe3, 0x8d, 0x0e, 0x26, 0x57, 0x34, 0xf7, 0x3f, 0x53, 0x6f, 0xe6,
+	0x66, 0xa8, 0x87, 0x9a, 0xb0, 0xdc, 0xab, 0x30, 0x8c, 0x0a, 0xfc, 0xfb, 0x22, 0x4b, 0x51, 0x91,
+	0xa1, 0x4b, 0xf6, 0x05, 0xd8, 0x61, 0x1d, 0xc6, 0x47, 0x94, 0xc9, 0x5e, 0x1f, 0xfc, 0x45, 0xc1,
+	0x69, 0x85, 0x0f, 0x65, 0x0f, 0x60, 0xfd, 0x32, 0x4e, 0xc3, 0x65, 0x72, 0xa3, 0xa6, 0xb9, 0x52,
+	0x1a, 0xf0, 0xaa, 0xb8, 0xca, 0x8d, 0x9e, 0xc3, 0x5b, 0x83, 0xb4, 0x57, 0xf6, 0xec, 0x78, 0xda,
+	0x35, 0x37, 0xc0, 0x7b, 0x85, 0x9e, 0x41, 0x2e, 0x41, 0x2d, 0x65, 0xcf, 0x8d, 0x97, 0x3e, 0xdb,
+	0xe7, 0x56, 0x4f, 0x75, 0xbe, 0xb7, 0x00, 0xc5, 0x02, 0x91, 0x14, 0x9f, 0xfb, 0x90, 0x33, 0x25,
+	0x9d, 0x86, 0xd9, 0x1b, 0x01, 0xca, 0x6e, 0x2d, 0x0f, 0x82, 0x97, 0xf8, 0x00, 0x67, 0xbb, 0x89,
+	0xaf, 0xef, 0xc0, 0x8c, 0x62, 0x2d, 0x4e, 0x74, 0x20, 0x23, 0x4d, 0xca, 0xe1, 0xbe, 0x21, 0xfc,
+	0x41, 0xe9, 0x04, 0x8d, 0x63, 0xfa, 0x32, 0x56, 0x99, 0x78, 0x17, 0x37, 0x74, 0x0c, 0x0b, 0xc3,
+	0xfd, 0x28, 0x74, 0xd8, 0x97, 0xbc, 0x64, 0x63, 0xca, 0xb6, 0xcc, 0xb1, 0x16, 0x46, 0x4a, 0x5e,
+	0xb2, 0xc1, 0x05, 0x39, 0x64, 0x53, 0x1b, 0xbf, 0x4c, 0x82, 0xbd, 0x23, 0xdd, 0x36, 0xeb, 0x52,
+	0xaf, 0x1a, 0x41, 0x17, 0x1d, 0x36, 0x77, 0xaf, 0x14, 0xe0, 0x75, 0xc8, 0x35, 0x7c, 0xe1, 0x1e,
+	0x3b, 0x6d, 0xca, 0x5a, 0x6d, 0x6d, 0x66, 0x4c, 0xe1, 0xac, 0xb1, 0x3d, 0x32, 0xa6, 0x11, 0x9a,
+	0x98, 0xba, 0x51, 0x4d, 0xbc, 0xa4, 0x3a, 0xe9, 0x9b, 0x54, 0x9d, 0x21, 0x5a, 0x4f, 0xfe, 0xb7,
+	0xb4, 0xce, 0xdc, 0x1c, 0xad, 0xd1, 0xb7, 0x16, 0xdc, 0x75, 0x05, 0x6f, 0x32, 0x2f, 0x3c, 0x44,
+	0x87, 0x71, 0x4d, 0x65, 0x97, 0xf8, 0x8e, 0x24, 0xa7, 0x4e, 0x87, 0x4a, 0x97, 0x72, 0xcd, 0xfc,
+	0x58, 0xf2, 0xc7, 0xa8, 0x59, 0xe8, 0xd7, 0xa8, 0xc4, 0x25, 0x30, 0x39, 0x3d, 0xe8, 0x17, 0x40,
+	0x01, 0xac, 0x8c, 0xea, 0x23, 0x1e, 0x79, 0x7a, 0xbc, 0xf2, 0xf6, 0x70, 0xf9, 0x81, 0x6b, 0xfd,
+	0xdd, 0xad, 0x8b, 0x6b, 0x54, 0xf7, 0x89, 0x6a, 0x33, 0xde, 0xaa, 0xf0, 0xa6, 0x40, 0xf7, 0x60,
+	0xd9, 0x15, 0x5c, 0x51, 0x37, 0xd0, 0x21, 0xfe, 0xe1, 0x9d, 0xa1, 0x5e, 0xf4, 0x78, 0x50, 0x31,
+	0xc9, 0xdf, 0x49, 0x04, 0xec, 0x1b, 0xbf, 0x79, 0x1d, 0x28, 0x74, 0x1f, 0x56, 0x12, 0xae, 0x50,
+	0x6d, 0x7d, 0x46, 0xa5, 0x43, 0x3b, 0xc2, 0x6d, 0x2b, 0x73, 0x05, 0xd2, 0xd8, 0x4e, 0x44, 0xd4,
+	0xa2, 0x80, 0xb2, 0xf1, 0xa3, 0x8f, 0xe1, 0xb6, 0x4f, 0x94, 0x76, 0x54, 0xd0, 0x38, 0x61, 0x5a,
+	0xf7, 0xaa, 0x9a, 0x5b, 0x91, 0xc2, 0x28, 0xf4, 0xd5, 0x7b, 0xae, 0xe8, 0x39, 0xb2, 0x04, 0x99,
+	0xaf, 0x08, 0xf3, 0xa9, 0x67, 0xc8, 0x3d, 0x8d, 0xe3, 0x1d, 0xfa, 0x10, 0x50, 0xb4, 0x72, 0x82,
+	0x10, 0x6a, 0xc7, 0xdc, 0x3a, 0xf3, 0xe2, 0x48, 0xe1, 0xf9, 0xc8, 0x73, 0x14, 0x3a, 0x76, 0x43,
+	0xfb, 0xfb, 0xdf, 0xf4, 0x05, 0xce, 0x20, 0x81, 0x29, 0x51, 0x82, 0xa3, 0x77, 0xa1, 0x80, 0xcb,
+	0x07, 0x47, 0x87, 0x65, 0xec, 0xd4, 0x1f, 0xef, 0xd4, 0x1f, 0x39, 0xb8, 0xbc, 0x53, 0xaf, 0x55,
+	0x9d, 0xa3, 0x6a, 0xfd, 0xa0, 0xfc, 0xa0, 0xf2, 0xb0, 0x52, 0xde, 0x9b, 0x9f, 0x40, 0xef, 0xc1,
+	0xc6, 0xc8, 0xa8, 0xfd, 0x4a, 0xbd, 0x5e, 0xde, 0x73, 0xaa, 0xb5, 0xea, 0x83, 0x72, 0x7d, 0xde,
+	0x42, 0x05, 0xb8, 0x33, 0x32, 0xae, 0x76, 0x74, 0xf8, 0xb8, 0x52, 0xc6, 0xf3, 0xb7, 0x76, 0xf1,
+	0xab, 0xb3, 0xbc, 0xf5, 0xfa, 0x2c, 0x6f, 0xfd, 0x71, 0x96, 0xb7, 0x7e, 0x38, 0xcf, 0x4f, 0xbc,
+	0x3e, 0xcf, 0x4f, 0xfc, 0x7a, 0x9e, 0x9f, 0x78, 0xf6, 0xe9, 0x35, 0xcf, 0xfe, 0x45, 0xa9, 0xff,
+	0x97, 0x45, 0xbf, 0xec, 0x50, 0xd5, 0xc8, 0x98, 0xbf, 0x26, 0xdb, 0x7f, 0x0f, 0x00, 0x84, 0x51,
+	0x91, 0x95, 0xf6, 0x0c, 0x00, 0x00,
 }
 
 func (this *WorkerAttributedValue) Equal(that interface{}) bool {
@@ -488,6 +696,14 @@ func (this *WorkerAttributedValue) Equal(that interface{}) bool {
 	if !this.Value.Equal(that1.Value) {
 		return false
 	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if !this.Values[i].Equal(that1.Values[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *WithheldWorkerAttributedValue) Equal(that interface{}) bool {
@@ -515,6 +731,14 @@ func (this *WithheldWorkerAttributedValue) Equal(that interface{}) bool {
 	if !this.Value.Equal(that1.Value) {
 		return false
 	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if !this.Values[i].Equal(that1.Values[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *OneOutInfererForecasterValues) Equal(that interface{}) bool {
@@ -634,6 +858,22 @@ func (this *ValueBundle) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.CombinedValues) != len(that1.CombinedValues) {
+		return false
+	}
+	for i := range this.CombinedValues {
+		if !this.CombinedValues[i].Equal(that1.CombinedValues[i]) {
+			return false
+		}
+	}
+	if len(this.NaiveValues) != len(that1.NaiveValues) {
+		return false
+	}
+	for i := range this.NaiveValues {
+		if !this.NaiveValues[i].Equal(that1.NaiveValues[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ReputerValueBundle) Equal(that interface{}) bool {
@@ -666,6 +906,71 @@ func (this *ReputerValueBundle) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ArchivedNetworkInference) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ArchivedNetworkInference)
+	if !ok {
+		that2, ok := that.(ArchivedNetworkInference)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TopicId != that1.TopicId {
+		return false
+	}
+	if this.BlockHeight != that1.BlockHeight {
+		return false
+	}
+	if !this.CombinedValue.Equal(that1.CombinedValue) {
+		return false
+	}
+	if !this.NaiveValue.Equal(that1.NaiveValue) {
+		return false
+	}
+	if len(this.CombinedValues) != len(that1.CombinedValues) {
+		return false
+	}
+	for i := range this.CombinedValues {
+		if !this.CombinedValues[i].Equal(that1.CombinedValues[i]) {
+			return false
+		}
+	}
+	if len(this.NaiveValues) != len(that1.NaiveValues) {
+		return false
+	}
+	for i := range this.NaiveValues {
+		if !this.NaiveValues[i].Equal(that1.NaiveValues[i]) {
+			return false
+		}
+	}
+	if len(this.ConfidenceIntervalRawPercentiles) != len(that1.ConfidenceIntervalRawPercentiles) {
+		return false
+	}
+	for i := range this.ConfidenceIntervalRawPercentiles {
+		if !this.ConfidenceIntervalRawPercentiles[i].Equal(that1.ConfidenceIntervalRawPercentiles[i]) {
+			return false
+		}
+	}
+	if len(this.ConfidenceIntervalValues) != len(that1.ConfidenceIntervalValues) {
+		return false
+	}
+	for i := range this.ConfidenceIntervalValues {
+		if !this.ConfidenceIntervalValues[i].Equal(that1.ConfidenceIntervalValues[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (m *WorkerAttributedValue) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -686,6 +991,20 @@ func (m *WorkerAttributedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Values[iNdEx].Size()
+				i -= size
+				if _, err := m.Values[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintReputer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size := m.Value.Size()
 		i -= size
@@ -726,6 +1045,20 @@ func (m *WithheldWorkerAttributedValue) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Values[iNdEx].Size()
+				i -= size
+				if _, err := m.Values[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintReputer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size := m.Value.Size()
 		i -= size
@@ -810,6 +1143,34 @@ func (m *ValueBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NaiveValues) > 0 {
+		for iNdEx := len(m.NaiveValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.NaiveValues[iNdEx].Size()
+				i -= size
+				if _, err := m.NaiveValues[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintReputer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.CombinedValues) > 0 {
+		for iNdEx := len(m.CombinedValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CombinedValues[iNdEx].Size()
+				i -= size
+				if _, err := m.CombinedValues[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintReputer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if len(m.OneOutInfererForecasterValues) > 0 {
 		for iNdEx := len(m.OneOutInfererForecasterValues) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1034,59 +1395,233 @@ func (m *ReputerValueBundles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintReputer(dAtA []byte, offset int, v uint64) int {
-	offset -= sovReputer(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *WorkerAttributedValue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Worker)
-	if l > 0 {
-		n += 1 + l + sovReputer(uint64(l))
+func (m *ArchivedNetworkInference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Value.Size()
-	n += 1 + l + sovReputer(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *WithheldWorkerAttributedValue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Worker)
-	if l > 0 {
-		n += 1 + l + sovReputer(uint64(l))
-	}
-	l = m.Value.Size()
-	n += 1 + l + sovReputer(uint64(l))
-	return n
+func (m *ArchivedNetworkInference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OneOutInfererForecasterValues) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ArchivedNetworkInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Forecaster)
-	if l > 0 {
-		n += 1 + l + sovReputer(uint64(l))
+	if len(m.ConfidenceIntervalValues) > 0 {
+		for iNdEx := len(m.ConfidenceIntervalValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ConfidenceIntervalValues[iNdEx].Size()
+				i -= size
+				if _, err := m.ConfidenceIntervalValues[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintReputer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	if len(m.OneOutInfererValues) > 0 {
-		for _, e := range m.OneOutInfererValues {
+	if len(m.ConfidenceIntervalRawPercentiles) > 0 {
+		for iNdEx := len(m.ConfidenceIntervalRawPercentiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ConfidenceIntervalRawPercentiles[iNdEx].Size()
+				i -= size
+				if _, err := m.ConfidenceIntervalRawPercentiles[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintReputer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.NaiveValues) > 0 {
+		for iNdEx := len(m.NaiveValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.NaiveValues[iNdEx].Size()
+				i -= size
+				if _, err := m.NaiveValues[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintReputer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.CombinedValues) > 0 {
+		for iNdEx := len(m.CombinedValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CombinedValues[iNdEx].Size()
+				i -= size
+				if _, err := m.CombinedValues[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintReputer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.NaiveValue.Size()
+		i -= size
+		if _, err := m.NaiveValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReputer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CombinedValue.Size()
+		i -= size
+		if _, err := m.CombinedValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReputer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.BlockHeight != 0 {
+		i = encodeVarintReputer(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintReputer(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReputerSlashingInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReputerSlashingInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReputerSlashingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailedUntilBlock != 0 {
+		i = encodeVarintReputer(dAtA, i, uint64(m.JailedUntilBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastSubmittedNonce != 0 {
+		i = encodeVarintReputer(dAtA, i, uint64(m.LastSubmittedNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConsecutiveOutlierEpochs != 0 {
+		i = encodeVarintReputer(dAtA, i, uint64(m.ConsecutiveOutlierEpochs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ConsecutiveMissedNonces != 0 {
+		i = encodeVarintReputer(dAtA, i, uint64(m.ConsecutiveMissedNonces))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReputer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReputer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WorkerAttributedValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovReputer(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovReputer(uint64(l))
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovReputer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WithheldWorkerAttributedValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovReputer(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovReputer(uint64(l))
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovReputer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OneOutInfererForecasterValues) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Forecaster)
+	if l > 0 {
+		n += 1 + l + sovReputer(uint64(l))
+	}
+	if len(m.OneOutInfererValues) > 0 {
+		for _, e := range m.OneOutInfererValues {
 			l = e.Size()
 			n += 1 + l + sovReputer(uint64(l))
 		}
@@ -1155,6 +1690,18 @@ func (m *ValueBundle) Size() (n int) {
 			n += 1 + l + sovReputer(uint64(l))
 		}
 	}
+	if len(m.CombinedValues) > 0 {
+		for _, e := range m.CombinedValues {
+			l = e.Size()
+			n += 1 + l + sovReputer(uint64(l))
+		}
+	}
+	if len(m.NaiveValues) > 0 {
+		for _, e := range m.NaiveValues {
+			l = e.Size()
+			n += 1 + l + sovReputer(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1194,6 +1741,73 @@ func (m *ReputerValueBundles) Size() (n int) {
 	return n
 }
 
+func (m *ArchivedNetworkInference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovReputer(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovReputer(uint64(m.BlockHeight))
+	}
+	l = m.CombinedValue.Size()
+	n += 1 + l + sovReputer(uint64(l))
+	l = m.NaiveValue.Size()
+	n += 1 + l + sovReputer(uint64(l))
+	if len(m.CombinedValues) > 0 {
+		for _, e := range m.CombinedValues {
+			l = e.Size()
+			n += 1 + l + sovReputer(uint64(l))
+		}
+	}
+	if len(m.NaiveValues) > 0 {
+		for _, e := range m.NaiveValues {
+			l = e.Size()
+			n += 1 + l + sovReputer(uint64(l))
+		}
+	}
+	if len(m.ConfidenceIntervalRawPercentiles) > 0 {
+		for _, e := range m.ConfidenceIntervalRawPercentiles {
+			l = e.Size()
+			n += 1 + l + sovReputer(uint64(l))
+		}
+	}
+	if len(m.ConfidenceIntervalValues) > 0 {
+		for _, e := range m.ConfidenceIntervalValues {
+			l = e.Size()
+			n += 1 + l + sovReputer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReputerSlashingInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConsecutiveMissedNonces != 0 {
+		n += 1 + sovReputer(uint64(m.ConsecutiveMissedNonces))
+	}
+	if m.ConsecutiveOutlierEpochs != 0 {
+		n += 1 + sovReputer(uint64(m.ConsecutiveOutlierEpochs))
+	}
+	if m.LastSubmittedNonce != 0 {
+		n += 1 + sovReputer(uint64(m.LastSubmittedNonce))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.JailedUntilBlock != 0 {
+		n += 1 + sovReputer(uint64(m.JailedUntilBlock))
+	}
+	return n
+}
+
 func sovReputer(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1295,6 +1909,42 @@ func (m *WorkerAttributedValue) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Values = append(m.Values, v)
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReputer(dAtA[iNdEx:])
@@ -1411,24 +2061,60 @@ func (m *WithheldWorkerAttributedValue) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipReputer(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthReputer
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Values = append(m.Values, v)
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReputer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
@@ -1970,6 +2656,78 @@ func (m *ValueBundle) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CombinedValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.CombinedValues = append(m.CombinedValues, v)
+			if err := m.CombinedValues[len(m.CombinedValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NaiveValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.NaiveValues = append(m.NaiveValues, v)
+			if err := m.NaiveValues[len(m.NaiveValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReputer(dAtA[iNdEx:])
@@ -2227,6 +2985,452 @@ func (m *ReputerValueBundles) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ArchivedNetworkInference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReputer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedNetworkInference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedNetworkInference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CombinedValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CombinedValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NaiveValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NaiveValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CombinedValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.CombinedValues = append(m.CombinedValues, v)
+			if err := m.CombinedValues[len(m.CombinedValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NaiveValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.NaiveValues = append(m.NaiveValues, v)
+			if err := m.NaiveValues[len(m.NaiveValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfidenceIntervalRawPercentiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.ConfidenceIntervalRawPercentiles = append(m.ConfidenceIntervalRawPercentiles, v)
+			if err := m.ConfidenceIntervalRawPercentiles[len(m.ConfidenceIntervalRawPercentiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfidenceIntervalValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.ConfidenceIntervalValues = append(m.ConfidenceIntervalValues, v)
+			if err := m.ConfidenceIntervalValues[len(m.ConfidenceIntervalValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReputer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReputerSlashingInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReputer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReputerSlashingInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReputerSlashingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveMissedNonces", wireType)
+			}
+			m.ConsecutiveMissedNonces = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveMissedNonces |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveOutlierEpochs", wireType)
+			}
+			m.ConsecutiveOutlierEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveOutlierEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSubmittedNonce", wireType)
+			}
+			m.LastSubmittedNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSubmittedNonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntilBlock", wireType)
+			}
+			m.JailedUntilBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntilBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReputer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipReputer(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -403,6 +403,111 @@ func (m *DelegatorInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DelegatorInfo proto.InternalMessageInfo
 
+// Stake a delegator moved from a reputer to another, possibly in another topic.
+// Until it completes, the redelegated stake remains slashable for misbehaviour of
+// its source reputer.
+type Redelegation struct {
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Delegator  string `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	SrcTopicId uint64 `protobuf:"varint,3,opt,name=src_topic_id,json=srcTopicId,proto3" json:"src_topic_id,omitempty"`
+	SrcReputer string `protobuf:"bytes,4,opt,name=src_reputer,json=srcReputer,proto3" json:"src_reputer,omitempty"`
+	DstTopicId uint64 `protobuf:"varint,5,opt,name=dst_topic_id,json=dstTopicId,proto3" json:"dst_topic_id,omitempty"`
+	DstReputer string `protobuf:"bytes,6,opt,name=dst_reputer,json=dstReputer,proto3" json:"dst_reputer,omitempty"`
+	// stake redelegated, reduced by the slashes of the source reputer
+	Amount                     cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	BlockRedelegated           int64                 `protobuf:"varint,8,opt,name=block_redelegated,json=blockRedelegated,proto3" json:"block_redelegated,omitempty"`
+	BlockRedelegationCompleted int64                 `protobuf:"varint,9,opt,name=block_redelegation_completed,json=blockRedelegationCompleted,proto3" json:"block_redelegation_completed,omitempty"`
+}
+
+func (m *Redelegation) Reset()         { *m = Redelegation{} }
+func (m *Redelegation) String() string { return proto.CompactTextString(m) }
+func (*Redelegation) ProtoMessage()    {}
+func (*Redelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdee6632fec3e740, []int{6}
+}
+func (m *Redelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Redelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Redelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Redelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Redelegation.Merge(m, src)
+}
+func (m *Redelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Redelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Redelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Redelegation proto.InternalMessageInfo
+
+func (m *Redelegation) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Redelegation) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *Redelegation) GetSrcTopicId() uint64 {
+	if m != nil {
+		return m.SrcTopicId
+	}
+	return 0
+}
+
+func (m *Redelegation) GetSrcReputer() string {
+	if m != nil {
+		return m.SrcReputer
+	}
+	return ""
+}
+
+func (m *Redelegation) GetDstTopicId() uint64 {
+	if m != nil {
+		return m.DstTopicId
+	}
+	return 0
+}
+
+func (m *Redelegation) GetDstReputer() string {
+	if m != nil {
+		return m.DstReputer
+	}
+	return ""
+}
+
+func (m *Redelegation) GetBlockRedelegated() int64 {
+	if m != nil {
+		return m.BlockRedelegated
+	}
+	return 0
+}
+
+func (m *Redelegation) GetBlockRedelegationCompleted() int64 {
+	if m != nil {
+		return m.BlockRedelegationCompleted
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*StakePlacement)(nil), "emissions.v3.StakePlacement")
 	proto.RegisterType((*DelegateStakePlacement)(nil), "emissions.v3.DelegateStakePlacement")
@@ -410,43 +515,52 @@ func init() {
 	proto.RegisterType((*StakeRemovalInfo)(nil), "emissions.v3.StakeRemovalInfo")
 	proto.RegisterType((*DelegateStakeRemovalInfo)(nil), "emissions.v3.DelegateStakeRemovalInfo")
 	proto.RegisterType((*DelegatorInfo)(nil), "emissions.v3.DelegatorInfo")
+	proto.RegisterType((*Redelegation)(nil), "emissions.v3.Redelegation")
 }
 
 func init() { proto.RegisterFile("emissions/v3/stake.proto", fileDescriptor_cdee6632fec3e740) }
 
 var fileDescriptor_cdee6632fec3e740 = []byte{
-	// 495 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x94, 0xb1, 0x6f, 0xd3, 0x4e,
-	0x14, 0xc7, 0x73, 0x49, 0x9b, 0xfe, 0x72, 0x3f, 0x40, 0x60, 0x08, 0xb8, 0x15, 0x72, 0xa3, 0x4c,
-	0x11, 0x52, 0x6d, 0x44, 0x24, 0x60, 0x2e, 0x19, 0xc8, 0x04, 0x72, 0x17, 0xc4, 0x62, 0x9d, 0xed,
-	0x47, 0x62, 0xc5, 0x77, 0xcf, 0xba, 0xbb, 0xa4, 0xf0, 0x2f, 0x30, 0xf1, 0x3f, 0xb0, 0x30, 0x32,
-	0x30, 0xb1, 0xb2, 0x74, 0xac, 0x98, 0x10, 0x43, 0x85, 0x92, 0x01, 0xf1, 0x47, 0x20, 0xa1, 0xdc,
-	0x39, 0x69, 0x33, 0xb4, 0x42, 0x54, 0xa2, 0x62, 0xb1, 0xfc, 0xde, 0xd7, 0x7e, 0xf7, 0xf9, 0x7e,
-	0xf5, 0x74, 0xd4, 0x05, 0x9e, 0x29, 0x95, 0xa1, 0x50, 0xc1, 0xa4, 0x1b, 0x28, 0xcd, 0x46, 0xe0,
-	0x17, 0x12, 0x35, 0x3a, 0x97, 0x96, 0x8a, 0x3f, 0xe9, 0x6e, 0x5d, 0x63, 0x3c, 0x13, 0x18, 0x98,
-	0xa7, 0xfd, 0x60, 0x6b, 0x33, 0x41, 0xc5, 0x51, 0x45, 0xa6, 0x0a, 0x6c, 0x51, 0x4a, 0x37, 0x06,
-	0x38, 0x40, 0xdb, 0x9f, 0xbf, 0xd9, 0x6e, 0xfb, 0x13, 0xa1, 0x57, 0xf6, 0xe6, 0x27, 0x3c, 0xcd,
-	0x59, 0x02, 0x1c, 0x84, 0x76, 0xee, 0xd1, 0x66, 0x9c, 0x63, 0x32, 0x8a, 0x24, 0x70, 0x9c, 0xb0,
-	0x3c, 0x52, 0x9a, 0x49, 0x0d, 0xa9, 0x4b, 0x5a, 0xa4, 0x53, 0x0b, 0xaf, 0x1b, 0x31, 0xb4, 0xda,
-	0x9e, 0x95, 0x9c, 0x4d, 0xfa, 0x9f, 0xc6, 0x22, 0x4b, 0xa2, 0x2c, 0x75, 0xab, 0x2d, 0xd2, 0x59,
-	0x0b, 0x37, 0x4c, 0xdd, 0x4f, 0x1d, 0x97, 0x6e, 0x48, 0x28, 0xc6, 0x1a, 0xa4, 0x5b, 0x6b, 0x91,
-	0x4e, 0x23, 0x5c, 0x94, 0xce, 0x63, 0x5a, 0x67, 0x1c, 0xc7, 0x42, 0xbb, 0x6b, 0x73, 0x61, 0xf7,
-	0xee, 0xc1, 0xd1, 0x76, 0xe5, 0xeb, 0xd1, 0x76, 0xd3, 0x72, 0xab, 0x74, 0xe4, 0x67, 0x18, 0x70,
-	0xa6, 0x87, 0x7e, 0x5f, 0xe8, 0xcf, 0x1f, 0x76, 0x68, 0x69, 0xa8, 0x2f, 0xf4, 0xbb, 0xef, 0xef,
-	0xef, 0x90, 0xb0, 0xfc, 0xbf, 0xfd, 0x83, 0xd0, 0x9b, 0x3d, 0xc8, 0x61, 0xc0, 0x34, 0x5c, 0x94,
-	0x9b, 0xdb, 0xb4, 0x91, 0x5a, 0x04, 0x94, 0xd6, 0x50, 0x78, 0xdc, 0x38, 0xe1, 0x75, 0xfd, 0x9c,
-	0x5e, 0x5f, 0x13, 0xda, 0x30, 0x1e, 0xfb, 0xe2, 0x05, 0xae, 0xa0, 0x92, 0x53, 0x51, 0xab, 0xa7,
-	0x05, 0x5f, 0x3b, 0x27, 0xcc, 0x4f, 0x42, 0xaf, 0x1a, 0x98, 0x32, 0x41, 0xc3, 0xf4, 0xef, 0x2d,
-	0x90, 0x73, 0x9f, 0xde, 0x5a, 0x45, 0x4e, 0x90, 0x17, 0x39, 0xcc, 0xa1, 0xd7, 0x0d, 0x74, 0xf3,
-	0x24, 0xf4, 0xa3, 0x85, 0xd8, 0x7e, 0x5b, 0xa5, 0xee, 0xca, 0xe2, 0x5d, 0x48, 0x0e, 0x7f, 0x69,
-	0xf5, 0xce, 0x4a, 0xa9, 0x7e, 0x56, 0x4a, 0x1f, 0x09, 0xbd, 0xdc, 0x5b, 0xf0, 0x98, 0x68, 0x9e,
-	0x2c, 0x99, 0x88, 0x61, 0x7a, 0x50, 0x32, 0x05, 0x83, 0x4c, 0x0f, 0xc7, 0xb1, 0x9f, 0x20, 0x0f,
-	0x58, 0x9e, 0xa3, 0x64, 0x3b, 0x02, 0xf4, 0x3e, 0xca, 0xd1, 0xa2, 0x4c, 0x86, 0x2c, 0x13, 0x96,
-	0xb6, 0x07, 0xc9, 0x12, 0xed, 0x19, 0xfd, 0x5f, 0xc2, 0x3e, 0x93, 0x69, 0x94, 0x42, 0xac, 0xed,
-	0xc2, 0xff, 0xf9, 0x54, 0x6a, 0x67, 0xf5, 0x20, 0xd6, 0xbb, 0xe1, 0xc1, 0xd4, 0x23, 0x87, 0x53,
-	0x8f, 0x7c, 0x9b, 0x7a, 0xe4, 0xcd, 0xcc, 0xab, 0x1c, 0xce, 0xbc, 0xca, 0x97, 0x99, 0x57, 0x79,
-	0xfe, 0xf0, 0x37, 0xc7, 0xbe, 0x0c, 0x8e, 0x2f, 0x74, 0xfd, 0xaa, 0x00, 0x15, 0xd7, 0xcd, 0xe5,
-	0xdb, 0xfd, 0x15, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x21, 0xcd, 0x79, 0xea, 0x05, 0x00, 0x00,
+	// 613 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x95, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x73, 0x4e, 0x9b, 0x36, 0xaf, 0xa1, 0x6a, 0x0d, 0x05, 0xb7, 0xaa, 0xdc, 0x28, 0x53,
+	0x05, 0x6a, 0x8c, 0xa8, 0x04, 0x8c, 0xa8, 0x64, 0x20, 0x13, 0xc8, 0x65, 0x40, 0x2c, 0xd6, 0xc5,
+	0x77, 0xa4, 0xa7, 0xd8, 0x77, 0xd1, 0xdd, 0xb5, 0x85, 0xaf, 0xc0, 0xc4, 0x77, 0x60, 0x61, 0x64,
+	0x60, 0x62, 0x65, 0xe9, 0x58, 0x31, 0x21, 0x86, 0x0a, 0xb5, 0x03, 0xe2, 0x33, 0x20, 0x24, 0xe4,
+	0x3b, 0xdb, 0x4d, 0x8a, 0x5a, 0x21, 0x45, 0xa2, 0x62, 0x89, 0xf2, 0xee, 0xbd, 0x7b, 0xfe, 0xbd,
+	0xff, 0xff, 0x59, 0x06, 0x8f, 0xa6, 0x4c, 0x29, 0x26, 0xb8, 0x0a, 0xf6, 0x36, 0x03, 0xa5, 0xf1,
+	0x80, 0xb6, 0x87, 0x52, 0x68, 0xe1, 0x36, 0xca, 0x4c, 0x7b, 0x6f, 0x73, 0x65, 0x11, 0xa7, 0x8c,
+	0x8b, 0xc0, 0xfc, 0xda, 0x82, 0x95, 0xe5, 0x58, 0xa8, 0x54, 0xa8, 0xc8, 0x44, 0x81, 0x0d, 0xf2,
+	0xd4, 0xb5, 0xbe, 0xe8, 0x0b, 0x7b, 0x9e, 0xfd, 0xb3, 0xa7, 0xad, 0x4f, 0x08, 0xe6, 0xb7, 0xb3,
+	0x27, 0x3c, 0x49, 0x70, 0x4c, 0x53, 0xca, 0xb5, 0x7b, 0x07, 0x96, 0x7a, 0x89, 0x88, 0x07, 0x91,
+	0xa4, 0xa9, 0xd8, 0xc3, 0x49, 0xa4, 0x34, 0x96, 0x9a, 0x12, 0x0f, 0x35, 0xd1, 0x7a, 0x35, 0xbc,
+	0x6a, 0x92, 0xa1, 0xcd, 0x6d, 0xdb, 0x94, 0xbb, 0x0c, 0xb3, 0x5a, 0x0c, 0x59, 0x1c, 0x31, 0xe2,
+	0x39, 0x4d, 0xb4, 0x3e, 0x15, 0xce, 0x98, 0xb8, 0x4b, 0x5c, 0x0f, 0x66, 0x24, 0x1d, 0xee, 0x6a,
+	0x2a, 0xbd, 0x6a, 0x13, 0xad, 0xd7, 0xc3, 0x22, 0x74, 0x1f, 0x41, 0x0d, 0xa7, 0x62, 0x97, 0x6b,
+	0x6f, 0x2a, 0x4b, 0x6c, 0xdd, 0x3e, 0x38, 0x5a, 0xab, 0x7c, 0x3d, 0x5a, 0x5b, 0xb2, 0xdc, 0x8a,
+	0x0c, 0xda, 0x4c, 0x04, 0x29, 0xd6, 0x3b, 0xed, 0x2e, 0xd7, 0x9f, 0x3f, 0x6c, 0x40, 0x3e, 0x50,
+	0x97, 0xeb, 0x77, 0xdf, 0xdf, 0xdf, 0x44, 0x61, 0x7e, 0xbf, 0xf5, 0x03, 0xc1, 0xf5, 0x0e, 0x4d,
+	0x68, 0x1f, 0x6b, 0x7a, 0x59, 0xd3, 0xac, 0x42, 0x9d, 0x58, 0x04, 0x21, 0xed, 0x40, 0xe1, 0xe9,
+	0xc1, 0xc8, 0xac, 0xd3, 0x13, 0xce, 0xfa, 0x1a, 0x41, 0xdd, 0xcc, 0xd8, 0xe5, 0x2f, 0xc4, 0x18,
+	0x2a, 0x3a, 0x17, 0xd5, 0x39, 0x4f, 0xf8, 0xea, 0x84, 0x30, 0xbf, 0x10, 0x2c, 0x18, 0x98, 0x5c,
+	0x41, 0xc3, 0xf4, 0xff, 0x2d, 0x90, 0x7b, 0x17, 0x6e, 0x8c, 0x23, 0xc7, 0x22, 0x1d, 0x26, 0x34,
+	0x83, 0x9e, 0x36, 0xd0, 0x4b, 0xa3, 0xd0, 0x0f, 0x8b, 0x64, 0xeb, 0xad, 0x03, 0xde, 0xd8, 0xe2,
+	0x5d, 0x8a, 0x0e, 0xff, 0x68, 0xf5, 0x2e, 0x52, 0xa9, 0x76, 0x91, 0x4a, 0x1f, 0x11, 0x5c, 0xe9,
+	0x14, 0x3c, 0x46, 0x9a, 0xc7, 0x25, 0x13, 0x32, 0x4c, 0xf7, 0x72, 0xa6, 0xa0, 0xcf, 0xf4, 0xce,
+	0x6e, 0xaf, 0x1d, 0x8b, 0x34, 0xc0, 0x49, 0x22, 0x24, 0xde, 0xe0, 0x54, 0xef, 0x0b, 0x39, 0x28,
+	0xc2, 0x78, 0x07, 0x33, 0x6e, 0x69, 0x3b, 0x34, 0x2e, 0xd1, 0x9e, 0xc1, 0x9c, 0xa4, 0xfb, 0x58,
+	0x92, 0x88, 0xd0, 0x9e, 0xf6, 0x9c, 0xc9, 0xba, 0x82, 0xed, 0xd5, 0xa1, 0x3d, 0xdd, 0xfa, 0xe9,
+	0x40, 0x23, 0xa4, 0xb9, 0x9c, 0x4c, 0x70, 0x77, 0x1e, 0x9c, 0xf2, 0x65, 0x73, 0x18, 0x19, 0x57,
+	0xdf, 0x39, 0xab, 0x7e, 0x13, 0x1a, 0x4a, 0xc6, 0x51, 0x69, 0x6a, 0xd5, 0xdc, 0x03, 0x25, 0xe3,
+	0xa7, 0xb9, 0xaf, 0x6b, 0x30, 0x97, 0x55, 0x14, 0xde, 0x5a, 0xff, 0xb2, 0x82, 0x30, 0xb7, 0xb7,
+	0x09, 0x0d, 0xa2, 0xf4, 0x69, 0x8b, 0x69, 0xdb, 0x82, 0x28, 0x3d, 0xd2, 0x22, 0xab, 0x28, 0x5a,
+	0xd4, 0x6c, 0x0b, 0xa2, 0x74, 0xf8, 0xc7, 0x9b, 0x32, 0x33, 0xe1, 0x0e, 0xdc, 0x82, 0xc5, 0x62,
+	0x07, 0xf2, 0x21, 0x29, 0xf1, 0x66, 0x8d, 0xfb, 0x0b, 0xb9, 0xfb, 0xe5, 0xb9, 0xfb, 0x00, 0x56,
+	0xcf, 0x14, 0x33, 0xc1, 0x47, 0xb6, 0xa6, 0x6e, 0xee, 0xad, 0x8c, 0xdf, 0x63, 0x82, 0x97, 0xab,
+	0xb3, 0x15, 0x1e, 0x1c, 0xfb, 0xe8, 0xf0, 0xd8, 0x47, 0xdf, 0x8e, 0x7d, 0xf4, 0xe6, 0xc4, 0xaf,
+	0x1c, 0x9e, 0xf8, 0x95, 0x2f, 0x27, 0x7e, 0xe5, 0xf9, 0xfd, 0xbf, 0x34, 0xf5, 0x65, 0x70, 0xfa,
+	0x39, 0xd5, 0xaf, 0x86, 0x54, 0xf5, 0x6a, 0xe6, 0xd3, 0xb7, 0xf9, 0x7b, 0x00, 0x57, 0x94, 0xf2,
+	0x48, 0x68, 0x07, 0x00, 0x00,
 }
 
 func (m *StakePlacement) Marshal() (dAtA []byte, err error) {
@@ -761,6 +875,85 @@ func (m *DelegatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Redelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Redelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Redelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockRedelegationCompleted != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockRedelegationCompleted))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.BlockRedelegated != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockRedelegated))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.DstReputer) > 0 {
+		i -= len(m.DstReputer)
+		copy(dAtA[i:], m.DstReputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.DstReputer)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.DstTopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.DstTopicId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SrcReputer) > 0 {
+		i -= len(m.SrcReputer)
+		copy(dAtA[i:], m.SrcReputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.SrcReputer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SrcTopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.SrcTopicId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStake(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStake(v)
 	base := offset
@@ -901,6 +1094,44 @@ func (m *DelegatorInfo) Size() (n int) {
 	return n
 }
 
+func (m *Redelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStake(uint64(m.Id))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	if m.SrcTopicId != 0 {
+		n += 1 + sovStake(uint64(m.SrcTopicId))
+	}
+	l = len(m.SrcReputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	if m.DstTopicId != 0 {
+		n += 1 + sovStake(uint64(m.DstTopicId))
+	}
+	l = len(m.DstReputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	if m.BlockRedelegated != 0 {
+		n += 1 + sovStake(uint64(m.BlockRedelegated))
+	}
+	if m.BlockRedelegationCompleted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRedelegationCompleted))
+	}
+	return n
+}
+
 func sovStake(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1878,6 +2109,281 @@ func (m *DelegatorInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Redelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcTopicId", wireType)
+			}
+			m.SrcTopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcTopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcReputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcReputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstTopicId", wireType)
+			}
+			m.DstTopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstTopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstReputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstReputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRedelegated", wireType)
+			}
+			m.BlockRedelegated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRedelegated |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRedelegationCompleted", wireType)
+			}
+			m.BlockRedelegationCompleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRedelegationCompleted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStake(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
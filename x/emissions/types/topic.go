@@ -0,0 +1,85 @@
+package types
+
+// Returns a copy of the topic with every set field of the optional params applied.
+// Every option is a repeated field, so an empty array is interpreted as "make no change".
+func (p OptionalTopicParams) ApplyTo(topic Topic) Topic {
+	if len(p.EpochLength) == 1 {
+		topic.EpochLength = p.EpochLength[0]
+	}
+	if len(p.GroundTruthLag) == 1 {
+		topic.GroundTruthLag = p.GroundTruthLag[0]
+	}
+	if len(p.WorkerSubmissionWindow) == 1 {
+		topic.WorkerSubmissionWindow = p.WorkerSubmissionWindow[0]
+	}
+	if len(p.PNorm) == 1 {
+		topic.PNorm = p.PNorm[0]
+	}
+	if len(p.AlphaRegret) == 1 {
+		topic.AlphaRegret = p.AlphaRegret[0]
+	}
+	if len(p.Epsilon) == 1 {
+		topic.Epsilon = p.Epsilon[0]
+	}
+	if len(p.MeritSortitionAlpha) == 1 {
+		topic.MeritSortitionAlpha = p.MeritSortitionAlpha[0]
+	}
+	if len(p.ActiveInfererQuantile) == 1 {
+		topic.ActiveInfererQuantile = p.ActiveInfererQuantile[0]
+	}
+	if len(p.ActiveForecasterQuantile) == 1 {
+		topic.ActiveForecasterQuantile = p.ActiveForecasterQuantile[0]
+	}
+	if len(p.ActiveReputerQuantile) == 1 {
+		topic.ActiveReputerQuantile = p.ActiveReputerQuantile[0]
+	}
+	if len(p.WorkerRevealWindow) == 1 {
+		topic.WorkerRevealWindow = p.WorkerRevealWindow[0]
+	}
+	if len(p.ArchiveNetworkInferences) == 1 {
+		topic.ArchiveNetworkInferences = p.ArchiveNetworkInferences[0]
+	}
+	return topic
+}
+
+// Returns the union of both sets of optional params.
+// Fields set in `newer` take precedence over those set in `p`.
+func (p OptionalTopicParams) Merge(newer OptionalTopicParams) OptionalTopicParams {
+	if len(newer.EpochLength) == 1 {
+		p.EpochLength = newer.EpochLength
+	}
+	if len(newer.GroundTruthLag) == 1 {
+		p.GroundTruthLag = newer.GroundTruthLag
+	}
+	if len(newer.WorkerSubmissionWindow) == 1 {
+		p.WorkerSubmissionWindow = newer.WorkerSubmissionWindow
+	}
+	if len(newer.PNorm) == 1 {
+		p.PNorm = newer.PNorm
+	}
+	if len(newer.AlphaRegret) == 1 {
+		p.AlphaRegret = newer.AlphaRegret
+	}
+	if len(newer.Epsilon) == 1 {
+		p.Epsilon = newer.Epsilon
+	}
+	if len(newer.MeritSortitionAlpha) == 1 {
+		p.MeritSortitionAlpha = newer.MeritSortitionAlpha
+	}
+	if len(newer.ActiveInfererQuantile) == 1 {
+		p.ActiveInfererQuantile = newer.ActiveInfererQuantile
+	}
+	if len(newer.ActiveForecasterQuantile) == 1 {
+		p.ActiveForecasterQuantile = newer.ActiveForecasterQuantile
+	}
+	if len(newer.ActiveReputerQuantile) == 1 {
+		p.ActiveReputerQuantile = newer.ActiveReputerQuantile
+	}
+	if len(newer.WorkerRevealWindow) == 1 {
+		p.WorkerRevealWindow = newer.WorkerRevealWindow
+	}
+	if len(newer.ArchiveNetworkInferences) == 1 {
+		p.ArchiveNetworkInferences = newer.ArchiveNetworkInferences
+	}
+	return p
+}
@@ -24,6 +24,44 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Stages a retired topic moves through while its state is garbage collected
+type TopicRetirementStage int32
+
+const (
+	TopicRetirementStage_TOPIC_RETIREMENT_STAGE_UNSPECIFIED TopicRetirementStage = 0
+	// Stake removals refunding every reputer and delegator are being queued
+	TopicRetirementStage_TOPIC_RETIREMENT_STAGE_REFUNDING_STAKE TopicRetirementStage = 1
+	// Waiting for the queued stake removals to be paid out
+	TopicRetirementStage_TOPIC_RETIREMENT_STAGE_AWAITING_REFUNDS TopicRetirementStage = 2
+	// Topic-scoped state is being deleted a bounded number of keys per block
+	TopicRetirementStage_TOPIC_RETIREMENT_STAGE_CLEARING_STATE TopicRetirementStage = 3
+	TopicRetirementStage_TOPIC_RETIREMENT_STAGE_COMPLETE       TopicRetirementStage = 4
+)
+
+var TopicRetirementStage_name = map[int32]string{
+	0: "TOPIC_RETIREMENT_STAGE_UNSPECIFIED",
+	1: "TOPIC_RETIREMENT_STAGE_REFUNDING_STAKE",
+	2: "TOPIC_RETIREMENT_STAGE_AWAITING_REFUNDS",
+	3: "TOPIC_RETIREMENT_STAGE_CLEARING_STATE",
+	4: "TOPIC_RETIREMENT_STAGE_COMPLETE",
+}
+
+var TopicRetirementStage_value = map[string]int32{
+	"TOPIC_RETIREMENT_STAGE_UNSPECIFIED":      0,
+	"TOPIC_RETIREMENT_STAGE_REFUNDING_STAKE":  1,
+	"TOPIC_RETIREMENT_STAGE_AWAITING_REFUNDS": 2,
+	"TOPIC_RETIREMENT_STAGE_CLEARING_STATE":   3,
+	"TOPIC_RETIREMENT_STAGE_COMPLETE":         4,
+}
+
+func (x TopicRetirementStage) String() string {
+	return proto.EnumName(TopicRetirementStage_name, int32(x))
+}
+
+func (TopicRetirementStage) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6320c39e204beb2b, []int{0}
+}
+
 type Topic struct {
 	Id                     uint64                                          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Creator                string                                          `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
@@ -44,6 +82,16 @@ type Topic struct {
 	ActiveInfererQuantile    github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,19,opt,name=active_inferer_quantile,json=activeInfererQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"active_inferer_quantile"`
 	ActiveForecasterQuantile github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,20,opt,name=active_forecaster_quantile,json=activeForecasterQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"active_forecaster_quantile"`
 	ActiveReputerQuantile    github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,21,opt,name=active_reputer_quantile,json=activeReputerQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"active_reputer_quantile"`
+	// Blocks following the worker submission window during which workers reveal
+	// the payloads they committed to in it. Zero disables commit-reveal, workers
+	// then submit their payloads in plaintext.
+	WorkerRevealWindow int64 `protobuf:"varint,22,opt,name=worker_reveal_window,json=workerRevealWindow,proto3" json:"worker_reveal_window,omitempty"`
+	// Number of values making up each inference of the topic. Zero and one
+	// denote scalar inferences.
+	ValueDimension uint64 `protobuf:"varint,23,opt,name=value_dimension,json=valueDimension,proto3" json:"value_dimension,omitempty"`
+	// Whether the network inferences of each nonce of the topic are archived so
+	// they remain queryable after their inputs are pruned
+	ArchiveNetworkInferences bool `protobuf:"varint,24,opt,name=archive_network_inferences,json=archiveNetworkInferences,proto3" json:"archive_network_inferences,omitempty"`
 }
 
 func (m *Topic) Reset()         { *m = Topic{} }
@@ -142,6 +190,27 @@ func (m *Topic) GetWorkerSubmissionWindow() int64 {
 	return 0
 }
 
+func (m *Topic) GetWorkerRevealWindow() int64 {
+	if m != nil {
+		return m.WorkerRevealWindow
+	}
+	return 0
+}
+
+func (m *Topic) GetValueDimension() uint64 {
+	if m != nil {
+		return m.ValueDimension
+	}
+	return 0
+}
+
+func (m *Topic) GetArchiveNetworkInferences() bool {
+	if m != nil {
+		return m.ArchiveNetworkInferences
+	}
+	return false
+}
+
 type TopicList struct {
 	Topics []*Topic `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
 }
@@ -327,67 +396,392 @@ func (m *TopicIdWeightPair) GetTopicId() uint64 {
 	return 0
 }
 
+// Subset of topic fields that may be changed after creation via UpdateTopic.
+// Uses the same single-element repeated field convention as OptionalParams:
+// an empty field means the value is left unchanged.
+type OptionalTopicParams struct {
+	EpochLength              []int64                                           `protobuf:"varint,1,rep,packed,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	GroundTruthLag           []int64                                           `protobuf:"varint,2,rep,packed,name=ground_truth_lag,json=groundTruthLag,proto3" json:"ground_truth_lag,omitempty"`
+	WorkerSubmissionWindow   []int64                                           `protobuf:"varint,3,rep,packed,name=worker_submission_window,json=workerSubmissionWindow,proto3" json:"worker_submission_window,omitempty"`
+	PNorm                    []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,4,rep,name=p_norm,json=pNorm,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_norm"`
+	AlphaRegret              []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,5,rep,name=alpha_regret,json=alphaRegret,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"alpha_regret"`
+	Epsilon                  []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,6,rep,name=epsilon,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"epsilon"`
+	MeritSortitionAlpha      []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,7,rep,name=merit_sortition_alpha,json=meritSortitionAlpha,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"merit_sortition_alpha"`
+	ActiveInfererQuantile    []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,8,rep,name=active_inferer_quantile,json=activeInfererQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"active_inferer_quantile"`
+	ActiveForecasterQuantile []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,9,rep,name=active_forecaster_quantile,json=activeForecasterQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"active_forecaster_quantile"`
+	ActiveReputerQuantile    []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,10,rep,name=active_reputer_quantile,json=activeReputerQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"active_reputer_quantile"`
+	WorkerRevealWindow       []int64                                           `protobuf:"varint,11,rep,packed,name=worker_reveal_window,json=workerRevealWindow,proto3" json:"worker_reveal_window,omitempty"`
+	ArchiveNetworkInferences []bool                                            `protobuf:"varint,12,rep,packed,name=archive_network_inferences,json=archiveNetworkInferences,proto3" json:"archive_network_inferences,omitempty"`
+}
+
+func (m *OptionalTopicParams) Reset()         { *m = OptionalTopicParams{} }
+func (m *OptionalTopicParams) String() string { return proto.CompactTextString(m) }
+func (*OptionalTopicParams) ProtoMessage()    {}
+func (*OptionalTopicParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6320c39e204beb2b, []int{5}
+}
+func (m *OptionalTopicParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OptionalTopicParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OptionalTopicParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OptionalTopicParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptionalTopicParams.Merge(m, src)
+}
+func (m *OptionalTopicParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *OptionalTopicParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptionalTopicParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OptionalTopicParams proto.InternalMessageInfo
+
+func (m *OptionalTopicParams) GetEpochLength() []int64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return nil
+}
+
+func (m *OptionalTopicParams) GetGroundTruthLag() []int64 {
+	if m != nil {
+		return m.GroundTruthLag
+	}
+	return nil
+}
+
+func (m *OptionalTopicParams) GetWorkerSubmissionWindow() []int64 {
+	if m != nil {
+		return m.WorkerSubmissionWindow
+	}
+	return nil
+}
+
+func (m *OptionalTopicParams) GetWorkerRevealWindow() []int64 {
+	if m != nil {
+		return m.WorkerRevealWindow
+	}
+	return nil
+}
+
+func (m *OptionalTopicParams) GetArchiveNetworkInferences() []bool {
+	if m != nil {
+		return m.ArchiveNetworkInferences
+	}
+	return nil
+}
+
+// A topic update waiting for the topic's next epoch boundary
+type ScheduledTopicUpdate struct {
+	TopicId              uint64               `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	EffectiveBlockHeight int64                `protobuf:"varint,2,opt,name=effective_block_height,json=effectiveBlockHeight,proto3" json:"effective_block_height,omitempty"`
+	Params               *OptionalTopicParams `protobuf:"bytes,3,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *ScheduledTopicUpdate) Reset()         { *m = ScheduledTopicUpdate{} }
+func (m *ScheduledTopicUpdate) String() string { return proto.CompactTextString(m) }
+func (*ScheduledTopicUpdate) ProtoMessage()    {}
+func (*ScheduledTopicUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6320c39e204beb2b, []int{6}
+}
+func (m *ScheduledTopicUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledTopicUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledTopicUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledTopicUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTopicUpdate.Merge(m, src)
+}
+func (m *ScheduledTopicUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledTopicUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTopicUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledTopicUpdate proto.InternalMessageInfo
+
+func (m *ScheduledTopicUpdate) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *ScheduledTopicUpdate) GetEffectiveBlockHeight() int64 {
+	if m != nil {
+		return m.EffectiveBlockHeight
+	}
+	return 0
+}
+
+func (m *ScheduledTopicUpdate) GetParams() *OptionalTopicParams {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+// Archive and garbage collection progress of a retired topic
+type TopicRetirement struct {
+	// The topic as it was at the time it was retired
+	Topic          *Topic               `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	RetiredBy      string               `protobuf:"bytes,2,opt,name=retired_by,json=retiredBy,proto3" json:"retired_by,omitempty"`
+	RetiredAtBlock int64                `protobuf:"varint,3,opt,name=retired_at_block,json=retiredAtBlock,proto3" json:"retired_at_block,omitempty"`
+	Stage          TopicRetirementStage `protobuf:"varint,4,opt,name=stage,proto3,enum=emissions.v3.TopicRetirementStage" json:"stage,omitempty"`
+	// Last reputer whose stake removal was queued
+	LastRefundedReputer string `protobuf:"bytes,5,opt,name=last_refunded_reputer,json=lastRefundedReputer,proto3" json:"last_refunded_reputer,omitempty"`
+	// Last (delegator, reputer) pair whose delegate stake removal was queued
+	LastRefundedDelegator        string `protobuf:"bytes,6,opt,name=last_refunded_delegator,json=lastRefundedDelegator,proto3" json:"last_refunded_delegator,omitempty"`
+	LastRefundedDelegatorReputer string `protobuf:"bytes,7,opt,name=last_refunded_delegator_reputer,json=lastRefundedDelegatorReputer,proto3" json:"last_refunded_delegator_reputer,omitempty"`
+	ReputerRefundsQueued         bool   `protobuf:"varint,8,opt,name=reputer_refunds_queued,json=reputerRefundsQueued,proto3" json:"reputer_refunds_queued,omitempty"`
+	// Block at which the last queued stake removal completes
+	RefundsCompleteBlock int64 `protobuf:"varint,9,opt,name=refunds_complete_block,json=refundsCompleteBlock,proto3" json:"refunds_complete_block,omitempty"`
+	// Index into the list of topic-scoped stores being cleared
+	StoreIndex       uint64 `protobuf:"varint,10,opt,name=store_index,json=storeIndex,proto3" json:"store_index,omitempty"`
+	KeysDeleted      uint64 `protobuf:"varint,11,opt,name=keys_deleted,json=keysDeleted,proto3" json:"keys_deleted,omitempty"`
+	CompletedAtBlock int64  `protobuf:"varint,12,opt,name=completed_at_block,json=completedAtBlock,proto3" json:"completed_at_block,omitempty"`
+}
+
+func (m *TopicRetirement) Reset()         { *m = TopicRetirement{} }
+func (m *TopicRetirement) String() string { return proto.CompactTextString(m) }
+func (*TopicRetirement) ProtoMessage()    {}
+func (*TopicRetirement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6320c39e204beb2b, []int{7}
+}
+func (m *TopicRetirement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicRetirement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicRetirement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicRetirement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicRetirement.Merge(m, src)
+}
+func (m *TopicRetirement) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicRetirement) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicRetirement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicRetirement proto.InternalMessageInfo
+
+func (m *TopicRetirement) GetTopic() *Topic {
+	if m != nil {
+		return m.Topic
+	}
+	return nil
+}
+
+func (m *TopicRetirement) GetRetiredBy() string {
+	if m != nil {
+		return m.RetiredBy
+	}
+	return ""
+}
+
+func (m *TopicRetirement) GetRetiredAtBlock() int64 {
+	if m != nil {
+		return m.RetiredAtBlock
+	}
+	return 0
+}
+
+func (m *TopicRetirement) GetStage() TopicRetirementStage {
+	if m != nil {
+		return m.Stage
+	}
+	return TopicRetirementStage_TOPIC_RETIREMENT_STAGE_UNSPECIFIED
+}
+
+func (m *TopicRetirement) GetLastRefundedReputer() string {
+	if m != nil {
+		return m.LastRefundedReputer
+	}
+	return ""
+}
+
+func (m *TopicRetirement) GetLastRefundedDelegator() string {
+	if m != nil {
+		return m.LastRefundedDelegator
+	}
+	return ""
+}
+
+func (m *TopicRetirement) GetLastRefundedDelegatorReputer() string {
+	if m != nil {
+		return m.LastRefundedDelegatorReputer
+	}
+	return ""
+}
+
+func (m *TopicRetirement) GetReputerRefundsQueued() bool {
+	if m != nil {
+		return m.ReputerRefundsQueued
+	}
+	return false
+}
+
+func (m *TopicRetirement) GetRefundsCompleteBlock() int64 {
+	if m != nil {
+		return m.RefundsCompleteBlock
+	}
+	return 0
+}
+
+func (m *TopicRetirement) GetStoreIndex() uint64 {
+	if m != nil {
+		return m.StoreIndex
+	}
+	return 0
+}
+
+func (m *TopicRetirement) GetKeysDeleted() uint64 {
+	if m != nil {
+		return m.KeysDeleted
+	}
+	return 0
+}
+
+func (m *TopicRetirement) GetCompletedAtBlock() int64 {
+	if m != nil {
+		return m.CompletedAtBlock
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("emissions.v3.TopicRetirementStage", TopicRetirementStage_name, TopicRetirementStage_value)
 	proto.RegisterType((*Topic)(nil), "emissions.v3.Topic")
 	proto.RegisterType((*TopicList)(nil), "emissions.v3.TopicList")
 	proto.RegisterType((*TimestampedActorNonce)(nil), "emissions.v3.TimestampedActorNonce")
 	proto.RegisterType((*TopicIds)(nil), "emissions.v3.TopicIds")
 	proto.RegisterType((*TopicIdWeightPair)(nil), "emissions.v3.TopicIdWeightPair")
+	proto.RegisterType((*OptionalTopicParams)(nil), "emissions.v3.OptionalTopicParams")
+	proto.RegisterType((*ScheduledTopicUpdate)(nil), "emissions.v3.ScheduledTopicUpdate")
+	proto.RegisterType((*TopicRetirement)(nil), "emissions.v3.TopicRetirement")
 }
 
 func init() { proto.RegisterFile("emissions/v3/topic.proto", fileDescriptor_6320c39e204beb2b) }
 
 var fileDescriptor_6320c39e204beb2b = []byte{
-	// 777 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xc1, 0x8e, 0x1b, 0x35,
-	0x18, 0xc7, 0x77, 0x92, 0x49, 0x32, 0x71, 0xb2, 0xe9, 0xd4, 0xdb, 0x80, 0x09, 0x52, 0x12, 0x22,
-	0x21, 0x05, 0xa1, 0x26, 0x12, 0x7b, 0x60, 0xc5, 0xad, 0x15, 0x20, 0x1a, 0x2d, 0x81, 0x4e, 0x57,
-	0xaa, 0xd4, 0x03, 0x96, 0x33, 0xe3, 0x9d, 0xb1, 0x76, 0xc6, 0x1e, 0x6c, 0xcf, 0xa6, 0x9c, 0x78,
-	0x05, 0x1e, 0x81, 0x37, 0xe1, 0xda, 0x63, 0x8f, 0x88, 0x43, 0x85, 0x76, 0x2f, 0x3c, 0x06, 0xb2,
-	0x3d, 0xc9, 0xb6, 0xe2, 0x82, 0x94, 0xde, 0xc6, 0xff, 0xef, 0xf3, 0xef, 0xff, 0xd9, 0x33, 0xfa,
-	0x0f, 0x40, 0xb4, 0x60, 0x4a, 0x31, 0xc1, 0xd5, 0xf2, 0xfa, 0x74, 0xa9, 0x45, 0xc9, 0xe2, 0x45,
-	0x29, 0x85, 0x16, 0xb0, 0xbf, 0xaf, 0x2c, 0xae, 0x4f, 0x47, 0xef, 0xf6, 0x71, 0xc1, 0x63, 0xea,
-	0xfa, 0x46, 0x0f, 0x52, 0x91, 0x0a, 0xfb, 0xb8, 0x34, 0x4f, 0x4e, 0x9d, 0xfd, 0xd1, 0x05, 0xad,
-	0x0b, 0x43, 0x83, 0x03, 0xd0, 0x60, 0x09, 0xf2, 0xa6, 0xde, 0xdc, 0x8f, 0x1a, 0x2c, 0x81, 0x08,
-	0x74, 0x62, 0x49, 0x89, 0x16, 0x12, 0x35, 0xa6, 0xde, 0xbc, 0x1b, 0xed, 0x96, 0x70, 0x04, 0x82,
-	0x82, 0x6a, 0x92, 0x10, 0x4d, 0x50, 0xd3, 0x96, 0xf6, 0x6b, 0x38, 0x01, 0xbd, 0x5c, 0x28, 0x85,
-	0x0b, 0xaa, 0x33, 0x91, 0xa0, 0x96, 0x2d, 0x03, 0x23, 0x7d, 0x6f, 0x15, 0x38, 0x07, 0x21, 0x2d,
-	0x45, 0x9c, 0xe1, 0x9c, 0x28, 0x8d, 0x29, 0x4f, 0x68, 0x82, 0x82, 0xa9, 0x37, 0x6f, 0x46, 0x03,
-	0xab, 0x9f, 0x13, 0xa5, 0xbf, 0x31, 0x2a, 0xfc, 0x04, 0xf4, 0xeb, 0x4e, 0xca, 0x53, 0x9d, 0xa1,
-	0xae, 0xed, 0xea, 0xb9, 0x2e, 0x2b, 0x19, 0x58, 0x2a, 0x45, 0xc5, 0x13, 0xac, 0x65, 0xa5, 0x0d,
-	0x33, 0x45, 0xc0, 0xc1, 0x9c, 0x7e, 0x61, 0xe4, 0x73, 0x92, 0xc2, 0x35, 0x68, 0x97, 0x98, 0x0b,
-	0x59, 0xa0, 0xbe, 0x19, 0xe9, 0xf1, 0x97, 0xaf, 0xde, 0x4c, 0x8e, 0xfe, 0x7a, 0x33, 0x59, 0xa6,
-	0x4c, 0x67, 0xd5, 0x66, 0x11, 0x8b, 0x62, 0x49, 0xf2, 0x5c, 0x48, 0xf2, 0x90, 0x53, 0xbd, 0x15,
-	0xf2, 0x6a, 0xb7, 0x8c, 0x33, 0xc2, 0xf8, 0xb2, 0x20, 0x3a, 0x5b, 0x7c, 0x4d, 0xe3, 0xa8, 0x55,
-	0xae, 0x85, 0x2c, 0xe0, 0x0b, 0xd0, 0x27, 0x79, 0x99, 0x11, 0x2c, 0x69, 0x2a, 0xa9, 0x46, 0xc7,
-	0x87, 0x51, 0x7b, 0x16, 0x16, 0x59, 0x16, 0xfc, 0x14, 0x0c, 0x4c, 0xd7, 0x16, 0x73, 0x9a, 0x12,
-	0xcd, 0xae, 0x29, 0x1a, 0x4c, 0xbd, 0x79, 0x10, 0x1d, 0x5b, 0x75, 0x5d, 0x8b, 0xf0, 0x29, 0xe8,
-	0xd0, 0x52, 0xb1, 0x5c, 0x70, 0x74, 0xef, 0x30, 0xf7, 0x1d, 0x07, 0xfe, 0x04, 0x06, 0x8c, 0x33,
-	0xcd, 0x48, 0xbe, 0x3b, 0x57, 0x78, 0x18, 0xf9, 0xb8, 0xc6, 0xd5, 0x27, 0x3b, 0x03, 0xc8, 0x34,
-	0x53, 0x89, 0x55, 0xb5, 0xa9, 0x3f, 0x54, 0xbc, 0x65, 0x3c, 0x11, 0x5b, 0x74, 0xdf, 0xbe, 0xb7,
-	0x0f, 0x5c, 0xfd, 0xd9, 0xbe, 0xfc, 0xdc, 0x56, 0xe1, 0x15, 0x18, 0x16, 0x54, 0x32, 0x8d, 0x95,
-	0x90, 0x9a, 0x69, 0xb3, 0xcf, 0x5e, 0x19, 0x82, 0x87, 0x0d, 0x78, 0x62, 0xa9, 0xcf, 0x76, 0xd0,
-	0x47, 0x86, 0x09, 0x05, 0xf8, 0x90, 0xc4, 0xe6, 0x8e, 0x31, 0xe3, 0x97, 0x54, 0x52, 0x89, 0x7f,
-	0xae, 0x08, 0xd7, 0x2c, 0xa7, 0xe8, 0xe4, 0x30, 0xbb, 0xa1, 0xe3, 0x3e, 0x71, 0xd8, 0xa7, 0x35,
-	0x15, 0x56, 0x60, 0x54, 0x1b, 0x5e, 0x0a, 0x49, 0x63, 0xa2, 0xf4, 0xdb, 0x9e, 0x0f, 0x0e, 0xf3,
-	0x44, 0x0e, 0xfd, 0xed, 0x9e, 0xbc, 0xb7, 0xbd, 0x3b, 0xa7, 0xa4, 0x65, 0xf5, 0x8e, 0xe7, 0xf0,
-	0xbd, 0x9c, 0x33, 0x72, 0xd8, 0x9d, 0xe1, 0xca, 0x0f, 0xfc, 0xb0, 0xb5, 0xf2, 0x83, 0x76, 0xd8,
-	0x59, 0xf9, 0x41, 0x27, 0x0c, 0x56, 0x7e, 0xd0, 0x0b, 0xfb, 0x2e, 0x20, 0x70, 0x2e, 0x52, 0x16,
-	0x47, 0xf7, 0xdc, 0x9d, 0xf3, 0x98, 0xd6, 0x42, 0x78, 0x27, 0xb8, 0x54, 0x89, 0x7a, 0x09, 0xbd,
-	0x24, 0x55, 0xae, 0x31, 0x91, 0xe9, 0xec, 0x0c, 0x74, 0x6d, 0x80, 0x9d, 0x33, 0xa5, 0xe1, 0xe7,
-	0xa0, 0x6d, 0xb3, 0x51, 0x21, 0x6f, 0xda, 0x9c, 0xf7, 0xbe, 0x38, 0x59, 0xbc, 0x9d, 0x8e, 0x0b,
-	0xdb, 0x18, 0xd5, 0x2d, 0xb3, 0x97, 0x60, 0x78, 0xc1, 0x0a, 0xaa, 0x34, 0x29, 0x4a, 0x9a, 0x3c,
-	0x8a, 0xb5, 0x90, 0x6b, 0x13, 0x98, 0x26, 0x79, 0x36, 0xb9, 0x88, 0xaf, 0x70, 0x46, 0x59, 0x9a,
-	0x69, 0x1b, 0x8a, 0xcd, 0xa8, 0x67, 0xb5, 0xef, 0xac, 0x04, 0x3f, 0x03, 0x2d, 0x1b, 0xae, 0x36,
-	0x00, 0xff, 0xe3, 0x63, 0x31, 0x91, 0xeb, 0xf8, 0xca, 0xff, 0xe7, 0xf7, 0x89, 0xb7, 0xf2, 0x83,
-	0x46, 0xd8, 0x8c, 0x5a, 0xc4, 0xb8, 0xcc, 0x1e, 0x82, 0xc0, 0x8e, 0xf2, 0x24, 0x51, 0xf0, 0x63,
-	0xd0, 0xb5, 0xf3, 0x60, 0x96, 0xb8, 0xa9, 0xfd, 0x28, 0xd0, 0x75, 0xd1, 0xed, 0x9d, 0xfd, 0x0a,
-	0xee, 0xd7, 0xed, 0xcf, 0xad, 0xfb, 0x8f, 0x84, 0x49, 0xf8, 0x11, 0x08, 0x76, 0xfb, 0xea, 0xd4,
-	0xee, 0xd4, 0xdb, 0xe0, 0x0f, 0xa0, 0xbd, 0x75, 0x93, 0x37, 0x0e, 0x7b, 0x8d, 0x35, 0xe6, 0x71,
-	0xf4, 0xea, 0x66, 0xec, 0xbd, 0xbe, 0x19, 0x7b, 0x7f, 0xdf, 0x8c, 0xbd, 0xdf, 0x6e, 0xc7, 0x47,
-	0xaf, 0x6f, 0xc7, 0x47, 0x7f, 0xde, 0x8e, 0x8f, 0x5e, 0x9c, 0xfd, 0x4f, 0xe4, 0xcb, 0xe5, 0xdd,
-	0x8f, 0x49, 0xff, 0x52, 0x52, 0xb5, 0x69, 0xdb, 0x1f, 0xd0, 0xe9, 0xbf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xe3, 0x0b, 0xb9, 0xfe, 0xda, 0x06, 0x00, 0x00,
+	// 1357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xdf, 0x72, 0xd3, 0xc6,
+	0x17, 0x8e, 0x2c, 0xd9, 0x56, 0xd6, 0xc1, 0x11, 0x1b, 0x27, 0xe8, 0x97, 0x5f, 0x9b, 0x08, 0x77,
+	0x80, 0x00, 0x25, 0xe9, 0x00, 0xd3, 0xa6, 0x9d, 0xde, 0xe4, 0x8f, 0xa0, 0x4e, 0x83, 0x13, 0x64,
+	0x33, 0xcc, 0x70, 0x51, 0xcd, 0x46, 0xda, 0xd8, 0x9a, 0x48, 0x5a, 0xb1, 0x5a, 0x27, 0xe4, 0xaa,
+	0xaf, 0xd0, 0x47, 0xe8, 0xf4, 0x69, 0xb8, 0xa4, 0x77, 0x4c, 0x2f, 0x98, 0x0e, 0x5c, 0xb4, 0x8f,
+	0xd1, 0xd9, 0x3f, 0x72, 0x92, 0x21, 0xce, 0xd0, 0x31, 0xe5, 0xce, 0xfa, 0xce, 0x77, 0xbe, 0x73,
+	0x56, 0xbb, 0xfe, 0x8e, 0x16, 0xd8, 0x38, 0x89, 0xf2, 0x3c, 0x22, 0x69, 0xbe, 0x72, 0x78, 0x6f,
+	0x85, 0x91, 0x2c, 0x0a, 0x96, 0x33, 0x4a, 0x18, 0x81, 0x53, 0xc3, 0xc8, 0xf2, 0xe1, 0xbd, 0xf9,
+	0xb3, 0xbc, 0x94, 0xa4, 0x01, 0x96, 0xbc, 0xf9, 0x46, 0x8f, 0xf4, 0x88, 0xf8, 0xb9, 0xc2, 0x7f,
+	0x49, 0xb4, 0xf9, 0x1a, 0x80, 0x72, 0x97, 0xab, 0xc1, 0x3a, 0x28, 0x45, 0xa1, 0xad, 0x39, 0xda,
+	0x92, 0xe1, 0x95, 0xa2, 0x10, 0xda, 0xa0, 0x1a, 0x50, 0x8c, 0x18, 0xa1, 0x76, 0xc9, 0xd1, 0x96,
+	0x26, 0xbd, 0xe2, 0x11, 0xce, 0x03, 0x33, 0xc1, 0x0c, 0x85, 0x88, 0x21, 0x5b, 0x17, 0xa1, 0xe1,
+	0x33, 0x5c, 0x04, 0xb5, 0x98, 0xe4, 0xb9, 0x9f, 0x60, 0xd6, 0x27, 0xa1, 0x5d, 0x16, 0x61, 0xc0,
+	0xa1, 0x47, 0x02, 0x81, 0x4b, 0xc0, 0xc2, 0x19, 0x09, 0xfa, 0x7e, 0x8c, 0x72, 0xe6, 0xe3, 0x34,
+	0xc4, 0xa1, 0x6d, 0x3a, 0xda, 0x92, 0xee, 0xd5, 0x05, 0xbe, 0x8d, 0x72, 0xe6, 0x72, 0x14, 0x5e,
+	0x05, 0x53, 0x8a, 0x89, 0xd3, 0x1e, 0xeb, 0xdb, 0x93, 0x82, 0x55, 0x93, 0x2c, 0x01, 0x71, 0xb1,
+	0x1e, 0x25, 0x83, 0x34, 0xf4, 0x19, 0x1d, 0x30, 0xae, 0xd9, 0xb3, 0x81, 0x14, 0x93, 0x78, 0x97,
+	0xc3, 0xdb, 0xa8, 0x07, 0xdb, 0xa0, 0x92, 0xf9, 0x29, 0xa1, 0x89, 0x3d, 0xc5, 0x5b, 0x5a, 0xff,
+	0xe6, 0xe5, 0x9b, 0xc5, 0x89, 0x3f, 0xde, 0x2c, 0xae, 0xf4, 0x22, 0xd6, 0x1f, 0xec, 0x2d, 0x07,
+	0x24, 0x59, 0x41, 0x71, 0x4c, 0x28, 0xba, 0x93, 0x62, 0x76, 0x44, 0xe8, 0x41, 0xf1, 0x18, 0xf4,
+	0x51, 0x94, 0xae, 0x24, 0x88, 0xf5, 0x97, 0x37, 0x71, 0xe0, 0x95, 0xb3, 0x36, 0xa1, 0x09, 0x7c,
+	0x06, 0xa6, 0x50, 0x9c, 0xf5, 0x91, 0x4f, 0x71, 0x8f, 0x62, 0x66, 0x5f, 0x1a, 0x4f, 0xb5, 0x26,
+	0xc4, 0x3c, 0xa1, 0x05, 0xaf, 0x81, 0x3a, 0x67, 0x1d, 0xf9, 0x29, 0xee, 0x21, 0x16, 0x1d, 0x62,
+	0xbb, 0xee, 0x68, 0x4b, 0xa6, 0x77, 0x49, 0xa0, 0x6d, 0x05, 0xc2, 0xc7, 0xa0, 0x8a, 0xb3, 0x3c,
+	0x8a, 0x49, 0x6a, 0x4f, 0x8f, 0x57, 0xbd, 0xd0, 0x81, 0x3f, 0x81, 0x7a, 0x94, 0x46, 0x2c, 0x42,
+	0x71, 0xb1, 0x2e, 0x6b, 0x3c, 0xe5, 0x4b, 0x4a, 0x4e, 0xad, 0x6c, 0x15, 0xd8, 0x9c, 0x8c, 0xa9,
+	0x9f, 0x0f, 0xf6, 0xd4, 0x41, 0xf5, 0x8f, 0xa2, 0x34, 0x24, 0x47, 0xf6, 0x65, 0xb1, 0x6f, 0x73,
+	0x32, 0xde, 0x19, 0x86, 0x9f, 0x8a, 0x28, 0x3c, 0x00, 0xb3, 0x09, 0xa6, 0x11, 0xf3, 0x73, 0x42,
+	0x59, 0xc4, 0x78, 0x9e, 0x78, 0x65, 0x36, 0x1c, 0xaf, 0xc1, 0x19, 0xa1, 0xda, 0x29, 0x44, 0xd7,
+	0xb8, 0x26, 0x24, 0xe0, 0x0a, 0x0a, 0xf8, 0x3b, 0xf6, 0xa3, 0x74, 0x1f, 0x53, 0x4c, 0xfd, 0xe7,
+	0x03, 0x94, 0xb2, 0x28, 0xc6, 0xf6, 0xcc, 0x78, 0xe5, 0x66, 0xa5, 0x6e, 0x4b, 0xca, 0x3e, 0x56,
+	0xaa, 0x70, 0x00, 0xe6, 0x55, 0xc1, 0x7d, 0x42, 0x71, 0x80, 0x72, 0x76, 0xba, 0x66, 0x63, 0xbc,
+	0x9a, 0xb6, 0x94, 0x7e, 0x30, 0x54, 0x1e, 0x96, 0x3d, 0x59, 0x27, 0xc5, 0xd9, 0xe0, 0x4c, 0xcd,
+	0xd9, 0x8f, 0xb2, 0x4e, 0x4f, 0xca, 0x0e, 0x0b, 0x7e, 0x05, 0x1a, 0x6a, 0xff, 0x29, 0x3e, 0xc4,
+	0x28, 0x2e, 0xf6, 0x7e, 0x4e, 0xec, 0x3d, 0x94, 0x31, 0x4f, 0x84, 0xd4, 0xbe, 0xdf, 0x00, 0xd3,
+	0x87, 0x28, 0x1e, 0x60, 0x3f, 0x8c, 0x12, 0x9c, 0xf2, 0x03, 0x61, 0x5f, 0x11, 0x16, 0x55, 0x17,
+	0xf0, 0x66, 0x81, 0xc2, 0xef, 0xc1, 0x3c, 0xa2, 0x41, 0x9f, 0x2f, 0x46, 0xb5, 0xa6, 0x36, 0x2f,
+	0x0d, 0x70, 0x6e, 0xdb, 0xe2, 0x0f, 0x64, 0x2b, 0x46, 0x5b, 0x12, 0x5a, 0xc3, 0xf8, 0x96, 0x61,
+	0x1a, 0x56, 0x79, 0xcb, 0x30, 0x2b, 0x56, 0x75, 0xcb, 0x30, 0xab, 0x96, 0xb9, 0x65, 0x98, 0x35,
+	0x6b, 0x4a, 0x3a, 0x97, 0x1f, 0x93, 0x5e, 0x14, 0x78, 0xd3, 0x43, 0x3d, 0x05, 0x58, 0x27, 0x80,
+	0xb4, 0x3b, 0xaf, 0x16, 0xe2, 0x7d, 0x34, 0x88, 0x99, 0x8f, 0x68, 0xaf, 0xb9, 0x0a, 0x26, 0x85,
+	0xb3, 0x6e, 0x47, 0x39, 0x83, 0xb7, 0x41, 0x45, 0x98, 0x76, 0x6e, 0x6b, 0x8e, 0xbe, 0x54, 0xbb,
+	0x3b, 0xb3, 0x7c, 0xda, 0xb6, 0x97, 0x05, 0xd1, 0x53, 0x94, 0xe6, 0x0b, 0x30, 0xdb, 0x8d, 0x12,
+	0x9c, 0x33, 0x94, 0x64, 0x38, 0x5c, 0x0b, 0x18, 0xa1, 0x6d, 0xee, 0xe4, 0xdc, 0x12, 0xf7, 0x62,
+	0x12, 0x1c, 0xf8, 0x7d, 0x1c, 0xf5, 0xfa, 0x4c, 0xb8, 0xb5, 0xee, 0xd5, 0x04, 0xf6, 0x83, 0x80,
+	0xe0, 0x4d, 0x50, 0x16, 0xae, 0x2f, 0x9c, 0xf9, 0xbd, 0x3a, 0x42, 0xc6, 0x93, 0x8c, 0xef, 0x8c,
+	0xbf, 0x7f, 0x5d, 0xd4, 0xb6, 0x0c, 0xb3, 0x64, 0xe9, 0x5e, 0x19, 0xf1, 0x2a, 0xcd, 0x3b, 0xc0,
+	0x14, 0xad, 0xb4, 0xc2, 0x1c, 0xfe, 0x1f, 0x4c, 0x8a, 0x7e, 0xfc, 0x28, 0x94, 0x5d, 0x1b, 0x9e,
+	0xc9, 0x54, 0x50, 0xe6, 0x36, 0x7f, 0x06, 0x97, 0x15, 0xfd, 0xa9, 0xa8, 0xbe, 0x8b, 0x22, 0x0a,
+	0xff, 0x07, 0xcc, 0x22, 0x4f, 0x8d, 0x93, 0xaa, 0x4a, 0x83, 0x3b, 0xa0, 0x72, 0x24, 0x3b, 0x2f,
+	0x8d, 0x77, 0xbe, 0x94, 0x4c, 0xf3, 0xf7, 0x2a, 0x98, 0xd9, 0xc9, 0xf8, 0x3f, 0x17, 0xc5, 0xa2,
+	0x93, 0x5d, 0x44, 0x51, 0x92, 0xbf, 0x37, 0x3b, 0x78, 0xfb, 0x1f, 0x30, 0x3b, 0x4a, 0x8e, 0x7e,
+	0xce, 0xec, 0xb8, 0xc8, 0xb5, 0x74, 0x47, 0xbf, 0xc0, 0xb5, 0x4e, 0xa6, 0x8e, 0xe1, 0xe8, 0xff,
+	0xc1, 0xd4, 0x29, 0x3b, 0xfa, 0x47, 0x9b, 0x3a, 0xa7, 0xc6, 0x49, 0x65, 0x3c, 0xd9, 0xe1, 0x38,
+	0x19, 0x69, 0xda, 0x55, 0x47, 0xff, 0x94, 0xa6, 0x6d, 0x3a, 0xfa, 0x47, 0x30, 0xb3, 0x7f, 0x67,
+	0xda, 0x93, 0x8e, 0xfe, 0xc9, 0x4d, 0x1b, 0x38, 0xfa, 0x27, 0x34, 0xed, 0x9a, 0xa3, 0x8f, 0x30,
+	0xed, 0x8b, 0xbd, 0x78, 0xca, 0xd1, 0x2f, 0xf2, 0xe2, 0xe6, 0x6f, 0x1a, 0x68, 0x74, 0x82, 0x3e,
+	0x0e, 0x07, 0x31, 0x0e, 0xc5, 0x9f, 0xfa, 0x49, 0x16, 0x22, 0x86, 0x2f, 0x32, 0x96, 0xfb, 0x60,
+	0x0e, 0xef, 0xef, 0x63, 0xf9, 0x5e, 0xce, 0x58, 0x64, 0x49, 0x58, 0x64, 0x63, 0x18, 0x5d, 0x3f,
+	0xe5, 0x95, 0xdf, 0x82, 0x4a, 0x26, 0xfc, 0x42, 0x99, 0xe5, 0xd5, 0xb3, 0x66, 0x79, 0x8e, 0xb1,
+	0x78, 0x2a, 0xa1, 0xf9, 0xca, 0x00, 0xd3, 0x02, 0xf7, 0x30, 0x8b, 0x28, 0x4e, 0x70, 0x2a, 0xac,
+	0x57, 0xf4, 0x63, 0x6b, 0xe7, 0x59, 0xaf, 0x64, 0x4b, 0x06, 0xfc, 0x1c, 0x00, 0x2a, 0x12, 0x43,
+	0x7f, 0xef, 0x58, 0x7d, 0x5f, 0x4f, 0x2a, 0x64, 0xfd, 0x98, 0x7b, 0x53, 0x11, 0x46, 0x4c, 0xae,
+	0x47, 0xb4, 0xa8, 0x7b, 0x75, 0x85, 0xaf, 0x31, 0xb1, 0x10, 0xb8, 0x0a, 0xca, 0x39, 0x43, 0x3d,
+	0x6c, 0x1b, 0x8e, 0xb6, 0x54, 0xbf, 0xdb, 0x3c, 0xaf, 0xe6, 0xb0, 0xc3, 0x0e, 0x67, 0x7a, 0x32,
+	0x01, 0xde, 0x05, 0xb3, 0xe2, 0x13, 0x9c, 0xe2, 0xfd, 0x01, 0xff, 0xde, 0x2e, 0x8e, 0x93, 0xfa,
+	0x66, 0x9f, 0xe1, 0x41, 0x4f, 0xc5, 0xd4, 0x91, 0x80, 0x5f, 0x83, 0x2b, 0x67, 0x73, 0x42, 0x1c,
+	0xf3, 0xcf, 0x51, 0x42, 0xed, 0x8a, 0xc8, 0x9a, 0x3d, 0x9d, 0xb5, 0x59, 0x04, 0xa1, 0x0b, 0x16,
+	0x47, 0xe4, 0x0d, 0xab, 0x56, 0x45, 0xfe, 0x67, 0xe7, 0xe6, 0x17, 0xe5, 0xef, 0x83, 0xb9, 0xe2,
+	0xcc, 0x4b, 0xa5, 0xdc, 0x7f, 0x3e, 0xc0, 0x03, 0x75, 0x83, 0x30, 0xbd, 0x86, 0x8a, 0x4a, 0x81,
+	0xfc, 0xb1, 0x88, 0xc9, 0x2c, 0xc9, 0x0e, 0x48, 0x92, 0xc5, 0x98, 0xa9, 0x23, 0xa2, 0x6e, 0x14,
+	0x0d, 0x15, 0xdd, 0x50, 0x41, 0xf9, 0x62, 0x17, 0x41, 0x2d, 0x67, 0x84, 0x72, 0x37, 0x09, 0xf1,
+	0x0b, 0x71, 0xab, 0x30, 0x3c, 0x20, 0xa0, 0x16, 0x47, 0xf8, 0x88, 0x39, 0xc0, 0xc7, 0xb9, 0x58,
+	0x0a, 0xc3, 0xa1, 0x5d, 0x13, 0x8c, 0x1a, 0xc7, 0x36, 0x25, 0x04, 0xbf, 0x04, 0xb0, 0xa8, 0x78,
+	0x6a, 0x23, 0xa7, 0x44, 0x55, 0x6b, 0x18, 0x51, 0x5b, 0x79, 0xeb, 0x2f, 0x0d, 0x34, 0xce, 0xdb,
+	0x30, 0x78, 0x1d, 0x34, 0xbb, 0x3b, 0xbb, 0xad, 0x0d, 0xdf, 0x73, 0xbb, 0x2d, 0xcf, 0x7d, 0xe4,
+	0xb6, 0xbb, 0x7e, 0xa7, 0xbb, 0xf6, 0xd0, 0xf5, 0x9f, 0xb4, 0x3b, 0xbb, 0xee, 0x46, 0xeb, 0x41,
+	0xcb, 0xdd, 0xb4, 0x26, 0xe0, 0x2d, 0x70, 0x7d, 0x04, 0xcf, 0x73, 0x1f, 0x3c, 0x69, 0x6f, 0xb6,
+	0xda, 0x0f, 0xf9, 0xf3, 0x8f, 0xae, 0xa5, 0xc1, 0xdb, 0xe0, 0xc6, 0x08, 0xee, 0xda, 0xd3, 0xb5,
+	0x56, 0x97, 0x53, 0x65, 0x52, 0xc7, 0x2a, 0xc1, 0x9b, 0xe0, 0xda, 0x08, 0xf2, 0xc6, 0xb6, 0xbb,
+	0xe6, 0x29, 0xdd, 0xae, 0x6b, 0xe9, 0xf0, 0x0b, 0xb0, 0x38, 0x8a, 0xba, 0xf3, 0x68, 0x77, 0xdb,
+	0xed, 0xba, 0x96, 0xb1, 0xee, 0xbd, 0x7c, 0xbb, 0xa0, 0xbd, 0x7a, 0xbb, 0xa0, 0xfd, 0xf9, 0x76,
+	0x41, 0xfb, 0xe5, 0xdd, 0xc2, 0xc4, 0xab, 0x77, 0x0b, 0x13, 0xaf, 0xdf, 0x2d, 0x4c, 0x3c, 0x5b,
+	0xfd, 0x40, 0xcf, 0x7a, 0xb1, 0x72, 0x72, 0xcf, 0x65, 0xc7, 0x19, 0xce, 0xf7, 0x2a, 0xe2, 0x3e,
+	0x7b, 0xef, 0x9f, 0x01, 0x00, 0xac, 0x77, 0x95, 0x80, 0x29, 0x0f, 0x00, 0x00,
 }
 
 func (this *TimestampedActorNonce) Equal(that interface{}) bool {
@@ -466,6 +860,32 @@ func (m *Topic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ArchiveNetworkInferences {
+		i--
+		if m.ArchiveNetworkInferences {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.ValueDimension != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.ValueDimension))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.WorkerRevealWindow != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.WorkerRevealWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
 	{
 		size := m.ActiveReputerQuantile.Size()
 		i -= size
@@ -773,35 +1193,393 @@ func (m *TopicIdWeightPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTopic(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTopic(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *OptionalTopicParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Topic) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *OptionalTopicParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptionalTopicParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTopic(uint64(m.Id))
+	if len(m.ArchiveNetworkInferences) > 0 {
+		for iNdEx := len(m.ArchiveNetworkInferences) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.ArchiveNetworkInferences[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(len(m.ArchiveNetworkInferences)))
+		i--
+		dAtA[i] = 0x62
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTopic(uint64(l))
+	if len(m.WorkerRevealWindow) > 0 {
+		dAtA5 := make([]byte, len(m.WorkerRevealWindow)*10)
+		var j4 int
+		for _, num1 := range m.WorkerRevealWindow {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintTopic(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x5a
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTopic(uint64(l))
+	if len(m.ActiveReputerQuantile) > 0 {
+		for iNdEx := len(m.ActiveReputerQuantile) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ActiveReputerQuantile[iNdEx].Size()
+				i -= size
+				if _, err := m.ActiveReputerQuantile[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	l = len(m.LossMethod)
+	if len(m.ActiveForecasterQuantile) > 0 {
+		for iNdEx := len(m.ActiveForecasterQuantile) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ActiveForecasterQuantile[iNdEx].Size()
+				i -= size
+				if _, err := m.ActiveForecasterQuantile[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ActiveInfererQuantile) > 0 {
+		for iNdEx := len(m.ActiveInfererQuantile) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ActiveInfererQuantile[iNdEx].Size()
+				i -= size
+				if _, err := m.ActiveInfererQuantile[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.MeritSortitionAlpha) > 0 {
+		for iNdEx := len(m.MeritSortitionAlpha) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MeritSortitionAlpha[iNdEx].Size()
+				i -= size
+				if _, err := m.MeritSortitionAlpha[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Epsilon) > 0 {
+		for iNdEx := len(m.Epsilon) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Epsilon[iNdEx].Size()
+				i -= size
+				if _, err := m.Epsilon[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AlphaRegret) > 0 {
+		for iNdEx := len(m.AlphaRegret) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.AlphaRegret[iNdEx].Size()
+				i -= size
+				if _, err := m.AlphaRegret[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PNorm) > 0 {
+		for iNdEx := len(m.PNorm) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PNorm[iNdEx].Size()
+				i -= size
+				if _, err := m.PNorm[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.WorkerSubmissionWindow) > 0 {
+		dAtA7 := make([]byte, len(m.WorkerSubmissionWindow)*10)
+		var j6 int
+		for _, num1 := range m.WorkerSubmissionWindow {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintTopic(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GroundTruthLag) > 0 {
+		dAtA9 := make([]byte, len(m.GroundTruthLag)*10)
+		var j8 int
+		for _, num1 := range m.GroundTruthLag {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintTopic(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EpochLength) > 0 {
+		dAtA11 := make([]byte, len(m.EpochLength)*10)
+		var j10 int
+		for _, num1 := range m.EpochLength {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintTopic(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledTopicUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledTopicUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTopicUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTopic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EffectiveBlockHeight != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.EffectiveBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicRetirement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicRetirement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicRetirement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletedAtBlock != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.CompletedAtBlock))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.KeysDeleted != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.KeysDeleted))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.StoreIndex != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.StoreIndex))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.RefundsCompleteBlock != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.RefundsCompleteBlock))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ReputerRefundsQueued {
+		i--
+		if m.ReputerRefundsQueued {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.LastRefundedDelegatorReputer) > 0 {
+		i -= len(m.LastRefundedDelegatorReputer)
+		copy(dAtA[i:], m.LastRefundedDelegatorReputer)
+		i = encodeVarintTopic(dAtA, i, uint64(len(m.LastRefundedDelegatorReputer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.LastRefundedDelegator) > 0 {
+		i -= len(m.LastRefundedDelegator)
+		copy(dAtA[i:], m.LastRefundedDelegator)
+		i = encodeVarintTopic(dAtA, i, uint64(len(m.LastRefundedDelegator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LastRefundedReputer) > 0 {
+		i -= len(m.LastRefundedReputer)
+		copy(dAtA[i:], m.LastRefundedReputer)
+		i = encodeVarintTopic(dAtA, i, uint64(len(m.LastRefundedReputer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Stage != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.Stage))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RetiredAtBlock != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.RetiredAtBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RetiredBy) > 0 {
+		i -= len(m.RetiredBy)
+		copy(dAtA[i:], m.RetiredBy)
+		i = encodeVarintTopic(dAtA, i, uint64(len(m.RetiredBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Topic != nil {
+		{
+			size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTopic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTopic(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTopic(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Topic) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTopic(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	l = len(m.LossMethod)
 	if l > 0 {
 		n += 1 + l + sovTopic(uint64(l))
 	}
@@ -836,6 +1614,15 @@ func (m *Topic) Size() (n int) {
 	n += 2 + l + sovTopic(uint64(l))
 	l = m.ActiveReputerQuantile.Size()
 	n += 2 + l + sovTopic(uint64(l))
+	if m.WorkerRevealWindow != 0 {
+		n += 2 + sovTopic(uint64(m.WorkerRevealWindow))
+	}
+	if m.ValueDimension != 0 {
+		n += 2 + sovTopic(uint64(m.ValueDimension))
+	}
+	if m.ArchiveNetworkInferences {
+		n += 3
+	}
 	return n
 }
 
@@ -900,41 +1687,192 @@ func (m *TopicIdWeightPair) Size() (n int) {
 	return n
 }
 
-func sovTopic(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTopic(x uint64) (n int) {
-	return sovTopic(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Topic) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTopic
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+func (m *OptionalTopicParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EpochLength) > 0 {
+		l = 0
+		for _, e := range m.EpochLength {
+			l += sovTopic(uint64(e))
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Topic: wiretype end group for non-group")
+		n += 1 + sovTopic(uint64(l)) + l
+	}
+	if len(m.GroundTruthLag) > 0 {
+		l = 0
+		for _, e := range m.GroundTruthLag {
+			l += sovTopic(uint64(e))
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Topic: illegal tag %d (wire type %d)", fieldNum, wire)
+		n += 1 + sovTopic(uint64(l)) + l
+	}
+	if len(m.WorkerSubmissionWindow) > 0 {
+		l = 0
+		for _, e := range m.WorkerSubmissionWindow {
+			l += sovTopic(uint64(e))
 		}
-		switch fieldNum {
+		n += 1 + sovTopic(uint64(l)) + l
+	}
+	if len(m.PNorm) > 0 {
+		for _, e := range m.PNorm {
+			l = e.Size()
+			n += 1 + l + sovTopic(uint64(l))
+		}
+	}
+	if len(m.AlphaRegret) > 0 {
+		for _, e := range m.AlphaRegret {
+			l = e.Size()
+			n += 1 + l + sovTopic(uint64(l))
+		}
+	}
+	if len(m.Epsilon) > 0 {
+		for _, e := range m.Epsilon {
+			l = e.Size()
+			n += 1 + l + sovTopic(uint64(l))
+		}
+	}
+	if len(m.MeritSortitionAlpha) > 0 {
+		for _, e := range m.MeritSortitionAlpha {
+			l = e.Size()
+			n += 1 + l + sovTopic(uint64(l))
+		}
+	}
+	if len(m.ActiveInfererQuantile) > 0 {
+		for _, e := range m.ActiveInfererQuantile {
+			l = e.Size()
+			n += 1 + l + sovTopic(uint64(l))
+		}
+	}
+	if len(m.ActiveForecasterQuantile) > 0 {
+		for _, e := range m.ActiveForecasterQuantile {
+			l = e.Size()
+			n += 1 + l + sovTopic(uint64(l))
+		}
+	}
+	if len(m.ActiveReputerQuantile) > 0 {
+		for _, e := range m.ActiveReputerQuantile {
+			l = e.Size()
+			n += 1 + l + sovTopic(uint64(l))
+		}
+	}
+	if len(m.WorkerRevealWindow) > 0 {
+		l = 0
+		for _, e := range m.WorkerRevealWindow {
+			l += sovTopic(uint64(e))
+		}
+		n += 1 + sovTopic(uint64(l)) + l
+	}
+	if len(m.ArchiveNetworkInferences) > 0 {
+		n += 1 + sovTopic(uint64(len(m.ArchiveNetworkInferences))) + len(m.ArchiveNetworkInferences)*1
+	}
+	return n
+}
+
+func (m *ScheduledTopicUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovTopic(uint64(m.TopicId))
+	}
+	if m.EffectiveBlockHeight != 0 {
+		n += 1 + sovTopic(uint64(m.EffectiveBlockHeight))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicRetirement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Topic != nil {
+		l = m.Topic.Size()
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	l = len(m.RetiredBy)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	if m.RetiredAtBlock != 0 {
+		n += 1 + sovTopic(uint64(m.RetiredAtBlock))
+	}
+	if m.Stage != 0 {
+		n += 1 + sovTopic(uint64(m.Stage))
+	}
+	l = len(m.LastRefundedReputer)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	l = len(m.LastRefundedDelegator)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	l = len(m.LastRefundedDelegatorReputer)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	if m.ReputerRefundsQueued {
+		n += 2
+	}
+	if m.RefundsCompleteBlock != 0 {
+		n += 1 + sovTopic(uint64(m.RefundsCompleteBlock))
+	}
+	if m.StoreIndex != 0 {
+		n += 1 + sovTopic(uint64(m.StoreIndex))
+	}
+	if m.KeysDeleted != 0 {
+		n += 1 + sovTopic(uint64(m.KeysDeleted))
+	}
+	if m.CompletedAtBlock != 0 {
+		n += 1 + sovTopic(uint64(m.CompletedAtBlock))
+	}
+	return n
+}
+
+func sovTopic(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTopic(x uint64) (n int) {
+	return sovTopic(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Topic) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Topic: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Topic: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
@@ -1418,6 +2356,64 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerRevealWindow", wireType)
+			}
+			m.WorkerRevealWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkerRevealWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueDimension", wireType)
+			}
+			m.ValueDimension = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValueDimension |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveNetworkInferences", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ArchiveNetworkInferences = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTopic(dAtA[iNdEx:])
@@ -1857,6 +2853,1154 @@ func (m *TopicIdWeightPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *OptionalTopicParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptionalTopicParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptionalTopicParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.EpochLength = append(m.EpochLength, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTopic
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTopic
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.EpochLength) == 0 {
+					m.EpochLength = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTopic
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.EpochLength = append(m.EpochLength, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GroundTruthLag = append(m.GroundTruthLag, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTopic
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTopic
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GroundTruthLag) == 0 {
+					m.GroundTruthLag = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTopic
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GroundTruthLag = append(m.GroundTruthLag, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroundTruthLag", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.WorkerSubmissionWindow = append(m.WorkerSubmissionWindow, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTopic
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTopic
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.WorkerSubmissionWindow) == 0 {
+					m.WorkerSubmissionWindow = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTopic
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.WorkerSubmissionWindow = append(m.WorkerSubmissionWindow, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerSubmissionWindow", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PNorm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.PNorm = append(m.PNorm, v)
+			if err := m.PNorm[len(m.PNorm)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlphaRegret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.AlphaRegret = append(m.AlphaRegret, v)
+			if err := m.AlphaRegret[len(m.AlphaRegret)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epsilon", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Epsilon = append(m.Epsilon, v)
+			if err := m.Epsilon[len(m.Epsilon)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MeritSortitionAlpha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.MeritSortitionAlpha = append(m.MeritSortitionAlpha, v)
+			if err := m.MeritSortitionAlpha[len(m.MeritSortitionAlpha)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveInfererQuantile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.ActiveInfererQuantile = append(m.ActiveInfererQuantile, v)
+			if err := m.ActiveInfererQuantile[len(m.ActiveInfererQuantile)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveForecasterQuantile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.ActiveForecasterQuantile = append(m.ActiveForecasterQuantile, v)
+			if err := m.ActiveForecasterQuantile[len(m.ActiveForecasterQuantile)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveReputerQuantile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.ActiveReputerQuantile = append(m.ActiveReputerQuantile, v)
+			if err := m.ActiveReputerQuantile[len(m.ActiveReputerQuantile)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.WorkerRevealWindow = append(m.WorkerRevealWindow, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTopic
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTopic
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.WorkerRevealWindow) == 0 {
+					m.WorkerRevealWindow = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTopic
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.WorkerRevealWindow = append(m.WorkerRevealWindow, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerRevealWindow", wireType)
+			}
+		case 12:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ArchiveNetworkInferences = append(m.ArchiveNetworkInferences, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTopic
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTopic
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.ArchiveNetworkInferences) == 0 {
+					m.ArchiveNetworkInferences = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTopic
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ArchiveNetworkInferences = append(m.ArchiveNetworkInferences, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveNetworkInferences", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledTopicUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledTopicUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledTopicUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveBlockHeight", wireType)
+			}
+			m.EffectiveBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &OptionalTopicParams{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicRetirement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicRetirement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicRetirement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Topic == nil {
+				m.Topic = &Topic{}
+			}
+			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiredBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetiredBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiredAtBlock", wireType)
+			}
+			m.RetiredAtBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetiredAtBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+			}
+			m.Stage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stage |= TopicRetirementStage(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRefundedReputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastRefundedReputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRefundedDelegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastRefundedDelegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRefundedDelegatorReputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastRefundedDelegatorReputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerRefundsQueued", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReputerRefundsQueued = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundsCompleteBlock", wireType)
+			}
+			m.RefundsCompleteBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundsCompleteBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreIndex", wireType)
+			}
+			m.StoreIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StoreIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysDeleted", wireType)
+			}
+			m.KeysDeleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeysDeleted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAtBlock", wireType)
+			}
+			m.CompletedAtBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedAtBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTopic(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// TopicSponsorshipEscrowAddress holds the prepaid balances of topic sponsorships until they pay
+// the data sending fees of topic participants or are withdrawn by their sponsors
+var TopicSponsorshipEscrowAddress = sdk.AccAddress(address.Module(ModuleName, []byte("topic_sponsorship_escrow")))
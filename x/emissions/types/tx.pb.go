@@ -83,6 +83,22 @@ type OptionalParams struct {
 	MaxStringLength                     []uint64                                          `protobuf:"varint,47,rep,packed,name=max_string_length,json=maxStringLength,proto3" json:"max_string_length,omitempty"`
 	InitialRegretQuantile               []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,48,rep,name=initial_regret_quantile,json=initialRegretQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"initial_regret_quantile"`
 	PNormSafeDiv                        []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,49,rep,name=p_norm_safe_div,json=pNormSafeDiv,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_norm_safe_div"`
+	ReputerSlashFraction                []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,50,rep,name=reputer_slash_fraction,json=reputerSlashFraction,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reputer_slash_fraction"`
+	SlashedStakeBurnFraction            []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,51,rep,name=slashed_stake_burn_fraction,json=slashedStakeBurnFraction,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"slashed_stake_burn_fraction"`
+	MaxMissedReputerNonces              []uint64                                          `protobuf:"varint,52,rep,packed,name=max_missed_reputer_nonces,json=maxMissedReputerNonces,proto3" json:"max_missed_reputer_nonces,omitempty"`
+	ReputerOutlierScoreRatio            []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,53,rep,name=reputer_outlier_score_ratio,json=reputerOutlierScoreRatio,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reputer_outlier_score_ratio"`
+	MaxReputerOutlierEpochs             []uint64                                          `protobuf:"varint,54,rep,packed,name=max_reputer_outlier_epochs,json=maxReputerOutlierEpochs,proto3" json:"max_reputer_outlier_epochs,omitempty"`
+	ReputerJailDuration                 []int64                                           `protobuf:"varint,55,rep,packed,name=reputer_jail_duration,json=reputerJailDuration,proto3" json:"reputer_jail_duration,omitempty"`
+	MaxReputerLossDeviation             []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,56,rep,name=max_reputer_loss_deviation,json=maxReputerLossDeviation,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"max_reputer_loss_deviation"`
+	NetworkInferenceArchiveRetention    []int64                                           `protobuf:"varint,57,rep,packed,name=network_inference_archive_retention,json=networkInferenceArchiveRetention,proto3" json:"network_inference_archive_retention,omitempty"`
+	MaxPayloadsPerBatch                 []uint64                                          `protobuf:"varint,58,rep,packed,name=max_payloads_per_batch,json=maxPayloadsPerBatch,proto3" json:"max_payloads_per_batch,omitempty"`
+	BatchedPayloadGasDiscount           []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,59,rep,name=batched_payload_gas_discount,json=batchedPayloadGasDiscount,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"batched_payload_gas_discount"`
+	MaxRedelegationsPerDelegator        []uint64                                          `protobuf:"varint,60,rep,packed,name=max_redelegations_per_delegator,json=maxRedelegationsPerDelegator,proto3" json:"max_redelegations_per_delegator,omitempty"`
+	RewardAccrualEnabled                []bool                                            `protobuf:"varint,61,rep,packed,name=reward_accrual_enabled,json=rewardAccrualEnabled,proto3" json:"reward_accrual_enabled,omitempty"`
+	DataSendingFeeMaxChangeRate         []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,62,rep,name=data_sending_fee_max_change_rate,json=dataSendingFeeMaxChangeRate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"data_sending_fee_max_change_rate"`
+	DataSendingFeeTargetRatio           []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,63,rep,name=data_sending_fee_target_ratio,json=dataSendingFeeTargetRatio,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"data_sending_fee_target_ratio"`
+	GmpAxelarChannelId                  []string                                          `protobuf:"bytes,64,rep,name=gmp_axelar_channel_id,json=gmpAxelarChannelId,proto3" json:"gmp_axelar_channel_id,omitempty"`
+	GmpAxelarCounterpartyChannelId      []string                                          `protobuf:"bytes,65,rep,name=gmp_axelar_counterparty_channel_id,json=gmpAxelarCounterpartyChannelId,proto3" json:"gmp_axelar_counterparty_channel_id,omitempty"`
 }
 
 func (m *OptionalParams) Reset()         { *m = OptionalParams{} }
@@ -251,6 +267,69 @@ func (m *OptionalParams) GetMaxStringLength() []uint64 {
 	return nil
 }
 
+func (m *OptionalParams) GetMaxMissedReputerNonces() []uint64 {
+	if m != nil {
+		return m.MaxMissedReputerNonces
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetMaxReputerOutlierEpochs() []uint64 {
+	if m != nil {
+		return m.MaxReputerOutlierEpochs
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetReputerJailDuration() []int64 {
+	if m != nil {
+		return m.ReputerJailDuration
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetNetworkInferenceArchiveRetention() []int64 {
+	if m != nil {
+		return m.NetworkInferenceArchiveRetention
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetMaxPayloadsPerBatch() []uint64 {
+	if m != nil {
+		return m.MaxPayloadsPerBatch
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetMaxRedelegationsPerDelegator() []uint64 {
+	if m != nil {
+		return m.MaxRedelegationsPerDelegator
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetRewardAccrualEnabled() []bool {
+	if m != nil {
+		return m.RewardAccrualEnabled
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetGmpAxelarChannelId() []string {
+	if m != nil {
+		return m.GmpAxelarChannelId
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetGmpAxelarCounterpartyChannelId() []string {
+	if m != nil {
+		return m.GmpAxelarCounterpartyChannelId
+	}
+	return nil
+}
+
 type UpdateParamsRequest struct {
 	Sender string          `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Params *OptionalParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
@@ -355,6 +434,11 @@ type CreateNewTopicRequest struct {
 	ActiveInfererQuantile    github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,16,opt,name=active_inferer_quantile,json=activeInfererQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"active_inferer_quantile"`
 	ActiveForecasterQuantile github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,17,opt,name=active_forecaster_quantile,json=activeForecasterQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"active_forecaster_quantile"`
 	ActiveReputerQuantile    github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,18,opt,name=active_reputer_quantile,json=activeReputerQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"active_reputer_quantile"`
+	WorkerRevealWindow       int64                                           `protobuf:"varint,19,opt,name=worker_reveal_window,json=workerRevealWindow,proto3" json:"worker_reveal_window,omitempty"`
+	// Number of values making up each inference, zero or one for scalar topics
+	ValueDimension uint64 `protobuf:"varint,20,opt,name=value_dimension,json=valueDimension,proto3" json:"value_dimension,omitempty"`
+	// Whether network inferences of the topic are archived for historical queries
+	ArchiveNetworkInferences bool `protobuf:"varint,21,opt,name=archive_network_inferences,json=archiveNetworkInferences,proto3" json:"archive_network_inferences,omitempty"`
 }
 
 func (m *CreateNewTopicRequest) Reset()         { *m = CreateNewTopicRequest{} }
@@ -439,6 +523,27 @@ func (m *CreateNewTopicRequest) GetWorkerSubmissionWindow() int64 {
 	return 0
 }
 
+func (m *CreateNewTopicRequest) GetWorkerRevealWindow() int64 {
+	if m != nil {
+		return m.WorkerRevealWindow
+	}
+	return 0
+}
+
+func (m *CreateNewTopicRequest) GetValueDimension() uint64 {
+	if m != nil {
+		return m.ValueDimension
+	}
+	return 0
+}
+
+func (m *CreateNewTopicRequest) GetArchiveNetworkInferences() bool {
+	if m != nil {
+		return m.ArchiveNetworkInferences
+	}
+	return false
+}
+
 type CreateNewTopicResponse struct {
 	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 }
@@ -483,23 +588,27 @@ func (m *CreateNewTopicResponse) GetTopicId() uint64 {
 	return 0
 }
 
-type InsertReputerPayloadRequest struct {
-	Sender             string              `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	ReputerValueBundle *ReputerValueBundle `protobuf:"bytes,2,opt,name=reputer_value_bundle,json=reputerValueBundle,proto3" json:"reputer_value_bundle,omitempty"`
+// Changes the mutable parameters of an existing topic. Only the topic creator
+// or a whitelist admin may send it. If the topic is active the update takes
+// effect at the topic's next epoch boundary, otherwise it is applied at once.
+type UpdateTopicRequest struct {
+	Sender  string               `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64               `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Params  *OptionalTopicParams `protobuf:"bytes,3,opt,name=params,proto3" json:"params,omitempty"`
 }
 
-func (m *InsertReputerPayloadRequest) Reset()         { *m = InsertReputerPayloadRequest{} }
-func (m *InsertReputerPayloadRequest) String() string { return proto.CompactTextString(m) }
-func (*InsertReputerPayloadRequest) ProtoMessage()    {}
-func (*InsertReputerPayloadRequest) Descriptor() ([]byte, []int) {
+func (m *UpdateTopicRequest) Reset()         { *m = UpdateTopicRequest{} }
+func (m *UpdateTopicRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateTopicRequest) ProtoMessage()    {}
+func (*UpdateTopicRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6928f235d40e7988, []int{5}
 }
-func (m *InsertReputerPayloadRequest) XXX_Unmarshal(b []byte) error {
+func (m *UpdateTopicRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InsertReputerPayloadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateTopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InsertReputerPayloadRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateTopicRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -509,47 +618,56 @@ func (m *InsertReputerPayloadRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *InsertReputerPayloadRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InsertReputerPayloadRequest.Merge(m, src)
+func (m *UpdateTopicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTopicRequest.Merge(m, src)
 }
-func (m *InsertReputerPayloadRequest) XXX_Size() int {
+func (m *UpdateTopicRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *InsertReputerPayloadRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_InsertReputerPayloadRequest.DiscardUnknown(m)
+func (m *UpdateTopicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTopicRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InsertReputerPayloadRequest proto.InternalMessageInfo
+var xxx_messageInfo_UpdateTopicRequest proto.InternalMessageInfo
 
-func (m *InsertReputerPayloadRequest) GetSender() string {
+func (m *UpdateTopicRequest) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *InsertReputerPayloadRequest) GetReputerValueBundle() *ReputerValueBundle {
+func (m *UpdateTopicRequest) GetTopicId() uint64 {
 	if m != nil {
-		return m.ReputerValueBundle
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *UpdateTopicRequest) GetParams() *OptionalTopicParams {
+	if m != nil {
+		return m.Params
 	}
 	return nil
 }
 
-type InsertReputerPayloadResponse struct {
+type UpdateTopicResponse struct {
+	// block height at which the update is (or was) applied
+	EffectiveBlockHeight int64 `protobuf:"varint,1,opt,name=effective_block_height,json=effectiveBlockHeight,proto3" json:"effective_block_height,omitempty"`
 }
 
-func (m *InsertReputerPayloadResponse) Reset()         { *m = InsertReputerPayloadResponse{} }
-func (m *InsertReputerPayloadResponse) String() string { return proto.CompactTextString(m) }
-func (*InsertReputerPayloadResponse) ProtoMessage()    {}
-func (*InsertReputerPayloadResponse) Descriptor() ([]byte, []int) {
+func (m *UpdateTopicResponse) Reset()         { *m = UpdateTopicResponse{} }
+func (m *UpdateTopicResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateTopicResponse) ProtoMessage()    {}
+func (*UpdateTopicResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6928f235d40e7988, []int{6}
 }
-func (m *InsertReputerPayloadResponse) XXX_Unmarshal(b []byte) error {
+func (m *UpdateTopicResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InsertReputerPayloadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateTopicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InsertReputerPayloadResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateTopicResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -559,35 +677,46 @@ func (m *InsertReputerPayloadResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *InsertReputerPayloadResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InsertReputerPayloadResponse.Merge(m, src)
+func (m *UpdateTopicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTopicResponse.Merge(m, src)
 }
-func (m *InsertReputerPayloadResponse) XXX_Size() int {
+func (m *UpdateTopicResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *InsertReputerPayloadResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_InsertReputerPayloadResponse.DiscardUnknown(m)
+func (m *UpdateTopicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTopicResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InsertReputerPayloadResponse proto.InternalMessageInfo
+var xxx_messageInfo_UpdateTopicResponse proto.InternalMessageInfo
 
-type InsertWorkerPayloadRequest struct {
-	Sender           string            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	WorkerDataBundle *WorkerDataBundle `protobuf:"bytes,2,opt,name=worker_data_bundle,json=workerDataBundle,proto3" json:"worker_data_bundle,omitempty"`
+func (m *UpdateTopicResponse) GetEffectiveBlockHeight() int64 {
+	if m != nil {
+		return m.EffectiveBlockHeight
+	}
+	return 0
 }
 
-func (m *InsertWorkerPayloadRequest) Reset()         { *m = InsertWorkerPayloadRequest{} }
-func (m *InsertWorkerPayloadRequest) String() string { return proto.CompactTextString(m) }
-func (*InsertWorkerPayloadRequest) ProtoMessage()    {}
-func (*InsertWorkerPayloadRequest) Descriptor() ([]byte, []int) {
+// Permanently retires a topic. Only the topic creator or a whitelist admin may
+// send it. The topic stops accepting messages at once; its stake is refunded
+// through the stake removal queue and its state is garbage collected over
+// subsequent blocks.
+type RetireTopicRequest struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+}
+
+func (m *RetireTopicRequest) Reset()         { *m = RetireTopicRequest{} }
+func (m *RetireTopicRequest) String() string { return proto.CompactTextString(m) }
+func (*RetireTopicRequest) ProtoMessage()    {}
+func (*RetireTopicRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6928f235d40e7988, []int{7}
 }
-func (m *InsertWorkerPayloadRequest) XXX_Unmarshal(b []byte) error {
+func (m *RetireTopicRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InsertWorkerPayloadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RetireTopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InsertWorkerPayloadRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RetireTopicRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -597,47 +726,47 @@ func (m *InsertWorkerPayloadRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *InsertWorkerPayloadRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InsertWorkerPayloadRequest.Merge(m, src)
+func (m *RetireTopicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetireTopicRequest.Merge(m, src)
 }
-func (m *InsertWorkerPayloadRequest) XXX_Size() int {
+func (m *RetireTopicRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *InsertWorkerPayloadRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_InsertWorkerPayloadRequest.DiscardUnknown(m)
+func (m *RetireTopicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetireTopicRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InsertWorkerPayloadRequest proto.InternalMessageInfo
+var xxx_messageInfo_RetireTopicRequest proto.InternalMessageInfo
 
-func (m *InsertWorkerPayloadRequest) GetSender() string {
+func (m *RetireTopicRequest) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *InsertWorkerPayloadRequest) GetWorkerDataBundle() *WorkerDataBundle {
+func (m *RetireTopicRequest) GetTopicId() uint64 {
 	if m != nil {
-		return m.WorkerDataBundle
+		return m.TopicId
 	}
-	return nil
+	return 0
 }
 
-type InsertWorkerPayloadResponse struct {
+type RetireTopicResponse struct {
 }
 
-func (m *InsertWorkerPayloadResponse) Reset()         { *m = InsertWorkerPayloadResponse{} }
-func (m *InsertWorkerPayloadResponse) String() string { return proto.CompactTextString(m) }
-func (*InsertWorkerPayloadResponse) ProtoMessage()    {}
-func (*InsertWorkerPayloadResponse) Descriptor() ([]byte, []int) {
+func (m *RetireTopicResponse) Reset()         { *m = RetireTopicResponse{} }
+func (m *RetireTopicResponse) String() string { return proto.CompactTextString(m) }
+func (*RetireTopicResponse) ProtoMessage()    {}
+func (*RetireTopicResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6928f235d40e7988, []int{8}
 }
-func (m *InsertWorkerPayloadResponse) XXX_Unmarshal(b []byte) error {
+func (m *RetireTopicResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InsertWorkerPayloadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RetireTopicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InsertWorkerPayloadResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RetireTopicResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -647,37 +776,35 @@ func (m *InsertWorkerPayloadResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *InsertWorkerPayloadResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InsertWorkerPayloadResponse.Merge(m, src)
+func (m *RetireTopicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetireTopicResponse.Merge(m, src)
 }
-func (m *InsertWorkerPayloadResponse) XXX_Size() int {
+func (m *RetireTopicResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *InsertWorkerPayloadResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_InsertWorkerPayloadResponse.DiscardUnknown(m)
+func (m *RetireTopicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetireTopicResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InsertWorkerPayloadResponse proto.InternalMessageInfo
+var xxx_messageInfo_RetireTopicResponse proto.InternalMessageInfo
 
-type RegisterRequest struct {
-	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	TopicId   uint64 `protobuf:"varint,4,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Owner     string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
-	IsReputer bool   `protobuf:"varint,6,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
+type InsertReputerPayloadRequest struct {
+	Sender             string              `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	ReputerValueBundle *ReputerValueBundle `protobuf:"bytes,2,opt,name=reputer_value_bundle,json=reputerValueBundle,proto3" json:"reputer_value_bundle,omitempty"`
 }
 
-func (m *RegisterRequest) Reset()         { *m = RegisterRequest{} }
-func (m *RegisterRequest) String() string { return proto.CompactTextString(m) }
-func (*RegisterRequest) ProtoMessage()    {}
-func (*RegisterRequest) Descriptor() ([]byte, []int) {
+func (m *InsertReputerPayloadRequest) Reset()         { *m = InsertReputerPayloadRequest{} }
+func (m *InsertReputerPayloadRequest) String() string { return proto.CompactTextString(m) }
+func (*InsertReputerPayloadRequest) ProtoMessage()    {}
+func (*InsertReputerPayloadRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6928f235d40e7988, []int{9}
 }
-func (m *RegisterRequest) XXX_Unmarshal(b []byte) error {
+func (m *InsertReputerPayloadRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RegisterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InsertReputerPayloadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RegisterRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InsertReputerPayloadRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -687,63 +814,47 @@ func (m *RegisterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *RegisterRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisterRequest.Merge(m, src)
+func (m *InsertReputerPayloadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertReputerPayloadRequest.Merge(m, src)
 }
-func (m *RegisterRequest) XXX_Size() int {
+func (m *InsertReputerPayloadRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RegisterRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisterRequest.DiscardUnknown(m)
+func (m *InsertReputerPayloadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertReputerPayloadRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RegisterRequest proto.InternalMessageInfo
+var xxx_messageInfo_InsertReputerPayloadRequest proto.InternalMessageInfo
 
-func (m *RegisterRequest) GetSender() string {
+func (m *InsertReputerPayloadRequest) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *RegisterRequest) GetTopicId() uint64 {
-	if m != nil {
-		return m.TopicId
-	}
-	return 0
-}
-
-func (m *RegisterRequest) GetOwner() string {
-	if m != nil {
-		return m.Owner
-	}
-	return ""
-}
-
-func (m *RegisterRequest) GetIsReputer() bool {
+func (m *InsertReputerPayloadRequest) GetReputerValueBundle() *ReputerValueBundle {
 	if m != nil {
-		return m.IsReputer
+		return m.ReputerValueBundle
 	}
-	return false
+	return nil
 }
 
-type RegisterResponse struct {
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+type InsertReputerPayloadResponse struct {
 }
 
-func (m *RegisterResponse) Reset()         { *m = RegisterResponse{} }
-func (m *RegisterResponse) String() string { return proto.CompactTextString(m) }
-func (*RegisterResponse) ProtoMessage()    {}
-func (*RegisterResponse) Descriptor() ([]byte, []int) {
+func (m *InsertReputerPayloadResponse) Reset()         { *m = InsertReputerPayloadResponse{} }
+func (m *InsertReputerPayloadResponse) String() string { return proto.CompactTextString(m) }
+func (*InsertReputerPayloadResponse) ProtoMessage()    {}
+func (*InsertReputerPayloadResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6928f235d40e7988, []int{10}
 }
-func (m *RegisterResponse) XXX_Unmarshal(b []byte) error {
+func (m *InsertReputerPayloadResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RegisterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InsertReputerPayloadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RegisterResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InsertReputerPayloadResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -753,50 +864,38 @@ func (m *RegisterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *RegisterResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisterResponse.Merge(m, src)
+func (m *InsertReputerPayloadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertReputerPayloadResponse.Merge(m, src)
 }
-func (m *RegisterResponse) XXX_Size() int {
+func (m *InsertReputerPayloadResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RegisterResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisterResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_RegisterResponse proto.InternalMessageInfo
-
-func (m *RegisterResponse) GetSuccess() bool {
-	if m != nil {
-		return m.Success
-	}
-	return false
+func (m *InsertReputerPayloadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertReputerPayloadResponse.DiscardUnknown(m)
 }
 
-func (m *RegisterResponse) GetMessage() string {
-	if m != nil {
-		return m.Message
-	}
-	return ""
-}
+var xxx_messageInfo_InsertReputerPayloadResponse proto.InternalMessageInfo
 
-type RemoveRegistrationRequest struct {
-	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	TopicId   uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	IsReputer bool   `protobuf:"varint,3,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
+// Inserts many reputer payloads, possibly for different topics, in one
+// message. Each payload is processed as by InsertReputerPayload; those that
+// fail are skipped without reverting the others.
+type InsertReputerPayloadsRequest struct {
+	Sender              string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	ReputerValueBundles []*ReputerValueBundle `protobuf:"bytes,2,rep,name=reputer_value_bundles,json=reputerValueBundles,proto3" json:"reputer_value_bundles,omitempty"`
 }
 
-func (m *RemoveRegistrationRequest) Reset()         { *m = RemoveRegistrationRequest{} }
-func (m *RemoveRegistrationRequest) String() string { return proto.CompactTextString(m) }
-func (*RemoveRegistrationRequest) ProtoMessage()    {}
-func (*RemoveRegistrationRequest) Descriptor() ([]byte, []int) {
+func (m *InsertReputerPayloadsRequest) Reset()         { *m = InsertReputerPayloadsRequest{} }
+func (m *InsertReputerPayloadsRequest) String() string { return proto.CompactTextString(m) }
+func (*InsertReputerPayloadsRequest) ProtoMessage()    {}
+func (*InsertReputerPayloadsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6928f235d40e7988, []int{11}
 }
-func (m *RemoveRegistrationRequest) XXX_Unmarshal(b []byte) error {
+func (m *InsertReputerPayloadsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveRegistrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InsertReputerPayloadsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveRegistrationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InsertReputerPayloadsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -806,56 +905,97 @@ func (m *RemoveRegistrationRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *RemoveRegistrationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveRegistrationRequest.Merge(m, src)
+func (m *InsertReputerPayloadsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertReputerPayloadsRequest.Merge(m, src)
 }
-func (m *RemoveRegistrationRequest) XXX_Size() int {
+func (m *InsertReputerPayloadsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveRegistrationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveRegistrationRequest.DiscardUnknown(m)
+func (m *InsertReputerPayloadsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertReputerPayloadsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveRegistrationRequest proto.InternalMessageInfo
+var xxx_messageInfo_InsertReputerPayloadsRequest proto.InternalMessageInfo
 
-func (m *RemoveRegistrationRequest) GetSender() string {
+func (m *InsertReputerPayloadsRequest) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *RemoveRegistrationRequest) GetTopicId() uint64 {
+func (m *InsertReputerPayloadsRequest) GetReputerValueBundles() []*ReputerValueBundle {
 	if m != nil {
-		return m.TopicId
+		return m.ReputerValueBundles
 	}
-	return 0
+	return nil
 }
 
-func (m *RemoveRegistrationRequest) GetIsReputer() bool {
+type InsertReputerPayloadsResponse struct {
+	// One result per payload, in the order of the request
+	Results []*BatchedPayloadResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *InsertReputerPayloadsResponse) Reset()         { *m = InsertReputerPayloadsResponse{} }
+func (m *InsertReputerPayloadsResponse) String() string { return proto.CompactTextString(m) }
+func (*InsertReputerPayloadsResponse) ProtoMessage()    {}
+func (*InsertReputerPayloadsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{12}
+}
+func (m *InsertReputerPayloadsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsertReputerPayloadsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsertReputerPayloadsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InsertReputerPayloadsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertReputerPayloadsResponse.Merge(m, src)
+}
+func (m *InsertReputerPayloadsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsertReputerPayloadsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertReputerPayloadsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsertReputerPayloadsResponse proto.InternalMessageInfo
+
+func (m *InsertReputerPayloadsResponse) GetResults() []*BatchedPayloadResult {
 	if m != nil {
-		return m.IsReputer
+		return m.Results
 	}
-	return false
+	return nil
 }
 
-type RemoveRegistrationResponse struct {
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+// Outcome of a payload of a batched payload message
+type BatchedPayloadResult struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Success bool   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// Reason the payload was rejected, empty on success
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *RemoveRegistrationResponse) Reset()         { *m = RemoveRegistrationResponse{} }
-func (m *RemoveRegistrationResponse) String() string { return proto.CompactTextString(m) }
-func (*RemoveRegistrationResponse) ProtoMessage()    {}
-func (*RemoveRegistrationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{12}
+func (m *BatchedPayloadResult) Reset()         { *m = BatchedPayloadResult{} }
+func (m *BatchedPayloadResult) String() string { return proto.CompactTextString(m) }
+func (*BatchedPayloadResult) ProtoMessage()    {}
+func (*BatchedPayloadResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{13}
 }
-func (m *RemoveRegistrationResponse) XXX_Unmarshal(b []byte) error {
+func (m *BatchedPayloadResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveRegistrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BatchedPayloadResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveRegistrationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BatchedPayloadResult.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -865,50 +1005,63 @@ func (m *RemoveRegistrationResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *RemoveRegistrationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveRegistrationResponse.Merge(m, src)
+func (m *BatchedPayloadResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchedPayloadResult.Merge(m, src)
 }
-func (m *RemoveRegistrationResponse) XXX_Size() int {
+func (m *BatchedPayloadResult) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveRegistrationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveRegistrationResponse.DiscardUnknown(m)
+func (m *BatchedPayloadResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchedPayloadResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveRegistrationResponse proto.InternalMessageInfo
+var xxx_messageInfo_BatchedPayloadResult proto.InternalMessageInfo
 
-func (m *RemoveRegistrationResponse) GetSuccess() bool {
+func (m *BatchedPayloadResult) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *BatchedPayloadResult) GetSuccess() bool {
 	if m != nil {
 		return m.Success
 	}
 	return false
 }
 
-func (m *RemoveRegistrationResponse) GetMessage() string {
+func (m *BatchedPayloadResult) GetError() string {
 	if m != nil {
-		return m.Message
+		return m.Error
 	}
 	return ""
 }
 
-type AddStakeRequest struct {
-	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+// Submits the ground truth of a reputer nonce of a topic whose loss method is
+// known to the chain. Must be sent by the topic creator or a whitelist admin
+// once the ground truth lag has elapsed and before the reputer nonce closes.
+// The chain then recomputes inferer losses itself and disregards reputer
+// losses that deviate from them.
+type SubmitGroundTruthRequest struct {
+	Sender                  string                                          `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId                 uint64                                          `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	ReputerNonceBlockHeight int64                                           `protobuf:"varint,3,opt,name=reputer_nonce_block_height,json=reputerNonceBlockHeight,proto3" json:"reputer_nonce_block_height,omitempty"`
+	GroundTruth             github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,4,opt,name=ground_truth,json=groundTruth,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"ground_truth"`
 }
 
-func (m *AddStakeRequest) Reset()         { *m = AddStakeRequest{} }
-func (m *AddStakeRequest) String() string { return proto.CompactTextString(m) }
-func (*AddStakeRequest) ProtoMessage()    {}
-func (*AddStakeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{13}
+func (m *SubmitGroundTruthRequest) Reset()         { *m = SubmitGroundTruthRequest{} }
+func (m *SubmitGroundTruthRequest) String() string { return proto.CompactTextString(m) }
+func (*SubmitGroundTruthRequest) ProtoMessage()    {}
+func (*SubmitGroundTruthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{14}
 }
-func (m *AddStakeRequest) XXX_Unmarshal(b []byte) error {
+func (m *SubmitGroundTruthRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AddStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SubmitGroundTruthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AddStakeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SubmitGroundTruthRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -918,47 +1071,54 @@ func (m *AddStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *AddStakeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddStakeRequest.Merge(m, src)
+func (m *SubmitGroundTruthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitGroundTruthRequest.Merge(m, src)
 }
-func (m *AddStakeRequest) XXX_Size() int {
+func (m *SubmitGroundTruthRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AddStakeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddStakeRequest.DiscardUnknown(m)
+func (m *SubmitGroundTruthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitGroundTruthRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddStakeRequest proto.InternalMessageInfo
+var xxx_messageInfo_SubmitGroundTruthRequest proto.InternalMessageInfo
 
-func (m *AddStakeRequest) GetSender() string {
+func (m *SubmitGroundTruthRequest) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *AddStakeRequest) GetTopicId() uint64 {
+func (m *SubmitGroundTruthRequest) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-type AddStakeResponse struct {
+func (m *SubmitGroundTruthRequest) GetReputerNonceBlockHeight() int64 {
+	if m != nil {
+		return m.ReputerNonceBlockHeight
+	}
+	return 0
 }
 
-func (m *AddStakeResponse) Reset()         { *m = AddStakeResponse{} }
-func (m *AddStakeResponse) String() string { return proto.CompactTextString(m) }
-func (*AddStakeResponse) ProtoMessage()    {}
-func (*AddStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{14}
+type SubmitGroundTruthResponse struct {
 }
-func (m *AddStakeResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *SubmitGroundTruthResponse) Reset()         { *m = SubmitGroundTruthResponse{} }
+func (m *SubmitGroundTruthResponse) String() string { return proto.CompactTextString(m) }
+func (*SubmitGroundTruthResponse) ProtoMessage()    {}
+func (*SubmitGroundTruthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{15}
+}
+func (m *SubmitGroundTruthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AddStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SubmitGroundTruthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AddStakeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SubmitGroundTruthResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -968,36 +1128,35 @@ func (m *AddStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *AddStakeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddStakeResponse.Merge(m, src)
+func (m *SubmitGroundTruthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitGroundTruthResponse.Merge(m, src)
 }
-func (m *AddStakeResponse) XXX_Size() int {
+func (m *SubmitGroundTruthResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *AddStakeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddStakeResponse.DiscardUnknown(m)
+func (m *SubmitGroundTruthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitGroundTruthResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddStakeResponse proto.InternalMessageInfo
+var xxx_messageInfo_SubmitGroundTruthResponse proto.InternalMessageInfo
 
-type RemoveStakeRequest struct {
-	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+type InsertWorkerPayloadRequest struct {
+	Sender           string            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	WorkerDataBundle *WorkerDataBundle `protobuf:"bytes,2,opt,name=worker_data_bundle,json=workerDataBundle,proto3" json:"worker_data_bundle,omitempty"`
 }
 
-func (m *RemoveStakeRequest) Reset()         { *m = RemoveStakeRequest{} }
-func (m *RemoveStakeRequest) String() string { return proto.CompactTextString(m) }
-func (*RemoveStakeRequest) ProtoMessage()    {}
-func (*RemoveStakeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{15}
+func (m *InsertWorkerPayloadRequest) Reset()         { *m = InsertWorkerPayloadRequest{} }
+func (m *InsertWorkerPayloadRequest) String() string { return proto.CompactTextString(m) }
+func (*InsertWorkerPayloadRequest) ProtoMessage()    {}
+func (*InsertWorkerPayloadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{16}
 }
-func (m *RemoveStakeRequest) XXX_Unmarshal(b []byte) error {
+func (m *InsertWorkerPayloadRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InsertWorkerPayloadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveStakeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InsertWorkerPayloadRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1007,47 +1166,47 @@ func (m *RemoveStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *RemoveStakeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveStakeRequest.Merge(m, src)
+func (m *InsertWorkerPayloadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertWorkerPayloadRequest.Merge(m, src)
 }
-func (m *RemoveStakeRequest) XXX_Size() int {
+func (m *InsertWorkerPayloadRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveStakeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveStakeRequest.DiscardUnknown(m)
+func (m *InsertWorkerPayloadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertWorkerPayloadRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveStakeRequest proto.InternalMessageInfo
+var xxx_messageInfo_InsertWorkerPayloadRequest proto.InternalMessageInfo
 
-func (m *RemoveStakeRequest) GetSender() string {
+func (m *InsertWorkerPayloadRequest) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *RemoveStakeRequest) GetTopicId() uint64 {
+func (m *InsertWorkerPayloadRequest) GetWorkerDataBundle() *WorkerDataBundle {
 	if m != nil {
-		return m.TopicId
+		return m.WorkerDataBundle
 	}
-	return 0
+	return nil
 }
 
-type RemoveStakeResponse struct {
+type InsertWorkerPayloadResponse struct {
 }
 
-func (m *RemoveStakeResponse) Reset()         { *m = RemoveStakeResponse{} }
-func (m *RemoveStakeResponse) String() string { return proto.CompactTextString(m) }
-func (*RemoveStakeResponse) ProtoMessage()    {}
-func (*RemoveStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{16}
+func (m *InsertWorkerPayloadResponse) Reset()         { *m = InsertWorkerPayloadResponse{} }
+func (m *InsertWorkerPayloadResponse) String() string { return proto.CompactTextString(m) }
+func (*InsertWorkerPayloadResponse) ProtoMessage()    {}
+func (*InsertWorkerPayloadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{17}
 }
-func (m *RemoveStakeResponse) XXX_Unmarshal(b []byte) error {
+func (m *InsertWorkerPayloadResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InsertWorkerPayloadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveStakeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InsertWorkerPayloadResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1057,35 +1216,38 @@ func (m *RemoveStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *RemoveStakeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveStakeResponse.Merge(m, src)
+func (m *InsertWorkerPayloadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertWorkerPayloadResponse.Merge(m, src)
 }
-func (m *RemoveStakeResponse) XXX_Size() int {
+func (m *InsertWorkerPayloadResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveStakeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveStakeResponse.DiscardUnknown(m)
+func (m *InsertWorkerPayloadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertWorkerPayloadResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveStakeResponse proto.InternalMessageInfo
+var xxx_messageInfo_InsertWorkerPayloadResponse proto.InternalMessageInfo
 
-type CancelRemoveStakeRequest struct {
-	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+// Inserts many worker payloads, possibly for different topics, in one message.
+// Each payload is processed as by InsertWorkerPayload; those that fail are
+// skipped without reverting the others.
+type InsertWorkerPayloadsRequest struct {
+	Sender            string              `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	WorkerDataBundles []*WorkerDataBundle `protobuf:"bytes,2,rep,name=worker_data_bundles,json=workerDataBundles,proto3" json:"worker_data_bundles,omitempty"`
 }
 
-func (m *CancelRemoveStakeRequest) Reset()         { *m = CancelRemoveStakeRequest{} }
-func (m *CancelRemoveStakeRequest) String() string { return proto.CompactTextString(m) }
-func (*CancelRemoveStakeRequest) ProtoMessage()    {}
-func (*CancelRemoveStakeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{17}
+func (m *InsertWorkerPayloadsRequest) Reset()         { *m = InsertWorkerPayloadsRequest{} }
+func (m *InsertWorkerPayloadsRequest) String() string { return proto.CompactTextString(m) }
+func (*InsertWorkerPayloadsRequest) ProtoMessage()    {}
+func (*InsertWorkerPayloadsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{18}
 }
-func (m *CancelRemoveStakeRequest) XXX_Unmarshal(b []byte) error {
+func (m *InsertWorkerPayloadsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CancelRemoveStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InsertWorkerPayloadsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CancelRemoveStakeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InsertWorkerPayloadsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1095,47 +1257,49 @@ func (m *CancelRemoveStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *CancelRemoveStakeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CancelRemoveStakeRequest.Merge(m, src)
+func (m *InsertWorkerPayloadsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertWorkerPayloadsRequest.Merge(m, src)
 }
-func (m *CancelRemoveStakeRequest) XXX_Size() int {
+func (m *InsertWorkerPayloadsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CancelRemoveStakeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CancelRemoveStakeRequest.DiscardUnknown(m)
+func (m *InsertWorkerPayloadsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertWorkerPayloadsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CancelRemoveStakeRequest proto.InternalMessageInfo
+var xxx_messageInfo_InsertWorkerPayloadsRequest proto.InternalMessageInfo
 
-func (m *CancelRemoveStakeRequest) GetSender() string {
+func (m *InsertWorkerPayloadsRequest) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *CancelRemoveStakeRequest) GetTopicId() uint64 {
+func (m *InsertWorkerPayloadsRequest) GetWorkerDataBundles() []*WorkerDataBundle {
 	if m != nil {
-		return m.TopicId
+		return m.WorkerDataBundles
 	}
-	return 0
+	return nil
 }
 
-type CancelRemoveStakeResponse struct {
+type InsertWorkerPayloadsResponse struct {
+	// One result per payload, in the order of the request
+	Results []*BatchedPayloadResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (m *CancelRemoveStakeResponse) Reset()         { *m = CancelRemoveStakeResponse{} }
-func (m *CancelRemoveStakeResponse) String() string { return proto.CompactTextString(m) }
-func (*CancelRemoveStakeResponse) ProtoMessage()    {}
-func (*CancelRemoveStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{18}
+func (m *InsertWorkerPayloadsResponse) Reset()         { *m = InsertWorkerPayloadsResponse{} }
+func (m *InsertWorkerPayloadsResponse) String() string { return proto.CompactTextString(m) }
+func (*InsertWorkerPayloadsResponse) ProtoMessage()    {}
+func (*InsertWorkerPayloadsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{19}
 }
-func (m *CancelRemoveStakeResponse) XXX_Unmarshal(b []byte) error {
+func (m *InsertWorkerPayloadsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CancelRemoveStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InsertWorkerPayloadsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CancelRemoveStakeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InsertWorkerPayloadsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1145,37 +1309,48 @@ func (m *CancelRemoveStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *CancelRemoveStakeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CancelRemoveStakeResponse.Merge(m, src)
+func (m *InsertWorkerPayloadsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertWorkerPayloadsResponse.Merge(m, src)
 }
-func (m *CancelRemoveStakeResponse) XXX_Size() int {
+func (m *InsertWorkerPayloadsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *CancelRemoveStakeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CancelRemoveStakeResponse.DiscardUnknown(m)
+func (m *InsertWorkerPayloadsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertWorkerPayloadsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CancelRemoveStakeResponse proto.InternalMessageInfo
+var xxx_messageInfo_InsertWorkerPayloadsResponse proto.InternalMessageInfo
 
-type DelegateStakeRequest struct {
-	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Reputer string                `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
-	Amount  cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+func (m *InsertWorkerPayloadsResponse) GetResults() []*BatchedPayloadResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
 }
 
-func (m *DelegateStakeRequest) Reset()         { *m = DelegateStakeRequest{} }
-func (m *DelegateStakeRequest) String() string { return proto.CompactTextString(m) }
-func (*DelegateStakeRequest) ProtoMessage()    {}
-func (*DelegateStakeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{19}
+// Commits a worker to the payload it will reveal for a worker nonce of a topic
+// in commit-reveal mode. Must be sent by the worker itself during the worker
+// submission window. A later commitment for the same nonce replaces it.
+type CommitWorkerPayloadRequest struct {
+	Sender           string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId          uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	NonceBlockHeight int64  `protobuf:"varint,3,opt,name=nonce_block_height,json=nonceBlockHeight,proto3" json:"nonce_block_height,omitempty"`
+	// sha256 of the serialized InferenceForecastBundle followed by the salt
+	Commitment []byte `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
 }
-func (m *DelegateStakeRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *CommitWorkerPayloadRequest) Reset()         { *m = CommitWorkerPayloadRequest{} }
+func (m *CommitWorkerPayloadRequest) String() string { return proto.CompactTextString(m) }
+func (*CommitWorkerPayloadRequest) ProtoMessage()    {}
+func (*CommitWorkerPayloadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{20}
+}
+func (m *CommitWorkerPayloadRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegateStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CommitWorkerPayloadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegateStakeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CommitWorkerPayloadRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1185,54 +1360,61 @@ func (m *DelegateStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *DelegateStakeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegateStakeRequest.Merge(m, src)
+func (m *CommitWorkerPayloadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitWorkerPayloadRequest.Merge(m, src)
 }
-func (m *DelegateStakeRequest) XXX_Size() int {
+func (m *CommitWorkerPayloadRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegateStakeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegateStakeRequest.DiscardUnknown(m)
+func (m *CommitWorkerPayloadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitWorkerPayloadRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegateStakeRequest proto.InternalMessageInfo
+var xxx_messageInfo_CommitWorkerPayloadRequest proto.InternalMessageInfo
 
-func (m *DelegateStakeRequest) GetSender() string {
+func (m *CommitWorkerPayloadRequest) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *DelegateStakeRequest) GetTopicId() uint64 {
+func (m *CommitWorkerPayloadRequest) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-func (m *DelegateStakeRequest) GetReputer() string {
+func (m *CommitWorkerPayloadRequest) GetNonceBlockHeight() int64 {
 	if m != nil {
-		return m.Reputer
+		return m.NonceBlockHeight
 	}
-	return ""
+	return 0
 }
 
-type DelegateStakeResponse struct {
+func (m *CommitWorkerPayloadRequest) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
 }
 
-func (m *DelegateStakeResponse) Reset()         { *m = DelegateStakeResponse{} }
-func (m *DelegateStakeResponse) String() string { return proto.CompactTextString(m) }
-func (*DelegateStakeResponse) ProtoMessage()    {}
-func (*DelegateStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{20}
+type CommitWorkerPayloadResponse struct {
 }
-func (m *DelegateStakeResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *CommitWorkerPayloadResponse) Reset()         { *m = CommitWorkerPayloadResponse{} }
+func (m *CommitWorkerPayloadResponse) String() string { return proto.CompactTextString(m) }
+func (*CommitWorkerPayloadResponse) ProtoMessage()    {}
+func (*CommitWorkerPayloadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{21}
+}
+func (m *CommitWorkerPayloadResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CommitWorkerPayloadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegateStakeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CommitWorkerPayloadResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1242,37 +1424,38 @@ func (m *DelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *DelegateStakeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegateStakeResponse.Merge(m, src)
+func (m *CommitWorkerPayloadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitWorkerPayloadResponse.Merge(m, src)
 }
-func (m *DelegateStakeResponse) XXX_Size() int {
+func (m *CommitWorkerPayloadResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegateStakeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegateStakeResponse.DiscardUnknown(m)
+func (m *CommitWorkerPayloadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitWorkerPayloadResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegateStakeResponse proto.InternalMessageInfo
+var xxx_messageInfo_CommitWorkerPayloadResponse proto.InternalMessageInfo
 
-type RemoveDelegateStakeRequest struct {
-	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Reputer string                `protobuf:"bytes,2,opt,name=reputer,proto3" json:"reputer,omitempty"`
-	TopicId uint64                `protobuf:"varint,3,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Amount  cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+// Reveals a payload committed to with CommitWorkerPayload during the worker
+// reveal window that follows the worker submission window.
+type RevealWorkerPayloadRequest struct {
+	Sender           string            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	WorkerDataBundle *WorkerDataBundle `protobuf:"bytes,2,opt,name=worker_data_bundle,json=workerDataBundle,proto3" json:"worker_data_bundle,omitempty"`
+	Salt             []byte            `protobuf:"bytes,3,opt,name=salt,proto3" json:"salt,omitempty"`
 }
 
-func (m *RemoveDelegateStakeRequest) Reset()         { *m = RemoveDelegateStakeRequest{} }
-func (m *RemoveDelegateStakeRequest) String() string { return proto.CompactTextString(m) }
-func (*RemoveDelegateStakeRequest) ProtoMessage()    {}
-func (*RemoveDelegateStakeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{21}
+func (m *RevealWorkerPayloadRequest) Reset()         { *m = RevealWorkerPayloadRequest{} }
+func (m *RevealWorkerPayloadRequest) String() string { return proto.CompactTextString(m) }
+func (*RevealWorkerPayloadRequest) ProtoMessage()    {}
+func (*RevealWorkerPayloadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{22}
 }
-func (m *RemoveDelegateStakeRequest) XXX_Unmarshal(b []byte) error {
+func (m *RevealWorkerPayloadRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveDelegateStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RevealWorkerPayloadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveDelegateStakeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RevealWorkerPayloadRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1282,54 +1465,54 @@ func (m *RemoveDelegateStakeRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *RemoveDelegateStakeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveDelegateStakeRequest.Merge(m, src)
+func (m *RevealWorkerPayloadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevealWorkerPayloadRequest.Merge(m, src)
 }
-func (m *RemoveDelegateStakeRequest) XXX_Size() int {
+func (m *RevealWorkerPayloadRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveDelegateStakeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveDelegateStakeRequest.DiscardUnknown(m)
+func (m *RevealWorkerPayloadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevealWorkerPayloadRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveDelegateStakeRequest proto.InternalMessageInfo
+var xxx_messageInfo_RevealWorkerPayloadRequest proto.InternalMessageInfo
 
-func (m *RemoveDelegateStakeRequest) GetSender() string {
+func (m *RevealWorkerPayloadRequest) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *RemoveDelegateStakeRequest) GetReputer() string {
+func (m *RevealWorkerPayloadRequest) GetWorkerDataBundle() *WorkerDataBundle {
 	if m != nil {
-		return m.Reputer
+		return m.WorkerDataBundle
 	}
-	return ""
+	return nil
 }
 
-func (m *RemoveDelegateStakeRequest) GetTopicId() uint64 {
+func (m *RevealWorkerPayloadRequest) GetSalt() []byte {
 	if m != nil {
-		return m.TopicId
+		return m.Salt
 	}
-	return 0
+	return nil
 }
 
-type RemoveDelegateStakeResponse struct {
+type RevealWorkerPayloadResponse struct {
 }
 
-func (m *RemoveDelegateStakeResponse) Reset()         { *m = RemoveDelegateStakeResponse{} }
-func (m *RemoveDelegateStakeResponse) String() string { return proto.CompactTextString(m) }
-func (*RemoveDelegateStakeResponse) ProtoMessage()    {}
-func (*RemoveDelegateStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{22}
+func (m *RevealWorkerPayloadResponse) Reset()         { *m = RevealWorkerPayloadResponse{} }
+func (m *RevealWorkerPayloadResponse) String() string { return proto.CompactTextString(m) }
+func (*RevealWorkerPayloadResponse) ProtoMessage()    {}
+func (*RevealWorkerPayloadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{23}
 }
-func (m *RemoveDelegateStakeResponse) XXX_Unmarshal(b []byte) error {
+func (m *RevealWorkerPayloadResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveDelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RevealWorkerPayloadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveDelegateStakeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RevealWorkerPayloadResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1339,37 +1522,37 @@ func (m *RemoveDelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *RemoveDelegateStakeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveDelegateStakeResponse.Merge(m, src)
+func (m *RevealWorkerPayloadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevealWorkerPayloadResponse.Merge(m, src)
 }
-func (m *RemoveDelegateStakeResponse) XXX_Size() int {
+func (m *RevealWorkerPayloadResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveDelegateStakeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveDelegateStakeResponse.DiscardUnknown(m)
+func (m *RevealWorkerPayloadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevealWorkerPayloadResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveDelegateStakeResponse proto.InternalMessageInfo
+var xxx_messageInfo_RevealWorkerPayloadResponse proto.InternalMessageInfo
 
-type CancelRemoveDelegateStakeRequest struct {
+type RegisterRequest struct {
 	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	TopicId   uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Delegator string `protobuf:"bytes,3,opt,name=delegator,proto3" json:"delegator,omitempty"`
-	Reputer   string `protobuf:"bytes,4,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	TopicId   uint64 `protobuf:"varint,4,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Owner     string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	IsReputer bool   `protobuf:"varint,6,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
 }
 
-func (m *CancelRemoveDelegateStakeRequest) Reset()         { *m = CancelRemoveDelegateStakeRequest{} }
-func (m *CancelRemoveDelegateStakeRequest) String() string { return proto.CompactTextString(m) }
-func (*CancelRemoveDelegateStakeRequest) ProtoMessage()    {}
-func (*CancelRemoveDelegateStakeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{23}
+func (m *RegisterRequest) Reset()         { *m = RegisterRequest{} }
+func (m *RegisterRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterRequest) ProtoMessage()    {}
+func (*RegisterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{24}
 }
-func (m *CancelRemoveDelegateStakeRequest) XXX_Unmarshal(b []byte) error {
+func (m *RegisterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CancelRemoveDelegateStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RegisterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CancelRemoveDelegateStakeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RegisterRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1379,61 +1562,63 @@ func (m *CancelRemoveDelegateStakeRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *CancelRemoveDelegateStakeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CancelRemoveDelegateStakeRequest.Merge(m, src)
+func (m *RegisterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterRequest.Merge(m, src)
 }
-func (m *CancelRemoveDelegateStakeRequest) XXX_Size() int {
+func (m *RegisterRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CancelRemoveDelegateStakeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CancelRemoveDelegateStakeRequest.DiscardUnknown(m)
+func (m *RegisterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CancelRemoveDelegateStakeRequest proto.InternalMessageInfo
+var xxx_messageInfo_RegisterRequest proto.InternalMessageInfo
 
-func (m *CancelRemoveDelegateStakeRequest) GetSender() string {
+func (m *RegisterRequest) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *CancelRemoveDelegateStakeRequest) GetTopicId() uint64 {
+func (m *RegisterRequest) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-func (m *CancelRemoveDelegateStakeRequest) GetDelegator() string {
+func (m *RegisterRequest) GetOwner() string {
 	if m != nil {
-		return m.Delegator
+		return m.Owner
 	}
 	return ""
 }
 
-func (m *CancelRemoveDelegateStakeRequest) GetReputer() string {
+func (m *RegisterRequest) GetIsReputer() bool {
 	if m != nil {
-		return m.Reputer
+		return m.IsReputer
 	}
-	return ""
+	return false
 }
 
-type CancelRemoveDelegateStakeResponse struct {
+type RegisterResponse struct {
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (m *CancelRemoveDelegateStakeResponse) Reset()         { *m = CancelRemoveDelegateStakeResponse{} }
-func (m *CancelRemoveDelegateStakeResponse) String() string { return proto.CompactTextString(m) }
-func (*CancelRemoveDelegateStakeResponse) ProtoMessage()    {}
-func (*CancelRemoveDelegateStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{24}
+func (m *RegisterResponse) Reset()         { *m = RegisterResponse{} }
+func (m *RegisterResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterResponse) ProtoMessage()    {}
+func (*RegisterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{25}
 }
-func (m *CancelRemoveDelegateStakeResponse) XXX_Unmarshal(b []byte) error {
+func (m *RegisterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CancelRemoveDelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RegisterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CancelRemoveDelegateStakeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RegisterResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1443,38 +1628,50 @@ func (m *CancelRemoveDelegateStakeResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *CancelRemoveDelegateStakeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CancelRemoveDelegateStakeResponse.Merge(m, src)
+func (m *RegisterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterResponse.Merge(m, src)
 }
-func (m *CancelRemoveDelegateStakeResponse) XXX_Size() int {
+func (m *RegisterResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *CancelRemoveDelegateStakeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CancelRemoveDelegateStakeResponse.DiscardUnknown(m)
+func (m *RegisterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CancelRemoveDelegateStakeResponse proto.InternalMessageInfo
+var xxx_messageInfo_RegisterResponse proto.InternalMessageInfo
 
-// Inferences are requested by consumers who fund topics by sending ALLO to
-// ecosystem account via TopicFund messages
-type FundTopicRequest struct {
-	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+func (m *RegisterResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
 }
 
-func (m *FundTopicRequest) Reset()         { *m = FundTopicRequest{} }
-func (m *FundTopicRequest) String() string { return proto.CompactTextString(m) }
-func (*FundTopicRequest) ProtoMessage()    {}
-func (*FundTopicRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{25}
+func (m *RegisterResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
 }
-func (m *FundTopicRequest) XXX_Unmarshal(b []byte) error {
+
+type RemoveRegistrationRequest struct {
+	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId   uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	IsReputer bool   `protobuf:"varint,3,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
+}
+
+func (m *RemoveRegistrationRequest) Reset()         { *m = RemoveRegistrationRequest{} }
+func (m *RemoveRegistrationRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveRegistrationRequest) ProtoMessage()    {}
+func (*RemoveRegistrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{26}
+}
+func (m *RemoveRegistrationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *FundTopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RemoveRegistrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_FundTopicRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RemoveRegistrationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1484,47 +1681,56 @@ func (m *FundTopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *FundTopicRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FundTopicRequest.Merge(m, src)
+func (m *RemoveRegistrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveRegistrationRequest.Merge(m, src)
 }
-func (m *FundTopicRequest) XXX_Size() int {
+func (m *RemoveRegistrationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *FundTopicRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FundTopicRequest.DiscardUnknown(m)
+func (m *RemoveRegistrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveRegistrationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FundTopicRequest proto.InternalMessageInfo
+var xxx_messageInfo_RemoveRegistrationRequest proto.InternalMessageInfo
 
-func (m *FundTopicRequest) GetSender() string {
+func (m *RemoveRegistrationRequest) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *FundTopicRequest) GetTopicId() uint64 {
+func (m *RemoveRegistrationRequest) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-type FundTopicResponse struct {
+func (m *RemoveRegistrationRequest) GetIsReputer() bool {
+	if m != nil {
+		return m.IsReputer
+	}
+	return false
 }
 
-func (m *FundTopicResponse) Reset()         { *m = FundTopicResponse{} }
-func (m *FundTopicResponse) String() string { return proto.CompactTextString(m) }
-func (*FundTopicResponse) ProtoMessage()    {}
-func (*FundTopicResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{26}
+type RemoveRegistrationResponse struct {
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
-func (m *FundTopicResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *RemoveRegistrationResponse) Reset()         { *m = RemoveRegistrationResponse{} }
+func (m *RemoveRegistrationResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveRegistrationResponse) ProtoMessage()    {}
+func (*RemoveRegistrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{27}
+}
+func (m *RemoveRegistrationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *FundTopicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RemoveRegistrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_FundTopicResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RemoveRegistrationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1534,35 +1740,50 @@ func (m *FundTopicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *FundTopicResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FundTopicResponse.Merge(m, src)
+func (m *RemoveRegistrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveRegistrationResponse.Merge(m, src)
 }
-func (m *FundTopicResponse) XXX_Size() int {
+func (m *RemoveRegistrationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *FundTopicResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FundTopicResponse.DiscardUnknown(m)
+func (m *RemoveRegistrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveRegistrationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FundTopicResponse proto.InternalMessageInfo
+var xxx_messageInfo_RemoveRegistrationResponse proto.InternalMessageInfo
 
-type AddToWhitelistAdminRequest struct {
-	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+func (m *RemoveRegistrationResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
 }
 
-func (m *AddToWhitelistAdminRequest) Reset()         { *m = AddToWhitelistAdminRequest{} }
-func (m *AddToWhitelistAdminRequest) String() string { return proto.CompactTextString(m) }
-func (*AddToWhitelistAdminRequest) ProtoMessage()    {}
-func (*AddToWhitelistAdminRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{27}
+func (m *RemoveRegistrationResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
 }
-func (m *AddToWhitelistAdminRequest) XXX_Unmarshal(b []byte) error {
+
+type AddStakeRequest struct {
+	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *AddStakeRequest) Reset()         { *m = AddStakeRequest{} }
+func (m *AddStakeRequest) String() string { return proto.CompactTextString(m) }
+func (*AddStakeRequest) ProtoMessage()    {}
+func (*AddStakeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{28}
+}
+func (m *AddStakeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AddToWhitelistAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AddStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AddToWhitelistAdminRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AddStakeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1572,47 +1793,47 @@ func (m *AddToWhitelistAdminRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *AddToWhitelistAdminRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddToWhitelistAdminRequest.Merge(m, src)
+func (m *AddStakeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddStakeRequest.Merge(m, src)
 }
-func (m *AddToWhitelistAdminRequest) XXX_Size() int {
+func (m *AddStakeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AddToWhitelistAdminRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddToWhitelistAdminRequest.DiscardUnknown(m)
+func (m *AddStakeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddStakeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddToWhitelistAdminRequest proto.InternalMessageInfo
+var xxx_messageInfo_AddStakeRequest proto.InternalMessageInfo
 
-func (m *AddToWhitelistAdminRequest) GetSender() string {
+func (m *AddStakeRequest) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *AddToWhitelistAdminRequest) GetAddress() string {
+func (m *AddStakeRequest) GetTopicId() uint64 {
 	if m != nil {
-		return m.Address
+		return m.TopicId
 	}
-	return ""
+	return 0
 }
 
-type AddToWhitelistAdminResponse struct {
+type AddStakeResponse struct {
 }
 
-func (m *AddToWhitelistAdminResponse) Reset()         { *m = AddToWhitelistAdminResponse{} }
-func (m *AddToWhitelistAdminResponse) String() string { return proto.CompactTextString(m) }
-func (*AddToWhitelistAdminResponse) ProtoMessage()    {}
-func (*AddToWhitelistAdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{28}
+func (m *AddStakeResponse) Reset()         { *m = AddStakeResponse{} }
+func (m *AddStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*AddStakeResponse) ProtoMessage()    {}
+func (*AddStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{29}
 }
-func (m *AddToWhitelistAdminResponse) XXX_Unmarshal(b []byte) error {
+func (m *AddStakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AddToWhitelistAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AddStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AddToWhitelistAdminResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AddStakeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1622,35 +1843,36 @@ func (m *AddToWhitelistAdminResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *AddToWhitelistAdminResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddToWhitelistAdminResponse.Merge(m, src)
+func (m *AddStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddStakeResponse.Merge(m, src)
 }
-func (m *AddToWhitelistAdminResponse) XXX_Size() int {
+func (m *AddStakeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *AddToWhitelistAdminResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddToWhitelistAdminResponse.DiscardUnknown(m)
+func (m *AddStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddStakeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddToWhitelistAdminResponse proto.InternalMessageInfo
+var xxx_messageInfo_AddStakeResponse proto.InternalMessageInfo
 
-type RemoveFromWhitelistAdminRequest struct {
-	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+type RemoveStakeRequest struct {
+	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func (m *RemoveFromWhitelistAdminRequest) Reset()         { *m = RemoveFromWhitelistAdminRequest{} }
-func (m *RemoveFromWhitelistAdminRequest) String() string { return proto.CompactTextString(m) }
-func (*RemoveFromWhitelistAdminRequest) ProtoMessage()    {}
-func (*RemoveFromWhitelistAdminRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{29}
+func (m *RemoveStakeRequest) Reset()         { *m = RemoveStakeRequest{} }
+func (m *RemoveStakeRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveStakeRequest) ProtoMessage()    {}
+func (*RemoveStakeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{30}
 }
-func (m *RemoveFromWhitelistAdminRequest) XXX_Unmarshal(b []byte) error {
+func (m *RemoveStakeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveFromWhitelistAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RemoveStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveFromWhitelistAdminRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RemoveStakeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1660,47 +1882,47 @@ func (m *RemoveFromWhitelistAdminRequest) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *RemoveFromWhitelistAdminRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveFromWhitelistAdminRequest.Merge(m, src)
+func (m *RemoveStakeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveStakeRequest.Merge(m, src)
 }
-func (m *RemoveFromWhitelistAdminRequest) XXX_Size() int {
+func (m *RemoveStakeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveFromWhitelistAdminRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveFromWhitelistAdminRequest.DiscardUnknown(m)
+func (m *RemoveStakeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveStakeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveFromWhitelistAdminRequest proto.InternalMessageInfo
+var xxx_messageInfo_RemoveStakeRequest proto.InternalMessageInfo
 
-func (m *RemoveFromWhitelistAdminRequest) GetSender() string {
+func (m *RemoveStakeRequest) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *RemoveFromWhitelistAdminRequest) GetAddress() string {
+func (m *RemoveStakeRequest) GetTopicId() uint64 {
 	if m != nil {
-		return m.Address
+		return m.TopicId
 	}
-	return ""
+	return 0
 }
 
-type RemoveFromWhitelistAdminResponse struct {
+type RemoveStakeResponse struct {
 }
 
-func (m *RemoveFromWhitelistAdminResponse) Reset()         { *m = RemoveFromWhitelistAdminResponse{} }
-func (m *RemoveFromWhitelistAdminResponse) String() string { return proto.CompactTextString(m) }
-func (*RemoveFromWhitelistAdminResponse) ProtoMessage()    {}
-func (*RemoveFromWhitelistAdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{30}
+func (m *RemoveStakeResponse) Reset()         { *m = RemoveStakeResponse{} }
+func (m *RemoveStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveStakeResponse) ProtoMessage()    {}
+func (*RemoveStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{31}
 }
-func (m *RemoveFromWhitelistAdminResponse) XXX_Unmarshal(b []byte) error {
+func (m *RemoveStakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveFromWhitelistAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RemoveStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveFromWhitelistAdminResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RemoveStakeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1710,33 +1932,35 @@ func (m *RemoveFromWhitelistAdminResponse) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *RemoveFromWhitelistAdminResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveFromWhitelistAdminResponse.Merge(m, src)
+func (m *RemoveStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveStakeResponse.Merge(m, src)
 }
-func (m *RemoveFromWhitelistAdminResponse) XXX_Size() int {
+func (m *RemoveStakeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveFromWhitelistAdminResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveFromWhitelistAdminResponse.DiscardUnknown(m)
+func (m *RemoveStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveStakeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveFromWhitelistAdminResponse proto.InternalMessageInfo
+var xxx_messageInfo_RemoveStakeResponse proto.InternalMessageInfo
 
-type RewardDelegateStakeResponse struct {
+type CancelRemoveStakeRequest struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 }
 
-func (m *RewardDelegateStakeResponse) Reset()         { *m = RewardDelegateStakeResponse{} }
-func (m *RewardDelegateStakeResponse) String() string { return proto.CompactTextString(m) }
-func (*RewardDelegateStakeResponse) ProtoMessage()    {}
-func (*RewardDelegateStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{31}
+func (m *CancelRemoveStakeRequest) Reset()         { *m = CancelRemoveStakeRequest{} }
+func (m *CancelRemoveStakeRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelRemoveStakeRequest) ProtoMessage()    {}
+func (*CancelRemoveStakeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{32}
 }
-func (m *RewardDelegateStakeResponse) XXX_Unmarshal(b []byte) error {
+func (m *CancelRemoveStakeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RewardDelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CancelRemoveStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RewardDelegateStakeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CancelRemoveStakeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1746,36 +1970,47 @@ func (m *RewardDelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *RewardDelegateStakeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RewardDelegateStakeResponse.Merge(m, src)
+func (m *CancelRemoveStakeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelRemoveStakeRequest.Merge(m, src)
 }
-func (m *RewardDelegateStakeResponse) XXX_Size() int {
+func (m *CancelRemoveStakeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RewardDelegateStakeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RewardDelegateStakeResponse.DiscardUnknown(m)
+func (m *CancelRemoveStakeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelRemoveStakeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RewardDelegateStakeResponse proto.InternalMessageInfo
+var xxx_messageInfo_CancelRemoveStakeRequest proto.InternalMessageInfo
 
-type RewardDelegateStakeRequest struct {
-	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Reputer string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+func (m *CancelRemoveStakeRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *RewardDelegateStakeRequest) Reset()         { *m = RewardDelegateStakeRequest{} }
-func (m *RewardDelegateStakeRequest) String() string { return proto.CompactTextString(m) }
-func (*RewardDelegateStakeRequest) ProtoMessage()    {}
-func (*RewardDelegateStakeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{32}
+func (m *CancelRemoveStakeRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
 }
-func (m *RewardDelegateStakeRequest) XXX_Unmarshal(b []byte) error {
+
+type CancelRemoveStakeResponse struct {
+}
+
+func (m *CancelRemoveStakeResponse) Reset()         { *m = CancelRemoveStakeResponse{} }
+func (m *CancelRemoveStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelRemoveStakeResponse) ProtoMessage()    {}
+func (*CancelRemoveStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{33}
+}
+func (m *CancelRemoveStakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RewardDelegateStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CancelRemoveStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RewardDelegateStakeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CancelRemoveStakeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1785,3998 +2020,13782 @@ func (m *RewardDelegateStakeRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *RewardDelegateStakeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RewardDelegateStakeRequest.Merge(m, src)
+func (m *CancelRemoveStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelRemoveStakeResponse.Merge(m, src)
 }
-func (m *RewardDelegateStakeRequest) XXX_Size() int {
+func (m *CancelRemoveStakeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RewardDelegateStakeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RewardDelegateStakeRequest.DiscardUnknown(m)
+func (m *CancelRemoveStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelRemoveStakeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RewardDelegateStakeRequest proto.InternalMessageInfo
+var xxx_messageInfo_CancelRemoveStakeResponse proto.InternalMessageInfo
 
-func (m *RewardDelegateStakeRequest) GetSender() string {
-	if m != nil {
-		return m.Sender
-	}
-	return ""
+type DelegateStakeRequest struct {
+	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reputer string                `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func (m *RewardDelegateStakeRequest) GetTopicId() uint64 {
-	if m != nil {
-		return m.TopicId
-	}
-	return 0
+func (m *DelegateStakeRequest) Reset()         { *m = DelegateStakeRequest{} }
+func (m *DelegateStakeRequest) String() string { return proto.CompactTextString(m) }
+func (*DelegateStakeRequest) ProtoMessage()    {}
+func (*DelegateStakeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{34}
 }
-
-func (m *RewardDelegateStakeRequest) GetReputer() string {
-	if m != nil {
-		return m.Reputer
-	}
-	return ""
+func (m *DelegateStakeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func init() {
-	proto.RegisterType((*OptionalParams)(nil), "emissions.v5.OptionalParams")
-	proto.RegisterType((*UpdateParamsRequest)(nil), "emissions.v5.UpdateParamsRequest")
-	proto.RegisterType((*UpdateParamsResponse)(nil), "emissions.v5.UpdateParamsResponse")
-	proto.RegisterType((*CreateNewTopicRequest)(nil), "emissions.v5.CreateNewTopicRequest")
-	proto.RegisterType((*CreateNewTopicResponse)(nil), "emissions.v5.CreateNewTopicResponse")
-	proto.RegisterType((*InsertReputerPayloadRequest)(nil), "emissions.v5.InsertReputerPayloadRequest")
-	proto.RegisterType((*InsertReputerPayloadResponse)(nil), "emissions.v5.InsertReputerPayloadResponse")
-	proto.RegisterType((*InsertWorkerPayloadRequest)(nil), "emissions.v5.InsertWorkerPayloadRequest")
-	proto.RegisterType((*InsertWorkerPayloadResponse)(nil), "emissions.v5.InsertWorkerPayloadResponse")
-	proto.RegisterType((*RegisterRequest)(nil), "emissions.v5.RegisterRequest")
-	proto.RegisterType((*RegisterResponse)(nil), "emissions.v5.RegisterResponse")
-	proto.RegisterType((*RemoveRegistrationRequest)(nil), "emissions.v5.RemoveRegistrationRequest")
-	proto.RegisterType((*RemoveRegistrationResponse)(nil), "emissions.v5.RemoveRegistrationResponse")
-	proto.RegisterType((*AddStakeRequest)(nil), "emissions.v5.AddStakeRequest")
-	proto.RegisterType((*AddStakeResponse)(nil), "emissions.v5.AddStakeResponse")
-	proto.RegisterType((*RemoveStakeRequest)(nil), "emissions.v5.RemoveStakeRequest")
-	proto.RegisterType((*RemoveStakeResponse)(nil), "emissions.v5.RemoveStakeResponse")
-	proto.RegisterType((*CancelRemoveStakeRequest)(nil), "emissions.v5.CancelRemoveStakeRequest")
-	proto.RegisterType((*CancelRemoveStakeResponse)(nil), "emissions.v5.CancelRemoveStakeResponse")
-	proto.RegisterType((*DelegateStakeRequest)(nil), "emissions.v5.DelegateStakeRequest")
-	proto.RegisterType((*DelegateStakeResponse)(nil), "emissions.v5.DelegateStakeResponse")
-	proto.RegisterType((*RemoveDelegateStakeRequest)(nil), "emissions.v5.RemoveDelegateStakeRequest")
-	proto.RegisterType((*RemoveDelegateStakeResponse)(nil), "emissions.v5.RemoveDelegateStakeResponse")
-	proto.RegisterType((*CancelRemoveDelegateStakeRequest)(nil), "emissions.v5.CancelRemoveDelegateStakeRequest")
-	proto.RegisterType((*CancelRemoveDelegateStakeResponse)(nil), "emissions.v5.CancelRemoveDelegateStakeResponse")
-	proto.RegisterType((*FundTopicRequest)(nil), "emissions.v5.FundTopicRequest")
-	proto.RegisterType((*FundTopicResponse)(nil), "emissions.v5.FundTopicResponse")
-	proto.RegisterType((*AddToWhitelistAdminRequest)(nil), "emissions.v5.AddToWhitelistAdminRequest")
-	proto.RegisterType((*AddToWhitelistAdminResponse)(nil), "emissions.v5.AddToWhitelistAdminResponse")
-	proto.RegisterType((*RemoveFromWhitelistAdminRequest)(nil), "emissions.v5.RemoveFromWhitelistAdminRequest")
-	proto.RegisterType((*RemoveFromWhitelistAdminResponse)(nil), "emissions.v5.RemoveFromWhitelistAdminResponse")
-	proto.RegisterType((*RewardDelegateStakeResponse)(nil), "emissions.v5.RewardDelegateStakeResponse")
-	proto.RegisterType((*RewardDelegateStakeRequest)(nil), "emissions.v5.RewardDelegateStakeRequest")
+func (m *DelegateStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateStakeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func init() { proto.RegisterFile("emissions/v5/tx.proto", fileDescriptor_6928f235d40e7988) }
-
-var fileDescriptor_6928f235d40e7988 = []byte{
-	// 2631 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x73, 0x1c, 0x47,
-	0x15, 0xf7, 0x5a, 0x2b, 0x69, 0xf5, 0x24, 0x4b, 0xab, 0xb6, 0x24, 0x8f, 0x56, 0x9f, 0x96, 0x9c,
-	0x44, 0x31, 0xb1, 0x36, 0x89, 0x49, 0x11, 0x02, 0x07, 0xec, 0xd8, 0x0a, 0x52, 0x59, 0x46, 0x19,
-	0x29, 0x31, 0xe5, 0x50, 0x4c, 0x5a, 0x33, 0xbd, 0xbb, 0x83, 0x66, 0xa6, 0x27, 0xdd, 0xbd, 0x2b,
-	0x29, 0xc5, 0x81, 0xa2, 0x8a, 0x33, 0x9c, 0x38, 0x00, 0xc5, 0x99, 0x13, 0xe4, 0xc0, 0x89, 0x53,
-	0xaa, 0xb8, 0xe4, 0x98, 0xe2, 0x44, 0x71, 0x48, 0x51, 0xc9, 0x21, 0xff, 0x06, 0xd5, 0x1f, 0x33,
-	0x3b, 0xb3, 0xda, 0x95, 0x15, 0x8f, 0xa8, 0xca, 0x25, 0xf1, 0x74, 0xbf, 0xfe, 0xbd, 0xd7, 0xbf,
-	0x7e, 0x9f, 0x5b, 0x82, 0x59, 0x12, 0xfa, 0x9c, 0xfb, 0x34, 0xe2, 0xf5, 0xce, 0x1b, 0x75, 0x71,
-	0xb2, 0x19, 0x33, 0x2a, 0x28, 0x9a, 0x48, 0x97, 0x37, 0x3b, 0x6f, 0xd4, 0xa6, 0x71, 0xe8, 0x47,
-	0xb4, 0xae, 0xfe, 0xab, 0x05, 0x6a, 0x37, 0x5c, 0xca, 0x43, 0xca, 0xeb, 0x21, 0x6f, 0xd6, 0x3b,
-	0xaf, 0xc9, 0xff, 0x99, 0x8d, 0x79, 0xbd, 0xe1, 0xa8, 0xaf, 0xba, 0xfe, 0x30, 0x5b, 0xb5, 0x8c,
-	0xae, 0xbb, 0x75, 0x46, 0xe2, 0xb6, 0x20, 0x2c, 0x39, 0x96, 0xdb, 0x3b, 0xa6, 0xec, 0x28, 0xdd,
-	0x9a, 0x69, 0xd2, 0x26, 0xd5, 0x70, 0xf2, 0x5f, 0x7a, 0x75, 0xed, 0x6f, 0x4b, 0x30, 0xf9, 0x93,
-	0x58, 0xf8, 0x34, 0xc2, 0xc1, 0x1e, 0x66, 0x38, 0xe4, 0xc8, 0x82, 0xd1, 0x0e, 0x61, 0x12, 0xc4,
-	0x2a, 0xad, 0x0e, 0x6d, 0x8c, 0xd9, 0xc9, 0x27, 0xfa, 0x3e, 0xcc, 0x87, 0xf8, 0xc4, 0xe1, 0x84,
-	0xf9, 0x38, 0xf0, 0x3f, 0x26, 0x9e, 0x13, 0xf2, 0xa6, 0x13, 0x90, 0xa8, 0x29, 0x5a, 0xd6, 0xd5,
-	0xd5, 0xa1, 0x8d, 0x21, 0x7b, 0x2e, 0xc4, 0x27, 0xfb, 0xe9, 0xfe, 0x2e, 0x6f, 0x3e, 0x52, 0xbb,
-	0x08, 0x43, 0x35, 0xf4, 0x23, 0x47, 0xd0, 0xd8, 0x77, 0x9d, 0x63, 0xe2, 0x37, 0x5b, 0xc2, 0x1a,
-	0x92, 0xe8, 0xf7, 0xbf, 0xf7, 0xd9, 0x17, 0x2b, 0x57, 0xfe, 0xf3, 0xc5, 0x4a, 0xbd, 0xe9, 0x8b,
-	0x56, 0xfb, 0x70, 0xd3, 0xa5, 0x61, 0x1d, 0x07, 0x01, 0x65, 0xf8, 0x4e, 0x44, 0x84, 0xbc, 0x42,
-	0xf2, 0xe9, 0xb6, 0xb0, 0x1f, 0xd5, 0x43, 0x2c, 0x5a, 0x9b, 0x0f, 0x88, 0x6b, 0x4f, 0x86, 0x7e,
-	0x74, 0x20, 0xf1, 0x9e, 0x28, 0x38, 0xd4, 0x80, 0x39, 0x46, 0x3e, 0x6a, 0xfb, 0x4c, 0xda, 0xe5,
-	0x47, 0x7e, 0xd8, 0x0e, 0x1d, 0x2e, 0xf0, 0x11, 0xb1, 0x86, 0x95, 0xa2, 0x57, 0x8d, 0xa2, 0x59,
-	0xcd, 0x26, 0xf7, 0x8e, 0x36, 0x7d, 0xaa, 0xe1, 0xb6, 0x23, 0xf1, 0xaf, 0xbf, 0xdf, 0x01, 0x43,
-	0xf3, 0x76, 0x24, 0xfe, 0xf2, 0xf5, 0x27, 0xb7, 0x4b, 0xf6, 0x4c, 0x82, 0xb7, 0xab, 0xe1, 0xf6,
-	0x25, 0x9a, 0x64, 0x81, 0x91, 0x90, 0x76, 0x88, 0x46, 0x77, 0x3c, 0x12, 0xe0, 0x53, 0xe7, 0xd8,
-	0x8f, 0x3c, 0x7a, 0x6c, 0x8d, 0x68, 0x16, 0xb4, 0x80, 0x92, 0x7f, 0x20, 0xb7, 0x9f, 0xa8, 0x5d,
-	0xb4, 0xa1, 0x59, 0x20, 0x31, 0x75, 0x5b, 0x09, 0x6f, 0xa3, 0xea, 0x84, 0xbc, 0xcc, 0x43, 0xb9,
-	0x6c, 0xf8, 0x7a, 0x0a, 0x13, 0x87, 0x44, 0x60, 0x87, 0x44, 0x82, 0xd1, 0xf8, 0xd4, 0xaa, 0x14,
-	0xe3, 0x6a, 0x5c, 0x82, 0x3d, 0xd4, 0x58, 0xe8, 0x67, 0x70, 0x2d, 0x20, 0x98, 0x45, 0x7e, 0xd4,
-	0x74, 0x18, 0x16, 0xc4, 0x1a, 0x2b, 0x06, 0x3e, 0x91, 0xa0, 0xd9, 0x58, 0x10, 0x14, 0x82, 0xf4,
-	0x01, 0xa7, 0xc9, 0xb0, 0xe7, 0x93, 0x48, 0x38, 0xa2, 0xc5, 0x08, 0x6f, 0xd1, 0xc0, 0xb3, 0xa0,
-	0x98, 0x9a, 0x99, 0x10, 0x9f, 0xbc, 0x63, 0x50, 0x0f, 0x12, 0x50, 0x44, 0x00, 0x49, 0x4a, 0xf5,
-	0x53, 0x34, 0x18, 0x76, 0xa5, 0x2f, 0x5b, 0xe3, 0xc5, 0x54, 0xc9, 0x57, 0x52, 0x8f, 0xb7, 0x65,
-	0x00, 0xd1, 0x43, 0x58, 0x91, 0xb7, 0x6a, 0x47, 0x8d, 0x76, 0xd0, 0xf0, 0x83, 0x80, 0x78, 0x8e,
-	0x8e, 0x2e, 0x47, 0xfa, 0x08, 0xe1, 0x82, 0x5b, 0xd7, 0x56, 0x87, 0x36, 0xca, 0xf6, 0x62, 0x88,
-	0x4f, 0xde, 0xeb, 0x4a, 0x3d, 0x51, 0x42, 0xb6, 0x91, 0x41, 0xef, 0xc0, 0x6a, 0x2f, 0x8c, 0x09,
-	0xe0, 0x2e, 0xce, 0xa4, 0xc2, 0x59, 0xca, 0xe3, 0xd8, 0x5a, 0x2a, 0x05, 0xfa, 0x18, 0x96, 0x74,
-	0x2c, 0x31, 0x72, 0x8c, 0x99, 0x67, 0xee, 0xef, 0x87, 0x31, 0x65, 0x02, 0x47, 0x2e, 0xb1, 0xa6,
-	0x8a, 0x31, 0x50, 0x53, 0xe8, 0xb6, 0x02, 0x57, 0x4c, 0x6c, 0xa7, 0xd0, 0xe8, 0x37, 0x25, 0x58,
-	0xcf, 0x29, 0x6f, 0x10, 0xe2, 0x30, 0xd2, 0x21, 0x51, 0x3b, 0x67, 0x42, 0xb5, 0x98, 0x09, 0x2b,
-	0x19, 0x13, 0xb6, 0x08, 0xb1, 0xb5, 0x82, 0x8c, 0x1d, 0x04, 0x50, 0xce, 0x0c, 0x1c, 0xc4, 0x2d,
-	0x6c, 0x4d, 0x17, 0x7c, 0xfa, 0x8c, 0xd6, 0x7b, 0x12, 0x10, 0xb9, 0x30, 0x2d, 0x30, 0x3f, 0xca,
-	0x6b, 0x41, 0xc5, 0xb4, 0x4c, 0x49, 0xc4, 0xac, 0x12, 0xc9, 0x69, 0x07, 0x07, 0xbe, 0x87, 0x05,
-	0x65, 0xdc, 0xe9, 0x70, 0x47, 0x1f, 0x74, 0x62, 0xc2, 0x5c, 0x19, 0x46, 0x5a, 0xbb, 0x75, 0xbd,
-	0x20, 0xa7, 0x5d, 0x1d, 0xef, 0xf3, 0x7b, 0x4a, 0x64, 0x4f, 0x2b, 0xd0, 0xc6, 0xa0, 0x1f, 0xc2,
-	0x82, 0x4a, 0xf1, 0x38, 0x8c, 0x03, 0xc2, 0x1d, 0x41, 0x1d, 0xee, 0xe2, 0x80, 0x38, 0xdc, 0xa5,
-	0x8c, 0x70, 0x6b, 0x46, 0xf9, 0xe6, 0x0d, 0x99, 0xe4, 0xb5, 0xc4, 0x01, 0xdd, 0x97, 0xfb, 0xfb,
-	0x6a, 0x1b, 0xbd, 0x05, 0x35, 0x79, 0x5a, 0xd0, 0xd8, 0xf1, 0xa3, 0x06, 0x61, 0x84, 0x29, 0x08,
-	0x63, 0xfb, 0xac, 0x3a, 0x2c, 0xb3, 0xc3, 0x01, 0x8d, 0xb7, 0xcd, 0xfe, 0x01, 0x35, 0x9a, 0x7f,
-	0x04, 0x4b, 0xc9, 0xd9, 0x06, 0x65, 0xc4, 0xc5, 0x5c, 0xe4, 0x8f, 0xcf, 0xa9, 0xe3, 0xf3, 0xfa,
-	0xf8, 0x56, 0x57, 0x24, 0x45, 0xc8, 0x68, 0x37, 0x41, 0x95, 0x3d, 0x7e, 0x23, 0xab, 0xdd, 0x84,
-	0x53, 0xf7, 0xec, 0x53, 0xa8, 0xba, 0x8c, 0x60, 0x41, 0x4c, 0x89, 0x6a, 0x10, 0x62, 0x59, 0xcf,
-	0x59, 0x36, 0x26, 0x35, 0x92, 0xaa, 0x4d, 0x5b, 0x84, 0xa0, 0x1f, 0x40, 0x2d, 0xcd, 0x86, 0x1e,
-	0xe1, 0xea, 0x39, 0xa5, 0xa1, 0xbe, 0xb4, 0xc0, 0x9a, 0xd7, 0x94, 0x26, 0x12, 0x0f, 0xb4, 0xc0,
-	0x2e, 0x3e, 0xd9, 0x96, 0xdb, 0xe8, 0x03, 0xa8, 0x32, 0xd2, 0xf4, 0xb9, 0x60, 0x58, 0x26, 0x22,
-	0x65, 0xd8, 0xe2, 0x73, 0x1a, 0x36, 0x95, 0x45, 0x92, 0x96, 0xbd, 0x02, 0xc8, 0x23, 0x0d, 0xdc,
-	0x0e, 0x84, 0x13, 0xe3, 0x26, 0x71, 0x02, 0x3f, 0xf4, 0x85, 0xb5, 0xa4, 0x2c, 0xaa, 0x9a, 0x9d,
-	0x3d, 0xdc, 0x24, 0x8f, 0xe4, 0x3a, 0xba, 0x05, 0x93, 0xd2, 0xec, 0x8c, 0xe4, 0xb2, 0x92, 0x9c,
-	0x08, 0xf1, 0x49, 0x57, 0x4a, 0xbe, 0x63, 0x4f, 0x8d, 0x73, 0x18, 0x71, 0x29, 0xf3, 0xcc, 0xa1,
-	0x15, 0x55, 0xf0, 0xe6, 0xf3, 0x05, 0xcf, 0x56, 0x12, 0x1a, 0x61, 0x03, 0xaa, 0x87, 0x01, 0x75,
-	0x8f, 0xb8, 0x74, 0x7e, 0x27, 0xa4, 0x91, 0x68, 0x59, 0xab, 0x4a, 0xd3, 0xa4, 0x5e, 0xdf, 0x23,
-	0x6c, 0x57, 0xae, 0xca, 0x0c, 0x10, 0x27, 0x71, 0xa9, 0x1d, 0x4e, 0xe6, 0x9d, 0x9b, 0x05, 0x33,
-	0x40, 0xac, 0x7d, 0x62, 0x3b, 0x01, 0x94, 0x19, 0x20, 0x55, 0x93, 0xf8, 0xa6, 0xb5, 0x56, 0x30,
-	0x03, 0x18, 0x2d, 0x89, 0x23, 0xcb, 0x0e, 0x29, 0x55, 0x62, 0xdc, 0xd7, 0x5a, 0x2f, 0xd8, 0x21,
-	0x19, 0x1d, 0xc6, 0xdb, 0x25, 0x5d, 0xee, 0x59, 0xba, 0x6e, 0x15, 0xa4, 0xcb, 0xed, 0x43, 0x97,
-	0x7b, 0x86, 0xae, 0x17, 0x0a, 0xd2, 0xe5, 0xf6, 0xd0, 0xf5, 0x18, 0x46, 0x5c, 0x27, 0xa2, 0x2c,
-	0xb4, 0x5e, 0x2c, 0x86, 0x3c, 0xec, 0x3e, 0xa6, 0x2c, 0x44, 0x1f, 0xc2, 0x14, 0x89, 0xb9, 0x1f,
-	0xd0, 0x28, 0x65, 0x7f, 0xa3, 0x20, 0xfb, 0x06, 0x2f, 0x61, 0xff, 0x7d, 0x78, 0xb9, 0x85, 0x83,
-	0x86, 0x0a, 0xfd, 0x98, 0x51, 0x97, 0x70, 0x6e, 0xca, 0xb6, 0xea, 0x16, 0x71, 0xc0, 0x1d, 0x12,
-	0x79, 0x8e, 0x72, 0x71, 0xeb, 0xb6, 0xf2, 0xf7, 0x75, 0x79, 0x60, 0x17, 0x9f, 0xec, 0x69, 0x71,
-	0x55, 0x88, 0x6d, 0x23, 0xfc, 0x30, 0xf2, 0xee, 0x4b, 0x51, 0x99, 0xba, 0x3c, 0x2c, 0xb0, 0xc3,
-	0x49, 0xe4, 0xc9, 0x96, 0x4e, 0x66, 0x88, 0xef, 0x3c, 0x6f, 0xea, 0x92, 0x48, 0xfb, 0x1a, 0x48,
-	0x26, 0x08, 0x0c, 0xd5, 0x84, 0x15, 0x8e, 0x1b, 0xc4, 0xf1, 0xfc, 0x8e, 0xf5, 0xca, 0xe5, 0xd0,
-	0xb2, 0x8f, 0x1b, 0xe4, 0x81, 0xdf, 0x49, 0x86, 0x0a, 0x12, 0x90, 0x90, 0x44, 0x42, 0xc7, 0x7c,
-	0xea, 0x35, 0x77, 0xd2, 0xa4, 0xfd, 0xd0, 0xec, 0xef, 0x11, 0x96, 0xfa, 0x80, 0x29, 0x56, 0xb2,
-	0x45, 0xeb, 0x98, 0xc4, 0xad, 0xcf, 0x6b, 0x0e, 0x37, 0xd3, 0x62, 0x75, 0x4f, 0x49, 0xa8, 0x84,
-	0x2c, 0x01, 0x34, 0x6f, 0xb7, 0x61, 0x5a, 0x95, 0x3a, 0xc1, 0x24, 0x6b, 0xa6, 0x1b, 0xaf, 0xab,
-	0x33, 0x53, 0xb2, 0xc0, 0xa9, 0x75, 0xd3, 0x8e, 0x53, 0xb8, 0xe1, 0x47, 0xbe, 0xf0, 0x71, 0xe0,
-	0x30, 0xd2, 0x64, 0x44, 0x38, 0x1f, 0xb5, 0x71, 0x24, 0xfc, 0x80, 0x58, 0xaf, 0x16, 0xa3, 0x63,
-	0xd6, 0xe0, 0xda, 0x0a, 0xf6, 0x5d, 0x83, 0x8a, 0x7e, 0x0e, 0x53, 0xb1, 0x72, 0xef, 0x2e, 0xef,
-	0xaf, 0x15, 0xec, 0xd2, 0x63, 0xe9, 0xe7, 0x86, 0xf5, 0x9d, 0x72, 0xa5, 0x5c, 0x1d, 0xde, 0x29,
-	0x57, 0x6a, 0xd5, 0x85, 0x9d, 0x72, 0x65, 0xa1, 0xba, 0xb8, 0x53, 0xae, 0xbc, 0x54, 0xdd, 0xd8,
-	0x29, 0x57, 0x5e, 0xae, 0xde, 0x56, 0xad, 0xf6, 0x19, 0x3e, 0xed, 0x05, 0x95, 0xd7, 0x1b, 0x0d,
-	0x92, 0xe1, 0x3b, 0xe9, 0xfb, 0xec, 0x75, 0x79, 0x84, 0x11, 0xc1, 0x7c, 0xdd, 0x36, 0xe8, 0xce,
-	0xd5, 0x89, 0x68, 0xe4, 0x12, 0x6e, 0x7a, 0x65, 0x7b, 0x31, 0x2d, 0xae, 0x69, 0xbf, 0xe8, 0x11,
-	0x17, 0x9f, 0xaa, 0x31, 0xc4, 0xbe, 0x75, 0x2e, 0x84, 0x89, 0xca, 0xb5, 0x18, 0xae, 0xbf, 0x17,
-	0x7b, 0x58, 0x10, 0x3d, 0xae, 0x9a, 0x8e, 0x18, 0xcd, 0xc1, 0x88, 0x0c, 0x00, 0xc2, 0xac, 0xd2,
-	0x6a, 0x69, 0x63, 0xcc, 0x36, 0x5f, 0xe8, 0xbb, 0x30, 0x12, 0x2b, 0x41, 0xeb, 0xea, 0x6a, 0x69,
-	0x63, 0xfc, 0xf5, 0xc5, 0xcd, 0xec, 0x4c, 0xbe, 0x99, 0x9f, 0x7d, 0x6d, 0x23, 0xfb, 0xd6, 0xf8,
-	0xaf, 0xbf, 0xfe, 0xe4, 0xb6, 0x81, 0x58, 0x9b, 0x83, 0x99, 0xbc, 0x46, 0x1e, 0xd3, 0x88, 0x93,
-	0xb5, 0x7f, 0x56, 0x60, 0xf6, 0x6d, 0x55, 0xea, 0x1f, 0x93, 0xe3, 0x03, 0xdd, 0x36, 0x6a, 0x63,
-	0x2c, 0x18, 0x55, 0x3d, 0x00, 0x4d, 0xac, 0x49, 0x3e, 0x51, 0x0d, 0x2a, 0x21, 0x11, 0x58, 0x86,
-	0x99, 0x32, 0x68, 0xcc, 0x4e, 0xbf, 0xd1, 0x0a, 0x8c, 0x07, 0x94, 0x73, 0x27, 0x24, 0xa2, 0x45,
-	0x3d, 0xab, 0xac, 0xb6, 0x41, 0x2e, 0xed, 0xaa, 0x15, 0x74, 0x13, 0x26, 0x7a, 0x46, 0xc7, 0xd2,
-	0xc6, 0x90, 0x3d, 0x4e, 0x32, 0x73, 0xe3, 0x06, 0x54, 0x9b, 0x8c, 0xb6, 0x23, 0xcf, 0x11, 0xac,
-	0x2d, 0x5a, 0x4e, 0x80, 0x9b, 0x56, 0x45, 0x89, 0x4d, 0xea, 0xf5, 0x03, 0xb9, 0xfc, 0x08, 0x37,
-	0x65, 0x02, 0xd5, 0x1e, 0x66, 0x81, 0x54, 0x54, 0x20, 0x81, 0x2a, 0xc7, 0x92, 0x13, 0xab, 0x6a,
-	0x8d, 0x4d, 0x80, 0x58, 0xe3, 0xc5, 0x50, 0xc7, 0x15, 0x98, 0x8e, 0x0a, 0xf4, 0x02, 0x4c, 0x4a,
-	0xa9, 0x63, 0x27, 0x22, 0x4d, 0x2c, 0x9d, 0xcf, 0x9a, 0x58, 0x2d, 0x6d, 0x54, 0xec, 0x6b, 0x6a,
-	0xf5, 0xb1, 0x59, 0x44, 0xef, 0xc2, 0xa8, 0x49, 0x2e, 0xd6, 0xb5, 0x62, 0xda, 0x13, 0x1c, 0xf4,
-	0x26, 0x58, 0x66, 0xce, 0xe3, 0xed, 0x43, 0xe3, 0x38, 0xc9, 0xac, 0x3f, 0xa9, 0x78, 0x9d, 0xd3,
-	0xfb, 0xfb, 0xe9, 0xb6, 0x99, 0xf5, 0x8f, 0x60, 0x36, 0x24, 0xcc, 0x17, 0x0e, 0xa7, 0x4c, 0xf8,
-	0xaa, 0x77, 0xd3, 0xa3, 0xc3, 0x54, 0x31, 0xd3, 0xae, 0x2b, 0xd4, 0xfd, 0x04, 0x54, 0x8f, 0x0f,
-	0x14, 0x6e, 0x98, 0x2c, 0x68, 0xfa, 0xee, 0x6e, 0x7e, 0xaa, 0x16, 0x53, 0x37, 0xab, 0x71, 0x4d,
-	0xbb, 0x9e, 0xe6, 0xa7, 0x36, 0xd4, 0x8c, 0xc2, 0x6e, 0xb3, 0xde, 0xd5, 0x39, 0x5d, 0x4c, 0xa7,
-	0xa5, 0xa1, 0xbb, 0x3d, 0x7e, 0xaa, 0xb6, 0x7b, 0xcf, 0x64, 0x6c, 0x4e, 0x75, 0xa2, 0x4b, 0xb9,
-	0xa7, 0x29, 0xd6, 0x89, 0xc2, 0xb7, 0x26, 0x64, 0x22, 0x48, 0xa2, 0x77, 0xa7, 0x5c, 0x19, 0xaa,
-	0x96, 0x77, 0xca, 0x95, 0xe1, 0xea, 0xc8, 0x4e, 0xb9, 0x32, 0x52, 0x1d, 0xdd, 0x29, 0x57, 0xc6,
-	0xaa, 0xa0, 0x83, 0xd4, 0x09, 0x68, 0xd3, 0x77, 0xed, 0xa9, 0xb4, 0xb3, 0x32, 0x0b, 0xd5, 0xee,
-	0x82, 0x8e, 0x6c, 0x7b, 0x3c, 0x69, 0xba, 0x31, 0x6b, 0xae, 0xdd, 0x85, 0xb9, 0xde, 0x24, 0xa2,
-	0xf3, 0x0b, 0x9a, 0x87, 0x8a, 0xce, 0x97, 0xbe, 0xa7, 0xd2, 0x48, 0xd9, 0x1e, 0x55, 0xdf, 0xdb,
-	0xde, 0xda, 0xef, 0x4b, 0xb0, 0xb0, 0x1d, 0x71, 0xc2, 0x84, 0x31, 0x78, 0x0f, 0x9f, 0x06, 0x14,
-	0x7b, 0xcf, 0xca, 0x86, 0x36, 0xcc, 0x24, 0xc4, 0x75, 0x70, 0xd0, 0x26, 0xce, 0x61, 0x3b, 0xf2,
-	0x02, 0x62, 0x72, 0xe3, 0x6a, 0x36, 0x37, 0xde, 0xdd, 0x34, 0xd0, 0xef, 0x4b, 0xc1, 0xfb, 0x4a,
-	0xce, 0x46, 0xec, 0xcc, 0x5a, 0x3e, 0x57, 0x2e, 0xc3, 0x62, 0x7f, 0xbb, 0x4c, 0xce, 0xfc, 0x6d,
-	0x09, 0x6a, 0x5a, 0x40, 0xff, 0x32, 0x72, 0x41, 0xbb, 0x1f, 0x01, 0x32, 0x61, 0xa8, 0x5a, 0x9d,
-	0x9c, 0xd5, 0xcb, 0x79, 0xab, 0x35, 0xee, 0x03, 0x2c, 0xb0, 0xb1, 0xb9, 0x7a, 0xdc, 0xb3, 0x92,
-	0xb7, 0x78, 0x29, 0x61, 0xb2, 0xc7, 0x20, 0x63, 0xf0, 0x5f, 0x4b, 0x30, 0x65, 0xab, 0xb1, 0x29,
-	0xfd, 0xf5, 0x65, 0xa0, 0x95, 0xd9, 0x07, 0x2b, 0xe7, 0x1e, 0x0c, 0xcd, 0xc0, 0x30, 0x3d, 0x8e,
-	0x08, 0xb3, 0x86, 0xd5, 0x09, 0xfd, 0x81, 0x96, 0x00, 0xfc, 0xb4, 0xb2, 0x59, 0x23, 0x2a, 0xa7,
-	0x8d, 0xf9, 0xdc, 0x70, 0x97, 0xb3, 0x73, 0xa7, 0x5c, 0xb9, 0x5a, 0x1d, 0xd2, 0x1e, 0x68, 0x8f,
-	0x07, 0xfe, 0xa1, 0x13, 0xbf, 0x1e, 0x3b, 0x47, 0xe4, 0xd4, 0xbe, 0x16, 0xb6, 0x03, 0xe1, 0x3b,
-	0xd8, 0xf3, 0x18, 0xe1, 0x7c, 0x6d, 0x0b, 0xaa, 0x5d, 0x7b, 0x8d, 0x27, 0x59, 0x30, 0xca, 0xdb,
-	0xae, 0x6c, 0x21, 0x95, 0xc5, 0x15, 0x3b, 0xf9, 0x94, 0x3b, 0x21, 0xe1, 0x1c, 0x37, 0x89, 0x29,
-	0x47, 0xc9, 0xe7, 0xda, 0xc7, 0x30, 0xaf, 0x5a, 0x4d, 0x62, 0x67, 0x86, 0xc6, 0x6f, 0xc2, 0xc0,
-	0xd5, 0x3c, 0x03, 0xf9, 0xbb, 0x0e, 0x9d, 0x77, 0xd7, 0xb5, 0x3d, 0xa8, 0xf5, 0xd3, 0x5d, 0xe0,
-	0x36, 0x7f, 0x2c, 0xc1, 0xd4, 0x3d, 0xcf, 0x33, 0x1d, 0xf4, 0x73, 0x5f, 0xe2, 0xc7, 0x30, 0x82,
-	0x43, 0xda, 0x8e, 0x84, 0xba, 0xc0, 0xf3, 0x74, 0xd8, 0xe6, 0x7c, 0xfe, 0xbe, 0x08, 0xaa, 0x5d,
-	0xe3, 0x8c, 0xe3, 0xfd, 0xb9, 0x04, 0xc8, 0xee, 0xfe, 0x8c, 0xfc, 0xed, 0x33, 0x7a, 0x16, 0xae,
-	0xe7, 0xec, 0x33, 0x76, 0x3f, 0x05, 0xeb, 0x6d, 0x1c, 0xb9, 0x24, 0xb8, 0x14, 0xe3, 0xf3, 0x2a,
-	0x17, 0x60, 0xbe, 0x0f, 0xb6, 0x51, 0xfc, 0x8f, 0x12, 0xcc, 0x3c, 0x20, 0x81, 0x6c, 0x06, 0x0a,
-	0x53, 0x66, 0xc1, 0x68, 0xd6, 0x53, 0xc7, 0xec, 0xe4, 0x33, 0x43, 0x66, 0xf9, 0x32, 0xc9, 0xbc,
-	0x01, 0xb3, 0x3d, 0xb6, 0x9b, 0x5b, 0x7d, 0x5a, 0x4a, 0x62, 0xe1, 0x1b, 0xdd, 0x2d, 0x73, 0x81,
-	0xab, 0xf9, 0x0b, 0x64, 0x6f, 0x3d, 0x34, 0xc8, 0x51, 0x2e, 0xf5, 0x6e, 0x4b, 0xb0, 0xd0, 0xf7,
-	0x06, 0xe6, 0x86, 0x7f, 0x28, 0xc1, 0x6a, 0xf6, 0x55, 0x2f, 0xeb, 0x0d, 0x17, 0x61, 0xcc, 0xd3,
-	0x50, 0x34, 0x79, 0xc5, 0xee, 0x42, 0x96, 0xa0, 0x72, 0x8e, 0xa0, 0xbc, 0xed, 0xeb, 0x70, 0xf3,
-	0x1c, 0xdb, 0xcc, 0x0d, 0xfe, 0x54, 0x82, 0xea, 0x96, 0xec, 0xad, 0xb3, 0x33, 0xc0, 0xb7, 0x27,
-	0x50, 0xaf, 0xc3, 0x74, 0xc6, 0x3a, 0x63, 0xf3, 0x07, 0x50, 0xbb, 0xe7, 0x79, 0x07, 0xf4, 0x49,
-	0xcb, 0x17, 0x24, 0xf0, 0xb9, 0xb8, 0xe7, 0x85, 0x7e, 0x74, 0x01, 0xb7, 0x32, 0x75, 0x26, 0x71,
-	0x2b, 0xf3, 0x79, 0xe6, 0xc5, 0xfb, 0x82, 0x1b, 0xdd, 0x1f, 0xc2, 0x8a, 0xa6, 0x73, 0x8b, 0xd1,
-	0xf0, 0xff, 0x62, 0xc0, 0x1a, 0xac, 0x0e, 0xd6, 0x60, 0xac, 0x50, 0x6e, 0x79, 0x8c, 0x99, 0xd7,
-	0xff, 0x51, 0x3b, 0x32, 0xee, 0xfa, 0x6c, 0x5f, 0x7e, 0x4e, 0xc9, 0x99, 0xfe, 0xfa, 0xa7, 0x13,
-	0x00, 0xbb, 0xbc, 0xb9, 0x4f, 0x58, 0xc7, 0x77, 0x09, 0x7a, 0x0f, 0x26, 0xb2, 0xc3, 0x27, 0xba,
-	0x99, 0x9f, 0x5f, 0xfb, 0x8c, 0xc2, 0xb5, 0xb5, 0xf3, 0x44, 0x4c, 0x0d, 0xfd, 0x00, 0x26, 0xf3,
-	0x5d, 0x27, 0x5a, 0xcf, 0x9f, 0xea, 0x3b, 0xd8, 0xd6, 0x6e, 0x9d, 0x2f, 0x64, 0xc0, 0xb7, 0xa1,
-	0x92, 0xb4, 0x20, 0x68, 0x29, 0x7f, 0xa2, 0xa7, 0x95, 0xaa, 0x2d, 0x0f, 0xda, 0x36, 0x50, 0xcd,
-	0xa4, 0x08, 0x66, 0x3b, 0x01, 0xf4, 0x52, 0xef, 0xa9, 0x01, 0x7d, 0x4a, 0x6d, 0xe3, 0xd9, 0x82,
-	0x5d, 0x9b, 0x93, 0x12, 0xdc, 0x6b, 0x73, 0x4f, 0xdf, 0xd0, 0x6b, 0x73, 0x6f, 0xe5, 0x46, 0x36,
-	0x8c, 0x67, 0xea, 0x13, 0x5a, 0xed, 0x67, 0x43, 0x0e, 0xf0, 0xe6, 0x39, 0x12, 0x06, 0xd3, 0x83,
-	0xe9, 0x33, 0x95, 0x0f, 0xbd, 0xd8, 0xf3, 0x1a, 0x03, 0xca, 0x6e, 0xed, 0xa5, 0x67, 0xca, 0x19,
-	0x2d, 0x3f, 0x85, 0x6b, 0x39, 0x6f, 0x47, 0x3d, 0xae, 0xd4, 0x2f, 0x14, 0x6a, 0xeb, 0xe7, 0xca,
-	0x18, 0xe4, 0x5f, 0xc8, 0x66, 0xe1, 0x4c, 0x34, 0xa1, 0x33, 0xef, 0x33, 0x28, 0xe0, 0x6a, 0x2f,
-	0x5f, 0x40, 0x32, 0xab, 0xeb, 0x4c, 0xb6, 0x46, 0x7d, 0x7d, 0xe1, 0x62, 0xba, 0x06, 0xa6, 0x7e,
-	0xf4, 0xcb, 0x7c, 0x47, 0x92, 0xd7, 0xb8, 0x39, 0x98, 0xf7, 0xbe, 0x7a, 0xeb, 0x17, 0x96, 0x37,
-	0xda, 0x1f, 0xc1, 0x58, 0x9a, 0xd9, 0x51, 0x8f, 0x5b, 0xf6, 0x16, 0xa4, 0xda, 0xca, 0xc0, 0xfd,
-	0x2e, 0x6f, 0x7d, 0xb2, 0x76, 0x2f, 0x6f, 0x83, 0xab, 0x46, 0x2f, 0x6f, 0xe7, 0x94, 0x00, 0x74,
-	0x0a, 0xd6, 0xa0, 0x04, 0x8d, 0xee, 0xf4, 0xa3, 0x7f, 0x60, 0xa9, 0xa8, 0x6d, 0x5e, 0x54, 0xbc,
-	0x7b, 0xcd, 0x3e, 0x03, 0x5f, 0xef, 0x35, 0x07, 0x0f, 0xa9, 0xbd, 0xd7, 0x3c, 0x67, 0x7a, 0x44,
-	0x21, 0xcc, 0xf4, 0x1b, 0x87, 0x51, 0x5f, 0x88, 0xbe, 0xa3, 0x7c, 0xed, 0xf6, 0x45, 0x44, 0xb5,
-	0xba, 0xda, 0xf0, 0xaf, 0x64, 0x17, 0x70, 0xdf, 0xfe, 0xec, 0xcb, 0xe5, 0xd2, 0xe7, 0x5f, 0x2e,
-	0x97, 0xfe, 0xfb, 0xe5, 0x72, 0xe9, 0x77, 0x5f, 0x2d, 0x5f, 0xf9, 0xfc, 0xab, 0xe5, 0x2b, 0xff,
-	0xfe, 0x6a, 0xf9, 0xca, 0xd3, 0x37, 0x2f, 0xf8, 0xb3, 0xc8, 0x49, 0xbd, 0xfb, 0x87, 0x44, 0xe2,
-	0x34, 0x26, 0xfc, 0x70, 0x44, 0xfd, 0xbd, 0xd0, 0xdd, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x97,
-	0x13, 0x33, 0x9c, 0xea, 0x24, 0x00, 0x00,
+func (m *DelegateStakeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateStakeRequest.Merge(m, src)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgServiceClient is the client API for MsgService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgServiceClient interface {
-	UpdateParams(ctx context.Context, in *UpdateParamsRequest, opts ...grpc.CallOption) (*UpdateParamsResponse, error)
-	CreateNewTopic(ctx context.Context, in *CreateNewTopicRequest, opts ...grpc.CallOption) (*CreateNewTopicResponse, error)
-	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
-	RemoveRegistration(ctx context.Context, in *RemoveRegistrationRequest, opts ...grpc.CallOption) (*RemoveRegistrationResponse, error)
-	AddStake(ctx context.Context, in *AddStakeRequest, opts ...grpc.CallOption) (*AddStakeResponse, error)
-	RemoveStake(ctx context.Context, in *RemoveStakeRequest, opts ...grpc.CallOption) (*RemoveStakeResponse, error)
-	CancelRemoveStake(ctx context.Context, in *CancelRemoveStakeRequest, opts ...grpc.CallOption) (*CancelRemoveStakeResponse, error)
-	DelegateStake(ctx context.Context, in *DelegateStakeRequest, opts ...grpc.CallOption) (*DelegateStakeResponse, error)
-	RewardDelegateStake(ctx context.Context, in *RewardDelegateStakeRequest, opts ...grpc.CallOption) (*RewardDelegateStakeResponse, error)
-	RemoveDelegateStake(ctx context.Context, in *RemoveDelegateStakeRequest, opts ...grpc.CallOption) (*RemoveDelegateStakeResponse, error)
-	CancelRemoveDelegateStake(ctx context.Context, in *CancelRemoveDelegateStakeRequest, opts ...grpc.CallOption) (*CancelRemoveDelegateStakeResponse, error)
-	FundTopic(ctx context.Context, in *FundTopicRequest, opts ...grpc.CallOption) (*FundTopicResponse, error)
-	AddToWhitelistAdmin(ctx context.Context, in *AddToWhitelistAdminRequest, opts ...grpc.CallOption) (*AddToWhitelistAdminResponse, error)
-	RemoveFromWhitelistAdmin(ctx context.Context, in *RemoveFromWhitelistAdminRequest, opts ...grpc.CallOption) (*RemoveFromWhitelistAdminResponse, error)
-	InsertWorkerPayload(ctx context.Context, in *InsertWorkerPayloadRequest, opts ...grpc.CallOption) (*InsertWorkerPayloadResponse, error)
-	InsertReputerPayload(ctx context.Context, in *InsertReputerPayloadRequest, opts ...grpc.CallOption) (*InsertReputerPayloadResponse, error)
+func (m *DelegateStakeRequest) XXX_Size() int {
+	return m.Size()
 }
-
-type msgServiceClient struct {
-	cc grpc1.ClientConn
+func (m *DelegateStakeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateStakeRequest.DiscardUnknown(m)
 }
 
-func NewMsgServiceClient(cc grpc1.ClientConn) MsgServiceClient {
-	return &msgServiceClient{cc}
-}
+var xxx_messageInfo_DelegateStakeRequest proto.InternalMessageInfo
 
-func (c *msgServiceClient) UpdateParams(ctx context.Context, in *UpdateParamsRequest, opts ...grpc.CallOption) (*UpdateParamsResponse, error) {
-	out := new(UpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DelegateStakeRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgServiceClient) CreateNewTopic(ctx context.Context, in *CreateNewTopicRequest, opts ...grpc.CallOption) (*CreateNewTopicResponse, error) {
-	out := new(CreateNewTopicResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/CreateNewTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DelegateStakeRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgServiceClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error) {
-	out := new(RegisterResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/Register", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DelegateStakeRequest) GetReputer() string {
+	if m != nil {
+		return m.Reputer
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgServiceClient) RemoveRegistration(ctx context.Context, in *RemoveRegistrationRequest, opts ...grpc.CallOption) (*RemoveRegistrationResponse, error) {
-	out := new(RemoveRegistrationResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RemoveRegistration", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type DelegateStakeResponse struct {
 }
 
-func (c *msgServiceClient) AddStake(ctx context.Context, in *AddStakeRequest, opts ...grpc.CallOption) (*AddStakeResponse, error) {
-	out := new(AddStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/AddStake", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *DelegateStakeResponse) Reset()         { *m = DelegateStakeResponse{} }
+func (m *DelegateStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*DelegateStakeResponse) ProtoMessage()    {}
+func (*DelegateStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{35}
 }
-
-func (c *msgServiceClient) RemoveStake(ctx context.Context, in *RemoveStakeRequest, opts ...grpc.CallOption) (*RemoveStakeResponse, error) {
-	out := new(RemoveStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RemoveStake", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *DelegateStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgServiceClient) CancelRemoveStake(ctx context.Context, in *CancelRemoveStakeRequest, opts ...grpc.CallOption) (*CancelRemoveStakeResponse, error) {
-	out := new(CancelRemoveStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/CancelRemoveStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *DelegateStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateStakeResponse.Merge(m, src)
+}
+func (m *DelegateStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateStakeResponse.DiscardUnknown(m)
 }
 
-func (c *msgServiceClient) DelegateStake(ctx context.Context, in *DelegateStakeRequest, opts ...grpc.CallOption) (*DelegateStakeResponse, error) {
-	out := new(DelegateStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/DelegateStake", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_DelegateStakeResponse proto.InternalMessageInfo
+
+type RemoveDelegateStakeRequest struct {
+	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Reputer string                `protobuf:"bytes,2,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	TopicId uint64                `protobuf:"varint,3,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func (c *msgServiceClient) RewardDelegateStake(ctx context.Context, in *RewardDelegateStakeRequest, opts ...grpc.CallOption) (*RewardDelegateStakeResponse, error) {
-	out := new(RewardDelegateStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RewardDelegateStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RemoveDelegateStakeRequest) Reset()         { *m = RemoveDelegateStakeRequest{} }
+func (m *RemoveDelegateStakeRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveDelegateStakeRequest) ProtoMessage()    {}
+func (*RemoveDelegateStakeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{36}
+}
+func (m *RemoveDelegateStakeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveDelegateStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveDelegateStakeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *RemoveDelegateStakeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveDelegateStakeRequest.Merge(m, src)
+}
+func (m *RemoveDelegateStakeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveDelegateStakeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveDelegateStakeRequest.DiscardUnknown(m)
 }
 
-func (c *msgServiceClient) RemoveDelegateStake(ctx context.Context, in *RemoveDelegateStakeRequest, opts ...grpc.CallOption) (*RemoveDelegateStakeResponse, error) {
-	out := new(RemoveDelegateStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RemoveDelegateStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RemoveDelegateStakeRequest proto.InternalMessageInfo
+
+func (m *RemoveDelegateStakeRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgServiceClient) CancelRemoveDelegateStake(ctx context.Context, in *CancelRemoveDelegateStakeRequest, opts ...grpc.CallOption) (*CancelRemoveDelegateStakeResponse, error) {
-	out := new(CancelRemoveDelegateStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/CancelRemoveDelegateStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RemoveDelegateStakeRequest) GetReputer() string {
+	if m != nil {
+		return m.Reputer
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgServiceClient) FundTopic(ctx context.Context, in *FundTopicRequest, opts ...grpc.CallOption) (*FundTopicResponse, error) {
-	out := new(FundTopicResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/FundTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RemoveDelegateStakeRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgServiceClient) AddToWhitelistAdmin(ctx context.Context, in *AddToWhitelistAdminRequest, opts ...grpc.CallOption) (*AddToWhitelistAdminResponse, error) {
-	out := new(AddToWhitelistAdminResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/AddToWhitelistAdmin", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type RemoveDelegateStakeResponse struct {
 }
 
-func (c *msgServiceClient) RemoveFromWhitelistAdmin(ctx context.Context, in *RemoveFromWhitelistAdminRequest, opts ...grpc.CallOption) (*RemoveFromWhitelistAdminResponse, error) {
-	out := new(RemoveFromWhitelistAdminResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RemoveFromWhitelistAdmin", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RemoveDelegateStakeResponse) Reset()         { *m = RemoveDelegateStakeResponse{} }
+func (m *RemoveDelegateStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveDelegateStakeResponse) ProtoMessage()    {}
+func (*RemoveDelegateStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{37}
+}
+func (m *RemoveDelegateStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveDelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveDelegateStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *RemoveDelegateStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveDelegateStakeResponse.Merge(m, src)
+}
+func (m *RemoveDelegateStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveDelegateStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveDelegateStakeResponse.DiscardUnknown(m)
 }
 
-func (c *msgServiceClient) InsertWorkerPayload(ctx context.Context, in *InsertWorkerPayloadRequest, opts ...grpc.CallOption) (*InsertWorkerPayloadResponse, error) {
-	out := new(InsertWorkerPayloadResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/InsertWorkerPayload", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_RemoveDelegateStakeResponse proto.InternalMessageInfo
+
+type CancelRemoveDelegateStakeRequest struct {
+	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId   uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Delegator string `protobuf:"bytes,3,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Reputer   string `protobuf:"bytes,4,opt,name=reputer,proto3" json:"reputer,omitempty"`
 }
 
-func (c *msgServiceClient) InsertReputerPayload(ctx context.Context, in *InsertReputerPayloadRequest, opts ...grpc.CallOption) (*InsertReputerPayloadResponse, error) {
-	out := new(InsertReputerPayloadResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/InsertReputerPayload", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CancelRemoveDelegateStakeRequest) Reset()         { *m = CancelRemoveDelegateStakeRequest{} }
+func (m *CancelRemoveDelegateStakeRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelRemoveDelegateStakeRequest) ProtoMessage()    {}
+func (*CancelRemoveDelegateStakeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{38}
+}
+func (m *CancelRemoveDelegateStakeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelRemoveDelegateStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelRemoveDelegateStakeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *CancelRemoveDelegateStakeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelRemoveDelegateStakeRequest.Merge(m, src)
+}
+func (m *CancelRemoveDelegateStakeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelRemoveDelegateStakeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelRemoveDelegateStakeRequest.DiscardUnknown(m)
 }
 
-// MsgServiceServer is the server API for MsgService service.
-type MsgServiceServer interface {
-	UpdateParams(context.Context, *UpdateParamsRequest) (*UpdateParamsResponse, error)
-	CreateNewTopic(context.Context, *CreateNewTopicRequest) (*CreateNewTopicResponse, error)
-	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
-	RemoveRegistration(context.Context, *RemoveRegistrationRequest) (*RemoveRegistrationResponse, error)
-	AddStake(context.Context, *AddStakeRequest) (*AddStakeResponse, error)
-	RemoveStake(context.Context, *RemoveStakeRequest) (*RemoveStakeResponse, error)
-	CancelRemoveStake(context.Context, *CancelRemoveStakeRequest) (*CancelRemoveStakeResponse, error)
-	DelegateStake(context.Context, *DelegateStakeRequest) (*DelegateStakeResponse, error)
-	RewardDelegateStake(context.Context, *RewardDelegateStakeRequest) (*RewardDelegateStakeResponse, error)
-	RemoveDelegateStake(context.Context, *RemoveDelegateStakeRequest) (*RemoveDelegateStakeResponse, error)
-	CancelRemoveDelegateStake(context.Context, *CancelRemoveDelegateStakeRequest) (*CancelRemoveDelegateStakeResponse, error)
-	FundTopic(context.Context, *FundTopicRequest) (*FundTopicResponse, error)
-	AddToWhitelistAdmin(context.Context, *AddToWhitelistAdminRequest) (*AddToWhitelistAdminResponse, error)
-	RemoveFromWhitelistAdmin(context.Context, *RemoveFromWhitelistAdminRequest) (*RemoveFromWhitelistAdminResponse, error)
-	InsertWorkerPayload(context.Context, *InsertWorkerPayloadRequest) (*InsertWorkerPayloadResponse, error)
-	InsertReputerPayload(context.Context, *InsertReputerPayloadRequest) (*InsertReputerPayloadResponse, error)
+var xxx_messageInfo_CancelRemoveDelegateStakeRequest proto.InternalMessageInfo
+
+func (m *CancelRemoveDelegateStakeRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-// UnimplementedMsgServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServiceServer struct {
+func (m *CancelRemoveDelegateStakeRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
 }
 
-func (*UnimplementedMsgServiceServer) UpdateParams(ctx context.Context, req *UpdateParamsRequest) (*UpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *CancelRemoveDelegateStakeRequest) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
 }
-func (*UnimplementedMsgServiceServer) CreateNewTopic(ctx context.Context, req *CreateNewTopicRequest) (*CreateNewTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateNewTopic not implemented")
+
+func (m *CancelRemoveDelegateStakeRequest) GetReputer() string {
+	if m != nil {
+		return m.Reputer
+	}
+	return ""
 }
-func (*UnimplementedMsgServiceServer) Register(ctx context.Context, req *RegisterRequest) (*RegisterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+
+type CancelRemoveDelegateStakeResponse struct {
 }
-func (*UnimplementedMsgServiceServer) RemoveRegistration(ctx context.Context, req *RemoveRegistrationRequest) (*RemoveRegistrationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveRegistration not implemented")
+
+func (m *CancelRemoveDelegateStakeResponse) Reset()         { *m = CancelRemoveDelegateStakeResponse{} }
+func (m *CancelRemoveDelegateStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelRemoveDelegateStakeResponse) ProtoMessage()    {}
+func (*CancelRemoveDelegateStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{39}
 }
-func (*UnimplementedMsgServiceServer) AddStake(ctx context.Context, req *AddStakeRequest) (*AddStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddStake not implemented")
+func (m *CancelRemoveDelegateStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServiceServer) RemoveStake(ctx context.Context, req *RemoveStakeRequest) (*RemoveStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveStake not implemented")
+func (m *CancelRemoveDelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelRemoveDelegateStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServiceServer) CancelRemoveStake(ctx context.Context, req *CancelRemoveStakeRequest) (*CancelRemoveStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelRemoveStake not implemented")
+func (m *CancelRemoveDelegateStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelRemoveDelegateStakeResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServiceServer) DelegateStake(ctx context.Context, req *DelegateStakeRequest) (*DelegateStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegateStake not implemented")
+func (m *CancelRemoveDelegateStakeResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServiceServer) RewardDelegateStake(ctx context.Context, req *RewardDelegateStakeRequest) (*RewardDelegateStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RewardDelegateStake not implemented")
+func (m *CancelRemoveDelegateStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelRemoveDelegateStakeResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServiceServer) RemoveDelegateStake(ctx context.Context, req *RemoveDelegateStakeRequest) (*RemoveDelegateStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveDelegateStake not implemented")
+
+var xxx_messageInfo_CancelRemoveDelegateStakeResponse proto.InternalMessageInfo
+
+// Inferences are requested by consumers who fund topics by sending ALLO to
+// ecosystem account via TopicFund messages
+type FundTopicRequest struct {
+	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
-func (*UnimplementedMsgServiceServer) CancelRemoveDelegateStake(ctx context.Context, req *CancelRemoveDelegateStakeRequest) (*CancelRemoveDelegateStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelRemoveDelegateStake not implemented")
+
+func (m *FundTopicRequest) Reset()         { *m = FundTopicRequest{} }
+func (m *FundTopicRequest) String() string { return proto.CompactTextString(m) }
+func (*FundTopicRequest) ProtoMessage()    {}
+func (*FundTopicRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{40}
 }
-func (*UnimplementedMsgServiceServer) FundTopic(ctx context.Context, req *FundTopicRequest) (*FundTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FundTopic not implemented")
+func (m *FundTopicRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServiceServer) AddToWhitelistAdmin(ctx context.Context, req *AddToWhitelistAdminRequest) (*AddToWhitelistAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddToWhitelistAdmin not implemented")
+func (m *FundTopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundTopicRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServiceServer) RemoveFromWhitelistAdmin(ctx context.Context, req *RemoveFromWhitelistAdminRequest) (*RemoveFromWhitelistAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromWhitelistAdmin not implemented")
+func (m *FundTopicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundTopicRequest.Merge(m, src)
 }
-func (*UnimplementedMsgServiceServer) InsertWorkerPayload(ctx context.Context, req *InsertWorkerPayloadRequest) (*InsertWorkerPayloadResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InsertWorkerPayload not implemented")
+func (m *FundTopicRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServiceServer) InsertReputerPayload(ctx context.Context, req *InsertReputerPayloadRequest) (*InsertReputerPayloadResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InsertReputerPayload not implemented")
+func (m *FundTopicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundTopicRequest.DiscardUnknown(m)
 }
 
-func RegisterMsgServiceServer(s grpc1.Server, srv MsgServiceServer) {
-	s.RegisterService(&_MsgService_serviceDesc, srv)
-}
+var xxx_messageInfo_FundTopicRequest proto.InternalMessageInfo
 
-func _MsgService_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).UpdateParams(ctx, req.(*UpdateParamsRequest))
+func (m *FundTopicRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _MsgService_CreateNewTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateNewTopicRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).CreateNewTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/CreateNewTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).CreateNewTopic(ctx, req.(*CreateNewTopicRequest))
+func (m *FundTopicRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _MsgService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).Register(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/Register",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).Register(ctx, req.(*RegisterRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type FundTopicResponse struct {
 }
 
-func _MsgService_RemoveRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveRegistrationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).RemoveRegistration(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/RemoveRegistration",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).RemoveRegistration(ctx, req.(*RemoveRegistrationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *FundTopicResponse) Reset()         { *m = FundTopicResponse{} }
+func (m *FundTopicResponse) String() string { return proto.CompactTextString(m) }
+func (*FundTopicResponse) ProtoMessage()    {}
+func (*FundTopicResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{41}
 }
-
-func _MsgService_AddStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddStakeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).AddStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/AddStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).AddStake(ctx, req.(*AddStakeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *FundTopicResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _MsgService_RemoveStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveStakeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).RemoveStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/RemoveStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).RemoveStake(ctx, req.(*RemoveStakeRequest))
+func (m *FundTopicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundTopicResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *FundTopicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundTopicResponse.Merge(m, src)
+}
+func (m *FundTopicResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundTopicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundTopicResponse.DiscardUnknown(m)
 }
 
-func _MsgService_CancelRemoveStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelRemoveStakeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).CancelRemoveStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/CancelRemoveStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).CancelRemoveStake(ctx, req.(*CancelRemoveStakeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_FundTopicResponse proto.InternalMessageInfo
+
+type AddToWhitelistAdminRequest struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func _MsgService_DelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelegateStakeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).DelegateStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/DelegateStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).DelegateStake(ctx, req.(*DelegateStakeRequest))
+func (m *AddToWhitelistAdminRequest) Reset()         { *m = AddToWhitelistAdminRequest{} }
+func (m *AddToWhitelistAdminRequest) String() string { return proto.CompactTextString(m) }
+func (*AddToWhitelistAdminRequest) ProtoMessage()    {}
+func (*AddToWhitelistAdminRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{42}
+}
+func (m *AddToWhitelistAdminRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddToWhitelistAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddToWhitelistAdminRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *AddToWhitelistAdminRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddToWhitelistAdminRequest.Merge(m, src)
+}
+func (m *AddToWhitelistAdminRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddToWhitelistAdminRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddToWhitelistAdminRequest.DiscardUnknown(m)
 }
 
-func _MsgService_RewardDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RewardDelegateStakeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).RewardDelegateStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/RewardDelegateStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).RewardDelegateStake(ctx, req.(*RewardDelegateStakeRequest))
+var xxx_messageInfo_AddToWhitelistAdminRequest proto.InternalMessageInfo
+
+func (m *AddToWhitelistAdminRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _MsgService_RemoveDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveDelegateStakeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).RemoveDelegateStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/RemoveDelegateStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).RemoveDelegateStake(ctx, req.(*RemoveDelegateStakeRequest))
+func (m *AddToWhitelistAdminRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _MsgService_CancelRemoveDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelRemoveDelegateStakeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).CancelRemoveDelegateStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/CancelRemoveDelegateStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).CancelRemoveDelegateStake(ctx, req.(*CancelRemoveDelegateStakeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type AddToWhitelistAdminResponse struct {
 }
 
-func _MsgService_FundTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FundTopicRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).FundTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/FundTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).FundTopic(ctx, req.(*FundTopicRequest))
+func (m *AddToWhitelistAdminResponse) Reset()         { *m = AddToWhitelistAdminResponse{} }
+func (m *AddToWhitelistAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*AddToWhitelistAdminResponse) ProtoMessage()    {}
+func (*AddToWhitelistAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{43}
+}
+func (m *AddToWhitelistAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddToWhitelistAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddToWhitelistAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *AddToWhitelistAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddToWhitelistAdminResponse.Merge(m, src)
+}
+func (m *AddToWhitelistAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddToWhitelistAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddToWhitelistAdminResponse.DiscardUnknown(m)
 }
 
-func _MsgService_AddToWhitelistAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddToWhitelistAdminRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_AddToWhitelistAdminResponse proto.InternalMessageInfo
+
+type RemoveFromWhitelistAdminRequest struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *RemoveFromWhitelistAdminRequest) Reset()         { *m = RemoveFromWhitelistAdminRequest{} }
+func (m *RemoveFromWhitelistAdminRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveFromWhitelistAdminRequest) ProtoMessage()    {}
+func (*RemoveFromWhitelistAdminRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{44}
+}
+func (m *RemoveFromWhitelistAdminRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveFromWhitelistAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveFromWhitelistAdminRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).AddToWhitelistAdmin(ctx, in)
+}
+func (m *RemoveFromWhitelistAdminRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveFromWhitelistAdminRequest.Merge(m, src)
+}
+func (m *RemoveFromWhitelistAdminRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveFromWhitelistAdminRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveFromWhitelistAdminRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveFromWhitelistAdminRequest proto.InternalMessageInfo
+
+func (m *RemoveFromWhitelistAdminRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/AddToWhitelistAdmin",
+	return ""
+}
+
+func (m *RemoveFromWhitelistAdminRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).AddToWhitelistAdmin(ctx, req.(*AddToWhitelistAdminRequest))
+	return ""
+}
+
+type RemoveFromWhitelistAdminResponse struct {
+}
+
+func (m *RemoveFromWhitelistAdminResponse) Reset()         { *m = RemoveFromWhitelistAdminResponse{} }
+func (m *RemoveFromWhitelistAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveFromWhitelistAdminResponse) ProtoMessage()    {}
+func (*RemoveFromWhitelistAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{45}
+}
+func (m *RemoveFromWhitelistAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveFromWhitelistAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveFromWhitelistAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *RemoveFromWhitelistAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveFromWhitelistAdminResponse.Merge(m, src)
+}
+func (m *RemoveFromWhitelistAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveFromWhitelistAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveFromWhitelistAdminResponse.DiscardUnknown(m)
 }
 
-func _MsgService_RemoveFromWhitelistAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveFromWhitelistAdminRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_RemoveFromWhitelistAdminResponse proto.InternalMessageInfo
+
+type AddGmpAllowedSourceRequest struct {
+	Sender        string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	SourceChain   string `protobuf:"bytes,2,opt,name=source_chain,json=sourceChain,proto3" json:"source_chain,omitempty"`
+	SourceAddress string `protobuf:"bytes,3,opt,name=source_address,json=sourceAddress,proto3" json:"source_address,omitempty"`
+}
+
+func (m *AddGmpAllowedSourceRequest) Reset()         { *m = AddGmpAllowedSourceRequest{} }
+func (m *AddGmpAllowedSourceRequest) String() string { return proto.CompactTextString(m) }
+func (*AddGmpAllowedSourceRequest) ProtoMessage()    {}
+func (*AddGmpAllowedSourceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{46}
+}
+func (m *AddGmpAllowedSourceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddGmpAllowedSourceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddGmpAllowedSourceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).RemoveFromWhitelistAdmin(ctx, in)
+}
+func (m *AddGmpAllowedSourceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddGmpAllowedSourceRequest.Merge(m, src)
+}
+func (m *AddGmpAllowedSourceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddGmpAllowedSourceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddGmpAllowedSourceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddGmpAllowedSourceRequest proto.InternalMessageInfo
+
+func (m *AddGmpAllowedSourceRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/RemoveFromWhitelistAdmin",
+	return ""
+}
+
+func (m *AddGmpAllowedSourceRequest) GetSourceChain() string {
+	if m != nil {
+		return m.SourceChain
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).RemoveFromWhitelistAdmin(ctx, req.(*RemoveFromWhitelistAdminRequest))
+	return ""
+}
+
+func (m *AddGmpAllowedSourceRequest) GetSourceAddress() string {
+	if m != nil {
+		return m.SourceAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _MsgService_InsertWorkerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InsertWorkerPayloadRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type AddGmpAllowedSourceResponse struct {
+}
+
+func (m *AddGmpAllowedSourceResponse) Reset()         { *m = AddGmpAllowedSourceResponse{} }
+func (m *AddGmpAllowedSourceResponse) String() string { return proto.CompactTextString(m) }
+func (*AddGmpAllowedSourceResponse) ProtoMessage()    {}
+func (*AddGmpAllowedSourceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{47}
+}
+func (m *AddGmpAllowedSourceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddGmpAllowedSourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddGmpAllowedSourceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).InsertWorkerPayload(ctx, in)
+}
+func (m *AddGmpAllowedSourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddGmpAllowedSourceResponse.Merge(m, src)
+}
+func (m *AddGmpAllowedSourceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddGmpAllowedSourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddGmpAllowedSourceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddGmpAllowedSourceResponse proto.InternalMessageInfo
+
+type RemoveGmpAllowedSourceRequest struct {
+	Sender        string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	SourceChain   string `protobuf:"bytes,2,opt,name=source_chain,json=sourceChain,proto3" json:"source_chain,omitempty"`
+	SourceAddress string `protobuf:"bytes,3,opt,name=source_address,json=sourceAddress,proto3" json:"source_address,omitempty"`
+}
+
+func (m *RemoveGmpAllowedSourceRequest) Reset()         { *m = RemoveGmpAllowedSourceRequest{} }
+func (m *RemoveGmpAllowedSourceRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveGmpAllowedSourceRequest) ProtoMessage()    {}
+func (*RemoveGmpAllowedSourceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{48}
+}
+func (m *RemoveGmpAllowedSourceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveGmpAllowedSourceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveGmpAllowedSourceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/InsertWorkerPayload",
+}
+func (m *RemoveGmpAllowedSourceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveGmpAllowedSourceRequest.Merge(m, src)
+}
+func (m *RemoveGmpAllowedSourceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveGmpAllowedSourceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveGmpAllowedSourceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveGmpAllowedSourceRequest proto.InternalMessageInfo
+
+func (m *RemoveGmpAllowedSourceRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).InsertWorkerPayload(ctx, req.(*InsertWorkerPayloadRequest))
+	return ""
+}
+
+func (m *RemoveGmpAllowedSourceRequest) GetSourceChain() string {
+	if m != nil {
+		return m.SourceChain
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _MsgService_InsertReputerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InsertReputerPayloadRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *RemoveGmpAllowedSourceRequest) GetSourceAddress() string {
+	if m != nil {
+		return m.SourceAddress
 	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).InsertReputerPayload(ctx, in)
+	return ""
+}
+
+type RemoveGmpAllowedSourceResponse struct {
+}
+
+func (m *RemoveGmpAllowedSourceResponse) Reset()         { *m = RemoveGmpAllowedSourceResponse{} }
+func (m *RemoveGmpAllowedSourceResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveGmpAllowedSourceResponse) ProtoMessage()    {}
+func (*RemoveGmpAllowedSourceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{49}
+}
+func (m *RemoveGmpAllowedSourceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveGmpAllowedSourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveGmpAllowedSourceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/InsertReputerPayload",
+}
+func (m *RemoveGmpAllowedSourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveGmpAllowedSourceResponse.Merge(m, src)
+}
+func (m *RemoveGmpAllowedSourceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveGmpAllowedSourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveGmpAllowedSourceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveGmpAllowedSourceResponse proto.InternalMessageInfo
+
+type CreateGmpSubscriptionRequest struct {
+	Sender             string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId            uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	DestinationChain   string                `protobuf:"bytes,3,opt,name=destination_chain,json=destinationChain,proto3" json:"destination_chain,omitempty"`
+	DestinationAddress string                `protobuf:"bytes,4,opt,name=destination_address,json=destinationAddress,proto3" json:"destination_address,omitempty"`
+	FeePerMessage      cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=fee_per_message,json=feePerMessage,proto3,customtype=cosmossdk.io/math.Int" json:"fee_per_message"`
+	Prepayment         cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=prepayment,proto3,customtype=cosmossdk.io/math.Int" json:"prepayment"`
+}
+
+func (m *CreateGmpSubscriptionRequest) Reset()         { *m = CreateGmpSubscriptionRequest{} }
+func (m *CreateGmpSubscriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateGmpSubscriptionRequest) ProtoMessage()    {}
+func (*CreateGmpSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{50}
+}
+func (m *CreateGmpSubscriptionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateGmpSubscriptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateGmpSubscriptionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).InsertReputerPayload(ctx, req.(*InsertReputerPayloadRequest))
+}
+func (m *CreateGmpSubscriptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateGmpSubscriptionRequest.Merge(m, src)
+}
+func (m *CreateGmpSubscriptionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateGmpSubscriptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateGmpSubscriptionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateGmpSubscriptionRequest proto.InternalMessageInfo
+
+func (m *CreateGmpSubscriptionRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var MsgService_serviceDesc = _MsgService_serviceDesc
-var _MsgService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "emissions.v5.MsgService",
-	HandlerType: (*MsgServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _MsgService_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreateNewTopic",
-			Handler:    _MsgService_CreateNewTopic_Handler,
-		},
-		{
-			MethodName: "Register",
-			Handler:    _MsgService_Register_Handler,
-		},
-		{
-			MethodName: "RemoveRegistration",
-			Handler:    _MsgService_RemoveRegistration_Handler,
-		},
-		{
-			MethodName: "AddStake",
-			Handler:    _MsgService_AddStake_Handler,
-		},
-		{
-			MethodName: "RemoveStake",
-			Handler:    _MsgService_RemoveStake_Handler,
-		},
-		{
-			MethodName: "CancelRemoveStake",
-			Handler:    _MsgService_CancelRemoveStake_Handler,
-		},
-		{
-			MethodName: "DelegateStake",
-			Handler:    _MsgService_DelegateStake_Handler,
-		},
-		{
-			MethodName: "RewardDelegateStake",
-			Handler:    _MsgService_RewardDelegateStake_Handler,
-		},
-		{
-			MethodName: "RemoveDelegateStake",
-			Handler:    _MsgService_RemoveDelegateStake_Handler,
-		},
-		{
-			MethodName: "CancelRemoveDelegateStake",
-			Handler:    _MsgService_CancelRemoveDelegateStake_Handler,
-		},
-		{
-			MethodName: "FundTopic",
-			Handler:    _MsgService_FundTopic_Handler,
-		},
-		{
-			MethodName: "AddToWhitelistAdmin",
-			Handler:    _MsgService_AddToWhitelistAdmin_Handler,
-		},
-		{
-			MethodName: "RemoveFromWhitelistAdmin",
-			Handler:    _MsgService_RemoveFromWhitelistAdmin_Handler,
-		},
-		{
-			MethodName: "InsertWorkerPayload",
-			Handler:    _MsgService_InsertWorkerPayload_Handler,
-		},
-		{
-			MethodName: "InsertReputerPayload",
-			Handler:    _MsgService_InsertReputerPayload_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "emissions/v5/tx.proto",
+func (m *CreateGmpSubscriptionRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
 }
 
-func (m *OptionalParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CreateGmpSubscriptionRequest) GetDestinationChain() string {
+	if m != nil {
+		return m.DestinationChain
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *OptionalParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CreateGmpSubscriptionRequest) GetDestinationAddress() string {
+	if m != nil {
+		return m.DestinationAddress
+	}
+	return ""
 }
 
-func (m *OptionalParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PNormSafeDiv) > 0 {
-		for iNdEx := len(m.PNormSafeDiv) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.PNormSafeDiv[iNdEx].Size()
-				i -= size
-				if _, err := m.PNormSafeDiv[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0x8a
+type CreateGmpSubscriptionResponse struct {
+	SubscriptionId uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+}
+
+func (m *CreateGmpSubscriptionResponse) Reset()         { *m = CreateGmpSubscriptionResponse{} }
+func (m *CreateGmpSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateGmpSubscriptionResponse) ProtoMessage()    {}
+func (*CreateGmpSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{51}
+}
+func (m *CreateGmpSubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateGmpSubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateGmpSubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.InitialRegretQuantile) > 0 {
-		for iNdEx := len(m.InitialRegretQuantile) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.InitialRegretQuantile[iNdEx].Size()
-				i -= size
-				if _, err := m.InitialRegretQuantile[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0x82
-		}
+}
+func (m *CreateGmpSubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateGmpSubscriptionResponse.Merge(m, src)
+}
+func (m *CreateGmpSubscriptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateGmpSubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateGmpSubscriptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateGmpSubscriptionResponse proto.InternalMessageInfo
+
+func (m *CreateGmpSubscriptionResponse) GetSubscriptionId() uint64 {
+	if m != nil {
+		return m.SubscriptionId
 	}
-	if len(m.MaxStringLength) > 0 {
-		dAtA2 := make([]byte, len(m.MaxStringLength)*10)
-		var j1 int
-		for _, num := range m.MaxStringLength {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
+	return 0
+}
+
+type FundGmpSubscriptionRequest struct {
+	Sender         string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	SubscriptionId uint64                `protobuf:"varint,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Amount         cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *FundGmpSubscriptionRequest) Reset()         { *m = FundGmpSubscriptionRequest{} }
+func (m *FundGmpSubscriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*FundGmpSubscriptionRequest) ProtoMessage()    {}
+func (*FundGmpSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{52}
+}
+func (m *FundGmpSubscriptionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundGmpSubscriptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundGmpSubscriptionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintTx(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xfa
+		return b[:n], nil
 	}
-	if len(m.MaxActiveTopicsPerBlock) > 0 {
-		dAtA4 := make([]byte, len(m.MaxActiveTopicsPerBlock)*10)
-		var j3 int
-		for _, num := range m.MaxActiveTopicsPerBlock {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
-		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintTx(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xf2
+}
+func (m *FundGmpSubscriptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundGmpSubscriptionRequest.Merge(m, src)
+}
+func (m *FundGmpSubscriptionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundGmpSubscriptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundGmpSubscriptionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundGmpSubscriptionRequest proto.InternalMessageInfo
+
+func (m *FundGmpSubscriptionRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.MaxElementsPerForecast) > 0 {
-		dAtA6 := make([]byte, len(m.MaxElementsPerForecast)*10)
-		var j5 int
-		for _, num := range m.MaxElementsPerForecast {
-			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
-			}
-			dAtA6[j5] = uint8(num)
-			j5++
-		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintTx(dAtA, i, uint64(j5))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xea
+	return ""
+}
+
+func (m *FundGmpSubscriptionRequest) GetSubscriptionId() uint64 {
+	if m != nil {
+		return m.SubscriptionId
 	}
-	if len(m.EpsilonSafeDiv) > 0 {
-		for iNdEx := len(m.EpsilonSafeDiv) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.EpsilonSafeDiv[iNdEx].Size()
-				i -= size
-				if _, err := m.EpsilonSafeDiv[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xe2
+	return 0
+}
+
+type FundGmpSubscriptionResponse struct {
+}
+
+func (m *FundGmpSubscriptionResponse) Reset()         { *m = FundGmpSubscriptionResponse{} }
+func (m *FundGmpSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*FundGmpSubscriptionResponse) ProtoMessage()    {}
+func (*FundGmpSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{53}
+}
+func (m *FundGmpSubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundGmpSubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundGmpSubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.DataSendingFee) > 0 {
-		for iNdEx := len(m.DataSendingFee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.DataSendingFee[iNdEx].Size()
-				i -= size
-				if _, err := m.DataSendingFee[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xda
+}
+func (m *FundGmpSubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundGmpSubscriptionResponse.Merge(m, src)
+}
+func (m *FundGmpSubscriptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundGmpSubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundGmpSubscriptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundGmpSubscriptionResponse proto.InternalMessageInfo
+
+type CancelGmpSubscriptionRequest struct {
+	Sender         string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	SubscriptionId uint64 `protobuf:"varint,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+}
+
+func (m *CancelGmpSubscriptionRequest) Reset()         { *m = CancelGmpSubscriptionRequest{} }
+func (m *CancelGmpSubscriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelGmpSubscriptionRequest) ProtoMessage()    {}
+func (*CancelGmpSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{54}
+}
+func (m *CancelGmpSubscriptionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelGmpSubscriptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelGmpSubscriptionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.HalfMaxProcessStakeRemovalsEndBlock) > 0 {
-		dAtA8 := make([]byte, len(m.HalfMaxProcessStakeRemovalsEndBlock)*10)
-		var j7 int
-		for _, num := range m.HalfMaxProcessStakeRemovalsEndBlock {
-			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j7++
-			}
-			dAtA8[j7] = uint8(num)
-			j7++
-		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintTx(dAtA, i, uint64(j7))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xd2
+}
+func (m *CancelGmpSubscriptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelGmpSubscriptionRequest.Merge(m, src)
+}
+func (m *CancelGmpSubscriptionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelGmpSubscriptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelGmpSubscriptionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelGmpSubscriptionRequest proto.InternalMessageInfo
+
+func (m *CancelGmpSubscriptionRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.EpsilonReputer) > 0 {
-		for iNdEx := len(m.EpsilonReputer) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.EpsilonReputer[iNdEx].Size()
-				i -= size
-				if _, err := m.EpsilonReputer[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xc2
-		}
+	return ""
+}
+
+func (m *CancelGmpSubscriptionRequest) GetSubscriptionId() uint64 {
+	if m != nil {
+		return m.SubscriptionId
 	}
-	if len(m.CNorm) > 0 {
-		for iNdEx := len(m.CNorm) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.CNorm[iNdEx].Size()
-				i -= size
-				if _, err := m.CNorm[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xb2
+	return 0
+}
+
+type CancelGmpSubscriptionResponse struct {
+}
+
+func (m *CancelGmpSubscriptionResponse) Reset()         { *m = CancelGmpSubscriptionResponse{} }
+func (m *CancelGmpSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelGmpSubscriptionResponse) ProtoMessage()    {}
+func (*CancelGmpSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{55}
+}
+func (m *CancelGmpSubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelGmpSubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelGmpSubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.CRewardForecast) > 0 {
-		for iNdEx := len(m.CRewardForecast) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.CRewardForecast[iNdEx].Size()
-				i -= size
-				if _, err := m.CRewardForecast[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xaa
+}
+func (m *CancelGmpSubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelGmpSubscriptionResponse.Merge(m, src)
+}
+func (m *CancelGmpSubscriptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelGmpSubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelGmpSubscriptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelGmpSubscriptionResponse proto.InternalMessageInfo
+
+// Adds to the sponsorship of the sender for a topic, creating it if needed,
+// and sets its caps. Sponsorships cover the data sending fees of the workers
+// and reputers of the topic in the sender's stead.
+type FundTopicSponsorshipRequest struct {
+	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// zero for no cap
+	MaxFeesPerActorPerEpoch cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_fees_per_actor_per_epoch,json=maxFeesPerActorPerEpoch,proto3,customtype=cosmossdk.io/math.Int" json:"max_fees_per_actor_per_epoch"`
+	// zero for no cap
+	MaxFeesPerEpoch cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=max_fees_per_epoch,json=maxFeesPerEpoch,proto3,customtype=cosmossdk.io/math.Int" json:"max_fees_per_epoch"`
+}
+
+func (m *FundTopicSponsorshipRequest) Reset()         { *m = FundTopicSponsorshipRequest{} }
+func (m *FundTopicSponsorshipRequest) String() string { return proto.CompactTextString(m) }
+func (*FundTopicSponsorshipRequest) ProtoMessage()    {}
+func (*FundTopicSponsorshipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{56}
+}
+func (m *FundTopicSponsorshipRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundTopicSponsorshipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundTopicSponsorshipRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.CRewardInference) > 0 {
-		for iNdEx := len(m.CRewardInference) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.CRewardInference[iNdEx].Size()
-				i -= size
-				if _, err := m.CRewardInference[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xa2
-		}
+}
+func (m *FundTopicSponsorshipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundTopicSponsorshipRequest.Merge(m, src)
+}
+func (m *FundTopicSponsorshipRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundTopicSponsorshipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundTopicSponsorshipRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundTopicSponsorshipRequest proto.InternalMessageInfo
+
+func (m *FundTopicSponsorshipRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.PRewardReputer) > 0 {
-		for iNdEx := len(m.PRewardReputer) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.PRewardReputer[iNdEx].Size()
-				i -= size
-				if _, err := m.PRewardReputer[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0x9a
-		}
+	return ""
+}
+
+func (m *FundTopicSponsorshipRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if len(m.PRewardForecast) > 0 {
-		for iNdEx := len(m.PRewardForecast) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.PRewardForecast[iNdEx].Size()
-				i -= size
-				if _, err := m.PRewardForecast[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0x92
+	return 0
+}
+
+type FundTopicSponsorshipResponse struct {
+}
+
+func (m *FundTopicSponsorshipResponse) Reset()         { *m = FundTopicSponsorshipResponse{} }
+func (m *FundTopicSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*FundTopicSponsorshipResponse) ProtoMessage()    {}
+func (*FundTopicSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{57}
+}
+func (m *FundTopicSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundTopicSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundTopicSponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.PRewardInference) > 0 {
-		for iNdEx := len(m.PRewardInference) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.PRewardInference[iNdEx].Size()
-				i -= size
-				if _, err := m.PRewardInference[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0x8a
+}
+func (m *FundTopicSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundTopicSponsorshipResponse.Merge(m, src)
+}
+func (m *FundTopicSponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundTopicSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundTopicSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundTopicSponsorshipResponse proto.InternalMessageInfo
+
+// Withdraws from the remaining balance of the sponsorship of the sender for a
+// topic. The sponsorship is removed once its balance is withdrawn entirely.
+type WithdrawTopicSponsorshipRequest struct {
+	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *WithdrawTopicSponsorshipRequest) Reset()         { *m = WithdrawTopicSponsorshipRequest{} }
+func (m *WithdrawTopicSponsorshipRequest) String() string { return proto.CompactTextString(m) }
+func (*WithdrawTopicSponsorshipRequest) ProtoMessage()    {}
+func (*WithdrawTopicSponsorshipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{58}
+}
+func (m *WithdrawTopicSponsorshipRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawTopicSponsorshipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawTopicSponsorshipRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.BlocksPerMonth) > 0 {
-		dAtA10 := make([]byte, len(m.BlocksPerMonth)*10)
-		var j9 int
-		for _, num := range m.BlocksPerMonth {
-			for num >= 1<<7 {
-				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j9++
-			}
-			dAtA10[j9] = uint8(num)
-			j9++
-		}
-		i -= j9
-		copy(dAtA[i:], dAtA10[:j9])
-		i = encodeVarintTx(dAtA, i, uint64(j9))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x82
+}
+func (m *WithdrawTopicSponsorshipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawTopicSponsorshipRequest.Merge(m, src)
+}
+func (m *WithdrawTopicSponsorshipRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawTopicSponsorshipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawTopicSponsorshipRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawTopicSponsorshipRequest proto.InternalMessageInfo
+
+func (m *WithdrawTopicSponsorshipRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.MinEpochLengthRecordLimit) > 0 {
-		dAtA12 := make([]byte, len(m.MinEpochLengthRecordLimit)*10)
-		var j11 int
-		for _, num1 := range m.MinEpochLengthRecordLimit {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j11++
-			}
-			dAtA12[j11] = uint8(num)
-			j11++
-		}
-		i -= j11
-		copy(dAtA[i:], dAtA12[:j11])
-		i = encodeVarintTx(dAtA, i, uint64(j11))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xfa
+	return ""
+}
+
+func (m *WithdrawTopicSponsorshipRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if len(m.MaxPageLimit) > 0 {
-		dAtA14 := make([]byte, len(m.MaxPageLimit)*10)
-		var j13 int
-		for _, num := range m.MaxPageLimit {
-			for num >= 1<<7 {
-				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j13++
-			}
-			dAtA14[j13] = uint8(num)
-			j13++
+	return 0
+}
+
+type WithdrawTopicSponsorshipResponse struct {
+}
+
+func (m *WithdrawTopicSponsorshipResponse) Reset()         { *m = WithdrawTopicSponsorshipResponse{} }
+func (m *WithdrawTopicSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*WithdrawTopicSponsorshipResponse) ProtoMessage()    {}
+func (*WithdrawTopicSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{59}
+}
+func (m *WithdrawTopicSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawTopicSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawTopicSponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j13
-		copy(dAtA[i:], dAtA14[:j13])
-		i = encodeVarintTx(dAtA, i, uint64(j13))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xf2
+		return b[:n], nil
 	}
-	if len(m.DefaultPageLimit) > 0 {
-		dAtA16 := make([]byte, len(m.DefaultPageLimit)*10)
-		var j15 int
-		for _, num := range m.DefaultPageLimit {
-			for num >= 1<<7 {
-				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j15++
-			}
-			dAtA16[j15] = uint8(num)
-			j15++
+}
+func (m *WithdrawTopicSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawTopicSponsorshipResponse.Merge(m, src)
+}
+func (m *WithdrawTopicSponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawTopicSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawTopicSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawTopicSponsorshipResponse proto.InternalMessageInfo
+
+// Turns enforcement of the worker (or reputer) allowlist of a topic on or off
+type SetTopicAllowlistEnabledRequest struct {
+	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId   uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	IsReputer bool   `protobuf:"varint,3,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
+	Enabled   bool   `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *SetTopicAllowlistEnabledRequest) Reset()         { *m = SetTopicAllowlistEnabledRequest{} }
+func (m *SetTopicAllowlistEnabledRequest) String() string { return proto.CompactTextString(m) }
+func (*SetTopicAllowlistEnabledRequest) ProtoMessage()    {}
+func (*SetTopicAllowlistEnabledRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{60}
+}
+func (m *SetTopicAllowlistEnabledRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetTopicAllowlistEnabledRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetTopicAllowlistEnabledRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j15
-		copy(dAtA[i:], dAtA16[:j15])
-		i = encodeVarintTx(dAtA, i, uint64(j15))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xea
+		return b[:n], nil
 	}
-	if len(m.RegistrationFee) > 0 {
-		for iNdEx := len(m.RegistrationFee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.RegistrationFee[iNdEx].Size()
-				i -= size
-				if _, err := m.RegistrationFee[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xe2
-		}
+}
+func (m *SetTopicAllowlistEnabledRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTopicAllowlistEnabledRequest.Merge(m, src)
+}
+func (m *SetTopicAllowlistEnabledRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetTopicAllowlistEnabledRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTopicAllowlistEnabledRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetTopicAllowlistEnabledRequest proto.InternalMessageInfo
+
+func (m *SetTopicAllowlistEnabledRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.GradientDescentMaxIters) > 0 {
-		dAtA18 := make([]byte, len(m.GradientDescentMaxIters)*10)
-		var j17 int
-		for _, num := range m.GradientDescentMaxIters {
-			for num >= 1<<7 {
-				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j17++
-			}
-			dAtA18[j17] = uint8(num)
-			j17++
-		}
-		i -= j17
-		copy(dAtA[i:], dAtA18[:j17])
-		i = encodeVarintTx(dAtA, i, uint64(j17))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xca
+	return ""
+}
+
+func (m *SetTopicAllowlistEnabledRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if len(m.CreateTopicFee) > 0 {
-		for iNdEx := len(m.CreateTopicFee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.CreateTopicFee[iNdEx].Size()
-				i -= size
-				if _, err := m.CreateTopicFee[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xc2
-		}
+	return 0
+}
+
+func (m *SetTopicAllowlistEnabledRequest) GetIsReputer() bool {
+	if m != nil {
+		return m.IsReputer
 	}
-	if len(m.MaxTopReputersToReward) > 0 {
-		dAtA20 := make([]byte, len(m.MaxTopReputersToReward)*10)
-		var j19 int
-		for _, num := range m.MaxTopReputersToReward {
-			for num >= 1<<7 {
-				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j19++
-			}
-			dAtA20[j19] = uint8(num)
-			j19++
+	return false
+}
+
+func (m *SetTopicAllowlistEnabledRequest) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type SetTopicAllowlistEnabledResponse struct {
+}
+
+func (m *SetTopicAllowlistEnabledResponse) Reset()         { *m = SetTopicAllowlistEnabledResponse{} }
+func (m *SetTopicAllowlistEnabledResponse) String() string { return proto.CompactTextString(m) }
+func (*SetTopicAllowlistEnabledResponse) ProtoMessage()    {}
+func (*SetTopicAllowlistEnabledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{61}
+}
+func (m *SetTopicAllowlistEnabledResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetTopicAllowlistEnabledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetTopicAllowlistEnabledResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j19
-		copy(dAtA[i:], dAtA20[:j19])
-		i = encodeVarintTx(dAtA, i, uint64(j19))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xba
+		return b[:n], nil
 	}
-	if len(m.MaxTopForecastersToReward) > 0 {
-		dAtA22 := make([]byte, len(m.MaxTopForecastersToReward)*10)
-		var j21 int
-		for _, num := range m.MaxTopForecastersToReward {
-			for num >= 1<<7 {
-				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j21++
-			}
-			dAtA22[j21] = uint8(num)
-			j21++
+}
+func (m *SetTopicAllowlistEnabledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTopicAllowlistEnabledResponse.Merge(m, src)
+}
+func (m *SetTopicAllowlistEnabledResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetTopicAllowlistEnabledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTopicAllowlistEnabledResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetTopicAllowlistEnabledResponse proto.InternalMessageInfo
+
+type AddToTopicAllowlistRequest struct {
+	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId   uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	IsReputer bool   `protobuf:"varint,3,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
+	Address   string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *AddToTopicAllowlistRequest) Reset()         { *m = AddToTopicAllowlistRequest{} }
+func (m *AddToTopicAllowlistRequest) String() string { return proto.CompactTextString(m) }
+func (*AddToTopicAllowlistRequest) ProtoMessage()    {}
+func (*AddToTopicAllowlistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{62}
+}
+func (m *AddToTopicAllowlistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddToTopicAllowlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddToTopicAllowlistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j21
-		copy(dAtA[i:], dAtA22[:j21])
-		i = encodeVarintTx(dAtA, i, uint64(j21))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb2
+		return b[:n], nil
 	}
-	if len(m.MaxTopInferersToReward) > 0 {
-		dAtA24 := make([]byte, len(m.MaxTopInferersToReward)*10)
-		var j23 int
-		for _, num := range m.MaxTopInferersToReward {
-			for num >= 1<<7 {
-				dAtA24[j23] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j23++
-			}
-			dAtA24[j23] = uint8(num)
-			j23++
-		}
-		i -= j23
-		copy(dAtA[i:], dAtA24[:j23])
-		i = encodeVarintTx(dAtA, i, uint64(j23))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xaa
+}
+func (m *AddToTopicAllowlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddToTopicAllowlistRequest.Merge(m, src)
+}
+func (m *AddToTopicAllowlistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddToTopicAllowlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddToTopicAllowlistRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddToTopicAllowlistRequest proto.InternalMessageInfo
+
+func (m *AddToTopicAllowlistRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.MaxSamplesToScaleScores) > 0 {
-		dAtA26 := make([]byte, len(m.MaxSamplesToScaleScores)*10)
-		var j25 int
-		for _, num := range m.MaxSamplesToScaleScores {
-			for num >= 1<<7 {
-				dAtA26[j25] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j25++
-			}
-			dAtA26[j25] = uint8(num)
-			j25++
-		}
-		i -= j25
-		copy(dAtA[i:], dAtA26[:j25])
-		i = encodeVarintTx(dAtA, i, uint64(j25))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
+	return ""
+}
+
+func (m *AddToTopicAllowlistRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if len(m.ValidatorsVsAlloraPercentReward) > 0 {
-		for iNdEx := len(m.ValidatorsVsAlloraPercentReward) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.ValidatorsVsAlloraPercentReward[iNdEx].Size()
-				i -= size
-				if _, err := m.ValidatorsVsAlloraPercentReward[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x9a
-		}
+	return 0
+}
+
+func (m *AddToTopicAllowlistRequest) GetIsReputer() bool {
+	if m != nil {
+		return m.IsReputer
 	}
-	if len(m.TaskRewardAlpha) > 0 {
-		for iNdEx := len(m.TaskRewardAlpha) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.TaskRewardAlpha[iNdEx].Size()
-				i -= size
-				if _, err := m.TaskRewardAlpha[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
-		}
+	return false
+}
+
+func (m *AddToTopicAllowlistRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if len(m.TopicRewardAlpha) > 0 {
-		for iNdEx := len(m.TopicRewardAlpha) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.TopicRewardAlpha[iNdEx].Size()
-				i -= size
-				if _, err := m.TopicRewardAlpha[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x8a
+	return ""
+}
+
+type AddToTopicAllowlistResponse struct {
+}
+
+func (m *AddToTopicAllowlistResponse) Reset()         { *m = AddToTopicAllowlistResponse{} }
+func (m *AddToTopicAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*AddToTopicAllowlistResponse) ProtoMessage()    {}
+func (*AddToTopicAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{63}
+}
+func (m *AddToTopicAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddToTopicAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddToTopicAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.TopicRewardFeeRevenueImportance) > 0 {
-		for iNdEx := len(m.TopicRewardFeeRevenueImportance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.TopicRewardFeeRevenueImportance[iNdEx].Size()
-				i -= size
-				if _, err := m.TopicRewardFeeRevenueImportance[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x82
+}
+func (m *AddToTopicAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddToTopicAllowlistResponse.Merge(m, src)
+}
+func (m *AddToTopicAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddToTopicAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddToTopicAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddToTopicAllowlistResponse proto.InternalMessageInfo
+
+type RemoveFromTopicAllowlistRequest struct {
+	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId   uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	IsReputer bool   `protobuf:"varint,3,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
+	Address   string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *RemoveFromTopicAllowlistRequest) Reset()         { *m = RemoveFromTopicAllowlistRequest{} }
+func (m *RemoveFromTopicAllowlistRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveFromTopicAllowlistRequest) ProtoMessage()    {}
+func (*RemoveFromTopicAllowlistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{64}
+}
+func (m *RemoveFromTopicAllowlistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveFromTopicAllowlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveFromTopicAllowlistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.TopicRewardStakeImportance) > 0 {
-		for iNdEx := len(m.TopicRewardStakeImportance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.TopicRewardStakeImportance[iNdEx].Size()
-				i -= size
-				if _, err := m.TopicRewardStakeImportance[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x7a
-		}
+}
+func (m *RemoveFromTopicAllowlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveFromTopicAllowlistRequest.Merge(m, src)
+}
+func (m *RemoveFromTopicAllowlistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveFromTopicAllowlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveFromTopicAllowlistRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveFromTopicAllowlistRequest proto.InternalMessageInfo
+
+func (m *RemoveFromTopicAllowlistRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.MaxUnfulfilledReputerRequests) > 0 {
-		dAtA28 := make([]byte, len(m.MaxUnfulfilledReputerRequests)*10)
-		var j27 int
-		for _, num := range m.MaxUnfulfilledReputerRequests {
-			for num >= 1<<7 {
-				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j27++
-			}
-			dAtA28[j27] = uint8(num)
-			j27++
-		}
-		i -= j27
-		copy(dAtA[i:], dAtA28[:j27])
-		i = encodeVarintTx(dAtA, i, uint64(j27))
-		i--
-		dAtA[i] = 0x72
+	return ""
+}
+
+func (m *RemoveFromTopicAllowlistRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if len(m.MaxUnfulfilledWorkerRequests) > 0 {
-		dAtA30 := make([]byte, len(m.MaxUnfulfilledWorkerRequests)*10)
-		var j29 int
-		for _, num := range m.MaxUnfulfilledWorkerRequests {
-			for num >= 1<<7 {
-				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j29++
-			}
-			dAtA30[j29] = uint8(num)
-			j29++
-		}
-		i -= j29
-		copy(dAtA[i:], dAtA30[:j29])
-		i = encodeVarintTx(dAtA, i, uint64(j29))
-		i--
-		dAtA[i] = 0x6a
+	return 0
+}
+
+func (m *RemoveFromTopicAllowlistRequest) GetIsReputer() bool {
+	if m != nil {
+		return m.IsReputer
 	}
-	if len(m.MinStakeFraction) > 0 {
-		for iNdEx := len(m.MinStakeFraction) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.MinStakeFraction[iNdEx].Size()
-				i -= size
-				if _, err := m.MinStakeFraction[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
+	return false
+}
+
+func (m *RemoveFromTopicAllowlistRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type RemoveFromTopicAllowlistResponse struct {
+}
+
+func (m *RemoveFromTopicAllowlistResponse) Reset()         { *m = RemoveFromTopicAllowlistResponse{} }
+func (m *RemoveFromTopicAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveFromTopicAllowlistResponse) ProtoMessage()    {}
+func (*RemoveFromTopicAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{65}
+}
+func (m *RemoveFromTopicAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveFromTopicAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveFromTopicAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.MaxGradientThreshold) > 0 {
-		for iNdEx := len(m.MaxGradientThreshold) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.MaxGradientThreshold[iNdEx].Size()
-				i -= size
-				if _, err := m.MaxGradientThreshold[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
-	}
-	if len(m.LearningRate) > 0 {
-		for iNdEx := len(m.LearningRate) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.LearningRate[iNdEx].Size()
-				i -= size
-				if _, err := m.LearningRate[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if len(m.BetaEntropy) > 0 {
-		for iNdEx := len(m.BetaEntropy) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.BetaEntropy[iNdEx].Size()
-				i -= size
-				if _, err := m.BetaEntropy[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
-	}
-	if len(m.MinEpochLength) > 0 {
-		dAtA32 := make([]byte, len(m.MinEpochLength)*10)
-		var j31 int
-		for _, num1 := range m.MinEpochLength {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j31++
-			}
-			dAtA32[j31] = uint8(num)
-			j31++
-		}
-		i -= j31
-		copy(dAtA[i:], dAtA32[:j31])
-		i = encodeVarintTx(dAtA, i, uint64(j31))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.RemoveStakeDelayWindow) > 0 {
-		dAtA34 := make([]byte, len(m.RemoveStakeDelayWindow)*10)
-		var j33 int
-		for _, num1 := range m.RemoveStakeDelayWindow {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA34[j33] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j33++
-			}
-			dAtA34[j33] = uint8(num)
-			j33++
-		}
-		i -= j33
-		copy(dAtA[i:], dAtA34[:j33])
-		i = encodeVarintTx(dAtA, i, uint64(j33))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.RequiredMinimumStake) > 0 {
-		for iNdEx := len(m.RequiredMinimumStake) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.RequiredMinimumStake[iNdEx].Size()
-				i -= size
-				if _, err := m.RequiredMinimumStake[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.MinTopicWeight) > 0 {
-		for iNdEx := len(m.MinTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.MinTopicWeight[iNdEx].Size()
-				i -= size
-				if _, err := m.MinTopicWeight[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.MaxSerializedMsgLength) > 0 {
-		dAtA36 := make([]byte, len(m.MaxSerializedMsgLength)*10)
-		var j35 int
-		for _, num1 := range m.MaxSerializedMsgLength {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA36[j35] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j35++
-			}
-			dAtA36[j35] = uint8(num)
-			j35++
-		}
-		i -= j35
-		copy(dAtA[i:], dAtA36[:j35])
-		i = encodeVarintTx(dAtA, i, uint64(j35))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Version) > 0 {
-		for iNdEx := len(m.Version) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Version[iNdEx])
-			copy(dAtA[i:], m.Version[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Version[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *UpdateParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *RemoveFromTopicAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveFromTopicAllowlistResponse.Merge(m, src)
+}
+func (m *RemoveFromTopicAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveFromTopicAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveFromTopicAllowlistResponse.DiscardUnknown(m)
 }
 
-func (m *UpdateParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_RemoveFromTopicAllowlistResponse proto.InternalMessageInfo
+
+type UnjailReputerRequest struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 }
 
-func (m *UpdateParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *UnjailReputerRequest) Reset()         { *m = UnjailReputerRequest{} }
+func (m *UnjailReputerRequest) String() string { return proto.CompactTextString(m) }
+func (*UnjailReputerRequest) ProtoMessage()    {}
+func (*UnjailReputerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{66}
+}
+func (m *UnjailReputerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnjailReputerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnjailReputerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *UnjailReputerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnjailReputerRequest.Merge(m, src)
+}
+func (m *UnjailReputerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnjailReputerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnjailReputerRequest.DiscardUnknown(m)
 }
 
-func (m *UpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UnjailReputerRequest proto.InternalMessageInfo
+
+func (m *UnjailReputerRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UnjailReputerRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
 }
 
-func (m *UpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type UnjailReputerResponse struct {
 }
 
-func (m *CreateNewTopicRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UnjailReputerResponse) Reset()         { *m = UnjailReputerResponse{} }
+func (m *UnjailReputerResponse) String() string { return proto.CompactTextString(m) }
+func (*UnjailReputerResponse) ProtoMessage()    {}
+func (*UnjailReputerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{67}
+}
+func (m *UnjailReputerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnjailReputerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnjailReputerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *UnjailReputerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnjailReputerResponse.Merge(m, src)
+}
+func (m *UnjailReputerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnjailReputerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnjailReputerResponse.DiscardUnknown(m)
 }
 
-func (m *CreateNewTopicRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_UnjailReputerResponse proto.InternalMessageInfo
+
+type RewardDelegateStakeResponse struct {
 }
 
-func (m *CreateNewTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ActiveReputerQuantile.Size()
-		i -= size
-		if _, err := m.ActiveReputerQuantile.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x92
-	{
-		size := m.ActiveForecasterQuantile.Size()
-		i -= size
-		if _, err := m.ActiveForecasterQuantile.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x8a
-	{
-		size := m.ActiveInfererQuantile.Size()
-		i -= size
-		if _, err := m.ActiveInfererQuantile.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *RewardDelegateStakeResponse) Reset()         { *m = RewardDelegateStakeResponse{} }
+func (m *RewardDelegateStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*RewardDelegateStakeResponse) ProtoMessage()    {}
+func (*RewardDelegateStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{68}
+}
+func (m *RewardDelegateStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardDelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardDelegateStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x82
-	{
-		size := m.MeritSortitionAlpha.Size()
-		i -= size
-		if _, err := m.MeritSortitionAlpha.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *RewardDelegateStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardDelegateStakeResponse.Merge(m, src)
+}
+func (m *RewardDelegateStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardDelegateStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardDelegateStakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardDelegateStakeResponse proto.InternalMessageInfo
+
+type RewardDelegateStakeRequest struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reputer string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+}
+
+func (m *RewardDelegateStakeRequest) Reset()         { *m = RewardDelegateStakeRequest{} }
+func (m *RewardDelegateStakeRequest) String() string { return proto.CompactTextString(m) }
+func (*RewardDelegateStakeRequest) ProtoMessage()    {}
+func (*RewardDelegateStakeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{69}
+}
+func (m *RewardDelegateStakeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardDelegateStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardDelegateStakeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x7a
-	if m.WorkerSubmissionWindow != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.WorkerSubmissionWindow))
-		i--
-		dAtA[i] = 0x70
+		return b[:n], nil
 	}
-	{
-		size := m.Epsilon.Size()
-		i -= size
-		if _, err := m.Epsilon.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+}
+func (m *RewardDelegateStakeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardDelegateStakeRequest.Merge(m, src)
+}
+func (m *RewardDelegateStakeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardDelegateStakeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardDelegateStakeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardDelegateStakeRequest proto.InternalMessageInfo
+
+func (m *RewardDelegateStakeRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	i--
-	dAtA[i] = 0x6a
-	if m.AllowNegative {
-		i--
-		if m.AllowNegative {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
+	return ""
+}
+
+func (m *RewardDelegateStakeRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	{
-		size := m.AlphaRegret.Size()
-		i -= size
-		if _, err := m.AlphaRegret.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *RewardDelegateStakeRequest) GetReputer() string {
+	if m != nil {
+		return m.Reputer
 	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.PNorm.Size()
-		i -= size
-		if _, err := m.PNorm.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return ""
+}
+
+// Opts the delegation of the sender upon a reputer in or out of compounding its
+// rewards into its delegated stake whenever the reputer is paid rewards
+type SetDelegateStakeAutoCompoundRequest struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reputer string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	Enabled bool   `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *SetDelegateStakeAutoCompoundRequest) Reset()         { *m = SetDelegateStakeAutoCompoundRequest{} }
+func (m *SetDelegateStakeAutoCompoundRequest) String() string { return proto.CompactTextString(m) }
+func (*SetDelegateStakeAutoCompoundRequest) ProtoMessage()    {}
+func (*SetDelegateStakeAutoCompoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{70}
+}
+func (m *SetDelegateStakeAutoCompoundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetDelegateStakeAutoCompoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetDelegateStakeAutoCompoundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	if m.GroundTruthLag != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GroundTruthLag))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.EpochLength != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EpochLength))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.LossMethod) > 0 {
-		i -= len(m.LossMethod)
-		copy(dAtA[i:], m.LossMethod)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.LossMethod)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SetDelegateStakeAutoCompoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDelegateStakeAutoCompoundRequest.Merge(m, src)
+}
+func (m *SetDelegateStakeAutoCompoundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetDelegateStakeAutoCompoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDelegateStakeAutoCompoundRequest.DiscardUnknown(m)
 }
 
-func (m *CreateNewTopicResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SetDelegateStakeAutoCompoundRequest proto.InternalMessageInfo
+
+func (m *SetDelegateStakeAutoCompoundRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CreateNewTopicResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SetDelegateStakeAutoCompoundRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
 }
 
-func (m *CreateNewTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+func (m *SetDelegateStakeAutoCompoundRequest) GetReputer() string {
+	if m != nil {
+		return m.Reputer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *InsertReputerPayloadRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SetDelegateStakeAutoCompoundRequest) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *InsertReputerPayloadRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SetDelegateStakeAutoCompoundResponse struct {
 }
 
-func (m *InsertReputerPayloadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ReputerValueBundle != nil {
-		{
-			size, err := m.ReputerValueBundle.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *SetDelegateStakeAutoCompoundResponse) Reset()         { *m = SetDelegateStakeAutoCompoundResponse{} }
+func (m *SetDelegateStakeAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*SetDelegateStakeAutoCompoundResponse) ProtoMessage()    {}
+func (*SetDelegateStakeAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{71}
+}
+func (m *SetDelegateStakeAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetDelegateStakeAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetDelegateStakeAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *InsertReputerPayloadResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *SetDelegateStakeAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDelegateStakeAutoCompoundResponse.Merge(m, src)
 }
-
-func (m *InsertReputerPayloadResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SetDelegateStakeAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *InsertReputerPayloadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *SetDelegateStakeAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDelegateStakeAutoCompoundResponse.DiscardUnknown(m)
 }
 
-func (m *InsertWorkerPayloadRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_SetDelegateStakeAutoCompoundResponse proto.InternalMessageInfo
 
-func (m *InsertWorkerPayloadRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Moves delegated stake of the sender from a reputer to another, possibly in
+// another topic, without waiting for the stake removal delay
+type RedelegateStakeRequest struct {
+	Sender     string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	SrcTopicId uint64                `protobuf:"varint,2,opt,name=src_topic_id,json=srcTopicId,proto3" json:"src_topic_id,omitempty"`
+	SrcReputer string                `protobuf:"bytes,3,opt,name=src_reputer,json=srcReputer,proto3" json:"src_reputer,omitempty"`
+	DstTopicId uint64                `protobuf:"varint,4,opt,name=dst_topic_id,json=dstTopicId,proto3" json:"dst_topic_id,omitempty"`
+	DstReputer string                `protobuf:"bytes,5,opt,name=dst_reputer,json=dstReputer,proto3" json:"dst_reputer,omitempty"`
+	Amount     cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func (m *InsertWorkerPayloadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WorkerDataBundle != nil {
-		{
-			size, err := m.WorkerDataBundle.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *RedelegateStakeRequest) Reset()         { *m = RedelegateStakeRequest{} }
+func (m *RedelegateStakeRequest) String() string { return proto.CompactTextString(m) }
+func (*RedelegateStakeRequest) ProtoMessage()    {}
+func (*RedelegateStakeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{72}
+}
+func (m *RedelegateStakeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegateStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegateStakeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *RedelegateStakeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegateStakeRequest.Merge(m, src)
+}
+func (m *RedelegateStakeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegateStakeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegateStakeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedelegateStakeRequest proto.InternalMessageInfo
+
+func (m *RedelegateStakeRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *InsertWorkerPayloadResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RedelegateStakeRequest) GetSrcTopicId() uint64 {
+	if m != nil {
+		return m.SrcTopicId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *InsertWorkerPayloadResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RedelegateStakeRequest) GetSrcReputer() string {
+	if m != nil {
+		return m.SrcReputer
+	}
+	return ""
 }
 
-func (m *InsertWorkerPayloadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *RedelegateStakeRequest) GetDstTopicId() uint64 {
+	if m != nil {
+		return m.DstTopicId
+	}
+	return 0
 }
 
-func (m *RegisterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RedelegateStakeRequest) GetDstReputer() string {
+	if m != nil {
+		return m.DstReputer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *RegisterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RedelegateStakeResponse struct {
 }
 
-func (m *RegisterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsReputer {
-		i--
-		if m.IsReputer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *RedelegateStakeResponse) Reset()         { *m = RedelegateStakeResponse{} }
+func (m *RedelegateStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*RedelegateStakeResponse) ProtoMessage()    {}
+func (*RedelegateStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{73}
+}
+func (m *RedelegateStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegateStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *RegisterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *RedelegateStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegateStakeResponse.Merge(m, src)
+}
+func (m *RedelegateStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegateStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegateStakeResponse.DiscardUnknown(m)
 }
 
-func (m *RegisterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_RedelegateStakeResponse proto.InternalMessageInfo
+
+type ClaimRewardsRequest struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (m *RegisterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *ClaimRewardsRequest) Reset()         { *m = ClaimRewardsRequest{} }
+func (m *ClaimRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*ClaimRewardsRequest) ProtoMessage()    {}
+func (*ClaimRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{74}
+}
+func (m *ClaimRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ClaimRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimRewardsRequest.Merge(m, src)
+}
+func (m *ClaimRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimRewardsRequest.DiscardUnknown(m)
 }
 
-func (m *RemoveRegistrationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ClaimRewardsRequest proto.InternalMessageInfo
+
+func (m *ClaimRewardsRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *RemoveRegistrationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ClaimRewardsResponse struct {
+	// rewards claimed across all topics
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func (m *RemoveRegistrationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsReputer {
-		i--
-		if m.IsReputer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (m *ClaimRewardsResponse) Reset()         { *m = ClaimRewardsResponse{} }
+func (m *ClaimRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*ClaimRewardsResponse) ProtoMessage()    {}
+func (*ClaimRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{75}
+}
+func (m *ClaimRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ClaimRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimRewardsResponse.Merge(m, src)
+}
+func (m *ClaimRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *RemoveRegistrationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ClaimRewardsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*OptionalParams)(nil), "emissions.v5.OptionalParams")
+	proto.RegisterType((*UpdateParamsRequest)(nil), "emissions.v5.UpdateParamsRequest")
+	proto.RegisterType((*UpdateParamsResponse)(nil), "emissions.v5.UpdateParamsResponse")
+	proto.RegisterType((*CreateNewTopicRequest)(nil), "emissions.v5.CreateNewTopicRequest")
+	proto.RegisterType((*CreateNewTopicResponse)(nil), "emissions.v5.CreateNewTopicResponse")
+	proto.RegisterType((*UpdateTopicRequest)(nil), "emissions.v5.UpdateTopicRequest")
+	proto.RegisterType((*UpdateTopicResponse)(nil), "emissions.v5.UpdateTopicResponse")
+	proto.RegisterType((*RetireTopicRequest)(nil), "emissions.v5.RetireTopicRequest")
+	proto.RegisterType((*RetireTopicResponse)(nil), "emissions.v5.RetireTopicResponse")
+	proto.RegisterType((*InsertReputerPayloadRequest)(nil), "emissions.v5.InsertReputerPayloadRequest")
+	proto.RegisterType((*InsertReputerPayloadResponse)(nil), "emissions.v5.InsertReputerPayloadResponse")
+	proto.RegisterType((*InsertReputerPayloadsRequest)(nil), "emissions.v5.InsertReputerPayloadsRequest")
+	proto.RegisterType((*InsertReputerPayloadsResponse)(nil), "emissions.v5.InsertReputerPayloadsResponse")
+	proto.RegisterType((*BatchedPayloadResult)(nil), "emissions.v5.BatchedPayloadResult")
+	proto.RegisterType((*SubmitGroundTruthRequest)(nil), "emissions.v5.SubmitGroundTruthRequest")
+	proto.RegisterType((*SubmitGroundTruthResponse)(nil), "emissions.v5.SubmitGroundTruthResponse")
+	proto.RegisterType((*InsertWorkerPayloadRequest)(nil), "emissions.v5.InsertWorkerPayloadRequest")
+	proto.RegisterType((*InsertWorkerPayloadResponse)(nil), "emissions.v5.InsertWorkerPayloadResponse")
+	proto.RegisterType((*InsertWorkerPayloadsRequest)(nil), "emissions.v5.InsertWorkerPayloadsRequest")
+	proto.RegisterType((*InsertWorkerPayloadsResponse)(nil), "emissions.v5.InsertWorkerPayloadsResponse")
+	proto.RegisterType((*CommitWorkerPayloadRequest)(nil), "emissions.v5.CommitWorkerPayloadRequest")
+	proto.RegisterType((*CommitWorkerPayloadResponse)(nil), "emissions.v5.CommitWorkerPayloadResponse")
+	proto.RegisterType((*RevealWorkerPayloadRequest)(nil), "emissions.v5.RevealWorkerPayloadRequest")
+	proto.RegisterType((*RevealWorkerPayloadResponse)(nil), "emissions.v5.RevealWorkerPayloadResponse")
+	proto.RegisterType((*RegisterRequest)(nil), "emissions.v5.RegisterRequest")
+	proto.RegisterType((*RegisterResponse)(nil), "emissions.v5.RegisterResponse")
+	proto.RegisterType((*RemoveRegistrationRequest)(nil), "emissions.v5.RemoveRegistrationRequest")
+	proto.RegisterType((*RemoveRegistrationResponse)(nil), "emissions.v5.RemoveRegistrationResponse")
+	proto.RegisterType((*AddStakeRequest)(nil), "emissions.v5.AddStakeRequest")
+	proto.RegisterType((*AddStakeResponse)(nil), "emissions.v5.AddStakeResponse")
+	proto.RegisterType((*RemoveStakeRequest)(nil), "emissions.v5.RemoveStakeRequest")
+	proto.RegisterType((*RemoveStakeResponse)(nil), "emissions.v5.RemoveStakeResponse")
+	proto.RegisterType((*CancelRemoveStakeRequest)(nil), "emissions.v5.CancelRemoveStakeRequest")
+	proto.RegisterType((*CancelRemoveStakeResponse)(nil), "emissions.v5.CancelRemoveStakeResponse")
+	proto.RegisterType((*DelegateStakeRequest)(nil), "emissions.v5.DelegateStakeRequest")
+	proto.RegisterType((*DelegateStakeResponse)(nil), "emissions.v5.DelegateStakeResponse")
+	proto.RegisterType((*RemoveDelegateStakeRequest)(nil), "emissions.v5.RemoveDelegateStakeRequest")
+	proto.RegisterType((*RemoveDelegateStakeResponse)(nil), "emissions.v5.RemoveDelegateStakeResponse")
+	proto.RegisterType((*CancelRemoveDelegateStakeRequest)(nil), "emissions.v5.CancelRemoveDelegateStakeRequest")
+	proto.RegisterType((*CancelRemoveDelegateStakeResponse)(nil), "emissions.v5.CancelRemoveDelegateStakeResponse")
+	proto.RegisterType((*FundTopicRequest)(nil), "emissions.v5.FundTopicRequest")
+	proto.RegisterType((*FundTopicResponse)(nil), "emissions.v5.FundTopicResponse")
+	proto.RegisterType((*AddToWhitelistAdminRequest)(nil), "emissions.v5.AddToWhitelistAdminRequest")
+	proto.RegisterType((*AddToWhitelistAdminResponse)(nil), "emissions.v5.AddToWhitelistAdminResponse")
+	proto.RegisterType((*RemoveFromWhitelistAdminRequest)(nil), "emissions.v5.RemoveFromWhitelistAdminRequest")
+	proto.RegisterType((*RemoveFromWhitelistAdminResponse)(nil), "emissions.v5.RemoveFromWhitelistAdminResponse")
+	proto.RegisterType((*AddGmpAllowedSourceRequest)(nil), "emissions.v5.AddGmpAllowedSourceRequest")
+	proto.RegisterType((*AddGmpAllowedSourceResponse)(nil), "emissions.v5.AddGmpAllowedSourceResponse")
+	proto.RegisterType((*RemoveGmpAllowedSourceRequest)(nil), "emissions.v5.RemoveGmpAllowedSourceRequest")
+	proto.RegisterType((*RemoveGmpAllowedSourceResponse)(nil), "emissions.v5.RemoveGmpAllowedSourceResponse")
+	proto.RegisterType((*CreateGmpSubscriptionRequest)(nil), "emissions.v5.CreateGmpSubscriptionRequest")
+	proto.RegisterType((*CreateGmpSubscriptionResponse)(nil), "emissions.v5.CreateGmpSubscriptionResponse")
+	proto.RegisterType((*FundGmpSubscriptionRequest)(nil), "emissions.v5.FundGmpSubscriptionRequest")
+	proto.RegisterType((*FundGmpSubscriptionResponse)(nil), "emissions.v5.FundGmpSubscriptionResponse")
+	proto.RegisterType((*CancelGmpSubscriptionRequest)(nil), "emissions.v5.CancelGmpSubscriptionRequest")
+	proto.RegisterType((*CancelGmpSubscriptionResponse)(nil), "emissions.v5.CancelGmpSubscriptionResponse")
+	proto.RegisterType((*FundTopicSponsorshipRequest)(nil), "emissions.v5.FundTopicSponsorshipRequest")
+	proto.RegisterType((*FundTopicSponsorshipResponse)(nil), "emissions.v5.FundTopicSponsorshipResponse")
+	proto.RegisterType((*WithdrawTopicSponsorshipRequest)(nil), "emissions.v5.WithdrawTopicSponsorshipRequest")
+	proto.RegisterType((*WithdrawTopicSponsorshipResponse)(nil), "emissions.v5.WithdrawTopicSponsorshipResponse")
+	proto.RegisterType((*SetTopicAllowlistEnabledRequest)(nil), "emissions.v5.SetTopicAllowlistEnabledRequest")
+	proto.RegisterType((*SetTopicAllowlistEnabledResponse)(nil), "emissions.v5.SetTopicAllowlistEnabledResponse")
+	proto.RegisterType((*AddToTopicAllowlistRequest)(nil), "emissions.v5.AddToTopicAllowlistRequest")
+	proto.RegisterType((*AddToTopicAllowlistResponse)(nil), "emissions.v5.AddToTopicAllowlistResponse")
+	proto.RegisterType((*RemoveFromTopicAllowlistRequest)(nil), "emissions.v5.RemoveFromTopicAllowlistRequest")
+	proto.RegisterType((*RemoveFromTopicAllowlistResponse)(nil), "emissions.v5.RemoveFromTopicAllowlistResponse")
+	proto.RegisterType((*UnjailReputerRequest)(nil), "emissions.v5.UnjailReputerRequest")
+	proto.RegisterType((*UnjailReputerResponse)(nil), "emissions.v5.UnjailReputerResponse")
+	proto.RegisterType((*RewardDelegateStakeResponse)(nil), "emissions.v5.RewardDelegateStakeResponse")
+	proto.RegisterType((*RewardDelegateStakeRequest)(nil), "emissions.v5.RewardDelegateStakeRequest")
+	proto.RegisterType((*SetDelegateStakeAutoCompoundRequest)(nil), "emissions.v5.SetDelegateStakeAutoCompoundRequest")
+	proto.RegisterType((*SetDelegateStakeAutoCompoundResponse)(nil), "emissions.v5.SetDelegateStakeAutoCompoundResponse")
+	proto.RegisterType((*RedelegateStakeRequest)(nil), "emissions.v5.RedelegateStakeRequest")
+	proto.RegisterType((*RedelegateStakeResponse)(nil), "emissions.v5.RedelegateStakeResponse")
+	proto.RegisterType((*ClaimRewardsRequest)(nil), "emissions.v5.ClaimRewardsRequest")
+	proto.RegisterType((*ClaimRewardsResponse)(nil), "emissions.v5.ClaimRewardsResponse")
+}
+
+func init() { proto.RegisterFile("emissions/v5/tx.proto", fileDescriptor_6928f235d40e7988) }
+
+var fileDescriptor_6928f235d40e7988 = []byte{
+	// 4321 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7c, 0xdb, 0x6f, 0x1c, 0x47,
+	0x76, 0xb7, 0x47, 0x1c, 0x52, 0xe4, 0x21, 0x45, 0x0e, 0x8b, 0x17, 0x35, 0x9b, 0xb7, 0xd1, 0x50,
+	0xb6, 0x48, 0xd9, 0x26, 0x6d, 0xc9, 0xfe, 0xd6, 0xb7, 0x2f, 0x59, 0x4a, 0x94, 0x64, 0x32, 0xa2,
+	0x96, 0xdb, 0xa4, 0xed, 0x85, 0xbd, 0xbb, 0xed, 0x62, 0x77, 0x71, 0xa6, 0x57, 0x7d, 0xdb, 0xaa,
+	0x1e, 0x5e, 0x8c, 0x20, 0x08, 0x02, 0xec, 0x53, 0x90, 0x6c, 0x10, 0x20, 0x01, 0x36, 0xb7, 0x97,
+	0x20, 0x40, 0x90, 0x00, 0xc1, 0x3e, 0xec, 0x53, 0x9e, 0xf2, 0xb8, 0x8f, 0x8b, 0x3c, 0x05, 0x41,
+	0xb0, 0x08, 0xec, 0x87, 0xfd, 0x03, 0xf2, 0x10, 0x20, 0x4f, 0x41, 0x5d, 0x7a, 0xfa, 0x32, 0x3d,
+	0xc3, 0xb1, 0x5a, 0x42, 0xfc, 0x62, 0x73, 0xaa, 0x4e, 0xfd, 0xce, 0xa5, 0x4e, 0x9d, 0x73, 0xea,
+	0x74, 0x41, 0x30, 0x47, 0x3c, 0x87, 0x31, 0x27, 0xf0, 0xd9, 0xd6, 0xe9, 0xdb, 0x5b, 0xd1, 0xf9,
+	0x66, 0x48, 0x83, 0x28, 0x40, 0x13, 0x9d, 0xe1, 0xcd, 0xd3, 0xb7, 0xf5, 0x69, 0xec, 0x39, 0x7e,
+	0xb0, 0x25, 0xfe, 0x2b, 0x09, 0xf4, 0xeb, 0x56, 0xc0, 0xbc, 0x80, 0x6d, 0x79, 0xac, 0xb9, 0x75,
+	0xfa, 0x26, 0xff, 0x9f, 0x9a, 0x58, 0x90, 0x13, 0xa6, 0xf8, 0xb5, 0x25, 0x7f, 0xa8, 0x29, 0x3d,
+	0xc5, 0xeb, 0xee, 0x16, 0x25, 0x61, 0x3b, 0x22, 0x54, 0xcd, 0x69, 0x99, 0xb9, 0x28, 0x08, 0x1d,
+	0x2b, 0x06, 0xcc, 0xcc, 0x9c, 0x05, 0xf4, 0x69, 0x67, 0xd1, 0x6c, 0x33, 0x68, 0x06, 0x92, 0x11,
+	0xff, 0x4b, 0x8e, 0x36, 0xfe, 0x6e, 0x1d, 0x26, 0xbf, 0x13, 0x46, 0x4e, 0xe0, 0x63, 0xf7, 0x00,
+	0x53, 0xec, 0x31, 0xa4, 0xc1, 0xd5, 0x53, 0x42, 0x39, 0x88, 0x56, 0xa9, 0x0f, 0xad, 0x8f, 0x19,
+	0xf1, 0x4f, 0xf4, 0x2e, 0x2c, 0x78, 0xf8, 0xdc, 0x64, 0x84, 0x3a, 0xd8, 0x75, 0xbe, 0x20, 0xb6,
+	0xe9, 0xb1, 0xa6, 0xe9, 0x12, 0xbf, 0x19, 0xb5, 0xb4, 0x2b, 0xf5, 0xa1, 0xf5, 0x21, 0x63, 0xde,
+	0xc3, 0xe7, 0x87, 0x9d, 0xf9, 0x7d, 0xd6, 0x7c, 0x2c, 0x66, 0x11, 0x86, 0x9a, 0xe7, 0xf8, 0xa6,
+	0x90, 0xd5, 0x3c, 0x23, 0x4e, 0xb3, 0x15, 0x69, 0x43, 0x1c, 0xfd, 0xde, 0xb7, 0x7e, 0xf9, 0xeb,
+	0xd5, 0x97, 0xfe, 0xfd, 0xd7, 0xab, 0x5b, 0x4d, 0x27, 0x6a, 0xb5, 0x8f, 0x37, 0xad, 0xc0, 0xdb,
+	0xc2, 0xae, 0x1b, 0x50, 0xfc, 0xba, 0x4f, 0x22, 0xae, 0x42, 0xfc, 0xd3, 0x6a, 0x61, 0xc7, 0xdf,
+	0xf2, 0x70, 0xd4, 0xda, 0xdc, 0x21, 0x96, 0x31, 0xe9, 0x39, 0xfe, 0x11, 0xc7, 0xfb, 0x44, 0xc0,
+	0xa1, 0x13, 0x98, 0xa7, 0xe4, 0xc7, 0x6d, 0x87, 0x72, 0xb9, 0x1c, 0xdf, 0xf1, 0xda, 0x9e, 0xc9,
+	0x22, 0xfc, 0x94, 0x68, 0xc3, 0x82, 0xd1, 0x1b, 0x8a, 0xd1, 0x9c, 0xb4, 0x33, 0xb3, 0x9f, 0x6e,
+	0x3a, 0x81, 0x84, 0xdb, 0xf5, 0xa3, 0x7f, 0xfd, 0xc5, 0xeb, 0xa0, 0x36, 0x60, 0xd7, 0x8f, 0xfe,
+	0xfe, 0x37, 0x3f, 0xbf, 0x5d, 0x31, 0x66, 0x63, 0xbc, 0x7d, 0x09, 0x77, 0xc8, 0xd1, 0xb8, 0x15,
+	0x28, 0xf1, 0x82, 0x53, 0x22, 0xd1, 0x4d, 0x9b, 0xb8, 0xf8, 0xc2, 0x3c, 0x73, 0x7c, 0x3b, 0x38,
+	0xd3, 0x46, 0xa4, 0x15, 0x24, 0x81, 0xa0, 0xdf, 0xe1, 0xd3, 0x9f, 0x88, 0x59, 0xb4, 0x2e, 0xad,
+	0x40, 0xc2, 0xc0, 0x6a, 0xc5, 0x76, 0xbb, 0x2a, 0x56, 0x70, 0x65, 0x1e, 0xf0, 0x61, 0x65, 0xaf,
+	0x4f, 0x61, 0xe2, 0x98, 0x44, 0xd8, 0x24, 0x7e, 0x44, 0x83, 0xf0, 0x42, 0x1b, 0x2d, 0x67, 0xab,
+	0x71, 0x0e, 0xf6, 0x40, 0x62, 0xa1, 0xef, 0xc3, 0x35, 0x97, 0x60, 0xea, 0x3b, 0x7e, 0xd3, 0xa4,
+	0x38, 0x22, 0xda, 0x58, 0x39, 0xf0, 0x89, 0x18, 0xcd, 0xc0, 0x11, 0x41, 0x1e, 0x70, 0x1f, 0x30,
+	0x9b, 0x14, 0xdb, 0x0e, 0xf1, 0x23, 0x33, 0x6a, 0x51, 0xc2, 0x5a, 0x81, 0x6b, 0x6b, 0x50, 0x8e,
+	0xcd, 0xac, 0x87, 0xcf, 0x1f, 0x29, 0xd4, 0xa3, 0x18, 0x14, 0x11, 0x40, 0xdc, 0xa4, 0x72, 0x2b,
+	0x4e, 0x28, 0xb6, 0xb8, 0x2f, 0x6b, 0xe3, 0xe5, 0x58, 0xf1, 0x5d, 0x12, 0x9b, 0xf7, 0x50, 0x01,
+	0xa2, 0x07, 0xb0, 0xca, 0xb5, 0x6a, 0xfb, 0x27, 0x6d, 0xf7, 0xc4, 0x71, 0x5d, 0x62, 0x9b, 0xf2,
+	0x74, 0x99, 0xdc, 0x47, 0x08, 0x8b, 0x98, 0x76, 0xad, 0x3e, 0xb4, 0x5e, 0x35, 0x96, 0x3c, 0x7c,
+	0xfe, 0x51, 0x42, 0xf5, 0x89, 0x20, 0x32, 0x14, 0x0d, 0x7a, 0x04, 0xf5, 0x3c, 0x8c, 0x3a, 0xda,
+	0x09, 0xce, 0xa4, 0xc0, 0x59, 0xce, 0xe2, 0x18, 0x92, 0xaa, 0x03, 0xf4, 0x05, 0x2c, 0xcb, 0xb3,
+	0x44, 0xc9, 0x19, 0xa6, 0xb6, 0xd2, 0xdf, 0xf1, 0xc2, 0x80, 0x46, 0xd8, 0xb7, 0x88, 0x36, 0x55,
+	0xce, 0x02, 0xba, 0x40, 0x37, 0x04, 0xb8, 0xb0, 0xc4, 0x6e, 0x07, 0x1a, 0xfd, 0xa4, 0x02, 0x6b,
+	0x19, 0xe6, 0x27, 0x84, 0x98, 0x94, 0x9c, 0x12, 0xbf, 0x9d, 0x11, 0xa1, 0x56, 0x4e, 0x84, 0xd5,
+	0x94, 0x08, 0x0f, 0x09, 0x31, 0x24, 0x83, 0x94, 0x1c, 0x04, 0x50, 0x46, 0x0c, 0xec, 0x86, 0x2d,
+	0xac, 0x4d, 0x97, 0xdc, 0xfa, 0x14, 0xd7, 0x6d, 0x0e, 0x88, 0x2c, 0x98, 0x8e, 0x30, 0x7b, 0x9a,
+	0xe5, 0x82, 0xca, 0x71, 0x99, 0xe2, 0x88, 0x69, 0x26, 0xdc, 0xa6, 0xa7, 0xd8, 0x75, 0x6c, 0x1c,
+	0x05, 0x94, 0x99, 0xa7, 0xcc, 0x94, 0x0b, 0xcd, 0x90, 0x50, 0x8b, 0x1f, 0x23, 0xc9, 0x5d, 0x9b,
+	0x29, 0x69, 0xd3, 0x84, 0xc7, 0xc7, 0x6c, 0x5b, 0x90, 0x1c, 0x48, 0x06, 0x52, 0x18, 0xf4, 0x01,
+	0x2c, 0x8a, 0x10, 0x8f, 0xbd, 0xd0, 0x25, 0xcc, 0x8c, 0x02, 0x93, 0x59, 0xd8, 0x25, 0x26, 0xb3,
+	0x02, 0x4a, 0x98, 0x36, 0x2b, 0x7c, 0xf3, 0x3a, 0x0f, 0xf2, 0x92, 0xe2, 0x28, 0x38, 0xe4, 0xf3,
+	0x87, 0x62, 0x1a, 0xbd, 0x07, 0x3a, 0x5f, 0x1d, 0x05, 0xa1, 0xe9, 0xf8, 0x27, 0x84, 0x12, 0x2a,
+	0x20, 0x94, 0xec, 0x73, 0x62, 0x31, 0x8f, 0x0e, 0x47, 0x41, 0xb8, 0xab, 0xe6, 0x8f, 0x02, 0xc5,
+	0xf9, 0xdb, 0xb0, 0x1c, 0xaf, 0x3d, 0x09, 0x28, 0xb1, 0x30, 0x8b, 0xb2, 0xcb, 0xe7, 0xc5, 0xf2,
+	0x05, 0xb9, 0xfc, 0x61, 0x42, 0xd2, 0x41, 0x48, 0x71, 0x57, 0x87, 0x2a, 0xbd, 0xfc, 0x7a, 0x9a,
+	0xbb, 0x3a, 0x4e, 0xc9, 0xda, 0x4f, 0xa1, 0x66, 0x51, 0x82, 0x23, 0xa2, 0x52, 0xd4, 0x09, 0x21,
+	0x9a, 0xf6, 0x8c, 0x69, 0x63, 0x52, 0x22, 0x89, 0xdc, 0xf4, 0x90, 0x10, 0xf4, 0x3e, 0xe8, 0x9d,
+	0x68, 0x68, 0x13, 0x26, 0xb6, 0x93, 0x0b, 0xea, 0x70, 0x09, 0xb4, 0x05, 0x69, 0xd2, 0x98, 0x62,
+	0x47, 0x12, 0xec, 0xe3, 0xf3, 0x5d, 0x3e, 0x8d, 0x3e, 0x83, 0x1a, 0x25, 0x4d, 0x87, 0x45, 0x14,
+	0xf3, 0x40, 0x24, 0x04, 0x5b, 0x7a, 0x46, 0xc1, 0xa6, 0xd2, 0x48, 0x5c, 0xb2, 0xd7, 0x00, 0xd9,
+	0xe4, 0x04, 0xb7, 0xdd, 0xc8, 0x0c, 0x71, 0x93, 0x98, 0xae, 0xe3, 0x39, 0x91, 0xb6, 0x2c, 0x24,
+	0xaa, 0xa9, 0x99, 0x03, 0xdc, 0x24, 0x8f, 0xf9, 0x38, 0xba, 0x09, 0x93, 0x5c, 0xec, 0x14, 0xe5,
+	0x8a, 0xa0, 0x9c, 0xf0, 0xf0, 0x79, 0x42, 0xc5, 0xf7, 0x31, 0x97, 0xe3, 0x4c, 0x4a, 0xac, 0x80,
+	0xda, 0x6a, 0xd1, 0xaa, 0x48, 0x78, 0x0b, 0xd9, 0x84, 0x67, 0x08, 0x0a, 0x89, 0xb0, 0x0e, 0xb5,
+	0x63, 0x37, 0xb0, 0x9e, 0x32, 0xee, 0xfc, 0xa6, 0x17, 0xf8, 0x51, 0x4b, 0xab, 0x0b, 0x4e, 0x93,
+	0x72, 0xfc, 0x80, 0xd0, 0x7d, 0x3e, 0xca, 0x23, 0x40, 0x18, 0x9f, 0x4b, 0xe9, 0x70, 0x3c, 0xee,
+	0xdc, 0x28, 0x19, 0x01, 0x42, 0xe9, 0x13, 0xbb, 0x31, 0x20, 0x8f, 0x00, 0x1d, 0x36, 0xb1, 0x6f,
+	0x6a, 0x8d, 0x92, 0x11, 0x40, 0x71, 0x89, 0x1d, 0x99, 0x57, 0x48, 0x1d, 0x26, 0xca, 0x7d, 0xb5,
+	0xb5, 0x92, 0x15, 0x92, 0xe2, 0xa1, 0xbc, 0x9d, 0x9b, 0xcb, 0xea, 0x36, 0xd7, 0xcd, 0x92, 0xe6,
+	0xb2, 0x0a, 0xcc, 0x65, 0x75, 0x99, 0xeb, 0xe5, 0x92, 0xe6, 0xb2, 0x72, 0xe6, 0x7a, 0x02, 0x23,
+	0x96, 0xe9, 0x07, 0xd4, 0xd3, 0x5e, 0x29, 0x87, 0x3c, 0x6c, 0x3d, 0x09, 0xa8, 0x87, 0x3e, 0x87,
+	0x29, 0x12, 0x32, 0xc7, 0x0d, 0xfc, 0x8e, 0xf5, 0xd7, 0x4b, 0x5a, 0x5f, 0xe1, 0xc5, 0xd6, 0xff,
+	0x18, 0x36, 0x5a, 0xd8, 0x3d, 0x11, 0x47, 0x3f, 0xa4, 0x81, 0x45, 0x18, 0x53, 0x69, 0x5b, 0x54,
+	0x8b, 0xd8, 0x65, 0x26, 0xf1, 0x6d, 0x53, 0xb8, 0xb8, 0x76, 0x5b, 0xf8, 0xfb, 0x1a, 0x5f, 0xb0,
+	0x8f, 0xcf, 0x0f, 0x24, 0xb9, 0x48, 0xc4, 0x86, 0x22, 0x7e, 0xe0, 0xdb, 0xf7, 0x38, 0x29, 0x0f,
+	0x5d, 0x36, 0x8e, 0xb0, 0xc9, 0x88, 0x6f, 0xf3, 0x92, 0x8e, 0x47, 0x88, 0x57, 0x9f, 0x35, 0x74,
+	0x71, 0xa4, 0x43, 0x09, 0xc4, 0x03, 0x04, 0x86, 0x5a, 0x6c, 0x15, 0x86, 0x4f, 0x88, 0x69, 0x3b,
+	0xa7, 0xda, 0x6b, 0xcf, 0xc7, 0x2c, 0x87, 0xf8, 0x84, 0xec, 0x38, 0xa7, 0xf1, 0xa5, 0x82, 0xb8,
+	0xc4, 0x23, 0x7e, 0x24, 0xcf, 0x7c, 0xc7, 0x6b, 0x5e, 0xef, 0x04, 0xed, 0x07, 0x6a, 0xfe, 0x80,
+	0xd0, 0x8e, 0x0f, 0xa8, 0x64, 0xc5, 0x4b, 0xb4, 0x53, 0x15, 0xb8, 0xe5, 0x7a, 0x69, 0xc3, 0xcd,
+	0x4e, 0xb2, 0xda, 0x16, 0x14, 0x22, 0x20, 0x73, 0x00, 0x69, 0xb7, 0xdb, 0x30, 0xcd, 0x57, 0xb3,
+	0x88, 0x72, 0xab, 0xa9, 0x6a, 0x7c, 0x4b, 0xac, 0x99, 0xe2, 0x09, 0x4e, 0x8c, 0xab, 0x72, 0x3c,
+	0x80, 0xeb, 0x8e, 0xef, 0x44, 0x0e, 0x76, 0x4d, 0x4a, 0x9a, 0x94, 0x44, 0xe6, 0x8f, 0xdb, 0xd8,
+	0x8f, 0x1c, 0x97, 0x68, 0x6f, 0x94, 0x33, 0xc7, 0x9c, 0xc2, 0x35, 0x04, 0xec, 0x77, 0x15, 0x2a,
+	0xfa, 0x21, 0x4c, 0x85, 0xc2, 0xbd, 0x13, 0xbb, 0xbf, 0x59, 0xb2, 0x4a, 0x0f, 0xb9, 0x9f, 0xc7,
+	0x56, 0xf7, 0xf8, 0x65, 0x49, 0x16, 0x9e, 0xcc, 0xc5, 0xac, 0x95, 0x94, 0xce, 0x77, 0x4a, 0x56,
+	0xe9, 0x0a, 0xf6, 0x90, 0xa3, 0x76, 0xca, 0xe7, 0x53, 0x58, 0x14, 0x6c, 0x48, 0x5c, 0xa9, 0x1e,
+	0xb7, 0xa9, 0x9f, 0xf0, 0xbc, 0x5b, 0x8e, 0xa7, 0xa6, 0xb0, 0xc5, 0xf9, 0xb8, 0xd7, 0xa6, 0x7e,
+	0x87, 0xaf, 0x72, 0x2e, 0x7e, 0x29, 0x4e, 0x95, 0xda, 0x7e, 0xe0, 0x5b, 0x84, 0x69, 0x6f, 0x75,
+	0x9c, 0x6b, 0x5f, 0xcc, 0xab, 0x83, 0xfa, 0x44, 0xcc, 0x72, 0x91, 0x63, 0xfa, 0xa0, 0x1d, 0xb9,
+	0x0e, 0xb7, 0x94, 0x15, 0x50, 0x62, 0x8a, 0xf4, 0xa9, 0xbd, 0x5d, 0x52, 0x64, 0x85, 0xfd, 0x1d,
+	0x09, 0x2d, 0xea, 0x27, 0x83, 0x03, 0xf3, 0x6a, 0x81, 0x8b, 0x9c, 0xe7, 0x2d, 0xf2, 0x29, 0xd3,
+	0xfe, 0x5f, 0xc7, 0xa7, 0x8d, 0x0c, 0x80, 0x48, 0xa5, 0x0c, 0xdd, 0x81, 0xb9, 0x78, 0xe1, 0x8f,
+	0xb0, 0xe3, 0x9a, 0x76, 0x5b, 0x26, 0x7b, 0xed, 0x5b, 0x22, 0xe9, 0xce, 0xa8, 0xc9, 0x3d, 0xec,
+	0xb8, 0x3b, 0x6a, 0x0a, 0x45, 0x59, 0x86, 0x6e, 0xc0, 0x98, 0x69, 0x93, 0x53, 0x47, 0x2e, 0x7c,
+	0xa7, 0x9c, 0x9e, 0x29, 0x49, 0x1f, 0x07, 0x8c, 0xed, 0xc4, 0xb8, 0x68, 0x1f, 0xd6, 0xd4, 0xca,
+	0x24, 0x15, 0x99, 0x98, 0x5a, 0x2d, 0x7e, 0x94, 0x29, 0x89, 0x88, 0x2f, 0xd8, 0xbf, 0x2b, 0xe4,
+	0xae, 0x2b, 0xd2, 0x4e, 0x8e, 0xd9, 0x96, 0x84, 0x46, 0x4c, 0x87, 0xee, 0xca, 0x5b, 0x67, 0x88,
+	0x2f, 0xdc, 0x00, 0xdb, 0x2a, 0x0a, 0xe0, 0xc8, 0x6a, 0x69, 0xef, 0x09, 0x8b, 0xcd, 0x88, 0x1a,
+	0x45, 0x4e, 0xf2, 0x08, 0xc0, 0xa7, 0xd0, 0x39, 0x2c, 0x09, 0x1a, 0x62, 0xc7, 0x0b, 0xcd, 0x26,
+	0x66, 0xa6, 0xed, 0x30, 0x2b, 0x68, 0xfb, 0x91, 0xf6, 0x7e, 0x39, 0xdd, 0x17, 0x14, 0xb8, 0xe2,
+	0xfb, 0x08, 0xb3, 0x1d, 0x85, 0x1c, 0x5f, 0x27, 0x29, 0xb1, 0x89, 0x4b, 0x9a, 0xc2, 0x22, 0x52,
+	0x66, 0xf5, 0x3b, 0xa0, 0xda, 0x07, 0x9d, 0xeb, 0xa4, 0x91, 0xa6, 0x3a, 0x20, 0x74, 0x27, 0xa6,
+	0x41, 0x6f, 0xf1, 0x53, 0x2c, 0x6f, 0x25, 0x96, 0x45, 0xdb, 0xd8, 0x35, 0x89, 0x8f, 0x8f, 0x5d,
+	0x62, 0x6b, 0xff, 0xbf, 0x3e, 0xb4, 0x3e, 0x6a, 0xcc, 0xca, 0xd9, 0x6d, 0x39, 0xf9, 0x40, 0xce,
+	0xa1, 0xdf, 0x83, 0x7a, 0x3e, 0x61, 0x88, 0xa4, 0x64, 0xb5, 0xb0, 0xdf, 0x24, 0xb2, 0x25, 0xf0,
+	0x5b, 0xe5, 0x54, 0x5f, 0xcc, 0xe6, 0x91, 0x7d, 0x7c, 0x7e, 0x5f, 0x80, 0x8b, 0x0e, 0xc1, 0x05,
+	0x2c, 0x77, 0xf1, 0x8f, 0x30, 0x6d, 0x92, 0x48, 0x9d, 0xad, 0xdf, 0x2e, 0x69, 0xf7, 0x2c, 0xf3,
+	0x23, 0x01, 0x2d, 0x0f, 0xd7, 0x9b, 0x30, 0xd7, 0xf4, 0x42, 0x13, 0x9f, 0x13, 0x17, 0x53, 0xa1,
+	0xb0, 0x4f, 0x5c, 0xd3, 0xb1, 0xb5, 0x6f, 0x73, 0x96, 0x06, 0x6a, 0x7a, 0xe1, 0xb6, 0x98, 0xbb,
+	0x2f, 0xa7, 0x76, 0x6d, 0xb4, 0x07, 0x8d, 0xf4, 0x12, 0xbe, 0x7d, 0x84, 0x86, 0x98, 0x46, 0x17,
+	0xe9, 0xf5, 0xdb, 0x62, 0xfd, 0x4a, 0xb2, 0x3e, 0x45, 0xd7, 0xc1, 0xda, 0xab, 0x8e, 0x56, 0x6b,
+	0xc3, 0x7b, 0xd5, 0x51, 0xbd, 0xb6, 0xb8, 0x57, 0x1d, 0x5d, 0xac, 0x2d, 0xed, 0x55, 0x47, 0x6f,
+	0xd5, 0xd6, 0xf7, 0xaa, 0xa3, 0x1b, 0xb5, 0xdb, 0xa2, 0xc1, 0xd1, 0x95, 0xc5, 0x8c, 0x45, 0x51,
+	0x4d, 0x9f, 0x9c, 0x90, 0x54, 0x96, 0x8b, 0x6f, 0xdb, 0xc6, 0x9a, 0xf4, 0xa2, 0x88, 0x3a, 0xf2,
+	0xb2, 0x26, 0xfb, 0x05, 0x2a, 0xc0, 0xa9, 0x0e, 0x85, 0xb1, 0xd4, 0xb9, 0xd2, 0xc4, 0xeb, 0x4c,
+	0x9b, 0x58, 0xf8, 0x42, 0xec, 0xb4, 0x71, 0xb3, 0x2f, 0x84, 0x8a, 0x0a, 0x8d, 0x10, 0x66, 0x3e,
+	0x0a, 0x6d, 0x1c, 0x11, 0xd9, 0x24, 0x54, 0x7d, 0x08, 0x34, 0x0f, 0x23, 0x7c, 0x17, 0x09, 0xd5,
+	0x2a, 0xf5, 0xca, 0xfa, 0x98, 0xa1, 0x7e, 0xa1, 0xb7, 0x60, 0x24, 0x14, 0x84, 0xda, 0x95, 0x7a,
+	0x65, 0x7d, 0xfc, 0xce, 0xd2, 0x66, 0xba, 0x47, 0xba, 0x99, 0xed, 0x38, 0x1a, 0x8a, 0xf6, 0xbd,
+	0xf1, 0x3f, 0xf8, 0xcd, 0xcf, 0x6f, 0x2b, 0x88, 0xc6, 0x3c, 0xcc, 0x66, 0x39, 0xb2, 0x30, 0xf0,
+	0x19, 0x69, 0xfc, 0xc7, 0x18, 0xcc, 0xdd, 0x17, 0x17, 0xac, 0x27, 0xe4, 0xec, 0x48, 0x5e, 0xd6,
+	0xa5, 0x30, 0x1a, 0x5c, 0x15, 0x37, 0xaf, 0x20, 0x96, 0x26, 0xfe, 0x89, 0x74, 0x18, 0xf5, 0x48,
+	0x84, 0xb9, 0x5f, 0x08, 0x81, 0xc6, 0x8c, 0xce, 0x6f, 0xb4, 0x0a, 0xe3, 0x22, 0xe4, 0x79, 0x24,
+	0x6a, 0x05, 0xb6, 0x56, 0x15, 0xd3, 0xc0, 0x87, 0xf6, 0xc5, 0x08, 0xba, 0x01, 0x13, 0xb9, 0x86,
+	0x5d, 0x65, 0x7d, 0xc8, 0x18, 0x27, 0xa9, 0x6e, 0xdd, 0x3a, 0xd4, 0x9a, 0x34, 0x68, 0xfb, 0xb6,
+	0x19, 0xd1, 0x76, 0xd4, 0x32, 0x5d, 0xdc, 0xd4, 0x46, 0x05, 0xd9, 0xa4, 0x1c, 0x3f, 0xe2, 0xc3,
+	0x8f, 0x71, 0x93, 0x97, 0xad, 0x32, 0xaf, 0x6b, 0x50, 0xaf, 0x94, 0x71, 0xf2, 0x61, 0x91, 0xce,
+	0x79, 0x9f, 0x50, 0x34, 0x24, 0x54, 0x59, 0xa2, 0x8d, 0x97, 0x43, 0x1d, 0x17, 0x60, 0xb2, 0x16,
+	0x41, 0x2f, 0xc3, 0x24, 0xa7, 0x3a, 0x33, 0x7d, 0x11, 0x7c, 0x4e, 0x89, 0x36, 0x51, 0xaf, 0xac,
+	0x8f, 0x1a, 0xd7, 0xc4, 0xe8, 0x13, 0x35, 0x88, 0xbe, 0x0b, 0x57, 0x55, 0x49, 0xa7, 0x5d, 0x2b,
+	0xc7, 0x3d, 0xc6, 0x41, 0xef, 0x80, 0xa6, 0xba, 0x6b, 0xac, 0x7d, 0xac, 0x1c, 0x27, 0xee, 0xb0,
+	0x4e, 0x0a, 0xbb, 0xce, 0xcb, 0xf9, 0xc3, 0xce, 0xb4, 0xea, 0xb0, 0x3e, 0x85, 0x39, 0x8f, 0x50,
+	0x27, 0x32, 0x59, 0x40, 0x23, 0x47, 0xdc, 0x98, 0x65, 0xc3, 0x66, 0xaa, 0x9c, 0x68, 0x33, 0x02,
+	0xf5, 0x30, 0x06, 0x95, 0x4d, 0x9b, 0x00, 0xae, 0xab, 0xda, 0x53, 0x75, 0x3b, 0x92, 0xaa, 0xb0,
+	0x56, 0x8e, 0xdd, 0x9c, 0xc4, 0x55, 0x4d, 0x92, 0x4e, 0x55, 0xd8, 0x06, 0x5d, 0x31, 0x4c, 0x5a,
+	0x24, 0x09, 0xcf, 0xe9, 0x72, 0x3c, 0x35, 0x09, 0x9d, 0x74, 0x56, 0x3a, 0x6c, 0x13, 0x3d, 0xe3,
+	0x22, 0xa1, 0xc3, 0x13, 0x3d, 0x17, 0x3d, 0x55, 0x85, 0xd0, 0x61, 0xf8, 0x06, 0xcc, 0x76, 0xba,
+	0xab, 0xa7, 0x04, 0xbb, 0xf1, 0xde, 0xcf, 0x88, 0xbd, 0x47, 0x72, 0xce, 0x10, 0x53, 0x6a, 0xdf,
+	0x6f, 0xc1, 0xd4, 0x29, 0x76, 0x79, 0x64, 0x73, 0x3c, 0xe2, 0x8b, 0x8f, 0x17, 0xb3, 0xf5, 0x0a,
+	0x6f, 0x19, 0x88, 0xe1, 0x9d, 0x78, 0x14, 0x7d, 0x00, 0x7a, 0x5c, 0x65, 0x74, 0xd5, 0x1f, 0x4c,
+	0x9b, 0x13, 0x0e, 0xae, 0x29, 0x8a, 0x27, 0xb9, 0xaa, 0x83, 0xbd, 0x37, 0xc1, 0x23, 0x54, 0x1c,
+	0x56, 0xf6, 0xaa, 0xa3, 0x43, 0xb5, 0xea, 0x5e, 0x75, 0x74, 0xb8, 0x36, 0xb2, 0x57, 0x1d, 0x1d,
+	0xa9, 0x5d, 0xdd, 0xab, 0x8e, 0x8e, 0xd5, 0x40, 0x46, 0x0f, 0xd3, 0x0d, 0x9a, 0x8e, 0x65, 0x4c,
+	0x25, 0xd5, 0x8d, 0x1c, 0xa8, 0x25, 0x03, 0x32, 0xe4, 0x18, 0xe3, 0x71, 0x0f, 0x06, 0xd3, 0x66,
+	0xe3, 0x2e, 0xcc, 0xe7, 0xa3, 0x9b, 0x0c, 0x7c, 0x68, 0x01, 0x46, 0x65, 0x20, 0x77, 0x6c, 0x11,
+	0xdf, 0xaa, 0xc6, 0x55, 0xf1, 0x7b, 0xd7, 0x6e, 0xfc, 0xb4, 0x02, 0x48, 0x06, 0xcb, 0x4c, 0x40,
+	0xec, 0x15, 0x9d, 0xd3, 0x48, 0x57, 0x32, 0x48, 0xe8, 0xdd, 0x4e, 0xe0, 0x1e, 0x12, 0x81, 0xfb,
+	0x46, 0x3a, 0x70, 0xdf, 0xed, 0x04, 0x6e, 0xc1, 0xa6, 0x5f, 0xf4, 0xfe, 0x9d, 0x38, 0x5f, 0x64,
+	0x75, 0x78, 0x0b, 0xe6, 0x93, 0x54, 0x26, 0xf2, 0x9b, 0xd9, 0x92, 0x1f, 0x83, 0x2a, 0x62, 0x6b,
+	0x67, 0x3b, 0xb3, 0xe2, 0x8e, 0xf6, 0xa1, 0x98, 0x6b, 0x1c, 0x01, 0x32, 0x48, 0xe4, 0xd0, 0xb2,
+	0xda, 0x65, 0x45, 0x9c, 0x83, 0x99, 0x0c, 0xaa, 0xca, 0x2f, 0x7f, 0x5e, 0x81, 0xc5, 0x5d, 0x9f,
+	0x11, 0x1a, 0x29, 0xaf, 0x54, 0xe5, 0xdb, 0x65, 0x6c, 0x0d, 0x88, 0xaf, 0x3e, 0xa6, 0xf4, 0xc4,
+	0xe3, 0xb6, 0x6f, 0xbb, 0x44, 0x25, 0xc0, 0x7a, 0xd6, 0x8e, 0x0a, 0xfa, 0x63, 0x4e, 0x78, 0x4f,
+	0xd0, 0x19, 0x88, 0x76, 0x8d, 0x65, 0xe5, 0x5d, 0x81, 0xa5, 0x62, 0xb9, 0x94, 0xe0, 0x3f, 0xab,
+	0x14, 0x13, 0x5c, 0x9a, 0xac, 0x8f, 0x60, 0xae, 0x48, 0x72, 0x26, 0x3e, 0xe9, 0x0d, 0x22, 0xfa,
+	0x4c, 0xb7, 0xe8, 0x39, 0x77, 0xf8, 0x01, 0x2c, 0xf7, 0x10, 0x4d, 0x39, 0xc6, 0x07, 0x70, 0x95,
+	0x12, 0xd6, 0x76, 0x23, 0x26, 0x3e, 0x3a, 0x8e, 0xdf, 0x69, 0x64, 0x2b, 0x86, 0x7b, 0x99, 0x52,
+	0xda, 0x10, 0xa4, 0x46, 0xbc, 0xa4, 0x81, 0x61, 0xb6, 0x88, 0xa0, 0xcf, 0x91, 0xe1, 0xc5, 0x02,
+	0x6b, 0x5b, 0x16, 0x61, 0xb2, 0x44, 0x19, 0x35, 0xe2, 0x9f, 0x68, 0x16, 0x86, 0x09, 0xa5, 0x01,
+	0x15, 0x27, 0x60, 0xcc, 0x90, 0x3f, 0x1a, 0xff, 0x55, 0x01, 0x4d, 0x64, 0x9b, 0xe8, 0x51, 0x92,
+	0xd1, 0x4b, 0x1c, 0xb4, 0xf7, 0x41, 0xcf, 0x5c, 0x47, 0xb3, 0xa7, 0x61, 0x48, 0x9c, 0x86, 0xeb,
+	0x34, 0x75, 0x23, 0x4d, 0x1d, 0x08, 0x9e, 0xf5, 0xd3, 0xf5, 0x86, 0x56, 0x2d, 0x17, 0x85, 0xc7,
+	0x53, 0x45, 0x4a, 0x76, 0xdf, 0x16, 0x61, 0xa1, 0x40, 0x69, 0xe5, 0x70, 0x3f, 0xad, 0x80, 0x2e,
+	0x77, 0x55, 0x7e, 0xe5, 0x1a, 0xf0, 0xa0, 0x3c, 0x06, 0x15, 0xc0, 0x4d, 0x71, 0x0b, 0xc8, 0x1c,
+	0x93, 0x95, 0xac, 0xaf, 0x49, 0xdc, 0x1d, 0x1c, 0x61, 0xe5, 0x69, 0xb5, 0xb3, 0xdc, 0x48, 0x56,
+	0xdc, 0xe5, 0xf8, 0xe8, 0xe6, 0x04, 0x52, 0x02, 0xff, 0x69, 0xa5, 0x70, 0xfe, 0xd2, 0x03, 0xf2,
+	0x04, 0x66, 0xba, 0x25, 0x8e, 0x8f, 0xc7, 0x65, 0x22, 0x4f, 0xe7, 0x45, 0xce, 0x1d, 0x8d, 0xef,
+	0xc7, 0xa7, 0x36, 0x2f, 0xd3, 0x73, 0x39, 0x19, 0xff, 0x50, 0x01, 0xfd, 0x7e, 0xe0, 0x79, 0xce,
+	0xd7, 0xdb, 0xa3, 0x3e, 0x8e, 0xfb, 0x1a, 0xa0, 0x9e, 0x0e, 0x5b, 0xf3, 0xf3, 0x9e, 0xba, 0x02,
+	0x60, 0x09, 0xf6, 0x1e, 0xf1, 0x23, 0xe1, 0xa7, 0x13, 0x46, 0x6a, 0xa4, 0x6b, 0xfb, 0x0a, 0x65,
+	0x55, 0xdb, 0xf7, 0xb7, 0x15, 0xd0, 0x55, 0xd2, 0xff, 0x3f, 0xf3, 0x37, 0x84, 0xa0, 0xca, 0xb0,
+	0x2b, 0x15, 0x9e, 0x30, 0xc4, 0xdf, 0x5d, 0x4a, 0x14, 0x0a, 0xa9, 0x94, 0xf8, 0xa7, 0x0a, 0x4c,
+	0x19, 0xe2, 0x33, 0x0c, 0xa1, 0x97, 0x49, 0x9e, 0xde, 0x85, 0x6a, 0x76, 0x17, 0x66, 0x61, 0x38,
+	0x38, 0xf3, 0x09, 0xd5, 0x86, 0x65, 0x90, 0x12, 0x3f, 0xd0, 0x32, 0x80, 0xd3, 0xb9, 0xb3, 0x69,
+	0x23, 0x22, 0xae, 0x8d, 0x39, 0x4c, 0x05, 0xdd, 0x8c, 0x9c, 0x7b, 0xd5, 0xd1, 0x2b, 0xb5, 0x21,
+	0x59, 0xc2, 0x18, 0xe3, 0xae, 0x73, 0x6c, 0x86, 0x77, 0x42, 0xf3, 0x29, 0xb9, 0x30, 0xae, 0x79,
+	0x6d, 0x37, 0x72, 0x4c, 0x6c, 0xdb, 0x94, 0x30, 0xd6, 0x78, 0x08, 0xb5, 0x44, 0x5e, 0xe5, 0x93,
+	0xa9, 0xe0, 0x59, 0xc9, 0x06, 0x4f, 0x0d, 0xae, 0x7a, 0x84, 0x31, 0xdc, 0x24, 0xea, 0xa2, 0x15,
+	0xff, 0x6c, 0x7c, 0x01, 0x0b, 0xa2, 0x75, 0x4d, 0x8c, 0xd4, 0x47, 0xa8, 0x12, 0x7e, 0x98, 0xd5,
+	0x75, 0xa8, 0x9f, 0xae, 0x8d, 0x03, 0xd0, 0x8b, 0x78, 0x97, 0xd0, 0xe6, 0x2f, 0x2b, 0x30, 0xb5,
+	0x6d, 0xdb, 0xaa, 0x23, 0xff, 0xcc, 0x4a, 0x7c, 0x08, 0x23, 0xd8, 0x13, 0xbd, 0x26, 0x91, 0x6c,
+	0x9e, 0xa1, 0x63, 0xaf, 0xd6, 0x67, 0xf5, 0x45, 0x50, 0x4b, 0x84, 0x53, 0x8e, 0xf7, 0x37, 0x15,
+	0x40, 0xd2, 0x08, 0xdf, 0x50, 0xa1, 0x45, 0x3d, 0x96, 0x92, 0x4f, 0xc9, 0xfd, 0x29, 0x68, 0xf7,
+	0xb1, 0x6f, 0x11, 0xf7, 0xb9, 0x08, 0xdf, 0x95, 0xde, 0x0a, 0xb0, 0x15, 0xe3, 0x7f, 0xae, 0xc0,
+	0xac, 0x6a, 0xb5, 0x95, 0x36, 0x99, 0xc6, 0x83, 0x78, 0xe2, 0xa9, 0x63, 0x46, 0xfc, 0x33, 0x65,
+	0xcc, 0xea, 0xf3, 0x34, 0xe6, 0x75, 0x98, 0xcb, 0xc9, 0xae, 0xb4, 0xfa, 0x97, 0x4a, 0x7c, 0x16,
+	0xbe, 0x96, 0x6e, 0x29, 0x05, 0xae, 0x64, 0x15, 0x48, 0x6b, 0x3d, 0xd4, 0xcb, 0x51, 0x9e, 0xab,
+	0x6e, 0x22, 0xc2, 0x16, 0x68, 0xa0, 0x34, 0xfc, 0x8b, 0x0a, 0xd4, 0xd3, 0xbb, 0xfa, 0xbc, 0xf6,
+	0x70, 0x09, 0xc6, 0x92, 0xee, 0xac, 0xdc, 0xc5, 0x64, 0x20, 0x6d, 0xa0, 0x6a, 0xc6, 0x40, 0x59,
+	0xd9, 0xd7, 0xe0, 0x46, 0x1f, 0xd9, 0x94, 0x06, 0x7f, 0x55, 0x81, 0xda, 0x43, 0x5e, 0x6e, 0x95,
+	0xbc, 0xcc, 0xbd, 0xa0, 0x83, 0x3a, 0x03, 0xd3, 0x29, 0xe9, 0x94, 0xcc, 0x9f, 0x81, 0xbe, 0x6d,
+	0xdb, 0x47, 0xc1, 0x27, 0x2d, 0x27, 0x22, 0xae, 0xc3, 0xa2, 0x6d, 0xdb, 0x73, 0xfc, 0x01, 0xdc,
+	0x4a, 0xe5, 0x99, 0xd8, 0xad, 0xd4, 0xcf, 0xae, 0x1d, 0x2f, 0x04, 0x57, 0xbc, 0x3f, 0x87, 0x55,
+	0x69, 0xce, 0x87, 0x34, 0xf0, 0x5e, 0x88, 0x00, 0x0d, 0xa8, 0xf7, 0xe6, 0xa0, 0xa4, 0xf8, 0xc3,
+	0x8a, 0x30, 0xc1, 0x23, 0x2f, 0xe4, 0x0f, 0x6b, 0xce, 0x88, 0x7d, 0x18, 0xb4, 0xa9, 0x75, 0xa9,
+	0xc7, 0xdd, 0x80, 0x09, 0x26, 0x08, 0x4d, 0x51, 0x95, 0x2b, 0x31, 0xc6, 0xe5, 0xd8, 0x7d, 0x3e,
+	0xc4, 0x1b, 0x71, 0x8a, 0x24, 0x96, 0x55, 0xba, 0xdf, 0x35, 0x39, 0xba, 0xdd, 0xdb, 0x64, 0xdd,
+	0xc2, 0x28, 0x61, 0xff, 0xb8, 0x02, 0xcb, 0x52, 0xa3, 0x6f, 0x88, 0xbc, 0x75, 0x58, 0xe9, 0x25,
+	0x8f, 0x12, 0xf9, 0x7f, 0xae, 0xc0, 0x92, 0x6c, 0x8d, 0x3c, 0xf2, 0xc2, 0xc3, 0xf6, 0x31, 0xb3,
+	0xa8, 0x13, 0x96, 0x2c, 0x22, 0x5e, 0x85, 0x69, 0x9b, 0xb0, 0xc8, 0xf1, 0xe5, 0xe3, 0x1a, 0xa9,
+	0x91, 0x14, 0xb6, 0x96, 0x9a, 0x90, 0x6a, 0x6d, 0xc1, 0x4c, 0x9a, 0x38, 0xd6, 0x4d, 0x1e, 0x77,
+	0x94, 0x9a, 0x52, 0x0a, 0xa2, 0xef, 0xc1, 0x14, 0x6f, 0xba, 0x8b, 0x57, 0x2c, 0xaa, 0x8c, 0x18,
+	0x79, 0xc6, 0x83, 0x78, 0xed, 0x84, 0x10, 0xfe, 0xec, 0x45, 0xc2, 0xa0, 0x03, 0x80, 0x90, 0x92,
+	0x10, 0x5f, 0x88, 0xb2, 0xfa, 0xea, 0x33, 0x82, 0xa6, 0x30, 0xf2, 0xb5, 0xe1, 0x70, 0x6d, 0xc4,
+	0x80, 0xe4, 0xfb, 0x46, 0xe3, 0x43, 0x58, 0xee, 0x61, 0x7b, 0x55, 0x44, 0xdd, 0x82, 0x29, 0x96,
+	0x1a, 0x4f, 0x6e, 0xdc, 0x93, 0xe9, 0xe1, 0x5d, 0xbb, 0xf1, 0x8b, 0x0a, 0xe8, 0x3c, 0x7c, 0x7c,
+	0xcd, 0x4d, 0x2c, 0xc0, 0xbf, 0x52, 0x84, 0xff, 0xa2, 0x82, 0xde, 0x32, 0x2c, 0x16, 0x4a, 0xad,
+	0x9c, 0xd3, 0x85, 0x25, 0x19, 0xd7, 0x5f, 0x90, 0x5a, 0x59, 0x61, 0x56, 0x61, 0xb9, 0x07, 0x37,
+	0x25, 0xce, 0x7f, 0x5f, 0x81, 0xc5, 0x4e, 0x8c, 0x3e, 0xe4, 0x63, 0x01, 0x65, 0x2d, 0x27, 0xfc,
+	0x26, 0x24, 0x13, 0x14, 0x00, 0xff, 0xaa, 0xc9, 0xbf, 0x47, 0xc9, 0xef, 0x5c, 0xd8, 0x8a, 0x02,
+	0x2a, 0xfe, 0x12, 0xdf, 0x54, 0x9e, 0xb9, 0x58, 0xe0, 0xdf, 0x9a, 0x1f, 0x12, 0xc2, 0x3f, 0x91,
+	0x6e, 0x73, 0xc8, 0x03, 0xf5, 0x59, 0x1c, 0xfd, 0x00, 0x50, 0x86, 0xa1, 0x64, 0x33, 0xfc, 0x8c,
+	0x6c, 0xa6, 0x12, 0x36, 0x02, 0xbe, 0xab, 0x4b, 0x57, 0x6c, 0x78, 0xb5, 0x33, 0xff, 0x58, 0x81,
+	0xd5, 0x4f, 0x9c, 0xa8, 0x65, 0x53, 0x7c, 0xf6, 0x4d, 0xdc, 0x9d, 0xae, 0xbc, 0xd7, 0x5b, 0xd8,
+	0xa4, 0xde, 0x5a, 0x3d, 0x24, 0x91, 0x98, 0x17, 0x91, 0x9b, 0xa7, 0x46, 0xf5, 0xad, 0xf9, 0x85,
+	0xdd, 0xef, 0x78, 0xe2, 0x8e, 0xbf, 0x75, 0x57, 0xe5, 0xa5, 0x4d, 0xfd, 0xec, 0x52, 0xa0, 0xb7,
+	0x6c, 0x4a, 0x81, 0x3f, 0xab, 0xa8, 0xda, 0x25, 0x4b, 0xf6, 0x42, 0x65, 0xcf, 0x26, 0x8f, 0xfe,
+	0x55, 0x4f, 0x5e, 0xac, 0x94, 0xdd, 0x93, 0xa2, 0xe4, 0x1b, 0x26, 0x7b, 0xa6, 0x60, 0xea, 0xa1,
+	0xc0, 0xc7, 0x30, 0xfb, 0x91, 0xcf, 0x9f, 0xa9, 0x64, 0x1f, 0xb7, 0x97, 0xbe, 0xd5, 0x5d, 0x87,
+	0xb9, 0x1c, 0xae, 0x62, 0x28, 0x2e, 0x0e, 0xfc, 0x41, 0x44, 0x71, 0xd9, 0x7d, 0x0a, 0x7a, 0xe1,
+	0xf4, 0xf3, 0xbf, 0xf5, 0x65, 0xe5, 0xfd, 0x59, 0x05, 0xd6, 0x0e, 0x49, 0x94, 0xe1, 0xba, 0xdd,
+	0x8e, 0x82, 0xfb, 0x81, 0x17, 0xf2, 0xae, 0xeb, 0x0b, 0xb9, 0x77, 0x0e, 0x78, 0x7e, 0x5e, 0x81,
+	0x9b, 0xfd, 0x45, 0x53, 0xb6, 0xfb, 0xa3, 0x2b, 0x30, 0xdf, 0x79, 0xa7, 0x32, 0x98, 0xe1, 0xea,
+	0x30, 0xc1, 0xa8, 0x65, 0xe6, 0x44, 0x07, 0x46, 0xad, 0x23, 0x25, 0xfd, 0x2a, 0x8c, 0x73, 0x8a,
+	0xac, 0x06, 0x9c, 0x20, 0x76, 0xc6, 0x3a, 0x4c, 0xd8, 0x2c, 0x32, 0x73, 0x4d, 0x32, 0xb0, 0x59,
+	0x94, 0x82, 0xe0, 0x14, 0x31, 0x84, 0xec, 0x96, 0x71, 0x02, 0xa3, 0xeb, 0xfe, 0x3d, 0xf2, 0x3c,
+	0x03, 0xe7, 0x02, 0x5c, 0xef, 0x32, 0x87, 0x32, 0xd5, 0x7b, 0x30, 0x73, 0xdf, 0xc5, 0x8e, 0x27,
+	0x7d, 0xed, 0xb2, 0xe6, 0x73, 0x16, 0xf6, 0x73, 0x98, 0xcd, 0xae, 0x55, 0xd5, 0x57, 0xa2, 0x45,
+	0xa5, 0x9c, 0x16, 0x77, 0xfe, 0x7a, 0x05, 0x60, 0x9f, 0x35, 0x0f, 0x09, 0x3d, 0x75, 0x2c, 0x82,
+	0x3e, 0x82, 0x89, 0xf4, 0x2b, 0x0c, 0x74, 0x23, 0xdb, 0x7c, 0x2e, 0x78, 0x13, 0xa2, 0x37, 0xfa,
+	0x91, 0x28, 0x79, 0x3f, 0x83, 0xc9, 0xec, 0x57, 0x4e, 0xb4, 0x96, 0x5d, 0x55, 0xf8, 0xc2, 0x43,
+	0xbf, 0xd9, 0x9f, 0x48, 0x81, 0x1b, 0x30, 0x9e, 0xfa, 0xf6, 0x88, 0xea, 0x45, 0xf2, 0x64, 0x60,
+	0x6f, 0xf4, 0xa1, 0x48, 0x30, 0x53, 0x1f, 0x0b, 0xf3, 0x98, 0xdd, 0x5f, 0x27, 0xf5, 0x1b, 0x7d,
+	0x28, 0x14, 0xe6, 0x2e, 0x8c, 0xc6, 0x9d, 0x55, 0xb4, 0x9c, 0x27, 0xcf, 0x74, 0x88, 0xf5, 0x95,
+	0x5e, 0xd3, 0x0a, 0xaa, 0x19, 0xf7, 0xf6, 0xd2, 0x0d, 0x4e, 0x74, 0x2b, 0xbf, 0xaa, 0x47, 0xfb,
+	0x55, 0x5f, 0xbf, 0x9c, 0x30, 0x91, 0x39, 0xee, 0x2c, 0xe6, 0x65, 0xce, 0xb5, 0x43, 0xf5, 0x95,
+	0x5e, 0xd3, 0x69, 0x93, 0x76, 0xda, 0x6e, 0xdd, 0x26, 0xcd, 0x77, 0xfb, 0xf4, 0x1b, 0x7d, 0x28,
+	0x14, 0xa6, 0x0d, 0xd3, 0x5d, 0x0d, 0x3d, 0xf4, 0x4a, 0xce, 0x6b, 0x7a, 0x74, 0x13, 0xf5, 0x5b,
+	0x97, 0xd2, 0x29, 0x2e, 0xdf, 0x83, 0x6b, 0x99, 0x88, 0x88, 0x72, 0x2e, 0x5f, 0x94, 0x3f, 0xf4,
+	0xb5, 0xbe, 0x34, 0x0a, 0xf9, 0x47, 0x30, 0x23, 0x8f, 0x76, 0x16, 0xbf, 0x6b, 0x7f, 0x7a, 0x65,
+	0x29, 0x7d, 0x63, 0x00, 0x4a, 0xc5, 0xeb, 0x27, 0x15, 0x58, 0xea, 0x17, 0xdb, 0xd1, 0x9b, 0x59,
+	0xac, 0x01, 0x52, 0x94, 0x7e, 0xe7, 0xeb, 0x2c, 0x51, 0x72, 0xfc, 0x10, 0xa6, 0x72, 0xa1, 0x12,
+	0xdd, 0xcc, 0x6b, 0x51, 0x94, 0x58, 0xf4, 0x97, 0x2f, 0xa1, 0x52, 0xf8, 0x1f, 0xc1, 0x44, 0x3a,
+	0x66, 0xe6, 0x43, 0x58, 0x41, 0x2c, 0xd6, 0x1b, 0xfd, 0x48, 0xd2, 0x5b, 0xd5, 0xd5, 0xc3, 0x43,
+	0x85, 0x47, 0x69, 0xb0, 0xad, 0xea, 0xd9, 0x10, 0x44, 0xbf, 0x9b, 0xed, 0x53, 0x67, 0x39, 0x6e,
+	0xf6, 0x76, 0xdb, 0x42, 0xbe, 0x5b, 0x03, 0xd3, 0x2b, 0xee, 0x8f, 0x61, 0xac, 0x73, 0xa5, 0x41,
+	0xb9, 0x53, 0x9d, 0x6f, 0x53, 0xea, 0xab, 0x3d, 0xe7, 0x13, 0xbb, 0x15, 0xf4, 0xf2, 0xf2, 0x76,
+	0xeb, 0xdd, 0x4b, 0xd4, 0x37, 0x06, 0xa0, 0x54, 0xbc, 0x2e, 0x40, 0xeb, 0xd5, 0xb6, 0x43, 0xaf,
+	0x17, 0x99, 0xbf, 0x67, 0x03, 0x51, 0xdf, 0x1c, 0x94, 0x3c, 0xa3, 0x66, 0xbe, 0x99, 0x55, 0xa0,
+	0x66, 0x8f, 0xfe, 0x9b, 0xbe, 0x31, 0x00, 0xa5, 0xe2, 0xc5, 0x60, 0x5e, 0xca, 0xd3, 0xc5, 0xee,
+	0xd5, 0x22, 0xa9, 0x7b, 0x71, 0x7c, 0x6d, 0x30, 0x62, 0xc5, 0x34, 0x8c, 0x9f, 0x61, 0xe6, 0x7a,
+	0x10, 0xe8, 0x76, 0x51, 0x92, 0x2e, 0x6e, 0x8b, 0xe8, 0xaf, 0x0e, 0x44, 0x9b, 0x98, 0xb4, 0xa0,
+	0x05, 0x93, 0x37, 0x69, 0xef, 0xde, 0x92, 0xbe, 0x31, 0x00, 0x65, 0x4a, 0xbb, 0xa2, 0x0e, 0x4b,
+	0x97, 0x76, 0x7d, 0x9a, 0x3e, 0xfa, 0xab, 0x03, 0xd1, 0x2a, 0x8e, 0x1e, 0xcc, 0x16, 0x35, 0x0e,
+	0xd0, 0x46, 0x8f, 0x03, 0xd5, 0xdd, 0x37, 0xd0, 0x6f, 0x0f, 0x42, 0x9a, 0x1c, 0x8d, 0x5e, 0x37,
+	0xfb, 0xfc, 0xd1, 0xb8, 0xa4, 0x5d, 0xa1, 0x6f, 0x0e, 0x4a, 0x9e, 0xb0, 0xee, 0x75, 0x27, 0xcf,
+	0xb3, 0xbe, 0xa4, 0xaf, 0xa0, 0x6f, 0x0e, 0x4a, 0x9e, 0x0b, 0x3e, 0x59, 0xaa, 0xc2, 0xe0, 0x53,
+	0x78, 0xa1, 0xd6, 0x37, 0x06, 0xa0, 0x2c, 0x0a, 0x3e, 0x39, 0x86, 0x3d, 0x83, 0x4f, 0x31, 0xd7,
+	0xcd, 0x41, 0xc9, 0x93, 0x02, 0x25, 0x73, 0x03, 0xce, 0x17, 0x28, 0x45, 0xd7, 0x6e, 0x7d, 0xad,
+	0x2f, 0x4d, 0x62, 0xc0, 0x82, 0xd7, 0x2a, 0x79, 0x03, 0xf6, 0x7e, 0x15, 0xa4, 0x6f, 0x0c, 0x40,
+	0x99, 0xf0, 0x2a, 0x78, 0x0e, 0x92, 0xe7, 0xd5, 0xfb, 0x75, 0x8b, 0xbe, 0x31, 0x00, 0x65, 0x3a,
+	0x9b, 0x77, 0xbd, 0xda, 0xe8, 0xce, 0xe6, 0xbd, 0x5e, 0x9f, 0xe8, 0x1b, 0x03, 0x50, 0x26, 0x27,
+	0xbd, 0xe8, 0x31, 0x1c, 0x2a, 0x34, 0x4d, 0xe1, 0x23, 0x44, 0xfd, 0xf6, 0x20, 0xa4, 0x79, 0x76,
+	0x19, 0x69, 0x18, 0xba, 0x7c, 0x27, 0x58, 0x5f, 0x76, 0x3d, 0xde, 0x2b, 0x85, 0x30, 0x57, 0x24,
+	0x0e, 0x43, 0x03, 0xc8, 0xcc, 0x7a, 0x44, 0xce, 0xfe, 0x6f, 0x07, 0x6d, 0x98, 0xee, 0x7a, 0xa4,
+	0x96, 0x2f, 0xfa, 0x7b, 0x3d, 0xdd, 0xd3, 0x6f, 0x5d, 0x4a, 0x27, 0xb9, 0xe8, 0xc3, 0xbf, 0xcf,
+	0xef, 0xc9, 0xf7, 0x8c, 0x5f, 0x7e, 0xb9, 0x52, 0xf9, 0xd5, 0x97, 0x2b, 0x95, 0xff, 0xfc, 0x72,
+	0xa5, 0xf2, 0x27, 0x5f, 0xad, 0xbc, 0xf4, 0xab, 0xaf, 0x56, 0x5e, 0xfa, 0xb7, 0xaf, 0x56, 0x5e,
+	0xfa, 0xf4, 0x9d, 0x01, 0x9f, 0xdd, 0x9d, 0x6f, 0x75, 0x38, 0x6e, 0x45, 0x17, 0x21, 0x61, 0xc7,
+	0x23, 0xe2, 0xdf, 0x62, 0xb9, 0xfb, 0xbf, 0x03, 0x00, 0xad, 0x5a, 0x42, 0xd3, 0x60, 0x46, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgServiceClient is the client API for MsgService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgServiceClient interface {
+	UpdateParams(ctx context.Context, in *UpdateParamsRequest, opts ...grpc.CallOption) (*UpdateParamsResponse, error)
+	CreateNewTopic(ctx context.Context, in *CreateNewTopicRequest, opts ...grpc.CallOption) (*CreateNewTopicResponse, error)
+	UpdateTopic(ctx context.Context, in *UpdateTopicRequest, opts ...grpc.CallOption) (*UpdateTopicResponse, error)
+	RetireTopic(ctx context.Context, in *RetireTopicRequest, opts ...grpc.CallOption) (*RetireTopicResponse, error)
+	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
+	RemoveRegistration(ctx context.Context, in *RemoveRegistrationRequest, opts ...grpc.CallOption) (*RemoveRegistrationResponse, error)
+	AddStake(ctx context.Context, in *AddStakeRequest, opts ...grpc.CallOption) (*AddStakeResponse, error)
+	RemoveStake(ctx context.Context, in *RemoveStakeRequest, opts ...grpc.CallOption) (*RemoveStakeResponse, error)
+	CancelRemoveStake(ctx context.Context, in *CancelRemoveStakeRequest, opts ...grpc.CallOption) (*CancelRemoveStakeResponse, error)
+	DelegateStake(ctx context.Context, in *DelegateStakeRequest, opts ...grpc.CallOption) (*DelegateStakeResponse, error)
+	RewardDelegateStake(ctx context.Context, in *RewardDelegateStakeRequest, opts ...grpc.CallOption) (*RewardDelegateStakeResponse, error)
+	SetDelegateStakeAutoCompound(ctx context.Context, in *SetDelegateStakeAutoCompoundRequest, opts ...grpc.CallOption) (*SetDelegateStakeAutoCompoundResponse, error)
+	RedelegateStake(ctx context.Context, in *RedelegateStakeRequest, opts ...grpc.CallOption) (*RedelegateStakeResponse, error)
+	ClaimRewards(ctx context.Context, in *ClaimRewardsRequest, opts ...grpc.CallOption) (*ClaimRewardsResponse, error)
+	RemoveDelegateStake(ctx context.Context, in *RemoveDelegateStakeRequest, opts ...grpc.CallOption) (*RemoveDelegateStakeResponse, error)
+	CancelRemoveDelegateStake(ctx context.Context, in *CancelRemoveDelegateStakeRequest, opts ...grpc.CallOption) (*CancelRemoveDelegateStakeResponse, error)
+	FundTopic(ctx context.Context, in *FundTopicRequest, opts ...grpc.CallOption) (*FundTopicResponse, error)
+	AddToWhitelistAdmin(ctx context.Context, in *AddToWhitelistAdminRequest, opts ...grpc.CallOption) (*AddToWhitelistAdminResponse, error)
+	RemoveFromWhitelistAdmin(ctx context.Context, in *RemoveFromWhitelistAdminRequest, opts ...grpc.CallOption) (*RemoveFromWhitelistAdminResponse, error)
+	AddGmpAllowedSource(ctx context.Context, in *AddGmpAllowedSourceRequest, opts ...grpc.CallOption) (*AddGmpAllowedSourceResponse, error)
+	RemoveGmpAllowedSource(ctx context.Context, in *RemoveGmpAllowedSourceRequest, opts ...grpc.CallOption) (*RemoveGmpAllowedSourceResponse, error)
+	CreateGmpSubscription(ctx context.Context, in *CreateGmpSubscriptionRequest, opts ...grpc.CallOption) (*CreateGmpSubscriptionResponse, error)
+	FundGmpSubscription(ctx context.Context, in *FundGmpSubscriptionRequest, opts ...grpc.CallOption) (*FundGmpSubscriptionResponse, error)
+	CancelGmpSubscription(ctx context.Context, in *CancelGmpSubscriptionRequest, opts ...grpc.CallOption) (*CancelGmpSubscriptionResponse, error)
+	FundTopicSponsorship(ctx context.Context, in *FundTopicSponsorshipRequest, opts ...grpc.CallOption) (*FundTopicSponsorshipResponse, error)
+	WithdrawTopicSponsorship(ctx context.Context, in *WithdrawTopicSponsorshipRequest, opts ...grpc.CallOption) (*WithdrawTopicSponsorshipResponse, error)
+	SetTopicAllowlistEnabled(ctx context.Context, in *SetTopicAllowlistEnabledRequest, opts ...grpc.CallOption) (*SetTopicAllowlistEnabledResponse, error)
+	AddToTopicAllowlist(ctx context.Context, in *AddToTopicAllowlistRequest, opts ...grpc.CallOption) (*AddToTopicAllowlistResponse, error)
+	RemoveFromTopicAllowlist(ctx context.Context, in *RemoveFromTopicAllowlistRequest, opts ...grpc.CallOption) (*RemoveFromTopicAllowlistResponse, error)
+	UnjailReputer(ctx context.Context, in *UnjailReputerRequest, opts ...grpc.CallOption) (*UnjailReputerResponse, error)
+	InsertWorkerPayload(ctx context.Context, in *InsertWorkerPayloadRequest, opts ...grpc.CallOption) (*InsertWorkerPayloadResponse, error)
+	CommitWorkerPayload(ctx context.Context, in *CommitWorkerPayloadRequest, opts ...grpc.CallOption) (*CommitWorkerPayloadResponse, error)
+	RevealWorkerPayload(ctx context.Context, in *RevealWorkerPayloadRequest, opts ...grpc.CallOption) (*RevealWorkerPayloadResponse, error)
+	InsertReputerPayload(ctx context.Context, in *InsertReputerPayloadRequest, opts ...grpc.CallOption) (*InsertReputerPayloadResponse, error)
+	InsertWorkerPayloads(ctx context.Context, in *InsertWorkerPayloadsRequest, opts ...grpc.CallOption) (*InsertWorkerPayloadsResponse, error)
+	InsertReputerPayloads(ctx context.Context, in *InsertReputerPayloadsRequest, opts ...grpc.CallOption) (*InsertReputerPayloadsResponse, error)
+	SubmitGroundTruth(ctx context.Context, in *SubmitGroundTruthRequest, opts ...grpc.CallOption) (*SubmitGroundTruthResponse, error)
+}
+
+type msgServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgServiceClient(cc grpc1.ClientConn) MsgServiceClient {
+	return &msgServiceClient{cc}
+}
+
+func (c *msgServiceClient) UpdateParams(ctx context.Context, in *UpdateParamsRequest, opts ...grpc.CallOption) (*UpdateParamsResponse, error) {
+	out := new(UpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *RemoveRegistrationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgServiceClient) CreateNewTopic(ctx context.Context, in *CreateNewTopicRequest, opts ...grpc.CallOption) (*CreateNewTopicResponse, error) {
+	out := new(CreateNewTopicResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/CreateNewTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *RemoveRegistrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (c *msgServiceClient) UpdateTopic(ctx context.Context, in *UpdateTopicRequest, opts ...grpc.CallOption) (*UpdateTopicResponse, error) {
+	out := new(UpdateTopicResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/UpdateTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *AddStakeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgServiceClient) RetireTopic(ctx context.Context, in *RetireTopicRequest, opts ...grpc.CallOption) (*RetireTopicResponse, error) {
+	out := new(RetireTopicResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RetireTopic", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *AddStakeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgServiceClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error) {
+	out := new(RegisterResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/Register", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *AddStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
+func (c *msgServiceClient) RemoveRegistration(ctx context.Context, in *RemoveRegistrationRequest, opts ...grpc.CallOption) (*RemoveRegistrationResponse, error) {
+	out := new(RemoveRegistrationResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RemoveRegistration", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgServiceClient) AddStake(ctx context.Context, in *AddStakeRequest, opts ...grpc.CallOption) (*AddStakeResponse, error) {
+	out := new(AddStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/AddStake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *AddStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgServiceClient) RemoveStake(ctx context.Context, in *RemoveStakeRequest, opts ...grpc.CallOption) (*RemoveStakeResponse, error) {
+	out := new(RemoveStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RemoveStake", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *AddStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgServiceClient) CancelRemoveStake(ctx context.Context, in *CancelRemoveStakeRequest, opts ...grpc.CallOption) (*CancelRemoveStakeResponse, error) {
+	out := new(CancelRemoveStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/CancelRemoveStake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *AddStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgServiceClient) DelegateStake(ctx context.Context, in *DelegateStakeRequest, opts ...grpc.CallOption) (*DelegateStakeResponse, error) {
+	out := new(DelegateStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/DelegateStake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *RemoveStakeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgServiceClient) RewardDelegateStake(ctx context.Context, in *RewardDelegateStakeRequest, opts ...grpc.CallOption) (*RewardDelegateStakeResponse, error) {
+	out := new(RewardDelegateStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RewardDelegateStake", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *RemoveStakeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgServiceClient) SetDelegateStakeAutoCompound(ctx context.Context, in *SetDelegateStakeAutoCompoundRequest, opts ...grpc.CallOption) (*SetDelegateStakeAutoCompoundResponse, error) {
+	out := new(SetDelegateStakeAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/SetDelegateStakeAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *RemoveStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgServiceClient) RedelegateStake(ctx context.Context, in *RedelegateStakeRequest, opts ...grpc.CallOption) (*RedelegateStakeResponse, error) {
+	out := new(RedelegateStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RedelegateStake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *RemoveStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgServiceClient) ClaimRewards(ctx context.Context, in *ClaimRewardsRequest, opts ...grpc.CallOption) (*ClaimRewardsResponse, error) {
+	out := new(ClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/ClaimRewards", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *RemoveStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgServiceClient) RemoveDelegateStake(ctx context.Context, in *RemoveDelegateStakeRequest, opts ...grpc.CallOption) (*RemoveDelegateStakeResponse, error) {
+	out := new(RemoveDelegateStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RemoveDelegateStake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *RemoveStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgServiceClient) CancelRemoveDelegateStake(ctx context.Context, in *CancelRemoveDelegateStakeRequest, opts ...grpc.CallOption) (*CancelRemoveDelegateStakeResponse, error) {
+	out := new(CancelRemoveDelegateStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/CancelRemoveDelegateStake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *CancelRemoveStakeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgServiceClient) FundTopic(ctx context.Context, in *FundTopicRequest, opts ...grpc.CallOption) (*FundTopicResponse, error) {
+	out := new(FundTopicResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/FundTopic", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *CancelRemoveStakeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgServiceClient) AddToWhitelistAdmin(ctx context.Context, in *AddToWhitelistAdminRequest, opts ...grpc.CallOption) (*AddToWhitelistAdminResponse, error) {
+	out := new(AddToWhitelistAdminResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/AddToWhitelistAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *CancelRemoveStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgServiceClient) RemoveFromWhitelistAdmin(ctx context.Context, in *RemoveFromWhitelistAdminRequest, opts ...grpc.CallOption) (*RemoveFromWhitelistAdminResponse, error) {
+	out := new(RemoveFromWhitelistAdminResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RemoveFromWhitelistAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *CancelRemoveStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgServiceClient) AddGmpAllowedSource(ctx context.Context, in *AddGmpAllowedSourceRequest, opts ...grpc.CallOption) (*AddGmpAllowedSourceResponse, error) {
+	out := new(AddGmpAllowedSourceResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/AddGmpAllowedSource", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *CancelRemoveStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgServiceClient) RemoveGmpAllowedSource(ctx context.Context, in *RemoveGmpAllowedSourceRequest, opts ...grpc.CallOption) (*RemoveGmpAllowedSourceResponse, error) {
+	out := new(RemoveGmpAllowedSourceResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RemoveGmpAllowedSource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *CancelRemoveStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgServiceClient) CreateGmpSubscription(ctx context.Context, in *CreateGmpSubscriptionRequest, opts ...grpc.CallOption) (*CreateGmpSubscriptionResponse, error) {
+	out := new(CreateGmpSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/CreateGmpSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *DelegateStakeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgServiceClient) FundGmpSubscription(ctx context.Context, in *FundGmpSubscriptionRequest, opts ...grpc.CallOption) (*FundGmpSubscriptionResponse, error) {
+	out := new(FundGmpSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/FundGmpSubscription", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DelegateStakeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgServiceClient) CancelGmpSubscription(ctx context.Context, in *CancelGmpSubscriptionRequest, opts ...grpc.CallOption) (*CancelGmpSubscriptionResponse, error) {
+	out := new(CancelGmpSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/CancelGmpSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *DelegateStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgServiceClient) FundTopicSponsorship(ctx context.Context, in *FundTopicSponsorshipRequest, opts ...grpc.CallOption) (*FundTopicSponsorshipResponse, error) {
+	out := new(FundTopicSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/FundTopicSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *DelegateStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgServiceClient) WithdrawTopicSponsorship(ctx context.Context, in *WithdrawTopicSponsorshipRequest, opts ...grpc.CallOption) (*WithdrawTopicSponsorshipResponse, error) {
+	out := new(WithdrawTopicSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/WithdrawTopicSponsorship", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgServiceClient) SetTopicAllowlistEnabled(ctx context.Context, in *SetTopicAllowlistEnabledRequest, opts ...grpc.CallOption) (*SetTopicAllowlistEnabledResponse, error) {
+	out := new(SetTopicAllowlistEnabledResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/SetTopicAllowlistEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *DelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgServiceClient) AddToTopicAllowlist(ctx context.Context, in *AddToTopicAllowlistRequest, opts ...grpc.CallOption) (*AddToTopicAllowlistResponse, error) {
+	out := new(AddToTopicAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/AddToTopicAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *RemoveDelegateStakeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgServiceClient) RemoveFromTopicAllowlist(ctx context.Context, in *RemoveFromTopicAllowlistRequest, opts ...grpc.CallOption) (*RemoveFromTopicAllowlistResponse, error) {
+	out := new(RemoveFromTopicAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RemoveFromTopicAllowlist", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *RemoveDelegateStakeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgServiceClient) UnjailReputer(ctx context.Context, in *UnjailReputerRequest, opts ...grpc.CallOption) (*UnjailReputerResponse, error) {
+	out := new(UnjailReputerResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/UnjailReputer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *RemoveDelegateStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgServiceClient) InsertWorkerPayload(ctx context.Context, in *InsertWorkerPayloadRequest, opts ...grpc.CallOption) (*InsertWorkerPayloadResponse, error) {
+	out := new(InsertWorkerPayloadResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/InsertWorkerPayload", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *RemoveDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgServiceClient) CommitWorkerPayload(ctx context.Context, in *CommitWorkerPayloadRequest, opts ...grpc.CallOption) (*CommitWorkerPayloadResponse, error) {
+	out := new(CommitWorkerPayloadResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/CommitWorkerPayload", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *RemoveDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RemoveDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *CancelRemoveDelegateStakeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgServiceClient) RevealWorkerPayload(ctx context.Context, in *RevealWorkerPayloadRequest, opts ...grpc.CallOption) (*RevealWorkerPayloadResponse, error) {
+	out := new(RevealWorkerPayloadResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RevealWorkerPayload", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CancelRemoveDelegateStakeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CancelRemoveDelegateStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *CancelRemoveDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgServiceClient) InsertReputerPayload(ctx context.Context, in *InsertReputerPayloadRequest, opts ...grpc.CallOption) (*InsertReputerPayloadResponse, error) {
+	out := new(InsertReputerPayloadResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/InsertReputerPayload", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CancelRemoveDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CancelRemoveDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *FundTopicRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgServiceClient) InsertWorkerPayloads(ctx context.Context, in *InsertWorkerPayloadsRequest, opts ...grpc.CallOption) (*InsertWorkerPayloadsResponse, error) {
+	out := new(InsertWorkerPayloadsResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/InsertWorkerPayloads", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FundTopicRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *FundTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgServiceClient) InsertReputerPayloads(ctx context.Context, in *InsertReputerPayloadsRequest, opts ...grpc.CallOption) (*InsertReputerPayloadsResponse, error) {
+	out := new(InsertReputerPayloadsResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/InsertReputerPayloads", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *FundTopicResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgServiceClient) SubmitGroundTruth(ctx context.Context, in *SubmitGroundTruthRequest, opts ...grpc.CallOption) (*SubmitGroundTruthResponse, error) {
+	out := new(SubmitGroundTruthResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/SubmitGroundTruth", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *FundTopicResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServiceServer is the server API for MsgService service.
+type MsgServiceServer interface {
+	UpdateParams(context.Context, *UpdateParamsRequest) (*UpdateParamsResponse, error)
+	CreateNewTopic(context.Context, *CreateNewTopicRequest) (*CreateNewTopicResponse, error)
+	UpdateTopic(context.Context, *UpdateTopicRequest) (*UpdateTopicResponse, error)
+	RetireTopic(context.Context, *RetireTopicRequest) (*RetireTopicResponse, error)
+	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
+	RemoveRegistration(context.Context, *RemoveRegistrationRequest) (*RemoveRegistrationResponse, error)
+	AddStake(context.Context, *AddStakeRequest) (*AddStakeResponse, error)
+	RemoveStake(context.Context, *RemoveStakeRequest) (*RemoveStakeResponse, error)
+	CancelRemoveStake(context.Context, *CancelRemoveStakeRequest) (*CancelRemoveStakeResponse, error)
+	DelegateStake(context.Context, *DelegateStakeRequest) (*DelegateStakeResponse, error)
+	RewardDelegateStake(context.Context, *RewardDelegateStakeRequest) (*RewardDelegateStakeResponse, error)
+	SetDelegateStakeAutoCompound(context.Context, *SetDelegateStakeAutoCompoundRequest) (*SetDelegateStakeAutoCompoundResponse, error)
+	RedelegateStake(context.Context, *RedelegateStakeRequest) (*RedelegateStakeResponse, error)
+	ClaimRewards(context.Context, *ClaimRewardsRequest) (*ClaimRewardsResponse, error)
+	RemoveDelegateStake(context.Context, *RemoveDelegateStakeRequest) (*RemoveDelegateStakeResponse, error)
+	CancelRemoveDelegateStake(context.Context, *CancelRemoveDelegateStakeRequest) (*CancelRemoveDelegateStakeResponse, error)
+	FundTopic(context.Context, *FundTopicRequest) (*FundTopicResponse, error)
+	AddToWhitelistAdmin(context.Context, *AddToWhitelistAdminRequest) (*AddToWhitelistAdminResponse, error)
+	RemoveFromWhitelistAdmin(context.Context, *RemoveFromWhitelistAdminRequest) (*RemoveFromWhitelistAdminResponse, error)
+	AddGmpAllowedSource(context.Context, *AddGmpAllowedSourceRequest) (*AddGmpAllowedSourceResponse, error)
+	RemoveGmpAllowedSource(context.Context, *RemoveGmpAllowedSourceRequest) (*RemoveGmpAllowedSourceResponse, error)
+	CreateGmpSubscription(context.Context, *CreateGmpSubscriptionRequest) (*CreateGmpSubscriptionResponse, error)
+	FundGmpSubscription(context.Context, *FundGmpSubscriptionRequest) (*FundGmpSubscriptionResponse, error)
+	CancelGmpSubscription(context.Context, *CancelGmpSubscriptionRequest) (*CancelGmpSubscriptionResponse, error)
+	FundTopicSponsorship(context.Context, *FundTopicSponsorshipRequest) (*FundTopicSponsorshipResponse, error)
+	WithdrawTopicSponsorship(context.Context, *WithdrawTopicSponsorshipRequest) (*WithdrawTopicSponsorshipResponse, error)
+	SetTopicAllowlistEnabled(context.Context, *SetTopicAllowlistEnabledRequest) (*SetTopicAllowlistEnabledResponse, error)
+	AddToTopicAllowlist(context.Context, *AddToTopicAllowlistRequest) (*AddToTopicAllowlistResponse, error)
+	RemoveFromTopicAllowlist(context.Context, *RemoveFromTopicAllowlistRequest) (*RemoveFromTopicAllowlistResponse, error)
+	UnjailReputer(context.Context, *UnjailReputerRequest) (*UnjailReputerResponse, error)
+	InsertWorkerPayload(context.Context, *InsertWorkerPayloadRequest) (*InsertWorkerPayloadResponse, error)
+	CommitWorkerPayload(context.Context, *CommitWorkerPayloadRequest) (*CommitWorkerPayloadResponse, error)
+	RevealWorkerPayload(context.Context, *RevealWorkerPayloadRequest) (*RevealWorkerPayloadResponse, error)
+	InsertReputerPayload(context.Context, *InsertReputerPayloadRequest) (*InsertReputerPayloadResponse, error)
+	InsertWorkerPayloads(context.Context, *InsertWorkerPayloadsRequest) (*InsertWorkerPayloadsResponse, error)
+	InsertReputerPayloads(context.Context, *InsertReputerPayloadsRequest) (*InsertReputerPayloadsResponse, error)
+	SubmitGroundTruth(context.Context, *SubmitGroundTruthRequest) (*SubmitGroundTruthResponse, error)
 }
 
-func (m *FundTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServiceServer struct {
 }
 
-func (m *AddToWhitelistAdminRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServiceServer) UpdateParams(ctx context.Context, req *UpdateParamsRequest) (*UpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
-
-func (m *AddToWhitelistAdminRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServiceServer) CreateNewTopic(ctx context.Context, req *CreateNewTopicRequest) (*CreateNewTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNewTopic not implemented")
 }
-
-func (m *AddToWhitelistAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServiceServer) UpdateTopic(ctx context.Context, req *UpdateTopicRequest) (*UpdateTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTopic not implemented")
 }
-
-func (m *AddToWhitelistAdminResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServiceServer) RetireTopic(ctx context.Context, req *RetireTopicRequest) (*RetireTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetireTopic not implemented")
 }
-
-func (m *AddToWhitelistAdminResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServiceServer) Register(ctx context.Context, req *RegisterRequest) (*RegisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
 }
-
-func (m *AddToWhitelistAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServiceServer) RemoveRegistration(ctx context.Context, req *RemoveRegistrationRequest) (*RemoveRegistrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRegistration not implemented")
 }
-
-func (m *RemoveFromWhitelistAdminRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServiceServer) AddStake(ctx context.Context, req *AddStakeRequest) (*AddStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStake not implemented")
 }
-
-func (m *RemoveFromWhitelistAdminRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServiceServer) RemoveStake(ctx context.Context, req *RemoveStakeRequest) (*RemoveStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveStake not implemented")
 }
-
-func (m *RemoveFromWhitelistAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServiceServer) CancelRemoveStake(ctx context.Context, req *CancelRemoveStakeRequest) (*CancelRemoveStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRemoveStake not implemented")
 }
-
-func (m *RemoveFromWhitelistAdminResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServiceServer) DelegateStake(ctx context.Context, req *DelegateStakeRequest) (*DelegateStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateStake not implemented")
 }
-
-func (m *RemoveFromWhitelistAdminResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServiceServer) RewardDelegateStake(ctx context.Context, req *RewardDelegateStakeRequest) (*RewardDelegateStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardDelegateStake not implemented")
 }
-
-func (m *RemoveFromWhitelistAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServiceServer) SetDelegateStakeAutoCompound(ctx context.Context, req *SetDelegateStakeAutoCompoundRequest) (*SetDelegateStakeAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDelegateStakeAutoCompound not implemented")
 }
-
-func (m *RewardDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServiceServer) RedelegateStake(ctx context.Context, req *RedelegateStakeRequest) (*RedelegateStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegateStake not implemented")
 }
-
-func (m *RewardDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServiceServer) ClaimRewards(ctx context.Context, req *ClaimRewardsRequest) (*ClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+}
+func (*UnimplementedMsgServiceServer) RemoveDelegateStake(ctx context.Context, req *RemoveDelegateStakeRequest) (*RemoveDelegateStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDelegateStake not implemented")
+}
+func (*UnimplementedMsgServiceServer) CancelRemoveDelegateStake(ctx context.Context, req *CancelRemoveDelegateStakeRequest) (*CancelRemoveDelegateStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRemoveDelegateStake not implemented")
+}
+func (*UnimplementedMsgServiceServer) FundTopic(ctx context.Context, req *FundTopicRequest) (*FundTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundTopic not implemented")
+}
+func (*UnimplementedMsgServiceServer) AddToWhitelistAdmin(ctx context.Context, req *AddToWhitelistAdminRequest) (*AddToWhitelistAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToWhitelistAdmin not implemented")
+}
+func (*UnimplementedMsgServiceServer) RemoveFromWhitelistAdmin(ctx context.Context, req *RemoveFromWhitelistAdminRequest) (*RemoveFromWhitelistAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromWhitelistAdmin not implemented")
+}
+func (*UnimplementedMsgServiceServer) AddGmpAllowedSource(ctx context.Context, req *AddGmpAllowedSourceRequest) (*AddGmpAllowedSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGmpAllowedSource not implemented")
+}
+func (*UnimplementedMsgServiceServer) RemoveGmpAllowedSource(ctx context.Context, req *RemoveGmpAllowedSourceRequest) (*RemoveGmpAllowedSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGmpAllowedSource not implemented")
+}
+func (*UnimplementedMsgServiceServer) CreateGmpSubscription(ctx context.Context, req *CreateGmpSubscriptionRequest) (*CreateGmpSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGmpSubscription not implemented")
+}
+func (*UnimplementedMsgServiceServer) FundGmpSubscription(ctx context.Context, req *FundGmpSubscriptionRequest) (*FundGmpSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundGmpSubscription not implemented")
+}
+func (*UnimplementedMsgServiceServer) CancelGmpSubscription(ctx context.Context, req *CancelGmpSubscriptionRequest) (*CancelGmpSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelGmpSubscription not implemented")
+}
+func (*UnimplementedMsgServiceServer) FundTopicSponsorship(ctx context.Context, req *FundTopicSponsorshipRequest) (*FundTopicSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundTopicSponsorship not implemented")
+}
+func (*UnimplementedMsgServiceServer) WithdrawTopicSponsorship(ctx context.Context, req *WithdrawTopicSponsorshipRequest) (*WithdrawTopicSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawTopicSponsorship not implemented")
+}
+func (*UnimplementedMsgServiceServer) SetTopicAllowlistEnabled(ctx context.Context, req *SetTopicAllowlistEnabledRequest) (*SetTopicAllowlistEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTopicAllowlistEnabled not implemented")
+}
+func (*UnimplementedMsgServiceServer) AddToTopicAllowlist(ctx context.Context, req *AddToTopicAllowlistRequest) (*AddToTopicAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToTopicAllowlist not implemented")
+}
+func (*UnimplementedMsgServiceServer) RemoveFromTopicAllowlist(ctx context.Context, req *RemoveFromTopicAllowlistRequest) (*RemoveFromTopicAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromTopicAllowlist not implemented")
+}
+func (*UnimplementedMsgServiceServer) UnjailReputer(ctx context.Context, req *UnjailReputerRequest) (*UnjailReputerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailReputer not implemented")
+}
+func (*UnimplementedMsgServiceServer) InsertWorkerPayload(ctx context.Context, req *InsertWorkerPayloadRequest) (*InsertWorkerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertWorkerPayload not implemented")
+}
+func (*UnimplementedMsgServiceServer) CommitWorkerPayload(ctx context.Context, req *CommitWorkerPayloadRequest) (*CommitWorkerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitWorkerPayload not implemented")
+}
+func (*UnimplementedMsgServiceServer) RevealWorkerPayload(ctx context.Context, req *RevealWorkerPayloadRequest) (*RevealWorkerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealWorkerPayload not implemented")
+}
+func (*UnimplementedMsgServiceServer) InsertReputerPayload(ctx context.Context, req *InsertReputerPayloadRequest) (*InsertReputerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertReputerPayload not implemented")
+}
+func (*UnimplementedMsgServiceServer) InsertWorkerPayloads(ctx context.Context, req *InsertWorkerPayloadsRequest) (*InsertWorkerPayloadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertWorkerPayloads not implemented")
+}
+func (*UnimplementedMsgServiceServer) InsertReputerPayloads(ctx context.Context, req *InsertReputerPayloadsRequest) (*InsertReputerPayloadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertReputerPayloads not implemented")
+}
+func (*UnimplementedMsgServiceServer) SubmitGroundTruth(ctx context.Context, req *SubmitGroundTruthRequest) (*SubmitGroundTruthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitGroundTruth not implemented")
 }
 
-func (m *RewardDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func RegisterMsgServiceServer(s grpc1.Server, srv MsgServiceServer) {
+	s.RegisterService(&_MsgService_serviceDesc, srv)
 }
 
-func (m *RewardDelegateStakeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _MsgService_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServiceServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).UpdateParams(ctx, req.(*UpdateParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RewardDelegateStakeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _MsgService_CreateNewTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNewTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CreateNewTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/CreateNewTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CreateNewTopic(ctx, req.(*CreateNewTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RewardDelegateStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
-		i--
-		dAtA[i] = 0x1a
+func _MsgService_UpdateTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(MsgServiceServer).UpdateTopic(ctx, in)
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/UpdateTopic",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).UpdateTopic(ctx, req.(*UpdateTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _MsgService_RetireTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetireTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *OptionalParams) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RetireTopic(ctx, in)
 	}
-	var l int
-	_ = l
-	if len(m.Version) > 0 {
-		for _, s := range m.Version {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/RetireTopic",
 	}
-	if len(m.MaxSerializedMsgLength) > 0 {
-		l = 0
-		for _, e := range m.MaxSerializedMsgLength {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RetireTopic(ctx, req.(*RetireTopicRequest))
 	}
-	if len(m.MinTopicWeight) > 0 {
-		for _, e := range m.MinTopicWeight {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.RequiredMinimumStake) > 0 {
-		for _, e := range m.RequiredMinimumStake {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).Register(ctx, in)
 	}
-	if len(m.RemoveStakeDelayWindow) > 0 {
-		l = 0
-		for _, e := range m.RemoveStakeDelayWindow {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/Register",
 	}
-	if len(m.MinEpochLength) > 0 {
-		l = 0
-		for _, e := range m.MinEpochLength {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).Register(ctx, req.(*RegisterRequest))
 	}
-	if len(m.BetaEntropy) > 0 {
-		for _, e := range m.BetaEntropy {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RemoveRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.LearningRate) > 0 {
-		for _, e := range m.LearningRate {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RemoveRegistration(ctx, in)
 	}
-	if len(m.MaxGradientThreshold) > 0 {
-		for _, e := range m.MaxGradientThreshold {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/RemoveRegistration",
 	}
-	if len(m.MinStakeFraction) > 0 {
-		for _, e := range m.MinStakeFraction {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RemoveRegistration(ctx, req.(*RemoveRegistrationRequest))
 	}
-	if len(m.MaxUnfulfilledWorkerRequests) > 0 {
-		l = 0
-		for _, e := range m.MaxUnfulfilledWorkerRequests {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_AddStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.MaxUnfulfilledReputerRequests) > 0 {
-		l = 0
-		for _, e := range m.MaxUnfulfilledReputerRequests {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	if interceptor == nil {
+		return srv.(MsgServiceServer).AddStake(ctx, in)
 	}
-	if len(m.TopicRewardStakeImportance) > 0 {
-		for _, e := range m.TopicRewardStakeImportance {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/AddStake",
 	}
-	if len(m.TopicRewardFeeRevenueImportance) > 0 {
-		for _, e := range m.TopicRewardFeeRevenueImportance {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).AddStake(ctx, req.(*AddStakeRequest))
 	}
-	if len(m.TopicRewardAlpha) > 0 {
-		for _, e := range m.TopicRewardAlpha {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RemoveStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.TaskRewardAlpha) > 0 {
-		for _, e := range m.TaskRewardAlpha {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RemoveStake(ctx, in)
 	}
-	if len(m.ValidatorsVsAlloraPercentReward) > 0 {
-		for _, e := range m.ValidatorsVsAlloraPercentReward {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/RemoveStake",
 	}
-	if len(m.MaxSamplesToScaleScores) > 0 {
-		l = 0
-		for _, e := range m.MaxSamplesToScaleScores {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RemoveStake(ctx, req.(*RemoveStakeRequest))
 	}
-	if len(m.MaxTopInferersToReward) > 0 {
-		l = 0
-		for _, e := range m.MaxTopInferersToReward {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_CancelRemoveStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRemoveStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.MaxTopForecastersToReward) > 0 {
-		l = 0
-		for _, e := range m.MaxTopForecastersToReward {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CancelRemoveStake(ctx, in)
 	}
-	if len(m.MaxTopReputersToReward) > 0 {
-		l = 0
-		for _, e := range m.MaxTopReputersToReward {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/CancelRemoveStake",
 	}
-	if len(m.CreateTopicFee) > 0 {
-		for _, e := range m.CreateTopicFee {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CancelRemoveStake(ctx, req.(*CancelRemoveStakeRequest))
 	}
-	if len(m.GradientDescentMaxIters) > 0 {
-		l = 0
-		for _, e := range m.GradientDescentMaxIters {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_DelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelegateStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.RegistrationFee) > 0 {
-		for _, e := range m.RegistrationFee {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).DelegateStake(ctx, in)
 	}
-	if len(m.DefaultPageLimit) > 0 {
-		l = 0
-		for _, e := range m.DefaultPageLimit {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/DelegateStake",
 	}
-	if len(m.MaxPageLimit) > 0 {
-		l = 0
-		for _, e := range m.MaxPageLimit {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).DelegateStake(ctx, req.(*DelegateStakeRequest))
 	}
-	if len(m.MinEpochLengthRecordLimit) > 0 {
-		l = 0
-		for _, e := range m.MinEpochLengthRecordLimit {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RewardDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewardDelegateStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.BlocksPerMonth) > 0 {
-		l = 0
-		for _, e := range m.BlocksPerMonth {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RewardDelegateStake(ctx, in)
 	}
-	if len(m.PRewardInference) > 0 {
-		for _, e := range m.PRewardInference {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/RewardDelegateStake",
 	}
-	if len(m.PRewardForecast) > 0 {
-		for _, e := range m.PRewardForecast {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RewardDelegateStake(ctx, req.(*RewardDelegateStakeRequest))
 	}
-	if len(m.PRewardReputer) > 0 {
-		for _, e := range m.PRewardReputer {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_SetDelegateStakeAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDelegateStakeAutoCompoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.CRewardInference) > 0 {
-		for _, e := range m.CRewardInference {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).SetDelegateStakeAutoCompound(ctx, in)
 	}
-	if len(m.CRewardForecast) > 0 {
-		for _, e := range m.CRewardForecast {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/SetDelegateStakeAutoCompound",
 	}
-	if len(m.CNorm) > 0 {
-		for _, e := range m.CNorm {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).SetDelegateStakeAutoCompound(ctx, req.(*SetDelegateStakeAutoCompoundRequest))
 	}
-	if len(m.EpsilonReputer) > 0 {
-		for _, e := range m.EpsilonReputer {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RedelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedelegateStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.HalfMaxProcessStakeRemovalsEndBlock) > 0 {
-		l = 0
-		for _, e := range m.HalfMaxProcessStakeRemovalsEndBlock {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RedelegateStake(ctx, in)
 	}
-	if len(m.DataSendingFee) > 0 {
-		for _, e := range m.DataSendingFee {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/RedelegateStake",
 	}
-	if len(m.EpsilonSafeDiv) > 0 {
-		for _, e := range m.EpsilonSafeDiv {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RedelegateStake(ctx, req.(*RedelegateStakeRequest))
 	}
-	if len(m.MaxElementsPerForecast) > 0 {
-		l = 0
-		for _, e := range m.MaxElementsPerForecast {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.MaxActiveTopicsPerBlock) > 0 {
-		l = 0
-		for _, e := range m.MaxActiveTopicsPerBlock {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	if interceptor == nil {
+		return srv.(MsgServiceServer).ClaimRewards(ctx, in)
 	}
-	if len(m.MaxStringLength) > 0 {
-		l = 0
-		for _, e := range m.MaxStringLength {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
-	}
-	if len(m.InitialRegretQuantile) > 0 {
-		for _, e := range m.InitialRegretQuantile {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/ClaimRewards",
 	}
-	if len(m.PNormSafeDiv) > 0 {
-		for _, e := range m.PNormSafeDiv {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).ClaimRewards(ctx, req.(*ClaimRewardsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpdateParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_RemoveDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDelegateStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RemoveDelegateStake(ctx, in)
 	}
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/RemoveDelegateStake",
 	}
-	return n
-}
-
-func (m *UpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RemoveDelegateStake(ctx, req.(*RemoveDelegateStakeRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CreateNewTopicRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_CancelRemoveDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRemoveDelegateStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CancelRemoveDelegateStake(ctx, in)
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/CancelRemoveDelegateStake",
 	}
-	l = len(m.LossMethod)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CancelRemoveDelegateStake(ctx, req.(*CancelRemoveDelegateStakeRequest))
 	}
-	if m.EpochLength != 0 {
-		n += 1 + sovTx(uint64(m.EpochLength))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_FundTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.GroundTruthLag != 0 {
-		n += 1 + sovTx(uint64(m.GroundTruthLag))
+	if interceptor == nil {
+		return srv.(MsgServiceServer).FundTopic(ctx, in)
 	}
-	l = m.PNorm.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.AlphaRegret.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.AllowNegative {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/FundTopic",
 	}
-	l = m.Epsilon.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.WorkerSubmissionWindow != 0 {
-		n += 1 + sovTx(uint64(m.WorkerSubmissionWindow))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).FundTopic(ctx, req.(*FundTopicRequest))
 	}
-	l = m.MeritSortitionAlpha.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ActiveInfererQuantile.Size()
-	n += 2 + l + sovTx(uint64(l))
-	l = m.ActiveForecasterQuantile.Size()
-	n += 2 + l + sovTx(uint64(l))
-	l = m.ActiveReputerQuantile.Size()
-	n += 2 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CreateNewTopicResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_AddToWhitelistAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToWhitelistAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+	if interceptor == nil {
+		return srv.(MsgServiceServer).AddToWhitelistAdmin(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/AddToWhitelistAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).AddToWhitelistAdmin(ctx, req.(*AddToWhitelistAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *InsertReputerPayloadRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_RemoveFromWhitelistAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromWhitelistAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RemoveFromWhitelistAdmin(ctx, in)
 	}
-	if m.ReputerValueBundle != nil {
-		l = m.ReputerValueBundle.Size()
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/RemoveFromWhitelistAdmin",
 	}
-	return n
-}
-
-func (m *InsertReputerPayloadResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RemoveFromWhitelistAdmin(ctx, req.(*RemoveFromWhitelistAdminRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *InsertWorkerPayloadRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_AddGmpAllowedSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGmpAllowedSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServiceServer).AddGmpAllowedSource(ctx, in)
 	}
-	if m.WorkerDataBundle != nil {
-		l = m.WorkerDataBundle.Size()
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/AddGmpAllowedSource",
 	}
-	return n
-}
-
-func (m *InsertWorkerPayloadResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).AddGmpAllowedSource(ctx, req.(*AddGmpAllowedSourceRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RegisterRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _MsgService_RemoveGmpAllowedSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGmpAllowedSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RemoveGmpAllowedSource(ctx, in)
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/RemoveGmpAllowedSource",
 	}
-	if m.IsReputer {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RemoveGmpAllowedSource(ctx, req.(*RemoveGmpAllowedSourceRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RegisterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_CreateGmpSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGmpSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CreateGmpSubscription(ctx, in)
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/CreateGmpSubscription",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CreateGmpSubscription(ctx, req.(*CreateGmpSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RemoveRegistrationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_FundGmpSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundGmpSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServiceServer).FundGmpSubscription(ctx, in)
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/FundGmpSubscription",
 	}
-	if m.IsReputer {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).FundGmpSubscription(ctx, req.(*FundGmpSubscriptionRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RemoveRegistrationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_CancelGmpSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelGmpSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CancelGmpSubscription(ctx, in)
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/CancelGmpSubscription",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CancelGmpSubscription(ctx, req.(*CancelGmpSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddStakeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_FundTopicSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundTopicSponsorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServiceServer).FundTopicSponsorship(ctx, in)
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/FundTopicSponsorship",
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *AddStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).FundTopicSponsorship(ctx, req.(*FundTopicSponsorshipRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RemoveStakeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_WithdrawTopicSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawTopicSponsorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServiceServer).WithdrawTopicSponsorship(ctx, in)
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/WithdrawTopicSponsorship",
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *RemoveStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).WithdrawTopicSponsorship(ctx, req.(*WithdrawTopicSponsorshipRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CancelRemoveStakeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_SetTopicAllowlistEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTopicAllowlistEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServiceServer).SetTopicAllowlistEnabled(ctx, in)
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/SetTopicAllowlistEnabled",
 	}
-	return n
-}
-
-func (m *CancelRemoveStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).SetTopicAllowlistEnabled(ctx, req.(*SetTopicAllowlistEnabledRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DelegateStakeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_AddToTopicAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToTopicAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServiceServer).AddToTopicAllowlist(ctx, in)
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/AddToTopicAllowlist",
 	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).AddToTopicAllowlist(ctx, req.(*AddToTopicAllowlistRequest))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DelegateStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_RemoveFromTopicAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromTopicAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RemoveFromTopicAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/RemoveFromTopicAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RemoveFromTopicAllowlist(ctx, req.(*RemoveFromTopicAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RemoveDelegateStakeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_UnjailReputer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnjailReputerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServiceServer).UnjailReputer(ctx, in)
 	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/UnjailReputer",
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).UnjailReputer(ctx, req.(*UnjailReputerRequest))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RemoveDelegateStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_InsertWorkerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertWorkerPayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServiceServer).InsertWorkerPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/InsertWorkerPayload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).InsertWorkerPayload(ctx, req.(*InsertWorkerPayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CancelRemoveDelegateStakeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _MsgService_CommitWorkerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitWorkerPayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CommitWorkerPayload(ctx, in)
 	}
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/CommitWorkerPayload",
 	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CommitWorkerPayload(ctx, req.(*CommitWorkerPayloadRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CancelRemoveDelegateStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_RevealWorkerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevealWorkerPayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RevealWorkerPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/RevealWorkerPayload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RevealWorkerPayload(ctx, req.(*RevealWorkerPayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FundTopicRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_InsertReputerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertReputerPayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServiceServer).InsertReputerPayload(ctx, in)
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/InsertReputerPayload",
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).InsertReputerPayload(ctx, req.(*InsertReputerPayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FundTopicResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_InsertWorkerPayloads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertWorkerPayloadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *AddToWhitelistAdminRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServiceServer).InsertWorkerPayloads(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/InsertWorkerPayloads",
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).InsertWorkerPayloads(ctx, req.(*InsertWorkerPayloadsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddToWhitelistAdminResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_InsertReputerPayloads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertReputerPayloadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServiceServer).InsertReputerPayloads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/InsertReputerPayloads",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).InsertReputerPayloads(ctx, req.(*InsertReputerPayloadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RemoveFromWhitelistAdminRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_SubmitGroundTruth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitGroundTruthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServiceServer).SubmitGroundTruth(ctx, in)
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/SubmitGroundTruth",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).SubmitGroundTruth(ctx, req.(*SubmitGroundTruthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RemoveFromWhitelistAdminResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var MsgService_serviceDesc = _MsgService_serviceDesc
+var _MsgService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "emissions.v5.MsgService",
+	HandlerType: (*MsgServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _MsgService_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreateNewTopic",
+			Handler:    _MsgService_CreateNewTopic_Handler,
+		},
+		{
+			MethodName: "UpdateTopic",
+			Handler:    _MsgService_UpdateTopic_Handler,
+		},
+		{
+			MethodName: "RetireTopic",
+			Handler:    _MsgService_RetireTopic_Handler,
+		},
+		{
+			MethodName: "Register",
+			Handler:    _MsgService_Register_Handler,
+		},
+		{
+			MethodName: "RemoveRegistration",
+			Handler:    _MsgService_RemoveRegistration_Handler,
+		},
+		{
+			MethodName: "AddStake",
+			Handler:    _MsgService_AddStake_Handler,
+		},
+		{
+			MethodName: "RemoveStake",
+			Handler:    _MsgService_RemoveStake_Handler,
+		},
+		{
+			MethodName: "CancelRemoveStake",
+			Handler:    _MsgService_CancelRemoveStake_Handler,
+		},
+		{
+			MethodName: "DelegateStake",
+			Handler:    _MsgService_DelegateStake_Handler,
+		},
+		{
+			MethodName: "RewardDelegateStake",
+			Handler:    _MsgService_RewardDelegateStake_Handler,
+		},
+		{
+			MethodName: "SetDelegateStakeAutoCompound",
+			Handler:    _MsgService_SetDelegateStakeAutoCompound_Handler,
+		},
+		{
+			MethodName: "RedelegateStake",
+			Handler:    _MsgService_RedelegateStake_Handler,
+		},
+		{
+			MethodName: "ClaimRewards",
+			Handler:    _MsgService_ClaimRewards_Handler,
+		},
+		{
+			MethodName: "RemoveDelegateStake",
+			Handler:    _MsgService_RemoveDelegateStake_Handler,
+		},
+		{
+			MethodName: "CancelRemoveDelegateStake",
+			Handler:    _MsgService_CancelRemoveDelegateStake_Handler,
+		},
+		{
+			MethodName: "FundTopic",
+			Handler:    _MsgService_FundTopic_Handler,
+		},
+		{
+			MethodName: "AddToWhitelistAdmin",
+			Handler:    _MsgService_AddToWhitelistAdmin_Handler,
+		},
+		{
+			MethodName: "RemoveFromWhitelistAdmin",
+			Handler:    _MsgService_RemoveFromWhitelistAdmin_Handler,
+		},
+		{
+			MethodName: "AddGmpAllowedSource",
+			Handler:    _MsgService_AddGmpAllowedSource_Handler,
+		},
+		{
+			MethodName: "RemoveGmpAllowedSource",
+			Handler:    _MsgService_RemoveGmpAllowedSource_Handler,
+		},
+		{
+			MethodName: "CreateGmpSubscription",
+			Handler:    _MsgService_CreateGmpSubscription_Handler,
+		},
+		{
+			MethodName: "FundGmpSubscription",
+			Handler:    _MsgService_FundGmpSubscription_Handler,
+		},
+		{
+			MethodName: "CancelGmpSubscription",
+			Handler:    _MsgService_CancelGmpSubscription_Handler,
+		},
+		{
+			MethodName: "FundTopicSponsorship",
+			Handler:    _MsgService_FundTopicSponsorship_Handler,
+		},
+		{
+			MethodName: "WithdrawTopicSponsorship",
+			Handler:    _MsgService_WithdrawTopicSponsorship_Handler,
+		},
+		{
+			MethodName: "SetTopicAllowlistEnabled",
+			Handler:    _MsgService_SetTopicAllowlistEnabled_Handler,
+		},
+		{
+			MethodName: "AddToTopicAllowlist",
+			Handler:    _MsgService_AddToTopicAllowlist_Handler,
+		},
+		{
+			MethodName: "RemoveFromTopicAllowlist",
+			Handler:    _MsgService_RemoveFromTopicAllowlist_Handler,
+		},
+		{
+			MethodName: "UnjailReputer",
+			Handler:    _MsgService_UnjailReputer_Handler,
+		},
+		{
+			MethodName: "InsertWorkerPayload",
+			Handler:    _MsgService_InsertWorkerPayload_Handler,
+		},
+		{
+			MethodName: "CommitWorkerPayload",
+			Handler:    _MsgService_CommitWorkerPayload_Handler,
+		},
+		{
+			MethodName: "RevealWorkerPayload",
+			Handler:    _MsgService_RevealWorkerPayload_Handler,
+		},
+		{
+			MethodName: "InsertReputerPayload",
+			Handler:    _MsgService_InsertReputerPayload_Handler,
+		},
+		{
+			MethodName: "InsertWorkerPayloads",
+			Handler:    _MsgService_InsertWorkerPayloads_Handler,
+		},
+		{
+			MethodName: "InsertReputerPayloads",
+			Handler:    _MsgService_InsertReputerPayloads_Handler,
+		},
+		{
+			MethodName: "SubmitGroundTruth",
+			Handler:    _MsgService_SubmitGroundTruth_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "emissions/v5/tx.proto",
 }
 
-func (m *RewardDelegateStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OptionalParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RewardDelegateStakeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *OptionalParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptionalParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+	if len(m.GmpAxelarCounterpartyChannelId) > 0 {
+		for iNdEx := len(m.GmpAxelarCounterpartyChannelId) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GmpAxelarCounterpartyChannelId[iNdEx])
+			copy(dAtA[i:], m.GmpAxelarCounterpartyChannelId[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.GmpAxelarCounterpartyChannelId[iNdEx])))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0x8a
+		}
 	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.GmpAxelarChannelId) > 0 {
+		for iNdEx := len(m.GmpAxelarChannelId) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GmpAxelarChannelId[iNdEx])
+			copy(dAtA[i:], m.GmpAxelarChannelId[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.GmpAxelarChannelId[iNdEx])))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0x82
+		}
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *OptionalParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+	if len(m.DataSendingFeeTargetRatio) > 0 {
+		for iNdEx := len(m.DataSendingFeeTargetRatio) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.DataSendingFeeTargetRatio[iNdEx].Size()
+				i -= size
+				if _, err := m.DataSendingFeeTargetRatio[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OptionalParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OptionalParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xfa
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+	}
+	if len(m.DataSendingFeeMaxChangeRate) > 0 {
+		for iNdEx := len(m.DataSendingFeeMaxChangeRate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.DataSendingFeeMaxChangeRate[iNdEx].Size()
+				i -= size
+				if _, err := m.DataSendingFeeMaxChangeRate[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
 				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = append(m.Version, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxSerializedMsgLength = append(m.MaxSerializedMsgLength, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxSerializedMsgLength) == 0 {
-					m.MaxSerializedMsgLength = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxSerializedMsgLength = append(m.MaxSerializedMsgLength, v)
-				}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.RewardAccrualEnabled) > 0 {
+		for iNdEx := len(m.RewardAccrualEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.RewardAccrualEnabled[iNdEx] {
+				dAtA[i] = 1
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSerializedMsgLength", wireType)
+				dAtA[i] = 0
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinTopicWeight", wireType)
+		}
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardAccrualEnabled)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.MaxRedelegationsPerDelegator) > 0 {
+		dAtA2 := make([]byte, len(m.MaxRedelegationsPerDelegator)*10)
+		var j1 int
+		for _, num := range m.MaxRedelegationsPerDelegator {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xe2
+	}
+	if len(m.BatchedPayloadGasDiscount) > 0 {
+		for iNdEx := len(m.BatchedPayloadGasDiscount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.BatchedPayloadGasDiscount[iNdEx].Size()
+				i -= size
+				if _, err := m.BatchedPayloadGasDiscount[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
 				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.MinTopicWeight = append(m.MinTopicWeight, v)
-			if err := m.MinTopicWeight[len(m.MinTopicWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.MaxPayloadsPerBatch) > 0 {
+		dAtA4 := make([]byte, len(m.MaxPayloadsPerBatch)*10)
+		var j3 int
+		for _, num := range m.MaxPayloadsPerBatch {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredMinimumStake", wireType)
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.NetworkInferenceArchiveRetention) > 0 {
+		dAtA6 := make([]byte, len(m.NetworkInferenceArchiveRetention)*10)
+		var j5 int
+		for _, num1 := range m.NetworkInferenceArchiveRetention {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTx(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.MaxReputerLossDeviation) > 0 {
+		for iNdEx := len(m.MaxReputerLossDeviation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MaxReputerLossDeviation[iNdEx].Size()
+				i -= size
+				if _, err := m.MaxReputerLossDeviation[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
 				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.ReputerJailDuration) > 0 {
+		dAtA8 := make([]byte, len(m.ReputerJailDuration)*10)
+		var j7 int
+		for _, num1 := range m.ReputerJailDuration {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
 			}
-			postIndex := iNdEx + intStringLen
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintTx(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.MaxReputerOutlierEpochs) > 0 {
+		dAtA10 := make([]byte, len(m.MaxReputerOutlierEpochs)*10)
+		var j9 int
+		for _, num := range m.MaxReputerOutlierEpochs {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintTx(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.ReputerOutlierScoreRatio) > 0 {
+		for iNdEx := len(m.ReputerOutlierScoreRatio) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ReputerOutlierScoreRatio[iNdEx].Size()
+				i -= size
+				if _, err := m.ReputerOutlierScoreRatio[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.MaxMissedReputerNonces) > 0 {
+		dAtA12 := make([]byte, len(m.MaxMissedReputerNonces)*10)
+		var j11 int
+		for _, num := range m.MaxMissedReputerNonces {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintTx(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.SlashedStakeBurnFraction) > 0 {
+		for iNdEx := len(m.SlashedStakeBurnFraction) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.SlashedStakeBurnFraction[iNdEx].Size()
+				i -= size
+				if _, err := m.SlashedStakeBurnFraction[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.ReputerSlashFraction) > 0 {
+		for iNdEx := len(m.ReputerSlashFraction) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ReputerSlashFraction[iNdEx].Size()
+				i -= size
+				if _, err := m.ReputerSlashFraction[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.PNormSafeDiv) > 0 {
+		for iNdEx := len(m.PNormSafeDiv) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PNormSafeDiv[iNdEx].Size()
+				i -= size
+				if _, err := m.PNormSafeDiv[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.InitialRegretQuantile) > 0 {
+		for iNdEx := len(m.InitialRegretQuantile) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.InitialRegretQuantile[iNdEx].Size()
+				i -= size
+				if _, err := m.InitialRegretQuantile[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.MaxStringLength) > 0 {
+		dAtA14 := make([]byte, len(m.MaxStringLength)*10)
+		var j13 int
+		for _, num := range m.MaxStringLength {
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintTx(dAtA, i, uint64(j13))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xfa
+	}
+	if len(m.MaxActiveTopicsPerBlock) > 0 {
+		dAtA16 := make([]byte, len(m.MaxActiveTopicsPerBlock)*10)
+		var j15 int
+		for _, num := range m.MaxActiveTopicsPerBlock {
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
+		}
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintTx(dAtA, i, uint64(j15))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf2
+	}
+	if len(m.MaxElementsPerForecast) > 0 {
+		dAtA18 := make([]byte, len(m.MaxElementsPerForecast)*10)
+		var j17 int
+		for _, num := range m.MaxElementsPerForecast {
+			for num >= 1<<7 {
+				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j17++
+			}
+			dAtA18[j17] = uint8(num)
+			j17++
+		}
+		i -= j17
+		copy(dAtA[i:], dAtA18[:j17])
+		i = encodeVarintTx(dAtA, i, uint64(j17))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.EpsilonSafeDiv) > 0 {
+		for iNdEx := len(m.EpsilonSafeDiv) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.EpsilonSafeDiv[iNdEx].Size()
+				i -= size
+				if _, err := m.EpsilonSafeDiv[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.DataSendingFee) > 0 {
+		for iNdEx := len(m.DataSendingFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.DataSendingFee[iNdEx].Size()
+				i -= size
+				if _, err := m.DataSendingFee[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.HalfMaxProcessStakeRemovalsEndBlock) > 0 {
+		dAtA20 := make([]byte, len(m.HalfMaxProcessStakeRemovalsEndBlock)*10)
+		var j19 int
+		for _, num := range m.HalfMaxProcessStakeRemovalsEndBlock {
+			for num >= 1<<7 {
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j19++
+			}
+			dAtA20[j19] = uint8(num)
+			j19++
+		}
+		i -= j19
+		copy(dAtA[i:], dAtA20[:j19])
+		i = encodeVarintTx(dAtA, i, uint64(j19))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.EpsilonReputer) > 0 {
+		for iNdEx := len(m.EpsilonReputer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.EpsilonReputer[iNdEx].Size()
+				i -= size
+				if _, err := m.EpsilonReputer[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.CNorm) > 0 {
+		for iNdEx := len(m.CNorm) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CNorm[iNdEx].Size()
+				i -= size
+				if _, err := m.CNorm[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.CRewardForecast) > 0 {
+		for iNdEx := len(m.CRewardForecast) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CRewardForecast[iNdEx].Size()
+				i -= size
+				if _, err := m.CRewardForecast[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.CRewardInference) > 0 {
+		for iNdEx := len(m.CRewardInference) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CRewardInference[iNdEx].Size()
+				i -= size
+				if _, err := m.CRewardInference[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.PRewardReputer) > 0 {
+		for iNdEx := len(m.PRewardReputer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PRewardReputer[iNdEx].Size()
+				i -= size
+				if _, err := m.PRewardReputer[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.PRewardForecast) > 0 {
+		for iNdEx := len(m.PRewardForecast) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PRewardForecast[iNdEx].Size()
+				i -= size
+				if _, err := m.PRewardForecast[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.PRewardInference) > 0 {
+		for iNdEx := len(m.PRewardInference) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PRewardInference[iNdEx].Size()
+				i -= size
+				if _, err := m.PRewardInference[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.BlocksPerMonth) > 0 {
+		dAtA22 := make([]byte, len(m.BlocksPerMonth)*10)
+		var j21 int
+		for _, num := range m.BlocksPerMonth {
+			for num >= 1<<7 {
+				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j21++
+			}
+			dAtA22[j21] = uint8(num)
+			j21++
+		}
+		i -= j21
+		copy(dAtA[i:], dAtA22[:j21])
+		i = encodeVarintTx(dAtA, i, uint64(j21))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.MinEpochLengthRecordLimit) > 0 {
+		dAtA24 := make([]byte, len(m.MinEpochLengthRecordLimit)*10)
+		var j23 int
+		for _, num1 := range m.MinEpochLengthRecordLimit {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA24[j23] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j23++
+			}
+			dAtA24[j23] = uint8(num)
+			j23++
+		}
+		i -= j23
+		copy(dAtA[i:], dAtA24[:j23])
+		i = encodeVarintTx(dAtA, i, uint64(j23))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if len(m.MaxPageLimit) > 0 {
+		dAtA26 := make([]byte, len(m.MaxPageLimit)*10)
+		var j25 int
+		for _, num := range m.MaxPageLimit {
+			for num >= 1<<7 {
+				dAtA26[j25] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j25++
+			}
+			dAtA26[j25] = uint8(num)
+			j25++
+		}
+		i -= j25
+		copy(dAtA[i:], dAtA26[:j25])
+		i = encodeVarintTx(dAtA, i, uint64(j25))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if len(m.DefaultPageLimit) > 0 {
+		dAtA28 := make([]byte, len(m.DefaultPageLimit)*10)
+		var j27 int
+		for _, num := range m.DefaultPageLimit {
+			for num >= 1<<7 {
+				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j27++
+			}
+			dAtA28[j27] = uint8(num)
+			j27++
+		}
+		i -= j27
+		copy(dAtA[i:], dAtA28[:j27])
+		i = encodeVarintTx(dAtA, i, uint64(j27))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.RegistrationFee) > 0 {
+		for iNdEx := len(m.RegistrationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.RegistrationFee[iNdEx].Size()
+				i -= size
+				if _, err := m.RegistrationFee[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.GradientDescentMaxIters) > 0 {
+		dAtA30 := make([]byte, len(m.GradientDescentMaxIters)*10)
+		var j29 int
+		for _, num := range m.GradientDescentMaxIters {
+			for num >= 1<<7 {
+				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j29++
+			}
+			dAtA30[j29] = uint8(num)
+			j29++
+		}
+		i -= j29
+		copy(dAtA[i:], dAtA30[:j29])
+		i = encodeVarintTx(dAtA, i, uint64(j29))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.CreateTopicFee) > 0 {
+		for iNdEx := len(m.CreateTopicFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CreateTopicFee[iNdEx].Size()
+				i -= size
+				if _, err := m.CreateTopicFee[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.MaxTopReputersToReward) > 0 {
+		dAtA32 := make([]byte, len(m.MaxTopReputersToReward)*10)
+		var j31 int
+		for _, num := range m.MaxTopReputersToReward {
+			for num >= 1<<7 {
+				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j31++
+			}
+			dAtA32[j31] = uint8(num)
+			j31++
+		}
+		i -= j31
+		copy(dAtA[i:], dAtA32[:j31])
+		i = encodeVarintTx(dAtA, i, uint64(j31))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.MaxTopForecastersToReward) > 0 {
+		dAtA34 := make([]byte, len(m.MaxTopForecastersToReward)*10)
+		var j33 int
+		for _, num := range m.MaxTopForecastersToReward {
+			for num >= 1<<7 {
+				dAtA34[j33] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j33++
+			}
+			dAtA34[j33] = uint8(num)
+			j33++
+		}
+		i -= j33
+		copy(dAtA[i:], dAtA34[:j33])
+		i = encodeVarintTx(dAtA, i, uint64(j33))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.MaxTopInferersToReward) > 0 {
+		dAtA36 := make([]byte, len(m.MaxTopInferersToReward)*10)
+		var j35 int
+		for _, num := range m.MaxTopInferersToReward {
+			for num >= 1<<7 {
+				dAtA36[j35] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j35++
+			}
+			dAtA36[j35] = uint8(num)
+			j35++
+		}
+		i -= j35
+		copy(dAtA[i:], dAtA36[:j35])
+		i = encodeVarintTx(dAtA, i, uint64(j35))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.MaxSamplesToScaleScores) > 0 {
+		dAtA38 := make([]byte, len(m.MaxSamplesToScaleScores)*10)
+		var j37 int
+		for _, num := range m.MaxSamplesToScaleScores {
+			for num >= 1<<7 {
+				dAtA38[j37] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j37++
+			}
+			dAtA38[j37] = uint8(num)
+			j37++
+		}
+		i -= j37
+		copy(dAtA[i:], dAtA38[:j37])
+		i = encodeVarintTx(dAtA, i, uint64(j37))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.ValidatorsVsAlloraPercentReward) > 0 {
+		for iNdEx := len(m.ValidatorsVsAlloraPercentReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ValidatorsVsAlloraPercentReward[iNdEx].Size()
+				i -= size
+				if _, err := m.ValidatorsVsAlloraPercentReward[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.TaskRewardAlpha) > 0 {
+		for iNdEx := len(m.TaskRewardAlpha) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.TaskRewardAlpha[iNdEx].Size()
+				i -= size
+				if _, err := m.TaskRewardAlpha[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.TopicRewardAlpha) > 0 {
+		for iNdEx := len(m.TopicRewardAlpha) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.TopicRewardAlpha[iNdEx].Size()
+				i -= size
+				if _, err := m.TopicRewardAlpha[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.TopicRewardFeeRevenueImportance) > 0 {
+		for iNdEx := len(m.TopicRewardFeeRevenueImportance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.TopicRewardFeeRevenueImportance[iNdEx].Size()
+				i -= size
+				if _, err := m.TopicRewardFeeRevenueImportance[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.TopicRewardStakeImportance) > 0 {
+		for iNdEx := len(m.TopicRewardStakeImportance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.TopicRewardStakeImportance[iNdEx].Size()
+				i -= size
+				if _, err := m.TopicRewardStakeImportance[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.MaxUnfulfilledReputerRequests) > 0 {
+		dAtA40 := make([]byte, len(m.MaxUnfulfilledReputerRequests)*10)
+		var j39 int
+		for _, num := range m.MaxUnfulfilledReputerRequests {
+			for num >= 1<<7 {
+				dAtA40[j39] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j39++
+			}
+			dAtA40[j39] = uint8(num)
+			j39++
+		}
+		i -= j39
+		copy(dAtA[i:], dAtA40[:j39])
+		i = encodeVarintTx(dAtA, i, uint64(j39))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.MaxUnfulfilledWorkerRequests) > 0 {
+		dAtA42 := make([]byte, len(m.MaxUnfulfilledWorkerRequests)*10)
+		var j41 int
+		for _, num := range m.MaxUnfulfilledWorkerRequests {
+			for num >= 1<<7 {
+				dAtA42[j41] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j41++
+			}
+			dAtA42[j41] = uint8(num)
+			j41++
+		}
+		i -= j41
+		copy(dAtA[i:], dAtA42[:j41])
+		i = encodeVarintTx(dAtA, i, uint64(j41))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.MinStakeFraction) > 0 {
+		for iNdEx := len(m.MinStakeFraction) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MinStakeFraction[iNdEx].Size()
+				i -= size
+				if _, err := m.MinStakeFraction[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.MaxGradientThreshold) > 0 {
+		for iNdEx := len(m.MaxGradientThreshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MaxGradientThreshold[iNdEx].Size()
+				i -= size
+				if _, err := m.MaxGradientThreshold[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.LearningRate) > 0 {
+		for iNdEx := len(m.LearningRate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.LearningRate[iNdEx].Size()
+				i -= size
+				if _, err := m.LearningRate[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.BetaEntropy) > 0 {
+		for iNdEx := len(m.BetaEntropy) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.BetaEntropy[iNdEx].Size()
+				i -= size
+				if _, err := m.BetaEntropy[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.MinEpochLength) > 0 {
+		dAtA44 := make([]byte, len(m.MinEpochLength)*10)
+		var j43 int
+		for _, num1 := range m.MinEpochLength {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA44[j43] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j43++
+			}
+			dAtA44[j43] = uint8(num)
+			j43++
+		}
+		i -= j43
+		copy(dAtA[i:], dAtA44[:j43])
+		i = encodeVarintTx(dAtA, i, uint64(j43))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.RemoveStakeDelayWindow) > 0 {
+		dAtA46 := make([]byte, len(m.RemoveStakeDelayWindow)*10)
+		var j45 int
+		for _, num1 := range m.RemoveStakeDelayWindow {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA46[j45] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j45++
+			}
+			dAtA46[j45] = uint8(num)
+			j45++
+		}
+		i -= j45
+		copy(dAtA[i:], dAtA46[:j45])
+		i = encodeVarintTx(dAtA, i, uint64(j45))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RequiredMinimumStake) > 0 {
+		for iNdEx := len(m.RequiredMinimumStake) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.RequiredMinimumStake[iNdEx].Size()
+				i -= size
+				if _, err := m.RequiredMinimumStake[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MinTopicWeight) > 0 {
+		for iNdEx := len(m.MinTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MinTopicWeight[iNdEx].Size()
+				i -= size
+				if _, err := m.MinTopicWeight[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MaxSerializedMsgLength) > 0 {
+		dAtA48 := make([]byte, len(m.MaxSerializedMsgLength)*10)
+		var j47 int
+		for _, num1 := range m.MaxSerializedMsgLength {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA48[j47] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j47++
+			}
+			dAtA48[j47] = uint8(num)
+			j47++
+		}
+		i -= j47
+		copy(dAtA[i:], dAtA48[:j47])
+		i = encodeVarintTx(dAtA, i, uint64(j47))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Version) > 0 {
+		for iNdEx := len(m.Version) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Version[iNdEx])
+			copy(dAtA[i:], m.Version[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Version[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateNewTopicRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateNewTopicRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateNewTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ArchiveNetworkInferences {
+		i--
+		if m.ArchiveNetworkInferences {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.ValueDimension != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ValueDimension))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.WorkerRevealWindow != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WorkerRevealWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	{
+		size := m.ActiveReputerQuantile.Size()
+		i -= size
+		if _, err := m.ActiveReputerQuantile.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.ActiveForecasterQuantile.Size()
+		i -= size
+		if _, err := m.ActiveForecasterQuantile.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.ActiveInfererQuantile.Size()
+		i -= size
+		if _, err := m.ActiveInfererQuantile.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.MeritSortitionAlpha.Size()
+		i -= size
+		if _, err := m.MeritSortitionAlpha.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if m.WorkerSubmissionWindow != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WorkerSubmissionWindow))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size := m.Epsilon.Size()
+		i -= size
+		if _, err := m.Epsilon.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.AllowNegative {
+		i--
+		if m.AllowNegative {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.AlphaRegret.Size()
+		i -= size
+		if _, err := m.AlphaRegret.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.PNorm.Size()
+		i -= size
+		if _, err := m.PNorm.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.GroundTruthLag != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GroundTruthLag))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.LossMethod) > 0 {
+		i -= len(m.LossMethod)
+		copy(dAtA[i:], m.LossMethod)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LossMethod)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateNewTopicResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateNewTopicResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateNewTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateTopicRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTopicRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateTopicResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTopicResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveBlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EffectiveBlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RetireTopicRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetireTopicRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetireTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RetireTopicResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetireTopicResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetireTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *InsertReputerPayloadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsertReputerPayloadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsertReputerPayloadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReputerValueBundle != nil {
+		{
+			size, err := m.ReputerValueBundle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsertReputerPayloadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsertReputerPayloadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsertReputerPayloadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *InsertReputerPayloadsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsertReputerPayloadsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsertReputerPayloadsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReputerValueBundles) > 0 {
+		for iNdEx := len(m.ReputerValueBundles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReputerValueBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsertReputerPayloadsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsertReputerPayloadsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsertReputerPayloadsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchedPayloadResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchedPayloadResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchedPayloadResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmitGroundTruthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmitGroundTruthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmitGroundTruthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.GroundTruth.Size()
+		i -= size
+		if _, err := m.GroundTruth.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ReputerNonceBlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReputerNonceBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmitGroundTruthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmitGroundTruthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmitGroundTruthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *InsertWorkerPayloadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsertWorkerPayloadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsertWorkerPayloadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WorkerDataBundle != nil {
+		{
+			size, err := m.WorkerDataBundle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsertWorkerPayloadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsertWorkerPayloadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsertWorkerPayloadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *InsertWorkerPayloadsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsertWorkerPayloadsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsertWorkerPayloadsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WorkerDataBundles) > 0 {
+		for iNdEx := len(m.WorkerDataBundles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WorkerDataBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsertWorkerPayloadsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsertWorkerPayloadsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsertWorkerPayloadsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitWorkerPayloadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitWorkerPayloadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitWorkerPayloadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NonceBlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NonceBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitWorkerPayloadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitWorkerPayloadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitWorkerPayloadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RevealWorkerPayloadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevealWorkerPayloadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevealWorkerPayloadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkerDataBundle != nil {
+		{
+			size, err := m.WorkerDataBundle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevealWorkerPayloadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevealWorkerPayloadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevealWorkerPayloadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsReputer {
+		i--
+		if m.IsReputer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveRegistrationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveRegistrationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveRegistrationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsReputer {
+		i--
+		if m.IsReputer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveRegistrationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveRegistrationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveRegistrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddStakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddStakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveStakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveStakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelRemoveStakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelRemoveStakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelRemoveStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelRemoveStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelRemoveStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelRemoveStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateStakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateStakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveDelegateStakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveDelegateStakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveDelegateStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelRemoveDelegateStakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelRemoveDelegateStakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelRemoveDelegateStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelRemoveDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelRemoveDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelRemoveDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *FundTopicRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundTopicRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundTopicResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundTopicResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AddToWhitelistAdminRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddToWhitelistAdminRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddToWhitelistAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddToWhitelistAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddToWhitelistAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddToWhitelistAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveFromWhitelistAdminRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveFromWhitelistAdminRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveFromWhitelistAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveFromWhitelistAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveFromWhitelistAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveFromWhitelistAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AddGmpAllowedSourceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddGmpAllowedSourceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddGmpAllowedSourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SourceAddress) > 0 {
+		i -= len(m.SourceAddress)
+		copy(dAtA[i:], m.SourceAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceChain) > 0 {
+		i -= len(m.SourceChain)
+		copy(dAtA[i:], m.SourceChain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceChain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddGmpAllowedSourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddGmpAllowedSourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddGmpAllowedSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveGmpAllowedSourceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveGmpAllowedSourceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveGmpAllowedSourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SourceAddress) > 0 {
+		i -= len(m.SourceAddress)
+		copy(dAtA[i:], m.SourceAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceChain) > 0 {
+		i -= len(m.SourceChain)
+		copy(dAtA[i:], m.SourceChain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceChain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveGmpAllowedSourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveGmpAllowedSourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveGmpAllowedSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateGmpSubscriptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateGmpSubscriptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateGmpSubscriptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Prepayment.Size()
+		i -= size
+		if _, err := m.Prepayment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.FeePerMessage.Size()
+		i -= size
+		if _, err := m.FeePerMessage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.DestinationAddress) > 0 {
+		i -= len(m.DestinationAddress)
+		copy(dAtA[i:], m.DestinationAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DestinationChain) > 0 {
+		i -= len(m.DestinationChain)
+		copy(dAtA[i:], m.DestinationChain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationChain)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateGmpSubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateGmpSubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateGmpSubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubscriptionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubscriptionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundGmpSubscriptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundGmpSubscriptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundGmpSubscriptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.SubscriptionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubscriptionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundGmpSubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundGmpSubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundGmpSubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelGmpSubscriptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelGmpSubscriptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelGmpSubscriptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubscriptionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubscriptionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelGmpSubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelGmpSubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelGmpSubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *FundTopicSponsorshipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundTopicSponsorshipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundTopicSponsorshipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxFeesPerEpoch.Size()
+		i -= size
+		if _, err := m.MaxFeesPerEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxFeesPerActorPerEpoch.Size()
+		i -= size
+		if _, err := m.MaxFeesPerActorPerEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundTopicSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundTopicSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundTopicSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawTopicSponsorshipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawTopicSponsorshipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawTopicSponsorshipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawTopicSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawTopicSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawTopicSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SetTopicAllowlistEnabledRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetTopicAllowlistEnabledRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetTopicAllowlistEnabledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IsReputer {
+		i--
+		if m.IsReputer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetTopicAllowlistEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetTopicAllowlistEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetTopicAllowlistEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AddToTopicAllowlistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddToTopicAllowlistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddToTopicAllowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.IsReputer {
+		i--
+		if m.IsReputer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddToTopicAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddToTopicAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddToTopicAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveFromTopicAllowlistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveFromTopicAllowlistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveFromTopicAllowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.IsReputer {
+		i--
+		if m.IsReputer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveFromTopicAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveFromTopicAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveFromTopicAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *UnjailReputerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnjailReputerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnjailReputerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnjailReputerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnjailReputerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnjailReputerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardDelegateStakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardDelegateStakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardDelegateStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetDelegateStakeAutoCompoundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetDelegateStakeAutoCompoundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetDelegateStakeAutoCompoundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetDelegateStakeAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetDelegateStakeAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetDelegateStakeAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RedelegateStakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegateStakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegateStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.DstReputer) > 0 {
+		i -= len(m.DstReputer)
+		copy(dAtA[i:], m.DstReputer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DstReputer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.DstTopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DstTopicId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SrcReputer) > 0 {
+		i -= len(m.SrcReputer)
+		copy(dAtA[i:], m.SrcReputer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SrcReputer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SrcTopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SrcTopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedelegateStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OptionalParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		for _, s := range m.Version {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxSerializedMsgLength) > 0 {
+		l = 0
+		for _, e := range m.MaxSerializedMsgLength {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.MinTopicWeight) > 0 {
+		for _, e := range m.MinTopicWeight {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RequiredMinimumStake) > 0 {
+		for _, e := range m.RequiredMinimumStake {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemoveStakeDelayWindow) > 0 {
+		l = 0
+		for _, e := range m.RemoveStakeDelayWindow {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.MinEpochLength) > 0 {
+		l = 0
+		for _, e := range m.MinEpochLength {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.BetaEntropy) > 0 {
+		for _, e := range m.BetaEntropy {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.LearningRate) > 0 {
+		for _, e := range m.LearningRate {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxGradientThreshold) > 0 {
+		for _, e := range m.MaxGradientThreshold {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MinStakeFraction) > 0 {
+		for _, e := range m.MinStakeFraction {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxUnfulfilledWorkerRequests) > 0 {
+		l = 0
+		for _, e := range m.MaxUnfulfilledWorkerRequests {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxUnfulfilledReputerRequests) > 0 {
+		l = 0
+		for _, e := range m.MaxUnfulfilledReputerRequests {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.TopicRewardStakeImportance) > 0 {
+		for _, e := range m.TopicRewardStakeImportance {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TopicRewardFeeRevenueImportance) > 0 {
+		for _, e := range m.TopicRewardFeeRevenueImportance {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TopicRewardAlpha) > 0 {
+		for _, e := range m.TopicRewardAlpha {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TaskRewardAlpha) > 0 {
+		for _, e := range m.TaskRewardAlpha {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ValidatorsVsAlloraPercentReward) > 0 {
+		for _, e := range m.ValidatorsVsAlloraPercentReward {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxSamplesToScaleScores) > 0 {
+		l = 0
+		for _, e := range m.MaxSamplesToScaleScores {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxTopInferersToReward) > 0 {
+		l = 0
+		for _, e := range m.MaxTopInferersToReward {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxTopForecastersToReward) > 0 {
+		l = 0
+		for _, e := range m.MaxTopForecastersToReward {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxTopReputersToReward) > 0 {
+		l = 0
+		for _, e := range m.MaxTopReputersToReward {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.CreateTopicFee) > 0 {
+		for _, e := range m.CreateTopicFee {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.GradientDescentMaxIters) > 0 {
+		l = 0
+		for _, e := range m.GradientDescentMaxIters {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.RegistrationFee) > 0 {
+		for _, e := range m.RegistrationFee {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.DefaultPageLimit) > 0 {
+		l = 0
+		for _, e := range m.DefaultPageLimit {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxPageLimit) > 0 {
+		l = 0
+		for _, e := range m.MaxPageLimit {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MinEpochLengthRecordLimit) > 0 {
+		l = 0
+		for _, e := range m.MinEpochLengthRecordLimit {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.BlocksPerMonth) > 0 {
+		l = 0
+		for _, e := range m.BlocksPerMonth {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.PRewardInference) > 0 {
+		for _, e := range m.PRewardInference {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.PRewardForecast) > 0 {
+		for _, e := range m.PRewardForecast {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.PRewardReputer) > 0 {
+		for _, e := range m.PRewardReputer {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CRewardInference) > 0 {
+		for _, e := range m.CRewardInference {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CRewardForecast) > 0 {
+		for _, e := range m.CRewardForecast {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CNorm) > 0 {
+		for _, e := range m.CNorm {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.EpsilonReputer) > 0 {
+		for _, e := range m.EpsilonReputer {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.HalfMaxProcessStakeRemovalsEndBlock) > 0 {
+		l = 0
+		for _, e := range m.HalfMaxProcessStakeRemovalsEndBlock {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.DataSendingFee) > 0 {
+		for _, e := range m.DataSendingFee {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.EpsilonSafeDiv) > 0 {
+		for _, e := range m.EpsilonSafeDiv {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxElementsPerForecast) > 0 {
+		l = 0
+		for _, e := range m.MaxElementsPerForecast {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxActiveTopicsPerBlock) > 0 {
+		l = 0
+		for _, e := range m.MaxActiveTopicsPerBlock {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxStringLength) > 0 {
+		l = 0
+		for _, e := range m.MaxStringLength {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.InitialRegretQuantile) > 0 {
+		for _, e := range m.InitialRegretQuantile {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.PNormSafeDiv) > 0 {
+		for _, e := range m.PNormSafeDiv {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ReputerSlashFraction) > 0 {
+		for _, e := range m.ReputerSlashFraction {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.SlashedStakeBurnFraction) > 0 {
+		for _, e := range m.SlashedStakeBurnFraction {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxMissedReputerNonces) > 0 {
+		l = 0
+		for _, e := range m.MaxMissedReputerNonces {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.ReputerOutlierScoreRatio) > 0 {
+		for _, e := range m.ReputerOutlierScoreRatio {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxReputerOutlierEpochs) > 0 {
+		l = 0
+		for _, e := range m.MaxReputerOutlierEpochs {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.ReputerJailDuration) > 0 {
+		l = 0
+		for _, e := range m.ReputerJailDuration {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxReputerLossDeviation) > 0 {
+		for _, e := range m.MaxReputerLossDeviation {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.NetworkInferenceArchiveRetention) > 0 {
+		l = 0
+		for _, e := range m.NetworkInferenceArchiveRetention {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxPayloadsPerBatch) > 0 {
+		l = 0
+		for _, e := range m.MaxPayloadsPerBatch {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.BatchedPayloadGasDiscount) > 0 {
+		for _, e := range m.BatchedPayloadGasDiscount {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxRedelegationsPerDelegator) > 0 {
+		l = 0
+		for _, e := range m.MaxRedelegationsPerDelegator {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.RewardAccrualEnabled) > 0 {
+		n += 2 + sovTx(uint64(len(m.RewardAccrualEnabled))) + len(m.RewardAccrualEnabled)*1
+	}
+	if len(m.DataSendingFeeMaxChangeRate) > 0 {
+		for _, e := range m.DataSendingFeeMaxChangeRate {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.DataSendingFeeTargetRatio) > 0 {
+		for _, e := range m.DataSendingFeeTargetRatio {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.GmpAxelarChannelId) > 0 {
+		for _, s := range m.GmpAxelarChannelId {
+			l = len(s)
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.GmpAxelarCounterpartyChannelId) > 0 {
+		for _, s := range m.GmpAxelarCounterpartyChannelId {
+			l = len(s)
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CreateNewTopicRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LossMethod)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovTx(uint64(m.EpochLength))
+	}
+	if m.GroundTruthLag != 0 {
+		n += 1 + sovTx(uint64(m.GroundTruthLag))
+	}
+	l = m.PNorm.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.AlphaRegret.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.AllowNegative {
+		n += 2
+	}
+	l = m.Epsilon.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.WorkerSubmissionWindow != 0 {
+		n += 1 + sovTx(uint64(m.WorkerSubmissionWindow))
+	}
+	l = m.MeritSortitionAlpha.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ActiveInfererQuantile.Size()
+	n += 2 + l + sovTx(uint64(l))
+	l = m.ActiveForecasterQuantile.Size()
+	n += 2 + l + sovTx(uint64(l))
+	l = m.ActiveReputerQuantile.Size()
+	n += 2 + l + sovTx(uint64(l))
+	if m.WorkerRevealWindow != 0 {
+		n += 2 + sovTx(uint64(m.WorkerRevealWindow))
+	}
+	if m.ValueDimension != 0 {
+		n += 2 + sovTx(uint64(m.ValueDimension))
+	}
+	if m.ArchiveNetworkInferences {
+		n += 3
+	}
+	return n
+}
+
+func (m *CreateNewTopicResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *UpdateTopicRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateTopicResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EffectiveBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.EffectiveBlockHeight))
+	}
+	return n
+}
+
+func (m *RetireTopicRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *RetireTopicResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *InsertReputerPayloadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReputerValueBundle != nil {
+		l = m.ReputerValueBundle.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *InsertReputerPayloadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *InsertReputerPayloadsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ReputerValueBundles) > 0 {
+		for _, e := range m.ReputerValueBundles {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InsertReputerPayloadsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchedPayloadResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *SubmitGroundTruthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	if m.ReputerNonceBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.ReputerNonceBlockHeight))
+	}
+	l = m.GroundTruth.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *SubmitGroundTruthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *InsertWorkerPayloadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.WorkerDataBundle != nil {
+		l = m.WorkerDataBundle.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *InsertWorkerPayloadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *InsertWorkerPayloadsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.WorkerDataBundles) > 0 {
+		for _, e := range m.WorkerDataBundles {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InsertWorkerPayloadsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommitWorkerPayloadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	if m.NonceBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.NonceBlockHeight))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *CommitWorkerPayloadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RevealWorkerPayloadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.WorkerDataBundle != nil {
+		l = m.WorkerDataBundle.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *RevealWorkerPayloadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RegisterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IsReputer {
+		n += 2
+	}
+	return n
+}
+
+func (m *RegisterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveRegistrationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	if m.IsReputer {
+		n += 2
+	}
+	return n
+}
+
+func (m *RemoveRegistrationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *AddStakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *AddStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoveStakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *RemoveStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CancelRemoveStakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *CancelRemoveStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DelegateStakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *DelegateStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoveDelegateStakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *RemoveDelegateStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CancelRemoveDelegateStakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *CancelRemoveDelegateStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *FundTopicRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *FundTopicResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AddToWhitelistAdminRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *AddToWhitelistAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoveFromWhitelistAdminRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveFromWhitelistAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AddGmpAllowedSourceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceChain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *AddGmpAllowedSourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoveGmpAllowedSourceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceChain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveGmpAllowedSourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CreateGmpSubscriptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.DestinationChain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestinationAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.FeePerMessage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Prepayment.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *CreateGmpSubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubscriptionId != 0 {
+		n += 1 + sovTx(uint64(m.SubscriptionId))
+	}
+	return n
+}
+
+func (m *FundGmpSubscriptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SubscriptionId != 0 {
+		n += 1 + sovTx(uint64(m.SubscriptionId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *FundGmpSubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CancelGmpSubscriptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SubscriptionId != 0 {
+		n += 1 + sovTx(uint64(m.SubscriptionId))
+	}
+	return n
+}
+
+func (m *CancelGmpSubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *FundTopicSponsorshipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxFeesPerActorPerEpoch.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxFeesPerEpoch.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *FundTopicSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *WithdrawTopicSponsorshipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *WithdrawTopicSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SetTopicAllowlistEnabledRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	if m.IsReputer {
+		n += 2
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *SetTopicAllowlistEnabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AddToTopicAllowlistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	if m.IsReputer {
+		n += 2
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *AddToTopicAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoveFromTopicAllowlistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	if m.IsReputer {
+		n += 2
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveFromTopicAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UnjailReputerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *UnjailReputerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RewardDelegateStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RewardDelegateStakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *SetDelegateStakeAutoCompoundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *SetDelegateStakeAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RedelegateStakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SrcTopicId != 0 {
+		n += 1 + sovTx(uint64(m.SrcTopicId))
+	}
+	l = len(m.SrcReputer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DstTopicId != 0 {
+		n += 1 + sovTx(uint64(m.DstTopicId))
+	}
+	l = len(m.DstReputer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *RedelegateStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ClaimRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *ClaimRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OptionalParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptionalParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptionalParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = append(m.Version, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxSerializedMsgLength = append(m.MaxSerializedMsgLength, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxSerializedMsgLength) == 0 {
+					m.MaxSerializedMsgLength = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxSerializedMsgLength = append(m.MaxSerializedMsgLength, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSerializedMsgLength", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTopicWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.MinTopicWeight = append(m.MinTopicWeight, v)
+			if err := m.MinTopicWeight[len(m.MinTopicWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredMinimumStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.RequiredMinimumStake = append(m.RequiredMinimumStake, v)
+			if err := m.RequiredMinimumStake[len(m.RequiredMinimumStake)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RemoveStakeDelayWindow = append(m.RemoveStakeDelayWindow, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RemoveStakeDelayWindow) == 0 {
+					m.RemoveStakeDelayWindow = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RemoveStakeDelayWindow = append(m.RemoveStakeDelayWindow, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveStakeDelayWindow", wireType)
+			}
+		case 7:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MinEpochLength = append(m.MinEpochLength, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MinEpochLength) == 0 {
+					m.MinEpochLength = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MinEpochLength = append(m.MinEpochLength, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinEpochLength", wireType)
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BetaEntropy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.BetaEntropy = append(m.BetaEntropy, v)
+			if err := m.BetaEntropy[len(m.BetaEntropy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.LearningRate = append(m.LearningRate, v)
+			if err := m.LearningRate[len(m.LearningRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGradientThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.MaxGradientThreshold = append(m.MaxGradientThreshold, v)
+			if err := m.MaxGradientThreshold[len(m.MaxGradientThreshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStakeFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.MinStakeFraction = append(m.MinStakeFraction, v)
+			if err := m.MinStakeFraction[len(m.MinStakeFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxUnfulfilledWorkerRequests = append(m.MaxUnfulfilledWorkerRequests, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxUnfulfilledWorkerRequests) == 0 {
+					m.MaxUnfulfilledWorkerRequests = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxUnfulfilledWorkerRequests = append(m.MaxUnfulfilledWorkerRequests, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnfulfilledWorkerRequests", wireType)
+			}
+		case 14:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxUnfulfilledReputerRequests = append(m.MaxUnfulfilledReputerRequests, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxUnfulfilledReputerRequests) == 0 {
+					m.MaxUnfulfilledReputerRequests = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxUnfulfilledReputerRequests = append(m.MaxUnfulfilledReputerRequests, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnfulfilledReputerRequests", wireType)
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardStakeImportance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.TopicRewardStakeImportance = append(m.TopicRewardStakeImportance, v)
+			if err := m.TopicRewardStakeImportance[len(m.TopicRewardStakeImportance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardFeeRevenueImportance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.TopicRewardFeeRevenueImportance = append(m.TopicRewardFeeRevenueImportance, v)
+			if err := m.TopicRewardFeeRevenueImportance[len(m.TopicRewardFeeRevenueImportance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardAlpha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.TopicRewardAlpha = append(m.TopicRewardAlpha, v)
+			if err := m.TopicRewardAlpha[len(m.TopicRewardAlpha)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskRewardAlpha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.TaskRewardAlpha = append(m.TaskRewardAlpha, v)
+			if err := m.TaskRewardAlpha[len(m.TaskRewardAlpha)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsVsAlloraPercentReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.ValidatorsVsAlloraPercentReward = append(m.ValidatorsVsAlloraPercentReward, v)
+			if err := m.ValidatorsVsAlloraPercentReward[len(m.ValidatorsVsAlloraPercentReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxSamplesToScaleScores = append(m.MaxSamplesToScaleScores, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxSamplesToScaleScores) == 0 {
+					m.MaxSamplesToScaleScores = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxSamplesToScaleScores = append(m.MaxSamplesToScaleScores, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSamplesToScaleScores", wireType)
+			}
+		case 21:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxTopInferersToReward = append(m.MaxTopInferersToReward, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxTopInferersToReward) == 0 {
+					m.MaxTopInferersToReward = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxTopInferersToReward = append(m.MaxTopInferersToReward, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopInferersToReward", wireType)
+			}
+		case 22:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxTopForecastersToReward = append(m.MaxTopForecastersToReward, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxTopForecastersToReward) == 0 {
+					m.MaxTopForecastersToReward = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxTopForecastersToReward = append(m.MaxTopForecastersToReward, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopForecastersToReward", wireType)
+			}
+		case 23:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxTopReputersToReward = append(m.MaxTopReputersToReward, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxTopReputersToReward) == 0 {
+					m.MaxTopReputersToReward = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxTopReputersToReward = append(m.MaxTopReputersToReward, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopReputersToReward", wireType)
+			}
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTopicFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.CreateTopicFee = append(m.CreateTopicFee, v)
+			if err := m.CreateTopicFee[len(m.CreateTopicFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GradientDescentMaxIters = append(m.GradientDescentMaxIters, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GradientDescentMaxIters) == 0 {
+					m.GradientDescentMaxIters = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GradientDescentMaxIters = append(m.GradientDescentMaxIters, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GradientDescentMaxIters", wireType)
+			}
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.RegistrationFee = append(m.RegistrationFee, v)
+			if err := m.RegistrationFee[len(m.RegistrationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DefaultPageLimit = append(m.DefaultPageLimit, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DefaultPageLimit) == 0 {
+					m.DefaultPageLimit = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DefaultPageLimit = append(m.DefaultPageLimit, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultPageLimit", wireType)
+			}
+		case 30:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxPageLimit = append(m.MaxPageLimit, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxPageLimit) == 0 {
+					m.MaxPageLimit = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxPageLimit = append(m.MaxPageLimit, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPageLimit", wireType)
+			}
+		case 31:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MinEpochLengthRecordLimit = append(m.MinEpochLengthRecordLimit, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MinEpochLengthRecordLimit) == 0 {
+					m.MinEpochLengthRecordLimit = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MinEpochLengthRecordLimit = append(m.MinEpochLengthRecordLimit, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinEpochLengthRecordLimit", wireType)
+			}
+		case 32:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BlocksPerMonth = append(m.BlocksPerMonth, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BlocksPerMonth) == 0 {
+					m.BlocksPerMonth = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BlocksPerMonth = append(m.BlocksPerMonth, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerMonth", wireType)
+			}
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PRewardInference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.PRewardInference = append(m.PRewardInference, v)
+			if err := m.PRewardInference[len(m.PRewardInference)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PRewardForecast", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.PRewardForecast = append(m.PRewardForecast, v)
+			if err := m.PRewardForecast[len(m.PRewardForecast)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PRewardReputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.PRewardReputer = append(m.PRewardReputer, v)
+			if err := m.PRewardReputer[len(m.PRewardReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CRewardInference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.CRewardInference = append(m.CRewardInference, v)
+			if err := m.CRewardInference[len(m.CRewardInference)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CRewardForecast", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.CRewardForecast = append(m.CRewardForecast, v)
+			if err := m.CRewardForecast[len(m.CRewardForecast)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CNorm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.CNorm = append(m.CNorm, v)
+			if err := m.CNorm[len(m.CNorm)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpsilonReputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.EpsilonReputer = append(m.EpsilonReputer, v)
+			if err := m.EpsilonReputer[len(m.EpsilonReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 42:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.HalfMaxProcessStakeRemovalsEndBlock = append(m.HalfMaxProcessStakeRemovalsEndBlock, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.HalfMaxProcessStakeRemovalsEndBlock) == 0 {
+					m.HalfMaxProcessStakeRemovalsEndBlock = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.HalfMaxProcessStakeRemovalsEndBlock = append(m.HalfMaxProcessStakeRemovalsEndBlock, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalfMaxProcessStakeRemovalsEndBlock", wireType)
+			}
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSendingFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.DataSendingFee = append(m.DataSendingFee, v)
+			if err := m.DataSendingFee[len(m.DataSendingFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpsilonSafeDiv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.EpsilonSafeDiv = append(m.EpsilonSafeDiv, v)
+			if err := m.EpsilonSafeDiv[len(m.EpsilonSafeDiv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 45:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxElementsPerForecast = append(m.MaxElementsPerForecast, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxElementsPerForecast) == 0 {
+					m.MaxElementsPerForecast = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxElementsPerForecast = append(m.MaxElementsPerForecast, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxElementsPerForecast", wireType)
+			}
+		case 46:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxActiveTopicsPerBlock = append(m.MaxActiveTopicsPerBlock, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxActiveTopicsPerBlock) == 0 {
+					m.MaxActiveTopicsPerBlock = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxActiveTopicsPerBlock = append(m.MaxActiveTopicsPerBlock, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActiveTopicsPerBlock", wireType)
+			}
+		case 47:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxStringLength = append(m.MaxStringLength, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxStringLength) == 0 {
+					m.MaxStringLength = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxStringLength = append(m.MaxStringLength, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStringLength", wireType)
+			}
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialRegretQuantile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.InitialRegretQuantile = append(m.InitialRegretQuantile, v)
+			if err := m.InitialRegretQuantile[len(m.InitialRegretQuantile)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 49:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PNormSafeDiv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.PNormSafeDiv = append(m.PNormSafeDiv, v)
+			if err := m.PNormSafeDiv[len(m.PNormSafeDiv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 50:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.ReputerSlashFraction = append(m.ReputerSlashFraction, v)
+			if err := m.ReputerSlashFraction[len(m.ReputerSlashFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedStakeBurnFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.SlashedStakeBurnFraction = append(m.SlashedStakeBurnFraction, v)
+			if err := m.SlashedStakeBurnFraction[len(m.SlashedStakeBurnFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 52:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxMissedReputerNonces = append(m.MaxMissedReputerNonces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxMissedReputerNonces) == 0 {
+					m.MaxMissedReputerNonces = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxMissedReputerNonces = append(m.MaxMissedReputerNonces, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedReputerNonces", wireType)
+			}
+		case 53:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerOutlierScoreRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.ReputerOutlierScoreRatio = append(m.ReputerOutlierScoreRatio, v)
+			if err := m.ReputerOutlierScoreRatio[len(m.ReputerOutlierScoreRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 54:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxReputerOutlierEpochs = append(m.MaxReputerOutlierEpochs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxReputerOutlierEpochs) == 0 {
+					m.MaxReputerOutlierEpochs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxReputerOutlierEpochs = append(m.MaxReputerOutlierEpochs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReputerOutlierEpochs", wireType)
+			}
+		case 55:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ReputerJailDuration = append(m.ReputerJailDuration, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ReputerJailDuration) == 0 {
+					m.ReputerJailDuration = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ReputerJailDuration = append(m.ReputerJailDuration, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerJailDuration", wireType)
+			}
+		case 56:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReputerLossDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.MaxReputerLossDeviation = append(m.MaxReputerLossDeviation, v)
+			if err := m.MaxReputerLossDeviation[len(m.MaxReputerLossDeviation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 57:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.NetworkInferenceArchiveRetention = append(m.NetworkInferenceArchiveRetention, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.NetworkInferenceArchiveRetention) == 0 {
+					m.NetworkInferenceArchiveRetention = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.NetworkInferenceArchiveRetention = append(m.NetworkInferenceArchiveRetention, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkInferenceArchiveRetention", wireType)
+			}
+		case 58:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxPayloadsPerBatch = append(m.MaxPayloadsPerBatch, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxPayloadsPerBatch) == 0 {
+					m.MaxPayloadsPerBatch = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxPayloadsPerBatch = append(m.MaxPayloadsPerBatch, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPayloadsPerBatch", wireType)
+			}
+		case 59:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchedPayloadGasDiscount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.BatchedPayloadGasDiscount = append(m.BatchedPayloadGasDiscount, v)
+			if err := m.BatchedPayloadGasDiscount[len(m.BatchedPayloadGasDiscount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 60:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxRedelegationsPerDelegator = append(m.MaxRedelegationsPerDelegator, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxRedelegationsPerDelegator) == 0 {
+					m.MaxRedelegationsPerDelegator = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxRedelegationsPerDelegator = append(m.MaxRedelegationsPerDelegator, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedelegationsPerDelegator", wireType)
+			}
+		case 61:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RewardAccrualEnabled = append(m.RewardAccrualEnabled, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.RewardAccrualEnabled) == 0 {
+					m.RewardAccrualEnabled = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RewardAccrualEnabled = append(m.RewardAccrualEnabled, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAccrualEnabled", wireType)
+			}
+		case 62:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSendingFeeMaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.DataSendingFeeMaxChangeRate = append(m.DataSendingFeeMaxChangeRate, v)
+			if err := m.DataSendingFeeMaxChangeRate[len(m.DataSendingFeeMaxChangeRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 63:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSendingFeeTargetRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.DataSendingFeeTargetRatio = append(m.DataSendingFeeTargetRatio, v)
+			if err := m.DataSendingFeeTargetRatio[len(m.DataSendingFeeTargetRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 64:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GmpAxelarChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GmpAxelarChannelId = append(m.GmpAxelarChannelId, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 65:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GmpAxelarCounterpartyChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GmpAxelarCounterpartyChannelId = append(m.GmpAxelarCounterpartyChannelId, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &OptionalParams{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateNewTopicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateNewTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossMethod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LossMethod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroundTruthLag", wireType)
+			}
+			m.GroundTruthLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroundTruthLag |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PNorm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PNorm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlphaRegret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AlphaRegret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowNegative", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowNegative = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epsilon", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Epsilon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerSubmissionWindow", wireType)
+			}
+			m.WorkerSubmissionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkerSubmissionWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MeritSortitionAlpha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MeritSortitionAlpha.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveInfererQuantile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActiveInfererQuantile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveForecasterQuantile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActiveForecasterQuantile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveReputerQuantile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActiveReputerQuantile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerRevealWindow", wireType)
+			}
+			m.WorkerRevealWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkerRevealWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueDimension", wireType)
+			}
+			m.ValueDimension = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValueDimension |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveNetworkInferences", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ArchiveNetworkInferences = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateNewTopicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateNewTopicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateNewTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateTopicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateTopicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &OptionalTopicParams{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateTopicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateTopicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveBlockHeight", wireType)
+			}
+			m.EffectiveBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetireTopicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetireTopicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetireTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetireTopicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetireTopicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetireTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertReputerPayloadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.RequiredMinimumStake = append(m.RequiredMinimumStake, v)
-			if err := m.RequiredMinimumStake[len(m.RequiredMinimumStake)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertReputerPayloadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertReputerPayloadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.RemoveStakeDelayWindow = append(m.RemoveStakeDelayWindow, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerValueBundle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.RemoveStakeDelayWindow) == 0 {
-					m.RemoveStakeDelayWindow = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RemoveStakeDelayWindow = append(m.RemoveStakeDelayWindow, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveStakeDelayWindow", wireType)
 			}
-		case 7:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReputerValueBundle == nil {
+				m.ReputerValueBundle = &ReputerValueBundle{}
+			}
+			if err := m.ReputerValueBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertReputerPayloadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertReputerPayloadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertReputerPayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertReputerPayloadsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertReputerPayloadsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertReputerPayloadsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.MinEpochLength = append(m.MinEpochLength, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerValueBundles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MinEpochLength) == 0 {
-					m.MinEpochLength = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MinEpochLength = append(m.MinEpochLength, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinEpochLength", wireType)
 			}
-		case 8:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReputerValueBundles = append(m.ReputerValueBundles, &ReputerValueBundle{})
+			if err := m.ReputerValueBundles[len(m.ReputerValueBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertReputerPayloadsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertReputerPayloadsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertReputerPayloadsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BetaEntropy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5786,33 +15805,100 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.BetaEntropy = append(m.BetaEntropy, v)
-			if err := m.BetaEntropy[len(m.BetaEntropy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, &BatchedPayloadResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchedPayloadResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchedPayloadResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchedPayloadResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5822,31 +15908,15 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.LearningRate = append(m.LearningRate, v)
-			if err := m.LearningRate[len(m.LearningRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+			m.Success = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxGradientThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5874,15 +15944,61 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.MaxGradientThreshold = append(m.MaxGradientThreshold, v)
-			if err := m.MaxGradientThreshold[len(m.MaxGradientThreshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 11:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmitGroundTruthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmitGroundTruthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmitGroundTruthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinStakeFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5910,167 +16026,183 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.MinStakeFraction = append(m.MinStakeFraction, v)
-			if err := m.MinStakeFraction[len(m.MinStakeFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxUnfulfilledWorkerRequests = append(m.MaxUnfulfilledWorkerRequests, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxUnfulfilledWorkerRequests) == 0 {
-					m.MaxUnfulfilledWorkerRequests = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxUnfulfilledWorkerRequests = append(m.MaxUnfulfilledWorkerRequests, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnfulfilledWorkerRequests", wireType)
 			}
-		case 14:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxUnfulfilledReputerRequests = append(m.MaxUnfulfilledReputerRequests, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerNonceBlockHeight", wireType)
+			}
+			m.ReputerNonceBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxUnfulfilledReputerRequests) == 0 {
-					m.MaxUnfulfilledReputerRequests = make([]uint64, 0, elementCount)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReputerNonceBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxUnfulfilledReputerRequests = append(m.MaxUnfulfilledReputerRequests, v)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroundTruth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnfulfilledReputerRequests", wireType)
 			}
-		case 15:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GroundTruth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmitGroundTruthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmitGroundTruthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmitGroundTruthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertWorkerPayloadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertWorkerPayloadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertWorkerPayloadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardStakeImportance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6098,15 +16230,147 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.TopicRewardStakeImportance = append(m.TopicRewardStakeImportance, v)
-			if err := m.TopicRewardStakeImportance[len(m.TopicRewardStakeImportance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerDataBundle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkerDataBundle == nil {
+				m.WorkerDataBundle = &WorkerDataBundle{}
+			}
+			if err := m.WorkerDataBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertWorkerPayloadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertWorkerPayloadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertWorkerPayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 16:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertWorkerPayloadsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertWorkerPayloadsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertWorkerPayloadsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardFeeRevenueImportance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6134,17 +16398,13 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.TopicRewardFeeRevenueImportance = append(m.TopicRewardFeeRevenueImportance, v)
-			if err := m.TopicRewardFeeRevenueImportance[len(m.TopicRewardFeeRevenueImportance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardAlpha", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerDataBundles", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6154,69 +16414,81 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.TopicRewardAlpha = append(m.TopicRewardAlpha, v)
-			if err := m.TopicRewardAlpha[len(m.TopicRewardAlpha)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WorkerDataBundles = append(m.WorkerDataBundles, &WorkerDataBundle{})
+			if err := m.WorkerDataBundles[len(m.WorkerDataBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskRewardAlpha", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertWorkerPayloadsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.TaskRewardAlpha = append(m.TaskRewardAlpha, v)
-			if err := m.TaskRewardAlpha[len(m.TaskRewardAlpha)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 19:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertWorkerPayloadsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertWorkerPayloadsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsVsAlloraPercentReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6226,335 +16498,536 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.ValidatorsVsAlloraPercentReward = append(m.ValidatorsVsAlloraPercentReward, v)
-			if err := m.ValidatorsVsAlloraPercentReward[len(m.ValidatorsVsAlloraPercentReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, &BatchedPayloadResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxSamplesToScaleScores = append(m.MaxSamplesToScaleScores, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxSamplesToScaleScores) == 0 {
-					m.MaxSamplesToScaleScores = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxSamplesToScaleScores = append(m.MaxSamplesToScaleScores, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSamplesToScaleScores", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-		case 21:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitWorkerPayloadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitWorkerPayloadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitWorkerPayloadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.MaxTopInferersToReward = append(m.MaxTopInferersToReward, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxTopInferersToReward) == 0 {
-					m.MaxTopInferersToReward = make([]uint64, 0, elementCount)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonceBlockHeight", wireType)
+			}
+			m.NonceBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxTopInferersToReward = append(m.MaxTopInferersToReward, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NonceBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopInferersToReward", wireType)
 			}
-		case 22:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.MaxTopForecastersToReward = append(m.MaxTopForecastersToReward, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitWorkerPayloadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitWorkerPayloadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitWorkerPayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevealWorkerPayloadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevealWorkerPayloadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevealWorkerPayloadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxTopForecastersToReward) == 0 {
-					m.MaxTopForecastersToReward = make([]uint64, 0, elementCount)
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerDataBundle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxTopForecastersToReward = append(m.MaxTopForecastersToReward, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopForecastersToReward", wireType)
 			}
-		case 23:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkerDataBundle == nil {
+				m.WorkerDataBundle = &WorkerDataBundle{}
+			}
+			if err := m.WorkerDataBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.MaxTopReputersToReward = append(m.MaxTopReputersToReward, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevealWorkerPayloadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevealWorkerPayloadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevealWorkerPayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxTopReputersToReward) == 0 {
-					m.MaxTopReputersToReward = make([]uint64, 0, elementCount)
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxTopReputersToReward = append(m.MaxTopReputersToReward, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopReputersToReward", wireType)
 			}
-		case 24:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateTopicFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6582,91 +17055,183 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.CreateTopicFee = append(m.CreateTopicFee, v)
-			if err := m.CreateTopicFee[len(m.CreateTopicFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 25:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.GradientDescentMaxIters = append(m.GradientDescentMaxIters, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+			m.IsReputer = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.GradientDescentMaxIters) == 0 {
-					m.GradientDescentMaxIters = make([]uint64, 0, elementCount)
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.GradientDescentMaxIters = append(m.GradientDescentMaxIters, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field GradientDescentMaxIters", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-		case 28:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveRegistrationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveRegistrationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveRegistrationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6691,322 +17256,205 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v cosmossdk_io_math.Int
-			m.RegistrationFee = append(m.RegistrationFee, v)
-			if err := m.RegistrationFee[len(m.RegistrationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 29:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.DefaultPageLimit = append(m.DefaultPageLimit, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.DefaultPageLimit) == 0 {
-					m.DefaultPageLimit = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.DefaultPageLimit = append(m.DefaultPageLimit, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultPageLimit", wireType)
-			}
-		case 30:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxPageLimit = append(m.MaxPageLimit, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxPageLimit) == 0 {
-					m.MaxPageLimit = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxPageLimit = append(m.MaxPageLimit, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPageLimit", wireType)
-			}
-		case 31:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MinEpochLengthRecordLimit = append(m.MinEpochLengthRecordLimit, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MinEpochLengthRecordLimit) == 0 {
-					m.MinEpochLengthRecordLimit = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MinEpochLengthRecordLimit = append(m.MinEpochLengthRecordLimit, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinEpochLengthRecordLimit", wireType)
 			}
-		case 32:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.BlocksPerMonth = append(m.BlocksPerMonth, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+			m.IsReputer = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveRegistrationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveRegistrationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveRegistrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.BlocksPerMonth) == 0 {
-					m.BlocksPerMonth = make([]uint64, 0, elementCount)
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.BlocksPerMonth = append(m.BlocksPerMonth, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerMonth", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddStakeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-		case 33:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddStakeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PRewardInference", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7034,15 +17482,30 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.PRewardInference = append(m.PRewardInference, v)
-			if err := m.PRewardInference[len(m.PRewardInference)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 34:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PRewardForecast", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7070,15 +17533,113 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.PRewardForecast = append(m.PRewardForecast, v)
-			if err := m.PRewardForecast[len(m.PRewardForecast)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 35:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveStakeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveStakeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PRewardReputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7106,15 +17667,30 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.PRewardReputer = append(m.PRewardReputer, v)
-			if err := m.PRewardReputer[len(m.PRewardReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 36:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CRewardInference", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7135,58 +17711,120 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.CRewardInference = append(m.CRewardInference, v)
-			if err := m.CRewardInference[len(m.CRewardInference)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 37:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CRewardForecast", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelRemoveStakeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.CRewardForecast = append(m.CRewardForecast, v)
-			if err := m.CRewardForecast[len(m.CRewardForecast)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 38:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelRemoveStakeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelRemoveStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CNorm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7214,17 +17852,13 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.CNorm = append(m.CNorm, v)
-			if err := m.CNorm[len(m.CNorm)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 40:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpsilonReputer", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var stringLen uint64
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7234,143 +17868,114 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.EpsilonReputer = append(m.EpsilonReputer, v)
-			if err := m.EpsilonReputer[len(m.EpsilonReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelRemoveStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 42:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.HalfMaxProcessStakeRemovalsEndBlock = append(m.HalfMaxProcessStakeRemovalsEndBlock, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.HalfMaxProcessStakeRemovalsEndBlock) == 0 {
-					m.HalfMaxProcessStakeRemovalsEndBlock = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.HalfMaxProcessStakeRemovalsEndBlock = append(m.HalfMaxProcessStakeRemovalsEndBlock, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field HalfMaxProcessStakeRemovalsEndBlock", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 43:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSendingFee", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelRemoveStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelRemoveStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateStakeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.DataSendingFee = append(m.DataSendingFee, v)
-			if err := m.DataSendingFee[len(m.DataSendingFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 44:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateStakeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpsilonSafeDiv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7398,243 +18003,30 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.EpsilonSafeDiv = append(m.EpsilonSafeDiv, v)
-			if err := m.EpsilonSafeDiv[len(m.EpsilonSafeDiv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 45:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxElementsPerForecast = append(m.MaxElementsPerForecast, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxElementsPerForecast) == 0 {
-					m.MaxElementsPerForecast = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxElementsPerForecast = append(m.MaxElementsPerForecast, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxElementsPerForecast", wireType)
-			}
-		case 46:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxActiveTopicsPerBlock = append(m.MaxActiveTopicsPerBlock, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxActiveTopicsPerBlock) == 0 {
-					m.MaxActiveTopicsPerBlock = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxActiveTopicsPerBlock = append(m.MaxActiveTopicsPerBlock, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxActiveTopicsPerBlock", wireType)
-			}
-		case 47:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxStringLength = append(m.MaxStringLength, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxStringLength) == 0 {
-					m.MaxStringLength = make([]uint64, 0, elementCount)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxStringLength = append(m.MaxStringLength, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxStringLength", wireType)
 			}
-		case 48:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialRegretQuantile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7662,15 +18054,11 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.InitialRegretQuantile = append(m.InitialRegretQuantile, v)
-			if err := m.InitialRegretQuantile[len(m.InitialRegretQuantile)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 49:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PNormSafeDiv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7698,9 +18086,7 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.PNormSafeDiv = append(m.PNormSafeDiv, v)
-			if err := m.PNormSafeDiv[len(m.PNormSafeDiv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7725,7 +18111,7 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *DelegateStakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7748,10 +18134,60 @@ func (m *UpdateParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegateStakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveDelegateStakeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveDelegateStakeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveDelegateStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7788,9 +18224,9 @@ func (m *UpdateParamsRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7800,25 +18236,74 @@ func (m *UpdateParamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &OptionalParams{}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7843,7 +18328,7 @@ func (m *UpdateParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7866,10 +18351,10 @@ func (m *UpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveDelegateStakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveDelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7893,7 +18378,7 @@ func (m *UpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
+func (m *CancelRemoveDelegateStakeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7916,15 +18401,15 @@ func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateNewTopicRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelRemoveDelegateStakeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateNewTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelRemoveDelegateStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7952,77 +18437,13 @@ func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LossMethod", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LossMethod = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			m.EpochLength = 0
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8032,16 +18453,16 @@ func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochLength |= int64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroundTruthLag", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
-			m.GroundTruthLag = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8051,14 +18472,27 @@ func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroundTruthLag |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PNorm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8086,13 +18520,111 @@ func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PNorm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 11:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelRemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelRemoveDelegateStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelRemoveDelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundTopicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundTopicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlphaRegret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8120,15 +18652,13 @@ func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AlphaRegret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowNegative", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var v int
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8138,15 +18668,14 @@ func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowNegative = bool(v != 0)
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epsilon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8174,32 +18703,113 @@ func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Epsilon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerSubmissionWindow", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.WorkerSubmissionWindow = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WorkerSubmissionWindow |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundTopicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundTopicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddToWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 15:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddToWhitelistAdminRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddToWhitelistAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MeritSortitionAlpha", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8227,13 +18837,11 @@ func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MeritSortitionAlpha.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveInfererQuantile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8261,13 +18869,111 @@ func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ActiveInfererQuantile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 17:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddToWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddToWhitelistAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddToWhitelistAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveFromWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveFromWhitelistAdminRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveFromWhitelistAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveForecasterQuantile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8295,13 +19001,11 @@ func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ActiveForecasterQuantile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveReputerQuantile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8329,9 +19033,7 @@ func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ActiveReputerQuantile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8354,7 +19056,7 @@ func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateNewTopicResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveFromWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8377,31 +19079,12 @@ func (m *CreateNewTopicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateNewTopicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveFromWhitelistAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateNewTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveFromWhitelistAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8423,7 +19106,7 @@ func (m *CreateNewTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InsertReputerPayloadRequest) Unmarshal(dAtA []byte) error {
+func (m *AddGmpAllowedSourceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8446,10 +19129,10 @@ func (m *InsertReputerPayloadRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InsertReputerPayloadRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddGmpAllowedSourceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertReputerPayloadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddGmpAllowedSourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8486,9 +19169,9 @@ func (m *InsertReputerPayloadRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerValueBundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChain", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8498,27 +19181,55 @@ func (m *InsertReputerPayloadRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReputerValueBundle == nil {
-				m.ReputerValueBundle = &ReputerValueBundle{}
+			m.SourceChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAddress", wireType)
 			}
-			if err := m.ReputerValueBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.SourceAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8541,7 +19252,7 @@ func (m *InsertReputerPayloadRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InsertReputerPayloadResponse) Unmarshal(dAtA []byte) error {
+func (m *AddGmpAllowedSourceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8564,10 +19275,10 @@ func (m *InsertReputerPayloadResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InsertReputerPayloadResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddGmpAllowedSourceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertReputerPayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddGmpAllowedSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8591,7 +19302,7 @@ func (m *InsertReputerPayloadResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InsertWorkerPayloadRequest) Unmarshal(dAtA []byte) error {
+func (m *RemoveGmpAllowedSourceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8610,19 +19321,51 @@ func (m *InsertWorkerPayloadRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InsertWorkerPayloadRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertWorkerPayloadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveGmpAllowedSourceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveGmpAllowedSourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8650,13 +19393,13 @@ func (m *InsertWorkerPayloadRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.SourceChain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerDataBundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8666,27 +19409,23 @@ func (m *InsertWorkerPayloadRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkerDataBundle == nil {
-				m.WorkerDataBundle = &WorkerDataBundle{}
-			}
-			if err := m.WorkerDataBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourceAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8709,7 +19448,7 @@ func (m *InsertWorkerPayloadRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InsertWorkerPayloadResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveGmpAllowedSourceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8732,10 +19471,10 @@ func (m *InsertWorkerPayloadResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InsertWorkerPayloadResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveGmpAllowedSourceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertWorkerPayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveGmpAllowedSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8759,7 +19498,7 @@ func (m *InsertWorkerPayloadResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateGmpSubscriptionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8782,10 +19521,10 @@ func (m *RegisterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateGmpSubscriptionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateGmpSubscriptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8820,7 +19559,7 @@ func (m *RegisterRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
@@ -8839,9 +19578,9 @@ func (m *RegisterRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8869,13 +19608,45 @@ func (m *RegisterRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.DestinationChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePerMessage", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8885,12 +19656,60 @@ func (m *RegisterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsReputer = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePerMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prepayment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Prepayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8912,7 +19731,7 @@ func (m *RegisterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterResponse) Unmarshal(dAtA []byte) error {
+func (m *CreateGmpSubscriptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8935,17 +19754,17 @@ func (m *RegisterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateGmpSubscriptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateGmpSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionId", wireType)
 			}
-			var v int
+			m.SubscriptionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8955,15 +19774,115 @@ func (m *RegisterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.SubscriptionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundGmpSubscriptionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundGmpSubscriptionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundGmpSubscriptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionId", wireType)
+			}
+			m.SubscriptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubscriptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8980,19 +19899,71 @@ func (m *RegisterResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundGmpSubscriptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundGmpSubscriptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundGmpSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9014,7 +19985,7 @@ func (m *RegisterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveRegistrationRequest) Unmarshal(dAtA []byte) error {
+func (m *CancelGmpSubscriptionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9037,10 +20008,10 @@ func (m *RemoveRegistrationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveRegistrationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelGmpSubscriptionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveRegistrationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelGmpSubscriptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9077,28 +20048,9 @@ func (m *RemoveRegistrationRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionId", wireType)
 			}
-			var v int
+			m.SubscriptionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9108,12 +20060,11 @@ func (m *RemoveRegistrationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.SubscriptionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsReputer = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9135,7 +20086,7 @@ func (m *RemoveRegistrationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveRegistrationResponse) Unmarshal(dAtA []byte) error {
+func (m *CancelGmpSubscriptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9158,64 +20109,12 @@ func (m *RemoveRegistrationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveRegistrationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelGmpSubscriptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveRegistrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelGmpSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Success = bool(v != 0)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9237,7 +20136,7 @@ func (m *RemoveRegistrationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddStakeRequest) Unmarshal(dAtA []byte) error {
+func (m *FundTopicSponsorshipRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9260,10 +20159,10 @@ func (m *AddStakeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddStakeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FundTopicSponsorshipRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FundTopicSponsorshipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9351,6 +20250,74 @@ func (m *AddStakeRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeesPerActorPerEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFeesPerActorPerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeesPerEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFeesPerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9372,7 +20339,7 @@ func (m *AddStakeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *FundTopicSponsorshipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9395,10 +20362,10 @@ func (m *AddStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FundTopicSponsorshipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FundTopicSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9422,7 +20389,7 @@ func (m *AddStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveStakeRequest) Unmarshal(dAtA []byte) error {
+func (m *WithdrawTopicSponsorshipRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9445,10 +20412,10 @@ func (m *RemoveStakeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveStakeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WithdrawTopicSponsorshipRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WithdrawTopicSponsorshipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9557,7 +20524,7 @@ func (m *RemoveStakeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *WithdrawTopicSponsorshipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9580,10 +20547,10 @@ func (m *RemoveStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WithdrawTopicSponsorshipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WithdrawTopicSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9607,7 +20574,7 @@ func (m *RemoveStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelRemoveStakeRequest) Unmarshal(dAtA []byte) error {
+func (m *SetTopicAllowlistEnabledRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9630,10 +20597,10 @@ func (m *CancelRemoveStakeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelRemoveStakeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetTopicAllowlistEnabledRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelRemoveStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetTopicAllowlistEnabledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9650,29 +20617,68 @@ func (m *CancelRemoveStakeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.IsReputer = bool(v != 0)
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			m.TopicId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9682,11 +20688,12 @@ func (m *CancelRemoveStakeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9708,7 +20715,7 @@ func (m *CancelRemoveStakeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelRemoveStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *SetTopicAllowlistEnabledResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9731,10 +20738,10 @@ func (m *CancelRemoveStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelRemoveStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetTopicAllowlistEnabledResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelRemoveStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetTopicAllowlistEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9758,7 +20765,7 @@ func (m *CancelRemoveStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegateStakeRequest) Unmarshal(dAtA []byte) error {
+func (m *AddToTopicAllowlistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9781,10 +20788,10 @@ func (m *DelegateStakeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateStakeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddToTopicAllowlistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddToTopicAllowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9839,10 +20846,10 @@ func (m *DelegateStakeRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9852,27 +20859,15 @@ func (m *DelegateStakeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.IsReputer = bool(v != 0)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9900,9 +20895,7 @@ func (m *DelegateStakeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9925,7 +20918,7 @@ func (m *DelegateStakeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegateStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *AddToTopicAllowlistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9948,10 +20941,10 @@ func (m *DelegateStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddToTopicAllowlistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddToTopicAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9975,7 +20968,7 @@ func (m *DelegateStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveDelegateStakeRequest) Unmarshal(dAtA []byte) error {
+func (m *RemoveFromTopicAllowlistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9998,10 +20991,10 @@ func (m *RemoveDelegateStakeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveDelegateStakeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveFromTopicAllowlistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveDelegateStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveFromTopicAllowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10037,10 +21030,10 @@ func (m *RemoveDelegateStakeRequest) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var stringLen uint64
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10050,29 +21043,16 @@ func (m *RemoveDelegateStakeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
 			}
-			m.TopicId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10082,14 +21062,15 @@ func (m *RemoveDelegateStakeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IsReputer = bool(v != 0)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10117,9 +21098,7 @@ func (m *RemoveDelegateStakeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10142,7 +21121,7 @@ func (m *RemoveDelegateStakeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveFromTopicAllowlistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10165,10 +21144,10 @@ func (m *RemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveDelegateStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveFromTopicAllowlistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveDelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveFromTopicAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10192,7 +21171,7 @@ func (m *RemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelRemoveDelegateStakeRequest) Unmarshal(dAtA []byte) error {
+func (m *UnjailReputerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10215,10 +21194,10 @@ func (m *CancelRemoveDelegateStakeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelRemoveDelegateStakeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnjailReputerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelRemoveDelegateStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnjailReputerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10272,70 +21251,56 @@ func (m *CancelRemoveDelegateStakeRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnjailReputerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnjailReputerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnjailReputerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10357,7 +21322,7 @@ func (m *CancelRemoveDelegateStakeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelRemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *RewardDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10380,10 +21345,10 @@ func (m *CancelRemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelRemoveDelegateStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardDelegateStakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelRemoveDelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardDelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10407,7 +21372,7 @@ func (m *CancelRemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FundTopicRequest) Unmarshal(dAtA []byte) error {
+func (m *RewardDelegateStakeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10430,10 +21395,10 @@ func (m *FundTopicRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FundTopicRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardDelegateStakeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FundTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardDelegateStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10489,7 +21454,7 @@ func (m *FundTopicRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10517,60 +21482,8 @@ func (m *FundTopicRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FundTopicResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FundTopicResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FundTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10592,7 +21505,7 @@ func (m *FundTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddToWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
+func (m *SetDelegateStakeAutoCompoundRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10615,10 +21528,10 @@ func (m *AddToWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddToWhitelistAdminRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetDelegateStakeAutoCompoundRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddToWhitelistAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetDelegateStakeAutoCompoundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10654,8 +21567,27 @@ func (m *AddToWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10683,8 +21615,28 @@ func (m *AddToWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Reputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10706,7 +21658,7 @@ func (m *AddToWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddToWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *SetDelegateStakeAutoCompoundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10729,10 +21681,10 @@ func (m *AddToWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddToWhitelistAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetDelegateStakeAutoCompoundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddToWhitelistAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetDelegateStakeAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10756,7 +21708,7 @@ func (m *AddToWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveFromWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
+func (m *RedelegateStakeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10779,10 +21731,10 @@ func (m *RemoveFromWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveFromWhitelistAdminRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegateStakeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveFromWhitelistAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegateStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10818,8 +21770,27 @@ func (m *RemoveFromWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcTopicId", wireType)
+			}
+			m.SrcTopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcTopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcReputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10847,7 +21818,92 @@ func (m *RemoveFromWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.SrcReputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstTopicId", wireType)
+			}
+			m.DstTopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstTopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstReputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstReputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10870,7 +21926,7 @@ func (m *RemoveFromWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveFromWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *RedelegateStakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10893,10 +21949,10 @@ func (m *RemoveFromWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveFromWhitelistAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegateStakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveFromWhitelistAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10920,7 +21976,7 @@ func (m *RemoveFromWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RewardDelegateStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *ClaimRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10943,12 +21999,44 @@ func (m *RewardDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RewardDelegateStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardDelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10970,7 +22058,7 @@ func (m *RewardDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RewardDelegateStakeRequest) Unmarshal(dAtA []byte) error {
+func (m *ClaimRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10993,15 +22081,15 @@ func (m *RewardDelegateStakeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RewardDelegateStakeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardDelegateStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11029,58 +22117,9 @@ func (m *RewardDelegateStakeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
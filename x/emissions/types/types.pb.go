@@ -4,8 +4,11 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_allora_network_allora_chain_math "github.com/allora-network/allora-chain/math"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -157,35 +160,415 @@ func (m *ListeningCoefficient) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ListeningCoefficient proto.InternalMessageInfo
 
+// A source chain and contract address that may route Axelar general messages
+// into emissions actions through the GMP middleware
+type GmpAllowedSource struct {
+	SourceChain   string `protobuf:"bytes,1,opt,name=source_chain,json=sourceChain,proto3" json:"source_chain,omitempty"`
+	SourceAddress string `protobuf:"bytes,2,opt,name=source_address,json=sourceAddress,proto3" json:"source_address,omitempty"`
+}
+
+func (m *GmpAllowedSource) Reset()         { *m = GmpAllowedSource{} }
+func (m *GmpAllowedSource) String() string { return proto.CompactTextString(m) }
+func (*GmpAllowedSource) ProtoMessage()    {}
+func (*GmpAllowedSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_715278a52b00983b, []int{3}
+}
+func (m *GmpAllowedSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GmpAllowedSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GmpAllowedSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GmpAllowedSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GmpAllowedSource.Merge(m, src)
+}
+func (m *GmpAllowedSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *GmpAllowedSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_GmpAllowedSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GmpAllowedSource proto.InternalMessageInfo
+
+func (m *GmpAllowedSource) GetSourceChain() string {
+	if m != nil {
+		return m.SourceChain
+	}
+	return ""
+}
+
+func (m *GmpAllowedSource) GetSourceAddress() string {
+	if m != nil {
+		return m.SourceAddress
+	}
+	return ""
+}
+
+// A subscription pushing a topic's network inferences to a contract on another
+// chain through Axelar GMP, over the channel to Axelar set in the module
+// params, paid for from the subscriber's prepaid balance
+type GmpSubscription struct {
+	Id                 uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TopicId            uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Subscriber         string `protobuf:"bytes,3,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
+	DestinationChain   string `protobuf:"bytes,4,opt,name=destination_chain,json=destinationChain,proto3" json:"destination_chain,omitempty"`
+	DestinationAddress string `protobuf:"bytes,5,opt,name=destination_address,json=destinationAddress,proto3" json:"destination_address,omitempty"`
+	// fee sent to Axelar with every message
+	FeePerMessage cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=fee_per_message,json=feePerMessage,proto3,customtype=cosmossdk.io/math.Int" json:"fee_per_message"`
+	// prepaid fees remaining, held in escrow
+	Balance cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=balance,proto3,customtype=cosmossdk.io/math.Int" json:"balance"`
+}
+
+func (m *GmpSubscription) Reset()         { *m = GmpSubscription{} }
+func (m *GmpSubscription) String() string { return proto.CompactTextString(m) }
+func (*GmpSubscription) ProtoMessage()    {}
+func (*GmpSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_715278a52b00983b, []int{4}
+}
+func (m *GmpSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GmpSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GmpSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GmpSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GmpSubscription.Merge(m, src)
+}
+func (m *GmpSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *GmpSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_GmpSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GmpSubscription proto.InternalMessageInfo
+
+func (m *GmpSubscription) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GmpSubscription) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *GmpSubscription) GetSubscriber() string {
+	if m != nil {
+		return m.Subscriber
+	}
+	return ""
+}
+
+func (m *GmpSubscription) GetDestinationChain() string {
+	if m != nil {
+		return m.DestinationChain
+	}
+	return ""
+}
+
+func (m *GmpSubscription) GetDestinationAddress() string {
+	if m != nil {
+		return m.DestinationAddress
+	}
+	return ""
+}
+
+// An outbound GMP message awaiting its acknowledgement or timeout
+type GmpDelivery struct {
+	SubscriptionId uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	TopicId        uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Subscriber     string `protobuf:"bytes,3,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
+	// block height of the network inference that was sent
+	BlockHeight int64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// channel to Axelar the message was sent over
+	ChannelId string                `protobuf:"bytes,5,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64                `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Fee       cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=fee,proto3,customtype=cosmossdk.io/math.Int" json:"fee"`
+}
+
+func (m *GmpDelivery) Reset()         { *m = GmpDelivery{} }
+func (m *GmpDelivery) String() string { return proto.CompactTextString(m) }
+func (*GmpDelivery) ProtoMessage()    {}
+func (*GmpDelivery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_715278a52b00983b, []int{5}
+}
+func (m *GmpDelivery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GmpDelivery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GmpDelivery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GmpDelivery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GmpDelivery.Merge(m, src)
+}
+func (m *GmpDelivery) XXX_Size() int {
+	return m.Size()
+}
+func (m *GmpDelivery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GmpDelivery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GmpDelivery proto.InternalMessageInfo
+
+func (m *GmpDelivery) GetSubscriptionId() uint64 {
+	if m != nil {
+		return m.SubscriptionId
+	}
+	return 0
+}
+
+func (m *GmpDelivery) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *GmpDelivery) GetSubscriber() string {
+	if m != nil {
+		return m.Subscriber
+	}
+	return ""
+}
+
+func (m *GmpDelivery) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *GmpDelivery) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *GmpDelivery) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// Fees prepaid by a sponsor to cover the data sending fees of the workers and
+// reputers of a topic
+type TopicSponsorship struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Sponsor string `protobuf:"bytes,2,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// prepaid fees remaining, held in escrow
+	Balance cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=balance,proto3,customtype=cosmossdk.io/math.Int" json:"balance"`
+	// most fees covered per worker or reputer per epoch of the topic, zero for
+	// no cap
+	MaxFeesPerActorPerEpoch cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_fees_per_actor_per_epoch,json=maxFeesPerActorPerEpoch,proto3,customtype=cosmossdk.io/math.Int" json:"max_fees_per_actor_per_epoch"`
+	// most fees covered per epoch of the topic across workers and reputers,
+	// zero for no cap
+	MaxFeesPerEpoch cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=max_fees_per_epoch,json=maxFeesPerEpoch,proto3,customtype=cosmossdk.io/math.Int" json:"max_fees_per_epoch"`
+	// fees covered so far during the epoch of the topic that last ended at
+	// this block height
+	EpochFees *SponsoredFees `protobuf:"bytes,6,opt,name=epoch_fees,json=epochFees,proto3" json:"epoch_fees,omitempty"`
+}
+
+func (m *TopicSponsorship) Reset()         { *m = TopicSponsorship{} }
+func (m *TopicSponsorship) String() string { return proto.CompactTextString(m) }
+func (*TopicSponsorship) ProtoMessage()    {}
+func (*TopicSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_715278a52b00983b, []int{6}
+}
+func (m *TopicSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicSponsorship.Merge(m, src)
+}
+func (m *TopicSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicSponsorship proto.InternalMessageInfo
+
+func (m *TopicSponsorship) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *TopicSponsorship) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *TopicSponsorship) GetEpochFees() *SponsoredFees {
+	if m != nil {
+		return m.EpochFees
+	}
+	return nil
+}
+
+// Fees covered by a sponsorship during an epoch of its topic
+type SponsoredFees struct {
+	// block height at which the epoch of the topic last ended
+	EpochLastEnded int64                 `protobuf:"varint,1,opt,name=epoch_last_ended,json=epochLastEnded,proto3" json:"epoch_last_ended,omitempty"`
+	Fees           cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=fees,proto3,customtype=cosmossdk.io/math.Int" json:"fees"`
+}
+
+func (m *SponsoredFees) Reset()         { *m = SponsoredFees{} }
+func (m *SponsoredFees) String() string { return proto.CompactTextString(m) }
+func (*SponsoredFees) ProtoMessage()    {}
+func (*SponsoredFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_715278a52b00983b, []int{7}
+}
+func (m *SponsoredFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SponsoredFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SponsoredFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SponsoredFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SponsoredFees.Merge(m, src)
+}
+func (m *SponsoredFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *SponsoredFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_SponsoredFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SponsoredFees proto.InternalMessageInfo
+
+func (m *SponsoredFees) GetEpochLastEnded() int64 {
+	if m != nil {
+		return m.EpochLastEnded
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*SimpleCursorPaginationRequest)(nil), "emissions.v3.SimpleCursorPaginationRequest")
 	proto.RegisterType((*SimpleCursorPaginationResponse)(nil), "emissions.v3.SimpleCursorPaginationResponse")
 	proto.RegisterType((*ListeningCoefficient)(nil), "emissions.v3.ListeningCoefficient")
+	proto.RegisterType((*GmpAllowedSource)(nil), "emissions.v3.GmpAllowedSource")
+	proto.RegisterType((*GmpSubscription)(nil), "emissions.v3.GmpSubscription")
+	proto.RegisterType((*GmpDelivery)(nil), "emissions.v3.GmpDelivery")
+	proto.RegisterType((*TopicSponsorship)(nil), "emissions.v3.TopicSponsorship")
+	proto.RegisterType((*SponsoredFees)(nil), "emissions.v3.SponsoredFees")
 }
 
 func init() { proto.RegisterFile("emissions/v3/types.proto", fileDescriptor_715278a52b00983b) }
 
 var fileDescriptor_715278a52b00983b = []byte{
-	// 294 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0xbd, 0x4e, 0xeb, 0x40,
-	0x10, 0x85, 0xbd, 0xf7, 0xf2, 0xbb, 0xa4, 0x40, 0x56, 0x8a, 0x80, 0xc4, 0x26, 0x4a, 0x95, 0x06,
-	0x6f, 0x91, 0x02, 0x24, 0xba, 0x04, 0x89, 0x02, 0x0a, 0x64, 0x2a, 0x68, 0xd0, 0xc6, 0x9a, 0x38,
-	0xa3, 0xd8, 0x3b, 0x8e, 0x77, 0x13, 0xe2, 0xb7, 0xe0, 0xb1, 0x52, 0xa6, 0x44, 0x14, 0x11, 0xb2,
-	0x5f, 0x04, 0xd9, 0x11, 0x98, 0x06, 0x89, 0xee, 0x7c, 0x3a, 0x33, 0x5f, 0x71, 0x78, 0x0b, 0x62,
-	0x34, 0x06, 0x49, 0x1b, 0xb9, 0xe8, 0x4b, 0x9b, 0x25, 0x60, 0xbc, 0x24, 0x25, 0x4b, 0x6e, 0xe3,
-	0xbb, 0xf1, 0x16, 0xfd, 0xd3, 0x66, 0x48, 0x21, 0x55, 0x85, 0x2c, 0xd3, 0xf6, 0xa6, 0x7b, 0xc3,
-	0xcf, 0x1e, 0x30, 0x4e, 0x22, 0x18, 0xce, 0x53, 0x43, 0xe9, 0xbd, 0x0a, 0x51, 0x2b, 0x8b, 0xa4,
-	0x7d, 0x98, 0xcd, 0xc1, 0x58, 0xf7, 0x98, 0xff, 0x9f, 0x42, 0xd6, 0x62, 0x1d, 0xd6, 0x6b, 0xf8,
-	0x65, 0x74, 0x9b, 0x7c, 0x37, 0xc2, 0x18, 0x6d, 0xeb, 0x5f, 0x87, 0xf5, 0x76, 0xfc, 0x2d, 0x74,
-	0xaf, 0xb8, 0xf8, 0x4d, 0x64, 0x12, 0xd2, 0x06, 0xdc, 0x13, 0x7e, 0xa0, 0x61, 0x69, 0x9f, 0x6b,
-	0xdd, 0x7e, 0xc9, 0xb7, 0x90, 0x75, 0x67, 0xbc, 0x79, 0x87, 0xc6, 0x82, 0x46, 0x1d, 0x0e, 0x09,
-	0xc6, 0x63, 0x0c, 0x10, 0xb4, 0x75, 0x1f, 0xf9, 0x51, 0x50, 0x63, 0xf5, 0x75, 0x38, 0xb8, 0x58,
-	0x6d, 0xda, 0xce, 0xfb, 0xa6, 0x2d, 0x43, 0xb4, 0x93, 0xf9, 0xc8, 0x0b, 0x28, 0x96, 0x2a, 0x8a,
-	0x28, 0x55, 0xe7, 0x1a, 0xec, 0x0b, 0xa5, 0xd3, 0x2f, 0x0c, 0x26, 0x0a, 0xb5, 0x8c, 0x95, 0x9d,
-	0x78, 0xd7, 0x10, 0xf8, 0x3f, 0x5d, 0x03, 0x7f, 0x95, 0x0b, 0xb6, 0xce, 0x05, 0xfb, 0xc8, 0x05,
-	0x7b, 0x2d, 0x84, 0xb3, 0x2e, 0x84, 0xf3, 0x56, 0x08, 0xe7, 0xe9, 0xf2, 0x8f, 0xde, 0xa5, 0xac,
-	0x97, 0xaf, 0x66, 0x1f, 0xed, 0x55, 0x9b, 0xf6, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf2, 0xbb,
-	0xca, 0xff, 0x93, 0x01, 0x00, 0x00,
+	// 796 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcd, 0x6e, 0x1c, 0x45,
+	0x10, 0xf6, 0xec, 0xae, 0xbd, 0x76, 0xad, 0x7f, 0x36, 0x8d, 0x11, 0x13, 0x43, 0x26, 0xc9, 0x48,
+	0x08, 0x0b, 0x94, 0x1d, 0x84, 0x0f, 0x20, 0x38, 0xf9, 0x27, 0x98, 0x0d, 0x41, 0xb2, 0xc6, 0x1c,
+	0x00, 0x81, 0x46, 0xbd, 0x33, 0xb5, 0x3b, 0x2d, 0xcf, 0x74, 0x4f, 0xba, 0x7b, 0x1d, 0xfb, 0xc4,
+	0x2b, 0x20, 0xf1, 0x00, 0x5c, 0x39, 0x72, 0xe0, 0x21, 0x72, 0x8c, 0x38, 0x21, 0x0e, 0x11, 0xb2,
+	0x0f, 0xdc, 0x79, 0x02, 0xd4, 0xdd, 0x63, 0x7b, 0xf6, 0x80, 0x14, 0xad, 0x2f, 0xab, 0xae, 0xaf,
+	0xab, 0xbe, 0xaf, 0xfa, 0xab, 0xde, 0x69, 0xf0, 0xb1, 0x64, 0x4a, 0x31, 0xc1, 0x55, 0x74, 0xba,
+	0x13, 0xe9, 0xf3, 0x0a, 0xd5, 0xa0, 0x92, 0x42, 0x0b, 0xb2, 0x7a, 0xbd, 0x33, 0x38, 0xdd, 0xd9,
+	0xba, 0x43, 0x4b, 0xc6, 0x45, 0x64, 0x7f, 0x5d, 0xc2, 0xd6, 0xdd, 0x54, 0xa8, 0x52, 0xa8, 0xc4,
+	0x46, 0x91, 0x0b, 0xea, 0xad, 0xcd, 0x89, 0x98, 0x08, 0x87, 0x9b, 0x95, 0x43, 0xc3, 0x43, 0xb8,
+	0x77, 0xcc, 0xca, 0xaa, 0xc0, 0xfd, 0xa9, 0x54, 0x42, 0x1e, 0xd1, 0x09, 0xe3, 0x54, 0x33, 0xc1,
+	0x63, 0x7c, 0x36, 0x45, 0xa5, 0x49, 0x1f, 0xda, 0x27, 0x78, 0xee, 0x7b, 0x0f, 0xbc, 0xed, 0xd5,
+	0xd8, 0x2c, 0xc9, 0x26, 0x2c, 0x16, 0xac, 0x64, 0xda, 0x6f, 0x3d, 0xf0, 0xb6, 0x3b, 0xb1, 0x0b,
+	0xc2, 0xcf, 0x20, 0xf8, 0x3f, 0x22, 0x55, 0x09, 0xae, 0x90, 0xdc, 0x85, 0x65, 0x8e, 0x67, 0x3a,
+	0xb9, 0xa1, 0xeb, 0x9a, 0xf8, 0x4b, 0x3c, 0x0f, 0x9f, 0xc1, 0xe6, 0x53, 0xa6, 0x34, 0x72, 0xc6,
+	0x27, 0xfb, 0x02, 0xc7, 0x63, 0x96, 0x32, 0xe4, 0x9a, 0x7c, 0x0b, 0xbd, 0xf4, 0x26, 0xb4, 0x55,
+	0x2b, 0x7b, 0x1f, 0xbf, 0x78, 0x75, 0x7f, 0xe1, 0xaf, 0x57, 0xf7, 0xa3, 0x09, 0xd3, 0xf9, 0x74,
+	0x34, 0x48, 0x45, 0x19, 0xd1, 0xa2, 0x10, 0x92, 0x3e, 0xe2, 0xa8, 0x9f, 0x0b, 0x79, 0x72, 0x15,
+	0xa6, 0x39, 0x65, 0x3c, 0x2a, 0xa9, 0xce, 0x07, 0x07, 0x98, 0xc6, 0x4d, 0xae, 0xf0, 0x7b, 0xe8,
+	0x1f, 0x96, 0xd5, 0x6e, 0x51, 0x88, 0xe7, 0x98, 0x1d, 0x8b, 0xa9, 0x4c, 0x91, 0x3c, 0x84, 0x55,
+	0x65, 0x57, 0x89, 0xad, 0x74, 0x7a, 0x71, 0xcf, 0x61, 0xfb, 0x06, 0x22, 0xef, 0xc2, 0x7a, 0x9d,
+	0x42, 0xb3, 0x4c, 0xa2, 0x52, 0xd6, 0x85, 0x95, 0x78, 0xcd, 0xa1, 0xbb, 0x0e, 0x0c, 0xff, 0x6d,
+	0xc1, 0xc6, 0x61, 0x59, 0x1d, 0x4f, 0x47, 0x2a, 0x95, 0xac, 0x32, 0x3e, 0x90, 0x75, 0x68, 0xb1,
+	0xcc, 0x72, 0x76, 0xe2, 0x16, 0xcb, 0x8c, 0x1f, 0x5a, 0x54, 0x2c, 0x4d, 0x58, 0x56, 0x5b, 0xd9,
+	0xb5, 0xf1, 0x30, 0x23, 0x01, 0x80, 0x72, 0xa5, 0x23, 0x94, 0x7e, 0xdb, 0x2a, 0x34, 0x10, 0xf2,
+	0x01, 0xdc, 0xc9, 0x50, 0xe9, 0xda, 0xe1, 0xba, 0xdb, 0x8e, 0x4d, 0xeb, 0x37, 0x36, 0x5c, 0xcb,
+	0x11, 0xbc, 0xd1, 0x4c, 0xbe, 0xea, 0x7b, 0xd1, 0xa6, 0x93, 0xc6, 0x56, 0xdd, 0x3c, 0xf9, 0x06,
+	0x36, 0xc6, 0x88, 0x49, 0x85, 0x32, 0x29, 0x51, 0x29, 0x3a, 0x41, 0xbf, 0x6b, 0x9d, 0xff, 0xb0,
+	0x76, 0xfe, 0x4d, 0x77, 0xb1, 0x54, 0x76, 0x32, 0x60, 0xc2, 0xf9, 0x3b, 0xe4, 0xfa, 0x8f, 0xdf,
+	0x1f, 0x41, 0x7d, 0xe3, 0x86, 0x5c, 0xff, 0xfa, 0xcf, 0x6f, 0xef, 0x7b, 0xf1, 0xda, 0x18, 0xf1,
+	0x08, 0xe5, 0x57, 0x8e, 0x86, 0x3c, 0x81, 0xee, 0x88, 0x16, 0x94, 0xa7, 0xe8, 0x2f, 0xcf, 0xc9,
+	0x78, 0x45, 0xf0, 0xa4, 0xb3, 0xbc, 0xd4, 0xef, 0xc6, 0x90, 0xe6, 0x94, 0x73, 0x2c, 0x12, 0x96,
+	0x85, 0x3f, 0xb7, 0xa0, 0x77, 0x58, 0x56, 0x07, 0x58, 0xb0, 0x53, 0x94, 0xe7, 0xe4, 0x3d, 0xd8,
+	0x50, 0x8d, 0x01, 0x24, 0xd7, 0xee, 0xaf, 0x37, 0xe1, 0xe1, 0xad, 0x26, 0xf1, 0x10, 0x56, 0x47,
+	0x85, 0x48, 0x4f, 0x92, 0x1c, 0xd9, 0x24, 0xd7, 0x76, 0x08, 0xed, 0xb8, 0x67, 0xb1, 0x2f, 0x2c,
+	0x44, 0xee, 0x41, 0xa3, 0xc9, 0xda, 0xf6, 0x95, 0x1a, 0x19, 0x66, 0x64, 0x0b, 0x96, 0x95, 0xf9,
+	0xaf, 0x19, 0x53, 0x96, 0xac, 0xf8, 0x75, 0x4c, 0xf6, 0xa0, 0x3d, 0xc6, 0xf9, 0xdd, 0x37, 0xc5,
+	0xe1, 0x2f, 0x6d, 0xe8, 0x7f, 0x6d, 0x4e, 0x73, 0x6c, 0xfe, 0x86, 0x42, 0xaa, 0x9c, 0x55, 0x33,
+	0x27, 0xf6, 0x66, 0x4f, 0xec, 0x43, 0x57, 0xb9, 0xcc, 0xfa, 0x6a, 0x5f, 0x85, 0xcd, 0xe9, 0xb5,
+	0x6f, 0x39, 0x3d, 0x22, 0xe0, 0x9d, 0x92, 0x9e, 0x25, 0x63, 0x44, 0x65, 0x2f, 0x1a, 0x4d, 0xb5,
+	0x90, 0x76, 0x85, 0x95, 0x48, 0x73, 0xbf, 0x33, 0xa7, 0xc0, 0x5b, 0x25, 0x3d, 0xfb, 0x1c, 0x51,
+	0x1d, 0xa1, 0xdc, 0x35, 0x94, 0x47, 0x28, 0x1f, 0x1b, 0x42, 0xf2, 0x03, 0x90, 0x19, 0x41, 0x27,
+	0xb3, 0x38, 0xa7, 0xcc, 0xc6, 0x8d, 0x8c, 0xa3, 0xff, 0x14, 0xc0, 0x32, 0x5a, 0x01, 0x3b, 0xc7,
+	0xde, 0x47, 0x6f, 0x0f, 0x9a, 0x9f, 0xeb, 0x41, 0xed, 0x3f, 0x66, 0xa6, 0x30, 0x5e, 0xb1, 0xe9,
+	0x66, 0x19, 0xfe, 0x08, 0x6b, 0x33, 0x7b, 0x64, 0x1b, 0xfa, 0x8e, 0xac, 0xa0, 0x4a, 0x27, 0xc8,
+	0x33, 0x74, 0x53, 0x6a, 0xc7, 0xeb, 0x16, 0x7f, 0x4a, 0x95, 0x7e, 0x6c, 0x50, 0x72, 0x00, 0x1d,
+	0x2b, 0xd8, 0x9a, 0xf3, 0x1c, 0xb6, 0x7a, 0x2f, 0x7e, 0x71, 0x11, 0x78, 0x2f, 0x2f, 0x02, 0xef,
+	0xef, 0x8b, 0xc0, 0xfb, 0xe9, 0x32, 0x58, 0x78, 0x79, 0x19, 0x2c, 0xfc, 0x79, 0x19, 0x2c, 0x7c,
+	0xf7, 0xc9, 0x6b, 0x7e, 0x63, 0xcf, 0xa2, 0x9b, 0x37, 0xcb, 0x3e, 0x58, 0xa3, 0x25, 0xfb, 0xbe,
+	0xec, 0xfc, 0x37, 0x00, 0x41, 0xa4, 0x17, 0x9f, 0xcd, 0x06, 0x00, 0x00,
 }
 
 func (m *SimpleCursorPaginationRequest) Marshal() (dAtA []byte, err error) {
@@ -286,69 +669,1118 @@ func (m *ListeningCoefficient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GmpAllowedSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *SimpleCursorPaginationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Limit != 0 {
-		n += 1 + sovTypes(uint64(m.Limit))
-	}
-	return n
+
+func (m *GmpAllowedSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SimpleCursorPaginationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GmpAllowedSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NextKey)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.SourceAddress) > 0 {
+		i -= len(m.SourceAddress)
+		copy(dAtA[i:], m.SourceAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SourceAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.SourceChain) > 0 {
+		i -= len(m.SourceChain)
+		copy(dAtA[i:], m.SourceChain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SourceChain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ListeningCoefficient) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GmpSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Coefficient.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *GmpSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *SimpleCursorPaginationRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+
+func (m *GmpSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.FeePerMessage.Size()
+		i -= size
+		if _, err := m.FeePerMessage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.DestinationAddress) > 0 {
+		i -= len(m.DestinationAddress)
+		copy(dAtA[i:], m.DestinationAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DestinationAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DestinationChain) > 0 {
+		i -= len(m.DestinationChain)
+		copy(dAtA[i:], m.DestinationChain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DestinationChain)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Subscriber) > 0 {
+		i -= len(m.Subscriber)
+		copy(dAtA[i:], m.Subscriber)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Subscriber)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GmpDelivery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GmpDelivery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GmpDelivery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Sequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Subscriber) > 0 {
+		i -= len(m.Subscriber)
+		copy(dAtA[i:], m.Subscriber)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Subscriber)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubscriptionId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SubscriptionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochFees != nil {
+		{
+			size, err := m.EpochFees.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.MaxFeesPerEpoch.Size()
+		i -= size
+		if _, err := m.MaxFeesPerEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxFeesPerActorPerEpoch.Size()
+		i -= size
+		if _, err := m.MaxFeesPerActorPerEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SponsoredFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SponsoredFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SponsoredFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fees.Size()
+		i -= size
+		if _, err := m.Fees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.EpochLastEnded != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EpochLastEnded))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SimpleCursorPaginationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovTypes(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *SimpleCursorPaginationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NextKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ListeningCoefficient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Coefficient.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *GmpAllowedSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceChain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.SourceAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *GmpSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTypes(uint64(m.TopicId))
+	}
+	l = len(m.Subscriber)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.DestinationChain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.DestinationAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.FeePerMessage.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *GmpDelivery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubscriptionId != 0 {
+		n += 1 + sovTypes(uint64(m.SubscriptionId))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTypes(uint64(m.TopicId))
+	}
+	l = len(m.Subscriber)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.BlockHeight))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTypes(uint64(m.Sequence))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *TopicSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovTypes(uint64(m.TopicId))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.MaxFeesPerActorPerEpoch.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.MaxFeesPerEpoch.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.EpochFees != nil {
+		l = m.EpochFees.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *SponsoredFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochLastEnded != 0 {
+		n += 1 + sovTypes(uint64(m.EpochLastEnded))
+	}
+	l = m.Fees.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SimpleCursorPaginationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimpleCursorPaginationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimpleCursorPaginationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimpleCursorPaginationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimpleCursorPaginationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimpleCursorPaginationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextKey = append(m.NextKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextKey == nil {
+				m.NextKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListeningCoefficient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListeningCoefficient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListeningCoefficient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coefficient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coefficient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GmpAllowedSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GmpAllowedSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GmpAllowedSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GmpSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GmpSubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GmpSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePerMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePerMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GmpDelivery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowTypes
@@ -356,27 +1788,116 @@ func (m *SimpleCursorPaginationRequest) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GmpDelivery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GmpDelivery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionId", wireType)
+			}
+			m.SubscriptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubscriptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SimpleCursorPaginationRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SimpleCursorPaginationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -386,31 +1907,29 @@ func (m *SimpleCursorPaginationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			m.Limit = 0
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -420,11 +1939,45 @@ func (m *SimpleCursorPaginationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -446,7 +1999,7 @@ func (m *SimpleCursorPaginationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SimpleCursorPaginationResponse) Unmarshal(dAtA []byte) error {
+func (m *TopicSponsorship) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -469,17 +2022,36 @@ func (m *SimpleCursorPaginationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SimpleCursorPaginationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicSponsorship: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SimpleCursorPaginationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -489,24 +2061,160 @@ func (m *SimpleCursorPaginationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextKey = append(m.NextKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextKey == nil {
-				m.NextKey = []byte{}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeesPerActorPerEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFeesPerActorPerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeesPerEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFeesPerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EpochFees == nil {
+				m.EpochFees = &SponsoredFees{}
+			}
+			if err := m.EpochFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -530,7 +2238,7 @@ func (m *SimpleCursorPaginationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListeningCoefficient) Unmarshal(dAtA []byte) error {
+func (m *SponsoredFees) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -553,15 +2261,34 @@ func (m *ListeningCoefficient) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListeningCoefficient: wiretype end group for non-group")
+			return fmt.Errorf("proto: SponsoredFees: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListeningCoefficient: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SponsoredFees: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLastEnded", wireType)
+			}
+			m.EpochLastEnded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLastEnded |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coefficient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -589,7 +2316,7 @@ func (m *ListeningCoefficient) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coefficient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
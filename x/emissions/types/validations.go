@@ -1,7 +1,9 @@
 package types
 
 import (
+	"crypto/sha256"
 	"encoding/hex"
+	"strings"
 
 	"cosmossdk.io/errors"
 	cosmosMath "cosmossdk.io/math"
@@ -86,21 +88,17 @@ func ValidateTopicId(value TopicId) error {
 
 /// EMISSIONS TYPES PACKAGE VALIDATIONS
 
-// Validate performs basic genesis state validation returning an error upon any
+// Validate performs deep genesis state validation returning an error upon the first problem found,
+// see ValidationProblems for the list of all of them
 func (gs *GenesisState) Validate() error {
-	if err := gs.Params.Validate(); err != nil {
-		return err
+	problems := gs.ValidationProblems()
+	if len(problems) == 0 {
+		return nil
 	}
-
-	// Ensure that the core team addresses are valid
-	for _, addr := range gs.CoreTeamAddresses {
-		_, err := sdk.AccAddressFromBech32(addr)
-		if err != nil {
-			return err
-		}
+	if len(problems) > 1 {
+		return errors.Wrapf(problems[0], "first of %d genesis problems", len(problems))
 	}
-
-	return nil
+	return problems[0]
 }
 
 // validate that an inference follows the expected format
@@ -120,6 +118,14 @@ func (inference *Inference) Validate() error {
 	if err := ValidateDec(inference.Value); err != nil {
 		return errors.Wrap(err, "inference value is invalid")
 	}
+	if len(inference.Values) > MaxTopicValueDimension {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "inference cannot have more than %d values", MaxTopicValueDimension)
+	}
+	for _, value := range inference.Values {
+		if err := ValidateDec(value); err != nil {
+			return errors.Wrap(err, "inference value is invalid")
+		}
+	}
 	// ExtraData not validated as it is not used by the chain
 	// Proof not validated as it is not used by the chain
 	return nil
@@ -254,6 +260,11 @@ func (bundle *ValueBundle) Validate() error {
 	if err := ValidateDec(bundle.CombinedValue); err != nil {
 		return errors.Wrap(err, "value bundle combined value is invalid")
 	}
+	for _, value := range bundle.CombinedValues {
+		if err := ValidateDec(value); err != nil {
+			return errors.Wrap(err, "value bundle combined value is invalid")
+		}
+	}
 
 	// nil values for bundle.InfererValues are interpreted to mean that there
 	// are no inferer values for this bundle, and are allowed
@@ -274,6 +285,11 @@ func (bundle *ValueBundle) Validate() error {
 	if err := ValidateDec(bundle.NaiveValue); err != nil {
 		return errors.Wrap(err, "value bundle naive value is invalid")
 	}
+	for _, value := range bundle.NaiveValues {
+		if err := ValidateDec(value); err != nil {
+			return errors.Wrap(err, "value bundle naive value is invalid")
+		}
+	}
 
 	// nil values for bundle.OneOutInfererValues are interpreted to mean that there
 	// are no one out inferer values for this bundle, and are allowed
@@ -311,36 +327,51 @@ func (bundle *ValueBundle) Validate() error {
 
 // validate that a reputer value bundle follows the expected format
 func (bundle *ReputerValueBundle) Validate() error {
+	pubkey, err := bundle.validateWithoutSignature()
+	if err != nil {
+		return err
+	}
+
+	buf := reputerValueBundleBufferPool.Get()
+	defer reputerValueBundleBufferPool.Put(buf)
+	marshaled, err := bundle.ValueBundle.XXX_Marshal(buf, true)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to marshal value bundle: %s", err)
+	}
+	if !pubkey.VerifySignature(marshaled, bundle.Signature) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "signature verification failed")
+	}
+
+	return nil
+}
+
+// validate that a reputer value bundle follows the expected format, except for its signature.
+// Bundles filtered in CloseReputerNonce are stored with the signature of the unfiltered bundle (see PROTO-2369).
+func (bundle *ReputerValueBundle) ValidateWithoutSignature() error {
+	_, err := bundle.validateWithoutSignature()
+	return err
+}
+
+func (bundle *ReputerValueBundle) validateWithoutSignature() (secp256k1.PubKey, error) {
 	if bundle.ValueBundle == nil {
-		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "value bundle cannot be nil")
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "value bundle cannot be nil")
 	}
 	pk, err := hex.DecodeString(bundle.Pubkey)
 	if err != nil || len(pk) != secp256k1.PubKeySize {
-		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid pubkey %d", len(pk))
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid pubkey %d", len(pk))
 	}
 	pubkey := secp256k1.PubKey(pk)
 	pubKeyConvertedToAddress := sdk.AccAddress(pubkey.Address().Bytes()).String()
 
 	if bundle.ValueBundle.Reputer != pubKeyConvertedToAddress {
-		return errors.Wrapf(sdkerrors.ErrUnauthorized, "Reputer does not match pubkey")
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "Reputer does not match pubkey")
 	}
 
 	// validate the value bundle
 	if err := bundle.ValueBundle.Validate(); err != nil {
-		return errors.Wrap(err, "value bundle is invalid")
+		return nil, errors.Wrap(err, "value bundle is invalid")
 	}
-
-	buf := reputerValueBundleBufferPool.Get()
-	defer reputerValueBundleBufferPool.Put(buf)
-	marshaled, err := bundle.ValueBundle.XXX_Marshal(buf, true)
-	if err != nil {
-		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to marshal value bundle: %s", err)
-	}
-	if !pubkey.VerifySignature(marshaled, bundle.Signature) {
-		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "signature verification failed")
-	}
-
-	return nil
+	return pubkey, nil
 }
 
 // Validate checks if the given Topic is valid
@@ -384,6 +415,15 @@ func (topic Topic) Validate(params Params) error {
 	if topic.WorkerSubmissionWindow > topic.EpochLength {
 		return errors.Wrap(sdkerrors.ErrInvalidType, "topic worker submission window cannot be higher than epoch length")
 	}
+	if topic.WorkerRevealWindow < 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidType, "topic worker reveal window cannot be negative")
+	}
+	if topic.WorkerSubmissionWindow+topic.WorkerRevealWindow > topic.EpochLength {
+		return errors.Wrap(sdkerrors.ErrInvalidType, "topic worker submission and reveal windows cannot be longer than epoch length")
+	}
+	if topic.ValueDimension > MaxTopicValueDimension {
+		return errors.Wrapf(sdkerrors.ErrInvalidType, "topic value dimension cannot be higher than %d", MaxTopicValueDimension)
+	}
 	if err := ValidateDec(topic.AlphaRegret); err != nil {
 		return errors.Wrap(err, "topic alpha regret is invalid")
 	}
@@ -443,6 +483,11 @@ func (workerValue *WorkerAttributedValue) Validate() error {
 	if err := ValidateDec(workerValue.Value); err != nil {
 		return err
 	}
+	for _, value := range workerValue.Values {
+		if err := ValidateDec(value); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -457,6 +502,11 @@ func (withheldWorkerValue *WithheldWorkerAttributedValue) Validate() error {
 	if err := ValidateDec(withheldWorkerValue.Value); err != nil {
 		return err
 	}
+	for _, value := range withheldWorkerValue.Values {
+		if err := ValidateDec(value); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -490,6 +540,45 @@ func (bundle *ReputerValueBundles) Validate() error {
 	return nil
 }
 
+// validate that the reputer value bundles follow the expected format, except for their signatures,
+// as they are stored once filtered in CloseReputerNonce
+func (bundle *ReputerValueBundles) ValidateWithoutSignatures() error {
+	if bundle.ReputerValueBundles == nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidType, "reputer value bundles cannot be nil")
+	}
+	for i, reputerValueBundle := range bundle.ReputerValueBundles {
+		if err := reputerValueBundle.ValidateWithoutSignature(); err != nil {
+			return errors.Wrapf(err, "reputer value bundle at index %d is invalid", i)
+		}
+	}
+	return nil
+}
+
+// validate that a types.ArchivedNetworkInference has the expected format
+func (archived *ArchivedNetworkInference) Validate() error {
+	if err := ValidateTopicId(archived.TopicId); err != nil {
+		return errors.Wrap(err, "archived network inference topic id is invalid")
+	}
+	if err := ValidateBlockHeight(archived.BlockHeight); err != nil {
+		return errors.Wrap(err, "archived network inference block height is invalid")
+	}
+	decs := [][]alloraMath.Dec{
+		{archived.CombinedValue, archived.NaiveValue},
+		archived.CombinedValues,
+		archived.NaiveValues,
+		archived.ConfidenceIntervalRawPercentiles,
+		archived.ConfidenceIntervalValues,
+	}
+	for _, values := range decs {
+		for _, value := range values {
+			if err := ValidateDec(value); err != nil {
+				return errors.Wrap(err, "archived network inference value is invalid")
+			}
+		}
+	}
+	return nil
+}
+
 // validate that a types.Nonce has the expected format
 func (nonce *Nonce) Validate() error {
 	if err := ValidateBlockHeight(nonce.BlockHeight); err != nil {
@@ -730,6 +819,31 @@ func (msg *RewardDelegateStakeRequest) Validate() error {
 	return stakeValidateHelper([]string{msg.Sender, msg.Reputer}, cosmosMath.ZeroInt(), true)
 }
 
+// validate that a set delegate stake auto-compound request follows the expected format
+func (msg *SetDelegateStakeAutoCompoundRequest) Validate() error {
+	return stakeValidateHelper([]string{msg.Sender, msg.Reputer}, cosmosMath.ZeroInt(), true)
+}
+
+// validate that a redelegate stake request follows the expected format
+func (msg *RedelegateStakeRequest) Validate() error {
+	if msg.DstReputer == msg.Sender {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "cannot self delegate")
+	}
+	if msg.SrcTopicId == msg.DstTopicId && msg.SrcReputer == msg.DstReputer {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "cannot redelegate to the source topic and reputer")
+	}
+
+	return stakeValidateHelper([]string{msg.Sender, msg.SrcReputer, msg.DstReputer}, msg.Amount, false)
+}
+
+// validate that a claim rewards request follows the expected format
+func (msg *ClaimRewardsRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid sender")
+	}
+	return nil
+}
+
 // validate that a cancel remove stake request follows the expected format
 func (msg *CancelRemoveStakeRequest) Validate() error {
 	return stakeValidateHelper([]string{msg.Sender}, cosmosMath.ZeroInt(), true)
@@ -756,6 +870,15 @@ func (msg *CreateNewTopicRequest) Validate(maxStringLen uint64) error {
 	if msg.WorkerSubmissionWindow > msg.EpochLength {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "worker submission window cannot be higher than epoch length")
 	}
+	if msg.WorkerRevealWindow < 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "worker reveal window cannot be negative")
+	}
+	if msg.WorkerSubmissionWindow+msg.WorkerRevealWindow > msg.EpochLength {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "worker submission and reveal windows cannot be longer than epoch length")
+	}
+	if msg.ValueDimension > MaxTopicValueDimension {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "value dimension cannot be higher than %d", MaxTopicValueDimension)
+	}
 	if msg.AlphaRegret.Lte(alloraMath.ZeroDec()) || msg.AlphaRegret.Gt(alloraMath.OneDec()) || ValidateDec(msg.AlphaRegret) != nil {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "alpha regret must be greater than 0 and less than or equal to 1")
 	}
@@ -787,3 +910,434 @@ func (msg *CreateNewTopicRequest) Validate(maxStringLen uint64) error {
 
 	return nil
 }
+
+// validate that an update topic request follows the expected format
+// the resulting topic is validated against the module params by the keeper
+func (msg *UpdateTopicRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if err := ValidateTopicId(msg.TopicId); err != nil {
+		return errors.Wrap(err, "invalid topic id")
+	}
+	if msg.Params == nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "params cannot be nil")
+	}
+	return msg.Params.Validate()
+}
+
+// every option is a repeated field with at most one element
+func (p *OptionalTopicParams) Validate() error {
+	if len(p.EpochLength) > 1 || len(p.GroundTruthLag) > 1 || len(p.WorkerSubmissionWindow) > 1 ||
+		len(p.PNorm) > 1 || len(p.AlphaRegret) > 1 || len(p.Epsilon) > 1 || len(p.MeritSortitionAlpha) > 1 ||
+		len(p.ActiveInfererQuantile) > 1 || len(p.ActiveForecasterQuantile) > 1 || len(p.ActiveReputerQuantile) > 1 ||
+		len(p.WorkerRevealWindow) > 1 || len(p.ArchiveNetworkInferences) > 1 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "optional topic params must have at most one value per field")
+	}
+	decs := [][]alloraMath.Dec{
+		p.PNorm,
+		p.AlphaRegret,
+		p.Epsilon,
+		p.MeritSortitionAlpha,
+		p.ActiveInfererQuantile,
+		p.ActiveForecasterQuantile,
+		p.ActiveReputerQuantile,
+	}
+	for _, dec := range decs {
+		for _, d := range dec {
+			if err := ValidateDec(d); err != nil {
+				return errors.Wrap(err, "optional topic param invalid")
+			}
+		}
+	}
+	return nil
+}
+
+func (update *ScheduledTopicUpdate) Validate() error {
+	if err := ValidateTopicId(update.TopicId); err != nil {
+		return errors.Wrap(err, "scheduled topic update topic id invalid")
+	}
+	if err := ValidateBlockHeight(update.EffectiveBlockHeight); err != nil {
+		return errors.Wrap(err, "scheduled topic update effective block height invalid")
+	}
+	if update.Params == nil {
+		return errors.Wrap(sdkerrors.ErrInvalidType, "scheduled topic update params cannot be nil")
+	}
+	return update.Params.Validate()
+}
+
+func (msg *RetireTopicRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if err := ValidateTopicId(msg.TopicId); err != nil {
+		return errors.Wrap(err, "invalid topic id")
+	}
+	return nil
+}
+
+func (retirement *TopicRetirement) Validate() error {
+	if retirement.Topic == nil {
+		return errors.Wrap(sdkerrors.ErrInvalidType, "topic retirement topic cannot be nil")
+	}
+	if err := ValidateTopicId(retirement.Topic.Id); err != nil {
+		return errors.Wrap(err, "topic retirement topic id invalid")
+	}
+	if err := ValidateBech32(retirement.RetiredBy); err != nil {
+		return errors.Wrap(err, "topic retirement retired by address invalid")
+	}
+	if err := ValidateBlockHeight(retirement.RetiredAtBlock); err != nil {
+		return errors.Wrap(err, "topic retirement retired at block invalid")
+	}
+	if retirement.Stage <= TopicRetirementStage_TOPIC_RETIREMENT_STAGE_UNSPECIFIED ||
+		retirement.Stage > TopicRetirementStage_TOPIC_RETIREMENT_STAGE_COMPLETE {
+		return errors.Wrapf(sdkerrors.ErrInvalidType, "topic retirement stage invalid: %d", retirement.Stage)
+	}
+	for _, cursor := range []string{
+		retirement.LastRefundedReputer,
+		retirement.LastRefundedDelegator,
+		retirement.LastRefundedDelegatorReputer,
+	} {
+		if cursor == "" {
+			continue
+		}
+		if err := ValidateBech32(cursor); err != nil {
+			return errors.Wrap(err, "topic retirement refund cursor invalid")
+		}
+	}
+	if retirement.RefundsCompleteBlock < 0 || retirement.CompletedAtBlock < 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidType, "topic retirement block heights cannot be negative")
+	}
+	return nil
+}
+
+func (source *GmpAllowedSource) Validate() error {
+	if strings.TrimSpace(source.SourceChain) == "" {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "gmp source chain cannot be empty")
+	}
+	if strings.TrimSpace(source.SourceAddress) == "" {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "gmp source address cannot be empty")
+	}
+	return nil
+}
+
+func (msg *AddGmpAllowedSourceRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	source := GmpAllowedSource{SourceChain: msg.SourceChain, SourceAddress: msg.SourceAddress}
+	return source.Validate()
+}
+
+func (msg *RemoveGmpAllowedSourceRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	source := GmpAllowedSource{SourceChain: msg.SourceChain, SourceAddress: msg.SourceAddress}
+	return source.Validate()
+}
+
+func (subscription *GmpSubscription) Validate() error {
+	if err := ValidateTopicId(subscription.TopicId); err != nil {
+		return errors.Wrap(err, "gmp subscription topic id invalid")
+	}
+	if err := ValidateBech32(subscription.Subscriber); err != nil {
+		return errors.Wrap(err, "gmp subscription subscriber invalid")
+	}
+	if err := validateGmpDestination(subscription.DestinationChain, subscription.DestinationAddress); err != nil {
+		return err
+	}
+	if subscription.FeePerMessage.IsNil() || !subscription.FeePerMessage.IsPositive() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "gmp subscription fee per message must be positive")
+	}
+	if subscription.Balance.IsNil() || subscription.Balance.IsNegative() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "gmp subscription balance cannot be negative")
+	}
+	return nil
+}
+
+func (delivery *GmpDelivery) Validate() error {
+	if err := ValidateTopicId(delivery.TopicId); err != nil {
+		return errors.Wrap(err, "gmp delivery topic id invalid")
+	}
+	if err := ValidateBech32(delivery.Subscriber); err != nil {
+		return errors.Wrap(err, "gmp delivery subscriber invalid")
+	}
+	if err := ValidateBlockHeight(delivery.BlockHeight); err != nil {
+		return errors.Wrap(err, "gmp delivery block height invalid")
+	}
+	if strings.TrimSpace(delivery.ChannelId) == "" {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "gmp delivery channel id cannot be empty")
+	}
+	if delivery.Fee.IsNil() || delivery.Fee.IsNegative() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "gmp delivery fee cannot be negative")
+	}
+	return nil
+}
+
+func validateGmpDestination(destinationChain, destinationAddress string) error {
+	if strings.TrimSpace(destinationChain) == "" {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "gmp destination chain cannot be empty")
+	}
+	if strings.TrimSpace(destinationAddress) == "" {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "gmp destination address cannot be empty")
+	}
+	return nil
+}
+
+func (msg *CreateGmpSubscriptionRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if err := ValidateTopicId(msg.TopicId); err != nil {
+		return errors.Wrap(err, "invalid topic id")
+	}
+	if err := validateGmpDestination(msg.DestinationChain, msg.DestinationAddress); err != nil {
+		return err
+	}
+	if msg.FeePerMessage.IsNil() || !msg.FeePerMessage.IsPositive() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "fee per message must be positive")
+	}
+	if msg.Prepayment.IsNil() || msg.Prepayment.IsNegative() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "prepayment cannot be negative")
+	}
+	return nil
+}
+
+func (msg *FundGmpSubscriptionRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
+	}
+	return nil
+}
+
+func (msg *CancelGmpSubscriptionRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	return nil
+}
+
+func (msg *SetTopicAllowlistEnabledRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if err := ValidateTopicId(msg.TopicId); err != nil {
+		return errors.Wrap(err, "invalid topic id")
+	}
+	return nil
+}
+
+func (msg *AddToTopicAllowlistRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if err := ValidateTopicId(msg.TopicId); err != nil {
+		return errors.Wrap(err, "invalid topic id")
+	}
+	if err := ValidateBech32(msg.Address); err != nil {
+		return errors.Wrap(err, "invalid allowlist address")
+	}
+	return nil
+}
+
+func (msg *RemoveFromTopicAllowlistRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if err := ValidateTopicId(msg.TopicId); err != nil {
+		return errors.Wrap(err, "invalid topic id")
+	}
+	if err := ValidateBech32(msg.Address); err != nil {
+		return errors.Wrap(err, "invalid allowlist address")
+	}
+	return nil
+}
+
+func (msg *UnjailReputerRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if err := ValidateTopicId(msg.TopicId); err != nil {
+		return errors.Wrap(err, "invalid topic id")
+	}
+	return nil
+}
+
+func (msg *CommitWorkerPayloadRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if err := ValidateTopicId(msg.TopicId); err != nil {
+		return errors.Wrap(err, "invalid topic id")
+	}
+	if err := ValidateBlockHeight(msg.NonceBlockHeight); err != nil {
+		return errors.Wrap(err, "invalid nonce block height")
+	}
+	if len(msg.Commitment) != sha256.Size {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "commitment must be a %d byte sha256 hash", sha256.Size)
+	}
+	return nil
+}
+
+func (msg *RevealWorkerPayloadRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if len(msg.Salt) == 0 || len(msg.Salt) > MaxWorkerCommitmentSaltLength {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "salt must be between 1 and %d bytes", MaxWorkerCommitmentSaltLength)
+	}
+	return msg.WorkerDataBundle.Validate()
+}
+
+// Payloads are validated one by one when processed, so that invalid ones do not reject the batch
+func (msg *InsertWorkerPayloadsRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if len(msg.WorkerDataBundles) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "batch must contain at least one worker payload")
+	}
+	return nil
+}
+
+// Payloads are validated one by one when processed, so that invalid ones do not reject the batch
+func (msg *InsertReputerPayloadsRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if len(msg.ReputerValueBundles) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "batch must contain at least one reputer payload")
+	}
+	return nil
+}
+
+func (commitment *WorkerCommitment) Validate() error {
+	if len(commitment.Commitment) != sha256.Size {
+		return errors.Wrapf(sdkerrors.ErrInvalidType, "worker commitment must be a %d byte sha256 hash", sha256.Size)
+	}
+	return nil
+}
+
+func (msg *SubmitGroundTruthRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if err := ValidateTopicId(msg.TopicId); err != nil {
+		return errors.Wrap(err, "invalid topic id")
+	}
+	if err := ValidateBlockHeight(msg.ReputerNonceBlockHeight); err != nil {
+		return errors.Wrap(err, "invalid reputer nonce block height")
+	}
+	if err := ValidateDec(msg.GroundTruth); err != nil {
+		return errors.Wrap(err, "invalid ground truth")
+	}
+	return nil
+}
+
+func (sponsorship *TopicSponsorship) Validate() error {
+	if err := ValidateTopicId(sponsorship.TopicId); err != nil {
+		return errors.Wrap(err, "topic sponsorship topic id invalid")
+	}
+	if err := ValidateBech32(sponsorship.Sponsor); err != nil {
+		return errors.Wrap(err, "topic sponsorship sponsor invalid")
+	}
+	if sponsorship.Balance.IsNil() || sponsorship.Balance.IsNegative() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "topic sponsorship balance cannot be negative")
+	}
+	if err := validateSponsorshipCaps(sponsorship.MaxFeesPerActorPerEpoch, sponsorship.MaxFeesPerEpoch); err != nil {
+		return err
+	}
+	if sponsorship.EpochFees == nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "topic sponsorship epoch fees cannot be nil")
+	}
+	return sponsorship.EpochFees.Validate()
+}
+
+func (fees *SponsoredFees) Validate() error {
+	if fees.EpochLastEnded < 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "sponsored fees epoch last ended cannot be negative")
+	}
+	if fees.Fees.IsNil() || fees.Fees.IsNegative() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "sponsored fees cannot be negative")
+	}
+	return nil
+}
+
+// Caps of zero mean no cap
+func validateSponsorshipCaps(maxFeesPerActorPerEpoch, maxFeesPerEpoch cosmosMath.Int) error {
+	if maxFeesPerActorPerEpoch.IsNil() || maxFeesPerActorPerEpoch.IsNegative() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "max fees per actor per epoch cannot be negative")
+	}
+	if maxFeesPerEpoch.IsNil() || maxFeesPerEpoch.IsNegative() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "max fees per epoch cannot be negative")
+	}
+	return nil
+}
+
+func (msg *FundTopicSponsorshipRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if err := ValidateTopicId(msg.TopicId); err != nil {
+		return errors.Wrap(err, "invalid topic id")
+	}
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
+	}
+	return validateSponsorshipCaps(msg.MaxFeesPerActorPerEpoch, msg.MaxFeesPerEpoch)
+}
+
+func (msg *WithdrawTopicSponsorshipRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if err := ValidateTopicId(msg.TopicId); err != nil {
+		return errors.Wrap(err, "invalid topic id")
+	}
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
+	}
+	return nil
+}
+
+func (delegation *TopicIdDelegatorReputer) Validate() error {
+	if err := ValidateTopicId(delegation.TopicId); err != nil {
+		return errors.Wrap(err, "delegation topic id invalid")
+	}
+	if err := ValidateBech32(delegation.Delegator); err != nil {
+		return errors.Wrap(err, "delegation delegator invalid")
+	}
+	if err := ValidateBech32(delegation.Reputer); err != nil {
+		return errors.Wrap(err, "delegation reputer invalid")
+	}
+	return nil
+}
+
+func (redelegation *Redelegation) Validate() error {
+	if err := ValidateTopicId(redelegation.SrcTopicId); err != nil {
+		return errors.Wrap(err, "redelegation source topic id invalid")
+	}
+	if err := ValidateTopicId(redelegation.DstTopicId); err != nil {
+		return errors.Wrap(err, "redelegation destination topic id invalid")
+	}
+	if err := ValidateBech32(redelegation.Delegator); err != nil {
+		return errors.Wrap(err, "redelegation delegator invalid")
+	}
+	if err := ValidateBech32(redelegation.SrcReputer); err != nil {
+		return errors.Wrap(err, "redelegation source reputer invalid")
+	}
+	if err := ValidateBech32(redelegation.DstReputer); err != nil {
+		return errors.Wrap(err, "redelegation destination reputer invalid")
+	}
+	if redelegation.Amount.IsNil() || redelegation.Amount.IsNegative() {
+		return errors.Wrap(ErrInvalidValue, "redelegation amount cannot be negative")
+	}
+	if redelegation.BlockRedelegationCompleted < redelegation.BlockRedelegated {
+		return errors.Wrap(ErrInvalidValue, "redelegation cannot complete before it started")
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+)
+
+// Upper bound on the salt a worker mixes into its payload commitment
+const MaxWorkerCommitmentSaltLength = 64
+
+// Returns the commitment to an inference forecast bundle revealed with the given salt:
+// the sha256 hash of the serialized bundle, as signed by the worker, followed by the salt.
+func ComputeWorkerPayloadCommitment(bundle *InferenceForecastBundle, salt []byte) ([]byte, error) {
+	buf := inferenceForecastsBundleBufferPool.Get()
+	defer inferenceForecastsBundleBufferPool.Put(buf)
+	marshaled, err := bundle.XXX_Marshal(buf, true)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.New()
+	hash.Write(marshaled)
+	hash.Write(salt)
+	return hash.Sum(nil), nil
+}
+
+// Upper bound on the number of values making up each inference of a vector-valued topic
+const MaxTopicValueDimension = 256
+
+// Returns the number of values making up each inference of the topic, one for scalar topics
+func (topic Topic) InferenceDimension() int {
+	if topic.ValueDimension == 0 {
+		return 1
+	}
+	return int(topic.ValueDimension)
+}
+
+// Returns the values of the inference, a single one for inferences of scalar topics
+func (inference *Inference) ValueVector() []alloraMath.Dec {
+	return valueVector(inference.Value, inference.Values)
+}
+
+// Returns the values attributed to the worker, a single one for scalar topics
+func (workerValue *WorkerAttributedValue) ValueVector() []alloraMath.Dec {
+	return valueVector(workerValue.Value, workerValue.Values)
+}
+
+// Returns the values attributed to the withheld worker, a single one for scalar topics
+func (withheldWorkerValue *WithheldWorkerAttributedValue) ValueVector() []alloraMath.Dec {
+	return valueVector(withheldWorkerValue.Value, withheldWorkerValue.Values)
+}
+
+// Returns the combined values of the bundle, a single one for scalar topics
+func (bundle *ValueBundle) CombinedValueVector() []alloraMath.Dec {
+	return valueVector(bundle.CombinedValue, bundle.CombinedValues)
+}
+
+// Returns the naive values of the bundle, a single one for scalar topics
+func (bundle *ValueBundle) NaiveValueVector() []alloraMath.Dec {
+	return valueVector(bundle.NaiveValue, bundle.NaiveValues)
+}
+
+func valueVector(value alloraMath.Dec, values []alloraMath.Dec) []alloraMath.Dec {
+	if len(values) > 0 {
+		return values
+	}
+	return []alloraMath.Dec{value}
+}
+
+// Checks that an inference carries as many values as the topic value dimension.
+// Inferences of scalar topics carry their value in Value, those of vector-valued topics in Values.
+func ValidateInferenceDimension(topic Topic, inference *Inference) error {
+	dimension := topic.InferenceDimension()
+	if dimension == 1 {
+		if len(inference.Values) > 0 {
+			return errors.Wrapf(ErrInvalidInferenceDimension, "topic %d expects a scalar value, got %d values", topic.Id, len(inference.Values))
+		}
+		return nil
+	}
+	if len(inference.Values) != dimension {
+		return errors.Wrapf(ErrInvalidInferenceDimension, "topic %d expects %d values, got %d", topic.Id, dimension, len(inference.Values))
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package gmp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+)
+
+// abiWordSize is the size in bytes of a single Solidity ABI word
+const abiWordSize = 32
+
+// AbiType is a Solidity ABI type supported by the minimal codec used for GMP payloads
+type AbiType int
+
+const (
+	AbiUint256 AbiType = iota
+	AbiString
+	AbiBytes
+)
+
+// maxUint256 is the largest value representable in a single ABI word
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// DecodeAbi decodes data laid out as the Solidity ABI encoding of a tuple of the given types.
+// Uint256 values are returned as *big.Int, strings as string and bytes as []byte.
+func DecodeAbi(data []byte, types []AbiType) ([]interface{}, error) {
+	if len(data) < len(types)*abiWordSize {
+		return nil, fmt.Errorf("abi data too short: %d bytes for %d values", len(data), len(types))
+	}
+	values := make([]interface{}, 0, len(types))
+	for i, t := range types {
+		head := data[i*abiWordSize : (i+1)*abiWordSize]
+		switch t {
+		case AbiUint256:
+			values = append(values, new(big.Int).SetBytes(head))
+		case AbiString, AbiBytes:
+			raw, err := decodeAbiDynamicBytes(data, head)
+			if err != nil {
+				return nil, fmt.Errorf("abi value %d: %w", i, err)
+			}
+			if t == AbiString {
+				values = append(values, string(raw))
+			} else {
+				values = append(values, raw)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported abi type: %d", t)
+		}
+	}
+	return values, nil
+}
+
+// decodeAbiDynamicBytes follows the offset stored in a head word to the length-prefixed tail
+func decodeAbiDynamicBytes(data []byte, head []byte) ([]byte, error) {
+	offset, err := abiWordToLength(head)
+	if err != nil {
+		return nil, fmt.Errorf("invalid offset: %w", err)
+	}
+	if offset > uint64(len(data)) || uint64(len(data))-offset < abiWordSize {
+		return nil, fmt.Errorf("offset %d out of range", offset)
+	}
+	length, err := abiWordToLength(data[offset : offset+abiWordSize])
+	if err != nil {
+		return nil, fmt.Errorf("invalid length: %w", err)
+	}
+	start := offset + abiWordSize
+	if length > uint64(len(data))-start {
+		return nil, fmt.Errorf("length %d out of range", length)
+	}
+	return data[start : start+length], nil
+}
+
+// abiWordToLength reads a word that must fit into a uint64, as offsets and lengths do
+func abiWordToLength(word []byte) (uint64, error) {
+	for _, b := range word[:abiWordSize-8] {
+		if b != 0 {
+			return 0, fmt.Errorf("value exceeds uint64")
+		}
+	}
+	return binary.BigEndian.Uint64(word[abiWordSize-8:]), nil
+}
+
+// EncodeAbi encodes the given values as a Solidity ABI tuple.
+// Supported values are uint64, *big.Int (as uint256), string and []byte.
+func EncodeAbi(values ...interface{}) ([]byte, error) {
+	head := make([]byte, 0, len(values)*abiWordSize)
+	tail := make([]byte, 0)
+	for i, value := range values {
+		switch v := value.(type) {
+		case uint64:
+			head = append(head, abiUint64Word(v)...)
+		case *big.Int:
+			if v == nil || v.Sign() < 0 || v.Cmp(maxUint256) > 0 {
+				return nil, fmt.Errorf("abi value %d: uint256 out of range", i)
+			}
+			word := make([]byte, abiWordSize)
+			v.FillBytes(word)
+			head = append(head, word...)
+		case string:
+			head = append(head, abiUint64Word(uint64(len(values)*abiWordSize+len(tail)))...)
+			tail = append(tail, abiEncodeDynamicBytes([]byte(v))...)
+		case []byte:
+			head = append(head, abiUint64Word(uint64(len(values)*abiWordSize+len(tail)))...)
+			tail = append(tail, abiEncodeDynamicBytes(v)...)
+		default:
+			return nil, fmt.Errorf("abi value %d: unsupported type %T", i, value)
+		}
+	}
+	return append(head, tail...), nil
+}
+
+func abiUint64Word(v uint64) []byte {
+	word := make([]byte, abiWordSize)
+	binary.BigEndian.PutUint64(word[abiWordSize-8:], v)
+	return word
+}
+
+// abiEncodeDynamicBytes returns the length word followed by the right-padded contents
+func abiEncodeDynamicBytes(b []byte) []byte {
+	padded := (len(b) + abiWordSize - 1) / abiWordSize * abiWordSize
+	out := make([]byte, abiWordSize+padded)
+	binary.BigEndian.PutUint64(out[abiWordSize-8:abiWordSize], uint64(len(b)))
+	copy(out[abiWordSize:], b)
+	return out
+}
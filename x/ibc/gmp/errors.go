@@ -0,0 +1,13 @@
+package gmp
+
+import "cosmossdk.io/errors"
+
+var (
+	ErrUnauthorizedSender      = errors.Register(ModuleName, 2, "GMP packet sender is not the Axelar GMP account")
+	ErrSourceNotAllowed        = errors.Register(ModuleName, 3, "GMP source chain and address are not allowed")
+	ErrInvalidPayload          = errors.Register(ModuleName, 4, "invalid GMP payload")
+	ErrUnknownAction           = errors.Register(ModuleName, 5, "unknown GMP action")
+	ErrUnrecognizedMessageType = errors.Register(ModuleName, 6, "unrecognized GMP message type")
+	ErrInvalidPacketData       = errors.Register(ModuleName, 7, "invalid ICS-20 packet data")
+	ErrUnauthorizedChannel     = errors.Register(ModuleName, 8, "GMP packet was not received on the channel to Axelar")
+)
@@ -2,10 +2,12 @@ package gmp
 
 import (
 	"encoding/json"
-	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
@@ -13,12 +15,20 @@ import (
 )
 
 type IBCMiddleware struct {
-	app porttypes.IBCModule
+	app                porttypes.IBCModule
+	emissionsKeeper    EmissionsKeeper
+	emissionsMsgServer emissionstypes.MsgServiceServer
 }
 
-func NewIBCMiddleware(app porttypes.IBCModule) IBCMiddleware {
+func NewIBCMiddleware(
+	app porttypes.IBCModule,
+	emissionsKeeper EmissionsKeeper,
+	emissionsMsgServer emissionstypes.MsgServiceServer,
+) IBCMiddleware {
 	return IBCMiddleware{
-		app: app,
+		app:                app,
+		emissionsKeeper:    emissionsKeeper,
+		emissionsMsgServer: emissionsMsgServer,
 	}
 }
 
@@ -89,7 +99,12 @@ func (im IBCMiddleware) OnChanCloseConfirm(
 	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
 }
 
-// OnRecvPacket implements the IBCMiddleware interface
+// OnRecvPacket implements the IBCMiddleware interface.
+// Transfers without a GMP memo are passed straight to the underlying transfer app. GMP messages must
+// come from the Axelar GMP account over the channel to Axelar set in the emissions params, and from
+// an allowed source; their payload is decoded into an emissions
+// action that is executed by the source's derived account once the transfer itself has succeeded.
+// Any failure returns an error acknowledgement, which reverts the transfer as well.
 func (im IBCMiddleware) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -97,72 +112,192 @@ func (im IBCMiddleware) OnRecvPacket(
 ) ibcexported.Acknowledgement {
 	var data transfertypes.FungibleTokenPacketData
 	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
-		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("cannot unmarshal ICS-20 transfer packet data"))
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(ErrInvalidPacketData, "cannot unmarshal ICS-20 transfer packet data"))
 	}
 
 	var msg Message
-	_ = json.Unmarshal([]byte(data.GetMemo()), &msg)
-
-	if len(msg.Payload) != 0 {
-		logger := ctx.Logger().With("handler", "GMP")
-
-		switch msg.Type {
-		case TypeGeneralMessage:
-			logger.Info("Received TypeGeneralMessage",
-				"srcChain", msg.SourceChain,
-				"srcAddress", msg.SourceAddress,
-				"receiver", data.Receiver,
-				"payload", string(msg.Payload),
-				"handler", "GMP",
-			)
-			// let the next layer deal with this
-			// the rest of the data fields should be normal
-			fallthrough
-		case TypeGeneralMessageWithToken:
-			logger.Info("Received TypeGeneralMessageWithToken",
-				"srcChain", msg.SourceChain,
-				"srcAddress", msg.SourceAddress,
-				"receiver", data.Receiver,
-				"payload", string(msg.Payload),
-				"coin", data.Denom,
-				"amount", data.Amount,
-				"handler", "GMP",
-			)
-			// we throw out the rest of the msg.Payload fields here, for better or worse
-			data.Memo = string(msg.Payload)
-			var dataBytes []byte
-			if _, err := transfertypes.ModuleCdc.MarshalJSON(&data); err != nil {
-				return channeltypes.NewErrorAcknowledgement(fmt.Errorf("cannot marshal ICS-20 post-processed transfer packet data"))
-			}
-			packet.Data = dataBytes
-			return im.app.OnRecvPacket(ctx, packet, relayer)
-		default:
-			return channeltypes.NewErrorAcknowledgement(fmt.Errorf("unrecognized mesasge type: %d", msg.Type))
-		}
-	}
-
-	return im.appOnRecvPacket()
+	if err := json.Unmarshal([]byte(data.GetMemo()), &msg); err != nil || len(msg.Payload) == 0 {
+		// not a GMP message, handle as a plain transfer
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	logger := ctx.Logger().With("handler", "GMP")
+
+	// any chain may claim to be sent by the Axelar GMP account, only the channel to Axelar is trusted
+	if err := im.checkAxelarChannel(ctx, packet); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	if data.Sender != AxelarGMPAcc {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(ErrUnauthorizedSender, "sender %s", data.Sender))
+	}
+	isAllowed, err := im.emissionsKeeper.IsGmpSourceAllowed(ctx, msg.SourceChain, msg.SourceAddress)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	if !isAllowed {
+		return channeltypes.NewErrorAcknowledgement(
+			errorsmod.Wrapf(ErrSourceNotAllowed, "source chain %s, source address %s", msg.SourceChain, msg.SourceAddress))
+	}
+
+	action, err := ParseActionPayload(msg.Payload)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	actor := DeriveSourceAddress(msg.SourceChain, msg.SourceAddress)
+
+	switch msg.Type {
+	case TypeGeneralMessage:
+		logger.Info("Received TypeGeneralMessage",
+			"srcChain", msg.SourceChain,
+			"srcAddress", msg.SourceAddress,
+			"receiver", data.Receiver,
+			"action", action.Action,
+			"topicId", action.TopicId,
+		)
+	case TypeGeneralMessageWithToken:
+		logger.Info("Received TypeGeneralMessageWithToken",
+			"srcChain", msg.SourceChain,
+			"srcAddress", msg.SourceAddress,
+			"receiver", actor.String(),
+			"action", action.Action,
+			"topicId", action.TopicId,
+			"coin", data.Denom,
+			"amount", data.Amount,
+		)
+		// credit the tokens to the account acting for the source so the action can spend them
+		data.Receiver = actor.String()
+	default:
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(ErrUnrecognizedMessageType, "%d", msg.Type))
+	}
+
+	// the payload is consumed here, so the transfer app receives the packet without a memo
+	data.Memo = ""
+	dataBytes, err := transfertypes.ModuleCdc.MarshalJSON(&data)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(ErrInvalidPacketData, "cannot marshal ICS-20 post-processed transfer packet data"))
+	}
+	packet.Data = dataBytes
+
+	ack := im.app.OnRecvPacket(ctx, packet, relayer)
+	if !ack.Success() {
+		return ack
+	}
+
+	if err := im.executeAction(ctx, actor.String(), action); err != nil {
+		logger.Error("Failed to execute GMP action", "action", action.Action, "topicId", action.TopicId, "error", err)
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return ack
 }
 
-// OnAcknowledgementPacket implements the IBCMiddleware interface
+// checkAxelarChannel fails unless the packet was received on the channel to Axelar from its transfer port
+func (im IBCMiddleware) checkAxelarChannel(ctx sdk.Context, packet channeltypes.Packet) error {
+	moduleParams, err := im.emissionsKeeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if moduleParams.GmpAxelarChannelId == "" {
+		return errorsmod.Wrap(ErrUnauthorizedChannel, "no channel to Axelar is set")
+	}
+	if packet.DestinationPort != transfertypes.PortID ||
+		packet.DestinationChannel != moduleParams.GmpAxelarChannelId ||
+		packet.SourcePort != transfertypes.PortID ||
+		packet.SourceChannel != moduleParams.GmpAxelarCounterpartyChannelId {
+		return errorsmod.Wrapf(ErrUnauthorizedChannel, "received on %s/%s from %s/%s",
+			packet.DestinationPort, packet.DestinationChannel, packet.SourcePort, packet.SourceChannel)
+	}
+	return nil
+}
+
+// executeAction runs an emissions action through the emissions msg server with the actor as sender
+func (im IBCMiddleware) executeAction(ctx sdk.Context, actor string, action Action) error {
+	var err error
+	switch action.Action {
+	case ActionFundTopic:
+		_, err = im.emissionsMsgServer.FundTopic(ctx, &emissionstypes.FundTopicRequest{
+			Sender:  actor,
+			TopicId: action.TopicId,
+			Amount:  action.Amount,
+		})
+	case ActionDelegateStake:
+		_, err = im.emissionsMsgServer.DelegateStake(ctx, &emissionstypes.DelegateStakeRequest{
+			Sender:  actor,
+			TopicId: action.TopicId,
+			Reputer: action.Target,
+			Amount:  action.Amount,
+		})
+	case ActionRegisterWorker:
+		// the actor itself is registered, only its owner is taken from the payload
+		_, err = im.emissionsMsgServer.Register(ctx, &emissionstypes.RegisterRequest{
+			Sender:    actor,
+			TopicId:   action.TopicId,
+			Owner:     action.Target,
+			IsReputer: false,
+		})
+	default:
+		err = errorsmod.Wrapf(ErrUnknownAction, "%q", action.Action)
+	}
+	return err
+}
+
+// OnAcknowledgementPacket implements the IBCMiddleware interface.
+// Once the transfer app has processed the acknowledgement, an outbound GMP message sent for a
+// subscription is settled, refunding its fee to the subscription unless it succeeded.
 func (im IBCMiddleware) OnAcknowledgementPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
-	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+	status := emissionstypes.GmpDeliveryStatusFailed
+	if ackSucceeded(acknowledgement) {
+		status = emissionstypes.GmpDeliveryStatusAcknowledged
+	}
+	im.resolveGmpDelivery(ctx, packet, status)
+	return nil
 }
 
-// OnTimeoutPacket implements the IBCMiddleware interface
+// OnTimeoutPacket implements the IBCMiddleware interface.
+// Once the transfer app has refunded the timed out transfer, an outbound GMP message sent for a
+// subscription is settled, refunding its fee to the subscription.
 func (im IBCMiddleware) OnTimeoutPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
-	return im.app.OnTimeoutPacket(ctx, packet, relayer)
+	if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+	im.resolveGmpDelivery(ctx, packet, emissionstypes.GmpDeliveryStatusTimedOut)
+	return nil
+}
+
+// resolveGmpDelivery only logs failures: returning an error would revert the refund of the transfer itself
+func (im IBCMiddleware) resolveGmpDelivery(ctx sdk.Context, packet channeltypes.Packet, status string) {
+	err := im.emissionsKeeper.ResolveGmpDelivery(ctx, packet.SourceChannel, packet.Sequence, status)
+	if err != nil {
+		ctx.Logger().With("handler", "GMP").Error("Failed to resolve GMP delivery",
+			"channel", packet.SourceChannel,
+			"sequence", packet.Sequence,
+			"status", status,
+			"error", err,
+		)
+	}
 }
 
-func (im IBCMiddleware) appOnRecvPacket() ibcexported.Acknowledgement {
-	return channeltypes.NewResultAcknowledgement([]byte("success"))
+// ackSucceeded reports whether an ICS20 acknowledgement is a success.
+// On fee enabled channels the acknowledgement reaching this middleware is still wrapped by the fee middleware.
+func ackSucceeded(acknowledgement []byte) bool {
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err == nil {
+		return ack.Success()
+	}
+	var feeAck ibcfeetypes.IncentivizedAcknowledgement
+	if err := ibcfeetypes.ModuleCdc.UnmarshalJSON(acknowledgement, &feeAck); err == nil {
+		return feeAck.UnderlyingAppSuccess
+	}
+	return false
 }
@@ -0,0 +1,157 @@
+package gmp_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/log"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/allora-network/allora-chain/x/ibc/gmp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	axelarChannel             = "channel-3"
+	axelarCounterpartyChannel = "channel-70"
+)
+
+// Acknowledges every packet, recording those it receives
+type fakeTransferApp struct {
+	porttypes.IBCModule
+	received []channeltypes.Packet
+}
+
+func (app *fakeTransferApp) OnRecvPacket(_ sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	app.received = append(app.received, packet)
+	return channeltypes.NewResultAcknowledgement([]byte{1})
+}
+
+// Allows every source
+type fakeEmissionsKeeper struct {
+	params emissionstypes.Params
+}
+
+func (k fakeEmissionsKeeper) GetParams(context.Context) (emissionstypes.Params, error) {
+	return k.params, nil
+}
+
+func (k fakeEmissionsKeeper) IsGmpSourceAllowed(context.Context, string, string) (bool, error) {
+	return true, nil
+}
+
+func (k fakeEmissionsKeeper) ResolveGmpDelivery(context.Context, string, uint64, string) error {
+	return nil
+}
+
+// Records the topics funded
+type fakeEmissionsMsgServer struct {
+	emissionstypes.MsgServiceServer
+	funded []*emissionstypes.FundTopicRequest
+}
+
+func (ms *fakeEmissionsMsgServer) FundTopic(_ context.Context, msg *emissionstypes.FundTopicRequest) (*emissionstypes.FundTopicResponse, error) {
+	ms.funded = append(ms.funded, msg)
+	return &emissionstypes.FundTopicResponse{}, nil
+}
+
+func fundTopicPacket(t *testing.T, sourceChannel, destinationChannel string) channeltypes.Packet {
+	t.Helper()
+	memo, err := json.Marshal(gmp.Message{
+		SourceChain:   "ethereum",
+		SourceAddress: "0xabcd",
+		Payload:       []byte(`{"action":"fund_topic","topic_id":1,"amount":"10"}`),
+		Type:          gmp.TypeGeneralMessageWithToken,
+	})
+	require.NoError(t, err)
+	data := transfertypes.NewFungibleTokenPacketData("uallo", "10", gmp.AxelarGMPAcc, testReputer, string(memo))
+	return channeltypes.Packet{
+		Sequence:           1,
+		SourcePort:         transfertypes.PortID,
+		SourceChannel:      sourceChannel,
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: destinationChannel,
+		Data:               data.GetBytes(),
+	}
+}
+
+func newTestMiddleware(channelId, counterpartyChannelId string) (gmp.IBCMiddleware, *fakeTransferApp, *fakeEmissionsMsgServer) {
+	params := emissionstypes.DefaultParams()
+	params.GmpAxelarChannelId = channelId
+	params.GmpAxelarCounterpartyChannelId = counterpartyChannelId
+	app := &fakeTransferApp{}
+	msgServer := &fakeEmissionsMsgServer{}
+	return gmp.NewIBCMiddleware(app, fakeEmissionsKeeper{params: params}, msgServer), app, msgServer
+}
+
+func TestOnRecvPacketRunsActionsFromTheAxelarChannel(t *testing.T) {
+	middleware, app, msgServer := newTestMiddleware(axelarChannel, axelarCounterpartyChannel)
+	ctx := sdk.Context{}.WithLogger(log.NewNopLogger())
+
+	ack := middleware.OnRecvPacket(ctx, fundTopicPacket(t, axelarCounterpartyChannel, axelarChannel), nil)
+	require.True(t, ack.Success())
+	require.Len(t, app.received, 1)
+	require.Len(t, msgServer.funded, 1)
+	require.Equal(t, uint64(1), msgServer.funded[0].TopicId)
+}
+
+func TestOnRecvPacketRejectsGmpMessagesFromOtherChannels(t *testing.T) {
+	tests := []struct {
+		name                  string
+		channelId             string
+		counterpartyChannelId string
+		packet                func(t *testing.T) channeltypes.Packet
+	}{
+		{
+			name:                  "other destination channel",
+			channelId:             axelarChannel,
+			counterpartyChannelId: axelarCounterpartyChannel,
+			packet: func(t *testing.T) channeltypes.Packet {
+				return fundTopicPacket(t, axelarCounterpartyChannel, "channel-4")
+			},
+		},
+		{
+			name:                  "other counterparty channel",
+			channelId:             axelarChannel,
+			counterpartyChannelId: axelarCounterpartyChannel,
+			packet: func(t *testing.T) channeltypes.Packet {
+				return fundTopicPacket(t, "channel-0", axelarChannel)
+			},
+		},
+		{
+			name:                  "other counterparty port",
+			channelId:             axelarChannel,
+			counterpartyChannelId: axelarCounterpartyChannel,
+			packet: func(t *testing.T) channeltypes.Packet {
+				packet := fundTopicPacket(t, axelarCounterpartyChannel, axelarChannel)
+				packet.SourcePort = "wasm.axelar1contract"
+				return packet
+			},
+		},
+		{
+			name:                  "no channel to Axelar",
+			channelId:             "",
+			counterpartyChannelId: "",
+			packet: func(t *testing.T) channeltypes.Packet {
+				return fundTopicPacket(t, axelarCounterpartyChannel, axelarChannel)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			middleware, app, msgServer := newTestMiddleware(tc.channelId, tc.counterpartyChannelId)
+			ctx := sdk.Context{}.WithLogger(log.NewNopLogger())
+
+			ack := middleware.OnRecvPacket(ctx, tc.packet(t), nil)
+			require.False(t, ack.Success())
+			require.Equal(t, channeltypes.NewErrorAcknowledgement(gmp.ErrUnauthorizedChannel), ack)
+			require.Empty(t, app.received)
+			require.Empty(t, msgServer.funded)
+		})
+	}
+}
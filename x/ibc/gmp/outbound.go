@@ -0,0 +1,124 @@
+package gmp
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// AxelarGasServiceAcc receives the fee that pays for the execution of outbound messages on the destination chain
+const AxelarGasServiceAcc = "axelar1zl3rxpp70lmte2xr6c4lgske2fyuj3hupcsvcd"
+
+// OutboundPacketTimeout is how long an outbound message may wait to be relayed to Axelar before it times out
+const OutboundPacketTimeout = 10 * time.Minute
+
+// OutboundFee is the gas payment attached to an outbound message
+type OutboundFee struct {
+	Amount    string `json:"amount"`
+	Recipient string `json:"recipient"`
+}
+
+// OutboundMessage is attached in the ICS20 packet memo field of a message sent to an EVM chain through Axelar
+type OutboundMessage struct {
+	DestinationChain   string       `json:"destination_chain"`
+	DestinationAddress string       `json:"destination_address"`
+	Payload            []byte       `json:"payload"`
+	Type               int64        `json:"type"`
+	Fee                *OutboundFee `json:"fee"`
+}
+
+// TransferKeeper defines the ICS20 transfer keeper method used to send outbound messages
+type TransferKeeper interface {
+	Transfer(ctx context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error)
+}
+
+// OutboundSender sends the network inferences of subscribed topics to the Axelar GMP account,
+// which forwards them to the subscribed contract
+type OutboundSender struct {
+	transferKeeper TransferKeeper
+}
+
+func NewOutboundSender(transferKeeper TransferKeeper) OutboundSender {
+	return OutboundSender{transferKeeper: transferKeeper}
+}
+
+// EncodeNetworkInferencePayload ABI-encodes a network inference as
+// (uint256 topic_id, uint256 block_height, string combined_value, string naive_value).
+// Values are decimal strings because Solidity has no native decimal type. Vector-valued
+// inferences are encoded as their comma-separated per-dimension values.
+func EncodeNetworkInferencePayload(topicId uint64, blockHeight int64, networkInference *emissionstypes.ValueBundle) ([]byte, error) {
+	if networkInference == nil {
+		return nil, errorsmod.Wrap(ErrInvalidPayload, "network inference cannot be nil")
+	}
+	if blockHeight < 0 {
+		return nil, errorsmod.Wrap(ErrInvalidPayload, "block height cannot be negative")
+	}
+	return EncodeAbi(
+		topicId,
+		uint64(blockHeight),
+		encodeValueVector(networkInference.CombinedValueVector()),
+		encodeValueVector(networkInference.NaiveValueVector()),
+	)
+}
+
+func encodeValueVector(values []alloraMath.Dec) string {
+	encoded := make([]string, len(values))
+	for i, value := range values {
+		encoded[i] = value.String()
+	}
+	return strings.Join(encoded, ",")
+}
+
+// SendNetworkInference transfers the fee to the Axelar GMP account with the network inference as payload.
+// The fee pays Axelar's gas service, and is refunded to the sender by the transfer module
+// if the packet fails or times out.
+func (s OutboundSender) SendNetworkInference(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	channelId string,
+	subscription emissionstypes.GmpSubscription,
+	blockHeight int64,
+	networkInference *emissionstypes.ValueBundle,
+	fee sdk.Coin,
+) (uint64, error) {
+	payload, err := EncodeNetworkInferencePayload(subscription.TopicId, blockHeight, networkInference)
+	if err != nil {
+		return 0, err
+	}
+	memo, err := json.Marshal(OutboundMessage{
+		DestinationChain:   subscription.DestinationChain,
+		DestinationAddress: subscription.DestinationAddress,
+		Payload:            payload,
+		Type:               TypeGeneralMessage,
+		Fee: &OutboundFee{
+			Amount:    fee.Amount.String(),
+			Recipient: AxelarGasServiceAcc,
+		},
+	})
+	if err != nil {
+		return 0, errorsmod.Wrapf(ErrInvalidPayload, "cannot marshal outbound message: %s", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	timeout := sdkCtx.BlockTime().Add(OutboundPacketTimeout)
+	res, err := s.transferKeeper.Transfer(ctx, &transfertypes.MsgTransfer{
+		SourcePort:       transfertypes.PortID,
+		SourceChannel:    channelId,
+		Token:            fee,
+		Sender:           sender.String(),
+		Receiver:         AxelarGMPAcc,
+		TimeoutTimestamp: uint64(timeout.UnixNano()),
+		Memo:             string(memo),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res.Sequence, nil
+}
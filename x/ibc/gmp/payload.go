@@ -0,0 +1,105 @@
+package gmp
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// actionAbiTypes is the ABI layout of an action payload
+var actionAbiTypes = []AbiType{AbiString, AbiUint256, AbiString, AbiUint256}
+
+// ParseActionPayload decodes a GMP payload into an emissions action.
+// The payload is either prefixed by a 4 byte version (ABI or JSON), or is a bare JSON object.
+func ParseActionPayload(payload []byte) (Action, error) {
+	var action Action
+	var err error
+	switch {
+	case len(payload) > 0 && payload[0] == '{':
+		action, err = parseJsonAction(payload)
+	case len(payload) < len(PayloadVersionAbi):
+		return Action{}, errorsmod.Wrap(ErrInvalidPayload, "payload too short to contain a version")
+	case bytes.Equal(payload[:4], PayloadVersionAbi[:]):
+		action, err = parseAbiAction(payload[4:])
+	case bytes.Equal(payload[:4], PayloadVersionJson[:]):
+		action, err = parseJsonAction(payload[4:])
+	default:
+		return Action{}, errorsmod.Wrapf(ErrInvalidPayload, "unknown payload version %x", payload[:4])
+	}
+	if err != nil {
+		return Action{}, err
+	}
+	if action.Amount.IsNil() {
+		action.Amount = cosmosMath.ZeroInt()
+	}
+	return action, action.Validate()
+}
+
+func parseJsonAction(payload []byte) (Action, error) {
+	var action Action
+	if err := json.Unmarshal(payload, &action); err != nil {
+		return Action{}, errorsmod.Wrapf(ErrInvalidPayload, "cannot unmarshal json payload: %s", err)
+	}
+	return action, nil
+}
+
+func parseAbiAction(payload []byte) (Action, error) {
+	values, err := DecodeAbi(payload, actionAbiTypes)
+	if err != nil {
+		return Action{}, errorsmod.Wrapf(ErrInvalidPayload, "cannot decode abi payload: %s", err)
+	}
+	topicId, ok := values[1].(*big.Int)
+	if !ok || !topicId.IsUint64() {
+		return Action{}, errorsmod.Wrap(ErrInvalidPayload, "topic id does not fit in a uint64")
+	}
+	amount, ok := values[3].(*big.Int)
+	if !ok || amount.BitLen() > cosmosMath.MaxBitLen {
+		return Action{}, errorsmod.Wrap(ErrInvalidPayload, "amount out of range")
+	}
+	return Action{
+		Action:  values[0].(string),
+		TopicId: topicId.Uint64(),
+		Target:  values[2].(string),
+		Amount:  cosmosMath.NewIntFromBigInt(amount),
+	}, nil
+}
+
+// Validate checks that the action is known and carries the fields it needs
+func (action Action) Validate() error {
+	if action.TopicId == 0 {
+		return errorsmod.Wrap(ErrInvalidPayload, "topic id cannot be zero")
+	}
+	switch action.Action {
+	case ActionFundTopic:
+		if !action.Amount.IsPositive() {
+			return errorsmod.Wrap(ErrInvalidPayload, "fund amount must be positive")
+		}
+	case ActionDelegateStake:
+		if !action.Amount.IsPositive() {
+			return errorsmod.Wrap(ErrInvalidPayload, "delegate amount must be positive")
+		}
+		if _, err := sdk.AccAddressFromBech32(action.Target); err != nil {
+			return errorsmod.Wrapf(ErrInvalidPayload, "invalid reputer address: %s", err)
+		}
+	case ActionRegisterWorker:
+		if _, err := sdk.AccAddressFromBech32(action.Target); err != nil {
+			return errorsmod.Wrapf(ErrInvalidPayload, "invalid owner address: %s", err)
+		}
+	default:
+		return errorsmod.Wrapf(ErrUnknownAction, "%q", action.Action)
+	}
+	return nil
+}
+
+// DeriveSourceAddress returns the account that holds funds and acts on behalf of a GMP source.
+// Chain names and EVM addresses are case-insensitive, so they are lower-cased first.
+func DeriveSourceAddress(sourceChain, sourceAddress string) sdk.AccAddress {
+	key := strings.ToLower(sourceChain) + "/" + strings.ToLower(sourceAddress)
+	return address.Module(ModuleName, []byte(key))
+}
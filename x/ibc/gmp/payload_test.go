@@ -0,0 +1,102 @@
+package gmp_test
+
+import (
+	"math/big"
+	"testing"
+
+	cosmosMath "cosmossdk.io/math"
+	_ "github.com/allora-network/allora-chain/app/params" // sets the allo bech32 prefixes
+	"github.com/allora-network/allora-chain/x/ibc/gmp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testReputer = "allo1snm6pxg7p9jetmkhz0jz9ku3vdzmszegy9q5lh"
+
+func TestAbiRoundTrip(t *testing.T) {
+	amount, ok := new(big.Int).SetString("123456789012345678901234567890", 10)
+	require.True(t, ok)
+
+	encoded, err := gmp.EncodeAbi("delegate_stake", uint64(7), testReputer, amount, []byte{0x01, 0x02})
+	require.NoError(t, err)
+	require.Zero(t, len(encoded)%32, "abi encoding must be word aligned")
+
+	values, err := gmp.DecodeAbi(encoded, []gmp.AbiType{gmp.AbiString, gmp.AbiUint256, gmp.AbiString, gmp.AbiUint256, gmp.AbiBytes})
+	require.NoError(t, err)
+	require.Equal(t, "delegate_stake", values[0])
+	require.Equal(t, uint64(7), values[1].(*big.Int).Uint64())
+	require.Equal(t, testReputer, values[2])
+	require.Equal(t, 0, amount.Cmp(values[3].(*big.Int)))
+	require.Equal(t, []byte{0x01, 0x02}, values[4])
+}
+
+func TestDecodeAbiRejectsOutOfRangeOffset(t *testing.T) {
+	encoded, err := gmp.EncodeAbi("fund_topic")
+	require.NoError(t, err)
+	encoded[31] = 0xff
+
+	_, err = gmp.DecodeAbi(encoded, []gmp.AbiType{gmp.AbiString})
+	require.Error(t, err)
+
+	_, err = gmp.DecodeAbi(encoded[:16], []gmp.AbiType{gmp.AbiString})
+	require.Error(t, err)
+}
+
+func TestParseActionPayloadAbi(t *testing.T) {
+	encoded, err := gmp.EncodeAbi(gmp.ActionDelegateStake, uint64(3), testReputer, big.NewInt(500))
+	require.NoError(t, err)
+
+	action, err := gmp.ParseActionPayload(append(gmp.PayloadVersionAbi[:], encoded...))
+	require.NoError(t, err)
+	require.Equal(t, gmp.Action{
+		Action:  gmp.ActionDelegateStake,
+		TopicId: 3,
+		Target:  testReputer,
+		Amount:  cosmosMath.NewInt(500),
+	}, action)
+}
+
+func TestParseActionPayloadJson(t *testing.T) {
+	payload := []byte(`{"action":"fund_topic","topic_id":2,"amount":"42"}`)
+
+	action, err := gmp.ParseActionPayload(payload)
+	require.NoError(t, err)
+	require.Equal(t, gmp.ActionFundTopic, action.Action)
+	require.Equal(t, uint64(2), action.TopicId)
+	require.True(t, action.Amount.Equal(cosmosMath.NewInt(42)))
+
+	versioned, err := gmp.ParseActionPayload(append(gmp.PayloadVersionJson[:], payload...))
+	require.NoError(t, err)
+	require.Equal(t, action, versioned)
+}
+
+func TestParseActionPayloadInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload []byte
+		err     error
+	}{
+		{"too short", []byte{0x00}, gmp.ErrInvalidPayload},
+		{"unknown version", []byte{0x00, 0x00, 0x00, 0x09, 0x01}, gmp.ErrInvalidPayload},
+		{"malformed json", []byte(`{"action":`), gmp.ErrInvalidPayload},
+		{"unknown action", []byte(`{"action":"burn","topic_id":1,"amount":"1"}`), gmp.ErrUnknownAction},
+		{"zero topic", []byte(`{"action":"fund_topic","topic_id":0,"amount":"1"}`), gmp.ErrInvalidPayload},
+		{"zero amount", []byte(`{"action":"fund_topic","topic_id":1}`), gmp.ErrInvalidPayload},
+		{"bad reputer", []byte(`{"action":"delegate_stake","topic_id":1,"target":"0x01","amount":"1"}`), gmp.ErrInvalidPayload},
+		{"bad owner", []byte(`{"action":"register_worker","topic_id":1}`), gmp.ErrInvalidPayload},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := gmp.ParseActionPayload(tc.payload)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestDeriveSourceAddressIsCaseInsensitive(t *testing.T) {
+	a := gmp.DeriveSourceAddress("Ethereum", "0xAbCd")
+	b := gmp.DeriveSourceAddress("ethereum", "0xabcd")
+	require.Equal(t, a, b)
+	require.NotEqual(t, a, gmp.DeriveSourceAddress("avalanche", "0xabcd"))
+	require.NoError(t, sdk.VerifyAddressFormat(a))
+}
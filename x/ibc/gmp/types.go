@@ -1,5 +1,15 @@
 package gmp
 
+import (
+	"context"
+
+	cosmosMath "cosmossdk.io/math"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// ModuleName is used as the error codespace and to derive the accounts that act for GMP sources
+const ModuleName = "gmp"
+
 const AxelarGMPAcc = "axelar1dv4u5k73pzqrxlzujxg3qp8kvc3pje7jtdvu72npnt5zhq05ejcsn5qme5"
 
 // Message is attached in ICS20 packet memo field
@@ -19,3 +29,33 @@ const (
 	// TypeGeneralMessageWithToken is a general message with token
 	TypeGeneralMessageWithToken
 )
+
+// Payload versions prefixed to Axelar GMP payloads destined for Cosmos chains
+var (
+	PayloadVersionAbi  = [4]byte{0x00, 0x00, 0x00, 0x00}
+	PayloadVersionJson = [4]byte{0x00, 0x00, 0x00, 0x01}
+)
+
+// Emissions actions that can be requested by a GMP payload
+const (
+	ActionFundTopic      = "fund_topic"
+	ActionDelegateStake  = "delegate_stake"
+	ActionRegisterWorker = "register_worker"
+)
+
+// Action is an emissions action decoded from a GMP payload.
+// ABI payloads are encoded as (string action, uint256 topic_id, string target, uint256 amount).
+type Action struct {
+	Action  string `json:"action"`
+	TopicId uint64 `json:"topic_id"`
+	// Target is the reputer for delegate_stake and the worker owner for register_worker
+	Target string         `json:"target"`
+	Amount cosmosMath.Int `json:"amount"`
+}
+
+// EmissionsKeeper defines the emissions keeper methods used by the GMP middleware
+type EmissionsKeeper interface {
+	GetParams(ctx context.Context) (emissionstypes.Params, error)
+	IsGmpSourceAllowed(ctx context.Context, sourceChain, sourceAddress string) (bool, error)
+	ResolveGmpDelivery(ctx context.Context, channelId string, sequence uint64, status string) error
+}
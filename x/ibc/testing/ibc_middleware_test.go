@@ -2,13 +2,122 @@ package testing
 
 import (
 	"encoding/json"
+	"math/big"
+	"time"
 
+	"cosmossdk.io/math"
+	app2 "github.com/allora-network/allora-chain/app"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper/msgserver"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
 	"github.com/allora-network/allora-chain/x/ibc/gmp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types" //nolint:staticcheck // SA1019 deprecated code breaking unit tests
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
-func (s *IBCTestSuite) TestGMPMessageFrom_Success() {
+const (
+	gmpSourceChain   = "Ethereum"
+	gmpSourceAddress = "0xAbCdEf0123456789aBcDeF0123456789AbCdEf01"
+)
+
+func (s *IBCTestSuite) alloraApp() *app2.AlloraApp {
+	app, ok := s.alloraChain.App.(*app2.AlloraApp)
+	s.Require().True(ok)
+	return app
+}
+
+func (s *IBCTestSuite) allowGMPSource(sourceChain, sourceAddress string) {
+	err := s.alloraApp().EmissionsKeeper.AddGmpAllowedSource(s.alloraChain.GetContext(), emissionstypes.GmpAllowedSource{
+		SourceChain:   sourceChain,
+		SourceAddress: sourceAddress,
+	})
+	s.Require().NoError(err)
+}
+
+func (s *IBCTestSuite) createAlloraTopic() uint64 {
+	ms := msgserver.NewMsgServerImpl(s.alloraApp().EmissionsKeeper)
+	res, err := ms.CreateNewTopic(s.alloraChain.GetContext(), &emissionstypes.CreateNewTopicRequest{
+		Creator:                  s.alloraAddr.String(),
+		Metadata:                 "GMP test topic",
+		LossMethod:               "mse",
+		EpochLength:              10800,
+		GroundTruthLag:           10800,
+		AllowNegative:            false,
+		WorkerSubmissionWindow:   10,
+		AlphaRegret:              alloraMath.NewDecFromInt64(1),
+		PNorm:                    alloraMath.NewDecFromInt64(3),
+		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+	})
+	s.Require().NoError(err)
+	return res.TopicId
+}
+
+func abiActionPayload(action string, topicId uint64, target string, amount int64) []byte {
+	encoded, err := gmp.EncodeAbi(action, topicId, target, big.NewInt(amount))
+	if err != nil {
+		panic(err)
+	}
+	return append(gmp.PayloadVersionAbi[:], encoded...)
+}
+
+// sendGMPPacketProviderToAllora sends an ICS-20 packet from the provider chain as if it were relayed
+// by Axelar, receives it on Allora and returns the acknowledgement written by Allora
+func (s *IBCTestSuite) sendGMPPacketProviderToAllora(
+	sender string,
+	receiver string,
+	denom string,
+	amount math.Int,
+	msg gmp.Message,
+) channeltypes.Acknowledgement {
+	memo, err := json.Marshal(msg)
+	s.Require().NoError(err)
+	data := transfertypes.NewFungibleTokenPacketData(denom, amount.String(), sender, receiver, string(memo))
+
+	timeoutHeight := clienttypes.NewHeight(1, 110)
+	sequence, err := s.path.EndpointB.SendPacket(timeoutHeight, 0, data.GetBytes())
+	s.Require().NoError(err)
+
+	packet := channeltypes.NewPacket(
+		data.GetBytes(),
+		sequence,
+		s.path.EndpointB.ChannelConfig.PortID,
+		s.path.EndpointB.ChannelID,
+		s.path.EndpointA.ChannelConfig.PortID,
+		s.path.EndpointA.ChannelID,
+		timeoutHeight,
+		0,
+	)
+	res, err := s.path.EndpointA.RecvPacketWithResult(packet)
+	s.Require().NoError(err)
+	ackBytes, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+
+	var ack channeltypes.Acknowledgement
+	s.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(ackBytes, &ack))
+	return ack
+}
+
+// alloraDenomFromProvider is the denom the provider chain uses for uallo sent to it from Allora,
+// so that packets carrying it unescrow native uallo on Allora
+func (s *IBCTestSuite) alloraDenomFromProvider() string {
+	return transfertypes.GetPrefixedDenom(s.path.EndpointB.ChannelConfig.PortID, s.path.EndpointB.ChannelID, nativeDenom)
+}
+
+func (s *IBCTestSuite) TestPlainTransfer_Success() {
 	s.IBCTransferProviderToAllora(s.providerAddr, s.alloraAddr, nativeDenom, ibcTransferAmount, "")
 
+	s.assertAlloraBalance(s.alloraAddr, s.providerToAlloraDenom, ibcTransferAmount)
+	s.assertProviderBalance(s.providerAddr, nativeDenom, genesisWalletAmount.Sub(ibcTransferAmount))
+}
+
+func (s *IBCTestSuite) TestGMPMessageFrom_NonAxelarSenderIsRefunded() {
 	generalMsg := gmp.Message{
 		SourceChain:   "axelar",
 		SourceAddress: "allora",
@@ -26,9 +135,13 @@ func (s *IBCTestSuite) TestGMPMessageFrom_Success() {
 	}
 	generalMsgWithTokenJson, _ := json.Marshal(generalMsgWithToken)
 	s.IBCTransferProviderToAllora(s.providerAddr, s.alloraAddr, nativeDenom, ibcTransferAmount, string(generalMsgWithTokenJson))
+
+	// both packets are rejected by Allora and refunded on the provider chain
+	s.assertAlloraBalance(s.alloraAddr, s.providerToAlloraDenom, math.ZeroInt())
+	s.assertProviderBalance(s.providerAddr, nativeDenom, genesisWalletAmount)
 }
 
-func (s *IBCTestSuite) TestGMPMessageTo_Success() {
+func (s *IBCTestSuite) TestGMPMessageTo_RejectedByNonAxelarCounterparty() {
 	generalMsg := gmp.Message{
 		SourceChain:   "allora",
 		SourceAddress: "axelar",
@@ -37,4 +150,223 @@ func (s *IBCTestSuite) TestGMPMessageTo_Success() {
 	}
 	generalMsgJson, _ := json.Marshal(generalMsg)
 	s.IBCTransferAlloraToProvider(s.alloraAddr, s.providerAddr, nativeDenom, ibcTransferAmount, string(generalMsgJson))
+
+	s.assertAlloraBalance(s.alloraAddr, nativeDenom, genesisWalletAmount)
+}
+
+func (s *IBCTestSuite) TestGMPMessage_SourceNotAllowed() {
+	topicId := s.createAlloraTopic()
+	actor := gmp.DeriveSourceAddress(gmpSourceChain, gmpSourceAddress)
+
+	ack := s.sendGMPPacketProviderToAllora(gmp.AxelarGMPAcc, s.alloraAddr.String(), nativeDenom, ibcTransferAmount, gmp.Message{
+		SourceChain:   gmpSourceChain,
+		SourceAddress: gmpSourceAddress,
+		Payload:       abiActionPayload(gmp.ActionFundTopic, topicId, "", 1000),
+		Type:          gmp.TypeGeneralMessageWithToken,
+	})
+
+	s.Require().False(ack.Success())
+	s.assertAlloraBalance(actor, s.providerToAlloraDenom, math.ZeroInt())
+}
+
+func (s *IBCTestSuite) TestGMPMessage_InvalidPayload() {
+	s.allowGMPSource(gmpSourceChain, gmpSourceAddress)
+
+	ack := s.sendGMPPacketProviderToAllora(gmp.AxelarGMPAcc, s.alloraAddr.String(), nativeDenom, ibcTransferAmount, gmp.Message{
+		SourceChain:   gmpSourceChain,
+		SourceAddress: gmpSourceAddress,
+		Payload:       []byte("Hello Allora, I am Axelar"),
+		Type:          gmp.TypeGeneralMessage,
+	})
+
+	s.Require().False(ack.Success())
+	s.assertAlloraBalance(s.alloraAddr, s.providerToAlloraDenom, math.ZeroInt())
+}
+
+func (s *IBCTestSuite) TestGMPMessageWithToken_FundTopic() {
+	topicId := s.createAlloraTopic()
+	s.allowGMPSource(gmpSourceChain, gmpSourceAddress)
+	actor := gmp.DeriveSourceAddress(gmpSourceChain, gmpSourceAddress)
+
+	// escrow uallo on Allora so that it can be sent back by the "Axelar" side
+	s.IBCTransferAlloraToProvider(s.alloraAddr, s.providerAddr, nativeDenom, ibcTransferAmount, "")
+
+	revenueBefore, err := s.alloraApp().EmissionsKeeper.GetTopicFeeRevenue(s.alloraChain.GetContext(), topicId)
+	s.Require().NoError(err)
+
+	// the sender-chosen receiver is ignored, tokens are credited to the source's account and spent
+	ack := s.sendGMPPacketProviderToAllora(gmp.AxelarGMPAcc, s.alloraAddr.String(), s.alloraDenomFromProvider(), ibcTransferAmount, gmp.Message{
+		SourceChain:   gmpSourceChain,
+		SourceAddress: gmpSourceAddress,
+		Payload:       abiActionPayload(gmp.ActionFundTopic, topicId, "", ibcTransferAmount.Int64()),
+		Type:          gmp.TypeGeneralMessageWithToken,
+	})
+	s.Require().True(ack.Success(), "ack error: %s", ack.GetError())
+
+	s.assertAlloraBalance(actor, nativeDenom, math.ZeroInt())
+	s.assertAlloraBalance(s.alloraAddr, nativeDenom, genesisWalletAmount.Sub(ibcTransferAmount).Sub(emissionstypes.DefaultParams().CreateTopicFee))
+	revenueAfter, err := s.alloraApp().EmissionsKeeper.GetTopicFeeRevenue(s.alloraChain.GetContext(), topicId)
+	s.Require().NoError(err)
+	s.Require().True(revenueAfter.GT(revenueBefore), "topic fee revenue should grow")
+}
+
+func (s *IBCTestSuite) TestGMPMessageWithToken_FailedActionRevertsTransfer() {
+	s.allowGMPSource(gmpSourceChain, gmpSourceAddress)
+	actor := gmp.DeriveSourceAddress(gmpSourceChain, gmpSourceAddress)
+
+	s.IBCTransferAlloraToProvider(s.alloraAddr, s.providerAddr, nativeDenom, ibcTransferAmount, "")
+
+	// topic 1000 does not exist
+	ack := s.sendGMPPacketProviderToAllora(gmp.AxelarGMPAcc, s.alloraAddr.String(), s.alloraDenomFromProvider(), ibcTransferAmount, gmp.Message{
+		SourceChain:   gmpSourceChain,
+		SourceAddress: gmpSourceAddress,
+		Payload:       abiActionPayload(gmp.ActionFundTopic, 1000, "", ibcTransferAmount.Int64()),
+		Type:          gmp.TypeGeneralMessageWithToken,
+	})
+
+	s.Require().False(ack.Success())
+	s.assertAlloraBalance(actor, nativeDenom, math.ZeroInt())
+}
+
+func (s *IBCTestSuite) TestGMPMessage_RegisterWorker() {
+	topicId := s.createAlloraTopic()
+	s.allowGMPSource(gmpSourceChain, gmpSourceAddress)
+	actor := gmp.DeriveSourceAddress(gmpSourceChain, gmpSourceAddress)
+	app := s.alloraApp()
+
+	// the source's account pays the registration fee from funds it already holds
+	registrationFee := emissionstypes.DefaultParams().RegistrationFee
+	err := app.BankKeeper.SendCoins(s.alloraChain.GetContext(), s.alloraAddr, actor, sdk.NewCoins(sdk.NewCoin(nativeDenom, registrationFee)))
+	s.Require().NoError(err)
+
+	payload, err := json.Marshal(gmp.Action{
+		Action:  gmp.ActionRegisterWorker,
+		TopicId: topicId,
+		Target:  s.alloraAddr.String(),
+	})
+	s.Require().NoError(err)
+
+	ack := s.sendGMPPacketProviderToAllora(gmp.AxelarGMPAcc, s.alloraAddr.String(), nativeDenom, math.OneInt(), gmp.Message{
+		SourceChain:   gmpSourceChain,
+		SourceAddress: gmpSourceAddress,
+		Payload:       append(gmp.PayloadVersionJson[:], payload...),
+		Type:          gmp.TypeGeneralMessage,
+	})
+	s.Require().True(ack.Success(), "ack error: %s", ack.GetError())
+
+	ctx := s.alloraChain.GetContext()
+	isRegistered, err := app.EmissionsKeeper.IsWorkerRegisteredInTopic(ctx, topicId, actor.String())
+	s.Require().NoError(err)
+	s.Require().True(isRegistered)
+	worker, err := app.EmissionsKeeper.GetWorkerInfo(ctx, actor.String())
+	s.Require().NoError(err)
+	s.Require().Equal(s.alloraAddr.String(), worker.Owner)
+	isRegistered, err = app.EmissionsKeeper.IsWorkerRegisteredInTopic(ctx, topicId, s.alloraAddr.String())
+	s.Require().NoError(err)
+	s.Require().False(isRegistered, "the owner given in the payload should not be registered itself")
+	// a general message still transfers its tokens to the given receiver
+	s.assertAlloraBalance(s.alloraAddr, s.providerToAlloraDenom, math.OneInt())
+}
+
+// createGmpSubscription subscribes the allora test account to the topic, sent over the channel to Axelar set in the params
+func (s *IBCTestSuite) createGmpSubscription(topicId uint64, fee, prepayment math.Int) uint64 {
+	ms := msgserver.NewMsgServerImpl(s.alloraApp().EmissionsKeeper)
+	res, err := ms.CreateGmpSubscription(s.alloraChain.GetContext(), &emissionstypes.CreateGmpSubscriptionRequest{
+		Sender:             s.alloraAddr.String(),
+		TopicId:            topicId,
+		DestinationChain:   gmpSourceChain,
+		DestinationAddress: gmpSourceAddress,
+		FeePerMessage:      fee,
+		Prepayment:         prepayment,
+	})
+	s.Require().NoError(err)
+	return res.SubscriptionId
+}
+
+// sendNetworkInferenceToGmpSubscribers dispatches a network inference on Allora, commits the block
+// and returns the packet that carries it
+func (s *IBCTestSuite) sendNetworkInferenceToGmpSubscribers(topicId uint64) channeltypes.Packet {
+	ctx := s.alloraChain.GetContext()
+	err := s.alloraApp().EmissionsKeeper.SendNetworkInferenceToGmpSubscribers(ctx, topicId, ctx.BlockHeight(), &emissionstypes.ValueBundle{
+		TopicId:       topicId,
+		CombinedValue: alloraMath.MustNewDecFromString("1.5"),
+		NaiveValue:    alloraMath.MustNewDecFromString("1.25"),
+	})
+	s.Require().NoError(err)
+	packet, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().ABCIEvents())
+	s.Require().NoError(err)
+	s.coordinator.CommitBlock(s.alloraChain)
+	return packet
+}
+
+func (s *IBCTestSuite) assertGmpSubscriptionBalance(subscriptionId uint64, expected math.Int) {
+	subscription, found, err := s.alloraApp().EmissionsKeeper.GetGmpSubscription(s.alloraChain.GetContext(), subscriptionId)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().True(expected.Equal(subscription.Balance), "expected balance %s, got %s", expected, subscription.Balance)
+}
+
+func (s *IBCTestSuite) TestOutboundGMP_SendsNetworkInferenceToAxelar() {
+	topicId := s.createAlloraTopic()
+	fee := math.NewInt(100)
+	subscriptionId := s.createGmpSubscription(topicId, fee, math.NewInt(300))
+
+	packet := s.sendNetworkInferenceToGmpSubscribers(topicId)
+
+	var data transfertypes.FungibleTokenPacketData
+	s.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+	s.Require().Equal(gmp.AxelarGMPAcc, data.Receiver)
+	s.Require().Equal(emissionstypes.GmpFeeEscrowAddress.String(), data.Sender)
+	s.Require().Equal(fee.String(), data.Amount)
+
+	var msg gmp.OutboundMessage
+	s.Require().NoError(json.Unmarshal([]byte(data.Memo), &msg))
+	s.Require().Equal(gmpSourceChain, msg.DestinationChain)
+	s.Require().Equal(gmpSourceAddress, msg.DestinationAddress)
+	s.Require().Equal(int64(gmp.TypeGeneralMessage), msg.Type)
+	s.Require().Equal(gmp.AxelarGasServiceAcc, msg.Fee.Recipient)
+	values, err := gmp.DecodeAbi(msg.Payload, []gmp.AbiType{gmp.AbiUint256, gmp.AbiUint256, gmp.AbiString, gmp.AbiString})
+	s.Require().NoError(err)
+	s.Require().Equal(topicId, values[0].(*big.Int).Uint64())
+	s.Require().Equal("1.5", values[2])
+	s.Require().Equal("1.25", values[3])
+
+	s.assertGmpSubscriptionBalance(subscriptionId, math.NewInt(200))
+	s.assertAlloraBalance(emissionstypes.GmpFeeEscrowAddress, nativeDenom, math.NewInt(200))
+}
+
+func (s *IBCTestSuite) TestOutboundGMP_ErrorAckRefundsSubscription() {
+	topicId := s.createAlloraTopic()
+	subscriptionId := s.createGmpSubscription(topicId, math.NewInt(100), math.NewInt(300))
+
+	packet := s.sendNetworkInferenceToGmpSubscribers(topicId)
+	s.assertGmpSubscriptionBalance(subscriptionId, math.NewInt(200))
+
+	// the provider is not Axelar: it cannot credit the Axelar GMP account and acknowledges with an error
+	s.Require().NoError(s.path.RelayPacket(packet))
+
+	s.assertGmpSubscriptionBalance(subscriptionId, math.NewInt(300))
+	s.assertAlloraBalance(emissionstypes.GmpFeeEscrowAddress, nativeDenom, math.NewInt(300))
+	_, found, err := s.alloraApp().EmissionsKeeper.GetGmpDelivery(s.alloraChain.GetContext(), packet.SourceChannel, packet.Sequence)
+	s.Require().NoError(err)
+	s.Require().False(found)
+}
+
+func (s *IBCTestSuite) TestOutboundGMP_TimeoutRefundsSubscription() {
+	topicId := s.createAlloraTopic()
+	subscriptionId := s.createGmpSubscription(topicId, math.NewInt(100), math.NewInt(300))
+
+	packet := s.sendNetworkInferenceToGmpSubscribers(topicId)
+	s.assertGmpSubscriptionBalance(subscriptionId, math.NewInt(200))
+
+	s.coordinator.IncrementTimeBy(gmp.OutboundPacketTimeout + time.Minute)
+	s.coordinator.CommitBlock(s.providerChain)
+	s.Require().NoError(s.path.EndpointA.UpdateClient())
+	s.Require().NoError(s.path.EndpointA.TimeoutPacket(packet))
+
+	s.assertGmpSubscriptionBalance(subscriptionId, math.NewInt(300))
+	s.assertAlloraBalance(emissionstypes.GmpFeeEscrowAddress, nativeDenom, math.NewInt(300))
+	_, found, err := s.alloraApp().EmissionsKeeper.GetGmpDelivery(s.alloraChain.GetContext(), packet.SourceChannel, packet.Sequence)
+	s.Require().NoError(err)
+	s.Require().False(found)
 }
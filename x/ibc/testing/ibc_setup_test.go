@@ -77,6 +77,13 @@ func (s *IBCTestSuite) SetupTest() {
 
 	s.coordinator.Setup(s.path)
 
+	// The provider chain stands in for Axelar, GMP messages being relayed over the transfer channel to it
+	emissionsParams, err := s.alloraApp().EmissionsKeeper.GetParams(s.alloraChain.GetContext())
+	s.Require().NoError(err)
+	emissionsParams.GmpAxelarChannelId = s.path.EndpointA.ChannelID
+	emissionsParams.GmpAxelarCounterpartyChannelId = s.path.EndpointB.ChannelID
+	s.Require().NoError(s.alloraApp().EmissionsKeeper.SetParams(s.alloraChain.GetContext(), emissionsParams))
+
 	// Store ibc transfer denom for providerChain=>allora for test convenience
 	fullTransferDenomPath := transfertypes.GetPrefixedDenom(
 		transfertypes.PortID,
@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Params_FullMethodName       = "/mint.v1beta1.Query/Params"
-	Query_Inflation_FullMethodName    = "/mint.v1beta1.Query/Inflation"
-	Query_EmissionInfo_FullMethodName = "/mint.v1beta1.Query/EmissionInfo"
+	Query_Params_FullMethodName           = "/mint.v1beta1.Query/Params"
+	Query_Inflation_FullMethodName        = "/mint.v1beta1.Query/Inflation"
+	Query_EmissionInfo_FullMethodName     = "/mint.v1beta1.Query/EmissionInfo"
+	Query_ProjectEmissions_FullMethodName = "/mint.v1beta1.Query/ProjectEmissions"
+	Query_TotalBurnedFees_FullMethodName  = "/mint.v1beta1.Query/TotalBurnedFees"
 )
 
 // QueryClient is the client API for Query service.
@@ -35,6 +37,12 @@ type QueryClient interface {
 	// Inflation returns the current minting inflation value.
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
 	EmissionInfo(ctx context.Context, in *QueryEmissionInfoRequest, opts ...grpc.CallOption) (*QueryEmissionInfoResponse, error)
+	// ProjectEmissions simulates the emissions of the coming months
+	// under assumed staked supply and ecosystem balance.
+	ProjectEmissions(ctx context.Context, in *QueryProjectEmissionsRequest, opts ...grpc.CallOption) (*QueryProjectEmissionsResponse, error)
+	// TotalBurnedFees returns the number of tokens burned out of the fees
+	// collected.
+	TotalBurnedFees(ctx context.Context, in *QueryTotalBurnedFeesRequest, opts ...grpc.CallOption) (*QueryTotalBurnedFeesResponse, error)
 }
 
 type queryClient struct {
@@ -75,6 +83,26 @@ func (c *queryClient) EmissionInfo(ctx context.Context, in *QueryEmissionInfoReq
 	return out, nil
 }
 
+func (c *queryClient) ProjectEmissions(ctx context.Context, in *QueryProjectEmissionsRequest, opts ...grpc.CallOption) (*QueryProjectEmissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryProjectEmissionsResponse)
+	err := c.cc.Invoke(ctx, Query_ProjectEmissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalBurnedFees(ctx context.Context, in *QueryTotalBurnedFeesRequest, opts ...grpc.CallOption) (*QueryTotalBurnedFeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTotalBurnedFeesResponse)
+	err := c.cc.Invoke(ctx, Query_TotalBurnedFees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -86,6 +114,12 @@ type QueryServer interface {
 	// Inflation returns the current minting inflation value.
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
 	EmissionInfo(context.Context, *QueryEmissionInfoRequest) (*QueryEmissionInfoResponse, error)
+	// ProjectEmissions simulates the emissions of the coming months
+	// under assumed staked supply and ecosystem balance.
+	ProjectEmissions(context.Context, *QueryProjectEmissionsRequest) (*QueryProjectEmissionsResponse, error)
+	// TotalBurnedFees returns the number of tokens burned out of the fees
+	// collected.
+	TotalBurnedFees(context.Context, *QueryTotalBurnedFeesRequest) (*QueryTotalBurnedFeesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -105,6 +139,12 @@ func (UnimplementedQueryServer) Inflation(context.Context, *QueryInflationReques
 func (UnimplementedQueryServer) EmissionInfo(context.Context, *QueryEmissionInfoRequest) (*QueryEmissionInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EmissionInfo not implemented")
 }
+func (UnimplementedQueryServer) ProjectEmissions(context.Context, *QueryProjectEmissionsRequest) (*QueryProjectEmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectEmissions not implemented")
+}
+func (UnimplementedQueryServer) TotalBurnedFees(context.Context, *QueryTotalBurnedFeesRequest) (*QueryTotalBurnedFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalBurnedFees not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -180,6 +220,42 @@ func _Query_EmissionInfo_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProjectEmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectEmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectEmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProjectEmissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectEmissions(ctx, req.(*QueryProjectEmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalBurnedFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalBurnedFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalBurnedFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TotalBurnedFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalBurnedFees(ctx, req.(*QueryTotalBurnedFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -199,6 +275,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EmissionInfo",
 			Handler:    _Query_EmissionInfo_Handler,
 		},
+		{
+			MethodName: "ProjectEmissions",
+			Handler:    _Query_ProjectEmissions_Handler,
+		},
+		{
+			MethodName: "TotalBurnedFees",
+			Handler:    _Query_TotalBurnedFees_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mint/v1beta1/query.proto",
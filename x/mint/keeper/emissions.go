@@ -67,7 +67,8 @@ func GetNumStakedTokens(ctx context.Context, k types.MintKeeper) (math.Int, erro
 	return cosmosValidatorsStaked.Add(reputersStaked), nil
 }
 
-// return the circulating supply of tokens
+// return the circulating supply of tokens, and the total supply,
+// the max supply less the fees burned so far
 func GetCirculatingSupply(
 	ctx context.Context,
 	k types.MintKeeper,
@@ -85,7 +86,12 @@ func GetCirculatingSupply(
 	if err != nil {
 		return math.Int{}, math.Int{}, math.Int{}, math.Int{}, err
 	}
-	totalSupply = params.MaxSupply
+	totalBurnedFees, err := k.GetTotalBurnedFees(ctx)
+	if err != nil {
+		return math.Int{}, math.Int{}, math.Int{}, math.Int{}, err
+	}
+	// burned fees are gone from the supply for good
+	totalSupply = params.MaxSupply.Sub(totalBurnedFees)
 	lockedVestingTokens, _, _, _ = GetLockedVestingTokens(
 		blocksPerMonth,
 		math.NewIntFromUint64(blockHeight),
@@ -353,7 +359,7 @@ func GetEmissionPerMonth(
 		ecosystemLocked,
 		networkStaked,
 		circulatingSupply,
-		params.MaxSupply,
+		totalSupply,
 	)
 	if err != nil {
 		return math.Int{}, math.LegacyDec{}, err
@@ -392,3 +398,98 @@ func GetEmissionPerMonth(
 	types.EmitNewTokenomicsSetEvent(ctx, networkStaked, circulatingSupply, emissionPerMonth)
 	return emissionPerMonth, emissionPerUnitStakedToken, nil
 }
+
+// ProjectEmissions simulates the emissions of the coming months, month by month.
+// Each month starts with a recalculation of the emission rate, the first at startBlockHeight,
+// followed by a month of block emissions paid out of the ecosystem account the way BeginBlocker does:
+// the ecosystem account mints what its balance cannot pay, within the supply it may still mint.
+// The network is assumed to stake networkStaked tokens throughout and no fees to flow into the ecosystem account,
+// so that none are burned beyond totalBurnedFees either.
+func ProjectEmissions(
+	params types.Params,
+	blocksPerMonth uint64,
+	startBlockHeight uint64,
+	months uint64,
+	networkStaked math.Int,
+	ecosystemBalance math.Int,
+	ecosystemMintSupplyRemaining math.Int,
+	totalBurnedFees math.Int,
+	previousRewardEmissionPerUnitStakedToken math.LegacyDec,
+	reputersPercent math.LegacyDec,
+	validatorsPercent math.LegacyDec,
+) ([]types.EmissionProjection, error) {
+	if blocksPerMonth == 0 {
+		return nil, errors.Wrap(types.ErrZeroDenominator, "blocks per month is zero")
+	}
+	maximumMonthlyEmissionPerUnitStakedToken := GetMaximumMonthlyEmissionPerUnitStakedToken(
+		params.MaximumMonthlyPercentageYield,
+		reputersPercent,
+		validatorsPercent,
+	)
+	totalSupply := params.MaxSupply.Sub(totalBurnedFees)
+	cumulativeMinted := math.ZeroInt()
+	projections := make([]types.EmissionProjection, 0, months)
+	for month := uint64(1); month <= months; month++ {
+		blockHeight := startBlockHeight + (month-1)*blocksPerMonth
+		lockedVestingTokens, _, _, _ := GetLockedVestingTokens(
+			blocksPerMonth,
+			math.NewIntFromUint64(blockHeight),
+			params,
+		)
+		ecosystemLocked := ecosystemBalance.Add(ecosystemMintSupplyRemaining)
+		circulatingSupply := totalSupply.Sub(lockedVestingTokens).Sub(ecosystemLocked)
+		if circulatingSupply.IsNegative() {
+			return nil, errors.Wrapf(types.ErrNegativeCirculatingSupply, "month %d", month)
+		}
+		targetRewardEmissionPerUnitStakedToken, err := GetTargetRewardEmissionPerUnitStakedToken(
+			params.FEmission,
+			ecosystemLocked,
+			networkStaked,
+			circulatingSupply,
+			totalSupply,
+		)
+		if err != nil {
+			return nil, err
+		}
+		targetRewardEmissionPerUnitStakedToken = GetCappedTargetEmissionPerUnitStakedToken(
+			targetRewardEmissionPerUnitStakedToken,
+			maximumMonthlyEmissionPerUnitStakedToken,
+		)
+		// if this is the first month/time we're calculating the target emission...
+		if blockHeight < blocksPerMonth {
+			previousRewardEmissionPerUnitStakedToken = targetRewardEmissionPerUnitStakedToken
+		}
+		emissionPerUnitStakedToken := GetExponentialMovingAverage(
+			targetRewardEmissionPerUnitStakedToken,
+			params.OneMonthSmoothingDegree,
+			previousRewardEmissionPerUnitStakedToken,
+		)
+		emissionPerMonth := GetTotalEmissionPerMonth(emissionPerUnitStakedToken, networkStaked)
+		blockEmission := emissionPerMonth.Quo(math.NewIntFromUint64(blocksPerMonth))
+
+		// a month of block emissions first drains the ecosystem balance, then is minted
+		payout := blockEmission.Mul(math.NewIntFromUint64(blocksPerMonth))
+		minted := math.ZeroInt()
+		if payout.GT(ecosystemBalance) {
+			minted = math.MinInt(payout.Sub(ecosystemBalance), ecosystemMintSupplyRemaining)
+		}
+		paid := math.MinInt(payout, ecosystemBalance.Add(minted))
+		ecosystemBalance = ecosystemBalance.Add(minted).Sub(paid)
+		ecosystemMintSupplyRemaining = ecosystemMintSupplyRemaining.Sub(minted)
+		cumulativeMinted = cumulativeMinted.Add(minted)
+		previousRewardEmissionPerUnitStakedToken = emissionPerUnitStakedToken
+
+		projections = append(projections, types.EmissionProjection{
+			Month:                        month,
+			BlockHeight:                  blockHeight,
+			EmissionPerUnitStakedToken:   emissionPerUnitStakedToken,
+			EmissionPerMonth:             emissionPerMonth,
+			BlockEmission:                blockEmission,
+			Minted:                       minted,
+			CumulativeMinted:             cumulativeMinted,
+			EcosystemBalance:             ecosystemBalance,
+			EcosystemMintSupplyRemaining: ecosystemMintSupplyRemaining,
+		})
+	}
+	return projections, nil
+}
@@ -328,3 +328,102 @@ func (s *MintKeeperTestSuite) TestGetLockedVestingTokens() {
 		testutil.InEpsilon5Dec(s.T(), resultDAllo, expected)
 	}
 }
+
+func (s *MintKeeperTestSuite) TestProjectEmissions() {
+	params := types.DefaultParams()
+	blocksPerMonth := uint64(525960)
+	startBlockHeight := blocksPerMonth*13 + 1
+	networkStaked := cosmosMath.NewIntWithDecimal(3, 26)
+	ecosystemBalance := cosmosMath.NewIntWithDecimal(1, 24)
+	ecosystemMintSupplyRemaining := params.MaxSupply.ToLegacyDec().
+		Mul(params.EcosystemTreasuryPercentOfTotalSupply).TruncateInt()
+	previousEmission := cosmosMath.LegacyMustNewDecFromStr("0.01")
+	reputersPercent := cosmosMath.LegacyMustNewDecFromStr("0.5")
+	validatorsPercent := cosmosMath.LegacyMustNewDecFromStr("0.25")
+
+	projections, err := keeper.ProjectEmissions(
+		params,
+		blocksPerMonth,
+		startBlockHeight,
+		3,
+		networkStaked,
+		ecosystemBalance,
+		ecosystemMintSupplyRemaining,
+		cosmosMath.ZeroInt(),
+		previousEmission,
+		reputersPercent,
+		validatorsPercent,
+	)
+	s.Require().NoError(err)
+	s.Require().Len(projections, 3)
+
+	// the first month is the emission rate BeginBlocker would recalculate with the same inputs
+	lockedVestingTokens, _, _, _ := keeper.GetLockedVestingTokens(
+		blocksPerMonth,
+		cosmosMath.NewIntFromUint64(startBlockHeight),
+		params,
+	)
+	ecosystemLocked := ecosystemBalance.Add(ecosystemMintSupplyRemaining)
+	circulatingSupply := params.MaxSupply.Sub(lockedVestingTokens).Sub(ecosystemLocked)
+	target, err := keeper.GetTargetRewardEmissionPerUnitStakedToken(
+		params.FEmission,
+		ecosystemLocked,
+		networkStaked,
+		circulatingSupply,
+		params.MaxSupply,
+	)
+	s.Require().NoError(err)
+	target = keeper.GetCappedTargetEmissionPerUnitStakedToken(
+		target,
+		keeper.GetMaximumMonthlyEmissionPerUnitStakedToken(params.MaximumMonthlyPercentageYield, reputersPercent, validatorsPercent),
+	)
+	expectedEmission := keeper.GetExponentialMovingAverage(target, params.OneMonthSmoothingDegree, previousEmission)
+	s.Require().True(expectedEmission.Equal(projections[0].EmissionPerUnitStakedToken))
+	expectedEmissionPerMonth := keeper.GetTotalEmissionPerMonth(expectedEmission, networkStaked)
+	s.Require().Equal(expectedEmissionPerMonth, projections[0].EmissionPerMonth)
+	s.Require().Equal(expectedEmissionPerMonth.QuoRaw(int64(blocksPerMonth)), projections[0].BlockEmission)
+
+	cumulativeMinted := cosmosMath.ZeroInt()
+	balance := ecosystemBalance
+	remaining := ecosystemMintSupplyRemaining
+	for i, projection := range projections {
+		s.Require().Equal(uint64(i+1), projection.Month)
+		s.Require().Equal(startBlockHeight+uint64(i)*blocksPerMonth, projection.BlockHeight)
+		// the ecosystem balance is drained before anything is minted
+		payout := projection.BlockEmission.MulRaw(int64(blocksPerMonth))
+		s.Require().Equal(cosmosMath.MaxInt(payout.Sub(balance), cosmosMath.ZeroInt()), projection.Minted)
+		cumulativeMinted = cumulativeMinted.Add(projection.Minted)
+		balance = balance.Add(projection.Minted).Sub(payout)
+		remaining = remaining.Sub(projection.Minted)
+		s.Require().Equal(cumulativeMinted, projection.CumulativeMinted)
+		s.Require().Equal(balance, projection.EcosystemBalance)
+		s.Require().Equal(remaining, projection.EcosystemMintSupplyRemaining)
+	}
+	s.Require().True(projections[2].CumulativeMinted.IsPositive())
+}
+
+func (s *MintKeeperTestSuite) TestProjectEmissionsMintsNoMoreThanEcosystemMintSupplyRemaining() {
+	params := types.DefaultParams()
+	blocksPerMonth := uint64(525960)
+	ecosystemMintSupplyRemaining := cosmosMath.NewIntWithDecimal(1, 18)
+
+	projections, err := keeper.ProjectEmissions(
+		params,
+		blocksPerMonth,
+		blocksPerMonth*13+1,
+		2,
+		cosmosMath.NewIntWithDecimal(3, 26),
+		cosmosMath.ZeroInt(),
+		ecosystemMintSupplyRemaining,
+		cosmosMath.ZeroInt(),
+		cosmosMath.LegacyMustNewDecFromStr("0.01"),
+		cosmosMath.LegacyMustNewDecFromStr("0.5"),
+		cosmosMath.LegacyMustNewDecFromStr("0.25"),
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(ecosystemMintSupplyRemaining, projections[0].Minted)
+	s.Require().True(projections[0].EcosystemBalance.IsZero())
+	s.Require().True(projections[1].Minted.IsZero())
+	s.Require().Equal(ecosystemMintSupplyRemaining, projections[1].CumulativeMinted)
+	s.Require().True(projections[1].EcosystemMintSupplyRemaining.IsZero())
+}
@@ -29,6 +29,14 @@ func (k Keeper) InitGenesis(ctx context.Context, ak types.AccountKeeper, data *t
 		panic(err)
 	}
 
+	totalBurnedFees := data.TotalBurnedFees
+	if totalBurnedFees.IsNil() {
+		totalBurnedFees = types.DefaultTotalBurnedFees()
+	}
+	if err := k.TotalBurnedFees.Set(ctx, totalBurnedFees); err != nil {
+		panic(err)
+	}
+
 	ak.GetModuleAccount(ctx, types.ModuleName)
 }
 
@@ -54,10 +62,16 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	totalBurnedFees, err := k.GetTotalBurnedFees(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return types.NewGenesisState(
 		params,
 		previousRewardEmissionPerUnitStakedToken,
 		previousBlockEmission,
 		ecosystemTokensMinted,
+		totalBurnedFees,
 	)
 }
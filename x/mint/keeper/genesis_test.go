@@ -79,10 +79,12 @@ func (s *GenesisTestSuite) TestImportExportGenesis() {
 		defaultParams.InvestorsPreseedPercentOfTotalSupply,
 		defaultParams.TeamPercentOfTotalSupply,
 		defaultParams.MaximumMonthlyPercentageYield,
+		math.LegacyMustNewDecFromStr("0.2"),
 	)
 	genesisState.PreviousRewardEmissionPerUnitStakedToken = types.DefaultPreviousRewardEmissionPerUnitStakedToken()
 	genesisState.PreviousBlockEmission = types.DefaultPreviousBlockEmission()
 	genesisState.EcosystemTokensMinted = types.DefaultEcosystemTokensMinted()
+	genesisState.TotalBurnedFees = math.NewInt(1000)
 
 	s.keeper.InitGenesis(s.sdkCtx, s.accountKeeper, genesisState)
 
@@ -109,4 +111,5 @@ func (s *GenesisTestSuite) TestImportExportGenesis() {
 	s.Require().Equal(genesisState.Params, genesisState2.Params)
 	s.Require().True(genesisState.PreviousRewardEmissionPerUnitStakedToken.Equal(genesisState2.PreviousRewardEmissionPerUnitStakedToken))
 	s.Require().True(genesisState.EcosystemTokensMinted.Equal(genesisState2.EcosystemTokensMinted))
+	s.Require().True(genesisState.TotalBurnedFees.Equal(genesisState2.TotalBurnedFees))
 }
@@ -16,6 +16,7 @@ import (
 	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // Keeper of the mint store
@@ -33,6 +34,7 @@ type Keeper struct {
 	PreviousRewardEmissionPerUnitStakedToken collections.Item[math.LegacyDec]
 	PreviousBlockEmission                    collections.Item[math.Int]
 	EcosystemTokensMinted                    collections.Item[math.Int]
+	TotalBurnedFees                          collections.Item[math.Int]
 }
 
 // NewKeeper creates a new mint Keeper instance
@@ -64,6 +66,7 @@ func NewKeeper(
 		PreviousRewardEmissionPerUnitStakedToken: collections.NewItem(sb, types.PreviousRewardEmissionPerUnitStakedTokenKey, "previousrewardsemissionsperunitstakedtoken", alloraMath.LegacyDecValue),
 		PreviousBlockEmission:                    collections.NewItem(sb, types.PreviousBlockEmissionKey, "previousblockemission", sdk.IntValue),
 		EcosystemTokensMinted:                    collections.NewItem(sb, types.EcosystemTokensMintedKey, "ecosystemtokensminted", sdk.IntValue),
+		TotalBurnedFees:                          collections.NewItem(sb, types.TotalBurnedFeesKey, "totalburnedfees", sdk.IntValue),
 	}
 
 	schema, err := sb.Build()
@@ -101,6 +104,59 @@ func (k Keeper) AddEcosystemTokensMinted(ctx context.Context, minted math.Int) e
 	return k.EcosystemTokensMinted.Set(ctx, newTotal)
 }
 
+// Returns the total tokens burned out of collected fees over the life of the blockchain
+func (k Keeper) GetTotalBurnedFees(ctx context.Context) (math.Int, error) {
+	total, err := k.TotalBurnedFees.Get(ctx)
+	if errorsmod.IsOf(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+	return total, err
+}
+
+// BurnFees burns the FeeBurnFraction share of fees just collected into the ecosystem treasury
+// and adds it to the ledger of burned fees. Returns the amount burned.
+func (k Keeper) BurnFees(ctx context.Context, fees math.Int) (math.Int, error) {
+	moduleParams, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.Int{}, errorsmod.Wrap(err, "error getting params")
+	}
+	if moduleParams.FeeBurnFraction.IsNil() || fees.IsNil() || !fees.IsPositive() {
+		return math.ZeroInt(), nil
+	}
+	burned := moduleParams.FeeBurnFraction.MulInt(fees).TruncateInt()
+	if burned.IsZero() {
+		return burned, nil
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(moduleParams.MintDenom, burned))
+	if err := k.bankKeeper.BurnCoins(ctx, types.EcosystemModuleName, coins); err != nil {
+		return math.Int{}, errorsmod.Wrap(err, "error burning fees")
+	}
+	total, err := k.GetTotalBurnedFees(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+	if err := k.TotalBurnedFees.Set(ctx, total.Add(burned)); err != nil {
+		return math.Int{}, errorsmod.Wrap(err, "error setting total burned fees")
+	}
+	return burned, nil
+}
+
+// GrantEcosystemBurnerPermission lets the ecosystem treasury burn fees. Module accounts keep the permissions
+// they were created with, so the ecosystem account of chains started before fee burning must be updated.
+func (k Keeper) GrantEcosystemBurnerPermission(ctx context.Context) error {
+	acc := k.accountKeeper.GetModuleAccount(ctx, types.EcosystemModuleName)
+	if acc == nil || acc.HasPermission(authtypes.Burner) {
+		return nil
+	}
+	moduleAcc, ok := acc.(*authtypes.ModuleAccount)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for the %s module account", acc, types.EcosystemModuleName)
+	}
+	moduleAcc.Permissions = append(moduleAcc.Permissions, authtypes.Burner)
+	k.accountKeeper.SetModuleAccount(ctx, moduleAcc)
+	return nil
+}
+
 /// STAKING KEEPER RELATED FUNCTIONS
 
 // StakingTokenSupply implements an alias call to the underlying staking keeper's
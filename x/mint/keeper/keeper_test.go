@@ -105,3 +105,50 @@ func (s *MintKeeperTestSuite) TestAliasFunctions() {
 	s.emissionsKeeper.EXPECT().SetRewardCurrentBlockEmission(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	s.Require().NoError(s.mintKeeper.PayAlloraRewardsFromEcosystem(s.ctx, fees))
 }
+
+func (s *MintKeeperTestSuite) TestBurnFees() {
+	params := types.DefaultParams()
+	params.FeeBurnFraction = math.LegacyMustNewDecFromStr("0.25")
+	s.Require().NoError(s.mintKeeper.Params.Set(s.ctx, params))
+
+	burnedCoins := sdk.NewCoins(sdk.NewCoin(params.MintDenom, math.NewInt(250)))
+	s.bankKeeper.EXPECT().BurnCoins(s.ctx, types.EcosystemModuleName, burnedCoins).Return(nil).Times(2)
+	burned, err := s.mintKeeper.BurnFees(s.ctx, math.NewInt(1000))
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(250), burned)
+	burned, err = s.mintKeeper.BurnFees(s.ctx, math.NewInt(1003))
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(250), burned)
+
+	totalBurnedFees, err := s.mintKeeper.GetTotalBurnedFees(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(500), totalBurnedFees)
+
+	// Nothing is burned at a zero fraction
+	params.FeeBurnFraction = math.LegacyZeroDec()
+	s.Require().NoError(s.mintKeeper.Params.Set(s.ctx, params))
+	burned, err = s.mintKeeper.BurnFees(s.ctx, math.NewInt(1000))
+	s.Require().NoError(err)
+	s.Require().True(burned.IsZero())
+}
+
+func (s *MintKeeperTestSuite) TestGetCirculatingSupplyExcludesBurnedFees() {
+	params := types.DefaultParams()
+	blocksPerMonth := uint64(525960)
+	ecosystemAddr := authtypes.NewModuleAddress(types.EcosystemModuleName)
+	s.accountKeeper.EXPECT().GetModuleAddress(types.EcosystemModuleName).Return(ecosystemAddr).AnyTimes()
+	s.bankKeeper.EXPECT().GetBalance(s.ctx, ecosystemAddr, params.MintDenom).
+		Return(sdk.NewCoin(params.MintDenom, math.ZeroInt())).AnyTimes()
+	s.Require().NoError(s.mintKeeper.EcosystemTokensMinted.Set(s.ctx, math.ZeroInt()))
+
+	circulatingBefore, totalBefore, _, _, err := keeper.GetCirculatingSupply(s.ctx, s.mintKeeper, params, 1, blocksPerMonth)
+	s.Require().NoError(err)
+	s.Require().Equal(params.MaxSupply, totalBefore)
+
+	burned := math.NewInt(1000)
+	s.Require().NoError(s.mintKeeper.TotalBurnedFees.Set(s.ctx, burned))
+	circulatingAfter, totalAfter, _, _, err := keeper.GetCirculatingSupply(s.ctx, s.mintKeeper, params, 1, blocksPerMonth)
+	s.Require().NoError(err)
+	s.Require().Equal(params.MaxSupply.Sub(burned), totalAfter)
+	s.Require().Equal(circulatingBefore.Sub(burned), circulatingAfter)
+}
@@ -122,7 +122,7 @@ func (q queryServer) EmissionInfo(ctx context.Context, _ *types.QueryEmissionInf
 		ecosystemLocked,
 		networkStakedTokens,
 		circulatingSupply,
-		moduleParams.MaxSupply,
+		totalSupply,
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get target reward emission per unit staked token")
@@ -198,3 +198,107 @@ func (q queryServer) EmissionInfo(ctx context.Context, _ *types.QueryEmissionInf
 		PreviousRewardEmissionPerUnitStakedToken: previousRewardEmissionPerUnitStakedToken,
 	}, nil
 }
+
+// Max number of months a ProjectEmissions query may project, bounding the work of the query
+const MaxProjectedMonths = 120
+
+// ProjectEmissions projects the emissions of the coming months, starting with the next
+// recalculation of the emission rate, under an assumed staked supply and ecosystem balance.
+// Unset assumptions default to the current state of the chain.
+func (q queryServer) ProjectEmissions(ctx context.Context, req *types.QueryProjectEmissionsRequest) (*types.QueryProjectEmissionsResponse, error) {
+	if req.Months == 0 || req.Months > MaxProjectedMonths {
+		return nil, errors.Wrapf(types.ErrInvalidEmissionProjection, "months must be between 1 and %d: %d", MaxProjectedMonths, req.Months)
+	}
+	moduleParams, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get module params")
+	}
+	blocksPerMonth, err := q.k.GetParamsBlocksPerMonth(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get blocks per month")
+	}
+	if blocksPerMonth == 0 {
+		return nil, errors.Wrap(types.ErrZeroDenominator, "blocks per month is zero")
+	}
+
+	networkStaked := req.StakedSupply
+	if networkStaked.IsNil() {
+		networkStaked, err = GetNumStakedTokens(ctx, q.k)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get number of staked tokens")
+		}
+	}
+	ecosystemBalance := req.EcosystemBalance
+	if ecosystemBalance.IsNil() {
+		ecosystemBalance, err = q.k.GetEcosystemBalance(ctx, moduleParams.MintDenom)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get ecosystem balance")
+		}
+	}
+	if networkStaked.IsNegative() || ecosystemBalance.IsNegative() {
+		return nil, errors.Wrapf(
+			types.ErrInvalidEmissionProjection,
+			"staked supply and ecosystem balance cannot be negative: %s | %s",
+			networkStaked.String(),
+			ecosystemBalance.String(),
+		)
+	}
+	ecosystemMintSupplyRemaining, err := q.k.GetEcosystemMintSupplyRemaining(ctx, moduleParams)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get ecosystem mint supply remaining")
+	}
+	totalBurnedFees, err := q.k.GetTotalBurnedFees(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get total burned fees")
+	}
+	previousRewardEmissionPerUnitStakedToken, err := q.k.GetPreviousRewardEmissionPerUnitStakedToken(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get previous reward emission per unit staked token")
+	}
+	reputersPercent, err := q.k.GetPreviousPercentageRewardToStakedReputers(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get previous percentage reward to staked reputers")
+	}
+	vPercentADec, err := q.k.GetValidatorsVsAlloraPercentReward(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get validators vs allora percent reward")
+	}
+	vPercent, err := vPercentADec.SdkLegacyDec()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert validators vs allora percent reward to legacy dec")
+	}
+
+	// the emission rate is recalculated on the first block of every month
+	blockHeight := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	nextRecalculation := blockHeight/blocksPerMonth*blocksPerMonth + 1
+	if nextRecalculation <= blockHeight {
+		nextRecalculation += blocksPerMonth
+	}
+
+	projections, err := ProjectEmissions(
+		moduleParams,
+		blocksPerMonth,
+		nextRecalculation,
+		req.Months,
+		networkStaked,
+		ecosystemBalance,
+		ecosystemMintSupplyRemaining,
+		totalBurnedFees,
+		previousRewardEmissionPerUnitStakedToken,
+		reputersPercent,
+		vPercent,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to project emissions")
+	}
+	return &types.QueryProjectEmissionsResponse{Projections: projections}, nil
+}
+
+// TotalBurnedFees returns the number of tokens burned out of the fees collected
+func (q queryServer) TotalBurnedFees(ctx context.Context, _ *types.QueryTotalBurnedFeesRequest) (*types.QueryTotalBurnedFeesResponse, error) {
+	totalBurnedFees, err := q.k.GetTotalBurnedFees(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get total burned fees")
+	}
+	return &types.QueryTotalBurnedFeesResponse{TotalBurnedFees: totalBurnedFees}, nil
+}
@@ -71,6 +71,13 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	suite.Require().Equal(params.Params, kparams)
 }
 
+func (suite *MintTestSuite) TestGRPCProjectEmissionsRejectsInvalidMonths() {
+	_, err := suite.queryClient.ProjectEmissions(gocontext.Background(), &types.QueryProjectEmissionsRequest{Months: 0})
+	suite.Require().ErrorIs(err, types.ErrInvalidEmissionProjection)
+	_, err = suite.queryClient.ProjectEmissions(gocontext.Background(), &types.QueryProjectEmissionsRequest{Months: keeper.MaxProjectedMonths + 1})
+	suite.Require().ErrorIs(err, types.ErrInvalidEmissionProjection)
+}
+
 func TestMintTestSuite(t *testing.T) {
 	suite.Run(t, new(MintTestSuite))
 }
@@ -0,0 +1,36 @@
+package v3
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/mint/keeper"
+	"github.com/allora-network/allora-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateStore migrates the store from version 2 to version 3
+// it does the following:
+// - sets the FeeBurnFraction param, added in this version, to its default
+// - starts the ledger of burned fees at zero
+// - allows the ecosystem treasury to burn the fees it collects
+func MigrateStore(ctx sdk.Context, mintKeeper keeper.Keeper) error {
+	ctx.Logger().Info("MIGRATING MINT MODULE FROM VERSION 2 TO VERSION 3")
+	params, err := mintKeeper.Params.Get(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting params")
+	}
+	params.FeeBurnFraction = types.DefaultParams().FeeBurnFraction
+	if err := params.Validate(); err != nil {
+		return errorsmod.Wrap(err, "error validating migrated params")
+	}
+	if err := mintKeeper.Params.Set(ctx, params); err != nil {
+		return errorsmod.Wrap(err, "error setting params")
+	}
+	if err := mintKeeper.TotalBurnedFees.Set(ctx, types.DefaultTotalBurnedFees()); err != nil {
+		return errorsmod.Wrap(err, "error setting total burned fees")
+	}
+	if err := mintKeeper.GrantEcosystemBurnerPermission(ctx); err != nil {
+		return errorsmod.Wrap(err, "error granting the ecosystem account the burner permission")
+	}
+	ctx.Logger().Info("MIGRATING MINT MODULE FROM VERSION 2 TO VERSION 3 COMPLETE")
+	return nil
+}
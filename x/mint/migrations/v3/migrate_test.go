@@ -0,0 +1,74 @@
+package v3_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/allora-network/allora-chain/x/mint/keeper"
+	v3 "github.com/allora-network/allora-chain/x/mint/migrations/v3"
+	mint "github.com/allora-network/allora-chain/x/mint/module"
+	minttestutil "github.com/allora-network/allora-chain/x/mint/testutil"
+	"github.com/allora-network/allora-chain/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	cosmostestutil "github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MintV3MigrationTestSuite struct {
+	suite.Suite
+
+	ctx           sdk.Context
+	accountKeeper *minttestutil.MockAccountKeeper
+	mintKeeper    keeper.Keeper
+}
+
+func TestMintV3MigrationTestSuite(t *testing.T) {
+	suite.Run(t, new(MintV3MigrationTestSuite))
+}
+
+func (s *MintV3MigrationTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(mint.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := cosmostestutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx
+
+	ctrl := gomock.NewController(s.T())
+	accountKeeper := minttestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(sdk.AccAddress{})
+	s.accountKeeper = accountKeeper
+	s.mintKeeper = keeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		minttestutil.NewMockStakingKeeper(ctrl),
+		accountKeeper,
+		minttestutil.NewMockBankKeeper(ctrl),
+		minttestutil.NewMockEmissionsKeeper(ctrl),
+		authtypes.FeeCollectorName,
+	)
+}
+
+func (s *MintV3MigrationTestSuite) TestMigrateStore() {
+	// params stored before version 3 have no fee burn fraction
+	oldParams := types.DefaultParams()
+	oldParams.FeeBurnFraction = math.LegacyDec{}
+	s.Require().NoError(s.mintKeeper.Params.Set(s.ctx, oldParams))
+
+	// the ecosystem account was created without the permission to burn
+	ecosystem := authtypes.NewEmptyModuleAccount(types.EcosystemModuleName)
+	s.accountKeeper.EXPECT().GetModuleAccount(s.ctx, types.EcosystemModuleName).Return(ecosystem)
+	s.accountKeeper.EXPECT().SetModuleAccount(s.ctx, authtypes.NewEmptyModuleAccount(types.EcosystemModuleName, authtypes.Burner))
+
+	s.Require().NoError(v3.MigrateStore(s.ctx, s.mintKeeper))
+
+	params, err := s.mintKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams(), params)
+	totalBurnedFees, err := s.mintKeeper.TotalBurnedFees.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(totalBurnedFees.IsZero())
+}
@@ -26,6 +26,19 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "emission-info",
 					Short:     "Get a bunch of debugging info about the inflation rate",
 				},
+				{
+					RpcMethod: "ProjectEmissions",
+					Use:       "project-emissions [months]",
+					Short:     "Project the emissions of the coming months, optionally under an assumed --staked-supply and --ecosystem-balance",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "months"},
+					},
+				},
+				{
+					RpcMethod: "TotalBurnedFees",
+					Use:       "total-burned-fees",
+					Short:     "Query the number of tokens burned out of the fees collected",
+				},
 			},
 			SubCommands:          nil,
 			EnhanceCustomCommand: false,
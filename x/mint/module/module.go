@@ -10,18 +10,21 @@ import (
 	"cosmossdk.io/depinject"
 	modulev1 "github.com/allora-network/allora-chain/x/mint/api/mint/module/v1"
 	"github.com/allora-network/allora-chain/x/mint/keeper"
+	migrationV3 "github.com/allora-network/allora-chain/x/mint/migrations/v3"
+	"github.com/allora-network/allora-chain/x/mint/simulation"
 	"github.com/allora-network/allora-chain/x/mint/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 )
 
 // ConsensusVersion defines the current x/mint module consensus version.
-const ConsensusVersion = 2
+const ConsensusVersion = 3
 
 var (
 	_ module.AppModuleBasic = AppModule{} //nolint:exhaustruct
@@ -30,6 +33,8 @@ var (
 
 	_ appmodule.AppModule       = AppModule{} //nolint:exhaustruct
 	_ appmodule.HasBeginBlocker = AppModule{} //nolint:exhaustruct
+
+	_ module.AppModuleSimulation = AppModule{} //nolint:exhaustruct
 )
 
 // AppModuleBasic defines the basic application module used by the mint module.
@@ -81,6 +86,7 @@ type AppModule struct {
 
 	keeper     keeper.Keeper
 	authKeeper types.AccountKeeper
+	bankKeeper types.BankKeeper
 }
 
 // NewAppModule creates a new AppModule object. If the InflationCalculationFn
@@ -89,11 +95,13 @@ func NewAppModule(
 	cdc codec.Codec,
 	keeper keeper.Keeper,
 	ak types.AccountKeeper,
+	bk types.BankKeeper,
 ) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{cdc: cdc},
 		keeper:         keeper,
 		authKeeper:     ak,
+		bankKeeper:     bk,
 	}
 }
 
@@ -108,6 +116,12 @@ func (am AppModule) IsAppModule() {}
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServiceServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.keeper))
+
+	if err := cfg.RegisterMigration(types.ModuleName, 2, func(ctx sdk.Context) error {
+		return migrationV3.MigrateStore(ctx, am.keeper)
+	}); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the mint module. It returns
@@ -138,6 +152,21 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 	return err
 }
 
+// GenerateGenesisState creates a randomized GenState of the mint module.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// RegisterStoreDecoder registers a decoder for the mint module's types.
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simtypes.NewStoreDecoderFuncFromCollectionsSchema(am.keeper.Schema)
+}
+
+// WeightedOperations returns all the mint module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(simState.AppParams, simState.TxConfig, am.authKeeper, am.bankKeeper, am.keeper)
+}
+
 //
 // App Wiring Setup
 //
@@ -186,7 +215,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	)
 
 	// when no inflation calculation function is provided it will use the default types.DefaultInflationCalculationFn
-	m := NewAppModule(in.Cdc, k, in.AccountKeeper)
+	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper)
 
 	return ModuleOutputs{MintKeeper: k, Module: m, Out: depinject.Out{}}
 }
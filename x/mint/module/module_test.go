@@ -159,11 +159,11 @@ func (s *MintModuleTestSuite) SetupTest() {
 	s.emissionsKeeper = emissionsKeeper
 	s.mintKeeper = mintKeeper
 
-	emissionsModule := emissions.NewAppModule(encCfg.Codec, s.emissionsKeeper)
+	emissionsModule := emissions.NewAppModule(encCfg.Codec, s.emissionsKeeper, accountKeeper, bankKeeper)
 	emissionsDefaultGenesis := emissionsModule.DefaultGenesis(encCfg.Codec)
 	emissionsModule.InitGenesis(ctx, encCfg.Codec, emissionsDefaultGenesis)
 
-	mintAppModule := mint.NewAppModule(encCfg.Codec, s.mintKeeper, s.accountKeeper)
+	mintAppModule := mint.NewAppModule(encCfg.Codec, s.mintKeeper, accountKeeper, bankKeeper)
 	defaultGenesis := mintAppModule.DefaultGenesis(encCfg.Codec)
 	mintAppModule.InitGenesis(ctx, encCfg.Codec, defaultGenesis)
 	s.appModule = mintAppModule
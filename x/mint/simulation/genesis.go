@@ -0,0 +1,67 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// Keys of the randomized genesis parameters, which may be overridden by a simulation params file
+const (
+	fEmission                     = "f_emission"
+	oneMonthSmoothingDegree       = "one_month_smoothing_degree"
+	maximumMonthlyPercentageYield = "maximum_monthly_percentage_yield"
+	feeBurnFraction               = "fee_burn_fraction"
+)
+
+// Returns a random fraction in [0, max] with the given number of decimals
+func genFraction(r *rand.Rand, max int64, precision int64) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(r.Int63n(max+1), precision)
+}
+
+func genFEmission(r *rand.Rand) math.LegacyDec {
+	return genFraction(r, 100, 3)
+}
+
+func genOneMonthSmoothingDegree(r *rand.Rand) math.LegacyDec {
+	return genFraction(r, 100, 2)
+}
+
+func genMaximumMonthlyPercentageYield(r *rand.Rand) math.LegacyDec {
+	return genFraction(r, 200, 4)
+}
+
+func genFeeBurnFraction(r *rand.Rand) math.LegacyDec {
+	return genFraction(r, 100, 2)
+}
+
+// RandomizedParams returns module params with the emission shaping fields randomized
+func RandomizedParams(simState *module.SimulationState) types.Params {
+	r := simState.Rand
+	params := types.DefaultParams()
+	params.MintDenom = simState.BondDenom
+	simState.AppParams.GetOrGenerate(fEmission, &params.FEmission, r, func(r *rand.Rand) { params.FEmission = genFEmission(r) })
+	simState.AppParams.GetOrGenerate(oneMonthSmoothingDegree, &params.OneMonthSmoothingDegree, r, func(r *rand.Rand) { params.OneMonthSmoothingDegree = genOneMonthSmoothingDegree(r) })
+	simState.AppParams.GetOrGenerate(maximumMonthlyPercentageYield, &params.MaximumMonthlyPercentageYield, r, func(r *rand.Rand) {
+		params.MaximumMonthlyPercentageYield = genMaximumMonthlyPercentageYield(r)
+	})
+	simState.AppParams.GetOrGenerate(feeBurnFraction, &params.FeeBurnFraction, r, func(r *rand.Rand) { params.FeeBurnFraction = genFeeBurnFraction(r) })
+	return params
+}
+
+// RandomizedGenState generates a random GenesisState for the mint module
+func RandomizedGenState(simState *module.SimulationState) {
+	genesis := types.DefaultGenesisState()
+	genesis.Params = RandomizedParams(simState)
+
+	paramsBytes, err := json.MarshalIndent(&genesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated minting parameters:\n%s\n", paramsBytes)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
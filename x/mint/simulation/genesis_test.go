@@ -0,0 +1,38 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/mint/simulation"
+	"github.com/allora-network/allora-chain/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRandomizedGenState(t *testing.T) {
+	for seed := int64(0); seed < 20; seed++ {
+		r := rand.New(rand.NewSource(seed)) //nolint:gosec // simulations need deterministic randomness
+		simState := module.SimulationState{ //nolint:exhaustruct // the remaining fields are not used by genesis generation
+			AppParams:    make(simtypes.AppParams),
+			Cdc:          codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+			Rand:         r,
+			BondDenom:    "uallo",
+			Accounts:     simtypes.RandomAccounts(r, 3),
+			InitialStake: math.NewInt(1000),
+			GenState:     make(map[string]json.RawMessage),
+		}
+		simulation.RandomizedGenState(&simState)
+
+		var genesis types.GenesisState
+		simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &genesis)
+		require.NoError(t, types.ValidateGenesis(genesis))
+		require.Equal(t, "uallo", genesis.Params.MintDenom)
+		require.Equal(t, types.DefaultParams().MaxSupply, genesis.Params.MaxSupply)
+	}
+}
@@ -0,0 +1,132 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/allora-network/allora-chain/x/mint/keeper"
+	"github.com/allora-network/allora-chain/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgUpdateParams              = "op_weight_msg_update_params"
+	OpWeightMsgRecalculateTargetEmission = "op_weight_msg_recalculate_target_emission"
+
+	DefaultWeightMsgUpdateParams              = 5
+	DefaultWeightMsgRecalculateTargetEmission = 10
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgUpdateParams, weightMsgRecalculateTargetEmission int
+	appParams.GetOrGenerate(OpWeightMsgUpdateParams, &weightMsgUpdateParams, nil, func(_ *rand.Rand) {
+		weightMsgUpdateParams = DefaultWeightMsgUpdateParams
+	})
+	appParams.GetOrGenerate(OpWeightMsgRecalculateTargetEmission, &weightMsgRecalculateTargetEmission, nil, func(_ *rand.Rand) {
+		weightMsgRecalculateTargetEmission = DefaultWeightMsgRecalculateTargetEmission
+	})
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgUpdateParams, SimulateMsgUpdateParams(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgRecalculateTargetEmission, SimulateMsgRecalculateTargetEmission(txGen, ak, bk, k)),
+	}
+}
+
+// Returns a random simulation account that is an emissions whitelist admin, the only ones allowed to send mint messages
+func randomWhitelistAdmin(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (simtypes.Account, bool) {
+	admins := []simtypes.Account{}
+	for _, acc := range accs {
+		if isAdmin, err := k.IsWhitelistAdmin(ctx, acc.Address.String()); err == nil && isAdmin {
+			admins = append(admins, acc)
+		}
+	}
+	if len(admins) == 0 {
+		return simtypes.Account{}, false
+	}
+	return admins[r.Intn(len(admins))], true
+}
+
+func deliver(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	msg sdk.Msg,
+	account simtypes.Account,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	return simulation.GenAndDeliverTxWithRandFees(simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           txGen,
+		Cdc:             nil,
+		Msg:             msg,
+		CoinsSpentInMsg: sdk.NewCoins(),
+		Context:         ctx,
+		SimAccount:      account,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+	})
+}
+
+func SimulateMsgUpdateParams(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.UpdateParamsRequest{Sender: "", Params: types.DefaultParams(), RecalculateTargetEmission: false, BlocksPerMonth: 0}
+		admin, found := randomWhitelistAdmin(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no whitelist admin"), nil, nil
+		}
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "failed to get params"), nil, nil
+		}
+		blocksPerMonth, err := k.GetParamsBlocksPerMonth(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "failed to get blocks per month"), nil, nil
+		}
+
+		// The supply split is left alone, it must keep adding up to the whole supply
+		params.FEmission = genFEmission(r)
+		params.OneMonthSmoothingDegree = genOneMonthSmoothingDegree(r)
+		params.MaximumMonthlyPercentageYield = genMaximumMonthlyPercentageYield(r)
+		params.FeeBurnFraction = genFeeBurnFraction(r)
+		if r.Intn(2) == 0 {
+			blocksPerMonth = uint64(simtypes.RandIntBetween(r, 1000, 1000000))
+		}
+
+		msg.Sender = admin.Address.String()
+		msg.Params = params
+		msg.RecalculateTargetEmission = r.Intn(2) == 0
+		msg.BlocksPerMonth = blocksPerMonth
+		return deliver(r, app, ctx, txGen, ak, bk, msg, admin)
+	}
+}
+
+func SimulateMsgRecalculateTargetEmission(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.RecalculateTargetEmissionRequest{Sender: ""}
+		admin, found := randomWhitelistAdmin(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no whitelist admin"), nil, nil
+		}
+		msg.Sender = admin.Address.String()
+		return deliver(r, app, ctx, txGen, ak, bk, msg, admin)
+	}
+}
@@ -11,4 +11,6 @@ var (
 	ErrNegativeCirculatingSupply                       = errors.Register(ModuleName, 6, "circulating supply cannot be negative")
 	ErrNotFound                                        = errors.Register(ModuleName, 7, "not found")
 	ErrUnauthorized                                    = errors.Register(ModuleName, 8, "unauthorized message signer")
+	ErrInvalidEmissionProjection                       = errors.Register(ModuleName, 9, "invalid emission projection request")
+	ErrInvalidTotalBurnedFees                          = errors.Register(ModuleName, 10, "invalid total burned fees")
 )
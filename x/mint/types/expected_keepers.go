@@ -20,6 +20,7 @@ type StakingKeeper interface {
 // AccountKeeper defines the contract required for account APIs.
 type AccountKeeper interface {
 	AddressCodec() address.Codec
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 	GetModuleAddress(name string) sdk.AccAddress
 
 	SetModuleAccount(context.Context, sdk.ModuleAccountI)
@@ -32,8 +33,10 @@ type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	MintCoins(ctx context.Context, name string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, name string, amt sdk.Coins) error
 	GetSupply(ctx context.Context, denom string) sdk.Coin
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 }
 
 type EmissionsKeeper interface {
@@ -54,4 +57,5 @@ type MintKeeper interface {
 	GetPreviousRewardEmissionPerUnitStakedToken(ctx context.Context) (math.LegacyDec, error)
 	GetEcosystemMintSupplyRemaining(ctx context.Context, params Params) (math.Int, error)
 	GetEcosystemBalance(ctx context.Context, mintDenom string) (math.Int, error)
+	GetTotalBurnedFees(ctx context.Context) (math.Int, error)
 }
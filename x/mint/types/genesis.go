@@ -8,12 +8,14 @@ func NewGenesisState(
 	previousRewardEmissionPerUnitStakedToken math.LegacyDec,
 	previousBlockEmission math.Int,
 	ecosystemTokensMinted math.Int,
+	totalBurnedFees math.Int,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                                   params,
 		PreviousRewardEmissionPerUnitStakedToken: previousRewardEmissionPerUnitStakedToken,
 		PreviousBlockEmission:                    previousBlockEmission,
 		EcosystemTokensMinted:                    ecosystemTokensMinted,
+		TotalBurnedFees:                          totalBurnedFees,
 	}
 }
 
@@ -24,6 +26,7 @@ func DefaultGenesisState() *GenesisState {
 		PreviousRewardEmissionPerUnitStakedToken: DefaultPreviousRewardEmissionPerUnitStakedToken(),
 		PreviousBlockEmission:                    DefaultPreviousBlockEmission(),
 		EcosystemTokensMinted:                    DefaultEcosystemTokensMinted(),
+		TotalBurnedFees:                          DefaultTotalBurnedFees(),
 	}
 }
 
@@ -42,5 +45,9 @@ func ValidateGenesis(data GenesisState) error {
 		return ErrInvalidEcosystemTokensMinted
 	}
 
+	if data.TotalBurnedFees.IsNegative() {
+		return ErrInvalidTotalBurnedFees
+	}
+
 	return nil
 }
@@ -35,6 +35,8 @@ type GenesisState struct {
 	PreviousBlockEmission                    cosmossdk_io_math.Int       `protobuf:"bytes,3,opt,name=previous_block_emission,json=previousBlockEmission,proto3,customtype=cosmossdk.io/math.Int" json:"previous_block_emission"`
 	// number of tokens minted into the ecosystem treasury
 	EcosystemTokensMinted cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=ecosystem_tokens_minted,json=ecosystemTokensMinted,proto3,customtype=cosmossdk.io/math.Int" json:"ecosystem_tokens_minted"`
+	// number of tokens burned out of the fees collected
+	TotalBurnedFees cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=total_burned_fees,json=totalBurnedFees,proto3,customtype=cosmossdk.io/math.Int" json:"total_burned_fees"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,34 +86,35 @@ func init() {
 func init() { proto.RegisterFile("mint/v1beta1/genesis.proto", fileDescriptor_1dfa75836a5d5f23) }
 
 var fileDescriptor_1dfa75836a5d5f23 = []byte{
-	// 423 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x3f, 0x6b, 0x14, 0x41,
-	0x18, 0xc6, 0x6f, 0x8c, 0x04, 0xb2, 0xa6, 0xd0, 0x23, 0xc1, 0xf5, 0x84, 0x4d, 0xb0, 0x3a, 0x84,
-	0x9b, 0x31, 0xa6, 0xb0, 0xb1, 0x3a, 0x14, 0x09, 0x18, 0x08, 0x89, 0x36, 0x36, 0xc3, 0xdc, 0xde,
-	0xcb, 0xde, 0xb0, 0x37, 0xf3, 0x2e, 0x33, 0xef, 0x25, 0xde, 0xb7, 0xf0, 0x13, 0x58, 0x5b, 0x5a,
-	0xa4, 0xf4, 0x03, 0xa4, 0x0c, 0xa9, 0xc4, 0x22, 0xc8, 0x5d, 0xe1, 0xd7, 0x90, 0x9d, 0x99, 0x3b,
-	0x14, 0x3b, 0x9b, 0x65, 0xdf, 0x3f, 0x3c, 0xbf, 0xe7, 0x19, 0xde, 0xac, 0x67, 0xb4, 0x25, 0x71,
-	0x7e, 0x30, 0x02, 0x52, 0x07, 0xa2, 0x02, 0x0b, 0x5e, 0x7b, 0xde, 0x38, 0x24, 0xec, 0x6e, 0xb7,
-	0x33, 0x9e, 0x66, 0xbd, 0x07, 0xca, 0x68, 0x8b, 0x22, 0x7c, 0xe3, 0x42, 0xef, 0x51, 0x89, 0xde,
-	0xa0, 0x97, 0xa1, 0x12, 0xb1, 0x48, 0xa3, 0x9d, 0x0a, 0x2b, 0x8c, 0xfd, 0xf6, 0x2f, 0x75, 0xf3,
-	0xbf, 0x68, 0x34, 0x6f, 0x20, 0xed, 0x3f, 0xf9, 0xb6, 0x91, 0x6d, 0xbf, 0x89, 0xf4, 0x33, 0x52,
-	0x04, 0xdd, 0x17, 0xd9, 0x66, 0xa3, 0x9c, 0x32, 0x3e, 0x67, 0xfb, 0xac, 0x7f, 0xef, 0xf9, 0x0e,
-	0xff, 0xd3, 0x0d, 0x3f, 0x09, 0xb3, 0xe1, 0xd6, 0xd5, 0xed, 0x5e, 0xe7, 0xcb, 0xaf, 0xaf, 0x4f,
-	0xd9, 0x69, 0x5a, 0xef, 0x7e, 0x66, 0x19, 0x6f, 0x1c, 0x9c, 0x6b, 0x9c, 0x79, 0xe9, 0xe0, 0x42,
-	0xb9, 0xb1, 0x04, 0xa3, 0xbd, 0xd7, 0x68, 0x65, 0x03, 0x4e, 0xce, 0xac, 0x26, 0xe9, 0x49, 0xd5,
-	0x30, 0x96, 0x84, 0x35, 0xd8, 0xfc, 0xce, 0x3e, 0xeb, 0x6f, 0x0d, 0x5f, 0xb6, 0x5a, 0x3f, 0x6e,
-	0xf7, 0x1e, 0xc7, 0x20, 0x7e, 0x5c, 0x73, 0x8d, 0xc2, 0x28, 0x9a, 0xf0, 0xb7, 0x50, 0xa9, 0x72,
-	0xfe, 0x0a, 0xca, 0x9b, 0xcb, 0xc1, 0xfd, 0x94, 0x73, 0xdd, 0x8b, 0xf8, 0xfe, 0x8a, 0x79, 0x1a,
-	0x90, 0xaf, 0x13, 0xf1, 0x04, 0xdc, 0x7b, 0xab, 0xe9, 0x2c, 0xe0, 0xde, 0xb5, 0xb4, 0xee, 0x24,
-	0x7b, 0xb8, 0xf6, 0x37, 0x9a, 0x62, 0x59, 0xaf, 0xed, 0xe5, 0x1b, 0xc1, 0xc8, 0xb3, 0x64, 0x64,
-	0xf7, 0x5f, 0x23, 0x47, 0x96, 0x6e, 0x2e, 0x07, 0x59, 0xb2, 0x70, 0x64, 0x29, 0xc2, 0x77, 0x57,
-	0x82, 0xc3, 0x56, 0x6f, 0xc5, 0x6e, 0x49, 0x50, 0xa2, 0x9f, 0x7b, 0x02, 0x13, 0xa3, 0x7a, 0xd9,
-	0xbe, 0x22, 0x8c, 0xf3, 0xbb, 0xff, 0x4b, 0x5a, 0x0b, 0x86, 0x30, 0xfe, 0x38, 0xc8, 0x0d, 0x8f,
-	0xaf, 0x16, 0x05, 0xbb, 0x5e, 0x14, 0xec, 0xe7, 0xa2, 0x60, 0x9f, 0x96, 0x45, 0xe7, 0x7a, 0x59,
-	0x74, 0xbe, 0x2f, 0x8b, 0xce, 0x87, 0xc3, 0x4a, 0xd3, 0x64, 0x36, 0xe2, 0x25, 0x1a, 0xa1, 0xa6,
-	0x53, 0x74, 0x6a, 0x60, 0x81, 0x2e, 0xd0, 0xd5, 0xab, 0xb2, 0x9c, 0x28, 0x6d, 0xc5, 0x47, 0x11,
-	0x6e, 0x23, 0xdc, 0xc4, 0x68, 0x33, 0x1c, 0xc5, 0xe1, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbc,
-	0x4e, 0x99, 0xfd, 0x9e, 0x02, 0x00, 0x00,
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcf, 0x6a, 0xd4, 0x50,
+	0x14, 0xc6, 0x27, 0x5a, 0x0b, 0x8d, 0x05, 0x6d, 0x68, 0x31, 0x8e, 0x90, 0x16, 0x57, 0x83, 0x30,
+	0x89, 0xb5, 0x0b, 0x37, 0xae, 0x82, 0x7f, 0x28, 0x58, 0x28, 0xad, 0x6e, 0x44, 0xb8, 0xdc, 0x24,
+	0xc7, 0xcc, 0x25, 0x93, 0x7b, 0xc2, 0x3d, 0x67, 0x5a, 0xe7, 0x2d, 0x5c, 0xbb, 0x70, 0xed, 0xd2,
+	0x45, 0x1f, 0xa2, 0xcb, 0xd2, 0x95, 0xb8, 0x28, 0x32, 0xb3, 0xf0, 0x35, 0x24, 0x37, 0x77, 0x06,
+	0xa5, 0xbb, 0x6e, 0x42, 0xce, 0x1f, 0xbe, 0xdf, 0xf7, 0x5d, 0x8e, 0xdf, 0xaf, 0x95, 0xe6, 0xe4,
+	0x64, 0x37, 0x03, 0x96, 0xbb, 0x49, 0x09, 0x1a, 0x48, 0x51, 0xdc, 0x18, 0x64, 0x0c, 0xd6, 0xdb,
+	0x59, 0xec, 0x66, 0xfd, 0x0d, 0x59, 0x2b, 0x8d, 0x89, 0xfd, 0x76, 0x0b, 0xfd, 0x87, 0x39, 0x52,
+	0x8d, 0x24, 0x6c, 0x95, 0x74, 0x85, 0x1b, 0x6d, 0x96, 0x58, 0x62, 0xd7, 0x6f, 0xff, 0x5c, 0x37,
+	0xfc, 0x8f, 0xc6, 0xd3, 0x06, 0xdc, 0xfe, 0xe3, 0xaf, 0x2b, 0xfe, 0xfa, 0x9b, 0x8e, 0x7e, 0xcc,
+	0x92, 0x21, 0x78, 0xee, 0xaf, 0x36, 0xd2, 0xc8, 0x9a, 0x42, 0x6f, 0xc7, 0x1b, 0xdc, 0x7d, 0xb6,
+	0x19, 0xff, 0xeb, 0x26, 0x3e, 0xb4, 0xb3, 0x74, 0xed, 0xfc, 0x6a, 0xbb, 0xf7, 0xfd, 0xcf, 0x8f,
+	0x27, 0xde, 0x91, 0x5b, 0x0f, 0xbe, 0x79, 0x7e, 0xdc, 0x18, 0x38, 0x51, 0x38, 0x21, 0x61, 0xe0,
+	0x54, 0x9a, 0x42, 0x40, 0xad, 0x88, 0x14, 0x6a, 0xd1, 0x80, 0x11, 0x13, 0xad, 0x58, 0x10, 0xcb,
+	0x0a, 0x0a, 0xc1, 0x58, 0x81, 0x0e, 0x6f, 0xed, 0x78, 0x83, 0xb5, 0xf4, 0x45, 0xab, 0xf5, 0xeb,
+	0x6a, 0xfb, 0x51, 0x17, 0x84, 0x8a, 0x2a, 0x56, 0x98, 0xd4, 0x92, 0x47, 0xf1, 0x5b, 0x28, 0x65,
+	0x3e, 0x7d, 0x09, 0xf9, 0xe5, 0xd9, 0xf0, 0xbe, 0xcb, 0xb9, 0xec, 0x75, 0xf8, 0xc1, 0x82, 0x79,
+	0x64, 0x91, 0xaf, 0x1c, 0xf1, 0x10, 0xcc, 0x7b, 0xad, 0xf8, 0xd8, 0xe2, 0xde, 0xb5, 0xb4, 0x60,
+	0xe4, 0x3f, 0x58, 0xfa, 0xcb, 0xc6, 0x98, 0x57, 0x4b, 0x7b, 0xe1, 0x6d, 0x6b, 0xe4, 0xa9, 0x33,
+	0xb2, 0x75, 0xdd, 0xc8, 0xbe, 0xe6, 0xcb, 0xb3, 0xa1, 0xef, 0x2c, 0xec, 0x6b, 0xee, 0xe0, 0x5b,
+	0x0b, 0xc1, 0xb4, 0xd5, 0x5b, 0xb0, 0x5b, 0x12, 0xe4, 0x48, 0x53, 0x62, 0xa8, 0xbb, 0xa8, 0x24,
+	0xda, 0x57, 0x84, 0x22, 0x5c, 0xb9, 0x29, 0x69, 0x29, 0x68, 0xc3, 0xd0, 0x81, 0x95, 0x0b, 0x3e,
+	0xfa, 0x1b, 0x8c, 0x2c, 0xc7, 0x22, 0x9b, 0x18, 0x0d, 0x85, 0xf8, 0x04, 0x40, 0xe1, 0x9d, 0x1b,
+	0x32, 0xee, 0x59, 0xa9, 0xd4, 0x2a, 0xbd, 0x06, 0xa0, 0xf4, 0xe0, 0x7c, 0x16, 0x79, 0x17, 0xb3,
+	0xc8, 0xfb, 0x3d, 0x8b, 0xbc, 0x2f, 0xf3, 0xa8, 0x77, 0x31, 0x8f, 0x7a, 0x3f, 0xe7, 0x51, 0xef,
+	0xc3, 0x5e, 0xa9, 0x78, 0x34, 0xc9, 0xe2, 0x1c, 0xeb, 0x44, 0x8e, 0xc7, 0x68, 0xe4, 0x50, 0x03,
+	0x9f, 0xa2, 0xa9, 0x16, 0x65, 0x3e, 0x92, 0x4a, 0x27, 0x9f, 0x13, 0x7b, 0x79, 0xf6, 0xe2, 0xb2,
+	0x55, 0x7b, 0x72, 0x7b, 0x7f, 0x07, 0x00, 0x3c, 0x18, 0x3c, 0x20, 0xfc, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -134,6 +137,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.TotalBurnedFees.Size()
+		i -= size
+		if _, err := m.TotalBurnedFees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size := m.EcosystemTokensMinted.Size()
 		i -= size
@@ -202,6 +215,8 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.EcosystemTokensMinted.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.TotalBurnedFees.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -375,6 +390,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurnedFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBurnedFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -23,6 +23,7 @@ func NewParams(
 	investorsPreseedPercentOfTotalSupply math.LegacyDec,
 	teamPercentOfTotalSupply math.LegacyDec,
 	maxMonthlyPercentageYield math.LegacyDec,
+	feeBurnFraction math.LegacyDec,
 ) Params {
 	return Params{
 		MintDenom:                              mintDenom,
@@ -36,6 +37,7 @@ func NewParams(
 		InvestorsPreseedPercentOfTotalSupply:   investorsPreseedPercentOfTotalSupply,
 		TeamPercentOfTotalSupply:               teamPercentOfTotalSupply,
 		MaximumMonthlyPercentageYield:          maxMonthlyPercentageYield,
+		FeeBurnFraction:                        feeBurnFraction,
 	}
 }
 
@@ -57,6 +59,7 @@ func DefaultParams() Params {
 		InvestorsPreseedPercentOfTotalSupply:   math.LegacyMustNewDecFromStr("0.05"),   // 5%
 		TeamPercentOfTotalSupply:               math.LegacyMustNewDecFromStr("0.175"),  // 17.5%
 		MaximumMonthlyPercentageYield:          math.LegacyMustNewDecFromStr("0.0095"), // .95% per month
+		FeeBurnFraction:                        math.LegacyZeroDec(),                   // all collected fees go to the ecosystem treasury
 	}
 }
 
@@ -75,6 +78,11 @@ func DefaultEcosystemTokensMinted() math.Int {
 	return math.ZeroInt()
 }
 
+// at genesis, no fees have been burned yet
+func DefaultTotalBurnedFees() math.Int {
+	return math.ZeroInt()
+}
+
 // Validate does the sanity check on the params.
 func (p Params) Validate() error {
 	if err := validateMintDenom(p.MintDenom); err != nil {
@@ -120,6 +128,9 @@ func (p Params) Validate() error {
 	if err := validateAFractionValue(p.MaximumMonthlyPercentageYield); err != nil {
 		return err
 	}
+	if err := validateAFractionValue(p.FeeBurnFraction); err != nil {
+		return err
+	}
 	return nil
 }
 
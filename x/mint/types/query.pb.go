@@ -320,6 +320,247 @@ func (m *QueryEmissionInfoResponse) GetBlockHeightTargetEINextCalculated() uint6
 	return 0
 }
 
+// query for a month by month projection of the emissions
+type QueryProjectEmissionsRequest struct {
+	// number of months to project, starting with the next emission recalculation
+	Months uint64 `protobuf:"varint,1,opt,name=months,proto3" json:"months,omitempty"`
+	// tokens assumed staked on the network throughout, unset uses the current
+	// staked supply
+	StakedSupply cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=staked_supply,json=stakedSupply,proto3,customtype=cosmossdk.io/math.Int" json:"staked_supply"`
+	// balance of the ecosystem account at the start of the projection, unset
+	// uses the current balance
+	EcosystemBalance cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=ecosystem_balance,json=ecosystemBalance,proto3,customtype=cosmossdk.io/math.Int" json:"ecosystem_balance"`
+}
+
+func (m *QueryProjectEmissionsRequest) Reset()         { *m = QueryProjectEmissionsRequest{} }
+func (m *QueryProjectEmissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectEmissionsRequest) ProtoMessage()    {}
+func (*QueryProjectEmissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{6}
+}
+func (m *QueryProjectEmissionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectEmissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectEmissionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectEmissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectEmissionsRequest.Merge(m, src)
+}
+func (m *QueryProjectEmissionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectEmissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectEmissionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectEmissionsRequest proto.InternalMessageInfo
+
+func (m *QueryProjectEmissionsRequest) GetMonths() uint64 {
+	if m != nil {
+		return m.Months
+	}
+	return 0
+}
+
+// the projected emissions of a month
+type EmissionProjection struct {
+	// month of the projection, starting at 1
+	Month uint64 `protobuf:"varint,1,opt,name=month,proto3" json:"month,omitempty"`
+	// block at which the emission rate of the month is calculated
+	BlockHeight                uint64                      `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	EmissionPerUnitStakedToken cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=emission_per_unit_staked_token,json=emissionPerUnitStakedToken,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"emission_per_unit_staked_token"`
+	EmissionPerMonth           cosmossdk_io_math.Int       `protobuf:"bytes,4,opt,name=emission_per_month,json=emissionPerMonth,proto3,customtype=cosmossdk.io/math.Int" json:"emission_per_month"`
+	BlockEmission              cosmossdk_io_math.Int       `protobuf:"bytes,5,opt,name=block_emission,json=blockEmission,proto3,customtype=cosmossdk.io/math.Int" json:"block_emission"`
+	// tokens minted by the ecosystem account during the month
+	Minted cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=minted,proto3,customtype=cosmossdk.io/math.Int" json:"minted"`
+	// tokens minted from the start of the projection to the end of the month
+	CumulativeMinted cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=cumulative_minted,json=cumulativeMinted,proto3,customtype=cosmossdk.io/math.Int" json:"cumulative_minted"`
+	// balance of the ecosystem account at the end of the month
+	EcosystemBalance cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=ecosystem_balance,json=ecosystemBalance,proto3,customtype=cosmossdk.io/math.Int" json:"ecosystem_balance"`
+	// tokens the ecosystem account may still mint at the end of the month
+	EcosystemMintSupplyRemaining cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=ecosystem_mint_supply_remaining,json=ecosystemMintSupplyRemaining,proto3,customtype=cosmossdk.io/math.Int" json:"ecosystem_mint_supply_remaining"`
+}
+
+func (m *EmissionProjection) Reset()         { *m = EmissionProjection{} }
+func (m *EmissionProjection) String() string { return proto.CompactTextString(m) }
+func (*EmissionProjection) ProtoMessage()    {}
+func (*EmissionProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{7}
+}
+func (m *EmissionProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionProjection.Merge(m, src)
+}
+func (m *EmissionProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionProjection proto.InternalMessageInfo
+
+func (m *EmissionProjection) GetMonth() uint64 {
+	if m != nil {
+		return m.Month
+	}
+	return 0
+}
+
+func (m *EmissionProjection) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// return the projected emissions, month by month
+type QueryProjectEmissionsResponse struct {
+	Projections []EmissionProjection `protobuf:"bytes,1,rep,name=projections,proto3" json:"projections"`
+}
+
+func (m *QueryProjectEmissionsResponse) Reset()         { *m = QueryProjectEmissionsResponse{} }
+func (m *QueryProjectEmissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectEmissionsResponse) ProtoMessage()    {}
+func (*QueryProjectEmissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{8}
+}
+func (m *QueryProjectEmissionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectEmissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectEmissionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectEmissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectEmissionsResponse.Merge(m, src)
+}
+func (m *QueryProjectEmissionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectEmissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectEmissionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectEmissionsResponse proto.InternalMessageInfo
+
+func (m *QueryProjectEmissionsResponse) GetProjections() []EmissionProjection {
+	if m != nil {
+		return m.Projections
+	}
+	return nil
+}
+
+// QueryTotalBurnedFeesRequest is the request type for the Query/TotalBurnedFees
+// RPC method.
+type QueryTotalBurnedFeesRequest struct {
+}
+
+func (m *QueryTotalBurnedFeesRequest) Reset()         { *m = QueryTotalBurnedFeesRequest{} }
+func (m *QueryTotalBurnedFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalBurnedFeesRequest) ProtoMessage()    {}
+func (*QueryTotalBurnedFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{9}
+}
+func (m *QueryTotalBurnedFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalBurnedFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalBurnedFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalBurnedFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalBurnedFeesRequest.Merge(m, src)
+}
+func (m *QueryTotalBurnedFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalBurnedFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalBurnedFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalBurnedFeesRequest proto.InternalMessageInfo
+
+// QueryTotalBurnedFeesResponse is the response type for the
+// Query/TotalBurnedFees RPC method.
+type QueryTotalBurnedFeesResponse struct {
+	// number of tokens burned out of the fees collected
+	TotalBurnedFees cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=total_burned_fees,json=totalBurnedFees,proto3,customtype=cosmossdk.io/math.Int" json:"total_burned_fees"`
+}
+
+func (m *QueryTotalBurnedFeesResponse) Reset()         { *m = QueryTotalBurnedFeesResponse{} }
+func (m *QueryTotalBurnedFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalBurnedFeesResponse) ProtoMessage()    {}
+func (*QueryTotalBurnedFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{10}
+}
+func (m *QueryTotalBurnedFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalBurnedFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalBurnedFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalBurnedFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalBurnedFeesResponse.Merge(m, src)
+}
+func (m *QueryTotalBurnedFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalBurnedFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalBurnedFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalBurnedFeesResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "mint.v1beta1.QueryParamsResponse")
@@ -327,81 +568,104 @@ func init() {
 	proto.RegisterType((*QueryInflationResponse)(nil), "mint.v1beta1.QueryInflationResponse")
 	proto.RegisterType((*QueryEmissionInfoRequest)(nil), "mint.v1beta1.QueryEmissionInfoRequest")
 	proto.RegisterType((*QueryEmissionInfoResponse)(nil), "mint.v1beta1.QueryEmissionInfoResponse")
+	proto.RegisterType((*QueryProjectEmissionsRequest)(nil), "mint.v1beta1.QueryProjectEmissionsRequest")
+	proto.RegisterType((*EmissionProjection)(nil), "mint.v1beta1.EmissionProjection")
+	proto.RegisterType((*QueryProjectEmissionsResponse)(nil), "mint.v1beta1.QueryProjectEmissionsResponse")
+	proto.RegisterType((*QueryTotalBurnedFeesRequest)(nil), "mint.v1beta1.QueryTotalBurnedFeesRequest")
+	proto.RegisterType((*QueryTotalBurnedFeesResponse)(nil), "mint.v1beta1.QueryTotalBurnedFeesResponse")
 }
 
 func init() { proto.RegisterFile("mint/v1beta1/query.proto", fileDescriptor_b0718dda172d2cb4) }
 
 var fileDescriptor_b0718dda172d2cb4 = []byte{
-	// 1101 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0x4f, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xc7, 0xb3, 0xf9, 0xb5, 0xf9, 0xe1, 0x69, 0xfe, 0x4e, 0xe2, 0x64, 0xe3, 0x04, 0x27, 0x4d,
-	0xa2, 0x26, 0xaa, 0xa8, 0x4d, 0x5b, 0x09, 0xee, 0x6e, 0x2b, 0x61, 0x29, 0x29, 0xc1, 0x49, 0x41,
-	0x02, 0xc1, 0x68, 0xbc, 0x7e, 0x62, 0x8f, 0xbc, 0x3b, 0xe3, 0xee, 0xcc, 0x26, 0x36, 0x27, 0x84,
-	0x10, 0xe7, 0x4a, 0x70, 0xe0, 0x25, 0x70, 0xe4, 0xc0, 0x8b, 0xe8, 0xb1, 0x82, 0x0b, 0xe2, 0x50,
-	0x50, 0x82, 0xc4, 0x1b, 0xe0, 0x05, 0xa0, 0x9d, 0x99, 0xf5, 0x9f, 0xd4, 0x89, 0xd1, 0xfa, 0x12,
-	0x65, 0x67, 0x1e, 0x7f, 0xbe, 0xdf, 0xe7, 0xd9, 0x99, 0xd9, 0x67, 0x90, 0x1b, 0x30, 0xae, 0x8a,
-	0xa7, 0xf7, 0xab, 0xa0, 0xe8, 0xfd, 0xe2, 0xf3, 0x08, 0xc2, 0x4e, 0xa1, 0x15, 0x0a, 0x25, 0xf0,
-	0x74, 0x3c, 0x53, 0xb0, 0x33, 0xb9, 0x05, 0x1a, 0x30, 0x2e, 0x8a, 0xfa, 0xaf, 0x09, 0xc8, 0xad,
-	0x7a, 0x42, 0x06, 0x42, 0x12, 0xfd, 0x54, 0x34, 0x0f, 0x76, 0x6a, 0xa9, 0x2e, 0xea, 0xc2, 0x8c,
-	0xc7, 0xff, 0xd9, 0xd1, 0xf5, 0xba, 0x10, 0x75, 0x1f, 0x8a, 0xb4, 0xc5, 0x8a, 0x94, 0x73, 0xa1,
-	0xa8, 0x62, 0x82, 0x27, 0xbf, 0x19, 0x74, 0xa2, 0x3a, 0x2d, 0xb0, 0x33, 0x5b, 0x4b, 0x08, 0x7f,
-	0x14, 0x1b, 0x3b, 0xa4, 0x21, 0x0d, 0x64, 0x05, 0x9e, 0x47, 0x20, 0xd5, 0xd6, 0x53, 0xb4, 0x38,
-	0x30, 0x2a, 0x5b, 0x82, 0x4b, 0xc0, 0xef, 0xa3, 0xa9, 0x96, 0x1e, 0x71, 0x9d, 0x4d, 0x67, 0xef,
-	0xd6, 0x83, 0xa5, 0x42, 0x7f, 0x1e, 0x05, 0x13, 0x5d, 0xca, 0xbc, 0x7c, 0xbd, 0x31, 0xf1, 0xe3,
-	0xdf, 0x3f, 0xdd, 0x75, 0x2a, 0x36, 0x7c, 0x6b, 0x05, 0x65, 0x35, 0xaf, 0xcc, 0x4f, 0x7c, 0x6d,
-	0x2c, 0x11, 0xe2, 0x68, 0xf9, 0xf2, 0x84, 0xd5, 0x3a, 0x46, 0x19, 0x96, 0x0c, 0x6a, 0xb9, 0xe9,
-	0xd2, 0x7b, 0x31, 0xf8, 0xf7, 0xd7, 0x1b, 0x6b, 0xa6, 0x1e, 0xb2, 0xd6, 0x2c, 0x30, 0x51, 0x0c,
-	0xa8, 0x6a, 0x14, 0xf6, 0xa1, 0x4e, 0xbd, 0xce, 0x63, 0xf0, 0x7e, 0xf9, 0xf9, 0x1e, 0xb2, 0xe5,
-	0x7a, 0x0c, 0x9e, 0x71, 0xd1, 0x03, 0x6d, 0xe5, 0x90, 0xab, 0xf5, 0x9e, 0x04, 0x4c, 0x4a, 0x26,
-	0x78, 0x99, 0x9f, 0x88, 0xc4, 0xcb, 0x1f, 0x59, 0xb4, 0x3a, 0x64, 0x72, 0xcc, 0xdc, 0xf1, 0xe7,
-	0x68, 0x01, 0x3c, 0x21, 0x3b, 0x52, 0x41, 0x40, 0xaa, 0xd4, 0xa7, 0xdc, 0x03, 0x77, 0x72, 0xd3,
-	0xd9, 0xcb, 0x94, 0xde, 0xb5, 0x09, 0x65, 0xdf, 0x4c, 0xa8, 0xcc, 0x55, 0x5f, 0x2a, 0x65, 0xae,
-	0x0c, 0x74, 0xbe, 0x8b, 0x2a, 0x19, 0x12, 0x6e, 0xa0, 0x95, 0x56, 0x08, 0xa7, 0x4c, 0x44, 0x92,
-	0x54, 0x7d, 0xe1, 0x35, 0x09, 0x58, 0xfb, 0xee, 0xff, 0x52, 0x8a, 0x64, 0x13, 0x60, 0x29, 0xe6,
-	0x25, 0xd5, 0xc0, 0x67, 0x68, 0xa3, 0x97, 0x48, 0x9c, 0x3c, 0x91, 0x51, 0xab, 0xe5, 0x77, 0x48,
-	0x08, 0x01, 0x65, 0x9c, 0xf1, 0xba, 0x7b, 0x23, 0xa5, 0xe2, 0x7a, 0x17, 0x7c, 0xc0, 0xb8, 0x3a,
-	0xd2, 0xd8, 0x4a, 0x42, 0xc5, 0x7b, 0x68, 0x5e, 0x67, 0x26, 0x49, 0x0b, 0x42, 0x12, 0x08, 0xae,
-	0x1a, 0xee, 0xcd, 0x4d, 0x67, 0xef, 0x46, 0x65, 0xd6, 0x8c, 0x1f, 0x42, 0x78, 0x10, 0x8f, 0xe2,
-	0x0a, 0xda, 0x35, 0x35, 0x68, 0x00, 0xab, 0x37, 0x14, 0x51, 0x34, 0xac, 0x83, 0x22, 0x40, 0x18,
-	0xf1, 0xa9, 0x54, 0xc4, 0xa3, 0xbe, 0x17, 0xf9, 0x54, 0x41, 0xcd, 0x9d, 0xd2, 0x80, 0xdb, 0x3a,
-	0xfc, 0x03, 0x1d, 0x7d, 0xac, 0x83, 0x9f, 0x94, 0xf7, 0xa9, 0x54, 0x8f, 0xba, 0x81, 0xd7, 0x31,
-	0x39, 0xb4, 0x07, 0x98, 0xff, 0xbf, 0x92, 0xf9, 0x14, 0xda, 0xfd, 0xcc, 0x1a, 0xca, 0x72, 0x50,
-	0x67, 0x22, 0x6c, 0x12, 0xa9, 0x68, 0x13, 0x6a, 0x44, 0x89, 0x26, 0x70, 0xe9, 0xbe, 0x95, 0xb2,
-	0x80, 0x8b, 0x16, 0x77, 0xa4, 0x69, 0xc7, 0x1a, 0x86, 0x05, 0x5a, 0x8b, 0x9d, 0x40, 0x8d, 0x9c,
-	0x82, 0x54, 0x8c, 0xd7, 0xad, 0x0a, 0x51, 0x42, 0x51, 0xdf, 0xcd, 0xa4, 0xd4, 0x72, 0x0d, 0xf4,
-	0x63, 0xc3, 0x34, 0x5a, 0xc7, 0x31, 0x11, 0x7f, 0xeb, 0xa0, 0xdd, 0xe1, 0x8a, 0x8c, 0xc7, 0x03,
-	0x22, 0x8c, 0x4f, 0x34, 0x90, 0x00, 0x35, 0x17, 0xa5, 0x54, 0xdf, 0x1e, 0xa2, 0x5e, 0x4e, 0xe8,
-	0x87, 0x06, 0x8e, 0xbf, 0x72, 0xd0, 0xce, 0x28, 0x23, 0xda, 0xc5, 0xad, 0x94, 0x2e, 0x36, 0xaf,
-	0x73, 0x71, 0x14, 0x5b, 0x08, 0x50, 0xee, 0x8a, 0xe2, 0x03, 0x0d, 0xdc, 0xe9, 0x94, 0xba, 0x2b,
-	0xc3, 0x6a, 0x0f, 0x34, 0xc0, 0x9f, 0xa1, 0xde, 0xd1, 0x40, 0x4c, 0x90, 0x3b, 0x93, 0x52, 0x64,
-	0xae, 0x4b, 0xda, 0xd7, 0x20, 0x4c, 0x10, 0xf6, 0x58, 0xa8, 0x17, 0x6f, 0x9c, 0x88, 0xd9, 0xf6,
-	0xee, 0x6c, 0x4a, 0xfc, 0x42, 0x1f, 0xcb, 0x6c, 0x75, 0xfc, 0x21, 0x42, 0x01, 0x6d, 0x27, 0xe0,
-	0xb9, 0x94, 0xe0, 0x4c, 0x40, 0xdb, 0x16, 0xf8, 0xc2, 0x41, 0x77, 0x93, 0x8d, 0x6a, 0xcf, 0x2f,
-	0x12, 0x52, 0x05, 0xfa, 0x04, 0x89, 0x38, 0x53, 0x03, 0xfb, 0xce, 0x9d, 0xd7, 0x8a, 0x69, 0xbf,
-	0x2f, 0x3b, 0x46, 0x29, 0x39, 0x28, 0x2b, 0x54, 0xc1, 0x21, 0x84, 0xcf, 0x38, 0x53, 0x7d, 0xdb,
-	0x11, 0x53, 0x34, 0x1f, 0x42, 0x2b, 0x52, 0x10, 0xea, 0x73, 0xcc, 0x03, 0xae, 0xdc, 0x85, 0xb1,
-	0x74, 0xe7, 0x12, 0xde, 0xa1, 0xc1, 0x61, 0x40, 0xf8, 0x94, 0xfa, 0xac, 0x46, 0xcd, 0x4e, 0xb3,
-	0x22, 0x78, 0x2c, 0x91, 0x85, 0x1e, 0x31, 0x91, 0xf9, 0xc1, 0x41, 0x85, 0x80, 0xb6, 0x59, 0x10,
-	0x05, 0xe6, 0x34, 0xf6, 0x3b, 0xbd, 0x2a, 0x0f, 0x2f, 0xf0, 0xe2, 0x58, 0x1e, 0xf6, 0xac, 0xda,
-	0x81, 0x11, 0x4b, 0x0a, 0x3d, 0xa4, 0xc8, 0xdf, 0x3b, 0xe8, 0x1d, 0xfb, 0xde, 0x43, 0x38, 0xa3,
-	0x61, 0x6d, 0x94, 0xb1, 0xa5, 0xb1, 0x8c, 0xdd, 0x31, 0x5a, 0x15, 0x2d, 0x75, 0x8d, 0xad, 0x2f,
-	0x51, 0x7e, 0x84, 0x8f, 0xec, 0x58, 0x3e, 0x72, 0x70, 0xb5, 0xf6, 0x17, 0x08, 0x0f, 0x68, 0x9b,
-	0xef, 0xe7, 0x72, 0xea, 0x06, 0xa4, 0xa7, 0x64, 0xbe, 0xb9, 0x9f, 0xa0, 0xd9, 0x4b, 0x7d, 0xc7,
-	0x4a, 0x4a, 0xf6, 0x4c, 0x75, 0xa0, 0xdf, 0x78, 0x86, 0x66, 0xba, 0x6b, 0x8f, 0x78, 0x91, 0x72,
-	0xdd, 0x94, 0xdc, 0xe9, 0x2e, 0xe6, 0x51, 0xa4, 0xe2, 0x7a, 0x50, 0xdf, 0x17, 0x21, 0xb5, 0x2b,
-	0x44, 0x6a, 0xf6, 0x6a, 0xda, 0x7a, 0x18, 0x96, 0x59, 0x01, 0x32, 0xe6, 0xc7, 0xbb, 0xa3, 0xdb,
-	0x91, 0xfd, 0xb7, 0x45, 0x98, 0x1b, 0x6f, 0x77, 0x24, 0x6a, 0xa3, 0x96, 0xe1, 0x83, 0x7f, 0x26,
-	0xd1, 0x4d, 0xdd, 0xe1, 0xe2, 0x26, 0x9a, 0x32, 0x1d, 0x2b, 0xde, 0x1c, 0xec, 0x63, 0xdf, 0xbc,
-	0x0c, 0xe4, 0x6e, 0x5f, 0x13, 0x61, 0x9a, 0xe3, 0xad, 0xf5, 0xaf, 0x7f, 0xfd, 0xeb, 0xbb, 0xc9,
-	0x65, 0xbc, 0x54, 0x1c, 0xb8, 0x68, 0xd8, 0x0e, 0xf8, 0x0c, 0x65, 0xba, 0xfd, 0x3d, 0xde, 0x1e,
-	0x42, 0xbb, 0x7c, 0x2d, 0xc8, 0xed, 0x5c, 0x1f, 0x64, 0x55, 0x37, 0xb4, 0xea, 0x2a, 0x5e, 0x19,
-	0x54, 0xed, 0x76, 0xfb, 0xf8, 0x1b, 0x07, 0x4d, 0xf7, 0x37, 0xf3, 0xf8, 0xce, 0x10, 0xee, 0x90,
-	0xab, 0x40, 0x6e, 0x77, 0x64, 0x9c, 0xb5, 0xb0, 0xad, 0x2d, 0xbc, 0x8d, 0xd7, 0x06, 0x2d, 0x74,
-	0xdf, 0x37, 0xe3, 0x27, 0xa2, 0x74, 0xf0, 0xf2, 0x3c, 0xef, 0xbc, 0x3a, 0xcf, 0x3b, 0x7f, 0x9e,
-	0xe7, 0x9d, 0x17, 0x17, 0xf9, 0x89, 0x57, 0x17, 0xf9, 0x89, 0xdf, 0x2e, 0xf2, 0x13, 0x9f, 0x3e,
-	0xac, 0x33, 0xd5, 0x88, 0xaa, 0x05, 0x4f, 0x04, 0x45, 0xb3, 0x90, 0xee, 0xd9, 0x46, 0x2e, 0x79,
-	0xf4, 0x1a, 0x94, 0xf1, 0x62, 0xdb, 0xe0, 0xf5, 0xc5, 0xad, 0x3a, 0xa5, 0x6f, 0x6e, 0x0f, 0xff,
-	0x0d, 0x00, 0x00, 0xff, 0xff, 0xfe, 0xc7, 0x7d, 0xe3, 0x5f, 0x0e, 0x00, 0x00,
+	// 1390 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcb, 0x6f, 0xdb, 0xc6,
+	0x13, 0x36, 0x63, 0x5b, 0x89, 0xc6, 0x4a, 0x6c, 0x6f, 0xfc, 0xa0, 0x65, 0x47, 0xb6, 0x95, 0x20,
+	0xd6, 0xcf, 0xbf, 0x46, 0xca, 0x03, 0x68, 0xef, 0x4a, 0x52, 0xc4, 0x40, 0x9c, 0xba, 0x8a, 0xd3,
+	0x02, 0x7d, 0x11, 0x2b, 0x6a, 0x23, 0x6d, 0x4d, 0xee, 0x2a, 0xe4, 0xd2, 0x8f, 0x3e, 0x80, 0xa2,
+	0x2d, 0x7a, 0x4e, 0xd1, 0x1e, 0x7a, 0xe9, 0x3d, 0xc7, 0x1e, 0xfa, 0x47, 0xe4, 0x18, 0xb4, 0x97,
+	0xa2, 0x87, 0xb4, 0x48, 0x0a, 0xf4, 0xd2, 0x3f, 0xa2, 0xe0, 0xee, 0x92, 0x7a, 0x98, 0x7e, 0x80,
+	0x72, 0x7a, 0x31, 0xcc, 0xdd, 0xd1, 0xf7, 0x7d, 0x33, 0x9c, 0x19, 0xce, 0x2e, 0x98, 0x2e, 0x65,
+	0xa2, 0xb2, 0x7d, 0xad, 0x4e, 0x04, 0xbe, 0x56, 0x79, 0x14, 0x10, 0x6f, 0xaf, 0xdc, 0xf6, 0xb8,
+	0xe0, 0x28, 0x17, 0xee, 0x94, 0xf5, 0x4e, 0x7e, 0x12, 0xbb, 0x94, 0xf1, 0x8a, 0xfc, 0xab, 0x0c,
+	0xf2, 0x73, 0x36, 0xf7, 0x5d, 0xee, 0x5b, 0xf2, 0xa9, 0xa2, 0x1e, 0xf4, 0xd6, 0x54, 0x93, 0x37,
+	0xb9, 0x5a, 0x0f, 0xff, 0xd3, 0xab, 0x0b, 0x4d, 0xce, 0x9b, 0x0e, 0xa9, 0xe0, 0x36, 0xad, 0x60,
+	0xc6, 0xb8, 0xc0, 0x82, 0x72, 0x16, 0xfd, 0xa6, 0x57, 0x89, 0xd8, 0x6b, 0x13, 0xbd, 0x53, 0x9c,
+	0x02, 0xf4, 0x76, 0x28, 0x6c, 0x03, 0x7b, 0xd8, 0xf5, 0x6b, 0xe4, 0x51, 0x40, 0x7c, 0x51, 0xbc,
+	0x07, 0xe7, 0x7b, 0x56, 0xfd, 0x36, 0x67, 0x3e, 0x41, 0x6f, 0x40, 0xa6, 0x2d, 0x57, 0x4c, 0x63,
+	0xc9, 0x28, 0x8d, 0x5d, 0x9f, 0x2a, 0x77, 0xfb, 0x51, 0x56, 0xd6, 0xd5, 0xec, 0xd3, 0xe7, 0x8b,
+	0x43, 0x4f, 0xfe, 0xfe, 0x69, 0xd5, 0xa8, 0x69, 0xf3, 0xe2, 0x2c, 0x4c, 0x4b, 0xbc, 0x35, 0xf6,
+	0xd0, 0x91, 0xc2, 0x22, 0x22, 0x06, 0x33, 0xfd, 0x1b, 0x9a, 0x6b, 0x13, 0xb2, 0x34, 0x5a, 0x94,
+	0x74, 0xb9, 0xea, 0xeb, 0x21, 0xf0, 0xef, 0xcf, 0x17, 0xe7, 0x55, 0x3c, 0xfc, 0xc6, 0x56, 0x99,
+	0xf2, 0x8a, 0x8b, 0x45, 0xab, 0x7c, 0x97, 0x34, 0xb1, 0xbd, 0x77, 0x8b, 0xd8, 0xbf, 0xfc, 0x7c,
+	0x05, 0x74, 0xb8, 0x6e, 0x11, 0x5b, 0xa9, 0xe8, 0x00, 0x15, 0xf3, 0x60, 0x4a, 0xbe, 0xdb, 0x2e,
+	0xf5, 0x7d, 0xca, 0xd9, 0x1a, 0x7b, 0xc8, 0x23, 0x2d, 0x7f, 0x4c, 0xc3, 0x5c, 0xc2, 0xe6, 0x80,
+	0xbe, 0xa3, 0x0f, 0x61, 0x92, 0xd8, 0xdc, 0xdf, 0xf3, 0x05, 0x71, 0xad, 0x3a, 0x76, 0x30, 0xb3,
+	0x89, 0x79, 0x6a, 0xc9, 0x28, 0x65, 0xab, 0x57, 0xb5, 0x43, 0xd3, 0xfb, 0x1d, 0x5a, 0x63, 0xa2,
+	0xcb, 0x95, 0x35, 0x26, 0x14, 0xe8, 0x44, 0x0c, 0x55, 0x55, 0x48, 0xa8, 0x05, 0xb3, 0x6d, 0x8f,
+	0x6c, 0x53, 0x1e, 0xf8, 0x56, 0xdd, 0xe1, 0xf6, 0x96, 0x45, 0xb4, 0x7c, 0x73, 0x38, 0x25, 0xc9,
+	0x74, 0x04, 0x58, 0x0d, 0xf1, 0xa2, 0x68, 0xa0, 0x1d, 0x58, 0xec, 0x38, 0x12, 0x3a, 0x6f, 0xf9,
+	0x41, 0xbb, 0xed, 0xec, 0x59, 0x1e, 0x71, 0x31, 0x65, 0x94, 0x35, 0xcd, 0x91, 0x94, 0x8c, 0x0b,
+	0x31, 0xf0, 0x3a, 0x65, 0xe2, 0xbe, 0x84, 0xad, 0x45, 0xa8, 0xa8, 0x04, 0x13, 0xd2, 0x33, 0xdf,
+	0x6a, 0x13, 0xcf, 0x72, 0x39, 0x13, 0x2d, 0x73, 0x74, 0xc9, 0x28, 0x8d, 0xd4, 0xce, 0xa9, 0xf5,
+	0x0d, 0xe2, 0xad, 0x87, 0xab, 0xa8, 0x06, 0x2b, 0x2a, 0x06, 0x2d, 0x42, 0x9b, 0x2d, 0x61, 0x09,
+	0xec, 0x35, 0x89, 0xb0, 0x88, 0x45, 0x2d, 0x07, 0xfb, 0xc2, 0xb2, 0xb1, 0x63, 0x07, 0x0e, 0x16,
+	0xa4, 0x61, 0x66, 0x24, 0xc0, 0xb2, 0x34, 0xbf, 0x23, 0xad, 0x37, 0xa5, 0xf1, 0xed, 0xb5, 0xbb,
+	0xd8, 0x17, 0x37, 0x63, 0xc3, 0xc3, 0x30, 0x19, 0xd9, 0xed, 0xc1, 0x3c, 0x7d, 0x20, 0xe6, 0x3d,
+	0xb2, 0xdb, 0x8d, 0xd9, 0x80, 0x69, 0x46, 0xc4, 0x0e, 0xf7, 0xb6, 0x2c, 0x5f, 0xe0, 0x2d, 0xd2,
+	0xb0, 0x04, 0xdf, 0x22, 0xcc, 0x37, 0xcf, 0xa4, 0x0c, 0xe0, 0x79, 0x0d, 0x77, 0x5f, 0xa2, 0x6d,
+	0x4a, 0x30, 0xc4, 0x61, 0x3e, 0x54, 0x42, 0x1a, 0xd6, 0x36, 0xf1, 0x05, 0x65, 0x4d, 0xcd, 0x62,
+	0x09, 0x2e, 0xb0, 0x63, 0x66, 0x53, 0x72, 0x99, 0x0a, 0xf4, 0x1d, 0x85, 0xa9, 0xb8, 0x36, 0x43,
+	0x44, 0xf4, 0x8d, 0x01, 0x2b, 0xc9, 0x8c, 0x94, 0x85, 0x0b, 0xdc, 0x0b, 0x3b, 0x1a, 0xf1, 0x09,
+	0x69, 0x98, 0x90, 0x92, 0xfd, 0x62, 0x02, 0xfb, 0x5a, 0x84, 0xbe, 0xa1, 0xc0, 0xd1, 0x17, 0x06,
+	0x5c, 0x3a, 0x4a, 0x88, 0x54, 0x31, 0x96, 0x52, 0xc5, 0xd2, 0x61, 0x2a, 0xee, 0x87, 0x12, 0x5c,
+	0xc8, 0x1f, 0x10, 0x7c, 0x82, 0x5d, 0x33, 0x97, 0x92, 0x77, 0x36, 0x29, 0xf6, 0x04, 0xbb, 0xe8,
+	0x7d, 0xe8, 0xb4, 0x06, 0x4b, 0x19, 0x99, 0x67, 0x53, 0x92, 0x8c, 0xc7, 0x48, 0x77, 0x25, 0x10,
+	0xb2, 0x00, 0xd9, 0xd4, 0x93, 0xc9, 0x1b, 0x3a, 0xa2, 0xca, 0xde, 0x3c, 0x97, 0x12, 0x7e, 0xb2,
+	0x0b, 0x4b, 0x95, 0x3a, 0x7a, 0x0b, 0xc0, 0xc5, 0xbb, 0x11, 0xf0, 0x78, 0x4a, 0xe0, 0xac, 0x8b,
+	0x77, 0x35, 0xe0, 0x63, 0x03, 0x56, 0xa3, 0x42, 0xd5, 0xfd, 0xcb, 0xf2, 0xb0, 0x20, 0xb2, 0x83,
+	0x04, 0x8c, 0x8a, 0x9e, 0xba, 0x33, 0x27, 0x24, 0x63, 0xda, 0xef, 0xcb, 0x25, 0xc5, 0x14, 0x35,
+	0xca, 0x1a, 0x16, 0x64, 0x83, 0x78, 0x0f, 0x18, 0x15, 0x5d, 0xe5, 0x88, 0x30, 0x4c, 0x78, 0xa4,
+	0x1d, 0x08, 0xe2, 0xc9, 0x3e, 0x66, 0x13, 0x26, 0xcc, 0xc9, 0x81, 0x78, 0xc7, 0x23, 0xbc, 0x0d,
+	0x05, 0x87, 0x08, 0xa0, 0x6d, 0xec, 0xd0, 0x06, 0x56, 0x95, 0xa6, 0x49, 0xd0, 0x40, 0x24, 0x93,
+	0x1d, 0xc4, 0x88, 0xe6, 0x07, 0x03, 0xca, 0x2e, 0xde, 0xa5, 0x6e, 0xe0, 0xaa, 0x6e, 0xec, 0xec,
+	0x75, 0xa2, 0x9c, 0x1c, 0xe0, 0xf3, 0x03, 0x69, 0x28, 0x69, 0xb6, 0x75, 0x45, 0x16, 0x05, 0x3a,
+	0x21, 0xc8, 0xdf, 0x1b, 0xf0, 0x9a, 0x7e, 0xef, 0x1e, 0xd9, 0xc1, 0x5e, 0xe3, 0x28, 0x61, 0x53,
+	0x03, 0x09, 0xbb, 0xac, 0xb8, 0x6a, 0x92, 0xea, 0x10, 0x59, 0x9f, 0x40, 0xe1, 0x08, 0x1d, 0xd3,
+	0x03, 0xe9, 0xc8, 0x93, 0x83, 0xb9, 0x3f, 0x02, 0xd4, 0xc3, 0xad, 0xbe, 0x9f, 0x33, 0xa9, 0x07,
+	0x90, 0x0e, 0x93, 0xfa, 0xe6, 0xbe, 0x0b, 0xe7, 0xfa, 0xe6, 0x8e, 0xd9, 0x94, 0xd8, 0x67, 0xeb,
+	0x3d, 0xf3, 0xc6, 0x03, 0x38, 0x1b, 0xe7, 0x9e, 0x65, 0x07, 0xc2, 0x34, 0x53, 0xe2, 0xe6, 0x62,
+	0x98, 0x9b, 0x81, 0x08, 0xe3, 0x81, 0x1d, 0x87, 0x7b, 0x58, 0x67, 0x88, 0x2f, 0xb1, 0xe7, 0xd2,
+	0xc6, 0x43, 0x61, 0xa9, 0x0c, 0xf0, 0x6f, 0x06, 0xaa, 0x3a, 0xe2, 0x89, 0xec, 0x78, 0x49, 0x98,
+	0x1f, 0xac, 0x3a, 0x22, 0xb6, 0xa3, 0xd2, 0xb0, 0xf8, 0x8f, 0x01, 0x0b, 0x6a, 0xae, 0xf7, 0xf8,
+	0xc7, 0xc4, 0x8e, 0x3b, 0x56, 0x34, 0xf7, 0xa3, 0x19, 0xc8, 0xc8, 0xf4, 0x50, 0x43, 0xee, 0x48,
+	0x4d, 0x3f, 0x85, 0xaf, 0x42, 0x0b, 0xd6, 0x2d, 0x3a, 0xed, 0xfc, 0x9a, 0x53, 0x30, 0xba, 0x4b,
+	0x27, 0x8e, 0xc6, 0xc3, 0x27, 0x35, 0x1a, 0x17, 0xbf, 0xca, 0x00, 0x8a, 0xa3, 0xa1, 0x3c, 0x0e,
+	0xf3, 0x6a, 0x0a, 0x46, 0x55, 0x0d, 0x28, 0x1f, 0xd5, 0x03, 0x5a, 0x86, 0x5c, 0xf7, 0x98, 0x27,
+	0x3d, 0x1c, 0xa9, 0x8d, 0x75, 0xcd, 0x72, 0xc7, 0xa8, 0xe2, 0xe1, 0xff, 0xb8, 0x8a, 0x47, 0x5e,
+	0x61, 0x15, 0x8f, 0x9e, 0x4c, 0x15, 0xdf, 0x81, 0x4c, 0x78, 0x56, 0xd0, 0x13, 0x77, 0x1a, 0x40,
+	0xfd, 0xfb, 0x30, 0x5b, 0xec, 0xc0, 0x95, 0x83, 0xc3, 0x36, 0xb1, 0x34, 0xe8, 0xe9, 0xb4, 0x11,
+	0xe8, 0x40, 0xad, 0xc7, 0xf0, 0xfb, 0x93, 0xf1, 0xcc, 0x89, 0x9d, 0xd3, 0x8e, 0x71, 0x7a, 0xca,
+	0xbe, 0x8a, 0xd3, 0x53, 0x91, 0xc1, 0x85, 0x03, 0x6a, 0x5e, 0x9f, 0x6c, 0xd7, 0x61, 0xac, 0x1d,
+	0x57, 0x47, 0x58, 0xf9, 0xc3, 0xa5, 0xb1, 0xeb, 0x4b, 0xbd, 0xc7, 0xdb, 0xfd, 0x65, 0xd4, 0x7d,
+	0xd4, 0xed, 0xfe, 0x7d, 0xf1, 0x02, 0xcc, 0x4b, 0x3e, 0x79, 0x24, 0xa8, 0x06, 0x1e, 0x23, 0x8d,
+	0x37, 0x09, 0x89, 0xaf, 0x16, 0x3e, 0x83, 0x85, 0xe4, 0x6d, 0xad, 0xe6, 0x03, 0x98, 0x94, 0xc7,
+	0x13, 0xab, 0x2e, 0xf7, 0xac, 0x87, 0x84, 0xa8, 0x6e, 0x94, 0x6a, 0x92, 0x15, 0xbd, 0x2c, 0xd7,
+	0x9f, 0x8c, 0xc2, 0xa8, 0xa4, 0x47, 0x5b, 0x90, 0x51, 0x67, 0x76, 0xd4, 0xe7, 0xea, 0xfe, 0xeb,
+	0x90, 0xfc, 0xf2, 0x21, 0x16, 0x4a, 0x76, 0x71, 0xe1, 0xcb, 0x5f, 0xff, 0xfa, 0xee, 0xd4, 0x0c,
+	0x9a, 0xaa, 0xf4, 0x5c, 0xb5, 0xe8, 0x3b, 0x80, 0x1d, 0xc8, 0xc6, 0x37, 0x1c, 0xe8, 0x62, 0x02,
+	0x5a, 0xff, 0xc5, 0x48, 0xfe, 0xd2, 0xe1, 0x46, 0x9a, 0x75, 0x51, 0xb2, 0xce, 0xa1, 0xd9, 0x5e,
+	0xd6, 0xf8, 0xbe, 0x03, 0x7d, 0x6d, 0x40, 0xae, 0xfb, 0x3a, 0x03, 0x5d, 0x4e, 0xc0, 0x4d, 0xb8,
+	0x0c, 0xc9, 0xaf, 0x1c, 0x69, 0xa7, 0x25, 0x5c, 0x94, 0x12, 0x2e, 0xa0, 0xf9, 0x5e, 0x09, 0x71,
+	0xb3, 0xa2, 0x21, 0xeb, 0x8f, 0x06, 0x4c, 0xf4, 0xe7, 0x1f, 0x5a, 0x4d, 0x8a, 0x6a, 0xf2, 0x87,
+	0x29, 0xff, 0xff, 0x63, 0xd9, 0x6a, 0x49, 0x57, 0xa5, 0xa4, 0x55, 0x54, 0xea, 0x7b, 0x17, 0xca,
+	0x3e, 0xee, 0x70, 0x7e, 0xe5, 0x53, 0xf5, 0x79, 0xfb, 0x1c, 0x7d, 0x6b, 0xc0, 0x78, 0x5f, 0x42,
+	0xa2, 0xff, 0x25, 0x50, 0x26, 0xe7, 0x74, 0x7e, 0xf5, 0x38, 0xa6, 0x5a, 0xdc, 0x8a, 0x14, 0xb7,
+	0x8c, 0x16, 0x7b, 0xc5, 0xed, 0xcb, 0xf9, 0xea, 0xfa, 0xd3, 0x17, 0x05, 0xe3, 0xd9, 0x8b, 0x82,
+	0xf1, 0xe7, 0x8b, 0x82, 0xf1, 0xf8, 0x65, 0x61, 0xe8, 0xd9, 0xcb, 0xc2, 0xd0, 0x6f, 0x2f, 0x0b,
+	0x43, 0xef, 0xdd, 0x68, 0x52, 0xd1, 0x0a, 0xea, 0x65, 0x9b, 0xbb, 0x15, 0x35, 0x7e, 0x5c, 0xd1,
+	0xc7, 0xff, 0xe8, 0xd1, 0x6e, 0x61, 0xca, 0x2a, 0xbb, 0x8a, 0x42, 0x5e, 0xf7, 0xd5, 0x33, 0xf2,
+	0xbe, 0xef, 0xc6, 0xbf, 0x03, 0x00, 0x50, 0xcd, 0xdd, 0x84, 0x95, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -421,6 +685,12 @@ type QueryClient interface {
 	// Inflation returns the current minting inflation value.
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
 	EmissionInfo(ctx context.Context, in *QueryEmissionInfoRequest, opts ...grpc.CallOption) (*QueryEmissionInfoResponse, error)
+	// ProjectEmissions simulates the emissions of the coming months
+	// under assumed staked supply and ecosystem balance.
+	ProjectEmissions(ctx context.Context, in *QueryProjectEmissionsRequest, opts ...grpc.CallOption) (*QueryProjectEmissionsResponse, error)
+	// TotalBurnedFees returns the number of tokens burned out of the fees
+	// collected.
+	TotalBurnedFees(ctx context.Context, in *QueryTotalBurnedFeesRequest, opts ...grpc.CallOption) (*QueryTotalBurnedFeesResponse, error)
 }
 
 type queryClient struct {
@@ -458,6 +728,24 @@ func (c *queryClient) EmissionInfo(ctx context.Context, in *QueryEmissionInfoReq
 	return out, nil
 }
 
+func (c *queryClient) ProjectEmissions(ctx context.Context, in *QueryProjectEmissionsRequest, opts ...grpc.CallOption) (*QueryProjectEmissionsResponse, error) {
+	out := new(QueryProjectEmissionsResponse)
+	err := c.cc.Invoke(ctx, "/mint.v1beta1.Query/ProjectEmissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalBurnedFees(ctx context.Context, in *QueryTotalBurnedFeesRequest, opts ...grpc.CallOption) (*QueryTotalBurnedFeesResponse, error) {
+	out := new(QueryTotalBurnedFeesResponse)
+	err := c.cc.Invoke(ctx, "/mint.v1beta1.Query/TotalBurnedFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
@@ -465,6 +753,12 @@ type QueryServer interface {
 	// Inflation returns the current minting inflation value.
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
 	EmissionInfo(context.Context, *QueryEmissionInfoRequest) (*QueryEmissionInfoResponse, error)
+	// ProjectEmissions simulates the emissions of the coming months
+	// under assumed staked supply and ecosystem balance.
+	ProjectEmissions(context.Context, *QueryProjectEmissionsRequest) (*QueryProjectEmissionsResponse, error)
+	// TotalBurnedFees returns the number of tokens burned out of the fees
+	// collected.
+	TotalBurnedFees(context.Context, *QueryTotalBurnedFeesRequest) (*QueryTotalBurnedFeesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -480,6 +774,12 @@ func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflat
 func (*UnimplementedQueryServer) EmissionInfo(ctx context.Context, req *QueryEmissionInfoRequest) (*QueryEmissionInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EmissionInfo not implemented")
 }
+func (*UnimplementedQueryServer) ProjectEmissions(ctx context.Context, req *QueryProjectEmissionsRequest) (*QueryProjectEmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectEmissions not implemented")
+}
+func (*UnimplementedQueryServer) TotalBurnedFees(ctx context.Context, req *QueryTotalBurnedFeesRequest) (*QueryTotalBurnedFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalBurnedFees not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -539,6 +839,42 @@ func _Query_EmissionInfo_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProjectEmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectEmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectEmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mint.v1beta1.Query/ProjectEmissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectEmissions(ctx, req.(*QueryProjectEmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalBurnedFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalBurnedFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalBurnedFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mint.v1beta1.Query/TotalBurnedFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalBurnedFees(ctx, req.(*QueryTotalBurnedFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "mint.v1beta1.Query",
@@ -556,6 +892,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EmissionInfo",
 			Handler:    _Query_EmissionInfo_Handler,
 		},
+		{
+			MethodName: "ProjectEmissions",
+			Handler:    _Query_ProjectEmissions_Handler,
+		},
+		{
+			MethodName: "TotalBurnedFees",
+			Handler:    _Query_TotalBurnedFees_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mint/v1beta1/query.proto",
@@ -986,74 +1330,318 @@ func (m *QueryEmissionInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProjectEmissionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryProjectEmissionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryInflationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryProjectEmissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	{
+		size := m.EcosystemBalance.Size()
+		i -= size
+		if _, err := m.EcosystemBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.StakedSupply.Size()
+		i -= size
+		if _, err := m.StakedSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Months != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Months))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryInflationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EmissionProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Inflation.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryEmissionInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *EmissionProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryEmissionInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EmissionProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.EcosystemMintSupplyRemaining.Size()
+		i -= size
+		if _, err := m.EcosystemMintSupplyRemaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.EcosystemBalance.Size()
+		i -= size
+		if _, err := m.EcosystemBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.CumulativeMinted.Size()
+		i -= size
+		if _, err := m.CumulativeMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.BlockEmission.Size()
+		i -= size
+		if _, err := m.BlockEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.EmissionPerMonth.Size()
+		i -= size
+		if _, err := m.EmissionPerMonth.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.EmissionPerUnitStakedToken.Size()
+		i -= size
+		if _, err := m.EmissionPerUnitStakedToken.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Month != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Month))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectEmissionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectEmissionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectEmissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for iNdEx := len(m.Projections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalBurnedFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalBurnedFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalBurnedFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalBurnedFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalBurnedFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalBurnedFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalBurnedFees.Size()
+		i -= size
+		if _, err := m.TotalBurnedFees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInflationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEmissionInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEmissionInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	l = m.EcosystemBalance.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	l = m.PreviousBlockEmission.Size()
@@ -1110,25 +1698,105 @@ func (m *QueryEmissionInfoResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryProjectEmissionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Months != 0 {
+		n += 1 + sovQuery(uint64(m.Months))
+	}
+	l = m.StakedSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EcosystemBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *EmissionProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Month != 0 {
+		n += 1 + sovQuery(uint64(m.Month))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	l = m.EmissionPerUnitStakedToken.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EmissionPerMonth.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BlockEmission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Minted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CumulativeMinted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EcosystemBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EcosystemMintSupplyRemaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
+
+func (m *QueryProjectEmissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for _, e := range m.Projections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTotalBurnedFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalBurnedFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalBurnedFees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
 			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
@@ -1197,9 +1865,603 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EcosystemBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousBlockEmission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousBlockEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemMintSupplyRemaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EcosystemMintSupplyRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerMonth", wireType)
+			}
+			m.BlocksPerMonth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerMonth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeightTargetEILastCalculated", wireType)
+			}
+			m.BlockHeightTargetEILastCalculated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeightTargetEILastCalculated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeightTargetEINextCalculated", wireType)
+			}
+			m.BlockHeightTargetEINextCalculated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeightTargetEINextCalculated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkStakedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetworkStakedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedVestingTokensTotal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockedVestingTokensTotal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedVestingTokensInvestorsPreseed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockedVestingTokensInvestorsPreseed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedVestingTokensInvestorsSeed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockedVestingTokensInvestorsSeed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedVestingTokensTeam", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1209,130 +2471,31 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LockedVestingTokensTeam.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemLocked", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1342,130 +2505,65 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EcosystemLocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryEmissionInfoRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupply", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEmissionInfoRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEmissionInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.CirculatingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEmissionInfoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEmissionInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1475,28 +2573,29 @@ func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetEmissionRatePerUnitStakedToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1524,13 +2623,13 @@ func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EcosystemBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TargetEmissionRatePerUnitStakedToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousBlockEmission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputersPercent", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1558,13 +2657,13 @@ func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PreviousBlockEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReputersPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemMintSupplyRemaining", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPercent", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1592,15 +2691,15 @@ func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EcosystemMintSupplyRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValidatorsPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerMonth", wireType)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumMonthlyEmissionPerUnitStakedToken", wireType)
 			}
-			m.BlocksPerMonth = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1610,16 +2709,31 @@ func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlocksPerMonth |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeightTargetEILastCalculated", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.BlockHeightTargetEILastCalculated = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaximumMonthlyEmissionPerUnitStakedToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRewardEmissionPerUnitStakedToken", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1629,16 +2743,31 @@ func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeightTargetEILastCalculated |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeightTargetEINextCalculated", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.BlockHeightTargetEINextCalculated = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetRewardEmissionPerUnitStakedToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionPerUnitStakedToken", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1648,14 +2777,29 @@ func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeightTargetEINextCalculated |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmissionPerUnitStakedToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkStakedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionPerMonth", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1683,13 +2827,13 @@ func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetworkStakedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EmissionPerMonth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockedVestingTokensTotal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockEmission", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1717,13 +2861,13 @@ func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LockedVestingTokensTotal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BlockEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockedVestingTokensInvestorsPreseed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCut", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1751,13 +2895,13 @@ func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LockedVestingTokensInvestorsPreseed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValidatorCut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockedVestingTokensInvestorsSeed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AlloraRewardsCut", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1785,13 +2929,13 @@ func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LockedVestingTokensInvestorsSeed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AlloraRewardsCut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockedVestingTokensTeam", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousRewardEmissionPerUnitStakedToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1819,13 +2963,82 @@ func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LockedVestingTokensTeam.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PreviousRewardEmissionPerUnitStakedToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectEmissionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectEmissionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectEmissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Months", wireType)
+			}
+			m.Months = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Months |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemLocked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedSupply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1853,13 +3066,13 @@ func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EcosystemLocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StakedSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1887,15 +3100,65 @@ func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CirculatingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EcosystemBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Month", wireType)
 			}
-			var stringLen uint64
+			m.Month = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1905,31 +3168,16 @@ func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Month |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetEmissionRatePerUnitStakedToken", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1939,29 +3187,14 @@ func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TargetEmissionRatePerUnitStakedToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 17:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputersPercent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionPerUnitStakedToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1989,13 +3222,13 @@ func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReputersPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EmissionPerUnitStakedToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPercent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionPerMonth", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2023,13 +3256,13 @@ func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValidatorsPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EmissionPerMonth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 19:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumMonthlyEmissionPerUnitStakedToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockEmission", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2057,13 +3290,13 @@ func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaximumMonthlyEmissionPerUnitStakedToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BlockEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetRewardEmissionPerUnitStakedToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2091,13 +3324,13 @@ func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TargetRewardEmissionPerUnitStakedToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmissionPerUnitStakedToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeMinted", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2125,13 +3358,13 @@ func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EmissionPerUnitStakedToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CumulativeMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 22:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmissionPerMonth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2159,13 +3392,13 @@ func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EmissionPerMonth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EcosystemBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 23:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockEmission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemMintSupplyRemaining", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2193,15 +3426,65 @@ func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EcosystemMintSupplyRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 24:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectEmissionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectEmissionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectEmissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Projections", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2211,63 +3494,129 @@ func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValidatorCut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Projections = append(m.Projections, EmissionProjection{})
+			if err := m.Projections[len(m.Projections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 25:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlloraRewardsCut", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalBurnedFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AlloraRewardsCut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalBurnedFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalBurnedFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 26:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalBurnedFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalBurnedFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalBurnedFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousRewardEmissionPerUnitStakedToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurnedFees", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2295,7 +3644,7 @@ func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PreviousRewardEmissionPerUnitStakedToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalBurnedFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -56,6 +56,9 @@ type Params struct {
 	// percentage of the total supply that is locked in the preseed investors
 	// bucket at the genesis
 	InvestorsPreseedPercentOfTotalSupply cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=investors_preseed_percent_of_total_supply,json=investorsPreseedPercentOfTotalSupply,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"investors_preseed_percent_of_total_supply"`
+	// fraction of the create topic, registration and data sending fees burned
+	// when they are collected, the rest going to the ecosystem treasury
+	FeeBurnFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=fee_burn_fraction,json=feeBurnFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_burn_fraction"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -105,44 +108,45 @@ func init() {
 func init() { proto.RegisterFile("mint/v1beta1/types.proto", fileDescriptor_010015e812760429) }
 
 var fileDescriptor_010015e812760429 = []byte{
-	// 577 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x73, 0xfc, 0x28, 0xe4, 0x60, 0x00, 0x0b, 0x84, 0x09, 0xd4, 0x2d, 0xa8, 0x20, 0x08,
-	0x4a, 0x4c, 0xd5, 0x0d, 0x31, 0x55, 0x61, 0xa8, 0x44, 0xd4, 0xa8, 0xed, 0x02, 0x0c, 0xa7, 0x8b,
-	0xfd, 0xe2, 0x9c, 0xea, 0xbb, 0x67, 0xf9, 0x2e, 0x25, 0x96, 0x58, 0x61, 0x40, 0x0c, 0x2c, 0x08,
-	0xf1, 0x1f, 0x30, 0x76, 0xe8, 0x1f, 0xd1, 0xb1, 0xea, 0x84, 0x90, 0xa8, 0x50, 0x32, 0xf4, 0xdf,
-	0x40, 0xfe, 0xd1, 0xb4, 0x12, 0x09, 0x2c, 0x5e, 0x2c, 0xdf, 0x7b, 0xd6, 0xf7, 0xf3, 0xd1, 0x3b,
-	0xfb, 0x4c, 0x6d, 0x29, 0x94, 0x71, 0x77, 0x96, 0xbb, 0x60, 0xf8, 0xb2, 0x6b, 0x92, 0x08, 0x74,
-	0x33, 0x8a, 0xd1, 0xa0, 0x75, 0x35, 0xed, 0x34, 0x8b, 0x4e, 0xed, 0x3a, 0x97, 0x42, 0xa1, 0x9b,
-	0x5d, 0xf3, 0x07, 0x6a, 0xb7, 0x3d, 0xd4, 0x12, 0x35, 0xcb, 0x56, 0x6e, 0xbe, 0x28, 0x5a, 0x37,
-	0x02, 0x0c, 0x30, 0xaf, 0xa7, 0x77, 0x79, 0xf5, 0xfe, 0x2f, 0x4a, 0xe7, 0x3a, 0x3c, 0xe6, 0x52,
-	0x5b, 0xf3, 0x94, 0xa6, 0xf1, 0xcc, 0x07, 0x85, 0xd2, 0x26, 0x8b, 0xe4, 0x51, 0x75, 0xa3, 0x9a,
-	0x56, 0x5a, 0x69, 0xc1, 0x5a, 0xa7, 0x54, 0xf2, 0x21, 0xd3, 0x83, 0x28, 0x0a, 0x13, 0xfb, 0x5c,
-	0xda, 0x5e, 0x7d, 0xba, 0x7f, 0xb4, 0x50, 0xf9, 0x79, 0xb4, 0x70, 0x33, 0x27, 0x69, 0x7f, 0xbb,
-	0x29, 0xd0, 0x95, 0xdc, 0xf4, 0x9b, 0x6b, 0xca, 0x1c, 0xee, 0x35, 0x68, 0xa1, 0xb0, 0xa6, 0xcc,
-	0xf7, 0xe3, 0xdd, 0x3a, 0xd9, 0xa8, 0x4a, 0x3e, 0xdc, 0xcc, 0x22, 0xac, 0x37, 0x94, 0xf6, 0x18,
-	0x48, 0xa1, 0xb5, 0x40, 0x65, 0x9f, 0xcf, 0x02, 0x9f, 0x17, 0x81, 0x77, 0xfe, 0x0e, 0x7c, 0x09,
-	0x01, 0xf7, 0x92, 0x16, 0x78, 0x87, 0x7b, 0x8d, 0x6b, 0x45, 0xec, 0xa4, 0x56, 0x84, 0xf7, 0x5e,
-	0x14, 0x71, 0x56, 0x42, 0x6b, 0xa8, 0x80, 0x49, 0x54, 0xa6, 0xcf, 0xb4, 0x44, 0x34, 0x7d, 0xa1,
-	0x02, 0xe6, 0x43, 0x10, 0x03, 0xd8, 0x17, 0x4a, 0x80, 0xdd, 0x42, 0x05, 0xed, 0x34, 0x7e, 0xf3,
-	0x24, 0xbd, 0x95, 0x85, 0x5b, 0x5f, 0x09, 0xad, 0x83, 0x87, 0x3a, 0xd1, 0x06, 0x24, 0x33, 0x31,
-	0x70, 0x3d, 0x88, 0x13, 0x16, 0x41, 0xec, 0x81, 0x32, 0x0c, 0x7b, 0xcc, 0xa0, 0xe1, 0xe1, 0xc9,
-	0x24, 0x2f, 0x96, 0xe0, 0xf2, 0x60, 0xc2, 0xdb, 0x2a, 0x70, 0x9d, 0x9c, 0xb6, 0xde, 0xdb, 0x4a,
-	0x59, 0xc5, 0xc4, 0xbf, 0x11, 0xfa, 0xa4, 0x87, 0x03, 0xe5, 0x73, 0x23, 0x50, 0xfd, 0x5f, 0x6d,
-	0xae, 0x04, 0xb5, 0x87, 0xa7, 0xc0, 0x7f, 0xba, 0x7d, 0x22, 0x74, 0x29, 0xe2, 0xb1, 0x11, 0x9e,
-	0x88, 0xb8, 0x32, 0x7a, 0xa6, 0xd4, 0xa5, 0x12, 0xa4, 0x16, 0xcf, 0x92, 0xa6, 0xea, 0x7c, 0x20,
-	0xf4, 0x9e, 0x50, 0x3b, 0xa0, 0x0d, 0xc6, 0xb3, 0x5d, 0x2e, 0x97, 0xe0, 0x32, 0x3f, 0xc1, 0x4c,
-	0x15, 0x79, 0x47, 0xef, 0x1a, 0xe0, 0x72, 0xa6, 0x42, 0xb5, 0x04, 0x05, 0x3b, 0x25, 0x4c, 0xa5,
-	0xbf, 0x27, 0x74, 0x51, 0xf2, 0xa1, 0x90, 0x03, 0x99, 0x7f, 0x4b, 0xe1, 0xe4, 0x6d, 0xe1, 0x01,
-	0xb0, 0x44, 0x40, 0xe8, 0xdb, 0xb4, 0x8c, 0x29, 0x14, 0x94, 0x76, 0x0e, 0xe9, 0x4c, 0x18, 0xaf,
-	0x52, 0x84, 0xf5, 0x85, 0xd0, 0xc7, 0x67, 0xb6, 0x23, 0x06, 0x0d, 0xe0, 0xcf, 0x9c, 0xc9, 0x95,
-	0x12, 0x84, 0x96, 0x4e, 0xb7, 0x25, 0xa7, 0x4d, 0x9b, 0xcf, 0xb3, 0x85, 0x8f, 0xc7, 0xbb, 0xf5,
-	0x1a, 0x0f, 0x43, 0x8c, 0x79, 0xc3, 0xeb, 0x73, 0xa1, 0xdc, 0xa1, 0x9b, 0x1d, 0xdf, 0xf9, 0xa1,
-	0xba, 0xda, 0xde, 0x1f, 0x39, 0xe4, 0x60, 0xe4, 0x90, 0xdf, 0x23, 0x87, 0x7c, 0x1e, 0x3b, 0x95,
-	0x83, 0xb1, 0x53, 0xf9, 0x31, 0x76, 0x2a, 0xaf, 0x57, 0x02, 0x61, 0xfa, 0x83, 0x6e, 0xd3, 0x43,
-	0xe9, 0x16, 0x01, 0x0a, 0xcc, 0x5b, 0x8c, 0xb7, 0xdd, 0x69, 0x79, 0xd9, 0x6f, 0xa0, 0x3b, 0x97,
-	0x9d, 0xda, 0x2b, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x34, 0x64, 0x57, 0x75, 0x23, 0x06, 0x00,
-	0x00,
+	// 603 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xc1, 0x4f, 0xd4, 0x4e,
+	0x14, 0xc7, 0xb7, 0xbf, 0x9f, 0xa2, 0x3b, 0x90, 0x28, 0x8d, 0xc6, 0xba, 0xca, 0x82, 0x06, 0x8d,
+	0x62, 0xd8, 0x4a, 0xb8, 0x19, 0x4f, 0x04, 0x4d, 0x48, 0x24, 0x10, 0xe0, 0xa2, 0x1e, 0x26, 0xb3,
+	0xdd, 0xd7, 0xee, 0x84, 0xce, 0xbc, 0x66, 0x66, 0x8a, 0xdb, 0xc4, 0xab, 0x1e, 0x8c, 0x07, 0x2f,
+	0xc6, 0xf8, 0x1f, 0x78, 0xe4, 0xc0, 0xff, 0x20, 0x47, 0xc2, 0xc9, 0x78, 0x20, 0x86, 0x3d, 0xf0,
+	0x6f, 0x98, 0xb6, 0xc3, 0x42, 0xe2, 0xae, 0x5e, 0x7a, 0xd9, 0xec, 0xbc, 0xd7, 0x7c, 0x3f, 0x9f,
+	0xbc, 0x99, 0x76, 0x88, 0x27, 0xb8, 0x34, 0xfe, 0xce, 0x42, 0x1b, 0x0c, 0x5b, 0xf0, 0x4d, 0x96,
+	0x80, 0x6e, 0x25, 0x0a, 0x0d, 0xba, 0x13, 0x79, 0xa7, 0x65, 0x3b, 0x8d, 0x49, 0x26, 0xb8, 0x44,
+	0xbf, 0xf8, 0x2d, 0x1f, 0x68, 0xdc, 0x0c, 0x50, 0x0b, 0xd4, 0xb4, 0x58, 0xf9, 0xe5, 0xc2, 0xb6,
+	0xae, 0x45, 0x18, 0x61, 0x59, 0xcf, 0xff, 0x95, 0xd5, 0xbb, 0xdf, 0xc7, 0xc9, 0xd8, 0x3a, 0x53,
+	0x4c, 0x68, 0x77, 0x8a, 0x90, 0x3c, 0x9e, 0x76, 0x40, 0xa2, 0xf0, 0x9c, 0x19, 0xe7, 0x41, 0x7d,
+	0xa3, 0x9e, 0x57, 0x96, 0xf3, 0x82, 0xbb, 0x46, 0x88, 0x60, 0x3d, 0xaa, 0xd3, 0x24, 0x89, 0x33,
+	0xef, 0xbf, 0xbc, 0xbd, 0xf4, 0x78, 0xff, 0x68, 0xba, 0xf6, 0xf3, 0x68, 0xfa, 0x7a, 0x49, 0xd2,
+	0x9d, 0xed, 0x16, 0x47, 0x5f, 0x30, 0xd3, 0x6d, 0xad, 0x48, 0x73, 0xb8, 0x37, 0x4f, 0xac, 0xc2,
+	0x8a, 0x34, 0xdf, 0x4e, 0x76, 0xe7, 0x9c, 0x8d, 0xba, 0x60, 0xbd, 0xcd, 0x22, 0xc2, 0x7d, 0x4d,
+	0x48, 0x48, 0x41, 0x70, 0xad, 0x39, 0x4a, 0xef, 0xff, 0x22, 0xf0, 0xa9, 0x0d, 0xbc, 0xf5, 0x67,
+	0xe0, 0x0b, 0x88, 0x58, 0x90, 0x2d, 0x43, 0x70, 0xb8, 0x37, 0x7f, 0xd5, 0xc6, 0x0e, 0x6a, 0x36,
+	0x3c, 0x7c, 0x66, 0xe3, 0xdc, 0x8c, 0x34, 0x50, 0x02, 0x15, 0x28, 0x4d, 0x97, 0x6a, 0x81, 0x68,
+	0xba, 0x5c, 0x46, 0xb4, 0x03, 0x91, 0x02, 0xf0, 0x2e, 0x54, 0x00, 0xbb, 0x81, 0x12, 0x56, 0xf3,
+	0xf8, 0xcd, 0xd3, 0xf4, 0xe5, 0x22, 0xdc, 0xfd, 0xe2, 0x90, 0x39, 0x08, 0x50, 0x67, 0xda, 0x80,
+	0xa0, 0x46, 0x01, 0xd3, 0xa9, 0xca, 0x68, 0x02, 0x2a, 0x00, 0x69, 0x28, 0x86, 0xd4, 0xa0, 0x61,
+	0xf1, 0xe9, 0x24, 0x2f, 0x56, 0xe0, 0x72, 0x6f, 0xc0, 0xdb, 0xb2, 0xb8, 0xf5, 0x92, 0xb6, 0x16,
+	0x6e, 0xe5, 0x2c, 0x3b, 0xf1, 0xaf, 0x0e, 0x79, 0x14, 0x62, 0x2a, 0x3b, 0xcc, 0x70, 0x94, 0xff,
+	0x56, 0x1b, 0xab, 0x40, 0xed, 0xfe, 0x19, 0xf0, 0xaf, 0x6e, 0x1f, 0x1d, 0x32, 0x9b, 0x30, 0x65,
+	0x78, 0xc0, 0x13, 0x26, 0x8d, 0x1e, 0x29, 0x75, 0xa9, 0x02, 0xa9, 0x99, 0xf3, 0xa4, 0xa1, 0x3a,
+	0xef, 0x1d, 0x72, 0x87, 0xcb, 0x1d, 0xd0, 0x06, 0xd5, 0x68, 0x97, 0xcb, 0x15, 0xb8, 0x4c, 0x0d,
+	0x30, 0x43, 0x45, 0xde, 0x92, 0xdb, 0x06, 0x98, 0x18, 0xa9, 0x50, 0xaf, 0x40, 0xc1, 0xcb, 0x09,
+	0x43, 0xe9, 0xef, 0x1c, 0x32, 0x23, 0x58, 0x8f, 0x8b, 0x54, 0x94, 0xef, 0x52, 0x3c, 0x38, 0x2d,
+	0x2c, 0x02, 0x9a, 0x71, 0x88, 0x3b, 0x1e, 0xa9, 0x62, 0x0a, 0x96, 0xb2, 0x5a, 0x42, 0xd6, 0x07,
+	0x8c, 0x97, 0x39, 0xc2, 0xfd, 0xec, 0x90, 0x87, 0xe7, 0xb6, 0x43, 0x81, 0x06, 0xe8, 0x8c, 0x9c,
+	0xc9, 0x78, 0x05, 0x42, 0xb3, 0x67, 0xdb, 0x52, 0xd2, 0x86, 0xce, 0xa7, 0x4b, 0x26, 0x43, 0x00,
+	0xda, 0x4e, 0x95, 0xa4, 0xa1, 0x62, 0x41, 0x7e, 0xcc, 0xbd, 0x89, 0x0a, 0xf0, 0x57, 0x42, 0x80,
+	0xa5, 0x54, 0xc9, 0xe7, 0x36, 0xf4, 0xc9, 0xf4, 0x87, 0x93, 0xdd, 0xb9, 0x06, 0x8b, 0x63, 0x54,
+	0x6c, 0x3e, 0xe8, 0x32, 0x2e, 0xfd, 0x9e, 0x5f, 0x5c, 0x14, 0xe5, 0xe7, 0x7b, 0x69, 0x75, 0xff,
+	0xb8, 0xe9, 0x1c, 0x1c, 0x37, 0x9d, 0x5f, 0xc7, 0x4d, 0xe7, 0x53, 0xbf, 0x59, 0x3b, 0xe8, 0x37,
+	0x6b, 0x3f, 0xfa, 0xcd, 0xda, 0xab, 0xc5, 0x88, 0x9b, 0x6e, 0xda, 0x6e, 0x05, 0x28, 0x7c, 0x1b,
+	0x20, 0xc1, 0xbc, 0x41, 0xb5, 0xed, 0x0f, 0xcb, 0x2b, 0x2e, 0x9c, 0xf6, 0x58, 0x71, 0x3f, 0x2c,
+	0xfe, 0x1e, 0x00, 0x0f, 0x30, 0x1a, 0x57, 0x8d, 0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -165,6 +169,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.FeeBurnFraction.Size()
+		i -= size
+		if _, err := m.FeeBurnFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
 	{
 		size := m.InvestorsPreseedPercentOfTotalSupply.Size()
 		i -= size
@@ -316,6 +330,8 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovTypes(uint64(l))
 	l = m.InvestorsPreseedPercentOfTotalSupply.Size()
 	n += 1 + l + sovTypes(uint64(l))
+	l = m.FeeBurnFraction.Size()
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -726,6 +742,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeBurnFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])